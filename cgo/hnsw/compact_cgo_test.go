@@ -0,0 +1,91 @@
+//go:build cgo
+
+package hnsw
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+// unitVector returns a vector of the given dimension with a 1 at position i.
+func unitVector(dimension, i int) []float32 {
+	vec := make([]float32, dimension)
+	vec[i%dimension] = 1
+	return vec
+}
+
+func TestIndex_CompactKeepsIndexQueryable(t *testing.T) {
+	const dimension = 8
+	for _, precision := range []Precision{PrecisionFloat32, PrecisionFloat16, PrecisionInt8} {
+		t.Run(fmt.Sprintf("precision-%d", precision), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "vectors")
+			idx, err := New(path, dimension, precision)
+			if err != nil {
+				t.Fatalf("New failed: %v", err)
+			}
+
+			ctx := context.Background()
+			for i := 0; i < dimension; i++ {
+				if err := idx.Add(ctx, fmt.Sprintf("chunk-%d", i), unitVector(dimension, i)); err != nil {
+					t.Fatalf("Add failed: %v", err)
+				}
+			}
+			// Replacing and deleting vectors leaves stale slots behind
+			if err := idx.Add(ctx, "chunk-0", unitVector(dimension, 0)); err != nil {
+				t.Fatalf("re-Add failed: %v", err)
+			}
+			for i := 4; i < dimension; i++ {
+				if err := idx.Delete(ctx, fmt.Sprintf("chunk-%d", i)); err != nil {
+					t.Fatalf("Delete failed: %v", err)
+				}
+			}
+
+			if err := idx.Compact(ctx); err != nil {
+				t.Fatalf("Compact failed: %v", err)
+			}
+
+			assertLiveChunks(t, idx, dimension)
+
+			// The compacted index is saved and reopens with the same contents
+			if err := idx.Close(); err != nil {
+				t.Fatalf("Close failed: %v", err)
+			}
+			reopened, err := New(path, dimension, precision)
+			if err != nil {
+				t.Fatalf("reopen failed: %v", err)
+			}
+			defer reopened.Close()
+			assertLiveChunks(t, reopened, dimension)
+		})
+	}
+}
+
+// assertLiveChunks checks that chunks 0-3 remain and are each their own
+// nearest neighbour.
+func assertLiveChunks(t *testing.T, idx *Index, dimension int) {
+	t.Helper()
+	ctx := context.Background()
+
+	ids, err := idx.ChunkIDs(ctx)
+	if err != nil {
+		t.Fatalf("ChunkIDs failed: %v", err)
+	}
+	sort.Strings(ids)
+	want := []string{"chunk-0", "chunk-1", "chunk-2", "chunk-3"}
+	if fmt.Sprint(ids) != fmt.Sprint(want) {
+		t.Fatalf("ChunkIDs = %v, want %v", ids, want)
+	}
+
+	for i := 0; i < 4; i++ {
+		hits, err := idx.Search(ctx, unitVector(dimension, i), 1)
+		if err != nil {
+			t.Fatalf("Search failed: %v", err)
+		}
+		if len(hits) != 1 || hits[0].ChunkID != fmt.Sprintf("chunk-%d", i) {
+			t.Errorf("Search(chunk-%d) = %v", i, hits)
+		}
+	}
+}
@@ -20,8 +20,12 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
-// Ensure Index implements the interface.
-var _ driven.VectorIndex = (*Index)(nil)
+// Ensure Index implements the interfaces.
+var (
+	_ driven.VectorIndex           = (*Index)(nil)
+	_ driven.VectorIndexMaintainer = (*Index)(nil)
+	_ driven.VectorIndexCompactor  = (*Index)(nil)
+)
 
 // Default configuration values
 const (
@@ -181,6 +185,66 @@ func (idx *Index) Search(_ context.Context, query []float32, k int) ([]driven.Ve
 	return hits, nil
 }
 
+// ChunkIDs returns the IDs of all chunks with a stored vector.
+func (idx *Index) ChunkIDs(_ context.Context) ([]string, error) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	if idx.idx == nil {
+		return nil, errors.New("hnsw: index is closed")
+	}
+
+	var cIDs **C.char
+	count := C.hnsw_ids(idx.idx, &cIDs)
+	if count < 0 {
+		return nil, errors.New("hnsw: failed to list chunk IDs")
+	}
+	if count == 0 || cIDs == nil {
+		return nil, nil
+	}
+	defer C.hnsw_free_ids(cIDs, count)
+
+	ids := make([]string, int(count))
+	for i, cID := range unsafe.Slice(cIDs, int(count)) {
+		ids[i] = C.GoString(cID)
+	}
+
+	return ids, nil
+}
+
+// Save writes pending changes to disk.
+func (idx *Index) Save(_ context.Context) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.idx == nil {
+		return errors.New("hnsw: index is closed")
+	}
+
+	if C.hnsw_save(idx.idx) != 0 {
+		return errors.New("hnsw: failed to save index")
+	}
+
+	return nil
+}
+
+// Compact rebuilds the index from its live vectors, dropping the space held
+// by deleted and replaced vectors, and writes it to disk.
+func (idx *Index) Compact(_ context.Context) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.idx == nil {
+		return errors.New("hnsw: index is closed")
+	}
+
+	if C.hnsw_compact(idx.idx) != 0 {
+		return errors.New("hnsw: failed to compact index")
+	}
+
+	return nil
+}
+
 // Close releases resources.
 func (idx *Index) Close() error {
 	idx.mu.Lock()
@@ -9,8 +9,12 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
-// Ensure Index implements the interface.
-var _ driven.VectorIndex = (*Index)(nil)
+// Ensure Index implements the interfaces.
+var (
+	_ driven.VectorIndex           = (*Index)(nil)
+	_ driven.VectorIndexMaintainer = (*Index)(nil)
+	_ driven.VectorIndexCompactor  = (*Index)(nil)
+)
 
 // Precision defines the storage precision for vectors.
 // Runtime operations always use float32; this only affects disk storage.
@@ -58,6 +62,21 @@ func (idx *Index) Search(_ context.Context, _ []float32, _ int) ([]driven.Vector
 	return nil, domain.ErrNotImplemented
 }
 
+// ChunkIDs returns the IDs of all chunks with a stored vector.
+func (idx *Index) ChunkIDs(_ context.Context) ([]string, error) {
+	return nil, domain.ErrNotImplemented
+}
+
+// Save writes pending changes to disk.
+func (idx *Index) Save(_ context.Context) error {
+	return domain.ErrNotImplemented
+}
+
+// Compact rebuilds the index from its live vectors.
+func (idx *Index) Compact(_ context.Context) error {
+	return domain.ErrNotImplemented
+}
+
 // Close releases resources.
 func (idx *Index) Close() error {
 	return nil
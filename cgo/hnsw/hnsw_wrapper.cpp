@@ -642,28 +642,137 @@ void hnsw_free_results(HnswSearchResult* results, int count) {
     }
 }
 
-void hnsw_close(HnswIndex* index) {
+// Helper: save index and mappings if modified
+static bool save_index(HnswIndex* idx) {
+    if (!idx->modified) {
+        return true;
+    }
+
+    // Always save ID mappings (includes precision metadata)
+    bool ok = save_id_mappings(idx);
+
+    if (idx->precision == HNSW_PRECISION_FLOAT32) {
+        // For float32, save the full HNSW index
+        std::string index_path = idx->path + "/index.bin";
+        idx->hnsw->saveIndex(index_path);
+    } else {
+        // For float16/int8, save compressed vectors
+        ok = save_compressed_vectors(idx) && ok;
+    }
+
+    if (ok) {
+        idx->modified = false;
+    }
+    return ok;
+}
+
+int hnsw_ids(HnswIndex* index, char*** ids) {
+    if (index == nullptr || ids == nullptr) {
+        return -1;
+    }
+
+    std::lock_guard<std::mutex> lock(index->mutex);
+
+    try {
+        int count = static_cast<int>(index->id_to_label.size());
+        *ids = nullptr;
+        if (count == 0) {
+            return 0;
+        }
+
+        *ids = static_cast<char**>(malloc(count * sizeof(char*)));
+        if (*ids == nullptr) {
+            return -1;
+        }
+
+        int i = 0;
+        for (const auto& entry : index->id_to_label) {
+            (*ids)[i++] = strdup(entry.first.c_str());
+        }
+        return count;
+    } catch (...) {
+        return -1;
+    }
+}
+
+void hnsw_free_ids(char** ids, int count) {
+    if (ids != nullptr) {
+        for (int i = 0; i < count; i++) {
+            free(ids[i]);
+        }
+        free(ids);
+    }
+}
+
+int hnsw_save(HnswIndex* index) {
     if (index == nullptr) {
-        return;
+        return -1;
     }
 
     std::lock_guard<std::mutex> lock(index->mutex);
 
     try {
-        // Save index and mappings if modified
-        if (index->modified) {
-            // Always save ID mappings (includes precision metadata)
-            save_id_mappings(index);
-
-            if (index->precision == HNSW_PRECISION_FLOAT32) {
-                // For float32, save the full HNSW index
-                std::string index_path = index->path + "/index.bin";
-                index->hnsw->saveIndex(index_path);
-            } else {
-                // For float16/int8, save compressed vectors
-                save_compressed_vectors(index);
+        return save_index(index) ? 0 : -1;
+    } catch (...) {
+        return -1;
+    }
+}
+
+int hnsw_compact(HnswIndex* index) {
+    if (index == nullptr) {
+        return -1;
+    }
+
+    std::lock_guard<std::mutex> lock(index->mutex);
+
+    hnswlib::HierarchicalNSW<float>* fresh = nullptr;
+    try {
+        fresh = new hnswlib::HierarchicalNSW<float>(
+            index->space, index->max_elements, 16, 200);
+
+        // Re-add live vectors in label order under dense new labels.
+        // Stored vectors are already normalized.
+        std::vector<std::string> label_to_id;
+        std::unordered_map<std::string, hnswlib::labeltype> id_to_label;
+        label_to_id.reserve(index->id_to_label.size());
+        for (size_t old_label = 0; old_label < index->label_to_id.size(); old_label++) {
+            const std::string& id = index->label_to_id[old_label];
+            if (id.empty()) {
+                continue;
             }
+            std::vector<float> vec = index->hnsw->getDataByLabel<float>(old_label);
+            hnswlib::labeltype label = label_to_id.size();
+            fresh->addPoint(vec.data(), label);
+            label_to_id.push_back(id);
+            id_to_label[id] = label;
+        }
+        fresh->setEf(50);
+
+        delete index->hnsw;
+        index->hnsw = fresh;
+        index->label_to_id = std::move(label_to_id);
+        index->id_to_label = std::move(id_to_label);
+        index->next_label = index->label_to_id.size();
+        index->modified = true;
+
+        return save_index(index) ? 0 : -1;
+    } catch (...) {
+        if (fresh != nullptr && fresh != index->hnsw) {
+            delete fresh;
         }
+        return -1;
+    }
+}
+
+void hnsw_close(HnswIndex* index) {
+    if (index == nullptr) {
+        return;
+    }
+
+    std::lock_guard<std::mutex> lock(index->mutex);
+
+    try {
+        save_index(index);
 
         delete index->hnsw;
         delete index->space;
@@ -48,6 +48,22 @@ int hnsw_search(HnswIndex* index, const float* query, int dimension, int k,
 // Free search results.
 void hnsw_free_results(HnswSearchResult* results, int count);
 
+// List the chunk IDs of all vectors in the index.
+// Returns the number of IDs, or -1 on error. Free with hnsw_free_ids.
+int hnsw_ids(HnswIndex* index, char*** ids);
+
+// Free IDs returned by hnsw_ids.
+void hnsw_free_ids(char** ids, int count);
+
+// Write the index and ID mappings to disk if modified.
+// Returns 0 on success, -1 on error.
+int hnsw_save(HnswIndex* index);
+
+// Rebuild the graph from the live vectors, dropping slots left by deleted
+// and replaced vectors, and write the result to disk.
+// Returns 0 on success, -1 on error.
+int hnsw_compact(HnswIndex* index);
+
 // Close and free the index.
 void hnsw_close(HnswIndex* index);
 
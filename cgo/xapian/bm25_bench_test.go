@@ -0,0 +1,180 @@
+//go:build cgo
+
+package xapian
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// benchTopics are the subjects of the synthetic corpus. Each query is a
+// topic's terms; documents are judged against the topic they were written for.
+var benchTopics = [][]string{
+	{"kubernetes", "ingress", "controller"},
+	{"postgres", "replication", "slot"},
+	{"oauth", "refresh", "token"},
+	{"xapian", "stemming", "query"},
+	{"terraform", "provider", "state"},
+	{"webhook", "signature", "retry"},
+	{"sqlite", "vacuum", "checkpoint"},
+	{"embedding", "vector", "dimension"},
+}
+
+// benchFiller is generic technical prose padding documents out.
+var benchFiller = strings.Fields(`the a of to and in is for with this that on
+configuration service request response server client default example value
+option setting documentation guide section note see also using when must
+should can may error file path version release update change support`)
+
+// benchDoc is a synthetic document with its graded relevance per topic.
+type benchDoc struct {
+	id        string
+	content   string
+	relevance map[int]int
+}
+
+// benchCorpus builds a deterministic corpus exercising both BM25 parameters:
+//   - focused: short, mentions every topic term a few times (relevance 2)
+//   - overview: long, mentions every topic term once among filler (relevance 1)
+//   - stuffed: repeats a single topic term many times (relevance 0)
+//   - noise: filler only
+//
+// k1 trades focused documents against stuffed ones; b trades them against
+// long overviews.
+func benchCorpus() []benchDoc {
+	rng := rand.New(rand.NewSource(42))
+	filler := func(n int) []string {
+		words := make([]string, n)
+		for i := range words {
+			words[i] = benchFiller[rng.Intn(len(benchFiller))]
+		}
+		return words
+	}
+	build := func(terms []string, repeats, padding int) string {
+		words := filler(padding)
+		for _, term := range terms {
+			for i := 0; i < repeats; i++ {
+				pos := rng.Intn(len(words) + 1)
+				words = append(words[:pos], append([]string{term}, words[pos:]...)...)
+			}
+		}
+		return strings.Join(words, " ")
+	}
+
+	var docs []benchDoc
+	add := func(content string, topic, grade int) {
+		doc := benchDoc{id: fmt.Sprintf("doc-%03d", len(docs)), content: content, relevance: map[int]int{}}
+		if grade > 0 {
+			doc.relevance[topic] = grade
+		}
+		docs = append(docs, doc)
+	}
+
+	for topic, terms := range benchTopics {
+		for i := 0; i < 4; i++ {
+			add(build(terms, 3, 40), topic, 2)
+		}
+		for i := 0; i < 6; i++ {
+			add(build(terms, 1, 400), topic, 1)
+		}
+		for i, term := range terms {
+			add(build([]string{term}, 12+i*4, 60), topic, 0)
+		}
+	}
+	for i := 0; i < 80; i++ {
+		add(strings.Join(filler(100), " "), 0, 0)
+	}
+	return docs
+}
+
+// ndcgAt returns the normalised discounted cumulative gain of the first k
+// ranked documents for a topic.
+func ndcgAt(k int, ranked []string, docs map[string]benchDoc, topic int) float64 {
+	dcg := 0.0
+	for i, id := range ranked {
+		if i >= k {
+			break
+		}
+		dcg += gain(docs[id].relevance[topic], i)
+	}
+
+	ideal := make([]int, 0, len(docs))
+	for _, doc := range docs {
+		ideal = append(ideal, doc.relevance[topic])
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(ideal)))
+	idcg := 0.0
+	for i := 0; i < k && i < len(ideal); i++ {
+		idcg += gain(ideal[i], i)
+	}
+	if idcg == 0 {
+		return 0
+	}
+	return dcg / idcg
+}
+
+func gain(relevance, rank int) float64 {
+	return (math.Pow(2, float64(relevance)) - 1) / math.Log2(float64(rank)+2)
+}
+
+// BenchmarkSearchBM25_NDCG reports mean NDCG@10 over the synthetic corpus
+// for a range of BM25 parameters, alongside search time.
+//
+//	go test -run '^$' -bench BM25 ./cgo/xapian
+func BenchmarkSearchBM25_NDCG(b *testing.B) {
+	ctx := context.Background()
+	engine, err := New(filepath.Join(b.TempDir(), "xapian"))
+	if err != nil {
+		b.Fatalf("open engine: %v", err)
+	}
+	defer engine.Close()
+
+	corpus := benchCorpus()
+	docs := make(map[string]benchDoc, len(corpus))
+	for _, doc := range corpus {
+		docs[doc.id] = doc
+		chunk := domain.Chunk{ID: doc.id, DocumentID: doc.id, Content: doc.content}
+		if err := engine.Index(ctx, chunk); err != nil {
+			b.Fatalf("index %s: %v", doc.id, err)
+		}
+	}
+
+	params := []struct{ k1, b float64 }{
+		{domain.DefaultBM25K1, domain.DefaultBM25B},
+		{1.2, 0.75},
+		{0.5, 0.5},
+		{2.0, 0.5},
+		{1.0, 0.0},
+		{1.0, 1.0},
+	}
+
+	for _, p := range params {
+		b.Run(fmt.Sprintf("k1=%.2f,b=%.2f", p.k1, p.b), func(b *testing.B) {
+			var mean float64
+			for i := 0; i < b.N; i++ {
+				total := 0.0
+				for topic, terms := range benchTopics {
+					hits, err := engine.SearchBM25(ctx, strings.Join(terms, " "), 10, p.k1, p.b, "")
+					if err != nil {
+						b.Fatalf("search: %v", err)
+					}
+					ranked := make([]string, len(hits))
+					for j, hit := range hits {
+						ranked[j] = hit.ChunkID
+					}
+					total += ndcgAt(10, ranked, docs, topic)
+				}
+				mean = total / float64(len(benchTopics))
+			}
+			b.ReportMetric(mean, "ndcg@10")
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package xapian
+
+import "github.com/custodia-labs/sercha-cli/internal/core/domain"
+
+// stemLanguage returns the language a chunk is stemmed with: the language
+// detected for it, or fallback if it is untagged.
+func stemLanguage(chunk domain.Chunk, fallback string) string {
+	if lang, ok := chunk.Metadata[domain.DocMetaLanguage].(string); ok && lang != "" {
+		return lang
+	}
+	return fallback
+}
+
+// queryLanguage returns the language query terms are stemmed with: the
+// hint, or fallback if there is none.
+func queryLanguage(hint, fallback string) string {
+	if hint != "" {
+		return hint
+	}
+	return fallback
+}
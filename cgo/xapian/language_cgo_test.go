@@ -0,0 +1,98 @@
+//go:build cgo
+
+package xapian
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// matches reports whether searching query in language returns chunkID.
+func matches(t *testing.T, engine *Engine, query, language, chunkID string) bool {
+	t.Helper()
+	hits, err := engine.SearchBM25(context.Background(), query, 10, domain.DefaultBM25K1, domain.DefaultBM25B, language)
+	if err != nil {
+		t.Fatalf("SearchBM25(%q, %q) failed: %v", query, language, err)
+	}
+	for _, hit := range hits {
+		if hit.ChunkID == chunkID {
+			return true
+		}
+	}
+	return false
+}
+
+func TestEngine_StemsByDocumentLanguage(t *testing.T) {
+	engine, err := New(filepath.Join(t.TempDir(), "xapian"))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	defer engine.Close()
+
+	ctx := context.Background()
+	chunks := []domain.Chunk{
+		{
+			ID:         "fr-1",
+			DocumentID: "doc-fr",
+			Content:    "Nous mangeons ensemble le dimanche",
+			Metadata:   map[string]any{domain.DocMetaLanguage: "fr"},
+		},
+		{
+			ID:         "en-1",
+			DocumentID: "doc-en",
+			Content:    "The ponies graze in the field",
+			Metadata:   map[string]any{domain.DocMetaLanguage: "en"},
+		},
+		{
+			// Untagged, so stemmed with the default language (English)
+			ID:         "fr-untagged",
+			DocumentID: "doc-fr-untagged",
+			Content:    "Vous mangeons ensemble le samedi",
+		},
+	}
+	for _, chunk := range chunks {
+		if err := engine.Index(ctx, chunk); err != nil {
+			t.Fatalf("Index %s failed: %v", chunk.ID, err)
+		}
+	}
+
+	// French stemming reduces both "mangez" and "mangeons" to "mang"
+	if !matches(t, engine, "mangez", "fr", "fr-1") {
+		t.Error("French query should match the French-stemmed document")
+	}
+	if matches(t, engine, "mangez", "fr", "fr-untagged") {
+		t.Error("untagged document should not be French-stemmed")
+	}
+
+	// English stemming reduces both "pony" and "ponies" to "poni"
+	if !matches(t, engine, "pony", "en", "en-1") {
+		t.Error("English query should match the English-stemmed document")
+	}
+	if !matches(t, engine, "pony", "", "en-1") {
+		t.Error("query without a hint should use the default language")
+	}
+	if matches(t, engine, "pony", "fr", "en-1") {
+		t.Error("French query should not match the English-stemmed document")
+	}
+}
+
+func TestEngine_SetDefaultLanguage(t *testing.T) {
+	engine, err := New(filepath.Join(t.TempDir(), "xapian"))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	defer engine.Close()
+	engine.SetDefaultLanguage("fr")
+
+	chunk := domain.Chunk{ID: "c1", DocumentID: "doc-1", Content: "Nous mangeons ensemble"}
+	if err := engine.Index(context.Background(), chunk); err != nil {
+		t.Fatalf("Index failed: %v", err)
+	}
+
+	if !matches(t, engine, "mangez", "", "c1") {
+		t.Error("untagged document and query should use the default language")
+	}
+}
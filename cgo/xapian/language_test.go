@@ -0,0 +1,37 @@
+package xapian
+
+import (
+	"testing"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestStemLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata map[string]any
+		want     string
+	}{
+		{"untagged", nil, "en"},
+		{"tagged", map[string]any{domain.DocMetaLanguage: "fr"}, "fr"},
+		{"empty tag", map[string]any{domain.DocMetaLanguage: ""}, "en"},
+		{"non-string tag", map[string]any{domain.DocMetaLanguage: 42}, "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stemLanguage(domain.Chunk{Metadata: tt.metadata}, "en"); got != tt.want {
+				t.Errorf("stemLanguage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryLanguage(t *testing.T) {
+	if got := queryLanguage("", "en"); got != "en" {
+		t.Errorf("expected fallback 'en', got %q", got)
+	}
+	if got := queryLanguage("fr", "en"); got != "fr" {
+		t.Errorf("expected hint 'fr', got %q", got)
+	}
+}
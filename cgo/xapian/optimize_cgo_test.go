@@ -0,0 +1,115 @@
+//go:build cgo
+
+package xapian
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestEngine_FlushAndCompactKeepIndexQueryable(t *testing.T) {
+	engine, err := New(filepath.Join(t.TempDir(), "xapian"))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	defer engine.Close()
+
+	ctx := context.Background()
+	for i := 0; i < 20; i++ {
+		chunk := domain.Chunk{
+			ID:         fmt.Sprintf("chunk-%d", i),
+			DocumentID: "doc-1",
+			Content:    fmt.Sprintf("quarterly report number %d about ponies", i),
+		}
+		if err := engine.Index(ctx, chunk); err != nil {
+			t.Fatalf("Index %s failed: %v", chunk.ID, err)
+		}
+	}
+	// Leave deleted entries behind for compaction to reclaim
+	for i := 10; i < 20; i++ {
+		if err := engine.Delete(ctx, fmt.Sprintf("chunk-%d", i)); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+	}
+
+	if err := engine.Flush(ctx); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+	if err := engine.Compact(ctx); err != nil {
+		t.Fatalf("Compact failed: %v", err)
+	}
+
+	hits, err := engine.Search(ctx, "ponies", 50)
+	if err != nil {
+		t.Fatalf("Search after compaction failed: %v", err)
+	}
+	if len(hits) != 10 {
+		t.Errorf("got %d hits after compaction, want 10", len(hits))
+	}
+	if !matches(t, engine, "quarterly", "", "chunk-3") {
+		t.Error("live chunk should still match after compaction")
+	}
+	if matches(t, engine, "quarterly", "", "chunk-15") {
+		t.Error("deleted chunk should not match after compaction")
+	}
+
+	// The reopened database still accepts writes
+	if err := engine.Index(ctx, domain.Chunk{ID: "late", DocumentID: "doc-2", Content: "zebras"}); err != nil {
+		t.Fatalf("Index after compaction failed: %v", err)
+	}
+	if !matches(t, engine, "zebras", "", "late") {
+		t.Error("chunk indexed after compaction should match")
+	}
+}
+
+func TestEngine_FlushClosed(t *testing.T) {
+	engine, err := New(filepath.Join(t.TempDir(), "xapian"))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	_ = engine.Close()
+
+	if err := engine.Flush(context.Background()); err == nil {
+		t.Error("Flush on a closed engine should fail")
+	}
+}
+
+func TestEngine_ChunkIDs(t *testing.T) {
+	engine, err := New(filepath.Join(t.TempDir(), "xapian"))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	defer engine.Close()
+
+	ctx := context.Background()
+	ids, err := engine.ChunkIDs(ctx)
+	if err != nil {
+		t.Fatalf("ChunkIDs on an empty index failed: %v", err)
+	}
+	if len(ids) != 0 {
+		t.Errorf("got %v from an empty index, want none", ids)
+	}
+
+	for _, id := range []string{"b-1", "a-1", "a-2"} {
+		if err := engine.Index(ctx, domain.Chunk{ID: id, DocumentID: "doc", Content: "ponies"}); err != nil {
+			t.Fatalf("Index %s failed: %v", id, err)
+		}
+	}
+	if err := engine.Delete(ctx, "a-2"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	ids, err = engine.ChunkIDs(ctx)
+	if err != nil {
+		t.Fatalf("ChunkIDs failed: %v", err)
+	}
+	slices.Sort(ids)
+	if !slices.Equal(ids, []string{"a-1", "b-1"}) {
+		t.Errorf("got %v, want [a-1 b-1]", ids)
+	}
+}
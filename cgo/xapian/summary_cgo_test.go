@@ -0,0 +1,50 @@
+//go:build cgo
+
+package xapian
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestEngine_SearchesSummariesBelowContent(t *testing.T) {
+	engine, err := New(filepath.Join(t.TempDir(), "xapian"))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	defer engine.Close()
+
+	ctx := context.Background()
+	chunks := []domain.Chunk{
+		{
+			ID:         "notes-3",
+			DocumentID: "doc-notes",
+			Content:    "Action items were assigned to the platform team",
+			Metadata:   map[string]any{domain.DocMetaSummary: "The meeting covered the authentication outage."},
+		},
+		{
+			ID:         "postmortem-1",
+			DocumentID: "doc-postmortem",
+			Content:    "The authentication outage began after the certificate expired",
+		},
+	}
+	for _, chunk := range chunks {
+		if err := engine.Index(ctx, chunk); err != nil {
+			t.Fatalf("Index %s failed: %v", chunk.ID, err)
+		}
+	}
+
+	hits, err := engine.Search(ctx, "authentication outage", 10)
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(hits) != 2 {
+		t.Fatalf("expected the content and summary matches, got %v", hits)
+	}
+	if hits[0].ChunkID != "postmortem-1" || hits[1].ChunkID != "notes-3" {
+		t.Errorf("expected the summary match to rank below the content match, got %v", hits)
+	}
+}
@@ -14,6 +14,8 @@ import "C"
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
 	"sync"
 	"unsafe"
 
@@ -21,14 +23,28 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
-// Ensure Engine implements the interface.
-var _ driven.SearchEngine = (*Engine)(nil)
+// Ensure Engine implements the interfaces.
+var (
+	_ driven.SearchEngine          = (*Engine)(nil)
+	_ driven.BM25SearchEngine      = (*Engine)(nil)
+	_ driven.SearchIndexCompactor  = (*Engine)(nil)
+	_ driven.SearchIndexFlusher    = (*Engine)(nil)
+	_ driven.SearchIndexMaintainer = (*Engine)(nil)
+	_ driven.VersionReporter       = (*Engine)(nil)
+)
+
+// snippetLength is the maximum length of a result snippet in characters.
+const snippetLength = 200
 
 // Engine provides full-text search using Xapian.
 type Engine struct {
 	mu   sync.RWMutex
 	db   C.xapian_db
 	path string
+
+	highlightStart  string
+	highlightEnd    string
+	defaultLanguage string
 }
 
 // New creates a new Xapian search engine.
@@ -43,12 +59,36 @@ func New(path string) (*Engine, error) {
 	}
 
 	return &Engine{
-		db:   db,
-		path: path,
+		db:              db,
+		path:            path,
+		highlightStart:  domain.DefaultSnippetHighlightStart,
+		highlightEnd:    domain.DefaultSnippetHighlightEnd,
+		defaultLanguage: domain.DefaultSearchLanguage,
 	}, nil
 }
 
+// SetHighlightDelimiters sets the markers placed around query terms in
+// result snippets. Defaults to <b> and </b>.
+func (e *Engine) SetHighlightDelimiters(start, end string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.highlightStart = start
+	e.highlightEnd = end
+}
+
+// SetDefaultLanguage sets the ISO 639-1 code of the stemmer used for
+// chunks without a detected language and queries without a language hint.
+// "none" disables stemming. Defaults to English.
+func (e *Engine) SetDefaultLanguage(language string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.defaultLanguage = language
+}
+
 // Index adds or updates a chunk in the search index.
+// The chunk's detected language, or the default language if it has none,
+// selects the stemmer for its terms and snippets. Its document's summary,
+// if any, is indexed as a field that weighs less than the content.
 func (e *Engine) Index(_ context.Context, chunk domain.Chunk) error {
 	e.mu.Lock()
 	defer e.mu.Unlock()
@@ -66,7 +106,14 @@ func (e *Engine) Index(_ context.Context, chunk domain.Chunk) error {
 	cContent := C.CString(chunk.Content)
 	defer C.free(unsafe.Pointer(cContent))
 
-	result := C.xapian_index(e.db, cChunkID, cDocID, cContent)
+	cLanguage := C.CString(stemLanguage(chunk, e.defaultLanguage))
+	defer C.free(unsafe.Pointer(cLanguage))
+
+	summary, _ := chunk.Metadata[domain.DocMetaSummary].(string)
+	cSummary := C.CString(summary)
+	defer C.free(unsafe.Pointer(cSummary))
+
+	result := C.xapian_index(e.db, cChunkID, cDocID, cContent, cLanguage, cSummary)
 	if result != 0 {
 		errMsg := C.GoString(C.xapian_get_error())
 		return errors.New("xapian: failed to index chunk: " + errMsg)
@@ -97,7 +144,18 @@ func (e *Engine) Delete(_ context.Context, chunkID string) error {
 }
 
 // Search performs a keyword search and returns matching chunk IDs with scores.
-func (e *Engine) Search(_ context.Context, query string, limit int) ([]driven.SearchHit, error) {
+// Results are ranked with Xapian's default BM25 parameters, and query terms
+// are stemmed with the default language.
+func (e *Engine) Search(ctx context.Context, query string, limit int) ([]driven.SearchHit, error) {
+	return e.SearchBM25(ctx, query, limit, domain.DefaultBM25K1, domain.DefaultBM25B, "")
+}
+
+// SearchBM25 performs a keyword search ranked with the given BM25 parameters.
+// Query terms are stemmed with language, or the default language if empty.
+// Each hit carries a snippet of the chunk with the query terms highlighted.
+func (e *Engine) SearchBM25(
+	_ context.Context, query string, limit int, k1, b float64, language string,
+) ([]driven.SearchHit, error) {
 	e.mu.RLock()
 	defer e.mu.RUnlock()
 
@@ -108,7 +166,16 @@ func (e *Engine) Search(_ context.Context, query string, limit int) ([]driven.Se
 	cQuery := C.CString(query)
 	defer C.free(unsafe.Pointer(cQuery))
 
-	results := C.xapian_search(e.db, cQuery, C.int(limit))
+	cStart := C.CString(e.highlightStart)
+	defer C.free(unsafe.Pointer(cStart))
+	cEnd := C.CString(e.highlightEnd)
+	defer C.free(unsafe.Pointer(cEnd))
+	snippets := C.SnippetOptions{length: C.int(snippetLength), hl_start: cStart, hl_end: cEnd}
+
+	cLanguage := C.CString(queryLanguage(language, e.defaultLanguage))
+	defer C.free(unsafe.Pointer(cLanguage))
+
+	results := C.xapian_search_bm25(e.db, cQuery, C.int(limit), C.double(k1), C.double(b), cLanguage, snippets)
 	defer C.xapian_free_results(results)
 
 	if results.results == nil {
@@ -131,11 +198,123 @@ func (e *Engine) Search(_ context.Context, query string, limit int) ([]driven.Se
 			ChunkID: C.GoString(cResults[i].chunk_id),
 			Score:   float64(cResults[i].score),
 		}
+		if cResults[i].snippet != nil {
+			hits[i].Snippet = C.GoString(cResults[i].snippet)
+		}
 	}
 
 	return hits, nil
 }
 
+// ChunkIDs returns the IDs of all indexed chunks.
+func (e *Engine) ChunkIDs(_ context.Context) ([]string, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if e.db == nil {
+		return nil, errors.New("xapian: database is closed")
+	}
+
+	list := C.xapian_chunk_ids(e.db)
+	defer C.xapian_free_chunk_ids(list)
+
+	if list.ids == nil {
+		errMsg := C.GoString(C.xapian_get_error())
+		if errMsg != "" {
+			return nil, errors.New("xapian: failed to list chunks: " + errMsg)
+		}
+		return nil, nil
+	}
+
+	cIDs := unsafe.Slice(list.ids, int(list.count))
+	ids := make([]string, len(cIDs))
+	for i := range cIDs {
+		ids[i] = C.GoString(cIDs[i])
+	}
+	return ids, nil
+}
+
+// Flush commits pending changes to disk.
+func (e *Engine) Flush(_ context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.db == nil {
+		return errors.New("xapian: database is closed")
+	}
+
+	if C.xapian_flush(e.db) != 0 {
+		errMsg := C.GoString(C.xapian_get_error())
+		return errors.New("xapian: failed to flush database: " + errMsg)
+	}
+	return nil
+}
+
+// Compact rewrites the database to reclaim space left by deleted and updated
+// chunks. The compacted copy is written next to the database and swapped in,
+// so the original is kept if compaction fails.
+func (e *Engine) Compact(_ context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.db == nil {
+		return errors.New("xapian: database is closed")
+	}
+
+	compacted := e.path + ".compact"
+	old := e.path + ".old"
+	if err := os.RemoveAll(compacted); err != nil {
+		return fmt.Errorf("xapian: failed to clear compaction directory: %w", err)
+	}
+	defer os.RemoveAll(compacted)
+
+	cDest := C.CString(compacted)
+	defer C.free(unsafe.Pointer(cDest))
+
+	if C.xapian_compact(e.db, cDest) != 0 {
+		errMsg := C.GoString(C.xapian_get_error())
+		return errors.New("xapian: failed to compact database: " + errMsg)
+	}
+
+	C.xapian_close(e.db)
+	e.db = nil
+
+	// Swap the compacted copy into place, falling back to the original
+	swapErr := os.Rename(e.path, old)
+	if swapErr == nil {
+		if swapErr = os.Rename(compacted, e.path); swapErr != nil {
+			_ = os.Rename(old, e.path)
+		}
+	}
+
+	if err := e.reopen(); err != nil {
+		return errors.Join(swapErr, err)
+	}
+	if swapErr != nil {
+		return fmt.Errorf("xapian: failed to replace database: %w", swapErr)
+	}
+	return os.RemoveAll(old)
+}
+
+// EngineVersion returns the version of the Xapian library in use.
+func (e *Engine) EngineVersion(_ context.Context) (string, error) {
+	return C.GoString(C.xapian_version()), nil
+}
+
+// reopen opens the database at e.path. Callers must hold e.mu.
+func (e *Engine) reopen() error {
+	cpath := C.CString(e.path)
+	defer C.free(unsafe.Pointer(cpath))
+
+	db := C.xapian_open(cpath)
+	if db == nil {
+		errMsg := C.GoString(C.xapian_get_error())
+		return errors.New("xapian: failed to reopen database: " + errMsg)
+	}
+	e.db = db
+	return nil
+}
+
 // Close releases resources.
 func (e *Engine) Close() error {
 	e.mu.Lock()
@@ -9,8 +9,15 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
-// Ensure Engine implements the interface.
-var _ driven.SearchEngine = (*Engine)(nil)
+// Ensure Engine implements the interfaces.
+var (
+	_ driven.SearchEngine          = (*Engine)(nil)
+	_ driven.BM25SearchEngine      = (*Engine)(nil)
+	_ driven.SearchIndexCompactor  = (*Engine)(nil)
+	_ driven.SearchIndexFlusher    = (*Engine)(nil)
+	_ driven.SearchIndexMaintainer = (*Engine)(nil)
+	_ driven.VersionReporter       = (*Engine)(nil)
+)
 
 // Engine provides full-text search using Xapian.
 // This is a stub for builds without CGO.
@@ -25,6 +32,14 @@ func New(path string) (*Engine, error) {
 	}, nil
 }
 
+// SetHighlightDelimiters sets the markers placed around query terms in
+// result snippets.
+func (e *Engine) SetHighlightDelimiters(_, _ string) {}
+
+// SetDefaultLanguage sets the stemming language for untagged chunks and
+// queries without a language hint.
+func (e *Engine) SetDefaultLanguage(_ string) {}
+
 // Index adds or updates a chunk in the search index.
 func (e *Engine) Index(_ context.Context, _ domain.Chunk) error {
 	return domain.ErrNotImplemented
@@ -40,6 +55,31 @@ func (e *Engine) Search(_ context.Context, _ string, _ int) ([]driven.SearchHit,
 	return nil, domain.ErrNotImplemented
 }
 
+// SearchBM25 performs a keyword search ranked with the given BM25 parameters.
+func (e *Engine) SearchBM25(_ context.Context, _ string, _ int, _, _ float64, _ string) ([]driven.SearchHit, error) {
+	return nil, domain.ErrNotImplemented
+}
+
+// ChunkIDs returns the IDs of all indexed chunks.
+func (e *Engine) ChunkIDs(_ context.Context) ([]string, error) {
+	return nil, domain.ErrNotImplemented
+}
+
+// Flush commits pending changes to disk.
+func (e *Engine) Flush(_ context.Context) error {
+	return domain.ErrNotImplemented
+}
+
+// Compact rewrites the database to reclaim space.
+func (e *Engine) Compact(_ context.Context) error {
+	return domain.ErrNotImplemented
+}
+
+// EngineVersion reports that Xapian is not available without CGO.
+func (e *Engine) EngineVersion(_ context.Context) (string, error) {
+	return "", domain.ErrNotImplemented
+}
+
 // Close releases resources.
 func (e *Engine) Close() error {
 	return nil
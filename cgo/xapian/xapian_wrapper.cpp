@@ -8,12 +8,66 @@
 #include "xapian_wrapper.h"
 #include <xapian.h>
 #include <string>
+#include <vector>
 #include <cstring>
 #include <cstdlib>
 
 // Thread-local storage for error messages
 static thread_local std::string last_error;
 
+// Value slots
+static const Xapian::valueno SLOT_CHUNK_ID = 0;
+static const Xapian::valueno SLOT_DOC_ID = 1;
+static const Xapian::valueno SLOT_LANGUAGE = 2;
+
+// Term prefix of a chunk's document summary, and the weight of summary
+// matches relative to content matches
+static const char* const PREFIX_SUMMARY = "XS";
+static const double SUMMARY_WEIGHT = 0.3;
+
+// Default snippet options for xapian_search
+static const int DEFAULT_SNIPPET_LENGTH = 200;
+static const char* const DEFAULT_HL_START = "<b>";
+static const char* const DEFAULT_HL_END = "</b>";
+
+// stemmer_for returns the stemmer for an ISO 639-1 language code, or a
+// no-op stemmer if the language is unknown, "none" or has no stemmer (e.g., "ja").
+static Xapian::Stem stemmer_for(const std::string& language) {
+    if (language.empty()) {
+        return Xapian::Stem();
+    }
+    try {
+        return Xapian::Stem(language);
+    } catch (const Xapian::InvalidArgumentError&) {
+        return Xapian::Stem();
+    }
+}
+
+// free_results frees the results array and every string it owns
+static void free_results(SearchResults& results) {
+    if (results.results != nullptr) {
+        for (int i = 0; i < results.count; ++i) {
+            free(results.results[i].chunk_id);
+            free(results.results[i].snippet);
+        }
+        free(results.results);
+    }
+    results.results = nullptr;
+    results.count = 0;
+}
+
+// free_chunk_ids frees the IDs array and every string it owns
+static void free_chunk_ids(ChunkIDs& ids) {
+    if (ids.ids != nullptr) {
+        for (int i = 0; i < ids.count; ++i) {
+            free(ids.ids[i]);
+        }
+        free(ids.ids);
+    }
+    ids.ids = nullptr;
+    ids.count = 0;
+}
+
 // Internal database wrapper to hold both readable and writable database handles
 struct XapianDatabase {
     Xapian::WritableDatabase db;
@@ -50,7 +104,8 @@ void xapian_close(xapian_db db) {
     }
 }
 
-int xapian_index(xapian_db db, const char* chunk_id, const char* doc_id, const char* content) {
+int xapian_index(xapian_db db, const char* chunk_id, const char* doc_id, const char* content,
+                 const char* language, const char* summary) {
     if (db == nullptr || chunk_id == nullptr || content == nullptr) {
         last_error = "invalid arguments: db, chunk_id, and content must not be null";
         return -1;
@@ -59,9 +114,10 @@ int xapian_index(xapian_db db, const char* chunk_id, const char* doc_id, const c
     try {
         XapianDatabase* wrapper = static_cast<XapianDatabase*>(db);
 
-        // Create a term generator for indexing
+        // Create a term generator stemming in the content's language
+        std::string lang = language != nullptr ? language : "";
         Xapian::TermGenerator indexer;
-        indexer.set_stemmer(Xapian::Stem("en"));
+        indexer.set_stemmer(stemmer_for(lang));
         indexer.set_stemming_strategy(Xapian::TermGenerator::STEM_SOME);
 
         // Create a new document
@@ -71,13 +127,22 @@ int xapian_index(xapian_db db, const char* chunk_id, const char* doc_id, const c
         // Index the content with positional information for phrase queries
         indexer.index_text(content);
 
+        // Index the document's summary as a separate field, searched with a lower weight
+        if (summary != nullptr && summary[0] != '\0') {
+            indexer.increase_termpos();
+            indexer.index_text(summary, 1, PREFIX_SUMMARY);
+        }
+
         // Store metadata
-        doc.add_value(0, chunk_id);  // Slot 0: chunk_id for retrieval
+        doc.add_value(SLOT_CHUNK_ID, chunk_id);  // chunk_id for retrieval
         if (doc_id != nullptr) {
-            doc.add_value(1, doc_id);  // Slot 1: parent document ID
+            doc.add_value(SLOT_DOC_ID, doc_id);  // parent document ID
+        }
+        if (!lang.empty()) {
+            doc.add_value(SLOT_LANGUAGE, lang);  // stems snippets
         }
 
-        // Store the original content for potential snippeting
+        // Store the original content for snippeting
         doc.set_data(content);
 
         // Use chunk_id as the unique identifier term
@@ -123,7 +188,111 @@ int xapian_delete(xapian_db db, const char* chunk_id) {
     }
 }
 
+int xapian_flush(xapian_db db) {
+    if (db == nullptr) {
+        last_error = "invalid arguments: db must not be null";
+        return -1;
+    }
+
+    try {
+        XapianDatabase* wrapper = static_cast<XapianDatabase*>(db);
+
+        wrapper->db.commit();
+
+        last_error.clear();
+        return 0;
+    } catch (const Xapian::Error& e) {
+        last_error = e.get_description();
+        return -1;
+    } catch (const std::exception& e) {
+        last_error = e.what();
+        return -1;
+    }
+}
+
+int xapian_compact(xapian_db db, const char* dest) {
+    if (db == nullptr || dest == nullptr) {
+        last_error = "invalid arguments: db and dest must not be null";
+        return -1;
+    }
+
+    try {
+        XapianDatabase* wrapper = static_cast<XapianDatabase*>(db);
+
+        wrapper->db.commit();
+        wrapper->db.compact(dest);
+
+        last_error.clear();
+        return 0;
+    } catch (const Xapian::Error& e) {
+        last_error = e.get_description();
+        return -1;
+    } catch (const std::exception& e) {
+        last_error = e.what();
+        return -1;
+    }
+}
+
+ChunkIDs xapian_chunk_ids(xapian_db db) {
+    ChunkIDs ids = {nullptr, 0};
+
+    if (db == nullptr) {
+        last_error = "invalid arguments: db must not be null";
+        return ids;
+    }
+
+    try {
+        XapianDatabase* wrapper = static_cast<XapianDatabase*>(db);
+
+        // Every chunk carries exactly one "Q" unique ID term
+        const std::string prefix = "Q";
+        std::vector<std::string> terms;
+        for (Xapian::TermIterator it = wrapper->db.allterms_begin(prefix);
+             it != wrapper->db.allterms_end(prefix); ++it) {
+            terms.push_back((*it).substr(prefix.size()));
+        }
+
+        if (terms.empty()) {
+            last_error.clear();
+            return ids;
+        }
+
+        // Zeroed so partial results can be freed
+        ids.ids = static_cast<char**>(calloc(terms.size(), sizeof(char*)));
+        if (ids.ids == nullptr) {
+            last_error = "memory allocation failed";
+            return ids;
+        }
+        ids.count = static_cast<int>(terms.size());
+        for (int i = 0; i < ids.count; ++i) {
+            ids.ids[i] = strdup(terms[i].c_str());
+        }
+
+        last_error.clear();
+        return ids;
+    } catch (const Xapian::Error& e) {
+        last_error = e.get_description();
+        free_chunk_ids(ids);
+        return ids;
+    } catch (const std::exception& e) {
+        last_error = e.what();
+        free_chunk_ids(ids);
+        return ids;
+    }
+}
+
+void xapian_free_chunk_ids(ChunkIDs ids) {
+    free_chunk_ids(ids);
+}
+
 SearchResults xapian_search(xapian_db db, const char* query_str, int limit) {
+    // Xapian's BM25Weight defaults
+    SnippetOptions snippets = {DEFAULT_SNIPPET_LENGTH, DEFAULT_HL_START, DEFAULT_HL_END};
+    return xapian_search_bm25(db, query_str, limit, 1.0, 0.5, "en", snippets);
+}
+
+SearchResults xapian_search_bm25(xapian_db db, const char* query_str, int limit, double k1, double b,
+                                 const char* language, SnippetOptions snippets) {
     SearchResults results = {nullptr, 0};
 
     if (db == nullptr || query_str == nullptr || limit <= 0) {
@@ -137,17 +306,15 @@ SearchResults xapian_search(xapian_db db, const char* query_str, int limit) {
         // Create a query parser with database for proper stemming and case handling
         Xapian::QueryParser parser;
         parser.set_database(wrapper->db);
-        parser.set_stemmer(Xapian::Stem("en"));
+        parser.set_stemmer(stemmer_for(language != nullptr ? language : ""));
         parser.set_stemming_strategy(Xapian::QueryParser::STEM_SOME);
         parser.set_default_op(Xapian::Query::OP_OR);
 
         // Parse the query with partial matching for better recall
-        Xapian::Query query = parser.parse_query(
-            query_str,
-            Xapian::QueryParser::FLAG_DEFAULT |
+        const unsigned flags = Xapian::QueryParser::FLAG_DEFAULT |
             Xapian::QueryParser::FLAG_WILDCARD |
-            Xapian::QueryParser::FLAG_PARTIAL
-        );
+            Xapian::QueryParser::FLAG_PARTIAL;
+        Xapian::Query query = parser.parse_query(query_str, flags);
 
         // If empty query, return no results
         if (query.empty()) {
@@ -155,9 +322,18 @@ SearchResults xapian_search(xapian_db db, const char* query_str, int limit) {
             return results;
         }
 
+        // Also match document summaries, weighted below the content
+        Xapian::Query summary_query = parser.parse_query(query_str, flags, PREFIX_SUMMARY);
+        if (!summary_query.empty()) {
+            query = Xapian::Query(Xapian::Query::OP_OR, query,
+                Xapian::Query(Xapian::Query::OP_SCALE_WEIGHT, summary_query, SUMMARY_WEIGHT));
+        }
+
         // Create an enquire object and run the query
         Xapian::Enquire enquire(wrapper->db);
         enquire.set_query(query);
+        // k2, k3 and min_normlen keep Xapian's defaults
+        enquire.set_weighting_scheme(Xapian::BM25Weight(k1, 0, 1, b, 0.5));
 
         // Get the matching documents
         Xapian::MSet matches = enquire.get_mset(0, limit);
@@ -167,10 +343,10 @@ SearchResults xapian_search(xapian_db db, const char* query_str, int limit) {
             return results;
         }
 
-        // Allocate results array
+        // Allocate results array, zeroed so partial results can be freed
         results.count = static_cast<int>(matches.size());
         results.results = static_cast<SearchResult*>(
-            malloc(sizeof(SearchResult) * results.count)
+            calloc(results.count, sizeof(SearchResult))
         );
 
         if (results.results == nullptr) {
@@ -183,11 +359,25 @@ SearchResults xapian_search(xapian_db db, const char* query_str, int limit) {
         int i = 0;
         for (Xapian::MSetIterator it = matches.begin(); it != matches.end(); ++it, ++i) {
             Xapian::Document doc = it.get_document();
-            std::string chunk_id = doc.get_value(0);
+            std::string chunk_id = doc.get_value(SLOT_CHUNK_ID);
 
             // Copy chunk_id (caller must free)
             results.results[i].chunk_id = strdup(chunk_id.c_str());
 
+            // Highlight query terms in a window of the stored content,
+            // stemming it the way its language would be
+            if (snippets.length > 0) {
+                std::string snippet = matches.snippet(
+                    doc.get_data(),
+                    snippets.length,
+                    stemmer_for(doc.get_value(SLOT_LANGUAGE)),
+                    Xapian::MSet::SNIPPET_BACKGROUND_MODEL | Xapian::MSet::SNIPPET_EXHAUSTIVE,
+                    snippets.hl_start != nullptr ? snippets.hl_start : "",
+                    snippets.hl_end != nullptr ? snippets.hl_end : "",
+                    "...");
+                results.results[i].snippet = strdup(snippet.c_str());
+            }
+
             // Normalize score to 0-1 range using MSet's max_possible
             double max_weight = matches.get_max_possible();
             if (max_weight > 0) {
@@ -202,36 +392,21 @@ SearchResults xapian_search(xapian_db db, const char* query_str, int limit) {
     } catch (const Xapian::Error& e) {
         last_error = e.get_description();
         // Clean up any partial results
-        if (results.results != nullptr) {
-            for (int i = 0; i < results.count; ++i) {
-                free(results.results[i].chunk_id);
-            }
-            free(results.results);
-        }
-        results.results = nullptr;
-        results.count = 0;
+        free_results(results);
         return results;
     } catch (const std::exception& e) {
         last_error = e.what();
-        if (results.results != nullptr) {
-            for (int i = 0; i < results.count; ++i) {
-                free(results.results[i].chunk_id);
-            }
-            free(results.results);
-        }
-        results.results = nullptr;
-        results.count = 0;
+        free_results(results);
         return results;
     }
 }
 
 void xapian_free_results(SearchResults results) {
-    if (results.results != nullptr) {
-        for (int i = 0; i < results.count; ++i) {
-            free(results.results[i].chunk_id);
-        }
-        free(results.results);
-    }
+    free_results(results);
+}
+
+const char* xapian_version(void) {
+    return Xapian::version_string();
 }
 
 const char* xapian_get_error(void) {
@@ -37,9 +37,16 @@ void xapian_close(xapian_db db);
  * @param chunk_id: Unique identifier for the chunk
  * @param doc_id: Parent document ID
  * @param content: Text content to index
+ * @param language: ISO 639-1 code of the content's language, selecting the
+ *                  stemmer for its terms and snippets (NULL, empty or
+ *                  unknown disables stemming)
+ * @param summary: Summary of the chunk's document, indexed as a separate
+ *                 field whose matches weigh less than content matches
+ *                 (NULL or empty if there is none)
  * @return: 0 on success, -1 on error
  */
-int xapian_index(xapian_db db, const char* chunk_id, const char* doc_id, const char* content);
+int xapian_index(xapian_db db, const char* chunk_id, const char* doc_id, const char* content,
+                 const char* language, const char* summary);
 
 /*
  * xapian_delete - Remove a document from the index
@@ -50,12 +57,57 @@ int xapian_index(xapian_db db, const char* chunk_id, const char* doc_id, const c
  */
 int xapian_delete(xapian_db db, const char* chunk_id);
 
+/*
+ * xapian_flush - Commit pending changes to disk
+ *
+ * @param db: Database handle
+ * @return: 0 on success, -1 on error
+ */
+int xapian_flush(xapian_db db);
+
+/*
+ * xapian_compact - Write a compacted copy of the database
+ *
+ * Pending changes are committed first. The copy is written to dest, which
+ * must not be the database's own directory; the caller swaps it into place.
+ *
+ * @param db: Database handle
+ * @param dest: Directory to write the compacted database to
+ * @return: 0 on success, -1 on error
+ */
+int xapian_compact(xapian_db db, const char* dest);
+
+/*
+ * ChunkIDs - Array of chunk IDs
+ */
+typedef struct {
+    char** ids;
+    int count;
+} ChunkIDs;
+
+/*
+ * xapian_chunk_ids - List the IDs of all indexed chunks
+ *
+ * @param db: Database handle
+ * @return: ChunkIDs struct (caller must free with xapian_free_chunk_ids);
+ *          ids is NULL on error or when the index is empty
+ */
+ChunkIDs xapian_chunk_ids(xapian_db db);
+
+/*
+ * xapian_free_chunk_ids - Free chunk IDs memory
+ *
+ * @param ids: ChunkIDs to free
+ */
+void xapian_free_chunk_ids(ChunkIDs ids);
+
 /*
  * SearchResult - Single search result
  */
 typedef struct {
     char* chunk_id;
     double score;
+    char* snippet;  /* HTML-escaped excerpt with query terms highlighted, or NULL */
 } SearchResult;
 
 /*
@@ -67,7 +119,7 @@ typedef struct {
 } SearchResults;
 
 /*
- * xapian_search - Perform a search query
+ * xapian_search - Perform a search query, stemmed as English
  *
  * @param db: Database handle
  * @param query: Search query string
@@ -76,6 +128,33 @@ typedef struct {
  */
 SearchResults xapian_search(xapian_db db, const char* query, int limit);
 
+/*
+ * SnippetOptions - How result snippets are generated
+ */
+typedef struct {
+    int length;            /* Maximum snippet length in characters (0 disables snippets) */
+    const char* hl_start;  /* Inserted before each highlighted term */
+    const char* hl_end;    /* Inserted after each highlighted term */
+} SnippetOptions;
+
+/*
+ * xapian_search_bm25 - Perform a search query with custom BM25 parameters
+ *
+ * Xapian's defaults, used by xapian_search, are k1=1 and b=0.5.
+ *
+ * @param db: Database handle
+ * @param query: Search query string
+ * @param limit: Maximum number of results
+ * @param k1: Term frequency saturation (0 ignores term frequency)
+ * @param b: Document length normalisation, from 0 (none) to 1 (full)
+ * @param language: ISO 639-1 code selecting the query stemmer (NULL, empty or
+ *                  unknown disables stemming)
+ * @param snippets: How to generate each result's snippet
+ * @return: SearchResults struct (caller must free with xapian_free_results)
+ */
+SearchResults xapian_search_bm25(xapian_db db, const char* query, int limit, double k1, double b,
+                                 const char* language, SnippetOptions snippets);
+
 /*
  * xapian_free_results - Free search results memory
  *
@@ -83,6 +162,13 @@ SearchResults xapian_search(xapian_db db, const char* query, int limit);
  */
 void xapian_free_results(SearchResults results);
 
+/*
+ * xapian_version - Get the Xapian library version
+ *
+ * @return: Version string, e.g. "1.4.22" (statically allocated, do not free)
+ */
+const char* xapian_version(void);
+
 /*
  * xapian_get_error - Get the last error message
  *
@@ -1,18 +1,33 @@
 package main
 
 import (
-	"log"
+	"context"
+	"log/slog"
 	"os"
 	"path/filepath"
 
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
 	"github.com/custodia-labs/sercha-cli/cgo/xapian"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/ai"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/auth"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/config/file"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/notify"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/backup"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/diskusage"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/keychain"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/sqlite"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/tracing"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/cli"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/connectors"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/budget"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 	"github.com/custodia-labs/sercha-cli/internal/core/services"
+	"github.com/custodia-labs/sercha-cli/internal/logger"
 	"github.com/custodia-labs/sercha-cli/internal/normalisers"
 	"github.com/custodia-labs/sercha-cli/internal/postprocessors"
 )
@@ -26,11 +41,13 @@ func main() {
 //nolint:funlen // main initialisation requires sequential setup of all dependencies
 func run() int {
 	cli.SetVersion(version)
+	// Log the construction of services at the level the flags select
+	logger.Setup(cli.LogSettings(os.Args[1:]))
 
 	// Create unified SQLite store for all metadata persistence
 	sqliteStore, err := sqlite.NewStore("")
 	if err != nil {
-		log.Printf("failed to create SQLite store: %v", err)
+		slog.Error("failed to create SQLite store", slog.Any("error", err))
 		return 1
 	}
 	defer sqliteStore.Close()
@@ -42,12 +59,15 @@ func run() int {
 	exclusionStore := sqliteStore.ExclusionStore()
 	schedulerStore := sqliteStore.SchedulerStore()
 	authProviderStore := sqliteStore.AuthProviderStore()
-	credentialsStore := sqliteStore.CredentialsStore()
+	sourceHealthStore := sqliteStore.SourceHealthStore()
+	// Credentials tokens are encrypted with the OS keychain when --keychain is set,
+	// or with SERCHA_CREDENTIALS_PASSPHRASE
+	credentialsStore := keychain.NewCredentialsStore(sqliteStore.CredentialsStore())
 
 	// Create config store and settings service EARLY (needed for AI adapter creation)
 	configStore, err := file.NewConfigStore("")
 	if err != nil {
-		log.Printf("failed to create config store: %v", err)
+		slog.Error("failed to create config store", slog.Any("error", err))
 		return 1
 	}
 	aiConfigValidator := ai.NewConfigValidator()
@@ -56,49 +76,66 @@ func run() int {
 	// Get current settings to determine which adapters to create
 	settings, err := settingsSvc.Get()
 	if err != nil {
-		log.Printf("failed to get settings: %v", err)
+		slog.Error("failed to get settings", slog.Any("error", err))
 		return 1
 	}
 
+	// Export traces when an OTLP endpoint is set by flag or setting;
+	// otherwise spans are no-ops
+	traceEndpoint := cli.TraceEndpoint(os.Args[1:])
+	if traceEndpoint == "" {
+		traceEndpoint = settings.OpenTelemetryEndpoint
+	}
+	if traceEndpoint != "" {
+		tracerProvider, err := tracing.NewProvider(traceEndpoint, "sercha", version)
+		if err != nil {
+			slog.Warn("tracing disabled", slog.Any("error", err))
+		} else {
+			otel.SetTracerProvider(tracerProvider)
+			defer shutdownTracing(tracerProvider)
+		}
+	}
+
 	// Create Xapian search engine (always needed for keyword search)
 	home, err := os.UserHomeDir()
 	if err != nil {
-		log.Printf("failed to get home directory: %v", err)
+		slog.Error("failed to get home directory", slog.Any("error", err))
 		return 1
 	}
 	xapianPath := filepath.Join(home, ".sercha", "data", "xapian")
 	if err := os.MkdirAll(xapianPath, 0700); err != nil {
-		log.Printf("failed to create Xapian directory: %v", err)
+		slog.Error("failed to create Xapian directory", slog.Any("error", err))
 		return 1
 	}
 	searchEngine, err := xapian.New(xapianPath)
 	if err != nil {
-		log.Printf("failed to create Xapian search engine: %v", err)
+		slog.Error("failed to create Xapian search engine", slog.Any("error", err))
 		return 1
 	}
 	defer searchEngine.Close()
+	if domain.ValidateSearchLanguage(settings.DefaultSearchLanguage) == nil {
+		searchEngine.SetDefaultLanguage(settings.DefaultSearchLanguage)
+	}
 
 	// Initialise AI services with auto-fallback on failure
 	vectorPath := filepath.Join(home, ".sercha", "data", "vectors")
 	if err := os.MkdirAll(vectorPath, 0700); err != nil {
-		log.Printf("failed to create vector directory: %v", err)
+		slog.Error("failed to create vector directory", slog.Any("error", err))
 		return 1
 	}
 
 	aiResult, err := ai.InitialiseServices(settings, vectorPath)
 	if err != nil {
-		log.Printf("fatal error initialising AI: %v", err)
+		slog.Error("failed to initialise AI services", slog.Any("error", err))
 		return 1
 	}
 	defer aiResult.Close()
 
 	// Log warnings and notify user about fallback
 	if aiResult.FellBack {
-		log.Println("Warning: Running in text-only mode due to AI configuration issues:")
-		for _, w := range aiResult.Warnings {
-			log.Printf("  - %s", w)
-		}
-		log.Println("Run 'sercha settings wizard' to configure AI features.")
+		slog.Warn("running in text-only mode due to AI configuration issues; "+
+			"run 'sercha settings wizard' to configure AI features",
+			slog.Any("warnings", aiResult.Warnings))
 	}
 
 	// Provider registry is created after connector registry (see below)
@@ -112,23 +149,32 @@ func run() int {
 
 	// Create connector and normaliser registries
 	connectorFactory := connectors.NewFactory(tokenProviderFactory)
-	normaliserRegistry := normalisers.NewRegistry()
+	// Expiring OAuth tokens are refreshed with the connector's OAuth handler
+	tokenProviderFactory.SetTokenRefresher(connectorFactory)
+	// A global API budget bounds the combined load of all connectors
+	syncCfg := settingsSvc.GetSyncConfig()
+	if apiBudget := budget.New(syncCfg.MaxConcurrentRequests, syncCfg.RequestsPerSecond); apiBudget != nil {
+		connectorFactory.SetAPIBudget(apiBudget)
+	}
+	// Connectors ask providers for content in the configured locale
+	if domain.ValidateLocale(settings.Locale) == nil {
+		connectorFactory.SetLocale(settings.Locale)
+	}
+	normaliserRegistry := normalisers.NewRegistryWithConfig(settingsSvc.GetNormaliserConfig())
 
 	// Create PostProcessor pipeline from configuration
 	pipelineCfg := settingsSvc.GetPipelineConfig()
 	processorRegistry := postprocessors.NewRegistry()
 	postprocessors.RegisterDefaults(processorRegistry)
+	processorRegistry.SetLLMService(aiResult.LLMService)
 
-	pipeline := postprocessors.NewPipeline()
-	for _, name := range pipelineCfg.Processors {
-		cfg := pipelineCfg.GetProcessorConfig(name)
-		processor, err := processorRegistry.Build(name, cfg)
-		if err != nil {
-			log.Printf("failed to build processor %s: %v", name, err)
-			return 1
-		}
-		pipeline.Add(processor)
+	// Sources may override the global pipeline via their config
+	sourcePipelines, err := postprocessors.NewSourcePipelines(processorRegistry, pipelineCfg)
+	if err != nil {
+		slog.Error("failed to build pipeline", slog.Any("error", err))
+		return 1
 	}
+	pipeline := sourcePipelines.Global()
 
 	// Create core services with AI dependencies
 	searchSvc := services.NewSearchService(
@@ -138,12 +184,22 @@ func run() int {
 	// Set optional stores for SourceName enrichment in search results
 	searchSvc.SetSourceStore(sourceStore)
 	searchSvc.SetCredentialsStore(credentialsStore)
+	searchSvc.SetSettingsService(settingsSvc)
+	searchSvc.SetEmbeddingModels(aiResult.ModelEmbeddingServices)
+	if aiResult.LLMService != nil {
+		searchSvc.SetQueryExpander(services.NewQueryExpander(aiResult.LLMService))
+	}
 
 	sourceSvc := services.NewSourceService(sourceStore, syncStore, docStore)
 
 	// Create connector registry (needed before sourceSvc.SetConnectorRegistry)
 	connectorRegistry := services.NewConnectorRegistry(connectorFactory)
+	connectorRegistry.SetNormaliserRegistry(normaliserRegistry)
 	sourceSvc.SetConnectorRegistry(connectorRegistry)
+	if unhandled := connectorRegistry.UnhandledMIMETypes(); len(unhandled) > 0 {
+		slog.Warn("connector MIME types have no normaliser; run 'sercha connector check' for details",
+			slog.Int("count", len(unhandled)))
+	}
 
 	// Create provider registry (depends on connectorRegistry and connectorFactory)
 	providerRegistry := services.NewProviderRegistry(connectorRegistry, connectorFactory)
@@ -152,16 +208,68 @@ func run() int {
 		sourceStore, syncStore, docStore, exclusionStore, connectorFactory, normaliserRegistry,
 		pipeline, searchEngine, aiResult.VectorIndex, aiResult.EmbeddingService,
 	)
+	syncSvc.SetTokenProviderFactory(tokenProviderFactory)
+	syncSvc.SetPipelineProvider(sourcePipelines)
+	syncSvc.SetSkipEmpty(syncCfg.SkipEmpty)
+	// Chunks longer than the embedding model accepts are split, not failed
+	syncSvc.SetEmbeddingMaxTokens(settings.Embedding.MaxTokens)
+	syncSvc.SetEmbeddingBatchSize(settings.Embedding.BatchSize)
+	// Some connector or MIME types may be embedded by other models
+	syncSvc.SetEmbeddingModels(settings.Embedding.ModelOverrides, aiResult.ModelEmbeddingServices)
+	if aiResult.EmbeddingService != nil {
+		// Unchanged chunks reuse their embeddings on re-sync
+		syncSvc.SetEmbeddingCache(sqliteStore.EmbeddingCache())
+	}
+	// Record what each sync does with every document
+	syncAuditLog := sqliteStore.SyncAuditLog()
+	syncSvc.SetSyncAuditLog(syncAuditLog)
+	// Report finished syncs via desktop notifications and/or a webhook
+	if notifyCfg := settingsSvc.GetNotificationConfig(); notifyCfg.Enabled() {
+		syncSvc.SetNotifier(notify.FromConfig(notifyCfg), notifyCfg.Events)
+	}
+
+	// Scheduled and on-demand syncs share one concurrency cap
+	schedulerCfg := settingsSvc.GetSchedulerConfig()
+	syncLimiter := services.NewSyncLimiter(schedulerCfg.MaxConcurrentSyncs)
+	syncSvc.SetSyncLimiter(syncLimiter)
+	syncSvc.SetSyncWorkers(schedulerCfg.SyncWorkers)
+	scheduleSvc := services.NewScheduleService(sourceStore, schedulerStore)
 	resultActionSvc := services.NewResultActionService(sourceStore, connectorRegistry)
 	documentSvc := services.NewDocumentService(docStore, sourceStore, exclusionStore, connectorRegistry)
+	documentSvc.SetSyncOrchestrator(syncSvc)
+	accountSvc := services.NewAccountService(sourceStore, credentialsStore, connectorRegistry)
+	sourceHealthSvc := services.NewSourceHealthService(
+		sourceStore, credentialsStore, sourceHealthStore, connectorFactory)
+	backupArchiver := backup.NewArchiver(sqliteStore, xapianPath, vectorPath)
+	backupArchiver.SetConfigPath(filepath.Join(home, ".sercha", "config.toml"))
+	backupSvc := services.NewBackupService(backupArchiver, sourceStore, docStore, searchEngine)
+	diskUsageMeter := diskusage.NewMeter(sqliteStore.Path(), xapianPath, vectorPath)
+	maintenanceSvc := services.NewMaintenanceService(
+		sqliteStore, diskUsageMeter, sourceStore, docStore, searchEngine, aiResult.VectorIndex)
+	diagnosticSvc := services.NewDiagnosticService(
+		sourceStore, docStore, diskUsageMeter, sqliteStore, searchEngine, aiResult.VectorIndex)
+	diagnosticSvc.SetSettingsService(settingsSvc)
+	diagnosticSvc.SetPaths(sqliteStore.Path(), xapianPath, vectorPath)
+	syncAuditSvc := services.NewSyncAuditService(syncAuditLog)
+	rebuildSvc := services.NewRebuildService(
+		sourceStore, syncStore, docStore, sqliteStore.RebuildStateStore(), pipeline,
+		searchEngine, aiResult.VectorIndex, aiResult.EmbeddingService, syncSvc)
+	rebuildSvc.SetPipelineProvider(sourcePipelines)
+	rebuildSvc.SetEmbeddingMaxTokens(settings.Embedding.MaxTokens)
+	rebuildSvc.SetEmbeddingModels(settings.Embedding.ModelOverrides, aiResult.ModelEmbeddingServices)
 
 	// Create scheduler (started only by TUI command which is long-running)
-	schedulerCfg := settingsSvc.GetSchedulerConfig()
 	scheduler := services.NewScheduler(
 		schedulerCfg,
 		schedulerStore,
+		sourceStore,
 		syncSvc,
 	)
+	scheduler.SetSyncLimiter(syncLimiter)
+	scheduler.SetMaintenanceService(maintenanceSvc)
+
+	// Create watcher for live indexing (started only by TUI with --watch)
+	watcher := services.NewSyncWatcher(sourceStore, syncSvc)
 
 	// Inject services into CLI commands
 	cli.SetServices(&cli.Services{
@@ -174,8 +282,35 @@ func run() int {
 		Settings:          settingsSvc,
 		AuthProvider:      authProviderSvc,
 		Credentials:       credentialsSvc,
+		Schedule:          scheduleSvc,
+		Accounts:          accountSvc,
+		SourceHealth:      sourceHealthSvc,
+		Backup:            backupSvc,
+		Maintenance:       maintenanceSvc,
+		Rebuild:           rebuildSvc,
+		Diagnostic:        diagnosticSvc,
+		SyncAudit:         syncAuditSvc,
+		Keychain:          credentialsStore,
 	})
 
+	theme, err := styles.SelectTheme(settings.UI.Theme)
+	if err != nil {
+		slog.Warn("using the default theme", slog.Any("error", err))
+	}
+	keys, err := keymap.Load(settings.UI.KeyBindings)
+	if err != nil {
+		slog.Warn("using the default keybindings", slog.Any("error", err))
+		keys = keymap.DefaultKeyMap()
+	}
+
+	// The last TUI search is kept beside the config so it can be resumed
+	var searchSessionStore driven.SearchSessionStore
+	if store, err := file.NewSearchSessionStore(""); err != nil {
+		slog.Warn("last search will not be remembered", slog.Any("error", err))
+	} else {
+		searchSessionStore = store
+	}
+
 	// Inject services into TUI command (including scheduler for background tasks)
 	cli.SetTUIConfig(&cli.TUIConfig{
 		SearchService:       searchSvc,
@@ -188,8 +323,13 @@ func run() int {
 		SettingsService:     settingsSvc,
 		CredentialsService:  credentialsSvc,
 		AuthProviderService: authProviderSvc,
+		SourceHealthService: sourceHealthSvc,
+		SearchSession:       services.NewSearchSessionService(searchSessionStore),
 		Scheduler:           scheduler,
 		SchedulerConfig:     schedulerCfg,
+		Watcher:             watcher,
+		Theme:               theme,
+		KeyMap:              keys,
 	})
 
 	if err := cli.Execute(); err != nil {
@@ -197,3 +337,12 @@ func run() int {
 	}
 	return 0
 }
+
+// shutdownTracing exports the spans not yet sent to the trace collector.
+func shutdownTracing(provider *sdktrace.TracerProvider) {
+	ctx, cancel := context.WithTimeout(context.Background(), tracing.DefaultExportTimeout)
+	defer cancel()
+	if err := provider.Shutdown(ctx); err != nil {
+		slog.Warn("failed to export traces", slog.Any("error", err))
+	}
+}
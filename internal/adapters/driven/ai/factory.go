@@ -4,18 +4,20 @@ package ai
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"path/filepath"
 	"time"
 
 	"github.com/custodia-labs/sercha-cli/cgo/hnsw"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/config/file"
 	ollamaembed "github.com/custodia-labs/sercha-cli/internal/adapters/driven/embedding/ollama"
 	openaiembed "github.com/custodia-labs/sercha-cli/internal/adapters/driven/embedding/openai"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/embedding/openaicompat"
 	anthropicllm "github.com/custodia-labs/sercha-cli/internal/adapters/driven/llm/anthropic"
 	ollamallm "github.com/custodia-labs/sercha-cli/internal/adapters/driven/llm/ollama"
 	openaillm "github.com/custodia-labs/sercha-cli/internal/adapters/driven/llm/openai"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
-	"github.com/custodia-labs/sercha-cli/internal/logger"
 )
 
 // pingTimeout is the maximum time to wait for service connectivity validation.
@@ -29,6 +31,11 @@ type InitResult struct {
 	PromptStore      driven.PromptStore // User-customisable prompt templates.
 	Warnings         []string           // Non-fatal issues that caused fallback.
 	FellBack         bool               // True if fell back to text-only mode.
+
+	// ModelEmbeddingServices holds the services of the models named by
+	// embedding model overrides, keyed by model. VectorIndex keeps their
+	// vectors apart from the default model's.
+	ModelEmbeddingServices map[string]driven.EmbeddingService
 }
 
 // Close releases all resources held by InitResult.
@@ -36,6 +43,9 @@ func (r *InitResult) Close() {
 	if r.EmbeddingService != nil {
 		r.EmbeddingService.Close()
 	}
+	for _, svc := range r.ModelEmbeddingServices {
+		svc.Close()
+	}
 	if r.VectorIndex != nil {
 		r.VectorIndex.Close()
 	}
@@ -47,7 +57,14 @@ func (r *InitResult) Close() {
 // CreateAndValidateEmbeddingService creates an embedding service and validates connectivity.
 // Returns the service if successful, or an error with guidance.
 func CreateAndValidateEmbeddingService(settings *domain.EmbeddingSettings) (driven.EmbeddingService, error) {
-	if settings == nil || !settings.IsConfigured() {
+	if settings == nil {
+		return nil, nil
+	}
+	if err := settings.ValidateEndpoint(); err != nil {
+		return nil, fmt.Errorf("%w: %w. Run 'sercha settings wizard' to fix",
+			domain.ErrEmbeddingUnavailable, err)
+	}
+	if !settings.IsConfigured() {
 		return nil, nil
 	}
 
@@ -107,7 +124,13 @@ func CreateAndValidateLLMService(settings *domain.LLMSettings) (driven.LLMServic
 // ValidateEmbeddingConfig validates an embedding configuration by creating a service and pinging it.
 // This is intended for use in the settings wizard to validate credentials on configuration.
 func ValidateEmbeddingConfig(settings *domain.EmbeddingSettings) error {
-	if settings == nil || !settings.IsConfigured() {
+	if settings == nil {
+		return nil
+	}
+	if err := settings.ValidateEndpoint(); err != nil {
+		return err
+	}
+	if !settings.IsConfigured() {
 		return nil
 	}
 
@@ -147,9 +170,16 @@ func ValidateLLMConfig(settings *domain.LLMSettings) error {
 }
 
 // CreateEmbeddingService creates the appropriate embedding service based on settings.
-// Returns nil if the provider is not configured.
+// Returns nil if the provider is not configured, or an error if it is
+// selected but its endpoint settings are incomplete.
 func CreateEmbeddingService(settings *domain.EmbeddingSettings) (driven.EmbeddingService, error) {
-	if settings == nil || !settings.IsConfigured() {
+	if settings == nil {
+		return nil, nil
+	}
+	if err := settings.ValidateEndpoint(); err != nil {
+		return nil, err
+	}
+	if !settings.IsConfigured() {
 		return nil, nil
 	}
 
@@ -160,6 +190,9 @@ func CreateEmbeddingService(settings *domain.EmbeddingSettings) (driven.Embeddin
 	case domain.AIProviderOpenAI:
 		return createOpenAIEmbedding(settings)
 
+	case domain.AIProviderOpenAICompatible:
+		return createOpenAICompatibleEmbedding(settings)
+
 	case domain.AIProviderAnthropic:
 		// Anthropic does not support embeddings.
 		return nil, fmt.Errorf("anthropic does not support embeddings, use ollama or openai")
@@ -169,6 +202,18 @@ func CreateEmbeddingService(settings *domain.EmbeddingSettings) (driven.Embeddin
 	}
 }
 
+// CreateModelEmbeddingService creates an embedding service for another model
+// of the configured provider, as named by an embedding model override.
+func CreateModelEmbeddingService(settings *domain.EmbeddingSettings, model string) (driven.EmbeddingService, error) {
+	if settings == nil {
+		return nil, nil
+	}
+	modelSettings := *settings
+	modelSettings.Model = model
+	modelSettings.ModelOverrides = nil
+	return CreateEmbeddingService(&modelSettings)
+}
+
 // CreateLLMService creates the appropriate LLM service based on settings.
 // Returns nil if the provider is not configured.
 func CreateLLMService(settings *domain.LLMSettings) (driven.LLMService, error) {
@@ -217,6 +262,17 @@ func createOpenAIEmbedding(settings *domain.EmbeddingSettings) (driven.Embedding
 	})
 }
 
+// createOpenAICompatibleEmbedding creates an embedding service for an
+// OpenAI-compatible server.
+func createOpenAICompatibleEmbedding(settings *domain.EmbeddingSettings) (driven.EmbeddingService, error) {
+	return openaicompat.NewEmbeddingService(openaicompat.Config{
+		BaseURL:    settings.BaseURL,
+		APIKey:     settings.APIKey,
+		Model:      settings.Model,
+		Dimensions: settings.Dimensions,
+	})
+}
+
 // createOllamaLLM creates an Ollama LLM service.
 func createOllamaLLM(settings *domain.LLMSettings) driven.LLMService {
 	return ollamallm.NewLLMService(ollamallm.LLMConfig{
@@ -244,12 +300,11 @@ func createAnthropicLLM(settings *domain.LLMSettings) (driven.LLMService, error)
 }
 
 // InitialiseServices creates AI services with auto-fallback on failure.
-// If services required by settings fail, falls back to text-only mode and logs warnings.
+// If services required by settings fail, falls back to text-only mode and records warnings.
 // The caller should check result.FellBack and result.Warnings to inform the user.
 func InitialiseServices(settings *domain.AppSettings, vectorPath string) (*InitResult, error) {
-	logger.Section("AI Service Initialisation")
-	logger.Debug("Search mode: %s", settings.Search.Mode.Description())
-	logger.Debug("Vector index path: %s", vectorPath)
+	slog.Debug("initialising AI services",
+		slog.String("search_mode", settings.Search.Mode.Description()), slog.String("vector_path", vectorPath))
 
 	result := &InitResult{}
 
@@ -257,82 +312,123 @@ func InitialiseServices(settings *domain.AppSettings, vectorPath string) (*InitR
 	promptStore, err := file.NewPromptStore("")
 	if err == nil {
 		result.PromptStore = promptStore
-		logger.Debug("Prompt store: loaded")
+		slog.Debug("prompt store loaded")
 	} else {
-		logger.Debug("Prompt store: not available (%v)", err)
+		slog.Debug("prompt store not available", slog.Any("error", err))
 	}
 
 	// Try to create embedding service if mode requires it (no validation - done in wizard).
 	if settings.Search.Mode.RequiresEmbedding() {
-		logger.Debug("Embedding required: yes (mode=%s)", settings.Search.Mode)
-		logger.Debug("Embedding provider: %s", settings.Embedding.Provider.Description())
-		logger.Debug("Embedding model: %s", settings.Embedding.Model)
+		slog.Debug("embedding required",
+			slog.String("provider", settings.Embedding.Provider.Description()),
+			slog.String("model", settings.Embedding.Model))
 
 		svc, err := CreateEmbeddingService(&settings.Embedding)
 		if err != nil {
-			logger.Warn("Embedding service failed: %v", err)
+			slog.Debug("embedding service failed", slog.Any("error", err))
 			result.Warnings = append(result.Warnings, fmt.Sprintf("Embedding: %v", err))
 			result.FellBack = true
 		} else if svc != nil {
-			logger.Info("Embedding service: created (dimensions=%d)", svc.Dimensions())
+			slog.Info("embedding service created", slog.Int("dimensions", svc.Dimensions()))
 			result.EmbeddingService = svc
 		}
 	} else {
-		logger.Debug("Embedding required: no")
+		slog.Debug("embedding not required")
 	}
 
 	// Create vector index only if embedding service available.
 	if result.EmbeddingService != nil && vectorPath != "" {
 		precision := domainToHNSWPrecision(settings.VectorIndex.Precision)
-		logger.Debug("Creating vector index: path=%s, dims=%d, precision=%v",
-			vectorPath, result.EmbeddingService.Dimensions(), precision)
+		slog.Debug("creating vector index", slog.String("path", vectorPath),
+			slog.Int("dimensions", result.EmbeddingService.Dimensions()), slog.Any("precision", precision))
 
 		idx, err := hnsw.New(vectorPath, result.EmbeddingService.Dimensions(), precision)
 		if err != nil {
-			logger.Warn("Vector index failed: %v", err)
+			slog.Debug("vector index failed", slog.Any("error", err))
 			result.Warnings = append(result.Warnings,
 				fmt.Sprintf("Vector index: %v. Run 'sercha settings wizard' to fix", err))
 			result.EmbeddingService.Close()
 			result.EmbeddingService = nil
 			result.FellBack = true
 		} else {
-			logger.Info("Vector index: created")
+			slog.Info("vector index created")
 			result.VectorIndex = idx
+			initModelEmbeddingServices(result, settings, vectorPath)
 		}
 	}
 
 	// Try to create LLM service if mode requires it.
 	if settings.Search.Mode.RequiresLLM() {
-		logger.Debug("LLM required: yes (mode=%s)", settings.Search.Mode)
-		logger.Debug("LLM provider: %s", settings.LLM.Provider.Description())
-		logger.Debug("LLM model: %s", settings.LLM.Model)
+		slog.Debug("LLM required",
+			slog.String("provider", settings.LLM.Provider.Description()), slog.String("model", settings.LLM.Model))
 		initLLMService(result, &settings.LLM)
 	} else {
-		logger.Debug("LLM required: no")
+		slog.Debug("LLM not required")
+	}
+
+	return result, nil
+}
+
+// initModelEmbeddingServices creates the services of the models named by
+// embedding model overrides, each with its own vector index in a
+// subdirectory of vectorPath, and replaces result.VectorIndex with an index
+// covering every model. A model that fails is left out with a warning, so
+// its documents are embedded by the default model.
+func initModelEmbeddingServices(result *InitResult, settings *domain.AppSettings, vectorPath string) {
+	models := settings.Embedding.OverrideModels()
+	if len(models) == 0 {
+		return
 	}
 
-	if result.FellBack {
-		logger.Warn("Fell back to text-only mode due to service failures")
+	precision := domainToHNSWPrecision(settings.VectorIndex.Precision)
+	services := make(map[string]driven.EmbeddingService, len(models))
+	indexes := make(map[string]driven.VectorIndex, len(models))
+	for _, model := range models {
+		svc, err := CreateModelEmbeddingService(&settings.Embedding, model)
+		if err != nil {
+			slog.Debug("embedding service failed", slog.String("model", model), slog.Any("error", err))
+			result.Warnings = append(result.Warnings, fmt.Sprintf("Embedding model %s: %v", model, err))
+			continue
+		}
+		if svc == nil {
+			continue
+		}
+
+		path := filepath.Join(vectorPath, "models", modelIndexDir(model))
+		idx, err := hnsw.New(path, svc.Dimensions(), precision)
+		if err != nil {
+			slog.Debug("vector index failed", slog.String("model", model), slog.Any("error", err))
+			result.Warnings = append(result.Warnings, fmt.Sprintf("Vector index for model %s: %v", model, err))
+			svc.Close()
+			continue
+		}
+
+		slog.Info("embedding service created", slog.String("model", model), slog.Int("dimensions", svc.Dimensions()))
+		services[model] = svc
+		indexes[model] = idx
 	}
 
-	return result, nil
+	if len(services) > 0 {
+		result.ModelEmbeddingServices = services
+		result.VectorIndex = NewModelIndex(result.EmbeddingService.ModelName(), result.VectorIndex, indexes)
+	}
 }
 
 // initLLMService creates and configures the LLM service, updating result accordingly.
 func initLLMService(result *InitResult, settings *domain.LLMSettings) {
 	svc, err := CreateLLMService(settings)
 	if err != nil {
-		logger.Warn("LLM service failed: %v", err)
+		slog.Debug("LLM service failed", slog.Any("error", err))
 		result.Warnings = append(result.Warnings, fmt.Sprintf("LLM: %v", err))
 		result.FellBack = true
 		return
 	}
 	if svc == nil {
-		logger.Debug("LLM service: not configured")
+		slog.Debug("LLM service not configured")
 		return
 	}
 
-	logger.Info("LLM service: created")
+	slog.Info("LLM service created")
 	result.LLMService = svc
 	injectPromptStore(svc, result.PromptStore)
 }
@@ -54,6 +54,28 @@ func TestCreateEmbeddingService(t *testing.T) {
 			wantNil: false,
 			wantErr: false,
 		},
+		{
+			name: "openai-compatible provider creates service",
+			settings: &domain.EmbeddingSettings{
+				Provider:   domain.AIProviderOpenAICompatible,
+				BaseURL:    "http://localhost:1234/v1",
+				Model:      "nomic-embed-text",
+				Dimensions: 768,
+			},
+			wantNil: false,
+			wantErr: false,
+		},
+		{
+			name: "openai-compatible without base URL returns error",
+			settings: &domain.EmbeddingSettings{
+				Provider:   domain.AIProviderOpenAICompatible,
+				Model:      "nomic-embed-text",
+				Dimensions: 768,
+			},
+			wantNil:     true,
+			wantErr:     true,
+			errContains: "base URL is required",
+		},
 		{
 			name: "anthropic provider returns error",
 			settings: &domain.EmbeddingSettings{
@@ -0,0 +1,168 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure ModelIndex implements the interfaces.
+var (
+	_ driven.VectorIndex           = (*ModelIndex)(nil)
+	_ driven.ModelVectorIndex      = (*ModelIndex)(nil)
+	_ driven.VectorIndexMaintainer = (*ModelIndex)(nil)
+	_ driven.VectorIndexCompactor  = (*ModelIndex)(nil)
+)
+
+// ModelIndex is a vector index holding the embeddings of several embedding
+// models, with a separate index per model. A chunk's vector is tagged with
+// its model by the index it is stored in, so queries are only compared with
+// vectors computed by the model that embedded them.
+type ModelIndex struct {
+	defaultModel string
+	indexes      map[string]driven.VectorIndex
+	// models lists the indexed models, the default first, so that
+	// operations on every index run in a stable order.
+	models []string
+}
+
+// NewModelIndex creates a vector index from the index of the default model
+// and those of other models, keyed by model name.
+func NewModelIndex(
+	defaultModel string, defaultIndex driven.VectorIndex, others map[string]driven.VectorIndex,
+) *ModelIndex {
+	idx := &ModelIndex{
+		defaultModel: defaultModel,
+		indexes:      map[string]driven.VectorIndex{defaultModel: defaultIndex},
+	}
+	for model, index := range others {
+		if model != defaultModel {
+			idx.indexes[model] = index
+			idx.models = append(idx.models, model)
+		}
+	}
+	slices.Sort(idx.models)
+	idx.models = append([]string{defaultModel}, idx.models...)
+	return idx
+}
+
+// Add inserts a vector computed by the default model.
+func (idx *ModelIndex) Add(ctx context.Context, chunkID string, embedding []float32) error {
+	return idx.AddForModel(ctx, idx.defaultModel, chunkID, embedding)
+}
+
+// AddForModel inserts a vector computed by model, removing any vector the
+// chunk had under another model.
+func (idx *ModelIndex) AddForModel(ctx context.Context, model, chunkID string, embedding []float32) error {
+	index, ok := idx.indexes[model]
+	if !ok {
+		return fmt.Errorf("vector index: no index for embedding model %q", model)
+	}
+	for _, other := range idx.models {
+		if other == model {
+			continue
+		}
+		if err := idx.indexes[other].Delete(ctx, chunkID); err != nil {
+			return fmt.Errorf("vector index %s: %w", other, err)
+		}
+	}
+	return index.Add(ctx, chunkID, embedding)
+}
+
+// Delete removes a chunk's vector from every model's index.
+func (idx *ModelIndex) Delete(ctx context.Context, chunkID string) error {
+	var errs []error
+	for _, model := range idx.models {
+		if err := idx.indexes[model].Delete(ctx, chunkID); err != nil {
+			errs = append(errs, fmt.Errorf("vector index %s: %w", model, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Search finds the k nearest neighbours among the default model's vectors.
+func (idx *ModelIndex) Search(ctx context.Context, query []float32, k int) ([]driven.VectorHit, error) {
+	return idx.SearchModel(ctx, idx.defaultModel, query, k)
+}
+
+// SearchModel finds the k nearest neighbours among the vectors computed by model.
+func (idx *ModelIndex) SearchModel(
+	ctx context.Context, model string, query []float32, k int,
+) ([]driven.VectorHit, error) {
+	index, ok := idx.indexes[model]
+	if !ok {
+		return nil, fmt.Errorf("vector index: no index for embedding model %q", model)
+	}
+	return index.Search(ctx, query, k)
+}
+
+// ChunkIDs returns the IDs of all chunks with a stored vector, from the
+// indexes that can enumerate their contents.
+func (idx *ModelIndex) ChunkIDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	for _, model := range idx.models {
+		maintainer, ok := idx.indexes[model].(driven.VectorIndexMaintainer)
+		if !ok {
+			continue
+		}
+		modelIDs, err := maintainer.ChunkIDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("vector index %s: %w", model, err)
+		}
+		ids = append(ids, modelIDs...)
+	}
+	return ids, nil
+}
+
+// Save writes every index's pending changes to disk.
+func (idx *ModelIndex) Save(ctx context.Context) error {
+	for _, model := range idx.models {
+		if maintainer, ok := idx.indexes[model].(driven.VectorIndexMaintainer); ok {
+			if err := maintainer.Save(ctx); err != nil {
+				return fmt.Errorf("vector index %s: %w", model, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Compact rebuilds every index that supports it from its live vectors.
+func (idx *ModelIndex) Compact(ctx context.Context) error {
+	for _, model := range idx.models {
+		if compactor, ok := idx.indexes[model].(driven.VectorIndexCompactor); ok {
+			if err := compactor.Compact(ctx); err != nil {
+				return fmt.Errorf("vector index %s: %w", model, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Close closes every index.
+func (idx *ModelIndex) Close() error {
+	var errs []error
+	for _, model := range idx.models {
+		if err := idx.indexes[model].Close(); err != nil {
+			errs = append(errs, fmt.Errorf("vector index %s: %w", model, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// modelIndexDir returns the directory name of a model's index: the model
+// name with characters other than letters, digits, '_' and '-' replaced,
+// such as "nomic-embed-text_latest" for "nomic-embed-text:latest".
+func modelIndexDir(model string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '-':
+			return r
+		default:
+			return '_'
+		}
+	}, model)
+}
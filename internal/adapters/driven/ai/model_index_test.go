@@ -0,0 +1,107 @@
+package ai
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// mapVectorIndex is an in-memory vector index whose searches return every
+// stored chunk.
+type mapVectorIndex struct {
+	vectors map[string][]float32
+	saved   int
+}
+
+func newMapVectorIndex() *mapVectorIndex {
+	return &mapVectorIndex{vectors: make(map[string][]float32)}
+}
+
+func (v *mapVectorIndex) Add(_ context.Context, chunkID string, embedding []float32) error {
+	v.vectors[chunkID] = embedding
+	return nil
+}
+
+func (v *mapVectorIndex) Search(_ context.Context, _ []float32, _ int) ([]driven.VectorHit, error) {
+	hits := make([]driven.VectorHit, 0, len(v.vectors))
+	for id := range v.vectors {
+		hits = append(hits, driven.VectorHit{ChunkID: id, Similarity: 1})
+	}
+	return hits, nil
+}
+
+func (v *mapVectorIndex) Delete(_ context.Context, chunkID string) error {
+	delete(v.vectors, chunkID)
+	return nil
+}
+
+func (v *mapVectorIndex) ChunkIDs(_ context.Context) ([]string, error) {
+	ids := make([]string, 0, len(v.vectors))
+	for id := range v.vectors {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (v *mapVectorIndex) Save(_ context.Context) error {
+	v.saved++
+	return nil
+}
+
+func (v *mapVectorIndex) Close() error { return nil }
+
+func TestModelIndex(t *testing.T) {
+	ctx := context.Background()
+	text, code := newMapVectorIndex(), newMapVectorIndex()
+	idx := NewModelIndex("nomic-embed-text", text, map[string]driven.VectorIndex{"nomic-embed-code": code})
+
+	require.NoError(t, idx.Add(ctx, "chunk-1", []float32{1}))
+	require.NoError(t, idx.AddForModel(ctx, "nomic-embed-code", "chunk-2", []float32{2}))
+	assert.Contains(t, text.vectors, "chunk-1")
+	assert.Contains(t, code.vectors, "chunk-2")
+
+	hits, err := idx.Search(ctx, []float32{1}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []driven.VectorHit{{ChunkID: "chunk-1", Similarity: 1}}, hits)
+	hits, err = idx.SearchModel(ctx, "nomic-embed-code", []float32{2}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []driven.VectorHit{{ChunkID: "chunk-2", Similarity: 1}}, hits)
+
+	// Re-embedding a chunk with another model moves its vector
+	require.NoError(t, idx.AddForModel(ctx, "nomic-embed-code", "chunk-1", []float32{3}))
+	assert.NotContains(t, text.vectors, "chunk-1")
+	assert.Equal(t, []float32{3}, code.vectors["chunk-1"])
+
+	ids, err := idx.ChunkIDs(ctx)
+	require.NoError(t, err)
+	sort.Strings(ids)
+	assert.Equal(t, []string{"chunk-1", "chunk-2"}, ids)
+
+	require.NoError(t, idx.Delete(ctx, "chunk-1"))
+	assert.NotContains(t, code.vectors, "chunk-1")
+
+	require.NoError(t, idx.Save(ctx))
+	assert.Equal(t, 1, text.saved)
+	assert.Equal(t, 1, code.saved)
+	require.NoError(t, idx.Close())
+}
+
+func TestModelIndex_UnknownModel(t *testing.T) {
+	ctx := context.Background()
+	idx := NewModelIndex("nomic-embed-text", newMapVectorIndex(), nil)
+
+	assert.Error(t, idx.AddForModel(ctx, "other", "chunk-1", []float32{1}))
+	_, err := idx.SearchModel(ctx, "other", []float32{1}, 10)
+	assert.Error(t, err)
+}
+
+func TestModelIndexDir(t *testing.T) {
+	assert.Equal(t, "nomic-embed-text_latest", modelIndexDir("nomic-embed-text:latest"))
+	assert.Equal(t, "___etc_passwd", modelIndexDir("../etc/passwd"))
+	assert.Equal(t, "text-embedding-3-small", modelIndexDir("text-embedding-3-small"))
+}
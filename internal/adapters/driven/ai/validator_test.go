@@ -1,6 +1,8 @@
 package ai
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -63,3 +65,50 @@ func TestConfigValidator_ValidateLLM_UnconfiguredProvider(t *testing.T) {
 	// Unconfigured provider returns nil (nothing to validate)
 	assert.NoError(t, err)
 }
+
+func TestConfigValidator_ValidateEmbedding_OpenAICompatible(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/embeddings", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"index":0,"embedding":[0.1,0.2,0.3]}]}`))
+	}))
+	defer server.Close()
+
+	valid := domain.EmbeddingSettings{
+		Provider:   domain.AIProviderOpenAICompatible,
+		BaseURL:    server.URL + "/v1",
+		Model:      "local-embed",
+		Dimensions: 3,
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(*domain.EmbeddingSettings)
+		errContains string
+	}{
+		{"valid", func(*domain.EmbeddingSettings) {}, ""},
+		{"missing base URL", func(e *domain.EmbeddingSettings) { e.BaseURL = "" }, "base URL is required"},
+		{"invalid base URL", func(e *domain.EmbeddingSettings) { e.BaseURL = "localhost:1234" }, "http(s) URL"},
+		{"missing model", func(e *domain.EmbeddingSettings) { e.Model = "" }, "requires a model name"},
+		{"missing dimensions", func(e *domain.EmbeddingSettings) { e.Dimensions = 0 }, "vector dimensions"},
+		{"wrong dimensions", func(e *domain.EmbeddingSettings) { e.Dimensions = 768 }, "returned 3 dimensions, configured 768"},
+		{"unreachable", func(e *domain.EmbeddingSettings) { e.BaseURL = "http://127.0.0.1:1/v1" }, "ping failed"},
+	}
+
+	validator := NewConfigValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid
+			tt.modify(&config)
+
+			err := validator.ValidateEmbedding(&config)
+
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			}
+		})
+	}
+}
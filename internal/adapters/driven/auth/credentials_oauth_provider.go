@@ -19,18 +19,46 @@ var _ driven.TokenProvider = (*CredentialsOAuthProvider)(nil)
 
 // CredentialsOAuthProvider provides OAuth access tokens with automatic refresh.
 // Uses the new Credentials and AuthProvider stores instead of AuthorizationStore.
+//
+// Concurrent callers that find the token near expiry share a single refresh:
+// the first performs it and the others wait for its result. Refreshes are
+// shared by credentials ID, so providers created separately for sources that
+// share credentials do not race to rotate the same refresh token.
 type CredentialsOAuthProvider struct {
 	credentialsID     string
 	credentialsStore  driven.CredentialsStore
 	authProviderID    string
 	authProviderStore driven.AuthProviderStore
 
+	// refresher, when set, refreshes tokens with the connector type's OAuth
+	// handler instead of a plain refresh_token grant.
+	refresher     driven.TokenRefresher
+	connectorType string
+
+	// inMemory keeps refreshed tokens out of the credentials store.
+	inMemory bool
+
 	mu            sync.RWMutex
 	cachedToken   string
 	cacheExpiry   time.Time
 	refreshBuffer time.Duration
 }
 
+// refreshCall is a refresh in progress. done is closed once token, expiry
+// and err are set.
+type refreshCall struct {
+	done   chan struct{}
+	token  string
+	expiry time.Time
+	err    error
+}
+
+// refreshes holds the refresh in flight for each credentials ID.
+var (
+	refreshesMu sync.Mutex
+	refreshes   = make(map[string]*refreshCall)
+)
+
 // NewCredentialsOAuthProvider creates a token provider for OAuth-based authentication
 // using the new Credentials and AuthProvider stores.
 func NewCredentialsOAuthProvider(
@@ -48,11 +76,27 @@ func NewCredentialsOAuthProvider(
 	}
 }
 
+// SetTokenRefresher refreshes tokens through the OAuth handler of
+// connectorType, which knows provider-specific refresh requirements.
+func (p *CredentialsOAuthProvider) SetTokenRefresher(connectorType string, refresher driven.TokenRefresher) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.connectorType = connectorType
+	p.refresher = refresher
+}
+
+// KeepRefreshesInMemory makes the provider cache refreshed tokens without
+// saving them to the credentials store, for runs that must not write to it.
+// Its refreshes are not shared with providers that save theirs, which would
+// otherwise lose the refreshed tokens.
+func (p *CredentialsOAuthProvider) KeepRefreshesInMemory() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.inMemory = true
+}
+
 // GetToken returns a valid access token, refreshing if necessary.
-//
-//nolint:gocognit,nestif,gocyclo // Token refresh with necessary concurrency checks
 func (p *CredentialsOAuthProvider) GetToken(ctx context.Context) (string, error) {
-	// Fast path: check cache with read lock
 	p.mu.RLock()
 	if p.cachedToken != "" && time.Now().Before(p.cacheExpiry) {
 		token := p.cachedToken
@@ -61,22 +105,94 @@ func (p *CredentialsOAuthProvider) GetToken(ctx context.Context) (string, error)
 	}
 	p.mu.RUnlock()
 
-	// Slow path: need refresh, acquire write lock
+	return p.refresh(ctx, true)
+}
+
+// RefreshIfNeeded refreshes the access token if it expires within the
+// refresh buffer and persists the new token to the credentials store.
+// Unlike GetToken it always consults the store, so a token cached by
+// an earlier call does not hide an upcoming expiry.
+func (p *CredentialsOAuthProvider) RefreshIfNeeded(ctx context.Context) error {
+	_, err := p.refresh(ctx, false)
+	return err
+}
+
+// refresh loads and, if needed, refreshes the token, joining a refresh of
+// the same credentials that is already in flight rather than starting
+// another. With useCache, a token cached by a refresh that finished since
+// the caller's check is returned.
+func (p *CredentialsOAuthProvider) refresh(ctx context.Context, useCache bool) (string, error) {
+	p.mu.RLock()
+	if useCache && p.cachedToken != "" && time.Now().Before(p.cacheExpiry) {
+		token := p.cachedToken
+		p.mu.RUnlock()
+		return token, nil
+	}
+	p.mu.RUnlock()
+
+	p.mu.RLock()
+	key := p.credentialsID
+	if p.inMemory {
+		key += "\x00in-memory"
+	}
+	p.mu.RUnlock()
+
+	refreshesMu.Lock()
+	call, ok := refreshes[key]
+	if ok {
+		refreshesMu.Unlock()
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	} else {
+		call = &refreshCall{done: make(chan struct{})}
+		refreshes[key] = call
+		refreshesMu.Unlock()
+
+		call.token, call.expiry, call.err = p.load(ctx)
+
+		refreshesMu.Lock()
+		delete(refreshes, key)
+		refreshesMu.Unlock()
+		close(call.done)
+	}
+
+	if call.err != nil {
+		return "", call.err
+	}
+	p.cache(call.token, call.expiry)
+	return call.token, nil
+}
+
+// cache stores token as the cached token. Tokens without an expiry are
+// cached for an hour.
+func (p *CredentialsOAuthProvider) cache(token string, expiry time.Time) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	// Double-check after acquiring write lock
-	if p.cachedToken != "" && time.Now().Before(p.cacheExpiry) {
-		return p.cachedToken, nil
+	p.cachedToken = token
+	if !expiry.IsZero() {
+		p.cacheExpiry = expiry.Add(-p.refreshBuffer)
+	} else {
+		p.cacheExpiry = time.Now().Add(1 * time.Hour)
 	}
+}
 
+// load reads credentials and refreshes them if they are within the refresh
+// buffer, returning the access token and its expiry. Only one load runs at
+// a time for each credentials ID.
+//
+//nolint:gocognit,nestif // Token refresh with necessary validation steps
+func (p *CredentialsOAuthProvider) load(ctx context.Context) (string, time.Time, error) {
 	// Get current credentials
 	creds, err := p.credentialsStore.Get(ctx, p.credentialsID)
 	if err != nil {
-		return "", fmt.Errorf("get credentials: %w", err)
+		return "", time.Time{}, fmt.Errorf("get credentials: %w", err)
 	}
 	if creds.OAuth == nil {
-		return "", fmt.Errorf("credentials have no OAuth tokens")
+		return "", time.Time{}, fmt.Errorf("credentials have no OAuth tokens")
 	}
 
 	// Check if we need to refresh
@@ -89,16 +205,17 @@ func (p *CredentialsOAuthProvider) GetToken(ctx context.Context) (string, error)
 		// Get auth provider for token URL
 		provider, err := p.authProviderStore.Get(ctx, p.authProviderID)
 		if err != nil {
-			return "", fmt.Errorf("get auth provider: %w", err)
+			return "", time.Time{}, fmt.Errorf("get auth provider: %w", err)
 		}
 		if provider.OAuth == nil {
-			return "", fmt.Errorf("auth provider has no OAuth config")
+			return "", time.Time{}, fmt.Errorf("auth provider has no OAuth config")
 		}
 
-		// Refresh the token
-		newTokens, err := p.refreshToken(ctx, creds.OAuth.RefreshToken, provider.OAuth)
+		// Refresh the token. The stored token can no longer be trusted, so
+		// failures are reported as invalid authentication.
+		newTokens, err := p.refreshWith(ctx, creds.OAuth.RefreshToken, provider)
 		if err != nil {
-			return "", fmt.Errorf("refresh token: %w", err)
+			return "", time.Time{}, fmt.Errorf("%w: refresh token: %w", domain.ErrAuthInvalid, err)
 		}
 
 		// Update credentials with new tokens
@@ -110,22 +227,47 @@ func (p *CredentialsOAuthProvider) GetToken(ctx context.Context) (string, error)
 		creds.OAuth.TokenType = newTokens.TokenType
 		creds.UpdatedAt = time.Now()
 
-		if err := p.credentialsStore.Save(ctx, *creds); err != nil {
-			return "", fmt.Errorf("save refreshed credentials: %w", err)
+		p.mu.RLock()
+		inMemory := p.inMemory
+		p.mu.RUnlock()
+		if !inMemory {
+			if err := p.credentialsStore.Save(ctx, *creds); err != nil {
+				return "", time.Time{}, fmt.Errorf("save refreshed credentials: %w", err)
+			}
 		}
 	}
 
-	// Cache the token
-	p.cachedToken = creds.OAuth.AccessToken
+	return creds.OAuth.AccessToken, creds.OAuth.Expiry, nil
+}
 
-	// Set cache expiry
-	if !creds.OAuth.Expiry.IsZero() {
-		p.cacheExpiry = creds.OAuth.Expiry.Add(-p.refreshBuffer)
-	} else {
-		p.cacheExpiry = time.Now().Add(1 * time.Hour)
+// refreshWith refreshes tokens with the connector's OAuth handler if one is
+// set, falling back to a standard refresh_token grant.
+func (p *CredentialsOAuthProvider) refreshWith(
+	ctx context.Context,
+	refreshToken string,
+	provider *domain.AuthProvider,
+) (*domain.OAuthCredentials, error) {
+	p.mu.RLock()
+	refresher, connectorType := p.refresher, p.connectorType
+	p.mu.RUnlock()
+
+	if refresher == nil {
+		return p.refreshToken(ctx, refreshToken, provider.OAuth)
 	}
 
-	return p.cachedToken, nil
+	token, err := refresher.RefreshToken(ctx, connectorType, provider, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil || token.AccessToken == "" {
+		return nil, fmt.Errorf("%w: no access token returned", domain.ErrTokenRefreshFailed)
+	}
+	return &domain.OAuthCredentials{
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		TokenType:    token.TokenType,
+		Expiry:       token.Expiry,
+	}, nil
 }
 
 // refreshToken performs the OAuth2 token refresh.
@@ -153,8 +295,13 @@ func (p *CredentialsOAuthProvider) refreshToken(
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("token refresh failed with status %d", resp.StatusCode)
+	switch {
+	case resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized:
+		// invalid_grant and friends: the refresh token was revoked or expired
+		return nil, fmt.Errorf("%w: token endpoint rejected refresh token with status %d",
+			domain.ErrAuthExpired, resp.StatusCode)
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("%w: status %d", domain.ErrTokenRefreshFailed, resp.StatusCode)
 	}
 
 	var tokenResp struct {
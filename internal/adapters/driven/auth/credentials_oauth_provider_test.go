@@ -0,0 +1,349 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// mockCredentialsStore implements Get and Save of driven.CredentialsStore for testing.
+type mockCredentialsStore struct {
+	driven.CredentialsStore
+	mu    sync.Mutex
+	creds domain.Credentials
+	saves int
+}
+
+func (m *mockCredentialsStore) Get(_ context.Context, id string) (*domain.Credentials, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if id != m.creds.ID {
+		return nil, domain.ErrNotFound
+	}
+	creds := m.creds
+	oauth := *creds.OAuth
+	creds.OAuth = &oauth
+	return &creds, nil
+}
+
+func (m *mockCredentialsStore) Save(_ context.Context, creds domain.Credentials) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.creds = creds
+	m.saves++
+	return nil
+}
+
+// mockAuthProviderStore implements Get of driven.AuthProviderStore for testing.
+type mockAuthProviderStore struct {
+	driven.AuthProviderStore
+	provider domain.AuthProvider
+}
+
+func (m *mockAuthProviderStore) Get(_ context.Context, id string) (*domain.AuthProvider, error) {
+	if id != m.provider.ID {
+		return nil, domain.ErrNotFound
+	}
+	provider := m.provider
+	return &provider, nil
+}
+
+// mockTokenRefresher implements driven.TokenRefresher for testing.
+type mockTokenRefresher struct {
+	calls         atomic.Int32
+	connectorType string
+	refreshToken  string
+	token         *domain.OAuthToken
+	err           error
+	// release, if set, blocks refreshes until closed
+	release chan struct{}
+}
+
+func (m *mockTokenRefresher) RefreshToken(
+	_ context.Context, connectorType string, _ *domain.AuthProvider, refreshToken string,
+) (*domain.OAuthToken, error) {
+	m.calls.Add(1)
+	m.connectorType = connectorType
+	m.refreshToken = refreshToken
+	if m.release != nil {
+		<-m.release
+	}
+	return m.token, m.err
+}
+
+// newTestOAuthProvider returns a provider for credentials expiring at expiry
+// that refreshes through refresher.
+func newTestOAuthProvider(
+	expiry time.Time, refresher *mockTokenRefresher,
+) (*CredentialsOAuthProvider, *mockCredentialsStore) {
+	store := &mockCredentialsStore{creds: domain.Credentials{
+		ID: "creds-1",
+		OAuth: &domain.OAuthCredentials{
+			AccessToken:  "old-access",
+			RefreshToken: "old-refresh",
+			TokenType:    "Bearer",
+			Expiry:       expiry,
+		},
+	}}
+	providers := &mockAuthProviderStore{provider: domain.AuthProvider{
+		ID:    "auth-1",
+		OAuth: &domain.OAuthProviderConfig{ClientID: "client"},
+	}}
+	p := NewCredentialsOAuthProvider("creds-1", store, "auth-1", providers)
+	p.SetTokenRefresher("outlook", refresher)
+	return p, store
+}
+
+func TestCredentialsOAuthProvider_ValidTokenNotRefreshed(t *testing.T) {
+	refresher := &mockTokenRefresher{}
+	p, store := newTestOAuthProvider(time.Now().Add(time.Hour), refresher)
+
+	token, err := p.GetToken(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "old-access", token)
+	assert.Zero(t, refresher.calls.Load())
+	assert.Zero(t, store.saves)
+}
+
+func TestCredentialsOAuthProvider_RefreshesNearExpiry(t *testing.T) {
+	expiry := time.Now().Add(time.Hour)
+	refresher := &mockTokenRefresher{token: &domain.OAuthToken{
+		AccessToken:  "new-access",
+		RefreshToken: "new-refresh",
+		TokenType:    "Bearer",
+		Expiry:       expiry,
+	}}
+	p, store := newTestOAuthProvider(time.Now().Add(time.Minute), refresher)
+
+	token, err := p.GetToken(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "new-access", token)
+	assert.Equal(t, "outlook", refresher.connectorType)
+	assert.Equal(t, "old-refresh", refresher.refreshToken)
+	assert.Equal(t, 1, store.saves)
+	assert.Equal(t, "new-access", store.creds.OAuth.AccessToken)
+	assert.Equal(t, "new-refresh", store.creds.OAuth.RefreshToken)
+	assert.True(t, store.creds.OAuth.Expiry.Equal(expiry))
+
+	// The refreshed token is served from the cache
+	token, err = p.GetToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "new-access", token)
+	assert.Equal(t, int32(1), refresher.calls.Load())
+}
+
+func TestCredentialsOAuthProvider_KeepsRefreshTokenWhenNotRotated(t *testing.T) {
+	refresher := &mockTokenRefresher{token: &domain.OAuthToken{
+		AccessToken: "new-access",
+		Expiry:      time.Now().Add(time.Hour),
+	}}
+	p, store := newTestOAuthProvider(time.Now().Add(-time.Minute), refresher)
+
+	require.NoError(t, p.RefreshIfNeeded(context.Background()))
+
+	assert.Equal(t, "old-refresh", store.creds.OAuth.RefreshToken)
+}
+
+func TestCredentialsOAuthProvider_RefreshFailureIsAuthInvalid(t *testing.T) {
+	rejected := fmt.Errorf("%w: invalid_grant", domain.ErrAuthExpired)
+	refresher := &mockTokenRefresher{err: rejected}
+	p, store := newTestOAuthProvider(time.Now().Add(-time.Minute), refresher)
+
+	_, err := p.GetToken(context.Background())
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrAuthInvalid)
+	assert.ErrorIs(t, err, domain.ErrAuthExpired)
+	assert.Zero(t, store.saves)
+
+	// A failed refresh is not cached
+	_, err = p.GetToken(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, int32(2), refresher.calls.Load())
+}
+
+func TestCredentialsOAuthProvider_EmptyRefreshResponse(t *testing.T) {
+	refresher := &mockTokenRefresher{token: &domain.OAuthToken{}}
+	p, _ := newTestOAuthProvider(time.Now().Add(-time.Minute), refresher)
+
+	err := p.RefreshIfNeeded(context.Background())
+
+	assert.ErrorIs(t, err, domain.ErrAuthInvalid)
+	assert.ErrorIs(t, err, domain.ErrTokenRefreshFailed)
+}
+
+func TestCredentialsOAuthProvider_ConcurrentCallsShareRefresh(t *testing.T) {
+	refresher := &mockTokenRefresher{
+		token:   &domain.OAuthToken{AccessToken: "new-access", Expiry: time.Now().Add(time.Hour)},
+		release: make(chan struct{}),
+	}
+	p, store := newTestOAuthProvider(time.Now().Add(-time.Minute), refresher)
+
+	const callers = 10
+	tokens := make([]string, callers)
+	errs := make([]error, callers)
+	var wg sync.WaitGroup
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tokens[i], errs[i] = p.GetToken(context.Background())
+		}()
+	}
+
+	// Wait for the first refresh to start, then for the others to join it
+	require.Eventually(t, func() bool { return refresher.calls.Load() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	close(refresher.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), refresher.calls.Load())
+	assert.Equal(t, 1, store.saves)
+	for i := range callers {
+		require.NoError(t, errs[i])
+		assert.Equal(t, "new-access", tokens[i])
+	}
+}
+
+func TestCredentialsOAuthProvider_ConcurrentCallsShareFailure(t *testing.T) {
+	refresher := &mockTokenRefresher{err: errors.New("provider down"), release: make(chan struct{})}
+	p, _ := newTestOAuthProvider(time.Now().Add(-time.Minute), refresher)
+
+	errs := make(chan error, 2)
+	go func() {
+		_, err := p.GetToken(context.Background())
+		errs <- err
+	}()
+	require.Eventually(t, func() bool { return refresher.calls.Load() == 1 }, time.Second, time.Millisecond)
+	go func() { errs <- p.RefreshIfNeeded(context.Background()) }()
+	time.Sleep(20 * time.Millisecond)
+	close(refresher.release)
+
+	for range 2 {
+		assert.ErrorIs(t, <-errs, domain.ErrAuthInvalid)
+	}
+	assert.Equal(t, int32(1), refresher.calls.Load())
+}
+
+func TestCredentialsOAuthProvider_WaiterHonoursContext(t *testing.T) {
+	refresher := &mockTokenRefresher{
+		token:   &domain.OAuthToken{AccessToken: "new-access"},
+		release: make(chan struct{}),
+	}
+	p, _ := newTestOAuthProvider(time.Now().Add(-time.Minute), refresher)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = p.GetToken(context.Background())
+	}()
+	require.Eventually(t, func() bool { return refresher.calls.Load() == 1 }, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := p.GetToken(ctx)
+
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// Let the refresh finish so it is not shared with later tests
+	close(refresher.release)
+	<-done
+}
+
+func TestCredentialsOAuthProvider_ProvidersShareRefreshOfSameCredentials(t *testing.T) {
+	refresher := &mockTokenRefresher{
+		token:   &domain.OAuthToken{AccessToken: "new-access", Expiry: time.Now().Add(time.Hour)},
+		release: make(chan struct{}),
+	}
+	first, store := newTestOAuthProvider(time.Now().Add(-time.Minute), refresher)
+	// A second provider for the same credentials, as created for another
+	// source that shares them
+	second := NewCredentialsOAuthProvider("creds-1", store, "auth-1", first.authProviderStore)
+	second.SetTokenRefresher("outlook", refresher)
+
+	tokens := make(chan string, 2)
+	go func() {
+		token, _ := first.GetToken(context.Background())
+		tokens <- token
+	}()
+	require.Eventually(t, func() bool { return refresher.calls.Load() == 1 }, time.Second, time.Millisecond)
+	go func() {
+		token, _ := second.GetToken(context.Background())
+		tokens <- token
+	}()
+	time.Sleep(20 * time.Millisecond)
+	close(refresher.release)
+
+	for range 2 {
+		assert.Equal(t, "new-access", <-tokens)
+	}
+	assert.Equal(t, int32(1), refresher.calls.Load())
+	assert.Equal(t, 1, store.saves)
+}
+
+func TestFactory_SetsTokenRefresher(t *testing.T) {
+	store := &mockCredentialsStore{creds: domain.Credentials{
+		ID:    "creds-1",
+		OAuth: &domain.OAuthCredentials{AccessToken: "access"},
+	}}
+	refresher := &mockTokenRefresher{}
+	f := NewFactory(store, &mockAuthProviderStore{})
+	f.SetTokenRefresher(refresher)
+
+	tp, err := f.CreateTokenProvider(context.Background(), &domain.Source{
+		Type:           "gmail",
+		CredentialsID:  "creds-1",
+		AuthProviderID: "auth-1",
+	})
+
+	require.NoError(t, err)
+	oauth, ok := tp.(*CredentialsOAuthProvider)
+	require.True(t, ok)
+	assert.Equal(t, "gmail", oauth.connectorType)
+	assert.Same(t, refresher, oauth.refresher)
+}
+
+func TestCredentialsOAuthProvider_KeepRefreshesInMemory(t *testing.T) {
+	refresher := &mockTokenRefresher{token: &domain.OAuthToken{
+		AccessToken: "new-access",
+		Expiry:      time.Now().Add(time.Hour),
+	}}
+	p, store := newTestOAuthProvider(time.Now().Add(time.Minute), refresher)
+	p.KeepRefreshesInMemory()
+
+	token, err := p.GetToken(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "new-access", token)
+	assert.Zero(t, store.saves)
+	assert.Equal(t, "old-access", store.creds.OAuth.AccessToken)
+}
+
+func TestFactory_WithoutCredentialWrites(t *testing.T) {
+	store := &mockCredentialsStore{creds: domain.Credentials{
+		ID:    "creds-1",
+		OAuth: &domain.OAuthCredentials{AccessToken: "access"},
+	}}
+	f := NewFactory(store, &mockAuthProviderStore{})
+	source := &domain.Source{Type: "gmail", CredentialsID: "creds-1", AuthProviderID: "auth-1"}
+
+	tp, err := f.CreateTokenProvider(driven.WithoutCredentialWrites(context.Background()), source)
+	require.NoError(t, err)
+	assert.True(t, tp.(*CredentialsOAuthProvider).inMemory)
+
+	tp, err = f.CreateTokenProvider(context.Background(), source)
+	require.NoError(t, err)
+	assert.False(t, tp.(*CredentialsOAuthProvider).inMemory)
+}
@@ -54,6 +54,11 @@ func (p *CredentialsPATProvider) AuthMethod() domain.AuthMethod {
 	return domain.AuthMethodPAT
 }
 
+// RefreshIfNeeded is a no-op since PATs don't expire.
+func (p *CredentialsPATProvider) RefreshIfNeeded(_ context.Context) error {
+	return nil
+}
+
 // IsAuthenticated returns true if valid PAT credentials exist.
 func (p *CredentialsPATProvider) IsAuthenticated() bool {
 	creds, err := p.credentialsStore.Get(context.Background(), p.credentialsID)
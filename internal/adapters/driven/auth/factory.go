@@ -8,10 +8,14 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
+// Ensure Factory implements the TokenProviderFactory interface.
+var _ driven.TokenProviderFactory = (*Factory)(nil)
+
 // Factory creates TokenProviders for sources with credentials.
 type Factory struct {
 	credentialsStore  driven.CredentialsStore
 	authProviderStore driven.AuthProviderStore
+	refresher         driven.TokenRefresher
 }
 
 // NewFactory creates a token provider factory.
@@ -25,9 +29,17 @@ func NewFactory(
 	}
 }
 
+// SetTokenRefresher makes OAuth token providers created for a source refresh
+// tokens through the OAuth handler of the source's connector type.
+func (f *Factory) SetTokenRefresher(refresher driven.TokenRefresher) {
+	f.refresher = refresher
+}
+
 // CreateTokenProvider creates the appropriate TokenProvider for a source.
 // Uses the new Credentials system (CredentialsID + AuthProviderID).
-// Returns NullTokenProvider for sources without credentials.
+// Returns NullTokenProvider for sources without credentials. Under a context
+// from driven.WithoutCredentialWrites, OAuth providers keep refreshed tokens
+// in memory.
 func (f *Factory) CreateTokenProvider(ctx context.Context, source *domain.Source) (driven.TokenProvider, error) {
 	// Handle no-auth case
 	if source.CredentialsID == "" {
@@ -49,12 +61,19 @@ func (f *Factory) CreateTokenProvider(ctx context.Context, source *domain.Source
 		if source.AuthProviderID == "" {
 			return nil, fmt.Errorf("OAuth credentials require AuthProviderID")
 		}
-		return NewCredentialsOAuthProvider(
+		provider := NewCredentialsOAuthProvider(
 			source.CredentialsID,
 			f.credentialsStore,
 			source.AuthProviderID,
 			f.authProviderStore,
-		), nil
+		)
+		if f.refresher != nil {
+			provider.SetTokenRefresher(source.Type, f.refresher)
+		}
+		if driven.CredentialWritesDisabled(ctx) {
+			provider.KeepRefreshesInMemory()
+		}
+		return provider, nil
 	}
 
 	return NewNullTokenProvider(), nil
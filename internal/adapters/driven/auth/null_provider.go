@@ -34,6 +34,11 @@ func (p *NullTokenProvider) AuthMethod() domain.AuthMethod {
 	return domain.AuthMethodNone
 }
 
+// RefreshIfNeeded is a no-op since there are no tokens to refresh.
+func (p *NullTokenProvider) RefreshIfNeeded(_ context.Context) error {
+	return nil
+}
+
 // IsAuthenticated always returns true since no-auth is always "authenticated".
 func (p *NullTokenProvider) IsAuthenticated() bool {
 	return true
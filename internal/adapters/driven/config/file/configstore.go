@@ -91,6 +91,26 @@ func (s *ConfigStore) GetInt(key string) int {
 	}
 }
 
+// GetFloat retrieves a floating-point configuration value.
+func (s *ConfigStore) GetFloat(key string) float64 {
+	val, ok := s.Get(key)
+	if !ok {
+		return 0
+	}
+
+	// TOML floats are parsed as float64; whole numbers may be written as integers
+	switch v := val.(type) {
+	case float64:
+		return v
+	case int64:
+		return float64(v)
+	case int:
+		return float64(v)
+	default:
+		return 0
+	}
+}
+
 // GetBool retrieves a boolean configuration value.
 func (s *ConfigStore) GetBool(key string) bool {
 	val, ok := s.Get(key)
@@ -94,6 +94,28 @@ func TestConfigStore_GetInt(t *testing.T) {
 	assert.Equal(t, 0, val)
 }
 
+func TestConfigStore_GetFloat(t *testing.T) {
+	tmpDir := t.TempDir()
+	store, err := NewConfigStore(tmpDir)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Set("search.bm25_k1", 1.2))
+	require.NoError(t, store.Set("search.bm25_b", 1))
+
+	// Round trip through the TOML file
+	reloaded, err := NewConfigStore(tmpDir)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.2, reloaded.GetFloat("search.bm25_k1"), 1e-9)
+	assert.InDelta(t, 1.0, reloaded.GetFloat("search.bm25_b"), 1e-9)
+
+	// Non-existent key
+	assert.Zero(t, store.GetFloat("nonexistent"))
+
+	// Wrong type
+	require.NoError(t, store.Set("string_key", "1.2"))
+	assert.Zero(t, store.GetFloat("string_key"))
+}
+
 func TestConfigStore_GetBool(t *testing.T) {
 	tmpDir := t.TempDir()
 	store, err := NewConfigStore(tmpDir)
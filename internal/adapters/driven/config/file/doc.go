@@ -4,4 +4,5 @@
 // Adapters:
 //   - ConfigStore: TOML-based configuration storage
 //   - AuthorizationStore: JSON-based authorization persistence
+//   - SearchSessionStore: JSON-based persistence of the last TUI search
 package file
@@ -0,0 +1,100 @@
+package file
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure SearchSessionStore implements the interface.
+var _ driven.SearchSessionStore = (*SearchSessionStore)(nil)
+
+// searchSessionFile is the name of the file the last TUI search is kept in.
+const searchSessionFile = "tui_state.json"
+
+// SearchSessionStore keeps the last TUI search in a small JSON file within
+// the sercha config directory.
+type SearchSessionStore struct {
+	mu       sync.Mutex
+	filePath string
+}
+
+// NewSearchSessionStore creates a new JSON-based search session store.
+// If stateDir is empty, defaults to ~/.sercha/tui_state.json.
+func NewSearchSessionStore(stateDir string) (*SearchSessionStore, error) {
+	if stateDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		stateDir = filepath.Join(home, ".sercha")
+	}
+	return &SearchSessionStore{filePath: filepath.Join(stateDir, searchSessionFile)}, nil
+}
+
+// Load returns the saved session, or nil if there is none.
+func (s *SearchSessionStore) Load() (*domain.SearchSession, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var session domain.SearchSession
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", s.filePath, err)
+	}
+	return &session, nil
+}
+
+// Save replaces the saved session. The file is replaced atomically so an
+// interrupted write never leaves it half written.
+func (s *SearchSessionStore) Save(session domain.SearchSession) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.filePath), 0700); err != nil {
+		return err
+	}
+
+	tmp := s.filePath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, s.filePath); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+// Clear removes the saved session.
+func (s *SearchSessionStore) Clear() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.Remove(s.filePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Path returns the path of the session file.
+func (s *SearchSessionStore) Path() string {
+	return s.filePath
+}
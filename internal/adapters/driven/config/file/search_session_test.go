@@ -0,0 +1,67 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestSearchSessionStore_SaveThenLoad(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewSearchSessionStore(dir)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Save(domain.SearchSession{Query: "quarterly report", Selected: 3}))
+
+	// A new store, as on the next launch, reads the same session
+	reopened, err := NewSearchSessionStore(dir)
+	require.NoError(t, err)
+	session, err := reopened.Load()
+	require.NoError(t, err)
+	require.NotNil(t, session)
+	assert.Equal(t, "quarterly report", session.Query)
+	assert.Equal(t, 3, session.Selected)
+
+	info, err := os.Stat(store.Path())
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestSearchSessionStore_LoadWithoutSession(t *testing.T) {
+	store, err := NewSearchSessionStore(t.TempDir())
+	require.NoError(t, err)
+
+	session, err := store.Load()
+
+	require.NoError(t, err)
+	assert.Nil(t, session)
+}
+
+func TestSearchSessionStore_Clear(t *testing.T) {
+	store, err := NewSearchSessionStore(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, store.Save(domain.SearchSession{Query: "notes"}))
+
+	require.NoError(t, store.Clear())
+
+	session, err := store.Load()
+	require.NoError(t, err)
+	assert.Nil(t, session)
+	assert.NoError(t, store.Clear(), "clearing twice is not an error")
+}
+
+func TestSearchSessionStore_LoadCorruptFile(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, searchSessionFile), []byte("{not json"), 0600))
+	store, err := NewSearchSessionStore(dir)
+	require.NoError(t, err)
+
+	_, err = store.Load()
+
+	assert.Error(t, err)
+}
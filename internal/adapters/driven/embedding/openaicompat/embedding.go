@@ -0,0 +1,214 @@
+// Package openaicompat provides an embedding service adapter for servers
+// implementing the OpenAI embeddings API, such as Azure OpenAI, LM Studio
+// and Ollama's /v1 endpoint.
+package openaicompat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure EmbeddingService implements the interface.
+var _ driven.EmbeddingService = (*EmbeddingService)(nil)
+
+// DefaultTimeout is the default request timeout.
+const DefaultTimeout = 60 * time.Second
+
+// Config holds configuration for an OpenAI-compatible embedding service.
+type Config struct {
+	// BaseURL is the API base URL, e.g. http://localhost:1234/v1 (required).
+	// For Azure OpenAI, use the deployment URL including its api-version
+	// query, e.g. https://{resource}.openai.azure.com/openai/deployments/{name}?api-version=2024-02-01.
+	BaseURL string
+	// APIKey is sent as a bearer token, or as the api-key header for Azure
+	// OpenAI (optional; local servers usually need none).
+	APIKey string
+	// Model is the embedding model name (required).
+	Model string
+	// Dimensions is the size of the vectors the model returns (required).
+	Dimensions int
+	// Timeout is the request timeout (default: 60s).
+	Timeout time.Duration
+}
+
+// EmbeddingService generates embeddings using an OpenAI-compatible API.
+type EmbeddingService struct {
+	client     *http.Client
+	endpoint   string
+	apiKey     string
+	azure      bool
+	model      string
+	dimensions int
+}
+
+// embeddingRequest is the OpenAI API request format.
+type embeddingRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+// embeddingResponse is the OpenAI API response format.
+type embeddingResponse struct {
+	Data []struct {
+		Embedding []float64 `json:"embedding"`
+		Index     int       `json:"index"`
+	} `json:"data"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// NewEmbeddingService creates a new OpenAI-compatible embedding service.
+func NewEmbeddingService(cfg Config) (*EmbeddingService, error) {
+	if cfg.Model == "" {
+		return nil, fmt.Errorf("openai-compatible: model is required")
+	}
+	if cfg.Dimensions <= 0 {
+		return nil, fmt.Errorf("openai-compatible: dimensions are required")
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+
+	base, err := url.Parse(cfg.BaseURL)
+	if err != nil || (base.Scheme != "http" && base.Scheme != "https") || base.Host == "" {
+		return nil, fmt.Errorf("openai-compatible: invalid base URL %q", cfg.BaseURL)
+	}
+
+	// Append the endpoint to the path, keeping any query (Azure's api-version)
+	endpoint := *base
+	endpoint.Path = strings.TrimSuffix(base.Path, "/") + "/embeddings"
+
+	return &EmbeddingService{
+		client:     &http.Client{Timeout: cfg.Timeout},
+		endpoint:   endpoint.String(),
+		apiKey:     cfg.APIKey,
+		azure:      strings.Contains(base.Path, "/openai/deployments/"),
+		model:      cfg.Model,
+		dimensions: cfg.Dimensions,
+	}, nil
+}
+
+// Embed generates a vector embedding for the given text.
+func (s *EmbeddingService) Embed(ctx context.Context, text string) ([]float32, error) {
+	embeddings, err := s.EmbedBatch(ctx, []string{text})
+	if err != nil {
+		return nil, err
+	}
+	if len(embeddings) == 0 {
+		return nil, fmt.Errorf("openai-compatible: no embedding returned")
+	}
+	return embeddings[0], nil
+}
+
+// EmbedBatch generates embeddings for multiple texts in one request.
+func (s *EmbeddingService) EmbedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+
+	jsonBody, err := json.Marshal(embeddingRequest{Model: s.model, Input: texts})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	s.setAuth(req)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("openai-compatible error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var embedResp embeddingResponse
+	if err := json.Unmarshal(body, &embedResp); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	if embedResp.Error != nil {
+		return nil, fmt.Errorf("openai-compatible error: %s", embedResp.Error.Message)
+	}
+	if len(embedResp.Data) != len(texts) {
+		return nil, fmt.Errorf("openai-compatible: got %d embeddings for %d inputs", len(embedResp.Data), len(texts))
+	}
+
+	// Convert float64 to float32 and order by index
+	embeddings := make([][]float32, len(texts))
+	for _, data := range embedResp.Data {
+		if data.Index < 0 || data.Index >= len(texts) {
+			return nil, fmt.Errorf("openai-compatible: embedding index %d out of range", data.Index)
+		}
+		// A mismatch would corrupt the vector index, so reject it
+		if len(data.Embedding) != s.dimensions {
+			return nil, fmt.Errorf("openai-compatible: model %s returned %d dimensions, configured %d",
+				s.model, len(data.Embedding), s.dimensions)
+		}
+		embedding := make([]float32, len(data.Embedding))
+		for i, v := range data.Embedding {
+			embedding[i] = float32(v)
+		}
+		embeddings[data.Index] = embedding
+	}
+
+	return embeddings, nil
+}
+
+// setAuth adds the API key to the request, if one is configured.
+func (s *EmbeddingService) setAuth(req *http.Request) {
+	if s.apiKey == "" {
+		return
+	}
+	if s.azure {
+		req.Header.Set("api-key", s.apiKey)
+		return
+	}
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+}
+
+// Dimensions returns the embedding vector size.
+func (s *EmbeddingService) Dimensions() int {
+	return s.dimensions
+}
+
+// ModelName returns the name of the embedding model being used.
+func (s *EmbeddingService) ModelName() string {
+	return s.model
+}
+
+// Ping validates the service by embedding a short probe text.
+// Compatible servers do not reliably implement /models, and embedding
+// also checks the model exists and returns the configured dimensions.
+func (s *EmbeddingService) Ping(ctx context.Context) error {
+	if _, err := s.Embed(ctx, "ping"); err != nil {
+		return fmt.Errorf("openai-compatible: ping failed: %w", err)
+	}
+	return nil
+}
+
+// Close releases resources.
+func (s *EmbeddingService) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
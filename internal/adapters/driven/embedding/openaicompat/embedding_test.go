@@ -0,0 +1,164 @@
+package openaicompat
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// capturedRequest is what the stub server received.
+type capturedRequest struct {
+	path    string
+	query   string
+	headers http.Header
+	body    embeddingRequest
+}
+
+// newStubServer returns a server answering embedding requests with vectors
+// of the given size, in reverse index order, and the request it received.
+func newStubServer(t *testing.T, dimensions int) (*httptest.Server, *capturedRequest) {
+	t.Helper()
+	captured := &capturedRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		captured.path = r.URL.Path
+		captured.query = r.URL.RawQuery
+		captured.headers = r.Header.Clone()
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&captured.body))
+
+		type item struct {
+			Index     int       `json:"index"`
+			Embedding []float64 `json:"embedding"`
+		}
+		var data []item
+		for i := len(captured.body.Input) - 1; i >= 0; i-- {
+			vec := make([]float64, dimensions)
+			vec[0] = float64(i)
+			data = append(data, item{Index: i, Embedding: vec})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+	t.Cleanup(server.Close)
+	return server, captured
+}
+
+func TestNewEmbeddingService_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+	}{
+		{"missing model", Config{BaseURL: "http://localhost:1234/v1", Dimensions: 3}},
+		{"missing dimensions", Config{BaseURL: "http://localhost:1234/v1", Model: "m"}},
+		{"missing base URL", Config{Model: "m", Dimensions: 3}},
+		{"relative base URL", Config{BaseURL: "localhost:1234/v1", Model: "m", Dimensions: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewEmbeddingService(tt.cfg)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestEmbedBatch_RequestConstruction(t *testing.T) {
+	server, captured := newStubServer(t, 3)
+	svc, err := NewEmbeddingService(Config{
+		BaseURL:    server.URL + "/v1/",
+		APIKey:     "sk-local",
+		Model:      "nomic-embed-text",
+		Dimensions: 3,
+	})
+	require.NoError(t, err)
+	defer svc.Close()
+
+	embeddings, err := svc.EmbedBatch(context.Background(), []string{"first", "second"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "/v1/embeddings", captured.path)
+	assert.Equal(t, "Bearer sk-local", captured.headers.Get("Authorization"))
+	assert.Empty(t, captured.headers.Get("api-key"))
+	assert.Equal(t, "application/json", captured.headers.Get("Content-Type"))
+	assert.Equal(t, "nomic-embed-text", captured.body.Model)
+	assert.Equal(t, []string{"first", "second"}, captured.body.Input)
+
+	// Results are ordered by index, not response order
+	require.Len(t, embeddings, 2)
+	assert.Equal(t, []float32{0, 0, 0}, embeddings[0])
+	assert.Equal(t, []float32{1, 0, 0}, embeddings[1])
+	assert.Equal(t, 3, svc.Dimensions())
+	assert.Equal(t, "nomic-embed-text", svc.ModelName())
+}
+
+func TestEmbedBatch_NoAPIKey(t *testing.T) {
+	server, captured := newStubServer(t, 3)
+	svc, err := NewEmbeddingService(Config{BaseURL: server.URL + "/v1", Model: "m", Dimensions: 3})
+	require.NoError(t, err)
+
+	_, err = svc.Embed(context.Background(), "text")
+	require.NoError(t, err)
+
+	assert.Empty(t, captured.headers.Get("Authorization"))
+}
+
+func TestEmbedBatch_AzureDeployment(t *testing.T) {
+	server, captured := newStubServer(t, 3)
+	svc, err := NewEmbeddingService(Config{
+		BaseURL:    server.URL + "/openai/deployments/embed?api-version=2024-02-01",
+		APIKey:     "azure-key",
+		Model:      "text-embedding-3-small",
+		Dimensions: 3,
+	})
+	require.NoError(t, err)
+
+	_, err = svc.Embed(context.Background(), "text")
+	require.NoError(t, err)
+
+	assert.Equal(t, "/openai/deployments/embed/embeddings", captured.path)
+	assert.Equal(t, "api-version=2024-02-01", captured.query)
+	assert.Equal(t, "azure-key", captured.headers.Get("api-key"))
+	assert.Empty(t, captured.headers.Get("Authorization"))
+}
+
+func TestEmbedBatch_DimensionMismatch(t *testing.T) {
+	server, _ := newStubServer(t, 4)
+	svc, err := NewEmbeddingService(Config{BaseURL: server.URL, Model: "m", Dimensions: 3})
+	require.NoError(t, err)
+
+	_, err = svc.Embed(context.Background(), "text")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "returned 4 dimensions, configured 3")
+	assert.Error(t, svc.Ping(context.Background()))
+}
+
+func TestEmbedBatch_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"message":"model not found"}}`))
+	}))
+	defer server.Close()
+	svc, err := NewEmbeddingService(Config{BaseURL: server.URL, Model: "missing", Dimensions: 3})
+	require.NoError(t, err)
+
+	_, err = svc.Embed(context.Background(), "text")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 404")
+	assert.Contains(t, err.Error(), "model not found")
+}
+
+func TestEmbedBatch_Empty(t *testing.T) {
+	svc, err := NewEmbeddingService(Config{BaseURL: "http://localhost:1/v1", Model: "m", Dimensions: 3})
+	require.NoError(t, err)
+
+	embeddings, err := svc.EmbedBatch(context.Background(), nil)
+
+	assert.NoError(t, err)
+	assert.Nil(t, embeddings)
+}
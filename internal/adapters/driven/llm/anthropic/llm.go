@@ -2,6 +2,7 @@
 package anthropic
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -27,6 +28,9 @@ const (
 	anthropicVersion = "2023-06-01"
 )
 
+// maxStreamLineSize is the longest server-sent event line accepted.
+const maxStreamLineSize = 1024 * 1024
+
 // Config holds configuration for the Anthropic LLM service.
 type Config struct {
 	// APIKey is the Anthropic API key (required).
@@ -59,6 +63,7 @@ type messagesRequest struct {
 	System      string            `json:"system,omitempty"`
 	Temperature float64           `json:"temperature,omitempty"`
 	StopSeqs    []string          `json:"stop_sequences,omitempty"`
+	Stream      bool              `json:"stream,omitempty"`
 }
 
 // messagesMessage is the Anthropic message format.
@@ -84,6 +89,20 @@ type messagesResponse struct {
 	} `json:"error,omitempty"`
 }
 
+// streamEvent is the data of one server-sent event of a streamed
+// /v1/messages response.
+type streamEvent struct {
+	Type  string `json:"type"`
+	Delta struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"delta"`
+	Error *struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
 // NewLLMService creates a new Anthropic LLM service.
 func NewLLMService(cfg Config) (*LLMService, error) {
 	if cfg.APIKey == "" {
@@ -123,7 +142,30 @@ func (s *LLMService) Generate(ctx context.Context, prompt string, opts driven.Ge
 
 // Chat conducts a multi-turn conversation.
 func (s *LLMService) Chat(ctx context.Context, messages []driven.ChatMessage, opts driven.ChatOptions) (string, error) {
-	// Extract system message if present
+	systemPrompt, chatMessages := splitSystemPrompt(messages)
+	return s.sendMessages(ctx, systemPrompt, chatMessages, opts, nil)
+}
+
+// ChatStream conducts a multi-turn conversation, streaming the reply.
+func (s *LLMService) ChatStream(
+	ctx context.Context, messages []driven.ChatMessage, opts driven.ChatOptions,
+) (<-chan string, <-chan error) {
+	chunks := make(chan string)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(chunks)
+		systemPrompt, chatMessages := splitSystemPrompt(messages)
+		errs <- s.streamMessages(ctx, systemPrompt, chatMessages, opts, chunks)
+	}()
+
+	return chunks, errs
+}
+
+// splitSystemPrompt extracts the system message, which Anthropic takes as a
+// request field rather than a conversation turn.
+func splitSystemPrompt(messages []driven.ChatMessage) (string, []driven.ChatMessage) {
 	var systemPrompt string
 	var chatMessages []driven.ChatMessage
 
@@ -135,63 +177,87 @@ func (s *LLMService) Chat(ctx context.Context, messages []driven.ChatMessage, op
 		}
 	}
 
-	return s.sendMessages(ctx, systemPrompt, chatMessages, opts, nil)
+	return systemPrompt, chatMessages
 }
 
-// sendMessages is the internal implementation for both Generate and Chat.
-func (s *LLMService) sendMessages(
+// streamMessages sends a streamed messages request, forwarding text deltas
+// to chunks until the server sends message_stop.
+func (s *LLMService) streamMessages(
 	ctx context.Context,
 	systemPrompt string,
 	messages []driven.ChatMessage,
 	opts driven.ChatOptions,
-	stopWords []string,
-) (string, error) {
-	// Convert driven.ChatMessage to internal format
-	apiMessages := make([]messagesMessage, len(messages))
-	for i, msg := range messages {
-		apiMessages[i] = messagesMessage{
-			Role:    msg.Role,
-			Content: msg.Content,
-		}
+	chunks chan<- string,
+) error {
+	req, err := s.newMessagesRequest(ctx, systemPrompt, messages, opts, nil, true)
+	if err != nil {
+		return err
 	}
 
-	// Anthropic requires max_tokens to be set
-	maxTokens := opts.MaxTokens
-	if maxTokens == 0 {
-		maxTokens = 1024 // Default
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
 	}
+	defer resp.Body.Close()
 
-	reqBody := messagesRequest{
-		Model:     s.model,
-		Messages:  apiMessages,
-		MaxTokens: maxTokens,
-		System:    systemPrompt,
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("anthropic error (status %d): failed to read response", resp.StatusCode)
+		}
+		return fmt.Errorf("anthropic error (status %d): %s", resp.StatusCode, string(body))
 	}
 
-	if opts.Temperature > 0 {
-		reqBody.Temperature = opts.Temperature
-	}
-	if len(stopWords) > 0 {
-		reqBody.StopSeqs = stopWords
-	}
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLineSize)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue
+		}
 
-	jsonBody, err := json.Marshal(reqBody)
-	if err != nil {
-		return "", fmt.Errorf("marshal request: %w", err)
+		var event streamEvent
+		if err := json.Unmarshal([]byte(strings.TrimSpace(data)), &event); err != nil {
+			return fmt.Errorf("decode stream event: %w", err)
+		}
+
+		switch event.Type {
+		case "error":
+			if event.Error != nil {
+				return fmt.Errorf("anthropic error: %s", event.Error.Message)
+			}
+			return fmt.Errorf("anthropic: stream error")
+		case "message_stop":
+			return nil
+		case "content_block_delta":
+			if event.Delta.Type != "text_delta" || event.Delta.Text == "" {
+				continue
+			}
+			select {
+			case chunks <- event.Delta.Text:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read stream: %w", err)
 	}
+	return fmt.Errorf("anthropic: stream ended before completion")
+}
 
-	req, err := http.NewRequestWithContext(
-		ctx,
-		http.MethodPost,
-		s.baseURL+"/v1/messages",
-		bytes.NewReader(jsonBody),
-	)
+// sendMessages is the internal implementation for both Generate and Chat.
+func (s *LLMService) sendMessages(
+	ctx context.Context,
+	systemPrompt string,
+	messages []driven.ChatMessage,
+	opts driven.ChatOptions,
+	stopWords []string,
+) (string, error) {
+	req, err := s.newMessagesRequest(ctx, systemPrompt, messages, opts, stopWords, false)
 	if err != nil {
-		return "", fmt.Errorf("create request: %w", err)
+		return "", err
 	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("x-api-key", s.apiKey)
-	req.Header.Set("anthropic-version", anthropicVersion)
 
 	resp, err := s.client.Do(req)
 	if err != nil {
@@ -232,6 +298,65 @@ func (s *LLMService) sendMessages(
 	return result.String(), nil
 }
 
+// newMessagesRequest builds a /v1/messages request.
+func (s *LLMService) newMessagesRequest(
+	ctx context.Context,
+	systemPrompt string,
+	messages []driven.ChatMessage,
+	opts driven.ChatOptions,
+	stopWords []string,
+	stream bool,
+) (*http.Request, error) {
+	// Convert driven.ChatMessage to internal format
+	apiMessages := make([]messagesMessage, len(messages))
+	for i, msg := range messages {
+		apiMessages[i] = messagesMessage{
+			Role:    msg.Role,
+			Content: msg.Content,
+		}
+	}
+
+	// Anthropic requires max_tokens to be set
+	maxTokens := opts.MaxTokens
+	if maxTokens == 0 {
+		maxTokens = 1024 // Default
+	}
+
+	reqBody := messagesRequest{
+		Model:     s.model,
+		Messages:  apiMessages,
+		MaxTokens: maxTokens,
+		System:    systemPrompt,
+		Stream:    stream,
+	}
+
+	if opts.Temperature > 0 {
+		reqBody.Temperature = opts.Temperature
+	}
+	if len(stopWords) > 0 {
+		reqBody.StopSeqs = stopWords
+	}
+
+	jsonBody, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		s.baseURL+"/v1/messages",
+		bytes.NewReader(jsonBody),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", s.apiKey)
+	req.Header.Set("anthropic-version", anthropicVersion)
+	return req, nil
+}
+
 // defaultQueryRewritePrompt is the fallback prompt when no PromptStore is configured.
 const defaultQueryRewritePrompt = `Rewrite this search query to improve recall. Add synonyms and fix typos.
 Return ONLY the rewritten query, nothing else.
@@ -0,0 +1,101 @@
+package anthropic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// writeEvent writes one server-sent event.
+func writeEvent(w http.ResponseWriter, event string, data any) {
+	body, _ := json.Marshal(data)
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, body)
+	w.(http.Flusher).Flush()
+}
+
+// newStreamServer returns a server streaming chunks as text deltas, and
+// the request body it received.
+func newStreamServer(t *testing.T, chunks ...string) (*httptest.Server, *messagesRequest) {
+	t.Helper()
+	received := &messagesRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/messages", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(received))
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		writeEvent(w, "message_start", map[string]any{"type": "message_start"})
+		writeEvent(w, "content_block_start", map[string]any{"type": "content_block_start", "index": 0})
+		for _, chunk := range chunks {
+			writeEvent(w, "content_block_delta", map[string]any{
+				"type": "content_block_delta", "index": 0,
+				"delta": map[string]string{"type": "text_delta", "text": chunk},
+			})
+		}
+		writeEvent(w, "ping", map[string]any{"type": "ping"})
+		writeEvent(w, "content_block_stop", map[string]any{"type": "content_block_stop", "index": 0})
+		writeEvent(w, "message_stop", map[string]any{"type": "message_stop"})
+	}))
+	t.Cleanup(server.Close)
+	return server, received
+}
+
+func TestChatStream_EmitsChunksInOrder(t *testing.T) {
+	server, received := newStreamServer(t, "Sercha ", "indexes ", "your ", "files.")
+	svc, err := NewLLMService(Config{APIKey: "key", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	chunks, errs := svc.ChatStream(context.Background(), []driven.ChatMessage{
+		{Role: "system", Content: "Answer briefly."},
+		{Role: "user", Content: "What is Sercha?"},
+	}, driven.ChatOptions{})
+
+	var got []string
+	for chunk := range chunks {
+		got = append(got, chunk)
+	}
+	require.NoError(t, <-errs)
+	assert.Equal(t, []string{"Sercha ", "indexes ", "your ", "files."}, got)
+	assert.True(t, received.Stream)
+	assert.Equal(t, "Answer briefly.", received.System)
+	require.Len(t, received.Messages, 1)
+	assert.Equal(t, "What is Sercha?", received.Messages[0].Content)
+}
+
+func TestChatStream_Collect(t *testing.T) {
+	server, _ := newStreamServer(t, "one ", "two ", "three")
+	svc, err := NewLLMService(Config{APIKey: "key", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	reply, err := driven.CollectStream(svc.ChatStream(context.Background(),
+		[]driven.ChatMessage{{Role: "user", Content: "count"}}, driven.ChatOptions{}))
+
+	require.NoError(t, err)
+	assert.Equal(t, "one two three", reply)
+}
+
+func TestChatStream_ErrorEvent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeEvent(w, "content_block_delta", map[string]any{
+			"type": "content_block_delta", "delta": map[string]string{"type": "text_delta", "text": "partial"},
+		})
+		writeEvent(w, "error", map[string]any{
+			"type": "error", "error": map[string]string{"type": "overloaded_error", "message": "Overloaded"},
+		})
+	}))
+	defer server.Close()
+	svc, err := NewLLMService(Config{APIKey: "key", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	_, err = driven.CollectStream(svc.ChatStream(context.Background(), nil, driven.ChatOptions{}))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Overloaded")
+}
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -79,10 +80,12 @@ type chatMessage struct {
 	Content string `json:"content"`
 }
 
-// chatResponse is the Ollama /api/chat response format.
+// chatResponse is the Ollama /api/chat response format. Streamed replies
+// send one per line, the last with Done set.
 type chatResponse struct {
 	Message chatMessage `json:"message"`
 	Done    bool        `json:"done"`
+	Error   string      `json:"error,omitempty"`
 }
 
 // NewLLMService creates a new Ollama LLM service.
@@ -162,6 +165,107 @@ func (s *LLMService) Generate(ctx context.Context, prompt string, opts driven.Ge
 
 // Chat conducts a multi-turn conversation.
 func (s *LLMService) Chat(ctx context.Context, messages []driven.ChatMessage, opts driven.ChatOptions) (string, error) {
+	req, err := s.newChatRequest(ctx, messages, opts, false)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return "", fmt.Errorf("ollama error (status %d): failed to read response", resp.StatusCode)
+		}
+		return "", fmt.Errorf("ollama error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var chatResp chatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&chatResp); err != nil {
+		return "", fmt.Errorf("decode response: %w", err)
+	}
+
+	return chatResp.Message.Content, nil
+}
+
+// ChatStream conducts a multi-turn conversation, streaming the reply.
+func (s *LLMService) ChatStream(
+	ctx context.Context, messages []driven.ChatMessage, opts driven.ChatOptions,
+) (<-chan string, <-chan error) {
+	chunks := make(chan string)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(chunks)
+		errs <- s.streamChat(ctx, messages, opts, chunks)
+	}()
+
+	return chunks, errs
+}
+
+// streamChat sends a streamed chat request, forwarding message content to
+// chunks until Ollama reports the reply is done.
+func (s *LLMService) streamChat(
+	ctx context.Context,
+	messages []driven.ChatMessage,
+	opts driven.ChatOptions,
+	chunks chan<- string,
+) error {
+	req, err := s.newChatRequest(ctx, messages, opts, true)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("ollama error (status %d): failed to read response", resp.StatusCode)
+		}
+		return fmt.Errorf("ollama error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	// The streamed reply is one JSON object per line
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var chatResp chatResponse
+		if err := decoder.Decode(&chatResp); err != nil {
+			if errors.Is(err, io.EOF) {
+				return fmt.Errorf("ollama: stream ended before completion")
+			}
+			return fmt.Errorf("decode stream: %w", err)
+		}
+		if chatResp.Error != "" {
+			return fmt.Errorf("ollama error: %s", chatResp.Error)
+		}
+
+		if chatResp.Message.Content != "" {
+			select {
+			case chunks <- chatResp.Message.Content:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if chatResp.Done {
+			return nil
+		}
+	}
+}
+
+// newChatRequest builds an /api/chat request.
+func (s *LLMService) newChatRequest(
+	ctx context.Context, messages []driven.ChatMessage, opts driven.ChatOptions, stream bool,
+) (*http.Request, error) {
 	// Convert driven.ChatMessage to internal format
 	chatMessages := make([]chatMessage, len(messages))
 	for i, msg := range messages {
@@ -174,7 +278,7 @@ func (s *LLMService) Chat(ctx context.Context, messages []driven.ChatMessage, op
 	reqBody := chatRequest{
 		Model:    s.model,
 		Messages: chatMessages,
-		Stream:   false,
+		Stream:   stream,
 	}
 
 	if opts.MaxTokens > 0 || opts.Temperature > 0 {
@@ -186,7 +290,7 @@ func (s *LLMService) Chat(ctx context.Context, messages []driven.ChatMessage, op
 
 	jsonBody, err := json.Marshal(reqBody)
 	if err != nil {
-		return "", fmt.Errorf("marshal request: %w", err)
+		return nil, fmt.Errorf("marshal request: %w", err)
 	}
 
 	req, err := http.NewRequestWithContext(
@@ -196,30 +300,10 @@ func (s *LLMService) Chat(ctx context.Context, messages []driven.ChatMessage, op
 		bytes.NewReader(jsonBody),
 	)
 	if err != nil {
-		return "", fmt.Errorf("create request: %w", err)
+		return nil, fmt.Errorf("create request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := s.client.Do(req)
-	if err != nil {
-		return "", fmt.Errorf("send request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return "", fmt.Errorf("ollama error (status %d): failed to read response", resp.StatusCode)
-		}
-		return "", fmt.Errorf("ollama error (status %d): %s", resp.StatusCode, string(body))
-	}
-
-	var chatResp chatResponse
-	if err := json.NewDecoder(resp.Body).Decode(&chatResp); err != nil {
-		return "", fmt.Errorf("decode response: %w", err)
-	}
-
-	return chatResp.Message.Content, nil
+	return req, nil
 }
 
 // defaultQueryRewritePrompt is the fallback prompt when no PromptStore is configured.
@@ -0,0 +1,91 @@
+package ollama
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// newStreamServer returns a server streaming chunks as newline-delimited
+// chat responses, and the request body it received.
+func newStreamServer(t *testing.T, chunks ...string) (*httptest.Server, *chatRequest) {
+	t.Helper()
+	received := &chatRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/chat", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(received))
+
+		encoder := json.NewEncoder(w)
+		for _, chunk := range chunks {
+			_ = encoder.Encode(chatResponse{Message: chatMessage{Role: "assistant", Content: chunk}})
+			w.(http.Flusher).Flush()
+		}
+		_ = encoder.Encode(chatResponse{Message: chatMessage{Role: "assistant"}, Done: true})
+	}))
+	t.Cleanup(server.Close)
+	return server, received
+}
+
+func TestChatStream_EmitsChunksInOrder(t *testing.T) {
+	server, received := newStreamServer(t, "Sercha ", "indexes ", "your ", "files.")
+	svc := NewLLMService(LLMConfig{BaseURL: server.URL})
+
+	chunks, errs := svc.ChatStream(context.Background(),
+		[]driven.ChatMessage{{Role: "user", Content: "What is Sercha?"}}, driven.ChatOptions{})
+
+	var got []string
+	for chunk := range chunks {
+		got = append(got, chunk)
+	}
+	require.NoError(t, <-errs)
+	assert.Equal(t, []string{"Sercha ", "indexes ", "your ", "files."}, got)
+	assert.True(t, received.Stream)
+}
+
+func TestChatStream_Collect(t *testing.T) {
+	server, _ := newStreamServer(t, "one ", "two ", "three")
+	svc := NewLLMService(LLMConfig{BaseURL: server.URL})
+
+	reply, err := driven.CollectStream(svc.ChatStream(context.Background(),
+		[]driven.ChatMessage{{Role: "user", Content: "count"}}, driven.ChatOptions{}))
+
+	require.NoError(t, err)
+	assert.Equal(t, "one two three", reply)
+}
+
+func TestChatStream_ErrorLine(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintln(w, `{"message":{"role":"assistant","content":"partial"},"done":false}`)
+		fmt.Fprintln(w, `{"error":"model unloaded"}`)
+	}))
+	defer server.Close()
+	svc := NewLLMService(LLMConfig{BaseURL: server.URL})
+
+	_, err := driven.CollectStream(svc.ChatStream(context.Background(), nil, driven.ChatOptions{}))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "model unloaded")
+}
+
+func TestChatStream_Cancelled(t *testing.T) {
+	server, _ := newStreamServer(t, "one ", "two ", "three")
+	svc := NewLLMService(LLMConfig{BaseURL: server.URL})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	chunks, errs := svc.ChatStream(ctx, nil, driven.ChatOptions{})
+	assert.Equal(t, "one ", <-chunks)
+	cancel()
+
+	// With no reader, the stream stops on cancellation and closes chunks
+	assert.ErrorIs(t, <-errs, context.Canceled)
+	_, open := <-chunks
+	assert.False(t, open)
+}
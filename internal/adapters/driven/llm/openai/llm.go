@@ -2,6 +2,7 @@
 package openai
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -24,6 +25,9 @@ const (
 	DefaultLLMTimeout = 120 * time.Second
 )
 
+// maxStreamLineSize is the longest server-sent event line accepted.
+const maxStreamLineSize = 1024 * 1024
+
 // LLMConfig holds configuration for the OpenAI LLM service.
 type LLMConfig struct {
 	// APIKey is the OpenAI API key (required).
@@ -56,6 +60,7 @@ type chatCompletionRequest struct {
 	MaxTokens   int                 `json:"max_tokens,omitempty"`
 	Temperature float64             `json:"temperature,omitempty"`
 	Stop        []string            `json:"stop,omitempty"`
+	Stream      bool                `json:"stream,omitempty"`
 }
 
 // chatCompletionMsg is the OpenAI chat message format.
@@ -83,6 +88,18 @@ type chatCompletionResponse struct {
 	} `json:"error,omitempty"`
 }
 
+// chatCompletionChunk is one server-sent event of a streamed /chat/completions response.
+type chatCompletionChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
 // NewLLMService creates a new OpenAI LLM service.
 func NewLLMService(cfg LLMConfig) (*LLMService, error) {
 	if cfg.APIKey == "" {
@@ -125,6 +142,84 @@ func (s *LLMService) Chat(ctx context.Context, messages []driven.ChatMessage, op
 	return s.chatCompletion(ctx, messages, opts, nil)
 }
 
+// ChatStream conducts a multi-turn conversation, streaming the reply.
+func (s *LLMService) ChatStream(
+	ctx context.Context, messages []driven.ChatMessage, opts driven.ChatOptions,
+) (<-chan string, <-chan error) {
+	chunks := make(chan string)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(chunks)
+		errs <- s.streamCompletion(ctx, messages, opts, chunks)
+	}()
+
+	return chunks, errs
+}
+
+// streamCompletion sends a streamed chat completion request, forwarding
+// content deltas to chunks until the server sends [DONE].
+func (s *LLMService) streamCompletion(
+	ctx context.Context,
+	messages []driven.ChatMessage,
+	opts driven.ChatOptions,
+	chunks chan<- string,
+) error {
+	req, err := s.newCompletionRequest(ctx, messages, opts, nil, true)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("openai error (status %d): failed to read response", resp.StatusCode)
+		}
+		return fmt.Errorf("openai error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLineSize)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue
+		}
+		data = strings.TrimSpace(data)
+		if data == "[DONE]" {
+			return nil
+		}
+
+		var chunk chatCompletionChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return fmt.Errorf("decode stream event: %w", err)
+		}
+		if chunk.Error != nil {
+			return fmt.Errorf("openai error: %s", chunk.Error.Message)
+		}
+		if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+			continue
+		}
+
+		select {
+		case chunks <- chunk.Choices[0].Delta.Content:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read stream: %w", err)
+	}
+	return fmt.Errorf("openai: stream ended before completion")
+}
+
 // chatCompletion is the internal implementation for both Generate and Chat.
 func (s *LLMService) chatCompletion(
 	ctx context.Context,
@@ -132,6 +227,50 @@ func (s *LLMService) chatCompletion(
 	opts driven.ChatOptions,
 	stopWords []string,
 ) (string, error) {
+	req, err := s.newCompletionRequest(ctx, messages, opts, stopWords, false)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("read response: %w", err)
+	}
+
+	var chatResp chatCompletionResponse
+	if err := json.Unmarshal(body, &chatResp); err != nil {
+		return "", fmt.Errorf("decode response: %w", err)
+	}
+
+	if chatResp.Error != nil {
+		return "", fmt.Errorf("openai error: %s", chatResp.Error.Message)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("openai error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	if len(chatResp.Choices) == 0 {
+		return "", fmt.Errorf("openai: no response choices returned")
+	}
+
+	return chatResp.Choices[0].Message.Content, nil
+}
+
+// newCompletionRequest builds a /chat/completions request.
+func (s *LLMService) newCompletionRequest(
+	ctx context.Context,
+	messages []driven.ChatMessage,
+	opts driven.ChatOptions,
+	stopWords []string,
+	stream bool,
+) (*http.Request, error) {
 	// Convert driven.ChatMessage to internal format
 	chatMessages := make([]chatCompletionMsg, len(messages))
 	for i, msg := range messages {
@@ -144,6 +283,7 @@ func (s *LLMService) chatCompletion(
 	reqBody := chatCompletionRequest{
 		Model:    s.model,
 		Messages: chatMessages,
+		Stream:   stream,
 	}
 
 	if opts.MaxTokens > 0 {
@@ -158,7 +298,7 @@ func (s *LLMService) chatCompletion(
 
 	jsonBody, err := json.Marshal(reqBody)
 	if err != nil {
-		return "", fmt.Errorf("marshal request: %w", err)
+		return nil, fmt.Errorf("marshal request: %w", err)
 	}
 
 	req, err := http.NewRequestWithContext(
@@ -168,40 +308,11 @@ func (s *LLMService) chatCompletion(
 		bytes.NewReader(jsonBody),
 	)
 	if err != nil {
-		return "", fmt.Errorf("create request: %w", err)
+		return nil, fmt.Errorf("create request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", "Bearer "+s.apiKey)
-
-	resp, err := s.client.Do(req)
-	if err != nil {
-		return "", fmt.Errorf("send request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", fmt.Errorf("read response: %w", err)
-	}
-
-	var chatResp chatCompletionResponse
-	if err := json.Unmarshal(body, &chatResp); err != nil {
-		return "", fmt.Errorf("decode response: %w", err)
-	}
-
-	if chatResp.Error != nil {
-		return "", fmt.Errorf("openai error: %s", chatResp.Error.Message)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("openai error (status %d): %s", resp.StatusCode, string(body))
-	}
-
-	if len(chatResp.Choices) == 0 {
-		return "", fmt.Errorf("openai: no response choices returned")
-	}
-
-	return chatResp.Choices[0].Message.Content, nil
+	return req, nil
 }
 
 // defaultQueryRewritePrompt is the fallback prompt when no PromptStore is configured.
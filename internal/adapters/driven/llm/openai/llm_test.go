@@ -0,0 +1,98 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// newStreamServer returns a server streaming chunks as chat completion
+// deltas, and the request body it received.
+func newStreamServer(t *testing.T, chunks ...string) (*httptest.Server, *chatCompletionRequest) {
+	t.Helper()
+	received := &chatCompletionRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/chat/completions", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(received))
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"role\":\"assistant\"}}]}\n\n")
+		for _, chunk := range chunks {
+			data, _ := json.Marshal(map[string]any{
+				"choices": []map[string]any{{"delta": map[string]string{"content": chunk}}},
+			})
+			fmt.Fprintf(w, "data: %s\n\n", data)
+			w.(http.Flusher).Flush()
+		}
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	t.Cleanup(server.Close)
+	return server, received
+}
+
+func TestChatStream_EmitsChunksInOrder(t *testing.T) {
+	server, received := newStreamServer(t, "Sercha ", "indexes ", "your ", "files.")
+	svc, err := NewLLMService(LLMConfig{APIKey: "sk-test", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	chunks, errs := svc.ChatStream(context.Background(),
+		[]driven.ChatMessage{{Role: "user", Content: "What is Sercha?"}}, driven.ChatOptions{})
+
+	var got []string
+	for chunk := range chunks {
+		got = append(got, chunk)
+	}
+	require.NoError(t, <-errs)
+	assert.Equal(t, []string{"Sercha ", "indexes ", "your ", "files."}, got)
+	assert.True(t, received.Stream)
+	assert.Equal(t, "What is Sercha?", received.Messages[0].Content)
+}
+
+func TestChatStream_Collect(t *testing.T) {
+	server, _ := newStreamServer(t, "one ", "two ", "three")
+	svc, err := NewLLMService(LLMConfig{APIKey: "sk-test", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	reply, err := driven.CollectStream(svc.ChatStream(context.Background(),
+		[]driven.ChatMessage{{Role: "user", Content: "count"}}, driven.ChatOptions{}))
+
+	require.NoError(t, err)
+	assert.Equal(t, "one two three", reply)
+}
+
+func TestChatStream_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error":{"message":"invalid api key"}}`)
+	}))
+	defer server.Close()
+	svc, err := NewLLMService(LLMConfig{APIKey: "bad", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	_, err = driven.CollectStream(svc.ChatStream(context.Background(), nil, driven.ChatOptions{}))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 401")
+}
+
+func TestChatStream_Truncated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"content\":\"partial\"}}]}\n\n")
+	}))
+	defer server.Close()
+	svc, err := NewLLMService(LLMConfig{APIKey: "sk-test", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	_, err = driven.CollectStream(svc.ChatStream(context.Background(), nil, driven.ChatOptions{}))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "stream ended before completion")
+}
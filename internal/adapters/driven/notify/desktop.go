@@ -0,0 +1,74 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Desktop implements the interface.
+var _ driven.Notifier = (*Desktop)(nil)
+
+// Desktop shows notifications using the operating system's notifier:
+// notify-send on Linux and osascript on macOS.
+type Desktop struct {
+	goos string
+
+	// run executes a command; replaced by tests.
+	run func(ctx context.Context, name string, args ...string) error
+}
+
+// NewDesktop creates a desktop notifier for the current operating system.
+func NewDesktop() *Desktop {
+	return &Desktop{goos: runtime.GOOS, run: runCommand}
+}
+
+// Notify shows the notification on the desktop.
+func (d *Desktop) Notify(ctx context.Context, notification domain.SyncNotification) error {
+	name, args, err := d.command(&notification)
+	if err != nil {
+		return err
+	}
+	if err := d.run(ctx, name, args...); err != nil {
+		return fmt.Errorf("desktop notification: %w", err)
+	}
+	return nil
+}
+
+// command returns the command that shows notification.
+func (d *Desktop) command(notification *domain.SyncNotification) (string, []string, error) {
+	title, message := notification.Title(), notification.Message()
+	switch d.goos {
+	case "linux", "freebsd", "openbsd", "netbsd":
+		args := []string{"--app-name=sercha"}
+		if notification.Event == domain.SyncEventFailed {
+			args = append(args, "--urgency=critical")
+		}
+		return "notify-send", append(args, title, message), nil
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", appleScriptString(message),
+			appleScriptString(title))
+		return "osascript", []string{"-e", script}, nil
+	default:
+		return "", nil, fmt.Errorf("desktop notifications are not supported on %s", d.goos)
+	}
+}
+
+// appleScriptString quotes s as an AppleScript string literal.
+func appleScriptString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+func runCommand(ctx context.Context, name string, args ...string) error {
+	out, err := exec.CommandContext(ctx, name, args...).CombinedOutput()
+	if err != nil && len(out) > 0 {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return err
+}
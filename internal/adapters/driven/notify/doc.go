@@ -0,0 +1,3 @@
+// Package notify implements driven.Notifier for reporting finished syncs:
+// desktop notifications, webhook POSTs, and a fan-out over several notifiers.
+package notify
@@ -0,0 +1,46 @@
+package notify
+
+import (
+	"context"
+	"errors"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Multi implements the interface.
+var _ driven.Notifier = Multi(nil)
+
+// Multi sends each notification to every notifier in turn.
+type Multi []driven.Notifier
+
+// Notify notifies every notifier, returning their joined errors.
+func (m Multi) Notify(ctx context.Context, notification domain.SyncNotification) error {
+	var errs []error
+	for _, n := range m {
+		if err := n.Notify(ctx, notification); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// FromConfig builds the notifiers enabled by cfg.
+// Returns nil if none are enabled.
+func FromConfig(cfg domain.NotificationConfig) driven.Notifier {
+	var notifiers Multi
+	if cfg.Desktop {
+		notifiers = append(notifiers, NewDesktop())
+	}
+	if cfg.WebhookURL != "" {
+		notifiers = append(notifiers, NewWebhook(cfg.WebhookURL))
+	}
+	switch len(notifiers) {
+	case 0:
+		return nil
+	case 1:
+		return notifiers[0]
+	default:
+		return notifiers
+	}
+}
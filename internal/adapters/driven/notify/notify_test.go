@@ -0,0 +1,112 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func failedNotification() domain.SyncNotification {
+	return domain.SyncNotification{
+		Event:      domain.SyncEventFailed,
+		SourceID:   "src-1",
+		SourceName: `My "Notes"`,
+		SourceType: "filesystem",
+		Error:      "connector validation failed",
+		StartedAt:  time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		FinishedAt: time.Date(2024, 5, 1, 10, 0, 5, 0, time.UTC),
+	}
+}
+
+func TestWebhook_PostsJSON(t *testing.T) {
+	var got domain.SyncNotification
+	var contentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		contentType = r.Header.Get("Content-Type")
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := NewWebhook(server.URL).Notify(context.Background(), failedNotification())
+
+	require.NoError(t, err)
+	assert.Equal(t, "application/json", contentType)
+	assert.Equal(t, failedNotification(), got)
+}
+
+func TestWebhook_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	err := NewWebhook(server.URL).Notify(context.Background(), failedNotification())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 502")
+}
+
+func TestDesktop_Commands(t *testing.T) {
+	var name string
+	var args []string
+	d := NewDesktop()
+	d.run = func(_ context.Context, n string, a ...string) error {
+		name, args = n, a
+		return nil
+	}
+
+	d.goos = "linux"
+	require.NoError(t, d.Notify(context.Background(), failedNotification()))
+	assert.Equal(t, "notify-send", name)
+	assert.Equal(t, []string{
+		"--app-name=sercha", "--urgency=critical", `Sync failed: My "Notes"`, "connector validation failed",
+	}, args)
+
+	d.goos = "darwin"
+	require.NoError(t, d.Notify(context.Background(), failedNotification()))
+	assert.Equal(t, "osascript", name)
+	assert.Equal(t, []string{
+		"-e", `display notification "connector validation failed" with title "Sync failed: My \"Notes\""`,
+	}, args)
+
+	d.goos = "plan9"
+	assert.Error(t, d.Notify(context.Background(), failedNotification()))
+}
+
+type stubNotifier struct {
+	calls int
+	err   error
+}
+
+func (s *stubNotifier) Notify(_ context.Context, _ domain.SyncNotification) error {
+	s.calls++
+	return s.err
+}
+
+func TestMulti_NotifiesAll(t *testing.T) {
+	first := &stubNotifier{err: errors.New("desktop unavailable")}
+	second := &stubNotifier{}
+
+	err := Multi{first, second}.Notify(context.Background(), failedNotification())
+
+	require.Error(t, err)
+	assert.Equal(t, 1, first.calls)
+	assert.Equal(t, 1, second.calls)
+}
+
+func TestFromConfig(t *testing.T) {
+	assert.Nil(t, FromConfig(domain.NotificationConfig{}))
+	assert.IsType(t, &Webhook{}, FromConfig(domain.NotificationConfig{WebhookURL: "http://example.com"}))
+	assert.IsType(t, Multi{}, FromConfig(domain.NotificationConfig{Desktop: true, WebhookURL: "http://example.com"}))
+}
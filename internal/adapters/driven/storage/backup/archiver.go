@@ -0,0 +1,527 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Archiver implements the interface.
+var _ driven.BackupArchiver = (*Archiver)(nil)
+
+// Names of the entries in a backup archive.
+const (
+	manifestName = "manifest.json"
+	databaseName = "metadata.db"
+	configName   = "config.toml"
+	searchDir    = "xapian"
+	vectorDir    = "vectors"
+)
+
+// Database is the metadata database included in backups.
+// Implemented by sqlite.Store.
+type Database interface {
+	// SchemaVersion returns the schema version of the database.
+	SchemaVersion(ctx context.Context) (int, error)
+
+	// LastSync returns when a source last finished syncing, or the zero time
+	// if none has.
+	LastSync(ctx context.Context) (time.Time, error)
+
+	// Snapshot writes a consistent copy of the database to destPath.
+	Snapshot(ctx context.Context, destPath string) error
+
+	// RemoveSecrets clears credentials and client secrets from the copy at path.
+	RemoveSecrets(ctx context.Context, path string) error
+
+	// Restore validates the copy at srcPath and replaces the database with it,
+	// keeping the live secrets where the copy has none.
+	Restore(ctx context.Context, srcPath string) error
+}
+
+// Archiver creates and restores tar.gz backups.
+type Archiver struct {
+	db              Database
+	searchIndexPath string
+	vectorIndexPath string
+	configPath      string
+	now             func() time.Time
+}
+
+// NewArchiver creates an archiver for the given database and index directories.
+// Either index path may be empty to leave that index out of backups.
+func NewArchiver(db Database, searchIndexPath, vectorIndexPath string) *Archiver {
+	return &Archiver{
+		db:              db,
+		searchIndexPath: searchIndexPath,
+		vectorIndexPath: vectorIndexPath,
+		now:             time.Now,
+	}
+}
+
+// SetConfigPath sets the configuration file included in backups.
+// Its secrets, such as API keys, are left out.
+func (a *Archiver) SetConfigPath(path string) {
+	a.configPath = path
+}
+
+// Create writes a backup archive to outputPath.
+func (a *Archiver) Create(ctx context.Context, outputPath string) (*domain.BackupManifest, error) {
+	staging, err := os.MkdirTemp("", "sercha-backup-*")
+	if err != nil {
+		return nil, fmt.Errorf("create staging directory: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	version, err := a.db.SchemaVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Read before the snapshot, so a sync finishing meanwhile makes the backup
+	// look older rather than newer than its contents
+	lastSync, err := a.db.LastSync(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := filepath.Join(staging, databaseName)
+	if err := a.db.Snapshot(ctx, snapshot); err != nil {
+		return nil, err
+	}
+	if err := a.db.RemoveSecrets(ctx, snapshot); err != nil {
+		return nil, err
+	}
+
+	config, err := a.readConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &domain.BackupManifest{
+		FormatVersion: domain.BackupFormatVersion,
+		SchemaVersion: version,
+		CreatedAt:     a.now().UTC(),
+		LastSync:      lastSync.UTC(),
+		Config:        config != nil,
+	}
+
+	// Write to a temporary file beside the output so the final rename is atomic
+	tmp, err := os.CreateTemp(filepath.Dir(outputPath), ".sercha-backup-*.tmp")
+	if err != nil {
+		return nil, fmt.Errorf("create backup file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := a.writeArchive(ctx, tmp, manifest, snapshot, config); err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return nil, fmt.Errorf("sync backup file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, fmt.Errorf("close backup file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), outputPath); err != nil {
+		return nil, fmt.Errorf("move backup into place: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// readConfig returns the configuration file without its secrets, or nil if
+// there is none.
+func (a *Archiver) readConfig() ([]byte, error) {
+	if a.configPath == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(a.configPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+	return redactConfig(data)
+}
+
+// writeArchive writes the manifest, database snapshot, configuration and
+// index directories. config may be nil.
+func (a *Archiver) writeArchive(
+	ctx context.Context, w io.Writer, manifest *domain.BackupManifest, snapshot string, config []byte,
+) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode manifest: %w", err)
+	}
+	if err := writeEntry(tw, manifestName, manifestJSON, manifest.CreatedAt); err != nil {
+		return err
+	}
+	if err := addFile(tw, snapshot, databaseName); err != nil {
+		return err
+	}
+	if config != nil {
+		if err := writeEntry(tw, configName, config, manifest.CreatedAt); err != nil {
+			return err
+		}
+	}
+	if err := addDir(ctx, tw, a.searchIndexPath, searchDir); err != nil {
+		return err
+	}
+	if err := addDir(ctx, tw, a.vectorIndexPath, vectorDir); err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("finish archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("finish compression: %w", err)
+	}
+	return nil
+}
+
+// Restore validates the archive at archivePath and replaces the live
+// database, vector index and configuration with its contents. Everything is
+// staged beside the live data first, so each is replaced with a rename.
+func (a *Archiver) Restore(
+	ctx context.Context, archivePath string, opts domain.RestoreOptions,
+) (*domain.BackupManifest, error) {
+	staging, err := os.MkdirTemp("", "sercha-restore-*")
+	if err != nil {
+		return nil, fmt.Errorf("create staging directory: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	if err := extract(ctx, archivePath, staging); err != nil {
+		return nil, err
+	}
+
+	manifest, err := readManifest(filepath.Join(staging, manifestName))
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := filepath.Join(staging, databaseName)
+	if _, err := os.Stat(snapshot); err != nil {
+		return nil, fmt.Errorf("%w: archive has no %s", domain.ErrInvalidBackup, databaseName)
+	}
+
+	if !opts.Force {
+		if err := a.checkOutdated(ctx, manifest); err != nil {
+			return nil, err
+		}
+	}
+
+	var config []byte
+	if manifest.Config && a.configPath != "" {
+		if config, err = a.stageConfig(filepath.Join(staging, configName)); err != nil {
+			return nil, err
+		}
+	}
+
+	var vectors string
+	if a.vectorIndexPath != "" {
+		if vectors, err = stageDir(a.vectorIndexPath, filepath.Join(staging, vectorDir)); err != nil {
+			return nil, fmt.Errorf("stage vector index: %w", err)
+		}
+		defer os.RemoveAll(vectors)
+	}
+
+	// The database validates the snapshot before replacing anything
+	if err := a.db.Restore(ctx, snapshot); err != nil {
+		return nil, err
+	}
+
+	if vectors != "" {
+		if err := swapDir(a.vectorIndexPath, vectors); err != nil {
+			return nil, fmt.Errorf("restore vector index: %w", err)
+		}
+	}
+	if config != nil {
+		if err := writeFileAtomic(a.configPath, config); err != nil {
+			return nil, fmt.Errorf("restore config: %w", err)
+		}
+	}
+
+	return manifest, nil
+}
+
+// checkOutdated returns domain.ErrBackupOutdated if a source has synced
+// since the backup was taken.
+func (a *Archiver) checkOutdated(ctx context.Context, manifest *domain.BackupManifest) error {
+	lastSync, err := a.db.LastSync(ctx)
+	if err != nil {
+		return err
+	}
+	if !lastSync.After(manifest.LastSync) {
+		return nil
+	}
+	return fmt.Errorf("%w: live data last synced %s, after the backup was taken",
+		domain.ErrBackupOutdated, lastSync.Local().Format(time.DateTime))
+}
+
+// stageConfig returns the archived configuration at path merged with the
+// secrets of the live configuration.
+func (a *Archiver) stageConfig(path string) ([]byte, error) {
+	restored, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: archive has no %s", domain.ErrInvalidBackup, configName)
+	}
+	live, err := os.ReadFile(a.configPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("read live config: %w", err)
+	}
+	return mergeConfig(restored, live)
+}
+
+// readManifest reads and checks the archive manifest.
+func readManifest(path string) (*domain.BackupManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: archive has no %s", domain.ErrInvalidBackup, manifestName)
+	}
+
+	var manifest domain.BackupManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("%w: read manifest: %w", domain.ErrInvalidBackup, err)
+	}
+	if manifest.FormatVersion < 1 || manifest.FormatVersion > domain.BackupFormatVersion {
+		return nil, fmt.Errorf("%w: unsupported backup format version %d",
+			domain.ErrInvalidBackup, manifest.FormatVersion)
+	}
+	return &manifest, nil
+}
+
+// writeEntry writes an in-memory file to the archive.
+func writeEntry(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	return nil
+}
+
+// addFile copies a file on disk into the archive under name.
+func addFile(tw *tar.Writer, src, name string) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("open %s: %w", src, err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("stat %s: %w", src, err)
+	}
+	hdr, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return fmt.Errorf("header for %s: %w", src, err)
+	}
+	hdr.Name = name
+
+	if err := tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	if _, err := io.Copy(tw, f); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	return nil
+}
+
+// addDir copies the regular files under dir into the archive under prefix.
+// A missing or unset directory is skipped.
+func addDir(ctx context.Context, tw *tar.Writer, dir, prefix string) error {
+	if dir == "" {
+		return nil
+	}
+	if _, err := os.Stat(dir); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		return addFile(tw, p, path.Join(prefix, filepath.ToSlash(rel)))
+	})
+}
+
+// extract unpacks the regular files in a tar.gz archive into dir.
+func extract(ctx context.Context, archivePath, dir string) error {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return fmt.Errorf("open backup: %w", err)
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("%w: not a gzip archive: %w", domain.ErrInvalidBackup, err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: read archive: %w", domain.ErrInvalidBackup, err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		target, err := entryPath(dir, hdr.Name)
+		if err != nil {
+			return err
+		}
+		if err := writeFile(tr, target); err != nil {
+			return fmt.Errorf("extract %s: %w", hdr.Name, err)
+		}
+	}
+}
+
+// entryPath resolves an archive entry name inside dir, rejecting names that
+// would escape it.
+func entryPath(dir, name string) (string, error) {
+	clean := path.Clean(name)
+	if path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", fmt.Errorf("%w: unsafe entry %q", domain.ErrInvalidBackup, name)
+	}
+	return filepath.Join(dir, filepath.FromSlash(clean)), nil
+}
+
+// writeFile writes the contents of r to target, creating parent directories.
+func writeFile(r io.Reader, target string) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0700); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, r); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// stageDir copies the files under src into a new directory beside dst, for
+// swapDir to move into place, and returns its path. A missing src stages an
+// empty directory.
+func stageDir(dst, src string) (string, error) {
+	parent := filepath.Dir(dst)
+	if err := os.MkdirAll(parent, 0700); err != nil {
+		return "", err
+	}
+	staged, err := os.MkdirTemp(parent, "."+filepath.Base(dst)+"-restore-*")
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := os.Stat(src); errors.Is(err, fs.ErrNotExist) {
+		return staged, nil
+	}
+
+	err = filepath.WalkDir(src, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		in, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		return writeFile(in, filepath.Join(staged, rel))
+	})
+	if err != nil {
+		os.RemoveAll(staged)
+		return "", err
+	}
+	return staged, nil
+}
+
+// swapDir replaces dst with the directory staged by stageDir.
+func swapDir(dst, staged string) error {
+	old := staged + ".old"
+	if err := os.Rename(dst, old); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if err := os.Rename(staged, dst); err != nil {
+		// Put the live directory back
+		if renameErr := os.Rename(old, dst); renameErr != nil && !errors.Is(renameErr, fs.ErrNotExist) {
+			return errors.Join(err, renameErr)
+		}
+		return err
+	}
+	return os.RemoveAll(old)
+}
+
+// writeFileAtomic replaces the file at path with data via a temporary file
+// beside it.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+"-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,362 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/sqlite"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+type fixture struct {
+	store      *sqlite.Store
+	archiver   *Archiver
+	searchPath string
+	vectorPath string
+	configPath string
+}
+
+// liveConfig is the configuration file of the fixture.
+const liveConfig = `"llm.api_key" = "sk-live"
+"llm.model" = "llama3"
+`
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	dataDir := t.TempDir()
+
+	store, err := sqlite.NewStore(dataDir)
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+
+	searchPath := filepath.Join(dataDir, "xapian")
+	vectorPath := filepath.Join(dataDir, "vectors")
+	require.NoError(t, os.MkdirAll(filepath.Join(searchPath, "sub"), 0700))
+	require.NoError(t, os.MkdirAll(vectorPath, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(searchPath, "postlist.glass"), []byte("postings"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(searchPath, "sub", "iamglass"), []byte("meta"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(vectorPath, "index.bin"), []byte("vectors-v1"), 0600))
+
+	configPath := filepath.Join(dataDir, "config.toml")
+	require.NoError(t, os.WriteFile(configPath, []byte(liveConfig), 0600))
+
+	archiver := NewArchiver(store, searchPath, vectorPath)
+	archiver.SetConfigPath(configPath)
+	archiver.now = func() time.Time { return time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC) }
+
+	return &fixture{
+		store: store, archiver: archiver, searchPath: searchPath, vectorPath: vectorPath, configPath: configPath,
+	}
+}
+
+func (f *fixture) saveSource(t *testing.T, id string) {
+	t.Helper()
+	require.NoError(t, f.store.SourceStore().Save(context.Background(), domain.Source{
+		ID: id, Type: "filesystem", Name: id, Config: map[string]string{},
+	}))
+}
+
+func archiveEntries(t *testing.T, archivePath string) []string {
+	t.Helper()
+	f, err := os.Open(archivePath)
+	require.NoError(t, err)
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+
+	var names []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		names = append(names, hdr.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func archiveEntry(t *testing.T, archivePath, name string) string {
+	t.Helper()
+	f, err := os.Open(archivePath)
+	require.NoError(t, err)
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+
+	for {
+		hdr, err := tr.Next()
+		require.NoError(t, err, "no entry %s", name)
+		if hdr.Name == name {
+			data, err := io.ReadAll(tr)
+			require.NoError(t, err)
+			return string(data)
+		}
+	}
+}
+
+func TestArchiver_Create(t *testing.T) {
+	f := newFixture(t)
+	f.saveSource(t, "src-1")
+	out := filepath.Join(t.TempDir(), "backup.tar.gz")
+
+	manifest, err := f.archiver.Create(context.Background(), out)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.BackupFormatVersion, manifest.FormatVersion)
+	assert.Positive(t, manifest.SchemaVersion)
+	assert.Equal(t, 2026, manifest.CreatedAt.Year())
+	assert.True(t, manifest.Config)
+	assert.True(t, manifest.LastSync.IsZero())
+	assert.Equal(t, []string{
+		"config.toml",
+		"manifest.json",
+		"metadata.db",
+		"vectors/index.bin",
+		"xapian/postlist.glass",
+		"xapian/sub/iamglass",
+	}, archiveEntries(t, out))
+
+	// API keys are left out of the archived configuration
+	config := archiveEntry(t, out, "config.toml")
+	assert.Contains(t, config, "llama3")
+	assert.NotContains(t, config, "sk-live")
+
+	// No temporary files are left beside the output
+	entries, err := os.ReadDir(filepath.Dir(out))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestArchiver_Create_MissingIndexDirs(t *testing.T) {
+	f := newFixture(t)
+	archiver := NewArchiver(f.store, filepath.Join(t.TempDir(), "missing"), "")
+	out := filepath.Join(t.TempDir(), "backup.tar.gz")
+
+	_, err := archiver.Create(context.Background(), out)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"manifest.json", "metadata.db"}, archiveEntries(t, out))
+}
+
+func TestArchiver_Create_FailureLeavesNoOutput(t *testing.T) {
+	f := newFixture(t)
+	require.NoError(t, f.store.Close())
+	out := filepath.Join(t.TempDir(), "backup.tar.gz")
+
+	_, err := f.archiver.Create(context.Background(), out)
+
+	require.Error(t, err)
+	assert.NoFileExists(t, out)
+}
+
+func TestArchiver_Restore(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	f.saveSource(t, "src-1")
+	out := filepath.Join(t.TempDir(), "backup.tar.gz")
+	_, err := f.archiver.Create(ctx, out)
+	require.NoError(t, err)
+
+	// Diverge from the backup
+	f.saveSource(t, "src-2")
+	require.NoError(t, os.WriteFile(filepath.Join(f.vectorPath, "index.bin"), []byte("vectors-v2"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(f.vectorPath, "stale.bin"), []byte("stale"), 0600))
+
+	manifest, err := f.archiver.Restore(ctx, out, domain.RestoreOptions{})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.BackupFormatVersion, manifest.FormatVersion)
+
+	sources, err := f.store.SourceStore().List(ctx)
+	require.NoError(t, err)
+	require.Len(t, sources, 1)
+	assert.Equal(t, "src-1", sources[0].ID)
+
+	data, err := os.ReadFile(filepath.Join(f.vectorPath, "index.bin"))
+	require.NoError(t, err)
+	assert.Equal(t, "vectors-v1", string(data))
+	assert.NoFileExists(t, filepath.Join(f.vectorPath, "stale.bin"))
+
+	// Only the staged directory was swapped in; nothing is left beside it
+	entries, err := os.ReadDir(filepath.Dir(f.vectorPath))
+	require.NoError(t, err)
+	for _, entry := range entries {
+		assert.NotContains(t, entry.Name(), "restore")
+	}
+}
+
+func TestArchiver_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	src := newFixture(t)
+	src.saveSource(t, "src-1")
+	now := time.Now()
+	require.NoError(t, src.store.DocumentStore().SaveDocument(ctx, &domain.Document{
+		ID: "doc-1", SourceID: "src-1", URI: "file:///notes.md", Title: "Notes", Content: "hello",
+		CreatedAt: now, UpdatedAt: now,
+	}))
+	require.NoError(t, src.store.CredentialsStore().Save(ctx, domain.Credentials{
+		ID: "creds-1", SourceID: "src-1", AccountIdentifier: "me@example.com",
+		PAT:       &domain.PATCredentials{Token: "ghp_live_token"},
+		CreatedAt: now, UpdatedAt: now,
+	}))
+	lastSync := time.Date(2026, 2, 28, 8, 0, 0, 0, time.UTC)
+	require.NoError(t, src.store.SyncStateStore().Save(ctx, domain.SyncState{SourceID: "src-1", LastSync: lastSync}))
+
+	out := filepath.Join(t.TempDir(), "backup.tar.gz")
+	manifest, err := src.archiver.Create(ctx, out)
+	require.NoError(t, err)
+	assert.True(t, lastSync.Equal(manifest.LastSync))
+
+	// Restore onto another, empty data directory
+	dst := newFixture(t)
+	require.NoError(t, os.WriteFile(dst.configPath, []byte(`"llm.model" = "other"`+"\n"), 0600))
+
+	restored, err := dst.archiver.Restore(ctx, out, domain.RestoreOptions{})
+	require.NoError(t, err)
+	assert.True(t, restored.Config)
+
+	doc, err := dst.store.DocumentStore().GetDocument(ctx, "doc-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Notes", doc.Title)
+
+	creds, err := dst.store.CredentialsStore().Get(ctx, "creds-1")
+	require.NoError(t, err)
+	assert.Equal(t, "me@example.com", creds.AccountIdentifier)
+	assert.Nil(t, creds.PAT, "tokens are not carried between machines")
+
+	config, err := os.ReadFile(dst.configPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(config), "llama3")
+	assert.NotContains(t, string(config), "sk-live")
+
+	data, err := os.ReadFile(filepath.Join(dst.vectorPath, "index.bin"))
+	require.NoError(t, err)
+	assert.Equal(t, "vectors-v1", string(data))
+
+	// Restoring the same backup again is not refused
+	_, err = dst.archiver.Restore(ctx, out, domain.RestoreOptions{})
+	require.NoError(t, err)
+
+	// Restoring on the original machine keeps its secrets
+	_, err = src.archiver.Restore(ctx, out, domain.RestoreOptions{})
+	require.NoError(t, err)
+	creds, err = src.store.CredentialsStore().Get(ctx, "creds-1")
+	require.NoError(t, err)
+	require.NotNil(t, creds.PAT)
+	assert.Equal(t, "ghp_live_token", creds.PAT.Token)
+	config, err = os.ReadFile(src.configPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(config), "sk-live")
+}
+
+func TestArchiver_Restore_Outdated(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	f.saveSource(t, "src-1")
+	out := filepath.Join(t.TempDir(), "backup.tar.gz")
+	_, err := f.archiver.Create(ctx, out)
+	require.NoError(t, err)
+
+	// A sync after the backup makes the live data newer
+	f.saveSource(t, "src-2")
+	require.NoError(t, f.store.SyncStateStore().Save(ctx, domain.SyncState{SourceID: "src-2", LastSync: time.Now()}))
+
+	_, err = f.archiver.Restore(ctx, out, domain.RestoreOptions{})
+	require.ErrorIs(t, err, domain.ErrBackupOutdated)
+	_, err = f.store.SourceStore().Get(ctx, "src-2")
+	require.NoError(t, err, "live data is untouched")
+
+	_, err = f.archiver.Restore(ctx, out, domain.RestoreOptions{Force: true})
+	require.NoError(t, err)
+	_, err = f.store.SourceStore().Get(ctx, "src-2")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func writeArchive(t *testing.T, entries map[string]string) string {
+	t.Helper()
+	out := filepath.Join(t.TempDir(), "backup.tar.gz")
+	file, err := os.Create(out)
+	require.NoError(t, err)
+	gz := gzip.NewWriter(file)
+	tw := tar.NewWriter(gz)
+	for name, content := range entries {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	require.NoError(t, file.Close())
+	return out
+}
+
+func TestArchiver_Restore_InvalidArchives(t *testing.T) {
+	validManifest := `{"format_version":1,"schema_version":1}`
+
+	tests := []struct {
+		name    string
+		entries map[string]string
+		want    string
+	}{
+		{name: "missing manifest", entries: map[string]string{"metadata.db": "x"}, want: "no manifest.json"},
+		{
+			name:    "future format",
+			entries: map[string]string{"manifest.json": `{"format_version":99}`, "metadata.db": "x"},
+			want:    "unsupported backup format version 99",
+		},
+		{name: "missing database", entries: map[string]string{"manifest.json": validManifest}, want: "no metadata.db"},
+		{
+			name:    "corrupt database",
+			entries: map[string]string{"manifest.json": validManifest, "metadata.db": "not sqlite"},
+			want:    "not a database",
+		},
+		{
+			name:    "path traversal",
+			entries: map[string]string{"../evil": "x"},
+			want:    "unsafe entry",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFixture(t)
+			f.saveSource(t, "src-1")
+
+			_, err := f.archiver.Restore(context.Background(), writeArchive(t, tc.entries), domain.RestoreOptions{})
+
+			require.ErrorIs(t, err, domain.ErrInvalidBackup)
+			assert.Contains(t, err.Error(), tc.want)
+
+			// Live data is untouched
+			_, err = f.store.SourceStore().Get(context.Background(), "src-1")
+			assert.NoError(t, err)
+			data, err := os.ReadFile(filepath.Join(f.vectorPath, "index.bin"))
+			require.NoError(t, err)
+			assert.Equal(t, "vectors-v1", string(data))
+		})
+	}
+}
+
+func TestArchiver_Restore_NotGzip(t *testing.T) {
+	f := newFixture(t)
+	path := filepath.Join(t.TempDir(), "backup.tar.gz")
+	require.NoError(t, os.WriteFile(path, []byte("plain text"), 0600))
+
+	_, err := f.archiver.Restore(context.Background(), path, domain.RestoreOptions{})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidBackup)
+}
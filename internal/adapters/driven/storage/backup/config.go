@@ -0,0 +1,96 @@
+package backup
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// secretKeySuffixes end the names of configuration keys holding secrets,
+// e.g. "llm.api_key". Secrets are left out of backups.
+var secretKeySuffixes = []string{"api_key", "secret", "token", "password"}
+
+// isSecretKey reports whether a configuration key holds a secret.
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, suffix := range secretKeySuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactConfig returns the TOML configuration in data without its secrets.
+func redactConfig(data []byte) ([]byte, error) {
+	var config map[string]any
+	if err := toml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+	removeSecrets(config)
+	return toml.Marshal(config)
+}
+
+// removeSecrets deletes the secret keys from a configuration table and the
+// tables nested in it.
+func removeSecrets(table map[string]any) {
+	for key, value := range table {
+		if nested, ok := value.(map[string]any); ok {
+			removeSecrets(nested)
+			continue
+		}
+		if isSecretKey(key) {
+			delete(table, key)
+		}
+	}
+}
+
+// mergeConfig returns the restored TOML configuration with the secrets of
+// the live configuration added where it has none. live may be nil.
+func mergeConfig(restored, live []byte) ([]byte, error) {
+	var config map[string]any
+	if err := toml.Unmarshal(restored, &config); err != nil {
+		return nil, fmt.Errorf("%w: read config: %w", domain.ErrInvalidBackup, err)
+	}
+	if config == nil {
+		config = make(map[string]any)
+	}
+
+	if live != nil {
+		var liveConfig map[string]any
+		if err := toml.Unmarshal(live, &liveConfig); err != nil {
+			return nil, fmt.Errorf("read live config: %w", err)
+		}
+		copySecrets(config, liveConfig)
+	}
+	return toml.Marshal(config)
+}
+
+// copySecrets copies the secret keys of src missing from dst, including
+// those of nested tables.
+func copySecrets(dst, src map[string]any) {
+	for key, value := range src {
+		nested, ok := value.(map[string]any)
+		if !ok {
+			if _, exists := dst[key]; !exists && isSecretKey(key) {
+				dst[key] = value
+			}
+			continue
+		}
+
+		table, ok := dst[key].(map[string]any)
+		if !ok {
+			if _, exists := dst[key]; exists {
+				continue
+			}
+			table = make(map[string]any)
+		}
+		copySecrets(table, nested)
+		if len(table) > 0 {
+			dst[key] = table
+		}
+	}
+}
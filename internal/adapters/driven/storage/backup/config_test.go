@@ -0,0 +1,93 @@
+package backup
+
+import (
+	"testing"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func decodeConfig(t *testing.T, data []byte) map[string]any {
+	t.Helper()
+	var config map[string]any
+	require.NoError(t, toml.Unmarshal(data, &config))
+	return config
+}
+
+func TestIsSecretKey(t *testing.T) {
+	assert.True(t, isSecretKey("llm.api_key"))
+	assert.True(t, isSecretKey("embedding.API_KEY"))
+	assert.True(t, isSecretKey("webhook.secret"))
+	assert.True(t, isSecretKey("proxy.password"))
+	assert.False(t, isSecretKey("llm.max_context_tokens"))
+	assert.False(t, isSecretKey("llm.model"))
+}
+
+func TestRedactConfig(t *testing.T) {
+	data := []byte(`"llm.api_key" = "sk-1"
+"llm.model" = "llama3"
+
+[proxy]
+password = "hunter2"
+url = "http://proxy"
+`)
+
+	redacted, err := redactConfig(data)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"llm.model": "llama3",
+		"proxy":     map[string]any{"url": "http://proxy"},
+	}, decodeConfig(t, redacted))
+}
+
+func TestRedactConfig_Invalid(t *testing.T) {
+	_, err := redactConfig([]byte("not = [toml"))
+	assert.Error(t, err)
+}
+
+func TestMergeConfig(t *testing.T) {
+	restored := []byte(`"llm.model" = "llama3"
+"embedding.api_key" = "sk-restored"
+
+[proxy]
+url = "http://proxy"
+`)
+	live := []byte(`"llm.api_key" = "sk-live"
+"llm.model" = "mistral"
+"embedding.api_key" = "sk-live-embed"
+
+[proxy]
+password = "hunter2"
+
+[webhook]
+secret = "whsec"
+url = "http://hook"
+`)
+
+	merged, err := mergeConfig(restored, live)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"llm.model":         "llama3",
+		"llm.api_key":       "sk-live",
+		"embedding.api_key": "sk-restored",
+		"proxy":             map[string]any{"url": "http://proxy", "password": "hunter2"},
+		"webhook":           map[string]any{"secret": "whsec"},
+	}, decodeConfig(t, merged))
+}
+
+func TestMergeConfig_NoLiveConfig(t *testing.T) {
+	merged, err := mergeConfig([]byte(`"llm.model" = "llama3"`), nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"llm.model": "llama3"}, decodeConfig(t, merged))
+}
+
+func TestMergeConfig_InvalidArchive(t *testing.T) {
+	_, err := mergeConfig([]byte("not = [toml"), nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidBackup)
+}
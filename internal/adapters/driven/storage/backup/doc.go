@@ -0,0 +1,27 @@
+// Package backup packages sercha's persistent data into backup archives.
+//
+// # Archive Layout
+//
+// A backup is a gzip-compressed tar archive containing:
+//
+//   - manifest.json: format version, schema version, creation and last sync times
+//   - metadata.db: a consistent snapshot of the SQLite metadata database
+//   - config.toml: the configuration file, if there is one
+//   - xapian/: the Xapian search index directory
+//   - vectors/: the HNSW vector index directory
+//
+// # Secrets
+//
+// Credential tokens, OAuth client secrets and configuration API keys are
+// left out of archives. Restoring keeps the live secrets, so a backup
+// restored on the machine it was taken on keeps sources signed in; on
+// another machine, sources must be authenticated again.
+//
+// # Restore
+//
+// Restoring replaces the metadata database, vector index and configuration.
+// It is refused if a source has synced since the backup was taken, unless
+// forced. The search index in the archive is not restored, because the live
+// index is held open by the search engine; callers rebuild it from the
+// restored documents instead.
+package backup
@@ -0,0 +1,3 @@
+// Package diskusage measures the disk space used by sercha's data stores:
+// the metadata database and the search and vector index directories.
+package diskusage
@@ -0,0 +1,87 @@
+package diskusage
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Meter implements the interface.
+var _ driven.DiskUsageMeter = (*Meter)(nil)
+
+// Meter reports the size of the metadata database and index directories.
+type Meter struct {
+	databasePath    string
+	searchIndexPath string
+	vectorIndexPath string
+}
+
+// NewMeter creates a meter for the given database file and index directories.
+// Any path may be empty, in which case that store is reported as zero bytes.
+func NewMeter(databasePath, searchIndexPath, vectorIndexPath string) *Meter {
+	return &Meter{
+		databasePath:    databasePath,
+		searchIndexPath: searchIndexPath,
+		vectorIndexPath: vectorIndexPath,
+	}
+}
+
+// DiskUsage returns the current size of each store.
+// The database size includes its write-ahead log and shared-memory files.
+func (m *Meter) DiskUsage(_ context.Context) (domain.DiskUsage, error) {
+	var usage domain.DiskUsage
+	var err error
+
+	if m.databasePath != "" {
+		for _, suffix := range []string{"", "-wal", "-shm"} {
+			size, sizeErr := pathSize(m.databasePath + suffix)
+			if sizeErr != nil {
+				return domain.DiskUsage{}, sizeErr
+			}
+			usage.Database += size
+		}
+	}
+	if usage.SearchIndex, err = pathSize(m.searchIndexPath); err != nil {
+		return domain.DiskUsage{}, err
+	}
+	if usage.VectorIndex, err = pathSize(m.vectorIndexPath); err != nil {
+		return domain.DiskUsage{}, err
+	}
+
+	return usage, nil
+}
+
+// pathSize returns the total size of the regular files at or below path.
+// A missing path has size zero.
+func pathSize(path string) (int64, error) {
+	if path == "" {
+		return 0, nil
+	}
+
+	var total int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		total += info.Size()
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return total, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
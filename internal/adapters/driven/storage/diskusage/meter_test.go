@@ -0,0 +1,46 @@
+package diskusage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func writeFile(t *testing.T, path string, size int) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, os.WriteFile(path, make([]byte, size), 0600))
+}
+
+func TestMeter_DiskUsage(t *testing.T) {
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "metadata.db")
+	writeFile(t, dbPath, 100)
+	writeFile(t, dbPath+"-wal", 20)
+	writeFile(t, filepath.Join(dir, "xapian", "postlist.glass"), 300)
+	writeFile(t, filepath.Join(dir, "xapian", "nested", "termlist.glass"), 50)
+	writeFile(t, filepath.Join(dir, "vectors", "index.bin"), 400)
+
+	meter := NewMeter(dbPath, filepath.Join(dir, "xapian"), filepath.Join(dir, "vectors"))
+	usage, err := meter.DiskUsage(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.DiskUsage{Database: 120, SearchIndex: 350, VectorIndex: 400}, usage)
+	assert.Equal(t, int64(870), usage.Total())
+}
+
+func TestMeter_DiskUsage_MissingPaths(t *testing.T) {
+	dir := t.TempDir()
+	meter := NewMeter(filepath.Join(dir, "missing.db"), filepath.Join(dir, "missing"), "")
+
+	usage, err := meter.DiskUsage(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.DiskUsage{}, usage)
+}
@@ -0,0 +1,35 @@
+// Package keychain provides a CredentialsStore decorator that encrypts tokens at rest.
+//
+// The wrapped store (normally SQLite) keeps persisting credentials as before,
+// but OAuth access/refresh tokens and PAT tokens are sealed with AES-256-GCM
+// before they are written. The encryption key is generated once and held in
+// the operating system keychain via github.com/zalando/go-keyring:
+//
+//   - macOS: Keychain
+//   - Linux: Secret Service (libsecret)
+//   - Windows: Credential Manager (DPAPI)
+//
+// Alternatively, the key is derived from a passphrase with PBKDF2-SHA256,
+// for systems without a usable keychain. Each sealed value records which
+// kind of key sealed it, and passphrase-sealed values also record their salt.
+//
+// # Opt-in
+//
+// Encryption is off by default and enabled with the --keychain flag, or by
+// setting a passphrase in the SERCHA_CREDENTIALS_PASSPHRASE environment
+// variable. Encrypted values are always decrypted on read, and a credential
+// that is already encrypted stays encrypted when it is saved again (e.g.,
+// after a token refresh).
+//
+// # Migration
+//
+// While encryption is enabled, plaintext credentials are encrypted in the
+// wrapped store the first time they are read.
+//
+// # Fallback
+//
+// If the keychain is unavailable when saving, credentials are stored in
+// plaintext and a warning is logged. Plaintext values are read unchanged.
+// Reading values sealed with an unavailable key fails with
+// ErrKeychainUnavailable or ErrPassphraseRequired.
+package keychain
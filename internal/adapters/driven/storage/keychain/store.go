@@ -0,0 +1,478 @@
+package keychain
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/zalando/go-keyring"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+const (
+	// keyringService is the keychain service name the key is stored under.
+	keyringService = "sercha"
+
+	// keyringUser is the keychain account name for the encryption key.
+	keyringUser = "credentials-encryption-key"
+
+	// encryptedPrefix marks a token value sealed with the keychain key.
+	encryptedPrefix = "keychain:v1:"
+
+	// passphrasePrefix marks a token value sealed with a passphrase-derived
+	// key. It is followed by the key's salt and a colon.
+	passphrasePrefix = "passphrase:v1:"
+
+	// keySize is the AES-256 key length in bytes.
+	keySize = 32
+
+	// saltSize is the length in bytes of the salt a passphrase key is derived with.
+	saltSize = 16
+
+	// defaultPBKDF2Iterations is the PBKDF2-SHA256 work factor for passphrase keys.
+	defaultPBKDF2Iterations = 600_000
+)
+
+var (
+	// ErrKeychainUnavailable indicates the OS keychain could not be used.
+	ErrKeychainUnavailable = errors.New("keychain unavailable")
+
+	// ErrPassphraseRequired indicates credentials were sealed with a passphrase
+	// that has not been given.
+	ErrPassphraseRequired = errors.New("credentials passphrase required")
+)
+
+// Ensure CredentialsStore implements the interface.
+var _ driven.CredentialsStore = (*CredentialsStore)(nil)
+
+// CredentialsStore encrypts credential tokens with a key held in the OS keychain
+// and delegates persistence to a wrapped CredentialsStore.
+type CredentialsStore struct {
+	inner driven.CredentialsStore
+
+	mu         sync.Mutex
+	encrypt    bool
+	aead       cipher.AEAD
+	passphrase string
+	iterations int
+
+	// salt is used for values sealed by this process with the passphrase;
+	// passphraseAEADs caches the ciphers for each salt seen, base64 encoded.
+	salt            string
+	passphraseAEADs map[string]cipher.AEAD
+}
+
+// NewCredentialsStore wraps a credentials store with keychain encryption.
+// Encryption of new saves is off until SetEncryption(true) or SetPassphrase
+// is called.
+func NewCredentialsStore(inner driven.CredentialsStore) *CredentialsStore {
+	return &CredentialsStore{
+		inner:           inner,
+		iterations:      defaultPBKDF2Iterations,
+		passphraseAEADs: make(map[string]cipher.AEAD),
+	}
+}
+
+// SetEncryption enables or disables encryption of saved credentials.
+func (s *CredentialsStore) SetEncryption(enabled bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.encrypt = enabled
+}
+
+// SetPassphrase seals saved credentials with a key derived from passphrase
+// instead of the keychain key, for systems without a usable keychain.
+// A non-empty passphrase enables encryption; it is also needed to read
+// credentials sealed with it.
+func (s *CredentialsStore) SetPassphrase(passphrase string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.passphrase = passphrase
+	s.salt = ""
+	clear(s.passphraseAEADs)
+}
+
+// Save encrypts the credential tokens and stores them.
+// Falls back to plaintext with a warning if the keychain is unavailable.
+func (s *CredentialsStore) Save(ctx context.Context, creds domain.Credentials) error {
+	encrypt, err := s.shouldEncrypt(ctx, creds.ID)
+	if err != nil {
+		return err
+	}
+	if !encrypt {
+		return s.inner.Save(ctx, creds)
+	}
+
+	sealed, err := s.seal(creds)
+	if errors.Is(err, ErrKeychainUnavailable) {
+		slog.Warn("storing credentials in plaintext", slog.String("credentials_id", creds.ID), slog.Any("error", err))
+		return s.inner.Save(ctx, creds)
+	}
+	if err != nil {
+		return err
+	}
+	return s.inner.Save(ctx, sealed)
+}
+
+// Get retrieves credentials by ID and decrypts their tokens.
+// When encryption is enabled, plaintext tokens are encrypted in the store.
+func (s *CredentialsStore) Get(ctx context.Context, id string) (*domain.Credentials, error) {
+	creds, err := s.inner.Get(ctx, id)
+	if err != nil || creds == nil {
+		return creds, err
+	}
+	return s.read(ctx, creds)
+}
+
+// GetBySourceID retrieves credentials for a source and decrypts their tokens.
+// When encryption is enabled, plaintext tokens are encrypted in the store.
+func (s *CredentialsStore) GetBySourceID(ctx context.Context, sourceID string) (*domain.Credentials, error) {
+	creds, err := s.inner.GetBySourceID(ctx, sourceID)
+	if err != nil || creds == nil {
+		return creds, err
+	}
+	return s.read(ctx, creds)
+}
+
+// GetAllByAuthProviderID retrieves all accounts for an auth provider and decrypts their tokens.
+func (s *CredentialsStore) GetAllByAuthProviderID(
+	ctx context.Context, authProviderID string,
+) ([]domain.Credentials, error) {
+	all, err := s.inner.GetAllByAuthProviderID(ctx, authProviderID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range all {
+		if _, err := s.read(ctx, &all[i]); err != nil {
+			return nil, err
+		}
+	}
+	return all, nil
+}
+
+// Delete removes credentials by ID.
+func (s *CredentialsStore) Delete(ctx context.Context, id string) error {
+	return s.inner.Delete(ctx, id)
+}
+
+// encryptionEnabled reports whether new saves are encrypted.
+func (s *CredentialsStore) encryptionEnabled() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.encrypt || s.passphrase != ""
+}
+
+// read migrates and opens credentials read from the inner store. If their
+// plaintext tokens cannot be encrypted, e.g. because the keychain is
+// unavailable, they are used in plaintext with a warning.
+func (s *CredentialsStore) read(ctx context.Context, creds *domain.Credentials) (*domain.Credentials, error) {
+	if err := s.migrate(ctx, creds); err != nil {
+		slog.Warn("could not encrypt stored credentials, using them in plaintext",
+			slog.String("credentials_id", creds.ID), slog.Any("error", err))
+	}
+	return s.open(creds)
+}
+
+// migrate encrypts plaintext tokens read from the inner store when encryption
+// is enabled, so credentials saved before it was enabled are protected on
+// first use. creds itself is not modified. On failure the stored credentials
+// are unchanged.
+func (s *CredentialsStore) migrate(ctx context.Context, creds *domain.Credentials) error {
+	if !s.encryptionEnabled() || !hasPlaintext(creds) {
+		return nil
+	}
+
+	sealed, err := s.seal(*creds)
+	if err != nil {
+		return err
+	}
+	return s.inner.Save(ctx, sealed)
+}
+
+// shouldEncrypt reports whether a save should be encrypted: either encryption
+// is enabled, or the stored credentials are already encrypted.
+func (s *CredentialsStore) shouldEncrypt(ctx context.Context, id string) (bool, error) {
+	if s.encryptionEnabled() {
+		return true, nil
+	}
+
+	existing, err := s.inner.Get(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return isSealed(existing), nil
+}
+
+// seal returns a copy of creds with its tokens encrypted.
+func (s *CredentialsStore) seal(creds domain.Credentials) (domain.Credentials, error) {
+	aead, prefix, err := s.sealer()
+	if err != nil {
+		return creds, err
+	}
+
+	if creds.OAuth != nil {
+		oauth := *creds.OAuth
+		if oauth.AccessToken, err = encryptValue(aead, prefix, oauth.AccessToken); err != nil {
+			return creds, err
+		}
+		if oauth.RefreshToken, err = encryptValue(aead, prefix, oauth.RefreshToken); err != nil {
+			return creds, err
+		}
+		creds.OAuth = &oauth
+	}
+	if creds.PAT != nil {
+		pat := *creds.PAT
+		if pat.Token, err = encryptValue(aead, prefix, pat.Token); err != nil {
+			return creds, err
+		}
+		creds.PAT = &pat
+	}
+	return creds, nil
+}
+
+// open decrypts the sealed tokens of creds in place. Plaintext tokens are
+// left unchanged, so credentials saved while the keychain was unavailable
+// stay usable; only sealed tokens that cannot be decrypted are an error.
+func (s *CredentialsStore) open(creds *domain.Credentials) (*domain.Credentials, error) {
+	if !isSealed(creds) {
+		return creds, nil
+	}
+
+	var values []*string
+	if creds.OAuth != nil {
+		values = append(values, &creds.OAuth.AccessToken, &creds.OAuth.RefreshToken)
+	}
+	if creds.PAT != nil {
+		values = append(values, &creds.PAT.Token)
+	}
+	for _, value := range values {
+		if !isSealedValue(*value) {
+			continue
+		}
+		plaintext, err := s.decrypt(*value)
+		if err != nil {
+			return nil, fmt.Errorf("decrypting credentials %s: %w", creds.ID, err)
+		}
+		*value = plaintext
+	}
+	return creds, nil
+}
+
+// sealer returns the cipher and value prefix for sealing tokens: the
+// passphrase key if a passphrase is set, otherwise the keychain key.
+func (s *CredentialsStore) sealer() (cipher.AEAD, string, error) {
+	s.mu.Lock()
+	if s.passphrase != "" && s.salt == "" {
+		salt := make([]byte, saltSize)
+		if _, err := rand.Read(salt); err != nil {
+			s.mu.Unlock()
+			return nil, "", fmt.Errorf("generating salt: %w", err)
+		}
+		s.salt = base64.RawStdEncoding.EncodeToString(salt)
+	}
+	salt := s.salt
+	usePassphrase := s.passphrase != ""
+	s.mu.Unlock()
+
+	if !usePassphrase {
+		aead, err := s.cipher()
+		return aead, encryptedPrefix, err
+	}
+	aead, err := s.passphraseCipher(salt)
+	return aead, passphrasePrefix + salt + ":", err
+}
+
+// decrypt opens a sealed token with the key it was sealed with.
+// Plaintext values are returned unchanged.
+func (s *CredentialsStore) decrypt(value string) (string, error) {
+	if encoded, ok := strings.CutPrefix(value, encryptedPrefix); ok {
+		aead, err := s.cipher()
+		if err != nil {
+			return "", err
+		}
+		return decryptValue(aead, encoded)
+	}
+
+	rest, ok := strings.CutPrefix(value, passphrasePrefix)
+	if !ok {
+		return value, nil
+	}
+	salt, encoded, ok := strings.Cut(rest, ":")
+	if !ok {
+		return "", errors.New("decoding token: missing salt")
+	}
+	aead, err := s.passphraseCipher(salt)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := decryptValue(aead, encoded)
+	if err != nil {
+		return "", fmt.Errorf("%w (is the passphrase correct?)", err)
+	}
+	return plaintext, nil
+}
+
+// cipher returns the AES-GCM cipher, loading or creating the key in the keychain.
+func (s *CredentialsStore) cipher() (cipher.AEAD, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.aead != nil {
+		return s.aead, nil
+	}
+
+	key, err := loadOrCreateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	s.aead = aead
+	return aead, nil
+}
+
+// passphraseCipher returns the AES-GCM cipher for the passphrase key derived
+// with salt, which is base64 encoded.
+func (s *CredentialsStore) passphraseCipher(salt string) (cipher.AEAD, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.passphrase == "" {
+		return nil, ErrPassphraseRequired
+	}
+	if aead, ok := s.passphraseAEADs[salt]; ok {
+		return aead, nil
+	}
+
+	rawSalt, err := base64.RawStdEncoding.DecodeString(salt)
+	if err != nil {
+		return nil, fmt.Errorf("decoding salt: %w", err)
+	}
+	key, err := pbkdf2.Key(sha256.New, s.passphrase, rawSalt, s.iterations, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("deriving key: %w", err)
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	s.passphraseAEADs[salt] = aead
+	return aead, nil
+}
+
+// newGCM creates an AES-GCM cipher with key.
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("creating cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("creating cipher: %w", err)
+	}
+	return aead, nil
+}
+
+// loadOrCreateKey reads the encryption key from the keychain, generating it on first use.
+func loadOrCreateKey() ([]byte, error) {
+	encoded, err := keyring.Get(keyringService, keyringUser)
+	if err == nil {
+		key, decodeErr := base64.StdEncoding.DecodeString(encoded)
+		if decodeErr != nil || len(key) != keySize {
+			return nil, fmt.Errorf("%w: stored key is invalid", ErrKeychainUnavailable)
+		}
+		return key, nil
+	}
+	if !errors.Is(err, keyring.ErrNotFound) {
+		return nil, fmt.Errorf("%w: %w", ErrKeychainUnavailable, err)
+	}
+
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generating key: %w", err)
+	}
+	if err := keyring.Set(keyringService, keyringUser, base64.StdEncoding.EncodeToString(key)); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrKeychainUnavailable, err)
+	}
+	return key, nil
+}
+
+// encryptValue seals a token, marking it with prefix. Empty and already
+// sealed values are returned unchanged.
+func encryptValue(aead cipher.AEAD, prefix, plaintext string) (string, error) {
+	if plaintext == "" || isSealedValue(plaintext) {
+		return plaintext, nil
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generating nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return prefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptValue opens a token sealed by encryptValue, without its prefix.
+func decryptValue(aead cipher.AEAD, encoded string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("decoding token: %w", err)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("decoding token: ciphertext too short")
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("decrypting token: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+// isSealedValue reports whether a token value is encrypted.
+func isSealedValue(value string) bool {
+	return strings.HasPrefix(value, encryptedPrefix) || strings.HasPrefix(value, passphrasePrefix)
+}
+
+// tokens returns the token values of creds.
+func tokens(creds *domain.Credentials) []string {
+	var values []string
+	if creds.OAuth != nil {
+		values = append(values, creds.OAuth.AccessToken, creds.OAuth.RefreshToken)
+	}
+	if creds.PAT != nil {
+		values = append(values, creds.PAT.Token)
+	}
+	return values
+}
+
+// isSealed reports whether any token in creds is encrypted.
+func isSealed(creds *domain.Credentials) bool {
+	return creds != nil && slices.ContainsFunc(tokens(creds), isSealedValue)
+}
+
+// hasPlaintext reports whether any non-empty token in creds is unencrypted.
+func hasPlaintext(creds *domain.Credentials) bool {
+	return creds != nil && slices.ContainsFunc(tokens(creds), func(value string) bool {
+		return value != "" && !isSealedValue(value)
+	})
+}
@@ -0,0 +1,364 @@
+package keychain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockCredentialsStore is an in-memory driven.CredentialsStore for testing.
+type mockCredentialsStore struct {
+	creds map[string]domain.Credentials
+}
+
+func newMockCredentialsStore() *mockCredentialsStore {
+	return &mockCredentialsStore{creds: make(map[string]domain.Credentials)}
+}
+
+func (m *mockCredentialsStore) Save(_ context.Context, creds domain.Credentials) error {
+	m.creds[creds.ID] = creds
+	return nil
+}
+
+func (m *mockCredentialsStore) Get(_ context.Context, id string) (*domain.Credentials, error) {
+	creds, ok := m.creds[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return copyCredentials(creds), nil
+}
+
+func (m *mockCredentialsStore) GetBySourceID(_ context.Context, sourceID string) (*domain.Credentials, error) {
+	for _, creds := range m.creds {
+		if creds.SourceID == sourceID {
+			return copyCredentials(creds), nil
+		}
+	}
+	return nil, nil
+}
+
+// GetAllByAuthProviderID returns every stored credential; the mock has no sources to filter by.
+func (m *mockCredentialsStore) GetAllByAuthProviderID(_ context.Context, _ string) ([]domain.Credentials, error) {
+	all := make([]domain.Credentials, 0, len(m.creds))
+	for _, creds := range m.creds {
+		all = append(all, *copyCredentials(creds))
+	}
+	return all, nil
+}
+
+func (m *mockCredentialsStore) Delete(_ context.Context, id string) error {
+	delete(m.creds, id)
+	return nil
+}
+
+// copyCredentials deep-copies creds, as a real store returns fresh values.
+func copyCredentials(creds domain.Credentials) *domain.Credentials {
+	if creds.OAuth != nil {
+		oauth := *creds.OAuth
+		creds.OAuth = &oauth
+	}
+	if creds.PAT != nil {
+		pat := *creds.PAT
+		creds.PAT = &pat
+	}
+	return &creds
+}
+
+func oauthCredentials() domain.Credentials {
+	return domain.Credentials{
+		ID:       "creds-1",
+		SourceID: "source-1",
+		OAuth: &domain.OAuthCredentials{
+			AccessToken:  "access-token",
+			RefreshToken: "refresh-token",
+			TokenType:    "Bearer",
+		},
+	}
+}
+
+func TestCredentialsStore_EncryptsOAuthTokens(t *testing.T) {
+	keyring.MockInit()
+	inner := newMockCredentialsStore()
+	store := NewCredentialsStore(inner)
+	store.SetEncryption(true)
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, oauthCredentials()))
+
+	raw := inner.creds["creds-1"]
+	assert.True(t, strings.HasPrefix(raw.OAuth.AccessToken, encryptedPrefix))
+	assert.True(t, strings.HasPrefix(raw.OAuth.RefreshToken, encryptedPrefix))
+	assert.NotContains(t, raw.OAuth.AccessToken, "access-token")
+	assert.Equal(t, "Bearer", raw.OAuth.TokenType)
+
+	got, err := store.Get(ctx, "creds-1")
+	require.NoError(t, err)
+	assert.Equal(t, "access-token", got.OAuth.AccessToken)
+	assert.Equal(t, "refresh-token", got.OAuth.RefreshToken)
+
+	bySource, err := store.GetBySourceID(ctx, "source-1")
+	require.NoError(t, err)
+	assert.Equal(t, "access-token", bySource.OAuth.AccessToken)
+}
+
+func TestCredentialsStore_EncryptsPAT(t *testing.T) {
+	keyring.MockInit()
+	inner := newMockCredentialsStore()
+	store := NewCredentialsStore(inner)
+	store.SetEncryption(true)
+	ctx := context.Background()
+
+	creds := domain.Credentials{ID: "creds-2", SourceID: "source-2", PAT: &domain.PATCredentials{Token: "ghp_secret"}}
+	require.NoError(t, store.Save(ctx, creds))
+
+	assert.True(t, strings.HasPrefix(inner.creds["creds-2"].PAT.Token, encryptedPrefix))
+	// Caller's value is not modified
+	assert.Equal(t, "ghp_secret", creds.PAT.Token)
+
+	got, err := store.Get(ctx, "creds-2")
+	require.NoError(t, err)
+	assert.Equal(t, "ghp_secret", got.PAT.Token)
+}
+
+func TestCredentialsStore_DisabledStoresPlaintext(t *testing.T) {
+	keyring.MockInit()
+	inner := newMockCredentialsStore()
+	store := NewCredentialsStore(inner)
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, oauthCredentials()))
+
+	assert.Equal(t, "access-token", inner.creds["creds-1"].OAuth.AccessToken)
+}
+
+func TestCredentialsStore_GetAllByAuthProviderIDDecrypts(t *testing.T) {
+	keyring.MockInit()
+	inner := newMockCredentialsStore()
+	store := NewCredentialsStore(inner)
+	store.SetEncryption(true)
+	ctx := context.Background()
+	require.NoError(t, store.Save(ctx, oauthCredentials()))
+
+	all, err := store.GetAllByAuthProviderID(ctx, "auth-provider-1")
+
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, "access-token", all[0].OAuth.AccessToken)
+	assert.Equal(t, "refresh-token", all[0].OAuth.RefreshToken)
+}
+
+func TestCredentialsStore_KeepsEncryptedCredentialsEncrypted(t *testing.T) {
+	keyring.MockInit()
+	inner := newMockCredentialsStore()
+	store := NewCredentialsStore(inner)
+	ctx := context.Background()
+
+	store.SetEncryption(true)
+	require.NoError(t, store.Save(ctx, oauthCredentials()))
+
+	// A later save without the flag (e.g., token refresh) stays encrypted
+	store.SetEncryption(false)
+	refreshed := oauthCredentials()
+	refreshed.OAuth.AccessToken = "new-access-token"
+	require.NoError(t, store.Save(ctx, refreshed))
+
+	assert.True(t, strings.HasPrefix(inner.creds["creds-1"].OAuth.AccessToken, encryptedPrefix))
+	got, err := store.Get(ctx, "creds-1")
+	require.NoError(t, err)
+	assert.Equal(t, "new-access-token", got.OAuth.AccessToken)
+}
+
+func TestCredentialsStore_ReadsPlaintext(t *testing.T) {
+	keyring.MockInitWithError(errors.New("no keychain"))
+	inner := newMockCredentialsStore()
+	require.NoError(t, inner.Save(context.Background(), oauthCredentials()))
+	store := NewCredentialsStore(inner)
+
+	got, err := store.Get(context.Background(), "creds-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "access-token", got.OAuth.AccessToken)
+}
+
+func TestCredentialsStore_KeychainUnavailableFallsBackToPlaintext(t *testing.T) {
+	keyring.MockInitWithError(errors.New("no keychain"))
+	inner := newMockCredentialsStore()
+	store := NewCredentialsStore(inner)
+	store.SetEncryption(true)
+
+	err := store.Save(context.Background(), oauthCredentials())
+
+	require.NoError(t, err)
+	assert.Equal(t, "access-token", inner.creds["creds-1"].OAuth.AccessToken)
+}
+
+func TestCredentialsStore_DecryptWithoutKeychainFails(t *testing.T) {
+	keyring.MockInit()
+	inner := newMockCredentialsStore()
+	store := NewCredentialsStore(inner)
+	store.SetEncryption(true)
+	require.NoError(t, store.Save(context.Background(), oauthCredentials()))
+
+	keyring.MockInitWithError(errors.New("no keychain"))
+	_, err := NewCredentialsStore(inner).Get(context.Background(), "creds-1")
+
+	assert.ErrorIs(t, err, ErrKeychainUnavailable)
+}
+
+func TestCredentialsStore_KeychainUnavailableReadsPlaintextTokens(t *testing.T) {
+	keyring.MockInit()
+	inner := newMockCredentialsStore()
+	ctx := context.Background()
+	store := NewCredentialsStore(inner)
+	store.SetEncryption(true)
+	require.NoError(t, store.Save(ctx, oauthCredentials()))
+
+	// A token refreshed while the keychain was unavailable is stored in plaintext
+	keyring.MockInitWithError(errors.New("no keychain"))
+	stored := inner.creds["creds-1"]
+	stored.OAuth.AccessToken = "refreshed-token"
+	inner.creds["creds-1"] = stored
+	require.NoError(t, NewCredentialsStore(inner).Save(ctx, domain.Credentials{
+		ID: "creds-2", SourceID: "source-2", PAT: &domain.PATCredentials{Token: "pat-token"},
+	}))
+
+	reader := NewCredentialsStore(inner)
+	reader.SetEncryption(true)
+
+	got, err := reader.Get(ctx, "creds-2")
+	require.NoError(t, err, "plaintext credentials are read without the keychain")
+	assert.Equal(t, "pat-token", got.PAT.Token)
+
+	_, err = reader.Get(ctx, "creds-1")
+	assert.ErrorIs(t, err, ErrKeychainUnavailable, "sealed tokens need the keychain")
+}
+
+func TestCredentialsStore_ReusesKeyAcrossInstances(t *testing.T) {
+	keyring.MockInit()
+	inner := newMockCredentialsStore()
+	first := NewCredentialsStore(inner)
+	first.SetEncryption(true)
+	require.NoError(t, first.Save(context.Background(), oauthCredentials()))
+
+	got, err := NewCredentialsStore(inner).Get(context.Background(), "creds-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "access-token", got.OAuth.AccessToken)
+}
+
+func TestCredentialsStore_GetNotFound(t *testing.T) {
+	keyring.MockInit()
+	store := NewCredentialsStore(newMockCredentialsStore())
+
+	_, err := store.Get(context.Background(), "missing")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	creds, err := store.GetBySourceID(context.Background(), "missing")
+	require.NoError(t, err)
+	assert.Nil(t, creds)
+}
+
+func TestCredentialsStore_Delete(t *testing.T) {
+	keyring.MockInit()
+	inner := newMockCredentialsStore()
+	store := NewCredentialsStore(inner)
+	require.NoError(t, store.Save(context.Background(), oauthCredentials()))
+
+	require.NoError(t, store.Delete(context.Background(), "creds-1"))
+
+	assert.Empty(t, inner.creds)
+}
+
+// newPassphraseStore returns a store sealing with passphrase, using a low
+// PBKDF2 work factor to keep tests fast.
+func newPassphraseStore(inner *mockCredentialsStore, passphrase string) *CredentialsStore {
+	store := NewCredentialsStore(inner)
+	store.iterations = 1000
+	store.SetPassphrase(passphrase)
+	return store
+}
+
+func TestCredentialsStore_PassphraseRoundTrip(t *testing.T) {
+	keyring.MockInitWithError(errors.New("no keychain"))
+	inner := newMockCredentialsStore()
+	ctx := context.Background()
+
+	require.NoError(t, newPassphraseStore(inner, "correct horse").Save(ctx, oauthCredentials()))
+
+	raw := inner.creds["creds-1"]
+	assert.True(t, strings.HasPrefix(raw.OAuth.AccessToken, passphrasePrefix))
+	assert.True(t, strings.HasPrefix(raw.OAuth.RefreshToken, passphrasePrefix))
+	assert.NotContains(t, raw.OAuth.AccessToken, "access-token")
+
+	// A new process derives the same key from the stored salt
+	got, err := newPassphraseStore(inner, "correct horse").Get(ctx, "creds-1")
+	require.NoError(t, err)
+	assert.Equal(t, "access-token", got.OAuth.AccessToken)
+	assert.Equal(t, "refresh-token", got.OAuth.RefreshToken)
+}
+
+func TestCredentialsStore_PassphraseUnavailable(t *testing.T) {
+	keyring.MockInitWithError(errors.New("no keychain"))
+	inner := newMockCredentialsStore()
+	ctx := context.Background()
+	require.NoError(t, newPassphraseStore(inner, "correct horse").Save(ctx, oauthCredentials()))
+
+	_, err := NewCredentialsStore(inner).Get(ctx, "creds-1")
+	assert.ErrorIs(t, err, ErrPassphraseRequired)
+
+	_, err = newPassphraseStore(inner, "wrong").Get(ctx, "creds-1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is the passphrase correct?")
+}
+
+func TestCredentialsStore_MigratesPlaintextOnRead(t *testing.T) {
+	keyring.MockInit()
+	inner := newMockCredentialsStore()
+	ctx := context.Background()
+	require.NoError(t, inner.Save(ctx, oauthCredentials()))
+	store := NewCredentialsStore(inner)
+	store.SetEncryption(true)
+
+	got, err := store.GetBySourceID(ctx, "source-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "access-token", got.OAuth.AccessToken)
+	assert.True(t, strings.HasPrefix(inner.creds["creds-1"].OAuth.AccessToken, encryptedPrefix))
+	assert.True(t, strings.HasPrefix(inner.creds["creds-1"].OAuth.RefreshToken, encryptedPrefix))
+}
+
+func TestCredentialsStore_MigrationFailureKeepsPlaintext(t *testing.T) {
+	keyring.MockInitWithError(errors.New("no keychain"))
+	inner := newMockCredentialsStore()
+	ctx := context.Background()
+	require.NoError(t, inner.Save(ctx, oauthCredentials()))
+	store := NewCredentialsStore(inner)
+	store.SetEncryption(true)
+
+	got, err := store.Get(ctx, "creds-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "access-token", got.OAuth.AccessToken)
+	assert.Equal(t, "access-token", inner.creds["creds-1"].OAuth.AccessToken)
+}
+
+func TestCredentialsStore_ReadsKeychainValuesWithPassphraseSet(t *testing.T) {
+	keyring.MockInit()
+	inner := newMockCredentialsStore()
+	ctx := context.Background()
+	keychainStore := NewCredentialsStore(inner)
+	keychainStore.SetEncryption(true)
+	require.NoError(t, keychainStore.Save(ctx, oauthCredentials()))
+
+	got, err := newPassphraseStore(inner, "correct horse").Get(ctx, "creds-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "access-token", got.OAuth.AccessToken)
+}
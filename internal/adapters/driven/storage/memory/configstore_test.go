@@ -237,6 +237,21 @@ func TestConfigStore_GetInt_Zero(t *testing.T) {
 	assert.Equal(t, 0, val)
 }
 
+func TestConfigStore_GetFloat(t *testing.T) {
+	store := NewConfigStore()
+
+	_ = store.Set("float", 1.2)
+	_ = store.Set("int", 2)
+	_ = store.Set("int64", int64(3))
+	_ = store.Set("string", "1.5")
+
+	assert.InDelta(t, 1.2, store.GetFloat("float"), 1e-9)
+	assert.InDelta(t, 2.0, store.GetFloat("int"), 1e-9)
+	assert.InDelta(t, 3.0, store.GetFloat("int64"), 1e-9)
+	assert.Zero(t, store.GetFloat("string"))
+	assert.Zero(t, store.GetFloat("nonexistent"))
+}
+
 func TestConfigStore_GetBool_Success(t *testing.T) {
 	store := NewConfigStore()
 
@@ -9,7 +9,12 @@ import (
 )
 
 // Ensure DocumentStore implements the interface.
-var _ driven.DocumentStore = (*DocumentStore)(nil)
+var (
+	_ driven.DocumentStore        = (*DocumentStore)(nil)
+	_ driven.DocumentCounter      = (*DocumentStore)(nil)
+	_ driven.DocumentBatchDeleter = (*DocumentStore)(nil)
+	_ driven.DocumentSourceLister = (*DocumentStore)(nil)
+)
 
 // DocumentStore is an in-memory implementation of driven.DocumentStore.
 type DocumentStore struct {
@@ -91,6 +96,17 @@ func (s *DocumentStore) DeleteDocument(_ context.Context, id string) error {
 	return nil
 }
 
+// DeleteDocuments removes the given documents and their chunks.
+func (s *DocumentStore) DeleteDocuments(_ context.Context, ids []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range ids {
+		delete(s.documents, id)
+		delete(s.chunks, id)
+	}
+	return nil
+}
+
 // ListDocuments returns documents for a source.
 func (s *DocumentStore) ListDocuments(_ context.Context, sourceID string) ([]domain.Document, error) {
 	s.mu.RLock()
@@ -104,3 +120,33 @@ func (s *DocumentStore) ListDocuments(_ context.Context, sourceID string) ([]dom
 	}
 	return result, nil
 }
+
+// CountDocuments returns the number of documents for a source.
+func (s *DocumentStore) CountDocuments(_ context.Context, sourceID string) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	count := 0
+	for id := range s.documents {
+		if s.documents[id].SourceID == sourceID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// DocumentSourceIDs returns the distinct source IDs of stored documents.
+func (s *DocumentStore) DocumentSourceIDs(_ context.Context) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	seen := make(map[string]struct{})
+	var ids []string
+	for id := range s.documents {
+		sourceID := s.documents[id].SourceID
+		if _, ok := seen[sourceID]; ok {
+			continue
+		}
+		seen[sourceID] = struct{}{}
+		ids = append(ids, sourceID)
+	}
+	return ids, nil
+}
@@ -380,6 +380,50 @@ func TestDocumentStore_ListDocuments_Empty(t *testing.T) {
 	assert.Nil(t, docs)
 }
 
+func TestDocumentStore_CountDocuments(t *testing.T) {
+	store := NewDocumentStore()
+	ctx := context.Background()
+	_ = store.SaveDocument(ctx, &domain.Document{ID: "doc-1", SourceID: "src-1"})
+	_ = store.SaveDocument(ctx, &domain.Document{ID: "doc-2", SourceID: "src-1"})
+	_ = store.SaveDocument(ctx, &domain.Document{ID: "doc-3", SourceID: "src-2"})
+
+	count, err := store.CountDocuments(ctx, "src-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestDocumentStore_DocumentSourceIDs(t *testing.T) {
+	store := NewDocumentStore()
+	ctx := context.Background()
+	_ = store.SaveDocument(ctx, &domain.Document{ID: "doc-1", SourceID: "src-1"})
+	_ = store.SaveDocument(ctx, &domain.Document{ID: "doc-2", SourceID: "src-1"})
+	_ = store.SaveDocument(ctx, &domain.Document{ID: "doc-3", SourceID: "src-2"})
+
+	ids, err := store.DocumentSourceIDs(ctx)
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"src-1", "src-2"}, ids)
+}
+
+func TestDocumentStore_DeleteDocuments(t *testing.T) {
+	store := NewDocumentStore()
+	ctx := context.Background()
+	_ = store.SaveDocument(ctx, &domain.Document{ID: "doc-1", SourceID: "src-1"})
+	_ = store.SaveDocument(ctx, &domain.Document{ID: "doc-2", SourceID: "src-1"})
+	_ = store.SaveChunks(ctx, []domain.Chunk{{ID: "chunk-1", DocumentID: "doc-1"}})
+
+	err := store.DeleteDocuments(ctx, []string{"doc-1", "doc-missing"})
+
+	require.NoError(t, err)
+	_, err = store.GetDocument(ctx, "doc-1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	chunks, _ := store.GetChunks(ctx, "doc-1")
+	assert.Empty(t, chunks)
+	_, err = store.GetDocument(ctx, "doc-2")
+	assert.NoError(t, err)
+}
+
 func TestDocumentStore_ListDocuments_Success(t *testing.T) {
 	store := NewDocumentStore()
 	ctx := context.Background()
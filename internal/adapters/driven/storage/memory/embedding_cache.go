@@ -0,0 +1,141 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure EmbeddingCache implements the interfaces.
+var (
+	_ driven.EmbeddingCache         = (*EmbeddingCache)(nil)
+	_ driven.DocumentEmbeddingCache = (*EmbeddingCache)(nil)
+)
+
+// cachedEmbedding is an embedding and the model that computed it.
+type cachedEmbedding struct {
+	model     string
+	embedding []float32
+}
+
+// documentHash is the content hash a document was embedded from.
+type documentHash struct {
+	sourceID    string
+	uri         string
+	contentHash string
+	model       string
+}
+
+// EmbeddingCache is an in-memory implementation of driven.EmbeddingCache.
+type EmbeddingCache struct {
+	mu      sync.RWMutex
+	entries map[string]cachedEmbedding
+
+	// Document hashes by document ID, and the IDs in the order recorded
+	documents map[string]documentHash
+	order     []string
+}
+
+// NewEmbeddingCache creates a new in-memory embedding cache.
+func NewEmbeddingCache() *EmbeddingCache {
+	return &EmbeddingCache{
+		entries:   make(map[string]cachedEmbedding),
+		documents: make(map[string]documentHash),
+	}
+}
+
+// Get returns the embedding cached under key.
+func (c *EmbeddingCache) Get(_ context.Context, key string) ([]float32, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return slices.Clone(entry.embedding), nil
+}
+
+// Put caches an embedding computed by model under key.
+func (c *EmbeddingCache) Put(_ context.Context, key, model string, embedding []float32) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = cachedEmbedding{model: model, embedding: slices.Clone(embedding)}
+	return nil
+}
+
+// DeleteOtherModels removes embeddings and document hashes computed by any
+// model other than the given ones. Only removed embeddings are counted.
+func (c *EmbeddingCache) DeleteOtherModels(_ context.Context, models ...string) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := 0
+	for key, entry := range c.entries {
+		if !slices.Contains(models, entry.model) {
+			delete(c.entries, key)
+			removed++
+		}
+	}
+	for id, doc := range c.documents {
+		if !slices.Contains(models, doc.model) {
+			c.deleteDocument(id)
+		}
+	}
+	return removed, nil
+}
+
+// LatestDocument returns the document most recently embedded by model for
+// uri in a source, and the content hash it was embedded from.
+func (c *EmbeddingCache) LatestDocument(
+	_ context.Context, sourceID, uri, model string,
+) (documentID, contentHash string, err error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for i := len(c.order) - 1; i >= 0; i-- {
+		doc := c.documents[c.order[i]]
+		if doc.sourceID == sourceID && doc.uri == uri && doc.model == model {
+			return c.order[i], doc.contentHash, nil
+		}
+	}
+	return "", "", domain.ErrNotFound
+}
+
+// PutDocument records the content hash doc's chunks were embedded from.
+func (c *EmbeddingCache) PutDocument(_ context.Context, doc *domain.Document, contentHash, model string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.deleteDocument(doc.ID)
+	c.documents[doc.ID] = documentHash{
+		sourceID:    doc.SourceID,
+		uri:         doc.URI,
+		contentHash: contentHash,
+		model:       model,
+	}
+	c.order = append(c.order, doc.ID)
+	return nil
+}
+
+// DeleteDocument removes the hash recorded for a document.
+func (c *EmbeddingCache) DeleteDocument(_ context.Context, documentID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.deleteDocument(documentID)
+	return nil
+}
+
+// deleteDocument removes a document hash. The caller must hold the lock.
+func (c *EmbeddingCache) deleteDocument(documentID string) {
+	if _, ok := c.documents[documentID]; !ok {
+		return
+	}
+	delete(c.documents, documentID)
+	c.order = slices.DeleteFunc(c.order, func(id string) bool { return id == documentID })
+}
@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestEmbeddingCache_PutAndGet(t *testing.T) {
+	cache := NewEmbeddingCache()
+	ctx := context.Background()
+
+	require.NoError(t, cache.Put(ctx, "key-1", "model-a", []float32{0.1, 0.2}))
+
+	embedding, err := cache.Get(ctx, "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, []float32{0.1, 0.2}, embedding)
+
+	_, err = cache.Get(ctx, "missing")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestEmbeddingCache_DeleteOtherModels(t *testing.T) {
+	cache := NewEmbeddingCache()
+	ctx := context.Background()
+	require.NoError(t, cache.Put(ctx, "old", "model-a", []float32{1}))
+	require.NoError(t, cache.Put(ctx, "new", "model-b", []float32{2}))
+
+	removed, err := cache.DeleteOtherModels(ctx, "model-b")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	_, err = cache.Get(ctx, "old")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestEmbeddingCache_DocumentHashes(t *testing.T) {
+	cache := NewEmbeddingCache()
+	ctx := context.Background()
+	first := &domain.Document{ID: "doc-1", SourceID: "source-1", URI: "a.txt"}
+	second := &domain.Document{ID: "doc-2", SourceID: "source-1", URI: "a.txt"}
+	require.NoError(t, cache.PutDocument(ctx, first, "hash-1", "model-a"))
+	require.NoError(t, cache.PutDocument(ctx, second, "hash-2", "model-a"))
+
+	documentID, hash, err := cache.LatestDocument(ctx, "source-1", "a.txt", "model-a")
+	require.NoError(t, err)
+	assert.Equal(t, "doc-2", documentID)
+	assert.Equal(t, "hash-2", hash)
+
+	require.NoError(t, cache.DeleteDocument(ctx, "doc-2"))
+	documentID, _, err = cache.LatestDocument(ctx, "source-1", "a.txt", "model-a")
+	require.NoError(t, err)
+	assert.Equal(t, "doc-1", documentID)
+
+	_, err = cache.DeleteOtherModels(ctx, "model-b")
+	require.NoError(t, err)
+	_, _, err = cache.LatestDocument(ctx, "source-1", "a.txt", "model-a")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,52 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure RebuildStateStore implements the interface.
+var _ driven.RebuildStateStore = (*RebuildStateStore)(nil)
+
+// RebuildStateStore is an in-memory implementation of driven.RebuildStateStore.
+type RebuildStateStore struct {
+	mu     sync.RWMutex
+	states map[string]domain.RebuildState
+}
+
+// NewRebuildStateStore creates a new in-memory rebuild state store.
+func NewRebuildStateStore() *RebuildStateStore {
+	return &RebuildStateStore{
+		states: make(map[string]domain.RebuildState),
+	}
+}
+
+// Save stores or updates the watermark for a rebuild scope.
+func (s *RebuildStateStore) Save(_ context.Context, state domain.RebuildState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.states[state.Scope] = state
+	return nil
+}
+
+// Get retrieves the watermark for a rebuild scope.
+func (s *RebuildStateStore) Get(_ context.Context, scope string) (*domain.RebuildState, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	state, ok := s.states[scope]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &state, nil
+}
+
+// Delete removes the watermark for a rebuild scope.
+func (s *RebuildStateStore) Delete(_ context.Context, scope string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.states, scope)
+	return nil
+}
@@ -0,0 +1,40 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestRebuildStateStore_SaveAndGet(t *testing.T) {
+	store := NewRebuildStateStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, domain.RebuildState{Scope: "src-1", SourceID: "src-1", DocumentID: "doc-2"}))
+
+	state, err := store.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "doc-2", state.DocumentID)
+}
+
+func TestRebuildStateStore_GetNotFound(t *testing.T) {
+	store := NewRebuildStateStore()
+
+	_, err := store.Get(context.Background(), "")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestRebuildStateStore_Delete(t *testing.T) {
+	store := NewRebuildStateStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, domain.RebuildState{Scope: "", SourceID: "src-1"}))
+	require.NoError(t, store.Delete(ctx, ""))
+
+	_, err := store.Get(ctx, "")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,52 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure SourceHealthStore implements the interface.
+var _ driven.SourceHealthStore = (*SourceHealthStore)(nil)
+
+// SourceHealthStore is an in-memory implementation of driven.SourceHealthStore.
+type SourceHealthStore struct {
+	mu      sync.RWMutex
+	results map[string]domain.SourceHealth
+}
+
+// NewSourceHealthStore creates a new in-memory source health store.
+func NewSourceHealthStore() *SourceHealthStore {
+	return &SourceHealthStore{
+		results: make(map[string]domain.SourceHealth),
+	}
+}
+
+// Save stores or replaces the health result for a source.
+func (s *SourceHealthStore) Save(_ context.Context, health domain.SourceHealth) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.results[health.SourceID] = health
+	return nil
+}
+
+// Get retrieves the health result for a source.
+func (s *SourceHealthStore) Get(_ context.Context, sourceID string) (*domain.SourceHealth, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	health, ok := s.results[sourceID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &health, nil
+}
+
+// Delete removes the health result for a source.
+func (s *SourceHealthStore) Delete(_ context.Context, sourceID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.results, sourceID)
+	return nil
+}
@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestSourceHealthStore_SaveAndGet(t *testing.T) {
+	store := NewSourceHealthStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, domain.SourceHealth{
+		SourceID:  "src-1",
+		Error:     "unauthorized",
+		CheckedAt: time.Now(),
+	}))
+
+	health, err := store.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "unauthorized", health.Error)
+	assert.Equal(t, domain.HealthFailed, health.Status())
+}
+
+func TestSourceHealthStore_GetNotFound(t *testing.T) {
+	store := NewSourceHealthStore()
+
+	_, err := store.Get(context.Background(), "missing")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestSourceHealthStore_Delete(t *testing.T) {
+	store := NewSourceHealthStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, domain.SourceHealth{SourceID: "src-1"}))
+	require.NoError(t, store.Delete(ctx, "src-1"))
+
+	_, err := store.Get(ctx, "src-1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,232 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/sqlite/migrations"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// SchemaVersion returns the schema version of the open database.
+func (s *Store) SchemaVersion(ctx context.Context) (int, error) {
+	return schemaVersion(ctx, s.db)
+}
+
+// Snapshot writes a consistent copy of the database to destPath.
+// VACUUM INTO reads inside a transaction, so it is safe while the database is
+// in use. destPath must not already exist.
+func (s *Store) Snapshot(ctx context.Context, destPath string) error {
+	if _, err := s.db.ExecContext(ctx, "VACUUM INTO ?", destPath); err != nil {
+		return fmt.Errorf("snapshot database: %w", err)
+	}
+	return nil
+}
+
+// LastSync returns when a source last finished syncing, or the zero time if
+// none has.
+func (s *Store) LastSync(ctx context.Context) (time.Time, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT last_sync FROM sync_states WHERE last_sync IS NOT NULL")
+	if err != nil {
+		return time.Time{}, fmt.Errorf("querying last sync: %w", err)
+	}
+	defer rows.Close()
+
+	var latest time.Time
+	for rows.Next() {
+		var lastSync sql.NullTime
+		if err := rows.Scan(&lastSync); err != nil {
+			return time.Time{}, fmt.Errorf("scanning last sync: %w", err)
+		}
+		if lastSync.Valid && lastSync.Time.After(latest) {
+			latest = lastSync.Time
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return time.Time{}, fmt.Errorf("iterating last sync: %w", err)
+	}
+	return latest, nil
+}
+
+// RemoveSecrets clears the credential tokens and OAuth client secrets from
+// the database copy at path, e.g. a snapshot about to be archived, and
+// vacuums it so the removed values do not linger in free pages.
+func (s *Store) RemoveSecrets(ctx context.Context, path string) error {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return fmt.Errorf("open database: %w", err)
+	}
+	defer db.Close()
+
+	statements := []string{
+		"UPDATE credentials SET oauth = NULL, pat = NULL",
+		`UPDATE auth_providers SET oauth = json_set(oauth, '$.client_secret', '')
+			WHERE CASE WHEN json_valid(oauth) THEN json_type(oauth) END = 'object'`,
+		"VACUUM",
+	}
+	for _, stmt := range statements {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("remove secrets: %w", err)
+		}
+	}
+	return nil
+}
+
+// Restore replaces the database with the copy at srcPath, then migrates it to
+// the current schema. The copy is validated first: if it is not a sercha
+// database or was created by a newer version, domain.ErrInvalidBackup is
+// returned and the live database is left untouched. Secrets removed from the
+// copy by RemoveSecrets are taken from the live database where it has them.
+func (s *Store) Restore(ctx context.Context, srcPath string) error {
+	if err := ValidateDatabase(ctx, srcPath); err != nil {
+		return err
+	}
+
+	// Stage the copy next to the live database so the swap is a rename
+	tmpPath, err := copyToTemp(srcPath, filepath.Dir(s.path))
+	if err != nil {
+		return fmt.Errorf("stage database: %w", err)
+	}
+	defer os.Remove(tmpPath)
+
+	if err := s.keepSecrets(ctx, tmpPath); err != nil {
+		return err
+	}
+
+	if err := s.db.Close(); err != nil {
+		return fmt.Errorf("close database: %w", err)
+	}
+
+	// The WAL belongs to the old database and must not be replayed on the new one
+	for _, suffix := range []string{"-wal", "-shm"} {
+		if err := os.Remove(s.path + suffix); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return errors.Join(fmt.Errorf("remove %s file: %w", suffix, err), s.open())
+		}
+	}
+
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return errors.Join(fmt.Errorf("replace database: %w", err), s.open())
+	}
+
+	return s.open()
+}
+
+// keepSecrets copies the live credential tokens and OAuth client secrets into
+// the database at path, for the rows that exist in both and have none there.
+func (s *Store) keepSecrets(ctx context.Context, path string) error {
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("keep secrets: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "ATTACH DATABASE ? AS restored", path); err != nil {
+		return fmt.Errorf("keep secrets: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), "DETACH DATABASE restored") //nolint:errcheck // best effort
+
+	// Backups of databases older than the auth tables have no secrets to keep
+	var tables int
+	err = conn.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM restored.sqlite_master
+		WHERE type = 'table' AND name IN ('credentials', 'auth_providers')
+	`).Scan(&tables)
+	if err != nil {
+		return fmt.Errorf("keep secrets: %w", err)
+	}
+	if tables < 2 {
+		return nil
+	}
+
+	statements := []string{
+		`UPDATE restored.credentials AS c SET oauth = live.oauth, pat = live.pat
+			FROM main.credentials AS live
+			WHERE c.id = live.id AND c.oauth IS NULL AND c.pat IS NULL`,
+		`UPDATE restored.auth_providers AS p
+			SET oauth = json_set(p.oauth, '$.client_secret', json_extract(live.oauth, '$.client_secret'))
+			FROM main.auth_providers AS live
+			WHERE p.id = live.id
+				AND CASE WHEN json_valid(p.oauth) THEN json_type(p.oauth) END = 'object'
+				AND CASE WHEN json_valid(live.oauth) THEN json_type(live.oauth) END = 'object'
+				AND json_extract(p.oauth, '$.client_secret') = ''
+				AND json_extract(live.oauth, '$.client_secret') IS NOT NULL`,
+	}
+	for _, stmt := range statements {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("keep secrets: %w", err)
+		}
+	}
+	return nil
+}
+
+// ValidateDatabase checks that the database at path is a sercha database
+// with a schema this version can migrate.
+func ValidateDatabase(ctx context.Context, path string) error {
+	migrationList, err := LoadMigrations(migrations.FS)
+	if err != nil {
+		return err
+	}
+	latest := NewMigrator(nil, migrationList).Latest()
+
+	db, err := sql.Open("sqlite", "file:"+path+"?mode=ro")
+	if err != nil {
+		return fmt.Errorf("%w: open database: %w", domain.ErrInvalidBackup, err)
+	}
+	defer db.Close()
+
+	var integrity string
+	if err := db.QueryRowContext(ctx, "PRAGMA quick_check").Scan(&integrity); err != nil {
+		return fmt.Errorf("%w: not a database: %w", domain.ErrInvalidBackup, err)
+	}
+	if integrity != "ok" {
+		return fmt.Errorf("%w: database is corrupt: %s", domain.ErrInvalidBackup, integrity)
+	}
+
+	version, err := schemaVersion(ctx, db)
+	if err != nil || version == 0 {
+		return fmt.Errorf("%w: not a sercha database", domain.ErrInvalidBackup)
+	}
+	if version > latest {
+		return fmt.Errorf("%w: schema version %d is newer than supported version %d; upgrade sercha first",
+			domain.ErrInvalidBackup, version, latest)
+	}
+
+	return nil
+}
+
+// copyToTemp copies src to a new temporary file in dir and returns its path.
+func copyToTemp(src, dir string) (string, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return "", err
+	}
+	defer in.Close()
+
+	out, err := os.CreateTemp(dir, ".metadata-restore-*.db")
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(out.Name())
+		return "", err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		os.Remove(out.Name())
+		return "", err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(out.Name())
+		return "", err
+	}
+	return out.Name(), nil
+}
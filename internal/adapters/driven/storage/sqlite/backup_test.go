@@ -0,0 +1,227 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/sqlite/migrations"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestStore_SnapshotAndRestore(t *testing.T) {
+	ctx := context.Background()
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	createTestSource(t, store, "src-1")
+	snapshot := filepath.Join(t.TempDir(), "snapshot.db")
+	require.NoError(t, store.Snapshot(ctx, snapshot))
+
+	// Changes after the snapshot are discarded by the restore
+	createTestSource(t, store, "src-2")
+	require.NoError(t, store.Restore(ctx, snapshot))
+
+	sources, err := store.SourceStore().List(ctx)
+	require.NoError(t, err)
+	require.Len(t, sources, 1)
+	assert.Equal(t, "src-1", sources[0].ID)
+
+	// The store is usable after the restore
+	createTestSource(t, store, "src-3")
+	_, err = store.SourceStore().Get(ctx, "src-3")
+	assert.NoError(t, err)
+}
+
+func TestStore_Snapshot_ExistingDestination(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	dest := filepath.Join(t.TempDir(), "snapshot.db")
+	require.NoError(t, os.WriteFile(dest, []byte("keep"), 0600))
+
+	assert.Error(t, store.Snapshot(context.Background(), dest))
+}
+
+func TestStore_SchemaVersion(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	version, err := store.SchemaVersion(context.Background())
+	require.NoError(t, err)
+
+	migrationList, err := LoadMigrations(migrations.FS)
+	require.NoError(t, err)
+	assert.Equal(t, NewMigrator(nil, migrationList).Latest(), version)
+}
+
+func TestStore_Restore_InvalidFileLeavesDatabase(t *testing.T) {
+	ctx := context.Background()
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	createTestSource(t, store, "src-1")
+
+	notADatabase := filepath.Join(t.TempDir(), "garbage.db")
+	require.NoError(t, os.WriteFile(notADatabase, []byte("this is not sqlite"), 0600))
+
+	err := store.Restore(ctx, notADatabase)
+	require.ErrorIs(t, err, domain.ErrInvalidBackup)
+
+	_, err = store.SourceStore().Get(ctx, "src-1")
+	assert.NoError(t, err)
+}
+
+func TestValidateDatabase_NewerSchema(t *testing.T) {
+	ctx := context.Background()
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	snapshot := filepath.Join(t.TempDir(), "snapshot.db")
+	require.NoError(t, store.Snapshot(ctx, snapshot))
+
+	// Simulate a backup from a future version
+	other, err := NewStore(filepath.Dir(snapshot))
+	require.NoError(t, err)
+	_, err = other.db.Exec("INSERT INTO migrations (version) VALUES (9999)")
+	require.NoError(t, err)
+	require.NoError(t, other.Close())
+
+	err = ValidateDatabase(ctx, filepath.Join(filepath.Dir(snapshot), "metadata.db"))
+	require.ErrorIs(t, err, domain.ErrInvalidBackup)
+	assert.Contains(t, err.Error(), "newer than supported")
+}
+
+func TestValidateDatabase_NotSerchaDatabase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "other.db")
+
+	// A valid SQLite file without a migrations table
+	db, err := sql.Open("sqlite", path)
+	require.NoError(t, err)
+	_, err = db.Exec("CREATE TABLE notes (id TEXT)")
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	err = ValidateDatabase(context.Background(), path)
+	require.ErrorIs(t, err, domain.ErrInvalidBackup)
+	assert.Contains(t, err.Error(), "not a sercha database")
+}
+
+func TestStore_LastSync(t *testing.T) {
+	ctx := context.Background()
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	lastSync, err := store.LastSync(ctx)
+	require.NoError(t, err)
+	assert.True(t, lastSync.IsZero())
+
+	createTestSource(t, store, "src-1")
+	createTestSource(t, store, "src-2")
+	older := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	newer := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	require.NoError(t, store.SyncStateStore().Save(ctx, domain.SyncState{SourceID: "src-1", LastSync: newer}))
+	require.NoError(t, store.SyncStateStore().Save(ctx, domain.SyncState{SourceID: "src-2", LastSync: older}))
+
+	lastSync, err = store.LastSync(ctx)
+	require.NoError(t, err)
+	assert.True(t, newer.Equal(lastSync), "got %v", lastSync)
+}
+
+func saveTestSecrets(t *testing.T, store *Store, sourceID, token, clientSecret string) {
+	t.Helper()
+	ctx := context.Background()
+	now := time.Now()
+	require.NoError(t, store.AuthProviderStore().Save(ctx, domain.AuthProvider{
+		ID: "provider-1", Name: "GitHub", ProviderType: domain.ProviderGitHub, AuthMethod: domain.AuthMethodOAuth,
+		OAuth:     &domain.OAuthProviderConfig{ClientID: "client-1", ClientSecret: clientSecret},
+		CreatedAt: now, UpdatedAt: now,
+	}))
+	require.NoError(t, store.CredentialsStore().Save(ctx, domain.Credentials{
+		ID: "creds-1", SourceID: sourceID,
+		OAuth:     &domain.OAuthCredentials{AccessToken: token, RefreshToken: "refresh-" + token},
+		CreatedAt: now, UpdatedAt: now,
+	}))
+}
+
+func TestStore_RemoveSecrets(t *testing.T) {
+	ctx := context.Background()
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	createTestSource(t, store, "src-1")
+	saveTestSecrets(t, store, "src-1", "access-1", "client-secret")
+
+	snapshot := filepath.Join(t.TempDir(), "snapshot.db")
+	require.NoError(t, store.Snapshot(ctx, snapshot))
+	require.NoError(t, store.RemoveSecrets(ctx, snapshot))
+
+	data, err := os.ReadFile(snapshot)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "access-1")
+	assert.NotContains(t, string(data), "client-secret")
+	assert.Contains(t, string(data), "client-1")
+
+	// The copy is still a restorable database
+	assert.NoError(t, ValidateDatabase(ctx, snapshot))
+}
+
+func TestStore_Restore_KeepsLiveSecrets(t *testing.T) {
+	ctx := context.Background()
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	createTestSource(t, store, "src-1")
+	saveTestSecrets(t, store, "src-1", "access-1", "client-secret")
+
+	snapshot := filepath.Join(t.TempDir(), "snapshot.db")
+	require.NoError(t, store.Snapshot(ctx, snapshot))
+	require.NoError(t, store.RemoveSecrets(ctx, snapshot))
+
+	// Tokens refreshed after the backup are the ones kept
+	saveTestSecrets(t, store, "src-1", "access-2", "client-secret")
+	require.NoError(t, store.Restore(ctx, snapshot))
+
+	creds, err := store.CredentialsStore().Get(ctx, "creds-1")
+	require.NoError(t, err)
+	require.NotNil(t, creds.OAuth)
+	assert.Equal(t, "access-2", creds.OAuth.AccessToken)
+	assert.Equal(t, "refresh-access-2", creds.OAuth.RefreshToken)
+
+	provider, err := store.AuthProviderStore().Get(ctx, "provider-1")
+	require.NoError(t, err)
+	require.NotNil(t, provider.OAuth)
+	assert.Equal(t, "client-1", provider.OAuth.ClientID)
+	assert.Equal(t, "client-secret", provider.OAuth.ClientSecret)
+}
+
+func TestStore_Restore_WithoutLiveSecrets(t *testing.T) {
+	ctx := context.Background()
+	source, cleanup := setupTestStore(t)
+	defer cleanup()
+	createTestSource(t, source, "src-1")
+	saveTestSecrets(t, source, "src-1", "access-1", "client-secret")
+
+	snapshot := filepath.Join(t.TempDir(), "snapshot.db")
+	require.NoError(t, source.Snapshot(ctx, snapshot))
+	require.NoError(t, source.RemoveSecrets(ctx, snapshot))
+
+	// A fresh machine has no secrets to keep; sources must re-authenticate
+	target, cleanupTarget := setupTestStore(t)
+	defer cleanupTarget()
+	require.NoError(t, target.Restore(ctx, snapshot))
+
+	creds, err := target.CredentialsStore().Get(ctx, "creds-1")
+	require.NoError(t, err)
+	assert.Nil(t, creds.OAuth)
+	assert.Nil(t, creds.PAT)
+
+	provider, err := target.AuthProviderStore().Get(ctx, "provider-1")
+	require.NoError(t, err)
+	require.NotNil(t, provider.OAuth)
+	assert.Equal(t, "client-1", provider.OAuth.ClientID)
+	assert.Empty(t, provider.OAuth.ClientSecret)
+}
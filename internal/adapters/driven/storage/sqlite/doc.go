@@ -13,7 +13,11 @@
 // # Schema
 //
 // The database schema is managed through versioned migrations stored in the
-// migrations/ directory. Each migration is a pair of .up.sql and .down.sql files.
+// migrations/ directory. Each migration is a pair of .up.sql and .down.sql
+// files. The Migrator applies pending up migrations in order when the store is
+// opened, each in its own transaction, and records them in the migrations
+// table. The down files are rollback scripts that are run by hand. Schema
+// changes are added as new migrations; applied migrations are never edited.
 //
 // # Data Location
 //
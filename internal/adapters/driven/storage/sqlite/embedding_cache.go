@@ -0,0 +1,128 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// embeddingCache implements driven.EmbeddingCache.
+type embeddingCache struct {
+	store *Store
+}
+
+var (
+	_ driven.EmbeddingCache         = (*embeddingCache)(nil)
+	_ driven.DocumentEmbeddingCache = (*embeddingCache)(nil)
+)
+
+// Get returns the embedding cached under key.
+func (c *embeddingCache) Get(ctx context.Context, key string) ([]float32, error) {
+	var blob []byte
+	err := c.store.db.QueryRowContext(ctx,
+		"SELECT embedding FROM embedding_cache WHERE key = ?", key,
+	).Scan(&blob)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("getting cached embedding: %w", err)
+	}
+	return bytesToFloat32Slice(blob), nil
+}
+
+// Put caches an embedding computed by model under key.
+func (c *embeddingCache) Put(ctx context.Context, key, model string, embedding []float32) error {
+	_, err := c.store.db.ExecContext(ctx, `
+		INSERT INTO embedding_cache (key, model, embedding, created_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(key) DO UPDATE SET
+			model = excluded.model,
+			embedding = excluded.embedding,
+			created_at = excluded.created_at
+	`, key, model, float32SliceToBytes(embedding), time.Now().UTC().Format(time.RFC3339))
+	if err != nil {
+		return fmt.Errorf("caching embedding: %w", err)
+	}
+	return nil
+}
+
+// DeleteOtherModels removes embeddings and document hashes computed by any
+// model other than the given ones. Only removed embeddings are counted.
+func (c *embeddingCache) DeleteOtherModels(ctx context.Context, models ...string) (int, error) {
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(models)), ",")
+	args := make([]any, len(models))
+	for i, model := range models {
+		args[i] = model
+	}
+
+	result, err := c.store.db.ExecContext(ctx,
+		"DELETE FROM embedding_cache WHERE model NOT IN ("+placeholders+")", args...)
+	if err != nil {
+		return 0, fmt.Errorf("invalidating embedding cache: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("invalidating embedding cache: %w", err)
+	}
+	_, err = c.store.db.ExecContext(ctx,
+		"DELETE FROM document_embeddings WHERE model NOT IN ("+placeholders+")", args...)
+	if err != nil {
+		return 0, fmt.Errorf("invalidating document embeddings: %w", err)
+	}
+	return int(n), nil
+}
+
+// LatestDocument returns the document most recently embedded by model for
+// uri in a source, and the content hash it was embedded from.
+func (c *embeddingCache) LatestDocument(
+	ctx context.Context, sourceID, uri, model string,
+) (documentID, contentHash string, err error) {
+	err = c.store.db.QueryRowContext(ctx, `
+		SELECT e.document_id, e.content_hash
+		FROM document_embeddings e
+		JOIN documents d ON d.id = e.document_id
+		WHERE d.source_id = ? AND d.uri = ? AND e.model = ?
+		ORDER BY e.embedded_at DESC, e.rowid DESC
+		LIMIT 1
+	`, sourceID, uri, model).Scan(&documentID, &contentHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", "", domain.ErrNotFound
+		}
+		return "", "", fmt.Errorf("getting document embedding hash: %w", err)
+	}
+	return documentID, contentHash, nil
+}
+
+// PutDocument records the content hash doc's chunks were embedded from.
+func (c *embeddingCache) PutDocument(ctx context.Context, doc *domain.Document, contentHash, model string) error {
+	_, err := c.store.db.ExecContext(ctx, `
+		INSERT INTO document_embeddings (document_id, content_hash, model, embedded_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(document_id) DO UPDATE SET
+			content_hash = excluded.content_hash,
+			model = excluded.model,
+			embedded_at = excluded.embedded_at
+	`, doc.ID, contentHash, model, time.Now().UTC().Format(time.RFC3339))
+	if err != nil {
+		return fmt.Errorf("recording document embedding hash: %w", err)
+	}
+	return nil
+}
+
+// DeleteDocument removes the hash recorded for a document. Hashes are also
+// removed with their document by the foreign key.
+func (c *embeddingCache) DeleteDocument(ctx context.Context, documentID string) error {
+	_, err := c.store.db.ExecContext(ctx, "DELETE FROM document_embeddings WHERE document_id = ?", documentID)
+	if err != nil {
+		return fmt.Errorf("deleting document embedding hash: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+func TestEmbeddingCache_PutAndGet(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	cache := store.EmbeddingCache()
+	require.NoError(t, cache.Put(ctx, "key-1", "model-a", []float32{0.1, -0.2, 0.3}))
+
+	embedding, err := cache.Get(ctx, "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, []float32{0.1, -0.2, 0.3}, embedding)
+
+	// Put overwrites an existing key
+	require.NoError(t, cache.Put(ctx, "key-1", "model-a", []float32{1, 2}))
+	embedding, err = cache.Get(ctx, "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, []float32{1, 2}, embedding)
+}
+
+func TestEmbeddingCache_GetMiss(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	_, err := store.EmbeddingCache().Get(context.Background(), "missing")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestEmbeddingCache_DeleteOtherModels(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	cache := store.EmbeddingCache()
+	require.NoError(t, cache.Put(ctx, "old-1", "model-a", []float32{1}))
+	require.NoError(t, cache.Put(ctx, "old-2", "model-a", []float32{2}))
+	require.NoError(t, cache.Put(ctx, "new-1", "model-b", []float32{3}))
+	require.NoError(t, cache.Put(ctx, "code-1", "model-c", []float32{4}))
+
+	removed, err := cache.DeleteOtherModels(ctx, "model-b", "model-c")
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, removed)
+	_, err = cache.Get(ctx, "old-1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = cache.Get(ctx, "new-1")
+	assert.NoError(t, err)
+	_, err = cache.Get(ctx, "code-1")
+	assert.NoError(t, err)
+}
+
+func TestEmbeddingCache_DocumentHashes(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+	createTestSource(t, store, "source-1")
+
+	cache, ok := store.EmbeddingCache().(driven.DocumentEmbeddingCache)
+	require.True(t, ok)
+
+	_, _, err := cache.LatestDocument(ctx, "source-1", "file:///a.txt", "model-a")
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	// The same URI synced twice, as documents with different IDs
+	now := time.Now().UTC()
+	for _, id := range []string{"doc-1", "doc-2"} {
+		doc := &domain.Document{ID: id, SourceID: "source-1", URI: "file:///a.txt", CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, store.DocumentStore().SaveDocument(ctx, doc))
+		require.NoError(t, cache.PutDocument(ctx, doc, "hash-"+id, "model-a"))
+	}
+
+	documentID, hash, err := cache.LatestDocument(ctx, "source-1", "file:///a.txt", "model-a")
+	require.NoError(t, err)
+	assert.Equal(t, "doc-2", documentID)
+	assert.Equal(t, "hash-doc-2", hash)
+
+	_, _, err = cache.LatestDocument(ctx, "source-1", "file:///a.txt", "model-b")
+	assert.ErrorIs(t, err, domain.ErrNotFound, "hashes are per model")
+
+	// Deleting a document removes its hash
+	require.NoError(t, store.DocumentStore().DeleteDocument(ctx, "doc-2"))
+	documentID, _, err = cache.LatestDocument(ctx, "source-1", "file:///a.txt", "model-a")
+	require.NoError(t, err)
+	assert.Equal(t, "doc-1", documentID)
+
+	// Changing the model discards the remaining hashes
+	_, err = store.EmbeddingCache().DeleteOtherModels(ctx, "model-b")
+	require.NoError(t, err)
+	_, _, err = cache.LatestDocument(ctx, "source-1", "file:///a.txt", "model-a")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,250 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+)
+
+// Migration is a numbered, forward-only schema change.
+type Migration struct {
+	// Version orders migrations; each version is applied at most once.
+	Version int
+	// Description summarises the change, e.g. "Source health checks".
+	Description string
+	// SQL is the statements to execute.
+	SQL string
+}
+
+// Migrator applies pending migrations to a database in version order.
+//
+// Applied versions are recorded in the migrations table together with when
+// they were applied and their description. The Migrator only migrates
+// forward; each migration's .down.sql rollback script is run by hand.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// NewMigrator creates a migrator for db. The migrations are sorted by version.
+func NewMigrator(db *sql.DB, migrations []Migration) *Migrator {
+	sorted := append([]Migration(nil), migrations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+	return &Migrator{db: db, migrations: sorted}
+}
+
+// LoadMigrations reads migrations from the NNN_name.up.sql files in fsys.
+// The description is taken from a leading "-- Migration NNN: <description>"
+// comment, falling back to the file name.
+func LoadMigrations(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("reading migrations directory: %w", err)
+	}
+
+	seen := make(map[int]string)
+	var migrations []Migration
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasSuffix(name, ".up.sql") {
+			continue
+		}
+
+		// Extract version number (e.g., "001_initial.up.sql" -> 1)
+		var version int
+		if _, err := fmt.Sscanf(name, "%d_", &version); err != nil {
+			continue // Skip files that don't match pattern
+		}
+		if other, ok := seen[version]; ok {
+			return nil, fmt.Errorf("duplicate migration version %d: %s and %s", version, other, name)
+		}
+		seen[version] = name
+
+		content, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("reading migration %s: %w", name, err)
+		}
+
+		migrations = append(migrations, Migration{
+			Version:     version,
+			Description: migrationDescription(name, string(content)),
+			SQL:         string(content),
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// migrationDescription returns the description from the migration's header
+// comment, or a name derived from the file name.
+func migrationDescription(name, content string) string {
+	firstLine, _, _ := strings.Cut(content, "\n")
+	if header, ok := strings.CutPrefix(strings.TrimSpace(firstLine), "-- Migration "); ok {
+		if _, desc, found := strings.Cut(header, ":"); found {
+			return strings.TrimSpace(desc)
+		}
+	}
+
+	desc := strings.TrimSuffix(name, ".up.sql")
+	if _, rest, found := strings.Cut(desc, "_"); found {
+		desc = rest
+	}
+	return strings.ReplaceAll(desc, "_", " ")
+}
+
+// Latest returns the highest known migration version, or 0 if there are none.
+func (m *Migrator) Latest() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version returns the highest applied migration version.
+func (m *Migrator) Version(ctx context.Context) (int, error) {
+	return schemaVersion(ctx, m.db)
+}
+
+// Migrate applies all pending migrations in order. Each migration runs in its
+// own transaction together with its migrations record, so a failing
+// migration leaves the database at the previous version.
+func (m *Migrator) Migrate(ctx context.Context) error {
+	if err := m.ensureTable(ctx); err != nil {
+		return err
+	}
+
+	current, err := m.Version(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range m.migrations {
+		if migration.Version <= current {
+			continue // Already applied
+		}
+		if err := m.apply(ctx, migration); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// apply runs a single migration and records it.
+func (m *Migrator) apply(ctx context.Context, migration Migration) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning migration %d: %w", migration.Version, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, migration.SQL); err != nil {
+		return fmt.Errorf("executing migration %d (%s): %w", migration.Version, migration.Description, err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO migrations (version, description) VALUES (?, ?)",
+		migration.Version, migration.Description)
+	if err != nil {
+		return fmt.Errorf("recording migration %d: %w", migration.Version, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing migration %d: %w", migration.Version, err)
+	}
+	return nil
+}
+
+// ensureTable creates the migrations table. The schema_migrations table of
+// earlier versions of sercha is renamed and given the description column.
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	table, err := trackingTable(ctx, m.db)
+	if err != nil {
+		return err
+	}
+	if table == legacyMigrationsTable {
+		if _, err := m.db.ExecContext(ctx, "ALTER TABLE schema_migrations RENAME TO migrations"); err != nil {
+			return fmt.Errorf("renaming schema_migrations table: %w", err)
+		}
+	}
+
+	_, err = m.db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS migrations (
+			version INTEGER PRIMARY KEY,
+			applied_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+			description TEXT NOT NULL DEFAULT ''
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("creating migrations table: %w", err)
+	}
+
+	var hasDescription int
+	row := m.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM pragma_table_info('migrations') WHERE name = 'description'")
+	if err := row.Scan(&hasDescription); err != nil {
+		return fmt.Errorf("inspecting migrations table: %w", err)
+	}
+	if hasDescription > 0 {
+		return nil
+	}
+
+	_, err = m.db.ExecContext(ctx,
+		"ALTER TABLE migrations ADD COLUMN description TEXT NOT NULL DEFAULT ''")
+	if err != nil {
+		return fmt.Errorf("adding migration descriptions: %w", err)
+	}
+
+	// Backfill descriptions for migrations applied before they were recorded
+	for _, migration := range m.migrations {
+		_, err := m.db.ExecContext(ctx,
+			"UPDATE migrations SET description = ? WHERE version = ?",
+			migration.Description, migration.Version)
+		if err != nil {
+			return fmt.Errorf("recording migration %d description: %w", migration.Version, err)
+		}
+	}
+	return nil
+}
+
+// legacyMigrationsTable is where earlier versions of sercha recorded migrations.
+const legacyMigrationsTable = "schema_migrations"
+
+// trackingTable returns the table db records migrations in: migrations, or
+// schema_migrations for databases last opened by an earlier version of sercha
+// such as old backups. Databases with neither report migrations.
+func trackingTable(ctx context.Context, db *sql.DB) (string, error) {
+	var table string
+	row := db.QueryRowContext(ctx, `
+		SELECT name FROM sqlite_master
+		WHERE type = 'table' AND name IN ('migrations', 'schema_migrations')
+		ORDER BY name = 'migrations' DESC
+		LIMIT 1`)
+	switch err := row.Scan(&table); {
+	case errors.Is(err, sql.ErrNoRows):
+		return "migrations", nil
+	case err != nil:
+		return "", fmt.Errorf("finding migrations table: %w", err)
+	}
+	return table, nil
+}
+
+// schemaVersion returns the highest applied migration version.
+func schemaVersion(ctx context.Context, db *sql.DB) (int, error) {
+	table, err := trackingTable(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+
+	var version int
+	row := db.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM "+table)
+	if err := row.Scan(&version); err != nil {
+		return 0, fmt.Errorf("getting schema version: %w", err)
+	}
+	return version, nil
+}
@@ -22,4 +22,4 @@ DROP TABLE IF EXISTS sources;
 DROP INDEX IF EXISTS idx_authorizations_provider;
 DROP TABLE IF EXISTS authorizations;
 
-DELETE FROM schema_migrations WHERE version = 1;
+DELETE FROM migrations WHERE version = 1;
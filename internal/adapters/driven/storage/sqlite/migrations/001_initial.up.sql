@@ -1,12 +1,6 @@
 -- Migration 001: Initial schema
 -- This schema is derived from domain models in internal/core/domain/
 
--- Schema version tracking
-CREATE TABLE IF NOT EXISTS schema_migrations (
-    version INTEGER PRIMARY KEY,
-    applied_at DATETIME DEFAULT CURRENT_TIMESTAMP
-);
-
 -- Authorizations table (domain.Authorization)
 -- Stores OAuth/PAT credentials for connectors
 CREATE TABLE IF NOT EXISTS authorizations (
@@ -94,6 +88,3 @@ CREATE TABLE IF NOT EXISTS exclusions (
 
 CREATE INDEX IF NOT EXISTS idx_exclusions_source ON exclusions(source_id);
 CREATE INDEX IF NOT EXISTS idx_exclusions_uri ON exclusions(source_id, uri);
-
--- Record this migration
-INSERT INTO schema_migrations (version) VALUES (1);
@@ -28,4 +28,4 @@ CREATE INDEX IF NOT EXISTS idx_documents_uri ON documents(uri);
 CREATE INDEX IF NOT EXISTS idx_documents_parent ON documents(parent_id);
 
 -- Remove migration record
-DELETE FROM schema_migrations WHERE version = 2;
+DELETE FROM migrations WHERE version = 2;
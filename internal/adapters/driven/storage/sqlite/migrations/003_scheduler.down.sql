@@ -5,4 +5,4 @@ DROP INDEX IF EXISTS idx_task_results_task_id;
 DROP TABLE IF EXISTS task_results;
 DROP TABLE IF EXISTS scheduled_tasks;
 
-DELETE FROM schema_migrations WHERE version = 3;
+DELETE FROM migrations WHERE version = 3;
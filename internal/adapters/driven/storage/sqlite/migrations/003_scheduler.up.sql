@@ -29,6 +29,3 @@ CREATE TABLE IF NOT EXISTS task_results (
 
 CREATE INDEX IF NOT EXISTS idx_task_results_task_id ON task_results(task_id);
 CREATE INDEX IF NOT EXISTS idx_task_results_started_at ON task_results(started_at);
-
--- Record this migration
-INSERT INTO schema_migrations (version) VALUES (3);
@@ -34,4 +34,4 @@ DROP TABLE IF EXISTS credentials;
 DROP TABLE IF EXISTS auth_providers;
 
 -- Remove migration record
-DELETE FROM schema_migrations WHERE version = 4;
+DELETE FROM migrations WHERE version = 4;
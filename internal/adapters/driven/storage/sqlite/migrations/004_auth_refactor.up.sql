@@ -45,6 +45,3 @@ CREATE INDEX IF NOT EXISTS idx_sources_auth_provider ON sources(auth_provider_id
 -- Note: The old authorization_id column is kept for backward compatibility
 -- It will be removed in a future migration after all code is updated
 -- Old authorizations table is also kept for now (will be dropped later)
-
--- Record this migration
-INSERT INTO schema_migrations (version) VALUES (4);
@@ -48,4 +48,4 @@ CREATE INDEX IF NOT EXISTS idx_sources_auth ON sources(authorization_id);
 CREATE INDEX IF NOT EXISTS idx_sources_auth_provider ON sources(auth_provider_id);
 
 -- Remove migration record
-DELETE FROM schema_migrations WHERE version = 5;
+DELETE FROM migrations WHERE version = 5;
@@ -34,6 +34,3 @@ CREATE INDEX IF NOT EXISTS idx_sources_auth_provider ON sources(auth_provider_id
 
 -- Now drop the old authorizations table (no longer needed)
 DROP TABLE IF EXISTS authorizations;
-
--- Record this migration
-INSERT INTO schema_migrations (version) VALUES (5);
@@ -0,0 +1,6 @@
+-- Migration 006 down: Remove cron schedules from scheduled tasks
+
+ALTER TABLE scheduled_tasks DROP COLUMN cron;
+
+-- Remove migration record
+DELETE FROM migrations WHERE version = 6;
@@ -0,0 +1,4 @@
+-- Migration 006: Cron schedules for scheduled tasks
+-- A non-empty cron expression overrides interval_seconds when computing next_run
+
+ALTER TABLE scheduled_tasks ADD COLUMN cron TEXT NOT NULL DEFAULT '';
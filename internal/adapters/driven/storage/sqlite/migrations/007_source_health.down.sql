@@ -0,0 +1,6 @@
+-- Migration 007 down: Remove source health checks
+
+DROP TABLE IF EXISTS source_health;
+
+-- Remove migration record
+DELETE FROM migrations WHERE version = 7;
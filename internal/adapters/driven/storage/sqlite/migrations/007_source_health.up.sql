@@ -0,0 +1,11 @@
+-- Migration 007: Source health checks
+-- Stores the last validation result for each source (domain.SourceHealth)
+
+CREATE TABLE IF NOT EXISTS source_health (
+    source_id TEXT PRIMARY KEY,
+    error TEXT NOT NULL DEFAULT '',    -- Validation failure, empty if it passed
+    warning TEXT NOT NULL DEFAULT '',  -- Non-fatal issue, e.g. expiring token
+    token_expiry TEXT,                 -- ISO 8601 timestamp, NULL if not OAuth
+    checked_at TEXT NOT NULL,          -- ISO 8601 timestamp
+    FOREIGN KEY (source_id) REFERENCES sources(id) ON DELETE CASCADE
+);
@@ -0,0 +1,6 @@
+-- Migration 008 down: Remove index rebuild watermarks
+
+DROP TABLE IF EXISTS rebuild_state;
+
+-- Remove migration record
+DELETE FROM migrations WHERE version = 8;
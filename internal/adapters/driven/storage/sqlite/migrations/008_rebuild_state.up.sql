@@ -0,0 +1,10 @@
+-- Migration 008: Index rebuild watermarks
+-- Records how far an interrupted index rebuild got (domain.RebuildState)
+
+CREATE TABLE IF NOT EXISTS rebuild_state (
+    scope TEXT PRIMARY KEY,              -- Source ID, or empty for all sources
+    source_id TEXT NOT NULL,             -- Source being rebuilt
+    document_id TEXT NOT NULL DEFAULT '', -- Last rebuilt document, empty once the source is done
+    refetch INTEGER NOT NULL DEFAULT 0,  -- 1 if documents are re-fetched from the connector
+    updated_at TEXT NOT NULL             -- ISO 8601 timestamp
+);
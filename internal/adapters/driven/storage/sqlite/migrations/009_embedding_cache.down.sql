@@ -0,0 +1,7 @@
+-- Migration 009 down: Remove the embedding cache
+
+DROP INDEX IF EXISTS idx_embedding_cache_model;
+DROP TABLE IF EXISTS embedding_cache;
+
+-- Remove migration record
+DELETE FROM migrations WHERE version = 9;
@@ -0,0 +1,11 @@
+-- Migration 009: Embedding cache
+-- Embeddings keyed by sha256(model + chunk text), reused when a chunk is re-synced unchanged
+
+CREATE TABLE IF NOT EXISTS embedding_cache (
+    key TEXT PRIMARY KEY,          -- Hex sha256 of model name + embedded text
+    model TEXT NOT NULL,           -- Model that computed the embedding
+    embedding BLOB NOT NULL,       -- Little-endian float32 vector
+    created_at TEXT NOT NULL       -- ISO 8601 timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_embedding_cache_model ON embedding_cache(model);
@@ -0,0 +1,7 @@
+-- Migration 010 down: Remove document embedding hashes
+
+DROP INDEX IF EXISTS idx_document_embeddings_model;
+DROP TABLE IF EXISTS document_embeddings;
+
+-- Remove migration record
+DELETE FROM migrations WHERE version = 10;
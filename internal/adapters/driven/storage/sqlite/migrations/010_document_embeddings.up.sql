@@ -0,0 +1,13 @@
+-- Migration 010: Document embedding hashes
+-- The content hash each document was embedded from, so a re-synced document
+-- whose content is unchanged reuses its stored chunk embeddings
+
+CREATE TABLE IF NOT EXISTS document_embeddings (
+    document_id TEXT PRIMARY KEY,
+    content_hash TEXT NOT NULL,    -- Hex sha256 of the normalised content
+    model TEXT NOT NULL,           -- Model that computed the embeddings
+    embedded_at TEXT NOT NULL,     -- ISO 8601 timestamp
+    FOREIGN KEY (document_id) REFERENCES documents(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_document_embeddings_model ON document_embeddings(model);
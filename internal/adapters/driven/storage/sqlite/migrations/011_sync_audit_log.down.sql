@@ -0,0 +1,7 @@
+-- Migration 011 down: Remove the sync audit log
+
+DROP INDEX IF EXISTS idx_sync_audit_log_source;
+DROP TABLE IF EXISTS sync_audit_log;
+
+-- Remove migration record
+DELETE FROM migrations WHERE version = 11;
@@ -0,0 +1,15 @@
+-- Migration 011: Sync audit log
+-- One row per document processed by a sync (domain.SyncAuditEntry), capped at
+-- domain.MaxSyncAuditEntries rows with the oldest evicted first
+
+CREATE TABLE IF NOT EXISTS sync_audit_log (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    source_id TEXT NOT NULL,
+    document_uri TEXT NOT NULL,
+    event_type TEXT NOT NULL,          -- created, updated, deleted, skipped or failed
+    reason TEXT NOT NULL DEFAULT '',   -- Why a document was skipped or failed
+    sync_run_id TEXT NOT NULL,
+    occurred_at TEXT NOT NULL          -- ISO 8601 timestamp, UTC
+);
+
+CREATE INDEX IF NOT EXISTS idx_sync_audit_log_source ON sync_audit_log(source_id, occurred_at);
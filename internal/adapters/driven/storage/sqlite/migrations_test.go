@@ -0,0 +1,258 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/sqlite/migrations"
+)
+
+func openEmptyDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func tableColumns(t *testing.T, db *sql.DB, table string) []string {
+	t.Helper()
+	rows, err := db.Query("SELECT name FROM pragma_table_info(?)", table)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var columns []string
+	for rows.Next() {
+		var name string
+		require.NoError(t, rows.Scan(&name))
+		columns = append(columns, name)
+	}
+	require.NoError(t, rows.Err())
+	return columns
+}
+
+func tableExists(t *testing.T, db *sql.DB, table string) bool {
+	t.Helper()
+	var count int
+	err := db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type='table' AND name=?", table).Scan(&count)
+	require.NoError(t, err)
+	return count > 0
+}
+
+func TestLoadMigrations(t *testing.T) {
+	migrationList, err := LoadMigrations(migrations.FS)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, migrationList)
+	for i, m := range migrationList {
+		assert.Equal(t, i+1, m.Version, "migration versions are contiguous from 1")
+		assert.NotEmpty(t, m.Description)
+		assert.NotContains(t, m.SQL, "migrations", "the migrator records versions")
+	}
+	assert.Equal(t, "Initial schema", migrationList[0].Description)
+}
+
+func TestMigrations_HaveRollbackScripts(t *testing.T) {
+	migrationList, err := LoadMigrations(migrations.FS)
+	require.NoError(t, err)
+	downs, err := fs.Glob(migrations.FS, "*.down.sql")
+	require.NoError(t, err)
+
+	for _, m := range migrationList {
+		matches, err := fs.Glob(migrations.FS, fmt.Sprintf("%03d_*.down.sql", m.Version))
+		require.NoError(t, err)
+		assert.Len(t, matches, 1, "migration %d has a down script", m.Version)
+	}
+	assert.Len(t, downs, len(migrationList))
+}
+
+func TestLoadMigrations_DuplicateVersion(t *testing.T) {
+	fsys := fstest.MapFS{
+		"001_one.up.sql":   {Data: []byte("SELECT 1;")},
+		"001_other.up.sql": {Data: []byte("SELECT 1;")},
+	}
+
+	_, err := LoadMigrations(fsys)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "duplicate migration version 1")
+}
+
+func TestLoadMigrations_DescriptionFromFileName(t *testing.T) {
+	fsys := fstest.MapFS{
+		"002_saved_searches.up.sql": {Data: []byte("CREATE TABLE saved_searches (id TEXT);")},
+		"notes.txt":                 {Data: []byte("ignored")},
+	}
+
+	migrationList, err := LoadMigrations(fsys)
+
+	require.NoError(t, err)
+	require.Len(t, migrationList, 1)
+	assert.Equal(t, 2, migrationList[0].Version)
+	assert.Equal(t, "saved searches", migrationList[0].Description)
+}
+
+// TestMigrator_AppliesEachMigrationInSequence applies the real migrations one
+// at a time to an empty database and checks the schema after each step.
+func TestMigrator_AppliesEachMigrationInSequence(t *testing.T) {
+	ctx := context.Background()
+	migrationList, err := LoadMigrations(migrations.FS)
+	require.NoError(t, err)
+
+	checks := map[int]func(t *testing.T, db *sql.DB){
+		1: func(t *testing.T, db *sql.DB) {
+			for _, table := range []string{"sources", "documents", "chunks", "sync_states", "exclusions"} {
+				assert.True(t, tableExists(t, db, table), table)
+			}
+		},
+		2: func(t *testing.T, db *sql.DB) {
+			assert.Contains(t, tableColumns(t, db, "documents"), "content")
+		},
+		3: func(t *testing.T, db *sql.DB) {
+			assert.True(t, tableExists(t, db, "scheduled_tasks"))
+			assert.True(t, tableExists(t, db, "task_results"))
+		},
+		4: func(t *testing.T, db *sql.DB) {
+			assert.True(t, tableExists(t, db, "auth_providers"))
+			assert.True(t, tableExists(t, db, "credentials"))
+		},
+		5: func(t *testing.T, db *sql.DB) {
+			assert.False(t, tableExists(t, db, "authorizations"))
+			assert.NotContains(t, tableColumns(t, db, "sources"), "authorization_id")
+		},
+		6: func(t *testing.T, db *sql.DB) {
+			assert.Contains(t, tableColumns(t, db, "scheduled_tasks"), "cron")
+		},
+		7: func(t *testing.T, db *sql.DB) {
+			assert.True(t, tableExists(t, db, "source_health"))
+		},
+		8: func(t *testing.T, db *sql.DB) {
+			assert.True(t, tableExists(t, db, "rebuild_state"))
+		},
+		9: func(t *testing.T, db *sql.DB) {
+			assert.True(t, tableExists(t, db, "embedding_cache"))
+		},
+		10: func(t *testing.T, db *sql.DB) {
+			assert.True(t, tableExists(t, db, "document_embeddings"))
+		},
+		11: func(t *testing.T, db *sql.DB) {
+			assert.True(t, tableExists(t, db, "sync_audit_log"))
+		},
+	}
+
+	db := openEmptyDB(t)
+	for i := range migrationList {
+		migrator := NewMigrator(db, migrationList[:i+1])
+		require.NoError(t, migrator.Migrate(ctx), "migration %d", migrationList[i].Version)
+
+		version, err := migrator.Version(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, migrationList[i].Version, version)
+
+		if check, ok := checks[migrationList[i].Version]; ok {
+			check(t, db)
+		}
+	}
+	assert.Len(t, checks, len(migrationList), "every migration has a schema check")
+
+	var description string
+	err = db.QueryRow("SELECT description FROM migrations WHERE version = 7").Scan(&description)
+	require.NoError(t, err)
+	assert.Equal(t, "Source health checks", description)
+}
+
+func TestMigrator_FailedMigrationIsRolledBack(t *testing.T) {
+	ctx := context.Background()
+	db := openEmptyDB(t)
+	migrator := NewMigrator(db, []Migration{
+		{Version: 1, Description: "notes", SQL: "CREATE TABLE notes (id TEXT);"},
+		{Version: 2, Description: "broken", SQL: "CREATE TABLE tags (id TEXT); INSERT INTO missing VALUES (1);"},
+	})
+
+	err := migrator.Migrate(ctx)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "executing migration 2 (broken)")
+	version, err := migrator.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, version)
+	assert.True(t, tableExists(t, db, "notes"))
+	assert.False(t, tableExists(t, db, "tags"))
+}
+
+func TestMigrator_AppliesOnlyPendingMigrations(t *testing.T) {
+	ctx := context.Background()
+	db := openEmptyDB(t)
+	first := Migration{Version: 1, Description: "notes", SQL: "CREATE TABLE notes (id TEXT);"}
+	require.NoError(t, NewMigrator(db, []Migration{first}).Migrate(ctx))
+
+	// Out of order input is sorted; re-running version 1 would fail
+	migrator := NewMigrator(db, []Migration{
+		{Version: 2, Description: "tags", SQL: "CREATE TABLE tags (id TEXT);"},
+		first,
+	})
+	require.NoError(t, migrator.Migrate(ctx))
+
+	assert.Equal(t, 2, migrator.Latest())
+	assert.True(t, tableExists(t, db, "tags"))
+}
+
+func TestMigrator_UpgradesLegacyMigrationsTable(t *testing.T) {
+	ctx := context.Background()
+	db := openEmptyDB(t)
+
+	// Databases created before descriptions were recorded
+	_, err := db.Exec(`
+		CREATE TABLE schema_migrations (
+			version INTEGER PRIMARY KEY,
+			applied_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		);
+		CREATE TABLE notes (id TEXT);
+		INSERT INTO schema_migrations (version) VALUES (1);
+	`)
+	require.NoError(t, err)
+
+	migrator := NewMigrator(db, []Migration{
+		{Version: 1, Description: "notes", SQL: "CREATE TABLE notes (id TEXT);"},
+		{Version: 2, Description: "tags", SQL: "CREATE TABLE tags (id TEXT);"},
+	})
+	require.NoError(t, migrator.Migrate(ctx))
+
+	assert.False(t, tableExists(t, db, "schema_migrations"), "the table is renamed to migrations")
+	rows, err := db.Query("SELECT version, description FROM migrations ORDER BY version")
+	require.NoError(t, err)
+	defer rows.Close()
+	got := map[int]string{}
+	for rows.Next() {
+		var version int
+		var description string
+		require.NoError(t, rows.Scan(&version, &description))
+		got[version] = description
+	}
+	assert.Equal(t, map[int]string{1: "notes", 2: "tags"}, got)
+}
+
+func TestSchemaVersion_LegacyTable(t *testing.T) {
+	ctx := context.Background()
+	db := openEmptyDB(t)
+
+	// Backups taken before the table was renamed are validated read-only
+	_, err := db.Exec(`
+		CREATE TABLE schema_migrations (version INTEGER PRIMARY KEY);
+		INSERT INTO schema_migrations (version) VALUES (1), (2);
+	`)
+	require.NoError(t, err)
+
+	version, err := schemaVersion(ctx, db)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, version)
+}
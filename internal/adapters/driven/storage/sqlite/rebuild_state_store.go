@@ -0,0 +1,65 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// rebuildStateStore implements driven.RebuildStateStore.
+type rebuildStateStore struct {
+	store *Store
+}
+
+var _ driven.RebuildStateStore = (*rebuildStateStore)(nil)
+
+// Save stores or updates the watermark for a rebuild scope.
+func (s *rebuildStateStore) Save(ctx context.Context, state domain.RebuildState) error {
+	_, err := s.store.db.ExecContext(ctx, `
+		INSERT INTO rebuild_state (scope, source_id, document_id, refetch, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(scope) DO UPDATE SET
+			source_id = excluded.source_id,
+			document_id = excluded.document_id,
+			refetch = excluded.refetch,
+			updated_at = excluded.updated_at
+	`, state.Scope, state.SourceID, state.DocumentID, state.Refetch, state.UpdatedAt.Format(time.RFC3339))
+	if err != nil {
+		return fmt.Errorf("saving rebuild state: %w", err)
+	}
+	return nil
+}
+
+// Get retrieves the watermark for a rebuild scope.
+func (s *rebuildStateStore) Get(ctx context.Context, scope string) (*domain.RebuildState, error) {
+	row := s.store.db.QueryRowContext(ctx, `
+		SELECT scope, source_id, document_id, refetch, updated_at
+		FROM rebuild_state WHERE scope = ?
+	`, scope)
+
+	var state domain.RebuildState
+	var updatedAt sql.NullString
+	if err := row.Scan(&state.Scope, &state.SourceID, &state.DocumentID, &state.Refetch, &updatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("scanning rebuild state: %w", err)
+	}
+	state.UpdatedAt = parseNullableTime(updatedAt)
+
+	return &state, nil
+}
+
+// Delete removes the watermark for a rebuild scope.
+func (s *rebuildStateStore) Delete(ctx context.Context, scope string) error {
+	_, err := s.store.db.ExecContext(ctx, "DELETE FROM rebuild_state WHERE scope = ?", scope)
+	if err != nil {
+		return fmt.Errorf("deleting rebuild state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestRebuildStateStore_SaveAndGet(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	updatedAt := time.Now().UTC().Truncate(time.Second)
+	stateStore := store.RebuildStateStore()
+	require.NoError(t, stateStore.Save(ctx, domain.RebuildState{
+		Scope:      "",
+		SourceID:   "src-1",
+		DocumentID: "doc-3",
+		UpdatedAt:  updatedAt,
+	}))
+
+	state, err := stateStore.Get(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, "src-1", state.SourceID)
+	assert.Equal(t, "doc-3", state.DocumentID)
+	assert.False(t, state.Refetch)
+	assert.True(t, updatedAt.Equal(state.UpdatedAt))
+}
+
+func TestRebuildStateStore_SaveAdvancesWatermark(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	stateStore := store.RebuildStateStore()
+	require.NoError(t, stateStore.Save(ctx, domain.RebuildState{
+		Scope: "src-1", SourceID: "src-1", DocumentID: "doc-1", UpdatedAt: time.Now(),
+	}))
+	require.NoError(t, stateStore.Save(ctx, domain.RebuildState{
+		Scope: "src-1", SourceID: "src-1", Refetch: true, UpdatedAt: time.Now(),
+	}))
+
+	state, err := stateStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Empty(t, state.DocumentID)
+	assert.True(t, state.Refetch)
+
+	// Scopes are independent
+	_, err = stateStore.Get(ctx, "")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestRebuildStateStore_Delete(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	stateStore := store.RebuildStateStore()
+	require.NoError(t, stateStore.Save(ctx, domain.RebuildState{Scope: "", SourceID: "src-1", UpdatedAt: time.Now()}))
+	require.NoError(t, stateStore.Delete(ctx, ""))
+
+	_, err := stateStore.Get(ctx, "")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -22,7 +22,7 @@ var _ driven.SchedulerStore = (*schedulerStore)(nil)
 // Returns nil and no error if the task does not exist.
 func (s *schedulerStore) GetTask(ctx context.Context, taskID string) (*domain.ScheduledTask, error) {
 	row := s.store.db.QueryRowContext(ctx, `
-		SELECT id, name, interval_seconds, last_run, next_run, last_error, last_success, enabled
+		SELECT id, name, interval_seconds, cron, last_run, next_run, last_error, last_success, enabled
 		FROM scheduled_tasks WHERE id = ?
 	`, taskID)
 
@@ -39,7 +39,7 @@ func (s *schedulerStore) GetTask(ctx context.Context, taskID string) (*domain.Sc
 // ListTasks returns all scheduled tasks.
 func (s *schedulerStore) ListTasks(ctx context.Context) ([]domain.ScheduledTask, error) {
 	rows, err := s.store.db.QueryContext(ctx, `
-		SELECT id, name, interval_seconds, last_run, next_run, last_error, last_success, enabled
+		SELECT id, name, interval_seconds, cron, last_run, next_run, last_error, last_success, enabled
 		FROM scheduled_tasks
 	`)
 	if err != nil {
@@ -71,17 +71,19 @@ func (s *schedulerStore) SaveTask(ctx context.Context, task *domain.ScheduledTas
 	}
 
 	_, err := s.store.db.ExecContext(ctx, `
-		INSERT INTO scheduled_tasks (id, name, interval_seconds, last_run, next_run, last_error, last_success, enabled)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO scheduled_tasks
+			(id, name, interval_seconds, cron, last_run, next_run, last_error, last_success, enabled)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 		ON CONFLICT(id) DO UPDATE SET
 			name = excluded.name,
 			interval_seconds = excluded.interval_seconds,
+			cron = excluded.cron,
 			last_run = excluded.last_run,
 			next_run = excluded.next_run,
 			last_error = excluded.last_error,
 			last_success = excluded.last_success,
 			enabled = excluded.enabled
-	`, task.ID, task.Name, int64(task.Interval.Seconds()),
+	`, task.ID, task.Name, int64(task.Interval.Seconds()), task.Cron,
 		formatNullableTime(task.LastRun), formatNullableTime(task.NextRun),
 		nullString(task.LastError), formatNullableTime(task.LastSuccess),
 		boolToInt(task.Enabled))
@@ -182,7 +184,7 @@ func scanScheduledTask(row *sql.Row) (*domain.ScheduledTask, error) {
 	var lastRun, nextRun, lastError, lastSuccess sql.NullString
 	var enabled int
 
-	if err := row.Scan(&task.ID, &task.Name, &intervalSeconds,
+	if err := row.Scan(&task.ID, &task.Name, &intervalSeconds, &task.Cron,
 		&lastRun, &nextRun, &lastError, &lastSuccess, &enabled); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domain.ErrNotFound
@@ -209,7 +211,7 @@ func scanScheduledTaskRows(rows *sql.Rows) (*domain.ScheduledTask, error) {
 	var lastRun, nextRun, lastError, lastSuccess sql.NullString
 	var enabled int
 
-	if err := rows.Scan(&task.ID, &task.Name, &intervalSeconds,
+	if err := rows.Scan(&task.ID, &task.Name, &intervalSeconds, &task.Cron,
 		&lastRun, &nextRun, &lastError, &lastSuccess, &enabled); err != nil {
 		return nil, fmt.Errorf("scanning scheduled task: %w", err)
 	}
@@ -51,6 +51,32 @@ func TestSchedulerStore_SaveAndGetTask(t *testing.T) {
 	assert.WithinDuration(t, task.LastSuccess, retrieved.LastSuccess, time.Second)
 }
 
+func TestSchedulerStore_SaveAndGetTask_Cron(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	schedulerStore := store.SchedulerStore()
+
+	task := &domain.ScheduledTask{
+		ID:      "source-sync:gmail",
+		Name:    "Sync Gmail",
+		Cron:    "0 6 * * 1-5",
+		Enabled: true,
+	}
+	require.NoError(t, schedulerStore.SaveTask(ctx, task))
+
+	retrieved, err := schedulerStore.GetTask(ctx, task.ID)
+	require.NoError(t, err)
+	require.NotNil(t, retrieved)
+	assert.Equal(t, "0 6 * * 1-5", retrieved.Cron)
+
+	tasks, err := schedulerStore.ListTasks(ctx)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "0 6 * * 1-5", tasks[0].Cron)
+}
+
 func TestSchedulerStore_GetTask_NotFound(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
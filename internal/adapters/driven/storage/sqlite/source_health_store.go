@@ -0,0 +1,67 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// sourceHealthStore implements driven.SourceHealthStore.
+type sourceHealthStore struct {
+	store *Store
+}
+
+var _ driven.SourceHealthStore = (*sourceHealthStore)(nil)
+
+// Save stores or replaces the health result for a source.
+func (s *sourceHealthStore) Save(ctx context.Context, health domain.SourceHealth) error {
+	_, err := s.store.db.ExecContext(ctx, `
+		INSERT INTO source_health (source_id, error, warning, token_expiry, checked_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(source_id) DO UPDATE SET
+			error = excluded.error,
+			warning = excluded.warning,
+			token_expiry = excluded.token_expiry,
+			checked_at = excluded.checked_at
+	`, health.SourceID, health.Error, health.Warning,
+		formatNullableTime(health.TokenExpiry), health.CheckedAt.Format(time.RFC3339))
+	if err != nil {
+		return fmt.Errorf("saving source health: %w", err)
+	}
+	return nil
+}
+
+// Get retrieves the health result for a source.
+func (s *sourceHealthStore) Get(ctx context.Context, sourceID string) (*domain.SourceHealth, error) {
+	row := s.store.db.QueryRowContext(ctx, `
+		SELECT source_id, error, warning, token_expiry, checked_at
+		FROM source_health WHERE source_id = ?
+	`, sourceID)
+
+	var health domain.SourceHealth
+	var tokenExpiry, checkedAt sql.NullString
+	if err := row.Scan(&health.SourceID, &health.Error, &health.Warning, &tokenExpiry, &checkedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("scanning source health: %w", err)
+	}
+	health.TokenExpiry = parseNullableTime(tokenExpiry)
+	health.CheckedAt = parseNullableTime(checkedAt)
+
+	return &health, nil
+}
+
+// Delete removes the health result for a source.
+func (s *sourceHealthStore) Delete(ctx context.Context, sourceID string) error {
+	_, err := s.store.db.ExecContext(ctx, "DELETE FROM source_health WHERE source_id = ?", sourceID)
+	if err != nil {
+		return fmt.Errorf("deleting source health: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestSourceHealthStore_SaveAndGet(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+	createTestSource(t, store, "src-1")
+
+	checkedAt := time.Now().UTC().Truncate(time.Second)
+	expiry := checkedAt.Add(3 * time.Hour)
+	healthStore := store.SourceHealthStore()
+	require.NoError(t, healthStore.Save(ctx, domain.SourceHealth{
+		SourceID:    "src-1",
+		Warning:     "OAuth token expires in 3h0m0s",
+		TokenExpiry: expiry,
+		CheckedAt:   checkedAt,
+	}))
+
+	health, err := healthStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "src-1", health.SourceID)
+	assert.Empty(t, health.Error)
+	assert.Equal(t, "OAuth token expires in 3h0m0s", health.Warning)
+	assert.True(t, expiry.Equal(health.TokenExpiry))
+	assert.True(t, checkedAt.Equal(health.CheckedAt))
+	assert.Equal(t, domain.HealthWarning, health.Status())
+}
+
+func TestSourceHealthStore_SaveReplacesPreviousResult(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+	createTestSource(t, store, "src-1")
+
+	healthStore := store.SourceHealthStore()
+	require.NoError(t, healthStore.Save(ctx, domain.SourceHealth{
+		SourceID:  "src-1",
+		Error:     "unauthorized",
+		CheckedAt: time.Now(),
+	}))
+	require.NoError(t, healthStore.Save(ctx, domain.SourceHealth{
+		SourceID:  "src-1",
+		CheckedAt: time.Now(),
+	}))
+
+	health, err := healthStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Empty(t, health.Error)
+	assert.True(t, health.TokenExpiry.IsZero())
+	assert.Equal(t, domain.HealthOK, health.Status())
+}
+
+func TestSourceHealthStore_GetNotFound(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	_, err := store.SourceHealthStore().Get(context.Background(), "missing")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestSourceHealthStore_DeletedWithSource(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+	createTestSource(t, store, "src-1")
+
+	healthStore := store.SourceHealthStore()
+	require.NoError(t, healthStore.Save(ctx, domain.SourceHealth{SourceID: "src-1", CheckedAt: time.Now()}))
+	require.NoError(t, store.SourceStore().Delete(ctx, "src-1"))
+
+	_, err := healthStore.Get(ctx, "src-1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
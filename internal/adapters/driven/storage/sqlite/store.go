@@ -3,16 +3,13 @@ package sqlite
 import (
 	"context"
 	"database/sql"
-	"embed"
 	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io/fs"
 	"math"
 	"os"
 	"path/filepath"
-	"sort"
 	"strings"
 	"time"
 
@@ -49,32 +46,44 @@ func NewStore(dataDir string) (*Store, error) {
 		return nil, fmt.Errorf("creating data directory: %w", err)
 	}
 
-	dbPath := filepath.Join(dataDir, "metadata.db")
+	s := &Store{
+		path: filepath.Join(dataDir, "metadata.db"),
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
 
+	return s, nil
+}
+
+// open opens the database at s.path and runs pending migrations.
+func (s *Store) open() error {
 	// Open database with WAL mode for better concurrency
-	db, err := sql.Open("sqlite", dbPath+"?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)")
+	db, err := sql.Open("sqlite", s.path+"?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)")
 	if err != nil {
-		return nil, fmt.Errorf("opening database: %w", err)
+		return fmt.Errorf("opening database: %w", err)
 	}
 
 	// Enable foreign keys
 	if _, err := db.Exec("PRAGMA foreign_keys = ON"); err != nil {
 		db.Close()
-		return nil, fmt.Errorf("enabling foreign keys: %w", err)
+		return fmt.Errorf("enabling foreign keys: %w", err)
 	}
 
-	s := &Store{
-		db:   db,
-		path: dbPath,
-	}
+	s.db = db
 
 	// Run migrations
-	if err := s.migrate(migrations.FS); err != nil {
+	migrationList, err := LoadMigrations(migrations.FS)
+	if err != nil {
+		db.Close()
+		return fmt.Errorf("loading migrations: %w", err)
+	}
+	if err := NewMigrator(db, migrationList).Migrate(context.Background()); err != nil {
 		db.Close()
-		return nil, fmt.Errorf("running migrations: %w", err)
+		return fmt.Errorf("running migrations: %w", err)
 	}
 
-	return s, nil
+	return nil
 }
 
 // Close closes the database connection.
@@ -87,6 +96,18 @@ func (s *Store) Path() string {
 	return s.path
 }
 
+// Ensure Store implements the interface.
+var _ driven.VersionReporter = (*Store)(nil)
+
+// EngineVersion returns the version of the SQLite library in use.
+func (s *Store) EngineVersion(ctx context.Context) (string, error) {
+	var version string
+	if err := s.db.QueryRowContext(ctx, "SELECT sqlite_version()").Scan(&version); err != nil {
+		return "", fmt.Errorf("query sqlite version: %w", err)
+	}
+	return version, nil
+}
+
 // SourceStore returns a SourceStore interface backed by this store.
 func (s *Store) SourceStore() driven.SourceStore {
 	return &sourceStore{store: s}
@@ -122,65 +143,24 @@ func (s *Store) CredentialsStore() driven.CredentialsStore {
 	return &credentialsStore{store: s}
 }
 
-// migrate runs all pending migrations.
-func (s *Store) migrate(fsys embed.FS) error {
-	// Ensure schema_migrations table exists
-	_, err := s.db.Exec(`
-		CREATE TABLE IF NOT EXISTS schema_migrations (
-			version INTEGER PRIMARY KEY,
-			applied_at DATETIME DEFAULT CURRENT_TIMESTAMP
-		)
-	`)
-	if err != nil {
-		return fmt.Errorf("creating schema_migrations table: %w", err)
-	}
-
-	// Get current version
-	var currentVersion int
-	row := s.db.QueryRow("SELECT COALESCE(MAX(version), 0) FROM schema_migrations")
-	if err := row.Scan(&currentVersion); err != nil {
-		return fmt.Errorf("getting current version: %w", err)
-	}
-
-	// Find all up migrations
-	entries, err := fs.ReadDir(fsys, ".")
-	if err != nil {
-		return fmt.Errorf("reading migrations directory: %w", err)
-	}
-
-	// Sort and run migrations
-	var upFiles []string
-	for _, entry := range entries {
-		name := entry.Name()
-		if strings.HasSuffix(name, ".up.sql") {
-			upFiles = append(upFiles, name)
-		}
-	}
-	sort.Strings(upFiles)
-
-	for _, name := range upFiles {
-		// Extract version number (e.g., "001_initial.up.sql" -> 1)
-		var version int
-		if _, err := fmt.Sscanf(name, "%d_", &version); err != nil {
-			continue // Skip files that don't match pattern
-		}
-
-		if version <= currentVersion {
-			continue // Already applied
-		}
+// SourceHealthStore returns a SourceHealthStore interface backed by this store.
+func (s *Store) SourceHealthStore() driven.SourceHealthStore {
+	return &sourceHealthStore{store: s}
+}
 
-		// Read and execute migration
-		content, err := fs.ReadFile(fsys, name)
-		if err != nil {
-			return fmt.Errorf("reading migration %s: %w", name, err)
-		}
+// RebuildStateStore returns a RebuildStateStore interface backed by this store.
+func (s *Store) RebuildStateStore() driven.RebuildStateStore {
+	return &rebuildStateStore{store: s}
+}
 
-		if _, err := s.db.Exec(string(content)); err != nil {
-			return fmt.Errorf("executing migration %s: %w", name, err)
-		}
-	}
+// EmbeddingCache returns an EmbeddingCache interface backed by this store.
+func (s *Store) EmbeddingCache() driven.EmbeddingCache {
+	return &embeddingCache{store: s}
+}
 
-	return nil
+// SyncAuditLog returns a SyncAuditLog interface backed by this store.
+func (s *Store) SyncAuditLog() driven.SyncAuditLog {
+	return &syncAuditLog{store: s, maxEntries: domain.MaxSyncAuditEntries}
 }
 
 // ==================== Source Store ====================
@@ -261,11 +241,38 @@ func (s *sourceStore) Get(ctx context.Context, id string) (*domain.Source, error
 }
 
 // Delete removes a source.
+// Credentials owned by the source but also used by other sources (one account
+// shared across sources) are handed over to one of them instead of being
+// cascade-deleted with the source.
 func (s *sourceStore) Delete(ctx context.Context, id string) error {
-	_, err := s.store.db.ExecContext(ctx, "DELETE FROM sources WHERE id = ?", id)
+	tx, err := s.store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck // no-op after commit
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE credentials SET source_id = (
+			SELECT other.id FROM sources other
+			WHERE other.credentials_id = credentials.id AND other.id != ?
+			LIMIT 1
+		)
+		WHERE source_id = ? AND EXISTS (
+			SELECT 1 FROM sources other
+			WHERE other.credentials_id = credentials.id AND other.id != ?
+		)
+	`, id, id, id)
 	if err != nil {
+		return fmt.Errorf("handing over shared credentials: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM sources WHERE id = ?", id); err != nil {
 		return fmt.Errorf("deleting source: %w", err)
 	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
 	return nil
 }
 
@@ -320,7 +327,12 @@ type documentStore struct {
 	store *Store
 }
 
-var _ driven.DocumentStore = (*documentStore)(nil)
+var (
+	_ driven.DocumentStore        = (*documentStore)(nil)
+	_ driven.DocumentCounter      = (*documentStore)(nil)
+	_ driven.DocumentBatchDeleter = (*documentStore)(nil)
+	_ driven.DocumentSourceLister = (*documentStore)(nil)
+)
 
 // SaveDocument stores or updates a document.
 func (s *documentStore) SaveDocument(ctx context.Context, doc *domain.Document) error {
@@ -449,6 +461,26 @@ func (s *documentStore) DeleteDocument(ctx context.Context, id string) error {
 	return nil
 }
 
+// DeleteDocuments removes the given documents and their chunks in a
+// single statement.
+func (s *documentStore) DeleteDocuments(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	_, err := s.store.db.ExecContext(ctx, "DELETE FROM documents WHERE id IN ("+placeholders+")", args...)
+	if err != nil {
+		return fmt.Errorf("deleting documents: %w", err)
+	}
+	return nil
+}
+
 // ListDocuments returns documents for a source.
 func (s *documentStore) ListDocuments(ctx context.Context, sourceID string) ([]domain.Document, error) {
 	rows, err := s.store.db.QueryContext(ctx, `
@@ -476,6 +508,37 @@ func (s *documentStore) ListDocuments(ctx context.Context, sourceID string) ([]d
 	return docs, nil
 }
 
+// CountDocuments returns the number of documents for a source.
+func (s *documentStore) CountDocuments(ctx context.Context, sourceID string) (int, error) {
+	var count int
+	err := s.store.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM documents WHERE source_id = ?
+	`, sourceID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("counting documents: %w", err)
+	}
+	return count, nil
+}
+
+// DocumentSourceIDs returns the distinct source IDs of stored documents.
+func (s *documentStore) DocumentSourceIDs(ctx context.Context) ([]string, error) {
+	rows, err := s.store.db.QueryContext(ctx, `SELECT DISTINCT source_id FROM documents`)
+	if err != nil {
+		return nil, fmt.Errorf("querying document sources: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scanning document source: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
 // ==================== Sync State Store ====================
 
 // syncStateStore implements driven.SyncStateStore.
@@ -978,6 +1041,40 @@ func (s *credentialsStore) GetBySourceID(ctx context.Context, sourceID string) (
 	return creds, err
 }
 
+// GetAllByAuthProviderID retrieves every account authenticated through an auth provider.
+// Credentials are linked to the provider through the sources that use them.
+func (s *credentialsStore) GetAllByAuthProviderID(
+	ctx context.Context,
+	authProviderID string,
+) ([]domain.Credentials, error) {
+	rows, err := s.store.db.QueryContext(ctx, `
+		SELECT DISTINCT c.id, c.source_id, c.account_identifier, c.oauth, c.pat, c.created_at, c.updated_at
+		FROM credentials c
+		JOIN sources s ON s.id = c.source_id OR s.credentials_id = c.id
+		WHERE s.auth_provider_id = ?
+		ORDER BY c.created_at, c.id
+	`, authProviderID)
+	if err != nil {
+		return nil, fmt.Errorf("querying credentials by auth provider: %w", err)
+	}
+	defer rows.Close()
+
+	var all []domain.Credentials //nolint:prealloc // size unknown from query
+	for rows.Next() {
+		creds, err := scanCredentials(rows)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, *creds)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating credentials: %w", err)
+	}
+
+	return all, nil
+}
+
 // Delete removes credentials by ID.
 func (s *credentialsStore) Delete(ctx context.Context, id string) error {
 	_, err := s.store.db.ExecContext(ctx, "DELETE FROM credentials WHERE id = ?", id)
@@ -987,8 +1084,13 @@ func (s *credentialsStore) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// credentialsScanner is satisfied by both *sql.Row and *sql.Rows.
+type credentialsScanner interface {
+	Scan(dest ...any) error
+}
+
 // scanCredentials scans a single credentials row.
-func scanCredentials(row *sql.Row) (*domain.Credentials, error) {
+func scanCredentials(row credentialsScanner) (*domain.Credentials, error) {
 	var creds domain.Credentials
 	var oauthJSON, patJSON sql.NullString
 
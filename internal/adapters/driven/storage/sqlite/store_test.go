@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
 // setupTestStore creates a temporary SQLite store for testing.
@@ -105,6 +106,16 @@ func TestNewStore_Success(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestStore_EngineVersion(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	version, err := store.EngineVersion(context.Background())
+
+	require.NoError(t, err)
+	assert.Regexp(t, `^3\.\d+\.\d+`, version)
+}
+
 func TestNewStore_DefaultDirectory(t *testing.T) {
 	// This test creates a database in the default location
 	// We'll clean it up, but it demonstrates the default behavior
@@ -143,9 +154,9 @@ func TestNewStore_Migrations(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
 
-	// Verify schema_migrations table exists
+	// Verify migrations table exists
 	var count int
-	err := store.db.QueryRow("SELECT COUNT(*) FROM schema_migrations").Scan(&count)
+	err := store.db.QueryRow("SELECT COUNT(*) FROM migrations").Scan(&count)
 	require.NoError(t, err)
 	assert.Greater(t, count, 0, "should have at least one migration")
 
@@ -775,6 +786,81 @@ func TestDocumentStore_ListDocuments(t *testing.T) {
 	assert.Empty(t, retrieved)
 }
 
+func TestDocumentStore_CountDocuments(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	createTestSource(t, store, "source-1")
+	createTestSource(t, store, "source-2")
+	createTestDocument(t, store, "doc-1", "source-1")
+	createTestDocument(t, store, "doc-2", "source-1")
+	createTestDocument(t, store, "doc-3", "source-2")
+
+	counter, ok := store.DocumentStore().(driven.DocumentCounter)
+	require.True(t, ok)
+
+	count, err := counter.CountDocuments(ctx, "source-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	count, err = counter.CountDocuments(ctx, "source-999")
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestDocumentStore_DocumentSourceIDs(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	createTestSource(t, store, "source-1")
+	createTestSource(t, store, "source-2")
+	createTestSource(t, store, "source-3")
+	createTestDocument(t, store, "doc-1", "source-1")
+	createTestDocument(t, store, "doc-2", "source-1")
+	createTestDocument(t, store, "doc-3", "source-2")
+
+	lister, ok := store.DocumentStore().(driven.DocumentSourceLister)
+	require.True(t, ok)
+
+	ids, err := lister.DocumentSourceIDs(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"source-1", "source-2"}, ids)
+}
+
+func TestDocumentStore_DeleteDocuments(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	docStore := store.DocumentStore()
+	createTestSource(t, store, "source-1")
+	createTestDocument(t, store, "doc-1", "source-1")
+	createTestDocument(t, store, "doc-2", "source-1")
+	createTestDocument(t, store, "doc-3", "source-1")
+	require.NoError(t, docStore.SaveChunks(ctx, []domain.Chunk{
+		{ID: "chunk-1", DocumentID: "doc-1", Content: "Chunk 1", Metadata: map[string]any{}},
+	}))
+
+	deleter, ok := docStore.(driven.DocumentBatchDeleter)
+	require.True(t, ok)
+
+	err := deleter.DeleteDocuments(ctx, []string{"doc-1", "doc-3", "doc-missing"})
+	require.NoError(t, err)
+
+	docs, err := docStore.ListDocuments(ctx, "source-1")
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "doc-2", docs[0].ID)
+
+	chunks, err := docStore.GetChunks(ctx, "doc-1")
+	require.NoError(t, err)
+	assert.Empty(t, chunks)
+
+	assert.NoError(t, deleter.DeleteDocuments(ctx, nil))
+}
+
 // ==================== Chunk Tests ====================
 
 func TestDocumentStore_SaveAndGetChunks(t *testing.T) {
@@ -1733,12 +1819,12 @@ func TestStore_MigrationIdempotency(t *testing.T) {
 
 	// Check migration version
 	var version1 int
-	err = store1.db.QueryRow("SELECT MAX(version) FROM schema_migrations").Scan(&version1)
+	err = store1.db.QueryRow("SELECT MAX(version) FROM migrations").Scan(&version1)
 	require.NoError(t, err)
 
 	// Check migration count
 	var count1 int
-	err = store1.db.QueryRow("SELECT COUNT(*) FROM schema_migrations").Scan(&count1)
+	err = store1.db.QueryRow("SELECT COUNT(*) FROM migrations").Scan(&count1)
 	require.NoError(t, err)
 
 	// Close and reopen (should not run migrations again)
@@ -1751,14 +1837,14 @@ func TestStore_MigrationIdempotency(t *testing.T) {
 
 	// Check migration version is the same
 	var version2 int
-	err = store2.db.QueryRow("SELECT MAX(version) FROM schema_migrations").Scan(&version2)
+	err = store2.db.QueryRow("SELECT MAX(version) FROM migrations").Scan(&version2)
 	require.NoError(t, err)
 
 	assert.Equal(t, version1, version2)
 
 	// Check migration count is the same
 	var count2 int
-	err = store2.db.QueryRow("SELECT COUNT(*) FROM schema_migrations").Scan(&count2)
+	err = store2.db.QueryRow("SELECT COUNT(*) FROM migrations").Scan(&count2)
 	require.NoError(t, err)
 
 	assert.Equal(t, count1, count2)
@@ -1773,8 +1859,8 @@ func TestStore_MigrateRecordsMigrationVersion(t *testing.T) {
 	require.NoError(t, err)
 	defer store.Close()
 
-	// Verify schema_migrations table records migrations
-	rows, err := store.db.Query("SELECT version FROM schema_migrations ORDER BY version")
+	// Verify migrations table records migrations
+	rows, err := store.db.Query("SELECT version FROM migrations ORDER BY version")
 	require.NoError(t, err)
 	defer rows.Close()
 
@@ -2627,3 +2713,131 @@ func TestExclusionStore_ScanMultipleRows(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, source1, 10)
 }
+
+// ==================== Credentials Store Tests ====================
+
+// createTestAccount saves a source using an auth provider, plus its own credentials.
+func createTestAccount(t *testing.T, store *Store, sourceID, authProviderID, account string) domain.Credentials {
+	t.Helper()
+	ctx := context.Background()
+
+	require.NoError(t, store.SourceStore().Save(ctx, domain.Source{
+		ID: sourceID, Type: "gmail", Name: sourceID, Config: map[string]string{}, AuthProviderID: authProviderID,
+	}))
+
+	now := time.Now().UTC()
+	creds := domain.Credentials{
+		ID:                "creds-" + sourceID,
+		SourceID:          sourceID,
+		AccountIdentifier: account,
+		OAuth:             &domain.OAuthCredentials{AccessToken: "token-" + sourceID, TokenType: "Bearer"},
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+	require.NoError(t, store.CredentialsStore().Save(ctx, creds))
+
+	source, err := store.SourceStore().Get(ctx, sourceID)
+	require.NoError(t, err)
+	source.CredentialsID = creds.ID
+	require.NoError(t, store.SourceStore().Save(ctx, *source))
+	return creds
+}
+
+// createTestAuthProvider saves an OAuth auth provider.
+func createTestAuthProvider(t *testing.T, store *Store, id string) {
+	t.Helper()
+	now := time.Now().UTC()
+	require.NoError(t, store.AuthProviderStore().Save(context.Background(), domain.AuthProvider{
+		ID: id, Name: id, ProviderType: domain.ProviderGoogle, AuthMethod: domain.AuthMethodOAuth,
+		CreatedAt: now, UpdatedAt: now,
+	}))
+}
+
+func TestCredentialsStore_GetAllByAuthProviderID(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	createTestAuthProvider(t, store, "google-app")
+	createTestAuthProvider(t, store, "other-app")
+	createTestAccount(t, store, "personal", "google-app", "me@gmail.com")
+	createTestAccount(t, store, "work", "google-app", "me@work.com")
+	createTestAccount(t, store, "other", "other-app", "someone@gmail.com")
+
+	all, err := store.CredentialsStore().GetAllByAuthProviderID(ctx, "google-app")
+
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	accounts := []string{all[0].AccountIdentifier, all[1].AccountIdentifier}
+	assert.ElementsMatch(t, []string{"me@gmail.com", "me@work.com"}, accounts)
+	assert.NotNil(t, all[0].OAuth)
+}
+
+func TestCredentialsStore_GetAllByAuthProviderID_SharedAccountListedOnce(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	createTestAuthProvider(t, store, "google-app")
+	creds := createTestAccount(t, store, "inbox", "google-app", "me@gmail.com")
+	require.NoError(t, store.SourceStore().Save(ctx, domain.Source{
+		ID: "drive", Type: "google-drive", Name: "drive", Config: map[string]string{},
+		AuthProviderID: "google-app", CredentialsID: creds.ID,
+	}))
+
+	all, err := store.CredentialsStore().GetAllByAuthProviderID(ctx, "google-app")
+
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, creds.ID, all[0].ID)
+}
+
+func TestCredentialsStore_GetAllByAuthProviderID_None(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	all, err := store.CredentialsStore().GetAllByAuthProviderID(context.Background(), "missing")
+
+	require.NoError(t, err)
+	assert.Empty(t, all)
+}
+
+func TestSourceStore_Delete_HandsOverSharedCredentials(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	createTestAuthProvider(t, store, "google-app")
+	creds := createTestAccount(t, store, "inbox", "google-app", "me@gmail.com")
+	require.NoError(t, store.SourceStore().Save(ctx, domain.Source{
+		ID: "drive", Type: "google-drive", Name: "drive", Config: map[string]string{},
+		AuthProviderID: "google-app", CredentialsID: creds.ID,
+	}))
+
+	// Deleting the owning source keeps the account for the other source
+	require.NoError(t, store.SourceStore().Delete(ctx, "inbox"))
+
+	got, err := store.CredentialsStore().Get(ctx, creds.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "drive", got.SourceID)
+
+	// Deleting the last source removes the account
+	require.NoError(t, store.SourceStore().Delete(ctx, "drive"))
+
+	_, err = store.CredentialsStore().Get(ctx, creds.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestSourceStore_Delete_RemovesUnsharedCredentials(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	createTestAuthProvider(t, store, "google-app")
+	creds := createTestAccount(t, store, "inbox", "google-app", "me@gmail.com")
+
+	require.NoError(t, store.SourceStore().Delete(ctx, "inbox"))
+
+	_, err := store.CredentialsStore().Get(ctx, creds.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
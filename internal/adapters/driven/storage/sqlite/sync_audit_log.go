@@ -0,0 +1,131 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// syncAuditLog implements driven.SyncAuditLog.
+type syncAuditLog struct {
+	store *Store
+
+	// maxEntries is how many entries are kept.
+	maxEntries int
+}
+
+var _ driven.SyncAuditLog = (*syncAuditLog)(nil)
+
+// Append records entries, evicting the oldest if the log is full.
+func (l *syncAuditLog) Append(ctx context.Context, entries []domain.SyncAuditEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	tx, err := l.store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO sync_audit_log (source_id, document_uri, event_type, reason, sync_run_id, occurred_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`)
+	if err != nil {
+		return fmt.Errorf("preparing statement: %w", err)
+	}
+	defer stmt.Close()
+
+	for i := range entries {
+		e := &entries[i]
+		_, err := stmt.ExecContext(ctx, e.SourceID, e.DocumentURI, string(e.Event), e.Reason, e.SyncRunID,
+			e.OccurredAt.UTC().Format(time.RFC3339))
+		if err != nil {
+			return fmt.Errorf("appending sync audit entry: %w", err)
+		}
+	}
+
+	// Evict everything older than the newest maxEntries entries
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM sync_audit_log WHERE id <= (
+			SELECT id FROM sync_audit_log ORDER BY id DESC LIMIT 1 OFFSET ?
+		)
+	`, l.maxEntries)
+	if err != nil {
+		return fmt.Errorf("evicting sync audit entries: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing sync audit entries: %w", err)
+	}
+	return nil
+}
+
+// List returns the entries matching filter, newest first.
+func (l *syncAuditLog) List(ctx context.Context, filter domain.SyncAuditFilter) ([]domain.SyncAuditEntry, error) {
+	var conditions []string
+	var args []any
+	if filter.SourceID != "" {
+		conditions = append(conditions, "source_id = ?")
+		args = append(args, filter.SourceID)
+	}
+	if !filter.After.IsZero() {
+		conditions = append(conditions, "occurred_at > ?")
+		args = append(args, filter.After.UTC().Format(time.RFC3339))
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = domain.DefaultSyncAuditLimit
+	}
+
+	query := `SELECT id, source_id, document_uri, event_type, reason, sync_run_id, occurred_at
+		FROM sync_audit_log`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY id DESC LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := l.store.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying sync audit log: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []domain.SyncAuditEntry
+	for rows.Next() {
+		var e domain.SyncAuditEntry
+		var event string
+		var occurredAt sql.NullString
+		if err := rows.Scan(&e.ID, &e.SourceID, &e.DocumentURI, &event, &e.Reason, &e.SyncRunID,
+			&occurredAt); err != nil {
+			return nil, fmt.Errorf("scanning sync audit entry: %w", err)
+		}
+		e.Event = domain.SyncAuditEvent(event)
+		e.OccurredAt = parseNullableTime(occurredAt)
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating sync audit log: %w", err)
+	}
+	return entries, nil
+}
+
+// Clear removes every entry, returning how many were removed.
+func (l *syncAuditLog) Clear(ctx context.Context) (int, error) {
+	result, err := l.store.db.ExecContext(ctx, "DELETE FROM sync_audit_log")
+	if err != nil {
+		return 0, fmt.Errorf("clearing sync audit log: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("clearing sync audit log: %w", err)
+	}
+	return int(n), nil
+}
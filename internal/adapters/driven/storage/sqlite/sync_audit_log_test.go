@@ -0,0 +1,135 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func auditEntry(sourceID, uri string, event domain.SyncAuditEvent, at time.Time) domain.SyncAuditEntry {
+	return domain.SyncAuditEntry{
+		SourceID:    sourceID,
+		DocumentURI: uri,
+		Event:       event,
+		SyncRunID:   "run-1",
+		OccurredAt:  at,
+	}
+}
+
+func TestSyncAuditLog_AppendAndList(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+	auditLog := store.SyncAuditLog()
+
+	at := time.Date(2024, 3, 5, 17, 30, 0, 0, time.UTC)
+	skipped := auditEntry("src-1", "b.txt", domain.SyncAuditSkipped, at)
+	skipped.Reason = "excluded"
+	require.NoError(t, auditLog.Append(ctx, []domain.SyncAuditEntry{
+		auditEntry("src-1", "a.txt", domain.SyncAuditCreated, at),
+		skipped,
+	}))
+
+	entries, err := auditLog.List(ctx, domain.SyncAuditFilter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "b.txt", entries[0].DocumentURI, "newest first")
+	assert.Equal(t, domain.SyncAuditSkipped, entries[0].Event)
+	assert.Equal(t, "excluded", entries[0].Reason)
+	assert.Equal(t, "run-1", entries[0].SyncRunID)
+	assert.True(t, at.Equal(entries[0].OccurredAt))
+	assert.Greater(t, entries[0].ID, entries[1].ID)
+	assert.Equal(t, domain.SyncAuditCreated, entries[1].Event)
+}
+
+func TestSyncAuditLog_ListFilters(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+	auditLog := store.SyncAuditLog()
+
+	day := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, auditLog.Append(ctx, []domain.SyncAuditEntry{
+		auditEntry("src-1", "old.txt", domain.SyncAuditCreated, day.Add(-time.Hour)),
+		auditEntry("src-1", "new.txt", domain.SyncAuditUpdated, day.Add(time.Hour)),
+		auditEntry("src-2", "other.txt", domain.SyncAuditDeleted, day.Add(2*time.Hour)),
+	}))
+
+	entries, err := auditLog.List(ctx, domain.SyncAuditFilter{SourceID: "src-1"})
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	entries, err = auditLog.List(ctx, domain.SyncAuditFilter{SourceID: "src-1", After: day})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "new.txt", entries[0].DocumentURI)
+
+	entries, err = auditLog.List(ctx, domain.SyncAuditFilter{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "other.txt", entries[0].DocumentURI)
+}
+
+func TestSyncAuditLog_EvictsOldestEntries(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+	auditLog := &syncAuditLog{store: store, maxEntries: 3}
+
+	for i := range 5 {
+		require.NoError(t, auditLog.Append(ctx, []domain.SyncAuditEntry{
+			auditEntry("src-1", fmt.Sprintf("%d.txt", i), domain.SyncAuditCreated, time.Now()),
+		}))
+	}
+
+	entries, err := auditLog.List(ctx, domain.SyncAuditFilter{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "4.txt", entries[0].DocumentURI)
+	assert.Equal(t, "2.txt", entries[2].DocumentURI)
+}
+
+func TestSyncAuditLog_Clear(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+	auditLog := store.SyncAuditLog()
+	require.NoError(t, auditLog.Append(ctx, []domain.SyncAuditEntry{
+		auditEntry("src-1", "a.txt", domain.SyncAuditCreated, time.Now()),
+		auditEntry("src-1", "b.txt", domain.SyncAuditCreated, time.Now()),
+	}))
+
+	removed, err := auditLog.Clear(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, removed)
+
+	entries, err := auditLog.List(ctx, domain.SyncAuditFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestSyncAuditLog_IncludedInSnapshot(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx := context.Background()
+	require.NoError(t, store.SyncAuditLog().Append(ctx, []domain.SyncAuditEntry{
+		auditEntry("src-1", "a.txt", domain.SyncAuditCreated, time.Now()),
+	}))
+
+	snapshot := filepath.Join(t.TempDir(), "snapshot.db")
+	require.NoError(t, store.Snapshot(ctx, snapshot))
+	_, err := store.SyncAuditLog().Clear(ctx)
+	require.NoError(t, err)
+	require.NoError(t, store.Restore(ctx, snapshot))
+
+	entries, err := store.SyncAuditLog().List(ctx, domain.SyncAuditFilter{})
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
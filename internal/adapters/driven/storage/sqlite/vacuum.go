@@ -0,0 +1,52 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Store implements the interface.
+var _ driven.DatabaseVacuumer = (*Store)(nil)
+
+// Vacuum rebuilds the database file to reclaim free pages.
+//
+// The write-ahead log is checkpointed first so every committed page is in the
+// main file; if another connection holds the log open the vacuum is refused
+// rather than left half done. VACUUM itself holds the database's exclusive
+// write lock while it runs. A final checkpoint truncates the log the rebuild
+// was written through.
+func (s *Store) Vacuum(ctx context.Context) error {
+	// Pin a single connection so every step runs against the same lock state
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire connection: %w", err)
+	}
+	defer conn.Close()
+
+	if err := checkpoint(ctx, conn); err != nil {
+		return err
+	}
+
+	if _, err := conn.ExecContext(ctx, "VACUUM"); err != nil {
+		return fmt.Errorf("vacuum database: %w", err)
+	}
+
+	return checkpoint(ctx, conn)
+}
+
+// checkpoint copies the write-ahead log into the database file and truncates it.
+func checkpoint(ctx context.Context, conn *sql.Conn) error {
+	var busy, logPages, checkpointed int
+	row := conn.QueryRowContext(ctx, "PRAGMA wal_checkpoint(TRUNCATE)")
+	if err := row.Scan(&busy, &logPages, &checkpointed); err != nil {
+		return fmt.Errorf("checkpoint write-ahead log: %w", err)
+	}
+	if busy != 0 {
+		return errors.New("checkpoint write-ahead log: database is in use by another process")
+	}
+	return nil
+}
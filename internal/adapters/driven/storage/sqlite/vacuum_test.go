@@ -0,0 +1,62 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func fileSize(t *testing.T, path string) int64 {
+	t.Helper()
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return 0
+	}
+	require.NoError(t, err)
+	return info.Size()
+}
+
+func TestStore_Vacuum_ReclaimsDeletedPages(t *testing.T) {
+	ctx := context.Background()
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	createTestSource(t, store, "src-1")
+	docStore := store.DocumentStore()
+	now := time.Now().UTC()
+	for i := range 50 {
+		require.NoError(t, docStore.SaveDocument(ctx, &domain.Document{
+			ID:        fmt.Sprintf("doc-%d", i),
+			SourceID:  "src-1",
+			URI:       fmt.Sprintf("file:///test/%d", i),
+			Content:   strings.Repeat("x", 8192),
+			Metadata:  map[string]any{},
+			CreatedAt: now,
+			UpdatedAt: now,
+		}))
+	}
+	createTestDocument(t, store, "keep", "src-1")
+	for i := range 50 {
+		require.NoError(t, docStore.DeleteDocument(ctx, fmt.Sprintf("doc-%d", i)))
+	}
+
+	before := fileSize(t, store.Path()) + fileSize(t, store.Path()+"-wal")
+	require.NoError(t, store.Vacuum(ctx))
+	after := fileSize(t, store.Path()) + fileSize(t, store.Path()+"-wal")
+
+	assert.Less(t, after, before)
+	assert.Zero(t, fileSize(t, store.Path()+"-wal"), "write-ahead log is truncated")
+
+	// Remaining data is intact and the store is still usable
+	_, err := docStore.GetDocument(ctx, "keep")
+	require.NoError(t, err)
+	createTestDocument(t, store, "after", "src-1")
+}
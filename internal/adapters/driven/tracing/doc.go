@@ -0,0 +1,7 @@
+// Package tracing creates the OpenTelemetry tracer provider that exports
+// spans to an OTLP/HTTP collector.
+//
+// Installing the provider with otel.SetTracerProvider turns the spans
+// recorded by the services and connectors into traces. Without one they are
+// no-ops.
+package tracing
@@ -0,0 +1,64 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+const (
+	// DefaultExportTimeout bounds each export request.
+	DefaultExportTimeout = 10 * time.Second
+
+	// tracesPath is the OTLP/HTTP path for traces, added to endpoints
+	// given without it.
+	tracesPath = "/v1/traces"
+)
+
+// NewProvider creates a tracer provider exporting spans in batches to the
+// OTLP/HTTP collector at endpoint, e.g. "http://localhost:4318". Spans are
+// attributed to the service name and version.
+func NewProvider(endpoint, serviceName, serviceVersion string) (*sdktrace.TracerProvider, error) {
+	if err := domain.ValidateEndpointURL(endpoint); err != nil {
+		return nil, fmt.Errorf("trace endpoint: %w", err)
+	}
+
+	exporter, err := otlptracehttp.New(context.Background(),
+		otlptracehttp.WithEndpointURL(tracesURL(endpoint)),
+		otlptracehttp.WithTimeout(DefaultExportTimeout),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(serviceVersion),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("create trace resource: %w", err)
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	), nil
+}
+
+// tracesURL returns the URL traces are posted to for a collector endpoint.
+func tracesURL(endpoint string) string {
+	url := strings.TrimSuffix(endpoint, "/")
+	if !strings.HasSuffix(url, tracesPath) {
+		url += tracesPath
+	}
+	return url
+}
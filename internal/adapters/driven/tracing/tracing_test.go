@@ -0,0 +1,78 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// collector records the names of the spans exported to it.
+type collector struct {
+	mu    sync.Mutex
+	paths []string
+	names []string
+}
+
+func newCollector(t *testing.T) (*collector, *httptest.Server) {
+	t.Helper()
+	c := &collector{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var req coltracepb.ExportTraceServiceRequest
+		if err := proto.Unmarshal(body, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.paths = append(c.paths, r.URL.Path)
+		for _, rs := range req.GetResourceSpans() {
+			for _, ss := range rs.GetScopeSpans() {
+				for _, s := range ss.GetSpans() {
+					c.names = append(c.names, s.GetName())
+				}
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return c, server
+}
+
+func TestNewProvider_InvalidEndpoint(t *testing.T) {
+	_, err := NewProvider("localhost:4318", "sercha", "dev")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestProvider_ExportsSpansToCollector(t *testing.T) {
+	c, server := newCollector(t)
+	provider, err := NewProvider(server.URL, "sercha", "dev")
+	require.NoError(t, err)
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "sync")
+	_, child := provider.Tracer("test").Start(ctx, "fetch page")
+	child.End()
+	parent.End()
+	require.NoError(t, provider.Shutdown(context.Background()))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	assert.ElementsMatch(t, []string{"fetch page", "sync"}, c.names)
+	require.NotEmpty(t, c.paths)
+	assert.Equal(t, "/v1/traces", c.paths[0])
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var accountsCmd = &cobra.Command{
+	Use:   "accounts",
+	Short: "View authenticated accounts",
+	Long: `View the accounts your sources are authenticated as.
+
+Accounts are grouped across connectors by provider and account identifier,
+so a Google account used for both Gmail and Drive appears once.
+
+Examples:
+  sercha accounts list`,
+}
+
+var accountsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List accounts and the sources that use them",
+	RunE:  runAccountsList,
+}
+
+func init() {
+	accountsCmd.AddCommand(accountsListCmd)
+	rootCmd.AddCommand(accountsCmd)
+}
+
+func runAccountsList(cmd *cobra.Command, _ []string) error {
+	if accountService == nil {
+		return errors.New("account service not configured")
+	}
+
+	ctx := context.Background()
+	accounts, err := accountService.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	if len(accounts) == 0 {
+		cmd.Println("No authenticated accounts.")
+		return nil
+	}
+
+	cmd.Println("Accounts:")
+	cmd.Println()
+	for i := range accounts {
+		cmd.Printf("  %s\n", accounts[i].DisplayName())
+		cmd.Printf("    Provider: %s\n", accounts[i].ProviderType)
+		cmd.Println("    Sources:")
+		for j := range accounts[i].Sources {
+			src := &accounts[i].Sources[j]
+			cmd.Printf("      - %s (%s, %s)\n", src.Name, src.Type, src.ID)
+		}
+		cmd.Println()
+	}
+
+	return nil
+}
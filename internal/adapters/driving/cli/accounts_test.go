@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockAccountService implements driving.AccountService for testing.
+type mockAccountService struct {
+	accounts []domain.Account
+}
+
+func (m *mockAccountService) List(_ context.Context) ([]domain.Account, error) {
+	return m.accounts, nil
+}
+
+func runAccountsListCmd(t *testing.T, svc *mockAccountService) (string, error) {
+	t.Helper()
+	oldAccounts := accountService
+	accountService = svc
+	defer func() { accountService = oldAccounts }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{"accounts", "list"})
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestAccountsListCmd_ShowsAccountsWithSources(t *testing.T) {
+	out, err := runAccountsListCmd(t, &mockAccountService{accounts: []domain.Account{
+		{
+			Identifier:   "me@gmail.com",
+			ProviderType: domain.ProviderGoogle,
+			Sources: []domain.Source{
+				{ID: "s1", Type: "gmail", Name: "Mail"},
+				{ID: "s2", Type: "google-drive", Name: "Drive"},
+			},
+		},
+		{ProviderType: domain.ProviderMicrosoft, Sources: []domain.Source{{ID: "s3", Type: "onedrive", Name: "Files"}}},
+	}})
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "me@gmail.com")
+	assert.Contains(t, out, "Provider: google")
+	assert.Contains(t, out, "- Mail (gmail, s1)")
+	assert.Contains(t, out, "- Drive (google-drive, s2)")
+	assert.Contains(t, out, "(unknown account)")
+	assert.Contains(t, out, "- Files (onedrive, s3)")
+}
+
+func TestAccountsListCmd_Empty(t *testing.T) {
+	out, err := runAccountsListCmd(t, &mockAccountService{})
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "No authenticated accounts.")
+}
+
+func TestAccountsListCmd_NoService(t *testing.T) {
+	oldAccounts := accountService
+	accountService = nil
+	defer func() { accountService = oldAccounts }()
+
+	err := runAccountsList(accountsListCmd, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "account service not configured")
+}
@@ -0,0 +1,271 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+var applyFile string
+
+var applyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Create or update sources from a manifest file",
+	Long: `Create or update the sources declared in a YAML manifest, without prompts.
+
+Each declared source updates the existing source with the same id, or with
+the same name if no id is given, and is created otherwise. Applying the same
+manifest again changes nothing. Config values the connector marks as secret
+are kept when omitted from an update.
+
+New sources that need authentication reference their credentials with
+auth.provider (the ID or name of an OAuth app configuration) or
+auth.token_env (an environment variable holding a Personal Access Token).
+Credentials of existing sources are not changed; use 'sercha auth rotate'.
+
+Manifest format:
+  version: 1
+  sources:
+    - name: Notes
+      type: filesystem
+      config:
+        path: ~/notes
+    - name: Docs repo
+      type: github
+      config:
+        owner: acme
+        repo: docs
+      auth:
+        token_env: GITHUB_TOKEN
+
+Examples:
+  sercha apply -f sources.yaml`,
+	Args: cobra.NoArgs,
+	RunE: runApply,
+}
+
+func init() {
+	applyCmd.Flags().StringVarP(&applyFile, "file", "f", "", "Manifest file declaring the sources")
+	_ = applyCmd.MarkFlagRequired("file")
+	rootCmd.AddCommand(applyCmd)
+}
+
+func runApply(cmd *cobra.Command, _ []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+	if connectorRegistry == nil {
+		return errors.New("connector registry not configured")
+	}
+
+	data, err := os.ReadFile(applyFile)
+	if err != nil {
+		return fmt.Errorf("failed to read manifest: %w", err)
+	}
+	var manifest domain.SourceManifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if manifest.Version > domain.SourceManifestVersion {
+		return fmt.Errorf("manifest version %d is newer than supported version %d",
+			manifest.Version, domain.SourceManifestVersion)
+	}
+
+	ctx := context.Background()
+	existing, err := sourceService.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list sources: %w", err)
+	}
+
+	var created, updated, unchanged, failed int
+	for i := range manifest.Sources {
+		spec := &manifest.Sources[i]
+		label := spec.Name
+		if label == "" {
+			label = spec.Type
+		}
+
+		if err := spec.Validate(); err != nil {
+			cmd.Printf("Failed to apply %s: %v\n", label, err)
+			failed++
+			continue
+		}
+
+		if idx := findSpecSource(spec, existing); idx >= 0 {
+			changed, err := updateSourceFromSpec(ctx, spec, &existing[idx])
+			switch {
+			case err != nil:
+				cmd.Printf("Failed to update %s: %v\n", label, err)
+				failed++
+			case changed:
+				cmd.Printf("Updated %s: %s\n", label, existing[idx].ID)
+				updated++
+			default:
+				cmd.Printf("Unchanged %s: %s\n", label, existing[idx].ID)
+				unchanged++
+			}
+			continue
+		}
+
+		source, err := createSourceFromSpec(ctx, cmd, spec)
+		if err != nil {
+			cmd.Printf("Failed to create %s: %v\n", label, err)
+			failed++
+			continue
+		}
+		cmd.Printf("Created %s: %s\n", label, source.ID)
+		existing = append(existing, *source)
+		created++
+	}
+
+	cmd.Printf("\n%d created, %d updated, %d unchanged, %d failed\n", created, updated, unchanged, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d source(s) failed to apply", failed)
+	}
+	return nil
+}
+
+// findSpecSource returns the index of the source a spec declares, matched
+// by ID if the spec has one and by name otherwise, or -1 if there is none.
+func findSpecSource(spec *domain.SourceSpec, sources []domain.Source) int {
+	for i := range sources {
+		if spec.ID != "" {
+			if sources[i].ID == spec.ID {
+				return i
+			}
+			continue
+		}
+		if sources[i].Name == spec.Name {
+			return i
+		}
+	}
+	return -1
+}
+
+// updateSourceFromSpec applies a spec's name and config to an existing
+// source, keeping secret config values the spec omits. It reports whether
+// the source changed; unchanged sources are not written.
+func updateSourceFromSpec(ctx context.Context, spec *domain.SourceSpec, source *domain.Source) (bool, error) {
+	if source.Type != spec.Type {
+		return false, fmt.Errorf("existing source has connector type %s, not %s", source.Type, spec.Type)
+	}
+	connector, err := connectorRegistry.Get(spec.Type)
+	if err != nil {
+		return false, fmt.Errorf("unknown connector type: %s", spec.Type)
+	}
+
+	config := maps.Clone(spec.Config)
+	for _, key := range connector.ConfigKeys {
+		if _, ok := config[key.Key]; key.Secret && !ok {
+			if val, ok := source.Config[key.Key]; ok {
+				if config == nil {
+					config = make(map[string]string)
+				}
+				config[key.Key] = val
+			}
+		}
+	}
+
+	if source.Name == spec.Name && maps.Equal(source.Config, config) {
+		return false, nil
+	}
+	if err := validateSourceConfig(connector, config); err != nil {
+		return false, err
+	}
+
+	next := *source
+	next.Name = spec.Name
+	next.Config = config
+	if err := sourceService.Update(ctx, next); err != nil {
+		return false, fmt.Errorf("failed to update source: %w", err)
+	}
+	*source = next
+	return true, nil
+}
+
+// createSourceFromSpec authenticates and creates a declared source using
+// the credentials its spec references.
+func createSourceFromSpec(ctx context.Context, cmd *cobra.Command, spec *domain.SourceSpec) (*domain.Source, error) {
+	connector, err := connectorRegistry.Get(spec.Type)
+	if err != nil {
+		return nil, fmt.Errorf("unknown connector type: %s", spec.Type)
+	}
+	if err := validateSourceConfig(connector, spec.Config); err != nil {
+		return nil, err
+	}
+
+	var token, authID string
+	if spec.Auth.TokenEnv != "" {
+		if token = os.Getenv(spec.Auth.TokenEnv); token == "" {
+			return nil, fmt.Errorf("environment variable %s is not set", spec.Auth.TokenEnv)
+		}
+	}
+	if spec.Auth.Provider != "" {
+		if authID, err = resolveSpecAuthProvider(ctx, connector, spec.Auth.Provider); err != nil {
+			return nil, err
+		}
+	}
+
+	sourceID := spec.ID
+	if sourceID == "" {
+		sourceID = uuid.New().String()
+	}
+	authResult, err := selectAuthWithNewSystem(ctx, cmd, connector, sourceID, token, authID, "", true)
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w", err)
+	}
+	source := domain.Source{
+		ID:             sourceID,
+		Type:           spec.Type,
+		Name:           spec.Name,
+		Config:         spec.Config,
+		AuthProviderID: authResult.AuthProviderID,
+	}
+	if err := addSourceWithCredentials(ctx, cmd, source, authResult); err != nil {
+		return nil, err
+	}
+	return &source, nil
+}
+
+// validateSourceConfig checks that config has the connector's required keys
+// and passes its validation.
+func validateSourceConfig(connector *domain.ConnectorType, config map[string]string) error {
+	for _, key := range connector.ConfigKeys {
+		if _, ok := config[key.Key]; key.Required && !ok {
+			return fmt.Errorf("required config missing: %s", key.Key)
+		}
+	}
+	if err := connectorRegistry.ValidateConfig(connector.ID, config); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	return nil
+}
+
+// resolveSpecAuthProvider returns the ID of the OAuth app configuration a
+// spec references by ID or, for the connector's provider type, by name.
+func resolveSpecAuthProvider(ctx context.Context, connector *domain.ConnectorType, ref string) (string, error) {
+	if authProviderService == nil {
+		return "", errors.New("auth provider service not configured")
+	}
+	if provider, err := authProviderService.Get(ctx, ref); err == nil {
+		return provider.ID, nil
+	}
+	providers, err := authProviderService.ListByProvider(ctx, connector.ProviderType)
+	if err != nil {
+		return "", fmt.Errorf("failed to list auth providers: %w", err)
+	}
+	for i := range providers {
+		if providers[i].Name == ref {
+			return providers[i].ID, nil
+		}
+	}
+	return "", fmt.Errorf("auth provider not found: %s", ref)
+}
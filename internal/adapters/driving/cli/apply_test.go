@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func runApplyCmd(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"apply"}, args...))
+	defer func() {
+		rootCmd.SetArgs(nil)
+		applyFile = ""
+	}()
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func writeManifest(t *testing.T, manifest string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "sources.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(manifest), 0o600))
+	return path
+}
+
+const applyFixture = `version: 1
+sources:
+  - name: Notes
+    type: filesystem
+    config:
+      path: /notes
+  - name: Infra
+    type: gitlab
+    config:
+      project: acme/infra
+    auth:
+      token_env: SERCHA_TEST_GITLAB_TOKEN
+`
+
+func TestApplyCmd_CreatesNewSources(t *testing.T) {
+	svc, creds, cleanup := setupExportServices()
+	defer cleanup()
+	t.Setenv("SERCHA_TEST_GITLAB_TOKEN", "glpat-123")
+
+	out, err := runApplyCmd(t, "-f", writeManifest(t, applyFixture))
+
+	require.NoError(t, err, out)
+	assert.Contains(t, out, "2 created, 0 updated, 0 unchanged, 0 failed")
+	require.Len(t, svc.sources, 2)
+	notes, infra := svc.sources[0], svc.sources[1]
+	assert.Equal(t, "Notes", notes.Name)
+	assert.Equal(t, map[string]string{"path": "/notes"}, notes.Config)
+	assert.Empty(t, notes.CredentialsID)
+	assert.Equal(t, "gitlab", infra.Type)
+	assert.NotEmpty(t, infra.CredentialsID)
+	assert.Len(t, creds.saved, 1)
+}
+
+func TestApplyCmd_UpdatesExistingSourceByName(t *testing.T) {
+	svc, _, cleanup := setupExportServices(domain.Source{
+		ID: "src-1", Type: "gitlab", Name: "Infra", CredentialsID: "creds-1",
+		Config: map[string]string{"project": "acme/old", "webhook_secret": "s3cret"},
+	})
+	defer cleanup()
+	t.Setenv("SERCHA_TEST_GITLAB_TOKEN", "")
+
+	out, err := runApplyCmd(t, "-f", writeManifest(t, applyFixture))
+
+	require.NoError(t, err, out)
+	assert.Contains(t, out, "Updated Infra: src-1")
+	assert.Contains(t, out, "1 created, 1 updated, 0 unchanged, 0 failed")
+	require.Len(t, svc.sources, 2)
+	infra := svc.sources[0]
+	assert.Equal(t, "src-1", infra.ID)
+	assert.Equal(t, "creds-1", infra.CredentialsID)
+	assert.Equal(t, map[string]string{"project": "acme/infra", "webhook_secret": "s3cret"}, infra.Config)
+}
+
+func TestApplyCmd_IsIdempotent(t *testing.T) {
+	svc, _, cleanup := setupExportServices()
+	defer cleanup()
+	t.Setenv("SERCHA_TEST_GITLAB_TOKEN", "glpat-123")
+	path := writeManifest(t, applyFixture)
+
+	_, err := runApplyCmd(t, "-f", path)
+	require.NoError(t, err)
+	out, err := runApplyCmd(t, "-f", path)
+
+	require.NoError(t, err, out)
+	assert.Contains(t, out, "0 created, 0 updated, 2 unchanged, 0 failed")
+	assert.Len(t, svc.sources, 2)
+}
+
+func TestApplyCmd_MatchesByID(t *testing.T) {
+	svc, _, cleanup := setupExportServices(domain.Source{
+		ID: "src-1", Type: "filesystem", Name: "Old name", Config: map[string]string{"path": "/notes"},
+	})
+	defer cleanup()
+	path := writeManifest(t, `version: 1
+sources:
+  - id: src-1
+    name: Notes
+    type: filesystem
+    config:
+      path: /notes
+`)
+
+	out, err := runApplyCmd(t, "-f", path)
+
+	require.NoError(t, err, out)
+	assert.Contains(t, out, "Updated Notes: src-1")
+	require.Len(t, svc.sources, 1)
+	assert.Equal(t, "Notes", svc.sources[0].Name)
+}
+
+func TestApplyCmd_ReportsFailures(t *testing.T) {
+	svc, _, cleanup := setupExportServices(domain.Source{
+		ID: "src-1", Type: "filesystem", Name: "Infra", Config: map[string]string{"path": "/infra"},
+	})
+	defer cleanup()
+	t.Setenv("SERCHA_TEST_GITLAB_TOKEN", "")
+	path := writeManifest(t, applyFixture+`  - name: Mystery
+    type: unknown
+  - type: filesystem
+`)
+
+	out, err := runApplyCmd(t, "-f", path)
+
+	require.Error(t, err)
+	assert.Contains(t, out, "Failed to update Infra: existing source has connector type filesystem, not gitlab")
+	assert.Contains(t, out, "Failed to create Mystery: unknown connector type: unknown")
+	assert.Contains(t, out, "Failed to apply filesystem:")
+	assert.Contains(t, out, "1 created, 0 updated, 0 unchanged, 3 failed")
+	assert.Len(t, svc.sources, 2)
+}
+
+func TestApplyCmd_MissingTokenEnv(t *testing.T) {
+	svc, _, cleanup := setupExportServices()
+	defer cleanup()
+	t.Setenv("SERCHA_TEST_GITLAB_TOKEN", "")
+
+	out, err := runApplyCmd(t, "-f", writeManifest(t, applyFixture))
+
+	require.Error(t, err)
+	assert.Contains(t, out, "Failed to create Infra: environment variable SERCHA_TEST_GITLAB_TOKEN is not set")
+	assert.Len(t, svc.sources, 1)
+}
+
+func TestApplyCmd_RejectsNewerVersion(t *testing.T) {
+	_, _, cleanup := setupExportServices()
+	defer cleanup()
+
+	_, err := runApplyCmd(t, "-f", writeManifest(t, "version: 2\n"))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "newer than supported")
+}
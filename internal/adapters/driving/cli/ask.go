@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+var (
+	askLimit   int
+	askSources []string
+	askJSON    bool
+)
+
+var askCmd = &cobra.Command{
+	Use:   "ask [question]",
+	Short: "Answer a question from indexed documents",
+	Long: `Answers a question with the configured LLM, using the most relevant
+indexed documents as context. The answer is printed as it is written and
+cites the documents it draws on, which are listed below it along with
+suggested follow-up questions.
+
+Requires an LLM provider; see "sercha settings wizard".
+
+Examples:
+  sercha ask "what is our deployment process?"
+  sercha ask --source src-123 "who owns the billing service?"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAsk,
+}
+
+func init() {
+	askCmd.Flags().IntVarP(&askLimit, "limit", "n", 10, "maximum number of documents to use as context")
+	askCmd.Flags().StringSliceVar(&askSources, "source", nil, "only use documents from these source IDs")
+	askCmd.Flags().BoolVar(&askJSON, "json", false, "output the answer as JSON")
+	rootCmd.AddCommand(askCmd)
+}
+
+func runAsk(cmd *cobra.Command, args []string) error {
+	if searchService == nil {
+		return errors.New("search service not configured")
+	}
+
+	// Print the answer as it is written, unless it is wanted as JSON
+	var onAnswer func(string)
+	printed := false
+	if !askJSON {
+		onAnswer = func(chunk string) {
+			cmd.Print(chunk)
+			printed = true
+		}
+	}
+
+	opts := domain.SearchOptions{Limit: askLimit, SourceIDs: askSources}
+	result, err := searchService.Ask(context.Background(), args[0], opts, onAnswer)
+	if err != nil && printed {
+		// End a partly printed answer before the error
+		cmd.Println()
+	}
+	if errors.Is(err, domain.ErrLLMUnavailable) {
+		return errors.New("ask requires an LLM provider; configure one with 'sercha settings wizard'")
+	}
+	if err != nil {
+		return fmt.Errorf("ask failed: %w", err)
+	}
+
+	if askJSON {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal answer: %w", err)
+		}
+		cmd.Println(string(data))
+		return nil
+	}
+
+	if result.Answer == "" {
+		cmd.Println("No relevant documents found.")
+		return nil
+	}
+
+	cmd.Println()
+	if len(result.Citations) > 0 {
+		cmd.Println()
+		cmd.Println("Sources:")
+		for i := range result.Citations {
+			doc := &result.Citations[i].Document
+			title := doc.Title
+			if title == "" {
+				title = doc.ID
+			}
+			cmd.Printf("  [%d] %s\n", i+1, title)
+			if doc.URI != "" {
+				cmd.Printf("      %s\n", doc.URI)
+			}
+		}
+	}
+	if len(result.FollowUpSuggestions) > 0 {
+		cmd.Println()
+		cmd.Println("Follow-up questions:")
+		for _, question := range result.FollowUpSuggestions {
+			cmd.Printf("  - %s\n", question)
+		}
+	}
+	return nil
+}
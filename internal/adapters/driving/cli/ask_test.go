@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// recordingAskService records the options it was asked with.
+type recordingAskService struct {
+	mockSearchService
+	opts domain.SearchOptions
+}
+
+func (m *recordingAskService) Ask(
+	ctx context.Context, question string, opts domain.SearchOptions, onAnswer func(string),
+) (domain.AskResult, error) {
+	m.opts = opts
+	return m.mockSearchService.Ask(ctx, question, opts, onAnswer)
+}
+
+func runAskCmd(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"ask"}, args...))
+	defer func() {
+		rootCmd.SetArgs(nil)
+		askLimit = 10
+		askSources = nil
+		askJSON = false
+	}()
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestAskCmd(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	recorder := &recordingAskService{}
+	searchService = recorder
+
+	out, err := runAskCmd(t, "--source", "src-1,src-2", "-n", "5", "how do we deploy?")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Deploys run from the release branch [Source: file:///docs/deploy.md].\n\nSources:")
+	assert.Contains(t, out, "Sources:\n  [1] Deploy Guide\n      file:///docs/deploy.md")
+	assert.Contains(t, out, "Follow-up questions:\n  - Who approves releases?")
+	assert.Equal(t, 5, recorder.opts.Limit)
+	assert.Equal(t, []string{"src-1", "src-2"}, recorder.opts.SourceIDs)
+}
+
+func TestAskCmd_JSON(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	out, err := runAskCmd(t, "--json", "how do we deploy?")
+
+	require.NoError(t, err)
+	var result domain.AskResult
+	require.NoError(t, json.Unmarshal([]byte(out), &result), "the answer is not streamed into the JSON")
+	assert.Len(t, result.Citations, 1)
+	assert.Equal(t, []string{"Who approves releases?"}, result.FollowUpSuggestions)
+}
+
+func TestAskCmd_NoRelevantDocuments(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	out, err := runAskCmd(t, "")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "No relevant documents found.")
+}
+
+func TestAskCmd_LLMUnavailable(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	searchService = &mockSearchServiceError{}
+
+	_, err := runAskCmd(t, "how do we deploy?")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires an LLM provider")
+}
+
+func TestAskCmd_NotConfigured(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	searchService = nil
+
+	_, err := runAskCmd(t, "how do we deploy?")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "search service not configured")
+}
@@ -79,6 +79,65 @@ var authRemoveCmd = &cobra.Command{
 	RunE:  runAuthRemove,
 }
 
+var authRotateCmd = &cobra.Command{
+	Use:   "rotate [source-id]",
+	Short: "Rotate a source's OAuth token",
+	Long: `Obtain a fresh OAuth token for a source and save it to the credentials store.
+
+The stored refresh token is used when it is still valid. If the refresh fails,
+or the source has no refresh token, the browser authorization flow is started
+using the source's OAuth app configuration. --device skips the refresh and
+prints a device code instead, to be entered on any device with a browser.
+Signing in to a different account gives the source its own credentials when
+they are shared with other sources, which keep the previous account.
+
+Examples:
+  sercha auth rotate <source-id>
+  sercha auth rotate <source-id> --browser   # Skip refresh, re-authorize
+  sercha auth rotate <source-id> --device    # Skip refresh, re-authorize without a local browser`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeSourceIDs,
+	RunE:              runAuthRotate,
+}
+
+var authCheckCmd = &cobra.Command{
+	Use:   "check [source-id]",
+	Short: "Validate source credentials without syncing",
+	Long: `Validate that sources are still authenticated, without syncing them.
+
+Each source's connector is created and validated against its provider, and
+OAuth tokens expiring within 24 hours are reported as warnings. The command
+exits with a non-zero status if any source fails validation, so it can be
+used in scripts and CI before starting a long sync.
+
+Examples:
+  sercha auth check               # Check all sources
+  sercha auth check <source-id>   # Check a single source`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeSourceIDs,
+	SilenceUsage:      true,
+	RunE:              runAuthCheck,
+}
+
+var authWhoamiCmd = &cobra.Command{
+	Use:   "whoami [source-id]",
+	Short: "Show the account each source is authenticated as",
+	Long: `Show the provider account (email or username) each source authenticates as.
+
+The account recorded when the source was authenticated is shown. Use --refresh
+to fetch it from the provider again with the source's stored token; a changed
+account is saved to the credentials store.
+
+Examples:
+  sercha auth whoami                        # Show all sources
+  sercha auth whoami <source-id>            # Show a single source
+  sercha auth whoami <source-id> --refresh  # Ask the provider`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeSourceIDs,
+	SilenceUsage:      true,
+	RunE:              runAuthWhoami,
+}
+
 // Flags for auth add.
 var (
 	authAddName         string
@@ -88,6 +147,15 @@ var (
 	authAddScopes       string
 )
 
+// Flags for auth rotate.
+var (
+	authRotateBrowser bool
+	authRotateDevice  bool
+)
+
+// Flags for auth whoami.
+var authWhoamiRefresh bool
+
 func init() {
 	// Auth add flags
 	authAddCmd.Flags().StringVar(
@@ -101,10 +169,23 @@ func init() {
 	authAddCmd.Flags().StringVar(
 		&authAddScopes, "scopes", "", "OAuth scopes (comma-separated, uses defaults if not provided)")
 
+	// Auth rotate flags
+	authRotateCmd.Flags().BoolVar(
+		&authRotateBrowser, "browser", false, "Skip the refresh flow and re-authorize in the browser")
+	authRotateCmd.Flags().BoolVar(
+		&authRotateDevice, "device", false, "Re-authorize with a device code instead of a browser")
+
+	// Auth whoami flags
+	authWhoamiCmd.Flags().BoolVar(
+		&authWhoamiRefresh, "refresh", false, "Fetch the account from the provider instead of the stored value")
+
 	// Add subcommands
 	authCmd.AddCommand(authAddCmd)
 	authCmd.AddCommand(authListCmd)
 	authCmd.AddCommand(authRemoveCmd)
+	authCmd.AddCommand(authRotateCmd)
+	authCmd.AddCommand(authCheckCmd)
+	authCmd.AddCommand(authWhoamiCmd)
 	rootCmd.AddCommand(authCmd)
 }
 
@@ -423,6 +504,314 @@ func runAuthRemove(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+//nolint:gocyclo // sequential validation of source, credentials and OAuth app
+func runAuthRotate(cmd *cobra.Command, args []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+	if credentialsService == nil {
+		return errors.New("credentials service not configured")
+	}
+	if authProviderService == nil {
+		return errors.New("auth provider service not configured")
+	}
+	if connectorRegistry == nil {
+		return errors.New("connector registry not configured")
+	}
+
+	sourceID := args[0]
+	ctx := context.Background()
+
+	source, err := sourceService.Get(ctx, sourceID)
+	if err != nil {
+		return fmt.Errorf("source not found: %w", err)
+	}
+	if source.CredentialsID == "" {
+		return fmt.Errorf("source %s has no credentials", sourceID)
+	}
+
+	creds, err := credentialsService.Get(ctx, source.CredentialsID)
+	if err != nil {
+		return fmt.Errorf("failed to get credentials: %w", err)
+	}
+	if creds.OAuth == nil {
+		return fmt.Errorf("source %s does not use OAuth credentials", sourceID)
+	}
+
+	if source.AuthProviderID == "" {
+		return fmt.Errorf("source %s has no OAuth app configuration", sourceID)
+	}
+	authProvider, err := authProviderService.Get(ctx, source.AuthProviderID)
+	if err != nil {
+		return fmt.Errorf("OAuth app not found: %w", err)
+	}
+	if authProvider.OAuth == nil {
+		return errors.New("auth provider has no OAuth configuration")
+	}
+
+	var tokens *domain.OAuthToken
+	if creds.OAuth.RefreshToken != "" && !authRotateBrowser && !authRotateDevice {
+		cmd.Println("Refreshing OAuth token...")
+		tokens, err = connectorRegistry.RefreshToken(ctx, source.Type, authProvider, creds.OAuth.RefreshToken)
+		if err != nil {
+			cmd.Printf("Token refresh failed: %v\n", err)
+			tokens = nil
+		}
+	}
+
+	if tokens == nil {
+		cmd.Println("\nStarting OAuth authentication...")
+		tokens, err = runOAuthFlow(ctx, cmd, source.Type, authProvider, authRotateDevice)
+		if err != nil {
+			return err
+		}
+
+		// A browser flow may sign in to a different account
+		accountID, err := connectorRegistry.GetUserInfo(ctx, source.Type, tokens.AccessToken)
+		if err != nil {
+			cmd.Printf("Warning: could not fetch account identifier: %v\n", err)
+		}
+		creds, err = credentialsForAccount(ctx, cmd, source, creds, accountID)
+		if err != nil {
+			return err
+		}
+	}
+
+	applyOAuthTokens(creds, tokens)
+
+	if err := credentialsService.Save(ctx, *creds); err != nil {
+		return fmt.Errorf("failed to save credentials: %w", err)
+	}
+
+	source.CredentialsID = creds.ID
+	source.UpdatedAt = time.Now()
+	if err := sourceService.Update(ctx, *source); err != nil {
+		return fmt.Errorf("failed to update source: %w", err)
+	}
+
+	cmd.Printf("Rotated OAuth token for source: %s (%s)\n", source.Name, source.ID)
+	if !tokens.Expiry.IsZero() {
+		cmd.Printf("Token expires: %s\n", tokens.Expiry.Local().Format(time.RFC1123))
+	}
+	return nil
+}
+
+// accountChanged reports whether accountID identifies a different account
+// than the one creds were issued for.
+func accountChanged(creds *domain.Credentials, accountID string) bool {
+	return accountID != "" && creds.AccountIdentifier != "" && accountID != creds.AccountIdentifier
+}
+
+// recordAccount sets the account identifier of creds, warning when it
+// replaces a different account. An empty accountID leaves creds unchanged.
+func recordAccount(cmd *cobra.Command, creds *domain.Credentials, accountID string) {
+	if accountChanged(creds, accountID) {
+		cmd.Printf("Warning: account changed from %s to %s\n", creds.AccountIdentifier, accountID)
+	}
+	if accountID != "" {
+		creds.AccountIdentifier = accountID
+	}
+}
+
+// applyOAuthTokens stores tokens as the OAuth tokens of creds. The stored
+// refresh token and token type are kept when tokens has none, as refresh
+// responses may omit them.
+func applyOAuthTokens(creds *domain.Credentials, tokens *domain.OAuthToken) {
+	if creds.OAuth == nil {
+		creds.OAuth = &domain.OAuthCredentials{}
+	}
+	creds.OAuth.AccessToken = tokens.AccessToken
+	if tokens.RefreshToken != "" {
+		creds.OAuth.RefreshToken = tokens.RefreshToken
+	}
+	if tokens.TokenType != "" {
+		creds.OAuth.TokenType = tokens.TokenType
+	}
+	creds.OAuth.Expiry = tokens.Expiry
+	creds.PAT = nil
+	creds.UpdatedAt = time.Now()
+}
+
+func runAuthCheck(cmd *cobra.Command, args []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+	if sourceHealthService == nil {
+		return errors.New("source health service not configured")
+	}
+
+	ctx := context.Background()
+
+	var sources []domain.Source
+	if len(args) == 1 {
+		source, err := sourceService.Get(ctx, args[0])
+		if err != nil {
+			return fmt.Errorf("source not found: %w", err)
+		}
+		sources = []domain.Source{*source}
+	} else {
+		var err error
+		sources, err = sourceService.List(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list sources: %w", err)
+		}
+	}
+
+	if len(sources) == 0 {
+		cmd.Println("No configured sources.")
+		return nil
+	}
+
+	failed := 0
+	for i := range sources {
+		src := &sources[i]
+		health, err := sourceHealthService.Check(ctx, src.ID)
+		if err != nil {
+			return fmt.Errorf("failed to check %s: %w", src.ID, err)
+		}
+
+		if !printSourceHealth(cmd, src, health) {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d source(s) failed validation", failed, len(sources))
+	}
+	cmd.Printf("\nAll %d source(s) passed validation.\n", len(sources))
+	return nil
+}
+
+// printSourceHealth prints the result of a source check, suggesting
+// re-authentication for failed sources with credentials. Reports whether
+// the source passed.
+func printSourceHealth(cmd *cobra.Command, src *domain.Source, health *domain.SourceHealth) bool {
+	switch health.Status() {
+	case domain.HealthFailed:
+		cmd.Printf("  FAIL  %s (%s): %s\n", src.Name, src.ID, health.Error)
+		if src.CredentialsID != "" {
+			cmd.Printf("        Run 'sercha auth rotate %s' to re-authenticate.\n", src.ID)
+		}
+		return false
+	case domain.HealthWarning:
+		cmd.Printf("  WARN  %s (%s): %s\n", src.Name, src.ID, health.Warning)
+	default:
+		cmd.Printf("  OK    %s (%s)\n", src.Name, src.ID)
+	}
+	return true
+}
+
+func runAuthWhoami(cmd *cobra.Command, args []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+	if credentialsService == nil {
+		return errors.New("credentials service not configured")
+	}
+	if authWhoamiRefresh && connectorRegistry == nil {
+		return errors.New("connector registry not configured")
+	}
+
+	ctx := context.Background()
+
+	var sources []domain.Source
+	if len(args) == 1 {
+		source, err := sourceService.Get(ctx, args[0])
+		if err != nil {
+			return fmt.Errorf("source not found: %w", err)
+		}
+		sources = []domain.Source{*source}
+	} else {
+		var err error
+		sources, err = sourceService.List(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list sources: %w", err)
+		}
+	}
+
+	if len(sources) == 0 {
+		cmd.Println("No configured sources.")
+		return nil
+	}
+
+	// Sources sharing credentials are looked up once per run
+	accounts := make(map[string]string)
+	failed := 0
+	for i := range sources {
+		src := &sources[i]
+		if src.CredentialsID == "" {
+			cmd.Printf("  %s (%s): no credentials\n", src.Name, src.ID)
+			continue
+		}
+
+		account, ok := accounts[src.CredentialsID]
+		if !ok {
+			var err error
+			account, err = lookupAccount(ctx, cmd, src)
+			if err != nil {
+				failed++
+				cmd.Printf("  %s (%s): error: %v\n", src.Name, src.ID, err)
+				continue
+			}
+			accounts[src.CredentialsID] = account
+		}
+
+		if account == "" {
+			account = "unknown"
+		}
+		cmd.Printf("  %s (%s): %s\n", src.Name, src.ID, account)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to look up the account of %d source(s)", failed)
+	}
+	return nil
+}
+
+// lookupAccount returns the account identifier of a source's credentials. With
+// --refresh it is fetched from the provider and saved if it has changed.
+func lookupAccount(ctx context.Context, cmd *cobra.Command, source *domain.Source) (string, error) {
+	creds, err := credentialsService.Get(ctx, source.CredentialsID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get credentials: %w", err)
+	}
+	if !authWhoamiRefresh {
+		return creds.AccountIdentifier, nil
+	}
+
+	var accessToken string
+	switch {
+	case creds.OAuth != nil:
+		if creds.OAuth.IsExpired() {
+			return "", fmt.Errorf("OAuth token expired, run 'sercha auth rotate %s'", source.ID)
+		}
+		accessToken = creds.OAuth.AccessToken
+	case creds.PAT != nil:
+		accessToken = creds.PAT.Token
+	}
+	if accessToken == "" {
+		return "", errors.New("credentials have no token")
+	}
+
+	accountID, err := connectorRegistry.GetUserInfo(ctx, source.Type, accessToken)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch account: %w", err)
+	}
+	if accountID == "" || accountID == creds.AccountIdentifier {
+		return accountID, nil
+	}
+
+	if creds.AccountIdentifier != "" {
+		cmd.Printf("Account for %s changed from %s to %s\n", source.ID, creds.AccountIdentifier, accountID)
+	}
+	creds.AccountIdentifier = accountID
+	creds.UpdatedAt = time.Now()
+	if err := credentialsService.Save(ctx, *creds); err != nil {
+		return "", fmt.Errorf("failed to save credentials: %w", err)
+	}
+	return accountID, nil
+}
+
 // truncate truncates a string to the specified length.
 func truncate(s string, maxLen int) string {
 	if len(s) <= maxLen {
@@ -0,0 +1,610 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockRotateSourceService implements driving.SourceService for auth rotate tests.
+type mockRotateSourceService struct {
+	mockSourceService
+	source  domain.Source
+	others  []domain.Source
+	updated *domain.Source
+}
+
+func (m *mockRotateSourceService) List(_ context.Context) ([]domain.Source, error) {
+	return append([]domain.Source{m.source}, m.others...), nil
+}
+
+func (m *mockRotateSourceService) Get(_ context.Context, id string) (*domain.Source, error) {
+	if id != m.source.ID {
+		return nil, domain.ErrNotFound
+	}
+	src := m.source
+	return &src, nil
+}
+
+func (m *mockRotateSourceService) Update(_ context.Context, source domain.Source) error {
+	m.updated = &source
+	return nil
+}
+
+// mockRotateCredentialsService implements driving.CredentialsService for auth rotate tests.
+type mockRotateCredentialsService struct {
+	mockCredentialsService
+	stored domain.Credentials
+	saved  *domain.Credentials
+}
+
+func (m *mockRotateCredentialsService) Get(_ context.Context, id string) (*domain.Credentials, error) {
+	if id != m.stored.ID {
+		return nil, domain.ErrNotFound
+	}
+	creds := m.stored
+	if creds.OAuth != nil {
+		oauth := *creds.OAuth
+		creds.OAuth = &oauth
+	}
+	return &creds, nil
+}
+
+func (m *mockRotateCredentialsService) Save(_ context.Context, creds domain.Credentials) error {
+	m.saved = &creds
+	return nil
+}
+
+// mockRotateAuthProviderService implements driving.AuthProviderService for auth rotate tests.
+type mockRotateAuthProviderService struct {
+	provider domain.AuthProvider
+}
+
+func (m *mockRotateAuthProviderService) Save(_ context.Context, _ domain.AuthProvider) error {
+	return nil
+}
+
+func (m *mockRotateAuthProviderService) Get(_ context.Context, id string) (*domain.AuthProvider, error) {
+	if id != m.provider.ID {
+		return nil, domain.ErrNotFound
+	}
+	provider := m.provider
+	return &provider, nil
+}
+
+func (m *mockRotateAuthProviderService) List(_ context.Context) ([]domain.AuthProvider, error) {
+	return []domain.AuthProvider{m.provider}, nil
+}
+
+func (m *mockRotateAuthProviderService) ListByProvider(
+	_ context.Context, _ domain.ProviderType,
+) ([]domain.AuthProvider, error) {
+	return []domain.AuthProvider{m.provider}, nil
+}
+
+func (m *mockRotateAuthProviderService) Delete(_ context.Context, _ string) error {
+	return nil
+}
+
+// mockRotateConnectorRegistry implements driving.ConnectorRegistry for auth rotate tests.
+type mockRotateConnectorRegistry struct {
+	mockConnectorRegistry
+	refreshErr     error
+	refreshedWith  string
+	accountID      string
+	refreshedToken *domain.OAuthToken
+}
+
+func (m *mockRotateConnectorRegistry) RefreshToken(
+	_ context.Context, _ string, _ *domain.AuthProvider, refreshToken string,
+) (*domain.OAuthToken, error) {
+	m.refreshedWith = refreshToken
+	if m.refreshErr != nil {
+		return nil, m.refreshErr
+	}
+	return m.refreshedToken, nil
+}
+
+func (m *mockRotateConnectorRegistry) GetUserInfo(_ context.Context, _, _ string) (string, error) {
+	return m.accountID, nil
+}
+
+type rotateFixture struct {
+	sources  *mockRotateSourceService
+	creds    *mockRotateCredentialsService
+	registry *mockRotateConnectorRegistry
+	browser  int
+	device   int
+}
+
+func newRotateFixture() *rotateFixture {
+	return &rotateFixture{
+		sources: &mockRotateSourceService{source: domain.Source{
+			ID:             "src-1",
+			Type:           "google-drive",
+			Name:           "Drive",
+			AuthProviderID: "auth-1",
+			CredentialsID:  "creds-1",
+		}},
+		creds: &mockRotateCredentialsService{stored: domain.Credentials{
+			ID:                "creds-1",
+			SourceID:          "src-1",
+			AccountIdentifier: "user@example.com",
+			OAuth: &domain.OAuthCredentials{
+				AccessToken:  "old-access",
+				RefreshToken: "old-refresh",
+				TokenType:    "Bearer",
+			},
+		}},
+		registry: &mockRotateConnectorRegistry{
+			accountID: "user@example.com",
+			refreshedToken: &domain.OAuthToken{
+				AccessToken: "refreshed-access",
+				Expiry:      time.Now().Add(time.Hour),
+			},
+		},
+	}
+}
+
+func runAuthRotateCmd(t *testing.T, f *rotateFixture, args ...string) (string, error) {
+	t.Helper()
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	oldSources, oldCreds, oldAuth, oldRegistry := sourceService, credentialsService, authProviderService, connectorRegistry
+	oldFlow, oldDeviceFlow := browserOAuthFlow, deviceOAuthFlow
+	sourceService = f.sources
+	credentialsService = f.creds
+	authProviderService = &mockRotateAuthProviderService{provider: domain.AuthProvider{
+		ID:           "auth-1",
+		ProviderType: domain.ProviderGoogle,
+		OAuth:        &domain.OAuthProviderConfig{ClientID: "client"},
+	}}
+	connectorRegistry = f.registry
+	browserOAuthFlow = func(
+		_ context.Context, _ *cobra.Command, _ string, _ *domain.AuthProvider,
+	) (*domain.OAuthToken, error) {
+		f.browser++
+		return &domain.OAuthToken{AccessToken: "browser-access", RefreshToken: "browser-refresh"}, nil
+	}
+	deviceOAuthFlow = func(
+		_ context.Context, _ *cobra.Command, _ string, _ *domain.AuthProvider,
+	) (*domain.OAuthToken, error) {
+		f.device++
+		return &domain.OAuthToken{AccessToken: "device-access", RefreshToken: "device-refresh"}, nil
+	}
+	defer func() {
+		sourceService, credentialsService, authProviderService, connectorRegistry = oldSources, oldCreds, oldAuth, oldRegistry
+		browserOAuthFlow, deviceOAuthFlow = oldFlow, oldDeviceFlow
+	}()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"auth", "rotate"}, args...))
+	defer func() {
+		rootCmd.SetArgs(nil)
+		authRotateBrowser = false
+		authRotateDevice = false
+	}()
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestAuthRotateCmd_UsesRefreshToken(t *testing.T) {
+	f := newRotateFixture()
+
+	out, err := runAuthRotateCmd(t, f, "src-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "old-refresh", f.registry.refreshedWith)
+	assert.Zero(t, f.browser)
+	require.NotNil(t, f.creds.saved)
+	assert.Equal(t, "refreshed-access", f.creds.saved.OAuth.AccessToken)
+	assert.Equal(t, "old-refresh", f.creds.saved.OAuth.RefreshToken, "keeps refresh token when none is returned")
+	assert.False(t, f.creds.saved.UpdatedAt.IsZero())
+	require.NotNil(t, f.sources.updated)
+	assert.False(t, f.sources.updated.UpdatedAt.IsZero())
+	assert.Contains(t, out, "Rotated OAuth token for source: Drive")
+}
+
+func TestAuthRotateCmd_FallsBackToBrowserWhenRefreshFails(t *testing.T) {
+	f := newRotateFixture()
+	f.registry.refreshErr = errors.New("invalid_grant")
+
+	out, err := runAuthRotateCmd(t, f, "src-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, f.browser)
+	assert.Contains(t, out, "Token refresh failed: invalid_grant")
+	require.NotNil(t, f.creds.saved)
+	assert.Equal(t, "browser-access", f.creds.saved.OAuth.AccessToken)
+	assert.Equal(t, "browser-refresh", f.creds.saved.OAuth.RefreshToken)
+	assert.NotNil(t, f.sources.updated)
+}
+
+func TestAuthRotateCmd_BrowserFlagSkipsRefresh(t *testing.T) {
+	f := newRotateFixture()
+	f.registry.accountID = "other@example.com"
+
+	out, err := runAuthRotateCmd(t, f, "src-1", "--browser")
+
+	require.NoError(t, err)
+	assert.Empty(t, f.registry.refreshedWith)
+	assert.Equal(t, 1, f.browser)
+	require.NotNil(t, f.creds.saved)
+	assert.Equal(t, "other@example.com", f.creds.saved.AccountIdentifier)
+	assert.Contains(t, out, "account changed from user@example.com to other@example.com")
+}
+
+func TestAuthRotateCmd_NewAccountOnSharedCredentialsCreatesRow(t *testing.T) {
+	f := newRotateFixture()
+	f.registry.accountID = "other@example.com"
+	f.sources.others = []domain.Source{{ID: "src-2", Name: "Drive clone", CredentialsID: "creds-1"}}
+
+	out, err := runAuthRotateCmd(t, f, "src-1", "--browser")
+
+	require.NoError(t, err)
+	require.NotNil(t, f.creds.saved)
+	assert.NotEqual(t, "creds-1", f.creds.saved.ID, "shared credentials are not overwritten")
+	assert.Equal(t, "src-1", f.creds.saved.SourceID)
+	assert.Equal(t, "other@example.com", f.creds.saved.AccountIdentifier)
+	assert.Equal(t, "browser-access", f.creds.saved.OAuth.AccessToken)
+	require.NotNil(t, f.sources.updated)
+	assert.Equal(t, f.creds.saved.ID, f.sources.updated.CredentialsID)
+	assert.Contains(t, out, "other sources keep using user@example.com")
+}
+
+func TestAuthRotateCmd_DeviceFlagUsesDeviceFlow(t *testing.T) {
+	f := newRotateFixture()
+
+	_, err := runAuthRotateCmd(t, f, "src-1", "--device")
+
+	require.NoError(t, err)
+	assert.Empty(t, f.registry.refreshedWith)
+	assert.Zero(t, f.browser)
+	assert.Equal(t, 1, f.device)
+	require.NotNil(t, f.creds.saved)
+	assert.Equal(t, "device-access", f.creds.saved.OAuth.AccessToken)
+	assert.Equal(t, "device-refresh", f.creds.saved.OAuth.RefreshToken)
+}
+
+func TestRunDeviceOAuthFlow_UnsupportedConnector(t *testing.T) {
+	oldRegistry := connectorRegistry
+	connectorRegistry = &mockConnectorRegistry{}
+	defer func() { connectorRegistry = oldRegistry }()
+
+	_, err := runDeviceOAuthFlow(context.Background(), authRotateCmd, "notion", &domain.AuthProvider{
+		OAuth: &domain.OAuthProviderConfig{ClientID: "client"},
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "connector notion does not support the device code flow")
+}
+
+func TestAuthRotateCmd_NoRefreshTokenUsesBrowser(t *testing.T) {
+	f := newRotateFixture()
+	f.creds.stored.OAuth.RefreshToken = ""
+
+	_, err := runAuthRotateCmd(t, f, "src-1")
+
+	require.NoError(t, err)
+	assert.Empty(t, f.registry.refreshedWith)
+	assert.Equal(t, 1, f.browser)
+}
+
+func TestAuthRotateCmd_SourceWithoutCredentials(t *testing.T) {
+	f := newRotateFixture()
+	f.sources.source.CredentialsID = ""
+
+	_, err := runAuthRotateCmd(t, f, "src-1")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has no credentials")
+	assert.Nil(t, f.creds.saved)
+}
+
+func TestAuthRotateCmd_RejectsNonOAuthCredentials(t *testing.T) {
+	f := newRotateFixture()
+	f.creds.stored.OAuth = nil
+	f.creds.stored.PAT = &domain.PATCredentials{Token: "ghp_xxx"}
+
+	_, err := runAuthRotateCmd(t, f, "src-1")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not use OAuth credentials")
+	assert.Nil(t, f.creds.saved)
+	assert.Nil(t, f.sources.updated)
+}
+
+func TestAuthRotateCmd_SourceNotFound(t *testing.T) {
+	f := newRotateFixture()
+
+	_, err := runAuthRotateCmd(t, f, "missing")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "source not found")
+}
+
+// mockCheckSourceService implements driving.SourceService for auth check tests.
+type mockCheckSourceService struct {
+	mockSourceService
+	sources []domain.Source
+}
+
+func (m *mockCheckSourceService) Get(_ context.Context, id string) (*domain.Source, error) {
+	for i := range m.sources {
+		if m.sources[i].ID == id {
+			return &m.sources[i], nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockCheckSourceService) List(_ context.Context) ([]domain.Source, error) {
+	return m.sources, nil
+}
+
+// mockSourceHealthService implements driving.SourceHealthService for testing.
+type mockSourceHealthService struct {
+	results map[string]domain.SourceHealth
+	checked []string
+}
+
+func (m *mockSourceHealthService) Check(_ context.Context, sourceID string) (*domain.SourceHealth, error) {
+	m.checked = append(m.checked, sourceID)
+	health := m.results[sourceID]
+	health.SourceID = sourceID
+	return &health, nil
+}
+
+func (m *mockSourceHealthService) CheckAll(_ context.Context) ([]domain.SourceHealth, error) {
+	return nil, nil
+}
+
+func (m *mockSourceHealthService) Get(_ context.Context, _ string) (*domain.SourceHealth, error) {
+	return nil, nil
+}
+
+func runAuthCheckCmd(t *testing.T, health *mockSourceHealthService, args ...string) (string, error) {
+	t.Helper()
+	oldSources, oldHealth := sourceService, sourceHealthService
+	sourceService = &mockCheckSourceService{sources: []domain.Source{
+		{ID: "src-1", Type: "filesystem", Name: "Notes"},
+		{ID: "src-2", Type: "gmail", Name: "Mail", CredentialsID: "creds-2"},
+		{ID: "src-3", Type: "google-drive", Name: "Drive", CredentialsID: "creds-3"},
+	}}
+	sourceHealthService = health
+	defer func() { sourceService, sourceHealthService = oldSources, oldHealth }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"auth", "check"}, args...))
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestAuthCheckCmd_AllPass(t *testing.T) {
+	health := &mockSourceHealthService{results: map[string]domain.SourceHealth{
+		"src-3": {Warning: "OAuth token expires in 5h0m0s"},
+	}}
+
+	out, err := runAuthCheckCmd(t, health)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"src-1", "src-2", "src-3"}, health.checked)
+	assert.Contains(t, out, "OK    Notes (src-1)")
+	assert.Contains(t, out, "WARN  Drive (src-3): OAuth token expires in 5h0m0s")
+	assert.Contains(t, out, "All 3 source(s) passed validation.")
+}
+
+func TestAuthCheckCmd_FailureReturnsErrorAndSuggestsRotate(t *testing.T) {
+	health := &mockSourceHealthService{results: map[string]domain.SourceHealth{
+		"src-2": {Error: "401 unauthorized"},
+	}}
+
+	out, err := runAuthCheckCmd(t, health)
+
+	require.Error(t, err)
+	assert.Equal(t, "1 of 3 source(s) failed validation", err.Error())
+	assert.Equal(t, []string{"src-1", "src-2", "src-3"}, health.checked, "checks continue after a failure")
+	assert.Contains(t, out, "FAIL  Mail (src-2): 401 unauthorized")
+	assert.Contains(t, out, "sercha auth rotate src-2")
+	assert.NotContains(t, out, "Usage:")
+}
+
+func TestAuthCheckCmd_SingleSource(t *testing.T) {
+	health := &mockSourceHealthService{}
+
+	out, err := runAuthCheckCmd(t, health, "src-2")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"src-2"}, health.checked)
+	assert.Contains(t, out, "OK    Mail (src-2)")
+}
+
+func TestAuthCheckCmd_UnknownSource(t *testing.T) {
+	health := &mockSourceHealthService{}
+
+	_, err := runAuthCheckCmd(t, health, "missing")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "source not found")
+	assert.Empty(t, health.checked)
+}
+
+func TestAuthCheckCmd_NoHealthService(t *testing.T) {
+	oldSources, oldHealth := sourceService, sourceHealthService
+	sourceService, sourceHealthService = &mockSourceService{}, nil
+	defer func() { sourceService, sourceHealthService = oldSources, oldHealth }()
+
+	err := runAuthCheck(authCheckCmd, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "source health service not configured")
+}
+
+// mockWhoamiCredentialsService implements driving.CredentialsService for auth whoami tests.
+type mockWhoamiCredentialsService struct {
+	mockCredentialsService
+	stored map[string]domain.Credentials
+	saved  []domain.Credentials
+}
+
+func (m *mockWhoamiCredentialsService) Get(_ context.Context, id string) (*domain.Credentials, error) {
+	creds, ok := m.stored[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &creds, nil
+}
+
+func (m *mockWhoamiCredentialsService) Save(_ context.Context, creds domain.Credentials) error {
+	m.saved = append(m.saved, creds)
+	return nil
+}
+
+// mockWhoamiConnectorRegistry implements driving.ConnectorRegistry for auth whoami tests.
+type mockWhoamiConnectorRegistry struct {
+	mockConnectorRegistry
+	accountID string
+	err       error
+	tokens    []string
+}
+
+func (m *mockWhoamiConnectorRegistry) GetUserInfo(_ context.Context, _, accessToken string) (string, error) {
+	m.tokens = append(m.tokens, accessToken)
+	return m.accountID, m.err
+}
+
+func runAuthWhoamiCmd(
+	t *testing.T, creds *mockWhoamiCredentialsService, registry *mockWhoamiConnectorRegistry, args ...string,
+) (string, error) {
+	t.Helper()
+	oldSources, oldCreds, oldRegistry := sourceService, credentialsService, connectorRegistry
+	sourceService = &mockCheckSourceService{sources: []domain.Source{
+		{ID: "src-1", Type: "filesystem", Name: "Notes"},
+		{ID: "src-2", Type: "gmail", Name: "Mail", CredentialsID: "creds-2"},
+		{ID: "src-3", Type: "github", Name: "Code", CredentialsID: "creds-3"},
+	}}
+	credentialsService = creds
+	connectorRegistry = registry
+	defer func() { sourceService, credentialsService, connectorRegistry = oldSources, oldCreds, oldRegistry }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"auth", "whoami"}, args...))
+	defer func() {
+		rootCmd.SetArgs(nil)
+		authWhoamiRefresh = false
+	}()
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func newWhoamiCredentials() *mockWhoamiCredentialsService {
+	return &mockWhoamiCredentialsService{stored: map[string]domain.Credentials{
+		"creds-2": {
+			ID:                "creds-2",
+			AccountIdentifier: "user@example.com",
+			OAuth:             &domain.OAuthCredentials{AccessToken: "oauth-access"},
+		},
+		"creds-3": {
+			ID:  "creds-3",
+			PAT: &domain.PATCredentials{Token: "ghp_token"},
+		},
+	}}
+}
+
+func TestAuthWhoamiCmd_ShowsStoredAccounts(t *testing.T) {
+	registry := &mockWhoamiConnectorRegistry{}
+
+	out, err := runAuthWhoamiCmd(t, newWhoamiCredentials(), registry)
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Notes (src-1): no credentials")
+	assert.Contains(t, out, "Mail (src-2): user@example.com")
+	assert.Contains(t, out, "Code (src-3): unknown")
+	assert.Empty(t, registry.tokens, "stored accounts need no provider call")
+}
+
+func TestAuthWhoamiCmd_RefreshFetchesAndSavesAccount(t *testing.T) {
+	creds := newWhoamiCredentials()
+	registry := &mockWhoamiConnectorRegistry{accountID: "octocat"}
+
+	out, err := runAuthWhoamiCmd(t, creds, registry, "src-3", "--refresh")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ghp_token"}, registry.tokens)
+	assert.Contains(t, out, "Code (src-3): octocat")
+	require.Len(t, creds.saved, 1)
+	assert.Equal(t, "octocat", creds.saved[0].AccountIdentifier)
+}
+
+func TestAuthWhoamiCmd_RefreshReportsChangedAccount(t *testing.T) {
+	creds := newWhoamiCredentials()
+	registry := &mockWhoamiConnectorRegistry{accountID: "other@example.com"}
+
+	out, err := runAuthWhoamiCmd(t, creds, registry, "src-2", "--refresh")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"oauth-access"}, registry.tokens)
+	assert.Contains(t, out, "changed from user@example.com to other@example.com")
+	require.Len(t, creds.saved, 1)
+}
+
+func TestAuthWhoamiCmd_RefreshUnchangedDoesNotSave(t *testing.T) {
+	creds := newWhoamiCredentials()
+	registry := &mockWhoamiConnectorRegistry{accountID: "user@example.com"}
+
+	_, err := runAuthWhoamiCmd(t, creds, registry, "src-2", "--refresh")
+
+	require.NoError(t, err)
+	assert.Empty(t, creds.saved)
+}
+
+func TestAuthWhoamiCmd_RefreshExpiredToken(t *testing.T) {
+	creds := newWhoamiCredentials()
+	expired := creds.stored["creds-2"]
+	expired.OAuth = &domain.OAuthCredentials{AccessToken: "old", Expiry: time.Now().Add(-time.Hour)}
+	creds.stored["creds-2"] = expired
+	registry := &mockWhoamiConnectorRegistry{accountID: "user@example.com"}
+
+	out, err := runAuthWhoamiCmd(t, creds, registry, "--refresh")
+
+	require.Error(t, err)
+	assert.Equal(t, "failed to look up the account of 1 source(s)", err.Error())
+	assert.Contains(t, out, "sercha auth rotate src-2")
+	assert.Contains(t, out, "Code (src-3): user@example.com", "lookup continues after a failure")
+}
+
+func TestAuthWhoamiCmd_RefreshProviderError(t *testing.T) {
+	registry := &mockWhoamiConnectorRegistry{err: errors.New("401 unauthorized")}
+
+	out, err := runAuthWhoamiCmd(t, newWhoamiCredentials(), registry, "src-2", "--refresh")
+
+	require.Error(t, err)
+	assert.Contains(t, out, "Mail (src-2): error: failed to fetch account: 401 unauthorized")
+	assert.NotContains(t, out, "Usage:")
+}
+
+func TestAuthWhoamiCmd_UnknownSource(t *testing.T) {
+	_, err := runAuthWhoamiCmd(t, newWhoamiCredentials(), &mockWhoamiConnectorRegistry{}, "missing")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "source not found")
+}
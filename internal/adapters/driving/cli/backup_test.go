@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockBackupService implements driving.BackupService for testing.
+type mockBackupService struct {
+	path string
+	opts domain.RestoreOptions
+	err  error
+}
+
+func (m *mockBackupService) Backup(_ context.Context, outputPath string) (*domain.BackupManifest, error) {
+	m.path = outputPath
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.BackupManifest{FormatVersion: 1, SchemaVersion: 7, CreatedAt: time.Now()}, nil
+}
+
+func (m *mockBackupService) Restore(
+	_ context.Context, archivePath string, opts domain.RestoreOptions,
+) (*domain.RestoreResult, error) {
+	m.path = archivePath
+	m.opts = opts
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.RestoreResult{
+		Manifest:  domain.BackupManifest{FormatVersion: 2, SchemaVersion: 7, CreatedAt: time.Now(), Config: true},
+		Documents: 3,
+		Chunks:    12,
+	}, nil
+}
+
+func runBackupCmd(t *testing.T, svc *mockBackupService, args ...string) (string, error) {
+	t.Helper()
+	oldBackup := backupService
+	backupService = svc
+	defer func() { backupService = oldBackup }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(args)
+	defer rootCmd.SetArgs(nil)
+	defer func() { restoreForce = false }()
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestBackupCmd(t *testing.T) {
+	svc := &mockBackupService{}
+
+	out, err := runBackupCmd(t, svc, "backup", "out.tar.gz")
+
+	require.NoError(t, err)
+	assert.Equal(t, "out.tar.gz", svc.path)
+	assert.Contains(t, out, "Backup written to out.tar.gz")
+	assert.Contains(t, out, "Schema version: 7")
+}
+
+func TestBackupCmd_Error(t *testing.T) {
+	_, err := runBackupCmd(t, &mockBackupService{err: fmt.Errorf("disk full")}, "backup", "out.tar.gz")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "backup failed")
+}
+
+func TestRestoreCmd(t *testing.T) {
+	svc := &mockBackupService{}
+
+	out, err := runBackupCmd(t, svc, "restore", "in.tar.gz")
+
+	require.NoError(t, err)
+	assert.Equal(t, "in.tar.gz", svc.path)
+	assert.Contains(t, out, "Restored backup from in.tar.gz")
+	assert.Contains(t, out, "3 documents (12 chunks)")
+	assert.Contains(t, out, "Config:         restored")
+	assert.False(t, svc.opts.Force)
+}
+
+func TestRestoreCmd_Force(t *testing.T) {
+	svc := &mockBackupService{}
+
+	_, err := runBackupCmd(t, svc, "restore", "--force", "in.tar.gz")
+
+	require.NoError(t, err)
+	assert.True(t, svc.opts.Force)
+}
+
+func TestRestoreCmd_Outdated(t *testing.T) {
+	svc := &mockBackupService{err: fmt.Errorf("%w: live data last synced today", domain.ErrBackupOutdated)}
+
+	_, err := runBackupCmd(t, svc, "restore", "in.tar.gz")
+
+	require.ErrorIs(t, err, domain.ErrBackupOutdated)
+	assert.Contains(t, err.Error(), "use --force")
+}
+
+func TestRestoreCmd_InvalidBackup(t *testing.T) {
+	svc := &mockBackupService{err: fmt.Errorf("%w: not a database", domain.ErrInvalidBackup)}
+
+	_, err := runBackupCmd(t, svc, "restore", "in.tar.gz")
+
+	require.ErrorIs(t, err, domain.ErrInvalidBackup)
+}
+
+func TestBackupCmd_NoService(t *testing.T) {
+	oldBackup := backupService
+	backupService = nil
+	defer func() { backupService = oldBackup }()
+
+	err := runBackup(backupCmd, []string{"out.tar.gz"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "backup service not configured")
+
+	err = runRestore(restoreCmd, []string{"in.tar.gz"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "backup service not configured")
+}
@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// completionInstall writes the script to the shell's completion directory.
+var completionInstall bool
+
+// completionShells lists the shells completion scripts can be generated for.
+var completionShells = []string{"bash", "zsh", "fish"}
+
+var completionCmd = &cobra.Command{
+	Use:   "completion [bash|zsh|fish]",
+	Short: "Generate shell completion scripts",
+	Long: `Generate a tab completion script for sercha commands, flags, source IDs
+and connector types.
+
+The script is written to stdout. To load completions in the current shell:
+
+  bash:  source <(sercha completion bash)
+  zsh:   source <(sercha completion zsh)
+  fish:  sercha completion fish | source
+
+With --install, the script is written to the shell's completion directory
+instead, so it loads in every new shell:
+
+  bash:  $XDG_DATA_HOME/bash-completion/completions/sercha (needs bash-completion)
+  zsh:   ~/.zsh/completions/_sercha (the directory must be in your fpath)
+  fish:  $XDG_CONFIG_HOME/fish/completions/sercha.fish`,
+	Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	ValidArgs:             completionShells,
+	DisableFlagsInUseLine: true,
+	RunE:                  runCompletion,
+}
+
+func init() {
+	completionCmd.Flags().BoolVar(&completionInstall, "install", false,
+		"Write the script to the shell's completion directory instead of stdout")
+	rootCmd.AddCommand(completionCmd)
+}
+
+func runCompletion(cmd *cobra.Command, args []string) error {
+	shell := args[0]
+
+	if !completionInstall {
+		return generateCompletion(cmd.Root(), shell, cmd.OutOrStdout())
+	}
+
+	path, err := completionInstallPath(shell)
+	if err != nil {
+		return err
+	}
+
+	var script bytes.Buffer
+	if err := generateCompletion(cmd.Root(), shell, &script); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create completion directory: %w", err)
+	}
+	if err := os.WriteFile(path, script.Bytes(), 0o644); err != nil { //nolint:gosec // G306: completion scripts are not secret
+		return fmt.Errorf("write completion script: %w", err)
+	}
+
+	cmd.Printf("Installed %s completions to %s\n", shell, path)
+	switch shell {
+	case "bash":
+		cmd.Println("Completions load in new shells when bash-completion is installed.")
+	case "zsh":
+		cmd.Printf("Ensure %s is in your fpath, e.g. add to ~/.zshrc before compinit:\n", filepath.Dir(path))
+		cmd.Printf("  fpath=(%s $fpath)\n", filepath.Dir(path))
+	case "fish":
+		cmd.Println("Completions load in new fish shells.")
+	}
+	return nil
+}
+
+// generateCompletion writes the completion script for shell to w.
+func generateCompletion(root *cobra.Command, shell string, w io.Writer) error {
+	switch shell {
+	case "bash":
+		return root.GenBashCompletionV2(w, true)
+	case "zsh":
+		return root.GenZshCompletion(w)
+	case "fish":
+		return root.GenFishCompletion(w, true)
+	default:
+		return fmt.Errorf("unsupported shell %q (supported: %s)", shell, strings.Join(completionShells, ", "))
+	}
+}
+
+// completionInstallPath returns where the completion script for shell is installed.
+func completionInstallPath(shell string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("find home directory: %w", err)
+	}
+
+	switch shell {
+	case "bash":
+		dataHome := os.Getenv("XDG_DATA_HOME")
+		if dataHome == "" {
+			dataHome = filepath.Join(home, ".local", "share")
+		}
+		return filepath.Join(dataHome, "bash-completion", "completions", "sercha"), nil
+	case "zsh":
+		return filepath.Join(home, ".zsh", "completions", "_sercha"), nil
+	case "fish":
+		configHome := os.Getenv("XDG_CONFIG_HOME")
+		if configHome == "" {
+			configHome = filepath.Join(home, ".config")
+		}
+		return filepath.Join(configHome, "fish", "completions", "sercha.fish"), nil
+	default:
+		return "", fmt.Errorf("unsupported shell %q (supported: %s)", shell, strings.Join(completionShells, ", "))
+	}
+}
+
+// completeConnectorTypes completes the first argument with the available
+// connector types, described by their names.
+func completeConnectorTypes(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 || connectorRegistry == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	connectors := connectorRegistry.List()
+	completions := make([]string, 0, len(connectors))
+	for _, c := range connectors {
+		completions = append(completions, fmt.Sprintf("%s\t%s", c.ID, c.Name))
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// authMethodCompletions are the values of --auth-method.
+var authMethodCompletions = []string{
+	"token\tPersonal access token",
+	"oauth\tOAuth authorization",
+}
+
+// completeSourceIDs completes the first argument with configured source IDs,
+// described by their names.
+func completeSourceIDs(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 || sourceService == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	sources, err := sourceService.List(context.Background())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	completions := make([]string, 0, len(sources))
+	for _, src := range sources {
+		completions = append(completions, fmt.Sprintf("%s\t%s (%s)", src.ID, src.Name, src.Type))
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
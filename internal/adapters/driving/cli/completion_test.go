@@ -0,0 +1,180 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// executeCompletion runs the root command with args and returns its output.
+func executeCompletion(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(args)
+	defer func() {
+		rootCmd.SetArgs(nil)
+		completionInstall = false
+	}()
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+// checkSyntax parses script with the shell's no-exec mode, skipping if the
+// shell is not installed.
+func checkSyntax(t *testing.T, shell, script string) {
+	t.Helper()
+	path, err := exec.LookPath(shell)
+	if err != nil {
+		t.Skipf("%s not installed", shell)
+	}
+
+	file := filepath.Join(t.TempDir(), "sercha."+shell)
+	require.NoError(t, os.WriteFile(file, []byte(script), 0o600))
+
+	out, err := exec.Command(path, "-n", file).CombinedOutput()
+	assert.NoError(t, err, "%s reported a syntax error: %s", shell, out)
+}
+
+func TestCompletionCmd_GeneratesScripts(t *testing.T) {
+	tests := []struct {
+		shell  string
+		marker string
+	}{
+		{"bash", "__start_sercha"},
+		{"zsh", "#compdef sercha"},
+		{"fish", "complete -c sercha"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.shell, func(t *testing.T) {
+			out, err := executeCompletion(t, "completion", tt.shell)
+
+			require.NoError(t, err)
+			assert.NotEmpty(t, out)
+			assert.Contains(t, out, tt.marker)
+			checkSyntax(t, tt.shell, out)
+		})
+	}
+}
+
+func TestCompletionCmd_RejectsUnknownShell(t *testing.T) {
+	_, err := executeCompletion(t, "completion", "tcsh")
+	assert.Error(t, err)
+}
+
+func TestCompletionCmd_Install(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_DATA_HOME", "")
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "config"))
+
+	tests := []struct {
+		shell string
+		path  string
+	}{
+		{"bash", filepath.Join(home, ".local", "share", "bash-completion", "completions", "sercha")},
+		{"zsh", filepath.Join(home, ".zsh", "completions", "_sercha")},
+		{"fish", filepath.Join(home, "config", "fish", "completions", "sercha.fish")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.shell, func(t *testing.T) {
+			out, err := executeCompletion(t, "completion", tt.shell, "--install")
+
+			require.NoError(t, err)
+			assert.Contains(t, out, tt.path)
+			script, err := os.ReadFile(tt.path)
+			require.NoError(t, err)
+			assert.NotEmpty(t, script)
+		})
+	}
+}
+
+func TestCompletion_SourceIDs(t *testing.T) {
+	original := sourceService
+	sourceService = &mockSourceService{}
+	defer func() { sourceService = original }()
+
+	for _, args := range [][]string{
+		{"__complete", "source", "remove", ""},
+		{"__complete", "sync", ""},
+	} {
+		out, err := executeCompletion(t, args...)
+
+		require.NoError(t, err)
+		assert.Contains(t, out, "src-1\t~/Documents (filesystem)")
+	}
+}
+
+func TestCompletion_SourceIDs_OnlyFirstArg(t *testing.T) {
+	original := sourceService
+	sourceService = &mockSourceService{}
+	defer func() { sourceService = original }()
+
+	completions, _ := completeSourceIDs(sourceRemoveCmd, []string{"src-1"}, "")
+
+	assert.Empty(t, completions)
+}
+
+func TestCompletion_SourceIDs_OtherCommands(t *testing.T) {
+	original := sourceService
+	sourceService = &mockSourceService{}
+	defer func() { sourceService = original }()
+
+	for _, args := range [][]string{
+		{"__complete", "source", "schedule", ""},
+		{"__complete", "document", "list", ""},
+		{"__complete", "auth", "check", ""},
+	} {
+		out, err := executeCompletion(t, args...)
+
+		require.NoError(t, err)
+		assert.Contains(t, out, "src-1\t~/Documents (filesystem)", "completing %v", args)
+	}
+}
+
+func TestCompletion_ConnectorTypes(t *testing.T) {
+	original := connectorRegistry
+	connectorRegistry = &mockConnectorRegistry{}
+	defer func() { connectorRegistry = original }()
+
+	completions, directive := completeConnectorTypes(sourceAddCmd, nil, "")
+
+	assert.Equal(t, []string{"filesystem\tLocal Filesystem", "github\tGitHub"}, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+
+	out, err := executeCompletion(t, "__complete", "source", "add", "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "github\tGitHub")
+
+	completions, _ = completeConnectorTypes(sourceAddCmd, []string{"github"}, "")
+	assert.Empty(t, completions, "only the first argument is a connector type")
+}
+
+func TestCompletion_ConnectorTypes_NoRegistry(t *testing.T) {
+	original := connectorRegistry
+	connectorRegistry = nil
+	defer func() { connectorRegistry = original }()
+
+	completions, directive := completeConnectorTypes(sourceAddCmd, nil, "")
+
+	assert.Empty(t, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+}
+
+func TestCompletion_AuthMethodFlag(t *testing.T) {
+	out, err := executeCompletion(t, "__complete", "source", "add", "github", "--auth-method", "")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "token\tPersonal access token")
+	assert.Contains(t, out, "oauth\tOAuth authorization")
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var credentialsCmd = &cobra.Command{
+	Use:   "credentials",
+	Short: "Manage stored source credentials",
+}
+
+var credentialsMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Encrypt existing credentials with the OS keychain or a passphrase",
+	Long: `Re-save the credentials of every source so their tokens are encrypted
+with a key held in the OS keychain (Keychain on macOS, libsecret on Linux,
+Credential Manager on Windows), or derived from the passphrase in
+SERCHA_CREDENTIALS_PASSPHRASE.
+
+Must be run with --keychain or with SERCHA_CREDENTIALS_PASSPHRASE set.
+Credentials that are already encrypted are left unchanged. Credentials are
+also encrypted the first time they are used while encryption is enabled.
+
+Examples:
+  sercha credentials migrate --keychain
+  SERCHA_CREDENTIALS_PASSPHRASE=... sercha credentials migrate`,
+	RunE: runCredentialsMigrate,
+}
+
+func init() {
+	credentialsCmd.AddCommand(credentialsMigrateCmd)
+	rootCmd.AddCommand(credentialsCmd)
+}
+
+func runCredentialsMigrate(cmd *cobra.Command, _ []string) error {
+	if !useKeychain && os.Getenv(passphraseEnv) == "" {
+		return fmt.Errorf("credentials migrate requires --keychain or %s", passphraseEnv)
+	}
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+	if credentialsService == nil {
+		return errors.New("credentials service not configured")
+	}
+
+	ctx := context.Background()
+	sources, err := sourceService.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list sources: %w", err)
+	}
+
+	migrated := 0
+	for i := range sources {
+		creds, err := credentialsService.GetBySourceID(ctx, sources[i].ID)
+		if err != nil {
+			return fmt.Errorf("failed to get credentials for %s: %w", sources[i].ID, err)
+		}
+		if creds == nil {
+			continue
+		}
+		if err := credentialsService.Save(ctx, *creds); err != nil {
+			return fmt.Errorf("failed to save credentials for %s: %w", sources[i].ID, err)
+		}
+		migrated++
+	}
+
+	cmd.Printf("Migrated credentials for %d source(s).\n", migrated)
+	return nil
+}
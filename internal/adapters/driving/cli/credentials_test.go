@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockCredentialsService implements driving.CredentialsService for testing.
+type mockCredentialsService struct {
+	creds map[string]domain.Credentials
+	saved []string
+}
+
+func (m *mockCredentialsService) Save(_ context.Context, creds domain.Credentials) error {
+	m.saved = append(m.saved, creds.ID)
+	return nil
+}
+
+func (m *mockCredentialsService) Get(_ context.Context, id string) (*domain.Credentials, error) {
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockCredentialsService) GetBySourceID(_ context.Context, sourceID string) (*domain.Credentials, error) {
+	creds, ok := m.creds[sourceID]
+	if !ok {
+		return nil, nil
+	}
+	return &creds, nil
+}
+
+func (m *mockCredentialsService) GetAllByAuthProviderID(_ context.Context, _ string) ([]domain.Credentials, error) {
+	return nil, nil
+}
+
+func (m *mockCredentialsService) Delete(_ context.Context, _ string) error {
+	return nil
+}
+
+// mockKeychain implements KeychainEncryption for testing.
+type mockKeychain struct {
+	enabled    bool
+	passphrase string
+}
+
+func (m *mockKeychain) SetEncryption(enabled bool) {
+	m.enabled = enabled
+}
+
+func (m *mockKeychain) SetPassphrase(passphrase string) {
+	m.passphrase = passphrase
+}
+
+func runCredentialsMigrateCmd(t *testing.T, args ...string) (string, *mockCredentialsService, *mockKeychain, error) {
+	t.Helper()
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	creds := &mockCredentialsService{creds: map[string]domain.Credentials{
+		"src-1": {ID: "creds-1", SourceID: "src-1"},
+	}}
+	kc := &mockKeychain{}
+	oldCreds, oldKeychain := credentialsService, keychain
+	credentialsService, keychain = creds, kc
+	defer func() { credentialsService, keychain = oldCreds, oldKeychain }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"credentials", "migrate"}, args...))
+	defer func() {
+		rootCmd.SetArgs(nil)
+		useKeychain = false
+	}()
+
+	err := rootCmd.Execute()
+	return buf.String(), creds, kc, err
+}
+
+func TestCredentialsMigrateCmd_RequiresKeychainFlag(t *testing.T) {
+	_, creds, _, err := runCredentialsMigrateCmd(t)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires --keychain")
+	assert.Empty(t, creds.saved)
+}
+
+func TestCredentialsMigrateCmd_ResavesCredentials(t *testing.T) {
+	out, creds, kc, err := runCredentialsMigrateCmd(t, "--keychain")
+
+	require.NoError(t, err)
+	assert.True(t, kc.enabled)
+	assert.Equal(t, []string{"creds-1"}, creds.saved)
+	assert.Contains(t, out, "Migrated credentials for 1 source(s).")
+}
+
+func TestCredentialsMigrateCmd_Passphrase(t *testing.T) {
+	t.Setenv(passphraseEnv, "correct horse")
+
+	_, creds, kc, err := runCredentialsMigrateCmd(t)
+
+	require.NoError(t, err)
+	assert.Equal(t, "correct horse", kc.passphrase)
+	assert.Equal(t, []string{"creds-1"}, creds.saved)
+}
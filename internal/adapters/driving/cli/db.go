@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Maintain the local database and indexes",
+	Long: `Maintain sercha's local data: the metadata database and the search and
+vector indexes.
+
+Examples:
+  sercha db vacuum`,
+}
+
+var dbVacuumCmd = &cobra.Command{
+	Use:   "vacuum",
+	Short: "Reclaim disk space used by the database and indexes",
+	Long: `Reclaim disk space left behind by deleted and updated documents.
+
+The metadata database's write-ahead log is checkpointed and the database is
+rebuilt with VACUUM, which holds an exclusive lock while it runs; stop any
+running sync or TUI session first. The search index is compacted, and vectors
+whose chunks no longer exist in the document store are removed from the
+vector index. Disk usage is reported before and after.
+
+Examples:
+  sercha db vacuum`,
+	Args: cobra.NoArgs,
+	RunE: runDBVacuum,
+}
+
+func init() {
+	dbCmd.AddCommand(dbVacuumCmd)
+	rootCmd.AddCommand(dbCmd)
+}
+
+func runDBVacuum(cmd *cobra.Command, _ []string) error {
+	if maintenanceService == nil {
+		return errors.New("maintenance service not configured")
+	}
+
+	ctx := context.Background()
+	result, err := maintenanceService.Vacuum(ctx)
+	if err != nil {
+		return fmt.Errorf("vacuum failed: %w", err)
+	}
+
+	if result.OrphanedVectors > 0 {
+		cmd.Printf("Removed %d orphaned vectors\n", result.OrphanedVectors)
+	}
+	if !result.SearchIndexCompacted {
+		cmd.Println("Search index compaction is not supported in this build")
+	}
+	printDiskUsage(cmd, result.Before, result.After)
+	return nil
+}
+
+// printDiskUsage prints a before/after table of disk usage per store.
+func printDiskUsage(cmd *cobra.Command, before, after domain.DiskUsage) {
+	cmd.Printf("%-14s %12s %12s\n", "", "Before", "After")
+	rows := []struct {
+		name          string
+		before, after int64
+	}{
+		{"Database", before.Database, after.Database},
+		{"Search index", before.SearchIndex, after.SearchIndex},
+		{"Vector index", before.VectorIndex, after.VectorIndex},
+		{"Total", before.Total(), after.Total()},
+	}
+	for _, row := range rows {
+		cmd.Printf("%-14s %12s %12s\n", row.name, formatByteSize(row.before), formatByteSize(row.after))
+	}
+
+	if saved := before.Total() - after.Total(); saved > 0 {
+		cmd.Printf("Reclaimed %s\n", formatByteSize(saved))
+	}
+}
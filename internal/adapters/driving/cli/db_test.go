@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockMaintenanceService implements driving.MaintenanceService for testing.
+type mockMaintenanceService struct {
+	result   *domain.VacuumResult
+	optimize *domain.OptimizeResult
+	compact  *domain.CompactResult
+	err      error
+}
+
+func (m *mockMaintenanceService) Vacuum(_ context.Context) (*domain.VacuumResult, error) {
+	return m.result, m.err
+}
+
+func (m *mockMaintenanceService) Optimize(_ context.Context) (*domain.OptimizeResult, error) {
+	return m.optimize, m.err
+}
+
+func (m *mockMaintenanceService) Compact(_ context.Context) (*domain.CompactResult, error) {
+	return m.compact, m.err
+}
+
+func runDBCmd(t *testing.T, svc *mockMaintenanceService, args ...string) (string, error) {
+	t.Helper()
+	oldMaintenance := maintenanceService
+	maintenanceService = svc
+	defer func() { maintenanceService = oldMaintenance }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(args)
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestDBVacuumCmd(t *testing.T) {
+	svc := &mockMaintenanceService{result: &domain.VacuumResult{
+		Before:               domain.DiskUsage{Database: 4096, SearchIndex: 2048, VectorIndex: 1024},
+		After:                domain.DiskUsage{Database: 2048, SearchIndex: 1024, VectorIndex: 1024},
+		SearchIndexCompacted: true,
+		OrphanedVectors:      3,
+	}}
+
+	out, err := runDBCmd(t, svc, "db", "vacuum")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Removed 3 orphaned vectors")
+	assert.Regexp(t, `Database\s+4\.0 KiB\s+2\.0 KiB`, out)
+	assert.Regexp(t, `Total\s+7\.0 KiB\s+4\.0 KiB`, out)
+	assert.Contains(t, out, "Reclaimed 3.0 KiB")
+	assert.NotContains(t, out, "not supported")
+}
+
+func TestDBVacuumCmd_CompactionUnsupported(t *testing.T) {
+	svc := &mockMaintenanceService{result: &domain.VacuumResult{}}
+
+	out, err := runDBCmd(t, svc, "db", "vacuum")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Search index compaction is not supported")
+	assert.NotContains(t, out, "Reclaimed")
+}
+
+func TestDBVacuumCmd_Error(t *testing.T) {
+	_, err := runDBCmd(t, &mockMaintenanceService{err: errors.New("database is in use")}, "db", "vacuum")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "vacuum failed")
+}
+
+func TestDBVacuumCmd_NotConfigured(t *testing.T) {
+	oldMaintenance := maintenanceService
+	maintenanceService = nil
+	defer func() { maintenanceService = oldMaintenance }()
+
+	err := runDBVacuum(dbVacuumCmd, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "maintenance service not configured")
+}
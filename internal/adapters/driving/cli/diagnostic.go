@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// diagnosticJSON is the --json flag for diagnostic.
+var diagnosticJSON bool
+
+var diagnosticCmd = &cobra.Command{
+	Use:   "diagnostic",
+	Short: "Print system information for bug reports",
+	Long: `Print the sercha version, platform, library versions, index sizes and AI
+configuration, formatted as a block that can be pasted into a GitHub issue.
+
+No credentials are printed, and the database and index locations are
+shown by name only, without the directories they are in.
+
+Examples:
+  sercha diagnostic          Print a Markdown block for an issue
+  sercha diagnostic --json   Print machine-readable JSON`,
+	Args: cobra.NoArgs,
+	RunE: runDiagnostic,
+}
+
+func init() {
+	diagnosticCmd.Flags().BoolVar(&diagnosticJSON, "json", false, "output diagnostics as JSON")
+	rootCmd.AddCommand(diagnosticCmd)
+}
+
+func runDiagnostic(cmd *cobra.Command, _ []string) error {
+	if diagnosticService == nil {
+		return errors.New("diagnostic service not configured")
+	}
+
+	diag, err := diagnosticService.Collect(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to collect diagnostics: %w", err)
+	}
+	diag.Version = version
+
+	if diagnosticJSON {
+		data, err := json.MarshalIndent(diag, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal diagnostics: %w", err)
+		}
+		cmd.Println(string(data))
+		return nil
+	}
+
+	cmd.Println("```text")
+	rows := []struct{ label, value string }{
+		{"sercha version", diag.Version},
+		{"OS/arch", diag.OS + "/" + diag.Arch},
+		{"Go version", diag.GoVersion},
+		{"SQLite version", orUnknown(diag.SQLiteVersion)},
+		{"Xapian version", orUnknown(diag.XapianVersion)},
+		{"Sources", formatSourceCounts(diag.SourcesByType)},
+		{"Documents", fmt.Sprintf("%d", diag.Documents)},
+		{"Database", orUnknown(diag.Database)},
+		{"Search index", formatIndex(diag.SearchIndex, diag.SearchIndexBytes)},
+		{"Vector index", formatIndex(diag.VectorIndex, diag.VectorIndexBytes)},
+		{"AI mode", diag.AIMode},
+		{"Search mode", orUnknown(string(diag.SearchMode))},
+		{"Embedding model", orNone(diag.EmbeddingModel)},
+	}
+	for _, row := range rows {
+		cmd.Printf("%-16s %s\n", row.label+":", row.value)
+	}
+	cmd.Println("```")
+	return nil
+}
+
+// formatSourceCounts formats source counts per connector type, e.g.
+// "3 (filesystem: 2, github: 1)".
+func formatSourceCounts(byType map[string]int) string {
+	if len(byType) == 0 {
+		return "0"
+	}
+	types := make([]string, 0, len(byType))
+	total := 0
+	for connectorType, count := range byType {
+		types = append(types, connectorType)
+		total += count
+	}
+	sort.Strings(types)
+
+	parts := make([]string, len(types))
+	for i, connectorType := range types {
+		parts[i] = fmt.Sprintf("%s: %d", connectorType, byType[connectorType])
+	}
+	return fmt.Sprintf("%d (%s)", total, strings.Join(parts, ", "))
+}
+
+// formatIndex formats an index's name and size on disk.
+func formatIndex(name string, size int64) string {
+	if name == "" {
+		return formatByteSize(size)
+	}
+	return fmt.Sprintf("%s (%s)", name, formatByteSize(size))
+}
+
+// orUnknown returns s, or "unknown" if s is empty.
+func orUnknown(s string) string {
+	if s == "" {
+		return "unknown"
+	}
+	return s
+}
+
+// orNone returns s, or "none" if s is empty.
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockDiagnosticService implements driving.DiagnosticService for testing.
+type mockDiagnosticService struct {
+	diag domain.Diagnostics
+}
+
+func (m *mockDiagnosticService) Collect(_ context.Context) (*domain.Diagnostics, error) {
+	diag := m.diag
+	return &diag, nil
+}
+
+func runDiagnosticCmd(t *testing.T, service *mockDiagnosticService, args ...string) (string, error) {
+	t.Helper()
+	oldService, oldVersion := diagnosticService, version
+	diagnosticService, version = service, "1.2.3"
+	defer func() { diagnosticService, version = oldService, oldVersion }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"diagnostic"}, args...))
+	defer func() {
+		rootCmd.SetArgs(nil)
+		diagnosticJSON = false
+	}()
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func newMockDiagnostics() *mockDiagnosticService {
+	return &mockDiagnosticService{diag: domain.Diagnostics{
+		OS:               "linux",
+		Arch:             "amd64",
+		GoVersion:        "go1.24.0",
+		SQLiteVersion:    "3.45.1",
+		SourcesByType:    map[string]int{"github": 1, "filesystem": 2},
+		Documents:        42,
+		Database:         "metadata.db",
+		SearchIndex:      "xapian",
+		SearchIndexBytes: 2048,
+		VectorIndex:      "vectors",
+		AIMode:           domain.AIModeTextOnly,
+		SearchMode:       domain.SearchModeTextOnly,
+	}}
+}
+
+func TestDiagnosticCmd_PrintsIssueBlock(t *testing.T) {
+	out, err := runDiagnosticCmd(t, newMockDiagnostics())
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "```text\n")
+	assert.Contains(t, out, "sercha version:  1.2.3")
+	assert.Contains(t, out, "OS/arch:         linux/amd64")
+	assert.Contains(t, out, "SQLite version:  3.45.1")
+	assert.Contains(t, out, "Xapian version:  unknown")
+	assert.Contains(t, out, "Sources:         3 (filesystem: 2, github: 1)")
+	assert.Contains(t, out, "Documents:       42")
+	assert.Contains(t, out, "Search index:    xapian (2.0 KiB)")
+	assert.Contains(t, out, "Vector index:    vectors (0 B)")
+	assert.Contains(t, out, "AI mode:         text-only")
+	assert.Contains(t, out, "Embedding model: none")
+	assert.Contains(t, out, "```\n")
+}
+
+func TestDiagnosticCmd_JSON(t *testing.T) {
+	out, err := runDiagnosticCmd(t, newMockDiagnostics(), "--json")
+
+	require.NoError(t, err)
+	var diag domain.Diagnostics
+	require.NoError(t, json.Unmarshal([]byte(out), &diag))
+	assert.Equal(t, "1.2.3", diag.Version)
+	assert.Equal(t, 42, diag.Documents)
+	assert.Equal(t, 2, diag.SourcesByType["filesystem"])
+	assert.Equal(t, domain.AIModeTextOnly, diag.AIMode)
+}
+
+func TestDiagnosticCmd_ServiceNotConfigured(t *testing.T) {
+	oldService := diagnosticService
+	diagnosticService = nil
+	defer func() { diagnosticService = oldService }()
+
+	err := runDiagnostic(diagnosticCmd, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "diagnostic service not configured")
+}
+
+func TestFormatSourceCounts(t *testing.T) {
+	assert.Equal(t, "0", formatSourceCounts(nil))
+	assert.Equal(t, "1 (notion: 1)", formatSourceCounts(map[string]int{"notion": 1}))
+}
@@ -6,19 +6,27 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
 var documentCmd = &cobra.Command{
 	Use:   "document",
 	Short: "Manage indexed documents",
-	Long:  `List, view, exclude, or refresh indexed documents.`,
+	Long:  `List, view, export, exclude, or refresh indexed documents.`,
 }
 
 var documentListCmd = &cobra.Command{
 	Use:   "list [source-id]",
 	Short: "List documents for a source",
-	Args:  cobra.ExactArgs(1),
-	RunE:  runDocumentList,
+	Long: `List the documents indexed for a source.
+
+With --resolve-web-urls, each document's web URL is resolved through its
+connector and shown alongside the URI. Connectors that cannot resolve web
+URLs list their documents without one.`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeSourceIDs,
+	RunE:              runDocumentList,
 }
 
 var documentGetCmd = &cobra.Command{
@@ -67,7 +75,12 @@ var documentOpenCmd = &cobra.Command{
 // excludeReason is a flag for the exclude command.
 var excludeReason string
 
+// documentListResolveWebURLs is the --resolve-web-urls flag for document list.
+var documentListResolveWebURLs bool
+
 func init() {
+	documentListCmd.Flags().BoolVar(&documentListResolveWebURLs, "resolve-web-urls", false,
+		"Resolve and show each document's web URL via its connector")
 	documentExcludeCmd.Flags().StringVarP(&excludeReason, "reason", "r", "", "Reason for excluding the document")
 
 	documentCmd.AddCommand(documentListCmd)
@@ -98,6 +111,11 @@ func runDocumentList(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	var resolver domain.WebURLResolver
+	if documentListResolveWebURLs {
+		resolver = sourceWebURLResolver(ctx, sourceID)
+	}
+
 	cmd.Printf("Documents for source %s:\n\n", sourceID)
 	for i := range docs {
 		cmd.Printf("  %s\n", docs[i].ID)
@@ -105,6 +123,11 @@ func runDocumentList(cmd *cobra.Command, args []string) error {
 		if docs[i].URI != "" {
 			cmd.Printf("    URI: %s\n", docs[i].URI)
 		}
+		if resolver != nil {
+			if webURL := resolver(docs[i].URI, docs[i].Metadata); webURL != "" {
+				cmd.Printf("    Web URL: %s\n", webURL)
+			}
+		}
 		cmd.Println()
 	}
 
@@ -112,6 +135,23 @@ func runDocumentList(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// sourceWebURLResolver returns the web URL resolver of a source's connector,
+// or nil if the source's connector has none.
+func sourceWebURLResolver(ctx context.Context, sourceID string) domain.WebURLResolver {
+	if sourceService == nil || connectorRegistry == nil {
+		return nil
+	}
+	source, err := sourceService.Get(ctx, sourceID)
+	if err != nil || source == nil {
+		return nil
+	}
+	connector, err := connectorRegistry.Get(source.Type)
+	if err != nil || connector == nil {
+		return nil
+	}
+	return connector.WebURLResolver
+}
+
 func runDocumentGet(cmd *cobra.Command, args []string) error {
 	if documentService == nil {
 		return errors.New("document service not configured")
@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// Document export formats.
+const (
+	documentExportText     = "text"
+	documentExportJSON     = "json"
+	documentExportMarkdown = "markdown"
+)
+
+var (
+	documentExportFormat string
+	documentExportOutput string
+)
+
+var documentExportCmd = &cobra.Command{
+	Use:   "export [doc-id]",
+	Short: "Export document content",
+	Long: `Write a document's normalised content to stdout or a file.
+
+Formats:
+  text      The normalised content as indexed (default)
+  json      The content with the document's metadata
+  markdown  The content after a YAML front matter block of metadata
+
+Examples:
+  sercha document export <doc-id> | pbcopy
+  sercha document export <doc-id> --format markdown > notes.md
+  sercha document export <doc-id> --format json --output doc.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDocumentExport,
+}
+
+func init() {
+	documentExportCmd.Flags().StringVarP(
+		&documentExportFormat, "format", "f", documentExportText,
+		"Output format: text, json or markdown")
+	documentExportCmd.Flags().StringVarP(
+		&documentExportOutput, "output", "o", "",
+		"File to write the export to (default: stdout)")
+	_ = documentExportCmd.RegisterFlagCompletionFunc("format", cobra.FixedCompletions(
+		[]string{documentExportText, documentExportJSON, documentExportMarkdown}, cobra.ShellCompDirectiveNoFileComp))
+	documentCmd.AddCommand(documentExportCmd)
+}
+
+// documentExport is a document with its content, as exported in the json
+// and markdown formats.
+type documentExport struct {
+	ID        string         `json:"id" yaml:"id"`
+	Title     string         `json:"title" yaml:"title"`
+	SourceID  string         `json:"source_id" yaml:"source_id"`
+	URI       string         `json:"uri" yaml:"uri"`
+	CreatedAt time.Time      `json:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at" yaml:"updated_at"`
+	Metadata  map[string]any `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	Content   string         `json:"content" yaml:"-"`
+}
+
+func runDocumentExport(cmd *cobra.Command, args []string) error {
+	if documentService == nil {
+		return errors.New("document service not configured")
+	}
+
+	format := strings.ToLower(strings.TrimSpace(documentExportFormat))
+	switch format {
+	case documentExportText, documentExportJSON, documentExportMarkdown:
+	default:
+		return fmt.Errorf("invalid --format: %s (use text, json or markdown)", documentExportFormat)
+	}
+
+	docID := args[0]
+	ctx := context.Background()
+
+	doc, err := documentService.Get(ctx, docID)
+	if err != nil {
+		return documentExportError(docID, err)
+	}
+	content, err := documentService.GetContent(ctx, docID)
+	if err != nil {
+		return documentExportError(docID, err)
+	}
+
+	data, err := formatDocumentExport(format, doc, content)
+	if err != nil {
+		return err
+	}
+
+	if documentExportOutput == "" {
+		_, err := cmd.OutOrStdout().Write(data)
+		return err
+	}
+	if err := os.WriteFile(documentExportOutput, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+	cmd.Printf("Exported %s to %s\n", doc.ID, documentExportOutput)
+	return nil
+}
+
+// documentExportError explains a failed lookup, pointing at how to find
+// document IDs when the document does not exist.
+func documentExportError(docID string, err error) error {
+	if errors.Is(err, domain.ErrNotFound) {
+		return fmt.Errorf("document not found: %s (find document IDs with "+
+			"'sercha search' or 'sercha document list <source-id>')", docID)
+	}
+	return fmt.Errorf("failed to get document: %w", err)
+}
+
+// formatDocumentExport renders a document and its content in format.
+func formatDocumentExport(format string, doc *domain.Document, content string) ([]byte, error) {
+	export := documentExport{
+		ID:        doc.ID,
+		Title:     doc.Title,
+		SourceID:  doc.SourceID,
+		URI:       doc.URI,
+		CreatedAt: doc.CreatedAt,
+		UpdatedAt: doc.UpdatedAt,
+		Metadata:  doc.Metadata,
+		Content:   content,
+	}
+
+	switch format {
+	case documentExportJSON:
+		data, err := json.MarshalIndent(export, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal document: %w", err)
+		}
+		return append(data, '\n'), nil
+	case documentExportMarkdown:
+		frontMatter, err := yaml.Marshal(export)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal front matter: %w", err)
+		}
+		var buf bytes.Buffer
+		buf.WriteString("---\n")
+		buf.Write(frontMatter)
+		buf.WriteString("---\n\n")
+		buf.WriteString(withTrailingNewline(content))
+		return buf.Bytes(), nil
+	default:
+		return []byte(withTrailingNewline(content)), nil
+	}
+}
+
+// withTrailingNewline ends non-empty text with a newline, so exports are
+// well-formed text files and shell prompts start on their own line.
+func withTrailingNewline(text string) string {
+	if text == "" || strings.HasSuffix(text, "\n") {
+		return text
+	}
+	return text + "\n"
+}
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockMissingDocumentService reports every document as not found.
+type mockMissingDocumentService struct {
+	mockDocumentService
+}
+
+func (m *mockMissingDocumentService) Get(_ context.Context, _ string) (*domain.Document, error) {
+	return nil, domain.ErrNotFound
+}
+
+func runDocumentExportCmd(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"document", "export"}, args...))
+	defer func() {
+		rootCmd.SetArgs(nil)
+		documentExportFormat = documentExportText
+		documentExportOutput = ""
+	}()
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestDocumentExportCmd_TextByDefault(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	out, err := runDocumentExportCmd(t, "doc-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "This is the content of the test document.\n", out)
+}
+
+func TestDocumentExportCmd_JSON(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	out, err := runDocumentExportCmd(t, "doc-1", "--format", "json")
+
+	require.NoError(t, err)
+	var export map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out), &export))
+	assert.Equal(t, "doc-1", export["id"])
+	assert.Equal(t, "Test Document", export["title"])
+	assert.Equal(t, "src-1", export["source_id"])
+	assert.Equal(t, "This is the content of the test document.", export["content"])
+	assert.Equal(t, map[string]any{"author": "test"}, export["metadata"])
+}
+
+func TestDocumentExportCmd_Markdown(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	out, err := runDocumentExportCmd(t, "doc-1", "--format", "markdown")
+
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(out, "---\n"))
+	frontMatter, body, found := strings.Cut(strings.TrimPrefix(out, "---\n"), "---\n\n")
+	require.True(t, found)
+	assert.Equal(t, "This is the content of the test document.\n", body)
+
+	var meta map[string]any
+	require.NoError(t, yaml.Unmarshal([]byte(frontMatter), &meta))
+	assert.Equal(t, "doc-1", meta["id"])
+	assert.Equal(t, "/path/to/document.txt", meta["uri"])
+	assert.NotContains(t, meta, "content")
+}
+
+func TestDocumentExportCmd_OutputFile(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	output := filepath.Join(t.TempDir(), "notes.md")
+
+	out, err := runDocumentExportCmd(t, "doc-1", "--output", output)
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Exported doc-1 to "+output)
+	data, err := os.ReadFile(output)
+	require.NoError(t, err)
+	assert.Equal(t, "This is the content of the test document.\n", string(data))
+}
+
+func TestDocumentExportCmd_InvalidFormat(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	_, err := runDocumentExportCmd(t, "doc-1", "--format", "pdf")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid --format: pdf")
+}
+
+func TestDocumentExportCmd_NotFound(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	documentService = &mockMissingDocumentService{}
+
+	_, err := runDocumentExportCmd(t, "missing")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "document not found: missing")
+	assert.Contains(t, err.Error(), "sercha document list")
+}
+
+func TestDocumentExportCmd_ServiceNotConfigured(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	documentService = nil
+
+	_, err := runDocumentExportCmd(t, "doc-1")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "document service not configured")
+}
@@ -2,9 +2,13 @@ package cli
 
 import (
 	"bytes"
+	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
 // Document Command Tests
@@ -73,6 +77,88 @@ func TestDocumentListCmd_ExecutesWithArg(t *testing.T) {
 	assert.Contains(t, buf.String(), "Test Document 1")
 }
 
+// mockWebURLConnectorRegistry adds a connector with a web URL resolver.
+type mockWebURLConnectorRegistry struct {
+	mockConnectorRegistry
+}
+
+func (m *mockWebURLConnectorRegistry) Get(id string) (*domain.ConnectorType, error) {
+	if id != "calendar" {
+		return m.mockConnectorRegistry.Get(id)
+	}
+	return &domain.ConnectorType{
+		ID: "calendar",
+		WebURLResolver: func(uri string, _ map[string]any) string {
+			return "https://calendar.example.com/" + strings.TrimPrefix(uri, "mscal://")
+		},
+	}, nil
+}
+
+// mockWebURLDocumentService lists calendar-style documents.
+type mockWebURLDocumentService struct {
+	mockDocumentService
+}
+
+func (m *mockWebURLDocumentService) ListBySource(_ context.Context, sourceID string) ([]domain.Document, error) {
+	return []domain.Document{
+		{ID: "doc-1", SourceID: sourceID, Title: "Standup", URI: "mscal://events/evt-1"},
+	}, nil
+}
+
+func runDocumentListWebURLs(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	oldSources, oldDocs, oldRegistry := sourceService, documentService, connectorRegistry
+	sourceService = &mockCheckSourceService{sources: []domain.Source{
+		{ID: "src-cal", Type: "calendar", Name: "Work Calendar"},
+		{ID: "src-fs", Type: "filesystem", Name: "Notes"},
+	}}
+	documentService = &mockWebURLDocumentService{}
+	connectorRegistry = &mockWebURLConnectorRegistry{}
+	defer func() { sourceService, documentService, connectorRegistry = oldSources, oldDocs, oldRegistry }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs(append([]string{"document", "list"}, args...))
+	defer func() {
+		rootCmd.SetArgs(nil)
+		documentListResolveWebURLs = false
+	}()
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestDocumentListCmd_ResolveWebURLs(t *testing.T) {
+	out, err := runDocumentListWebURLs(t, "src-cal", "--resolve-web-urls")
+
+	assert.NoError(t, err)
+	assert.Contains(t, out, "URI: mscal://events/evt-1")
+	assert.Contains(t, out, "Web URL: https://calendar.example.com/events/evt-1")
+}
+
+func TestDocumentListCmd_ResolveWebURLs_NoResolver(t *testing.T) {
+	out, err := runDocumentListWebURLs(t, "src-fs", "--resolve-web-urls")
+
+	assert.NoError(t, err)
+	assert.Contains(t, out, "URI: mscal://events/evt-1")
+	assert.NotContains(t, out, "Web URL:")
+}
+
+func TestDocumentListCmd_ResolveWebURLs_UnknownSource(t *testing.T) {
+	out, err := runDocumentListWebURLs(t, "src-missing", "--resolve-web-urls")
+
+	assert.NoError(t, err)
+	assert.Contains(t, out, "Standup")
+	assert.NotContains(t, out, "Web URL:")
+}
+
+func TestDocumentListCmd_WebURLsOffByDefault(t *testing.T) {
+	out, err := runDocumentListWebURLs(t, "src-cal")
+
+	assert.NoError(t, err)
+	assert.NotContains(t, out, "Web URL:")
+}
+
 // Document Get Tests
 
 func TestDocumentGetCmd_Use(t *testing.T) {
@@ -0,0 +1,180 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/spf13/cobra"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+var indexCmd = &cobra.Command{
+	Use:   "index",
+	Short: "Manage the search indexes",
+	Long: `Manage the search and vector indexes built from synced documents.
+
+Examples:
+  sercha index rebuild
+  sercha index rebuild --source abc123 --refetch
+  sercha index rebuild --model nomic-embed-code
+  sercha index rebuild --vectors-only
+  sercha index optimize`,
+}
+
+var indexRebuildCmd = &cobra.Command{
+	Use:   "rebuild",
+	Short: "Re-index stored documents",
+	Long: `Re-index stored documents after a chunker, embedding or normaliser change.
+
+By default each document's stored content is chunked and embedded again and
+its search and vector index entries are replaced. Original connector content
+is not stored, so picking up normaliser changes needs --refetch, which
+re-syncs each source from scratch.
+
+After changing embedding_model_overrides, --model re-embeds only the
+documents that the named model now embeds.
+
+--keyword-only re-chunks documents and rebuilds the search index without
+calling the embedding model; vectors of changed chunks are dropped until a
+later rebuild embeds them. --vectors-only re-embeds the stored chunks, for
+example after changing the embedding model, and leaves the search index as
+it is.
+
+The rebuild can be interrupted with Ctrl+C. Running the same command again
+resumes after the last completed document.`,
+	Args: cobra.NoArgs,
+	RunE: runIndexRebuild,
+}
+
+var reindexCmd = &cobra.Command{
+	Use:   "reindex",
+	Short: "Rebuild the indexes from stored documents",
+	Long: `Rebuild the search and vector indexes from stored documents, without
+fetching anything from connectors. Run this after changing chunking or the
+embedding model. It is the same as "sercha index rebuild".
+
+Examples:
+  sercha reindex
+  sercha reindex --source abc123
+  sercha reindex --keyword-only
+  sercha reindex --vectors-only`,
+	Args: cobra.NoArgs,
+	RunE: runIndexRebuild,
+}
+
+var indexOptimizeCmd = &cobra.Command{
+	Use:   "optimize",
+	Short: "Flush and compact the search and vector indexes",
+	Long: `Flush and compact the search and vector indexes for faster queries.
+
+Run this after a large sync. Pending search index changes are committed and
+the index is rewritten without the space left by updated and deleted chunks,
+and the vector index is rebuilt from its live vectors. Stop any running sync
+or TUI session first. Disk usage is reported before and after.
+
+Examples:
+  sercha index optimize`,
+	Args: cobra.NoArgs,
+	RunE: runIndexOptimize,
+}
+
+var (
+	indexRebuildSource  string
+	indexRebuildRefetch bool
+	indexRebuildModel   string
+	indexRebuildKeyword bool
+	indexRebuildVectors bool
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{indexRebuildCmd, reindexCmd} {
+		cmd.Flags().StringVar(&indexRebuildSource, "source", "",
+			"Only rebuild documents from this source")
+		cmd.Flags().BoolVar(&indexRebuildKeyword, "keyword-only", false,
+			"Only rebuild the search index, without embedding")
+		cmd.Flags().BoolVar(&indexRebuildVectors, "vectors-only", false,
+			"Only re-embed stored chunks into the vector index")
+	}
+	indexRebuildCmd.Flags().BoolVar(&indexRebuildRefetch, "refetch", false,
+		"Re-fetch and re-normalise documents from their connectors")
+	indexRebuildCmd.Flags().StringVar(&indexRebuildModel, "model", "",
+		"Only rebuild documents embedded by this embedding model")
+	indexCmd.AddCommand(indexRebuildCmd)
+	indexCmd.AddCommand(indexOptimizeCmd)
+	rootCmd.AddCommand(indexCmd)
+	rootCmd.AddCommand(reindexCmd)
+}
+
+func runIndexRebuild(cmd *cobra.Command, _ []string) error {
+	if rebuildService == nil {
+		return errors.New("rebuild service not configured")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	opts := domain.RebuildOptions{
+		SourceID:    indexRebuildSource,
+		Refetch:     indexRebuildRefetch,
+		Model:       indexRebuildModel,
+		KeywordOnly: indexRebuildKeyword,
+		VectorsOnly: indexRebuildVectors,
+	}
+	result, err := rebuildService.Rebuild(ctx, opts, func(p domain.RebuildProgress) {
+		if opts.Refetch {
+			cmd.Printf("\rRe-fetched source %s", p.SourceID)
+			return
+		}
+		if opts.VectorsOnly {
+			cmd.Printf("\rRe-embedding %s... %d documents, %d chunks", p.SourceID, p.Documents, p.Chunks)
+			return
+		}
+		cmd.Printf("\rRebuilding %s... %d documents, %d chunks", p.SourceID, p.Documents, p.Chunks)
+	})
+	cmd.Println()
+
+	if result != nil && result.Resumed {
+		cmd.Println("Resumed an interrupted rebuild.")
+	}
+	if errors.Is(err, context.Canceled) {
+		return errors.New("rebuild interrupted; run the command again to resume")
+	}
+	if err != nil {
+		return fmt.Errorf("rebuild failed: %w", err)
+	}
+
+	if opts.Refetch {
+		cmd.Printf("Re-fetched %d sources.\n", result.Sources)
+		return nil
+	}
+	cmd.Printf("Rebuilt %d documents (%d chunks) from %d sources.\n", result.Documents, result.Chunks, result.Sources)
+	if result.Failed > 0 {
+		cmd.Printf("%d documents failed; see the log for details.\n", result.Failed)
+	}
+	return nil
+}
+
+func runIndexOptimize(cmd *cobra.Command, _ []string) error {
+	if maintenanceService == nil {
+		return errors.New("maintenance service not configured")
+	}
+
+	ctx := context.Background()
+	result, err := maintenanceService.Optimize(ctx)
+	if err != nil {
+		return fmt.Errorf("optimize failed: %w", err)
+	}
+
+	if !result.SearchIndexCompacted {
+		cmd.Println("Search index compaction is not supported in this build")
+	}
+	if !result.VectorIndexCompacted {
+		cmd.Println("Vector index not compacted (not enabled or not supported in this build)")
+	}
+	printDiskUsage(cmd, result.Before, result.After)
+	return nil
+}
@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockRebuildService implements driving.RebuildService for testing.
+type mockRebuildService struct {
+	opts    domain.RebuildOptions
+	resumed bool
+	err     error
+
+	oldOverrides, newOverrides map[string]string
+	reassigned                 int
+}
+
+func (m *mockRebuildService) DeleteReassignedVectors(
+	_ context.Context, oldOverrides, newOverrides map[string]string,
+) (int, error) {
+	m.oldOverrides, m.newOverrides = oldOverrides, newOverrides
+	return m.reassigned, nil
+}
+
+func (m *mockRebuildService) Rebuild(
+	_ context.Context, opts domain.RebuildOptions, progress func(domain.RebuildProgress),
+) (*domain.RebuildResult, error) {
+	m.opts = opts
+	result := &domain.RebuildResult{Resumed: m.resumed}
+	result.SourceID = "src-1"
+	result.Documents = 3
+	result.Chunks = 9
+	result.Failed = 1
+	result.Sources = 1
+	progress(result.RebuildProgress)
+	return result, m.err
+}
+
+func runIndexCmd(t *testing.T, svc *mockRebuildService, args ...string) (string, error) {
+	t.Helper()
+	oldRebuild := rebuildService
+	rebuildService = svc
+	defer func() {
+		rebuildService = oldRebuild
+		indexRebuildSource = ""
+		indexRebuildRefetch = false
+		indexRebuildModel = ""
+		indexRebuildKeyword = false
+		indexRebuildVectors = false
+	}()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(args)
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestIndexRebuildCmd(t *testing.T) {
+	svc := &mockRebuildService{}
+
+	out, err := runIndexCmd(t, svc, "index", "rebuild", "--source", "src-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.RebuildOptions{SourceID: "src-1"}, svc.opts)
+	assert.Contains(t, out, "Rebuilding src-1... 3 documents, 9 chunks")
+	assert.Contains(t, out, "Rebuilt 3 documents (9 chunks) from 1 sources.")
+	assert.Contains(t, out, "1 documents failed")
+	assert.NotContains(t, out, "Resumed")
+}
+
+func TestIndexRebuildCmd_Refetch(t *testing.T) {
+	svc := &mockRebuildService{resumed: true}
+
+	out, err := runIndexCmd(t, svc, "index", "rebuild", "--refetch")
+
+	require.NoError(t, err)
+	assert.True(t, svc.opts.Refetch)
+	assert.Contains(t, out, "Resumed an interrupted rebuild.")
+	assert.Contains(t, out, "Re-fetched 1 sources.")
+}
+
+func TestIndexRebuildCmd_Model(t *testing.T) {
+	svc := &mockRebuildService{}
+
+	_, err := runIndexCmd(t, svc, "index", "rebuild", "--model", "nomic-embed-code")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.RebuildOptions{Model: "nomic-embed-code"}, svc.opts)
+}
+
+func TestReindexCmd(t *testing.T) {
+	svc := &mockRebuildService{}
+
+	out, err := runIndexCmd(t, svc, "reindex", "--source", "src-1", "--keyword-only")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.RebuildOptions{SourceID: "src-1", KeywordOnly: true}, svc.opts)
+	assert.Contains(t, out, "Rebuilt 3 documents (9 chunks) from 1 sources.")
+}
+
+func TestReindexCmd_VectorsOnly(t *testing.T) {
+	svc := &mockRebuildService{}
+
+	out, err := runIndexCmd(t, svc, "reindex", "--vectors-only")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.RebuildOptions{VectorsOnly: true}, svc.opts)
+	assert.Contains(t, out, "Re-embedding src-1... 3 documents, 9 chunks")
+}
+
+func TestIndexRebuildCmd_Interrupted(t *testing.T) {
+	_, err := runIndexCmd(t, &mockRebuildService{err: context.Canceled}, "index", "rebuild")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "run the command again to resume")
+}
+
+func TestIndexRebuildCmd_Error(t *testing.T) {
+	_, err := runIndexCmd(t, &mockRebuildService{err: errors.New("index locked")}, "index", "rebuild")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rebuild failed: index locked")
+}
+
+func TestIndexOptimizeCmd(t *testing.T) {
+	svc := &mockMaintenanceService{optimize: &domain.OptimizeResult{
+		Before:               domain.DiskUsage{Database: 1024, SearchIndex: 4096, VectorIndex: 2048},
+		After:                domain.DiskUsage{Database: 1024, SearchIndex: 2048, VectorIndex: 1024},
+		SearchIndexCompacted: true,
+		VectorIndexCompacted: true,
+	}}
+
+	out, err := runDBCmd(t, svc, "index", "optimize")
+
+	require.NoError(t, err)
+	assert.Regexp(t, `Search index\s+4\.0 KiB\s+2\.0 KiB`, out)
+	assert.Regexp(t, `Vector index\s+2\.0 KiB\s+1\.0 KiB`, out)
+	assert.Contains(t, out, "Reclaimed 3.0 KiB")
+	assert.NotContains(t, out, "not supported")
+}
+
+func TestIndexOptimizeCmd_Unsupported(t *testing.T) {
+	out, err := runDBCmd(t, &mockMaintenanceService{optimize: &domain.OptimizeResult{}}, "index", "optimize")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Search index compaction is not supported")
+	assert.Contains(t, out, "Vector index not compacted")
+}
+
+func TestIndexOptimizeCmd_Error(t *testing.T) {
+	_, err := runDBCmd(t, &mockMaintenanceService{err: errors.New("index locked")}, "index", "optimize")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "optimize failed: index locked")
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var maintenanceCmd = &cobra.Command{
+	Use:   "maintenance",
+	Short: "Run housekeeping on the local stores",
+	Long: `Run housekeeping on sercha's local data: the metadata database and the
+search and vector indexes.
+
+Examples:
+  sercha maintenance compact`,
+}
+
+var maintenanceCompactCmd = &cobra.Command{
+	Use:   "compact",
+	Short: "Remove orphaned data and reclaim disk space",
+	Long: `Remove data left behind by removed sources and deleted documents, then
+reclaim the disk space it used.
+
+Documents whose source no longer exists are deleted from the metadata
+database, and chunks of deleted documents are removed from the search and
+vector indexes. The database is then rebuilt with VACUUM, which holds an
+exclusive lock while it runs; stop any running sync or TUI session first.
+Finally both indexes are compacted. Disk usage is reported before and after.
+
+Compaction can also run weekly in the background: set
+scheduler.maintenance_compact.enabled = true in the config file, and change
+its schedule with "sercha settings cron maintenance-compact".
+
+Examples:
+  sercha maintenance compact`,
+	Args: cobra.NoArgs,
+	RunE: runMaintenanceCompact,
+}
+
+func init() {
+	maintenanceCmd.AddCommand(maintenanceCompactCmd)
+	rootCmd.AddCommand(maintenanceCmd)
+}
+
+func runMaintenanceCompact(cmd *cobra.Command, _ []string) error {
+	if maintenanceService == nil {
+		return errors.New("maintenance service not configured")
+	}
+
+	ctx := context.Background()
+	result, err := maintenanceService.Compact(ctx)
+	if err != nil {
+		return fmt.Errorf("compact failed: %w", err)
+	}
+
+	if result.OrphanedDocuments > 0 {
+		cmd.Printf("Removed %d documents of removed sources\n", result.OrphanedDocuments)
+	}
+	if result.OrphanedChunks > 0 {
+		cmd.Printf("Removed %d orphaned chunks from the search index\n", result.OrphanedChunks)
+	}
+	if result.OrphanedVectors > 0 {
+		cmd.Printf("Removed %d orphaned vectors\n", result.OrphanedVectors)
+	}
+	if !result.SearchIndexCompacted {
+		cmd.Println("Search index compaction is not supported in this build")
+	}
+	if !result.VectorIndexCompacted {
+		cmd.Println("Vector index not compacted (not enabled or not supported in this build)")
+	}
+	printDiskUsage(cmd, result.Before, result.After)
+	return nil
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestMaintenanceCompactCmd(t *testing.T) {
+	svc := &mockMaintenanceService{compact: &domain.CompactResult{
+		Before:               domain.DiskUsage{Database: 4096, SearchIndex: 2048, VectorIndex: 2048},
+		After:                domain.DiskUsage{Database: 2048, SearchIndex: 1024, VectorIndex: 1024},
+		OrphanedDocuments:    2,
+		OrphanedChunks:       5,
+		OrphanedVectors:      4,
+		SearchIndexCompacted: true,
+		VectorIndexCompacted: true,
+	}}
+
+	out, err := runDBCmd(t, svc, "maintenance", "compact")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Removed 2 documents of removed sources")
+	assert.Contains(t, out, "Removed 5 orphaned chunks from the search index")
+	assert.Contains(t, out, "Removed 4 orphaned vectors")
+	assert.Regexp(t, `Total\s+8\.0 KiB\s+4\.0 KiB`, out)
+	assert.Contains(t, out, "Reclaimed 4.0 KiB")
+	assert.NotContains(t, out, "not supported")
+}
+
+func TestMaintenanceCompactCmd_NothingToRemove(t *testing.T) {
+	out, err := runDBCmd(t, &mockMaintenanceService{compact: &domain.CompactResult{}}, "maintenance", "compact")
+
+	require.NoError(t, err)
+	assert.NotContains(t, out, "Removed")
+	assert.Contains(t, out, "Search index compaction is not supported")
+	assert.Contains(t, out, "Vector index not compacted")
+}
+
+func TestMaintenanceCompactCmd_Error(t *testing.T) {
+	_, err := runDBCmd(t, &mockMaintenanceService{err: errors.New("database is in use")}, "maintenance", "compact")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "compact failed: database is in use")
+}
+
+func TestMaintenanceCompactCmd_NotConfigured(t *testing.T) {
+	oldMaintenance := maintenanceService
+	maintenanceService = nil
+	defer func() { maintenanceService = oldMaintenance }()
+
+	err := runMaintenanceCompact(maintenanceCompactCmd, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "maintenance service not configured")
+}
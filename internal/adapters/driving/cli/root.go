@@ -1,8 +1,15 @@
 package cli
 
 import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
 	"github.com/custodia-labs/sercha-cli/internal/logger"
 )
@@ -11,8 +18,14 @@ var (
 	// Version is set by goreleaser ldflags.
 	version = "dev"
 
-	// Verbose enables debug logging.
-	verbose bool
+	// logging holds the flags that configure the structured logger.
+	logging logFlags
+
+	// traceEndpoint is the OTLP/HTTP endpoint traces are exported to.
+	traceEndpoint string
+
+	// useKeychain enables encryption of saved credentials with the OS keychain.
+	useKeychain bool
 
 	// Services holds injected service implementations for CLI commands.
 	searchService       driving.SearchService
@@ -24,8 +37,31 @@ var (
 	settingsService     driving.SettingsService
 	authProviderService driving.AuthProviderService
 	credentialsService  driving.CredentialsService
+	scheduleService     driving.ScheduleService
+	accountService      driving.AccountService
+	sourceHealthService driving.SourceHealthService
+	backupService       driving.BackupService
+	maintenanceService  driving.MaintenanceService
+	rebuildService      driving.RebuildService
+	diagnosticService   driving.DiagnosticService
+	syncAuditService    driving.SyncAuditService
+	keychain            KeychainEncryption
 )
 
+// passphraseEnv names the environment variable holding the passphrase that
+// credentials are encrypted with, as an alternative to the OS keychain.
+const passphraseEnv = "SERCHA_CREDENTIALS_PASSPHRASE"
+
+// KeychainEncryption toggles encryption of credentials at rest.
+type KeychainEncryption interface {
+	// SetEncryption enables or disables encryption of saved credentials.
+	SetEncryption(enabled bool)
+
+	// SetPassphrase encrypts saved credentials with a key derived from
+	// passphrase instead of the keychain key. Empty leaves it unset.
+	SetPassphrase(passphrase string)
+}
+
 // Services holds configuration for CLI commands.
 type Services struct {
 	Search            driving.SearchService
@@ -37,6 +73,15 @@ type Services struct {
 	Settings          driving.SettingsService
 	AuthProvider      driving.AuthProviderService
 	Credentials       driving.CredentialsService
+	Schedule          driving.ScheduleService
+	Accounts          driving.AccountService
+	SourceHealth      driving.SourceHealthService
+	Backup            driving.BackupService
+	Maintenance       driving.MaintenanceService
+	Rebuild           driving.RebuildService
+	Diagnostic        driving.DiagnosticService
+	SyncAudit         driving.SyncAuditService
+	Keychain          KeychainEncryption
 }
 
 // SetServices injects service implementations for CLI commands.
@@ -53,6 +98,15 @@ func SetServices(s *Services) {
 	settingsService = s.Settings
 	authProviderService = s.AuthProvider
 	credentialsService = s.Credentials
+	scheduleService = s.Schedule
+	accountService = s.Accounts
+	sourceHealthService = s.SourceHealth
+	backupService = s.Backup
+	maintenanceService = s.Maintenance
+	rebuildService = s.Rebuild
+	diagnosticService = s.Diagnostic
+	syncAuditService = s.SyncAudit
+	keychain = s.Keychain
 }
 
 // rootCmd is the base command.
@@ -75,12 +129,101 @@ func SetVersion(v string) {
 	version = v
 }
 
+// logFlags holds the flags that configure logging.
+type logFlags struct {
+	verbose bool
+	level   string
+	format  string
+}
+
+// register defines the logging flags on flags.
+func (f *logFlags) register(flags *pflag.FlagSet) {
+	flags.BoolVarP(&f.verbose, "verbose", "v", false, "enable verbose debug output (same as --log-level debug)")
+	flags.StringVar(&f.level, "log-level", "warn", "minimum level to log: debug, info, warn or error")
+	flags.StringVar(&f.format, "log-format", logger.FormatText, "log output format: text or json")
+}
+
+// settings returns the log level and format selected by the flags.
+func (f *logFlags) settings() (slog.Level, string, error) {
+	level, err := logger.ParseLevel(f.level)
+	if err != nil {
+		return 0, "", err
+	}
+	format, err := logger.ParseFormat(f.format)
+	if err != nil {
+		return 0, "", err
+	}
+	if f.verbose {
+		level = slog.LevelDebug
+	}
+	return level, format, nil
+}
+
+// LogSettings returns the log level and format selected by the logging flags
+// in args, so logging can be set up before the services are constructed.
+// Other flags are ignored; invalid settings fall back to the defaults and
+// are reported when the command runs.
+func LogSettings(args []string) (slog.Level, string) {
+	var f logFlags
+	preparse(args, f.register)
+
+	level, format, err := f.settings()
+	if err != nil {
+		return logger.DefaultLevel, logger.FormatText
+	}
+	return level, format
+}
+
+// TraceEndpoint returns the endpoint given by the --trace-endpoint flag in
+// args, so tracing can be set up before the services are constructed.
+// It is empty if the flag is not set; invalid endpoints are reported when
+// the command runs.
+func TraceEndpoint(args []string) string {
+	var endpoint string
+	preparse(args, func(flags *pflag.FlagSet) { registerTraceEndpoint(flags, &endpoint) })
+	return endpoint
+}
+
+// registerTraceEndpoint defines the --trace-endpoint flag on flags.
+func registerTraceEndpoint(flags *pflag.FlagSet, endpoint *string) {
+	flags.StringVar(endpoint, "trace-endpoint", "",
+		"export OpenTelemetry traces to this OTLP/HTTP endpoint (e.g. http://localhost:4318)")
+}
+
+// preparse parses the flags that register defines from args, ignoring any
+// other flags and all errors.
+func preparse(args []string, register func(*pflag.FlagSet)) {
+	flags := pflag.NewFlagSet("sercha", pflag.ContinueOnError)
+	flags.ParseErrorsAllowlist.UnknownFlags = true
+	flags.SetOutput(io.Discard)
+	flags.Usage = func() {}
+
+	register(flags)
+	_ = flags.Parse(args)
+}
+
 func init() {
-	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "enable verbose debug output")
+	logging.register(rootCmd.PersistentFlags())
+	registerTraceEndpoint(rootCmd.PersistentFlags(), &traceEndpoint)
+	rootCmd.PersistentFlags().BoolVar(
+		&useKeychain, "keychain", false, "encrypt saved credentials using the OS keychain")
 
-	// Use PersistentPreRunE to set verbose mode before any command executes
+	// Use PersistentPreRunE to set up logging before any command executes
 	rootCmd.PersistentPreRunE = func(_ *cobra.Command, _ []string) error {
-		logger.SetVerbose(verbose)
+		level, format, err := logging.settings()
+		if err != nil {
+			return err
+		}
+		logger.Setup(level, format)
+		if traceEndpoint != "" {
+			if err := domain.ValidateEndpointURL(traceEndpoint); err != nil {
+				return fmt.Errorf("--trace-endpoint: %w", err)
+			}
+		}
+		if keychain != nil {
+			keychain.SetEncryption(useKeychain)
+			keychain.SetPassphrase(os.Getenv(passphraseEnv))
+		}
 		return nil
 	}
 }
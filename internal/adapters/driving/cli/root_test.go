@@ -2,9 +2,12 @@ package cli
 
 import (
 	"bytes"
+	"log/slog"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
 func TestSetVersion(t *testing.T) {
@@ -65,6 +68,59 @@ func TestExecute_ReturnsNoErrorWithHelp(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestLogSettings_Defaults(t *testing.T) {
+	level, format := LogSettings([]string{"search", "query"})
+
+	assert.Equal(t, slog.LevelWarn, level)
+	assert.Equal(t, "text", format)
+}
+
+func TestLogSettings_FromFlags(t *testing.T) {
+	level, format := LogSettings([]string{"sync", "--source", "src-1", "--log-level", "info", "--log-format=json"})
+
+	assert.Equal(t, slog.LevelInfo, level)
+	assert.Equal(t, "json", format)
+}
+
+func TestLogSettings_VerboseSelectsDebug(t *testing.T) {
+	level, _ := LogSettings([]string{"search", "-v", "--log-level", "error", "query"})
+
+	assert.Equal(t, slog.LevelDebug, level)
+}
+
+func TestLogSettings_InvalidFallsBackToDefaults(t *testing.T) {
+	level, format := LogSettings([]string{"--log-level", "loud", "--log-format", "json"})
+
+	assert.Equal(t, slog.LevelWarn, level)
+	assert.Equal(t, "text", format)
+}
+
+func TestRootCmd_InvalidLogLevel(t *testing.T) {
+	original := logging
+	defer func() { logging = original }()
+
+	logging.level = "loud"
+	err := rootCmd.PersistentPreRunE(rootCmd, nil)
+
+	assert.ErrorContains(t, err, "invalid log level")
+}
+
+func TestTraceEndpoint(t *testing.T) {
+	assert.Empty(t, TraceEndpoint([]string{"sync", "--source", "src-1"}))
+	assert.Equal(t, "http://localhost:4318",
+		TraceEndpoint([]string{"search", "--trace-endpoint", "http://localhost:4318", "-v", "query"}))
+}
+
+func TestRootCmd_InvalidTraceEndpoint(t *testing.T) {
+	original := traceEndpoint
+	defer func() { traceEndpoint = original }()
+
+	traceEndpoint = "localhost:4318"
+	err := rootCmd.PersistentPreRunE(rootCmd, nil)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
 func TestSetServices_WithNilServices(t *testing.T) {
 	// Save current state
 	oldSearch := searchService
@@ -5,56 +5,156 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
+// maxSearchVectors caps how many results may carry embeddings in JSON output.
+// Each embedding is hundreds to thousands of floats, so output grows quickly.
+const maxSearchVectors = 100
+
 var (
-	searchLimit int
-	searchJSON  bool
+	searchLimit          int
+	searchJSON           bool
+	searchIncludeVectors bool
+	searchMaxVectors     int
+	searchLanguage       string
+	searchMinSimilarity  float64
+	searchDedupe         bool
+	searchMerge          bool
+	searchWatch          bool
 )
 
 var searchCmd = &cobra.Command{
 	Use:   "search [query]",
 	Short: "Search indexed documents",
 	Long: `Performs hybrid search across all indexed documents.
-Combines keyword (BM25) and semantic (vector) search for best results.`,
-	Args: cobra.ExactArgs(1),
+Combines keyword (BM25) and semantic (vector) search for best results.
+
+With --watch, queries are read from stdin one per line instead, and the
+results are reprinted for each one. Lines arriving within 150ms of each other
+only search the last, and on a terminal the previous results are cleared.
+Watch mode exits at end of input or on Ctrl-C.
+
+Examples:
+  sercha search "deployment steps"
+  echo "deployment steps" | sercha search --watch
+  sercha search --watch --json -n 5`,
+	Args: searchArgs,
 	RunE: runSearch,
 }
 
 func init() {
 	searchCmd.Flags().IntVarP(&searchLimit, "limit", "n", 10, "maximum number of results")
 	searchCmd.Flags().BoolVar(&searchJSON, "json", false, "output results as JSON")
+	searchCmd.Flags().BoolVar(
+		&searchIncludeVectors, "include-vectors", false,
+		"include chunk embeddings in JSON output (implies --json)")
+	searchCmd.Flags().IntVar(
+		&searchMaxVectors, "max-vectors", 10,
+		fmt.Sprintf("maximum number of results carrying embeddings (max %d)", maxSearchVectors))
+	searchCmd.Flags().StringVar(
+		&searchLanguage, "lang", "",
+		"ISO 639-1 code of the query's language, used to stem keyword terms (default: search.language setting)")
+	searchCmd.Flags().Float64Var(
+		&searchMinSimilarity, "min-similarity", 0,
+		"drop semantic results with a cosine similarity below this, from 0 to 1 "+
+			"(default: search.min_similarity setting)")
+	searchCmd.Flags().BoolVar(
+		&searchDedupe, "dedupe-results", false,
+		"collapse results from the same document, keeping its best-scoring chunk")
+	searchCmd.Flags().BoolVar(
+		&searchMerge, "merge-duplicates", false,
+		"also collapse documents with identical content across sources (implies --dedupe-results)")
+	searchCmd.Flags().BoolVar(
+		&searchWatch, "watch", false,
+		"read queries from stdin one per line and reprint the results for each")
 	rootCmd.AddCommand(searchCmd)
 }
 
-func runSearch(cmd *cobra.Command, args []string) error {
-	query := args[0]
+// searchArgs takes the query as the only argument, or none in watch mode,
+// where queries are read from stdin.
+func searchArgs(cmd *cobra.Command, args []string) error {
+	if searchWatch {
+		return cobra.NoArgs(cmd, args)
+	}
+	return cobra.ExactArgs(1)(cmd, args)
+}
 
+func runSearch(cmd *cobra.Command, args []string) error {
 	if searchService == nil {
 		return errors.New("search service not configured")
 	}
 
-	ctx := context.Background()
-	opts := domain.SearchOptions{
-		Limit: searchLimit,
+	opts, err := searchOptions(cmd)
+	if err != nil {
+		return err
 	}
 
-	results, err := searchService.Search(ctx, query, opts)
+	if searchWatch {
+		return runSearchWatch(cmd, opts)
+	}
+
+	results, err := searchService.Search(context.Background(), args[0], opts)
 	if err != nil {
 		return fmt.Errorf("search failed: %w", err)
 	}
+	return outputSearchResults(cmd, results)
+}
 
-	if searchJSON {
-		return outputSearchJSON(cmd, results)
+// searchOptions builds the search options from the command's flags.
+func searchOptions(cmd *cobra.Command) (domain.SearchOptions, error) {
+	opts := domain.SearchOptions{
+		Limit:           searchLimit,
+		DedupeResults:   searchDedupe || searchMerge,
+		MergeDuplicates: searchMerge,
+	}
+	if searchLanguage != "" {
+		lang := strings.ToLower(strings.TrimSpace(searchLanguage))
+		if err := domain.ValidateSearchLanguage(lang); err != nil {
+			return opts, fmt.Errorf("invalid --lang: %w", err)
+		}
+		opts.Language = lang
+	}
+	if cmd.Flags().Changed("min-similarity") {
+		if err := domain.ValidateMinSimilarity(searchMinSimilarity); err != nil {
+			return opts, fmt.Errorf("invalid --min-similarity: %w", err)
+		}
+		opts.MinSimilarity = &searchMinSimilarity
+	}
+	return opts, nil
+}
+
+// outputSearchResults prints results as JSON or a table, per the flags.
+func outputSearchResults(cmd *cobra.Command, results []domain.SearchResult) error {
+	if searchJSON || searchIncludeVectors {
+		vectorLimit := 0
+		if searchIncludeVectors {
+			vectorLimit = min(max(searchMaxVectors, 0), maxSearchVectors)
+		}
+		return outputSearchJSON(cmd, withEmbeddings(results, vectorLimit))
 	}
 
 	return outputSearchTable(cmd, results)
 }
 
+// withEmbeddings returns a copy of results in which only the first
+// limit results keep their chunk embedding. Results beyond the limit,
+// or all results when limit is zero, have the embedding removed.
+func withEmbeddings(results []domain.SearchResult, limit int) []domain.SearchResult {
+	out := make([]domain.SearchResult, len(results))
+	copy(out, results)
+	for i := range out {
+		if i >= limit {
+			out[i].Chunk.Embedding = nil
+		}
+	}
+	return out
+}
+
 func outputSearchJSON(cmd *cobra.Command, results []domain.SearchResult) error {
 	data, err := json.MarshalIndent(results, "", "  ")
 	if err != nil {
@@ -79,8 +179,8 @@ func outputSearchTable(cmd *cobra.Command, results []domain.SearchResult) error
 			title = results[i].Document.ID
 		}
 
-		snippet := ""
-		if len(results[i].Highlights) > 0 {
+		snippet := renderSnippet(results[i].Snippet)
+		if snippet == "" && len(results[i].Highlights) > 0 {
 			snippet = results[i].Highlights[0]
 		}
 
@@ -99,3 +199,17 @@ func outputSearchTable(cmd *cobra.Command, results []domain.SearchResult) error
 
 	return nil
 }
+
+// renderSnippet formats a search result snippet for the terminal, marking
+// matched query terms with asterisks and collapsing whitespace onto one line.
+func renderSnippet(snippet string) string {
+	var b strings.Builder
+	for _, segment := range domain.SnippetSegments(snippet) {
+		if segment.Highlighted {
+			b.WriteString("*" + segment.Text + "*")
+		} else {
+			b.WriteString(segment.Text)
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
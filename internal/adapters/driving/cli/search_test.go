@@ -2,6 +2,9 @@ package cli
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -100,6 +103,142 @@ func TestSearchCmd_ExecutesWithShortLimitFlag(t *testing.T) {
 	assert.Contains(t, buf.String(), "Results:")
 }
 
+// recordingSearchService records the options it was searched with.
+type recordingSearchService struct {
+	mockSearchService
+	opts domain.SearchOptions
+}
+
+func (m *recordingSearchService) Search(
+	ctx context.Context, query string, opts domain.SearchOptions,
+) ([]domain.SearchResult, error) {
+	m.opts = opts
+	return m.mockSearchService.Search(ctx, query, opts)
+}
+
+func TestSearchCmd_LangFlag(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	recorder := &recordingSearchService{}
+	searchService = recorder
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs([]string{"search", "--lang", "FR", "recherche"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+		searchLanguage = ""
+	}()
+
+	err := rootCmd.Execute()
+
+	require.NoError(t, err)
+	assert.Equal(t, "fr", recorder.opts.Language)
+}
+
+func TestSearchCmd_DedupeFlags(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantDedupe bool
+		wantMerge  bool
+	}{
+		{"default", nil, false, false},
+		{"dedupe", []string{"--dedupe-results"}, true, false},
+		{"merge implies dedupe", []string{"--merge-duplicates"}, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanup := setupTestServices()
+			defer cleanup()
+			recorder := &recordingSearchService{}
+			searchService = recorder
+
+			buf := new(bytes.Buffer)
+			rootCmd.SetOut(buf)
+			rootCmd.SetArgs(append(append([]string{"search"}, tt.args...), "query"))
+			defer func() {
+				rootCmd.SetArgs(nil)
+				searchDedupe = false
+				searchMerge = false
+			}()
+
+			err := rootCmd.Execute()
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDedupe, recorder.opts.DedupeResults)
+			assert.Equal(t, tt.wantMerge, recorder.opts.MergeDuplicates)
+		})
+	}
+}
+
+func TestSearchCmd_LangFlagInvalid(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{"search", "--lang", "french", "recherche"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+		searchLanguage = ""
+	}()
+
+	err := rootCmd.Execute()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid --lang")
+}
+
+func TestSearchCmd_MinSimilarityFlag(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    *float64
+		wantErr string
+	}{
+		{name: "unset uses setting", args: nil, want: nil},
+		{name: "set", args: []string{"--min-similarity", "0.4"}, want: ptrFloat(0.4)},
+		{name: "zero disables floor", args: []string{"--min-similarity", "0"}, want: ptrFloat(0)},
+		{name: "out of range", args: []string{"--min-similarity", "2"}, wantErr: "invalid --min-similarity"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanup := setupTestServices()
+			defer cleanup()
+			recorder := &recordingSearchService{}
+			searchService = recorder
+
+			buf := new(bytes.Buffer)
+			rootCmd.SetOut(buf)
+			rootCmd.SetErr(buf)
+			rootCmd.SetArgs(append(append([]string{"search"}, tt.args...), "query"))
+			defer func() {
+				rootCmd.SetArgs(nil)
+				searchMinSimilarity = 0
+				searchCmd.Flags().Lookup("min-similarity").Changed = false
+			}()
+
+			err := rootCmd.Execute()
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, recorder.opts.MinSimilarity)
+		})
+	}
+}
+
+func ptrFloat(f float64) *float64 {
+	return &f
+}
+
 func TestSearchCmd_JSONOutput(t *testing.T) {
 	cleanup := setupTestServices()
 	defer cleanup()
@@ -121,6 +260,70 @@ func TestSearchCmd_JSONOutput(t *testing.T) {
 	assert.Contains(t, buf.String(), "\"Score\"")
 }
 
+func runSearchJSON(t *testing.T, args ...string) []domain.SearchResult {
+	t.Helper()
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs(append([]string{"search"}, args...))
+	defer func() {
+		rootCmd.SetArgs(nil)
+		searchJSON = false
+		searchIncludeVectors = false
+		searchMaxVectors = 10
+	}()
+
+	require.NoError(t, rootCmd.Execute())
+
+	var results []domain.SearchResult
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &results))
+	return results
+}
+
+func TestSearchCmd_JSONOmitsVectorsByDefault(t *testing.T) {
+	results := runSearchJSON(t, "--json", "test query")
+
+	require.Len(t, results, 1)
+	assert.Nil(t, results[0].Chunk.Embedding)
+	// Component scores and chunk offsets are always included
+	assert.InDelta(t, 4.2, results[0].Scores.Keyword, 1e-9)
+	assert.InDelta(t, 0.88, results[0].Scores.Vector, 1e-9)
+	assert.InDelta(t, 0.95, results[0].Scores.Fused, 1e-9)
+	assert.Contains(t, results[0].Chunk.Metadata, domain.ChunkMetaStartOffset)
+	assert.Contains(t, results[0].Chunk.Metadata, domain.ChunkMetaEndOffset)
+}
+
+func TestSearchCmd_JSONIncludesVectorsWhenRequested(t *testing.T) {
+	results := runSearchJSON(t, "--include-vectors", "test query")
+
+	require.Len(t, results, 1)
+	assert.Equal(t, []float32{0.1, 0.2, 0.3}, results[0].Chunk.Embedding)
+}
+
+func TestSearchCmd_JSONMaxVectorsZero(t *testing.T) {
+	results := runSearchJSON(t, "--include-vectors", "--max-vectors", "0", "test query")
+
+	require.Len(t, results, 1)
+	assert.Nil(t, results[0].Chunk.Embedding)
+}
+
+func TestWithEmbeddings_BoundsResults(t *testing.T) {
+	results := make([]domain.SearchResult, 3)
+	for i := range results {
+		results[i].Chunk.Embedding = []float32{float32(i)}
+	}
+
+	bounded := withEmbeddings(results, 2)
+
+	assert.NotNil(t, bounded[0].Chunk.Embedding)
+	assert.NotNil(t, bounded[1].Chunk.Embedding)
+	assert.Nil(t, bounded[2].Chunk.Embedding)
+	// Input is not modified
+	assert.NotNil(t, results[2].Chunk.Embedding)
+}
+
 func TestSearchCmd_ServiceNotConfigured(t *testing.T) {
 	oldService := searchService
 	searchService = nil
@@ -185,6 +388,38 @@ func TestOutputSearchTable_WithHighlights(t *testing.T) {
 	assert.Contains(t, buf.String(), "This is a highlight snippet")
 }
 
+func TestOutputSearchTable_WithSnippet(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+
+	results := []domain.SearchResult{
+		{
+			Document:   domain.Document{ID: "doc-1", Title: "Test Document"},
+			Score:      0.95,
+			Highlights: []string{"This is a highlight snippet"},
+			Snippet:    "...the <b>quick</b>\n  brown &amp; <b>fox</b>",
+		},
+	}
+
+	err := outputSearchTable(rootCmd, results)
+
+	assert.NoError(t, err)
+	lines := strings.Split(buf.String(), "\n")
+	titleLine := -1
+	for i, line := range lines {
+		if strings.Contains(line, "Test Document") {
+			titleLine = i
+		}
+	}
+	require.GreaterOrEqual(t, titleLine, 0)
+	require.Greater(t, len(lines), titleLine+1)
+	assert.Equal(t, "      ...the *quick* brown & *fox*", lines[titleLine+1])
+	assert.NotContains(t, buf.String(), "This is a highlight snippet")
+}
+
 func TestOutputSearchTable_WithoutTitle(t *testing.T) {
 	cleanup := setupTestServices()
 	defer cleanup()
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// clearScreen moves the cursor home and clears the terminal.
+const clearScreen = "\x1b[H\x1b[2J"
+
+// searchWatchDebounce is how long watch mode waits after a query line for a
+// newer one before searching, so a burst of input only runs the last query.
+var searchWatchDebounce = 150 * time.Millisecond
+
+// runSearchWatch reads queries from stdin one per line and prints the
+// results of each, until end of input or an interrupt.
+func runSearchWatch(cmd *cobra.Command, opts domain.SearchOptions) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(cmd.InOrStdin())
+		for scanner.Scan() {
+			select {
+			case lines <- scanner.Text():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	clearOutput := isTerminalWriter(cmd.OutOrStdout())
+	debounce := time.NewTimer(searchWatchDebounce)
+	debounce.Stop()
+	var pending string
+
+	search := func() {
+		query := pending
+		pending = ""
+		if clearOutput {
+			cmd.Print(clearScreen)
+		}
+		results, err := searchService.Search(ctx, query, opts)
+		if err != nil {
+			cmd.Printf("Search for %q failed: %v\n", query, err)
+			return
+		}
+		if !searchJSON && !searchIncludeVectors {
+			cmd.Printf("Query: %s\n\n", query)
+		}
+		if err := outputSearchResults(cmd, results); err != nil {
+			cmd.Printf("Failed to print results: %v\n", err)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case line, ok := <-lines:
+			if !ok {
+				if pending != "" {
+					search()
+				}
+				return nil
+			}
+			if query := strings.TrimSpace(line); query != "" {
+				pending = query
+				debounce.Reset(searchWatchDebounce)
+			}
+		case <-debounce.C:
+			if pending != "" {
+				search()
+			}
+		}
+	}
+}
+
+// isTerminalWriter reports whether w is a terminal, so escape codes are
+// only written where they are interpreted.
+func isTerminalWriter(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
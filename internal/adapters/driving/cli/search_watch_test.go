@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// queryRecordingSearchService records the queries it was searched with.
+type queryRecordingSearchService struct {
+	mockSearchService
+	mu      sync.Mutex
+	queries []string
+}
+
+func (m *queryRecordingSearchService) Search(
+	ctx context.Context, query string, opts domain.SearchOptions,
+) ([]domain.SearchResult, error) {
+	m.mu.Lock()
+	m.queries = append(m.queries, query)
+	m.mu.Unlock()
+	return m.mockSearchService.Search(ctx, query, opts)
+}
+
+func runSearchWatchCmd(t *testing.T, stdin io.Reader, args ...string) (string, error) {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetIn(stdin)
+	rootCmd.SetArgs(append([]string{"search", "--watch"}, args...))
+	defer func() {
+		rootCmd.SetArgs(nil)
+		rootCmd.SetIn(nil)
+		searchWatch = false
+		searchJSON = false
+		searchLimit = 10
+	}()
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestSearchWatch_SearchesPipedQuery(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	recorder := &queryRecordingSearchService{}
+	searchService = recorder
+
+	out, err := runSearchWatchCmd(t, strings.NewReader("deployment steps\n"))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"deployment steps"}, recorder.queries)
+	assert.Contains(t, out, "Query: deployment steps")
+	assert.Contains(t, out, "Results:")
+	assert.NotContains(t, out, clearScreen)
+}
+
+func TestSearchWatch_DebouncesBurstOfLines(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	recorder := &queryRecordingSearchService{}
+	searchService = recorder
+
+	_, err := runSearchWatchCmd(t, strings.NewReader("dep\ndeploy\n\ndeployment\n"))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"deployment"}, recorder.queries)
+}
+
+func TestSearchWatch_SearchesEachSettledLine(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	recorder := &queryRecordingSearchService{}
+	searchService = recorder
+	oldDebounce := searchWatchDebounce
+	searchWatchDebounce = 10 * time.Millisecond
+	defer func() { searchWatchDebounce = oldDebounce }()
+
+	stdin, writer := io.Pipe()
+	go func() {
+		_, _ = io.WriteString(writer, "first\n")
+		time.Sleep(100 * time.Millisecond)
+		_, _ = io.WriteString(writer, "second\n")
+		_ = writer.Close()
+	}()
+
+	_, err := runSearchWatchCmd(t, stdin)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first", "second"}, recorder.queries)
+}
+
+func TestSearchWatch_JSONAndLimit(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	recorder := &recordingSearchService{}
+	searchService = recorder
+
+	out, err := runSearchWatchCmd(t, strings.NewReader("query\n"), "--json", "-n", "3")
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, recorder.opts.Limit)
+	assert.Contains(t, out, "\"Score\"")
+	assert.NotContains(t, out, "Query:")
+}
+
+func TestSearchWatch_EmptyInput(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+	recorder := &queryRecordingSearchService{}
+	searchService = recorder
+
+	_, err := runSearchWatchCmd(t, strings.NewReader(""))
+
+	require.NoError(t, err)
+	assert.Empty(t, recorder.queries)
+}
+
+func TestSearchWatch_RejectsQueryArgument(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	_, err := runSearchWatchCmd(t, strings.NewReader(""), "query")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown command")
+}
@@ -2,15 +2,20 @@ package cli
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/settingswizard"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
@@ -32,8 +37,16 @@ var settingsShowCmd = &cobra.Command{
 var settingsWizardCmd = &cobra.Command{
 	Use:   "wizard",
 	Short: "Interactive setup wizard",
-	Long:  `Run an interactive wizard to configure all settings step by step.`,
-	RunE:  runSettingsWizard,
+	Long: `Run an interactive wizard to configure the main settings step by step:
+
+  1. AI provider, checked with a test embedding call
+  2. Search mode
+  3. Document sync schedule
+  4. Theme
+
+Each step is pre-filled with the saved settings and only advances once its
+input is valid. When not run in a terminal, the wizard falls back to prompts.`,
+	RunE: runSettingsWizard,
 }
 
 var settingsModeCmd = &cobra.Command{
@@ -49,6 +62,106 @@ Available modes:
 	RunE: runSettingsMode,
 }
 
+var settingsCronCmd = &cobra.Command{
+	Use:   "cron [task-id] [expression|off]",
+	Short: "Set a cron schedule for a background task",
+	Long: `Set a standard 5-field cron expression (minute hour day-of-month month
+day-of-week) for a built-in scheduler task, evaluated in local time.
+A cron schedule takes precedence over the task's interval. Use "off" to
+return to the interval.
+
+Tasks:
+  document-sync        - Sync all sources without their own schedule
+  oauth-refresh        - Refresh OAuth tokens
+  maintenance-compact  - Remove orphaned data and compact the stores
+
+Examples:
+  sercha settings cron document-sync "0 6 * * 1-5"   Every weekday at 6am
+  sercha settings cron document-sync off
+  sercha settings cron maintenance-compact "0 3 * * 0"  Sundays at 3am`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: runSettingsCron,
+}
+
+var settingsSetCmd = &cobra.Command{
+	Use:   "set [key] [value]",
+	Short: "Set a single setting",
+	Long: `Set a single setting by name.
+
+Keys:
+  bm25_k1  - BM25 term frequency saturation for keyword search (default 1.0).
+             Higher values reward repeated query terms more; 0 ignores them.
+  bm25_b   - BM25 length normalisation, from 0 to 1 (default 0.5).
+             Higher values favour shorter chunks.
+  language - ISO 639-1 code of the stemming language (default en), or
+             "none" to disable stemming. Used for documents without a
+             detected language and for queries without --lang. Run
+             "sercha index rebuild" to re-stem indexed documents.
+  min_similarity - Cosine similarity, from 0 to 1, below which semantic
+             results are dropped before they are combined with keyword
+             results (default 0, keep all). Overridden per query with
+             "sercha search --min-similarity".
+  query_expansion - Whether the LLM rewrites each query into alternative
+             phrasings whose embeddings are averaged for semantic search
+             (default false). Improves recall for short queries at the
+             cost of an LLM call per search. Keyword search is unchanged.
+  chunk_strategy - How documents are split before indexing and embedding:
+             fixed (character windows), sentence (whole sentences) or
+             heading (sections of markdown/HTML documents). Default fixed.
+  chunk_size - Maximum characters per chunk (default 1000).
+  chunk_overlap - Characters repeated between chunks (default 200).
+  embedding_max_tokens - Most tokens the embedding model accepts in one
+             input (default 2048). Longer chunks are split into pieces
+             that fit, with a warning, instead of failing to embed.
+  embedding_batch_size - Most texts sent to the embedding model in one
+             request during sync (default 32).
+  embedding_model_overrides - Comma-separated key=model entries selecting
+             another model of the embedding provider for the documents of
+             a connector type (e.g. github) or MIME type prefix (e.g.
+             text/x-go). Empty clears them. The vectors of documents a
+             change moves to another model are removed; run "sercha index
+             rebuild --model <model>" to re-embed them.
+  max_context_tokens - LLM context window in tokens (default 8192).
+             Retrieved chunks sent to the LLM are trimmed, lowest score
+             first, to fit.
+  answer_reserve_tokens - Tokens of the context window kept free for
+             the LLM's answer (default 1024).
+  theme    - TUI colour theme: dark, light, solarized-dark, gruvbox,
+             auto to match the terminal background, or the path to a
+             YAML theme file (default auto).
+  keybindings - Semicolon-separated action=keys entries remapping TUI
+             keys, with keys separated by spaces (e.g. "down=down ctrl+n").
+             Actions: help, search, ask, up, down, select, new_search,
+             actions, preview, forget_search. Empty restores the defaults.
+  locale   - Language tag (e.g. en-GB) connectors send as Accept-Language,
+             so Microsoft 365, Google, Notion, Slack, Trello, YouTube and
+             websites return content in one language. A source's own
+             locale takes precedence. Empty sends none.
+  trace_endpoint - OTLP/HTTP endpoint OpenTelemetry traces of syncs and
+             searches are exported to (e.g. http://localhost:4318).
+             Empty disables tracing. Overridden by --trace-endpoint.
+
+Chunking changes apply to documents synced afterwards; run
+"sercha index rebuild" to re-chunk existing documents.
+
+Examples:
+  sercha settings set bm25_k1 1.2
+  sercha settings set bm25_b 0.75
+  sercha settings set language fr
+  sercha settings set min_similarity 0.3
+  sercha settings set query_expansion true
+  sercha settings set chunk_strategy heading
+  sercha settings set embedding_max_tokens 8192
+  sercha settings set embedding_model_overrides github=nomic-embed-code,text/x-go=nomic-embed-code
+  sercha settings set max_context_tokens 128000
+  sercha settings set theme ~/.config/sercha/theme.yaml
+  sercha settings set keybindings "up=up ctrl+p; down=down ctrl+n"
+  sercha settings set locale en-GB
+  sercha settings set trace_endpoint http://localhost:4318`,
+	Args: cobra.ExactArgs(2),
+	RunE: runSettingsSet,
+}
+
 var settingsEmbeddingCmd = &cobra.Command{
 	Use:   "embedding",
 	Short: "Configure embedding provider",
@@ -67,6 +180,8 @@ func init() {
 	settingsCmd.AddCommand(settingsShowCmd)
 	settingsCmd.AddCommand(settingsWizardCmd)
 	settingsCmd.AddCommand(settingsModeCmd)
+	settingsCmd.AddCommand(settingsCronCmd)
+	settingsCmd.AddCommand(settingsSetCmd)
 	settingsCmd.AddCommand(settingsEmbeddingCmd)
 	settingsCmd.AddCommand(settingsLLMCmd)
 	rootCmd.AddCommand(settingsCmd)
@@ -89,15 +204,27 @@ func runSettingsShow(cmd *cobra.Command, _ []string) error {
 	// Search settings
 	cmd.Println("[Search]")
 	cmd.Printf("  Mode: %s\n", settings.Search.Mode.Description())
+	cmd.Printf("  BM25: k1=%g, b=%g\n", settings.Search.BM25K1, settings.Search.BM25B)
+	cmd.Printf("  Language: %s\n", settings.DefaultSearchLanguage)
+	cmd.Printf("  Min similarity: %g\n", settings.Search.MinSimilarity)
+	cmd.Printf("  Query expansion: %t\n", settings.Search.QueryExpansion)
 	cmd.Println()
 
 	// Embedding settings
 	cmd.Println("[Embedding]")
 	cmd.Printf("  Provider: %s\n", settings.Embedding.Provider.Description())
 	cmd.Printf("  Model: %s\n", settings.Embedding.Model)
-	if settings.Embedding.Provider.IsLocal() {
+	if settings.Embedding.Provider.IsLocal() || settings.Embedding.Provider.RequiresEndpoint() {
 		cmd.Printf("  Base URL: %s\n", settings.Embedding.BaseURL)
 	}
+	if settings.Embedding.Provider.RequiresEndpoint() {
+		cmd.Printf("  Dimensions: %d\n", settings.Embedding.Dimensions)
+	}
+	cmd.Printf("  Max tokens: %d\n", settings.Embedding.MaxTokens)
+	cmd.Printf("  Batch size: %d\n", settings.Embedding.BatchSize)
+	for _, override := range domain.FormatModelOverrides(settings.Embedding.ModelOverrides) {
+		cmd.Printf("  Model override: %s\n", override)
+	}
 	if settings.Embedding.Provider.RequiresAPIKey() {
 		if settings.Embedding.APIKey != "" {
 			cmd.Printf("  API Key: %s\n", maskAPIKey(settings.Embedding.APIKey))
@@ -126,6 +253,8 @@ func runSettingsShow(cmd *cobra.Command, _ []string) error {
 			cmd.Printf("  API Key: (not set)\n")
 		}
 	}
+	cmd.Printf("  Context budget: %d tokens (%d reserved for answers)\n",
+		settings.LLM.MaxContextTokens, settings.LLM.AnswerReserveTokens)
 	status = "configured"
 	if !settings.LLM.IsConfigured() {
 		status = "not configured"
@@ -143,6 +272,39 @@ func runSettingsShow(cmd *cobra.Command, _ []string) error {
 	}
 	cmd.Println()
 
+	// Chunking settings
+	cmd.Println("[Chunking]")
+	cmd.Printf("  Strategy: %s\n", settings.Chunking.Strategy.Description())
+	cmd.Printf("  Chunk size: %d\n", settings.Chunking.ChunkSize)
+	cmd.Printf("  Overlap: %d\n", settings.Chunking.Overlap)
+	cmd.Println()
+
+	// Interface settings
+	cmd.Println("[Interface]")
+	cmd.Printf("  Theme: %s\n", settings.UI.Theme)
+	for _, binding := range domain.FormatKeyBindings(settings.UI.KeyBindings) {
+		cmd.Printf("  Key binding: %s\n", binding)
+	}
+	cmd.Println()
+
+	// Connector settings
+	cmd.Println("[Connectors]")
+	if settings.Locale != "" {
+		cmd.Printf("  Locale: %s\n", settings.Locale)
+	} else {
+		cmd.Printf("  Locale: (not set)\n")
+	}
+	cmd.Println()
+
+	// Telemetry settings
+	cmd.Println("[Telemetry]")
+	if settings.OpenTelemetryEndpoint != "" {
+		cmd.Printf("  Trace endpoint: %s\n", settings.OpenTelemetryEndpoint)
+	} else {
+		cmd.Printf("  Trace endpoint: (not set)\n")
+	}
+	cmd.Println()
+
 	// Validation
 	if err := settingsService.Validate(); err != nil {
 		cmd.Printf("Warning: %v\n", err)
@@ -159,6 +321,42 @@ func runSettingsWizard(cmd *cobra.Command, _ []string) error {
 		return errors.New("settings service not configured")
 	}
 
+	if term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd())) {
+		return runSettingsWizardTUI(cmd)
+	}
+	return runSettingsWizardPrompts(cmd)
+}
+
+// runSettingsWizardTUI runs the interactive wizard, which validates each
+// step before moving on.
+func runSettingsWizardTUI(cmd *cobra.Command) error {
+	if _, err := settingsService.Get(); err != nil {
+		return fmt.Errorf("failed to get settings: %w", err)
+	}
+
+	var theme *styles.Theme
+	if tuiConfig != nil {
+		theme = tuiConfig.Theme
+	}
+	wizard := settingswizard.NewModel(styles.NewStyles(theme), settingsService)
+	if tuiConfig != nil && tuiConfig.KeyMap != nil {
+		wizard.SetKeyMap(tuiConfig.KeyMap)
+	}
+
+	if _, err := tea.NewProgram(wizard, tea.WithAltScreen()).Run(); err != nil {
+		return fmt.Errorf("settings wizard error: %w", err)
+	}
+	if wizard.Completed() {
+		cmd.Println("Settings saved.")
+	} else {
+		cmd.Println("Settings wizard cancelled. Steps already completed were saved.")
+	}
+	return nil
+}
+
+// runSettingsWizardPrompts runs the wizard as line-based prompts, for when
+// stdin or stdout is not a terminal.
+func runSettingsWizardPrompts(cmd *cobra.Command) error {
 	cmd.Println("Sercha Settings Wizard")
 	cmd.Println("======================")
 	cmd.Println()
@@ -213,6 +411,14 @@ func runSettingsWizard(cmd *cobra.Command, _ []string) error {
 		cmd.Println("------------------------------")
 		cmd.Println("Not required for current search mode.")
 	}
+	cmd.Println()
+
+	// Step 4: Theme
+	cmd.Println("Step 4: Select Theme")
+	cmd.Println("--------------------")
+	if err := configureTheme(cmd, reader); err != nil {
+		return err
+	}
 
 	// Final validation
 	cmd.Println("Configuration Complete!")
@@ -226,6 +432,96 @@ func runSettingsWizard(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
+func runSettingsCron(cmd *cobra.Command, args []string) error {
+	if settingsService == nil {
+		return errors.New("settings service not configured")
+	}
+
+	taskID := args[0]
+	expr := strings.Join(args[1:], " ")
+	if strings.EqualFold(expr, "off") {
+		expr = ""
+	}
+
+	if err := settingsService.SetSchedulerCron(taskID, expr); err != nil {
+		return fmt.Errorf("failed to set cron schedule: %w", err)
+	}
+
+	if expr == "" {
+		cmd.Printf("Task %s now runs on its interval.\n", taskID)
+	} else {
+		cmd.Printf("Task %s will run on cron schedule %q\n", taskID, expr)
+	}
+	return nil
+}
+
+func runSettingsSet(cmd *cobra.Command, args []string) error {
+	if settingsService == nil {
+		return errors.New("settings service not configured")
+	}
+
+	key, value := args[0], args[1]
+	if key == "keybindings" {
+		// Check the bindings now rather than when the TUI next starts
+		if err := validateKeyBindings(value); err != nil {
+			return fmt.Errorf("failed to set %s: %w", key, err)
+		}
+	}
+	var oldOverrides map[string]string
+	if key == "embedding_model_overrides" {
+		settings, err := settingsService.Get()
+		if err != nil {
+			return fmt.Errorf("failed to get settings: %w", err)
+		}
+		oldOverrides = settings.Embedding.ModelOverrides
+	}
+	if err := settingsService.Set(key, value); err != nil {
+		return fmt.Errorf("failed to set %s: %w", key, err)
+	}
+
+	cmd.Printf("Set %s to %s\n", key, value)
+	if key == "embedding_model_overrides" {
+		return deleteReassignedVectors(cmd, oldOverrides)
+	}
+	return nil
+}
+
+// deleteReassignedVectors deletes the vectors of documents that the new
+// embedding model overrides assign to a different model, since they no
+// longer match the model their queries are embedded with.
+func deleteReassignedVectors(cmd *cobra.Command, oldOverrides map[string]string) error {
+	if rebuildService == nil {
+		return nil
+	}
+	settings, err := settingsService.Get()
+	if err != nil {
+		return fmt.Errorf("failed to get settings: %w", err)
+	}
+
+	reassigned, err := rebuildService.DeleteReassignedVectors(
+		context.Background(), oldOverrides, settings.Embedding.ModelOverrides)
+	if err != nil {
+		return fmt.Errorf("failed to delete vectors of reassigned documents: %w", err)
+	}
+	if reassigned > 0 {
+		cmd.Printf("Removed the vectors of %d document(s) now embedded by a different model.\n", reassigned)
+		cmd.Println("Run 'sercha index rebuild --model <model>' for each model they moved to, " +
+			"or 'sercha index rebuild --vectors-only', to embed them again.")
+	}
+	return nil
+}
+
+// validateKeyBindings checks that value remaps TUI actions to valid keys
+// without conflicts.
+func validateKeyBindings(value string) error {
+	bindings, err := domain.ParseKeyBindings(strings.Split(value, ";"))
+	if err != nil {
+		return err
+	}
+	_, err = keymap.Load(bindings)
+	return err
+}
+
 func runSettingsMode(cmd *cobra.Command, _ []string) error {
 	if settingsService == nil {
 		return errors.New("settings service not configured")
@@ -322,9 +618,37 @@ func configureEmbeddingProvider(cmd *cobra.Command, reader *bufio.Reader) error
 		}
 	}
 
+	// Endpoint providers need a base URL and the model's dimensions
+	var baseURL string
+	var dimensions int
+	if selectedProvider.RequiresEndpoint() {
+		if model == "" {
+			return errors.New("model name is required for this provider")
+		}
+		cmd.Print("Enter base URL (e.g. http://localhost:1234/v1): ")
+		baseURL = readLine(reader)
+		if err := domain.ValidateEndpointURL(baseURL); err != nil {
+			return err
+		}
+		cmd.Print("Enter API key (leave empty if none): ")
+		apiKey = readPassword()
+		cmd.Println()
+		cmd.Print("Enter vector dimensions: ")
+		dims, err := strconv.Atoi(readLine(reader))
+		if err != nil || dims <= 0 {
+			return errors.New("vector dimensions must be a positive number")
+		}
+		dimensions = dims
+	}
+
 	if err := settingsService.SetEmbeddingProvider(selectedProvider, model, apiKey); err != nil {
 		return fmt.Errorf("failed to configure embedding provider: %w", err)
 	}
+	if selectedProvider.RequiresEndpoint() {
+		if err := settingsService.SetEmbeddingEndpoint(baseURL, dimensions); err != nil {
+			return fmt.Errorf("failed to configure embedding endpoint: %w", err)
+		}
+	}
 
 	// Validate the configuration by pinging the service
 	cmd.Print("Validating configuration... ")
@@ -386,6 +710,51 @@ func configureLLMProvider(cmd *cobra.Command, reader *bufio.Reader) error {
 	return nil
 }
 
+// configureTheme lets the user pick a TUI theme, previewing the choice
+// before it is saved.
+func configureTheme(cmd *cobra.Command, reader *bufio.Reader) error {
+	settings, err := settingsService.Get()
+	if err != nil {
+		return fmt.Errorf("failed to get settings: %w", err)
+	}
+
+	names := styles.ThemeNames()
+	defaultIdx := 1
+	for i, name := range names {
+		if strings.EqualFold(name, settings.UI.Theme) {
+			defaultIdx = i + 1
+		}
+		theme, _ := styles.NamedTheme(name)
+		cmd.Printf("  %d. %-15s %s\n", i+1, name, styles.NewStyles(theme).Preview())
+	}
+	cmd.Println(`Or enter "auto" to match the terminal background, or the path to a YAML theme file.`)
+
+	for {
+		cmd.Printf("\nEnter choice [%d]: ", defaultIdx)
+		value := readLine(reader)
+		if _, err := strconv.Atoi(value); err == nil || value == "" {
+			value = names[parseChoice(value, len(names), defaultIdx)-1]
+		}
+
+		theme, err := styles.LoadTheme(value)
+		if err != nil {
+			cmd.Printf("Error: %v\n", err)
+			continue
+		}
+		cmd.Printf("Preview: %s\n", styles.NewStyles(theme).Preview())
+		cmd.Print("Use this theme? [Y/n]: ")
+		if strings.EqualFold(readLine(reader), "n") {
+			continue
+		}
+
+		if err := settingsService.Set("theme", value); err != nil {
+			return fmt.Errorf("failed to set theme: %w", err)
+		}
+		cmd.Printf("Set theme to: %s\n\n", value)
+		return nil
+	}
+}
+
 // Helper functions.
 
 //nolint:errcheck // CLI helper, error ignored for UX
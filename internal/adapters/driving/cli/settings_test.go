@@ -1,9 +1,18 @@
 package cli
 
 import (
+	"bufio"
+	"bytes"
+	"strings"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
+	"github.com/custodia-labs/sercha-cli/internal/core/services"
 )
 
 // Test helper functions in settings.go
@@ -129,3 +138,232 @@ func TestParseChoice(t *testing.T) {
 		})
 	}
 }
+
+func runSettingsCronCmd(t *testing.T, store *memory.ConfigStore, args ...string) (string, error) {
+	t.Helper()
+	oldSettings := settingsService
+	settingsService = services.NewSettingsService(store, nil)
+	defer func() { settingsService = oldSettings }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"settings", "cron"}, args...))
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestSettingsCronCmd_Set(t *testing.T) {
+	store := memory.NewConfigStore()
+
+	out, err := runSettingsCronCmd(t, store, "document-sync", "0 6 * * 1-5")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, `will run on cron schedule "0 6 * * 1-5"`)
+	assert.Equal(t, "0 6 * * 1-5", store.GetString("scheduler.document_sync.cron"))
+}
+
+func TestSettingsCronCmd_Off(t *testing.T) {
+	store := memory.NewConfigStore()
+	_ = store.Set("scheduler.document_sync.cron", "@daily")
+
+	out, err := runSettingsCronCmd(t, store, "document-sync", "off")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "now runs on its interval")
+	assert.Empty(t, store.GetString("scheduler.document_sync.cron"))
+}
+
+func TestSettingsCronCmd_InvalidExpression(t *testing.T) {
+	store := memory.NewConfigStore()
+
+	_, err := runSettingsCronCmd(t, store, "document-sync", "every", "weekday")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to set cron schedule")
+	assert.Empty(t, store.GetString("scheduler.document_sync.cron"))
+}
+
+func runSettingsSetCmd(t *testing.T, store *memory.ConfigStore, args ...string) (string, error) {
+	t.Helper()
+	oldSettings := settingsService
+	settingsService = services.NewSettingsService(store, nil)
+	defer func() { settingsService = oldSettings }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"settings", "set"}, args...))
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestSettingsSetCmd_BM25(t *testing.T) {
+	store := memory.NewConfigStore()
+
+	out, err := runSettingsSetCmd(t, store, "bm25_k1", "1.2")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Set bm25_k1 to 1.2")
+
+	_, err = runSettingsSetCmd(t, store, "bm25_b", "0.75")
+	require.NoError(t, err)
+
+	assert.InDelta(t, 1.2, store.GetFloat("search.bm25_k1"), 1e-9)
+	assert.InDelta(t, 0.75, store.GetFloat("search.bm25_b"), 1e-9)
+}
+
+func TestSettingsSetCmd_ModelOverridesDeleteReassignedVectors(t *testing.T) {
+	store := memory.NewConfigStore()
+	_, err := runSettingsSetCmd(t, store, "embedding_model_overrides", "github=model-a")
+	require.NoError(t, err)
+
+	oldRebuild := rebuildService
+	svc := &mockRebuildService{reassigned: 4}
+	rebuildService = svc
+	defer func() { rebuildService = oldRebuild }()
+
+	out, err := runSettingsSetCmd(t, store, "embedding_model_overrides", "github=model-b")
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"github": "model-a"}, svc.oldOverrides)
+	assert.Equal(t, map[string]string{"github": "model-b"}, svc.newOverrides)
+	assert.Contains(t, out, "Removed the vectors of 4 document(s)")
+	assert.Contains(t, out, "sercha index rebuild --vectors-only")
+}
+
+func TestSettingsSetCmd_Language(t *testing.T) {
+	store := memory.NewConfigStore()
+
+	_, err := runSettingsSetCmd(t, store, "language", "fr")
+	require.NoError(t, err)
+
+	assert.Equal(t, "fr", store.GetString("search.language"))
+}
+
+func TestSettingsSetCmd_Chunking(t *testing.T) {
+	store := memory.NewConfigStore()
+
+	_, err := runSettingsSetCmd(t, store, "chunk_strategy", "sentence")
+	require.NoError(t, err)
+	_, err = runSettingsSetCmd(t, store, "chunk_size", "600")
+	require.NoError(t, err)
+
+	assert.Equal(t, "sentence", store.GetString("pipeline.chunker.strategy"))
+	assert.Equal(t, 600, store.GetInt("pipeline.chunker.chunk_size"))
+}
+
+func TestSettingsSetCmd_ContextBudget(t *testing.T) {
+	store := memory.NewConfigStore()
+
+	_, err := runSettingsSetCmd(t, store, "max_context_tokens", "32000")
+	require.NoError(t, err)
+	_, err = runSettingsSetCmd(t, store, "answer_reserve_tokens", "2048")
+	require.NoError(t, err)
+
+	assert.Equal(t, 32000, store.GetInt("llm.max_context_tokens"))
+	assert.Equal(t, 2048, store.GetInt("llm.answer_reserve_tokens"))
+}
+
+func TestSettingsSetCmd_InvalidValue(t *testing.T) {
+	store := memory.NewConfigStore()
+
+	_, err := runSettingsSetCmd(t, store, "bm25_b", "1.5")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to set bm25_b")
+	_, exists := store.Get("search.bm25_b")
+	assert.False(t, exists)
+}
+
+func TestSettingsSetCmd_UnknownKey(t *testing.T) {
+	_, err := runSettingsSetCmd(t, memory.NewConfigStore(), "colour", "blue")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown setting "colour"`)
+}
+
+func TestSettingsSetCmd_Theme(t *testing.T) {
+	store := memory.NewConfigStore()
+
+	_, err := runSettingsSetCmd(t, store, "theme", "gruvbox")
+	require.NoError(t, err)
+
+	assert.Equal(t, "gruvbox", store.GetString("ui.theme"))
+}
+
+func TestSettingsSetCmd_KeyBindings(t *testing.T) {
+	store := memory.NewConfigStore()
+
+	_, err := runSettingsSetCmd(t, store, "keybindings", "down=down ctrl+n")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"down=down ctrl+n"}, store.GetStringSlice("ui.keybindings"))
+}
+
+func TestSettingsSetCmd_KeyBindingsConflict(t *testing.T) {
+	store := memory.NewConfigStore()
+
+	_, err := runSettingsSetCmd(t, store, "keybindings", "down=k")
+
+	require.ErrorIs(t, err, keymap.ErrKeyConflict)
+	assert.Empty(t, store.GetStringSlice("ui.keybindings"))
+}
+
+func runConfigureTheme(t *testing.T, store *memory.ConfigStore, input string) (string, error) {
+	t.Helper()
+	oldSettings := settingsService
+	settingsService = services.NewSettingsService(store, nil)
+	defer func() { settingsService = oldSettings }()
+
+	buf := new(bytes.Buffer)
+	cmd := &cobra.Command{}
+	cmd.SetOut(buf)
+
+	err := configureTheme(cmd, bufio.NewReader(strings.NewReader(input)))
+	return buf.String(), err
+}
+
+func TestConfigureTheme_SelectsByNumber(t *testing.T) {
+	store := memory.NewConfigStore()
+
+	out, err := runConfigureTheme(t, store, "2\n\n")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "solarized-dark")
+	assert.Contains(t, out, "Preview:")
+	assert.Equal(t, "light", store.GetString("ui.theme"))
+}
+
+func TestConfigureTheme_DefaultsToCurrent(t *testing.T) {
+	store := memory.NewConfigStore()
+	_ = store.Set("ui.theme", "gruvbox")
+
+	out, err := runConfigureTheme(t, store, "\ny\n")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Enter choice [4]")
+	assert.Equal(t, "gruvbox", store.GetString("ui.theme"))
+}
+
+func TestConfigureTheme_RejectedPreviewAsksAgain(t *testing.T) {
+	store := memory.NewConfigStore()
+
+	_, err := runConfigureTheme(t, store, "2\nn\n3\ny\n")
+
+	require.NoError(t, err)
+	assert.Equal(t, "solarized-dark", store.GetString("ui.theme"))
+}
+
+func TestConfigureTheme_UnknownTheme(t *testing.T) {
+	store := memory.NewConfigStore()
+
+	out, err := runConfigureTheme(t, store, "neon\n1\n\n")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, `unknown theme "neon"`)
+	assert.Equal(t, "dark", store.GetString("ui.theme"))
+}
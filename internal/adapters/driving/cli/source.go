@@ -3,6 +3,7 @@ package cli
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -46,10 +47,29 @@ Examples:
   # Non-interactive: GitHub source with OAuth
   sercha source add github --auth <auth-id> -c content_types=files,issues
 
+  # Non-interactive: OAuth on a headless machine, e.g. over SSH
+  sercha source add gmail --auth <auth-id> --device
+
   # Specify auth method explicitly (for connectors supporting both)
-  sercha source add github --auth-method token --token ghp_xxx -c content_types=files`,
-	Args: cobra.MaximumNArgs(1),
-	RunE: runSourceAdd,
+  sercha source add github --auth-method token --token ghp_xxx -c content_types=files
+
+Any source can override the global post-processor pipeline with comma-separated
+processor names: -c pipeline=<list> replaces it, -c pipeline_add=<list> appends
+to it and -c pipeline_remove=<list> removes from it.
+
+  # Personal notes: skip near-duplicate detection for this source only
+  sercha source add filesystem -c path=/Users/me/Notes -c pipeline_remove=dedup
+
+Any source can also skip paths matching glob patterns with --exclude, which
+can be repeated. Filesystem sources match patterns against paths relative to
+the directory, or their final element; other sources match document paths,
+titles and URIs.
+
+  # Skip dependencies and logs
+  sercha source add filesystem -c path=/Users/me/Code --exclude node_modules --exclude "*.log"`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeConnectorTypes,
+	RunE:              runSourceAdd,
 }
 
 var sourceListCmd = &cobra.Command{
@@ -59,10 +79,95 @@ var sourceListCmd = &cobra.Command{
 }
 
 var sourceRemoveCmd = &cobra.Command{
-	Use:   "remove [source-id]",
-	Short: "Remove a document source",
-	Args:  cobra.ExactArgs(1),
-	RunE:  runSourceRemove,
+	Use:               "remove [source-id]",
+	Short:             "Remove a document source",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeSourceIDs,
+	RunE:              runSourceRemove,
+}
+
+var sourceRenameCmd = &cobra.Command{
+	Use:               "rename [source-id] [new-name]",
+	Short:             "Rename a document source",
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: completeSourceIDs,
+	RunE:              runSourceRename,
+}
+
+var sourceScheduleCmd = &cobra.Command{
+	Use:   "schedule [source-id] [interval|cron|off]",
+	Short: "Show or set a source's sync schedule",
+	Long: `Show or set when a source is synced by the background scheduler.
+
+Intervals use Go duration syntax (e.g., 5m, 1h, 90m). Anything else is read
+as a standard 5-field cron expression (minute hour day-of-month month
+day-of-week), evaluated in local time. Sources without a schedule are synced
+by the global document sync task. Use "off" to remove a source's schedule
+and fall back to the global default.
+
+Examples:
+  sercha source schedule <id>                 Show the current schedule
+  sercha source schedule <id> 5m              Sync every five minutes
+  sercha source schedule <id> "0 6 * * 1-5"   Sync every weekday at 6am
+  sercha source schedule <id> off             Use the global default`,
+	Args:              cobra.MinimumNArgs(1),
+	ValidArgsFunction: completeSourceIDs,
+	RunE:              runSourceSchedule,
+}
+
+var sourceCloneCmd = &cobra.Command{
+	Use:   "clone [source-id]",
+	Short: "Duplicate a source with optional config overrides",
+	Long: `Create a new source with the same connector type, config and
+authentication as an existing one, overriding config values with -c flags.
+
+The clone shares the original's OAuth app and credentials rather than
+copying them, so re-authenticating either source updates both. Its name is
+derived from the overridden config when the original's name came from its
+config, e.g. a filesystem path; otherwise use --name.
+
+Examples:
+  sercha source clone <source-id> -c path=/Users/me/Projects
+  sercha source clone <source-id> -c owner=acme -c repo=api --name "Acme API"`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeSourceIDs,
+	RunE:              runSourceClone,
+}
+
+var sourceTestCmd = &cobra.Command{
+	Use:     "test [source-id]",
+	Aliases: []string{"validate"},
+	Short:   "Validate a source's auth and config without syncing",
+	Long: `Validate a source's authentication and configuration without syncing it.
+
+The source's connector is created and validated, e.g. checking that a
+filesystem path exists or that credentials are accepted by the provider.
+The command exits with a non-zero status if validation fails.
+
+Examples:
+  sercha source test <source-id>`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeSourceIDs,
+	SilenceUsage:      true,
+	RunE:              runSourceTest,
+}
+
+var sourceReauthCmd = &cobra.Command{
+	Use:   "reauth [source-id]",
+	Short: "Re-run OAuth authorization for an existing source",
+	Long: `Re-run the OAuth authorization flow for an existing source.
+
+The source keeps its configuration, sync state and indexed documents; only
+its credentials are replaced. The source's OAuth app configuration is reused
+and the account identifier is refreshed from the provider. Use this when a
+token has been revoked or to switch the source to a different account.
+
+Examples:
+  sercha source reauth <source-id>
+  sercha source reauth <source-id> --device`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeSourceIDs,
+	RunE:              runSourceReauth,
 }
 
 var connectorCmd = &cobra.Command{
@@ -74,7 +179,22 @@ var connectorCmd = &cobra.Command{
 var connectorListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List available connector types",
-	RunE:  runConnectorList,
+	Long: `List the available connector types and their configuration keys.
+
+With --json-schema, prints a JSON object mapping each connector ID to a
+JSON Schema (draft-07) for its configuration, suitable for generating and
+validating config forms.`,
+	RunE: runConnectorList,
+}
+
+var connectorCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Check every connector MIME type has a normaliser",
+	Long: `Cross-reference the MIME types each connector can emit with the
+registered normalisers and report any type that would fail to normalise.
+
+Exits with an error if any emitted type is unhandled.`,
+	RunE: runConnectorCheck,
 }
 
 // Flags for source add.
@@ -84,8 +204,13 @@ var (
 	sourceAuth       string // --auth flag for AuthProvider ID
 	sourceToken      string
 	sourceAuthMethod string
+	sourceDevice     bool
+	sourceExclude    []string
 )
 
+// connectorListJSONSchema is the --json-schema flag for connector list.
+var connectorListJSONSchema bool
+
 // authSelectionResult holds the result of auth selection for the new system.
 // Credentials are NOT saved yet - they will be saved after the source is created.
 type authSelectionResult struct {
@@ -117,16 +242,43 @@ func init() {
 	sourceAddCmd.Flags().StringVar(
 		&sourceAuthMethod, "auth-method", "",
 		"Authentication method: 'token' or 'oauth' (for connectors supporting both)")
+	// Only fails for a flag that does not exist
+	_ = sourceAddCmd.RegisterFlagCompletionFunc("auth-method",
+		cobra.FixedCompletions(authMethodCompletions, cobra.ShellCompDirectiveNoFileComp))
+	sourceAddCmd.Flags().BoolVar(
+		&sourceDevice, "device", false,
+		"Authorize OAuth with a device code instead of a browser (for headless machines)")
 	sourceAddCmd.Flags().StringArrayVarP(
 		&sourceConfig, "config", "c", nil,
 		"Configuration key=value pairs (can be repeated)")
+	sourceAddCmd.Flags().StringArrayVar(
+		&sourceExclude, "exclude", nil,
+		"Glob pattern for paths to skip during sync (can be repeated)")
 	sourceCmd.AddCommand(sourceAddCmd)
 	sourceCmd.AddCommand(sourceListCmd)
 	sourceCmd.AddCommand(sourceRemoveCmd)
+	sourceCmd.AddCommand(sourceRenameCmd)
+	sourceCmd.AddCommand(sourceScheduleCmd)
+	sourceCloneCmd.Flags().StringVar(
+		&sourceName, "name", "",
+		"Name for the clone (defaults to a name derived from config)")
+	sourceCloneCmd.Flags().StringArrayVarP(
+		&sourceConfig, "config", "c", nil,
+		"Configuration key=value pairs overriding the original's (can be repeated)")
+	sourceCmd.AddCommand(sourceCloneCmd)
+	sourceReauthCmd.Flags().BoolVar(
+		&sourceDevice, "device", false,
+		"Authorize with a device code instead of a browser (for headless machines)")
+	sourceCmd.AddCommand(sourceTestCmd)
+	sourceCmd.AddCommand(sourceReauthCmd)
 	rootCmd.AddCommand(sourceCmd)
 
 	// Connector commands
+	connectorListCmd.Flags().BoolVar(
+		&connectorListJSONSchema, "json-schema", false,
+		"Output a JSON Schema (draft-07) for each connector's configuration")
 	connectorCmd.AddCommand(connectorListCmd)
+	connectorCmd.AddCommand(connectorCheckCmd)
 	rootCmd.AddCommand(connectorCmd)
 }
 
@@ -136,6 +288,9 @@ func runConnectorList(cmd *cobra.Command, _ []string) error {
 	}
 
 	connectors := connectorRegistry.List()
+	if connectorListJSONSchema {
+		return outputConnectorSchemas(cmd, connectors)
+	}
 	if len(connectors) == 0 {
 		cmd.Println("No connectors available.")
 		return nil
@@ -174,6 +329,41 @@ func runConnectorList(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
+// outputConnectorSchemas prints each connector's config schema keyed by ID.
+func outputConnectorSchemas(cmd *cobra.Command, connectors []domain.ConnectorType) error {
+	schemas := make(map[string]any, len(connectors))
+	for i := range connectors {
+		schemas[connectors[i].ID] = connectors[i].ConfigSchema()
+	}
+
+	data, err := json.MarshalIndent(schemas, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal schemas: %w", err)
+	}
+	cmd.Println(string(data))
+	return nil
+}
+
+func runConnectorCheck(cmd *cobra.Command, _ []string) error {
+	if connectorRegistry == nil {
+		return errors.New("connector registry not configured")
+	}
+
+	unhandled := connectorRegistry.UnhandledMIMETypes()
+	if len(unhandled) == 0 {
+		cmd.Println("All connector MIME types have a registered normaliser.")
+		return nil
+	}
+
+	cmd.Println("MIME types with no registered normaliser:")
+	cmd.Println()
+	for _, u := range unhandled {
+		cmd.Printf("  %s: %s\n", u.ConnectorType, u.MIMEType)
+	}
+	cmd.Println()
+	return fmt.Errorf("%d unhandled MIME type(s)", len(unhandled))
+}
+
 //nolint:gocognit,errcheck,gocyclo,funlen // CLI interactive flow with intentional error ignoring for UX
 func runSourceAdd(cmd *cobra.Command, args []string) error {
 	if sourceService == nil {
@@ -186,14 +376,13 @@ func runSourceAdd(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
 	// Parse config flags into map
-	configFromFlags := make(map[string]string)
-	for _, kv := range sourceConfig {
-		parts := strings.SplitN(kv, "=", 2)
-		if len(parts) == 2 {
-			configFromFlags[parts[0]] = parts[1]
-		} else {
-			return fmt.Errorf("invalid config format: %s (expected key=value)", kv)
-		}
+	configFromFlags, err := parseConfigFlags(sourceConfig)
+	if err != nil {
+		return err
+	}
+	excludePatterns, err := exclusionPatternFlags(configFromFlags, sourceExclude)
+	if err != nil {
+		return err
 	}
 
 	// Determine if running non-interactively (connector type provided as arg)
@@ -231,7 +420,8 @@ func runSourceAdd(cmd *cobra.Command, args []string) error {
 	sourceID := uuid.New().String()
 
 	// Handle authentication using new AuthProvider/Credentials system
-	authResult, err := selectAuthWithNewSystem(ctx, cmd, connector, sourceID, isNonInteractive)
+	authResult, err := selectAuthWithNewSystem(
+		ctx, cmd, connector, sourceID, sourceToken, sourceAuth, sourceAuthMethod, isNonInteractive)
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
@@ -275,18 +465,30 @@ func runSourceAdd(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Pipeline overrides apply to every connector type
+	for _, key := range domain.PipelineConfigKeys {
+		if val, ok := configFromFlags[key]; ok {
+			config[key] = val
+		}
+	}
+
+	// So do exclusion patterns
+	if len(excludePatterns) == 0 && !isNonInteractive {
+		cmd.Print("Exclusion patterns (optional, comma-separated globs): ")
+		input, _ := reader.ReadString('\n')
+		excludePatterns = domain.ParseExclusionPatterns(input)
+		if err := domain.ValidateExclusionPatterns(excludePatterns); err != nil {
+			return err
+		}
+	}
+	if len(excludePatterns) > 0 {
+		config[domain.ConfigKeyExcludePatterns] = domain.FormatExclusionPatterns(excludePatterns)
+	}
+
 	// Generate name (use account identifier if available for clarity)
 	name := sourceName
-	//nolint:nestif // Intentional nesting for name derivation logic
 	if name == "" {
-		name = connector.Name
-		if val, ok := config["path"]; ok {
-			name = val
-		} else if val, ok := config["owner"]; ok {
-			if repo, ok := config["repo"]; ok {
-				name = val + "/" + repo
-			}
-		}
+		name = configSourceName(connector.Name, config)
 		// Append account identifier for OAuth sources
 		if authResult.AccountIdentifier != "" {
 			name = fmt.Sprintf("%s (%s)", name, authResult.AccountIdentifier)
@@ -304,17 +506,77 @@ func runSourceAdd(cmd *cobra.Command, args []string) error {
 		// CredentialsID will be set after credentials are saved
 	}
 
+	if err := addSourceWithCredentials(ctx, cmd, source, authResult); err != nil {
+		return err
+	}
+
+	cmd.Printf("Added source: %s (%s)\n", sourceID, connector.Name)
+	if authResult.AuthProviderID != "" {
+		cmd.Printf("Using OAuth app: %s\n", authResult.AuthProviderID)
+	}
+	if authResult.AccountIdentifier != "" {
+		cmd.Printf("Account: %s\n", authResult.AccountIdentifier)
+	}
+	return nil
+}
+
+// parseConfigFlags parses repeated -c key=value flags into a map.
+func parseConfigFlags(flags []string) (map[string]string, error) {
+	config := make(map[string]string)
+	for _, kv := range flags {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid config format: %s (expected key=value)", kv)
+		}
+		config[key] = value
+	}
+	return config, nil
+}
+
+// exclusionPatternFlags collects exclusion patterns from -c exclude_patterns
+// and repeated --exclude flags, each of which may list several patterns
+// separated by commas.
+func exclusionPatternFlags(config map[string]string, flags []string) ([]string, error) {
+	patterns := domain.ParseExclusionPatterns(config[domain.ConfigKeyExcludePatterns])
+	for _, flag := range flags {
+		patterns = append(patterns, domain.ParseExclusionPatterns(flag)...)
+	}
+	if err := domain.ValidateExclusionPatterns(patterns); err != nil {
+		return nil, err
+	}
+	return patterns, nil
+}
+
+// configSourceName derives a source name from its config: the directory
+// path, or owner/repo, falling back to the connector name.
+func configSourceName(connectorName string, config map[string]string) string {
+	if path, ok := config["path"]; ok {
+		return path
+	}
+	owner, hasOwner := config["owner"]
+	repo, hasRepo := config["repo"]
+	if hasOwner && hasRepo {
+		return owner + "/" + repo
+	}
+	return connectorName
+}
+
+// addSourceWithCredentials saves a new source, then its pending credentials
+// from authentication. The source must exist first because credentials
+// reference it; it is removed again if the credentials cannot be saved.
+func addSourceWithCredentials(
+	ctx context.Context, cmd *cobra.Command, source domain.Source, authResult *authSelectionResult,
+) error {
 	if err := sourceService.Add(ctx, source); err != nil {
 		return fmt.Errorf("failed to add source: %w", err)
 	}
 
 	// Now save credentials (if any) - source exists, FK constraint satisfied
-	var credentialsID string
 	if authResult.PendingCredentials != nil {
 		now := time.Now()
 		creds := domain.Credentials{
 			ID:                uuid.New().String(),
-			SourceID:          sourceID,
+			SourceID:          source.ID,
 			AccountIdentifier: authResult.AccountIdentifier,
 			OAuth:             authResult.PendingCredentials.OAuth,
 			PAT:               authResult.PendingCredentials.PAT,
@@ -323,26 +585,17 @@ func runSourceAdd(cmd *cobra.Command, args []string) error {
 		}
 		if err := credentialsService.Save(ctx, creds); err != nil {
 			// Rollback source creation
-			_ = sourceService.Remove(ctx, sourceID)
+			_ = sourceService.Remove(ctx, source.ID)
 			return fmt.Errorf("failed to save credentials: %w", err)
 		}
-		credentialsID = creds.ID
 
 		// Update source with credentials_id
-		source.CredentialsID = credentialsID
+		source.CredentialsID = creds.ID
 		if err := sourceService.Update(ctx, source); err != nil {
 			// Best effort - source exists but credentials_id not linked
 			cmd.Printf("Warning: failed to link credentials to source: %v\n", err)
 		}
 	}
-
-	cmd.Printf("Added source: %s (%s)\n", sourceID, connector.Name)
-	if authResult.AuthProviderID != "" {
-		cmd.Printf("Using OAuth app: %s\n", authResult.AuthProviderID)
-	}
-	if authResult.AccountIdentifier != "" {
-		cmd.Printf("Account: %s\n", authResult.AccountIdentifier)
-	}
 	return nil
 }
 
@@ -404,10 +657,303 @@ func runSourceRemove(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runSourceRename(cmd *cobra.Command, args []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+
+	sourceID, newName := args[0], args[1]
+	ctx := context.Background()
+
+	if err := sourceService.Rename(ctx, sourceID, newName); err != nil {
+		return fmt.Errorf("failed to rename source: %w", err)
+	}
+
+	cmd.Printf("Renamed source %s to %q\n", sourceID, strings.TrimSpace(newName))
+	return nil
+}
+
+func runSourceClone(cmd *cobra.Command, args []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+
+	overrides, err := parseConfigFlags(sourceConfig)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	original, err := sourceService.Get(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("source not found: %w", err)
+	}
+
+	config := make(map[string]string, len(original.Config)+len(overrides))
+	for k, v := range original.Config {
+		config[k] = v
+	}
+	for k, v := range overrides {
+		config[k] = v
+	}
+
+	name := sourceName
+	if name == "" {
+		name = cloneSourceName(original, config)
+	}
+
+	// Credentials are shared by reference, like reusing an account when adding a source
+	source := domain.Source{
+		ID:             uuid.New().String(),
+		Type:           original.Type,
+		Name:           name,
+		Config:         config,
+		AuthProviderID: original.AuthProviderID,
+		CredentialsID:  original.CredentialsID,
+	}
+	if err := sourceService.Add(ctx, source); err != nil {
+		return fmt.Errorf("failed to add source: %w", err)
+	}
+
+	cmd.Printf("Cloned source %s to: %s (%s)\n", original.ID, source.ID, source.Name)
+	return nil
+}
+
+// cloneSourceName names a clone of original with config. If the original's
+// name contains the name derived from its config, that part is replaced by
+// the name derived from the clone's config.
+func cloneSourceName(original *domain.Source, config map[string]string) string {
+	connectorName := original.Type
+	if connectorRegistry != nil {
+		if connector, err := connectorRegistry.Get(original.Type); err == nil {
+			connectorName = connector.Name
+		}
+	}
+
+	oldName := configSourceName(connectorName, original.Config)
+	newName := configSourceName(connectorName, config)
+	if oldName != newName && strings.Contains(original.Name, oldName) {
+		return strings.Replace(original.Name, oldName, newName, 1)
+	}
+	return original.Name + " (copy)"
+}
+
+func runSourceTest(cmd *cobra.Command, args []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+	if sourceHealthService == nil {
+		return errors.New("source health service not configured")
+	}
+
+	ctx := context.Background()
+
+	source, err := sourceService.Get(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("source not found: %w", err)
+	}
+
+	health, err := sourceHealthService.Check(ctx, source.ID)
+	if err != nil {
+		return fmt.Errorf("failed to test %s: %w", source.ID, err)
+	}
+
+	if !printSourceHealth(cmd, source, health) {
+		return fmt.Errorf("source %s failed validation", source.ID)
+	}
+	return nil
+}
+
+//nolint:gocyclo // sequential validation of source, connector and credentials
+func runSourceReauth(cmd *cobra.Command, args []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+	if credentialsService == nil {
+		return errors.New("credentials service not configured")
+	}
+	if authProviderService == nil {
+		return errors.New("auth provider service not configured")
+	}
+	if connectorRegistry == nil {
+		return errors.New("connector registry not configured")
+	}
+
+	ctx := context.Background()
+
+	source, err := sourceService.Get(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("source not found: %w", err)
+	}
+
+	connector, err := connectorRegistry.Get(source.Type)
+	if err != nil {
+		return fmt.Errorf("unknown connector type: %s", source.Type)
+	}
+	if !connector.AuthCapability.SupportsOAuth() {
+		return fmt.Errorf("source %s does not use OAuth", source.ID)
+	}
+
+	reader := bufio.NewReader(cmd.InOrStdin())
+	authResult, err := handleOAuthAuth(ctx, cmd, reader, connector, source.AuthProviderID, false)
+	if err != nil {
+		return err
+	}
+
+	var creds *domain.Credentials
+	if source.CredentialsID != "" {
+		creds, err = credentialsService.Get(ctx, source.CredentialsID)
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return fmt.Errorf("failed to get credentials: %w", err)
+		}
+	}
+	creds, err = credentialsForAccount(ctx, cmd, source, creds, authResult.AccountIdentifier)
+	if err != nil {
+		return err
+	}
+	pending := authResult.PendingCredentials.OAuth
+	applyOAuthTokens(creds, &domain.OAuthToken{
+		AccessToken:  pending.AccessToken,
+		RefreshToken: pending.RefreshToken,
+		TokenType:    pending.TokenType,
+		Expiry:       pending.Expiry,
+	})
+
+	if err := credentialsService.Save(ctx, *creds); err != nil {
+		return fmt.Errorf("failed to save credentials: %w", err)
+	}
+
+	source.AuthProviderID = authResult.AuthProviderID
+	source.CredentialsID = creds.ID
+	source.UpdatedAt = time.Now()
+	if err := sourceService.Update(ctx, *source); err != nil {
+		return fmt.Errorf("failed to update source: %w", err)
+	}
+
+	cmd.Printf("Re-authenticated source: %s (%s)\n", source.Name, source.ID)
+	return nil
+}
+
+// credentialsForAccount returns the credentials to store the tokens of source
+// for accountID, recording the account on them. The linked credentials are
+// updated in place so the source keeps one row, unless another source shares
+// them and accountID is a different account: the other sources then keep the
+// old account and source gets new credentials. As credentials are unique per
+// source_id, a shared row owned by source is first handed to a sharing source.
+func credentialsForAccount(
+	ctx context.Context, cmd *cobra.Command, source *domain.Source, creds *domain.Credentials, accountID string,
+) (*domain.Credentials, error) {
+	if creds != nil && accountChanged(creds, accountID) {
+		sharer, err := sharingSource(ctx, source)
+		if err != nil {
+			return nil, err
+		}
+		if sharer != nil {
+			if creds.SourceID == source.ID {
+				handed := *creds
+				handed.SourceID = sharer.ID
+				if err := credentialsService.Save(ctx, handed); err != nil {
+					return nil, fmt.Errorf("failed to hand over shared credentials: %w", err)
+				}
+			}
+			cmd.Printf("Warning: account changed from %s to %s; other sources keep using %s\n",
+				creds.AccountIdentifier, accountID, creds.AccountIdentifier)
+			creds = nil
+		}
+	}
+	if creds == nil {
+		creds = &domain.Credentials{
+			ID:        uuid.New().String(),
+			SourceID:  source.ID,
+			CreatedAt: time.Now(),
+		}
+	}
+	recordAccount(cmd, creds, accountID)
+	return creds, nil
+}
+
+// sharingSource returns another source that uses the credentials of source,
+// or nil if there is none.
+func sharingSource(ctx context.Context, source *domain.Source) (*domain.Source, error) {
+	sources, err := sourceService.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sources: %w", err)
+	}
+	for i := range sources {
+		if sources[i].ID != source.ID && sources[i].CredentialsID == source.CredentialsID {
+			return &sources[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func runSourceSchedule(cmd *cobra.Command, args []string) error {
+	if scheduleService == nil {
+		return errors.New("schedule service not configured")
+	}
+
+	sourceID := args[0]
+	ctx := context.Background()
+
+	if len(args) == 1 {
+		task, err := scheduleService.GetSourceSchedule(ctx, sourceID)
+		if err != nil {
+			return fmt.Errorf("failed to get schedule: %w", err)
+		}
+		if task == nil {
+			cmd.Printf("Source %s uses the global sync schedule.\n", sourceID)
+			return nil
+		}
+		if task.Cron != "" {
+			cmd.Printf("Source %s syncs on cron schedule %q\n", sourceID, task.Cron)
+		} else {
+			cmd.Printf("Source %s syncs every %s\n", sourceID, task.Interval)
+		}
+		if !task.NextRun.IsZero() {
+			cmd.Printf("  Next run: %s\n", task.NextRun.Format(time.RFC3339))
+		}
+		if !task.LastRun.IsZero() {
+			cmd.Printf("  Last run: %s\n", task.LastRun.Format(time.RFC3339))
+		}
+		if task.LastError != "" {
+			cmd.Printf("  Last error: %s\n", task.LastError)
+		}
+		return nil
+	}
+
+	schedule := strings.Join(args[1:], " ")
+	if strings.EqualFold(schedule, "off") {
+		if err := scheduleService.ClearSourceSchedule(ctx, sourceID); err != nil {
+			return fmt.Errorf("failed to clear schedule: %w", err)
+		}
+		cmd.Printf("Source %s now uses the global sync schedule.\n", sourceID)
+		return nil
+	}
+
+	interval, err := time.ParseDuration(schedule)
+	if err != nil {
+		// Not a duration, so treat it as a cron expression
+		if err := scheduleService.SetSourceCron(ctx, sourceID, schedule); err != nil {
+			return fmt.Errorf("failed to set schedule: %w", err)
+		}
+		cmd.Printf("Source %s will sync on cron schedule %q\n", sourceID, schedule)
+		return nil
+	}
+
+	if err := scheduleService.SetSourceSchedule(ctx, sourceID, interval); err != nil {
+		return fmt.Errorf("failed to set schedule: %w", err)
+	}
+
+	cmd.Printf("Source %s will sync every %s\n", sourceID, interval)
+	return nil
+}
+
 // selectAuthWithNewSystem handles authentication using the new AuthProvider/Credentials architecture.
 // For OAuth connectors: selects/creates AuthProvider, runs OAuth flow, creates Credentials.
 // For PAT connectors: prompts for PAT, creates Credentials.
 // For no-auth connectors: returns empty result.
+// The token, auth provider ID and auth method are the values of the source
+// add --token, --auth and --auth-method flags, or their equivalents.
 //
 //nolint:errcheck,gocyclo,gocognit,nestif // CLI interactive flow
 func selectAuthWithNewSystem(
@@ -415,6 +961,7 @@ func selectAuthWithNewSystem(
 	cmd *cobra.Command,
 	connector *domain.ConnectorType,
 	sourceID string,
+	token, authID, authMethod string,
 	isNonInteractive bool,
 ) (*authSelectionResult, error) {
 	result := &authSelectionResult{}
@@ -440,8 +987,8 @@ func selectAuthWithNewSystem(
 	// Non-interactive mode: determine auth method from flags
 	if isNonInteractive {
 		// Check if auth method was explicitly specified
-		if sourceAuthMethod != "" {
-			switch strings.ToLower(sourceAuthMethod) {
+		if authMethod != "" {
+			switch strings.ToLower(authMethod) {
 			case "token", "pat":
 				if !connector.AuthCapability.SupportsPAT() {
 					return nil, fmt.Errorf("connector %s does not support PAT authentication", connector.ID)
@@ -453,15 +1000,15 @@ func selectAuthWithNewSystem(
 				}
 				chosenMethod = domain.AuthMethodOAuth
 			default:
-				return nil, fmt.Errorf("invalid --auth-method: %s (use 'token' or 'oauth')", sourceAuthMethod)
+				return nil, fmt.Errorf("invalid --auth-method: %s (use 'token' or 'oauth')", authMethod)
 			}
-		} else if sourceToken != "" {
+		} else if token != "" {
 			// Token provided, use PAT auth
 			if !connector.AuthCapability.SupportsPAT() {
 				return nil, fmt.Errorf("connector %s does not support PAT authentication", connector.ID)
 			}
 			chosenMethod = domain.AuthMethodPAT
-		} else if sourceAuth != "" {
+		} else if authID != "" {
 			// Auth provider ID provided, use OAuth
 			if !connector.AuthCapability.SupportsOAuth() {
 				return nil, fmt.Errorf("connector %s does not support OAuth authentication", connector.ID)
@@ -513,9 +1060,9 @@ func selectAuthWithNewSystem(
 	//nolint:exhaustive // AuthMethodNone is explicitly handled above
 	switch chosenMethod {
 	case domain.AuthMethodPAT:
-		return handlePATAuth(ctx, cmd, reader, connector, sourceID, isNonInteractive)
+		return handlePATAuth(ctx, cmd, reader, connector, sourceID, token, isNonInteractive)
 	case domain.AuthMethodOAuth:
-		return handleOAuthAuth(ctx, cmd, reader, connector, sourceID, isNonInteractive)
+		return handleOAuthAuth(ctx, cmd, reader, connector, authID, isNonInteractive)
 	default:
 		return result, nil
 	}
@@ -530,19 +1077,18 @@ func handlePATAuth(
 	reader *bufio.Reader,
 	connector *domain.ConnectorType,
 	_ string, // sourceID - unused, credentials are pending until source is created
+	token string,
 	isNonInteractive bool,
 ) (*authSelectionResult, error) {
 	result := &authSelectionResult{}
 
-	var token string
 	var accountID string
 
 	// Non-interactive mode: use --token flag
 	if isNonInteractive {
-		if sourceToken == "" {
+		if token == "" {
 			return nil, errors.New("--token flag required for PAT authentication in non-interactive mode")
 		}
-		token = sourceToken
 	} else {
 		// Interactive mode: prompt for token
 		cmd.Println("\nPersonal Access Token Configuration")
@@ -588,7 +1134,8 @@ func handlePATAuth(
 	return result, nil
 }
 
-// handleOAuthAuth handles OAuth authentication flow.
+// handleOAuthAuth handles OAuth authentication flow. If authProviderID is
+// empty, the OAuth app configuration is selected or created interactively.
 //
 //nolint:errcheck,gocyclo,gocognit,funlen,nestif // CLI interactive flow
 func handleOAuthAuth(
@@ -596,22 +1143,22 @@ func handleOAuthAuth(
 	cmd *cobra.Command,
 	reader *bufio.Reader,
 	connector *domain.ConnectorType,
-	_ string, // sourceID - unused, credentials are pending until source is created
+	authProviderID string,
 	isNonInteractive bool,
 ) (*authSelectionResult, error) {
 	result := &authSelectionResult{}
 
-	// Check for --auth flag
+	// Use the given AuthProvider (--auth flag or the source's existing one)
 	var authProvider *domain.AuthProvider
-	if sourceAuth != "" {
-		provider, err := authProviderService.Get(ctx, sourceAuth)
+	if authProviderID != "" {
+		provider, err := authProviderService.Get(ctx, authProviderID)
 		if err != nil {
-			return nil, fmt.Errorf("auth provider not found: %s", sourceAuth)
+			return nil, fmt.Errorf("auth provider not found: %s", authProviderID)
 		}
 		if provider.ProviderType != connector.ProviderType {
 			return nil, fmt.Errorf(
 				"auth provider %s is for %s, but connector requires %s",
-				sourceAuth, provider.ProviderType, connector.ProviderType)
+				authProviderID, provider.ProviderType, connector.ProviderType)
 		}
 		authProvider = provider
 		result.AuthProviderID = provider.ID
@@ -680,6 +1227,116 @@ func handleOAuthAuth(
 		return nil, errors.New("auth provider has no OAuth configuration")
 	}
 
+	tokens, err := runOAuthFlow(ctx, cmd, connector.ID, authProvider, sourceDevice)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get account identifier from provider via connector registry
+	accountID, err := connectorRegistry.GetUserInfo(ctx, connector.ID, tokens.AccessToken)
+	if err != nil {
+		cmd.Printf("Warning: could not fetch account identifier: %v\n", err)
+	}
+	result.AccountIdentifier = accountID
+
+	// Store credentials as pending (will be saved AFTER source is created)
+	// This avoids FK constraint violation since credentials.source_id must reference existing source
+	result.PendingCredentials = &pendingCredentials{
+		OAuth: &domain.OAuthCredentials{
+			AccessToken:  tokens.AccessToken,
+			RefreshToken: tokens.RefreshToken,
+			TokenType:    tokens.TokenType,
+			Expiry:       tokens.Expiry,
+		},
+	}
+
+	cmd.Println("Authentication successful!")
+	if accountID != "" {
+		cmd.Printf("Authenticated as: %s\n", accountID)
+	}
+
+	return result, nil
+}
+
+// browserOAuthFlow runs the interactive OAuth flow. Swappable for tests.
+var browserOAuthFlow = runBrowserOAuthFlow
+
+// deviceOAuthFlow runs the OAuth device code flow. Swappable for tests.
+var deviceOAuthFlow = runDeviceOAuthFlow
+
+// runOAuthFlow obtains tokens with the device code flow if device is set,
+// and with the browser flow otherwise.
+func runOAuthFlow(
+	ctx context.Context,
+	cmd *cobra.Command,
+	connectorType string,
+	authProvider *domain.AuthProvider,
+	device bool,
+) (*domain.OAuthToken, error) {
+	if device {
+		return deviceOAuthFlow(ctx, cmd, connectorType, authProvider)
+	}
+	return browserOAuthFlow(ctx, cmd, connectorType, authProvider)
+}
+
+// runDeviceOAuthFlow runs the OAuth device authorization grant: it prints a
+// code for the user to enter on another device and polls the token endpoint
+// until they approve it. Needs neither a browser nor a callback port.
+func runDeviceOAuthFlow(
+	ctx context.Context,
+	cmd *cobra.Command,
+	connectorType string,
+	authProvider *domain.AuthProvider,
+) (*domain.OAuthToken, error) {
+	defaults := connectorRegistry.GetOAuthDefaults(connectorType)
+	if defaults == nil || defaults.DeviceURL == "" {
+		return nil, fmt.Errorf("connector %s does not support the device code flow", connectorType)
+	}
+
+	scopes := authProvider.OAuth.Scopes
+	if len(scopes) == 0 {
+		scopes = defaults.Scopes
+	}
+	tokenURL := authProvider.OAuth.TokenURL
+	if tokenURL == "" {
+		tokenURL = defaults.TokenURL
+	}
+
+	auth, err := oauth.RequestDeviceCode(ctx, defaults.DeviceURL, authProvider.OAuth.ClientID, scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.Printf("\nOn any device, visit:\n  %s\n", auth.VerificationURI)
+	cmd.Printf("and enter the code: %s\n", auth.UserCode)
+	if auth.VerificationURIComplete != "" {
+		cmd.Printf("\nOr open this link, which includes the code:\n  %s\n", auth.VerificationURIComplete)
+	}
+	cmd.Println("\nWaiting for authorization...")
+
+	token, err := oauth.PollDeviceToken(
+		ctx, tokenURL, authProvider.OAuth.ClientID, authProvider.OAuth.ClientSecret, auth)
+	if err != nil {
+		return nil, fmt.Errorf("authorization failed: %w", err)
+	}
+
+	return &domain.OAuthToken{
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		TokenType:    token.TokenType,
+		Expiry:       token.Expiry,
+	}, nil
+}
+
+// runBrowserOAuthFlow runs the OAuth authorization code flow with PKCE:
+// it opens the provider's consent page in a browser, waits for the local
+// callback and exchanges the returned code for tokens.
+func runBrowserOAuthFlow(
+	ctx context.Context,
+	cmd *cobra.Command,
+	connectorType string,
+	authProvider *domain.AuthProvider,
+) (*domain.OAuthToken, error) {
 	// Generate PKCE verifier and challenge
 	state := uuid.New().String()
 	codeVerifier := oauth.GenerateCodeVerifier()
@@ -696,7 +1353,7 @@ func handleOAuthAuth(
 
 	// Build auth URL via connector registry (includes provider-specific params)
 	authURL, err := connectorRegistry.BuildAuthURL(
-		connector.ID, authProvider, callbackServer.RedirectURI(), state, codeChallenge)
+		connectorType, authProvider, callbackServer.RedirectURI(), state, codeChallenge)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build auth URL: %w", err)
 	}
@@ -720,36 +1377,13 @@ func handleOAuthAuth(
 	// This allows connectors like Notion to use their custom token exchange
 	cmd.Println("Exchanging authorization code for tokens...")
 	tokens, err := connectorRegistry.ExchangeCode(
-		ctx, connector.ID, authProvider, code, callbackServer.RedirectURI(), codeVerifier,
+		ctx, connectorType, authProvider, code, callbackServer.RedirectURI(), codeVerifier,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to exchange code for tokens: %w", err)
 	}
 
-	// Get account identifier from provider via connector registry
-	accountID, err := connectorRegistry.GetUserInfo(ctx, connector.ID, tokens.AccessToken)
-	if err != nil {
-		cmd.Printf("Warning: could not fetch account identifier: %v\n", err)
-	}
-	result.AccountIdentifier = accountID
-
-	// Store credentials as pending (will be saved AFTER source is created)
-	// This avoids FK constraint violation since credentials.source_id must reference existing source
-	result.PendingCredentials = &pendingCredentials{
-		OAuth: &domain.OAuthCredentials{
-			AccessToken:  tokens.AccessToken,
-			RefreshToken: tokens.RefreshToken,
-			TokenType:    tokens.TokenType,
-			Expiry:       tokens.Expiry,
-		},
-	}
-
-	cmd.Println("Authentication successful!")
-	if accountID != "" {
-		cmd.Printf("Authenticated as: %s\n", accountID)
-	}
-
-	return result, nil
+	return tokens, nil
 }
 
 // createAuthProviderInline creates an AuthProvider during source add flow.
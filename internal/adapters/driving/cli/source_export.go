@@ -0,0 +1,334 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+var (
+	sourceExportOutput string
+	sourceImportTokens []string // --token name=token, keyed by source name or connector type
+	sourceImportAuths  []string // --auth name=auth-id, keyed by source name or connector type
+)
+
+var sourceExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export source configurations to YAML",
+	Long: `Export all source configurations to YAML for sharing across machines.
+
+The export contains each source's connector type, name, config and the name
+of its OAuth app configuration. Credentials and secret config values are
+never exported; they are requested again on import.
+
+Examples:
+  sercha source export                        Print to stdout
+  sercha source export --output sources.yaml  Write to a file`,
+	Args: cobra.NoArgs,
+	RunE: runSourceExport,
+}
+
+var sourceImportCmd = &cobra.Command{
+	Use:   "import [sources.yaml]",
+	Short: "Import source configurations from YAML",
+	Long: `Create the sources in a file written by 'sercha source export'.
+
+Sources that already exist (same connector type and config) are skipped.
+Credentials are prompted for interactively, unless given per source with
+--token or --auth, keyed by the source's name or connector type. Secret
+config values are not part of the export and are always prompted for.
+Sources exported with an OAuth app configuration use the local one of the
+same name.
+
+Examples:
+  sercha source import sources.yaml
+  sercha source import sources.yaml --token github=ghp_xxx
+  sercha source import sources.yaml --auth "Work Drive=<auth-id>"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSourceImport,
+}
+
+func init() {
+	sourceExportCmd.Flags().StringVarP(
+		&sourceExportOutput, "output", "o", "",
+		"File to write the export to (default: stdout)")
+	sourceImportCmd.Flags().StringArrayVar(
+		&sourceImportTokens, "token", nil,
+		"Personal Access Token for a source as name=token, by source name or connector type (can be repeated)")
+	sourceImportCmd.Flags().StringArrayVar(
+		&sourceImportAuths, "auth", nil,
+		"Auth provider ID for a source as name=auth-id, by source name or connector type (can be repeated)")
+	sourceCmd.AddCommand(sourceExportCmd)
+	sourceCmd.AddCommand(sourceImportCmd)
+}
+
+func runSourceExport(cmd *cobra.Command, _ []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+
+	ctx := context.Background()
+	sources, err := sourceService.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list sources: %w", err)
+	}
+
+	export := domain.SourceExportFile{
+		Version: domain.SourceExportVersion,
+		Sources: make([]domain.SourceExport, 0, len(sources)),
+	}
+	for i := range sources {
+		export.Sources = append(export.Sources, exportSource(ctx, &sources[i]))
+	}
+
+	data, err := yaml.Marshal(export)
+	if err != nil {
+		return fmt.Errorf("failed to marshal sources: %w", err)
+	}
+
+	if sourceExportOutput == "" {
+		_, err := cmd.OutOrStdout().Write(data)
+		return err
+	}
+	if err := os.WriteFile(sourceExportOutput, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+	cmd.Printf("Exported %d source(s) to %s\n", len(export.Sources), sourceExportOutput)
+	return nil
+}
+
+// exportSource returns the shareable configuration of a source, leaving
+// out config values its connector marks as secret.
+func exportSource(ctx context.Context, source *domain.Source) domain.SourceExport {
+	secret := make(map[string]bool)
+	if connectorRegistry != nil {
+		if connector, err := connectorRegistry.Get(source.Type); err == nil {
+			for _, key := range connector.ConfigKeys {
+				secret[key.Key] = key.Secret
+			}
+		}
+	}
+
+	var config map[string]string
+	for key, val := range source.Config {
+		if secret[key] {
+			continue
+		}
+		if config == nil {
+			config = make(map[string]string)
+		}
+		config[key] = val
+	}
+
+	export := domain.SourceExport{
+		Type:   source.Type,
+		Name:   source.Name,
+		Config: config,
+	}
+	if source.AuthProviderID != "" && authProviderService != nil {
+		if provider, err := authProviderService.Get(ctx, source.AuthProviderID); err == nil {
+			export.AuthProvider = provider.Name
+		}
+	}
+	return export
+}
+
+func runSourceImport(cmd *cobra.Command, args []string) error {
+	if sourceService == nil {
+		return errors.New("source service not configured")
+	}
+	if connectorRegistry == nil {
+		return errors.New("connector registry not configured")
+	}
+
+	tokens, err := parseImportFlags("--token", sourceImportTokens)
+	if err != nil {
+		return err
+	}
+	auths, err := parseImportFlags("--auth", sourceImportAuths)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read import file: %w", err)
+	}
+	var file domain.SourceExportFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to parse import file: %w", err)
+	}
+	if file.Version > domain.SourceExportVersion {
+		return fmt.Errorf("import file version %d is newer than supported version %d",
+			file.Version, domain.SourceExportVersion)
+	}
+
+	ctx := context.Background()
+	existing, err := sourceService.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list sources: %w", err)
+	}
+
+	var imported, skipped, failed int
+	for i := range file.Sources {
+		entry := &file.Sources[i]
+		label := entry.Name
+		if label == "" {
+			label = entry.Type
+		}
+
+		if matchesAnySource(entry, existing) {
+			cmd.Printf("Skipping %s: already exists\n", label)
+			skipped++
+			continue
+		}
+
+		source, err := importSource(ctx, cmd, entry, lookupImportFlag(tokens, entry), lookupImportFlag(auths, entry))
+		if err != nil {
+			cmd.Printf("Failed to import %s: %v\n", label, err)
+			failed++
+			continue
+		}
+		cmd.Printf("Imported %s: %s\n", label, source.ID)
+		existing = append(existing, *source)
+		imported++
+	}
+
+	cmd.Printf("\n%d imported, %d skipped, %d failed\n", imported, skipped, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d source(s) failed to import", failed)
+	}
+	return nil
+}
+
+// importSource authenticates and creates one exported source. A token or
+// auth provider ID from flags authenticates non-interactively.
+func importSource(
+	ctx context.Context, cmd *cobra.Command, entry *domain.SourceExport, token, authID string,
+) (*domain.Source, error) {
+	connector, err := connectorRegistry.Get(entry.Type)
+	if err != nil {
+		return nil, fmt.Errorf("unknown connector type: %s", entry.Type)
+	}
+	config, err := promptSecretConfig(cmd, connector, entry.Config)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSourceConfig(connector, config); err != nil {
+		return nil, err
+	}
+
+	// Use the same-named OAuth app configuration unless one was given
+	if authID == "" && entry.AuthProvider != "" && authProviderService != nil {
+		providers, err := authProviderService.ListByProvider(ctx, connector.ProviderType)
+		if err == nil {
+			for j := range providers {
+				if providers[j].Name == entry.AuthProvider {
+					authID = providers[j].ID
+					break
+				}
+			}
+		}
+	}
+
+	name := entry.Name
+	if name == "" {
+		name = connector.Name
+	}
+	if connector.AuthCapability.RequiresAuth() {
+		cmd.Printf("\nAuthenticating %s (%s)\n", name, connector.Name)
+	}
+
+	sourceID := uuid.New().String()
+	authResult, err := selectAuthWithNewSystem(
+		ctx, cmd, connector, sourceID, token, authID, "", token != "" || authID != "")
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w", err)
+	}
+	source := domain.Source{
+		ID:             sourceID,
+		Type:           entry.Type,
+		Name:           name,
+		Config:         config,
+		AuthProviderID: authResult.AuthProviderID,
+	}
+	if err := addSourceWithCredentials(ctx, cmd, source, authResult); err != nil {
+		return nil, err
+	}
+	return &source, nil
+}
+
+// promptSecretConfig returns a copy of config with the connector's secret
+// keys, which are never exported, read from stdin.
+func promptSecretConfig(
+	cmd *cobra.Command, connector *domain.ConnectorType, exported map[string]string,
+) (map[string]string, error) {
+	config := make(map[string]string, len(exported))
+	for k, v := range exported {
+		config[k] = v
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	for _, key := range connector.ConfigKeys {
+		if !key.Secret || config[key.Key] != "" {
+			continue
+		}
+
+		prompt := key.Label
+		if !key.Required {
+			prompt += " (optional)"
+		}
+		cmd.Printf("%s [hidden]: ", prompt)
+
+		input, _ := reader.ReadString('\n')
+		input = strings.TrimSpace(input)
+
+		if input != "" {
+			config[key.Key] = input
+		} else if key.Required {
+			return nil, fmt.Errorf("required field %s not provided", key.Key)
+		}
+	}
+	return config, nil
+}
+
+// matchesAnySource reports whether any source has the entry's type and config.
+func matchesAnySource(entry *domain.SourceExport, sources []domain.Source) bool {
+	for i := range sources {
+		if entry.Matches(&sources[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseImportFlags parses repeated name=value flags into a map.
+func parseImportFlags(flag string, values []string) (map[string]string, error) {
+	parsed := make(map[string]string, len(values))
+	for _, kv := range values {
+		name, value, ok := strings.Cut(kv, "=")
+		if !ok || name == "" || value == "" {
+			return nil, fmt.Errorf("invalid %s format: %s (expected name=value)", flag, kv)
+		}
+		parsed[name] = value
+	}
+	return parsed, nil
+}
+
+// lookupImportFlag returns the flag value for an entry, by source name
+// first and then connector type.
+func lookupImportFlag(values map[string]string, entry *domain.SourceExport) string {
+	if value, ok := values[entry.Name]; ok && entry.Name != "" {
+		return value
+	}
+	return values[entry.Type]
+}
@@ -0,0 +1,296 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockExportSourceService stores sources in memory for export/import tests.
+type mockExportSourceService struct {
+	mockSourceService
+	sources []domain.Source
+}
+
+func (m *mockExportSourceService) List(_ context.Context) ([]domain.Source, error) {
+	return m.sources, nil
+}
+
+func (m *mockExportSourceService) Add(_ context.Context, source domain.Source) error {
+	m.sources = append(m.sources, source)
+	return nil
+}
+
+func (m *mockExportSourceService) Update(_ context.Context, source domain.Source) error {
+	for i := range m.sources {
+		if m.sources[i].ID == source.ID {
+			m.sources[i] = source
+		}
+	}
+	return nil
+}
+
+// mockExportConnectorRegistry adds a PAT connector with a secret config key.
+type mockExportConnectorRegistry struct {
+	mockConnectorRegistry
+}
+
+func (m *mockExportConnectorRegistry) List() []domain.ConnectorType {
+	return append(m.mockConnectorRegistry.List(), domain.ConnectorType{
+		ID:             "gitlab",
+		Name:           "GitLab",
+		ProviderType:   domain.ProviderType("gitlab"),
+		AuthCapability: domain.AuthCapPAT,
+		ConfigKeys: []domain.ConfigKey{
+			{Key: "project", Label: "Project", Required: true},
+			{Key: "webhook_secret", Label: "Webhook secret", Secret: true},
+		},
+	})
+}
+
+func (m *mockExportConnectorRegistry) Get(id string) (*domain.ConnectorType, error) {
+	for _, c := range m.List() {
+		if c.ID == id {
+			return &c, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+// setupExportServices swaps in the export/import mocks.
+func setupExportServices(sources ...domain.Source) (*mockExportSourceService, *mockCredentialsService, func()) {
+	oldSource, oldRegistry := sourceService, connectorRegistry
+	oldCreds, oldAuth := credentialsService, authProviderService
+
+	svc := &mockExportSourceService{sources: sources}
+	creds := &mockCredentialsService{}
+	sourceService = svc
+	connectorRegistry = &mockExportConnectorRegistry{}
+	credentialsService = creds
+	authProviderService = &mockRotateAuthProviderService{provider: domain.AuthProvider{
+		ID: "auth-1", Name: "Work GitHub", ProviderType: domain.ProviderGitHub,
+	}}
+
+	return svc, creds, func() {
+		sourceService, connectorRegistry = oldSource, oldRegistry
+		credentialsService, authProviderService = oldCreds, oldAuth
+	}
+}
+
+// withStdin feeds input to prompts read from os.Stdin.
+func withStdin(t *testing.T, input string) {
+	t.Helper()
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	_, err = w.WriteString(input)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	old := os.Stdin
+	os.Stdin = r
+	t.Cleanup(func() {
+		os.Stdin = old
+		_ = r.Close()
+	})
+}
+
+func runSourceCmd(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"source"}, args...))
+	defer func() {
+		rootCmd.SetArgs(nil)
+		sourceExportOutput = ""
+		sourceImportTokens = nil
+		sourceImportAuths = nil
+	}()
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestSourceExportCmd_WritesYAML(t *testing.T) {
+	_, _, cleanup := setupExportServices(
+		domain.Source{
+			ID: "src-1", Type: "github", Name: "Docs repo",
+			Config:         map[string]string{"owner": "acme", "repo": "docs"},
+			AuthProviderID: "auth-1", CredentialsID: "creds-1",
+		},
+		domain.Source{
+			ID: "src-2", Type: "gitlab", Name: "Infra",
+			Config:        map[string]string{"project": "acme/infra", "webhook_secret": "s3cret"},
+			CredentialsID: "creds-2",
+		},
+	)
+	defer cleanup()
+	output := filepath.Join(t.TempDir(), "sources.yaml")
+
+	out, err := runSourceCmd(t, "export", "--output", output)
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Exported 2 source(s)")
+	data, err := os.ReadFile(output)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "s3cret")
+	assert.NotContains(t, string(data), "creds-")
+	assert.NotContains(t, string(data), "src-")
+
+	var file domain.SourceExportFile
+	require.NoError(t, yaml.Unmarshal(data, &file))
+	assert.Equal(t, domain.SourceExportVersion, file.Version)
+	assert.Equal(t, []domain.SourceExport{
+		{
+			Type: "github", Name: "Docs repo",
+			Config:       map[string]string{"owner": "acme", "repo": "docs"},
+			AuthProvider: "Work GitHub",
+		},
+		{Type: "gitlab", Name: "Infra", Config: map[string]string{"project": "acme/infra"}},
+	}, file.Sources)
+}
+
+func TestSourceExportCmd_Stdout(t *testing.T) {
+	_, _, cleanup := setupExportServices(domain.Source{
+		ID: "src-1", Type: "filesystem", Name: "Notes", Config: map[string]string{"path": "/notes"},
+	})
+	defer cleanup()
+
+	out, err := runSourceCmd(t, "export")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "version: 1")
+	assert.Contains(t, out, "type: filesystem")
+	assert.Contains(t, out, "path: /notes")
+}
+
+func writeImportFile(t *testing.T, file domain.SourceExportFile) string {
+	t.Helper()
+	data, err := yaml.Marshal(file)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "sources.yaml")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestSourceImportCmd_CreatesMissingAndSkipsExisting(t *testing.T) {
+	svc, creds, cleanup := setupExportServices(domain.Source{
+		ID: "src-1", Type: "filesystem", Name: "Old name", Config: map[string]string{"path": "/notes"},
+	})
+	defer cleanup()
+	path := writeImportFile(t, domain.SourceExportFile{
+		Version: domain.SourceExportVersion,
+		Sources: []domain.SourceExport{
+			{Type: "filesystem", Name: "Notes", Config: map[string]string{"path": "/notes"}},
+			{Type: "filesystem", Name: "Docs", Config: map[string]string{"path": "/docs"}},
+			{Type: "filesystem", Name: "Docs again", Config: map[string]string{"path": "/docs"}},
+			{Type: "gitlab", Name: "Infra", Config: map[string]string{"project": "acme/infra"}},
+		},
+	})
+
+	withStdin(t, "\n")
+
+	out, err := runSourceCmd(t, "import", path, "--token", "gitlab=glpat-123")
+
+	require.NoError(t, err, out)
+	assert.Contains(t, out, "Skipping Notes: already exists")
+	assert.Contains(t, out, "Skipping Docs again: already exists")
+	assert.Contains(t, out, "2 imported, 2 skipped, 0 failed")
+
+	require.Len(t, svc.sources, 3)
+	docs, infra := svc.sources[1], svc.sources[2]
+	assert.Equal(t, "Docs", docs.Name)
+	assert.Equal(t, map[string]string{"path": "/docs"}, docs.Config)
+	assert.Empty(t, docs.CredentialsID)
+	assert.Equal(t, "gitlab", infra.Type)
+	assert.NotEmpty(t, infra.CredentialsID)
+	assert.Len(t, creds.saved, 1)
+}
+
+func TestSourceImportCmd_TokenByName(t *testing.T) {
+	svc, _, cleanup := setupExportServices()
+	defer cleanup()
+	path := writeImportFile(t, domain.SourceExportFile{
+		Version: domain.SourceExportVersion,
+		Sources: []domain.SourceExport{
+			{Type: "gitlab", Name: "Infra", Config: map[string]string{"project": "acme/infra"}},
+		},
+	})
+
+	withStdin(t, "\n")
+
+	_, err := runSourceCmd(t, "import", path, "--token", "Infra=glpat-123")
+
+	require.NoError(t, err)
+	require.Len(t, svc.sources, 1)
+	assert.NotEmpty(t, svc.sources[0].CredentialsID)
+}
+
+func TestSourceImportCmd_PromptsForSecretConfig(t *testing.T) {
+	svc, _, cleanup := setupExportServices()
+	defer cleanup()
+	exported := map[string]string{"project": "acme/infra"}
+	path := writeImportFile(t, domain.SourceExportFile{
+		Version: domain.SourceExportVersion,
+		Sources: []domain.SourceExport{{Type: "gitlab", Name: "Infra", Config: exported}},
+	})
+	withStdin(t, "hook-123\n")
+
+	out, err := runSourceCmd(t, "import", path, "--token", "Infra=glpat-123")
+
+	require.NoError(t, err, out)
+	assert.Contains(t, out, "Webhook secret (optional) [hidden]: ")
+	require.Len(t, svc.sources, 1)
+	assert.Equal(t, map[string]string{"project": "acme/infra", "webhook_secret": "hook-123"}, svc.sources[0].Config)
+}
+
+func TestSourceImportCmd_ReportsFailures(t *testing.T) {
+	svc, _, cleanup := setupExportServices()
+	defer cleanup()
+	path := writeImportFile(t, domain.SourceExportFile{
+		Version: domain.SourceExportVersion,
+		Sources: []domain.SourceExport{
+			{Type: "unknown", Name: "Mystery"},
+			{Type: "filesystem", Name: "No path"},
+			{Type: "filesystem", Name: "Docs", Config: map[string]string{"path": "/docs"}},
+		},
+	})
+
+	out, err := runSourceCmd(t, "import", path)
+
+	require.Error(t, err)
+	assert.Contains(t, out, "Failed to import Mystery: unknown connector type: unknown")
+	assert.Contains(t, out, "Failed to import No path: required config missing: path")
+	assert.Contains(t, out, "1 imported, 0 skipped, 2 failed")
+	assert.Len(t, svc.sources, 1)
+}
+
+func TestSourceImportCmd_RejectsNewerVersion(t *testing.T) {
+	_, _, cleanup := setupExportServices()
+	defer cleanup()
+	path := writeImportFile(t, domain.SourceExportFile{Version: domain.SourceExportVersion + 1})
+
+	_, err := runSourceCmd(t, "import", path)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "newer than supported")
+}
+
+func TestSourceImportCmd_InvalidTokenFlag(t *testing.T) {
+	_, _, cleanup := setupExportServices()
+	defer cleanup()
+	path := writeImportFile(t, domain.SourceExportFile{Version: domain.SourceExportVersion})
+
+	_, err := runSourceCmd(t, "import", path, "--token", "glpat-123")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid --token format")
+}
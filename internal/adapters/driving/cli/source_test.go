@@ -2,9 +2,23 @@ package cli
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/sqlite"
+	"github.com/custodia-labs/sercha-cli/internal/connectors"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+	"github.com/custodia-labs/sercha-cli/internal/core/services"
 )
 
 func TestSourceCmd_Use(t *testing.T) {
@@ -73,6 +87,52 @@ func TestSourceAddCmd_ErrorsWithoutServices(t *testing.T) {
 	assert.Contains(t, err.Error(), "not configured")
 }
 
+func runSourceAddCmd(t *testing.T, store *memory.SourceStore, args ...string) (string, error) {
+	t.Helper()
+	oldSources, oldRegistry := sourceService, connectorRegistry
+	sourceService = services.NewSourceService(store, memory.NewSyncStateStore(), nil)
+	connectorRegistry = &mockConnectorRegistry{}
+	defer func() { sourceService, connectorRegistry = oldSources, oldRegistry }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"source", "add"}, args...))
+	defer func() {
+		rootCmd.SetArgs(nil)
+		sourceConfig = nil
+		sourceExclude = nil
+	}()
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestSourceAddCmd_ExcludePatterns(t *testing.T) {
+	store := memory.NewSourceStore()
+
+	_, err := runSourceAddCmd(t, store, "filesystem", "-c", "path=/Users/me/Code",
+		"-c", "exclude_patterns=*.log", "--exclude", "node_modules", "--exclude", "dist,build/*")
+
+	require.NoError(t, err)
+	sources, err := store.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, sources, 1)
+	assert.Equal(t, []string{"*.log", "node_modules", "dist", "build/*"}, sources[0].ExclusionPatterns())
+	assert.Equal(t, "/Users/me/Code", sources[0].Config["path"])
+}
+
+func TestSourceAddCmd_InvalidExcludePattern(t *testing.T) {
+	store := memory.NewSourceStore()
+
+	_, err := runSourceAddCmd(t, store, "filesystem", "-c", "path=/Users/me/Code", "--exclude", "[bad")
+
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+	sources, err := store.List(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, sources)
+}
+
 // Source List Tests
 
 func TestSourceListCmd_Use(t *testing.T) {
@@ -135,6 +195,161 @@ func TestSourceRemoveCmd_ExecutesWithArg(t *testing.T) {
 	assert.Contains(t, buf.String(), "Removed source:")
 }
 
+func TestSourceRenameCmd_Use(t *testing.T) {
+	assert.Equal(t, "rename [source-id] [new-name]", sourceRenameCmd.Use)
+}
+
+func TestSourceRenameCmd_RequiresTwoArgs(t *testing.T) {
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{"source", "rename", "source-123"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+	}()
+
+	err := rootCmd.Execute()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "accepts 2 arg(s)")
+}
+
+func TestSourceRenameCmd_ExecutesWithArgs(t *testing.T) {
+	cleanup := setupTestServices()
+	defer cleanup()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs([]string{"source", "rename", "source-123", " Work Docs "})
+	defer func() {
+		rootCmd.SetArgs(nil)
+	}()
+
+	err := rootCmd.Execute()
+
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), `Renamed source source-123 to "Work Docs"`)
+}
+
+func TestSourceRenameCmd_ServiceError(t *testing.T) {
+	oldService := sourceService
+	sourceService = &mockSourceServiceError{}
+	defer func() {
+		sourceService = oldService
+	}()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{"source", "rename", "missing", "Name"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+	}()
+
+	err := rootCmd.Execute()
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Contains(t, err.Error(), "failed to rename source")
+}
+
+// Source Schedule Tests
+
+func TestSourceScheduleCmd_Use(t *testing.T) {
+	assert.Equal(t, "schedule [source-id] [interval|cron|off]", sourceScheduleCmd.Use)
+}
+
+func runScheduleCmd(t *testing.T, svc driving.ScheduleService, args ...string) (string, error) {
+	t.Helper()
+	oldSchedule := scheduleService
+	scheduleService = svc
+	defer func() { scheduleService = oldSchedule }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs(append([]string{"source", "schedule"}, args...))
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestSourceScheduleCmd_SetAndShow(t *testing.T) {
+	svc := newMockScheduleService()
+
+	out, err := runScheduleCmd(t, svc, "src-1", "5m")
+	require.NoError(t, err)
+	assert.Contains(t, out, "will sync every 5m0s")
+	assert.Equal(t, 5*time.Minute, svc.tasks["src-1"].Interval)
+
+	out, err = runScheduleCmd(t, svc, "src-1")
+	require.NoError(t, err)
+	assert.Contains(t, out, "syncs every 5m0s")
+}
+
+func TestSourceScheduleCmd_ShowDefault(t *testing.T) {
+	out, err := runScheduleCmd(t, newMockScheduleService(), "src-1")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "uses the global sync schedule")
+}
+
+func TestSourceScheduleCmd_Off(t *testing.T) {
+	svc := newMockScheduleService()
+	svc.tasks["src-1"] = &domain.ScheduledTask{Interval: time.Hour}
+
+	out, err := runScheduleCmd(t, svc, "src-1", "off")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "now uses the global sync schedule")
+	assert.NotContains(t, svc.tasks, "src-1")
+}
+
+func TestSourceScheduleCmd_Cron(t *testing.T) {
+	svc := newMockScheduleService()
+
+	out, err := runScheduleCmd(t, svc, "src-1", "0 6 * * 1-5")
+	require.NoError(t, err)
+	assert.Contains(t, out, `will sync on cron schedule "0 6 * * 1-5"`)
+	assert.Equal(t, "0 6 * * 1-5", svc.tasks["src-1"].Cron)
+
+	out, err = runScheduleCmd(t, svc, "src-1")
+	require.NoError(t, err)
+	assert.Contains(t, out, `syncs on cron schedule "0 6 * * 1-5"`)
+}
+
+func TestSourceScheduleCmd_CronUnquoted(t *testing.T) {
+	svc := newMockScheduleService()
+
+	_, err := runScheduleCmd(t, svc, "src-1", "0", "6", "*", "*", "1-5")
+
+	require.NoError(t, err)
+	assert.Equal(t, "0 6 * * 1-5", svc.tasks["src-1"].Cron)
+}
+
+func TestSourceScheduleCmd_InvalidSchedule(t *testing.T) {
+	_, err := runScheduleCmd(t, newMockScheduleService(), "src-1", "often")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to set schedule")
+}
+
+func TestSourceScheduleCmd_ServiceError(t *testing.T) {
+	svc := newMockScheduleService()
+	svc.err = domain.ErrInvalidInput
+
+	_, err := runScheduleCmd(t, svc, "src-1", "10s")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to set schedule")
+}
+
+func TestSourceScheduleCmd_NilService(t *testing.T) {
+	_, err := runScheduleCmd(t, nil, "src-1", "5m")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "schedule service not configured")
+}
+
 // Connector List Tests
 
 func TestConnectorCmd_Use(t *testing.T) {
@@ -190,6 +405,46 @@ func TestConnectorListCmd_EmptyList(t *testing.T) {
 	assert.Contains(t, buf.String(), "No connectors available")
 }
 
+func TestConnectorCheckCmd_ReportsUnhandled(t *testing.T) {
+	oldRegistry := connectorRegistry
+	connectorRegistry = &mockConnectorRegistry{}
+	defer func() {
+		connectorRegistry = oldRegistry
+	}()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs([]string{"connector", "check"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+	}()
+
+	err := rootCmd.Execute()
+
+	assert.Error(t, err)
+	assert.Contains(t, buf.String(), "filesystem: application/x-unknown")
+}
+
+func TestConnectorCheckCmd_AllCovered(t *testing.T) {
+	oldRegistry := connectorRegistry
+	connectorRegistry = &mockConnectorRegistryEmpty{}
+	defer func() {
+		connectorRegistry = oldRegistry
+	}()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs([]string{"connector", "check"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+	}()
+
+	err := rootCmd.Execute()
+
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "All connector MIME types have a registered normaliser")
+}
+
 func TestConnectorListCmd_ServiceNotConfigured(t *testing.T) {
 	oldRegistry := connectorRegistry
 	connectorRegistry = nil
@@ -211,6 +466,33 @@ func TestConnectorListCmd_ServiceNotConfigured(t *testing.T) {
 	assert.Contains(t, err.Error(), "connector registry not configured")
 }
 
+func TestConnectorListCmd_JSONSchema(t *testing.T) {
+	oldRegistry := connectorRegistry
+	connectorRegistry = &mockConnectorRegistry{}
+	defer func() {
+		connectorRegistry = oldRegistry
+		connectorListJSONSchema = false
+	}()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs([]string{"connector", "list", "--json-schema"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+	}()
+
+	err := rootCmd.Execute()
+
+	require.NoError(t, err)
+	var schemas map[string]map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &schemas))
+	require.Contains(t, schemas, "filesystem")
+	require.Contains(t, schemas, "github")
+	assert.Equal(t, domain.JSONSchemaDraft07, schemas["filesystem"]["$schema"])
+	assert.Equal(t, []any{"path"}, schemas["filesystem"]["required"])
+	assert.Equal(t, []any{"owner", "repo"}, schemas["github"]["required"])
+}
+
 // Source List Empty Tests
 
 func TestSourceListCmd_EmptyList(t *testing.T) {
@@ -318,3 +600,473 @@ func TestSourceRemoveCmd_ServiceError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to remove source")
 }
+
+// Source Test Tests
+
+// nullTokenProviderFactory resolves every source to no TokenProvider.
+type nullTokenProviderFactory struct{}
+
+func (nullTokenProviderFactory) CreateTokenProvider(_ context.Context, _ *domain.Source) (driven.TokenProvider, error) {
+	return nil, nil
+}
+
+// runSourceTestCmd runs 'source test' against a real health service and
+// connector factory, so each source's connector is validated for real.
+func runSourceTestCmd(t *testing.T, source domain.Source) (string, error) {
+	t.Helper()
+	store := memory.NewSourceStore()
+	require.NoError(t, store.Save(context.Background(), source))
+
+	oldSources, oldHealth := sourceService, sourceHealthService
+	sourceService = &mockCheckSourceService{sources: []domain.Source{source}}
+	sourceHealthService = services.NewSourceHealthService(
+		store, nil, memory.NewSourceHealthStore(), connectors.NewFactory(nullTokenProviderFactory{}))
+	defer func() { sourceService, sourceHealthService = oldSources, oldHealth }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs([]string{"source", "test", source.ID})
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestSourceTestCmd_Passes(t *testing.T) {
+	out, err := runSourceTestCmd(t, domain.Source{
+		ID: "src-1", Type: "filesystem", Name: "Notes",
+		Config: map[string]string{"path": t.TempDir()},
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "OK    Notes (src-1)")
+}
+
+func TestSourceTestCmd_MissingPathFails(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing")
+
+	out, err := runSourceTestCmd(t, domain.Source{
+		ID: "src-1", Type: "filesystem", Name: "Notes",
+		Config: map[string]string{"path": missing},
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, "source src-1 failed validation", err.Error())
+	assert.Contains(t, out, "FAIL  Notes (src-1): root path does not exist: "+missing)
+	assert.NotContains(t, out, "Usage:")
+}
+
+func TestSourceTestCmd_UnknownSource(t *testing.T) {
+	oldSources, oldHealth := sourceService, sourceHealthService
+	sourceService, sourceHealthService = &mockCheckSourceService{}, &mockSourceHealthService{}
+	defer func() { sourceService, sourceHealthService = oldSources, oldHealth }()
+
+	err := runSourceTest(sourceTestCmd, []string{"missing"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "source not found")
+}
+
+func TestSourceTestCmd_NoHealthService(t *testing.T) {
+	oldSources, oldHealth := sourceService, sourceHealthService
+	sourceService, sourceHealthService = &mockSourceService{}, nil
+	defer func() { sourceService, sourceHealthService = oldSources, oldHealth }()
+
+	err := runSourceTest(sourceTestCmd, []string{"src-1"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "source health service not configured")
+}
+
+func TestSourceTestCmd_ValidateAlias(t *testing.T) {
+	assert.Contains(t, sourceTestCmd.Aliases, "validate")
+}
+
+// Source Reauth Tests
+
+// mockReauthCredentialsService stores credentials by ID, like the credentials table.
+type mockReauthCredentialsService struct {
+	mockCredentialsService
+	rows map[string]domain.Credentials
+}
+
+func (m *mockReauthCredentialsService) Get(_ context.Context, id string) (*domain.Credentials, error) {
+	creds, ok := m.rows[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &creds, nil
+}
+
+func (m *mockReauthCredentialsService) Save(_ context.Context, creds domain.Credentials) error {
+	m.rows[creds.ID] = creds
+	return nil
+}
+
+// mockReauthConnectorRegistry resolves every type to an OAuth connector.
+type mockReauthConnectorRegistry struct {
+	mockRotateConnectorRegistry
+}
+
+func (m *mockReauthConnectorRegistry) Get(id string) (*domain.ConnectorType, error) {
+	return &domain.ConnectorType{
+		ID:             id,
+		ProviderType:   domain.ProviderGoogle,
+		AuthCapability: domain.AuthCapOAuth,
+	}, nil
+}
+
+type reauthFixture struct {
+	sourceStore *memory.SourceStore
+	syncStore   *memory.SyncStateStore
+	creds       *mockReauthCredentialsService
+	source      domain.Source
+	syncState   domain.SyncState
+}
+
+func newReauthFixture(t *testing.T) *reauthFixture {
+	t.Helper()
+	ctx := context.Background()
+	f := &reauthFixture{
+		sourceStore: memory.NewSourceStore(),
+		syncStore:   memory.NewSyncStateStore(),
+		creds: &mockReauthCredentialsService{rows: map[string]domain.Credentials{
+			"creds-1": {
+				ID:                "creds-1",
+				SourceID:          "src-1",
+				AccountIdentifier: "old@example.com",
+				OAuth:             &domain.OAuthCredentials{AccessToken: "revoked", RefreshToken: "revoked"},
+			},
+		}},
+		source: domain.Source{
+			ID:             "src-1",
+			Type:           "google-drive",
+			Name:           "Drive",
+			Config:         map[string]string{"folder_id": "root"},
+			AuthProviderID: "auth-1",
+			CredentialsID:  "creds-1",
+		},
+		syncState: domain.SyncState{SourceID: "src-1", Cursor: "page-42", LastSync: time.Now().Add(-time.Hour)},
+	}
+	require.NoError(t, f.sourceStore.Save(ctx, f.source))
+	require.NoError(t, f.syncStore.Save(ctx, f.syncState))
+	return f
+}
+
+func runSourceReauthCmd(t *testing.T, f *reauthFixture, args ...string) (string, error) {
+	t.Helper()
+	return runSourceReauthWith(t, f.sourceStore, f.syncStore, f.creds, args...)
+}
+
+func runSourceReauthWith(
+	t *testing.T, sourceStore driven.SourceStore, syncStore driven.SyncStateStore,
+	creds driving.CredentialsService, args ...string,
+) (string, error) {
+	t.Helper()
+	oldSources, oldCreds, oldAuth, oldRegistry := sourceService, credentialsService, authProviderService, connectorRegistry
+	oldFlow := browserOAuthFlow
+	sourceService = services.NewSourceService(sourceStore, syncStore, nil)
+	credentialsService = creds
+	authProviderService = &mockRotateAuthProviderService{provider: domain.AuthProvider{
+		ID:           "auth-1",
+		ProviderType: domain.ProviderGoogle,
+		OAuth:        &domain.OAuthProviderConfig{ClientID: "client"},
+	}}
+	connectorRegistry = &mockReauthConnectorRegistry{
+		mockRotateConnectorRegistry: mockRotateConnectorRegistry{accountID: "new@example.com"},
+	}
+	browserOAuthFlow = func(
+		_ context.Context, _ *cobra.Command, _ string, _ *domain.AuthProvider,
+	) (*domain.OAuthToken, error) {
+		return &domain.OAuthToken{AccessToken: "new-access", RefreshToken: "new-refresh"}, nil
+	}
+	defer func() {
+		sourceService, credentialsService, authProviderService, connectorRegistry = oldSources, oldCreds, oldAuth, oldRegistry
+		browserOAuthFlow = oldFlow
+	}()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"source", "reauth"}, args...))
+	defer func() {
+		rootCmd.SetArgs(nil)
+		sourceDevice = false
+	}()
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestSourceReauthCmd_UpdatesCredentialsInPlace(t *testing.T) {
+	f := newReauthFixture(t)
+
+	out, err := runSourceReauthCmd(t, f, "src-1")
+
+	require.NoError(t, err)
+	require.Len(t, f.creds.rows, 1, "credentials row is updated, not duplicated")
+	creds := f.creds.rows["creds-1"]
+	assert.Equal(t, "src-1", creds.SourceID)
+	assert.Equal(t, "new@example.com", creds.AccountIdentifier)
+	require.NotNil(t, creds.OAuth)
+	assert.Equal(t, "new-access", creds.OAuth.AccessToken)
+	assert.Equal(t, "new-refresh", creds.OAuth.RefreshToken)
+	assert.Contains(t, out, "Authenticated as: new@example.com")
+	assert.Contains(t, out, "Re-authenticated source: Drive (src-1)")
+}
+
+func TestSourceReauthCmd_PreservesConfigAndSyncState(t *testing.T) {
+	f := newReauthFixture(t)
+	ctx := context.Background()
+
+	_, err := runSourceReauthCmd(t, f, "src-1")
+	require.NoError(t, err)
+
+	source, err := f.sourceStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, f.source.Config, source.Config)
+	assert.Equal(t, "auth-1", source.AuthProviderID)
+	assert.Equal(t, "creds-1", source.CredentialsID)
+
+	state, err := f.syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, f.syncState.Cursor, state.Cursor)
+	assert.True(t, f.syncState.LastSync.Equal(state.LastSync))
+}
+
+func TestSourceReauthCmd_LinksNewCredentialsWhenMissing(t *testing.T) {
+	f := newReauthFixture(t)
+	f.creds.rows = map[string]domain.Credentials{}
+
+	_, err := runSourceReauthCmd(t, f, "src-1")
+	require.NoError(t, err)
+
+	require.Len(t, f.creds.rows, 1)
+	source, err := f.sourceStore.Get(context.Background(), "src-1")
+	require.NoError(t, err)
+	creds, ok := f.creds.rows[source.CredentialsID]
+	require.True(t, ok, "source links the new credentials")
+	assert.Equal(t, "src-1", creds.SourceID)
+	assert.Equal(t, "new@example.com", creds.AccountIdentifier)
+}
+
+func TestSourceReauthCmd_NewAccountOnSharedCredentialsCreatesRow(t *testing.T) {
+	f := newReauthFixture(t)
+	ctx := context.Background()
+	clone := f.source
+	clone.ID = "src-2"
+	clone.Name = "Drive clone"
+	require.NoError(t, f.sourceStore.Save(ctx, clone))
+
+	out, err := runSourceReauthCmd(t, f, "src-1")
+	require.NoError(t, err)
+
+	require.Len(t, f.creds.rows, 2, "shared credentials are not overwritten")
+	shared := f.creds.rows["creds-1"]
+	assert.Equal(t, "old@example.com", shared.AccountIdentifier)
+	assert.Equal(t, "revoked", shared.OAuth.AccessToken)
+
+	source, err := f.sourceStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	require.NotEqual(t, "creds-1", source.CredentialsID)
+	creds := f.creds.rows[source.CredentialsID]
+	assert.Equal(t, "src-1", creds.SourceID)
+	assert.Equal(t, "new@example.com", creds.AccountIdentifier)
+	assert.Equal(t, "new-access", creds.OAuth.AccessToken)
+
+	other, err := f.sourceStore.Get(ctx, "src-2")
+	require.NoError(t, err)
+	assert.Equal(t, "creds-1", other.CredentialsID)
+	assert.Contains(t, out, "other sources keep using old@example.com")
+}
+
+func TestSourceReauthCmd_NewAccountOnOwnedSharedCredentialsInSQLite(t *testing.T) {
+	store, err := sqlite.NewStore(t.TempDir())
+	require.NoError(t, err)
+	defer store.Close()
+	ctx := context.Background()
+	now := time.Now()
+
+	require.NoError(t, store.AuthProviderStore().Save(ctx, domain.AuthProvider{
+		ID: "auth-1", Name: "Google", ProviderType: domain.ProviderGoogle, AuthMethod: domain.AuthMethodOAuth,
+		CreatedAt: now, UpdatedAt: now,
+	}))
+	source := domain.Source{ID: "src-1", Type: "google-drive", Name: "Drive", AuthProviderID: "auth-1"}
+	clone := domain.Source{ID: "src-2", Type: "google-drive", Name: "Drive clone", AuthProviderID: "auth-1"}
+	require.NoError(t, store.SourceStore().Save(ctx, source))
+	require.NoError(t, store.SourceStore().Save(ctx, clone))
+	require.NoError(t, store.CredentialsStore().Save(ctx, domain.Credentials{
+		ID:                "creds-1",
+		SourceID:          "src-1",
+		AccountIdentifier: "old@example.com",
+		OAuth:             &domain.OAuthCredentials{AccessToken: "revoked", RefreshToken: "revoked"},
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}))
+	source.CredentialsID, clone.CredentialsID = "creds-1", "creds-1"
+	require.NoError(t, store.SourceStore().Save(ctx, source))
+	require.NoError(t, store.SourceStore().Save(ctx, clone))
+
+	out, err := runSourceReauthWith(t, store.SourceStore(), store.SyncStateStore(),
+		services.NewCredentialsService(store.CredentialsStore()), "src-1")
+	require.NoError(t, err, out)
+
+	shared, err := store.CredentialsStore().Get(ctx, "creds-1")
+	require.NoError(t, err)
+	assert.Equal(t, "src-2", shared.SourceID, "shared credentials are handed to the other source")
+	assert.Equal(t, "old@example.com", shared.AccountIdentifier)
+
+	updated, err := store.SourceStore().Get(ctx, "src-1")
+	require.NoError(t, err)
+	require.NotEqual(t, "creds-1", updated.CredentialsID)
+	creds, err := store.CredentialsStore().Get(ctx, updated.CredentialsID)
+	require.NoError(t, err)
+	assert.Equal(t, "src-1", creds.SourceID)
+	assert.Equal(t, "new@example.com", creds.AccountIdentifier)
+	assert.Equal(t, "new-access", creds.OAuth.AccessToken)
+}
+
+func TestSourceReauthCmd_SameAccountOnSharedCredentialsUpdatesInPlace(t *testing.T) {
+	f := newReauthFixture(t)
+	ctx := context.Background()
+	row := f.creds.rows["creds-1"]
+	row.AccountIdentifier = "new@example.com"
+	f.creds.rows["creds-1"] = row
+	clone := f.source
+	clone.ID = "src-2"
+	require.NoError(t, f.sourceStore.Save(ctx, clone))
+
+	_, err := runSourceReauthCmd(t, f, "src-1")
+	require.NoError(t, err)
+
+	require.Len(t, f.creds.rows, 1)
+	assert.Equal(t, "new-access", f.creds.rows["creds-1"].OAuth.AccessToken)
+}
+
+func TestSourceReauthCmd_UnknownSource(t *testing.T) {
+	f := newReauthFixture(t)
+
+	_, err := runSourceReauthCmd(t, f, "missing")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "source not found")
+}
+
+// Source Clone Tests
+
+func runSourceCloneCmd(t *testing.T, store *memory.SourceStore, args ...string) (string, error) {
+	t.Helper()
+	oldSources, oldRegistry := sourceService, connectorRegistry
+	sourceService = services.NewSourceService(store, memory.NewSyncStateStore(), nil)
+	connectorRegistry = &mockConnectorRegistry{}
+	defer func() { sourceService, connectorRegistry = oldSources, oldRegistry }()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"source", "clone"}, args...))
+	defer func() {
+		rootCmd.SetArgs(nil)
+		sourceName = ""
+		sourceConfig = nil
+	}()
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+// cloneOf returns the only source in store other than the original.
+func cloneOf(t *testing.T, store *memory.SourceStore, originalID string) domain.Source {
+	t.Helper()
+	sources, err := store.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, sources, 2)
+	for _, s := range sources {
+		if s.ID != originalID {
+			return s
+		}
+	}
+	t.Fatal("clone not found")
+	return domain.Source{}
+}
+
+func TestSourceCloneCmd_OverridesConfigAndDerivesName(t *testing.T) {
+	store := memory.NewSourceStore()
+	require.NoError(t, store.Save(context.Background(), domain.Source{
+		ID:     "src-1",
+		Type:   "filesystem",
+		Name:   "/Users/me/Notes",
+		Config: map[string]string{"path": "/Users/me/Notes", "patterns": "*.md"},
+	}))
+
+	out, err := runSourceCloneCmd(t, store, "src-1", "-c", "path=/Users/me/Projects")
+
+	require.NoError(t, err)
+	clone := cloneOf(t, store, "src-1")
+	assert.NotEmpty(t, clone.ID)
+	assert.Equal(t, "filesystem", clone.Type)
+	assert.Equal(t, "/Users/me/Projects", clone.Name)
+	assert.Equal(t, map[string]string{"path": "/Users/me/Projects", "patterns": "*.md"}, clone.Config)
+	assert.Contains(t, out, "Cloned source src-1 to: "+clone.ID)
+
+	original, err := store.Get(context.Background(), "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "/Users/me/Notes", original.Config["path"], "original config is unchanged")
+}
+
+func TestSourceCloneCmd_SharesCredentials(t *testing.T) {
+	store := memory.NewSourceStore()
+	require.NoError(t, store.Save(context.Background(), domain.Source{
+		ID:             "src-1",
+		Type:           "github",
+		Name:           "acme/web (dev@acme.com)",
+		Config:         map[string]string{"owner": "acme", "repo": "web"},
+		AuthProviderID: "auth-1",
+		CredentialsID:  "creds-1",
+	}))
+
+	_, err := runSourceCloneCmd(t, store, "src-1", "-c", "repo=api")
+
+	require.NoError(t, err)
+	clone := cloneOf(t, store, "src-1")
+	assert.Equal(t, "acme/api (dev@acme.com)", clone.Name)
+	assert.Equal(t, "auth-1", clone.AuthProviderID)
+	assert.Equal(t, "creds-1", clone.CredentialsID)
+}
+
+func TestSourceCloneCmd_Name(t *testing.T) {
+	store := memory.NewSourceStore()
+	require.NoError(t, store.Save(context.Background(), domain.Source{
+		ID:     "src-1",
+		Type:   "filesystem",
+		Name:   "Notes",
+		Config: map[string]string{"path": "/Users/me/Notes"},
+	}))
+
+	_, err := runSourceCloneCmd(t, store, "src-1", "--name", "Projects", "-c", "path=/Users/me/Projects")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Projects", cloneOf(t, store, "src-1").Name)
+}
+
+func TestCloneSourceName_CustomName(t *testing.T) {
+	original := &domain.Source{Type: "filesystem", Name: "Notes", Config: map[string]string{"path": "/a"}}
+
+	assert.Equal(t, "Notes (copy)", cloneSourceName(original, map[string]string{"path": "/b"}))
+}
+
+func TestSourceCloneCmd_InvalidConfig(t *testing.T) {
+	store := memory.NewSourceStore()
+	require.NoError(t, store.Save(context.Background(), domain.Source{ID: "src-1", Type: "filesystem"}))
+
+	_, err := runSourceCloneCmd(t, store, "src-1", "-c", "path")
+
+	assert.ErrorContains(t, err, "invalid config format: path")
+}
+
+func TestSourceCloneCmd_UnknownSource(t *testing.T) {
+	_, err := runSourceCloneCmd(t, memory.NewSourceStore(), "missing")
+
+	assert.ErrorContains(t, err, "source not found")
+}
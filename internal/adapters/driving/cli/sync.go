@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
 )
 
@@ -16,11 +19,29 @@ var syncCmd = &cobra.Command{
 	Short: "Synchronise documents from sources",
 	Long: `Triggers document synchronisation from configured sources.
 If a source ID is provided, only that source is synchronised.
-Otherwise, all sources are synchronised.`,
-	RunE: runSync,
+Otherwise, all sources are synchronised.
+
+With --parallel-within-source, connectors that fetch several content types
+(such as GitHub files, issues and pull requests) fetch them concurrently.
+Requests still share the connector's rate limiter.
+
+With --dry-run, sources are fetched and normalised but nothing is indexed and
+the sync cursor is not advanced. The number of documents that would be indexed
+is reported by MIME type, with their estimated size.`,
+	ValidArgsFunction: completeSourceIDs,
+	RunE:              runSync,
 }
 
+var (
+	syncParallelWithinSource bool
+	syncDryRun               bool
+)
+
 func init() {
+	syncCmd.Flags().BoolVar(&syncParallelWithinSource, "parallel-within-source", false,
+		"Fetch each source's content types concurrently where supported")
+	syncCmd.Flags().BoolVar(&syncDryRun, "dry-run", false,
+		"Report what would be indexed without writing anything")
 	rootCmd.AddCommand(syncCmd)
 }
 
@@ -31,6 +52,14 @@ func runSync(cmd *cobra.Command, args []string) error {
 
 	ctx := context.Background()
 
+	if syncParallelWithinSource {
+		syncOrchestrator.SetParallelWithinSource(true)
+	}
+
+	if syncDryRun {
+		return runSyncDryRun(ctx, cmd, args)
+	}
+
 	if len(args) > 0 {
 		// Sync specific source
 		sourceID := args[0]
@@ -78,9 +107,8 @@ func syncWithProgress(
 		case err := <-errCh:
 			// Print final status (ignore status error - best effort)
 			status, statusErr := syncOrch.Status(ctx, sourceID)
-			if statusErr == nil && status != nil && status.DocumentsProcessed > 0 {
-				cmd.Printf("\rProcessed %d documents (%d errors)\n",
-					status.DocumentsProcessed, status.ErrorCount)
+			if statusErr == nil && status != nil {
+				printSyncSummary(cmd, status)
 			}
 			return err
 		case <-ticker.C:
@@ -93,3 +121,101 @@ func syncWithProgress(
 		}
 	}
 }
+
+// printSyncSummary prints the outcome of a finished sync, naming any
+// documents that failed after retrying.
+func printSyncSummary(cmd *cobra.Command, status *driving.SyncStatus) {
+	if status.DocumentsProcessed == 0 && status.ErrorCount == 0 {
+		return
+	}
+	cmd.Printf("\rProcessed %d documents: %d succeeded, %d failed, %d skipped\n",
+		status.DocumentsProcessed+status.ErrorCount,
+		status.DocumentsProcessed, status.FailedCount, status.SkippedCount)
+	if status.CacheHits > 0 {
+		cmd.Printf("  reused embeddings for %d unchanged documents, embedded %d\n",
+			status.CacheHits, status.CacheMisses)
+	}
+	for _, uri := range status.FailedURIs {
+		cmd.Printf("  failed: %s\n", uri)
+	}
+}
+
+// runSyncDryRun previews the given source, or every source if none is given.
+func runSyncDryRun(ctx context.Context, cmd *cobra.Command, args []string) error {
+	sourceIDs := args
+	if len(sourceIDs) == 0 {
+		if sourceService == nil {
+			return errors.New("source service not configured")
+		}
+		sources, err := sourceService.List(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list sources: %w", err)
+		}
+		for i := range sources {
+			sourceIDs = append(sourceIDs, sources[i].ID)
+		}
+	}
+
+	if len(sourceIDs) == 0 {
+		cmd.Println("No sources configured.")
+		return nil
+	}
+
+	cmd.Println("Dry run: nothing will be indexed.")
+	for _, sourceID := range sourceIDs {
+		preview, err := syncOrchestrator.DryRun(ctx, sourceID)
+		if err != nil {
+			return fmt.Errorf("dry run failed for %s: %w", sourceID, err)
+		}
+		printSyncPreview(cmd, preview)
+	}
+	return nil
+}
+
+// printSyncPreview prints what a sync of one source would index.
+func printSyncPreview(cmd *cobra.Command, preview *domain.SyncPreview) {
+	mode := "full sync"
+	if preview.Incremental {
+		mode = "incremental sync"
+	}
+
+	cmd.Println()
+	cmd.Printf("Source %s (%s)\n", preview.SourceID, mode)
+	cmd.Printf("  Would index: %d documents (%s)\n", preview.Documents, formatByteSize(preview.Bytes))
+
+	mimeTypes := slices.Sorted(maps.Keys(preview.ByMIMEType))
+	for _, mimeType := range mimeTypes {
+		stats := preview.ByMIMEType[mimeType]
+		cmd.Printf("    %-40s %6d  %s\n", mimeType, stats.Documents, formatByteSize(stats.Bytes))
+	}
+
+	if preview.Deletions > 0 {
+		cmd.Printf("  Would delete: %d documents\n", preview.Deletions)
+	}
+	if preview.Excluded > 0 {
+		cmd.Printf("  Excluded: %d\n", preview.Excluded)
+	}
+	if preview.Unsupported > 0 {
+		cmd.Printf("  Unsupported: %d\n", preview.Unsupported)
+	}
+	if preview.Empty > 0 {
+		cmd.Printf("  Empty: %d\n", preview.Empty)
+	}
+	if preview.Errors > 0 {
+		cmd.Printf("  Errors: %d\n", preview.Errors)
+	}
+}
+
+// formatByteSize formats a byte count with a binary unit, e.g. "1.5 MiB".
+func formatByteSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockSyncAuditService implements driving.SyncAuditService for testing.
+type mockSyncAuditService struct {
+	entries []domain.SyncAuditEntry
+	filter  domain.SyncAuditFilter
+	cleared bool
+}
+
+func (m *mockSyncAuditService) List(
+	_ context.Context, filter domain.SyncAuditFilter,
+) ([]domain.SyncAuditEntry, error) {
+	m.filter = filter
+	return m.entries, nil
+}
+
+func (m *mockSyncAuditService) Clear(_ context.Context) (int, error) {
+	m.cleared = true
+	return len(m.entries), nil
+}
+
+func runSyncAuditLogCmd(t *testing.T, svc *mockSyncAuditService, args ...string) (string, error) {
+	t.Helper()
+	old := syncAuditService
+	syncAuditService = svc
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"sync", "audit-log"}, args...))
+	defer func() {
+		syncAuditService = old
+		rootCmd.SetArgs(nil)
+		syncAuditSource = ""
+		syncAuditAfter = ""
+		syncAuditLimit = domain.DefaultSyncAuditLimit
+		syncAuditClear = false
+	}()
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestSyncAuditLogCmd(t *testing.T) {
+	svc := &mockSyncAuditService{entries: []domain.SyncAuditEntry{
+		{SourceID: "src-1", DocumentURI: "notes.md", Event: domain.SyncAuditSkipped, Reason: "excluded"},
+		{SourceID: "src-1", DocumentURI: "todo.md", Event: domain.SyncAuditCreated},
+	}}
+
+	out, err := runSyncAuditLogCmd(t, svc, "--source", "src-1", "--after", "2024-03-01", "--limit", "5")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "skipped  src-1  notes.md (excluded)")
+	assert.Contains(t, out, "created  src-1  todo.md\n")
+	assert.Contains(t, out, "Showing 2 entries")
+	assert.Equal(t, "src-1", svc.filter.SourceID)
+	assert.Equal(t, 5, svc.filter.Limit)
+	assert.True(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local).Equal(svc.filter.After))
+}
+
+func TestSyncAuditLogCmd_Empty(t *testing.T) {
+	svc := &mockSyncAuditService{}
+
+	out, err := runSyncAuditLogCmd(t, svc)
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "No audit log entries found.")
+	assert.Equal(t, domain.DefaultSyncAuditLimit, svc.filter.Limit)
+	assert.True(t, svc.filter.After.IsZero())
+}
+
+func TestSyncAuditLogCmd_Clear(t *testing.T) {
+	svc := &mockSyncAuditService{entries: make([]domain.SyncAuditEntry, 3)}
+
+	out, err := runSyncAuditLogCmd(t, svc, "--clear")
+
+	require.NoError(t, err)
+	assert.True(t, svc.cleared)
+	assert.Contains(t, out, "Cleared 3 audit log entries.")
+}
+
+func TestSyncAuditLogCmd_InvalidAfter(t *testing.T) {
+	_, err := runSyncAuditLogCmd(t, &mockSyncAuditService{}, "--after", "last week")
+
+	assert.ErrorContains(t, err, `invalid --after date "last week"`)
+}
+
+func TestParseAuditDate_RFC3339(t *testing.T) {
+	after, err := parseAuditDate("2024-03-01T09:30:00Z")
+
+	require.NoError(t, err)
+	assert.True(t, time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC).Equal(after))
+}
+
+func TestSyncAuditLogCmd_NotConfigured(t *testing.T) {
+	old := syncAuditService
+	syncAuditService = nil
+	defer func() { syncAuditService = old }()
+
+	err := runSyncAuditLog(syncAuditLogCmd, nil)
+
+	assert.ErrorContains(t, err, "sync audit service not configured")
+}
@@ -6,14 +6,20 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
 )
 
 // mockSyncOrchestrator implements driving.SyncOrchestrator for testing.
-type mockSyncOrchestrator struct{}
+type mockSyncOrchestrator struct {
+	parallelWithinSource bool
+	synced               []string
+}
 
-func (m *mockSyncOrchestrator) Sync(_ context.Context, _ string) error {
+func (m *mockSyncOrchestrator) Sync(_ context.Context, sourceID string) error {
+	m.synced = append(m.synced, sourceID)
 	return nil
 }
 
@@ -25,6 +31,24 @@ func (m *mockSyncOrchestrator) Status(_ context.Context, _ string) (*driving.Syn
 	return nil, nil
 }
 
+func (m *mockSyncOrchestrator) SetParallelWithinSource(enabled bool) {
+	m.parallelWithinSource = enabled
+}
+
+func (m *mockSyncOrchestrator) DryRun(_ context.Context, sourceID string) (*domain.SyncPreview, error) {
+	m.synced = append(m.synced, sourceID)
+	return &domain.SyncPreview{
+		SourceID:  sourceID,
+		Documents: 3,
+		Bytes:     3 * 1024,
+		ByMIMEType: map[string]domain.MIMETypeStats{
+			"text/plain":    {Documents: 2, Bytes: 1024},
+			"text/markdown": {Documents: 1, Bytes: 2048},
+		},
+		Unsupported: 1,
+	}, nil
+}
+
 func setupSyncTest() func() {
 	oldSync := syncOrchestrator
 	syncOrchestrator = &mockSyncOrchestrator{}
@@ -142,3 +166,99 @@ func TestSyncCmd_ServiceError_AllSources(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "sync failed")
 }
+
+func TestSyncCmd_ParallelWithinSource(t *testing.T) {
+	mock := &mockSyncOrchestrator{}
+	oldSync := syncOrchestrator
+	syncOrchestrator = mock
+	defer func() {
+		syncOrchestrator = oldSync
+		syncParallelWithinSource = false
+	}()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs([]string{"sync", "--parallel-within-source", "source-456"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+	}()
+
+	err := rootCmd.Execute()
+
+	assert.NoError(t, err)
+	assert.True(t, mock.parallelWithinSource)
+}
+
+func TestSyncCmd_ParallelWithinSource_DefaultOff(t *testing.T) {
+	mock := &mockSyncOrchestrator{}
+	oldSync := syncOrchestrator
+	syncOrchestrator = mock
+	defer func() {
+		syncOrchestrator = oldSync
+	}()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs([]string{"sync", "source-456"})
+	defer func() {
+		rootCmd.SetArgs(nil)
+	}()
+
+	err := rootCmd.Execute()
+
+	assert.NoError(t, err)
+	assert.False(t, mock.parallelWithinSource)
+}
+
+func runSyncDryRunCmd(t *testing.T, mock *mockSyncOrchestrator, args ...string) (string, error) {
+	t.Helper()
+	oldSync := syncOrchestrator
+	syncOrchestrator = mock
+	defer func() {
+		syncOrchestrator = oldSync
+		syncDryRun = false
+	}()
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetErr(buf)
+	rootCmd.SetArgs(append([]string{"sync", "--dry-run"}, args...))
+	defer rootCmd.SetArgs(nil)
+
+	err := rootCmd.Execute()
+	return buf.String(), err
+}
+
+func TestSyncCmd_DryRun_SingleSource(t *testing.T) {
+	mock := &mockSyncOrchestrator{}
+
+	out, err := runSyncDryRunCmd(t, mock, "source-456")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"source-456"}, mock.synced)
+	assert.Contains(t, out, "Dry run: nothing will be indexed.")
+	assert.Contains(t, out, "Source source-456 (full sync)")
+	assert.Contains(t, out, "Would index: 3 documents (3.0 KiB)")
+	assert.Contains(t, out, "text/markdown")
+	assert.Contains(t, out, "Unsupported: 1")
+	assert.NotContains(t, out, "Synchronising")
+}
+
+func TestSyncCmd_DryRun_AllSources(t *testing.T) {
+	oldSource := sourceService
+	sourceService = &mockSourceService{}
+	defer func() { sourceService = oldSource }()
+	mock := &mockSyncOrchestrator{}
+
+	out, err := runSyncDryRunCmd(t, mock)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"src-1"}, mock.synced)
+	assert.Contains(t, out, "Source src-1 (full sync)")
+}
+
+func TestFormatByteSize(t *testing.T) {
+	assert.Equal(t, "512 B", formatByteSize(512))
+	assert.Equal(t, "1.5 KiB", formatByteSize(1536))
+	assert.Equal(t, "2.0 MiB", formatByteSize(2*1024*1024))
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -20,11 +21,42 @@ func (m *mockSearchService) Search(
 	return []domain.SearchResult{
 		{
 			Document: domain.Document{ID: "doc-1", Title: "Test Doc"},
-			Score:    0.95,
+			Chunk: domain.Chunk{
+				ID:         "chunk-1",
+				DocumentID: "doc-1",
+				Embedding:  []float32{0.1, 0.2, 0.3},
+				Metadata: map[string]any{
+					domain.ChunkMetaStartOffset: 0,
+					domain.ChunkMetaEndOffset:   42,
+				},
+			},
+			Score:  0.95,
+			Scores: domain.ScoreBreakdown{Keyword: 4.2, Vector: 0.88, Fused: 0.95},
 		},
 	}, nil
 }
 
+func (m *mockSearchService) Ask(
+	_ context.Context, question string, _ domain.SearchOptions, onAnswer func(string),
+) (domain.AskResult, error) {
+	if question == "" {
+		return domain.AskResult{}, nil
+	}
+	answer := "Deploys run from the release branch [Source: file:///docs/deploy.md]."
+	if onAnswer != nil {
+		onAnswer(answer[:21])
+		onAnswer(answer[21:])
+	}
+	return domain.AskResult{
+		Answer: answer,
+		Citations: []domain.SearchResult{{
+			Document: domain.Document{ID: "doc-1", Title: "Deploy Guide", URI: "file:///docs/deploy.md"},
+			Score:    0.95,
+		}},
+		FollowUpSuggestions: []string{"Who approves releases?"},
+	}, nil
+}
+
 // mockSourceService implements driving.SourceService for testing.
 type mockSourceService struct{}
 
@@ -50,6 +82,10 @@ func (m *mockSourceService) Update(_ context.Context, _ domain.Source) error {
 	return nil
 }
 
+func (m *mockSourceService) Rename(_ context.Context, _, _ string) error {
+	return nil
+}
+
 func (m *mockSourceService) ValidateConfig(_ context.Context, _ string, _ map[string]string) error {
 	return nil
 }
@@ -77,6 +113,10 @@ func (m *mockSourceServiceEmpty) Update(_ context.Context, _ domain.Source) erro
 	return nil
 }
 
+func (m *mockSourceServiceEmpty) Rename(_ context.Context, _, _ string) error {
+	return domain.ErrNotFound
+}
+
 func (m *mockSourceServiceEmpty) ValidateConfig(_ context.Context, _ string, _ map[string]string) error {
 	return nil
 }
@@ -107,6 +147,10 @@ func (m *mockSourceServiceWithAuth) Update(_ context.Context, _ domain.Source) e
 	return nil
 }
 
+func (m *mockSourceServiceWithAuth) Rename(_ context.Context, _, _ string) error {
+	return nil
+}
+
 func (m *mockSourceServiceWithAuth) ValidateConfig(_ context.Context, _ string, _ map[string]string) error {
 	return nil
 }
@@ -122,6 +166,12 @@ func (m *mockSyncOrchestratorFull) SyncAll(_ context.Context) error {
 	return nil
 }
 
+func (m *mockSyncOrchestratorFull) SetParallelWithinSource(_ bool) {}
+
+func (m *mockSyncOrchestratorFull) DryRun(_ context.Context, sourceID string) (*domain.SyncPreview, error) {
+	return &domain.SyncPreview{SourceID: sourceID}, nil
+}
+
 func (m *mockSyncOrchestratorFull) Status(_ context.Context, _ string) (*driving.SyncStatus, error) {
 	return nil, nil
 }
@@ -175,6 +225,14 @@ func (m *mockDocumentService) Refresh(_ context.Context, _ string) error {
 	return nil
 }
 
+func (m *mockDocumentService) ExcludeMultiple(_ context.Context, _ []string) error {
+	return nil
+}
+
+func (m *mockDocumentService) RefreshMultiple(_ context.Context, _ []string) error {
+	return nil
+}
+
 func (m *mockDocumentService) Open(_ context.Context, _ string) error {
 	return nil
 }
@@ -206,6 +264,14 @@ func (m *mockDocumentServiceEmpty) Refresh(_ context.Context, _ string) error {
 	return nil
 }
 
+func (m *mockDocumentServiceEmpty) ExcludeMultiple(_ context.Context, _ []string) error {
+	return nil
+}
+
+func (m *mockDocumentServiceEmpty) RefreshMultiple(_ context.Context, _ []string) error {
+	return nil
+}
+
 func (m *mockDocumentServiceEmpty) Open(_ context.Context, _ string) error {
 	return nil
 }
@@ -258,6 +324,14 @@ func (m *mockDocumentServiceNoMetadata) Refresh(_ context.Context, _ string) err
 	return nil
 }
 
+func (m *mockDocumentServiceNoMetadata) ExcludeMultiple(_ context.Context, _ []string) error {
+	return nil
+}
+
+func (m *mockDocumentServiceNoMetadata) RefreshMultiple(_ context.Context, _ []string) error {
+	return nil
+}
+
 func (m *mockDocumentServiceNoMetadata) Open(_ context.Context, _ string) error {
 	return nil
 }
@@ -292,6 +366,14 @@ func (m *mockDocumentServiceNoURI) Refresh(_ context.Context, _ string) error {
 	return nil
 }
 
+func (m *mockDocumentServiceNoURI) ExcludeMultiple(_ context.Context, _ []string) error {
+	return nil
+}
+
+func (m *mockDocumentServiceNoURI) RefreshMultiple(_ context.Context, _ []string) error {
+	return nil
+}
+
 func (m *mockDocumentServiceNoURI) Open(_ context.Context, _ string) error {
 	return nil
 }
@@ -387,6 +469,16 @@ func (m *mockConnectorRegistry) ExchangeCode(_ context.Context, _ string, _ *dom
 	return nil, nil
 }
 
+func (m *mockConnectorRegistry) RefreshToken(_ context.Context, _ string, _ *domain.AuthProvider, _ string) (*domain.OAuthToken, error) {
+	return nil, nil
+}
+
+func (m *mockConnectorRegistry) UnhandledMIMETypes() []domain.UnhandledMIMEType {
+	return []domain.UnhandledMIMEType{
+		{ConnectorType: "filesystem", MIMEType: "application/x-unknown"},
+	}
+}
+
 // mockConnectorRegistryEmpty implements driving.ConnectorRegistry that returns empty list.
 type mockConnectorRegistryEmpty struct{}
 
@@ -430,6 +522,14 @@ func (m *mockConnectorRegistryEmpty) ExchangeCode(_ context.Context, _ string, _
 	return nil, domain.ErrNotFound
 }
 
+func (m *mockConnectorRegistryEmpty) RefreshToken(_ context.Context, _ string, _ *domain.AuthProvider, _ string) (*domain.OAuthToken, error) {
+	return nil, domain.ErrNotFound
+}
+
+func (m *mockConnectorRegistryEmpty) UnhandledMIMETypes() []domain.UnhandledMIMEType {
+	return nil
+}
+
 // mockSearchServiceError implements driving.SearchService that returns errors.
 type mockSearchServiceError struct{}
 
@@ -437,6 +537,12 @@ func (m *mockSearchServiceError) Search(_ context.Context, _ string, _ domain.Se
 	return nil, domain.ErrNotFound
 }
 
+func (m *mockSearchServiceError) Ask(
+	_ context.Context, _ string, _ domain.SearchOptions, _ func(string),
+) (domain.AskResult, error) {
+	return domain.AskResult{}, domain.ErrLLMUnavailable
+}
+
 // mockSourceServiceError implements driving.SourceService that returns errors.
 type mockSourceServiceError struct{}
 
@@ -460,6 +566,10 @@ func (m *mockSourceServiceError) Update(_ context.Context, _ domain.Source) erro
 	return domain.ErrNotFound
 }
 
+func (m *mockSourceServiceError) Rename(_ context.Context, _, _ string) error {
+	return domain.ErrNotFound
+}
+
 func (m *mockSourceServiceError) ValidateConfig(_ context.Context, _ string, _ map[string]string) error {
 	return domain.ErrNotFound
 }
@@ -491,6 +601,14 @@ func (m *mockDocumentServiceError) Refresh(_ context.Context, _ string) error {
 	return domain.ErrNotFound
 }
 
+func (m *mockDocumentServiceError) ExcludeMultiple(_ context.Context, _ []string) error {
+	return domain.ErrNotFound
+}
+
+func (m *mockDocumentServiceError) RefreshMultiple(_ context.Context, _ []string) error {
+	return domain.ErrNotFound
+}
+
 func (m *mockDocumentServiceError) Open(_ context.Context, _ string) error {
 	return domain.ErrNotFound
 }
@@ -506,6 +624,12 @@ func (m *mockSyncOrchestratorError) SyncAll(_ context.Context) error {
 	return domain.ErrNotFound
 }
 
+func (m *mockSyncOrchestratorError) SetParallelWithinSource(_ bool) {}
+
+func (m *mockSyncOrchestratorError) DryRun(_ context.Context, sourceID string) (*domain.SyncPreview, error) {
+	return &domain.SyncPreview{SourceID: sourceID}, nil
+}
+
 func (m *mockSyncOrchestratorError) Status(_ context.Context, _ string) (*driving.SyncStatus, error) {
 	return nil, domain.ErrNotFound
 }
@@ -529,3 +653,52 @@ func setupTestServices() func() {
 		documentService = oldDocument
 	}
 }
+
+// mockScheduleService implements driving.ScheduleService for testing.
+type mockScheduleService struct {
+	tasks map[string]*domain.ScheduledTask
+	err   error
+}
+
+func newMockScheduleService() *mockScheduleService {
+	return &mockScheduleService{tasks: make(map[string]*domain.ScheduledTask)}
+}
+
+func (m *mockScheduleService) SetSourceSchedule(_ context.Context, sourceID string, interval time.Duration) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.tasks[sourceID] = &domain.ScheduledTask{
+		ID:       domain.SourceSyncTaskID(sourceID),
+		Interval: interval,
+		Enabled:  true,
+	}
+	return nil
+}
+
+func (m *mockScheduleService) SetSourceCron(_ context.Context, sourceID, expr string) error {
+	if m.err != nil {
+		return m.err
+	}
+	if strings.Count(expr, " ") != 4 {
+		return domain.ErrInvalidInput
+	}
+	m.tasks[sourceID] = &domain.ScheduledTask{
+		ID:      domain.SourceSyncTaskID(sourceID),
+		Cron:    expr,
+		Enabled: true,
+	}
+	return nil
+}
+
+func (m *mockScheduleService) ClearSourceSchedule(_ context.Context, sourceID string) error {
+	delete(m.tasks, sourceID)
+	return m.err
+}
+
+func (m *mockScheduleService) GetSourceSchedule(_ context.Context, sourceID string) (*domain.ScheduledTask, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.tasks[sourceID], nil
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"os"
 	"runtime/debug"
 
@@ -10,6 +11,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
 )
@@ -26,13 +29,21 @@ type TUIConfig struct {
 	SettingsService     driving.SettingsService
 	CredentialsService  driving.CredentialsService
 	AuthProviderService driving.AuthProviderService
+	SourceHealthService driving.SourceHealthService
+	SearchSession       driving.SearchSessionService
 	Scheduler           driving.Scheduler
 	SchedulerConfig     domain.SchedulerConfig
+	Watcher             driving.SyncWatcher
+	Theme               *styles.Theme
+	KeyMap              *keymap.KeyMap
 }
 
 // tuiConfig holds the current TUI configuration.
 var tuiConfig *TUIConfig
 
+// tuiWatch enables live indexing of changes to sources that support watching.
+var tuiWatch bool
+
 // tuiCmd represents the tui command.
 var tuiCmd = &cobra.Command{
 	Use:   "tui",
@@ -42,12 +53,22 @@ var tuiCmd = &cobra.Command{
 The TUI provides a visual interface for searching your indexed documents,
 managing sources, and viewing search results with keyboard navigation.
 
+With --watch, changes to local sources are indexed as they happen, and the
+search results and documents shown refresh to include them.
+
 Controls:
   ↑/k, ↓/j - Navigate results
   Enter    - Search / Select
   Esc      - Back / Cancel
   ?        - Toggle help
-  q        - Quit`,
+  x        - Forget the last search
+  q        - Quit
+
+Results update as you type once typing pauses, and recent queries' results
+are remembered, so returning to one shows them at once.
+
+The last search and selected result are restored when the search view
+next opens, so you can resume where you left off.`,
 	RunE: runTUI,
 }
 
@@ -57,9 +78,32 @@ func SetTUIConfig(config *TUIConfig) {
 }
 
 func init() {
+	tuiCmd.Flags().BoolVar(&tuiWatch, "watch", false, "index changes to local sources as they happen")
 	rootCmd.AddCommand(tuiCmd)
 }
 
+// startWatcher runs watcher in the background until the returned function
+// is called, which stops it and waits for it to finish.
+func startWatcher(watcher driving.SyncWatcher) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := watcher.Start(ctx); err != nil {
+			// Log but don't fail - the TUI still works without live updates
+			slog.Warn("watcher stopped", slog.Any("error", err))
+		}
+	}()
+
+	return func() {
+		cancel()
+		if err := watcher.Stop(); err != nil {
+			slog.Warn("failed to stop watcher", slog.Any("error", err))
+		}
+		<-done
+	}
+}
+
 func runTUI(cmd *cobra.Command, args []string) error {
 	// Add panic recovery to get stack traces
 	defer func() {
@@ -88,8 +132,15 @@ func runTUI(cmd *cobra.Command, args []string) error {
 		}()
 	}
 
+	// Index changes to local sources for as long as the TUI runs
+	var watcher driving.SyncWatcher
+	if tuiWatch && tuiConfig != nil && tuiConfig.Watcher != nil {
+		watcher = tuiConfig.Watcher
+		defer startWatcher(watcher)()
+	}
+
 	// Build ports from configuration
-	ports := &tui.Ports{}
+	ports := &tui.Ports{Watcher: watcher}
 
 	if tuiConfig != nil {
 		ports.Search = tuiConfig.SearchService
@@ -102,6 +153,10 @@ func runTUI(cmd *cobra.Command, args []string) error {
 		ports.Settings = tuiConfig.SettingsService
 		ports.Credentials = tuiConfig.CredentialsService
 		ports.AuthProvider = tuiConfig.AuthProviderService
+		ports.SourceHealth = tuiConfig.SourceHealthService
+		ports.SearchSession = tuiConfig.SearchSession
+		ports.Theme = tuiConfig.Theme
+		ports.KeyMap = tuiConfig.KeyMap
 	}
 
 	// Create the TUI app
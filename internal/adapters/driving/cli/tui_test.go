@@ -28,6 +28,12 @@ func (m *MockTUISearchService) Search(
 	return []domain.SearchResult{}, nil
 }
 
+func (m *MockTUISearchService) Ask(
+	_ context.Context, _ string, _ domain.SearchOptions, _ func(string),
+) (domain.AskResult, error) {
+	return domain.AskResult{}, nil
+}
+
 // MockTUISourceService implements driving.SourceService for TUI tests.
 type MockTUISourceService struct{}
 
@@ -51,6 +57,10 @@ func (m *MockTUISourceService) Update(ctx context.Context, source domain.Source)
 	return nil
 }
 
+func (m *MockTUISourceService) Rename(ctx context.Context, id, newName string) error {
+	return nil
+}
+
 func (m *MockTUISourceService) ValidateConfig(
 	ctx context.Context,
 	connectorType string,
@@ -70,6 +80,12 @@ func (m *MockTUISyncOrchestrator) SyncAll(ctx context.Context) error {
 	return nil
 }
 
+func (m *MockTUISyncOrchestrator) SetParallelWithinSource(_ bool) {}
+
+func (m *MockTUISyncOrchestrator) DryRun(_ context.Context, sourceID string) (*domain.SyncPreview, error) {
+	return &domain.SyncPreview{SourceID: sourceID}, nil
+}
+
 func (m *MockTUISyncOrchestrator) Status(ctx context.Context, sourceID string) (*driving.SyncStatus, error) {
 	return nil, nil
 }
@@ -124,6 +140,42 @@ func TestTUICmd_HelpOutput(t *testing.T) {
 	assert.Contains(t, output, "Controls:")
 }
 
+// mockSyncWatcher implements driving.SyncWatcher, recording its lifecycle.
+type mockSyncWatcher struct {
+	started chan struct{}
+	stopped bool
+}
+
+func (m *mockSyncWatcher) Start(ctx context.Context) error {
+	close(m.started)
+	<-ctx.Done()
+	return nil
+}
+
+func (m *mockSyncWatcher) Stop() error {
+	m.stopped = true
+	return nil
+}
+
+func (m *mockSyncWatcher) Updates() <-chan domain.WatchUpdate { return nil }
+
+func TestStartWatcher_StopsWatcher(t *testing.T) {
+	watcher := &mockSyncWatcher{started: make(chan struct{})}
+
+	stop := startWatcher(watcher)
+	<-watcher.started
+	stop()
+
+	assert.True(t, watcher.stopped)
+}
+
+func TestTUICmd_WatchFlag(t *testing.T) {
+	flag := tuiCmd.Flags().Lookup("watch")
+
+	require.NotNil(t, flag)
+	assert.Equal(t, "false", flag.DefValue)
+}
+
 func TestTUIConfig_Fields(t *testing.T) {
 	config := &TUIConfig{
 		SearchService:    &MockTUISearchService{},
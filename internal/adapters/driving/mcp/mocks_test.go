@@ -21,6 +21,12 @@ func (m *mockSearchService) Search(
 	return m.results, m.err
 }
 
+func (m *mockSearchService) Ask(
+	_ context.Context, _ string, _ domain.SearchOptions, _ func(string),
+) (domain.AskResult, error) {
+	return domain.AskResult{}, m.err
+}
+
 // mockSourceService is a mock implementation of driving.SourceService.
 type mockSourceService struct {
 	sources []domain.Source
@@ -48,6 +54,10 @@ func (m *mockSourceService) Update(_ context.Context, _ domain.Source) error {
 	return m.err
 }
 
+func (m *mockSourceService) Rename(_ context.Context, _, _ string) error {
+	return m.err
+}
+
 func (m *mockSourceService) ValidateConfig(_ context.Context, _ string, _ map[string]string) error {
 	return m.err
 }
@@ -85,6 +95,14 @@ func (m *mockDocumentService) Refresh(_ context.Context, _ string) error {
 	return m.err
 }
 
+func (m *mockDocumentService) ExcludeMultiple(_ context.Context, _ []string) error {
+	return m.err
+}
+
+func (m *mockDocumentService) RefreshMultiple(_ context.Context, _ []string) error {
+	return m.err
+}
+
 func (m *mockDocumentService) Open(_ context.Context, _ string) error {
 	return m.err
 }
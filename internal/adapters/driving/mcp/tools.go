@@ -27,6 +27,7 @@ type SearchResultOutput struct {
 	URI        string   `json:"uri"`
 	Score      float64  `json:"score"`
 	Highlights []string `json:"highlights,omitempty"`
+	Snippet    string   `json:"snippet,omitempty"`
 	Content    string   `json:"content,omitempty"`
 }
 
@@ -67,6 +68,7 @@ func (s *Server) handleSearch(
 			URI:        results[i].Document.URI,
 			Score:      results[i].Score,
 			Highlights: results[i].Highlights,
+			Snippet:    results[i].Snippet,
 			Content:    results[i].Chunk.Content,
 		}
 	}
@@ -28,6 +28,7 @@ func TestServer_handleSearch(t *testing.T) {
 					},
 					Score:      0.95,
 					Highlights: []string{"matched text"},
+					Snippet:    "the <b>matched</b> text",
 				},
 			},
 		}
@@ -47,6 +48,7 @@ func TestServer_handleSearch(t *testing.T) {
 		assert.Equal(t, "/path/to/doc", output.Results[0].URI)
 		assert.Equal(t, 0.95, output.Results[0].Score)
 		assert.Equal(t, "This is the content", output.Results[0].Content)
+		assert.Equal(t, "the <b>matched</b> text", output.Results[0].Snippet)
 	})
 
 	t.Run("default limit is 10", func(t *testing.T) {
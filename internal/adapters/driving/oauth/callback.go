@@ -1,4 +1,4 @@
-// Package oauth provides OAuth callback server and browser utilities.
+// Package oauth provides OAuth callback server, device code flow and browser utilities.
 package oauth
 
 import (
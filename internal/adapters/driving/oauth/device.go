@@ -0,0 +1,222 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// deviceGrantType is the grant type for polling the token endpoint (RFC 8628).
+const deviceGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+const (
+	// defaultDeviceInterval is the polling interval when the provider sends none.
+	defaultDeviceInterval = 5 * time.Second
+	// slowDownIncrement is added to the polling interval on a slow_down response.
+	slowDownIncrement = 5 * time.Second
+)
+
+var (
+	// ErrDeviceAccessDenied indicates the user declined the authorization request.
+	ErrDeviceAccessDenied = errors.New("authorization denied by user")
+	// ErrDeviceCodeExpired indicates the user code expired before it was entered.
+	ErrDeviceCodeExpired = errors.New("device code expired")
+)
+
+// DeviceAuthorization is the provider's response to a device authorization
+// request: the code the user enters and where to enter it.
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// DeviceToken holds the tokens issued once the user approves a device code.
+type DeviceToken struct {
+	AccessToken  string
+	RefreshToken string
+	TokenType    string
+	Expiry       time.Time
+}
+
+// deviceHTTPClient sends device flow requests.
+var deviceHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// sleep waits for d or until ctx is done. Swappable for tests.
+var sleep = func(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RequestDeviceCode starts the device code flow, returning the user code and
+// verification URL to show the user.
+func RequestDeviceCode(
+	ctx context.Context,
+	deviceURL, clientID string,
+	scopes []string,
+) (*DeviceAuthorization, error) {
+	data := url.Values{}
+	data.Set("client_id", clientID)
+	if len(scopes) > 0 {
+		data.Set("scope", strings.Join(scopes, " "))
+	}
+
+	body, status, err := postForm(ctx, deviceURL, data)
+	if err != nil {
+		return nil, fmt.Errorf("device authorization request: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("device authorization failed: %s", describeError(body, status))
+	}
+
+	var auth struct {
+		DeviceAuthorization
+		// Google names the field verification_url
+		VerificationURL string `json:"verification_url"`
+	}
+	if err := json.Unmarshal(body, &auth); err != nil {
+		return nil, fmt.Errorf("decode device authorization: %w", err)
+	}
+	if auth.VerificationURI == "" {
+		auth.VerificationURI = auth.VerificationURL
+	}
+	if auth.DeviceCode == "" || auth.UserCode == "" {
+		return nil, errors.New("device authorization response missing device or user code")
+	}
+	return &auth.DeviceAuthorization, nil
+}
+
+// PollDeviceToken polls the token endpoint until the user approves or denies
+// the device code, the code expires, or ctx is done. It waits the interval
+// given by the provider between requests and backs off on slow_down.
+func PollDeviceToken(
+	ctx context.Context,
+	tokenURL, clientID, clientSecret string,
+	auth *DeviceAuthorization,
+) (*DeviceToken, error) {
+	interval := time.Duration(auth.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultDeviceInterval
+	}
+	if auth.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(auth.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+
+	data := url.Values{}
+	data.Set("grant_type", deviceGrantType)
+	data.Set("device_code", auth.DeviceCode)
+	data.Set("client_id", clientID)
+	if clientSecret != "" {
+		data.Set("client_secret", clientSecret)
+	}
+
+	for {
+		if err := sleep(ctx, interval); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return nil, ErrDeviceCodeExpired
+			}
+			return nil, err
+		}
+
+		body, status, err := postForm(ctx, tokenURL, data)
+		if err != nil {
+			return nil, fmt.Errorf("token request: %w", err)
+		}
+
+		// GitHub reports pending authorization with a 200 status, so the
+		// error field is checked before the status
+		var resp struct {
+			AccessToken  string `json:"access_token"`
+			RefreshToken string `json:"refresh_token"`
+			TokenType    string `json:"token_type"`
+			ExpiresIn    int    `json:"expires_in"`
+			Error        string `json:"error"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil && status == http.StatusOK {
+			return nil, fmt.Errorf("decode token response: %w", err)
+		}
+
+		switch resp.Error {
+		case "":
+		case "authorization_pending":
+			continue
+		case "slow_down":
+			interval += slowDownIncrement
+			continue
+		case "access_denied":
+			return nil, ErrDeviceAccessDenied
+		case "expired_token":
+			return nil, ErrDeviceCodeExpired
+		default:
+			return nil, fmt.Errorf("token error: %s", describeError(body, status))
+		}
+
+		if status != http.StatusOK || resp.AccessToken == "" {
+			return nil, fmt.Errorf("token request failed: %s", describeError(body, status))
+		}
+
+		token := &DeviceToken{
+			AccessToken:  resp.AccessToken,
+			RefreshToken: resp.RefreshToken,
+			TokenType:    resp.TokenType,
+		}
+		if resp.ExpiresIn > 0 {
+			token.Expiry = time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second)
+		}
+		return token, nil
+	}
+}
+
+// postForm posts form data and returns the response body and status.
+func postForm(ctx context.Context, endpoint string, data url.Values) ([]byte, int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, 0, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := deviceHTTPClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, 0, fmt.Errorf("read response: %w", err)
+	}
+	return body, resp.StatusCode, nil
+}
+
+// describeError formats an OAuth error response, falling back to the status.
+func describeError(body []byte, status int) string {
+	var errResp struct {
+		Error       string `json:"error"`
+		Description string `json:"error_description"`
+	}
+	if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error != "" {
+		if errResp.Description != "" {
+			return errResp.Error + " - " + errResp.Description
+		}
+		return errResp.Error
+	}
+	return fmt.Sprintf("status %d", status)
+}
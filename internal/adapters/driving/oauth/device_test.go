@@ -0,0 +1,267 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordSleeps replaces sleep for the duration of the test, recording the
+// intervals waited instead of waiting.
+func recordSleeps(t *testing.T) *[]time.Duration {
+	t.Helper()
+	var waits []time.Duration
+	old := sleep
+	sleep = func(ctx context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return ctx.Err()
+	}
+	t.Cleanup(func() { sleep = old })
+	return &waits
+}
+
+// tokenServer serves the given responses to successive token polls.
+type tokenServer struct {
+	mu        sync.Mutex
+	responses []tokenResponse
+	requests  []map[string]string
+}
+
+type tokenResponse struct {
+	status int
+	body   map[string]any
+}
+
+func (s *tokenServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_ = r.ParseForm()
+	form := make(map[string]string)
+	for k := range r.PostForm {
+		form[k] = r.PostForm.Get(k)
+	}
+	s.requests = append(s.requests, form)
+
+	resp := s.responses[0]
+	if len(s.responses) > 1 {
+		s.responses = s.responses[1:]
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(resp.status)
+	_ = json.NewEncoder(w).Encode(resp.body)
+}
+
+func pollTestServer(t *testing.T, responses ...tokenResponse) (*tokenServer, *httptest.Server) {
+	t.Helper()
+	ts := &tokenServer{responses: responses}
+	server := httptest.NewServer(ts)
+	t.Cleanup(server.Close)
+	return ts, server
+}
+
+func testDeviceAuthorization() *DeviceAuthorization {
+	return &DeviceAuthorization{DeviceCode: "device-123", UserCode: "ABCD-EFGH", Interval: 2, ExpiresIn: 900}
+}
+
+var (
+	pending  = tokenResponse{http.StatusBadRequest, map[string]any{"error": "authorization_pending"}}
+	slowDown = tokenResponse{http.StatusBadRequest, map[string]any{"error": "slow_down"}}
+	granted  = tokenResponse{http.StatusOK, map[string]any{
+		"access_token":  "access",
+		"refresh_token": "refresh",
+		"token_type":    "Bearer",
+		"expires_in":    3600,
+	}}
+)
+
+func TestRequestDeviceCode(t *testing.T) {
+	var form map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		form = map[string]string{"client_id": r.PostForm.Get("client_id"), "scope": r.PostForm.Get("scope")}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"device_code":      "device-123",
+			"user_code":        "ABCD-EFGH",
+			"verification_uri": "https://example.com/device",
+			"expires_in":       900,
+			"interval":         5,
+		})
+	}))
+	defer server.Close()
+
+	auth, err := RequestDeviceCode(context.Background(), server.URL, "client", []string{"read", "write"})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"client_id": "client", "scope": "read write"}, form)
+	assert.Equal(t, "device-123", auth.DeviceCode)
+	assert.Equal(t, "ABCD-EFGH", auth.UserCode)
+	assert.Equal(t, "https://example.com/device", auth.VerificationURI)
+	assert.Equal(t, 5, auth.Interval)
+}
+
+func TestRequestDeviceCode_GoogleVerificationURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"device_code":      "device-123",
+			"user_code":        "ABCD-EFGH",
+			"verification_url": "https://www.google.com/device",
+		})
+	}))
+	defer server.Close()
+
+	auth, err := RequestDeviceCode(context.Background(), server.URL, "client", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.google.com/device", auth.VerificationURI)
+}
+
+func TestRequestDeviceCode_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"error":             "invalid_client",
+			"error_description": "client type not allowed",
+		})
+	}))
+	defer server.Close()
+
+	_, err := RequestDeviceCode(context.Background(), server.URL, "client", nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid_client - client type not allowed")
+}
+
+func TestPollDeviceToken_PendingThenGranted(t *testing.T) {
+	waits := recordSleeps(t)
+	ts, server := pollTestServer(t, pending, pending, granted)
+
+	token, err := PollDeviceToken(context.Background(), server.URL, "client", "secret", testDeviceAuthorization())
+
+	require.NoError(t, err)
+	assert.Equal(t, "access", token.AccessToken)
+	assert.Equal(t, "refresh", token.RefreshToken)
+	assert.Equal(t, "Bearer", token.TokenType)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), token.Expiry, time.Minute)
+
+	assert.Equal(t, []time.Duration{2 * time.Second, 2 * time.Second, 2 * time.Second}, *waits)
+	require.Len(t, ts.requests, 3)
+	assert.Equal(t, map[string]string{
+		"grant_type":    deviceGrantType,
+		"device_code":   "device-123",
+		"client_id":     "client",
+		"client_secret": "secret",
+	}, ts.requests[0])
+}
+
+func TestPollDeviceToken_SlowDownIncreasesInterval(t *testing.T) {
+	waits := recordSleeps(t)
+	_, server := pollTestServer(t, slowDown, pending, slowDown, granted)
+
+	_, err := PollDeviceToken(context.Background(), server.URL, "client", "", testDeviceAuthorization())
+
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{
+		2 * time.Second, 7 * time.Second, 7 * time.Second, 12 * time.Second,
+	}, *waits)
+}
+
+func TestPollDeviceToken_PendingWithOKStatus(t *testing.T) {
+	// GitHub reports errors with a 200 status
+	recordSleeps(t)
+	githubPending := tokenResponse{http.StatusOK, map[string]any{"error": "authorization_pending"}}
+	ts, server := pollTestServer(t, githubPending, granted)
+
+	token, err := PollDeviceToken(context.Background(), server.URL, "client", "", testDeviceAuthorization())
+
+	require.NoError(t, err)
+	assert.Equal(t, "access", token.AccessToken)
+	assert.Len(t, ts.requests, 2)
+	_, sentSecret := ts.requests[0]["client_secret"]
+	assert.False(t, sentSecret)
+}
+
+func TestPollDeviceToken_DefaultInterval(t *testing.T) {
+	waits := recordSleeps(t)
+	_, server := pollTestServer(t, granted)
+
+	_, err := PollDeviceToken(context.Background(), server.URL, "client", "", &DeviceAuthorization{DeviceCode: "d"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{defaultDeviceInterval}, *waits)
+}
+
+func TestPollDeviceToken_Terminal(t *testing.T) {
+	tests := []struct {
+		name     string
+		response tokenResponse
+		wantErr  error
+		contains string
+	}{
+		{
+			name:     "access denied",
+			response: tokenResponse{http.StatusBadRequest, map[string]any{"error": "access_denied"}},
+			wantErr:  ErrDeviceAccessDenied,
+		},
+		{
+			name:     "expired token",
+			response: tokenResponse{http.StatusBadRequest, map[string]any{"error": "expired_token"}},
+			wantErr:  ErrDeviceCodeExpired,
+		},
+		{
+			name:     "other error",
+			response: tokenResponse{http.StatusBadRequest, map[string]any{"error": "invalid_grant"}},
+			contains: "invalid_grant",
+		},
+		{
+			name:     "server error",
+			response: tokenResponse{http.StatusInternalServerError, map[string]any{}},
+			contains: "status 500",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recordSleeps(t)
+			ts, server := pollTestServer(t, pending, tt.response)
+
+			_, err := PollDeviceToken(context.Background(), server.URL, "client", "", testDeviceAuthorization())
+
+			require.Error(t, err)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+			assert.Contains(t, err.Error(), tt.contains)
+			assert.Len(t, ts.requests, 2, "polling stops at a terminal response")
+		})
+	}
+}
+
+func TestPollDeviceToken_Cancelled(t *testing.T) {
+	recordSleeps(t)
+	_, server := pollTestServer(t, pending)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := PollDeviceToken(ctx, server.URL, "client", "", testDeviceAuthorization())
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestPollDeviceToken_ExpiresWhileWaiting(t *testing.T) {
+	_, server := pollTestServer(t, pending)
+	auth := testDeviceAuthorization()
+	auth.ExpiresIn = 1
+	auth.Interval = 5
+
+	_, err := PollDeviceToken(context.Background(), server.URL, "client", "", auth)
+
+	assert.ErrorIs(t, err, ErrDeviceCodeExpired)
+}
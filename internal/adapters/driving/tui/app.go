@@ -6,21 +6,48 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/addsource"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/doccontent"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/docdetails"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/documents"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/help"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/menu"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/search"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/settings"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/sourcedetail"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/sources"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/views/sourcestatus"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
 )
 
+// KeyBinding describes keys a view responds to, for the help overlay.
+// It aliases keymap.KeyBinding so that views can return it without
+// importing this package.
+type KeyBinding = keymap.KeyBinding
+
+// keyBindingsView is implemented by views whose keys are listed in the help overlay.
+type keyBindingsView interface {
+	KeyBindings() []KeyBinding
+}
+
+// viewTitles names views in the help overlay title.
+var viewTitles = map[messages.ViewType]string{
+	messages.ViewMenu:         "Menu",
+	messages.ViewSearch:       "Search",
+	messages.ViewSources:      "Sources",
+	messages.ViewSourceDetail: "Source Details",
+	messages.ViewDocuments:    "Documents",
+	messages.ViewDocContent:   "Document Content",
+	messages.ViewDocDetails:   "Document Details",
+	messages.ViewAddSource:    "Add Source",
+	messages.ViewSettings:     "Settings",
+	messages.ViewSourceStatus: "Source Status",
+}
+
 // App is the main TUI application following the Elm architecture.
 // It implements tea.Model for use with Bubbletea.
 type App struct {
@@ -60,6 +87,18 @@ type App struct {
 	// settingsView is the settings configuration view component.
 	settingsView *settings.View
 
+	// sourceStatusView is the source health dashboard.
+	sourceStatusView *sourcestatus.View
+
+	// helpOverlay lists the key bindings of the view beneath it.
+	helpOverlay *help.Overlay
+
+	// viewStack holds the views beneath overlays such as help, most recent last.
+	viewStack []messages.ViewType
+
+	// keys holds the global keybindings.
+	keys *keymap.KeyMap
+
 	// selectedSource tracks the currently selected source for navigation.
 	selectedSource *domain.Source
 
@@ -98,19 +137,39 @@ func NewApp(ports *Ports) (*App, error) {
 		return nil, fmt.Errorf("creating app: %w", err)
 	}
 
-	s := styles.DefaultStyles()
+	s := styles.NewStyles(ports.Theme)
+	keys := ports.KeyMap
+	if keys == nil {
+		keys = keymap.DefaultKeyMap()
+	}
 	menuView := menu.NewView(s)
-	searchView := search.NewView(s, nil, ports.Search, ports.ResultAction)
+	menuView.SetKeyMap(keys)
+	searchView := search.NewView(s, keys, ports.Search, ports.ResultAction)
+	searchView.SetDocumentService(ports.Document)
+	searchView.SetSessionService(ports.SearchSession)
 	sourcesView := sources.NewView(s, ports.Source, ports.Credentials)
+	sourcesView.SetHealthService(ports.SourceHealth)
+	sourcesView.SetKeyMap(keys)
 	sourceDetailView := sourcedetail.NewView(s, ports.Source, ports.Sync, ports.Document)
+	sourceDetailView.SetKeyMap(keys)
 	documentsView := documents.NewView(s, ports.Document)
+	documentsView.SetKeyMap(keys)
 	docContentView := doccontent.NewView(s, ports.Document)
+	docContentView.SetKeyMap(keys)
 	docDetailsView := docdetails.NewView(s)
+	docDetailsView.SetKeyMap(keys)
 	addSourceView := addsource.NewView(
 		s, ports.Source, ports.ConnectorRegistry, ports.ProviderRegistry,
 		ports.AuthProvider, ports.Credentials,
 	)
+	addSourceView.SetKeyMap(keys)
 	settingsView := settings.NewView(s, ports.Settings)
+	settingsView.SetKeyMap(keys)
+	sourceStatusView := sourcestatus.NewView(s, ports.Source, ports.Sync)
+	sourceStatusView.SetHealthService(ports.SourceHealth)
+	sourceStatusView.SetKeyMap(keys)
+	helpOverlay := help.NewOverlay(s)
+	helpOverlay.SetKeyMap(keys)
 
 	return &App{
 		ports:            ports,
@@ -125,6 +184,9 @@ func NewApp(ports *Ports) (*App, error) {
 		docDetailsView:   docDetailsView,
 		addSourceView:    addSourceView,
 		settingsView:     settingsView,
+		sourceStatusView: sourceStatusView,
+		helpOverlay:      helpOverlay,
+		keys:             keys,
 		currentView:      messages.ViewMenu, // Start with menu
 	}, nil
 }
@@ -141,9 +203,26 @@ func (a *App) Init() tea.Cmd {
 	return tea.Batch(
 		tea.EnterAltScreen,
 		tea.SetWindowTitle("sercha - Local Search"),
+		a.waitForWatchUpdate(),
 	)
 }
 
+// waitForWatchUpdate returns a command that waits for the watcher's next
+// update, or nil without a watcher. It is reissued after each update.
+func (a *App) waitForWatchUpdate() tea.Cmd {
+	if a.ports.Watcher == nil {
+		return nil
+	}
+	updates := a.ports.Watcher.Updates()
+	return func() tea.Msg {
+		update, ok := <-updates
+		if !ok {
+			return nil
+		}
+		return messages.WatchUpdated{Update: update}
+	}
+}
+
 // Update implements tea.Model.
 // It handles messages and updates the model state.
 //
@@ -166,14 +245,23 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.docDetailsView.SetDimensions(msg.Width, msg.Height)
 		a.addSourceView.SetDimensions(msg.Width, msg.Height)
 		a.settingsView.SetDimensions(msg.Width, msg.Height)
+		a.sourceStatusView.SetDimensions(msg.Width, msg.Height)
+		a.helpOverlay.SetDimensions(msg.Width, msg.Height)
 		return a, nil
 
 	case tea.KeyMsg:
 		// Global quit with ctrl+c
 		if msg.String() == "ctrl+c" {
+			a.saveSearchSession()
 			return a, tea.Quit
 		}
 
+		// '?' shows help for the current view, unless it is being typed
+		if a.currentView != messages.ViewHelp && keymap.Matches(msg.String(), a.keys.Help) && !a.inputFocused() {
+			a.pushHelp()
+			return a, nil
+		}
+
 		// Forward key messages to active view
 		switch a.currentView {
 		case messages.ViewMenu:
@@ -190,8 +278,8 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return a, cmd
 
 		case messages.ViewSources:
-			// Esc from sources goes to menu
-			if msg.Type == tea.KeyEsc {
+			// Esc from sources goes to menu, unless it cancels a rename
+			if msg.Type == tea.KeyEsc && !a.sourcesView.Renaming() {
 				a.currentView = messages.ViewMenu
 				return a, nil
 			}
@@ -215,12 +303,13 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return a, cmd
 
 		case messages.ViewHelp:
-			// Esc from help goes to menu
-			if msg.Type == tea.KeyEsc {
-				a.currentView = messages.ViewMenu
+			// Closing help returns to the view beneath it
+			if a.helpOverlay.Closes(msg) {
+				a.popView()
 				return a, nil
 			}
-			return a, nil
+			a.helpOverlay, cmd = a.helpOverlay.Update(msg)
+			return a, cmd
 
 		case messages.ViewAddSource:
 			a.addSourceView, cmd = a.addSourceView.Update(msg)
@@ -229,25 +318,38 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case messages.ViewSettings:
 			a.settingsView, cmd = a.settingsView.Update(msg)
 			return a, cmd
+
+		case messages.ViewSourceStatus:
+			a.sourceStatusView, cmd = a.sourceStatusView.Update(msg)
+			return a, cmd
 		}
 		return a, nil
 
-	case messages.SearchCompleted:
+	case messages.SearchCompleted, messages.AnswerChunk, messages.AskCompleted:
 		// Forward to searchView
 		a.searchView, cmd = a.searchView.Update(msg)
 		// Sync state
 		a.results = a.searchView.Results()
 		a.err = a.searchView.Err()
-		a.selectedIndex = 0
+		a.selectedIndex = a.searchView.SelectedIndex()
 		return a, cmd
 
 	case messages.ViewChanged:
+		// Help opens over the current view, e.g. from the menu
+		if msg.View == messages.ViewHelp {
+			a.pushHelp()
+			return a, nil
+		}
+		if a.currentView == messages.ViewSearch && msg.View != messages.ViewSearch {
+			a.saveSearchSession()
+		}
+		a.viewStack = nil
 		a.currentView = msg.View
 		// Initialise views when switching to them
 		switch msg.View {
 		case messages.ViewSearch:
 			a.searchView.Reset()
-			return a, a.searchView.Init()
+			return a, tea.Batch(a.searchView.Init(), a.searchView.RestoreSession())
 		case messages.ViewSources:
 			return a, a.sourcesView.Init()
 		case messages.ViewSourceDetail:
@@ -258,6 +360,8 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case messages.ViewSettings:
 			a.settingsView.Reset()
 			return a, a.settingsView.Init()
+		case messages.ViewSourceStatus:
+			return a, a.sourceStatusView.Init()
 		case messages.ViewMenu, messages.ViewHelp,
 			messages.ViewDocuments, messages.ViewDocContent, messages.ViewDocDetails:
 			// Other views don't need special initialisation
@@ -309,6 +413,14 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.documentsView, cmd = a.documentsView.Update(msg)
 		return a, cmd
 
+	case messages.DocumentsExcluded:
+		a.documentsView, cmd = a.documentsView.Update(msg)
+		return a, cmd
+
+	case messages.DocumentsRefreshed:
+		a.documentsView, cmd = a.documentsView.Update(msg)
+		return a, cmd
+
 	case messages.ErrorOccurred:
 		a.err = msg.Err
 		// Forward to current view
@@ -324,15 +436,16 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case messages.ViewAddSource:
 			a.addSourceView, cmd = a.addSourceView.Update(msg)
 		case messages.ViewMenu, messages.ViewSources, messages.ViewHelp,
-			messages.ViewSourceDetail, messages.ViewSettings:
+			messages.ViewSourceDetail, messages.ViewSettings, messages.ViewSourceStatus:
 			// Other views don't handle error messages
 		}
 		return a, cmd
 
 	case messages.Quit:
+		a.saveSearchSession()
 		return a, tea.Quit
 
-	case messages.SourcesLoaded, messages.SourceRemoved:
+	case messages.SourcesLoaded, messages.SourceRemoved, messages.SourceRenamed:
 		// Forward to relevant view
 		if a.currentView == messages.ViewSources {
 			a.sourcesView, cmd = a.sourcesView.Update(msg)
@@ -343,6 +456,30 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return a, cmd
 		}
 
+	case messages.SyncCompleted:
+		// The source detail and status views track their syncs even when
+		// not displayed. Cached search results may predate the sync.
+		a.searchView.ClearCache()
+		var statusCmd tea.Cmd
+		a.sourceDetailView, cmd = a.sourceDetailView.Update(msg)
+		a.sourceStatusView, statusCmd = a.sourceStatusView.Update(msg)
+		return a, tea.Batch(cmd, statusCmd)
+
+	case messages.WatchUpdated:
+		// Refresh the displayed results or documents with the changes
+		a.searchView.ClearCache()
+		switch a.currentView {
+		case messages.ViewSearch:
+			a.searchView, cmd = a.searchView.Update(msg)
+		case messages.ViewDocuments:
+			a.documentsView, cmd = a.documentsView.Update(msg)
+		case messages.ViewMenu, messages.ViewSources, messages.ViewHelp, messages.ViewSourceDetail,
+			messages.ViewDocContent, messages.ViewDocDetails, messages.ViewAddSource,
+			messages.ViewSettings, messages.ViewSourceStatus:
+			// Other views load their data when opened
+		}
+		return a, tea.Batch(cmd, a.waitForWatchUpdate())
+
 	case messages.SourceAdded:
 		// Forward to add source view
 		if a.currentView == messages.ViewAddSource {
@@ -378,6 +515,8 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.addSourceView, cmd = a.addSourceView.Update(msg)
 	case messages.ViewSettings:
 		a.settingsView, cmd = a.settingsView.Update(msg)
+	case messages.ViewSourceStatus:
+		a.sourceStatusView, cmd = a.sourceStatusView.Update(msg)
 	case messages.ViewHelp:
 		// Help view doesn't need to handle other messages
 	}
@@ -411,8 +550,10 @@ func (a *App) View() string {
 		return a.addSourceView.View()
 	case messages.ViewSettings:
 		return a.settingsView.View()
+	case messages.ViewSourceStatus:
+		return a.sourceStatusView.View()
 	case messages.ViewHelp:
-		return a.viewHelp()
+		return a.helpOverlay.View()
 	default:
 		return a.menuView.View()
 	}
@@ -428,29 +569,90 @@ func (a *App) viewSources() string {
 	return a.sourcesView.View()
 }
 
-// viewHelp renders the help view.
-func (a *App) viewHelp() string {
-	return `Help
+// pushHelp opens the help overlay over the current view.
+func (a *App) pushHelp() {
+	a.helpOverlay.SetBindings(viewTitles[a.currentView], a.keyBindings(a.currentView))
+	a.viewStack = append(a.viewStack, a.currentView)
+	a.currentView = messages.ViewHelp
+}
 
-Navigation:
-  esc         Back to Menu
-  ctrl+c      Quit
+// popView returns to the view beneath the current overlay, or to the menu
+// if there is none.
+func (a *App) popView() {
+	if len(a.viewStack) == 0 {
+		a.currentView = messages.ViewMenu
+		return
+	}
+	a.currentView = a.viewStack[len(a.viewStack)-1]
+	a.viewStack = a.viewStack[:len(a.viewStack)-1]
+}
 
-Menu:
-  j/k, ↑/↓    Navigate options
-  enter       Select option
-  q           Quit
+// saveSearchSession remembers the search shown in the search view so it is
+// restored on the next launch. Failing to remember it is not worth
+// interrupting the user for, so errors are ignored.
+func (a *App) saveSearchSession() {
+	_ = a.searchView.SaveSession()
+}
 
-Search:
-  (type)      Enter search query
-  enter       Submit search
-  esc         Back to Menu
+// keyBindings returns the key bindings of a view followed by the global ones.
+func (a *App) keyBindings(view messages.ViewType) []KeyBinding {
+	var bindings []KeyBinding
+	if v, ok := a.viewModel(view).(keyBindingsView); ok {
+		bindings = append(bindings, v.KeyBindings()...)
+	}
+	return append(bindings,
+		keymap.FromBinding("Global", a.keys.Help),
+		KeyBinding{Group: "Global", Keys: "ctrl+c", Description: "quit"},
+	)
+}
 
-Results:
-  j/k, ↑/↓    Navigate results
-  esc         Back to Menu
+// viewModel returns the component for a view, or nil for overlays.
+func (a *App) viewModel(view messages.ViewType) any {
+	switch view {
+	case messages.ViewMenu:
+		return a.menuView
+	case messages.ViewSearch:
+		return a.searchView
+	case messages.ViewSources:
+		return a.sourcesView
+	case messages.ViewSourceDetail:
+		return a.sourceDetailView
+	case messages.ViewDocuments:
+		return a.documentsView
+	case messages.ViewDocContent:
+		return a.docContentView
+	case messages.ViewDocDetails:
+		return a.docDetailsView
+	case messages.ViewAddSource:
+		return a.addSourceView
+	case messages.ViewSettings:
+		return a.settingsView
+	case messages.ViewSourceStatus:
+		return a.sourceStatusView
+	case messages.ViewHelp:
+		return nil
+	}
+	return nil
+}
 
-[esc] back to menu`
+// inputFocused reports whether the current view is taking text input, so
+// that keys are typed rather than treated as shortcuts.
+func (a *App) inputFocused() bool {
+	switch a.currentView {
+	case messages.ViewSearch:
+		return a.searchView.InputFocused()
+	case messages.ViewSources:
+		return a.sourcesView.Renaming()
+	case messages.ViewAddSource:
+		return a.addSourceView.InputFocused()
+	case messages.ViewSettings:
+		return a.settingsView.InputFocused()
+	case messages.ViewMenu, messages.ViewHelp, messages.ViewSourceDetail,
+		messages.ViewDocuments, messages.ViewDocContent, messages.ViewDocDetails,
+		messages.ViewSourceStatus:
+		// Other views have no text input
+	}
+	return false
 }
 
 // Run starts the TUI application.
@@ -495,6 +697,7 @@ func (a *App) SetDimensions(width, height int) {
 	a.width = width
 	a.height = height
 	a.ready = true
-	// Also set searchView dimensions so it renders properly
+	// Also set searchView and helpOverlay dimensions so they render properly
 	a.searchView.SetDimensions(width, height)
+	a.helpOverlay.SetDimensions(width, height)
 }
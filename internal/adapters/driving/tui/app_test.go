@@ -438,6 +438,58 @@ func TestApp_Update_KeyMsg_Enter_WithQuery(t *testing.T) {
 	assert.True(t, searchCalled)
 }
 
+func TestApp_WaitForWatchUpdate(t *testing.T) {
+	watcher := newMockSyncWatcher()
+	ports := newTestPorts()
+	ports.Watcher = watcher
+	app, _ := NewApp(ports)
+
+	watcher.updates <- domain.WatchUpdate{SourceIDs: []string{"src-1"}, Indexed: 1}
+	msg := app.waitForWatchUpdate()()
+
+	assert.Equal(t, messages.WatchUpdated{Update: domain.WatchUpdate{SourceIDs: []string{"src-1"}, Indexed: 1}}, msg)
+}
+
+func TestApp_WaitForWatchUpdate_WithoutWatcher(t *testing.T) {
+	app, _ := NewApp(newTestPorts())
+
+	assert.Nil(t, app.waitForWatchUpdate())
+}
+
+func TestApp_Update_WatchUpdated_RerunsSearch(t *testing.T) {
+	searches := 0
+	ports := newTestPorts()
+	ports.Search = &MockSearchService{
+		SearchFunc: func(_ context.Context, query string, _ domain.SearchOptions) ([]domain.SearchResult, error) {
+			searches++
+			assert.Equal(t, "notes", query)
+			return []domain.SearchResult{}, nil
+		},
+	}
+	app, _ := NewApp(ports)
+	goToSearchView(app)
+	for _, r := range "notes" {
+		app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	app.Update(cmd())
+
+	_, cmd = app.Update(messages.WatchUpdated{Update: domain.WatchUpdate{SourceIDs: []string{"src-1"}, Indexed: 1}})
+
+	require.NotNil(t, cmd)
+	assert.IsType(t, messages.SearchCompleted{}, cmd())
+	assert.Equal(t, 2, searches)
+}
+
+func TestApp_Update_WatchUpdated_WithoutSearch(t *testing.T) {
+	app, _ := NewApp(newTestPorts())
+	goToSearchView(app)
+
+	_, cmd := app.Update(messages.WatchUpdated{Update: domain.WatchUpdate{SourceIDs: []string{"src-1"}, Indexed: 1}})
+
+	assert.Nil(t, cmd)
+}
+
 func TestApp_Update_KeyMsg_Enter_EmptyQuery(t *testing.T) {
 	ports := newTestPorts()
 	app, _ := NewApp(ports)
@@ -764,6 +816,20 @@ func TestApp_Update_DocumentRefreshed(t *testing.T) {
 	assert.Nil(t, cmd)
 }
 
+// Test batch document messages are forwarded to the documents view.
+func TestApp_Update_DocumentsBatchMessages(t *testing.T) {
+	ports := newTestPorts()
+	app, _ := NewApp(ports)
+	app.SetDimensions(80, 24)
+	app.Update(messages.ViewChanged{View: messages.ViewDocuments})
+
+	app.Update(messages.DocumentsRefreshed{DocumentIDs: []string{"doc1"}, Err: errors.New("refresh failed")})
+	assert.EqualError(t, app.documentsView.Err(), "refresh failed")
+
+	app.Update(messages.DocumentsExcluded{DocumentIDs: []string{"doc1"}, Err: errors.New("exclude failed")})
+	assert.EqualError(t, app.documentsView.Err(), "exclude failed")
+}
+
 // Test SourcesLoaded message forwarded to sources view.
 func TestApp_Update_SourcesLoaded_InSourcesView(t *testing.T) {
 	ports := newTestPorts()
@@ -1022,6 +1088,23 @@ func TestApp_Update_ViewChanged_ToSettings(t *testing.T) {
 	assert.Equal(t, messages.ViewSettings, app.CurrentView())
 }
 
+func TestApp_Update_ViewChanged_ToSourceStatus(t *testing.T) {
+	app, _ := NewApp(newTestPorts())
+	app.SetDimensions(80, 24)
+
+	_, cmd := app.Update(messages.ViewChanged{View: messages.ViewSourceStatus})
+
+	assert.NotNil(t, cmd)
+	assert.Equal(t, messages.ViewSourceStatus, app.CurrentView())
+	assert.Contains(t, app.View(), "Source Status")
+
+	// Esc returns to the menu
+	_, cmd = app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.NotNil(t, cmd)
+	app.Update(cmd())
+	assert.Equal(t, messages.ViewMenu, app.CurrentView())
+}
+
 func TestApp_Update_ViewChanged_ToMenu(t *testing.T) {
 	ports := newTestPorts()
 	app, _ := NewApp(ports)
@@ -1091,6 +1174,21 @@ func TestApp_Update_KeyMsg_InSourcesView_Escape(t *testing.T) {
 	assert.Equal(t, messages.ViewMenu, app.CurrentView())
 }
 
+func TestApp_Update_KeyMsg_InSourcesView_EscapeCancelsRename(t *testing.T) {
+	ports := newTestPorts()
+	app, _ := NewApp(ports)
+	app.SetDimensions(80, 24)
+	app.Update(messages.ViewChanged{View: messages.ViewSources})
+	app.Update(messages.SourcesLoaded{Sources: []domain.Source{{ID: "src-1", Name: "Docs"}}})
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	require.True(t, app.sourcesView.Renaming())
+
+	app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	assert.False(t, app.sourcesView.Renaming())
+	assert.Equal(t, messages.ViewSources, app.CurrentView())
+}
+
 func TestApp_Update_KeyMsg_InSourceDetailView(t *testing.T) {
 	ports := newTestPorts()
 	app, _ := NewApp(ports)
@@ -1105,6 +1203,19 @@ func TestApp_Update_KeyMsg_InSourceDetailView(t *testing.T) {
 	_ = cmd
 }
 
+func TestApp_Update_SyncCompleted_ForwardedFromOtherView(t *testing.T) {
+	ports := newTestPorts()
+	app, _ := NewApp(ports)
+	app.SetDimensions(80, 24)
+	app.Update(messages.SourceSelected{Source: domain.Source{ID: "src-1"}})
+	app.Update(messages.ViewChanged{View: messages.ViewSources})
+
+	app.Update(messages.SyncCompleted{SourceID: "src-1", Err: errors.New("connection reset")})
+
+	assert.Equal(t, messages.ViewSources, app.CurrentView())
+	assert.EqualError(t, app.sourceDetailView.Err(), "connection reset")
+}
+
 func TestApp_Update_KeyMsg_InDocumentsView(t *testing.T) {
 	ports := newTestPorts()
 	app, _ := NewApp(ports)
@@ -1524,3 +1635,127 @@ func TestApp_Update_SettingsSaved_InOtherView(t *testing.T) {
 
 	assert.Equal(t, app, model)
 }
+
+func questionMark() tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}}
+}
+
+func TestApp_HelpOverlay_OpensOverEachView(t *testing.T) {
+	views := []messages.ViewType{
+		messages.ViewMenu, messages.ViewSources, messages.ViewSourceDetail, messages.ViewDocuments,
+		messages.ViewDocContent, messages.ViewDocDetails, messages.ViewAddSource, messages.ViewSettings,
+		messages.ViewSourceStatus,
+	}
+	for _, view := range views {
+		t.Run(view.String(), func(t *testing.T) {
+			app, _ := NewApp(newTestPorts())
+			app.SetDimensions(100, 40)
+			app.currentView = view
+
+			app.Update(questionMark())
+
+			require.Equal(t, messages.ViewHelp, app.CurrentView())
+			assert.Equal(t, viewTitles[view], app.helpOverlay.Title())
+			bindings := app.helpOverlay.Bindings()
+			assert.Greater(t, len(bindings), 2, "view bindings precede the global ones")
+			assert.Equal(t, KeyBinding{Group: "Global", Keys: "ctrl+c", Description: "quit"}, bindings[len(bindings)-1])
+			assert.Contains(t, app.View(), "Help: "+viewTitles[view])
+		})
+	}
+}
+
+func TestApp_HelpOverlay_DismissReturnsToView(t *testing.T) {
+	for _, key := range []tea.KeyMsg{
+		{Type: tea.KeyEsc}, {Type: tea.KeyRunes, Runes: []rune{'q'}}, questionMark(),
+	} {
+		t.Run(key.String(), func(t *testing.T) {
+			app, _ := NewApp(newTestPorts())
+			app.SetDimensions(100, 40)
+			app.Update(messages.ViewChanged{View: messages.ViewSources})
+			app.Update(questionMark())
+
+			_, cmd := app.Update(key)
+
+			assert.Nil(t, cmd)
+			assert.Equal(t, messages.ViewSources, app.CurrentView())
+		})
+	}
+}
+
+func TestApp_HelpOverlay_ScrollKeysStayInHelp(t *testing.T) {
+	app, _ := NewApp(newTestPorts())
+	app.SetDimensions(100, 40)
+	app.Update(questionMark())
+
+	app.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	assert.Equal(t, messages.ViewHelp, app.CurrentView())
+}
+
+func TestApp_HelpOverlay_SearchViewBindings(t *testing.T) {
+	app, _ := NewApp(newTestPorts())
+	goToSearchView(app)
+
+	// '?' is typed into the search input
+	app.Update(questionMark())
+	assert.Equal(t, messages.ViewSearch, app.CurrentView())
+	assert.Equal(t, "?", app.searchView.Query())
+
+	app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	app.Update(questionMark())
+	require.Equal(t, messages.ViewHelp, app.CurrentView())
+	assert.Contains(t, app.helpOverlay.Bindings(), KeyBinding{Group: "Results", Keys: "tab", Description: "preview"})
+
+	app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, messages.ViewSearch, app.CurrentView())
+	assert.Equal(t, "?", app.searchView.Query())
+}
+
+// mockSearchSessionService implements driving.SearchSessionService in memory.
+type mockSearchSessionService struct {
+	session *domain.SearchSession
+}
+
+func (m *mockSearchSessionService) Load() (*domain.SearchSession, error) {
+	return m.session, nil
+}
+
+func (m *mockSearchSessionService) Save(session domain.SearchSession) error {
+	m.session = &session
+	return nil
+}
+
+func (m *mockSearchSessionService) Clear() error {
+	m.session = nil
+	return nil
+}
+
+func TestApp_SearchSession_RestoredAndSaved(t *testing.T) {
+	sessions := &mockSearchSessionService{session: &domain.SearchSession{Query: "budget", Selected: 1}}
+	ports := newTestPorts()
+	ports.SearchSession = sessions
+	app, err := NewApp(ports)
+	require.NoError(t, err)
+
+	goToSearchView(app)
+	assert.Equal(t, "budget", app.searchView.Query(), "the last search is restored on opening search")
+
+	results := []domain.SearchResult{
+		{Document: domain.Document{ID: "1"}},
+		{Document: domain.Document{ID: "2"}},
+		{Document: domain.Document{ID: "3"}},
+	}
+	app.Update(messages.SearchCompleted{Results: results})
+	assert.Equal(t, 1, app.SelectedIndex())
+
+	app.Update(tea.KeyMsg{Type: tea.KeyDown})
+	app.Update(messages.ViewChanged{View: messages.ViewMenu})
+	assert.Equal(t, &domain.SearchSession{Query: "budget", Selected: 2}, sessions.session,
+		"leaving search saves the session")
+
+	goToSearchView(app)
+	app.Update(messages.SearchCompleted{Results: results})
+	app.Update(tea.KeyMsg{Type: tea.KeyUp})
+	app.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	assert.Equal(t, 1, sessions.session.Selected, "quitting saves the session")
+}
@@ -4,9 +4,11 @@ package list
 import (
 	"fmt"
 	"strings"
+	"unicode"
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
@@ -16,6 +18,7 @@ type ResultList struct {
 	results  []domain.SearchResult
 	selected int
 	styles   *styles.Styles
+	keys     *keymap.KeyMap
 	width    int
 	height   int
 }
@@ -30,11 +33,17 @@ func NewResultList(s *styles.Styles) *ResultList {
 		results:  nil,
 		selected: 0,
 		styles:   s,
+		keys:     keymap.DefaultKeyMap(),
 		width:    80,
 		height:   10,
 	}
 }
 
+// SetKeyMap sets the keys that move the selection.
+func (r *ResultList) SetKeyMap(km *keymap.KeyMap) {
+	r.keys = km
+}
+
 // Init initialises the result list.
 func (r *ResultList) Init() tea.Cmd {
 	return nil
@@ -43,19 +52,10 @@ func (r *ResultList) Init() tea.Cmd {
 // Update handles list navigation messages.
 func (r *ResultList) Update(msg tea.Msg) (*ResultList, tea.Cmd) {
 	if msg, ok := msg.(tea.KeyMsg); ok {
-		//nolint:exhaustive // handling only relevant key types
-		switch msg.Type {
-		case tea.KeyUp:
+		switch key := msg.String(); {
+		case keymap.Matches(key, r.keys.Up):
 			r.MoveUp()
-		case tea.KeyDown:
-			r.MoveDown()
-		default:
-			// Handle other keys
-		}
-		switch msg.String() {
-		case "k":
-			r.MoveUp()
-		case "j":
+		case keymap.Matches(key, r.keys.Down):
 			r.MoveDown()
 		}
 	}
@@ -131,24 +131,29 @@ func (r *ResultList) renderResult(index int, result *domain.SearchResult) string
 			r.styles.Muted.Render(score)
 	}
 
-	// Preview text (first highlight or chunk content)
-	preview := ""
-	if len(result.Highlights) > 0 {
-		preview = result.Highlights[0]
-	} else if result.Chunk.Content != "" {
-		preview = result.Chunk.Content
-	}
-
-	// Truncate preview to fit width
 	maxPreviewLen := r.width - 6
 	if maxPreviewLen < 20 {
 		maxPreviewLen = 20
 	}
-	if len(preview) > maxPreviewLen {
-		preview = preview[:maxPreviewLen-3] + "..."
-	}
 
-	previewLine := r.styles.Muted.Render("    " + preview)
+	// Preview text (snippet, first highlight or chunk content)
+	var previewLine string
+	if result.Snippet != "" {
+		previewLine = r.styles.Muted.Render("    ") + r.renderSnippet(result.Snippet, maxPreviewLen)
+	} else {
+		preview := ""
+		if len(result.Highlights) > 0 {
+			preview = result.Highlights[0]
+		} else if result.Chunk.Content != "" {
+			preview = result.Chunk.Content
+		}
+
+		// Truncate preview to fit width
+		if len(preview) > maxPreviewLen {
+			preview = preview[:maxPreviewLen-3] + "..."
+		}
+		previewLine = r.styles.Muted.Render("    " + preview)
+	}
 
 	// Source name line (if available)
 	var sourceLine string
@@ -159,6 +164,54 @@ func (r *ResultList) renderResult(index int, result *domain.SearchResult) string
 	return titleLine + sourceLine + "\n" + previewLine
 }
 
+// renderSnippet renders a result snippet on one line of at most maxLen
+// characters, with matched query terms in bold.
+func (r *ResultList) renderSnippet(snippet string, maxLen int) string {
+	highlight := r.styles.Normal.Bold(true)
+
+	var b strings.Builder
+	remaining := maxLen
+	for _, segment := range domain.SnippetSegments(snippet) {
+		text := collapseWhitespace(segment.Text)
+		runes := []rune(text)
+		if len(runes) > remaining {
+			text = string(runes[:max(remaining-3, 0)]) + "..."
+		}
+		remaining -= len(runes)
+
+		if segment.Highlighted {
+			b.WriteString(highlight.Render(text))
+		} else {
+			b.WriteString(r.styles.Muted.Render(text))
+		}
+		if remaining <= 0 {
+			break
+		}
+	}
+	return b.String()
+}
+
+// collapseWhitespace replaces each run of whitespace with a single space.
+func collapseWhitespace(text string) string {
+	var b strings.Builder
+	space := false
+	for _, c := range text {
+		if unicode.IsSpace(c) {
+			space = true
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteRune(c)
+	}
+	if space {
+		b.WriteByte(' ')
+	}
+	return b.String()
+}
+
 // SetResults updates the result list.
 func (r *ResultList) SetResults(results []domain.SearchResult) {
 	r.results = results
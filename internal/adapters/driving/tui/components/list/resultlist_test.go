@@ -1,6 +1,7 @@
 package list
 
 import (
+	"strings"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -294,3 +295,44 @@ func TestResultList_View_LongTitle(t *testing.T) {
 	// Should be truncated with ellipsis
 	assert.Contains(t, view, "...")
 }
+
+func TestResultList_View_Snippet(t *testing.T) {
+	list := NewResultList(nil)
+	list.SetResults([]domain.SearchResult{
+		{
+			Document:   domain.Document{Title: "Notes"},
+			Highlights: []string{"fallback highlight"},
+			Snippet:    "...the <b>quick</b>\n  brown &amp; <b>fox</b>",
+		},
+	})
+
+	view := list.View()
+
+	assert.Contains(t, view, "quick")
+	assert.Contains(t, view, "brown & ")
+	assert.Contains(t, view, "fox")
+	assert.NotContains(t, view, "<b>")
+	assert.NotContains(t, view, "fallback highlight")
+}
+
+func TestResultList_View_LongSnippetIsTruncated(t *testing.T) {
+	list := NewResultList(nil)
+	list.SetDimensions(40, 10)
+	list.SetResults([]domain.SearchResult{
+		{
+			Document: domain.Document{Title: "Notes"},
+			Snippet:  "<b>start</b> " + strings.Repeat("word ", 50) + "<b>end</b>",
+		},
+	})
+
+	view := list.View()
+
+	assert.Contains(t, view, "start")
+	assert.Contains(t, view, "...")
+	assert.NotContains(t, view, "end")
+}
+
+func TestCollapseWhitespace(t *testing.T) {
+	assert.Equal(t, " a b c ", collapseWhitespace("\n a \t\tb\nc  "))
+	assert.Equal(t, "abc", collapseWhitespace("abc"))
+}
@@ -19,6 +19,7 @@ type State string
 const (
 	StateReady     State = "ready"
 	StateSearching State = "searching"
+	StateAsking    State = "asking"
 	StateError     State = "error"
 	StateHelp      State = "help"
 	StateResults   State = "results"
@@ -92,6 +93,8 @@ func (s *Bar) renderLeft() string {
 	switch s.state {
 	case StateSearching:
 		return s.styles.Muted.Render("Searching...")
+	case StateAsking:
+		return s.styles.Muted.Render("Thinking...")
 	case StateError:
 		if s.message != "" {
 			return s.styles.Error.Render(fmt.Sprintf("Error: %s", s.message))
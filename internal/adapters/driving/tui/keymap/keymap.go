@@ -19,6 +19,9 @@ type KeyMap struct {
 	// Search triggers a search.
 	Search key.Binding
 
+	// Ask answers the query as a question from the indexed documents.
+	Ask key.Binding
+
 	// Up navigates up in a list.
 	Up key.Binding
 
@@ -36,6 +39,33 @@ type KeyMap struct {
 
 	// Actions opens the action menu on a result.
 	Actions key.Binding
+
+	// Preview toggles the preview pane for the selected result.
+	Preview key.Binding
+
+	// ScrollPreview scrolls the preview pane.
+	ScrollPreview key.Binding
+
+	// ForgetSearch forgets the last search so it is not restored on launch.
+	ForgetSearch key.Binding
+}
+
+// KeyBinding describes keys a view responds to, for listing in the help overlay.
+type KeyBinding struct {
+	// Group names the set the binding belongs to, e.g. "Navigation".
+	Group string
+
+	// Keys is how the keys are shown, e.g. "↑/k".
+	Keys string
+
+	// Description says what the keys do.
+	Description string
+}
+
+// FromBinding describes a key.Binding in group, using its help text.
+func FromBinding(group string, binding key.Binding) KeyBinding {
+	help := binding.Help()
+	return KeyBinding{Group: group, Keys: help.Key, Description: help.Desc}
 }
 
 // DefaultKeyMap returns the default keybindings.
@@ -57,6 +87,10 @@ func DefaultKeyMap() *KeyMap {
 			key.WithKeys("enter"),
 			key.WithHelp("enter", "search"),
 		),
+		Ask: key.NewBinding(
+			key.WithKeys("alt+enter"),
+			key.WithHelp("alt+enter", "ask"),
+		),
 		Up: key.NewBinding(
 			key.WithKeys("up", "k"),
 			key.WithHelp("↑/k", "up"),
@@ -81,6 +115,18 @@ func DefaultKeyMap() *KeyMap {
 			key.WithKeys("enter"),
 			key.WithHelp("enter", "actions"),
 		),
+		Preview: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "preview"),
+		),
+		ScrollPreview: key.NewBinding(
+			key.WithKeys("pgup", "pgdown", "ctrl+u", "ctrl+d", "home", "end"),
+			key.WithHelp("pgup/pgdn", "scroll preview"),
+		),
+		ForgetSearch: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "forget last search"),
+		),
 	}
 }
 
@@ -91,7 +137,7 @@ func (k *KeyMap) ShortHelp() []key.Binding {
 
 // ResultsHelp returns keybindings for the results view.
 func (k *KeyMap) ResultsHelp() []key.Binding {
-	return []key.Binding{k.NewSearch, k.Up, k.Actions, k.Back}
+	return []key.Binding{k.NewSearch, k.Up, k.Actions, k.Preview, k.Back}
 }
 
 // FullHelp returns the full list of keybindings for the help view.
@@ -103,6 +149,21 @@ func (k *KeyMap) FullHelp() [][]key.Binding {
 	}
 }
 
+// NavigationKeys returns the list navigation keys shown in view footers,
+// the last key of Down and of Up, e.g. "j/k".
+func (k *KeyMap) NavigationKeys() string {
+	return lastKey(k.Down) + "/" + lastKey(k.Up)
+}
+
+// lastKey returns the last key of binding, as shown in help text.
+func lastKey(binding key.Binding) string {
+	keys := binding.Keys()
+	if len(keys) == 0 {
+		return ""
+	}
+	return helpKeys(keys[len(keys)-1:])
+}
+
 // Matches checks if a key string matches a binding.
 func Matches(keyStr string, binding key.Binding) bool {
 	for _, k := range binding.Keys() {
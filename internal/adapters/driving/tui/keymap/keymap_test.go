@@ -127,6 +127,8 @@ func TestBindings_HaveHelp(t *testing.T) {
 		{"Down", km.Down},
 		{"Select", km.Select},
 		{"Cancel", km.Cancel},
+		{"Preview", km.Preview},
+		{"ScrollPreview", km.ScrollPreview},
 	}
 
 	for _, tc := range testCases {
@@ -136,3 +138,19 @@ func TestBindings_HaveHelp(t *testing.T) {
 		})
 	}
 }
+
+func TestFromBinding(t *testing.T) {
+	km := DefaultKeyMap()
+
+	binding := FromBinding("Results", km.Preview)
+
+	assert.Equal(t, KeyBinding{Group: "Results", Keys: "tab", Description: "preview"}, binding)
+}
+
+func TestNavigationKeys(t *testing.T) {
+	assert.Equal(t, "j/k", DefaultKeyMap().NavigationKeys())
+
+	km, err := Load(map[string][]string{"up": {"up"}, "down": {"down", "e"}})
+	require.NoError(t, err)
+	assert.Equal(t, "e/↑", km.NavigationKeys())
+}
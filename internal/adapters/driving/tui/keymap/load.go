@@ -0,0 +1,203 @@
+package keymap
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var (
+	// ErrUnknownAction is returned when a binding names an action that cannot be remapped.
+	ErrUnknownAction = errors.New("unknown action")
+
+	// ErrInvalidKey is returned when a binding uses a key the terminal cannot send.
+	ErrInvalidKey = errors.New("invalid key")
+
+	// ErrKeyConflict is returned when one key would trigger two actions in the same view.
+	ErrKeyConflict = errors.New("key conflict")
+)
+
+// action is a binding that can be remapped from settings.
+type action struct {
+	name    string
+	binding func(k *KeyMap) *key.Binding
+
+	// typed is set for actions handled while a text input has focus,
+	// which cannot be bound to printable characters.
+	typed bool
+}
+
+// actions lists the remappable bindings by their settings name.
+var actions = []action{
+	{name: "help", binding: func(k *KeyMap) *key.Binding { return &k.Help }},
+	{name: "search", binding: func(k *KeyMap) *key.Binding { return &k.Search }, typed: true},
+	{name: "ask", binding: func(k *KeyMap) *key.Binding { return &k.Ask }, typed: true},
+	{name: "up", binding: func(k *KeyMap) *key.Binding { return &k.Up }},
+	{name: "down", binding: func(k *KeyMap) *key.Binding { return &k.Down }},
+	{name: "select", binding: func(k *KeyMap) *key.Binding { return &k.Select }},
+	{name: "new_search", binding: func(k *KeyMap) *key.Binding { return &k.NewSearch }},
+	{name: "actions", binding: func(k *KeyMap) *key.Binding { return &k.Actions }},
+	{name: "preview", binding: func(k *KeyMap) *key.Binding { return &k.Preview }},
+	{name: "forget_search", binding: func(k *KeyMap) *key.Binding { return &k.ForgetSearch }},
+}
+
+// scopes groups bindings that are consulted by the same view at the same
+// time, and so must not share a key.
+var scopes = []struct {
+	name     string
+	bindings func(k *KeyMap) []key.Binding
+}{
+	{"search input", func(k *KeyMap) []key.Binding {
+		return []key.Binding{k.Search, k.Ask, k.Cancel}
+	}},
+	{"lists", func(k *KeyMap) []key.Binding {
+		return []key.Binding{k.Help, k.Up, k.Down, k.Select, k.Cancel, k.Quit}
+	}},
+	{"search results", func(k *KeyMap) []key.Binding {
+		return []key.Binding{
+			k.Help, k.Up, k.Down, k.Actions, k.Preview, k.NewSearch, k.ScrollPreview, k.ForgetSearch, k.Cancel,
+		}
+	}},
+}
+
+// reservedKeys always keep their meaning and cannot be bound.
+var reservedKeys = map[string]bool{"ctrl+c": true, "esc": true}
+
+// keyGlyphs are shown in help text in place of key names.
+var keyGlyphs = map[string]string{"up": "↑", "down": "↓", "left": "←", "right": "→", " ": "space"}
+
+// Actions returns the names of the bindings that can be remapped.
+func Actions() []string {
+	names := make([]string, len(actions))
+	for i, a := range actions {
+		names[i] = a.name
+	}
+	return names
+}
+
+// Load returns the default keybindings with bindings applied on top.
+// Bindings maps action names to the keys that trigger them, replacing
+// the default keys; "space" names the space bar. Unknown actions, invalid
+// keys and keys bound twice within a view are rejected.
+func Load(bindings map[string][]string) (*KeyMap, error) {
+	km := DefaultKeyMap()
+
+	names := make([]string, 0, len(bindings))
+	for name := range bindings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		a, ok := findAction(name)
+		if !ok {
+			return nil, fmt.Errorf("%w %q: expected one of %s", ErrUnknownAction, name,
+				strings.Join(Actions(), ", "))
+		}
+		keys, err := parseKeys(a, bindings[name])
+		if err != nil {
+			return nil, err
+		}
+		b := a.binding(km)
+		*b = key.NewBinding(key.WithKeys(keys...), key.WithHelp(helpKeys(keys), b.Help().Desc))
+	}
+
+	if err := checkConflicts(km); err != nil {
+		return nil, err
+	}
+	return km, nil
+}
+
+// findAction looks up a remappable binding by name.
+func findAction(name string) (action, bool) {
+	for _, a := range actions {
+		if a.name == name {
+			return a, true
+		}
+	}
+	return action{}, false
+}
+
+// parseKeys validates the keys bound to a.
+func parseKeys(a action, keys []string) ([]string, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w for %q: no keys given", ErrInvalidKey, a.name)
+	}
+	parsed := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if k == "space" {
+			k = " "
+		}
+		if !validKey(k) {
+			return nil, fmt.Errorf("%w %q for %q", ErrInvalidKey, k, a.name)
+		}
+		if reservedKeys[k] {
+			return nil, fmt.Errorf("%w %q for %q: the key is reserved", ErrInvalidKey, k, a.name)
+		}
+		if a.typed && utf8.RuneCountInString(k) == 1 {
+			return nil, fmt.Errorf("%w %q for %q: printable keys are typed into the search box",
+				ErrInvalidKey, k, a.name)
+		}
+		parsed = append(parsed, k)
+	}
+	return parsed, nil
+}
+
+// validKey reports whether k is a key name bubbletea produces.
+func validKey(k string) bool {
+	k = strings.TrimPrefix(k, "alt+")
+	if r, size := utf8.DecodeRuneInString(k); size == len(k) && r != utf8.RuneError {
+		return unicode.IsPrint(r)
+	}
+	return keyNames[k]
+}
+
+// keyNames holds the names of the special keys bubbletea reports.
+var keyNames = func() map[string]bool {
+	names := make(map[string]bool)
+	for t := tea.KeyType(-256); t < 256; t++ {
+		if t == tea.KeyRunes {
+			continue
+		}
+		if name := t.String(); name != "" {
+			names[name] = true
+		}
+	}
+	return names
+}()
+
+// helpKeys describes keys for the help text, e.g. "↑/k".
+func helpKeys(keys []string) string {
+	shown := make([]string, len(keys))
+	for i, k := range keys {
+		if glyph, ok := keyGlyphs[k]; ok {
+			k = glyph
+		}
+		shown[i] = k
+	}
+	return strings.Join(shown, "/")
+}
+
+// checkConflicts rejects keys that trigger two bindings in one scope.
+func checkConflicts(km *KeyMap) error {
+	for _, scope := range scopes {
+		owners := make(map[string]string)
+		for _, b := range scope.bindings(km) {
+			desc := b.Help().Desc
+			for _, k := range b.Keys() {
+				if owner, ok := owners[k]; ok && owner != desc {
+					return fmt.Errorf("%w in %s: %q is bound to both %q and %q",
+						ErrKeyConflict, scope.name, k, owner, desc)
+				}
+				owners[k] = desc
+			}
+		}
+	}
+	return nil
+}
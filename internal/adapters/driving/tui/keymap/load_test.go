@@ -0,0 +1,105 @@
+package keymap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad_NoBindingsReturnsDefaults(t *testing.T) {
+	km, err := Load(nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, DefaultKeyMap(), km)
+}
+
+func TestLoad_RemapsBindings(t *testing.T) {
+	km, err := Load(map[string][]string{
+		"up":   {"up", "i"},
+		"down": {"down", "space"},
+		"ask":  {"ctrl+a"},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, Matches("i", km.Up))
+	assert.False(t, Matches("k", km.Up))
+	assert.True(t, Matches(" ", km.Down))
+	assert.False(t, Matches("j", km.Down))
+	assert.True(t, Matches("ctrl+a", km.Ask))
+	assert.Equal(t, KeyBinding{Group: "Navigation", Keys: "↑/i", Description: "up"},
+		FromBinding("Navigation", km.Up))
+	assert.Equal(t, "↓/space", km.Down.Help().Key)
+
+	// Unmapped bindings keep their defaults
+	assert.Equal(t, DefaultKeyMap().Select, km.Select)
+}
+
+func TestLoad_UnknownAction(t *testing.T) {
+	_, err := Load(map[string][]string{"jump": {"g"}})
+
+	assert.ErrorIs(t, err, ErrUnknownAction)
+	assert.ErrorContains(t, err, `"jump"`)
+}
+
+func TestLoad_InvalidKeys(t *testing.T) {
+	testCases := []struct {
+		name string
+		keys []string
+	}{
+		{"no keys", nil},
+		{"unknown key name", []string{"pagedown"}},
+		{"control character", []string{"\x01"}},
+		{"reserved key", []string{"ctrl+c"}},
+		{"escape", []string{"esc"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Load(map[string][]string{"down": tc.keys})
+			assert.ErrorIs(t, err, ErrInvalidKey)
+		})
+	}
+}
+
+func TestLoad_PrintableKeyForSearchInput(t *testing.T) {
+	_, err := Load(map[string][]string{"ask": {"a"}})
+	assert.ErrorIs(t, err, ErrInvalidKey)
+
+	km, err := Load(map[string][]string{"ask": {"alt+a"}})
+	require.NoError(t, err)
+	assert.True(t, Matches("alt+a", km.Ask))
+}
+
+func TestLoad_Conflicts(t *testing.T) {
+	testCases := []struct {
+		name     string
+		bindings map[string][]string
+	}{
+		{"down takes up's key", map[string][]string{"down": {"k"}}},
+		{"preview takes new search's key", map[string][]string{"preview": {"n"}}},
+		{"new search takes a scroll key", map[string][]string{"new_search": {"pgdown"}}},
+		{"help takes select's key", map[string][]string{"help": {"enter"}}},
+		{"ask takes search's key", map[string][]string{"ask": {"enter"}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Load(tc.bindings)
+			assert.ErrorIs(t, err, ErrKeyConflict)
+		})
+	}
+}
+
+func TestLoad_SameKeyInSeparateViews(t *testing.T) {
+	// Select and preview are never consulted together
+	km, err := Load(map[string][]string{"preview": {"l"}, "select": {"enter", "l"}})
+
+	require.NoError(t, err)
+	assert.True(t, Matches("l", km.Preview))
+	assert.True(t, Matches("l", km.Select))
+}
+
+func TestDefaultKeyMap_HasNoConflicts(t *testing.T) {
+	assert.NoError(t, checkConflicts(DefaultKeyMap()))
+}
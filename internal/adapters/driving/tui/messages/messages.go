@@ -21,6 +21,35 @@ type SearchRequested struct {
 type SearchCompleted struct {
 	Results []domain.SearchResult
 	Err     error
+
+	// Query is the query that was searched.
+	Query string
+
+	// Seq identifies the search, so results of a search superseded by a
+	// newer one are dropped. Zero is never dropped.
+	Seq int
+
+	// Live is set for searches run while typing, which keep the input
+	// focused instead of moving to the results.
+	Live bool
+}
+
+// AnswerChunk carries a piece of an answer, as the LLM writes it, back to
+// the model.
+type AnswerChunk struct {
+	Text string
+
+	// Seq identifies the question, as SearchCompleted.Seq does.
+	Seq int
+}
+
+// AskCompleted carries the answer to a question back to the model.
+type AskCompleted struct {
+	Result domain.AskResult
+	Err    error
+
+	// Seq identifies the question, as SearchCompleted.Seq does.
+	Seq int
 }
 
 // ResultSelected is sent when a search result is selected.
@@ -57,6 +86,8 @@ const (
 	ViewAddSource
 	// ViewSettings is the settings configuration view.
 	ViewSettings
+	// ViewSourceStatus is the source health dashboard.
+	ViewSourceStatus
 )
 
 // String returns the string representation of the view type.
@@ -82,6 +113,8 @@ func (v ViewType) String() string {
 		return "add_source"
 	case ViewSettings:
 		return "settings"
+	case ViewSourceStatus:
+		return "source_status"
 	default:
 		return "unknown"
 	}
@@ -113,6 +146,25 @@ type SourceRemoved struct {
 	Err error
 }
 
+// SourceRenamed signals a source was renamed.
+type SourceRenamed struct {
+	ID   string
+	Name string
+	Err  error
+}
+
+// SyncCompleted signals a source sync finished.
+// Err is set if the sync failed; a retry resumes from the last checkpoint.
+type SyncCompleted struct {
+	SourceID string
+	Err      error
+}
+
+// WatchUpdated carries a summary of changes indexed while watching sources.
+type WatchUpdated struct {
+	Update domain.WatchUpdate
+}
+
 // SourceSelected signals a source was selected for detail view.
 type SourceSelected struct {
 	Source domain.Source
@@ -137,6 +189,14 @@ type DocumentContentLoaded struct {
 	Err        error
 }
 
+// PreviewContentLoaded carries the content of a search result's document
+// for the search preview pane.
+type PreviewContentLoaded struct {
+	DocumentID string
+	Content    string
+	Err        error
+}
+
 // DocumentDetailsLoaded carries the metadata of a document.
 type DocumentDetailsLoaded struct {
 	DocumentID string
@@ -156,6 +216,18 @@ type DocumentRefreshed struct {
 	Err        error
 }
 
+// DocumentsExcluded signals several documents were excluded together.
+type DocumentsExcluded struct {
+	DocumentIDs []string
+	Err         error
+}
+
+// DocumentsRefreshed signals a refresh of several documents completed.
+type DocumentsRefreshed struct {
+	DocumentIDs []string
+	Err         error
+}
+
 // AuthProvidersLoaded carries the list of OAuth app configurations.
 type AuthProvidersLoaded struct {
 	AuthProviders []domain.AuthProvider
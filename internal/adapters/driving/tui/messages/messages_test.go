@@ -151,6 +151,7 @@ func TestViewType_String(t *testing.T) {
 		{"ViewDocDetails", ViewDocDetails, "doc_details"},
 		{"ViewAddSource", ViewAddSource, "add_source"},
 		{"ViewSettings", ViewSettings, "settings"},
+		{"ViewSourceStatus", ViewSourceStatus, "source_status"},
 		{"UnknownView", ViewType(99), "unknown"},
 		{"NegativeView", ViewType(-1), "unknown"},
 		{"LargeView", ViewType(1000), "unknown"},
@@ -276,6 +277,31 @@ func TestSourceRemoved(t *testing.T) {
 	})
 }
 
+// TestSyncCompleted tests the SyncCompleted message type
+func TestSyncCompleted(t *testing.T) {
+	t.Run("successful sync", func(t *testing.T) {
+		msg := SyncCompleted{SourceID: "src-123"}
+
+		assert.Equal(t, "src-123", msg.SourceID)
+		assert.NoError(t, msg.Err)
+	})
+
+	t.Run("failed sync", func(t *testing.T) {
+		msg := SyncCompleted{SourceID: "src-456", Err: errors.New("connection reset")}
+
+		assert.Equal(t, "src-456", msg.SourceID)
+		assert.EqualError(t, msg.Err, "connection reset")
+	})
+}
+
+// TestWatchUpdated tests the WatchUpdated message type
+func TestWatchUpdated(t *testing.T) {
+	msg := WatchUpdated{Update: domain.WatchUpdate{SourceIDs: []string{"src-1"}, Indexed: 2}}
+
+	assert.Equal(t, []string{"src-1"}, msg.Update.SourceIDs)
+	assert.Equal(t, 2, msg.Update.Indexed)
+}
+
 // TestSourceSelected tests the SourceSelected message type
 func TestSourceSelected(t *testing.T) {
 	t.Run("with valid source", func(t *testing.T) {
@@ -400,6 +426,15 @@ func TestDocumentContentLoaded(t *testing.T) {
 	})
 }
 
+// TestPreviewContentLoaded tests the PreviewContentLoaded message type
+func TestPreviewContentLoaded(t *testing.T) {
+	msg := PreviewContentLoaded{DocumentID: "doc-123", Content: "# Heading", Err: nil}
+
+	assert.Equal(t, "doc-123", msg.DocumentID)
+	assert.Equal(t, "# Heading", msg.Content)
+	assert.NoError(t, msg.Err)
+}
+
 // TestDocumentDetailsLoaded tests the DocumentDetailsLoaded message type
 func TestDocumentDetailsLoaded(t *testing.T) {
 	t.Run("with details", func(t *testing.T) {
@@ -466,6 +501,25 @@ func TestDocumentExcluded(t *testing.T) {
 	})
 }
 
+// TestDocumentsExcluded tests the DocumentsExcluded message type
+func TestDocumentsExcluded(t *testing.T) {
+	msg := DocumentsExcluded{
+		DocumentIDs: []string{"doc-1", "doc-2"},
+		Err:         errors.New("exclusion failed"),
+	}
+
+	assert.Equal(t, []string{"doc-1", "doc-2"}, msg.DocumentIDs)
+	assert.EqualError(t, msg.Err, "exclusion failed")
+}
+
+// TestDocumentsRefreshed tests the DocumentsRefreshed message type
+func TestDocumentsRefreshed(t *testing.T) {
+	msg := DocumentsRefreshed{DocumentIDs: []string{"doc-1"}}
+
+	assert.Equal(t, []string{"doc-1"}, msg.DocumentIDs)
+	assert.NoError(t, msg.Err)
+}
+
 // TestDocumentRefreshed tests the DocumentRefreshed message type
 func TestDocumentRefreshed(t *testing.T) {
 	t.Run("successful refresh", func(t *testing.T) {
@@ -3,6 +3,8 @@
 package tui
 
 import (
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
 )
 
@@ -38,6 +40,23 @@ type Ports struct {
 
 	// AuthProvider manages OAuth app configurations (reusable across sources).
 	AuthProvider driving.AuthProviderService
+
+	// SourceHealth validates sources and reports their last check result.
+	SourceHealth driving.SourceHealthService
+
+	// SearchSession remembers the last search so it can be resumed on the
+	// next launch. Optional; without it searches start afresh.
+	SearchSession driving.SearchSessionService
+
+	// Watcher indexes changes to sources as they happen. Optional; when set,
+	// views showing search results or documents refresh as it applies them.
+	Watcher driving.SyncWatcher
+
+	// Theme is the colour theme. Nil selects the default theme.
+	Theme *styles.Theme
+
+	// KeyMap is the keybindings views respond to. Nil selects the defaults.
+	KeyMap *keymap.KeyMap
 }
 
 // NewPorts creates a new Ports aggregate with the given services.
@@ -27,6 +27,12 @@ func (m *MockSearchService) Search(
 	return nil, nil
 }
 
+func (m *MockSearchService) Ask(
+	_ context.Context, _ string, _ domain.SearchOptions, _ func(string),
+) (domain.AskResult, error) {
+	return domain.AskResult{}, nil
+}
+
 // MockSourceService implements driving.SourceService for testing.
 type MockSourceService struct {
 	AddFunc    func(ctx context.Context, source domain.Source) error
@@ -67,6 +73,10 @@ func (m *MockSourceService) Update(ctx context.Context, source domain.Source) er
 	return nil
 }
 
+func (m *MockSourceService) Rename(ctx context.Context, id, newName string) error {
+	return nil
+}
+
 func (m *MockSourceService) ValidateConfig(ctx context.Context, connectorType string, config map[string]string) error {
 	return nil
 }
@@ -92,6 +102,12 @@ func (m *MockSyncOrchestrator) SyncAll(ctx context.Context) error {
 	return nil
 }
 
+func (m *MockSyncOrchestrator) SetParallelWithinSource(_ bool) {}
+
+func (m *MockSyncOrchestrator) DryRun(_ context.Context, sourceID string) (*domain.SyncPreview, error) {
+	return &domain.SyncPreview{SourceID: sourceID}, nil
+}
+
 func (m *MockSyncOrchestrator) Status(ctx context.Context, sourceID string) (*driving.SyncStatus, error) {
 	if m.StatusFunc != nil {
 		return m.StatusFunc(ctx, sourceID)
@@ -119,6 +135,24 @@ func (m *MockResultActionService) OpenDocument(ctx context.Context, result *doma
 	return nil
 }
 
+// MockSyncWatcher implements driving.SyncWatcher for testing.
+type MockSyncWatcher struct {
+	updates chan domain.WatchUpdate
+}
+
+func newMockSyncWatcher() *MockSyncWatcher {
+	return &MockSyncWatcher{updates: make(chan domain.WatchUpdate, 1)}
+}
+
+func (m *MockSyncWatcher) Start(ctx context.Context) error {
+	<-ctx.Done()
+	return nil
+}
+
+func (m *MockSyncWatcher) Stop() error { return nil }
+
+func (m *MockSyncWatcher) Updates() <-chan domain.WatchUpdate { return m.updates }
+
 func TestNewPorts(t *testing.T) {
 	search := &MockSearchService{}
 	source := &MockSourceService{}
@@ -13,9 +13,15 @@ type Theme struct {
 	// Secondary is the secondary accent colour.
 	Secondary lipgloss.Color
 
+	// Highlight is the background of selected items.
+	Highlight lipgloss.Color
+
 	// Background is the background colour.
 	Background lipgloss.Color
 
+	// Surface is the background of bars and panels, such as the status bar.
+	Surface lipgloss.Color
+
 	// Foreground is the default text colour.
 	Foreground lipgloss.Color
 
@@ -37,10 +43,17 @@ type Theme struct {
 
 // DefaultTheme returns the default colour theme.
 func DefaultTheme() *Theme {
+	return DefaultDarkTheme()
+}
+
+// DefaultDarkTheme returns the default theme for dark terminals.
+func DefaultDarkTheme() *Theme {
 	return &Theme{
 		Primary:    lipgloss.Color("#7C3AED"), // Purple
 		Secondary:  lipgloss.Color("#06B6D4"), // Cyan
+		Highlight:  lipgloss.Color("#7C3AED"), // Purple
 		Background: lipgloss.Color("#1E1E2E"), // Dark gray
+		Surface:    lipgloss.Color("#181825"), // Darker gray
 		Foreground: lipgloss.Color("#CDD6F4"), // Light gray
 		Muted:      lipgloss.Color("#6C7086"), // Medium gray
 		Success:    lipgloss.Color("#A6E3A1"), // Green
@@ -117,7 +130,7 @@ func NewStyles(theme *Theme) *Styles {
 		Selected: lipgloss.NewStyle().
 			Bold(true).
 			Foreground(theme.Foreground).
-			Background(theme.Primary),
+			Background(theme.Highlight),
 
 		Error: lipgloss.NewStyle().
 			Foreground(theme.Error),
@@ -135,7 +148,7 @@ func NewStyles(theme *Theme) *Styles {
 
 		StatusBar: lipgloss.NewStyle().
 			Foreground(theme.Muted).
-			Background(lipgloss.Color("#181825")).
+			Background(theme.Surface).
 			Padding(0, 1),
 
 		Help: lipgloss.NewStyle().
@@ -14,7 +14,9 @@ func TestDefaultTheme(t *testing.T) {
 	require.NotNil(t, theme)
 	assert.NotEmpty(t, string(theme.Primary))
 	assert.NotEmpty(t, string(theme.Secondary))
+	assert.NotEmpty(t, string(theme.Highlight))
 	assert.NotEmpty(t, string(theme.Background))
+	assert.NotEmpty(t, string(theme.Surface))
 	assert.NotEmpty(t, string(theme.Foreground))
 	assert.NotEmpty(t, string(theme.Muted))
 	assert.NotEmpty(t, string(theme.Success))
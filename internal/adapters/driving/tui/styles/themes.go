@@ -0,0 +1,256 @@
+package styles
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lucasb-eyer/go-colorful"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrUnknownTheme indicates a theme is neither a built-in name nor a readable file.
+var ErrUnknownTheme = errors.New("unknown theme")
+
+// Theme settings with special meaning.
+const (
+	// ThemeDark names the default dark theme.
+	ThemeDark = "dark"
+
+	// ThemeLight names the default light theme.
+	ThemeLight = "light"
+
+	// ThemeAuto picks the dark or light theme to suit the terminal background.
+	ThemeAuto = "auto"
+)
+
+// DefaultLightTheme returns the default theme for light terminals.
+func DefaultLightTheme() *Theme {
+	return &Theme{
+		Primary:    lipgloss.Color("#6D28D9"), // Purple
+		Secondary:  lipgloss.Color("#0E7490"), // Teal
+		Highlight:  lipgloss.Color("#DDD6FE"), // Pale purple
+		Background: lipgloss.Color("#EFF1F5"), // Off white
+		Surface:    lipgloss.Color("#DCE0E8"), // Light gray
+		Foreground: lipgloss.Color("#4C4F69"), // Slate
+		Muted:      lipgloss.Color("#8C8FA1"), // Medium gray
+		Success:    lipgloss.Color("#40A02B"), // Green
+		Warning:    lipgloss.Color("#DF8E1D"), // Amber
+		Error:      lipgloss.Color("#D20F39"), // Red
+		Border:     lipgloss.Color("#BCC0CC"), // Border gray
+	}
+}
+
+// SolarizedDarkTheme returns Ethan Schoonover's Solarized palette on a dark base.
+func SolarizedDarkTheme() *Theme {
+	return &Theme{
+		Primary:    lipgloss.Color("#268BD2"), // Blue
+		Secondary:  lipgloss.Color("#2AA198"), // Cyan
+		Highlight:  lipgloss.Color("#073642"), // Base02
+		Background: lipgloss.Color("#002B36"), // Base03
+		Surface:    lipgloss.Color("#073642"), // Base02
+		Foreground: lipgloss.Color("#93A1A1"), // Base1
+		Muted:      lipgloss.Color("#586E75"), // Base01
+		Success:    lipgloss.Color("#859900"), // Green
+		Warning:    lipgloss.Color("#B58900"), // Yellow
+		Error:      lipgloss.Color("#DC322F"), // Red
+		Border:     lipgloss.Color("#586E75"), // Base01
+	}
+}
+
+// GruvboxTheme returns the Gruvbox dark palette.
+func GruvboxTheme() *Theme {
+	return &Theme{
+		Primary:    lipgloss.Color("#FE8019"), // Orange
+		Secondary:  lipgloss.Color("#83A598"), // Blue
+		Highlight:  lipgloss.Color("#504945"), // Bg2
+		Background: lipgloss.Color("#282828"), // Bg
+		Surface:    lipgloss.Color("#3C3836"), // Bg1
+		Foreground: lipgloss.Color("#EBDBB2"), // Fg
+		Muted:      lipgloss.Color("#928374"), // Gray
+		Success:    lipgloss.Color("#B8BB26"), // Green
+		Warning:    lipgloss.Color("#FABD2F"), // Yellow
+		Error:      lipgloss.Color("#FB4934"), // Red
+		Border:     lipgloss.Color("#665C54"), // Bg3
+	}
+}
+
+// builtinThemes maps theme names to their constructors, in display order.
+var builtinThemes = []struct {
+	name  string
+	theme func() *Theme
+}{
+	{ThemeDark, DefaultDarkTheme},
+	{ThemeLight, DefaultLightTheme},
+	{"solarized-dark", SolarizedDarkTheme},
+	{"gruvbox", GruvboxTheme},
+}
+
+// ThemeNames returns the names of the built-in themes.
+func ThemeNames() []string {
+	names := make([]string, len(builtinThemes))
+	for i, b := range builtinThemes {
+		names[i] = b.name
+	}
+	return names
+}
+
+// NamedTheme returns the built-in theme called name.
+func NamedTheme(name string) (*Theme, bool) {
+	for _, b := range builtinThemes {
+		if b.name == name {
+			return b.theme(), true
+		}
+	}
+	return nil, false
+}
+
+// AutoTheme returns the dark or light theme to suit the terminal background.
+// Terminals that do not report their background get the dark theme.
+func AutoTheme() *Theme {
+	if lipgloss.HasDarkBackground() {
+		return DefaultDarkTheme()
+	}
+	return DefaultLightTheme()
+}
+
+// IsDark reports whether the theme's background is dark, for choosing
+// content styles to match. Backgrounds that are not hex colours count as dark.
+func (t *Theme) IsDark() bool {
+	background, err := colorful.Hex(string(t.Background))
+	if err != nil {
+		return true
+	}
+	lightness, _, _ := background.Lab()
+	return lightness < 0.5
+}
+
+// LoadTheme resolves a theme setting: the name of a built-in theme, "auto"
+// or the path to a YAML theme file. An empty setting selects the default theme.
+func LoadTheme(setting string) (*Theme, error) {
+	setting = strings.TrimSpace(setting)
+	if setting == "" {
+		return DefaultTheme(), nil
+	}
+	if strings.EqualFold(setting, ThemeAuto) {
+		return AutoTheme(), nil
+	}
+	if theme, ok := NamedTheme(strings.ToLower(setting)); ok {
+		return theme, nil
+	}
+
+	path := setting
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("resolve theme path: %w", err)
+		}
+		path = filepath.Join(home, rest)
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w %q (built-in themes: %s)", ErrUnknownTheme, setting, strings.Join(ThemeNames(), ", "))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read theme file: %w", err)
+	}
+	theme, err := ParseTheme(data)
+	if err != nil {
+		return nil, fmt.Errorf("theme file %s: %w", path, err)
+	}
+	return theme, nil
+}
+
+// SelectTheme resolves a theme setting like LoadTheme, falling back to the
+// default theme if the setting cannot be loaded. The error says why the
+// setting was not used, for the caller to report.
+func SelectTheme(setting string) (*Theme, error) {
+	theme, err := LoadTheme(setting)
+	if err != nil {
+		return DefaultTheme(), err
+	}
+	return theme, nil
+}
+
+// themeFile is the YAML theme file format. Colours are hex codes or ANSI
+// colour numbers; those left out are taken from the base theme.
+type themeFile struct {
+	Base       string `yaml:"base"`
+	Primary    string `yaml:"primary"`
+	Secondary  string `yaml:"secondary"`
+	Highlight  string `yaml:"highlight"`
+	Background string `yaml:"background"`
+	Surface    string `yaml:"surface"`
+	Foreground string `yaml:"foreground"`
+	Muted      string `yaml:"muted"`
+	Success    string `yaml:"success"`
+	Warning    string `yaml:"warning"`
+	Error      string `yaml:"error"`
+	Border     string `yaml:"border"`
+}
+
+// ParseTheme parses a YAML theme file, for example:
+//
+//	base: light
+//	primary: "#D33682"
+//	highlight: "#EEE8D5"
+func ParseTheme(data []byte) (*Theme, error) {
+	var file themeFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse theme: %w", err)
+	}
+
+	theme := DefaultTheme()
+	switch {
+	case strings.EqualFold(file.Base, ThemeAuto):
+		theme = AutoTheme()
+	case file.Base != "":
+		base, ok := NamedTheme(strings.ToLower(file.Base))
+		if !ok {
+			return nil, fmt.Errorf("%w %q as base (built-in themes: %s)",
+				ErrUnknownTheme, file.Base, strings.Join(ThemeNames(), ", "))
+		}
+		theme = base
+	}
+
+	for _, c := range []struct {
+		value string
+		field *lipgloss.Color
+	}{
+		{file.Primary, &theme.Primary},
+		{file.Secondary, &theme.Secondary},
+		{file.Highlight, &theme.Highlight},
+		{file.Background, &theme.Background},
+		{file.Surface, &theme.Surface},
+		{file.Foreground, &theme.Foreground},
+		{file.Muted, &theme.Muted},
+		{file.Success, &theme.Success},
+		{file.Warning, &theme.Warning},
+		{file.Error, &theme.Error},
+		{file.Border, &theme.Border},
+	} {
+		if c.value != "" {
+			*c.field = lipgloss.Color(c.value)
+		}
+	}
+	return theme, nil
+}
+
+// Preview renders a one-line sample of the styles, for choosing a theme.
+func (s *Styles) Preview() string {
+	return strings.Join([]string{
+		s.Title.Render("Title"),
+		s.Subtitle.Render("Subtitle"),
+		s.Normal.Render("text"),
+		s.Selected.Render(" selected "),
+		s.Muted.Render("muted"),
+		s.Success.Render("ok"),
+		s.Warning.Render("warning"),
+		s.Error.Render("error"),
+		s.StatusBar.Render("status"),
+	}, "  ")
+}
@@ -0,0 +1,136 @@
+package styles
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamedThemes_AllColoursSet(t *testing.T) {
+	for _, name := range ThemeNames() {
+		t.Run(name, func(t *testing.T) {
+			theme, ok := NamedTheme(name)
+			require.True(t, ok)
+
+			for _, c := range []lipgloss.Color{
+				theme.Primary, theme.Secondary, theme.Highlight, theme.Background, theme.Surface,
+				theme.Foreground, theme.Muted, theme.Success, theme.Warning, theme.Error, theme.Border,
+			} {
+				assert.NotEmpty(t, string(c))
+			}
+		})
+	}
+}
+
+func TestThemeNames(t *testing.T) {
+	assert.Equal(t, []string{"dark", "light", "solarized-dark", "gruvbox"}, ThemeNames())
+
+	_, ok := NamedTheme("nord")
+	assert.False(t, ok)
+}
+
+func TestLoadTheme_Named(t *testing.T) {
+	theme, err := LoadTheme("Light")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultLightTheme(), theme)
+
+	theme, err = LoadTheme("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultTheme(), theme)
+}
+
+func TestLoadTheme_Auto(t *testing.T) {
+	defer lipgloss.SetHasDarkBackground(lipgloss.HasDarkBackground())
+
+	lipgloss.SetHasDarkBackground(false)
+	theme, err := LoadTheme("auto")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultLightTheme(), theme)
+
+	lipgloss.SetHasDarkBackground(true)
+	theme, err = LoadTheme("Auto")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultDarkTheme(), theme)
+
+	lipgloss.SetHasDarkBackground(false)
+	theme, err = ParseTheme([]byte("base: auto\nprimary: \"#D33682\"\n"))
+	require.NoError(t, err)
+	assert.Equal(t, DefaultLightTheme().Secondary, theme.Secondary)
+	assert.Equal(t, lipgloss.Color("#D33682"), theme.Primary)
+}
+
+func TestSelectTheme_FallsBackToDefault(t *testing.T) {
+	theme, err := SelectTheme("nord")
+
+	assert.ErrorIs(t, err, ErrUnknownTheme)
+	assert.Equal(t, DefaultTheme(), theme)
+
+	theme, err = SelectTheme("gruvbox")
+	require.NoError(t, err)
+	assert.Equal(t, GruvboxTheme(), theme)
+}
+
+func TestLoadTheme_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "theme.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("base: gruvbox\nprimary: \"#D33682\"\nerror: \"9\"\n"), 0o600))
+
+	theme, err := LoadTheme(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, lipgloss.Color("#D33682"), theme.Primary)
+	assert.Equal(t, lipgloss.Color("9"), theme.Error)
+	assert.Equal(t, GruvboxTheme().Secondary, theme.Secondary, "unset colours come from the base theme")
+}
+
+func TestLoadTheme_Errors(t *testing.T) {
+	_, err := LoadTheme("nord")
+	require.ErrorIs(t, err, ErrUnknownTheme)
+	assert.Contains(t, err.Error(), "dark, light, solarized-dark, gruvbox")
+
+	dir := t.TempDir()
+	badBase := filepath.Join(dir, "bad-base.yaml")
+	require.NoError(t, os.WriteFile(badBase, []byte("base: nord\n"), 0o600))
+	_, err = LoadTheme(badBase)
+	require.ErrorIs(t, err, ErrUnknownTheme)
+
+	invalid := filepath.Join(dir, "invalid.yaml")
+	require.NoError(t, os.WriteFile(invalid, []byte("primary: [\n"), 0o600))
+	_, err = LoadTheme(invalid)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "parse theme")
+}
+
+func TestParseTheme_DefaultsToDark(t *testing.T) {
+	theme, err := ParseTheme([]byte("highlight: \"#FFFFFF\"\n"))
+
+	require.NoError(t, err)
+	assert.Equal(t, lipgloss.Color("#FFFFFF"), theme.Highlight)
+	assert.Equal(t, DefaultDarkTheme().Primary, theme.Primary)
+}
+
+func TestTheme_IsDark(t *testing.T) {
+	assert.True(t, DefaultDarkTheme().IsDark())
+	assert.True(t, GruvboxTheme().IsDark())
+	assert.False(t, DefaultLightTheme().IsDark())
+	assert.True(t, (&Theme{Background: lipgloss.Color("236")}).IsDark())
+}
+
+func TestNewStyles_UsesThemeColours(t *testing.T) {
+	theme := GruvboxTheme()
+	s := NewStyles(theme)
+
+	assert.Equal(t, theme.Highlight, s.Selected.GetBackground())
+	assert.Equal(t, theme.Surface, s.StatusBar.GetBackground())
+}
+
+func TestStyles_Preview(t *testing.T) {
+	preview := NewStyles(SolarizedDarkTheme()).Preview()
+
+	for _, sample := range []string{"Title", "selected", "muted", "error", "status"} {
+		assert.Contains(t, preview, sample)
+	}
+}
@@ -4,8 +4,10 @@ package addsource
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
@@ -13,6 +15,7 @@ import (
 
 	drivenoauth "github.com/custodia-labs/sercha-cli/internal/adapters/driven/oauth"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/oauth"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -30,6 +33,8 @@ const (
 	StepEnterCredentials // Inline Client ID/Secret entry
 	StepOAuthFlow        // Browser auth + waiting
 	StepComplete
+	StepSelectAccount   // Reuse an existing account or add a new one (after OAuth, before Complete)
+	StepEnterExclusions // Optional exclusion patterns (after config, before auth)
 )
 
 // Key constants.
@@ -41,6 +46,7 @@ const (
 // View is the add source wizard view.
 type View struct {
 	styles              *styles.Styles
+	keys                *keymap.KeyMap
 	sourceService       driving.SourceService
 	connectorRegistry   driving.ConnectorRegistry
 	providerRegistry    driving.ProviderRegistry
@@ -52,6 +58,10 @@ type View struct {
 	connectors []domain.ConnectorType
 	selected   int
 
+	// connectorFilter narrows the connector list as the user types.
+	// selected indexes the filtered list.
+	connectorFilter string
+
 	// Selected connector
 	connector *domain.ConnectorType
 
@@ -60,6 +70,9 @@ type View struct {
 	configKeys   []string
 	focusIndex   int
 
+	// Optional exclusion patterns, comma-separated
+	excludeInput textinput.Model
+
 	// Auth method selection (for connectors supporting PAT+OAuth)
 	authMethodOptions       []domain.AuthMethod
 	selectedAuthMethodIndex int
@@ -84,6 +97,10 @@ type View struct {
 	accountIdentifier      string                   // Account ID fetched after OAuth
 	callbackServer         *oauth.CallbackServer
 
+	// Account selection (existing Credentials for the selected AuthProvider)
+	existingAccounts     []domain.Credentials
+	selectedAccountIndex int
+
 	// Result
 	source *domain.Source
 	err    error
@@ -117,8 +134,12 @@ func NewView(
 	tokenInput.EchoMode = textinput.EchoPassword
 	tokenInput.CharLimit = 256
 
+	excludeInput := textinput.New()
+	excludeInput.Placeholder = "e.g. node_modules, *.log (leave empty to index everything)"
+
 	return &View{
 		styles:              s,
+		keys:                keymap.DefaultKeyMap(),
 		sourceService:       sourceService,
 		connectorRegistry:   connectorRegistry,
 		providerRegistry:    providerRegistry,
@@ -128,9 +149,15 @@ func NewView(
 		clientIDInput:       clientIDInput,
 		clientSecretInput:   clientSecretInput,
 		tokenInput:          tokenInput,
+		excludeInput:        excludeInput,
 	}
 }
 
+// SetKeyMap sets the keys the wizard's lists respond to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	v.keys = km
+}
+
 // Init initialises the view and loads connectors.
 func (v *View) Init() tea.Cmd {
 	return v.loadConnectors()
@@ -209,9 +236,27 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 			v.err = msg.Err
 			v.step = StepEnterCredentials
 		} else {
-			// OAuth completed, create the source with the new authorization
+			// OAuth completed, check for existing accounts before creating the source
+			return v, v.loadExistingAccounts()
+		}
+		return v, nil
+
+	case accountsLoaded:
+		// No existing accounts (or lookup failed) - add the new account directly
+		if msg.err != nil || len(msg.accounts) == 0 {
 			return v, v.createSourceWithNewAuthorization()
 		}
+		v.existingAccounts = msg.accounts
+		// Preselect the matching account if the user signed in to one we have,
+		// otherwise "Add as new account" (last option)
+		v.selectedAccountIndex = len(msg.accounts)
+		for i := range msg.accounts {
+			if v.accountIdentifier != "" && msg.accounts[i].AccountIdentifier == v.accountIdentifier {
+				v.selectedAccountIndex = i
+				break
+			}
+		}
+		v.step = StepSelectAccount
 		return v, nil
 
 	case oauthFlowStarted:
@@ -247,21 +292,29 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 		// Go back one step or exit
 		switch v.step {
 		case StepSelectConnector:
+			if v.connectorFilter != "" {
+				v.setConnectorFilter("")
+				return v, nil
+			}
 			return v, func() tea.Msg {
 				return messages.ViewChanged{View: messages.ViewSources}
 			}
 		case StepEnterConfig:
 			v.step = StepSelectConnector
 			return v, nil
-		case StepSelectAuthMethod:
+		case StepEnterExclusions:
+			v.excludeInput.Blur()
 			v.step = StepEnterConfig
+			return v, v.updateFocus()
+		case StepSelectAuthMethod:
+			v.step = StepEnterExclusions
 			return v, nil
 		case StepSelectAuth:
-			// Go back to auth method if we came from there, otherwise config
+			// Go back to auth method if we came from there, otherwise exclusions
 			if v.connector != nil && v.connector.AuthCapability.SupportsMultipleMethods() {
 				v.step = StepSelectAuthMethod
 			} else {
-				v.step = StepEnterConfig
+				v.step = StepEnterExclusions
 			}
 			return v, nil
 		case StepEnterCredentials:
@@ -271,7 +324,7 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 			} else if v.connector != nil && v.connector.AuthCapability.SupportsMultipleMethods() {
 				v.step = StepSelectAuthMethod
 			} else {
-				v.step = StepEnterConfig
+				v.step = StepEnterExclusions
 			}
 			return v, nil
 		case StepOAuthFlow:
@@ -279,6 +332,12 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 			v.waitingForAuth = false
 			v.step = StepEnterCredentials
 			return v, nil
+		case StepSelectAccount:
+			// Discard the new tokens and start authentication again
+			v.pendingOAuthTokens = nil
+			v.existingAccounts = nil
+			v.step = StepEnterCredentials
+			return v, nil
 		case StepComplete:
 			return v, func() tea.Msg {
 				return messages.ViewChanged{View: messages.ViewSources}
@@ -291,6 +350,8 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 		return v.handleConnectorSelect(msg)
 	case StepEnterConfig:
 		return v.handleConfigInput(msg)
+	case StepEnterExclusions:
+		return v.handleExclusionInput(msg)
 	case StepSelectAuthMethod:
 		return v.handleAuthMethodSelect(msg)
 	case StepSelectAuth:
@@ -300,6 +361,8 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 	case StepOAuthFlow:
 		// Waiting for OAuth callback - no key handling needed
 		return v, nil
+	case StepSelectAccount:
+		return v.handleAccountSelect(msg)
 	case StepComplete:
 		if msg.String() == keyEnter {
 			return v, func() tea.Msg {
@@ -311,19 +374,33 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 	return v, nil
 }
 
+// handleConnectorSelect handles the connector list. Typing filters the list;
+// navigation keys that are printable only navigate until typing starts.
 func (v *View) handleConnectorSelect(msg tea.KeyMsg) (*View, tea.Cmd) {
-	switch msg.String() {
-	case "up", "k":
+	filtered := v.filteredConnectors()
+	key := msg.String()
+	typing := msg.Type == tea.KeyRunes && !msg.Alt
+
+	switch {
+	case typing && (v.connectorFilter != "" ||
+		!keymap.Matches(key, v.keys.Up) && !keymap.Matches(key, v.keys.Down)):
+		v.setConnectorFilter(v.connectorFilter + string(msg.Runes))
+	case msg.Type == tea.KeyBackspace:
+		if v.connectorFilter != "" {
+			_, size := utf8.DecodeLastRuneInString(v.connectorFilter)
+			v.setConnectorFilter(v.connectorFilter[:len(v.connectorFilter)-size])
+		}
+	case keymap.Matches(key, v.keys.Up):
 		if v.selected > 0 {
 			v.selected--
 		}
-	case keyDown, "j":
-		if v.selected < len(v.connectors)-1 {
+	case keymap.Matches(key, v.keys.Down):
+		if v.selected < len(filtered)-1 {
 			v.selected++
 		}
-	case keyEnter:
-		if len(v.connectors) > 0 && v.selected < len(v.connectors) {
-			v.connector = &v.connectors[v.selected]
+	case keymap.Matches(key, v.keys.Select):
+		if v.selected < len(filtered) {
+			v.connector = &v.connectors[filtered[v.selected]]
 			cmd := v.initConfigInputs()
 			v.step = StepEnterConfig
 			return v, cmd
@@ -332,6 +409,59 @@ func (v *View) handleConnectorSelect(msg tea.KeyMsg) (*View, tea.Cmd) {
 	return v, nil
 }
 
+// setConnectorFilter changes the connector filter, moving the selection to
+// the best match.
+func (v *View) setConnectorFilter(filter string) {
+	v.connectorFilter = filter
+	v.selected = 0
+}
+
+// filteredConnectors returns the indexes in v.connectors of the connectors
+// matching the filter, best matches first. A connector matches if the filter
+// is a prefix, substring or subsequence of its name or ID, ignoring case.
+func (v *View) filteredConnectors() []int {
+	indexes := make([]int, 0, len(v.connectors))
+	ranks := make(map[int]int, len(v.connectors))
+	for i := range v.connectors {
+		c := &v.connectors[i]
+		rank, ok := fuzzyRank(v.connectorFilter, c.Name)
+		if idRank, idOK := fuzzyRank(v.connectorFilter, c.ID); idOK && (!ok || idRank < rank) {
+			rank, ok = idRank, true
+		}
+		if ok {
+			indexes = append(indexes, i)
+			ranks[i] = rank
+		}
+	}
+	sort.SliceStable(indexes, func(a, b int) bool {
+		return ranks[indexes[a]] < ranks[indexes[b]]
+	})
+	return indexes
+}
+
+// fuzzyRank reports whether query matches target, ignoring case, and how
+// closely: 0 for a prefix, 1 for a substring and 2 for a subsequence.
+func fuzzyRank(query, target string) (int, bool) {
+	query = strings.ToLower(query)
+	target = strings.ToLower(target)
+	switch {
+	case strings.HasPrefix(target, query):
+		return 0, true
+	case strings.Contains(target, query):
+		return 1, true
+	}
+
+	rest := target
+	for _, r := range query {
+		i := strings.IndexRune(rest, r)
+		if i < 0 {
+			return 0, false
+		}
+		rest = rest[i+utf8.RuneLen(r):]
+	}
+	return 2, true
+}
+
 func (v *View) initConfigInputs() tea.Cmd {
 	if v.connector == nil {
 		return nil
@@ -339,6 +469,7 @@ func (v *View) initConfigInputs() tea.Cmd {
 
 	v.configInputs = make([]textinput.Model, len(v.connector.ConfigKeys))
 	v.configKeys = make([]string, len(v.connector.ConfigKeys))
+	v.excludeInput.SetValue("")
 
 	for i, key := range v.connector.ConfigKeys {
 		ti := textinput.New()
@@ -387,7 +518,11 @@ func (v *View) handleConfigInput(msg tea.KeyMsg) (*View, tea.Cmd) {
 	case keyEnter:
 		// Validate required fields
 		if v.validateConfig() {
-			return v, v.determineNextStepAfterConfig()
+			for i := range v.configInputs {
+				v.configInputs[i].Blur()
+			}
+			v.step = StepEnterExclusions
+			return v, v.excludeInput.Focus()
 		}
 		return v, nil
 	default:
@@ -401,6 +536,25 @@ func (v *View) handleConfigInput(msg tea.KeyMsg) (*View, tea.Cmd) {
 	return v, nil
 }
 
+// handleExclusionInput handles the optional exclusion patterns step.
+//
+//nolint:gocritic // evalOrder: bubbletea pattern returns cmd from method call
+func (v *View) handleExclusionInput(msg tea.KeyMsg) (*View, tea.Cmd) {
+	if msg.String() != keyEnter {
+		var cmd tea.Cmd
+		v.excludeInput, cmd = v.excludeInput.Update(msg)
+		return v, cmd
+	}
+
+	if err := domain.ValidateExclusionPatterns(domain.ParseExclusionPatterns(v.excludeInput.Value())); err != nil {
+		v.err = err
+		return v, nil
+	}
+	v.err = nil
+	v.excludeInput.Blur()
+	return v, v.determineNextStepAfterConfig()
+}
+
 // determineNextStepAfterConfig determines the next wizard step after config based on auth requirements.
 func (v *View) determineNextStepAfterConfig() tea.Cmd {
 	if v.connector == nil {
@@ -507,16 +661,16 @@ func (v *View) loadAuthorizations() tea.Cmd {
 func (v *View) handleAuthMethodSelect(msg tea.KeyMsg) (*View, tea.Cmd) {
 	maxIndex := len(v.authMethodOptions) - 1
 
-	switch msg.String() {
-	case "up", "k":
+	switch key := msg.String(); {
+	case keymap.Matches(key, v.keys.Up):
 		if v.selectedAuthMethodIndex > 0 {
 			v.selectedAuthMethodIndex--
 		}
-	case keyDown, "j":
+	case keymap.Matches(key, v.keys.Down):
 		if v.selectedAuthMethodIndex < maxIndex {
 			v.selectedAuthMethodIndex++
 		}
-	case keyEnter:
+	case keymap.Matches(key, v.keys.Select):
 		if v.selectedAuthMethodIndex >= 0 && v.selectedAuthMethodIndex < len(v.authMethodOptions) {
 			v.chosenAuthMethod = v.authMethodOptions[v.selectedAuthMethodIndex]
 
@@ -551,22 +705,22 @@ func (v *View) handleAuthSelect(msg tea.KeyMsg) (*View, tea.Cmd) {
 	// Options: existing auth providers + "Create new OAuth app" at the end
 	maxIndex := len(v.authProviders) // last index is "create new"
 
-	switch msg.String() {
-	case "up", "k":
+	switch key := msg.String(); {
+	case keymap.Matches(key, v.keys.Up):
 		if v.selectedAuthIndex > 0 {
 			v.selectedAuthIndex--
 		}
-	case keyDown, "j":
+	case keymap.Matches(key, v.keys.Down):
 		if v.selectedAuthIndex < maxIndex {
 			v.selectedAuthIndex++
 		}
-	case "n", "a":
+	case key == "n", key == "a":
 		// Shortcut to add new OAuth app
 		v.creatingNewAuth = true
 		v.initCredentialInputs()
 		v.step = StepEnterCredentials
 		return v, v.clientIDInput.Focus()
-	case keyEnter:
+	case keymap.Matches(key, v.keys.Select):
 		if v.selectedAuthIndex == len(v.authProviders) {
 			// "Create new OAuth app" selected
 			v.creatingNewAuth = true
@@ -583,6 +737,38 @@ func (v *View) handleAuthSelect(msg tea.KeyMsg) (*View, tea.Cmd) {
 	return v, nil
 }
 
+// handleAccountSelect handles the choice between reusing an existing account
+// and adding the newly authenticated account.
+//
+//nolint:gocritic // evalOrder: bubbletea pattern returns cmd from method call
+func (v *View) handleAccountSelect(msg tea.KeyMsg) (*View, tea.Cmd) {
+	// Options: existing accounts + "Add as new account" at the end
+	maxIndex := len(v.existingAccounts)
+
+	switch key := msg.String(); {
+	case keymap.Matches(key, v.keys.Up):
+		if v.selectedAccountIndex > 0 {
+			v.selectedAccountIndex--
+		}
+	case keymap.Matches(key, v.keys.Down):
+		if v.selectedAccountIndex < maxIndex {
+			v.selectedAccountIndex++
+		}
+	case key == "n", key == "a":
+		// Shortcut to add as a new account
+		return v, v.createSourceWithNewAuthorization()
+	case keymap.Matches(key, v.keys.Select):
+		if v.selectedAccountIndex == len(v.existingAccounts) {
+			return v, v.createSourceWithNewAuthorization()
+		}
+		if v.selectedAccountIndex >= 0 && v.selectedAccountIndex < len(v.existingAccounts) {
+			return v, v.createSourceWithExistingAccount(v.existingAccounts[v.selectedAccountIndex])
+		}
+		v.err = fmt.Errorf("no account selected")
+	}
+	return v, nil
+}
+
 // handleCredentialsInput handles inline credential entry (OAuth Client ID/Secret or PAT).
 //
 //nolint:gocritic,gocognit // evalOrder: bubbletea pattern; credential handling requires complexity
@@ -668,19 +854,7 @@ func (v *View) createAuthorizationAndSource() tea.Cmd {
 		ctx := context.Background()
 
 		// Build source config
-		config := make(map[string]string)
-		for i, key := range v.configKeys {
-			config[key] = v.configInputs[i].Value()
-		}
-
-		name := v.connector.Name
-		if val, ok := config["path"]; ok && val != "" {
-			name = val
-		} else if val, ok := config["owner"]; ok {
-			if repo, ok := config["repo"]; ok {
-				name = val + "/" + repo
-			}
-		}
+		config, name := v.sourceConfigAndName()
 
 		// Create source first (credentials have FK to source)
 		sourceID := uuid.New().String()
@@ -890,6 +1064,88 @@ func (v *View) waitForOAuthCallback(authProviderID string, flowState *driving.OA
 	}
 }
 
+// accountsLoaded is a message carrying the existing accounts for the selected AuthProvider.
+type accountsLoaded struct {
+	accounts []domain.Credentials
+	err      error
+}
+
+// loadExistingAccounts returns a command that loads the accounts already
+// authenticated through the selected AuthProvider.
+func (v *View) loadExistingAccounts() tea.Cmd {
+	return func() tea.Msg {
+		if v.credentialsService == nil || v.selectedAuthProviderID == "" {
+			return accountsLoaded{}
+		}
+		accounts, err := v.credentialsService.GetAllByAuthProviderID(context.Background(), v.selectedAuthProviderID)
+		return accountsLoaded{accounts: accounts, err: err}
+	}
+}
+
+// createSourceWithExistingAccount creates the source linked to an existing account's Credentials.
+// If the user signed in to that same account, its tokens are replaced with the fresh ones.
+func (v *View) createSourceWithExistingAccount(account domain.Credentials) tea.Cmd {
+	return func() tea.Msg {
+		if v.sourceService == nil || v.credentialsService == nil ||
+			v.connector == nil || v.selectedAuthProviderID == "" {
+			return messages.SourceAdded{Err: fmt.Errorf("service not available")}
+		}
+
+		ctx := context.Background()
+
+		// Refresh the shared account's tokens when re-authenticated as the same user
+		if v.pendingOAuthTokens != nil && v.accountIdentifier != "" &&
+			account.AccountIdentifier == v.accountIdentifier {
+			account.OAuth = v.pendingOAuthTokens
+			account.UpdatedAt = time.Now()
+			if err := v.credentialsService.Save(ctx, account); err != nil {
+				return messages.SourceAdded{Err: fmt.Errorf("failed to update credentials: %w", err)}
+			}
+		}
+
+		config, name := v.sourceConfigAndName()
+		if account.AccountIdentifier != "" {
+			name = fmt.Sprintf("%s (%s)", name, account.AccountIdentifier)
+		}
+
+		source := domain.Source{
+			ID:             uuid.New().String(),
+			Type:           v.connector.ID,
+			Name:           name,
+			Config:         config,
+			AuthProviderID: v.selectedAuthProviderID,
+			CredentialsID:  account.ID,
+		}
+
+		if err := v.sourceService.Add(ctx, source); err != nil {
+			return messages.SourceAdded{Err: fmt.Errorf("failed to add source: %w", err)}
+		}
+
+		return messages.SourceAdded{Source: source, Err: nil}
+	}
+}
+
+// sourceConfigAndName collects the entered config and derives a display name from it.
+func (v *View) sourceConfigAndName() (config map[string]string, name string) {
+	config = make(map[string]string)
+	for i, key := range v.configKeys {
+		config[key] = v.configInputs[i].Value()
+	}
+	if patterns := domain.ParseExclusionPatterns(v.excludeInput.Value()); len(patterns) > 0 {
+		config[domain.ConfigKeyExcludePatterns] = domain.FormatExclusionPatterns(patterns)
+	}
+
+	name = v.connector.Name
+	if val, ok := config["path"]; ok && val != "" {
+		name = val
+	} else if val, ok := config["owner"]; ok {
+		if repo, ok := config["repo"]; ok {
+			name = val + "/" + repo
+		}
+	}
+	return config, name
+}
+
 // createSourceWithNewAuthorization creates the source with AuthProviderID and Credentials (new system).
 func (v *View) createSourceWithNewAuthorization() tea.Cmd {
 	return func() tea.Msg {
@@ -904,19 +1160,7 @@ func (v *View) createSourceWithNewAuthorization() tea.Cmd {
 
 		ctx := context.Background()
 
-		config := make(map[string]string)
-		for i, key := range v.configKeys {
-			config[key] = v.configInputs[i].Value()
-		}
-
-		name := v.connector.Name
-		if val, ok := config["path"]; ok && val != "" {
-			name = val
-		} else if val, ok := config["owner"]; ok {
-			if repo, ok := config["repo"]; ok {
-				name = val + "/" + repo
-			}
-		}
+		config, name := v.sourceConfigAndName()
 
 		// Append account identifier for OAuth sources (like CLI does)
 		if v.accountIdentifier != "" {
@@ -970,19 +1214,7 @@ func (v *View) createSource() tea.Cmd {
 			return messages.SourceAdded{Err: fmt.Errorf("service not available")}
 		}
 
-		config := make(map[string]string)
-		for i, key := range v.configKeys {
-			config[key] = v.configInputs[i].Value()
-		}
-
-		name := v.connector.Name
-		if val, ok := config["path"]; ok && val != "" {
-			name = val
-		} else if val, ok := config["owner"]; ok {
-			if repo, ok := config["repo"]; ok {
-				name = val + "/" + repo
-			}
-		}
+		config, name := v.sourceConfigAndName()
 
 		source := domain.Source{
 			ID:     uuid.New().String(),
@@ -1048,6 +1280,8 @@ func (v *View) View() string {
 		b.WriteString(v.renderConnectorSelect())
 	case StepEnterConfig:
 		b.WriteString(v.renderConfigInput())
+	case StepEnterExclusions:
+		b.WriteString(v.renderExclusionInput())
 	case StepSelectAuthMethod:
 		b.WriteString(v.renderAuthMethodSelect())
 	case StepSelectAuth:
@@ -1056,6 +1290,8 @@ func (v *View) View() string {
 		b.WriteString(v.renderCredentialsInput())
 	case StepOAuthFlow:
 		b.WriteString(v.renderOAuthFlow())
+	case StepSelectAccount:
+		b.WriteString(v.renderAccountSelect())
 	case StepComplete:
 		b.WriteString(v.renderComplete())
 	}
@@ -1073,9 +1309,9 @@ func (v *View) renderProgress() string {
 	switch v.step {
 	case StepSelectConnector:
 		currentIdx = 0
-	case StepEnterConfig:
+	case StepEnterConfig, StepEnterExclusions:
 		currentIdx = 1
-	case StepSelectAuthMethod, StepSelectAuth, StepEnterCredentials, StepOAuthFlow:
+	case StepSelectAuthMethod, StepSelectAuth, StepEnterCredentials, StepOAuthFlow, StepSelectAccount:
 		currentIdx = 2
 	case StepComplete:
 		currentIdx = 3
@@ -1108,7 +1344,19 @@ func (v *View) renderConnectorSelect() string {
 		return b.String()
 	}
 
-	for i, c := range v.connectors {
+	if v.connectorFilter != "" {
+		b.WriteString(v.styles.Normal.Render("Filter: " + v.connectorFilter))
+		b.WriteString("\n\n")
+	}
+
+	filtered := v.filteredConnectors()
+	if len(filtered) == 0 {
+		b.WriteString(v.styles.Muted.Render("No connectors match."))
+		return b.String()
+	}
+
+	for i, idx := range filtered {
+		c := v.connectors[idx]
 		indicator := "  "
 		if i == v.selected {
 			indicator = "> "
@@ -1169,6 +1417,23 @@ func (v *View) renderConfigInput() string {
 	return b.String()
 }
 
+func (v *View) renderExclusionInput() string {
+	var b strings.Builder
+
+	if v.connector == nil {
+		return ""
+	}
+
+	b.WriteString(v.styles.Subtitle.Render(fmt.Sprintf("Exclude paths from %s (optional):", v.connector.Name)))
+	b.WriteString("\n\n")
+	b.WriteString(v.styles.Muted.Render("Comma-separated glob patterns for files and folders to skip during sync."))
+	b.WriteString("\n\n")
+	b.WriteString(v.excludeInput.View())
+	b.WriteString("\n\n")
+
+	return b.String()
+}
+
 func (v *View) renderAuthMethodSelect() string {
 	var b strings.Builder
 
@@ -1333,6 +1598,60 @@ func (v *View) renderOAuthFlow() string {
 	return b.String()
 }
 
+func (v *View) renderAccountSelect() string {
+	var b strings.Builder
+
+	b.WriteString(v.styles.Subtitle.Render("Choose an account:"))
+	b.WriteString("\n\n")
+
+	signedInAs := v.accountIdentifier
+	if signedInAs == "" {
+		signedInAs = "unknown account"
+	}
+	b.WriteString(v.styles.Muted.Render(fmt.Sprintf("Signed in as %s. Reuse an existing account or add a new one.", signedInAs)))
+	b.WriteString("\n\n")
+
+	// Show existing accounts
+	for i := range v.existingAccounts {
+		account := &v.existingAccounts[i]
+		indicator := "  "
+		if i == v.selectedAccountIndex {
+			indicator = "> "
+		}
+
+		label := account.AccountIdentifier
+		if label == "" {
+			label = "unnamed account"
+		}
+		if v.accountIdentifier != "" && account.AccountIdentifier == v.accountIdentifier {
+			label += " (signed in)"
+		}
+
+		line := fmt.Sprintf("%s%d. Reuse %s", indicator, i+1, label)
+		if i == v.selectedAccountIndex {
+			b.WriteString(v.styles.Selected.Render(line))
+		} else {
+			b.WriteString(v.styles.Normal.Render(line))
+		}
+		b.WriteString("\n")
+	}
+
+	// "Add as new account" option at the end
+	indicator := "  "
+	if v.selectedAccountIndex == len(v.existingAccounts) {
+		indicator = "> "
+	}
+	line := fmt.Sprintf("%s%d. Add %s as a new account", indicator, len(v.existingAccounts)+1, signedInAs)
+	if v.selectedAccountIndex == len(v.existingAccounts) {
+		b.WriteString(v.styles.Selected.Render(line))
+	} else {
+		b.WriteString(v.styles.Normal.Render(line))
+	}
+	b.WriteString("\n")
+
+	return b.String()
+}
+
 func (v *View) renderComplete() string {
 	var b strings.Builder
 
@@ -1349,19 +1668,27 @@ func (v *View) renderComplete() string {
 }
 
 func (v *View) renderHelp() string {
+	nav := "[" + v.keys.NavigationKeys() + "] navigate  "
 	switch v.step {
 	case StepSelectConnector:
-		return v.styles.Help.Render("[j/k] navigate  [enter] select  [esc] cancel")
+		if v.connectorFilter != "" {
+			return v.styles.Help.Render("[↑/↓] navigate  [enter] select  [esc] clear filter")
+		}
+		return v.styles.Help.Render(nav + "[enter] select  [esc] cancel  type to filter")
 	case StepEnterConfig:
 		return v.styles.Help.Render("[tab] next field  [enter] continue  [esc] back")
+	case StepEnterExclusions:
+		return v.styles.Help.Render("[enter] continue  [esc] back")
 	case StepSelectAuthMethod:
-		return v.styles.Help.Render("[j/k] navigate  [enter] select  [esc] back")
+		return v.styles.Help.Render(nav + "[enter] select  [esc] back")
 	case StepSelectAuth:
-		return v.styles.Help.Render("[j/k] navigate  [enter] select  [n] new app  [esc] back")
+		return v.styles.Help.Render(nav + "[enter] select  [n] new app  [esc] back")
 	case StepEnterCredentials:
 		return v.styles.Help.Render("[tab] next field  [enter] continue  [esc] back")
 	case StepOAuthFlow:
 		return v.styles.Help.Render("[esc] cancel")
+	case StepSelectAccount:
+		return v.styles.Help.Render(nav + "[enter] select  [n] new account  [esc] back")
 	case StepComplete:
 		return v.styles.Help.Render("[enter] done  [esc] back to sources")
 	default:
@@ -1369,6 +1696,21 @@ func (v *View) renderHelp() string {
 	}
 }
 
+// KeyBindings returns the keys the add source wizard responds to.
+func (v *View) KeyBindings() []keymap.KeyBinding {
+	return []keymap.KeyBinding{
+		{Group: "Lists", Keys: v.keys.Up.Help().Key, Description: "move up"},
+		{Group: "Lists", Keys: v.keys.Down.Help().Key, Description: "move down"},
+		{Group: "Lists", Keys: v.keys.Select.Help().Key, Description: "select"},
+		{Group: "Lists", Keys: "n", Description: "add a new app or account"},
+		{Group: "Lists", Keys: "type", Description: "filter connectors by name"},
+		{Group: "Forms", Keys: "tab/↓", Description: "next field"},
+		{Group: "Forms", Keys: "shift+tab/↑", Description: "previous field"},
+		{Group: "Forms", Keys: "enter", Description: "continue"},
+		{Group: "Wizard", Keys: "esc", Description: "go back a step, or cancel"},
+	}
+}
+
 // SetDimensions sets the view dimensions.
 func (v *View) SetDimensions(width, height int) {
 	v.width = width
@@ -1376,6 +1718,12 @@ func (v *View) SetDimensions(width, height int) {
 	v.ready = true
 }
 
+// InputFocused returns true if the current step has text fields, so keys
+// are typed rather than treated as shortcuts.
+func (v *View) InputFocused() bool {
+	return v.step == StepEnterConfig || v.step == StepEnterCredentials
+}
+
 // Reset resets the wizard to initial state.
 func (v *View) Reset() {
 	// Stop callback server if running
@@ -1404,6 +1752,8 @@ func (v *View) Reset() {
 	v.selectedAuthProviderID = ""
 	v.pendingOAuthTokens = nil
 	v.accountIdentifier = ""
+	v.existingAccounts = nil
+	v.selectedAccountIndex = 0
 	v.source = nil
 	v.err = nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -52,6 +53,10 @@ func (m *MockSourceService) Update(ctx context.Context, source domain.Source) er
 	return nil
 }
 
+func (m *MockSourceService) Rename(ctx context.Context, id, newName string) error {
+	return nil
+}
+
 func (m *MockSourceService) ValidateConfig(
 	ctx context.Context,
 	connectorType string,
@@ -178,11 +183,20 @@ func (m *MockConnectorRegistry) ExchangeCode(_ context.Context, _ string, _ *dom
 	return nil, nil
 }
 
+func (m *MockConnectorRegistry) RefreshToken(_ context.Context, _ string, _ *domain.AuthProvider, _ string) (*domain.OAuthToken, error) {
+	return nil, nil
+}
+
+func (m *MockConnectorRegistry) UnhandledMIMETypes() []domain.UnhandledMIMEType {
+	return nil
+}
+
 // MockCredentialsService implements driving.CredentialsService for testing.
 type MockCredentialsService struct {
 	SaveFunc          func(ctx context.Context, creds domain.Credentials) error
 	GetFunc           func(ctx context.Context, id string) (*domain.Credentials, error)
 	GetBySourceIDFunc func(ctx context.Context, sourceID string) (*domain.Credentials, error)
+	GetAllFunc        func(ctx context.Context, authProviderID string) ([]domain.Credentials, error)
 	DeleteFunc        func(ctx context.Context, id string) error
 }
 
@@ -207,6 +221,15 @@ func (m *MockCredentialsService) GetBySourceID(ctx context.Context, sourceID str
 	return nil, nil
 }
 
+func (m *MockCredentialsService) GetAllByAuthProviderID(
+	ctx context.Context, authProviderID string,
+) ([]domain.Credentials, error) {
+	if m.GetAllFunc != nil {
+		return m.GetAllFunc(ctx, authProviderID)
+	}
+	return nil, nil
+}
+
 func (m *MockCredentialsService) Delete(ctx context.Context, id string) error {
 	if m.DeleteFunc != nil {
 		return m.DeleteFunc(ctx, id)
@@ -412,7 +435,7 @@ func TestView_Update_KeyMsg_Escape_FromSelectAuth(t *testing.T) {
 	msg := tea.KeyMsg{Type: tea.KeyEsc}
 	view.Update(msg)
 
-	assert.Equal(t, StepEnterConfig, view.step)
+	assert.Equal(t, StepEnterExclusions, view.step)
 }
 
 func TestView_Update_KeyMsg_Escape_FromEnterCredentials_CreatingNew(t *testing.T) {
@@ -434,7 +457,7 @@ func TestView_Update_KeyMsg_Escape_FromEnterCredentials_NotCreatingNew(t *testin
 	msg := tea.KeyMsg{Type: tea.KeyEsc}
 	view.Update(msg)
 
-	assert.Equal(t, StepEnterConfig, view.step)
+	assert.Equal(t, StepEnterExclusions, view.step)
 }
 
 func TestView_Update_KeyMsg_Escape_FromOAuthFlow(t *testing.T) {
@@ -523,6 +546,119 @@ func TestView_Update_KeyMsg_NavigateConnectors_K(t *testing.T) {
 	assert.Equal(t, 0, view.selected)
 }
 
+func TestView_Update_KeyMsg_NavigateConnectors_RemappedDown(t *testing.T) {
+	km, err := keymap.Load(map[string][]string{"down": {"down", "e"}})
+	require.NoError(t, err)
+	view := NewView(styles.DefaultStyles(), nil, nil, nil, nil, nil)
+	view.SetKeyMap(km)
+	view.step = StepSelectConnector
+	view.connectors = []domain.ConnectorType{
+		{ID: "filesystem"},
+		{ID: "github"},
+		{ID: "gmail"},
+	}
+	view.selected = 0
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	assert.Equal(t, 1, view.selected)
+
+	view.Update(tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 2, view.selected)
+	assert.Contains(t, view.View(), "[e/k] navigate")
+
+	// The default key no longer moves down, it starts filtering
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	assert.Equal(t, "j", view.connectorFilter)
+}
+
+func TestView_Update_KeyMsg_FilterConnectors(t *testing.T) {
+	view := NewView(styles.DefaultStyles(), nil, nil, nil, nil, nil)
+	view.ready = true
+	view.step = StepSelectConnector
+	view.connectors = []domain.ConnectorType{
+		{ID: "filesystem", Name: "Local Filesystem"},
+		{ID: "github", Name: "GitHub"},
+		{ID: "google-drive", Name: "Google Drive"},
+		{ID: "slack", Name: "Slack"},
+		{ID: "google-calendar", Name: "Google Calendar"},
+	}
+
+	for _, r := range "goog" {
+		view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	output := view.View()
+	assert.Contains(t, output, "Filter: goog")
+	assert.Contains(t, output, "Google Drive")
+	assert.Contains(t, output, "Google Calendar")
+	assert.NotContains(t, output, "GitHub")
+	assert.NotContains(t, output, "Local Filesystem")
+	assert.NotContains(t, output, "Slack")
+	assert.Contains(t, output, "> Google Drive")
+
+	// Enter selects the highlighted filtered connector
+	view.Update(tea.KeyMsg{Type: tea.KeyDown})
+	assert.Contains(t, view.View(), "> Google Calendar")
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	assert.Equal(t, StepEnterConfig, view.step)
+	require.NotNil(t, view.connector)
+	assert.Equal(t, "google-calendar", view.connector.ID)
+}
+
+func TestView_Update_KeyMsg_FilterConnectors_EditAndClear(t *testing.T) {
+	view := NewView(styles.DefaultStyles(), nil, nil, nil, nil, nil)
+	view.ready = true
+	view.step = StepSelectConnector
+	view.connectors = []domain.ConnectorType{
+		{ID: "github", Name: "GitHub"},
+		{ID: "slack", Name: "Slack"},
+	}
+
+	// Once typing starts, navigation letters are part of the filter
+	for _, r := range "slk" {
+		view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	assert.Equal(t, "slk", view.connectorFilter)
+	assert.Contains(t, view.View(), "> Slack")
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	assert.Contains(t, view.View(), "No connectors match.")
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, StepSelectConnector, view.step, "enter does nothing without a match")
+
+	view.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	assert.Equal(t, "slk", view.connectorFilter)
+
+	// Escape clears the filter before leaving the wizard
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Nil(t, cmd)
+	assert.Empty(t, view.connectorFilter)
+	assert.Contains(t, view.View(), "GitHub")
+}
+
+func TestFuzzyRank(t *testing.T) {
+	tests := []struct {
+		query, target string
+		rank          int
+		ok            bool
+	}{
+		{"", "GitHub", 0, true},
+		{"goog", "Google Drive", 0, true},
+		{"drive", "Google Drive", 1, true},
+		{"gdr", "Google Drive", 2, true},
+		{"gdx", "Google Drive", 0, false},
+	}
+
+	for _, tt := range tests {
+		rank, ok := fuzzyRank(tt.query, tt.target)
+		assert.Equal(t, tt.ok, ok, tt.query)
+		if tt.ok {
+			assert.Equal(t, tt.rank, rank, tt.query)
+		}
+	}
+}
+
 func TestView_Update_KeyMsg_SelectConnector(t *testing.T) {
 	view := NewView(nil, nil, nil, nil, nil, nil)
 	view.step = StepSelectConnector
@@ -652,6 +788,182 @@ func TestView_Update_OAuthFlowCompleted_Error(t *testing.T) {
 	assert.Equal(t, StepEnterCredentials, view.step)
 }
 
+// newAccountSelectView returns a view that has just completed OAuth as "me@example.com".
+func newAccountSelectView(
+	sourceService *MockSourceService, credentialsService *MockCredentialsService,
+) *View {
+	view := NewView(styles.DefaultStyles(), sourceService, nil, nil, nil, credentialsService)
+	view.connector = &domain.ConnectorType{ID: "gmail", Name: "Gmail", ProviderType: domain.ProviderGoogle}
+	view.selectedAuthProviderID = "auth-provider-1"
+	view.pendingOAuthTokens = &domain.OAuthCredentials{AccessToken: "fresh-token", TokenType: "Bearer"}
+	view.accountIdentifier = "me@example.com"
+	return view
+}
+
+func TestView_LoadExistingAccounts(t *testing.T) {
+	var gotProviderID string
+	credentialsService := &MockCredentialsService{
+		GetAllFunc: func(_ context.Context, authProviderID string) ([]domain.Credentials, error) {
+			gotProviderID = authProviderID
+			return []domain.Credentials{{ID: "creds-1", AccountIdentifier: "me@example.com"}}, nil
+		},
+	}
+	view := newAccountSelectView(&MockSourceService{}, credentialsService)
+
+	msg := view.loadExistingAccounts()()
+
+	loaded, ok := msg.(accountsLoaded)
+	require.True(t, ok)
+	assert.Equal(t, "auth-provider-1", gotProviderID)
+	assert.Len(t, loaded.accounts, 1)
+}
+
+func TestView_Update_AccountsLoaded_NoAccountsCreatesNewAccount(t *testing.T) {
+	var saved []domain.Credentials
+	credentialsService := &MockCredentialsService{
+		SaveFunc: func(_ context.Context, creds domain.Credentials) error {
+			saved = append(saved, creds)
+			return nil
+		},
+	}
+	view := newAccountSelectView(&MockSourceService{}, credentialsService)
+
+	_, cmd := view.Update(accountsLoaded{})
+
+	require.NotNil(t, cmd)
+	added, ok := cmd().(messages.SourceAdded)
+	require.True(t, ok)
+	require.NoError(t, added.Err)
+	require.Len(t, saved, 1)
+	assert.Equal(t, "fresh-token", saved[0].OAuth.AccessToken)
+	assert.Equal(t, saved[0].ID, added.Source.CredentialsID)
+}
+
+func TestView_Update_AccountsLoaded_ShowsAccountSelection(t *testing.T) {
+	view := newAccountSelectView(&MockSourceService{}, &MockCredentialsService{})
+	accounts := []domain.Credentials{
+		{ID: "creds-work", AccountIdentifier: "work@example.com"},
+		{ID: "creds-me", AccountIdentifier: "me@example.com"},
+	}
+
+	_, cmd := view.Update(accountsLoaded{accounts: accounts})
+
+	assert.Nil(t, cmd)
+	assert.Equal(t, StepSelectAccount, view.step)
+	// The signed-in account is preselected
+	assert.Equal(t, 1, view.selectedAccountIndex)
+
+	output := view.View()
+	assert.Contains(t, output, "Signed in as me@example.com")
+	assert.Contains(t, output, "Reuse work@example.com")
+	assert.Contains(t, output, "Add me@example.com as a new account")
+}
+
+func TestView_Update_AccountsLoaded_UnknownAccountDefaultsToNew(t *testing.T) {
+	view := newAccountSelectView(&MockSourceService{}, &MockCredentialsService{})
+	accounts := []domain.Credentials{{ID: "creds-work", AccountIdentifier: "work@example.com"}}
+
+	view.Update(accountsLoaded{accounts: accounts})
+
+	assert.Equal(t, StepSelectAccount, view.step)
+	assert.Equal(t, 1, view.selectedAccountIndex)
+}
+
+func TestView_HandleAccountSelect_ReuseSameAccountRefreshesTokens(t *testing.T) {
+	var saved []domain.Credentials
+	var addedSource domain.Source
+	sourceService := &MockSourceService{
+		AddFunc: func(_ context.Context, source domain.Source) error {
+			addedSource = source
+			return nil
+		},
+	}
+	credentialsService := &MockCredentialsService{
+		SaveFunc: func(_ context.Context, creds domain.Credentials) error {
+			saved = append(saved, creds)
+			return nil
+		},
+	}
+	view := newAccountSelectView(sourceService, credentialsService)
+	view.Update(accountsLoaded{accounts: []domain.Credentials{
+		{ID: "creds-me", SourceID: "src-old", AccountIdentifier: "me@example.com",
+			OAuth: &domain.OAuthCredentials{AccessToken: "old-token"}},
+	}})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	require.NotNil(t, cmd)
+	added, ok := cmd().(messages.SourceAdded)
+	require.True(t, ok)
+	require.NoError(t, added.Err)
+	assert.Equal(t, "creds-me", addedSource.CredentialsID)
+	assert.Equal(t, "auth-provider-1", addedSource.AuthProviderID)
+	assert.Equal(t, "Gmail (me@example.com)", addedSource.Name)
+	// Existing account keeps its ID and owner but gets the fresh tokens
+	require.Len(t, saved, 1)
+	assert.Equal(t, "creds-me", saved[0].ID)
+	assert.Equal(t, "src-old", saved[0].SourceID)
+	assert.Equal(t, "fresh-token", saved[0].OAuth.AccessToken)
+}
+
+func TestView_HandleAccountSelect_ReuseOtherAccountKeepsTokens(t *testing.T) {
+	var saved []domain.Credentials
+	credentialsService := &MockCredentialsService{
+		SaveFunc: func(_ context.Context, creds domain.Credentials) error {
+			saved = append(saved, creds)
+			return nil
+		},
+	}
+	view := newAccountSelectView(&MockSourceService{}, credentialsService)
+	view.Update(accountsLoaded{accounts: []domain.Credentials{
+		{ID: "creds-work", AccountIdentifier: "work@example.com"},
+	}})
+	view.Update(tea.KeyMsg{Type: tea.KeyUp})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	require.NotNil(t, cmd)
+	added, ok := cmd().(messages.SourceAdded)
+	require.True(t, ok)
+	require.NoError(t, added.Err)
+	assert.Equal(t, "creds-work", added.Source.CredentialsID)
+	assert.Empty(t, saved)
+}
+
+func TestView_HandleAccountSelect_NewAccount(t *testing.T) {
+	var saved []domain.Credentials
+	credentialsService := &MockCredentialsService{
+		SaveFunc: func(_ context.Context, creds domain.Credentials) error {
+			saved = append(saved, creds)
+			return nil
+		},
+	}
+	view := newAccountSelectView(&MockSourceService{}, credentialsService)
+	view.Update(accountsLoaded{accounts: []domain.Credentials{
+		{ID: "creds-me", AccountIdentifier: "me@example.com"},
+	}})
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+
+	require.NotNil(t, cmd)
+	added, ok := cmd().(messages.SourceAdded)
+	require.True(t, ok)
+	require.NoError(t, added.Err)
+	require.Len(t, saved, 1)
+	assert.NotEqual(t, "creds-me", saved[0].ID)
+	assert.Equal(t, added.Source.ID, saved[0].SourceID)
+}
+
+func TestView_HandleAccountSelect_EscReturnsToCredentials(t *testing.T) {
+	view := newAccountSelectView(&MockSourceService{}, &MockCredentialsService{})
+	view.Update(accountsLoaded{accounts: []domain.Credentials{{ID: "creds-me"}}})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	assert.Equal(t, StepEnterCredentials, view.step)
+	assert.Nil(t, view.pendingOAuthTokens)
+}
+
 func TestView_ValidateConfig_Valid(t *testing.T) {
 	view := NewView(nil, nil, nil, nil, nil, nil)
 	view.connector = &domain.ConnectorType{
@@ -1450,6 +1762,76 @@ func TestView_HandleConfigInput_Enter_Valid(t *testing.T) {
 	require.NotNil(t, cmd)
 }
 
+func TestView_HandleConfigInput_Enter_GoesToExclusions(t *testing.T) {
+	view := NewView(nil, nil, nil, nil, nil, nil)
+	view.step = StepEnterConfig
+	view.connector = &domain.ConnectorType{ConfigKeys: []domain.ConfigKey{{Key: "path", Required: true}}}
+	ti := textinput.New()
+	ti.SetValue("/home/user")
+	view.configInputs = []textinput.Model{ti}
+	view.configKeys = []string{"path"}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	assert.Equal(t, StepEnterExclusions, view.step)
+	assert.True(t, view.excludeInput.Focused())
+
+	view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, StepEnterConfig, view.step)
+}
+
+func TestView_HandleExclusionInput_AddsPatternsToConfig(t *testing.T) {
+	sourceService := &MockSourceService{}
+	view := NewView(nil, sourceService, nil, nil, nil, nil)
+	view.step = StepEnterExclusions
+	view.connector = &domain.ConnectorType{
+		ID:             "filesystem",
+		Name:           "Filesystem",
+		AuthCapability: domain.AuthCapNone,
+		AuthMethod:     domain.AuthMethodNone,
+	}
+	ti := textinput.New()
+	ti.SetValue("/home/user")
+	view.configInputs = []textinput.Model{ti}
+	view.configKeys = []string{"path"}
+	view.excludeInput.SetValue("node_modules, *.log")
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	require.NotNil(t, cmd)
+	added, ok := cmd().(messages.SourceAdded)
+	require.True(t, ok)
+	require.NoError(t, added.Err)
+	assert.Equal(t, "node_modules,*.log", added.Source.Config[domain.ConfigKeyExcludePatterns])
+	assert.Equal(t, []string{"node_modules", "*.log"}, added.Source.ExclusionPatterns())
+}
+
+func TestView_HandleExclusionInput_InvalidPattern(t *testing.T) {
+	view := NewView(nil, nil, nil, nil, nil, nil)
+	view.step = StepEnterExclusions
+	view.connector = &domain.ConnectorType{ID: "filesystem"}
+	view.excludeInput.SetValue("[bad")
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	assert.Nil(t, cmd)
+	assert.ErrorIs(t, view.err, domain.ErrInvalidInput)
+	assert.Equal(t, StepEnterExclusions, view.step)
+}
+
+func TestView_View_EnterExclusions(t *testing.T) {
+	view := NewView(styles.DefaultStyles(), nil, nil, nil, nil, nil)
+	view.ready = true
+	view.step = StepEnterExclusions
+	view.connector = &domain.ConnectorType{Name: "Filesystem"}
+
+	output := view.View()
+
+	assert.Contains(t, output, "Exclude paths from Filesystem (optional)")
+	assert.Contains(t, output, "Configure")
+	assert.Contains(t, output, "[enter] continue")
+}
+
 func TestView_HandleConfigInput_Enter_Invalid(t *testing.T) {
 	view := NewView(nil, nil, nil, nil, nil, nil)
 	view.connector = &domain.ConnectorType{
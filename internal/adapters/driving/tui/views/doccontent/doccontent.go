@@ -8,6 +8,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -17,6 +18,7 @@ import (
 // View is the document content view.
 type View struct {
 	styles          *styles.Styles
+	keys            *keymap.KeyMap
 	documentService driving.DocumentService
 
 	document     *domain.Document
@@ -34,6 +36,7 @@ type View struct {
 func NewView(s *styles.Styles, documentService driving.DocumentService) *View {
 	return &View{
 		styles:          s,
+		keys:            keymap.DefaultKeyMap(),
 		documentService: documentService,
 	}
 }
@@ -48,6 +51,11 @@ func (v *View) SetDocument(doc *domain.Document) tea.Cmd {
 	return v.loadContent()
 }
 
+// SetKeyMap sets the keys the view responds to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	v.keys = km
+}
+
 // Init initialises the view.
 func (v *View) Init() tea.Cmd {
 	return nil
@@ -104,35 +112,35 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 
 // handleKeyMsg handles key presses.
 func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
-	switch msg.String() {
-	case "up", "k":
+	switch key := msg.String(); {
+	case keymap.Matches(key, v.keys.Up):
 		if v.scrollOffset > 0 {
 			v.scrollOffset--
 		}
-	case "down", "j":
+	case keymap.Matches(key, v.keys.Down):
 		maxOffset := v.maxScrollOffset()
 		if v.scrollOffset < maxOffset {
 			v.scrollOffset++
 		}
-	case "pgup", "ctrl+u":
+	case key == "pgup", key == "ctrl+u":
 		v.scrollOffset -= v.visibleLines()
 		if v.scrollOffset < 0 {
 			v.scrollOffset = 0
 		}
-	case "pgdown", "ctrl+d":
+	case key == "pgdown", key == "ctrl+d":
 		maxOffset := v.maxScrollOffset()
 		v.scrollOffset += v.visibleLines()
 		if v.scrollOffset > maxOffset {
 			v.scrollOffset = maxOffset
 		}
-	case "home", "g":
+	case key == "home", key == "g":
 		v.scrollOffset = 0
-	case "end", "G":
+	case key == "end", key == "G":
 		v.scrollOffset = v.maxScrollOffset()
-	case "c":
+	case key == "c":
 		// Copy all content - stub for now
 		return v, nil
-	case "esc":
+	case key == "esc":
 		return v, func() tea.Msg {
 			return messages.ViewChanged{View: messages.ViewDocuments}
 		}
@@ -270,6 +278,20 @@ func (v *View) renderHelp() string {
 	return v.styles.Help.Render("[↑/↓/PgUp/PgDn] scroll  [g/G] top/bottom  [c] copy all  [esc] back")
 }
 
+// KeyBindings returns the keys the document content view responds to.
+func (v *View) KeyBindings() []keymap.KeyBinding {
+	return []keymap.KeyBinding{
+		{Group: "Navigation", Keys: v.keys.Up.Help().Key, Description: "scroll up"},
+		{Group: "Navigation", Keys: v.keys.Down.Help().Key, Description: "scroll down"},
+		{Group: "Navigation", Keys: "pgup/ctrl+u", Description: "page up"},
+		{Group: "Navigation", Keys: "pgdn/ctrl+d", Description: "page down"},
+		{Group: "Navigation", Keys: "home/g", Description: "go to top"},
+		{Group: "Navigation", Keys: "end/G", Description: "go to bottom"},
+		{Group: "Navigation", Keys: "esc", Description: "back to documents"},
+		{Group: "Actions", Keys: "c", Description: "copy all content"},
+	}
+}
+
 // SetDimensions sets the view dimensions.
 func (v *View) SetDimensions(width, height int) {
 	v.width = width
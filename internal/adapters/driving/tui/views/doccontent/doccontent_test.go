@@ -49,6 +49,14 @@ func (m *MockDocumentService) Refresh(ctx context.Context, documentID string) er
 	return nil
 }
 
+func (m *MockDocumentService) ExcludeMultiple(ctx context.Context, documentIDs []string) error {
+	return nil
+}
+
+func (m *MockDocumentService) RefreshMultiple(ctx context.Context, documentIDs []string) error {
+	return nil
+}
+
 func (m *MockDocumentService) Open(ctx context.Context, documentID string) error {
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
@@ -16,6 +17,7 @@ import (
 // View is the document details view.
 type View struct {
 	styles *styles.Styles
+	keys   *keymap.KeyMap
 
 	details      *driving.DocumentDetails
 	scrollOffset int
@@ -29,6 +31,7 @@ type View struct {
 func NewView(s *styles.Styles) *View {
 	return &View{
 		styles: s,
+		keys:   keymap.DefaultKeyMap(),
 	}
 }
 
@@ -44,6 +47,11 @@ func (v *View) SetError(err error) {
 	v.err = err
 }
 
+// SetKeyMap sets the keys the view responds to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	v.keys = km
+}
+
 // Init initialises the view.
 func (v *View) Init() tea.Cmd {
 	return nil
@@ -71,20 +79,20 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 
 // handleKeyMsg handles key presses.
 func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
-	switch msg.String() {
-	case "up", "k":
+	switch key := msg.String(); {
+	case keymap.Matches(key, v.keys.Up):
 		if v.scrollOffset > 0 {
 			v.scrollOffset--
 		}
-	case "down", "j":
+	case keymap.Matches(key, v.keys.Down):
 		maxOffset := v.maxScrollOffset()
 		if v.scrollOffset < maxOffset {
 			v.scrollOffset++
 		}
-	case "c":
+	case key == "c":
 		// Copy path - stub for now
 		return v, nil
-	case "esc":
+	case key == "esc":
 		return v, func() tea.Msg {
 			return messages.ViewChanged{View: messages.ViewDocuments}
 		}
@@ -249,6 +257,16 @@ func (v *View) renderHelp() string {
 	return v.styles.Help.Render("[↑/↓] scroll  [c] copy path  [esc] back")
 }
 
+// KeyBindings returns the keys the document details view responds to.
+func (v *View) KeyBindings() []keymap.KeyBinding {
+	return []keymap.KeyBinding{
+		{Group: "Navigation", Keys: v.keys.Up.Help().Key, Description: "scroll up"},
+		{Group: "Navigation", Keys: v.keys.Down.Help().Key, Description: "scroll down"},
+		{Group: "Navigation", Keys: "esc", Description: "back to documents"},
+		{Group: "Actions", Keys: "c", Description: "copy the document path"},
+	}
+}
+
 // SetDimensions sets the view dimensions.
 func (v *View) SetDimensions(width, height int) {
 	v.width = width
@@ -4,10 +4,12 @@ package documents
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -29,6 +31,7 @@ const (
 // View is the documents list view.
 type View struct {
 	styles          *styles.Styles
+	keys            *keymap.KeyMap
 	documentService driving.DocumentService
 
 	source       *domain.Source
@@ -42,14 +45,19 @@ type View struct {
 	showingMenu  bool
 	menuSelected ActionOption
 	scrollOffset int
+
+	// checked holds the IDs of the documents ticked for a batch action.
+	checked map[string]bool
 }
 
 // NewView creates a new documents view.
 func NewView(s *styles.Styles, documentService driving.DocumentService) *View {
 	return &View{
 		styles:          s,
+		keys:            keymap.DefaultKeyMap(),
 		documentService: documentService,
 		documents:       []domain.Document{},
+		checked:         make(map[string]bool),
 	}
 }
 
@@ -61,9 +69,15 @@ func (v *View) SetSource(source domain.Source) tea.Cmd {
 	v.scrollOffset = 0
 	v.err = nil
 	v.showingMenu = false
+	v.checked = make(map[string]bool)
 	return v.loadDocuments()
 }
 
+// SetKeyMap sets the keys the view responds to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	v.keys = km
+}
+
 // Init initialises the view.
 func (v *View) Init() tea.Cmd {
 	return nil
@@ -108,6 +122,17 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 		} else {
 			v.documents = msg.Documents
 			v.err = nil
+			v.pruneChecked()
+			// Reloads can remove documents from under the selection
+			v.selected = min(v.selected, max(len(v.documents)-1, 0))
+			v.adjustScroll()
+		}
+		return v, nil
+
+	case messages.WatchUpdated:
+		// Reload when the listed source's documents changed
+		if v.source != nil && slices.Contains(msg.Update.SourceIDs, v.source.ID) {
+			return v, v.loadDocuments()
 		}
 		return v, nil
 
@@ -127,6 +152,22 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 		}
 		return v, nil
 
+	case messages.DocumentsExcluded:
+		if msg.Err != nil {
+			v.err = msg.Err
+			return v, nil
+		}
+		v.checked = make(map[string]bool)
+		return v, v.loadDocuments()
+
+	case messages.DocumentsRefreshed:
+		if msg.Err != nil {
+			v.err = msg.Err
+			return v, nil
+		}
+		v.checked = make(map[string]bool)
+		return v, nil
+
 	case messages.ErrorOccurred:
 		v.err = msg.Err
 		return v, nil
@@ -137,27 +178,41 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 
 // handleKeyMsg handles key presses in list mode.
 func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
-	switch msg.String() {
-	case "up", "k":
+	switch key := msg.String(); {
+	case keymap.Matches(key, v.keys.Up):
 		if v.selected > 0 {
 			v.selected--
 			v.adjustScroll()
 		}
-	case "down", "j":
+	case keymap.Matches(key, v.keys.Down):
 		if v.selected < len(v.documents)-1 {
 			v.selected++
 			v.adjustScroll()
 		}
-	case "enter":
+	case keymap.Matches(key, v.keys.Select):
 		if len(v.documents) > 0 {
 			v.showingMenu = true
 			v.menuSelected = ActionShowContent
 		}
-	case "esc":
+	case key == " ":
+		v.toggleChecked()
+	case key == "x":
+		if ids := v.CheckedIDs(); len(ids) > 0 {
+			return v, v.excludeDocuments(ids)
+		}
+	case key == "esc":
+		// Clear the selection before leaving the view
+		if len(v.checked) > 0 {
+			v.checked = make(map[string]bool)
+			return v, nil
+		}
 		return v, func() tea.Msg {
 			return messages.ViewChanged{View: messages.ViewSourceDetail}
 		}
-	case "r":
+	case key == "r":
+		if ids := v.CheckedIDs(); len(ids) > 0 {
+			return v, v.refreshDocuments(ids)
+		}
 		// Reload documents
 		v.loading = true
 		cmd := v.loadDocuments()
@@ -169,18 +224,18 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 
 // handleMenuKeyMsg handles key presses in action menu mode.
 func (v *View) handleMenuKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
-	switch msg.String() {
-	case "up", "k":
+	switch key := msg.String(); {
+	case keymap.Matches(key, v.keys.Up):
 		if v.menuSelected > ActionShowContent {
 			v.menuSelected--
 		}
-	case "down", "j":
+	case keymap.Matches(key, v.keys.Down):
 		if v.menuSelected < ActionCancel {
 			v.menuSelected++
 		}
-	case "enter":
+	case keymap.Matches(key, v.keys.Select):
 		return v.handleMenuSelect()
-	case "esc":
+	case key == "esc":
 		v.showingMenu = false
 	}
 
@@ -280,6 +335,56 @@ func (v *View) excludeDocument(docID string) tea.Cmd {
 	}
 }
 
+// excludeDocuments returns a command that excludes the documents together.
+func (v *View) excludeDocuments(docIDs []string) tea.Cmd {
+	return func() tea.Msg {
+		if v.documentService == nil {
+			return messages.DocumentsExcluded{DocumentIDs: docIDs, Err: fmt.Errorf("document service not available")}
+		}
+
+		err := v.documentService.ExcludeMultiple(context.Background(), docIDs)
+		return messages.DocumentsExcluded{DocumentIDs: docIDs, Err: err}
+	}
+}
+
+// refreshDocuments returns a command that refreshes the documents together.
+func (v *View) refreshDocuments(docIDs []string) tea.Cmd {
+	return func() tea.Msg {
+		if v.documentService == nil {
+			return messages.DocumentsRefreshed{DocumentIDs: docIDs, Err: fmt.Errorf("document service not available")}
+		}
+
+		err := v.documentService.RefreshMultiple(context.Background(), docIDs)
+		return messages.DocumentsRefreshed{DocumentIDs: docIDs, Err: err}
+	}
+}
+
+// toggleChecked ticks or unticks the highlighted document.
+func (v *View) toggleChecked() {
+	if v.selected >= len(v.documents) {
+		return
+	}
+	id := v.documents[v.selected].ID
+	if v.checked[id] {
+		delete(v.checked, id)
+	} else {
+		v.checked[id] = true
+	}
+}
+
+// pruneChecked drops ticked documents that are no longer listed.
+func (v *View) pruneChecked() {
+	listed := make(map[string]bool, len(v.documents))
+	for i := range v.documents {
+		listed[v.documents[i].ID] = true
+	}
+	for id := range v.checked {
+		if !listed[id] {
+			delete(v.checked, id)
+		}
+	}
+}
+
 // adjustScroll adjusts the scroll offset to keep the selected item visible.
 func (v *View) adjustScroll() {
 	visibleItems := v.visibleItemCount()
@@ -362,17 +467,31 @@ func (v *View) View() string {
 	}
 
 	b.WriteString("\n\n")
+	if len(v.checked) > 0 {
+		b.WriteString(v.renderActionBar())
+		b.WriteString("\n")
+	}
 	b.WriteString(v.renderHelp())
 
 	return b.String()
 }
 
+// renderActionBar renders the actions available for the ticked documents.
+func (v *View) renderActionBar() string {
+	return v.styles.Subtitle.Render(fmt.Sprintf("%d selected", len(v.checked))) + "  " +
+		v.styles.Help.Render("[x] exclude selected  [r] refresh selected  [esc] clear selection")
+}
+
 // renderDocument renders a single document line.
 func (v *View) renderDocument(index int, doc *domain.Document) string {
 	indicator := "  "
 	if index == v.selected {
 		indicator = "> "
 	}
+	mark := "  "
+	if v.checked[doc.ID] {
+		mark = "✓ "
+	}
 
 	title := doc.Title
 	if title == "" {
@@ -399,10 +518,11 @@ func (v *View) renderDocument(index int, doc *domain.Document) string {
 	}
 
 	if index == v.selected {
-		return v.styles.Selected.Render(fmt.Sprintf("%s%-*s  %s", indicator, maxTitleLen, title, uri))
+		return v.styles.Selected.Render(fmt.Sprintf("%s%s%-*s  %s", indicator, mark, maxTitleLen, title, uri))
 	}
 
 	return v.styles.Normal.Render(indicator) +
+		v.styles.Success.Render(mark) +
 		v.styles.Normal.Render(fmt.Sprintf("%-*s  ", maxTitleLen, title)) +
 		v.styles.Muted.Render(uri)
 }
@@ -454,7 +574,25 @@ func (v *View) renderActionMenu() string {
 
 // renderHelp renders the help footer.
 func (v *View) renderHelp() string {
-	return v.styles.Help.Render("[↑/↓] navigate  [enter] actions  [r] reload  [esc] back")
+	return v.styles.Help.Render("[↑/↓] navigate  [space] select  [enter] actions  [r] reload  [esc] back")
+}
+
+// KeyBindings returns the keys the documents view responds to.
+func (v *View) KeyBindings() []keymap.KeyBinding {
+	return []keymap.KeyBinding{
+		{Group: "Navigation", Keys: v.keys.Up.Help().Key, Description: "move up"},
+		{Group: "Navigation", Keys: v.keys.Down.Help().Key, Description: "move down"},
+		{Group: "Navigation", Keys: "esc", Description: "back to source details"},
+		{Group: "Actions", Keys: v.keys.Select.Help().Key, Description: "show actions for the selected document"},
+		{Group: "Actions", Keys: "r", Description: "reload documents"},
+		{Group: "Selection", Keys: "space", Description: "select or deselect the highlighted document"},
+		{Group: "Selection", Keys: "x", Description: "exclude the selected documents"},
+		{Group: "Selection", Keys: "r", Description: "refresh the selected documents"},
+		{Group: "Selection", Keys: "esc", Description: "clear the selection"},
+		{Group: "Action menu", Keys: "↑/↓", Description: "choose an action"},
+		{Group: "Action menu", Keys: v.keys.Select.Help().Key, Description: "run the action"},
+		{Group: "Action menu", Keys: "esc", Description: "close the menu"},
+	}
 }
 
 // SetDimensions sets the view dimensions.
@@ -482,6 +620,17 @@ func (v *View) SelectedDocument() *domain.Document {
 	return nil
 }
 
+// CheckedIDs returns the IDs of the ticked documents, in list order.
+func (v *View) CheckedIDs() []string {
+	var ids []string
+	for i := range v.documents {
+		if v.checked[v.documents[i].ID] {
+			ids = append(ids, v.documents[i].ID)
+		}
+	}
+	return ids
+}
+
 // IsShowingMenu returns true if the action menu is visible.
 func (v *View) IsShowingMenu() bool {
 	return v.showingMenu
@@ -18,13 +18,15 @@ import (
 
 // MockDocumentService implements driving.DocumentService for testing.
 type MockDocumentService struct {
-	ListBySourceFunc func(ctx context.Context, sourceID string) ([]domain.Document, error)
-	GetFunc          func(ctx context.Context, documentID string) (*domain.Document, error)
-	GetContentFunc   func(ctx context.Context, documentID string) (string, error)
-	GetDetailsFunc   func(ctx context.Context, documentID string) (*driving.DocumentDetails, error)
-	ExcludeFunc      func(ctx context.Context, documentID string, reason string) error
-	RefreshFunc      func(ctx context.Context, documentID string) error
-	OpenFunc         func(ctx context.Context, documentID string) error
+	ListBySourceFunc    func(ctx context.Context, sourceID string) ([]domain.Document, error)
+	GetFunc             func(ctx context.Context, documentID string) (*domain.Document, error)
+	GetContentFunc      func(ctx context.Context, documentID string) (string, error)
+	GetDetailsFunc      func(ctx context.Context, documentID string) (*driving.DocumentDetails, error)
+	ExcludeFunc         func(ctx context.Context, documentID string, reason string) error
+	ExcludeMultipleFunc func(ctx context.Context, documentIDs []string) error
+	RefreshFunc         func(ctx context.Context, documentID string) error
+	RefreshMultipleFunc func(ctx context.Context, documentIDs []string) error
+	OpenFunc            func(ctx context.Context, documentID string) error
 }
 
 func (m *MockDocumentService) ListBySource(ctx context.Context, sourceID string) ([]domain.Document, error) {
@@ -62,6 +64,13 @@ func (m *MockDocumentService) Exclude(ctx context.Context, documentID, reason st
 	return nil
 }
 
+func (m *MockDocumentService) ExcludeMultiple(ctx context.Context, documentIDs []string) error {
+	if m.ExcludeMultipleFunc != nil {
+		return m.ExcludeMultipleFunc(ctx, documentIDs)
+	}
+	return nil
+}
+
 func (m *MockDocumentService) Refresh(ctx context.Context, documentID string) error {
 	if m.RefreshFunc != nil {
 		return m.RefreshFunc(ctx, documentID)
@@ -69,6 +78,13 @@ func (m *MockDocumentService) Refresh(ctx context.Context, documentID string) er
 	return nil
 }
 
+func (m *MockDocumentService) RefreshMultiple(ctx context.Context, documentIDs []string) error {
+	if m.RefreshMultipleFunc != nil {
+		return m.RefreshMultipleFunc(ctx, documentIDs)
+	}
+	return nil
+}
+
 func (m *MockDocumentService) Open(ctx context.Context, documentID string) error {
 	if m.OpenFunc != nil {
 		return m.OpenFunc(ctx, documentID)
@@ -187,6 +203,45 @@ func TestView_Update_DocumentsLoaded_DifferentSource(t *testing.T) {
 	assert.Equal(t, "new-doc", view.documents[0].ID)
 }
 
+func TestView_Update_DocumentsLoaded_ClampsSelection(t *testing.T) {
+	view := NewView(nil, nil)
+	view.source = &domain.Source{ID: "src-1"}
+	view.documents = []domain.Document{{ID: "doc-1"}, {ID: "doc-2"}, {ID: "doc-3"}}
+	view.selected = 2
+
+	view.Update(messages.DocumentsLoaded{SourceID: "src-1", Documents: []domain.Document{{ID: "doc-1"}}})
+
+	assert.Equal(t, 0, view.selected)
+	require.NotNil(t, view.SelectedDocument())
+	assert.Equal(t, "doc-1", view.SelectedDocument().ID)
+}
+
+func TestView_Update_WatchUpdated_ReloadsChangedSource(t *testing.T) {
+	svc := &MockDocumentService{
+		ListBySourceFunc: func(_ context.Context, sourceID string) ([]domain.Document, error) {
+			return []domain.Document{{ID: "doc-new", SourceID: sourceID}}, nil
+		},
+	}
+	view := NewView(nil, svc)
+	view.source = &domain.Source{ID: "src-1"}
+
+	_, cmd := view.Update(messages.WatchUpdated{Update: domain.WatchUpdate{SourceIDs: []string{"src-1"}, Indexed: 1}})
+
+	require.NotNil(t, cmd)
+	loaded, ok := cmd().(messages.DocumentsLoaded)
+	require.True(t, ok)
+	assert.Equal(t, "doc-new", loaded.Documents[0].ID)
+}
+
+func TestView_Update_WatchUpdated_IgnoresOtherSources(t *testing.T) {
+	view := NewView(nil, &MockDocumentService{})
+	view.source = &domain.Source{ID: "src-1"}
+
+	_, cmd := view.Update(messages.WatchUpdated{Update: domain.WatchUpdate{SourceIDs: []string{"src-2"}, Deleted: 1}})
+
+	assert.Nil(t, cmd)
+}
+
 func TestView_Update_KeyMsg_Navigation(t *testing.T) {
 	view := NewView(nil, nil)
 	view.width = 80
@@ -405,6 +460,162 @@ func TestView_HandleMenuSelect_Cancel(t *testing.T) {
 	assert.False(t, view.showingMenu)
 }
 
+func spaceKey() tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+}
+
+func runeKey(r rune) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}
+}
+
+func newSelectionView(mock *MockDocumentService) *View {
+	view := NewView(styles.DefaultStyles(), mock)
+	view.SetDimensions(80, 24)
+	view.source = &domain.Source{ID: "src-1", Name: "Test"}
+	view.documents = []domain.Document{
+		{ID: "doc-1", Title: "Document One"},
+		{ID: "doc-2", Title: "Document Two"},
+		{ID: "doc-3", Title: "Document Three"},
+	}
+	return view
+}
+
+func TestView_Space_TogglesSelection(t *testing.T) {
+	view := newSelectionView(nil)
+
+	view.Update(spaceKey())
+	view.Update(runeKey('j'))
+	view.Update(runeKey('j'))
+	view.Update(spaceKey())
+	assert.Equal(t, []string{"doc-1", "doc-3"}, view.CheckedIDs())
+
+	output := view.View()
+	assert.Contains(t, output, "✓ Document One")
+	assert.NotContains(t, output, "✓ Document Two")
+	assert.Contains(t, output, "2 selected")
+	assert.Contains(t, output, "[x] exclude selected")
+
+	view.Update(spaceKey())
+	assert.Equal(t, []string{"doc-1"}, view.CheckedIDs())
+}
+
+func TestView_ActionBar_HiddenWithoutSelection(t *testing.T) {
+	view := newSelectionView(nil)
+
+	assert.NotContains(t, view.View(), "selected  ")
+}
+
+func TestView_ExcludeSelected(t *testing.T) {
+	var excluded []string
+	mock := &MockDocumentService{
+		ExcludeMultipleFunc: func(ctx context.Context, documentIDs []string) error {
+			excluded = documentIDs
+			return nil
+		},
+		ExcludeFunc: func(ctx context.Context, documentID string, reason string) error {
+			t.Fatal("documents should be excluded in one batch")
+			return nil
+		},
+	}
+	view := newSelectionView(mock)
+	view.Update(spaceKey())
+	view.Update(runeKey('j'))
+	view.Update(spaceKey())
+
+	_, cmd := view.Update(runeKey('x'))
+	require.NotNil(t, cmd)
+	msg := cmd()
+
+	assert.Equal(t, []string{"doc-1", "doc-2"}, excluded)
+	assert.Equal(t, messages.DocumentsExcluded{DocumentIDs: []string{"doc-1", "doc-2"}}, msg)
+
+	_, reload := view.Update(msg)
+	assert.NotNil(t, reload, "documents are reloaded after the exclusion")
+	assert.Empty(t, view.CheckedIDs())
+}
+
+func TestView_ExcludeSelected_Error(t *testing.T) {
+	view := newSelectionView(nil)
+	view.Update(spaceKey())
+
+	view.Update(messages.DocumentsExcluded{DocumentIDs: []string{"doc-1"}, Err: errors.New("locked")})
+
+	assert.EqualError(t, view.Err(), "locked")
+	assert.Equal(t, []string{"doc-1"}, view.CheckedIDs(), "the selection is kept to retry")
+}
+
+func TestView_X_WithoutSelection(t *testing.T) {
+	view := newSelectionView(&MockDocumentService{})
+
+	_, cmd := view.Update(runeKey('x'))
+
+	assert.Nil(t, cmd)
+}
+
+func TestView_RefreshSelected(t *testing.T) {
+	var refreshed []string
+	mock := &MockDocumentService{
+		RefreshMultipleFunc: func(ctx context.Context, documentIDs []string) error {
+			refreshed = documentIDs
+			return nil
+		},
+	}
+	view := newSelectionView(mock)
+	view.Update(runeKey('j'))
+	view.Update(spaceKey())
+
+	_, cmd := view.Update(runeKey('r'))
+	require.NotNil(t, cmd)
+	msg := cmd()
+
+	assert.Equal(t, []string{"doc-2"}, refreshed)
+	view.Update(msg)
+	assert.Empty(t, view.CheckedIDs())
+	assert.NoError(t, view.Err())
+}
+
+func TestView_R_WithoutSelectionReloads(t *testing.T) {
+	listed := false
+	mock := &MockDocumentService{
+		ListBySourceFunc: func(ctx context.Context, sourceID string) ([]domain.Document, error) {
+			listed = true
+			return nil, nil
+		},
+	}
+	view := newSelectionView(mock)
+
+	_, cmd := view.Update(runeKey('r'))
+	require.NotNil(t, cmd)
+	_, ok := cmd().(messages.DocumentsLoaded)
+
+	assert.True(t, ok)
+	assert.True(t, listed)
+}
+
+func TestView_Esc_ClearsSelectionFirst(t *testing.T) {
+	view := newSelectionView(nil)
+	view.Update(spaceKey())
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Nil(t, cmd)
+	assert.Empty(t, view.CheckedIDs())
+
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.NotNil(t, cmd)
+	assert.Equal(t, messages.ViewChanged{View: messages.ViewSourceDetail}, cmd())
+}
+
+func TestView_DocumentsLoaded_PrunesSelection(t *testing.T) {
+	view := newSelectionView(nil)
+	view.Update(spaceKey())
+	view.Update(runeKey('j'))
+	view.Update(spaceKey())
+
+	view.Update(messages.DocumentsLoaded{SourceID: "src-1", Documents: []domain.Document{{ID: "doc-2"}}})
+
+	assert.Equal(t, []string{"doc-2"}, view.CheckedIDs())
+}
+
 func TestView_View_EmptyState(t *testing.T) {
 	s := styles.DefaultStyles()
 	view := NewView(s, nil)
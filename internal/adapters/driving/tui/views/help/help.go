@@ -0,0 +1,167 @@
+// Package help provides the keyboard shortcut help overlay for the TUI.
+package help
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
+)
+
+// closeKeys dismiss the overlay, as does the key that opened it.
+var closeKeys = key.NewBinding(
+	key.WithKeys("q", "esc"),
+	key.WithHelp("q/esc", "close help"),
+)
+
+// Overlay lists the key bindings of a view in a scrollable viewport.
+type Overlay struct {
+	styles *styles.Styles
+	keys   *keymap.KeyMap
+
+	title    string
+	bindings []keymap.KeyBinding
+	viewport viewport.Model
+
+	width  int
+	height int
+}
+
+// NewOverlay creates an empty help overlay.
+func NewOverlay(s *styles.Styles) *Overlay {
+	if s == nil {
+		s = styles.DefaultStyles()
+	}
+	return &Overlay{
+		styles:   s,
+		keys:     keymap.DefaultKeyMap(),
+		viewport: viewport.New(0, 0),
+	}
+}
+
+// SetKeyMap sets the keys that scroll and close the overlay.
+func (o *Overlay) SetKeyMap(km *keymap.KeyMap) {
+	o.keys = km
+}
+
+// SetBindings sets the bindings listed and the name of the view they belong
+// to, and scrolls back to the top.
+func (o *Overlay) SetBindings(title string, bindings []keymap.KeyBinding) {
+	o.title = title
+	o.bindings = bindings
+	o.refresh()
+	o.viewport.GotoTop()
+}
+
+// Closes reports whether msg dismisses the overlay.
+func (o *Overlay) Closes(msg tea.KeyMsg) bool {
+	return keymap.Matches(msg.String(), closeKeys) || keymap.Matches(msg.String(), o.keys.Help)
+}
+
+// Update scrolls the overlay.
+func (o *Overlay) Update(msg tea.Msg) (*Overlay, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return o, nil
+	}
+
+	switch key := keyMsg.String(); {
+	case keymap.Matches(key, o.keys.Up):
+		o.viewport.ScrollUp(1)
+	case keymap.Matches(key, o.keys.Down):
+		o.viewport.ScrollDown(1)
+	case key == "pgup", key == "ctrl+u":
+		o.viewport.HalfPageUp()
+	case key == "pgdown", key == "ctrl+d":
+		o.viewport.HalfPageDown()
+	case key == "home", key == "g":
+		o.viewport.GotoTop()
+	case key == "end", key == "G":
+		o.viewport.GotoBottom()
+	}
+	return o, nil
+}
+
+// View renders the overlay.
+func (o *Overlay) View() string {
+	title := "Help"
+	if o.title != "" {
+		title += ": " + o.title
+	}
+
+	help := "[↑/↓] scroll  [" + closeKeys.Help().Key + "/" + o.keys.Help.Help().Key + "] close"
+	if !o.viewport.AtTop() || !o.viewport.AtBottom() {
+		help = fmt.Sprintf("[%d%%] ", int(o.viewport.ScrollPercent()*100)) + help
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		o.styles.Title.Render(title),
+		"",
+		o.viewport.View(),
+		"",
+		o.styles.Help.Render(help),
+	)
+}
+
+// refresh renders the bindings into the viewport.
+func (o *Overlay) refresh() {
+	// Title, footer and the blank lines around the viewport
+	o.viewport.Width = max(o.width, 1)
+	o.viewport.Height = max(o.height-4, 1)
+	o.viewport.SetContent(o.renderBindings())
+}
+
+// renderBindings lists the bindings under their group headings, in the
+// order each group first appears.
+func (o *Overlay) renderBindings() string {
+	if len(o.bindings) == 0 {
+		return o.styles.Muted.Render("No key bindings")
+	}
+
+	var groups []string
+	byGroup := make(map[string][]keymap.KeyBinding)
+	keysWidth := 0
+	for _, b := range o.bindings {
+		if _, ok := byGroup[b.Group]; !ok {
+			groups = append(groups, b.Group)
+		}
+		byGroup[b.Group] = append(byGroup[b.Group], b)
+		keysWidth = max(keysWidth, lipgloss.Width(b.Keys))
+	}
+
+	var lines []string
+	for i, group := range groups {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, o.styles.Subtitle.Render(group+":"))
+		for _, b := range byGroup[group] {
+			keys := b.Keys + strings.Repeat(" ", keysWidth-lipgloss.Width(b.Keys))
+			lines = append(lines, "  "+o.styles.Title.Render(keys)+"  "+o.styles.Normal.Render(b.Description))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// SetDimensions sets the overlay dimensions.
+func (o *Overlay) SetDimensions(width, height int) {
+	o.width = width
+	o.height = height
+	o.refresh()
+}
+
+// Title returns the name of the view whose bindings are listed.
+func (o *Overlay) Title() string {
+	return o.title
+}
+
+// Bindings returns the listed bindings.
+func (o *Overlay) Bindings() []keymap.KeyBinding {
+	return o.bindings
+}
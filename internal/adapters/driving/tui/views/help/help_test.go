@@ -0,0 +1,81 @@
+package help
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
+)
+
+func TestOverlay_ListsBindingsByGroup(t *testing.T) {
+	o := NewOverlay(nil)
+	o.SetDimensions(80, 24)
+
+	o.SetBindings("Sources", []keymap.KeyBinding{
+		{Group: "Navigation", Keys: "↑/k", Description: "move up"},
+		{Group: "Actions", Keys: "a", Description: "add a source"},
+		{Group: "Navigation", Keys: "esc", Description: "back to menu"},
+	})
+	view := o.View()
+
+	assert.Contains(t, view, "Help: Sources")
+	assert.Contains(t, view, "move up")
+	assert.Contains(t, view, "add a source")
+	// Groups are listed in order of first appearance, with their bindings together
+	assert.Less(t, strings.Index(view, "Navigation:"), strings.Index(view, "back to menu"))
+	assert.Less(t, strings.Index(view, "back to menu"), strings.Index(view, "Actions:"))
+}
+
+func TestOverlay_Empty(t *testing.T) {
+	o := NewOverlay(nil)
+	o.SetDimensions(80, 24)
+
+	assert.Contains(t, o.View(), "No key bindings")
+}
+
+func TestOverlay_Scrolls(t *testing.T) {
+	bindings := make([]keymap.KeyBinding, 50)
+	for i := range bindings {
+		bindings[i] = keymap.KeyBinding{Group: "Keys", Keys: fmt.Sprint(i), Description: fmt.Sprintf("binding %d", i)}
+	}
+	o := NewOverlay(nil)
+	o.SetDimensions(80, 10)
+	o.SetBindings("Long", bindings)
+	assert.NotContains(t, o.View(), "binding 49")
+
+	o.Update(tea.KeyMsg{Type: tea.KeyEnd})
+	assert.Contains(t, o.View(), "binding 49")
+
+	o.Update(tea.KeyMsg{Type: tea.KeyHome})
+	assert.NotContains(t, o.View(), "binding 49")
+
+	// New bindings start at the top
+	o.Update(tea.KeyMsg{Type: tea.KeyEnd})
+	o.SetBindings("Long", bindings)
+	assert.NotContains(t, o.View(), "binding 49")
+}
+
+func TestOverlay_Closes(t *testing.T) {
+	o := NewOverlay(nil)
+
+	assert.True(t, o.Closes(tea.KeyMsg{Type: tea.KeyEsc}))
+	assert.True(t, o.Closes(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}}))
+	assert.True(t, o.Closes(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}}))
+	assert.False(t, o.Closes(tea.KeyMsg{Type: tea.KeyDown}))
+}
+
+func TestOverlay_ClosesWithRemappedHelpKey(t *testing.T) {
+	km, err := keymap.Load(map[string][]string{"help": {"f1"}})
+	require.NoError(t, err)
+	o := NewOverlay(nil)
+	o.SetKeyMap(km)
+
+	assert.True(t, o.Closes(tea.KeyMsg{Type: tea.KeyF1}))
+	assert.False(t, o.Closes(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}}))
+	assert.True(t, o.Closes(tea.KeyMsg{Type: tea.KeyEsc}))
+}
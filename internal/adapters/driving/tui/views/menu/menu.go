@@ -5,8 +5,8 @@ import (
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/lipgloss"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 )
@@ -21,6 +21,7 @@ type Item struct {
 // View represents the main menu view.
 type View struct {
 	styles   *styles.Styles
+	keys     *keymap.KeyMap
 	items    []Item
 	selected int
 	width    int
@@ -36,9 +37,11 @@ func NewView(s *styles.Styles) *View {
 
 	return &View{
 		styles: s,
+		keys:   keymap.DefaultKeyMap(),
 		items: []Item{
 			{Label: "Search", View: messages.ViewSearch},
 			{Label: "Sources", View: messages.ViewSources},
+			{Label: "Source Status", View: messages.ViewSourceStatus},
 			{Label: "Settings", View: messages.ViewSettings},
 			{Label: "Help", View: messages.ViewHelp},
 			{Label: "Quit", Quit: true},
@@ -49,6 +52,11 @@ func NewView(s *styles.Styles) *View {
 	}
 }
 
+// SetKeyMap sets the keys the menu responds to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	v.keys = km
+}
+
 // Init initialises the menu view.
 func (v *View) Init() tea.Cmd {
 	return nil
@@ -64,20 +72,20 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 		return v, nil
 
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "up", "k":
+		switch key := msg.String(); {
+		case keymap.Matches(key, v.keys.Up):
 			if v.selected > 0 {
 				v.selected--
 			}
 			return v, nil
 
-		case "down", "j":
+		case keymap.Matches(key, v.keys.Down):
 			if v.selected < len(v.items)-1 {
 				v.selected++
 			}
 			return v, nil
 
-		case "enter":
+		case keymap.Matches(key, v.keys.Select):
 			item := v.items[v.selected]
 			if item.Quit {
 				return v, tea.Quit
@@ -86,7 +94,7 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 				return messages.ViewChanged{View: item.View}
 			}
 
-		case "q":
+		case key == "q":
 			return v, tea.Quit
 		}
 	}
@@ -108,22 +116,18 @@ func (v *View) View() string {
 	b.WriteString("\n\n")
 
 	// Subtitle
-	subtitle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("241")).
-		Render("Local Document Search")
+	subtitle := v.styles.Muted.Render("Local Document Search")
 	b.WriteString(subtitle)
 	b.WriteString("\n\n")
 
 	// Menu items
 	for i, item := range v.items {
 		cursor := "  "
-		style := lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
+		style := v.styles.Normal
 
 		if i == v.selected {
 			cursor = "> "
-			style = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("86")).
-				Bold(true)
+			style = v.styles.Subtitle
 		}
 
 		line := cursor + style.Render(item.Label)
@@ -133,14 +137,22 @@ func (v *View) View() string {
 
 	// Footer with keybindings
 	b.WriteString("\n")
-	footer := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("241")).
-		Render("[j/k] Navigate  [Enter] Select  [q] Quit")
+	footer := v.styles.Help.Render("[" + v.keys.NavigationKeys() + "] Navigate  [Enter] Select  [q] Quit")
 	b.WriteString(footer)
 
 	return b.String()
 }
 
+// KeyBindings returns the keys the menu responds to.
+func (v *View) KeyBindings() []keymap.KeyBinding {
+	return []keymap.KeyBinding{
+		{Group: "Navigation", Keys: v.keys.Up.Help().Key, Description: "move up"},
+		{Group: "Navigation", Keys: v.keys.Down.Help().Key, Description: "move down"},
+		{Group: "Actions", Keys: v.keys.Select.Help().Key, Description: "open the selected item"},
+		{Group: "Actions", Keys: "q", Description: "quit"},
+	}
+}
+
 // SetDimensions sets the view dimensions.
 func (v *View) SetDimensions(width, height int) {
 	v.width = width
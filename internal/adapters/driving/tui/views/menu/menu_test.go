@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -18,7 +20,7 @@ func TestNewView(t *testing.T) {
 
 	require.NotNil(t, view)
 	assert.NotNil(t, view.styles)
-	assert.Len(t, view.items, 5)
+	assert.Len(t, view.items, 6)
 	assert.Equal(t, 0, view.selected)
 	assert.Equal(t, 80, view.width)
 	assert.Equal(t, 24, view.height)
@@ -67,15 +69,17 @@ func TestView_Update_KeyMsg_NavigateDown(t *testing.T) {
 	view.Update(msg)
 	assert.Equal(t, 2, view.selected)
 
-	// Navigate to last item (5 items: Search, Sources, Settings, Help, Quit)
+	// Navigate to last item (6 items: Search, Sources, Source Status, Settings, Help, Quit)
 	view.Update(msg)
 	assert.Equal(t, 3, view.selected)
 	view.Update(msg)
 	assert.Equal(t, 4, view.selected)
+	view.Update(msg)
+	assert.Equal(t, 5, view.selected)
 
 	// Test boundary - can't go past last item
 	view.Update(msg)
-	assert.Equal(t, 4, view.selected)
+	assert.Equal(t, 5, view.selected)
 }
 
 func TestView_Update_KeyMsg_NavigateUp(t *testing.T) {
@@ -128,9 +132,23 @@ func TestView_Update_KeyMsg_Enter_Sources(t *testing.T) {
 	assert.Equal(t, messages.ViewSources, changed.View)
 }
 
+func TestView_Update_KeyMsg_Enter_SourceStatus(t *testing.T) {
+	view := NewView(nil)
+	view.selected = 2 // Source Status
+
+	msg := tea.KeyMsg{Type: tea.KeyEnter}
+	_, cmd := view.Update(msg)
+
+	require.NotNil(t, cmd)
+	result := cmd()
+	changed, ok := result.(messages.ViewChanged)
+	require.True(t, ok)
+	assert.Equal(t, messages.ViewSourceStatus, changed.View)
+}
+
 func TestView_Update_KeyMsg_Enter_Help(t *testing.T) {
 	view := NewView(nil)
-	view.selected = 3 // Help
+	view.selected = 4 // Help
 
 	msg := tea.KeyMsg{Type: tea.KeyEnter}
 	_, cmd := view.Update(msg)
@@ -144,7 +162,7 @@ func TestView_Update_KeyMsg_Enter_Help(t *testing.T) {
 
 func TestView_Update_KeyMsg_Enter_Quit(t *testing.T) {
 	view := NewView(nil)
-	view.selected = 4 // Quit
+	view.selected = 5 // Quit
 
 	msg := tea.KeyMsg{Type: tea.KeyEnter}
 	_, cmd := view.Update(msg)
@@ -199,6 +217,25 @@ func TestView_View_MenuItems(t *testing.T) {
 	assert.Contains(t, output, "Sources")
 }
 
+func TestView_View_UsesThemeColours(t *testing.T) {
+	defer lipgloss.SetColorProfile(lipgloss.ColorProfile())
+	lipgloss.SetColorProfile(termenv.TrueColor)
+
+	render := func(theme *styles.Theme) string {
+		view := NewView(styles.NewStyles(theme))
+		view.ready = true
+		return view.View()
+	}
+
+	dark := render(styles.DefaultDarkTheme())
+	light := render(styles.DefaultLightTheme())
+
+	assert.NotEqual(t, dark, light)
+	subtitle := styles.NewStyles(styles.DefaultLightTheme()).Muted.Render("Local Document Search")
+	assert.Contains(t, light, subtitle)
+	assert.NotContains(t, dark, subtitle)
+}
+
 func TestView_SetDimensions(t *testing.T) {
 	view := NewView(nil)
 	view.ready = false
@@ -230,17 +267,22 @@ func TestMenuItem_Properties(t *testing.T) {
 	assert.Equal(t, messages.ViewSources, view.items[1].View)
 	assert.False(t, view.items[1].Quit)
 
-	// Settings item
-	assert.Equal(t, "Settings", view.items[2].Label)
-	assert.Equal(t, messages.ViewSettings, view.items[2].View)
+	// Source Status item
+	assert.Equal(t, "Source Status", view.items[2].Label)
+	assert.Equal(t, messages.ViewSourceStatus, view.items[2].View)
 	assert.False(t, view.items[2].Quit)
 
-	// Help item
-	assert.Equal(t, "Help", view.items[3].Label)
-	assert.Equal(t, messages.ViewHelp, view.items[3].View)
+	// Settings item
+	assert.Equal(t, "Settings", view.items[3].Label)
+	assert.Equal(t, messages.ViewSettings, view.items[3].View)
 	assert.False(t, view.items[3].Quit)
 
+	// Help item
+	assert.Equal(t, "Help", view.items[4].Label)
+	assert.Equal(t, messages.ViewHelp, view.items[4].View)
+	assert.False(t, view.items[4].Quit)
+
 	// Quit item
-	assert.Equal(t, "Quit", view.items[4].Label)
-	assert.True(t, view.items[4].Quit)
+	assert.Equal(t, "Quit", view.items[5].Label)
+	assert.True(t, view.items[5].Quit)
 }
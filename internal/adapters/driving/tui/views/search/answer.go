@@ -0,0 +1,109 @@
+package search
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// AnswerPane shows the answer to a question and suggested follow-up
+// questions. The results it cites are shown in the results list below it.
+type AnswerPane struct {
+	styles *styles.Styles
+
+	viewport viewport.Model
+	result   domain.AskResult
+	width    int
+	height   int
+}
+
+// NewAnswerPane creates an empty answer pane.
+func NewAnswerPane(s *styles.Styles) *AnswerPane {
+	if s == nil {
+		s = styles.DefaultStyles()
+	}
+	return &AnswerPane{
+		styles:   s,
+		viewport: viewport.New(0, 0),
+	}
+}
+
+// SetResult shows result in the pane, scrolled to the top.
+func (p *AnswerPane) SetResult(result domain.AskResult) {
+	p.result = result
+	p.refresh()
+	p.viewport.GotoTop()
+}
+
+// AppendAnswer adds a piece of an answer being written to the pane,
+// scrolled to the end.
+func (p *AnswerPane) AppendAnswer(chunk string) {
+	p.result.Answer += chunk
+	p.refresh()
+	p.viewport.GotoBottom()
+}
+
+// Update handles scrolling keys.
+func (p *AnswerPane) Update(msg tea.Msg) (*AnswerPane, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "pgdown", "ctrl+d":
+			p.viewport.HalfPageDown()
+		case "pgup", "ctrl+u":
+			p.viewport.HalfPageUp()
+		case "home":
+			p.viewport.GotoTop()
+		case "end":
+			p.viewport.GotoBottom()
+		}
+	}
+	return p, nil
+}
+
+// View renders the answer in a bordered box.
+func (p *AnswerPane) View() string {
+	box := p.styles.Border.
+		Width(max(p.width-2, 0)).
+		Height(max(p.height-2, 0))
+	header := p.styles.Subtitle.Render("Answer")
+	return box.Render(lipgloss.JoinVertical(lipgloss.Left, header, p.viewport.View()))
+}
+
+// refresh re-renders the answer into the viewport.
+func (p *AnswerPane) refresh() {
+	width := max(p.width-2, 1)
+	p.viewport.Width = width
+	p.viewport.Height = max(p.height-3, 1)
+
+	if p.result.Answer == "" {
+		p.viewport.SetContent(p.styles.Muted.Render("No relevant documents found"))
+		return
+	}
+
+	sections := []string{lipgloss.NewStyle().Width(width).Render(p.result.Answer)}
+	if len(p.result.FollowUpSuggestions) > 0 {
+		lines := []string{"", p.styles.Muted.Render("Follow-up questions:")}
+		for _, question := range p.result.FollowUpSuggestions {
+			lines = append(lines, p.styles.Muted.Render(truncate("- "+question, width)))
+		}
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+	p.viewport.SetContent(strings.Join(sections, "\n"))
+}
+
+// SetDimensions sets the pane's outer size, including its border.
+func (p *AnswerPane) SetDimensions(width, height int) {
+	p.width = width
+	p.height = height
+	p.refresh()
+}
+
+// Result returns the answer being shown.
+func (p *AnswerPane) Result() domain.AskResult {
+	return p.result
+}
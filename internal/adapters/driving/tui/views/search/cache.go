@@ -0,0 +1,85 @@
+package search
+
+import (
+	"container/list"
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// resultCacheSize is how many queries' results the search view keeps.
+const resultCacheSize = 32
+
+// resultCache is a small LRU cache of search results keyed by normalised
+// query, so returning to a recent query, e.g. by backspacing, shows its
+// results without searching again. It is only used from the view's Update
+// loop, so it needs no locking.
+type resultCache struct {
+	capacity int
+	order    *list.List // of *cacheEntry, most recently used first
+	entries  map[string]*list.Element
+}
+
+// cacheEntry is a query's cached results.
+type cacheEntry struct {
+	key     string
+	results []domain.SearchResult
+}
+
+// newResultCache creates a cache holding up to capacity queries.
+func newResultCache(capacity int) *resultCache {
+	return &resultCache{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// Get returns the cached results for query, marking them recently used.
+func (c *resultCache) Get(query string) ([]domain.SearchResult, bool) {
+	elem, ok := c.entries[normaliseQuery(query)]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	entry, _ := elem.Value.(*cacheEntry)
+	return entry.results, true
+}
+
+// Put caches results for query, evicting the least recently used query
+// when the cache is full.
+func (c *resultCache) Put(query string, results []domain.SearchResult) {
+	key := normaliseQuery(query)
+	if key == "" {
+		return
+	}
+	if elem, ok := c.entries[key]; ok {
+		entry, _ := elem.Value.(*cacheEntry)
+		entry.results = results
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, results: results})
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		entry, _ := c.order.Remove(oldest).(*cacheEntry)
+		delete(c.entries, entry.key)
+	}
+}
+
+// Clear empties the cache, e.g. when the index changes.
+func (c *resultCache) Clear() {
+	c.order.Init()
+	clear(c.entries)
+}
+
+// Len returns the number of cached queries.
+func (c *resultCache) Len() int {
+	return c.order.Len()
+}
+
+// normaliseQuery returns the cache key of query: lower case, with runs of
+// whitespace collapsed, so queries differing only in those share results.
+func normaliseQuery(query string) string {
+	return strings.Join(strings.Fields(strings.ToLower(query)), " ")
+}
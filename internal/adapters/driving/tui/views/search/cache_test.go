@@ -0,0 +1,66 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func cachedResults(id string) []domain.SearchResult {
+	return []domain.SearchResult{{Document: domain.Document{ID: id}}}
+}
+
+func TestResultCache_GetPut(t *testing.T) {
+	cache := newResultCache(2)
+
+	_, ok := cache.Get("deploy")
+	assert.False(t, ok)
+
+	cache.Put("Deploy  Steps", cachedResults("a"))
+	results, ok := cache.Get(" deploy steps ")
+	assert.True(t, ok)
+	assert.Equal(t, cachedResults("a"), results)
+
+	cache.Put("deploy steps", cachedResults("b"))
+	results, _ = cache.Get("deploy steps")
+	assert.Equal(t, cachedResults("b"), results)
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestResultCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newResultCache(2)
+	cache.Put("one", cachedResults("1"))
+	cache.Put("two", cachedResults("2"))
+
+	// Using "one" makes "two" the least recently used
+	cache.Get("one")
+	cache.Put("three", cachedResults("3"))
+
+	_, ok := cache.Get("two")
+	assert.False(t, ok)
+	_, ok = cache.Get("one")
+	assert.True(t, ok)
+	_, ok = cache.Get("three")
+	assert.True(t, ok)
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestResultCache_IgnoresEmptyQueries(t *testing.T) {
+	cache := newResultCache(2)
+	cache.Put("   ", cachedResults("1"))
+
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestResultCache_Clear(t *testing.T) {
+	cache := newResultCache(2)
+	cache.Put("one", cachedResults("1"))
+
+	cache.Clear()
+
+	_, ok := cache.Get("one")
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.Len())
+}
@@ -6,4 +6,7 @@ import "errors"
 var (
 	// ErrNoSearchService indicates that no search service was provided.
 	ErrNoSearchService = errors.New("search service is required")
+
+	// ErrNoDocumentService indicates that no document service was provided.
+	ErrNoDocumentService = errors.New("document service not available")
 )
@@ -0,0 +1,93 @@
+package search
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// sgrPattern matches the ANSI escape sequences renderers use for styling.
+var sgrPattern = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// minTermLength is the shortest query term highlighted; shorter terms
+// match too much of a document to be useful.
+const minTermLength = 2
+
+// queryTerms returns the words of query to highlight: quotes and operators
+// are stripped and excluded terms ("-term") are dropped.
+func queryTerms(query string) []string {
+	seen := make(map[string]bool)
+	var terms []string
+	for _, field := range strings.Fields(query) {
+		if strings.HasPrefix(field, "-") {
+			continue
+		}
+		term := strings.ToLower(strings.Trim(field, `"'+()`))
+		if len([]rune(term)) < minTermLength || seen[term] {
+			continue
+		}
+		seen[term] = true
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// termPattern returns a case-insensitive pattern matching any of terms,
+// or nil if there are none. Longer terms are preferred where terms overlap.
+func termPattern(terms []string) *regexp.Regexp {
+	if len(terms) == 0 {
+		return nil
+	}
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = regexp.QuoteMeta(term)
+	}
+	sort.SliceStable(quoted, func(i, j int) bool { return len(quoted[i]) > len(quoted[j]) })
+	return regexp.MustCompile(`(?i)` + strings.Join(quoted, "|"))
+}
+
+// highlightMatches applies mark to the matches of pattern in rendered text.
+// Matches are only looked for between escape sequences, and the styling in
+// effect before a match is restored after it.
+func highlightMatches(text string, pattern *regexp.Regexp, mark func(string) string) string {
+	if pattern == nil {
+		return text
+	}
+
+	var b strings.Builder
+	active := ""
+	highlightRun := func(run string) {
+		b.WriteString(pattern.ReplaceAllStringFunc(run, func(match string) string {
+			return mark(match) + active
+		}))
+	}
+
+	prev := 0
+	for _, loc := range sgrPattern.FindAllStringIndex(text, -1) {
+		highlightRun(text[prev:loc[0]])
+		seq := text[loc[0]:loc[1]]
+		b.WriteString(seq)
+		if seq == "\x1b[0m" || seq == "\x1b[m" {
+			active = ""
+		} else {
+			active += seq
+		}
+		prev = loc[1]
+	}
+	highlightRun(text[prev:])
+	return b.String()
+}
+
+// firstMatchLine returns the first line of rendered text with a match of
+// pattern, or -1 if there is none.
+func firstMatchLine(text string, pattern *regexp.Regexp) int {
+	if pattern == nil {
+		return -1
+	}
+	for i, line := range strings.Split(text, "\n") {
+		if pattern.MatchString(sgrPattern.ReplaceAllString(line, "")) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,405 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+	glamourstyles "github.com/charmbracelet/glamour/styles"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// previewDateLayout formats document dates in the preview header.
+const previewDateLayout = "2006-01-02 15:04"
+
+// Large documents are rendered a window at a time so the pane stays
+// responsive. A window ends after previewWindowLines lines or
+// previewWindowBytes bytes, whichever comes first.
+const (
+	previewWindowLines = 500
+	previewWindowBytes = 64 * 1024
+)
+
+// Renderer renders document content to fit width columns.
+type Renderer func(content string, width int) string
+
+// PlainRenderer word-wraps content to width.
+func PlainRenderer(content string, width int) string {
+	return lipgloss.NewStyle().Width(width).Render(content)
+}
+
+// GlamourRenderer renders content as markdown with glamour, in the dark or
+// light style to suit theme. Content glamour cannot render is word-wrapped
+// by PlainRenderer instead.
+func GlamourRenderer(theme *styles.Theme) Renderer {
+	style := glamourstyles.DarkStyle
+	if theme != nil && !theme.IsDark() {
+		style = glamourstyles.LightStyle
+	}
+
+	// The term renderer wraps at a fixed width, so it is rebuilt when the
+	// pane is resized
+	var term *glamour.TermRenderer
+	termWidth := 0
+	return func(content string, width int) string {
+		if term == nil || termWidth != width {
+			r, err := glamour.NewTermRenderer(glamour.WithStandardStyle(style), glamour.WithWordWrap(width))
+			if err != nil {
+				return PlainRenderer(content, width)
+			}
+			term, termWidth = r, width
+		}
+
+		rendered, err := term.Render(content)
+		if err != nil {
+			return PlainRenderer(content, width)
+		}
+		return strings.Trim(rendered, "\n")
+	}
+}
+
+// PreviewPane shows the full content of the selected search result's document.
+type PreviewPane struct {
+	styles          *styles.Styles
+	documentService driving.DocumentService
+	renderer        Renderer
+	ctx             context.Context
+
+	viewport viewport.Model
+	result   *domain.SearchResult
+	content  string
+	loading  bool
+	err      error
+	width    int
+	height   int
+
+	// query matches the search query's terms, which mark highlights.
+	// Nil if there are none.
+	query *regexp.Regexp
+	mark  func(string) string
+
+	// lines is the content split into lines, and windows the index in lines
+	// of the first line of each window. window is the one shown.
+	lines   []string
+	windows []int
+	window  int
+
+	// firstMatch is the rendered line of the window's first query match,
+	// or -1 if it has none.
+	firstMatch int
+}
+
+// NewPreviewPane creates a preview pane that loads content from documentService.
+func NewPreviewPane(s *styles.Styles, documentService driving.DocumentService) *PreviewPane {
+	if s == nil {
+		s = styles.DefaultStyles()
+	}
+	return &PreviewPane{
+		styles:          s,
+		documentService: documentService,
+		renderer:        GlamourRenderer(s.Theme()),
+		ctx:             context.Background(),
+		viewport:        viewport.New(0, 0),
+		mark:            func(match string) string { return s.Normal.Bold(true).Render(match) },
+		firstMatch:      -1,
+	}
+}
+
+// SetRenderer sets how document content is rendered.
+func (p *PreviewPane) SetRenderer(renderer Renderer) {
+	if renderer == nil {
+		renderer = PlainRenderer
+	}
+	p.renderer = renderer
+	p.refresh()
+}
+
+// SetQuery sets the search query whose terms are highlighted in content.
+func (p *PreviewPane) SetQuery(query string) {
+	p.query = termPattern(queryTerms(query))
+	p.refresh()
+}
+
+// SetResult shows result in the pane, returning a command that loads its
+// document content. Returns nil if the document is already shown.
+func (p *PreviewPane) SetResult(result *domain.SearchResult) tea.Cmd {
+	if result == nil {
+		p.result = nil
+		p.setContent("")
+		p.loading = false
+		p.err = nil
+		p.refresh()
+		return nil
+	}
+	if p.result != nil && p.result.Document.ID == result.Document.ID {
+		return nil
+	}
+
+	p.result = result
+	p.setContent("")
+	p.err = nil
+	p.loading = true
+	p.refresh()
+
+	documentID := result.Document.ID
+	documentService := p.documentService
+	ctx := p.ctx
+	return func() tea.Msg {
+		if documentService == nil {
+			return messages.PreviewContentLoaded{DocumentID: documentID, Err: ErrNoDocumentService}
+		}
+		content, err := documentService.GetContent(ctx, documentID)
+		return messages.PreviewContentLoaded{DocumentID: documentID, Content: content, Err: err}
+	}
+}
+
+// Update handles loaded content and scrolling keys.
+func (p *PreviewPane) Update(msg tea.Msg) (*PreviewPane, tea.Cmd) {
+	switch msg := msg.(type) {
+	case messages.PreviewContentLoaded:
+		// Ignore content for a result that is no longer shown
+		if p.result == nil || msg.DocumentID != p.result.Document.ID {
+			return p, nil
+		}
+		p.loading = false
+		p.setContent(msg.Content)
+		p.err = msg.Err
+		p.window = p.matchWindow()
+		p.refresh()
+		p.viewport.GotoTop()
+		// Open at the first match so the relevant part is in view
+		if p.firstMatch > 0 {
+			p.viewport.SetYOffset(p.firstMatch)
+		}
+
+	case tea.KeyMsg:
+		// Scrolling past either end of a window moves to the next one
+		switch msg.String() {
+		case "pgdown", "ctrl+d":
+			if p.viewport.AtBottom() && p.window < len(p.windows)-1 {
+				p.showWindow(p.window + 1)
+				p.viewport.GotoTop()
+			} else {
+				p.viewport.HalfPageDown()
+			}
+		case "pgup", "ctrl+u":
+			if p.viewport.AtTop() && p.window > 0 {
+				p.showWindow(p.window - 1)
+				p.viewport.GotoBottom()
+			} else {
+				p.viewport.HalfPageUp()
+			}
+		case "home":
+			p.showWindow(0)
+			p.viewport.GotoTop()
+		case "end":
+			p.showWindow(len(p.windows) - 1)
+			p.viewport.GotoBottom()
+		}
+	}
+	return p, nil
+}
+
+// View renders the metadata header above the scrollable content.
+func (p *PreviewPane) View() string {
+	body := p.viewport.View()
+	if p.result == nil {
+		body = p.styles.Muted.Render("No result selected")
+	}
+
+	box := p.styles.Border.
+		Width(max(p.width-2, 0)).
+		Height(max(p.height-2, 0))
+	return box.Render(lipgloss.JoinVertical(lipgloss.Left, p.renderHeader(), body))
+}
+
+// renderHeader renders the document title and metadata bar.
+func (p *PreviewPane) renderHeader() string {
+	if p.result == nil {
+		return p.styles.Subtitle.Render("Preview")
+	}
+
+	doc := &p.result.Document
+	title := doc.Title
+	if title == "" {
+		title = doc.URI
+	}
+
+	var meta []string
+	source := p.result.SourceName
+	if source == "" {
+		source = doc.SourceID
+	}
+	if source != "" {
+		meta = append(meta, "Source: "+source)
+	}
+	if doc.URI != "" {
+		meta = append(meta, "URI: "+doc.URI)
+	}
+	if !doc.CreatedAt.IsZero() {
+		meta = append(meta, "Created: "+doc.CreatedAt.Format(previewDateLayout))
+	}
+	if !doc.UpdatedAt.IsZero() {
+		meta = append(meta, "Updated: "+doc.UpdatedAt.Format(previewDateLayout))
+	}
+	if len(p.windows) > 1 {
+		first, end := p.windowBounds(p.window)
+		meta = append(meta, fmt.Sprintf("Lines %d-%d of %d", first+1, end, len(p.lines)))
+	}
+
+	width := p.contentWidth()
+	lines := []string{p.styles.Subtitle.Render(truncate(title, width))}
+	for _, m := range meta {
+		lines = append(lines, p.styles.Muted.Render(truncate(m, width)))
+	}
+	lines = append(lines, p.styles.Muted.Render(strings.Repeat("─", width)))
+	return strings.Join(lines, "\n")
+}
+
+// refresh re-renders the content into the viewport.
+func (p *PreviewPane) refresh() {
+	p.viewport.Width = p.contentWidth()
+	p.viewport.Height = max(p.height-2-lipgloss.Height(p.renderHeader()), 1)
+
+	p.firstMatch = -1
+	switch {
+	case p.loading:
+		p.viewport.SetContent(p.styles.Muted.Render("Loading..."))
+	case p.err != nil:
+		p.viewport.SetContent(p.styles.Error.Render("Error: " + p.err.Error()))
+	case p.content == "":
+		p.viewport.SetContent(p.styles.Muted.Render("No content"))
+	default:
+		first, end := p.windowBounds(p.window)
+		rendered := p.renderer(strings.Join(p.lines[first:end], "\n"), p.contentWidth())
+		p.firstMatch = firstMatchLine(rendered, p.query)
+		p.viewport.SetContent(highlightMatches(rendered, p.query, p.mark))
+	}
+}
+
+// setContent sets the loaded content and divides it into windows.
+func (p *PreviewPane) setContent(content string) {
+	p.content = content
+	p.lines = nil
+	p.windows = nil
+	p.window = 0
+	if content == "" {
+		return
+	}
+
+	p.lines = strings.Split(content, "\n")
+	size := 0
+	for i, line := range p.lines {
+		count := i
+		if len(p.windows) > 0 {
+			count -= p.windows[len(p.windows)-1]
+		}
+		if len(p.windows) == 0 || count >= previewWindowLines || size+len(line) > previewWindowBytes {
+			p.windows = append(p.windows, i)
+			size = 0
+		}
+		size += len(line) + 1
+	}
+}
+
+// windowBounds returns the range of lines in window i.
+func (p *PreviewPane) windowBounds(i int) (first, end int) {
+	if i < 0 || i >= len(p.windows) {
+		return 0, 0
+	}
+	end = len(p.lines)
+	if i+1 < len(p.windows) {
+		end = p.windows[i+1]
+	}
+	return p.windows[i], end
+}
+
+// showWindow renders window i if it is not already shown.
+func (p *PreviewPane) showWindow(i int) {
+	if i < 0 || i >= len(p.windows) || i == p.window {
+		return
+	}
+	p.window = i
+	p.refresh()
+}
+
+// matchWindow returns the first window with a query match, or the first
+// window if none has one.
+func (p *PreviewPane) matchWindow() int {
+	if p.query == nil {
+		return 0
+	}
+	for i := range p.windows {
+		first, end := p.windowBounds(i)
+		for _, line := range p.lines[first:end] {
+			if p.query.MatchString(line) {
+				return i
+			}
+		}
+	}
+	return 0
+}
+
+// contentWidth returns the width inside the border.
+func (p *PreviewPane) contentWidth() int {
+	return max(p.width-2, 1)
+}
+
+// SetDimensions sets the pane's outer size, including its border.
+func (p *PreviewPane) SetDimensions(width, height int) {
+	p.width = width
+	p.height = height
+	p.refresh()
+}
+
+// WithContext sets the context used to load content.
+func (p *PreviewPane) WithContext(ctx context.Context) *PreviewPane {
+	p.ctx = ctx
+	return p
+}
+
+// Result returns the result being previewed, or nil.
+func (p *PreviewPane) Result() *domain.SearchResult {
+	return p.result
+}
+
+// Content returns the loaded document content.
+func (p *PreviewPane) Content() string {
+	return p.content
+}
+
+// Loading returns whether content is being loaded.
+func (p *PreviewPane) Loading() bool {
+	return p.loading
+}
+
+// Err returns the error from loading content, if any.
+func (p *PreviewPane) Err() error {
+	return p.err
+}
+
+// Window returns the window of content shown and the number of windows.
+func (p *PreviewPane) Window() (window, count int) {
+	return p.window, len(p.windows)
+}
+
+// truncate shortens s to at most width runes, ending with an ellipsis.
+func truncate(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+	if width <= 1 {
+		return string(runes[:width])
+	}
+	return string(runes[:width-1]) + "…"
+}
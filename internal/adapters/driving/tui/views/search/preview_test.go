@@ -0,0 +1,351 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// MockDocumentService implements GetContent of driving.DocumentService for testing.
+type MockDocumentService struct {
+	driving.DocumentService
+	contents map[string]string
+	err      error
+	calls    []string
+}
+
+func (m *MockDocumentService) GetContent(_ context.Context, documentID string) (string, error) {
+	m.calls = append(m.calls, documentID)
+	return m.contents[documentID], m.err
+}
+
+func previewResult() *domain.SearchResult {
+	return &domain.SearchResult{
+		Document: domain.Document{
+			ID:        "doc-1",
+			SourceID:  "src-1",
+			Title:     "Design notes",
+			URI:       "/notes/design.md",
+			CreatedAt: time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2024, 3, 5, 17, 30, 0, 0, time.UTC),
+		},
+		SourceName: "Notes",
+	}
+}
+
+// plainText strips the styling renderers add, leaving the text shown.
+func plainText(s string) string {
+	return sgrPattern.ReplaceAllString(s, "")
+}
+
+// loadPreview runs the command returned by SetResult through the pane.
+func loadPreview(t *testing.T, pane *PreviewPane, cmd tea.Cmd) {
+	t.Helper()
+	require.NotNil(t, cmd)
+	msg := cmd()
+	require.IsType(t, messages.PreviewContentLoaded{}, msg)
+	pane.Update(msg)
+}
+
+func TestPreviewPane_LoadsContent(t *testing.T) {
+	docs := &MockDocumentService{contents: map[string]string{"doc-1": "Full document body"}}
+	pane := NewPreviewPane(nil, docs)
+	pane.SetDimensions(60, 20)
+
+	cmd := pane.SetResult(previewResult())
+	assert.True(t, pane.Loading())
+	assert.Contains(t, pane.View(), "Loading...")
+	loadPreview(t, pane, cmd)
+
+	assert.False(t, pane.Loading())
+	assert.Equal(t, "Full document body", pane.Content())
+	assert.Equal(t, []string{"doc-1"}, docs.calls)
+
+	view := plainText(pane.View())
+	assert.Contains(t, view, "Design notes")
+	assert.Contains(t, view, "Source: Notes")
+	assert.Contains(t, view, "URI: /notes/design.md")
+	assert.Contains(t, view, "Created: 2024-03-01 09:00")
+	assert.Contains(t, view, "Updated: 2024-03-05 17:30")
+	assert.Contains(t, view, "Full document body")
+}
+
+func TestPreviewPane_SameDocumentNotReloaded(t *testing.T) {
+	docs := &MockDocumentService{contents: map[string]string{"doc-1": "body"}}
+	pane := NewPreviewPane(nil, docs)
+
+	loadPreview(t, pane, pane.SetResult(previewResult()))
+
+	assert.Nil(t, pane.SetResult(previewResult()))
+	assert.Len(t, docs.calls, 1)
+}
+
+func TestPreviewPane_IgnoresStaleContent(t *testing.T) {
+	pane := NewPreviewPane(nil, &MockDocumentService{})
+	pane.SetResult(previewResult())
+
+	pane.Update(messages.PreviewContentLoaded{DocumentID: "doc-other", Content: "stale"})
+
+	assert.True(t, pane.Loading())
+	assert.Empty(t, pane.Content())
+}
+
+func TestPreviewPane_Errors(t *testing.T) {
+	pane := NewPreviewPane(nil, &MockDocumentService{err: errors.New("chunks missing")})
+	pane.SetDimensions(60, 20)
+	loadPreview(t, pane, pane.SetResult(previewResult()))
+	assert.Contains(t, pane.View(), "Error: chunks missing")
+
+	pane = NewPreviewPane(nil, nil)
+	loadPreview(t, pane, pane.SetResult(previewResult()))
+	assert.ErrorIs(t, pane.Err(), ErrNoDocumentService)
+}
+
+func TestPreviewPane_Renderer(t *testing.T) {
+	docs := &MockDocumentService{contents: map[string]string{"doc-1": "# Title"}}
+	pane := NewPreviewPane(nil, docs)
+	pane.SetDimensions(60, 20)
+	pane.SetRenderer(func(content string, width int) string {
+		return strings.ToUpper(content)
+	})
+
+	loadPreview(t, pane, pane.SetResult(previewResult()))
+
+	assert.Contains(t, pane.View(), "# TITLE")
+}
+
+func TestPreviewPane_RendersMarkdown(t *testing.T) {
+	content := "# Design notes\n\nSome **bold** text"
+	docs := &MockDocumentService{contents: map[string]string{"doc-1": content}}
+	pane := NewPreviewPane(nil, docs)
+	pane.SetDimensions(60, 20)
+
+	loadPreview(t, pane, pane.SetResult(previewResult()))
+
+	view := plainText(pane.View())
+	assert.Contains(t, view, "Design notes")
+	assert.NotContains(t, view, "# Design notes", "headings are rendered, not shown literally")
+	assert.Contains(t, view, "Some bold text")
+	assert.NotContains(t, view, "**bold**")
+}
+
+func TestGlamourRenderer_ThemeStyles(t *testing.T) {
+	dark := GlamourRenderer(styles.DefaultDarkTheme())("# Title", 40)
+	light := GlamourRenderer(styles.DefaultLightTheme())("# Title", 40)
+
+	assert.Contains(t, plainText(dark), "Title")
+	assert.NotContains(t, plainText(dark), "# Title")
+	assert.NotEqual(t, dark, light, "dark and light themes get different styles")
+}
+
+func TestPreviewPane_Scrolls(t *testing.T) {
+	lines := make([]string, 100)
+	for i := range lines {
+		lines[i] = "line"
+	}
+	docs := &MockDocumentService{contents: map[string]string{"doc-1": strings.Join(lines, "\n")}}
+	pane := NewPreviewPane(nil, docs)
+	pane.SetRenderer(PlainRenderer)
+	pane.SetDimensions(60, 20)
+	loadPreview(t, pane, pane.SetResult(previewResult()))
+
+	pane.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+	assert.Positive(t, pane.viewport.YOffset)
+
+	pane.Update(tea.KeyMsg{Type: tea.KeyHome})
+	assert.Zero(t, pane.viewport.YOffset)
+}
+
+func TestPreviewPane_HighlightsQueryTerms(t *testing.T) {
+	docs := &MockDocumentService{contents: map[string]string{"doc-1": "Design review notes\nno draft here"}}
+	pane := NewPreviewPane(nil, docs)
+	pane.SetRenderer(PlainRenderer)
+	pane.mark = func(match string) string { return "[" + match + "]" }
+	pane.SetDimensions(60, 20)
+	pane.SetQuery(`design -draft "NOTES"`)
+
+	loadPreview(t, pane, pane.SetResult(previewResult()))
+
+	view := pane.View()
+	assert.Contains(t, view, "[Design] review [notes]")
+	assert.Contains(t, view, "no draft here")
+}
+
+func TestPreviewPane_OpensAtFirstMatch(t *testing.T) {
+	lines := make([]string, 100)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("row %d", i)
+	}
+	lines[60] = "the needle"
+	docs := &MockDocumentService{contents: map[string]string{"doc-1": strings.Join(lines, "\n")}}
+	pane := NewPreviewPane(nil, docs)
+	pane.SetRenderer(PlainRenderer)
+	pane.SetDimensions(60, 20)
+	pane.SetQuery("needle")
+
+	loadPreview(t, pane, pane.SetResult(previewResult()))
+
+	assert.Equal(t, 60, pane.viewport.YOffset)
+	assert.Contains(t, pane.View(), "the needle")
+}
+
+func TestPreviewPane_EmptyAndMissingDocument(t *testing.T) {
+	pane := NewPreviewPane(nil, &MockDocumentService{contents: map[string]string{"doc-1": ""}})
+	pane.SetDimensions(60, 20)
+	loadPreview(t, pane, pane.SetResult(previewResult()))
+	assert.Contains(t, pane.View(), "No content")
+
+	pane = NewPreviewPane(nil, &MockDocumentService{err: domain.ErrNotFound})
+	pane.SetDimensions(60, 20)
+	loadPreview(t, pane, pane.SetResult(previewResult()))
+	assert.ErrorIs(t, pane.Err(), domain.ErrNotFound)
+	assert.Contains(t, pane.View(), "Error: ")
+	assert.Contains(t, pane.View(), "Design notes", "metadata is still shown")
+}
+
+func TestPreviewPane_WindowsLargeContent(t *testing.T) {
+	lines := make([]string, 1200)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("row %d", i+1)
+	}
+	lines[749] = "the needle"
+	docs := &MockDocumentService{contents: map[string]string{"doc-1": strings.Join(lines, "\n")}}
+	pane := NewPreviewPane(nil, docs)
+	pane.SetRenderer(PlainRenderer)
+	pane.SetDimensions(60, 20)
+	pane.SetQuery("needle")
+
+	loadPreview(t, pane, pane.SetResult(previewResult()))
+
+	window, count := pane.Window()
+	assert.Equal(t, 1, window, "opens at the window with the first match")
+	assert.Equal(t, 3, count)
+	assert.Contains(t, pane.View(), "Lines 501-1000 of 1200")
+
+	pane.Update(tea.KeyMsg{Type: tea.KeyEnd})
+	window, _ = pane.Window()
+	assert.Equal(t, 2, window)
+	assert.Contains(t, pane.View(), "row 1200")
+
+	// Scrolling up past the top of a window shows the previous one
+	pane.viewport.GotoTop()
+	pane.Update(tea.KeyMsg{Type: tea.KeyPgUp})
+	window, _ = pane.Window()
+	assert.Equal(t, 1, window)
+	assert.Contains(t, pane.View(), "row 1000")
+
+	pane.Update(tea.KeyMsg{Type: tea.KeyHome})
+	window, _ = pane.Window()
+	assert.Equal(t, 0, window)
+	assert.Contains(t, pane.View(), "Lines 1-500 of 1200")
+}
+
+func TestPreviewPane_WindowsLongLines(t *testing.T) {
+	line := strings.Repeat("x", 1000)
+	lines := make([]string, 200)
+	for i := range lines {
+		lines[i] = line
+	}
+	pane := NewPreviewPane(nil, nil)
+
+	pane.setContent(strings.Join(lines, "\n"))
+
+	_, count := pane.Window()
+	assert.Equal(t, 4, count, "windows are capped in bytes as well as lines")
+}
+
+func TestQueryTerms(t *testing.T) {
+	assert.Equal(t, []string{"design", "notes"}, queryTerms(`Design -draft "notes" a design`))
+	assert.Empty(t, queryTerms(""))
+}
+
+func TestHighlightMatches(t *testing.T) {
+	mark := func(match string) string { return "[" + match + "]" }
+	pattern := termPattern([]string{"bar", "baz"})
+
+	assert.Equal(t, "foo [BAR] [baz]", highlightMatches("foo BAR baz", pattern, mark))
+	assert.Equal(t, "\x1b[1mfoo [bar]\x1b[1m\x1b[0m [baz]",
+		highlightMatches("\x1b[1mfoo bar\x1b[0m baz", pattern, mark), "styling is restored after a match")
+	assert.Equal(t, "foo bar", highlightMatches("foo bar", nil, mark))
+}
+
+// resultsView returns a view in results mode showing testSearchResults.
+func resultsView(t *testing.T, docs driving.DocumentService, width int) *View {
+	t.Helper()
+	view := NewView(nil, nil, &MockSearchService{}, nil)
+	view.SetDocumentService(docs)
+	view.SetDimensions(width, 40)
+	view.Update(messages.SearchCompleted{Results: testSearchResults()})
+	require.False(t, view.InputFocused())
+	return view
+}
+
+func TestView_TabTogglesPreview(t *testing.T) {
+	docs := &MockDocumentService{contents: map[string]string{"1": "first body", "2": "second body"}}
+	view := resultsView(t, docs, 120)
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyTab})
+	require.True(t, view.PreviewVisible())
+	_, _ = view.Update(cmd())
+	assert.Equal(t, "first body", view.Preview().Content())
+	assert.Contains(t, plainText(view.View()), "first body")
+
+	// Moving the selection previews the next result
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyDown})
+	_, _ = view.Update(cmd())
+	assert.Equal(t, "second body", view.Preview().Content())
+
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyTab})
+	assert.Nil(t, cmd)
+	assert.False(t, view.PreviewVisible())
+	assert.NotContains(t, view.View(), "second body")
+}
+
+func TestView_PreviewHiddenDoesNotLoad(t *testing.T) {
+	docs := &MockDocumentService{}
+	view := resultsView(t, docs, 120)
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	assert.Nil(t, cmd)
+	assert.Empty(t, docs.calls)
+}
+
+func TestView_PreviewLayout(t *testing.T) {
+	docs := &MockDocumentService{}
+
+	wide := resultsView(t, docs, 120)
+	wide.Update(tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, 48, wide.list.Width(), "list shares the width with the pane beside it")
+	assert.Equal(t, 30, wide.list.Height())
+
+	narrow := resultsView(t, docs, 80)
+	narrow.Update(tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, 80, narrow.list.Width())
+	assert.Equal(t, 14, narrow.list.Height(), "list shares the height with the pane below it")
+
+	narrow.Update(tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, 30, narrow.list.Height())
+}
+
+func TestView_PreviewPreferenceSurvivesReset(t *testing.T) {
+	view := resultsView(t, &MockDocumentService{}, 120)
+	view.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+	view.Reset()
+
+	assert.True(t, view.PreviewVisible())
+	assert.Nil(t, view.Preview().Result())
+}
@@ -3,7 +3,10 @@ package search
 
 import (
 	"context"
+	"errors"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -33,10 +36,13 @@ type View struct {
 	input     *input.SearchInput
 	list      *list.ResultList
 	statusbar *status.Bar
+	preview   *PreviewPane
+	answer    *AnswerPane
 
-	searchService driving.SearchService
-	actionService driving.ResultActionService
-	ctx           context.Context
+	searchService  driving.SearchService
+	actionService  driving.ResultActionService
+	sessionService driving.SearchSessionService
+	ctx            context.Context
 
 	width      int
 	height     int
@@ -44,8 +50,56 @@ type View struct {
 	err        error
 	focusInput bool // true = input mode (typing), false = results mode (navigating)
 	actionMenu *ActionMenu
+
+	// showPreview shows the preview pane beside or below the results.
+	// Kept for the session across searches but not persisted.
+	showPreview bool
+
+	// showAnswer shows the answer pane above the results, which are the
+	// answer's citations. Set by asking a question, cleared by searching.
+	showAnswer bool
+
+	// searched is the query of the results shown, rerun when the index
+	// changes while watching sources. Empty when no search results are shown.
+	searched string
+
+	// restoreSelected is the result to select once a restored search
+	// completes, or -1 when no search is being restored.
+	restoreSelected int
+
+	// cache holds recent queries' results, shown without searching again.
+	cache *resultCache
+
+	// searchSeq numbers searches and edits of the query. Results and
+	// pending live searches from before the latest are dropped.
+	searchSeq int
+
+	// cancelSearch cancels the in-flight search, if any.
+	cancelSearch context.CancelFunc
+
+	// answerUpdates delivers the pieces of the answer being asked, then
+	// the whole answer.
+	answerUpdates <-chan tea.Msg
 }
 
+// liveSearchDelay is how long typing must pause before the query is
+// searched, so each keystroke doesn't start a search.
+const liveSearchDelay = 250 * time.Millisecond
+
+// minLiveQueryLength is the shortest query, in characters, searched while
+// typing. Shorter queries match too much to be useful.
+const minLiveQueryLength = 2
+
+// liveSearchDue fires when typing has paused for liveSearchDelay.
+type liveSearchDue struct {
+	seq   int
+	query string
+}
+
+// previewSideMinWidth is the narrowest terminal that fits the preview pane
+// beside the results; narrower terminals show it below.
+const previewSideMinWidth = 100
+
 // NewView creates a new search view.
 func NewView(
 	s *styles.Styles,
@@ -64,8 +118,10 @@ func NewView(
 		styles:        s,
 		keymap:        km,
 		input:         input.NewSearchInput(s),
-		list:          list.NewResultList(s),
+		list:          newResultList(s, km),
 		statusbar:     status.NewBar(s, km),
+		preview:       NewPreviewPane(s, nil),
+		answer:        NewAnswerPane(s),
 		searchService: searchService,
 		actionService: actionService,
 		ctx:           context.Background(),
@@ -74,15 +130,42 @@ func NewView(
 		ready:         false,
 		focusInput:    true, // Start in input mode
 		actionMenu:    nil,
+
+		restoreSelected: -1,
+		cache:           newResultCache(resultCacheSize),
 	}
 }
 
+// newResultList creates the results list, navigated with km.
+func newResultList(s *styles.Styles, km *keymap.KeyMap) *list.ResultList {
+	l := list.NewResultList(s)
+	l.SetKeyMap(km)
+	return l
+}
+
 // WithContext sets the context for the view.
 func (v *View) WithContext(ctx context.Context) *View {
 	v.ctx = ctx
+	v.preview.WithContext(ctx)
 	return v
 }
 
+// SetDocumentService sets the service the preview pane loads content from.
+func (v *View) SetDocumentService(documentService driving.DocumentService) {
+	v.preview.documentService = documentService
+}
+
+// SetSessionService sets the service that remembers the last search, so
+// that it can be resumed on the next launch.
+func (v *View) SetSessionService(sessionService driving.SearchSessionService) {
+	v.sessionService = sessionService
+}
+
+// SetPreviewRenderer sets how the preview pane renders document content.
+func (v *View) SetPreviewRenderer(renderer Renderer) {
+	v.preview.SetRenderer(renderer)
+}
+
 // Init initialises the view.
 func (v *View) Init() tea.Cmd {
 	return v.input.Init()
@@ -103,6 +186,34 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 
 	case messages.SearchCompleted:
 		v.handleSearchCompleted(msg)
+		return v, v.syncPreview()
+
+	case liveSearchDue:
+		// Search unless the query was edited or submitted since
+		if msg.seq != v.searchSeq || !v.focusInput {
+			return v, nil
+		}
+		v.statusbar.SetState(status.StateSearching)
+		return v, v.runSearch(msg.query, true)
+
+	case messages.AnswerChunk:
+		if msg.Seq != v.searchSeq {
+			return v, nil
+		}
+		if !v.showAnswer {
+			v.showAnswer = true
+			v.list.SetResults(nil)
+			v.layout()
+		}
+		v.answer.AppendAnswer(msg.Text)
+		return v, waitForAnswer(v.answerUpdates)
+
+	case messages.AskCompleted:
+		v.handleAskCompleted(msg)
+		return v, v.syncPreview()
+
+	case messages.PreviewContentLoaded:
+		v.preview, _ = v.preview.Update(msg)
 		return v, nil
 
 	case messages.ErrorOccurred:
@@ -110,6 +221,15 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 		v.statusbar.SetState(status.StateError)
 		v.statusbar.SetMessage(msg.Err.Error())
 		return v, nil
+
+	case messages.WatchUpdated:
+		// Rerun the search so its results reflect the changes, unless a new
+		// query is being typed
+		v.cache.Clear()
+		if v.searched == "" || v.focusInput {
+			return v, nil
+		}
+		return v, v.performSearch(v.searched)
 	}
 
 	// Forward to input component
@@ -143,8 +263,26 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 		}
 	}
 
+	// Alt+Enter in input mode asks the query as a question
+	if v.focusInput && keymap.Matches(msg.String(), v.keymap.Ask) {
+		question := v.input.Value()
+		if question == "" {
+			return v, nil
+		}
+		v.nextSearch()
+		v.showAnswer = false
+		v.answer.SetResult(domain.AskResult{})
+		v.layout()
+		v.statusbar.SetState(status.StateAsking)
+		v.focusInput = false
+		v.input.Blur()
+		v.searched = ""
+		v.preview.SetQuery(question)
+		return v, v.performAsk(question)
+	}
+
 	// Enter in input mode submits search
-	if msg.Type == tea.KeyEnter && v.focusInput {
+	if v.focusInput && keymap.Matches(msg.String(), v.keymap.Search) {
 		query := v.input.Value()
 		if query == "" {
 			return v, nil
@@ -152,18 +290,24 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 		v.statusbar.SetState(status.StateSearching)
 		v.focusInput = false // Move to results mode after search
 		v.input.Blur()
+		v.searched = query
+		v.preview.SetQuery(query)
 		cmd := v.performSearch(query)
 		return v, cmd
 	}
 
-	// Input mode: all keys go to input
+	// Input mode: all keys go to input, searching as the query changes
 	if v.focusInput {
+		before := v.input.Value()
 		v.input, _ = v.input.Update(msg)
+		if query := v.input.Value(); query != before {
+			return v, v.queryChanged(query)
+		}
 		return v, nil
 	}
 
 	// Results mode: handle Enter to open action menu
-	if msg.Type == tea.KeyEnter {
+	if keymap.Matches(msg.String(), v.keymap.Actions) {
 		result := v.list.SelectedResult()
 		if result != nil {
 			v.actionMenu = &ActionMenu{
@@ -177,29 +321,35 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 	}
 
 	// Results mode: handle navigation
-	//nolint:exhaustive // handling only relevant key types
-	switch msg.Type {
-	case tea.KeyUp:
+	switch key := msg.String(); {
+	case keymap.Matches(key, v.keymap.Up):
 		v.list.MoveUp()
-		return v, nil
-	case tea.KeyDown:
-		v.list.MoveDown()
-		return v, nil
-	}
-
-	switch msg.String() {
-	case "k":
-		v.list.MoveUp()
-		return v, nil
-	case "j":
+		return v, v.syncPreview()
+	case keymap.Matches(key, v.keymap.Down):
 		v.list.MoveDown()
+		return v, v.syncPreview()
+	case keymap.Matches(key, v.keymap.Preview):
+		v.showPreview = !v.showPreview
+		v.layout()
+		return v, v.syncPreview()
+	case keymap.Matches(key, v.keymap.ScrollPreview):
+		// The preview pane scrolls if shown, else the answer
+		switch {
+		case v.showPreview:
+			v.preview, _ = v.preview.Update(msg)
+		case v.showAnswer:
+			v.answer, _ = v.answer.Update(msg)
+		}
 		return v, nil
-	case "n":
+	case keymap.Matches(key, v.keymap.NewSearch):
 		// New search: clear input and focus it
 		v.focusInput = true
 		v.input.Focus()
 		v.input.SetValue("")
 		return v, nil
+	case keymap.Matches(key, v.keymap.ForgetSearch):
+		v.forgetSession()
+		return v, nil
 	}
 
 	return v, nil
@@ -207,42 +357,22 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 
 // handleActionMenuKey processes keyboard input when action menu is visible.
 func (v *View) handleActionMenuKey(msg tea.KeyMsg) (*View, tea.Cmd) {
-	//nolint:exhaustive // handling only relevant key types
-	switch msg.Type {
-	case tea.KeyUp:
+	switch key := msg.String(); {
+	case keymap.Matches(key, v.keymap.Up):
 		if v.actionMenu.selected > 0 {
 			v.actionMenu.selected--
 		}
-		return v, nil
-	case tea.KeyDown:
+	case keymap.Matches(key, v.keymap.Down):
 		if v.actionMenu.selected < len(v.actionMenu.actions)-1 {
 			v.actionMenu.selected++
 		}
-		return v, nil
-	case tea.KeyEnter:
+	case keymap.Matches(key, v.keymap.Select):
 		action := v.actionMenu.actions[v.actionMenu.selected]
 		result := v.actionMenu.result
 		v.actionMenu = nil // Close menu
 		return v.executeAction(action, result)
-	case tea.KeyEsc:
+	case msg.Type == tea.KeyEsc:
 		v.actionMenu = nil // Close menu
-		return v, nil
-	default:
-		// Handle other keys
-	}
-
-	// Handle vim-style navigation in action menu
-	switch msg.String() {
-	case "k":
-		if v.actionMenu.selected > 0 {
-			v.actionMenu.selected--
-		}
-		return v, nil
-	case "j":
-		if v.actionMenu.selected < len(v.actionMenu.actions)-1 {
-			v.actionMenu.selected++
-		}
-		return v, nil
 	}
 
 	return v, nil
@@ -286,21 +416,147 @@ func (v *View) executeAction(action string, result *domain.SearchResult) (*View,
 
 // performSearch executes a search and returns results.
 func (v *View) performSearch(query string) tea.Cmd {
+	return v.runSearch(query, false)
+}
+
+// runSearch searches for query, superseding any earlier search. Cached
+// results are returned without searching. Live searches, run while typing,
+// keep the input focused when they complete.
+func (v *View) runSearch(query string, live bool) tea.Cmd {
+	seq := v.nextSearch()
+	if results, ok := v.cache.Get(query); ok {
+		return func() tea.Msg {
+			return messages.SearchCompleted{Results: results, Query: query, Seq: seq, Live: live}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(v.ctx)
+	v.cancelSearch = cancel
+	searchService := v.searchService
 	return func() tea.Msg {
-		if v.searchService == nil {
+		defer cancel()
+		if searchService == nil {
 			return messages.ErrorOccurred{Err: ErrNoSearchService}
 		}
 
-		results, err := v.searchService.Search(v.ctx, query, domain.SearchOptions{})
+		results, err := searchService.Search(ctx, query, domain.SearchOptions{})
 		if err != nil {
-			return messages.SearchCompleted{Results: nil, Err: err}
+			return messages.SearchCompleted{Results: nil, Err: err, Query: query, Seq: seq, Live: live}
 		}
-		return messages.SearchCompleted{Results: results, Err: nil}
+		return messages.SearchCompleted{Results: results, Err: nil, Query: query, Seq: seq, Live: live}
+	}
+}
+
+// queryChanged supersedes any pending or in-flight search when the typed
+// query changes, then shows its cached results at once or schedules a live
+// search for when typing pauses.
+func (v *View) queryChanged(query string) tea.Cmd {
+	seq := v.nextSearch()
+	if utf8.RuneCountInString(strings.TrimSpace(query)) < minLiveQueryLength {
+		return nil
+	}
+	if _, ok := v.cache.Get(query); ok {
+		return v.runSearch(query, true)
+	}
+	return tea.Tick(liveSearchDelay, func(time.Time) tea.Msg {
+		return liveSearchDue{seq: seq, query: query}
+	})
+}
+
+// nextSearch cancels the in-flight search and returns the number of the
+// next one, so results and live searches from earlier ones are dropped.
+func (v *View) nextSearch() int {
+	if v.cancelSearch != nil {
+		v.cancelSearch()
+		v.cancelSearch = nil
 	}
+	v.searchSeq++
+	return v.searchSeq
+}
+
+// ClearCache drops cached search results, e.g. when the index changes.
+func (v *View) ClearCache() {
+	v.cache.Clear()
+}
+
+// performAsk asks the search service to answer a question. The answer is
+// sent back in pieces as it is written, then whole with its citations.
+func (v *View) performAsk(question string) tea.Cmd {
+	searchService := v.searchService
+	if searchService == nil {
+		return func() tea.Msg {
+			return messages.ErrorOccurred{Err: ErrNoSearchService}
+		}
+	}
+
+	seq := v.searchSeq
+	ctx, cancel := context.WithCancel(v.ctx)
+	v.cancelSearch = cancel
+	updates := make(chan tea.Msg)
+	v.answerUpdates = updates
+	go func() {
+		defer close(updates)
+		defer cancel()
+		// Stop sending once superseded, when nothing waits for updates
+		send := func(msg tea.Msg) {
+			select {
+			case updates <- msg:
+			case <-ctx.Done():
+			}
+		}
+		result, err := searchService.Ask(ctx, question, domain.SearchOptions{}, func(chunk string) {
+			send(messages.AnswerChunk{Text: chunk, Seq: seq})
+		})
+		send(messages.AskCompleted{Result: result, Err: err, Seq: seq})
+	}()
+	return waitForAnswer(updates)
+}
+
+// waitForAnswer returns a command that waits for the next update of the
+// answer being asked. It is reissued after each piece of the answer.
+func waitForAnswer(updates <-chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		msg, ok := <-updates
+		if !ok {
+			return nil
+		}
+		return msg
+	}
+}
+
+// handleAskCompleted shows an answer above the results it cites.
+func (v *View) handleAskCompleted(msg messages.AskCompleted) {
+	if msg.Seq != 0 && msg.Seq != v.searchSeq {
+		return
+	}
+	if msg.Err != nil {
+		v.err = msg.Err
+		v.statusbar.SetState(status.StateError)
+		v.statusbar.SetMessage(msg.Err.Error())
+		return
+	}
+
+	v.err = nil
+	v.showAnswer = true
+	v.answer.SetResult(msg.Result)
+	v.list.SetResults(msg.Result.Citations)
+	v.layout()
+	v.statusbar.SetState(status.StateResults)
+	v.statusbar.SetResultCount(len(msg.Result.Citations))
+
+	v.focusInput = false
+	v.input.Blur()
 }
 
 // handleSearchCompleted processes search results.
 func (v *View) handleSearchCompleted(msg messages.SearchCompleted) {
+	// Drop results of superseded searches, which may arrive out of order
+	if msg.Seq != 0 && msg.Seq != v.searchSeq {
+		return
+	}
+	if msg.Err != nil && errors.Is(msg.Err, context.Canceled) {
+		return
+	}
 	if msg.Err != nil {
 		v.err = msg.Err
 		v.statusbar.SetState(status.StateError)
@@ -309,15 +565,85 @@ func (v *View) handleSearchCompleted(msg messages.SearchCompleted) {
 	}
 
 	v.err = nil
+	if msg.Query != "" {
+		v.cache.Put(msg.Query, msg.Results)
+	}
+	if v.showAnswer {
+		v.showAnswer = false
+		v.layout()
+	}
 	v.list.SetResults(msg.Results)
 	v.statusbar.SetState(status.StateResults)
 	v.statusbar.SetResultCount(len(msg.Results))
 
+	// Live results show while typing continues
+	if msg.Live {
+		return
+	}
+
+	// Reselect the result that was selected when a restored search was saved
+	if v.restoreSelected >= 0 {
+		v.list.SetSelected(min(v.restoreSelected, len(msg.Results)-1))
+		v.restoreSelected = -1
+	}
+
 	// Switch to results mode after successful search
 	v.focusInput = false
 	v.input.Blur()
 }
 
+// RestoreSession reruns the last search saved by SaveSession, returning the
+// command that performs it, or nil if there is no last search. The result
+// that was selected is selected again once the search completes.
+func (v *View) RestoreSession() tea.Cmd {
+	if v.sessionService == nil {
+		return nil
+	}
+	session, err := v.sessionService.Load()
+	if err != nil || session == nil || session.Query == "" {
+		// A missing or unreadable session just means starting afresh
+		return nil
+	}
+
+	v.input.SetValue(session.Query)
+	v.statusbar.SetState(status.StateSearching)
+	v.focusInput = false
+	v.input.Blur()
+	v.searched = session.Query
+	v.preview.SetQuery(session.Query)
+	v.restoreSelected = session.Selected
+	return v.performSearch(session.Query)
+}
+
+// SaveSession remembers the query of the results shown and the selected
+// result, so that RestoreSession can resume them on the next launch.
+// Answers to questions are not remembered.
+func (v *View) SaveSession() error {
+	if v.sessionService == nil || v.searched == "" {
+		return nil
+	}
+	selected := v.list.Selected()
+	if v.restoreSelected >= 0 {
+		// The restored search has not completed yet
+		selected = v.restoreSelected
+	}
+	return v.sessionService.Save(domain.SearchSession{Query: v.searched, Selected: selected})
+}
+
+// forgetSession forgets the last search and starts a new one.
+func (v *View) forgetSession() {
+	if v.sessionService == nil {
+		v.statusbar.SetMessage("Search history not available")
+		return
+	}
+	if err := v.sessionService.Clear(); err != nil {
+		v.statusbar.SetMessage("Forget: " + err.Error())
+		return
+	}
+	v.Reset()
+	v.statusbar.SetMessage("Last search forgotten")
+}
+
 // View renders the search view.
 func (v *View) View() string {
 	if !v.ready {
@@ -340,8 +666,21 @@ func (v *View) View() string {
 		sections = append(sections, errView, "")
 	}
 
-	// Results list
+	// Answer to a question, above the results it cites
+	if v.showAnswer {
+		sections = append(sections, v.answer.View(), "")
+	}
+
+	// Results list, with the preview pane beside or below it
 	listView := v.list.View()
+	if v.showPreview {
+		if v.previewBeside() {
+			listView = lipgloss.NewStyle().Width(v.list.Width()).Render(listView)
+			listView = lipgloss.JoinHorizontal(lipgloss.Top, listView, v.preview.View())
+		} else {
+			listView = lipgloss.JoinVertical(lipgloss.Left, listView, "", v.preview.View())
+		}
+	}
 	sections = append(sections, listView)
 
 	// Action menu overlay (if visible)
@@ -390,6 +729,28 @@ func (v *View) renderActionMenu() string {
 	return menuStyle.Render(content)
 }
 
+// KeyBindings returns the keys the search view responds to in each mode.
+func (v *View) KeyBindings() []keymap.KeyBinding {
+	km := v.keymap
+	return []keymap.KeyBinding{
+		keymap.FromBinding("Search input", km.Search),
+		keymap.FromBinding("Search input", km.Ask),
+		keymap.FromBinding("Search input", km.Back),
+		keymap.FromBinding("Results", km.Up),
+		keymap.FromBinding("Results", km.Down),
+		keymap.FromBinding("Results", km.Actions),
+		keymap.FromBinding("Results", km.Preview),
+		keymap.FromBinding("Results", km.ScrollPreview),
+		keymap.FromBinding("Results", km.NewSearch),
+		keymap.FromBinding("Results", km.ForgetSearch),
+		keymap.FromBinding("Results", km.Back),
+		keymap.FromBinding("Action menu", km.Up),
+		keymap.FromBinding("Action menu", km.Down),
+		keymap.FromBinding("Action menu", km.Select),
+		keymap.FromBinding("Action menu", km.Cancel),
+	}
+}
+
 // SetDimensions sets the view dimensions.
 func (v *View) SetDimensions(width, height int) {
 	v.width = width
@@ -398,8 +759,45 @@ func (v *View) SetDimensions(width, height int) {
 
 	// Allocate space to components
 	v.input.SetWidth(width)
-	v.list.SetDimensions(width, height-10) // Reserve space for header, input, status
 	v.statusbar.SetWidth(width)
+	v.layout()
+}
+
+// layout divides the results area between the answer, the list and the
+// preview pane.
+func (v *View) layout() {
+	listHeight := v.height - 10 // Reserve space for header, input, status
+	if v.showAnswer {
+		answerHeight := listHeight / 2
+		v.answer.SetDimensions(v.width, answerHeight)
+		listHeight -= answerHeight + 1
+	}
+	switch {
+	case !v.showPreview:
+		v.list.SetDimensions(v.width, listHeight)
+	case v.previewBeside():
+		listWidth := v.width * 2 / 5
+		v.list.SetDimensions(listWidth, listHeight)
+		v.preview.SetDimensions(v.width-listWidth-1, listHeight)
+	default:
+		previewHeight := listHeight / 2
+		v.list.SetDimensions(v.width, listHeight-previewHeight-1)
+		v.preview.SetDimensions(v.width, previewHeight)
+	}
+}
+
+// previewBeside returns whether the preview pane fits beside the results.
+func (v *View) previewBeside() bool {
+	return v.width >= previewSideMinWidth
+}
+
+// syncPreview shows the selected result in the preview pane if it is
+// visible, returning a command that loads its content.
+func (v *View) syncPreview() tea.Cmd {
+	if !v.showPreview {
+		return nil
+	}
+	return v.preview.SetResult(v.list.SelectedResult())
 }
 
 // Width returns the current width.
@@ -456,15 +854,42 @@ func (v *View) ClearError() {
 
 // Reset resets the view to initial input mode.
 func (v *View) Reset() {
+	v.nextSearch()
 	v.focusInput = true
 	v.input.Focus()
 	v.input.SetValue("")
 	v.list.SetResults(nil)
+	v.preview.SetResult(nil)
+	v.preview.SetQuery("")
+	v.showAnswer = false
+	v.searched = ""
+	v.restoreSelected = -1
+	v.layout()
 	v.err = nil
 	v.statusbar.SetState(status.StateReady)
 	v.statusbar.SetMessage("")
 }
 
+// PreviewVisible returns whether the preview pane is shown.
+func (v *View) PreviewVisible() bool {
+	return v.showPreview
+}
+
+// Preview returns the preview pane.
+func (v *View) Preview() *PreviewPane {
+	return v.preview
+}
+
+// AnswerVisible returns whether an answer is shown above the results.
+func (v *View) AnswerVisible() bool {
+	return v.showAnswer
+}
+
+// Answer returns the answer pane.
+func (v *View) Answer() *AnswerPane {
+	return v.answer
+}
+
 // InputFocused returns whether the input has focus.
 func (v *View) InputFocused() bool {
 	return v.focusInput
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/stretchr/testify/assert"
@@ -18,6 +19,9 @@ import (
 // MockSearchService implements driving.SearchService for testing.
 type MockSearchService struct {
 	SearchFunc func(ctx context.Context, query string, opts domain.SearchOptions) ([]domain.SearchResult, error)
+	AskFunc    func(
+		ctx context.Context, question string, opts domain.SearchOptions, onAnswer func(string),
+	) (domain.AskResult, error)
 }
 
 func (m *MockSearchService) Search(
@@ -31,6 +35,18 @@ func (m *MockSearchService) Search(
 	return []domain.SearchResult{}, nil
 }
 
+func (m *MockSearchService) Ask(
+	ctx context.Context,
+	question string,
+	opts domain.SearchOptions,
+	onAnswer func(string),
+) (domain.AskResult, error) {
+	if m.AskFunc != nil {
+		return m.AskFunc(ctx, question, opts, onAnswer)
+	}
+	return domain.AskResult{}, nil
+}
+
 // MockResultActionService implements driving.ResultActionService for testing.
 type MockResultActionService struct {
 	CopyToClipboardFunc func(ctx context.Context, result *domain.SearchResult) error
@@ -994,3 +1010,345 @@ func TestView_ActionMenu_ContextPropagation(t *testing.T) {
 
 	assert.True(t, copyCalled)
 }
+
+func TestView_Update_AltEnter_AsksQuestion(t *testing.T) {
+	answer := domain.AskResult{
+		Answer:              "Sercha indexes local files [Source: file:///a].",
+		Citations:           testSearchResults()[:1],
+		FollowUpSuggestions: []string{"Which sources are supported?"},
+	}
+	mock := &MockSearchService{
+		AskFunc: func(
+			_ context.Context, question string, _ domain.SearchOptions, _ func(string),
+		) (domain.AskResult, error) {
+			assert.Equal(t, "what is sercha?", question)
+			return answer, nil
+		},
+	}
+	view := NewView(nil, nil, mock, nil)
+	view.SetDimensions(100, 40)
+	view.SetQuery("what is sercha?")
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true})
+
+	require.NotNil(t, cmd)
+	msg := cmd()
+	require.IsType(t, messages.AskCompleted{}, msg)
+	assert.False(t, view.InputFocused())
+
+	view.Update(msg)
+	assert.True(t, view.AnswerVisible())
+	assert.Equal(t, answer, view.Answer().Result())
+	assert.Len(t, view.Results(), 1)
+	rendered := view.View()
+	assert.Contains(t, rendered, "Sercha indexes local files")
+	assert.Contains(t, rendered, "Which sources are supported?")
+
+	// A regular search replaces the answer
+	view.Update(messages.SearchCompleted{Results: testSearchResults()})
+	assert.False(t, view.AnswerVisible())
+}
+
+func TestView_Update_AskStreamsAnswer(t *testing.T) {
+	answer := domain.AskResult{
+		Answer:    "Sercha indexes local files [Source: file:///a].",
+		Citations: testSearchResults()[:1],
+	}
+	mock := &MockSearchService{
+		AskFunc: func(
+			_ context.Context, _ string, _ domain.SearchOptions, onAnswer func(string),
+		) (domain.AskResult, error) {
+			onAnswer("Sercha indexes ")
+			onAnswer("local files [Source: file:///a].")
+			return answer, nil
+		},
+	}
+	view := NewView(nil, nil, mock, nil)
+	view.SetDimensions(100, 40)
+	view.Update(messages.SearchCompleted{Results: testSearchResults()})
+	view.SetQuery("what is sercha?")
+	view.focusInput = true
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true})
+
+	require.NotNil(t, cmd)
+	msg := cmd()
+	require.IsType(t, messages.AnswerChunk{}, msg)
+	_, cmd = view.Update(msg)
+	assert.True(t, view.AnswerVisible())
+	assert.Empty(t, view.Results(), "results of the previous search are cleared")
+	assert.Contains(t, view.View(), "Sercha indexes")
+	assert.NotContains(t, view.View(), "local files")
+
+	require.NotNil(t, cmd)
+	_, cmd = view.Update(cmd())
+	assert.Contains(t, view.View(), "Sercha indexes local files")
+
+	require.NotNil(t, cmd)
+	msg = cmd()
+	require.IsType(t, messages.AskCompleted{}, msg)
+	view.Update(msg)
+	assert.Equal(t, answer, view.Answer().Result())
+	assert.Len(t, view.Results(), 1)
+}
+
+func TestView_Update_AskError(t *testing.T) {
+	view := NewView(nil, nil, &MockSearchService{}, nil)
+	view.SetDimensions(80, 24)
+
+	view.Update(messages.AskCompleted{Err: errors.New("LLM service unavailable")})
+
+	assert.False(t, view.AnswerVisible())
+	require.Error(t, view.Err())
+	assert.Contains(t, view.View(), "LLM service unavailable")
+}
+
+// mockSearchSessionService implements driving.SearchSessionService in memory.
+type mockSearchSessionService struct {
+	session *domain.SearchSession
+}
+
+func (m *mockSearchSessionService) Load() (*domain.SearchSession, error) {
+	return m.session, nil
+}
+
+func (m *mockSearchSessionService) Save(session domain.SearchSession) error {
+	m.session = &session
+	return nil
+}
+
+func (m *mockSearchSessionService) Clear() error {
+	m.session = nil
+	return nil
+}
+
+func TestView_SaveThenRestoreSession(t *testing.T) {
+	searchService := &MockSearchService{
+		SearchFunc: func(_ context.Context, _ string, _ domain.SearchOptions) ([]domain.SearchResult, error) {
+			return testSearchResults(), nil
+		},
+	}
+	sessions := &mockSearchSessionService{}
+
+	view := NewView(nil, nil, searchService, nil)
+	view.SetSessionService(sessions)
+	view.SetQuery("test query")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	view.Update(cmd())
+	view.Update(tea.KeyMsg{Type: tea.KeyDown})
+	require.NoError(t, view.SaveSession())
+
+	// A new view, as on the next launch, resumes the search
+	restored := NewView(nil, nil, searchService, nil)
+	restored.SetSessionService(sessions)
+	cmd = restored.RestoreSession()
+	require.NotNil(t, cmd)
+	assert.Equal(t, "test query", restored.Query())
+	assert.False(t, restored.InputFocused())
+	restored.Update(cmd())
+
+	assert.Equal(t, "test query", restored.Query())
+	assert.Equal(t, 1, restored.SelectedIndex())
+	assert.Equal(t, "2", restored.SelectedResult().Document.ID)
+}
+
+func TestView_RestoreSession_ClampsSelection(t *testing.T) {
+	sessions := &mockSearchSessionService{session: &domain.SearchSession{Query: "test", Selected: 10}}
+	view := NewView(nil, nil, &MockSearchService{}, nil)
+	view.SetSessionService(sessions)
+
+	view.RestoreSession()
+	view.Update(messages.SearchCompleted{Results: testSearchResults()})
+
+	assert.Equal(t, 1, view.SelectedIndex(), "the last result is selected when fewer are found")
+}
+
+func TestView_RestoreSession_NothingSaved(t *testing.T) {
+	view := NewView(nil, nil, &MockSearchService{}, nil)
+	assert.Nil(t, view.RestoreSession(), "no session service")
+
+	view.SetSessionService(&mockSearchSessionService{})
+	assert.Nil(t, view.RestoreSession())
+	assert.True(t, view.InputFocused())
+}
+
+func TestView_SaveSession_SkipsAnswers(t *testing.T) {
+	sessions := &mockSearchSessionService{}
+	view := NewView(nil, nil, &MockSearchService{}, nil)
+	view.SetSessionService(sessions)
+	view.SetQuery("what changed?")
+
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true})
+	require.NoError(t, view.SaveSession())
+
+	assert.Nil(t, sessions.session)
+}
+
+func TestView_ForgetSearch(t *testing.T) {
+	sessions := &mockSearchSessionService{session: &domain.SearchSession{Query: "old", Selected: 1}}
+	view := NewView(nil, nil, &MockSearchService{}, nil)
+	view.SetSessionService(sessions)
+	view.RestoreSession()
+	view.Update(messages.SearchCompleted{Results: testSearchResults()})
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+
+	assert.Nil(t, sessions.session)
+	assert.True(t, view.InputFocused())
+	assert.Empty(t, view.Query())
+	require.NoError(t, view.SaveSession())
+	assert.Nil(t, sessions.session, "a forgotten search is not saved again")
+}
+
+// typeText types text into the view one key at a time, returning the
+// command from the last key.
+func typeText(view *View, text string) tea.Cmd {
+	var cmd tea.Cmd
+	for _, r := range text {
+		_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return cmd
+}
+
+func TestView_LiveSearch_DebouncesTyping(t *testing.T) {
+	var queries []string
+	mock := &MockSearchService{
+		SearchFunc: func(_ context.Context, query string, _ domain.SearchOptions) ([]domain.SearchResult, error) {
+			queries = append(queries, query)
+			return testSearchResults(), nil
+		},
+	}
+	view := NewView(nil, nil, mock, nil)
+
+	assert.Nil(t, typeText(view, "t"), "single characters are not searched")
+	require.NotNil(t, typeText(view, "e"))
+	stale := liveSearchDue{seq: view.searchSeq, query: "te"}
+	require.NotNil(t, typeText(view, "s"))
+
+	// The pause after "te" was cut short by another key
+	_, cmd := view.Update(stale)
+	assert.Nil(t, cmd)
+
+	_, cmd = view.Update(liveSearchDue{seq: view.searchSeq, query: "tes"})
+	require.NotNil(t, cmd)
+	view.Update(cmd())
+
+	assert.Equal(t, []string{"tes"}, queries)
+	assert.Len(t, view.Results(), 2)
+	assert.True(t, view.InputFocused(), "live results keep the input focused")
+}
+
+func TestView_LiveSearch_CancelsInFlightSearchOnInput(t *testing.T) {
+	started := make(chan struct{})
+	mock := &MockSearchService{
+		SearchFunc: func(ctx context.Context, _ string, _ domain.SearchOptions) ([]domain.SearchResult, error) {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	}
+	view := NewView(nil, nil, mock, nil)
+	typeText(view, "tes")
+	_, cmd := view.Update(liveSearchDue{seq: view.searchSeq, query: "tes"})
+	require.NotNil(t, cmd)
+
+	done := make(chan tea.Msg)
+	go func() { done <- cmd() }()
+	<-started
+	typeText(view, "t")
+
+	select {
+	case msg := <-done:
+		completed, ok := msg.(messages.SearchCompleted)
+		require.True(t, ok)
+		require.ErrorIs(t, completed.Err, context.Canceled)
+		view.Update(msg)
+		assert.NoError(t, view.Err(), "cancelled searches are not errors")
+	case <-time.After(time.Second):
+		t.Fatal("search was not cancelled by new input")
+	}
+}
+
+func TestView_LiveSearch_DropsOutOfOrderResults(t *testing.T) {
+	mock := &MockSearchService{
+		SearchFunc: func(_ context.Context, query string, _ domain.SearchOptions) ([]domain.SearchResult, error) {
+			return []domain.SearchResult{{Document: domain.Document{ID: query}}}, nil
+		},
+	}
+	view := NewView(nil, nil, mock, nil)
+	typeText(view, "dep")
+	_, older := view.Update(liveSearchDue{seq: view.searchSeq, query: "dep"})
+	typeText(view, "loy")
+	_, newer := view.Update(liveSearchDue{seq: view.searchSeq, query: "deploy"})
+	require.NotNil(t, older)
+	require.NotNil(t, newer)
+
+	// The newer search finishes first
+	view.Update(newer())
+	view.Update(older())
+
+	require.Len(t, view.Results(), 1)
+	assert.Equal(t, "deploy", view.Results()[0].Document.ID)
+}
+
+func TestView_LiveSearch_CacheHitOnBackspace(t *testing.T) {
+	calls := 0
+	mock := &MockSearchService{
+		SearchFunc: func(_ context.Context, query string, _ domain.SearchOptions) ([]domain.SearchResult, error) {
+			calls++
+			return []domain.SearchResult{{Document: domain.Document{ID: query}}}, nil
+		},
+	}
+	view := NewView(nil, nil, mock, nil)
+	typeText(view, "dep")
+	_, cmd := view.Update(liveSearchDue{seq: view.searchSeq, query: "dep"})
+	view.Update(cmd())
+	typeText(view, "l")
+	_, cmd = view.Update(liveSearchDue{seq: view.searchSeq, query: "depl"})
+	view.Update(cmd())
+	require.Equal(t, 2, calls)
+
+	// Backspacing to a cached query shows its results without a delay
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	require.NotNil(t, cmd)
+	msg := cmd()
+	completed, ok := msg.(messages.SearchCompleted)
+	require.True(t, ok, "cached results are returned, not scheduled")
+	view.Update(completed)
+
+	assert.Equal(t, 2, calls)
+	require.Len(t, view.Results(), 1)
+	assert.Equal(t, "dep", view.Results()[0].Document.ID)
+}
+
+func TestView_Search_UsesCacheForNormalisedQuery(t *testing.T) {
+	calls := 0
+	mock := &MockSearchService{
+		SearchFunc: func(_ context.Context, _ string, _ domain.SearchOptions) ([]domain.SearchResult, error) {
+			calls++
+			return testSearchResults(), nil
+		},
+	}
+	view := NewView(nil, nil, mock, nil)
+	view.SetQuery("Deploy Steps")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view.Update(cmd())
+
+	view.Reset()
+	view.SetQuery("deploy   steps")
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view.Update(cmd())
+
+	assert.Equal(t, 1, calls)
+	assert.Len(t, view.Results(), 2)
+	assert.False(t, view.InputFocused())
+
+	// Changes to the index invalidate cached results
+	view.Update(messages.WatchUpdated{})
+	view.Reset()
+	view.SetQuery("deploy steps")
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view.Update(cmd())
+	assert.Equal(t, 2, calls)
+}
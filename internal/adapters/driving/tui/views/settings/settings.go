@@ -8,6 +8,7 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -22,11 +23,11 @@ const (
 	SectionSearchMode
 	SectionEmbedding
 	SectionLLM
+	SectionChunking
 )
 
 // Key constants for key handling.
 const (
-	keyDown  = "down"
 	keyEnter = "enter"
 	keyTab   = "tab"
 )
@@ -34,6 +35,7 @@ const (
 // View is the settings configuration view.
 type View struct {
 	styles          *styles.Styles
+	keys            *keymap.KeyMap
 	settingsService driving.SettingsService
 
 	// Current settings
@@ -73,6 +75,7 @@ func NewView(s *styles.Styles, settingsService driving.SettingsService) *View {
 
 	return &View{
 		styles:               s,
+		keys:                 keymap.DefaultKeyMap(),
 		settingsService:      settingsService,
 		section:              SectionOverview,
 		embeddingAPIKeyInput: embeddingAPIKeyInput,
@@ -80,6 +83,11 @@ func NewView(s *styles.Styles, settingsService driving.SettingsService) *View {
 	}
 }
 
+// SetKeyMap sets the keys the view responds to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	v.keys = km
+}
+
 // Init initialises the view and loads settings.
 func (v *View) Init() tea.Cmd {
 	return v.loadSettings()
@@ -159,25 +167,27 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 		return v.handleEmbeddingKeys(msg)
 	case SectionLLM:
 		return v.handleLLMKeys(msg)
+	case SectionChunking:
+		return v.handleChunkingKeys(msg)
 	}
 
 	return v, nil
 }
 
 func (v *View) handleOverviewKeys(msg tea.KeyMsg) (*View, tea.Cmd) {
-	// Overview menu: Search Mode, Embedding, LLM
-	maxItems := 3
+	// Overview menu: Search Mode, Embedding, LLM, Chunking
+	maxItems := 4
 
-	switch msg.String() {
-	case "up", "k":
+	switch key := msg.String(); {
+	case keymap.Matches(key, v.keys.Up):
 		if v.selected > 0 {
 			v.selected--
 		}
-	case keyDown, "j":
+	case keymap.Matches(key, v.keys.Down):
 		if v.selected < maxItems-1 {
 			v.selected++
 		}
-	case keyEnter:
+	case keymap.Matches(key, v.keys.Select):
 		switch v.selected {
 		case 0:
 			v.section = SectionSearchMode
@@ -188,6 +198,9 @@ func (v *View) handleOverviewKeys(msg tea.KeyMsg) (*View, tea.Cmd) {
 		case 2:
 			v.section = SectionLLM
 			v.selected = v.getLLMProviderIndex()
+		case 3:
+			v.section = SectionChunking
+			v.selected = v.getChunkingStrategyIndex()
 		}
 	}
 	return v, nil
@@ -196,16 +209,16 @@ func (v *View) handleOverviewKeys(msg tea.KeyMsg) (*View, tea.Cmd) {
 func (v *View) handleSearchModeKeys(msg tea.KeyMsg) (*View, tea.Cmd) {
 	modes := domain.AllSearchModes()
 
-	switch msg.String() {
-	case "up", "k":
+	switch key := msg.String(); {
+	case keymap.Matches(key, v.keys.Up):
 		if v.selected > 0 {
 			v.selected--
 		}
-	case keyDown, "j":
+	case keymap.Matches(key, v.keys.Down):
 		if v.selected < len(modes)-1 {
 			v.selected++
 		}
-	case keyEnter:
+	case keymap.Matches(key, v.keys.Select):
 		if v.selected >= 0 && v.selected < len(modes) {
 			cmd := v.setSearchMode(modes[v.selected])
 			return v, cmd
@@ -214,9 +227,43 @@ func (v *View) handleSearchModeKeys(msg tea.KeyMsg) (*View, tea.Cmd) {
 	return v, nil
 }
 
+func (v *View) handleChunkingKeys(msg tea.KeyMsg) (*View, tea.Cmd) {
+	strategies := domain.AllChunkingStrategies()
+
+	switch key := msg.String(); {
+	case keymap.Matches(key, v.keys.Up):
+		if v.selected > 0 {
+			v.selected--
+		}
+	case keymap.Matches(key, v.keys.Down):
+		if v.selected < len(strategies)-1 {
+			v.selected++
+		}
+	case keymap.Matches(key, v.keys.Select):
+		if v.selected >= 0 && v.selected < len(strategies) {
+			cmd := v.setChunkingStrategy(strategies[v.selected])
+			return v, cmd
+		}
+	}
+	return v, nil
+}
+
+// embeddingProviders returns the embedding providers the view can configure.
+// Providers requiring an endpoint need a base URL and dimensions, which are
+// set with 'sercha settings embedding'.
+func embeddingProviders() []domain.AIProvider {
+	var providers []domain.AIProvider
+	for _, p := range domain.AllEmbeddingProviders() {
+		if !p.RequiresEndpoint() {
+			providers = append(providers, p)
+		}
+	}
+	return providers
+}
+
 //nolint:dupl,gocognit,gocyclo // duplicate with handleLLMKeys; TUI input complexity
 func (v *View) handleEmbeddingKeys(msg tea.KeyMsg) (*View, tea.Cmd) {
-	providers := domain.AllEmbeddingProviders()
+	providers := embeddingProviders()
 
 	// If we're focused on the API key input
 	if v.focusedField == 1 {
@@ -239,23 +286,23 @@ func (v *View) handleEmbeddingKeys(msg tea.KeyMsg) (*View, tea.Cmd) {
 		return v, nil
 	}
 
-	switch msg.String() {
-	case "up", "k":
+	switch key := msg.String(); {
+	case keymap.Matches(key, v.keys.Up):
 		if v.selected > 0 {
 			v.selected--
 		}
-	case keyDown, "j":
+	case keymap.Matches(key, v.keys.Down):
 		if v.selected < len(providers)-1 {
 			v.selected++
 		}
-	case keyTab:
+	case key == keyTab:
 		// Tab to API key input if provider requires it
 		if v.selected >= 0 && v.selected < len(providers) && providers[v.selected].RequiresAPIKey() {
 			v.focusedField = 1
 			cmd := v.embeddingAPIKeyInput.Focus()
 			return v, cmd
 		}
-	case keyEnter:
+	case keymap.Matches(key, v.keys.Select):
 		if v.selected >= 0 && v.selected < len(providers) {
 			provider := providers[v.selected]
 			if provider.RequiresAPIKey() {
@@ -297,23 +344,23 @@ func (v *View) handleLLMKeys(msg tea.KeyMsg) (*View, tea.Cmd) {
 		return v, nil
 	}
 
-	switch msg.String() {
-	case "up", "k":
+	switch key := msg.String(); {
+	case keymap.Matches(key, v.keys.Up):
 		if v.selected > 0 {
 			v.selected--
 		}
-	case keyDown, "j":
+	case keymap.Matches(key, v.keys.Down):
 		if v.selected < len(providers)-1 {
 			v.selected++
 		}
-	case keyTab:
+	case key == keyTab:
 		// Tab to API key input if provider requires it
 		if v.selected >= 0 && v.selected < len(providers) && providers[v.selected].RequiresAPIKey() {
 			v.focusedField = 1
 			cmd := v.llmAPIKeyInput.Focus()
 			return v, cmd
 		}
-	case keyEnter:
+	case keymap.Matches(key, v.keys.Select):
 		if v.selected >= 0 && v.selected < len(providers) {
 			provider := providers[v.selected]
 			if provider.RequiresAPIKey() {
@@ -346,6 +393,20 @@ func (v *View) setSearchMode(mode domain.SearchMode) tea.Cmd {
 	}
 }
 
+func (v *View) setChunkingStrategy(strategy domain.ChunkingStrategy) tea.Cmd {
+	return func() tea.Msg {
+		if v.settingsService == nil {
+			return messages.SettingsSaved{Err: fmt.Errorf("settings service not available")}
+		}
+		err := v.settingsService.Set("chunk_strategy", strategy.String())
+		if err == nil {
+			v.section = SectionOverview
+			v.selected = 0
+		}
+		return messages.SettingsSaved{Err: err}
+	}
+}
+
 func (v *View) setEmbeddingProvider(provider domain.AIProvider, apiKey string) tea.Cmd {
 	return func() tea.Msg {
 		if v.settingsService == nil {
@@ -405,7 +466,7 @@ func (v *View) getEmbeddingProviderIndex() int {
 	if v.settings == nil {
 		return 0
 	}
-	providers := domain.AllEmbeddingProviders()
+	providers := embeddingProviders()
 	for i, p := range providers {
 		if p == v.settings.Embedding.Provider {
 			return i
@@ -414,6 +475,19 @@ func (v *View) getEmbeddingProviderIndex() int {
 	return 0
 }
 
+func (v *View) getChunkingStrategyIndex() int {
+	if v.settings == nil {
+		return 0
+	}
+	strategies := domain.AllChunkingStrategies()
+	for i, s := range strategies {
+		if s == v.settings.Chunking.Strategy {
+			return i
+		}
+	}
+	return 0
+}
+
 func (v *View) getLLMProviderIndex() int {
 	if v.settings == nil {
 		return 0
@@ -455,6 +529,8 @@ func (v *View) View() string {
 		b.WriteString(v.renderEmbeddingSelect())
 	case SectionLLM:
 		b.WriteString(v.renderLLMSelect())
+	case SectionChunking:
+		b.WriteString(v.renderChunkingSelect())
 	}
 
 	b.WriteString("\n")
@@ -485,6 +561,11 @@ func (v *View) renderOverview() string {
 			value:  fmt.Sprintf("%s (%s)", v.settings.LLM.Provider.Description(), v.settings.LLM.Model),
 			status: v.getLLMStatus(),
 		},
+		{
+			label: "Chunking",
+			value: fmt.Sprintf("%s (%d chars, %d overlap)", v.settings.Chunking.Strategy.Description(),
+				v.settings.Chunking.ChunkSize, v.settings.Chunking.Overlap),
+		},
 	}
 
 	for i, item := range items {
@@ -576,6 +657,41 @@ func (v *View) renderSearchModeSelect() string {
 	return b.String()
 }
 
+func (v *View) renderChunkingSelect() string {
+	var b strings.Builder
+
+	b.WriteString(v.styles.Subtitle.Render("Select Chunking Strategy"))
+	b.WriteString("\n\n")
+
+	strategies := domain.AllChunkingStrategies()
+	for i, strategy := range strategies {
+		indicator := "  "
+		if i == v.selected {
+			indicator = "> "
+		}
+
+		current := ""
+		if v.settings != nil && strategy == v.settings.Chunking.Strategy {
+			current = v.styles.Success.Render(" (current)")
+		}
+
+		line := fmt.Sprintf("%s%s%s", indicator, strategy.Description(), current)
+		if i == v.selected {
+			b.WriteString(v.styles.Selected.Render(line))
+		} else {
+			b.WriteString(v.styles.Normal.Render(line))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(v.styles.Muted.Render(
+		"Applies to documents synced afterwards. Set chunk_size and chunk_overlap with 'sercha settings set'."))
+	b.WriteString("\n")
+
+	return b.String()
+}
+
 //nolint:dupl // intentional duplicate structure with renderLLMSelect for maintainability
 func (v *View) renderEmbeddingSelect() string {
 	var b strings.Builder
@@ -583,7 +699,7 @@ func (v *View) renderEmbeddingSelect() string {
 	b.WriteString(v.styles.Subtitle.Render("Select Embedding Provider"))
 	b.WriteString("\n\n")
 
-	providers := domain.AllEmbeddingProviders()
+	providers := embeddingProviders()
 	for i, provider := range providers {
 		indicator := "  "
 		if i == v.selected && v.focusedField == 0 {
@@ -671,21 +787,39 @@ func (v *View) renderLLMSelect() string {
 }
 
 func (v *View) renderHelp() string {
+	nav := "[" + v.keys.NavigationKeys() + "] navigate  "
 	switch v.section {
 	case SectionOverview:
-		return v.styles.Help.Render("[j/k] navigate  [enter] edit  [esc] back")
-	case SectionSearchMode:
-		return v.styles.Help.Render("[j/k] navigate  [enter] select  [esc] back")
+		return v.styles.Help.Render(nav + "[enter] edit  [esc] back")
+	case SectionSearchMode, SectionChunking:
+		return v.styles.Help.Render(nav + "[enter] select  [esc] back")
 	case SectionEmbedding, SectionLLM:
 		if v.focusedField == 1 {
 			return v.styles.Help.Render("[tab] back to list  [enter] save  [esc] back")
 		}
-		return v.styles.Help.Render("[j/k] navigate  [tab] API key  [enter] select  [esc] back")
+		return v.styles.Help.Render(nav + "[tab] API key  [enter] select  [esc] back")
 	default:
 		return ""
 	}
 }
 
+// KeyBindings returns the keys the settings view responds to.
+func (v *View) KeyBindings() []keymap.KeyBinding {
+	return []keymap.KeyBinding{
+		{Group: "Navigation", Keys: v.keys.Up.Help().Key, Description: "move up"},
+		{Group: "Navigation", Keys: v.keys.Down.Help().Key, Description: "move down"},
+		{Group: "Navigation", Keys: "esc", Description: "back to overview, or to menu"},
+		{Group: "Actions", Keys: v.keys.Select.Help().Key, Description: "edit or select the highlighted setting"},
+		{Group: "Providers", Keys: "tab", Description: "switch between the list and API key"},
+		{Group: "Providers", Keys: "enter", Description: "save the provider"},
+	}
+}
+
+// InputFocused returns true if an API key input has focus.
+func (v *View) InputFocused() bool {
+	return (v.section == SectionEmbedding || v.section == SectionLLM) && v.focusedField == 1
+}
+
 // SetDimensions sets the view dimensions.
 func (v *View) SetDimensions(width, height int) {
 	v.width = width
@@ -42,6 +42,11 @@ func (m *MockSettingsService) SetEmbeddingProvider(provider domain.AIProvider, m
 	return args.Error(0)
 }
 
+func (m *MockSettingsService) SetEmbeddingEndpoint(baseURL string, dimensions int) error {
+	args := m.Called(baseURL, dimensions)
+	return args.Error(0)
+}
+
 func (m *MockSettingsService) SetLLMProvider(provider domain.AIProvider, model, apiKey string) error {
 	args := m.Called(provider, model, apiKey)
 	return args.Error(0)
@@ -77,6 +82,16 @@ func (m *MockSettingsService) ValidateLLMConfig() error {
 	return args.Error(0)
 }
 
+func (m *MockSettingsService) Set(key, value string) error {
+	args := m.Called(key, value)
+	return args.Error(0)
+}
+
+func (m *MockSettingsService) SetSchedulerCron(taskID, expr string) error {
+	args := m.Called(taskID, expr)
+	return args.Error(0)
+}
+
 // Helper function to create test settings.
 func testSettings() *domain.AppSettings {
 	return &domain.AppSettings{
@@ -93,6 +108,11 @@ func testSettings() *domain.AppSettings {
 			Model:    "llama3.2",
 			BaseURL:  "http://localhost:11434",
 		},
+		Chunking: domain.ChunkingSettings{
+			Strategy:  domain.ChunkingSentence,
+			ChunkSize: 1000,
+			Overlap:   200,
+		},
 	}
 }
 
@@ -309,9 +329,12 @@ func TestView_Update_KeyMsg_Overview_NavigateDown(t *testing.T) {
 	view.Update(msg)
 	assert.Equal(t, 2, view.selected)
 
-	// Test boundary - can't go past last item (3 items: 0-2)
 	view.Update(msg)
-	assert.Equal(t, 2, view.selected)
+	assert.Equal(t, 3, view.selected)
+
+	// Test boundary - can't go past last item (4 items: 0-3)
+	view.Update(msg)
+	assert.Equal(t, 3, view.selected)
 }
 
 func TestView_Update_KeyMsg_Overview_NavigateUp(t *testing.T) {
@@ -381,6 +404,55 @@ func TestView_Update_KeyMsg_Overview_Enter_LLM(t *testing.T) {
 	assert.Equal(t, SectionLLM, view.section)
 }
 
+func TestView_Update_KeyMsg_Overview_Enter_Chunking(t *testing.T) {
+	mockService := new(MockSettingsService)
+	view := NewView(nil, mockService)
+	view.section = SectionOverview
+	view.selected = 3
+	view.settings = testSettings()
+
+	msg := tea.KeyMsg{Type: tea.KeyEnter}
+	updated, cmd := view.Update(msg)
+
+	assert.Equal(t, view, updated)
+	assert.Nil(t, cmd)
+	assert.Equal(t, SectionChunking, view.section)
+	assert.Equal(t, 1, view.selected) // Index of current strategy
+}
+
+func TestView_Update_KeyMsg_Chunking_Enter_Success(t *testing.T) {
+	mockService := new(MockSettingsService)
+	mockService.On("Set", "chunk_strategy", "heading").Return(nil)
+
+	view := NewView(nil, mockService)
+	view.section = SectionChunking
+	view.selected = 1
+
+	view.Update(tea.KeyMsg{Type: tea.KeyDown})
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+
+	saved, ok := cmd().(messages.SettingsSaved)
+	require.True(t, ok)
+	assert.NoError(t, saved.Err)
+	assert.Equal(t, SectionOverview, view.section)
+	mockService.AssertExpectations(t)
+}
+
+func TestView_View_ChunkingSelect(t *testing.T) {
+	view := NewView(nil, new(MockSettingsService))
+	view.settings = testSettings()
+	view.section = SectionChunking
+
+	output := view.View()
+
+	assert.Contains(t, output, "Select Chunking Strategy")
+	for _, strategy := range domain.AllChunkingStrategies() {
+		assert.Contains(t, output, strategy.Description())
+	}
+	assert.Contains(t, output, "(current)")
+}
+
 func TestView_Update_KeyMsg_SearchMode_Navigate(t *testing.T) {
 	mockService := new(MockSettingsService)
 	view := NewView(nil, mockService)
@@ -1014,6 +1086,7 @@ func TestSectionConstants(t *testing.T) {
 	assert.Equal(t, Section(1), SectionSearchMode)
 	assert.Equal(t, Section(2), SectionEmbedding)
 	assert.Equal(t, Section(3), SectionLLM)
+	assert.Equal(t, Section(4), SectionChunking)
 }
 
 // Test that tab on provider not requiring API key does nothing.
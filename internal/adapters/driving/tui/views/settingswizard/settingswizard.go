@@ -0,0 +1,743 @@
+// Package settingswizard provides an interactive walkthrough of the main
+// settings that validates each step before moving on to the next.
+package settingswizard
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// Step tracks the wizard's current step.
+type Step int
+
+const (
+	StepProvider Step = iota
+	StepMode
+	StepSchedule
+	StepTheme
+	StepDone
+)
+
+// stepCount is the number of steps that take input.
+const stepCount = int(StepDone)
+
+// Key constants for key handling.
+const (
+	keyEnter    = "enter"
+	keyEsc      = "esc"
+	keyTab      = "tab"
+	keyShiftTab = "shift+tab"
+)
+
+// schedulerConfigProvider is implemented by settings services that expose
+// the scheduler configuration, used to pre-populate the sync schedule.
+type schedulerConfigProvider interface {
+	GetSchedulerConfig() domain.SchedulerConfig
+}
+
+// embeddingValidated reports the result of the test embedding call.
+type embeddingValidated struct {
+	err error
+}
+
+// Model is the settings wizard. It runs as a standalone bubbletea program.
+type Model struct {
+	styles          *styles.Styles
+	keys            *keymap.KeyMap
+	settingsService driving.SettingsService
+
+	// settings holds the saved settings, refreshed after each step
+	settings *domain.AppSettings
+
+	step       Step
+	err        error
+	validating bool
+	completed  bool
+
+	// List selection for the provider, mode and theme steps
+	selected int
+
+	// Provider step: focus 0 is the provider list, higher values the inputs
+	focus           int
+	modelInput      textinput.Model
+	apiKeyInput     textinput.Model
+	baseURLInput    textinput.Model
+	dimensionsInput textinput.Model
+
+	// Schedule step
+	cronInput    textinput.Model
+	syncInterval string
+
+	// Theme step
+	themes []string
+}
+
+// NewModel creates a settings wizard pre-populated with the saved settings.
+func NewModel(s *styles.Styles, settingsService driving.SettingsService) *Model {
+	if s == nil {
+		s = styles.DefaultStyles()
+	}
+
+	m := &Model{
+		styles:          s,
+		keys:            keymap.DefaultKeyMap(),
+		settingsService: settingsService,
+		modelInput:      newInput("Model name", 128),
+		apiKeyInput:     newInput("Enter API key", 256),
+		baseURLInput:    newInput("https://host/v1", 256),
+		dimensionsInput: newInput("e.g. 768", 6),
+		cronInput:       newInput("e.g. 0 6 * * 1-5", 64),
+	}
+	m.apiKeyInput.EchoMode = textinput.EchoPassword
+
+	if settingsService == nil {
+		m.err = errors.New("settings service not available")
+		return m
+	}
+	settings, err := settingsService.Get()
+	if err != nil {
+		m.err = fmt.Errorf("failed to load settings: %w", err)
+		return m
+	}
+	m.settings = settings
+	m.enterStep(StepProvider)
+	return m
+}
+
+// newInput creates a text input with a placeholder and character limit.
+func newInput(placeholder string, limit int) textinput.Model {
+	input := textinput.New()
+	input.Placeholder = placeholder
+	input.CharLimit = limit
+	return input
+}
+
+// SetKeyMap sets the keys the wizard responds to.
+func (m *Model) SetKeyMap(km *keymap.KeyMap) {
+	m.keys = km
+}
+
+// Step returns the current step.
+func (m *Model) Step() Step {
+	return m.step
+}
+
+// Completed reports whether every step was finished rather than the wizard
+// being cancelled.
+func (m *Model) Completed() bool {
+	return m.completed
+}
+
+// Err returns the error shown for the current step, if any.
+func (m *Model) Err() error {
+	return m.err
+}
+
+// Init implements tea.Model.
+func (m *Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case embeddingValidated:
+		return m.handleEmbeddingValidated(msg)
+	case tea.KeyMsg:
+		return m.handleKeyMsg(msg)
+	}
+	return m, nil
+}
+
+// handleKeyMsg routes key presses to the current step.
+func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.String() == "ctrl+c" {
+		return m, tea.Quit
+	}
+	if m.settings == nil {
+		// Settings failed to load; there is nothing to configure
+		return m, tea.Quit
+	}
+	if m.validating {
+		return m, nil
+	}
+
+	if msg.String() == keyEsc {
+		if m.step == StepProvider || m.step == StepDone {
+			return m, tea.Quit
+		}
+		m.enterStep(m.step - 1)
+		return m, nil
+	}
+
+	switch m.step {
+	case StepProvider:
+		return m.handleProviderKeys(msg)
+	case StepMode:
+		return m.handleListKeys(msg, len(domain.AllSearchModes()), m.submitMode)
+	case StepSchedule:
+		return m.handleScheduleKeys(msg)
+	case StepTheme:
+		return m.handleListKeys(msg, len(m.themes), m.submitTheme)
+	case StepDone:
+		if msg.String() == keyEnter || keymap.Matches(msg.String(), m.keys.Quit) {
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+// enterStep moves to step, selecting the saved value for it.
+func (m *Model) enterStep(step Step) {
+	m.step = step
+	m.err = nil
+	m.selected = 0
+	m.focus = 0
+	m.blurInputs()
+
+	switch step {
+	case StepProvider:
+		m.selected = len(domain.AllEmbeddingProviders()) // skip
+		for i, p := range domain.AllEmbeddingProviders() {
+			if p == m.settings.Embedding.Provider {
+				m.selected = i
+			}
+		}
+		m.resetProviderInputs()
+	case StepMode:
+		for i, mode := range domain.AllSearchModes() {
+			if mode == m.settings.Search.Mode {
+				m.selected = i
+			}
+		}
+	case StepSchedule:
+		m.loadSchedule()
+		m.cronInput.Focus()
+	case StepTheme:
+		m.loadThemes()
+	case StepDone:
+		m.completed = true
+	}
+}
+
+// blurInputs removes focus from every text input.
+func (m *Model) blurInputs() {
+	for _, input := range []*textinput.Model{
+		&m.modelInput, &m.apiKeyInput, &m.baseURLInput, &m.dimensionsInput, &m.cronInput,
+	} {
+		input.Blur()
+	}
+}
+
+// handleListKeys moves the selection through a list of n items and submits
+// the selection on select.
+func (m *Model) handleListKeys(msg tea.KeyMsg, n int, submit func() (tea.Model, tea.Cmd)) (tea.Model, tea.Cmd) {
+	switch key := msg.String(); {
+	case keymap.Matches(key, m.keys.Up):
+		if m.selected > 0 {
+			m.selected--
+		}
+	case keymap.Matches(key, m.keys.Down):
+		if m.selected < n-1 {
+			m.selected++
+		}
+	case keymap.Matches(key, m.keys.Select):
+		return submit()
+	}
+	return m, nil
+}
+
+// refreshSettings reloads the saved settings after a step changes them.
+func (m *Model) refreshSettings() error {
+	settings, err := m.settingsService.Get()
+	if err != nil {
+		return fmt.Errorf("failed to reload settings: %w", err)
+	}
+	m.settings = settings
+	return nil
+}
+
+// Step 1: embedding provider.
+
+// selectedProvider returns the provider under the cursor, or false when the
+// step is being skipped.
+func (m *Model) selectedProvider() (domain.AIProvider, bool) {
+	providers := domain.AllEmbeddingProviders()
+	if m.selected < len(providers) {
+		return providers[m.selected], true
+	}
+	return "", false
+}
+
+// providerInputs returns the inputs the selected provider needs, in focus order.
+func (m *Model) providerInputs() []*textinput.Model {
+	provider, ok := m.selectedProvider()
+	if !ok {
+		return nil
+	}
+	inputs := []*textinput.Model{&m.modelInput}
+	if provider.RequiresAPIKey() {
+		inputs = append(inputs, &m.apiKeyInput)
+	}
+	if provider.RequiresEndpoint() {
+		inputs = append(inputs, &m.baseURLInput, &m.dimensionsInput)
+	}
+	return inputs
+}
+
+// resetProviderInputs fills the inputs for the selected provider, keeping the
+// saved values when it is the configured provider.
+func (m *Model) resetProviderInputs() {
+	provider, ok := m.selectedProvider()
+	if !ok {
+		return
+	}
+	current := m.settings.Embedding
+	if provider == current.Provider {
+		m.modelInput.SetValue(current.Model)
+		m.apiKeyInput.SetValue(current.APIKey)
+		m.baseURLInput.SetValue(current.BaseURL)
+		m.dimensionsInput.SetValue("")
+		if current.Dimensions > 0 {
+			m.dimensionsInput.SetValue(strconv.Itoa(current.Dimensions))
+		}
+		return
+	}
+	m.modelInput.SetValue(domain.DefaultEmbeddingModels()[provider])
+	m.apiKeyInput.SetValue("")
+	m.baseURLInput.SetValue("")
+	m.dimensionsInput.SetValue("")
+}
+
+// setProviderFocus focuses the provider list (0) or one of the inputs.
+func (m *Model) setProviderFocus(focus int) tea.Cmd {
+	m.blurInputs()
+	m.focus = focus
+	if focus == 0 {
+		return nil
+	}
+	return m.providerInputs()[focus-1].Focus()
+}
+
+//nolint:gocognit // TUI input complexity
+func (m *Model) handleProviderKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	inputs := m.providerInputs()
+	key := msg.String()
+
+	switch key {
+	case keyTab:
+		return m, m.setProviderFocus((m.focus + 1) % (len(inputs) + 1))
+	case keyShiftTab:
+		return m, m.setProviderFocus((m.focus + len(inputs)) % (len(inputs) + 1))
+	}
+
+	if m.focus > 0 {
+		if key == keyEnter {
+			if m.focus < len(inputs) {
+				return m, m.setProviderFocus(m.focus + 1)
+			}
+			return m.submitProvider()
+		}
+		var cmd tea.Cmd
+		*inputs[m.focus-1], cmd = inputs[m.focus-1].Update(msg)
+		return m, cmd
+	}
+
+	switch {
+	case keymap.Matches(key, m.keys.Up):
+		if m.selected > 0 {
+			m.selected--
+			m.err = nil
+			m.resetProviderInputs()
+		}
+	case keymap.Matches(key, m.keys.Down):
+		if m.selected < len(domain.AllEmbeddingProviders()) {
+			m.selected++
+			m.err = nil
+			m.resetProviderInputs()
+		}
+	case keymap.Matches(key, m.keys.Select):
+		if len(inputs) > 0 {
+			return m, m.setProviderFocus(1)
+		}
+		return m.submitProvider()
+	}
+	return m, nil
+}
+
+// submitProvider checks the provider inputs, then saves them and makes a
+// test embedding call. The step advances only if the call succeeds.
+func (m *Model) submitProvider() (tea.Model, tea.Cmd) {
+	provider, ok := m.selectedProvider()
+	if !ok {
+		m.enterStep(StepMode)
+		return m, nil
+	}
+
+	model := strings.TrimSpace(m.modelInput.Value())
+	apiKey := strings.TrimSpace(m.apiKeyInput.Value())
+	if model == "" && provider.RequiresEndpoint() {
+		m.err = fmt.Errorf("%s requires a model name", provider.Description())
+		return m, nil
+	}
+	if provider.RequiresAPIKey() && apiKey == "" {
+		m.err = fmt.Errorf("an API key is required for %s", provider.Description())
+		return m, nil
+	}
+
+	var baseURL string
+	var dimensions int
+	if provider.RequiresEndpoint() {
+		baseURL = strings.TrimSpace(m.baseURLInput.Value())
+		if err := domain.ValidateEndpointURL(baseURL); err != nil {
+			m.err = err
+			return m, nil
+		}
+		d, err := strconv.Atoi(strings.TrimSpace(m.dimensionsInput.Value()))
+		if err != nil || d <= 0 {
+			m.err = errors.New("dimensions must be a positive number")
+			return m, nil
+		}
+		dimensions = d
+	}
+
+	m.err = nil
+	m.validating = true
+	m.blurInputs()
+	return m, m.saveEmbedding(provider, model, apiKey, baseURL, dimensions)
+}
+
+// saveEmbedding returns a command that saves the embedding settings and
+// validates them with a test embedding call, restoring the previous
+// settings if either fails.
+func (m *Model) saveEmbedding(provider domain.AIProvider, model, apiKey, baseURL string, dimensions int) tea.Cmd {
+	svc := m.settingsService
+	previous := *m.settings
+	return func() tea.Msg {
+		err := svc.SetEmbeddingProvider(provider, model, apiKey)
+		if err == nil && provider.RequiresEndpoint() {
+			err = svc.SetEmbeddingEndpoint(baseURL, dimensions)
+		}
+		if err == nil {
+			if err = svc.ValidateEmbeddingConfig(); err != nil {
+				err = fmt.Errorf("test embedding failed: %w", err)
+			}
+		}
+		if err != nil {
+			if restoreErr := svc.Save(&previous); restoreErr != nil {
+				err = fmt.Errorf("%w (restoring previous settings also failed: %w)", err, restoreErr)
+			}
+		}
+		return embeddingValidated{err: err}
+	}
+}
+
+func (m *Model) handleEmbeddingValidated(msg embeddingValidated) (tea.Model, tea.Cmd) {
+	m.validating = false
+	if msg.err != nil {
+		m.err = msg.err
+		return m, m.setProviderFocus(m.focus)
+	}
+	if err := m.refreshSettings(); err != nil {
+		m.err = err
+		return m, nil
+	}
+	m.enterStep(StepMode)
+	return m, nil
+}
+
+// Step 2: search mode.
+
+// submitMode saves the selected search mode if the AI providers it needs
+// are configured.
+func (m *Model) submitMode() (tea.Model, tea.Cmd) {
+	mode := domain.AllSearchModes()[m.selected]
+
+	if mode.RequiresEmbedding() && !m.settings.Embedding.IsConfigured() {
+		m.err = fmt.Errorf("%s needs an embedding provider: press esc to configure one", mode.Description())
+		return m, nil
+	}
+	if mode.RequiresLLM() && !m.settings.LLM.IsConfigured() {
+		m.err = fmt.Errorf("%s needs an LLM provider: configure one with 'sercha settings llm'",
+			mode.Description())
+		return m, nil
+	}
+	if err := m.settingsService.SetSearchMode(mode); err != nil {
+		m.err = fmt.Errorf("failed to set search mode: %w", err)
+		return m, nil
+	}
+	if err := m.refreshSettings(); err != nil {
+		m.err = err
+		return m, nil
+	}
+	m.enterStep(StepSchedule)
+	return m, nil
+}
+
+// Step 3: sync schedule.
+
+// loadSchedule fills the cron input with the saved document sync schedule.
+func (m *Model) loadSchedule() {
+	cfg := domain.DefaultSchedulerConfig()
+	if provider, ok := m.settingsService.(schedulerConfigProvider); ok {
+		cfg = provider.GetSchedulerConfig()
+	}
+	task := cfg.GetTaskConfig(domain.TaskIDDocumentSync)
+	m.cronInput.SetValue(task.Cron)
+	m.syncInterval = ""
+	if task.Interval > 0 {
+		m.syncInterval = task.Interval.String()
+	}
+}
+
+func (m *Model) handleScheduleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.String() == keyEnter {
+		expr := strings.TrimSpace(m.cronInput.Value())
+		if err := m.settingsService.SetSchedulerCron(domain.TaskIDDocumentSync, expr); err != nil {
+			m.err = fmt.Errorf("invalid cron schedule: %w", err)
+			return m, nil
+		}
+		m.enterStep(StepTheme)
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.cronInput, cmd = m.cronInput.Update(msg)
+	return m, cmd
+}
+
+// Step 4: theme.
+
+// loadThemes lists the theme choices, keeping a saved theme file as one of
+// them, and selects the saved theme.
+func (m *Model) loadThemes() {
+	m.themes = append([]string{styles.ThemeAuto}, styles.ThemeNames()...)
+	current := m.settings.UI.Theme
+	if current == "" {
+		current = domain.DefaultTheme
+	}
+	for i, name := range m.themes {
+		if strings.EqualFold(name, current) {
+			m.selected = i
+			return
+		}
+	}
+	m.themes = append(m.themes, current)
+	m.selected = len(m.themes) - 1
+}
+
+// submitTheme saves the selected theme if it loads.
+func (m *Model) submitTheme() (tea.Model, tea.Cmd) {
+	name := m.themes[m.selected]
+	// Resolving "auto" queries the terminal, which the running program owns
+	if name != styles.ThemeAuto {
+		if _, err := styles.LoadTheme(name); err != nil {
+			m.err = err
+			return m, nil
+		}
+	}
+	if err := m.settingsService.Set("theme", name); err != nil {
+		m.err = fmt.Errorf("failed to set theme: %w", err)
+		return m, nil
+	}
+	if err := m.refreshSettings(); err != nil {
+		m.err = err
+		return m, nil
+	}
+	m.enterStep(StepDone)
+	return m, nil
+}
+
+// View implements tea.Model.
+func (m *Model) View() string {
+	var b strings.Builder
+
+	b.WriteString(m.styles.Title.Render("Settings Wizard"))
+	b.WriteString("\n\n")
+
+	if m.settings == nil {
+		b.WriteString(m.styles.Error.Render(fmt.Sprintf("Error: %s", m.err)))
+		b.WriteString("\n\n")
+		b.WriteString(m.styles.Help.Render("Press any key to exit"))
+		return b.String()
+	}
+
+	switch m.step {
+	case StepProvider:
+		b.WriteString(m.renderProvider())
+	case StepMode:
+		b.WriteString(m.renderMode())
+	case StepSchedule:
+		b.WriteString(m.renderSchedule())
+	case StepTheme:
+		b.WriteString(m.renderTheme())
+	case StepDone:
+		b.WriteString(m.renderDone())
+	}
+
+	if m.validating {
+		b.WriteString("\n")
+		b.WriteString(m.styles.Muted.Render("Validating with a test embedding..."))
+		b.WriteString("\n")
+	}
+	if m.err != nil {
+		b.WriteString("\n")
+		b.WriteString(m.styles.Error.Render(fmt.Sprintf("Error: %s", m.err)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(m.renderHelp())
+	return b.String()
+}
+
+// renderStepTitle renders the step heading, e.g. "Step 1 of 4: AI Provider".
+func (m *Model) renderStepTitle(title string) string {
+	return m.styles.Subtitle.Render(fmt.Sprintf("Step %d of %d: %s", int(m.step)+1, stepCount, title)) + "\n\n"
+}
+
+// renderOption renders one line of a selection list.
+func (m *Model) renderOption(i int, label string) string {
+	if i == m.selected {
+		return m.styles.Selected.Render("> "+label) + "\n"
+	}
+	return m.styles.Normal.Render("  "+label) + "\n"
+}
+
+func (m *Model) renderProvider() string {
+	var b strings.Builder
+	b.WriteString(m.renderStepTitle("AI Provider"))
+	b.WriteString(m.styles.Muted.Render("Embeddings enable semantic search. Skip for keyword search only."))
+	b.WriteString("\n\n")
+
+	for i, p := range domain.AllEmbeddingProviders() {
+		label := p.Description()
+		if p == m.settings.Embedding.Provider && m.settings.Embedding.IsConfigured() {
+			label += " (current)"
+		}
+		b.WriteString(m.renderOption(i, label))
+	}
+	b.WriteString(m.renderOption(len(domain.AllEmbeddingProviders()), "Skip (keep current embedding settings)"))
+
+	labels := map[*textinput.Model]string{
+		&m.modelInput:      "Model",
+		&m.apiKeyInput:     "API key",
+		&m.baseURLInput:    "Base URL",
+		&m.dimensionsInput: "Dimensions",
+	}
+	if inputs := m.providerInputs(); len(inputs) > 0 {
+		b.WriteString("\n")
+		for _, input := range inputs {
+			b.WriteString(fmt.Sprintf("%-11s %s\n", labels[input]+":", input.View()))
+		}
+	}
+	return b.String()
+}
+
+func (m *Model) renderMode() string {
+	var b strings.Builder
+	b.WriteString(m.renderStepTitle("Search Mode"))
+
+	for i, mode := range domain.AllSearchModes() {
+		label := mode.Description()
+		if mode == m.settings.Search.Mode {
+			label += " (current)"
+		}
+		b.WriteString(m.renderOption(i, label))
+	}
+	return b.String()
+}
+
+func (m *Model) renderSchedule() string {
+	var b strings.Builder
+	b.WriteString(m.renderStepTitle("Sync Schedule"))
+	b.WriteString(m.styles.Muted.Render("Enter a 5-field cron expression for syncing documents."))
+	b.WriteString("\n")
+	hint := "Leave it empty to sync on the scheduler's interval."
+	if m.syncInterval != "" {
+		hint = fmt.Sprintf("Leave it empty to sync every %s.", m.syncInterval)
+	}
+	b.WriteString(m.styles.Muted.Render(hint))
+	b.WriteString("\n\n")
+	b.WriteString("Cron: " + m.cronInput.View() + "\n")
+	return b.String()
+}
+
+func (m *Model) renderTheme() string {
+	var b strings.Builder
+	b.WriteString(m.renderStepTitle("Theme"))
+
+	for i, name := range m.themes {
+		label := name
+		switch theme, ok := styles.NamedTheme(name); {
+		case name == styles.ThemeAuto:
+			label = fmt.Sprintf("%-15s %s", name, "match the terminal background")
+		case ok:
+			label = fmt.Sprintf("%-15s %s", name, styles.NewStyles(theme).Preview())
+		}
+		b.WriteString(m.renderOption(i, label))
+	}
+	return b.String()
+}
+
+func (m *Model) renderDone() string {
+	var b strings.Builder
+	b.WriteString(m.styles.Subtitle.Render("Configuration Complete"))
+	b.WriteString("\n\n")
+
+	embedding := "not configured"
+	if m.settings.Embedding.IsConfigured() {
+		embedding = fmt.Sprintf("%s (%s)", m.settings.Embedding.Provider.Description(), m.settings.Embedding.Model)
+	}
+	schedule := strings.TrimSpace(m.cronInput.Value())
+	if schedule == "" {
+		schedule = "interval"
+		if m.syncInterval != "" {
+			schedule = "every " + m.syncInterval
+		}
+	}
+	fmt.Fprintf(&b, "  Embedding:     %s\n", embedding)
+	fmt.Fprintf(&b, "  Search mode:   %s\n", m.settings.Search.Mode.Description())
+	fmt.Fprintf(&b, "  Sync schedule: %s\n", schedule)
+	fmt.Fprintf(&b, "  Theme:         %s\n", m.settings.UI.Theme)
+	b.WriteString("\n")
+
+	if err := m.settingsService.Validate(); err != nil {
+		b.WriteString(m.styles.Warning.Render(fmt.Sprintf("Warning: %s", err)))
+	} else {
+		b.WriteString(m.styles.Success.Render("All settings are valid and saved."))
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+func (m *Model) renderHelp() string {
+	var help string
+	switch m.step {
+	case StepProvider:
+		if m.focus > 0 {
+			help = "[tab] next field  [enter] validate  [esc] cancel"
+		} else {
+			help = fmt.Sprintf("[%s/%s] navigate  [tab] edit fields  [%s] select  [esc] cancel",
+				m.keys.Up.Help().Key, m.keys.Down.Help().Key, m.keys.Select.Help().Key)
+		}
+	case StepMode, StepTheme:
+		help = fmt.Sprintf("[%s/%s] navigate  [%s] select  [esc] back",
+			m.keys.Up.Help().Key, m.keys.Down.Help().Key, m.keys.Select.Help().Key)
+	case StepSchedule:
+		help = "[enter] save  [esc] back"
+	case StepDone:
+		help = "[enter] exit"
+	}
+	return m.styles.Help.Render(help)
+}
@@ -0,0 +1,404 @@
+package settingswizard
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// MockSettingsService is a mock implementation of driving.SettingsService.
+type MockSettingsService struct {
+	mock.Mock
+}
+
+func (m *MockSettingsService) Get() (*domain.AppSettings, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.AppSettings), args.Error(1)
+}
+
+func (m *MockSettingsService) Save(settings *domain.AppSettings) error {
+	args := m.Called(settings)
+	return args.Error(0)
+}
+
+func (m *MockSettingsService) SetSearchMode(mode domain.SearchMode) error {
+	args := m.Called(mode)
+	return args.Error(0)
+}
+
+func (m *MockSettingsService) SetEmbeddingProvider(provider domain.AIProvider, model, apiKey string) error {
+	args := m.Called(provider, model, apiKey)
+	return args.Error(0)
+}
+
+func (m *MockSettingsService) SetEmbeddingEndpoint(baseURL string, dimensions int) error {
+	args := m.Called(baseURL, dimensions)
+	return args.Error(0)
+}
+
+func (m *MockSettingsService) SetLLMProvider(provider domain.AIProvider, model, apiKey string) error {
+	args := m.Called(provider, model, apiKey)
+	return args.Error(0)
+}
+
+func (m *MockSettingsService) Validate() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockSettingsService) RequiresEmbedding() bool {
+	args := m.Called()
+	return args.Bool(0)
+}
+
+func (m *MockSettingsService) RequiresLLM() bool {
+	args := m.Called()
+	return args.Bool(0)
+}
+
+func (m *MockSettingsService) GetDefaults() domain.AppSettings {
+	args := m.Called()
+	return args.Get(0).(domain.AppSettings)
+}
+
+func (m *MockSettingsService) ValidateEmbeddingConfig() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockSettingsService) ValidateLLMConfig() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockSettingsService) Set(key, value string) error {
+	args := m.Called(key, value)
+	return args.Error(0)
+}
+
+func (m *MockSettingsService) SetSchedulerCron(taskID, expr string) error {
+	args := m.Called(taskID, expr)
+	return args.Error(0)
+}
+
+// schedulerSettingsService adds the scheduler configuration to the mock.
+type schedulerSettingsService struct {
+	*MockSettingsService
+	scheduler domain.SchedulerConfig
+}
+
+func (s *schedulerSettingsService) GetSchedulerConfig() domain.SchedulerConfig {
+	return s.scheduler
+}
+
+// testSettings returns saved settings with OpenAI embeddings configured.
+func testSettings() *domain.AppSettings {
+	return &domain.AppSettings{
+		Search: domain.SearchSettings{Mode: domain.SearchModeHybrid},
+		Embedding: domain.EmbeddingSettings{
+			Provider: domain.AIProviderOpenAI,
+			Model:    "text-embedding-3-small",
+			APIKey:   "sk-saved",
+		},
+		UI: domain.UISettings{Theme: "gruvbox"},
+	}
+}
+
+func keyMsg(k string) tea.KeyMsg {
+	switch k {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case "tab":
+		return tea.KeyMsg{Type: tea.KeyTab}
+	case "up":
+		return tea.KeyMsg{Type: tea.KeyUp}
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}
+	case "backspace":
+		return tea.KeyMsg{Type: tea.KeyBackspace}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+}
+
+// press sends keys to the wizard, returning the command of the last one.
+func press(m *Model, keys ...string) tea.Cmd {
+	var cmd tea.Cmd
+	for _, k := range keys {
+		_, cmd = m.Update(keyMsg(k))
+	}
+	return cmd
+}
+
+// typeText types text into the focused input.
+func typeText(m *Model, text string) {
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)})
+}
+
+// runValidation runs the pending embedding validation and delivers its result.
+func runValidation(t *testing.T, m *Model, cmd tea.Cmd) {
+	t.Helper()
+	require.NotNil(t, cmd)
+	require.True(t, m.validating)
+	msg, ok := cmd().(embeddingValidated)
+	require.True(t, ok)
+	m.Update(msg)
+}
+
+func TestNewModel_PrepopulatesSavedSettings(t *testing.T) {
+	svc := new(MockSettingsService)
+	svc.On("Get").Return(testSettings(), nil)
+
+	m := NewModel(nil, svc)
+
+	assert.Equal(t, StepProvider, m.Step())
+	assert.Equal(t, 1, m.selected, "OpenAI is selected")
+	assert.Equal(t, "text-embedding-3-small", m.modelInput.Value())
+	assert.Equal(t, "sk-saved", m.apiKeyInput.Value())
+	assert.NoError(t, m.Err())
+	assert.Contains(t, m.View(), "Step 1 of 4: AI Provider")
+}
+
+func TestNewModel_LoadError(t *testing.T) {
+	svc := new(MockSettingsService)
+	svc.On("Get").Return(nil, errors.New("corrupt config"))
+
+	m := NewModel(nil, svc)
+
+	require.Error(t, m.Err())
+	assert.Contains(t, m.View(), "corrupt config")
+	assert.NotNil(t, press(m, "enter"), "any key exits")
+}
+
+func TestNewModel_NoService(t *testing.T) {
+	m := NewModel(nil, nil)
+
+	assert.ErrorContains(t, m.Err(), "settings service not available")
+}
+
+func TestModel_Provider_SwitchingResetsInputs(t *testing.T) {
+	svc := new(MockSettingsService)
+	svc.On("Get").Return(testSettings(), nil)
+	m := NewModel(nil, svc)
+
+	press(m, "up")
+	assert.Equal(t, "nomic-embed-text", m.modelInput.Value())
+	assert.Len(t, m.providerInputs(), 1, "Ollama only needs a model")
+
+	press(m, "down")
+	assert.Equal(t, "sk-saved", m.apiKeyInput.Value(), "saved values return for the saved provider")
+
+	press(m, "down")
+	assert.Len(t, m.providerInputs(), 3, "endpoint providers need a base URL and dimensions")
+	assert.Empty(t, m.baseURLInput.Value())
+}
+
+func TestModel_Provider_MissingAPIKeyShowsError(t *testing.T) {
+	settings := testSettings()
+	settings.Embedding = domain.EmbeddingSettings{}
+	svc := new(MockSettingsService)
+	svc.On("Get").Return(settings, nil)
+	m := NewModel(nil, svc)
+
+	press(m, "up", "up") // from skip to OpenAI
+	press(m, "enter", "enter", "enter")
+
+	assert.Equal(t, StepProvider, m.Step())
+	assert.ErrorContains(t, m.Err(), "API key is required")
+	assert.Contains(t, m.View(), "Error: an API key is required for OpenAI")
+	svc.AssertNotCalled(t, "SetEmbeddingProvider", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestModel_Provider_InvalidEndpointShowsError(t *testing.T) {
+	svc := new(MockSettingsService)
+	svc.On("Get").Return(testSettings(), nil)
+	m := NewModel(nil, svc)
+
+	press(m, "down", "enter")
+	typeText(m, "bge-small")
+	press(m, "enter")
+	typeText(m, "http://localhost:8080/v1")
+	press(m, "enter")
+	typeText(m, "lots")
+	press(m, "enter")
+
+	assert.Equal(t, StepProvider, m.Step())
+	assert.ErrorContains(t, m.Err(), "dimensions must be a positive number")
+	svc.AssertNotCalled(t, "SetEmbeddingProvider", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestModel_Provider_FailedTestEmbeddingRestoresSettings(t *testing.T) {
+	svc := new(MockSettingsService)
+	saved := testSettings()
+	svc.On("Get").Return(saved, nil)
+	svc.On("SetEmbeddingProvider", domain.AIProviderOpenAI, "text-embedding-3-small", "sk-saved-bad").Return(nil)
+	svc.On("ValidateEmbeddingConfig").Return(errors.New("401 unauthorized"))
+	svc.On("Save", mock.AnythingOfType("*domain.AppSettings")).Return(nil)
+	m := NewModel(nil, svc)
+
+	press(m, "enter", "enter")
+	typeText(m, "-bad")
+	runValidation(t, m, press(m, "enter"))
+
+	assert.Equal(t, StepProvider, m.Step())
+	assert.False(t, m.validating)
+	assert.ErrorContains(t, m.Err(), "test embedding failed: 401 unauthorized")
+	restored := svc.Calls[len(svc.Calls)-1].Arguments.Get(0).(*domain.AppSettings)
+	assert.Equal(t, saved.Embedding, restored.Embedding)
+}
+
+func TestModel_Provider_EndpointValidatesAndAdvances(t *testing.T) {
+	svc := new(MockSettingsService)
+	svc.On("Get").Return(testSettings(), nil)
+	svc.On("SetEmbeddingProvider", domain.AIProviderOpenAICompatible, "bge-small", "").Return(nil)
+	svc.On("SetEmbeddingEndpoint", "http://localhost:8080/v1", 384).Return(nil)
+	svc.On("ValidateEmbeddingConfig").Return(nil)
+	m := NewModel(nil, svc)
+
+	press(m, "down", "enter")
+	typeText(m, "bge-small")
+	press(m, "tab")
+	typeText(m, "http://localhost:8080/v1")
+	press(m, "tab")
+	typeText(m, "384")
+	cmd := press(m, "enter")
+	assert.Contains(t, m.View(), "Validating with a test embedding")
+	runValidation(t, m, cmd)
+
+	assert.Equal(t, StepMode, m.Step())
+	assert.NoError(t, m.Err())
+	svc.AssertExpectations(t)
+}
+
+func TestModel_Provider_Skip(t *testing.T) {
+	svc := new(MockSettingsService)
+	svc.On("Get").Return(testSettings(), nil)
+	m := NewModel(nil, svc)
+
+	press(m, "down", "down", "enter")
+
+	assert.Equal(t, StepMode, m.Step())
+	assert.Equal(t, 1, m.selected, "saved mode is selected")
+	svc.AssertNotCalled(t, "SetEmbeddingProvider", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestModel_Mode_RequiresConfiguredProviders(t *testing.T) {
+	settings := testSettings()
+	settings.Embedding = domain.EmbeddingSettings{}
+	svc := new(MockSettingsService)
+	svc.On("Get").Return(settings, nil)
+	svc.On("SetSearchMode", domain.SearchModeTextOnly).Return(nil)
+	m := NewModel(nil, svc)
+	press(m, "enter")
+	require.Equal(t, StepMode, m.Step())
+
+	press(m, "enter")
+	assert.Equal(t, StepMode, m.Step())
+	assert.ErrorContains(t, m.Err(), "needs an embedding provider")
+
+	press(m, "down", "enter")
+	assert.ErrorContains(t, m.Err(), "needs an LLM provider")
+
+	press(m, "up", "up", "enter")
+	assert.Equal(t, StepSchedule, m.Step())
+	assert.NoError(t, m.Err())
+}
+
+func TestModel_Mode_RequiresLLM(t *testing.T) {
+	svc := new(MockSettingsService)
+	svc.On("Get").Return(testSettings(), nil)
+	m := NewModel(nil, svc)
+	m.enterStep(StepMode)
+
+	press(m, "down", "enter")
+
+	assert.Equal(t, StepMode, m.Step())
+	assert.ErrorContains(t, m.Err(), "sercha settings llm")
+}
+
+func TestModel_Schedule(t *testing.T) {
+	svc := &schedulerSettingsService{MockSettingsService: new(MockSettingsService)}
+	svc.scheduler = domain.DefaultSchedulerConfig()
+	task := svc.scheduler.TaskConfigs[domain.TaskIDDocumentSync]
+	task.Interval = time.Hour
+	task.Cron = "0 6 * * *"
+	svc.scheduler.TaskConfigs[domain.TaskIDDocumentSync] = task
+	svc.On("Get").Return(testSettings(), nil)
+	svc.On("SetSchedulerCron", domain.TaskIDDocumentSync, "0 6 * * *x").Return(errors.New("bad field"))
+	svc.On("SetSchedulerCron", domain.TaskIDDocumentSync, "0 6 * * *").Return(nil)
+	m := NewModel(nil, svc)
+	m.enterStep(StepSchedule)
+
+	assert.Equal(t, "0 6 * * *", m.cronInput.Value(), "saved schedule is pre-filled")
+	assert.Contains(t, m.View(), "sync every 1h0m0s")
+
+	typeText(m, "x")
+	press(m, "enter")
+	assert.Equal(t, StepSchedule, m.Step())
+	assert.ErrorContains(t, m.Err(), "invalid cron schedule: bad field")
+
+	press(m, "backspace", "enter")
+	assert.Equal(t, StepTheme, m.Step())
+}
+
+func TestModel_Theme(t *testing.T) {
+	svc := new(MockSettingsService)
+	svc.On("Get").Return(testSettings(), nil)
+	svc.On("Set", "theme", "light").Return(nil)
+	svc.On("Validate").Return(nil)
+	m := NewModel(nil, svc)
+	m.enterStep(StepTheme)
+
+	assert.Equal(t, "gruvbox", m.themes[m.selected], "saved theme is selected")
+
+	press(m, "up", "up", "enter")
+
+	assert.Equal(t, StepDone, m.Step())
+	assert.True(t, m.Completed())
+	assert.Contains(t, m.View(), "All settings are valid and saved.")
+	assert.NotNil(t, press(m, "enter"), "enter exits")
+}
+
+func TestModel_Theme_MissingFileShowsError(t *testing.T) {
+	settings := testSettings()
+	settings.UI.Theme = "/nonexistent/theme.yaml"
+	svc := new(MockSettingsService)
+	svc.On("Get").Return(settings, nil)
+	m := NewModel(nil, svc)
+	m.enterStep(StepTheme)
+
+	assert.Equal(t, "/nonexistent/theme.yaml", m.themes[m.selected], "theme files are kept as a choice")
+	press(m, "enter")
+
+	assert.Equal(t, StepTheme, m.Step())
+	assert.Error(t, m.Err())
+	svc.AssertNotCalled(t, "Set", mock.Anything, mock.Anything)
+}
+
+func TestModel_EscGoesBackThenCancels(t *testing.T) {
+	svc := new(MockSettingsService)
+	svc.On("Get").Return(testSettings(), nil)
+	m := NewModel(nil, svc)
+	m.enterStep(StepSchedule)
+
+	press(m, "esc")
+	assert.Equal(t, StepMode, m.Step())
+	press(m, "esc")
+	assert.Equal(t, StepProvider, m.Step())
+
+	cmd := press(m, "esc")
+	require.NotNil(t, cmd)
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+	assert.False(t, m.Completed())
+}
@@ -8,6 +8,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -27,6 +28,7 @@ const (
 // View is the source detail view.
 type View struct {
 	styles           *styles.Styles
+	keys             *keymap.KeyMap
 	sourceService    driving.SourceService
 	syncOrchestrator driving.SyncOrchestrator
 	documentService  driving.DocumentService
@@ -40,6 +42,9 @@ type View struct {
 	err      error
 	syncing  bool
 	deleting bool
+
+	// syncFailed is set when the last sync failed and can be retried.
+	syncFailed bool
 }
 
 // NewView creates a new source detail view.
@@ -51,6 +56,7 @@ func NewView(
 ) *View {
 	return &View{
 		styles:           s,
+		keys:             keymap.DefaultKeyMap(),
 		sourceService:    sourceService,
 		syncOrchestrator: syncOrchestrator,
 		documentService:  documentService,
@@ -63,10 +69,16 @@ func (v *View) SetSource(source domain.Source) {
 	v.source = &source
 	v.err = nil
 	v.syncing = false
+	v.syncFailed = false
 	v.deleting = false
 	v.selected = OptionViewDocuments
 }
 
+// SetKeyMap sets the keys the view responds to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	v.keys = km
+}
+
 // Init initialises the view.
 func (v *View) Init() tea.Cmd {
 	return v.loadDocCount()
@@ -112,6 +124,18 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 		}
 		return v, nil
 
+	case messages.SyncCompleted:
+		if v.source == nil || msg.SourceID != v.source.ID {
+			return v, nil
+		}
+		v.syncing = false
+		if msg.Err != nil {
+			v.err = msg.Err
+			v.syncFailed = true
+			return v, nil
+		}
+		return v, v.loadDocCount()
+
 	case messages.ErrorOccurred:
 		v.err = msg.Err
 		v.syncing = false
@@ -123,18 +147,22 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 
 // handleKeyMsg handles key presses.
 func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
-	switch msg.String() {
-	case "up", "k":
+	switch key := msg.String(); {
+	case keymap.Matches(key, v.keys.Up):
 		if v.selected > OptionViewDocuments {
 			v.selected--
 		}
-	case "down", "j":
+	case keymap.Matches(key, v.keys.Down):
 		if v.selected < OptionBack {
 			v.selected++
 		}
-	case "enter":
+	case keymap.Matches(key, v.keys.Select):
 		return v.handleSelect()
-	case "esc":
+	case key == "r":
+		if v.syncFailed && !v.syncing {
+			return v, v.syncSource()
+		}
+	case key == "esc":
 		return v, func() tea.Msg {
 			return messages.ViewChanged{View: messages.ViewSources}
 		}
@@ -166,20 +194,23 @@ func (v *View) handleSelect() (*View, tea.Cmd) {
 	return v, nil
 }
 
-// syncSource returns a command that syncs the source.
+// syncSource marks the source as syncing and returns a command that syncs it.
+// The orchestrator resumes from the source's saved cursor, so retrying a
+// failed sync continues from its last checkpoint instead of starting over.
 func (v *View) syncSource() tea.Cmd {
-	return func() tea.Msg {
-		if v.source == nil || v.syncOrchestrator == nil {
+	if v.source == nil || v.syncOrchestrator == nil {
+		return func() tea.Msg {
 			return messages.ErrorOccurred{Err: fmt.Errorf("sync not available")}
 		}
+	}
 
-		v.syncing = true
-		err := v.syncOrchestrator.Sync(context.Background(), v.source.ID)
-		if err != nil {
-			return messages.ErrorOccurred{Err: err}
-		}
-		v.syncing = false
-		return nil
+	v.syncing = true
+	v.syncFailed = false
+	v.err = nil
+	sourceID := v.source.ID
+	return func() tea.Msg {
+		err := v.syncOrchestrator.Sync(context.Background(), sourceID)
+		return messages.SyncCompleted{SourceID: sourceID, Err: err}
 	}
 }
 
@@ -224,7 +255,12 @@ func (v *View) View() string {
 	// Error state
 	if v.err != nil {
 		b.WriteString(v.styles.Error.Render(fmt.Sprintf("Error: %s", v.err.Error())))
-		b.WriteString("\n\n")
+		b.WriteString("\n")
+		if v.syncFailed {
+			b.WriteString(v.styles.Muted.Render("Press [r] to retry from the last checkpoint"))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
 	}
 
 	// Status
@@ -271,9 +307,23 @@ func (v *View) View() string {
 
 // renderHelp renders the help footer.
 func (v *View) renderHelp() string {
+	if v.syncFailed {
+		return v.styles.Help.Render("[↑/↓] navigate  [enter] select  [r] retry sync  [esc] back")
+	}
 	return v.styles.Help.Render("[↑/↓] navigate  [enter] select  [esc] back")
 }
 
+// KeyBindings returns the keys the source detail view responds to.
+func (v *View) KeyBindings() []keymap.KeyBinding {
+	return []keymap.KeyBinding{
+		{Group: "Navigation", Keys: v.keys.Up.Help().Key, Description: "move up"},
+		{Group: "Navigation", Keys: v.keys.Down.Help().Key, Description: "move down"},
+		{Group: "Navigation", Keys: "esc", Description: "back to sources"},
+		{Group: "Actions", Keys: v.keys.Select.Help().Key, Description: "run the selected option"},
+		{Group: "Actions", Keys: "r", Description: "retry a failed sync from the last checkpoint"},
+	}
+}
+
 // SetDimensions sets the view dimensions.
 func (v *View) SetDimensions(width, height int) {
 	v.width = width
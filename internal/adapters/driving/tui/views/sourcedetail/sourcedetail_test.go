@@ -47,6 +47,14 @@ func (m *MockDocumentService) Refresh(ctx context.Context, documentID string) er
 	return nil
 }
 
+func (m *MockDocumentService) ExcludeMultiple(ctx context.Context, documentIDs []string) error {
+	return nil
+}
+
+func (m *MockDocumentService) RefreshMultiple(ctx context.Context, documentIDs []string) error {
+	return nil
+}
+
 func (m *MockDocumentService) Open(ctx context.Context, documentID string) error {
 	return nil
 }
@@ -79,6 +87,10 @@ func (m *MockSourceService) Update(ctx context.Context, source domain.Source) er
 	return nil
 }
 
+func (m *MockSourceService) Rename(ctx context.Context, id, newName string) error {
+	return nil
+}
+
 func (m *MockSourceService) ValidateConfig(ctx context.Context, connectorType string, config map[string]string) error {
 	return nil
 }
@@ -99,6 +111,12 @@ func (m *MockSyncOrchestrator) SyncAll(ctx context.Context) error {
 	return nil
 }
 
+func (m *MockSyncOrchestrator) SetParallelWithinSource(_ bool) {}
+
+func (m *MockSyncOrchestrator) DryRun(_ context.Context, sourceID string) (*domain.SyncPreview, error) {
+	return &domain.SyncPreview{SourceID: sourceID}, nil
+}
+
 func (m *MockSyncOrchestrator) Status(ctx context.Context, sourceID string) (*driving.SyncStatus, error) {
 	return nil, nil
 }
@@ -220,11 +238,80 @@ func TestView_Update_KeyMsg_SelectSyncNow(t *testing.T) {
 	_, cmd := view.Update(msg)
 
 	require.NotNil(t, cmd)
-	// Execute command - syncing is set and then cleared within the command
-	cmd()
+	assert.True(t, view.syncing)
+	result := cmd()
 	assert.True(t, syncCalled)
-	// syncing is false after cmd completes (set to false in syncSource())
+	assert.Equal(t, messages.SyncCompleted{SourceID: "src-1"}, result)
+
+	// syncing is cleared once the completion is delivered
+	view.Update(result)
 	assert.False(t, view.syncing)
+	assert.False(t, view.syncFailed)
+}
+
+func TestView_SyncFailed_RetryReRunsSync(t *testing.T) {
+	var calls []string
+	syncErr := errors.New("connection reset")
+	syncMock := &MockSyncOrchestrator{
+		SyncFunc: func(ctx context.Context, sourceID string) error {
+			calls = append(calls, sourceID)
+			if len(calls) == 1 {
+				return syncErr
+			}
+			return nil
+		},
+	}
+	view := NewView(styles.DefaultStyles(), nil, syncMock, nil)
+	view.SetSource(domain.Source{ID: "src-1", Name: "Test"})
+	view.SetDimensions(80, 24)
+	view.selected = OptionSyncNow
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	view.Update(cmd())
+
+	assert.False(t, view.syncing)
+	assert.True(t, view.syncFailed)
+	assert.ErrorIs(t, view.Err(), syncErr)
+	assert.Contains(t, view.View(), "Press [r] to retry from the last checkpoint")
+
+	// Retry re-invokes Sync for the same source
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	require.NotNil(t, cmd)
+	assert.True(t, view.syncing)
+	assert.Nil(t, view.Err())
+	view.Update(cmd())
+
+	assert.Equal(t, []string{"src-1", "src-1"}, calls)
+	assert.False(t, view.syncFailed)
+	assert.NotContains(t, view.View(), "retry")
+}
+
+func TestView_RetryKey_IgnoredWithoutFailedSync(t *testing.T) {
+	syncMock := &MockSyncOrchestrator{
+		SyncFunc: func(ctx context.Context, sourceID string) error {
+			t.Fatal("sync should not run")
+			return nil
+		},
+	}
+	view := NewView(nil, nil, syncMock, nil)
+	view.source = &domain.Source{ID: "src-1"}
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+
+	assert.Nil(t, cmd)
+}
+
+func TestView_Update_SyncCompleted_OtherSource(t *testing.T) {
+	view := NewView(nil, nil, nil, nil)
+	view.source = &domain.Source{ID: "src-1"}
+	view.syncing = true
+
+	view.Update(messages.SyncCompleted{SourceID: "src-2", Err: errors.New("failed")})
+
+	assert.True(t, view.syncing)
+	assert.False(t, view.syncFailed)
+	assert.Nil(t, view.err)
 }
 
 func TestView_Update_KeyMsg_SelectDeleteSource(t *testing.T) {
@@ -6,8 +6,11 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -17,17 +20,26 @@ import (
 // View is the sources management view.
 type View struct {
 	styles             *styles.Styles
+	keys               *keymap.KeyMap
 	sourceService      driving.SourceService
 	credentialsService driving.CredentialsService
+	healthService      driving.SourceHealthService
 
 	sources            []domain.Source
-	accountIdentifiers map[string]string // sourceID -> accountIdentifier
+	accountIdentifiers map[string]string               // sourceID -> accountIdentifier
+	health             map[string]*domain.SourceHealth // sourceID -> last check result
+	checking           bool
 	selected           int
 	width              int
 	height             int
 	ready              bool
 	err                error
 	loading            bool
+
+	// renaming is set while the name input is shown for the selected source.
+	renaming    bool
+	renameInput textinput.Model
+	renameErr   error
 }
 
 // NewView creates a new sources view.
@@ -36,24 +48,50 @@ func NewView(
 	sourceService driving.SourceService,
 	credentialsService driving.CredentialsService,
 ) *View {
+	renameInput := textinput.New()
+	renameInput.Placeholder = "Source name"
+	renameInput.CharLimit = domain.MaxSourceNameLength
+
 	return &View{
 		styles:             s,
+		keys:               keymap.DefaultKeyMap(),
 		sourceService:      sourceService,
 		credentialsService: credentialsService,
 		sources:            []domain.Source{},
 		accountIdentifiers: make(map[string]string),
+		health:             make(map[string]*domain.SourceHealth),
+		renameInput:        renameInput,
 	}
 }
 
+// SetHealthService sets the service used to show and run source health checks.
+// If unset, no health badges are shown.
+func (v *View) SetHealthService(healthService driving.SourceHealthService) {
+	v.healthService = healthService
+}
+
+// SetKeyMap sets the keys the view responds to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	v.keys = km
+}
+
 // Init initialises the view and loads sources.
 func (v *View) Init() tea.Cmd {
 	return v.loadSources()
 }
 
-// sourcesLoadedMsg extends messages.SourcesLoaded with account identifiers.
+// sourcesLoadedMsg extends messages.SourcesLoaded with account identifiers
+// and the last health check result for each source.
 type sourcesLoadedMsg struct {
 	messages.SourcesLoaded
 	AccountIdentifiers map[string]string
+	Health             map[string]*domain.SourceHealth
+}
+
+// healthCheckedMsg carries the results of validating all sources.
+type healthCheckedMsg struct {
+	Results []domain.SourceHealth
+	Err     error
 }
 
 // loadSources returns a command that loads sources from the service.
@@ -81,10 +119,36 @@ func (v *View) loadSources() tea.Cmd {
 		return sourcesLoadedMsg{
 			SourcesLoaded:      messages.SourcesLoaded{Sources: sources, Err: nil},
 			AccountIdentifiers: accountIDs,
+			Health:             v.fetchHealth(ctx, sources),
 		}
 	}
 }
 
+// fetchHealth retrieves the last health check result for each source.
+func (v *View) fetchHealth(ctx context.Context, sources []domain.Source) map[string]*domain.SourceHealth {
+	health := make(map[string]*domain.SourceHealth)
+	if v.healthService == nil {
+		return health
+	}
+
+	for i := range sources {
+		result, err := v.healthService.Get(ctx, sources[i].ID)
+		if err != nil || result == nil {
+			continue
+		}
+		health[sources[i].ID] = result
+	}
+	return health
+}
+
+// checkHealth returns a command that validates all sources.
+func (v *View) checkHealth() tea.Cmd {
+	return func() tea.Msg {
+		results, err := v.healthService.CheckAll(context.Background())
+		return healthCheckedMsg{Results: results, Err: err}
+	}
+}
+
 // fetchAccountIdentifiers retrieves account identifiers for sources with credentials.
 func (v *View) fetchAccountIdentifiers(ctx context.Context, sources []domain.Source) map[string]string {
 	accountIDs := make(map[string]string)
@@ -127,10 +191,25 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 		} else {
 			v.sources = msg.Sources
 			v.accountIdentifiers = msg.AccountIdentifiers
+			if msg.Health != nil {
+				v.health = msg.Health
+			}
 			v.err = nil
 		}
 		return v, nil
 
+	case healthCheckedMsg:
+		v.checking = false
+		if msg.Err != nil {
+			v.err = msg.Err
+			return v, nil
+		}
+		for i := range msg.Results {
+			result := msg.Results[i]
+			v.health[result.SourceID] = &result
+		}
+		return v, nil
+
 	case messages.SourcesLoaded:
 		// Also handle the base type for backward compatibility
 		v.loading = false
@@ -151,6 +230,15 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 			return v, cmd
 		}
 		return v, nil
+
+	case messages.SourceRenamed:
+		if msg.Err != nil {
+			// Keep the input open so the name can be corrected
+			v.renameErr = msg.Err
+			return v, nil
+		}
+		v.stopRename()
+		return v, v.loadSources()
 	}
 
 	return v, nil
@@ -158,16 +246,20 @@ func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
 
 // handleKeyMsg handles key presses.
 func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
-	switch msg.String() {
-	case "up", "k":
+	if v.renaming {
+		return v.handleRenameKeys(msg)
+	}
+
+	switch key := msg.String(); {
+	case keymap.Matches(key, v.keys.Up):
 		if v.selected > 0 {
 			v.selected--
 		}
-	case "down", "j":
+	case keymap.Matches(key, v.keys.Down):
 		if v.selected < len(v.sources)-1 {
 			v.selected++
 		}
-	case "enter":
+	case keymap.Matches(key, v.keys.Select):
 		// Navigate to source detail
 		if len(v.sources) > 0 && v.selected < len(v.sources) {
 			source := v.sources[v.selected]
@@ -175,18 +267,29 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 				return messages.SourceSelected{Source: source}
 			}
 		}
-	case "a":
+	case key == "a":
 		// Add new source
 		return v, func() tea.Msg {
 			return messages.ViewChanged{View: messages.ViewAddSource}
 		}
-	case "d", "delete", "backspace":
+	case key == "d", key == "delete", key == "backspace":
 		// Delete selected source
 		if len(v.sources) > 0 && v.selected < len(v.sources) {
 			cmd := v.deleteSource(v.sources[v.selected].ID)
 			return v, cmd
 		}
-	case "r":
+	case key == "n":
+		// Rename selected source
+		if len(v.sources) > 0 && v.selected < len(v.sources) {
+			return v, v.startRename(&v.sources[v.selected])
+		}
+	case key == "c":
+		// Validate all sources without syncing
+		if v.healthService != nil && !v.checking {
+			v.checking = true
+			return v, v.checkHealth()
+		}
+	case key == "r":
 		// Reload sources
 		v.loading = true
 		cmd := v.loadSources()
@@ -196,6 +299,55 @@ func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
 	return v, nil
 }
 
+// handleRenameKeys handles key presses while the name input is shown.
+func (v *View) handleRenameKeys(msg tea.KeyMsg) (*View, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		v.stopRename()
+		return v, nil
+	case tea.KeyEnter:
+		name := v.renameInput.Value()
+		if err := domain.ValidateSourceName(name); err != nil {
+			v.renameErr = err
+			return v, nil
+		}
+		return v, v.renameSource(v.sources[v.selected].ID, name)
+	}
+
+	var cmd tea.Cmd
+	v.renameInput, cmd = v.renameInput.Update(msg)
+	return v, cmd
+}
+
+// startRename shows the name input pre-filled with the source's current name.
+func (v *View) startRename(source *domain.Source) tea.Cmd {
+	v.renaming = true
+	v.renameErr = nil
+	v.renameInput.SetValue(source.Name)
+	v.renameInput.CursorEnd()
+	return v.renameInput.Focus()
+}
+
+// stopRename hides the name input.
+func (v *View) stopRename() {
+	v.renaming = false
+	v.renameErr = nil
+	v.renameInput.SetValue("")
+	v.renameInput.Blur()
+}
+
+// renameSource returns a command that renames a source.
+func (v *View) renameSource(id, name string) tea.Cmd {
+	return func() tea.Msg {
+		if v.sourceService == nil {
+			return messages.SourceRenamed{ID: id, Name: name, Err: fmt.Errorf("source service not available")}
+		}
+
+		err := v.sourceService.Rename(context.Background(), id, name)
+		return messages.SourceRenamed{ID: id, Name: name, Err: err}
+	}
+}
+
 // deleteSource returns a command that deletes a source.
 func (v *View) deleteSource(id string) tea.Cmd {
 	return func() tea.Msg {
@@ -240,6 +392,11 @@ func (v *View) View() string {
 		return b.String()
 	}
 
+	if v.checking {
+		b.WriteString(v.styles.Muted.Render("Checking sources..."))
+		b.WriteString("\n\n")
+	}
+
 	// Sources list
 	for i := range v.sources {
 		line := v.renderSource(i, &v.sources[i])
@@ -248,11 +405,33 @@ func (v *View) View() string {
 	}
 
 	b.WriteString("\n")
+	if v.renaming {
+		b.WriteString(v.renderRename())
+		return b.String()
+	}
 	b.WriteString(v.renderHelp())
 
 	return b.String()
 }
 
+// renderRename renders the name input for the selected source.
+func (v *View) renderRename() string {
+	var b strings.Builder
+
+	b.WriteString(v.styles.Normal.Render("New name:"))
+	b.WriteString("\n")
+	b.WriteString(v.renameInput.View())
+	b.WriteString("\n")
+	if v.renameErr != nil {
+		b.WriteString(v.styles.Error.Render(fmt.Sprintf("Error: %s", v.renameErr.Error())))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(v.styles.Help.Render("[enter] save  [esc] cancel"))
+
+	return b.String()
+}
+
 // renderSource renders a single source line.
 func (v *View) renderSource(index int, source *domain.Source) string {
 	indicator := "  "
@@ -281,11 +460,14 @@ func (v *View) renderSource(index int, source *domain.Source) string {
 		name = name[:maxNameLen-3] + "..."
 	}
 
+	badge, badgeStyle := v.healthBadge(source.ID)
+
 	var line string
 	if index == v.selected {
-		line = v.styles.Selected.Render(fmt.Sprintf("%s%-10s %s", indicator, typeStr, name))
+		line = v.styles.Selected.Render(fmt.Sprintf("%s%s%-10s %s", indicator, badge, typeStr, name))
 	} else {
 		line = v.styles.Normal.Render(indicator) +
+			badgeStyle.Render(badge) +
 			v.styles.Subtitle.Render(fmt.Sprintf("%-10s ", typeStr)) +
 			v.styles.Normal.Render(name)
 	}
@@ -293,9 +475,59 @@ func (v *View) renderSource(index int, source *domain.Source) string {
 	return line
 }
 
+// healthBadge returns the health indicator for a source and its style.
+// Returns an empty badge when health checks are unavailable.
+func (v *View) healthBadge(sourceID string) (string, lipgloss.Style) {
+	if v.healthService == nil {
+		return "", v.styles.Normal
+	}
+
+	switch v.health[sourceID].Status() {
+	case domain.HealthOK:
+		return "● ", v.styles.Success
+	case domain.HealthWarning:
+		return "▲ ", v.styles.Warning
+	case domain.HealthFailed:
+		return "✗ ", v.styles.Error
+	default:
+		return "○ ", v.styles.Muted
+	}
+}
+
 // renderHelp renders the help footer.
 func (v *View) renderHelp() string {
-	return v.styles.Help.Render("[a] add  [enter] details  [d] delete  [r] reload  [esc] back  [q] quit")
+	if v.healthService != nil {
+		return v.styles.Help.Render(
+			"[a] add  [enter] details  [n] rename  [d] delete  [c] check  [r] reload  [esc] back  [q] quit")
+	}
+	return v.styles.Help.Render("[a] add  [enter] details  [n] rename  [d] delete  [r] reload  [esc] back  [q] quit")
+}
+
+// Health returns the last health check result for a source, or nil if unknown.
+func (v *View) Health(sourceID string) *domain.SourceHealth {
+	return v.health[sourceID]
+}
+
+// KeyBindings returns the keys the sources view responds to.
+func (v *View) KeyBindings() []keymap.KeyBinding {
+	bindings := []keymap.KeyBinding{
+		{Group: "Navigation", Keys: v.keys.Up.Help().Key, Description: "move up"},
+		{Group: "Navigation", Keys: v.keys.Down.Help().Key, Description: "move down"},
+		{Group: "Navigation", Keys: "esc", Description: "back to menu"},
+		{Group: "Actions", Keys: v.keys.Select.Help().Key, Description: "show source details"},
+		{Group: "Actions", Keys: "a", Description: "add a source"},
+		{Group: "Actions", Keys: "n", Description: "rename the selected source"},
+		{Group: "Actions", Keys: "d/delete", Description: "delete the selected source"},
+	}
+	if v.healthService != nil {
+		bindings = append(bindings,
+			keymap.KeyBinding{Group: "Actions", Keys: "c", Description: "check credentials of all sources"})
+	}
+	return append(bindings,
+		keymap.KeyBinding{Group: "Actions", Keys: "r", Description: "reload sources"},
+		keymap.KeyBinding{Group: "Rename", Keys: "enter", Description: "save the new name"},
+		keymap.KeyBinding{Group: "Rename", Keys: "esc", Description: "cancel renaming"},
+	)
 }
 
 // SetDimensions sets the view dimensions.
@@ -315,6 +547,11 @@ func (v *View) SelectedIndex() int {
 	return v.selected
 }
 
+// Renaming returns true while the name input is shown.
+func (v *View) Renaming() bool {
+	return v.renaming
+}
+
 // Err returns the last error.
 func (v *View) Err() error {
 	return v.err
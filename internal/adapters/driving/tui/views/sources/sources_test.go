@@ -18,6 +18,7 @@ import (
 type MockSourceService struct {
 	ListFunc   func(ctx context.Context) ([]domain.Source, error)
 	RemoveFunc func(ctx context.Context, id string) error
+	RenameFunc func(ctx context.Context, id, newName string) error
 }
 
 func (m *MockSourceService) Add(ctx context.Context, source domain.Source) error {
@@ -46,6 +47,13 @@ func (m *MockSourceService) Update(ctx context.Context, source domain.Source) er
 	return nil
 }
 
+func (m *MockSourceService) Rename(ctx context.Context, id, newName string) error {
+	if m.RenameFunc != nil {
+		return m.RenameFunc(ctx, id, newName)
+	}
+	return nil
+}
+
 func (m *MockSourceService) ValidateConfig(ctx context.Context, connectorType string, config map[string]string) error {
 	return nil
 }
@@ -439,3 +447,208 @@ func TestView_DeleteSource_NilService(t *testing.T) {
 	require.True(t, ok)
 	assert.Error(t, removed.Err)
 }
+
+// MockSourceHealthService implements driving.SourceHealthService for testing.
+type MockSourceHealthService struct {
+	results       map[string]*domain.SourceHealth
+	checkAllFunc  func(ctx context.Context) ([]domain.SourceHealth, error)
+	checkAllCalls int
+}
+
+func (m *MockSourceHealthService) Check(_ context.Context, sourceID string) (*domain.SourceHealth, error) {
+	return m.results[sourceID], nil
+}
+
+func (m *MockSourceHealthService) CheckAll(ctx context.Context) ([]domain.SourceHealth, error) {
+	m.checkAllCalls++
+	if m.checkAllFunc != nil {
+		return m.checkAllFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockSourceHealthService) Get(_ context.Context, sourceID string) (*domain.SourceHealth, error) {
+	return m.results[sourceID], nil
+}
+
+func TestView_Init_LoadsLastHealthResults(t *testing.T) {
+	mock := &MockSourceService{
+		ListFunc: func(ctx context.Context) ([]domain.Source, error) {
+			return []domain.Source{{ID: "src-1"}, {ID: "src-2"}}, nil
+		},
+	}
+	health := &MockSourceHealthService{results: map[string]*domain.SourceHealth{
+		"src-1": {SourceID: "src-1", Error: "unauthorized"},
+	}}
+	view := NewView(nil, mock, nil)
+	view.SetHealthService(health)
+
+	loaded, ok := view.Init()().(sourcesLoadedMsg)
+	require.True(t, ok)
+	view, _ = view.Update(loaded)
+
+	assert.Equal(t, domain.HealthFailed, view.Health("src-1").Status())
+	assert.Equal(t, domain.HealthUnknown, view.Health("src-2").Status())
+}
+
+func TestView_Update_KeyMsg_CheckHealth(t *testing.T) {
+	health := &MockSourceHealthService{
+		checkAllFunc: func(ctx context.Context) ([]domain.SourceHealth, error) {
+			return []domain.SourceHealth{
+				{SourceID: "src-1"},
+				{SourceID: "src-2", Warning: "OAuth token expires in 2h0m0s"},
+			}, nil
+		},
+	}
+	view := NewView(nil, nil, nil)
+	view.SetHealthService(health)
+
+	view, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	require.NotNil(t, cmd)
+	assert.True(t, view.checking)
+
+	// A second press while checking does not start another check
+	_, again := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	assert.Nil(t, again)
+
+	view, _ = view.Update(cmd())
+
+	assert.False(t, view.checking)
+	assert.Equal(t, 1, health.checkAllCalls)
+	assert.Equal(t, domain.HealthOK, view.Health("src-1").Status())
+	assert.Equal(t, domain.HealthWarning, view.Health("src-2").Status())
+}
+
+func TestView_Update_KeyMsg_CheckHealth_NoService(t *testing.T) {
+	view := NewView(nil, nil, nil)
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+
+	assert.Nil(t, cmd)
+}
+
+func TestView_Update_HealthChecked_Error(t *testing.T) {
+	view := NewView(nil, nil, nil)
+	view.checking = true
+
+	view, _ = view.Update(healthCheckedMsg{Err: errors.New("list failed")})
+
+	assert.False(t, view.checking)
+	assert.EqualError(t, view.Err(), "list failed")
+}
+
+func TestView_View_HealthBadges(t *testing.T) {
+	s := styles.DefaultStyles()
+	view := NewView(s, nil, nil)
+	view.SetHealthService(&MockSourceHealthService{})
+	view.width = 80
+	view.sources = []domain.Source{
+		{ID: "src-1", Name: "Healthy", Type: "filesystem"},
+		{ID: "src-2", Name: "Expiring", Type: "gmail"},
+		{ID: "src-3", Name: "Broken", Type: "notion"},
+		{ID: "src-4", Name: "Unchecked", Type: "github"},
+	}
+	view.health = map[string]*domain.SourceHealth{
+		"src-1": {SourceID: "src-1"},
+		"src-2": {SourceID: "src-2", Warning: "expiring"},
+		"src-3": {SourceID: "src-3", Error: "unauthorized"},
+	}
+
+	output := view.View()
+
+	assert.Contains(t, output, "● [filesystem]")
+	assert.Contains(t, output, "▲ ")
+	assert.Contains(t, output, "✗ ")
+	assert.Contains(t, output, "○ ")
+	assert.Contains(t, output, "[c] check")
+}
+
+func TestView_View_NoHealthBadgesWithoutService(t *testing.T) {
+	s := styles.DefaultStyles()
+	view := NewView(s, nil, nil)
+	view.width = 80
+	view.sources = []domain.Source{{ID: "src-1", Name: "Docs", Type: "filesystem"}}
+
+	output := view.View()
+
+	assert.NotContains(t, output, "○")
+	assert.NotContains(t, output, "[c] check")
+}
+
+func TestView_Update_KeyMsg_Rename(t *testing.T) {
+	var renamedID, renamedTo string
+	mock := &MockSourceService{
+		RenameFunc: func(ctx context.Context, id, newName string) error {
+			renamedID, renamedTo = id, newName
+			return nil
+		},
+	}
+	view := NewView(styles.DefaultStyles(), mock, nil)
+	view.sources = []domain.Source{{ID: "src-1", Name: "Docs"}, {ID: "src-2", Name: "Notes"}}
+	view.selected = 1
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+
+	require.True(t, view.Renaming())
+	assert.Equal(t, "Notes", view.renameInput.Value())
+	assert.Contains(t, view.View(), "New name:")
+
+	// Typed keys edit the name rather than triggering actions
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	assert.Equal(t, "Notesd", view.renameInput.Value())
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	msg := cmd()
+	assert.Equal(t, "src-2", renamedID)
+	assert.Equal(t, "Notesd", renamedTo)
+
+	_, cmd = view.Update(msg)
+	assert.False(t, view.Renaming())
+	assert.NotNil(t, cmd) // Should trigger reload
+}
+
+func TestView_Update_KeyMsg_Rename_Cancel(t *testing.T) {
+	view := NewView(styles.DefaultStyles(), &MockSourceService{}, nil)
+	view.sources = []domain.Source{{ID: "src-1", Name: "Docs"}}
+
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	require.True(t, view.Renaming())
+
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	assert.Nil(t, cmd)
+	assert.False(t, view.Renaming())
+}
+
+func TestView_Update_KeyMsg_Rename_Invalid(t *testing.T) {
+	mock := &MockSourceService{
+		RenameFunc: func(ctx context.Context, id, newName string) error {
+			t.Fatal("Rename should not be called with an invalid name")
+			return nil
+		},
+	}
+	view := NewView(styles.DefaultStyles(), mock, nil)
+	view.sources = []domain.Source{{ID: "src-1", Name: "Docs"}}
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+
+	view.renameInput.SetValue("   ")
+	_, cmd := view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	assert.Nil(t, cmd)
+	assert.True(t, view.Renaming())
+	assert.ErrorIs(t, view.renameErr, domain.ErrInvalidInput)
+	assert.Contains(t, view.View(), "cannot be empty")
+}
+
+func TestView_Update_SourceRenamed_Error(t *testing.T) {
+	view := NewView(styles.DefaultStyles(), &MockSourceService{}, nil)
+	view.sources = []domain.Source{{ID: "src-1", Name: "Docs"}}
+	view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+
+	_, cmd := view.Update(messages.SourceRenamed{ID: "src-1", Err: errors.New("save failed")})
+
+	assert.Nil(t, cmd)
+	assert.True(t, view.Renaming())
+	assert.Contains(t, view.View(), "save failed")
+}
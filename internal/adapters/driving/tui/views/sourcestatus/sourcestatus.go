@@ -0,0 +1,524 @@
+// Package sourcestatus provides the source health dashboard for the TUI.
+package sourcestatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/keymap"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/styles"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// RefreshInterval is how often the dashboard reloads while it is shown.
+const RefreshInterval = 30 * time.Second
+
+// State is the status shown for a source.
+type State int
+
+const (
+	// StateNotSynced means the source has not synced yet.
+	StateNotSynced State = iota
+	// StateOK means the last sync succeeded.
+	StateOK
+	// StateSyncing means a sync is running.
+	StateSyncing
+	// StateError means the last sync failed.
+	StateError
+	// StateAuthFailed means the source's credentials were rejected, by the
+	// last sync or by a later credentials check.
+	StateAuthFailed
+)
+
+// String returns the label shown in the status column.
+func (s State) String() string {
+	switch s {
+	case StateOK:
+		return "OK"
+	case StateSyncing:
+		return "Syncing"
+	case StateError:
+		return "Error"
+	case StateAuthFailed:
+		return "Auth Failed"
+	default:
+		return "Not synced"
+	}
+}
+
+// Row is one source's line in the dashboard.
+type Row struct {
+	Source domain.Source
+	// Status is the source's sync status. Nil if it could not be read.
+	Status *driving.SyncStatus
+	// Health is the last credentials check. Nil if the source was never checked.
+	Health *domain.SourceHealth
+}
+
+// State derives the status shown for the row.
+func (r *Row) State() State {
+	status := r.Status
+	if status == nil {
+		status = &driving.SyncStatus{}
+	}
+
+	switch {
+	case status.Running:
+		return StateSyncing
+	case r.Health.Status() == domain.HealthFailed && r.Health.CheckedAt.After(status.LastSyncAt):
+		return StateAuthFailed
+	case isAuthError(status.LastError):
+		return StateAuthFailed
+	case status.LastError != nil:
+		return StateError
+	case !status.LastSyncAt.IsZero():
+		return StateOK
+	default:
+		return StateNotSynced
+	}
+}
+
+// isAuthError reports whether err means the source's credentials were rejected.
+func isAuthError(err error) bool {
+	return errors.Is(err, domain.ErrAuthRequired) ||
+		errors.Is(err, domain.ErrAuthExpired) ||
+		errors.Is(err, domain.ErrAuthInvalid) ||
+		errors.Is(err, domain.ErrTokenRefreshFailed)
+}
+
+// View is the source health dashboard. It lists every source with its last
+// sync, status and document count, refreshing every RefreshInterval.
+type View struct {
+	styles           *styles.Styles
+	keys             *keymap.KeyMap
+	sourceService    driving.SourceService
+	syncOrchestrator driving.SyncOrchestrator
+	healthService    driving.SourceHealthService
+
+	rows     []Row
+	selected int
+	width    int
+	height   int
+	ready    bool
+	err      error
+	loading  bool
+
+	// syncing and checking hold the sources with a sync or credentials
+	// check started from this view.
+	syncing  map[string]bool
+	checking map[string]bool
+
+	// generation identifies the current refresh ticker. Showing the view
+	// starts a new ticker, and ticks from earlier ones are dropped.
+	generation int
+}
+
+// NewView creates a new source status view.
+func NewView(
+	s *styles.Styles,
+	sourceService driving.SourceService,
+	syncOrchestrator driving.SyncOrchestrator,
+) *View {
+	if s == nil {
+		s = styles.DefaultStyles()
+	}
+	return &View{
+		styles:           s,
+		keys:             keymap.DefaultKeyMap(),
+		sourceService:    sourceService,
+		syncOrchestrator: syncOrchestrator,
+		syncing:          make(map[string]bool),
+		checking:         make(map[string]bool),
+	}
+}
+
+// SetHealthService sets the service used to check credentials.
+// If unset, credentials checks are unavailable.
+func (v *View) SetHealthService(healthService driving.SourceHealthService) {
+	v.healthService = healthService
+}
+
+// statusLoadedMsg carries the dashboard rows.
+type statusLoadedMsg struct {
+	Rows []Row
+	Err  error
+}
+
+// refreshTickMsg asks for the dashboard to be reloaded.
+type refreshTickMsg struct {
+	generation int
+}
+
+// healthCheckedMsg carries the result of checking one source's credentials.
+type healthCheckedMsg struct {
+	SourceID string
+	Health   *domain.SourceHealth
+	Err      error
+}
+
+// SetKeyMap sets the keys the view responds to.
+func (v *View) SetKeyMap(km *keymap.KeyMap) {
+	v.keys = km
+}
+
+// Init loads the dashboard and starts the refresh ticker.
+func (v *View) Init() tea.Cmd {
+	v.generation++
+	v.loading = len(v.rows) == 0
+	return tea.Batch(v.loadStatus(), v.tick())
+}
+
+// tick returns a command that requests a refresh after RefreshInterval.
+// The ticker stops once the view is left, as the app only forwards ticks
+// to the view being shown.
+func (v *View) tick() tea.Cmd {
+	generation := v.generation
+	return tea.Tick(RefreshInterval, func(time.Time) tea.Msg {
+		return refreshTickMsg{generation: generation}
+	})
+}
+
+// loadStatus returns a command that reads the status of every source.
+func (v *View) loadStatus() tea.Cmd {
+	return func() tea.Msg {
+		if v.sourceService == nil {
+			return statusLoadedMsg{Err: fmt.Errorf("source service not available")}
+		}
+
+		ctx := context.Background()
+		sources, err := v.sourceService.List(ctx)
+		if err != nil {
+			return statusLoadedMsg{Err: err}
+		}
+
+		rows := make([]Row, len(sources))
+		for i := range sources {
+			rows[i] = Row{Source: sources[i]}
+			if v.syncOrchestrator != nil {
+				if status, err := v.syncOrchestrator.Status(ctx, sources[i].ID); err == nil {
+					rows[i].Status = status
+				}
+			}
+			if v.healthService != nil {
+				if health, err := v.healthService.Get(ctx, sources[i].ID); err == nil {
+					rows[i].Health = health
+				}
+			}
+		}
+		return statusLoadedMsg{Rows: rows}
+	}
+}
+
+// Update handles messages for the source status view.
+func (v *View) Update(msg tea.Msg) (*View, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		v.SetDimensions(msg.Width, msg.Height)
+		return v, nil
+
+	case tea.KeyMsg:
+		return v.handleKeyMsg(msg)
+
+	case statusLoadedMsg:
+		v.loading = false
+		if msg.Err != nil {
+			v.err = msg.Err
+			return v, nil
+		}
+		v.rows = msg.Rows
+		v.err = nil
+		if v.selected >= len(v.rows) {
+			v.selected = max(len(v.rows)-1, 0)
+		}
+		return v, nil
+
+	case refreshTickMsg:
+		if msg.generation != v.generation {
+			return v, nil
+		}
+		return v, tea.Batch(v.loadStatus(), v.tick())
+
+	case messages.SyncCompleted:
+		if !v.syncing[msg.SourceID] {
+			return v, nil
+		}
+		delete(v.syncing, msg.SourceID)
+		return v, v.loadStatus()
+
+	case healthCheckedMsg:
+		delete(v.checking, msg.SourceID)
+		if msg.Err != nil {
+			v.err = msg.Err
+			return v, nil
+		}
+		for i := range v.rows {
+			if v.rows[i].Source.ID == msg.SourceID {
+				v.rows[i].Health = msg.Health
+			}
+		}
+		return v, nil
+	}
+
+	return v, nil
+}
+
+// handleKeyMsg handles key presses.
+func (v *View) handleKeyMsg(msg tea.KeyMsg) (*View, tea.Cmd) {
+	switch key := msg.String(); {
+	case keymap.Matches(key, v.keys.Up):
+		if v.selected > 0 {
+			v.selected--
+		}
+	case keymap.Matches(key, v.keys.Down):
+		if v.selected < len(v.rows)-1 {
+			v.selected++
+		}
+	case key == "s":
+		if row := v.selectedRow(); row != nil {
+			return v, v.syncSource(row.Source.ID)
+		}
+	case key == "r":
+		if row := v.selectedRow(); row != nil {
+			return v, v.checkSource(row.Source.ID)
+		}
+	case key == "esc":
+		return v, func() tea.Msg {
+			return messages.ViewChanged{View: messages.ViewMenu}
+		}
+	}
+
+	return v, nil
+}
+
+// selectedRow returns the selected row, or nil if there are no sources.
+func (v *View) selectedRow() *Row {
+	if v.selected < 0 || v.selected >= len(v.rows) {
+		return nil
+	}
+	return &v.rows[v.selected]
+}
+
+// syncSource marks a source as syncing and returns a command that syncs it.
+func (v *View) syncSource(sourceID string) tea.Cmd {
+	if v.syncOrchestrator == nil || v.syncing[sourceID] {
+		return nil
+	}
+
+	v.syncing[sourceID] = true
+	return func() tea.Msg {
+		err := v.syncOrchestrator.Sync(context.Background(), sourceID)
+		return messages.SyncCompleted{SourceID: sourceID, Err: err}
+	}
+}
+
+// checkSource returns a command that checks a source's credentials, as
+// "sercha auth check" does.
+func (v *View) checkSource(sourceID string) tea.Cmd {
+	if v.healthService == nil || v.checking[sourceID] {
+		return nil
+	}
+
+	v.checking[sourceID] = true
+	return func() tea.Msg {
+		health, err := v.healthService.Check(context.Background(), sourceID)
+		return healthCheckedMsg{SourceID: sourceID, Health: health, Err: err}
+	}
+}
+
+// Column widths, excluding the name column which takes the remaining space.
+const (
+	typeWidth     = 12
+	lastSyncWidth = 16
+	stateWidth    = 14
+	docsWidth     = 9
+)
+
+// View renders the dashboard.
+func (v *View) View() string {
+	var b strings.Builder
+
+	b.WriteString(v.styles.Title.Render("Source Status"))
+	b.WriteString("\n\n")
+
+	if v.loading {
+		b.WriteString(v.styles.Muted.Render("Loading sources..."))
+		b.WriteString("\n\n")
+		b.WriteString(v.renderHelp())
+		return b.String()
+	}
+
+	if v.err != nil {
+		b.WriteString(v.styles.Error.Render(fmt.Sprintf("Error: %s", v.err.Error())))
+		b.WriteString("\n\n")
+	}
+
+	if len(v.rows) == 0 {
+		b.WriteString(v.styles.Muted.Render("No sources configured."))
+		b.WriteString("\n\n")
+		b.WriteString(v.renderHelp())
+		return b.String()
+	}
+
+	nameWidth := v.nameWidth()
+	header := fmt.Sprintf("  %-*s %-*s %-*s %-*s %*s",
+		nameWidth, "Name", typeWidth, "Type", lastSyncWidth, "Last Synced", stateWidth, "Status", docsWidth, "Documents")
+	b.WriteString(v.styles.Subtitle.Render(header))
+	b.WriteString("\n")
+
+	for i := range v.rows {
+		b.WriteString(v.renderRow(i, nameWidth))
+		b.WriteString("\n")
+	}
+
+	if row := v.selectedRow(); row != nil && row.Status != nil && row.Status.LastError != nil {
+		b.WriteString("\n")
+		b.WriteString(v.styles.Error.Render(fmt.Sprintf("Last error: %s", row.Status.LastError.Error())))
+		b.WriteString("\n")
+	}
+	if row := v.selectedRow(); row != nil && row.Health != nil && row.Health.Error != "" {
+		b.WriteString("\n")
+		b.WriteString(v.styles.Error.Render(fmt.Sprintf("Credentials check: %s", row.Health.Error)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(v.renderHelp())
+
+	return b.String()
+}
+
+// nameWidth returns the width of the name column for the view width.
+func (v *View) nameWidth() int {
+	fixed := 2 + typeWidth + lastSyncWidth + stateWidth + docsWidth + 4
+	return max(v.width-fixed, 12)
+}
+
+// renderRow renders one source's line.
+func (v *View) renderRow(index int, nameWidth int) string {
+	row := &v.rows[index]
+
+	name := row.Source.Name
+	if name == "" {
+		name = row.Source.ID
+	}
+	name = truncate(name, nameWidth)
+
+	lastSync := "never"
+	docs := "-"
+	if row.Status != nil {
+		if !row.Status.LastSyncAt.IsZero() {
+			lastSync = row.Status.LastSyncAt.Local().Format("2006-01-02 15:04")
+		}
+		docs = fmt.Sprintf("%d", row.Status.DocumentCount)
+	}
+
+	state := row.State()
+	if v.syncing[row.Source.ID] {
+		state = StateSyncing
+	}
+	icon, stateStyle := v.stateIcon(state)
+	stateLabel := icon + " " + state.String()
+	if v.checking[row.Source.ID] {
+		stateLabel = "… Checking"
+	}
+
+	indicator := "  "
+	if index == v.selected {
+		indicator = "> "
+		return v.styles.Selected.Render(fmt.Sprintf("%s%-*s %-*s %-*s %-*s %*s",
+			indicator, nameWidth, name, typeWidth, truncate(row.Source.Type, typeWidth),
+			lastSyncWidth, lastSync, stateWidth, stateLabel, docsWidth, docs))
+	}
+
+	return v.styles.Normal.Render(fmt.Sprintf("%s%-*s %-*s %-*s ",
+		indicator, nameWidth, name, typeWidth, truncate(row.Source.Type, typeWidth), lastSyncWidth, lastSync)) +
+		stateStyle.Render(fmt.Sprintf("%-*s", stateWidth, stateLabel)) +
+		v.styles.Normal.Render(fmt.Sprintf(" %*s", docsWidth, docs))
+}
+
+// stateIcon returns the icon for a state and the style it is shown in.
+func (v *View) stateIcon(state State) (string, lipgloss.Style) {
+	switch state {
+	case StateOK:
+		return "●", v.styles.Success
+	case StateSyncing:
+		return "↻", v.styles.Warning
+	case StateError:
+		return "✗", v.styles.Error
+	case StateAuthFailed:
+		return "⚠", v.styles.Error
+	default:
+		return "○", v.styles.Muted
+	}
+}
+
+// truncate shortens s to width characters, marking the cut with an ellipsis.
+func truncate(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+	if width <= 1 {
+		return string(runes[:width])
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+// renderHelp renders the help footer.
+func (v *View) renderHelp() string {
+	if v.healthService != nil {
+		return v.styles.Help.Render("[↑/↓] navigate  [s] sync now  [r] check credentials  [esc] back")
+	}
+	return v.styles.Help.Render("[↑/↓] navigate  [s] sync now  [esc] back")
+}
+
+// KeyBindings returns the keys the source status view responds to.
+func (v *View) KeyBindings() []keymap.KeyBinding {
+	bindings := []keymap.KeyBinding{
+		{Group: "Navigation", Keys: v.keys.Up.Help().Key, Description: "move up"},
+		{Group: "Navigation", Keys: v.keys.Down.Help().Key, Description: "move down"},
+		{Group: "Navigation", Keys: "esc", Description: "back to menu"},
+		{Group: "Actions", Keys: "s", Description: "sync the selected source now"},
+	}
+	if v.healthService != nil {
+		bindings = append(bindings,
+			keymap.KeyBinding{Group: "Actions", Keys: "r", Description: "check the selected source's credentials"})
+	}
+	return bindings
+}
+
+// SetDimensions sets the view dimensions.
+func (v *View) SetDimensions(width, height int) {
+	v.width = width
+	v.height = height
+	v.ready = true
+}
+
+// Rows returns the dashboard rows.
+func (v *View) Rows() []Row {
+	return v.rows
+}
+
+// SelectedIndex returns the index of the selected row.
+func (v *View) SelectedIndex() int {
+	return v.selected
+}
+
+// Syncing reports whether a sync of the source was started from the view
+// and has not finished.
+func (v *View) Syncing(sourceID string) bool {
+	return v.syncing[sourceID]
+}
+
+// Err returns the last error.
+func (v *View) Err() error {
+	return v.err
+}
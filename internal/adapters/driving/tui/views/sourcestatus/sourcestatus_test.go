@@ -0,0 +1,290 @@
+package sourcestatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driving/tui/messages"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// mockSourceService implements List of driving.SourceService for testing.
+type mockSourceService struct {
+	driving.SourceService
+	sources []domain.Source
+	err     error
+}
+
+func (m *mockSourceService) List(_ context.Context) ([]domain.Source, error) {
+	return m.sources, m.err
+}
+
+// mockSyncOrchestrator implements Sync and Status of driving.SyncOrchestrator for testing.
+type mockSyncOrchestrator struct {
+	driving.SyncOrchestrator
+	statuses map[string]*driving.SyncStatus
+	synced   []string
+	syncErr  error
+}
+
+func (m *mockSyncOrchestrator) Sync(_ context.Context, sourceID string) error {
+	m.synced = append(m.synced, sourceID)
+	return m.syncErr
+}
+
+func (m *mockSyncOrchestrator) Status(_ context.Context, sourceID string) (*driving.SyncStatus, error) {
+	if status, ok := m.statuses[sourceID]; ok {
+		return status, nil
+	}
+	return &driving.SyncStatus{SourceID: sourceID}, nil
+}
+
+// mockHealthService implements driving.SourceHealthService for testing.
+type mockHealthService struct {
+	results map[string]*domain.SourceHealth
+	checked []string
+	err     error
+}
+
+func (m *mockHealthService) Check(_ context.Context, sourceID string) (*domain.SourceHealth, error) {
+	m.checked = append(m.checked, sourceID)
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.results[sourceID], nil
+}
+
+func (m *mockHealthService) CheckAll(_ context.Context) ([]domain.SourceHealth, error) {
+	return nil, nil
+}
+
+func (m *mockHealthService) Get(_ context.Context, _ string) (*domain.SourceHealth, error) {
+	return nil, nil
+}
+
+func testSources() []domain.Source {
+	return []domain.Source{
+		{ID: "src-1", Name: "Notes", Type: "filesystem"},
+		{ID: "src-2", Name: "Mail", Type: "gmail"},
+	}
+}
+
+// newLoadedView returns a view showing the given sources and statuses.
+func newLoadedView(t *testing.T, sync *mockSyncOrchestrator, health *mockHealthService) *View {
+	t.Helper()
+	v := NewView(nil, &mockSourceService{sources: testSources()}, sync)
+	if health != nil {
+		v.SetHealthService(health)
+	}
+	v.SetDimensions(120, 40)
+	v.Update(v.loadStatus()())
+	require.Len(t, v.Rows(), 2)
+	return v
+}
+
+func key(r rune) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}
+}
+
+func TestRow_State(t *testing.T) {
+	synced := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		status *driving.SyncStatus
+		health *domain.SourceHealth
+		want   State
+	}{
+		{"never synced", &driving.SyncStatus{}, nil, StateNotSynced},
+		{"status unavailable", nil, nil, StateNotSynced},
+		{"synced", &driving.SyncStatus{LastSyncAt: synced}, nil, StateOK},
+		{"running", &driving.SyncStatus{Running: true, LastError: errors.New("old")}, nil, StateSyncing},
+		{"failed", &driving.SyncStatus{LastSyncAt: synced, LastError: errors.New("connection reset")}, nil, StateError},
+		{
+			"auth error",
+			&driving.SyncStatus{LastError: fmt.Errorf("sync: %w", domain.ErrAuthExpired)},
+			nil,
+			StateAuthFailed,
+		},
+		{
+			"failed check after sync",
+			&driving.SyncStatus{LastSyncAt: synced},
+			&domain.SourceHealth{Error: "invalid token", CheckedAt: synced.Add(time.Hour)},
+			StateAuthFailed,
+		},
+		{
+			"sync after failed check",
+			&driving.SyncStatus{LastSyncAt: synced},
+			&domain.SourceHealth{Error: "invalid token", CheckedAt: synced.Add(-time.Hour)},
+			StateOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := Row{Status: tt.status, Health: tt.health}
+			assert.Equal(t, tt.want, row.State())
+		})
+	}
+}
+
+func TestView_LoadsStatus(t *testing.T) {
+	synced := time.Now()
+	sync := &mockSyncOrchestrator{statuses: map[string]*driving.SyncStatus{
+		"src-1": {SourceID: "src-1", LastSyncAt: synced, DocumentCount: 42},
+		"src-2": {SourceID: "src-2", LastError: fmt.Errorf("%w: token revoked", domain.ErrAuthInvalid)},
+	}}
+	v := newLoadedView(t, sync, nil)
+
+	rows := v.Rows()
+	assert.Equal(t, StateOK, rows[0].State())
+	assert.Equal(t, StateAuthFailed, rows[1].State())
+
+	out := v.View()
+	for _, want := range []string{
+		"Name", "Type", "Last Synced", "Status", "Documents",
+		"Notes", "filesystem", synced.Format("2006-01-02 15:04"), "OK", "42",
+		"Mail", "gmail", "never", "Auth Failed",
+	} {
+		assert.Contains(t, out, want)
+	}
+	assert.NotContains(t, out, "Last error", "the selected source has no error")
+
+	v.Update(key('j'))
+	assert.Contains(t, v.View(), "Last error: authentication invalid: token revoked")
+}
+
+func TestView_LoadError(t *testing.T) {
+	v := NewView(nil, &mockSourceService{err: errors.New("db locked")}, &mockSyncOrchestrator{})
+
+	v.Update(v.loadStatus()())
+
+	assert.EqualError(t, v.Err(), "db locked")
+	assert.Contains(t, v.View(), "Error: db locked")
+}
+
+func TestView_RefreshTicker(t *testing.T) {
+	v := NewView(nil, &mockSourceService{sources: testSources()}, &mockSyncOrchestrator{})
+	v.Init()
+	stale := refreshTickMsg{generation: v.generation}
+	v.Init()
+
+	_, cmd := v.Update(stale)
+	assert.Nil(t, cmd, "ticks from an earlier showing are dropped")
+
+	_, cmd = v.Update(refreshTickMsg{generation: v.generation})
+	assert.NotNil(t, cmd, "a current tick reloads and schedules the next tick")
+}
+
+func TestView_SyncNow(t *testing.T) {
+	sync := &mockSyncOrchestrator{}
+	v := newLoadedView(t, sync, nil)
+	v.Update(key('j'))
+
+	_, cmd := v.Update(key('s'))
+	require.NotNil(t, cmd)
+	assert.True(t, v.Syncing("src-2"))
+	assert.Contains(t, v.View(), "Syncing")
+
+	// A second press while syncing does nothing
+	_, again := v.Update(key('s'))
+	assert.Nil(t, again)
+
+	msg := cmd()
+	assert.Equal(t, messages.SyncCompleted{SourceID: "src-2"}, msg)
+	assert.Equal(t, []string{"src-2"}, sync.synced)
+
+	_, reload := v.Update(msg)
+	assert.False(t, v.Syncing("src-2"))
+	assert.NotNil(t, reload, "the dashboard reloads after the sync")
+}
+
+func TestView_IgnoresOtherSyncs(t *testing.T) {
+	v := newLoadedView(t, &mockSyncOrchestrator{}, nil)
+
+	_, cmd := v.Update(messages.SyncCompleted{SourceID: "src-1"})
+
+	assert.Nil(t, cmd)
+}
+
+func TestView_CheckCredentials(t *testing.T) {
+	health := &mockHealthService{results: map[string]*domain.SourceHealth{
+		"src-1": {SourceID: "src-1", Error: "invalid_grant", CheckedAt: time.Now()},
+	}}
+	sync := &mockSyncOrchestrator{statuses: map[string]*driving.SyncStatus{
+		"src-1": {SourceID: "src-1", LastSyncAt: time.Now().Add(-time.Hour)},
+	}}
+	v := newLoadedView(t, sync, health)
+	require.Equal(t, StateOK, v.Rows()[0].State())
+
+	_, cmd := v.Update(key('r'))
+	require.NotNil(t, cmd)
+	assert.Contains(t, v.View(), "Checking")
+
+	v.Update(cmd())
+
+	assert.Equal(t, []string{"src-1"}, health.checked)
+	assert.Equal(t, StateAuthFailed, v.Rows()[0].State())
+	out := v.View()
+	assert.Contains(t, out, "Auth Failed")
+	assert.Contains(t, out, "Credentials check: invalid_grant")
+}
+
+func TestView_CheckCredentialsError(t *testing.T) {
+	health := &mockHealthService{err: errors.New("source not found")}
+	v := newLoadedView(t, &mockSyncOrchestrator{}, health)
+
+	_, cmd := v.Update(key('r'))
+	v.Update(cmd())
+
+	assert.EqualError(t, v.Err(), "source not found")
+}
+
+func TestView_CheckUnavailableWithoutHealthService(t *testing.T) {
+	v := newLoadedView(t, &mockSyncOrchestrator{}, nil)
+
+	_, cmd := v.Update(key('r'))
+
+	assert.Nil(t, cmd)
+	assert.NotContains(t, v.View(), "[r]")
+}
+
+func TestView_Navigation(t *testing.T) {
+	v := newLoadedView(t, &mockSyncOrchestrator{}, nil)
+
+	v.Update(key('j'))
+	v.Update(key('j'))
+	assert.Equal(t, 1, v.SelectedIndex())
+	v.Update(key('k'))
+	assert.Equal(t, 0, v.SelectedIndex())
+
+	_, cmd := v.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.NotNil(t, cmd)
+	assert.Equal(t, messages.ViewChanged{View: messages.ViewMenu}, cmd())
+}
+
+func TestView_Empty(t *testing.T) {
+	v := NewView(nil, &mockSourceService{}, &mockSyncOrchestrator{})
+
+	v.Update(v.loadStatus()())
+	_, cmd := v.Update(key('s'))
+
+	assert.Nil(t, cmd)
+	assert.Contains(t, v.View(), "No sources configured.")
+}
+
+func TestView_KeyBindings(t *testing.T) {
+	v := NewView(nil, nil, nil)
+	assert.Len(t, v.KeyBindings(), 4)
+
+	v.SetHealthService(&mockHealthService{})
+	assert.Len(t, v.KeyBindings(), 5)
+}
@@ -0,0 +1,77 @@
+// Package budget provides a global API budget shared by all connectors.
+//
+// Each connector rate limits itself against its provider's limits, but many
+// sources syncing together can still overwhelm the network or the machine.
+// A Budget caps the requests in flight and the request rate across every
+// connector it is injected into, regardless of how many sources sync.
+package budget
+
+import (
+	"context"
+	"math"
+	"sync"
+
+	"golang.org/x/time/rate"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Budget implements the interface.
+var _ driven.APIBudget = (*Budget)(nil)
+
+// Budget caps concurrent requests and requests per second across connectors.
+// A nil Budget imposes no limit.
+type Budget struct {
+	slots   chan struct{}
+	limiter *rate.Limiter
+}
+
+// New creates a budget allowing at most maxConcurrent requests in flight and
+// requestsPerSecond requests per second. Zero or negative values leave that
+// dimension unlimited. Returns nil when both are unlimited.
+func New(maxConcurrent int, requestsPerSecond float64) *Budget {
+	if maxConcurrent <= 0 && requestsPerSecond <= 0 {
+		return nil
+	}
+
+	b := &Budget{}
+	if maxConcurrent > 0 {
+		b.slots = make(chan struct{}, maxConcurrent)
+	}
+	if requestsPerSecond > 0 {
+		// Allow up to one second's worth of requests to burst
+		burst := int(math.Max(1, math.Floor(requestsPerSecond)))
+		b.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+	return b
+}
+
+// Acquire blocks until a request slot and a rate token are available.
+// The returned release function frees the slot; it is safe to call more than once.
+func (b *Budget) Acquire(ctx context.Context) (func(), error) {
+	if b == nil {
+		return func() {}, nil
+	}
+
+	release := func() {}
+	if b.slots != nil {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case b.slots <- struct{}{}:
+		}
+		var once sync.Once
+		release = func() {
+			once.Do(func() { <-b.slots })
+		}
+	}
+
+	if b.limiter != nil {
+		if err := b.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
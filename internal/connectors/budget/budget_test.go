@@ -0,0 +1,167 @@
+package budget
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// inFlightServer counts concurrent requests and records the peak.
+type inFlightServer struct {
+	current atomic.Int32
+	peak    atomic.Int32
+	total   atomic.Int32
+	delay   time.Duration
+}
+
+func (s *inFlightServer) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	n := s.current.Add(1)
+	defer s.current.Add(-1)
+	s.total.Add(1)
+	for {
+		peak := s.peak.Load()
+		if n <= peak || s.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	time.Sleep(s.delay)
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestNew_Unlimited(t *testing.T) {
+	assert.Nil(t, New(0, 0))
+	assert.Nil(t, New(-1, -1))
+
+	var b *Budget
+	release, err := b.Acquire(context.Background())
+	require.NoError(t, err)
+	release()
+}
+
+func TestBudget_CapsConcurrency(t *testing.T) {
+	b := New(2, 0)
+	var current, peak atomic.Int32
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := b.Acquire(context.Background())
+			if !assert.NoError(t, err) {
+				return
+			}
+			n := current.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			current.Add(-1)
+			release()
+			release() // Releasing twice must not free a second slot
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), peak.Load())
+}
+
+func TestBudget_CapsRate(t *testing.T) {
+	b := New(0, 100) // Burst of 100, then 100 per second
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 150; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := b.Acquire(context.Background())
+			if assert.NoError(t, err) {
+				release()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The 50 requests beyond the burst take at least half a second
+	assert.GreaterOrEqual(t, time.Since(start), 450*time.Millisecond)
+}
+
+func TestBudget_AcquireCancelled(t *testing.T) {
+	b := New(1, 0)
+	release, err := b.Acquire(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = b.Acquire(ctx)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestTransport_NilBudget(t *testing.T) {
+	base := &http.Transport{}
+	assert.Same(t, base, Transport(base, nil))
+	assert.Nil(t, Transport(nil, nil))
+}
+
+func TestTransport_ClientsShareCap(t *testing.T) {
+	server := &inFlightServer{delay: 10 * time.Millisecond}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	// Each "connector" has its own client, all charged to one budget
+	b := New(3, 0)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		client := &http.Client{Transport: Transport(nil, b)}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 5; j++ {
+				resp, err := client.Get(ts.URL)
+				if !assert.NoError(t, err) {
+					return
+				}
+				resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(20), server.total.Load())
+	assert.LessOrEqual(t, server.peak.Load(), int32(3))
+}
+
+func TestTransport_HoldsSlotUntilBodyClosed(t *testing.T) {
+	ts := httptest.NewServer(&inFlightServer{})
+	defer ts.Close()
+
+	b := New(1, 0)
+	client := &http.Client{Transport: Transport(nil, b)}
+
+	resp, err := client.Get(ts.URL)
+	require.NoError(t, err)
+
+	// The open body holds the only slot
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = b.Acquire(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	require.NoError(t, resp.Body.Close())
+	release, err := b.Acquire(context.Background())
+	require.NoError(t, err)
+	release()
+}
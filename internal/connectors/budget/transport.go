@@ -0,0 +1,72 @@
+package budget
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+
+	"golang.org/x/oauth2"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Transport returns a RoundTripper that charges every request to budget,
+// holding its slot until the response body is closed. A nil base uses
+// http.DefaultTransport; a nil budget returns base unchanged.
+func Transport(base http.RoundTripper, budget driven.APIBudget) http.RoundTripper {
+	if budget == nil {
+		return base
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base, budget: budget}
+}
+
+// NewOAuth2Client returns oauth2.NewClient(ctx, ts) with its requests
+// charged to budget, for API libraries that authenticate with a TokenSource.
+func NewOAuth2Client(ctx context.Context, ts oauth2.TokenSource, budget driven.APIBudget) *http.Client {
+	if budget != nil {
+		base := &http.Client{Transport: Transport(nil, budget)}
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, base)
+	}
+	return oauth2.NewClient(ctx, ts)
+}
+
+// transport charges requests to a budget before sending them.
+type transport struct {
+	base   http.RoundTripper
+	budget driven.APIBudget
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.budget.Acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releasingBody releases the request's budget slot when closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+// Close closes the body and releases the budget slot.
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
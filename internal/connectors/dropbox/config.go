@@ -1,6 +1,7 @@
 package dropbox
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -17,20 +18,27 @@ type Config struct {
 	MaxResults uint32
 	// Recursive includes subfolders (default: true).
 	Recursive bool
+	// ContentLimits bounds the file content downloaded for indexing.
+	ContentLimits domain.ContentLimits
+	// NamespaceID is the team space or shared folder namespace to sync
+	// (optional). FolderPath is relative to it when set.
+	NamespaceID string
 }
 
 // DefaultConfig returns the default configuration.
 func DefaultConfig() *Config {
 	return &Config{
-		FolderPath: "",
-		MaxResults: 100,
-		Recursive:  true,
+		FolderPath:    "",
+		MaxResults:    100,
+		Recursive:     true,
+		ContentLimits: domain.DefaultContentLimits(),
 	}
 }
 
 // ParseConfig extracts configuration from a Source.
 func ParseConfig(source domain.Source) (*Config, error) {
 	cfg := DefaultConfig()
+	cfg.ContentLimits = source.ContentLimits()
 
 	// Parse folder_path
 	if val := source.Config["folder_path"]; val != "" {
@@ -61,5 +69,17 @@ func ParseConfig(source domain.Source) (*Config, error) {
 		cfg.Recursive = val == "true" || val == "1"
 	}
 
+	// Parse namespace_id; a shared folder's ID is its namespace ID
+	val := source.Config["namespace_id"]
+	if val == "" {
+		val = source.Config["shared_folder_id"]
+	}
+	if val = strings.TrimSpace(val); val != "" {
+		if _, err := strconv.ParseUint(val, 10, 64); err != nil {
+			return nil, fmt.Errorf("dropbox: invalid namespace_id %q: must be numeric", val)
+		}
+		cfg.NamespaceID = val
+	}
+
 	return cfg, nil
 }
@@ -160,3 +160,50 @@ func TestParseConfig_AllOptions(t *testing.T) {
 	assert.Equal(t, uint32(25), cfg.MaxResults)
 	assert.False(t, cfg.Recursive)
 }
+
+func TestParseConfig_NamespaceID(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]string
+		expected string
+	}{
+		{"unset", map[string]string{}, ""},
+		{"namespace_id", map[string]string{"namespace_id": "1234"}, "1234"},
+		{"shared_folder_id alias", map[string]string{"shared_folder_id": "5678"}, "5678"},
+		{"namespace_id wins", map[string]string{"namespace_id": "1234", "shared_folder_id": "5678"}, "1234"},
+		{"trimmed", map[string]string{"namespace_id": " 1234 "}, "1234"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := ParseConfig(domain.Source{Config: tt.config})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg.NamespaceID)
+		})
+	}
+}
+
+func TestParseConfig_InvalidNamespaceID(t *testing.T) {
+	source := domain.Source{
+		Config: map[string]string{"namespace_id": `1", "root": "2`},
+	}
+
+	_, err := ParseConfig(source)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must be numeric")
+}
+
+func TestParseConfig_ContentLimits(t *testing.T) {
+	cfg, err := ParseConfig(domain.Source{Config: map[string]string{}})
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultContentLimits(), cfg.ContentLimits)
+
+	cfg, err = ParseConfig(domain.Source{Config: map[string]string{
+		domain.ConfigKeyMaxContentSize: "1024",
+		domain.ConfigKeyMetadataOnly:   "true",
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, domain.ContentLimits{MaxSize: 1024, MetadataOnly: true}, cfg.ContentLimits)
+}
@@ -5,19 +5,30 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"net/http"
 	"strings"
 	"sync"
 
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
+	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/auth"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/users"
+	"golang.org/x/oauth2"
 
+	"github.com/custodia-labs/sercha-cli/internal/connectors/budget"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/locale"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
-// Ensure Connector implements the interface.
-var _ driven.Connector = (*Connector)(nil)
+// Ensure Connector implements the interfaces.
+var (
+	_ driven.Connector      = (*Connector)(nil)
+	_ driven.APIBudgetAware = (*Connector)(nil)
+	_ driven.LocaleAware    = (*Connector)(nil)
+)
 
 // ErrCursorReset indicates the cursor has expired and a full sync is required.
 var ErrCursorReset = errors.New("cursor reset, full sync required")
@@ -28,6 +39,9 @@ type Connector struct {
 	config        *Config
 	tokenProvider driven.TokenProvider
 	rateLimiter   *RateLimiter
+	apiBudget     driven.APIBudget
+	locale        string
+	baseURL       string // overrides the SDK's API hosts, for testing
 	mu            sync.Mutex
 	closed        bool
 }
@@ -42,6 +56,16 @@ func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Conn
 	}
 }
 
+// SetAPIBudget charges the connector's API requests to a shared budget.
+func (c *Connector) SetAPIBudget(apiBudget driven.APIBudget) {
+	c.apiBudget = apiBudget
+}
+
+// SetLocale sends locale as Accept-Language on the connector's API requests.
+func (c *Connector) SetLocale(loc string) {
+	c.locale = loc
+}
+
 // Type returns the connector type identifier.
 func (c *Connector) Type() string {
 	return "dropbox"
@@ -90,12 +114,45 @@ func (c *Connector) Validate(ctx context.Context) error {
 	usersClient := c.createUsersClient(token)
 	_, err = usersClient.GetCurrentAccount()
 	if err != nil {
-		return fmt.Errorf("%w: %w", domain.ErrAuthRequired, err)
+		return c.validationError(err)
+	}
+
+	// A namespace the token cannot reach fails only on file routes
+	if c.config.NamespaceID != "" {
+		arg := files.NewListFolderArg(c.config.FolderPath)
+		arg.Limit = 1
+		if _, err := c.createClient(token).ListFolder(arg); err != nil {
+			return c.validationError(err)
+		}
 	}
 
 	return nil
 }
 
+// validationError explains why validation failed, calling out tokens
+// missing a scope and namespaces the account cannot access.
+func (c *Connector) validationError(err error) error {
+	var authErr auth.AuthAPIError
+	if errors.As(err, &authErr) && authErr.AuthError != nil &&
+		authErr.AuthError.Tag == auth.AuthErrorMissingScope {
+		scope := "a required"
+		if authErr.AuthError.MissingScope != nil {
+			scope = authErr.AuthError.MissingScope.RequiredScope
+		}
+		return fmt.Errorf("%w: token lacks the %s scope; re-authenticate with an app that grants it: %w",
+			domain.ErrAuthInvalid, scope, err)
+	}
+
+	// Dropbox rejects an invalid or inaccessible path root with 422
+	var sdkErr dropbox.SDKInternalError
+	if errors.As(err, &sdkErr) && sdkErr.StatusCode == http.StatusUnprocessableEntity && c.config.NamespaceID != "" {
+		return fmt.Errorf("%w: namespace %s is not accessible to this account; "+
+			"team spaces need a team member's token: %w", domain.ErrAuthInvalid, c.config.NamespaceID, err)
+	}
+
+	return fmt.Errorf("%w: %w", domain.ErrAuthRequired, err)
+}
+
 // FullSync fetches all files from Dropbox.
 func (c *Connector) FullSync(ctx context.Context) (
 	docs <-chan domain.RawDocument, errs <-chan error,
@@ -117,6 +174,7 @@ func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawD
 	if err := c.checkClosed(); err != nil {
 		return err
 	}
+	slog.Debug("full sync started", slog.String("connector", c.Type()), slog.String("source_id", c.sourceID))
 
 	token, err := c.tokenProvider.GetToken(ctx)
 	if err != nil {
@@ -136,7 +194,10 @@ func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawD
 		return err
 	}
 
+	// The SDK takes no context, so page spans only time the requests
+	_, span := tracing.StartPage(ctx, "dropbox", "list_folder")
 	result, err := client.ListFolder(arg)
+	tracing.EndPage(span, err)
 	if err != nil {
 		return fmt.Errorf("list folder: %w", err)
 	}
@@ -157,7 +218,9 @@ func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawD
 		}
 
 		continueArg := files.NewListFolderContinueArg(result.Cursor)
+		_, span := tracing.StartPage(ctx, "dropbox", "list_folder")
 		result, err = client.ListFolderContinue(continueArg)
+		tracing.EndPage(span, err)
 		if err != nil {
 			return fmt.Errorf("list folder continue: %w", err)
 		}
@@ -219,7 +282,9 @@ func (c *Connector) runIncrementalSync(
 	}
 
 	continueArg := files.NewListFolderContinueArg(cursor.GetCursor())
+	_, span := tracing.StartPage(ctx, "dropbox", "list_folder")
 	result, err := client.ListFolderContinue(continueArg)
+	tracing.EndPage(span, err)
 	if err != nil {
 		// Check for cursor reset error
 		if isResetError(err) {
@@ -244,7 +309,9 @@ func (c *Connector) runIncrementalSync(
 		}
 
 		continueArg = files.NewListFolderContinueArg(result.Cursor)
+		_, span := tracing.StartPage(ctx, "dropbox", "list_folder")
 		result, err = client.ListFolderContinue(continueArg)
+		tracing.EndPage(span, err)
 		if err != nil {
 			if isResetError(err) {
 				return fmt.Errorf("%w: %w", ErrCursorReset, err)
@@ -307,7 +374,7 @@ func (c *Connector) processFile(
 	// Download content if appropriate
 	var content []byte
 	mimeType := getMIMEType(file.Name)
-	if shouldDownloadContent(mimeType) && file.Size <= MaxContentSize {
+	if shouldDownloadContent(mimeType) && c.config.ContentLimits.AllowsDownload(int64(file.Size)) {
 		var err error
 		content, err = c.downloadFileContent(ctx, client, file.PathLower)
 		if err != nil {
@@ -338,7 +405,7 @@ func (c *Connector) downloadFileContent(
 	defer reader.Close()
 
 	// Read with size limit
-	limitedReader := io.LimitReader(reader, MaxContentSize)
+	limitedReader := io.LimitReader(reader, c.config.ContentLimits.MaxSize)
 	data, err := io.ReadAll(limitedReader)
 	if err != nil {
 		return nil, fmt.Errorf("read content: %w", err)
@@ -393,20 +460,41 @@ func (c *Connector) emitDocument(
 	return nil
 }
 
-// createClient creates a Dropbox files client with the given access token.
-func (c *Connector) createClient(accessToken string) files.Client {
+// sdkConfig returns the SDK configuration for the given access token.
+// With an API budget, requests go through a budgeted OAuth2 client;
+// the SDK does not pass contexts, so waits for the budget are not cancellable.
+// With a locale, the client also sends it as Accept-Language.
+// With a namespace, every request carries a Dropbox-API-Path-Root header so
+// paths, listings and cursors are relative to that namespace.
+func (c *Connector) sdkConfig(accessToken string) dropbox.Config {
 	config := dropbox.Config{
 		Token: accessToken,
 	}
-	return files.New(config)
+	if c.config.NamespaceID != "" {
+		config = config.WithNamespaceID(c.config.NamespaceID)
+	}
+	if c.baseURL != "" {
+		config.URLGenerator = func(_, namespace, route string) string {
+			return fmt.Sprintf("%s/2/%s/%s", c.baseURL, namespace, route)
+		}
+	}
+	if c.apiBudget != nil || c.locale != "" {
+		ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: accessToken})
+		client := budget.NewOAuth2Client(context.Background(), ts, c.apiBudget)
+		client.Transport = locale.Transport(client.Transport, c.locale)
+		config.Client = client
+	}
+	return config
+}
+
+// createClient creates a Dropbox files client with the given access token.
+func (c *Connector) createClient(accessToken string) files.Client {
+	return files.New(c.sdkConfig(accessToken))
 }
 
 // createUsersClient creates a Dropbox users client with the given access token.
 func (c *Connector) createUsersClient(accessToken string) users.Client {
-	config := dropbox.Config{
-		Token: accessToken,
-	}
-	return users.New(config)
+	return users.New(c.sdkConfig(accessToken))
 }
 
 // sendDocument sends a document to the channel or returns on context cancellation.
@@ -2,12 +2,18 @@ package dropbox
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
 // mockTokenProvider implements driven.TokenProvider for testing.
@@ -34,6 +40,10 @@ func (m *mockTokenProvider) IsAuthenticated() bool {
 	return m.isAuthed
 }
 
+func (m *mockTokenProvider) RefreshIfNeeded(_ context.Context) error {
+	return nil
+}
+
 func TestNew(t *testing.T) {
 	tp := &mockTokenProvider{token: "test-token", isAuthed: true}
 	cfg := DefaultConfig()
@@ -233,3 +243,218 @@ func TestConnector_createUsersClient(t *testing.T) {
 
 	assert.NotNil(t, client)
 }
+
+// pathRootServer serves the Dropbox routes used by Validate and FullSync,
+// recording each request's Dropbox-API-Path-Root header by route.
+func pathRootServer(t *testing.T, headers map[string]string) *httptest.Server {
+	t.Helper()
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		headers[r.URL.Path] = r.Header.Get("Dropbox-API-Path-Root")
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/2/users/get_current_account":
+			_, _ = w.Write([]byte(`{"account_id": "dbid:abc", "email": "user@example.com", "root_info": ` +
+				`{".tag": "team", "root_namespace_id": "1234", "home_namespace_id": "5678"}}`))
+		case "/2/files/list_folder", "/2/files/list_folder/continue":
+			_, _ = w.Write([]byte(`{"entries": [], "cursor": "cursor-1", "has_more": false}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestConnector_Validate_SetsPathRootHeader(t *testing.T) {
+	headers := make(map[string]string)
+	server := pathRootServer(t, headers)
+
+	cfg := DefaultConfig()
+	cfg.NamespaceID = "1234"
+	conn := New("source-123", cfg, &mockTokenProvider{token: "test-token", isAuthed: true})
+	conn.baseURL = server.URL
+
+	require.NoError(t, conn.Validate(context.Background()))
+
+	want := `{".tag": "namespace_id", "namespace_id": "1234"}`
+	assert.Equal(t, want, headers["/2/users/get_current_account"])
+	assert.Equal(t, want, headers["/2/files/list_folder"], "validation checks the namespace is listable")
+}
+
+func TestConnector_Validate_NoNamespace(t *testing.T) {
+	headers := make(map[string]string)
+	server := pathRootServer(t, headers)
+
+	conn := New("source-123", DefaultConfig(), &mockTokenProvider{token: "test-token", isAuthed: true})
+	conn.baseURL = server.URL
+
+	require.NoError(t, conn.Validate(context.Background()))
+
+	assert.Empty(t, headers["/2/users/get_current_account"])
+	_, listed := headers["/2/files/list_folder"]
+	assert.False(t, listed, "personal accounts need no namespace check")
+}
+
+func TestConnector_FullSync_SetsPathRootHeader(t *testing.T) {
+	headers := make(map[string]string)
+	server := pathRootServer(t, headers)
+
+	cfg := DefaultConfig()
+	cfg.NamespaceID = "1234"
+	conn := New("source-123", cfg, &mockTokenProvider{token: "test-token", isAuthed: true})
+	conn.baseURL = server.URL
+
+	docs, errs := conn.FullSync(context.Background())
+	for range docs {
+	}
+	var complete *driven.SyncComplete
+	for err := range errs {
+		require.ErrorAs(t, err, &complete)
+	}
+
+	require.NotNil(t, complete)
+	assert.Equal(t, `{".tag": "namespace_id", "namespace_id": "1234"}`, headers["/2/files/list_folder"])
+}
+
+func TestConnector_Validate_MissingScope(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error_summary": "missing_scope/", "error": {".tag": "missing_scope", ` +
+			`"required_scope": "team_data.member"}}`))
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.NamespaceID = "1234"
+	conn := New("source-123", cfg, &mockTokenProvider{token: "test-token", isAuthed: true})
+	conn.baseURL = server.URL
+
+	err := conn.Validate(context.Background())
+
+	require.ErrorIs(t, err, domain.ErrAuthInvalid)
+	assert.Contains(t, err.Error(), "token lacks the team_data.member scope")
+}
+
+func TestConnector_Validate_InaccessibleNamespace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"error_summary": "no_permission/", "error": {".tag": "no_permission"}}`))
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.NamespaceID = "1234"
+	conn := New("source-123", cfg, &mockTokenProvider{token: "test-token", isAuthed: true})
+	conn.baseURL = server.URL
+
+	err := conn.Validate(context.Background())
+
+	require.ErrorIs(t, err, domain.ErrAuthInvalid)
+	assert.Contains(t, err.Error(), "namespace 1234 is not accessible")
+}
+
+// contentLimitServer serves a folder listing with a small and a large text
+// file, recording the paths of downloaded files.
+func contentLimitServer(t *testing.T, downloads *[]string) *httptest.Server {
+	t.Helper()
+	var mu sync.Mutex
+	entry := func(name string, size int) string {
+		return fmt.Sprintf(`{".tag": "file", "name": "%[1]s", "path_lower": "/%[1]s", "path_display": "/%[1]s", `+
+			`"id": "id:%[1]s", "size": %[2]d, "rev": "015a1b2c3d4e5f600000001", `+
+			`"client_modified": "2024-01-01T00:00:00Z", "server_modified": "2024-01-01T00:00:00Z"}`, name, size)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/2/files/list_folder":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"entries": [%s, %s], "cursor": "cursor-1", "has_more": false}`,
+				entry("small.txt", 5), entry("large.txt", 10485760))
+		case "/2/files/download":
+			var arg struct {
+				Path string `json:"path"`
+			}
+			_ = json.Unmarshal([]byte(r.Header.Get("Dropbox-API-Arg")), &arg)
+			mu.Lock()
+			*downloads = append(*downloads, arg.Path)
+			mu.Unlock()
+			w.Header().Set("Dropbox-API-Result", entry("small.txt", 5))
+			_, _ = w.Write([]byte("hello"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// syncContents runs a full sync and returns each document's content by URI.
+func syncContents(t *testing.T, conn *Connector) map[string]string {
+	t.Helper()
+	docs, errs := conn.FullSync(context.Background())
+	contents := make(map[string]string)
+	for doc := range docs {
+		contents[doc.URI] = string(doc.Content)
+	}
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, <-errs, &complete)
+	return contents
+}
+
+func TestConnector_FullSync_OversizedFileIsMetadataOnly(t *testing.T) {
+	var downloads []string
+	server := contentLimitServer(t, &downloads)
+
+	conn := New("source-123", DefaultConfig(), &mockTokenProvider{token: "test-token", isAuthed: true})
+	conn.baseURL = server.URL
+
+	contents := syncContents(t, conn)
+
+	assert.Equal(t, map[string]string{
+		"dropbox://files/id:small.txt": "hello",
+		"dropbox://files/id:large.txt": "",
+	}, contents)
+	assert.Equal(t, []string{"/small.txt"}, downloads)
+}
+
+func TestConnector_FullSync_MetadataOnly(t *testing.T) {
+	var downloads []string
+	server := contentLimitServer(t, &downloads)
+
+	cfg := DefaultConfig()
+	cfg.ContentLimits.MetadataOnly = true
+	conn := New("source-123", cfg, &mockTokenProvider{token: "test-token", isAuthed: true})
+	conn.baseURL = server.URL
+
+	contents := syncContents(t, conn)
+
+	assert.Len(t, contents, 2, "files are still indexed")
+	assert.Empty(t, downloads)
+}
+
+func TestConnector_SetLocale(t *testing.T) {
+	var mu sync.Mutex
+	languages := make(map[string]string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		languages[r.URL.Path] = r.Header.Get("Accept-Language")
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"account_id": "dbid:abc", "email": "user@example.com", "root_info": ` +
+			`{".tag": "user", "root_namespace_id": "5678", "home_namespace_id": "5678"}}`))
+	}))
+	defer server.Close()
+
+	conn := New("source-123", DefaultConfig(), &mockTokenProvider{token: "test-token", isAuthed: true})
+	conn.baseURL = server.URL
+	conn.SetLocale("de-DE")
+
+	require.NoError(t, conn.Validate(context.Background()))
+	assert.Equal(t, "de-DE", languages["/2/users/get_current_account"])
+}
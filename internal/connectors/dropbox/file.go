@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
@@ -77,6 +78,25 @@ func ShouldSyncFile(file *files.FileMetadata, cfg *Config) bool {
 	return true
 }
 
+// downloadMIMETypes lists non-text/* types whose content is downloaded for normalisation.
+var downloadMIMETypes = []string{
+	// Text-based formats
+	"application/json",
+	"application/xml",
+	"application/javascript",
+	"application/typescript",
+	"application/x-yaml",
+	"application/x-sh",
+	"application/sql",
+	"image/svg+xml",
+	// Binary formats with normalisers
+	"application/pdf",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	"application/vnd.oasis.opendocument.text",
+	"application/vnd.oasis.opendocument.spreadsheet",
+	"application/vnd.oasis.opendocument.presentation",
+}
+
 // shouldDownloadContent checks if a MIME type requires content download.
 // This includes text files and binary formats that have normalisers (e.g., PDF).
 func shouldDownloadContent(mimeType string) bool {
@@ -84,20 +104,7 @@ func shouldDownloadContent(mimeType string) bool {
 		return true
 	}
 
-	// Types that need content downloaded for normalisation
-	downloadTypes := []string{
-		// Text-based formats
-		"application/json",
-		"application/xml",
-		"application/javascript",
-		"application/x-yaml",
-		"application/x-sh",
-		"application/sql",
-		// Binary formats with normalisers
-		"application/pdf",
-	}
-
-	for _, t := range downloadTypes {
+	for _, t := range downloadMIMETypes {
 		if mimeType == t {
 			return true
 		}
@@ -144,6 +151,9 @@ var mimeTypes = map[string]string{
 	".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
 	".ppt":  "application/vnd.ms-powerpoint",
 	".pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	".odt":  "application/vnd.oasis.opendocument.text",
+	".ods":  "application/vnd.oasis.opendocument.spreadsheet",
+	".odp":  "application/vnd.oasis.opendocument.presentation",
 
 	// Images
 	".jpg":  "image/jpeg",
@@ -159,6 +169,22 @@ var mimeTypes = map[string]string{
 	".gz":  "application/gzip",
 }
 
+// EmittedMIMETypes returns the MIME types Dropbox documents carry content for.
+// Derived from the extension map, so it stays in sync with getMIMEType; types
+// whose content is not downloaded, such as images and archives, are left out.
+func EmittedMIMETypes() []string {
+	seen := make(map[string]bool, len(mimeTypes))
+	types := make([]string, 0, len(mimeTypes))
+	for _, t := range mimeTypes {
+		if !seen[t] && shouldDownloadContent(t) {
+			seen[t] = true
+			types = append(types, t)
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
 // getMIMEType guesses MIME type from file extension.
 // Dropbox API doesn't always provide MIME type so we infer from extension.
 // Use getMIMETypeWithContent when content is available for better detection.
@@ -187,6 +213,3 @@ func getMIMETypeWithContent(filename string, content []byte) string {
 
 	return "application/octet-stream"
 }
-
-// MaxContentSize is the maximum file size to download (5MB).
-const MaxContentSize = 5 * 1024 * 1024
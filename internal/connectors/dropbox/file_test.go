@@ -226,6 +226,8 @@ func TestShouldDownloadContent(t *testing.T) {
 		{"application/x-sh", "application/x-sh", true},
 		{"application/sql", "application/sql", true},
 		{"application/pdf", "application/pdf", true},
+		{"docx", "application/vnd.openxmlformats-officedocument.wordprocessingml.document", true},
+		{"odt", "application/vnd.oasis.opendocument.text", true},
 
 		// Binary types (should not download)
 		{"image/png", "image/png", false},
@@ -284,6 +286,9 @@ func TestGetMIMEType(t *testing.T) {
 		{"spreadsheet.xlsx", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"},
 		{"presentation.ppt", "application/vnd.ms-powerpoint"},
 		{"presentation.pptx", "application/vnd.openxmlformats-officedocument.presentationml.presentation"},
+		{"document.odt", "application/vnd.oasis.opendocument.text"},
+		{"spreadsheet.ods", "application/vnd.oasis.opendocument.spreadsheet"},
+		{"presentation.odp", "application/vnd.oasis.opendocument.presentation"},
 
 		// Images
 		{"photo.jpg", "image/jpeg"},
@@ -312,11 +317,6 @@ func TestGetMIMEType(t *testing.T) {
 	}
 }
 
-func TestMaxContentSize(t *testing.T) {
-	// Verify the constant is set correctly (5MB)
-	assert.Equal(t, 5*1024*1024, MaxContentSize)
-}
-
 func TestGetMIMETypeWithContent(t *testing.T) {
 	t.Run("extension takes priority over content detection", func(t *testing.T) {
 		// Even if content looks like HTML, .txt extension should win
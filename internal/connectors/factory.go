@@ -17,6 +17,10 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/connectors/microsoft/onedrive"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/microsoft/outlook"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/notion"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/slack"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/trello"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/web"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/youtube"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
@@ -26,9 +30,7 @@ var _ driven.ConnectorFactory = (*Factory)(nil)
 
 // TokenProviderFactory creates TokenProviders for sources.
 // This interface is satisfied by auth.Factory.
-type TokenProviderFactory interface {
-	CreateTokenProvider(ctx context.Context, source *domain.Source) (driven.TokenProvider, error)
-}
+type TokenProviderFactory = driven.TokenProviderFactory
 
 // Factory creates connectors based on source configuration.
 type Factory struct {
@@ -36,6 +38,8 @@ type Factory struct {
 	builders             map[string]driven.ConnectorBuilder
 	oauthHandlers        map[string]OAuthHandler
 	tokenProviderFactory TokenProviderFactory
+	apiBudget            driven.APIBudget
+	locale               string
 }
 
 // NewFactory creates a new connector factory with default builders registered.
@@ -58,7 +62,9 @@ func (f *Factory) registerDefaultBuilders() {
 		if !ok {
 			return nil, fmt.Errorf("filesystem source requires 'path' config")
 		}
-		return filesystem.New(source.ID, path), nil
+		conn := filesystem.New(source.ID, path)
+		conn.SetExclusionPatterns(source.ExclusionPatterns())
+		return conn, nil
 	})
 
 	f.Register("github", func(source domain.Source, tokenProvider driven.TokenProvider) (driven.Connector, error) {
@@ -146,6 +152,44 @@ func (f *Factory) registerDefaultBuilders() {
 		}
 		return notion.New(source.ID, cfg, tokenProvider), nil
 	})
+
+	f.Register("trello", func(
+		source domain.Source, tokenProvider driven.TokenProvider,
+	) (driven.Connector, error) {
+		cfg, err := trello.ParseConfig(source)
+		if err != nil {
+			return nil, fmt.Errorf("trello config: %w", err)
+		}
+		return trello.New(source.ID, cfg, tokenProvider), nil
+	})
+
+	f.Register("slack", func(
+		source domain.Source, tokenProvider driven.TokenProvider,
+	) (driven.Connector, error) {
+		cfg, err := slack.ParseConfig(source)
+		if err != nil {
+			return nil, fmt.Errorf("slack config: %w", err)
+		}
+		return slack.New(source.ID, cfg, tokenProvider), nil
+	})
+
+	f.Register("youtube", func(
+		source domain.Source, tokenProvider driven.TokenProvider,
+	) (driven.Connector, error) {
+		cfg, err := youtube.ParseConfig(source)
+		if err != nil {
+			return nil, fmt.Errorf("youtube config: %w", err)
+		}
+		return youtube.New(source.ID, cfg, tokenProvider), nil
+	})
+
+	f.Register("web", func(source domain.Source, _ driven.TokenProvider) (driven.Connector, error) {
+		cfg, err := web.ParseConfig(source)
+		if err != nil {
+			return nil, fmt.Errorf("web config: %w", err)
+		}
+		return web.New(source.ID, cfg), nil
+	})
 }
 
 // registerOAuthHandlers registers OAuth handlers for all connector types that support OAuth.
@@ -170,6 +214,9 @@ func (f *Factory) registerOAuthHandlers() {
 
 	// Notion OAuth handler
 	f.RegisterOAuthHandler("notion", notion.NewOAuthHandler())
+
+	// Slack OAuth handler
+	f.RegisterOAuthHandler("slack", slack.NewOAuthHandler())
 }
 
 // Create instantiates a connector for the given source.
@@ -188,7 +235,40 @@ func (f *Factory) Create(ctx context.Context, source domain.Source) (driven.Conn
 		return nil, fmt.Errorf("create token provider for source %s: %w", source.ID, err)
 	}
 
-	return builder(source, tokenProvider)
+	conn, err := builder(source, tokenProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.RLock()
+	apiBudget, locale := f.apiBudget, f.locale
+	f.mu.RUnlock()
+	if aware, ok := conn.(driven.APIBudgetAware); ok && apiBudget != nil {
+		aware.SetAPIBudget(apiBudget)
+	}
+	if aware, ok := conn.(driven.LocaleAware); ok && locale != "" {
+		aware.SetLocale(locale)
+	}
+
+	return conn, nil
+}
+
+// SetAPIBudget sets a budget shared by all connectors created afterwards,
+// bounding their combined outbound API load. Connectors that do not
+// implement driven.APIBudgetAware are not charged to it.
+func (f *Factory) SetAPIBudget(apiBudget driven.APIBudget) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.apiBudget = apiBudget
+}
+
+// SetLocale sets the locale sent as Accept-Language by all connectors
+// created afterwards, unless a source configures its own. Connectors that
+// do not implement driven.LocaleAware do not send it.
+func (f *Factory) SetLocale(locale string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.locale = locale
 }
 
 // Register adds a connector builder for the given type.
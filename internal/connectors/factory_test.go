@@ -3,12 +3,17 @@ package connectors
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/custodia-labs/sercha-cli/internal/connectors/budget"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
@@ -27,6 +32,7 @@ func (p *mockTokenProvider) GetToken(_ context.Context) (string, error) { return
 func (p *mockTokenProvider) AuthorizationID() string                    { return "local" }
 func (p *mockTokenProvider) AuthMethod() domain.AuthMethod              { return domain.AuthMethodNone }
 func (p *mockTokenProvider) IsAuthenticated() bool                      { return true }
+func (p *mockTokenProvider) RefreshIfNeeded(_ context.Context) error    { return nil }
 
 // mockConnector implements the driven.Connector interface for testing.
 type mockConnector struct {
@@ -320,8 +326,8 @@ func TestFactory_SupportedTypes(t *testing.T) {
 		supportedTypes := factory.SupportedTypes()
 
 		// All default connectors: filesystem, github, google-drive, gmail, google-calendar,
-		// outlook, onedrive, microsoft-calendar, dropbox, notion
-		assert.Len(t, supportedTypes, 10)
+		// outlook, onedrive, microsoft-calendar, dropbox, notion, trello, slack, youtube, web
+		assert.Len(t, supportedTypes, 14)
 		assert.Contains(t, supportedTypes, "filesystem")
 		assert.Contains(t, supportedTypes, "github")
 		assert.Contains(t, supportedTypes, "google-drive")
@@ -332,6 +338,10 @@ func TestFactory_SupportedTypes(t *testing.T) {
 		assert.Contains(t, supportedTypes, "microsoft-calendar")
 		assert.Contains(t, supportedTypes, "dropbox")
 		assert.Contains(t, supportedTypes, "notion")
+		assert.Contains(t, supportedTypes, "trello")
+		assert.Contains(t, supportedTypes, "slack")
+		assert.Contains(t, supportedTypes, "youtube")
+		assert.Contains(t, supportedTypes, "web")
 	})
 
 	t.Run("returns empty slice for factory with no builders", func(t *testing.T) {
@@ -437,3 +447,127 @@ func TestFactory_ConcurrentCreateAndRegister(t *testing.T) {
 		assert.GreaterOrEqual(t, len(supportedTypes), 6) // At least filesystem + 5 custom types
 	})
 }
+
+// budgetedConnector is a mockConnector whose FullSync requests url through
+// the injected API budget.
+type budgetedConnector struct {
+	mockConnector
+	url       string
+	requests  int
+	apiBudget driven.APIBudget
+}
+
+func (c *budgetedConnector) SetAPIBudget(apiBudget driven.APIBudget) {
+	c.apiBudget = apiBudget
+}
+
+func (c *budgetedConnector) FullSync(ctx context.Context) (<-chan domain.RawDocument, <-chan error) {
+	docs := make(chan domain.RawDocument)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(docs)
+		defer close(errs)
+		client := &http.Client{Transport: budget.Transport(nil, c.apiBudget)}
+		for i := 0; i < c.requests; i++ {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, http.NoBody)
+			if err != nil {
+				errs <- err
+				return
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				errs <- err
+				return
+			}
+			resp.Body.Close()
+		}
+	}()
+	return docs, errs
+}
+
+func TestFactory_SetAPIBudget(t *testing.T) {
+	var current, peak, total atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+		total.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	factory := NewFactory(&mockTokenProviderFactory{})
+	factory.Register("budgeted", func(source domain.Source, _ driven.TokenProvider) (driven.Connector, error) {
+		return &budgetedConnector{
+			mockConnector: mockConnector{sourceID: source.ID, connType: source.Type},
+			url:           server.URL,
+			requests:      4,
+		}, nil
+	})
+	factory.SetAPIBudget(budget.New(2, 0))
+
+	// Five sources sync at once, each free to make requests concurrently
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		conn, err := factory.Create(context.Background(), domain.Source{ID: fmt.Sprintf("src-%d", i), Type: "budgeted"})
+		require.NoError(t, err)
+		require.NotNil(t, conn.(*budgetedConnector).apiBudget)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			docs, errs := conn.FullSync(context.Background())
+			for range docs {
+			}
+			assert.NoError(t, <-errs)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(20), total.Load())
+	assert.LessOrEqual(t, peak.Load(), int32(2), "connectors together exceeded the budget")
+}
+
+func TestFactory_Create_NoAPIBudget(t *testing.T) {
+	factory := NewFactory(&mockTokenProviderFactory{})
+	factory.Register("budgeted", func(source domain.Source, _ driven.TokenProvider) (driven.Connector, error) {
+		return &budgetedConnector{mockConnector: mockConnector{sourceID: source.ID}}, nil
+	})
+
+	conn, err := factory.Create(context.Background(), domain.Source{ID: "src", Type: "budgeted"})
+
+	require.NoError(t, err)
+	assert.Nil(t, conn.(*budgetedConnector).apiBudget)
+}
+
+// localisedConnector records the locale the factory injects.
+type localisedConnector struct {
+	mockConnector
+	locale string
+}
+
+func (c *localisedConnector) SetLocale(locale string) {
+	c.locale = locale
+}
+
+func TestFactory_SetLocale(t *testing.T) {
+	factory := NewFactory(&mockTokenProviderFactory{})
+	factory.Register("localised", func(source domain.Source, _ driven.TokenProvider) (driven.Connector, error) {
+		return &localisedConnector{mockConnector: mockConnector{sourceID: source.ID}}, nil
+	})
+
+	conn, err := factory.Create(context.Background(), domain.Source{ID: "src-1", Type: "localised"})
+	require.NoError(t, err)
+	assert.Empty(t, conn.(*localisedConnector).locale)
+
+	factory.SetLocale("en-GB")
+	conn, err = factory.Create(context.Background(), domain.Source{ID: "src-2", Type: "localised"})
+	require.NoError(t, err)
+	assert.Equal(t, "en-GB", conn.(*localisedConnector).locale)
+}
@@ -26,6 +26,7 @@ var _ driven.Connector = (*Connector)(nil)
 type Connector struct {
 	sourceID string
 	rootPath string
+	exclude  []string
 	watcher  *fsnotify.Watcher
 	mu       sync.Mutex
 	closed   bool
@@ -72,6 +73,12 @@ func New(sourceID, rootPath string) *Connector {
 	}
 }
 
+// SetExclusionPatterns sets glob patterns for files and directories to skip.
+// Patterns match paths relative to the root directory, or their final element.
+func (c *Connector) SetExclusionPatterns(patterns []string) {
+	c.exclude = patterns
+}
+
 // Type returns the connector type identifier.
 func (c *Connector) Type() string {
 	return "filesystem"
@@ -118,7 +125,6 @@ func (c *Connector) Validate(ctx context.Context) error {
 	}
 
 	// Verify root path exists
-	fmt.Print(c.rootPath)
 	info, err := os.Stat(c.rootPath)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -178,6 +184,14 @@ func (c *Connector) FullSync(ctx context.Context) (docs <-chan domain.RawDocumen
 				return nil
 			}
 
+			// Skip excluded files and directories
+			if c.isExcluded(path) {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
 			// Skip directories
 			if d.IsDir() {
 				return nil
@@ -248,6 +262,29 @@ func (c *Connector) readFile(path string) (*domain.RawDocument, error) {
 	}, nil
 }
 
+// EmittedMIMETypes returns the MIME types detectMIMEType maps explicitly.
+// Other extensions fall through to the system MIME database and are not listed.
+func EmittedMIMETypes() []string {
+	return []string{
+		"text/plain",
+		"text/markdown",
+		"text/x-go",
+		"text/x-python",
+		"text/x-rust",
+		"text/typescript",
+		"text/typescript-jsx",
+		"text/javascript-jsx",
+		"text/yaml",
+		"text/toml",
+		"text/x-shellscript",
+		"text/x-sql",
+		"application/xml",
+		"application/vnd.oasis.opendocument.text",
+		"application/vnd.oasis.opendocument.spreadsheet",
+		"application/vnd.oasis.opendocument.presentation",
+	}
+}
+
 // detectMIMEType returns the MIME type for a file based on its extension.
 // Code and text file extensions are checked first because system MIME databases
 // often map these to incorrect types (e.g., .ts to video/mp2t, .rs to RLS services).
@@ -286,6 +323,12 @@ func detectMIMEType(path string) string {
 		return "text/x-sql"
 	case ".xml":
 		return "application/xml" // Normalised: Linux returns text/xml, macOS returns application/xml
+	case ".odt":
+		return "application/vnd.oasis.opendocument.text" // Missing from some system MIME databases
+	case ".ods":
+		return "application/vnd.oasis.opendocument.spreadsheet"
+	case ".odp":
+		return "application/vnd.oasis.opendocument.presentation"
 	}
 
 	// Use Go's mime package for standard types (images, documents, etc.)
@@ -312,6 +355,19 @@ func isHidden(path string) bool {
 	return false
 }
 
+// isExcluded returns true if the path, relative to the root directory,
+// matches one of the exclusion patterns. The root itself is never excluded.
+func (c *Connector) isExcluded(path string) bool {
+	if len(c.exclude) == 0 {
+		return false
+	}
+	rel, err := filepath.Rel(c.rootPath, path)
+	if err != nil || rel == "." {
+		return false
+	}
+	return domain.MatchesExclusionPattern(filepath.ToSlash(rel), c.exclude)
+}
+
 // IncrementalSync syncs changes since the last sync state.
 // The cursor is a Unix timestamp in nanoseconds representing the last sync time.
 // Only files modified after this time are included.
@@ -371,6 +427,13 @@ func (c *Connector) IncrementalSync(
 				return nil
 			}
 
+			if c.isExcluded(path) {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
 			if d.IsDir() {
 				return nil
 			}
@@ -467,7 +530,7 @@ func (c *Connector) Watch(ctx context.Context) (<-chan domain.RawDocumentChange,
 			return nil
 		}
 		if d.IsDir() {
-			if isHidden(path) {
+			if isHidden(path) || c.isExcluded(path) {
 				return filepath.SkipDir
 			}
 			if err := watcher.Add(path); err != nil {
@@ -508,7 +571,8 @@ func (c *Connector) Watch(ctx context.Context) (<-chan domain.RawDocumentChange,
 
 				// If a new directory was created, add it to the watcher
 				if event.Op&fsnotify.Create != 0 {
-					if info, err := os.Stat(event.Name); err == nil && info.IsDir() && !isHidden(event.Name) {
+					if info, err := os.Stat(event.Name); err == nil && info.IsDir() &&
+						!isHidden(event.Name) && !c.isExcluded(event.Name) {
 						_ = watcher.Add(event.Name) //nolint:errcheck // best-effort directory watching
 					}
 				}
@@ -530,8 +594,8 @@ func (c *Connector) Watch(ctx context.Context) (<-chan domain.RawDocumentChange,
 func (c *Connector) handleFsEvent(event fsnotify.Event) *domain.RawDocumentChange {
 	path := event.Name
 
-	// Skip hidden files
-	if isHidden(path) {
+	// Skip hidden and excluded files
+	if isHidden(path) || c.isExcluded(path) {
 		return nil
 	}
 
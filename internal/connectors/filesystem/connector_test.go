@@ -169,6 +169,30 @@ func TestConnector_FullSync(t *testing.T) {
 		assert.Contains(t, docs[0].URI, "visible.txt")
 	})
 
+	t.Run("skips excluded files and directories", func(t *testing.T) {
+		tempDir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(tempDir, "node_modules", "pkg"), 0755))
+		require.NoError(t, os.MkdirAll(filepath.Join(tempDir, "docs", "build"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, "readme.md"), []byte("readme"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, "debug.log"), []byte("log"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, "docs", "guide.md"), []byte("guide"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, "docs", "build", "out.md"), []byte("out"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, "node_modules", "pkg", "index.js"), []byte("js"), 0644))
+
+		connector := New("test-source", tempDir)
+		connector.SetExclusionPatterns([]string{"*.log", "node_modules", "docs/build"})
+
+		docsChan, _ := connector.FullSync(context.Background())
+
+		var names []string
+		for doc := range docsChan {
+			rel, err := filepath.Rel(tempDir, doc.URI)
+			require.NoError(t, err)
+			names = append(names, filepath.ToSlash(rel))
+		}
+		assert.ElementsMatch(t, []string{"readme.md", "docs/guide.md"}, names)
+	})
+
 	t.Run("handles non-existent directory", func(t *testing.T) {
 		connector := New("test-source", "/non/existent/path")
 		ctx := context.Background()
@@ -934,6 +958,9 @@ func TestDetectMIMEType(t *testing.T) {
 		{"script.sh", "text/x-shellscript"},
 		{"script.bash", "text/x-shellscript"},
 		{"query.sql", "text/x-sql"},
+		{"notes.odt", "application/vnd.oasis.opendocument.text"},
+		{"budget.ods", "application/vnd.oasis.opendocument.spreadsheet"},
+		{"slides.odp", "application/vnd.oasis.opendocument.presentation"},
 
 		// Standard MIME types (from Go's mime package)
 		{"data.json", "application/json"},
@@ -1031,6 +1058,23 @@ func TestIsHidden(t *testing.T) {
 }
 
 // TestHandleFsEvent tests the handleFsEvent function with various event types.
+func TestConnector_IsExcluded(t *testing.T) {
+	root := t.TempDir()
+	connector := New("test-source", root)
+	assert.False(t, connector.isExcluded(filepath.Join(root, "app.log")), "no patterns exclude nothing")
+
+	connector.SetExclusionPatterns([]string{"*.log", "vendor"})
+
+	assert.True(t, connector.isExcluded(filepath.Join(root, "logs", "app.log")))
+	assert.True(t, connector.isExcluded(filepath.Join(root, "vendor")))
+	assert.False(t, connector.isExcluded(filepath.Join(root, "notes.md")))
+	assert.False(t, connector.isExcluded(root))
+
+	// Excluded files produce no watch events
+	require.NoError(t, os.WriteFile(filepath.Join(root, "app.log"), []byte("log"), 0644))
+	assert.Nil(t, connector.handleFsEvent(fsnotify.Event{Name: filepath.Join(root, "app.log"), Op: fsnotify.Write}))
+}
+
 func TestHandleFsEvent(t *testing.T) {
 	tests := []struct {
 		name           string
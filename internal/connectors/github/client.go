@@ -2,15 +2,20 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"sync"
 	"time"
 
 	gh "github.com/google/go-github/v80/github"
 	"golang.org/x/oauth2"
 
+	"github.com/custodia-labs/sercha-cli/internal/connectors/budget"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/locale"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
@@ -27,9 +32,12 @@ const (
 
 // Client wraps the go-github client with helper methods.
 type Client struct {
+	mu            sync.Mutex // Guards lazy initialisation of gh
 	gh            *gh.Client
 	tokenProvider driven.TokenProvider
 	rateLimiter   *RateLimiter
+	apiBudget     driven.APIBudget
+	locale        string
 }
 
 // NewClient creates a new GitHub API client with a token provider.
@@ -43,6 +51,9 @@ func NewClient(tokenProvider driven.TokenProvider) *Client {
 // ensureClient initializes the go-github client if not already done.
 // This is called lazily so we can get the token when needed.
 func (c *Client) ensureClient(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if c.gh != nil {
 		return nil
 	}
@@ -55,7 +66,8 @@ func (c *Client) ensureClient(ctx context.Context) error {
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: token},
 	)
-	tc := oauth2.NewClient(ctx, ts)
+	tc := budget.NewOAuth2Client(ctx, ts, c.apiBudget)
+	tc.Transport = locale.Transport(tc.Transport, c.locale)
 	tc.Timeout = DefaultTimeout
 	c.gh = gh.NewClient(tc)
 
@@ -98,7 +110,9 @@ func (c *Client) ListAllAccessibleRepos(ctx context.Context) ([]*gh.Repository,
 			return nil, fmt.Errorf("rate limit wait: %w", err)
 		}
 
-		repos, resp, err := c.gh.Repositories.ListByAuthenticatedUser(ctx, opts)
+		pageCtx, span := tracing.StartPage(ctx, "github", "repos")
+		repos, resp, err := c.gh.Repositories.ListByAuthenticatedUser(pageCtx, opts)
+		tracing.EndPage(span, err)
 		if err != nil {
 			return nil, c.wrapError(err, "list repos")
 		}
@@ -247,7 +261,9 @@ func (c *Client) ListIssues(
 			return nil, fmt.Errorf("rate limit wait: %w", err)
 		}
 
-		issues, resp, err := c.gh.Issues.ListByRepo(ctx, owner, repo, opts)
+		pageCtx, span := tracing.StartPage(ctx, "github", "issues")
+		issues, resp, err := c.gh.Issues.ListByRepo(pageCtx, owner, repo, opts)
+		tracing.EndPage(span, err)
 		if err != nil {
 			return nil, c.wrapError(err, "list issues")
 		}
@@ -285,7 +301,9 @@ func (c *Client) ListPullRequests(
 			return nil, fmt.Errorf("rate limit wait: %w", err)
 		}
 
-		prs, resp, err := c.gh.PullRequests.List(ctx, owner, repo, opts)
+		pageCtx, span := tracing.StartPage(ctx, "github", "pull_requests")
+		prs, resp, err := c.gh.PullRequests.List(pageCtx, owner, repo, opts)
+		tracing.EndPage(span, err)
 		if err != nil {
 			return nil, c.wrapError(err, "list pull requests")
 		}
@@ -302,6 +320,62 @@ func (c *Client) ListPullRequests(
 	return allPRs, nil
 }
 
+// graphQLRequest is the body of a GraphQL API request.
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+// graphQLResponse is the body of a GraphQL API response.
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []graphQLError  `json:"errors"`
+}
+
+// graphQLError is an error reported in a GraphQL API response.
+type graphQLError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// GraphQL runs a GraphQL API query and decodes its data into out. Content
+// only available through GraphQL, such as discussions, is fetched this way.
+func (c *Client) GraphQL(ctx context.Context, query string, variables map[string]any, out any) error {
+	if err := c.ensureClient(ctx); err != nil {
+		return err
+	}
+
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return fmt.Errorf("rate limit wait: %w", err)
+	}
+
+	req, err := c.gh.NewRequest(http.MethodPost, "graphql", graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return fmt.Errorf("build graphql request: %w", err)
+	}
+
+	var body graphQLResponse
+	resp, err := c.gh.Do(ctx, req, &body)
+	if err != nil {
+		return c.wrapError(err, "graphql query")
+	}
+
+	c.updateRateLimitFromResponse(resp)
+
+	// GraphQL reports errors such as missing repositories with a 200 status
+	if len(body.Errors) > 0 {
+		if body.Errors[0].Type == "NOT_FOUND" {
+			return &APIError{StatusCode: http.StatusNotFound, Message: body.Errors[0].Message, URL: req.URL.String()}
+		}
+		return fmt.Errorf("graphql query: %s", body.Errors[0].Message)
+	}
+
+	if err := json.Unmarshal(body.Data, out); err != nil {
+		return fmt.Errorf("decode graphql response: %w", err)
+	}
+	return nil
+}
+
 // RateLimit returns the current rate limit status.
 func (c *Client) RateLimit(ctx context.Context) (*gh.RateLimits, error) {
 	if err := c.ensureClient(ctx); err != nil {
@@ -1,6 +1,7 @@
 package github
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -10,26 +11,31 @@ import (
 type ContentType string
 
 const (
-	ContentFiles  ContentType = "files"
-	ContentIssues ContentType = "issues"
-	ContentPRs    ContentType = "prs"
-	ContentWikis  ContentType = "wikis"
+	ContentFiles       ContentType = "files"
+	ContentIssues      ContentType = "issues"
+	ContentPRs         ContentType = "prs"
+	ContentWikis       ContentType = "wikis"
+	ContentDiscussions ContentType = "discussions"
 )
 
 // AllContentTypes returns all supported content types.
 func AllContentTypes() []ContentType {
-	return []ContentType{ContentFiles, ContentIssues, ContentPRs, ContentWikis}
+	return []ContentType{ContentFiles, ContentIssues, ContentPRs, ContentWikis, ContentDiscussions}
 }
 
 // Config holds the parsed configuration for a GitHub source.
 type Config struct {
 	// ContentTypes specifies what content to index.
-	// Default: all types (files, issues, prs, wikis)
+	// Default: all types (files, issues, prs, wikis, discussions)
 	ContentTypes []ContentType
 
 	// FilePatterns are glob patterns for file filtering.
 	// Default: all files
 	FilePatterns []string
+
+	// ParallelFetch fetches each repository's content types concurrently.
+	// Default: false
+	ParallelFetch bool
 }
 
 // ParseConfig parses a source's config map into a Config struct.
@@ -54,6 +60,15 @@ func ParseConfig(source domain.Source) (*Config, error) {
 		cfg.FilePatterns = parsePatterns(patterns)
 	}
 
+	// Parse parallel_within_source (optional)
+	if parallel, ok := source.Config[domain.ConfigKeyParallelWithinSource]; ok && parallel != "" {
+		enabled, err := strconv.ParseBool(parallel)
+		if err != nil {
+			return nil, ErrConfigInvalidParallel
+		}
+		cfg.ParallelFetch = enabled
+	}
+
 	return cfg, nil
 }
 
@@ -62,10 +77,11 @@ func parseContentTypes(s string) ([]ContentType, error) {
 	parts := strings.Split(s, ",")
 	types := make([]ContentType, 0, len(parts))
 	valid := map[string]ContentType{
-		"files":  ContentFiles,
-		"issues": ContentIssues,
-		"prs":    ContentPRs,
-		"wikis":  ContentWikis,
+		"files":       ContentFiles,
+		"issues":      ContentIssues,
+		"prs":         ContentPRs,
+		"wikis":       ContentWikis,
+		"discussions": ContentDiscussions,
 	}
 
 	for _, part := range parts {
@@ -10,8 +10,12 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
-// Ensure Connector implements the interface.
-var _ driven.Connector = (*Connector)(nil)
+// Ensure Connector implements the interfaces.
+var (
+	_ driven.Connector      = (*Connector)(nil)
+	_ driven.APIBudgetAware = (*Connector)(nil)
+	_ driven.LocaleAware    = (*Connector)(nil)
+)
 
 // Connector fetches documents from GitHub repositories.
 type Connector struct {
@@ -33,6 +37,16 @@ func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Conn
 	}
 }
 
+// SetAPIBudget charges the connector's API requests to a shared budget.
+func (c *Connector) SetAPIBudget(apiBudget driven.APIBudget) {
+	c.client.apiBudget = apiBudget
+}
+
+// SetLocale sends locale as Accept-Language on the connector's API requests.
+func (c *Connector) SetLocale(loc string) {
+	c.client.locale = loc
+}
+
 // Type returns the connector type identifier.
 func (c *Connector) Type() string {
 	return "github"
@@ -46,16 +60,17 @@ func (c *Connector) SourceID() string {
 // Capabilities returns the connector's capabilities.
 func (c *Connector) Capabilities() driven.ConnectorCapabilities {
 	return driven.ConnectorCapabilities{
-		SupportsIncremental:  true,
-		SupportsWatch:        false, // No webhooks in CLI
-		SupportsHierarchy:    true,  // Files have directories
-		SupportsBinary:       false, // Text only
-		RequiresAuth:         true,
-		SupportsValidation:   true,
-		SupportsCursorReturn: true,
-		SupportsPartialSync:  true, // Can resume
-		SupportsRateLimiting: true,
-		SupportsPagination:   true,
+		SupportsIncremental:   true,
+		SupportsWatch:         false, // No webhooks in CLI
+		SupportsHierarchy:     true,  // Files have directories
+		SupportsBinary:        false, // Text only
+		RequiresAuth:          true,
+		SupportsValidation:    true,
+		SupportsCursorReturn:  true,
+		SupportsPartialSync:   true, // Can resume
+		SupportsRateLimiting:  true,
+		SupportsPagination:    true,
+		SupportsParallelFetch: true,
 	}
 }
 
@@ -116,6 +131,18 @@ func (c *Connector) FullSync(ctx context.Context) (<-chan domain.RawDocument, <-
 		// Filter repositories
 		repos = FilterRepos(repos, false, false)
 
+		// emit sends documents until the context is cancelled.
+		emit := func(docs []domain.RawDocument) {
+			for _, doc := range docs {
+				doc.SourceID = c.sourceID
+				select {
+				case <-ctx.Done():
+					return
+				case docsChan <- doc:
+				}
+			}
+		}
+
 		// Sync each repository.
 		for _, repo := range repos {
 			select {
@@ -129,68 +156,67 @@ func (c *Connector) FullSync(ctx context.Context) (<-chan domain.RawDocument, <-
 			owner := repo.GetOwner().GetLogin()
 			name := repo.GetName()
 
+			// Each fetch only writes its own cursor field, so they may run concurrently.
+			var fetches []func()
+
 			// Fetch files if enabled.
 			if c.config.HasContentType(ContentFiles) {
-				docs, treeSHA, err := FetchFiles(ctx, c.client, repo, c.config)
-				if err == nil || IsNotFound(err) {
-					repoCursor.FilesTreeSHA = treeSHA
-					for _, doc := range docs {
-						doc.SourceID = c.sourceID
-						select {
-						case <-ctx.Done():
-							return
-						case docsChan <- doc:
-						}
+				fetches = append(fetches, func() {
+					docs, treeSHA, err := FetchFiles(ctx, c.client, repo, c.config)
+					if err == nil || IsNotFound(err) {
+						repoCursor.FilesTreeSHA = treeSHA
+						emit(docs)
 					}
-				}
+				})
 			}
 
 			// Fetch issues if enabled.
 			if c.config.HasContentType(ContentIssues) {
-				docs, latestUpdate, err := FetchIssues(ctx, c.client, repo, time.Time{})
-				if err == nil || IsNotFound(err) {
-					repoCursor.IssuesSince = latestUpdate
-					for _, doc := range docs {
-						doc.SourceID = c.sourceID
-						select {
-						case <-ctx.Done():
-							return
-						case docsChan <- doc:
-						}
+				fetches = append(fetches, func() {
+					docs, latestUpdate, err := FetchIssues(ctx, c.client, repo, time.Time{})
+					if err == nil || IsNotFound(err) {
+						repoCursor.IssuesSince = latestUpdate
+						emit(docs)
 					}
-				}
+				})
 			}
 
 			// Fetch PRs if enabled.
 			if c.config.HasContentType(ContentPRs) {
-				docs, latestUpdate, err := FetchPullRequests(ctx, c.client, repo, time.Time{})
-				if err == nil || IsNotFound(err) {
-					repoCursor.PRsSince = latestUpdate
-					for _, doc := range docs {
-						doc.SourceID = c.sourceID
-						select {
-						case <-ctx.Done():
-							return
-						case docsChan <- doc:
-						}
+				fetches = append(fetches, func() {
+					docs, latestUpdate, err := FetchPullRequests(ctx, c.client, repo, time.Time{})
+					if err == nil || IsNotFound(err) {
+						repoCursor.PRsSince = latestUpdate
+						emit(docs)
 					}
-				}
+				})
 			}
 
 			// Fetch wiki if enabled.
 			if c.config.HasContentType(ContentWikis) {
-				docs, wikiSHA, err := FetchWikiPages(ctx, c.client, repo)
-				if err == nil {
-					repoCursor.WikiCommitSHA = wikiSHA
-					for _, doc := range docs {
-						doc.SourceID = c.sourceID
-						select {
-						case <-ctx.Done():
-							return
-						case docsChan <- doc:
-						}
+				fetches = append(fetches, func() {
+					docs, wikiSHA, err := FetchWikiPages(ctx, c.client, repo)
+					if err == nil {
+						repoCursor.WikiCommitSHA = wikiSHA
+						emit(docs)
 					}
-				}
+				})
+			}
+
+			// Fetch discussions if enabled.
+			if c.config.HasContentType(ContentDiscussions) {
+				fetches = append(fetches, func() {
+					docs, latestUpdate, err := FetchDiscussions(ctx, c.client, repo, time.Time{})
+					if err == nil || IsNotFound(err) {
+						repoCursor.DiscussionsSince = latestUpdate
+						emit(docs)
+					}
+				})
+			}
+
+			c.runFetches(ctx, fetches)
+			if ctx.Err() != nil {
+				return
 			}
 
 			// Save repo cursor.
@@ -241,6 +267,21 @@ func (c *Connector) IncrementalSync(
 
 		repos = FilterRepos(repos, false, false)
 
+		// emit sends documents as updates until the context is cancelled.
+		emit := func(docs []domain.RawDocument) {
+			for _, doc := range docs {
+				doc.SourceID = c.sourceID
+				select {
+				case <-ctx.Done():
+					return
+				case changesChan <- domain.RawDocumentChange{
+					Type:     domain.ChangeUpdated,
+					Document: doc,
+				}:
+				}
+			}
+		}
+
 		// Sync each repository.
 		for _, repo := range repos {
 			select {
@@ -254,89 +295,78 @@ func (c *Connector) IncrementalSync(
 			branch := repo.GetDefaultBranch()
 			repoCursor := cursor.GetRepoCursor(owner, name)
 
+			// Each fetch only writes its own cursor field, so they may run concurrently.
+			var fetches []func()
+
 			// Fetch updated files if enabled.
 			if c.config.HasContentType(ContentFiles) {
-				// For files, we compare tree SHAs.
-				currentTree, err := GetTree(ctx, c.client, owner, name, branch)
-				if err == nil && currentTree.GetSHA() != repoCursor.FilesTreeSHA {
-					// Tree changed, refetch all files (could optimize with diff).
-					docs, treeSHA, err := FetchFiles(ctx, c.client, repo, c.config)
-					if err == nil {
-						repoCursor.FilesTreeSHA = treeSHA
-						for _, doc := range docs {
-							doc.SourceID = c.sourceID
-							select {
-							case <-ctx.Done():
-								return
-							case changesChan <- domain.RawDocumentChange{
-								Type:     domain.ChangeUpdated,
-								Document: doc,
-							}:
-							}
+				fetches = append(fetches, func() {
+					// For files, we compare tree SHAs.
+					currentTree, err := GetTree(ctx, c.client, owner, name, branch)
+					if err == nil && currentTree.GetSHA() != repoCursor.FilesTreeSHA {
+						// Tree changed, refetch all files (could optimize with diff).
+						docs, treeSHA, err := FetchFiles(ctx, c.client, repo, c.config)
+						if err == nil {
+							repoCursor.FilesTreeSHA = treeSHA
+							emit(docs)
 						}
 					}
-				}
+				})
 			}
 
 			// Fetch updated issues if enabled.
 			if c.config.HasContentType(ContentIssues) {
-				docs, latestUpdate, err := FetchIssues(ctx, c.client, repo, repoCursor.IssuesSince)
-				if err == nil {
-					if !latestUpdate.IsZero() {
-						repoCursor.IssuesSince = latestUpdate
-					}
-					for _, doc := range docs {
-						doc.SourceID = c.sourceID
-						select {
-						case <-ctx.Done():
-							return
-						case changesChan <- domain.RawDocumentChange{
-							Type:     domain.ChangeUpdated,
-							Document: doc,
-						}:
+				fetches = append(fetches, func() {
+					docs, latestUpdate, err := FetchIssues(ctx, c.client, repo, repoCursor.IssuesSince)
+					if err == nil {
+						if !latestUpdate.IsZero() {
+							repoCursor.IssuesSince = latestUpdate
 						}
+						emit(docs)
 					}
-				}
+				})
 			}
 
 			// Fetch updated PRs if enabled.
 			if c.config.HasContentType(ContentPRs) {
-				docs, latestUpdate, err := FetchPullRequests(ctx, c.client, repo, repoCursor.PRsSince)
-				if err == nil {
-					if !latestUpdate.IsZero() {
-						repoCursor.PRsSince = latestUpdate
-					}
-					for _, doc := range docs {
-						doc.SourceID = c.sourceID
-						select {
-						case <-ctx.Done():
-							return
-						case changesChan <- domain.RawDocumentChange{
-							Type:     domain.ChangeUpdated,
-							Document: doc,
-						}:
+				fetches = append(fetches, func() {
+					docs, latestUpdate, err := FetchPullRequests(ctx, c.client, repo, repoCursor.PRsSince)
+					if err == nil {
+						if !latestUpdate.IsZero() {
+							repoCursor.PRsSince = latestUpdate
 						}
+						emit(docs)
 					}
-				}
+				})
 			}
 
 			// Fetch updated wiki if enabled.
 			if c.config.HasContentType(ContentWikis) {
-				docs, wikiSHA, err := FetchWikiPages(ctx, c.client, repo)
-				if err == nil && wikiSHA != repoCursor.WikiCommitSHA {
-					repoCursor.WikiCommitSHA = wikiSHA
-					for _, doc := range docs {
-						doc.SourceID = c.sourceID
-						select {
-						case <-ctx.Done():
-							return
-						case changesChan <- domain.RawDocumentChange{
-							Type:     domain.ChangeUpdated,
-							Document: doc,
-						}:
+				fetches = append(fetches, func() {
+					docs, wikiSHA, err := FetchWikiPages(ctx, c.client, repo)
+					if err == nil && wikiSHA != repoCursor.WikiCommitSHA {
+						repoCursor.WikiCommitSHA = wikiSHA
+						emit(docs)
+					}
+				})
+			}
+
+			// Fetch updated discussions if enabled.
+			if c.config.HasContentType(ContentDiscussions) {
+				fetches = append(fetches, func() {
+					docs, latestUpdate, err := FetchDiscussions(ctx, c.client, repo, repoCursor.DiscussionsSince)
+					if err == nil {
+						if !latestUpdate.IsZero() {
+							repoCursor.DiscussionsSince = latestUpdate
 						}
+						emit(docs)
 					}
-				}
+				})
+			}
+
+			c.runFetches(ctx, fetches)
+			if ctx.Err() != nil {
+				return
 			}
 
 			// Update repo cursor.
@@ -352,6 +382,30 @@ func (c *Connector) IncrementalSync(
 	return changesChan, errsChan
 }
 
+// runFetches runs a repository's content fetches, concurrently when
+// ParallelFetch is enabled. Concurrent fetches share the client's rate limiter.
+func (c *Connector) runFetches(ctx context.Context, fetches []func()) {
+	if !c.config.ParallelFetch || len(fetches) < 2 {
+		for _, fetch := range fetches {
+			if ctx.Err() != nil {
+				return
+			}
+			fetch()
+		}
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, fetch := range fetches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fetch()
+		}()
+	}
+	wg.Wait()
+}
+
 // Watch is not supported for GitHub (no webhooks in CLI).
 func (c *Connector) Watch(_ context.Context) (<-chan domain.RawDocumentChange, error) {
 	return nil, domain.ErrNotImplemented
@@ -4,14 +4,18 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"sync"
 	"testing"
 	"time"
 
 	gh "github.com/google/go-github/v80/github"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
@@ -39,6 +43,10 @@ func (p *mockTokenProvider) IsAuthenticated() bool {
 	return p.token != ""
 }
 
+func (p *mockTokenProvider) RefreshIfNeeded(_ context.Context) error {
+	return nil
+}
+
 func TestNew(t *testing.T) {
 	t.Run("creates connector with valid parameters", func(t *testing.T) {
 		cfg := &Config{
@@ -199,6 +207,21 @@ func TestParseConfig(t *testing.T) {
 		assert.Contains(t, cfg.ContentTypes, ContentWikis)
 	})
 
+	t.Run("parses discussions content type", func(t *testing.T) {
+		source := domain.Source{
+			ID:   "test-source",
+			Type: "github",
+			Config: map[string]string{
+				"content_types": "issues,discussions",
+			},
+		}
+
+		cfg, err := ParseConfig(source)
+
+		require.NoError(t, err)
+		assert.Equal(t, []ContentType{ContentIssues, ContentDiscussions}, cfg.ContentTypes)
+	})
+
 	t.Run("returns error for invalid content types", func(t *testing.T) {
 		source := domain.Source{
 			ID:   "test-source",
@@ -214,6 +237,32 @@ func TestParseConfig(t *testing.T) {
 		assert.Nil(t, cfg)
 		assert.ErrorIs(t, err, ErrConfigInvalidContentType)
 	})
+
+	t.Run("parses parallel_within_source", func(t *testing.T) {
+		source := domain.Source{
+			ID:     "test-source",
+			Type:   "github",
+			Config: map[string]string{"parallel_within_source": "true"},
+		}
+
+		cfg, err := ParseConfig(source)
+
+		require.NoError(t, err)
+		assert.True(t, cfg.ParallelFetch)
+	})
+
+	t.Run("returns error for invalid parallel_within_source", func(t *testing.T) {
+		source := domain.Source{
+			ID:     "test-source",
+			Type:   "github",
+			Config: map[string]string{"parallel_within_source": "sometimes"},
+		}
+
+		cfg, err := ParseConfig(source)
+
+		assert.Nil(t, cfg)
+		assert.ErrorIs(t, err, ErrConfigInvalidParallel)
+	})
 }
 
 func TestCursor(t *testing.T) {
@@ -272,6 +321,17 @@ func TestCursor(t *testing.T) {
 		assert.Equal(t, RepoCursor{}, repoCursor)
 	})
 
+	t.Run("UpdateDiscussionsSince round trips", func(t *testing.T) {
+		since := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+		cursor := NewCursor()
+		cursor.UpdateDiscussionsSince("myorg", "myrepo", since)
+
+		decoded, err := DecodeCursor(cursor.Encode())
+
+		require.NoError(t, err)
+		assert.True(t, since.Equal(decoded.GetRepoCursor("myorg", "myrepo").DiscussionsSince))
+	})
+
 	t.Run("SetRepoCursor updates existing repo", func(t *testing.T) {
 		cursor := &Cursor{
 			Version: 1,
@@ -959,11 +1019,12 @@ func TestAllContentTypes(t *testing.T) {
 	t.Run("returns all supported content types", func(t *testing.T) {
 		types := AllContentTypes()
 
-		assert.Len(t, types, 4)
+		assert.Len(t, types, 5)
 		assert.Contains(t, types, ContentFiles)
 		assert.Contains(t, types, ContentIssues)
 		assert.Contains(t, types, ContentPRs)
 		assert.Contains(t, types, ContentWikis)
+		assert.Contains(t, types, ContentDiscussions)
 	})
 
 	t.Run("returns unique content types", func(t *testing.T) {
@@ -988,3 +1049,179 @@ func TestConnector_Watch(t *testing.T) {
 		assert.ErrorIs(t, err, domain.ErrNotImplemented)
 	})
 }
+
+// fakeGitHubAPI serves one repository with one issue and one pull request.
+// The issue and pull request list handlers wait for each other to arrive,
+// recording whether the two requests were in flight at the same time.
+type fakeGitHubAPI struct {
+	wait       time.Duration
+	mu         sync.Mutex
+	inFlight   int
+	bothIn     chan struct{}
+	overlapped bool
+}
+
+func newFakeGitHubAPI(t *testing.T, wait time.Duration) (*fakeGitHubAPI, *Client) {
+	t.Helper()
+	api := &fakeGitHubAPI{wait: wait, bothIn: make(chan struct{})}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/user/repos", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `[{"name":"repo","owner":{"login":"octo"},"has_issues":true,"default_branch":"main"}]`)
+	})
+	mux.HandleFunc("/repos/octo/repo/issues", func(w http.ResponseWriter, _ *http.Request) {
+		api.rendezvous()
+		fmt.Fprint(w, `[{"number":1,"title":"Bug","updated_at":"2024-01-15T10:00:00Z"}]`)
+	})
+	mux.HandleFunc("/repos/octo/repo/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		api.rendezvous()
+		fmt.Fprint(w, `[{"number":2,"title":"Fix","updated_at":"2024-01-16T10:00:00Z"}]`)
+	})
+	mux.HandleFunc("/repos/octo/repo/", func(w http.ResponseWriter, _ *http.Request) {
+		// Comments and reviews
+		fmt.Fprint(w, `[]`)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := NewClientWithHTTPClient(server.Client())
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.gh.BaseURL = baseURL
+	client.rateLimiter = &RateLimiter{
+		remaining: GitHubRateLimit,
+		limit:     GitHubRateLimit,
+		bucket:    rate.NewLimiter(rate.Inf, 1),
+		minBuffer: MinBuffer,
+	}
+
+	return api, client
+}
+
+// rendezvous waits for the other list request, up to api.wait.
+func (api *fakeGitHubAPI) rendezvous() {
+	api.mu.Lock()
+	api.inFlight++
+	if api.inFlight == 2 {
+		api.overlapped = true
+		close(api.bothIn)
+	}
+	api.mu.Unlock()
+
+	select {
+	case <-api.bothIn:
+	case <-time.After(api.wait):
+	}
+
+	api.mu.Lock()
+	api.inFlight--
+	api.mu.Unlock()
+}
+
+func (api *fakeGitHubAPI) wasOverlapped() bool {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return api.overlapped
+}
+
+func newIssuesAndPRsConnector(client *Client, parallel bool) *Connector {
+	conn := New("source-1", &Config{
+		ContentTypes:  []ContentType{ContentIssues, ContentPRs},
+		ParallelFetch: parallel,
+	}, nil)
+	conn.client = client
+	return conn
+}
+
+func collectFullSync(t *testing.T, conn *Connector) ([]domain.RawDocument, string) {
+	t.Helper()
+	docsChan, errsChan := conn.FullSync(context.Background())
+
+	var docs []domain.RawDocument
+	for doc := range docsChan {
+		docs = append(docs, doc)
+	}
+
+	var cursor string
+	for err := range errsChan {
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+		cursor = complete.NewCursor
+	}
+	return docs, cursor
+}
+
+func TestConnector_FullSync_ParallelWithinSource(t *testing.T) {
+	api, client := newFakeGitHubAPI(t, 5*time.Second)
+	conn := newIssuesAndPRsConnector(client, true)
+
+	docs, cursorStr := collectFullSync(t, conn)
+
+	assert.True(t, api.wasOverlapped(), "issues and PRs should be fetched concurrently")
+
+	uris := make([]string, len(docs))
+	for i, doc := range docs {
+		uris[i] = doc.URI
+		assert.Equal(t, "source-1", doc.SourceID)
+	}
+	assert.ElementsMatch(t, []string{
+		buildIssueURI("octo", "repo", 1),
+		buildPRURI("octo", "repo", 2),
+	}, uris)
+
+	cursor, err := DecodeCursor(cursorStr)
+	require.NoError(t, err)
+	repoCursor := cursor.GetRepoCursor("octo", "repo")
+	assert.Equal(t, 2024, repoCursor.IssuesSince.Year())
+	assert.Equal(t, 16, repoCursor.PRsSince.Day())
+}
+
+func TestConnector_FullSync_SequentialByDefault(t *testing.T) {
+	api, client := newFakeGitHubAPI(t, 50*time.Millisecond)
+	conn := newIssuesAndPRsConnector(client, false)
+
+	docs, _ := collectFullSync(t, conn)
+
+	assert.False(t, api.wasOverlapped(), "content types should be fetched one at a time")
+	assert.Len(t, docs, 2)
+}
+
+func TestConnector_IncrementalSync_ParallelWithinSource(t *testing.T) {
+	api, client := newFakeGitHubAPI(t, 5*time.Second)
+	conn := newIssuesAndPRsConnector(client, true)
+
+	changesChan, errsChan := conn.IncrementalSync(context.Background(), domain.SyncState{})
+
+	count := 0
+	for change := range changesChan {
+		assert.Equal(t, domain.ChangeUpdated, change.Type)
+		count++
+	}
+	for err := range errsChan {
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+	}
+
+	assert.True(t, api.wasOverlapped(), "issues and PRs should be fetched concurrently")
+	assert.Equal(t, 2, count)
+}
+
+func TestConnector_SetLocale(t *testing.T) {
+	var gotLanguage string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotLanguage = r.Header.Get("Accept-Language")
+		fmt.Fprint(w, `{"login":"octo"}`)
+	}))
+	defer server.Close()
+
+	conn := New("source-123", &Config{}, &mockTokenProvider{token: "test-token"})
+	conn.SetLocale("de-DE")
+	require.NoError(t, conn.client.ensureClient(context.Background()))
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	conn.client.gh.BaseURL = baseURL
+
+	_, _, err = conn.client.GitHub().Users.Get(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, "de-DE", gotLanguage)
+}
@@ -31,6 +31,9 @@ type RepoCursor struct {
 
 	// WikiCommitSHA is the last indexed wiki commit SHA.
 	WikiCommitSHA string `json:"wiki_sha,omitempty"`
+
+	// DiscussionsSince is the timestamp of the last updated discussion.
+	DiscussionsSince time.Time `json:"discussions_since,omitempty"`
 }
 
 // NewCursor creates a new empty cursor.
@@ -122,6 +125,13 @@ func (c *Cursor) UpdateWikiCommitSHA(owner, repo, sha string) {
 	c.SetRepoCursor(owner, repo, &rc)
 }
 
+// UpdateDiscussionsSince updates the discussions timestamp for a repository.
+func (c *Cursor) UpdateDiscussionsSince(owner, repo string, t time.Time) {
+	rc := c.GetRepoCursor(owner, repo)
+	rc.DiscussionsSince = t
+	c.SetRepoCursor(owner, repo, &rc)
+}
+
 // RepoFullName returns the full repository name.
 func RepoFullName(owner, repo string) string {
 	return owner + "/" + repo
@@ -0,0 +1,292 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	gh "github.com/google/go-github/v80/github"
+
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// MIMETypeGitHubDiscussion is the custom MIME type for GitHub discussions.
+const MIMETypeGitHubDiscussion = "application/vnd.github.discussion+json"
+
+// discussionsQuery lists a repository's discussions, most recently updated
+// first, with the first comment threads of each. Discussions are only
+// available through the GraphQL API.
+const discussionsQuery = `query($owner: String!, $name: String!, $cursor: String) {
+  repository(owner: $owner, name: $name) {
+    discussions(first: 25, after: $cursor, orderBy: {field: UPDATED_AT, direction: DESC}) {
+      pageInfo { hasNextPage endCursor }
+      nodes {
+        number
+        title
+        body
+        url
+        createdAt
+        updatedAt
+        upvoteCount
+        author { login }
+        category { name }
+        labels(first: 20) { nodes { name } }
+        answer { author { login } body createdAt upvoteCount }
+        comments(first: 20) {
+          totalCount
+          nodes {
+            author { login }
+            body
+            createdAt
+            upvoteCount
+            replies(first: 10) { nodes { author { login } body createdAt } }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// DiscussionContent is the JSON structure for the discussion RawDocument content.
+type DiscussionContent struct {
+	Number    int                        `json:"number"`
+	Title     string                     `json:"title"`
+	Body      string                     `json:"body"`
+	Category  string                     `json:"category"`
+	Author    string                     `json:"author"`
+	CreatedAt time.Time                  `json:"created_at"`
+	UpdatedAt time.Time                  `json:"updated_at"`
+	Labels    []string                   `json:"labels"`
+	Upvotes   int                        `json:"upvotes"`
+	Answer    *DiscussionCommentContent  `json:"answer,omitempty"`
+	Comments  []DiscussionCommentContent `json:"comments"`
+}
+
+// DiscussionCommentContent represents a comment thread in the discussion content.
+type DiscussionCommentContent struct {
+	Author    string           `json:"author"`
+	Body      string           `json:"body"`
+	CreatedAt time.Time        `json:"created_at"`
+	Upvotes   int              `json:"upvotes"`
+	Replies   []CommentContent `json:"replies,omitempty"`
+}
+
+// graphQLActor is the author of a discussion or comment. It is null for
+// deleted accounts.
+type graphQLActor struct {
+	Login string `json:"login"`
+}
+
+// graphQLDiscussionComment is a discussion comment as returned by the GraphQL API.
+type graphQLDiscussionComment struct {
+	Author      *graphQLActor `json:"author"`
+	Body        string        `json:"body"`
+	CreatedAt   time.Time     `json:"createdAt"`
+	UpvoteCount int           `json:"upvoteCount"`
+	Replies     struct {
+		Nodes []graphQLDiscussionComment `json:"nodes"`
+	} `json:"replies"`
+}
+
+// graphQLDiscussion is a discussion as returned by the GraphQL API.
+type graphQLDiscussion struct {
+	Number      int           `json:"number"`
+	Title       string        `json:"title"`
+	Body        string        `json:"body"`
+	URL         string        `json:"url"`
+	CreatedAt   time.Time     `json:"createdAt"`
+	UpdatedAt   time.Time     `json:"updatedAt"`
+	UpvoteCount int           `json:"upvoteCount"`
+	Author      *graphQLActor `json:"author"`
+	Category    struct {
+		Name string `json:"name"`
+	} `json:"category"`
+	Labels struct {
+		Nodes []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"labels"`
+	Answer   *graphQLDiscussionComment `json:"answer"`
+	Comments struct {
+		TotalCount int                        `json:"totalCount"`
+		Nodes      []graphQLDiscussionComment `json:"nodes"`
+	} `json:"comments"`
+}
+
+// discussionsPage is the data of a discussionsQuery response.
+type discussionsPage struct {
+	Repository *struct {
+		Discussions struct {
+			PageInfo struct {
+				HasNextPage bool   `json:"hasNextPage"`
+				EndCursor   string `json:"endCursor"`
+			} `json:"pageInfo"`
+			Nodes []graphQLDiscussion `json:"nodes"`
+		} `json:"discussions"`
+	} `json:"repository"`
+}
+
+// FetchDiscussions retrieves discussions updated since the given time from
+// a repository. Each discussion includes its answer and first 20 comment
+// threads with up to 10 replies each.
+func FetchDiscussions(
+	ctx context.Context, client *Client, repo *gh.Repository, since time.Time,
+) ([]domain.RawDocument, time.Time, error) {
+	if !repo.GetHasDiscussions() {
+		return nil, since, nil
+	}
+
+	owner := repo.GetOwner().GetLogin()
+	name := repo.GetName()
+
+	docs := make([]domain.RawDocument, 0)
+	var latestUpdate time.Time
+
+	variables := map[string]any{"owner": owner, "name": name}
+	for {
+		select {
+		case <-ctx.Done():
+			return docs, since, ctx.Err()
+		default:
+		}
+
+		var page discussionsPage
+		pageCtx, span := tracing.StartPage(ctx, "github", "discussions")
+		err := client.GraphQL(pageCtx, discussionsQuery, variables, &page)
+		tracing.EndPage(span, err)
+		if err != nil {
+			return nil, since, fmt.Errorf("list discussions: %w", err)
+		}
+		if page.Repository == nil {
+			return nil, since, ErrRepoNotFound
+		}
+
+		discussions := page.Repository.Discussions
+		for i := range discussions.Nodes {
+			discussion := &discussions.Nodes[i]
+
+			// Discussions are ordered by update, so the rest are older.
+			if !since.IsZero() && discussion.UpdatedAt.Before(since) {
+				return docs, latestUpdate, nil
+			}
+
+			// Track latest update.
+			if discussion.UpdatedAt.After(latestUpdate) {
+				latestUpdate = discussion.UpdatedAt
+			}
+
+			contentJSON, err := json.Marshal(buildDiscussionContent(discussion))
+			if err != nil {
+				continue
+			}
+			docs = append(docs, buildDiscussionDocument(owner, name, discussion, contentJSON))
+		}
+
+		if !discussions.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = discussions.PageInfo.EndCursor
+	}
+
+	return docs, latestUpdate, nil
+}
+
+// buildDiscussionDocument creates a RawDocument from a discussion.
+func buildDiscussionDocument(
+	owner, name string, discussion *graphQLDiscussion, contentJSON []byte,
+) domain.RawDocument {
+	return domain.RawDocument{
+		SourceID: "", // Will be set by connector
+		URI:      buildDiscussionURI(owner, name, discussion.Number),
+		MIMEType: MIMETypeGitHubDiscussion,
+		Content:  contentJSON,
+		Metadata: map[string]any{
+			"type":       "discussion",
+			"owner":      owner,
+			"repo":       name,
+			"number":     discussion.Number,
+			"title":      discussion.Title,
+			"category":   discussion.Category.Name,
+			"author":     discussion.Author.login(),
+			"labels":     discussionLabels(discussion),
+			"answered":   discussion.Answer != nil,
+			"comments":   discussion.Comments.TotalCount,
+			"html_url":   discussion.URL,
+			"created_at": discussion.CreatedAt.Format(time.RFC3339),
+			"updated_at": discussion.UpdatedAt.Format(time.RFC3339),
+		},
+	}
+}
+
+// buildDiscussionContent creates the DiscussionContent structure.
+func buildDiscussionContent(discussion *graphQLDiscussion) DiscussionContent {
+	var answer *DiscussionCommentContent
+	if discussion.Answer != nil {
+		a := buildDiscussionComment(discussion.Answer)
+		answer = &a
+	}
+
+	comments := make([]DiscussionCommentContent, len(discussion.Comments.Nodes))
+	for i := range discussion.Comments.Nodes {
+		comments[i] = buildDiscussionComment(&discussion.Comments.Nodes[i])
+	}
+
+	return DiscussionContent{
+		Number:    discussion.Number,
+		Title:     discussion.Title,
+		Body:      discussion.Body,
+		Category:  discussion.Category.Name,
+		Author:    discussion.Author.login(),
+		CreatedAt: discussion.CreatedAt,
+		UpdatedAt: discussion.UpdatedAt,
+		Labels:    discussionLabels(discussion),
+		Upvotes:   discussion.UpvoteCount,
+		Answer:    answer,
+		Comments:  comments,
+	}
+}
+
+// buildDiscussionComment creates a comment thread from a discussion comment.
+func buildDiscussionComment(comment *graphQLDiscussionComment) DiscussionCommentContent {
+	var replies []CommentContent
+	for _, reply := range comment.Replies.Nodes {
+		replies = append(replies, CommentContent{
+			Author:    reply.Author.login(),
+			Body:      reply.Body,
+			CreatedAt: reply.CreatedAt,
+		})
+	}
+
+	return DiscussionCommentContent{
+		Author:    comment.Author.login(),
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+		Upvotes:   comment.UpvoteCount,
+		Replies:   replies,
+	}
+}
+
+// discussionLabels returns the names of a discussion's labels.
+func discussionLabels(discussion *graphQLDiscussion) []string {
+	labels := make([]string, len(discussion.Labels.Nodes))
+	for i, l := range discussion.Labels.Nodes {
+		labels[i] = l.Name
+	}
+	return labels
+}
+
+// login returns the actor's login, or "ghost" for deleted accounts as
+// GitHub displays them.
+func (a *graphQLActor) login() string {
+	if a == nil {
+		return "ghost"
+	}
+	return a.Login
+}
+
+// buildDiscussionURI creates a URI for a discussion.
+func buildDiscussionURI(owner, repo string, number int) string {
+	return fmt.Sprintf("github://%s/%s/discussions/%d", owner, repo, number)
+}
@@ -0,0 +1,255 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	gh "github.com/google/go-github/v80/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// discussionsPageJSON is the first page of discussions served by
+// newGraphQLDiscussionsAPI: an answered question with a comment thread and
+// a discussion by a deleted account.
+const discussionsPageJSON = `{"data":{"repository":{"discussions":{
+  "pageInfo":{"hasNextPage":true,"endCursor":"page2"},
+  "nodes":[
+    {"number":7,"title":"How do I configure X?","body":"Details here",
+     "url":"https://github.com/octo/repo/discussions/7",
+     "createdAt":"2024-03-01T09:00:00Z","updatedAt":"2024-03-05T10:00:00Z","upvoteCount":3,
+     "author":{"login":"alice"},"category":{"name":"Q&A"},"labels":{"nodes":[{"name":"config"}]},
+     "answer":{"author":{"login":"bob"},"body":"Set the flag","createdAt":"2024-03-02T09:00:00Z","upvoteCount":5},
+     "comments":{"totalCount":1,"nodes":[
+       {"author":{"login":"bob"},"body":"Set the flag","createdAt":"2024-03-02T09:00:00Z","upvoteCount":5,
+        "replies":{"nodes":[{"author":{"login":"alice"},"body":"Thanks!","createdAt":"2024-03-02T10:00:00Z"}]}}
+     ]}},
+    {"number":6,"title":"Ideas","body":"","url":"https://github.com/octo/repo/discussions/6",
+     "createdAt":"2024-02-01T09:00:00Z","updatedAt":"2024-02-10T10:00:00Z","upvoteCount":0,
+     "author":null,"category":{"name":"Ideas"},"labels":{"nodes":[]},"answer":null,
+     "comments":{"totalCount":0,"nodes":[]}}
+  ]}}}}`
+
+// discussionsLastPageJSON is the second and last page of discussions.
+const discussionsLastPageJSON = `{"data":{"repository":{"discussions":{
+  "pageInfo":{"hasNextPage":false,"endCursor":""},
+  "nodes":[
+    {"number":1,"title":"Welcome","body":"Hello","url":"https://github.com/octo/repo/discussions/1",
+     "createdAt":"2024-01-01T09:00:00Z","updatedAt":"2024-01-01T09:00:00Z","upvoteCount":0,
+     "author":{"login":"octo"},"category":{"name":"Announcements"},"labels":{"nodes":[]},"answer":null,
+     "comments":{"totalCount":0,"nodes":[]}}
+  ]}}}}`
+
+// newTestClient returns a client for a fake GitHub API served by handler.
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClientWithHTTPClient(server.Client())
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.gh.BaseURL = baseURL
+	client.rateLimiter = &RateLimiter{
+		remaining: GitHubRateLimit,
+		limit:     GitHubRateLimit,
+		bucket:    rate.NewLimiter(rate.Inf, 1),
+		minBuffer: MinBuffer,
+	}
+	return client
+}
+
+// newGraphQLDiscussionsAPI serves one repository with discussions enabled
+// over two pages, recording the variables of each GraphQL request.
+func newGraphQLDiscussionsAPI(t *testing.T) (*Client, *[]map[string]any) {
+	t.Helper()
+	var requests []map[string]any
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/user/repos", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `[{"name":"repo","owner":{"login":"octo"},"has_discussions":true,"default_branch":"main"}]`)
+	})
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var req graphQLRequest
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&req)) {
+			return
+		}
+		assert.Contains(t, req.Query, "discussions(")
+		requests = append(requests, req.Variables)
+
+		if req.Variables["cursor"] == "page2" {
+			fmt.Fprint(w, discussionsLastPageJSON)
+			return
+		}
+		fmt.Fprint(w, discussionsPageJSON)
+	})
+
+	return newTestClient(t, mux), &requests
+}
+
+func discussionsRepo() *gh.Repository {
+	return &gh.Repository{
+		Name:           gh.Ptr("repo"),
+		Owner:          &gh.User{Login: gh.Ptr("octo")},
+		HasDiscussions: gh.Ptr(true),
+	}
+}
+
+func TestFetchDiscussions(t *testing.T) {
+	client, requests := newGraphQLDiscussionsAPI(t)
+
+	docs, latestUpdate, err := FetchDiscussions(context.Background(), client, discussionsRepo(), time.Time{})
+
+	require.NoError(t, err)
+	require.Len(t, docs, 3)
+	assert.Equal(t, time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC), latestUpdate)
+
+	// Both pages are requested for the repository.
+	require.Len(t, *requests, 2)
+	assert.Equal(t, "octo", (*requests)[0]["owner"])
+	assert.Equal(t, "repo", (*requests)[0]["name"])
+	assert.Equal(t, "page2", (*requests)[1]["cursor"])
+
+	doc := docs[0]
+	assert.Equal(t, "github://octo/repo/discussions/7", doc.URI)
+	assert.Equal(t, MIMETypeGitHubDiscussion, doc.MIMEType)
+	assert.Equal(t, "discussion", doc.Metadata["type"])
+	assert.Equal(t, "Q&A", doc.Metadata["category"])
+	assert.Equal(t, true, doc.Metadata["answered"])
+	assert.Equal(t, 1, doc.Metadata["comments"])
+	assert.Equal(t, "https://github.com/octo/repo/discussions/7", doc.Metadata["html_url"])
+
+	var content DiscussionContent
+	require.NoError(t, json.Unmarshal(doc.Content, &content))
+	assert.Equal(t, "How do I configure X?", content.Title)
+	assert.Equal(t, "alice", content.Author)
+	assert.Equal(t, []string{"config"}, content.Labels)
+	require.NotNil(t, content.Answer)
+	assert.Equal(t, "bob", content.Answer.Author)
+	assert.Equal(t, "Set the flag", content.Answer.Body)
+	require.Len(t, content.Comments, 1)
+	require.Len(t, content.Comments[0].Replies, 1)
+	assert.Equal(t, "Thanks!", content.Comments[0].Replies[0].Body)
+
+	// Deleted accounts are shown as GitHub shows them.
+	assert.Equal(t, "ghost", docs[1].Metadata["author"])
+	assert.Equal(t, false, docs[1].Metadata["answered"])
+}
+
+func TestFetchDiscussions_SinceStopsAtOlderDiscussions(t *testing.T) {
+	client, requests := newGraphQLDiscussionsAPI(t)
+	since := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	docs, latestUpdate, err := FetchDiscussions(context.Background(), client, discussionsRepo(), since)
+
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "github://octo/repo/discussions/7", docs[0].URI)
+	assert.Equal(t, time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC), latestUpdate)
+	assert.Len(t, *requests, 1, "older pages should not be requested")
+}
+
+func TestFetchDiscussions_DisabledRepoMakesNoRequests(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	repo := discussionsRepo()
+	repo.HasDiscussions = gh.Ptr(false)
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	docs, latestUpdate, err := FetchDiscussions(context.Background(), client, repo, since)
+
+	require.NoError(t, err)
+	assert.Empty(t, docs)
+	assert.Equal(t, since, latestUpdate)
+}
+
+func TestFetchDiscussions_GraphQLErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		notFound bool
+	}{
+		{
+			name: "missing repository",
+			body: `{"data":{"repository":null},` +
+				`"errors":[{"type":"NOT_FOUND","message":"Could not resolve to a Repository"}]}`,
+			notFound: true,
+		},
+		{
+			name: "other error",
+			body: `{"data":null,"errors":[{"type":"FORBIDDEN","message":"Resource not accessible by integration"}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				fmt.Fprint(w, tt.body)
+			}))
+
+			docs, _, err := FetchDiscussions(context.Background(), client, discussionsRepo(), time.Time{})
+
+			require.Error(t, err)
+			assert.Nil(t, docs)
+			assert.Equal(t, tt.notFound, IsNotFound(err))
+		})
+	}
+}
+
+func TestConnector_FullSync_Discussions(t *testing.T) {
+	client, _ := newGraphQLDiscussionsAPI(t)
+	conn := New("source-1", &Config{ContentTypes: []ContentType{ContentDiscussions}}, nil)
+	conn.client = client
+
+	docs, cursorStr := collectFullSync(t, conn)
+
+	require.Len(t, docs, 3)
+	for _, doc := range docs {
+		assert.Equal(t, "source-1", doc.SourceID)
+		assert.Equal(t, MIMETypeGitHubDiscussion, doc.MIMEType)
+	}
+
+	cursor, err := DecodeCursor(cursorStr)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC),
+		cursor.GetRepoCursor("octo", "repo").DiscussionsSince.UTC())
+}
+
+func TestConnector_IncrementalSync_Discussions(t *testing.T) {
+	client, _ := newGraphQLDiscussionsAPI(t)
+	conn := New("source-1", &Config{ContentTypes: []ContentType{ContentDiscussions}}, nil)
+	conn.client = client
+
+	cursor := NewCursor()
+	cursor.UpdateDiscussionsSince("octo", "repo", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	changesChan, errsChan := conn.IncrementalSync(context.Background(), domain.SyncState{Cursor: cursor.Encode()})
+
+	var uris []string
+	for change := range changesChan {
+		assert.Equal(t, domain.ChangeUpdated, change.Type)
+		uris = append(uris, change.Document.URI)
+	}
+	for err := range errsChan {
+		var complete *driven.SyncComplete
+		require.ErrorAs(t, err, &complete)
+	}
+
+	assert.Equal(t, []string{
+		buildDiscussionURI("octo", "repo", 7),
+		buildDiscussionURI("octo", "repo", 6),
+	}, uris)
+}
@@ -3,7 +3,7 @@
 // This connector indexes all repositories accessible to the authenticated user,
 // including owned repositories, collaborator repositories, and organisation
 // member repositories. Content types indexed include repository files, issues,
-// pull requests, and wiki pages.
+// pull requests, wiki pages, and discussions.
 //
 // # Architecture
 //
@@ -33,11 +33,14 @@
 // Source configuration accepts the following keys:
 //
 //   - content_types: comma-separated list of content to index.
-//     Valid values: files, issues, prs, wikis. Default: all types.
+//     Valid values: files, issues, prs, wikis, discussions. Default: all types.
 //
 //   - file_patterns: comma-separated glob patterns for file filtering.
 //     Example: "*.go,*.md". Default: all files.
 //
+//   - parallel_within_source: when true, each repository's content types are
+//     fetched concurrently. Requests still share one rate limiter. Default: false.
+//
 // No repository specification is required. The connector automatically
 // discovers and indexes all repositories accessible to the authenticated user.
 //
@@ -64,11 +67,13 @@
 //  2. Retrieves blob content for each file matching configured patterns
 //  3. Fetches issues and pull requests with their comments
 //  4. Retrieves wiki pages if the repository has a wiki
+//  5. Fetches discussions with their answers and comment threads through the
+//     GraphQL API if the repository has discussions enabled
 //
 // Incremental sync uses cursors to track sync state. The cursor stores:
 //
 //   - Tree SHA: detects file changes by comparing against the current HEAD
-//   - Timestamps: filters issues and PRs updated since the last sync
+//   - Timestamps: filters issues, PRs and discussions updated since the last sync
 //   - Wiki SHA: tracks wiki repository changes
 //
 // Each repository maintains independent cursor state, enabling partial syncs
@@ -82,6 +87,7 @@
 //   - Issues: github://{owner}/{repo}/issues/{number}
 //   - Pull Requests: github://{owner}/{repo}/pull/{number}
 //   - Wiki Pages: github://{owner}/{repo}/wiki/{page}
+//   - Discussions: github://{owner}/{repo}/discussions/{number}
 //
 // Metadata includes repository information, file paths, issue/PR state,
 // labels, and timestamps.
@@ -101,6 +107,8 @@
 //   - File size limit: 1MB per file (GitHub API constraint)
 //   - Watch mode is not supported (no webhook integration in CLI)
 //   - Private repository access requires appropriate token scopes
+//   - Discussions include their first 20 comment threads with up to 10
+//     replies each
 //
 // # Example Usage
 //
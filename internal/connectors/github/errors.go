@@ -11,6 +11,9 @@ var (
 	// ErrConfigInvalidContentType indicates an invalid content type was specified.
 	ErrConfigInvalidContentType = errors.New("github: invalid content type")
 
+	// ErrConfigInvalidParallel indicates parallel_within_source is not a boolean.
+	ErrConfigInvalidParallel = errors.New("github: invalid parallel_within_source value")
+
 	// ErrRepoNotFound indicates the repository was not found or is not accessible.
 	ErrRepoNotFound = errors.New("github: repository not found")
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"mime"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	gh "github.com/google/go-github/v80/github"
@@ -118,6 +119,24 @@ var extMIMETypes = map[string]string{
 	".swift": "text/x-swift", ".vue": "text/x-vue", ".svelte": "text/x-svelte",
 }
 
+// EmittedMIMETypes returns the MIME types the GitHub connector can emit.
+// Covers issues, pull requests, wiki pages and the explicitly mapped file types.
+func EmittedMIMETypes() []string {
+	seen := map[string]bool{}
+	types := []string{MIMETypeGitHubIssue, MIMETypeGitHubPull, MIMETypeGitHubDiscussion, "text/markdown", "text/plain"}
+	for _, t := range types {
+		seen[t] = true
+	}
+	for _, t := range extMIMETypes {
+		if !seen[t] {
+			seen[t] = true
+			types = append(types, t)
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
 // detectFileMIMEType determines the MIME type from file extension.
 func detectFileMIMEType(path string) string {
 	ext := filepath.Ext(path)
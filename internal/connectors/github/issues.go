@@ -8,6 +8,7 @@ import (
 
 	gh "github.com/google/go-github/v80/github"
 
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
@@ -156,7 +157,9 @@ func FetchIssueComments(
 			return nil, fmt.Errorf("rate limit wait: %w", err)
 		}
 
-		comments, resp, err := client.gh.Issues.ListComments(ctx, owner, repo, issueNumber, opts)
+		pageCtx, span := tracing.StartPage(ctx, "github", "issue_comments")
+		comments, resp, err := client.gh.Issues.ListComments(pageCtx, owner, repo, issueNumber, opts)
+		tracing.EndPage(span, err)
 		if err != nil {
 			return nil, client.wrapError(err, "list comments")
 		}
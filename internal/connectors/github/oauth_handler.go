@@ -135,9 +135,10 @@ func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (str
 // DefaultConfig returns default OAuth URLs and scopes for GitHub.
 func (h *OAuthHandler) DefaultConfig() driven.OAuthDefaults {
 	return driven.OAuthDefaults{
-		AuthURL:  defaultAuthURL,
-		TokenURL: defaultTokenURL,
-		Scopes:   defaultScopes,
+		AuthURL:   defaultAuthURL,
+		TokenURL:  defaultTokenURL,
+		Scopes:    defaultScopes,
+		DeviceURL: defaultDeviceURL,
 	}
 }
 
@@ -150,7 +151,8 @@ func (h *OAuthHandler) SetupHint() string {
 const (
 	defaultAuthURL = "https://github.com/login/oauth/authorize"
 	//nolint:gosec // G101: Not credentials, OAuth endpoint URL
-	defaultTokenURL = "https://github.com/login/oauth/access_token"
+	defaultTokenURL  = "https://github.com/login/oauth/access_token"
+	defaultDeviceURL = "https://github.com/login/device/code"
 )
 
 // defaultScopes are the default OAuth scopes for GitHub.
@@ -8,6 +8,7 @@ import (
 
 	gh "github.com/google/go-github/v80/github"
 
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
@@ -184,7 +185,9 @@ func FetchPRReviews(
 			return nil, fmt.Errorf("rate limit wait: %w", err)
 		}
 
-		reviews, resp, err := client.gh.PullRequests.ListReviews(ctx, owner, repo, prNumber, opts)
+		pageCtx, span := tracing.StartPage(ctx, "github", "reviews")
+		reviews, resp, err := client.gh.PullRequests.ListReviews(pageCtx, owner, repo, prNumber, opts)
+		tracing.EndPage(span, err)
 		if err != nil {
 			return nil, client.wrapError(err, "list reviews")
 		}
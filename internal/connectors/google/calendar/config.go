@@ -1,8 +1,10 @@
 package calendar
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
@@ -18,14 +20,20 @@ type Config struct {
 	ShowDeleted bool
 	// SingleEvents expands recurring events into instances.
 	SingleEvents bool
+	// Timezone is the IANA name of the zone event times are rendered in.
+	Timezone string
 }
 
+// DefaultTimezone is the zone event times are rendered in when none is configured.
+const DefaultTimezone = "UTC"
+
 // DefaultConfig returns the default configuration.
 func DefaultConfig() *Config {
 	return &Config{
 		MaxResults:   250,
 		ShowDeleted:  true, // Need this for incremental sync to detect deletions
 		SingleEvents: true, // Expand recurring events for easier indexing
+		Timezone:     DefaultTimezone,
 	}
 }
 
@@ -58,5 +66,13 @@ func ParseConfig(source domain.Source) (*Config, error) {
 		cfg.SingleEvents = false
 	}
 
+	// Parse timezone
+	if val := strings.TrimSpace(source.Config["timezone"]); val != "" {
+		if _, err := time.LoadLocation(val); err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w", val, err)
+		}
+		cfg.Timezone = val
+	}
+
 	return cfg, nil
 }
@@ -16,6 +16,7 @@ func TestDefaultConfig(t *testing.T) {
 	assert.Equal(t, int64(250), cfg.MaxResults)
 	assert.True(t, cfg.ShowDeleted)
 	assert.True(t, cfg.SingleEvents)
+	assert.Equal(t, "UTC", cfg.Timezone)
 }
 
 func TestParseConfig_Defaults(t *testing.T) {
@@ -226,3 +227,13 @@ func TestParseConfig_AllOptions(t *testing.T) {
 	assert.False(t, cfg.ShowDeleted)
 	assert.False(t, cfg.SingleEvents)
 }
+
+func TestParseConfig_Timezone(t *testing.T) {
+	cfg, err := ParseConfig(domain.Source{Config: map[string]string{"timezone": "America/New_York"}})
+
+	require.NoError(t, err)
+	assert.Equal(t, "America/New_York", cfg.Timezone)
+
+	_, err = ParseConfig(domain.Source{Config: map[string]string{"timezone": "EST5EDT-nope"}})
+	assert.Error(t, err)
+}
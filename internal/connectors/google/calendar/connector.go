@@ -3,17 +3,22 @@ package calendar
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"sync"
 
 	"google.golang.org/api/calendar/v3"
 
 	"github.com/custodia-labs/sercha-cli/internal/connectors/google"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
-// Ensure Connector implements the interface.
-var _ driven.Connector = (*Connector)(nil)
+// Ensure Connector implements the interfaces.
+var (
+	_ driven.Connector      = (*Connector)(nil)
+	_ driven.APIBudgetAware = (*Connector)(nil)
+)
 
 // Connector fetches events from Google Calendar.
 type Connector struct {
@@ -21,6 +26,8 @@ type Connector struct {
 	config        *Config
 	tokenProvider driven.TokenProvider
 	rateLimiter   *google.RateLimiter
+	apiBudget     driven.APIBudget
+	locale        string
 	mu            sync.Mutex
 	closed        bool
 }
@@ -35,6 +42,16 @@ func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Conn
 	}
 }
 
+// SetAPIBudget charges the connector's API requests to a shared budget.
+func (c *Connector) SetAPIBudget(apiBudget driven.APIBudget) {
+	c.apiBudget = apiBudget
+}
+
+// SetLocale sends locale as Accept-Language on the connector's API requests.
+func (c *Connector) SetLocale(locale string) {
+	c.locale = locale
+}
+
 // Type returns the connector type identifier.
 func (c *Connector) Type() string {
 	return "google-calendar"
@@ -75,7 +92,7 @@ func (c *Connector) Validate(ctx context.Context) error {
 	}
 
 	ts := google.NewTokenSource(ctx, c.tokenProvider)
-	svc, err := google.NewCalendarService(ctx, ts)
+	svc, err := google.NewCalendarService(ctx, ts, c.apiBudget, c.locale)
 	if err != nil {
 		return fmt.Errorf("%w: %w", domain.ErrAuthRequired, err)
 	}
@@ -112,9 +129,10 @@ func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawD
 	if err := c.checkClosed(); err != nil {
 		return err
 	}
+	slog.Debug("full sync started", slog.String("connector", c.Type()), slog.String("source_id", c.sourceID))
 
 	ts := google.NewTokenSource(ctx, c.tokenProvider)
-	svc, err := google.NewCalendarService(ctx, ts)
+	svc, err := google.NewCalendarService(ctx, ts, c.apiBudget, c.locale)
 	if err != nil {
 		return fmt.Errorf("create calendar service: %w", err)
 	}
@@ -168,7 +186,7 @@ func (c *Connector) runIncrementalSync(
 	}
 
 	ts := google.NewTokenSource(ctx, c.tokenProvider)
-	svc, err := google.NewCalendarService(ctx, ts)
+	svc, err := google.NewCalendarService(ctx, ts, c.apiBudget, c.locale)
 	if err != nil {
 		return fmt.Errorf("create calendar service: %w", err)
 	}
@@ -287,7 +305,10 @@ func (c *Connector) listCalendars(
 	if pageToken != "" {
 		req = req.PageToken(pageToken)
 	}
-	return req.Context(ctx).Do()
+	pageCtx, span := tracing.StartPage(ctx, "google-calendar", "calendars")
+	resp, err := req.Context(pageCtx).Do()
+	tracing.EndPage(span, err)
+	return resp, err
 }
 
 // syncCalendarEvents syncs all events from a calendar for full sync.
@@ -343,7 +364,10 @@ func (c *Connector) listEvents(
 		req = req.PageToken(pageToken)
 	}
 
-	return req.Context(ctx).Do()
+	pageCtx, span := tracing.StartPage(ctx, "google-calendar", "events")
+	resp, err := req.Context(pageCtx).Do()
+	tracing.EndPage(span, err)
+	return resp, err
 }
 
 // processEventsForFullSync processes events for a full sync.
@@ -358,7 +382,7 @@ func (c *Connector) processEventsForFullSync(
 			continue
 		}
 
-		rawDoc := EventToRawDocument(event, calendarID, c.sourceID)
+		rawDoc := c.eventToRawDocument(event, calendarID)
 		if err := c.sendDocument(ctx, docsChan, rawDoc); err != nil {
 			return err
 		}
@@ -439,7 +463,10 @@ func (c *Connector) listEventsIncremental(
 		req = req.PageToken(pageToken)
 	}
 
-	return req.Context(ctx).Do()
+	pageCtx, span := tracing.StartPage(ctx, "google-calendar", "events")
+	resp, err := req.Context(pageCtx).Do()
+	tracing.EndPage(span, err)
+	return resp, err
 }
 
 // processEventsForIncremental processes events for an incremental sync.
@@ -474,13 +501,24 @@ func (c *Connector) eventToChange(event *calendar.Event, calendarID string) doma
 		}
 	}
 
-	rawDoc := EventToRawDocument(event, calendarID, c.sourceID)
+	rawDoc := c.eventToRawDocument(event, calendarID)
 	return domain.RawDocumentChange{
 		Type:     domain.ChangeUpdated,
 		Document: *rawDoc,
 	}
 }
 
+// eventToRawDocument converts an event, recording the timezone its times
+// are rendered in.
+func (c *Connector) eventToRawDocument(event *calendar.Event, calendarID string) *domain.RawDocument {
+	rawDoc := EventToRawDocument(event, calendarID, c.sourceID)
+	rawDoc.Metadata["timezone"] = c.config.Timezone
+	if c.config.Timezone == "" {
+		rawDoc.Metadata["timezone"] = DefaultTimezone
+	}
+	return rawDoc
+}
+
 // sendChange sends a change to the channel.
 func (c *Connector) sendChange(
 	ctx context.Context, changesChan chan<- domain.RawDocumentChange, change *domain.RawDocumentChange,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/api/calendar/v3"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
@@ -34,6 +35,10 @@ func (m *mockTokenProvider) IsAuthenticated() bool {
 	return m.isAuthed
 }
 
+func (m *mockTokenProvider) RefreshIfNeeded(_ context.Context) error {
+	return nil
+}
+
 func TestNew(t *testing.T) {
 	tp := &mockTokenProvider{token: "test-token", isAuthed: true}
 	cfg := DefaultConfig()
@@ -182,3 +187,15 @@ func TestConnector_getCalendarIDs_WithConfig(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, []string{"cal-1", "cal-2", "cal-3"}, calIDs)
 }
+
+func TestConnector_eventToRawDocument_Timezone(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Timezone = "Asia/Tokyo"
+	conn := New("source-123", cfg, nil)
+	event := &calendar.Event{Id: "event-1", Start: &calendar.EventDateTime{DateTime: "2024-01-20T14:00:00Z"}}
+
+	doc := conn.eventToRawDocument(event, "primary")
+
+	assert.Equal(t, "Asia/Tokyo", doc.Metadata["timezone"])
+	assert.Equal(t, "2024-01-20T14:00:00Z", doc.Metadata["start_time"])
+}
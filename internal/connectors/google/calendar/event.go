@@ -9,6 +9,14 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
+// mimeTypeEvent is the MIME type of iCalendar events emitted by this connector.
+const mimeTypeEvent = "text/calendar"
+
+// EmittedMIMETypes returns the MIME types the Google Calendar connector emits.
+func EmittedMIMETypes() []string {
+	return []string{mimeTypeEvent}
+}
+
 // EventToRawDocument converts a Google Calendar event to a RawDocument.
 func EventToRawDocument(event *calendar.Event, calendarID, sourceID string) *domain.RawDocument {
 	content := buildEventContent(event)
@@ -18,7 +26,7 @@ func EventToRawDocument(event *calendar.Event, calendarID, sourceID string) *dom
 	return &domain.RawDocument{
 		SourceID:  sourceID,
 		URI:       fmt.Sprintf("gcal://%s/events/%s", calendarID, event.Id),
-		MIMEType:  "text/calendar",
+		MIMEType:  mimeTypeEvent,
 		Content:   []byte(content),
 		ParentURI: parentURI,
 		Metadata: map[string]any{
@@ -13,7 +13,7 @@
 // authenticated API clients:
 //
 //	ts := google.NewTokenSource(ctx, tokenProvider)
-//	svc, err := google.NewGmailService(ctx, ts)
+//	svc, err := google.NewGmailService(ctx, ts, nil, "")
 //
 // # OAuth2 Scopes
 //
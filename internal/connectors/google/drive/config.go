@@ -32,19 +32,25 @@ type Config struct {
 	FolderIDs []string
 	// MaxResults is the page size for API requests.
 	MaxResults int64
+	// SharedDrives also syncs every shared drive the user is a member of.
+	SharedDrives bool
+	// ContentLimits bounds the file content downloaded for indexing.
+	ContentLimits domain.ContentLimits
 }
 
 // DefaultConfig returns the default configuration.
 func DefaultConfig() *Config {
 	return &Config{
-		ContentTypes: DefaultContentTypes,
-		MaxResults:   100,
+		ContentTypes:  DefaultContentTypes,
+		MaxResults:    100,
+		ContentLimits: domain.DefaultContentLimits(),
 	}
 }
 
 // ParseConfig extracts configuration from a Source.
 func ParseConfig(source domain.Source) (*Config, error) {
 	cfg := DefaultConfig()
+	cfg.ContentLimits = source.ContentLimits()
 
 	// Parse content_types from source config
 	if val := source.Config["content_types"]; val != "" {
@@ -81,6 +87,11 @@ func ParseConfig(source domain.Source) (*Config, error) {
 		}
 	}
 
+	// Parse shared_drives
+	if val := source.Config["shared_drives"]; val != "" {
+		cfg.SharedDrives = val == "true" || val == "1"
+	}
+
 	return cfg, nil
 }
 
@@ -303,3 +303,23 @@ func TestIsValidContentType(t *testing.T) {
 		})
 	}
 }
+
+func TestParseConfig_ContentLimits(t *testing.T) {
+	cfg, err := ParseConfig(domain.Source{Config: map[string]string{}})
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultContentLimits(), cfg.ContentLimits)
+
+	cfg, err = ParseConfig(domain.Source{Config: map[string]string{domain.ConfigKeyMetadataOnly: "1"}})
+	require.NoError(t, err)
+	assert.True(t, cfg.ContentLimits.MetadataOnly)
+}
+
+func TestParseConfig_SharedDrives(t *testing.T) {
+	cfg, err := ParseConfig(domain.Source{Config: map[string]string{}})
+	require.NoError(t, err)
+	assert.False(t, cfg.SharedDrives)
+
+	cfg, err = ParseConfig(domain.Source{Config: map[string]string{"shared_drives": "true"}})
+	require.NoError(t, err)
+	assert.True(t, cfg.SharedDrives)
+}
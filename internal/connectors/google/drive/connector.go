@@ -3,18 +3,23 @@ package drive
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"sync"
 
 	"google.golang.org/api/drive/v3"
 	"google.golang.org/api/googleapi"
 
 	"github.com/custodia-labs/sercha-cli/internal/connectors/google"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
-// Ensure Connector implements the interface.
-var _ driven.Connector = (*Connector)(nil)
+// Ensure Connector implements the interfaces.
+var (
+	_ driven.Connector      = (*Connector)(nil)
+	_ driven.APIBudgetAware = (*Connector)(nil)
+)
 
 // Connector fetches documents from Google Drive.
 type Connector struct {
@@ -22,18 +27,41 @@ type Connector struct {
 	config        *Config
 	tokenProvider driven.TokenProvider
 	rateLimiter   *google.RateLimiter
+	apiBudget     driven.APIBudget
+	locale        string
 	mu            sync.Mutex
 	closed        bool
+
+	// newService creates the Drive API service. Swappable for tests.
+	newService func(ctx context.Context) (*drive.Service, error)
 }
 
 // New creates a new Google Drive connector.
 func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Connector {
-	return &Connector{
+	c := &Connector{
 		sourceID:      sourceID,
 		config:        cfg,
 		tokenProvider: tokenProvider,
 		rateLimiter:   google.NewRateLimiter(google.ServiceDrive),
 	}
+	c.newService = c.driveService
+	return c
+}
+
+// driveService creates a Drive API service authenticated by the token provider.
+func (c *Connector) driveService(ctx context.Context) (*drive.Service, error) {
+	ts := google.NewTokenSource(ctx, c.tokenProvider)
+	return google.NewDriveService(ctx, ts, c.apiBudget, c.locale)
+}
+
+// SetAPIBudget charges the connector's API requests to a shared budget.
+func (c *Connector) SetAPIBudget(apiBudget driven.APIBudget) {
+	c.apiBudget = apiBudget
+}
+
+// SetLocale sends locale as Accept-Language on the connector's API requests.
+func (c *Connector) SetLocale(locale string) {
+	c.locale = locale
 }
 
 // Type returns the connector type identifier.
@@ -75,8 +103,7 @@ func (c *Connector) Validate(ctx context.Context) error {
 		return err
 	}
 
-	ts := google.NewTokenSource(ctx, c.tokenProvider)
-	svc, err := google.NewDriveService(ctx, ts)
+	svc, err := c.newService(ctx)
 	if err != nil {
 		return fmt.Errorf("%w: %w", domain.ErrAuthRequired, err)
 	}
@@ -113,31 +140,149 @@ func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawD
 	if err := c.checkClosed(); err != nil {
 		return err
 	}
+	slog.Debug("full sync started", slog.String("connector", c.Type()), slog.String("source_id", c.sourceID))
 
-	ts := google.NewTokenSource(ctx, c.tokenProvider)
-	svc, err := google.NewDriveService(ctx, ts)
+	svc, err := c.newService(ctx)
 	if err != nil {
 		return fmt.Errorf("create drive service: %w", err)
 	}
 
-	startTokenResp, err := svc.Changes.GetStartPageToken().Context(ctx).Do()
+	startPageToken, err := c.startPageToken(ctx, svc, "")
 	if err != nil {
-		return fmt.Errorf("get start page token: %w", google.WrapError(err))
+		return err
 	}
 
 	cursor := NewCursor()
-	cursor.StartPageToken = startTokenResp.StartPageToken
+	cursor.StartPageToken = startPageToken
+
+	if err := c.fetchAllFiles(ctx, svc, "", docsChan, nil); err != nil {
+		return err
+	}
 
-	if err := c.fetchAllFiles(ctx, svc, docsChan); err != nil {
+	if err := c.syncSharedDrives(ctx, svc, cursor, docsChan, nil); err != nil {
 		return err
 	}
 
 	return &driven.SyncComplete{NewCursor: cursor.Encode()}
 }
 
-// fetchAllFiles fetches all files matching the config.
+// startPageToken returns the changes page token marking the present state
+// of the shared drive with driveID, or of My Drive when it is empty.
+func (c *Connector) startPageToken(ctx context.Context, svc *drive.Service, driveID string) (string, error) {
+	req := svc.Changes.GetStartPageToken().SupportsAllDrives(true)
+	if driveID != "" {
+		req = req.DriveId(driveID)
+	}
+	resp, err := req.Context(ctx).Do()
+	if err != nil {
+		return "", fmt.Errorf("get start page token: %w", google.WrapError(err))
+	}
+	return resp.StartPageToken, nil
+}
+
+// syncSharedDrives syncs each shared drive the user is a member of, when
+// enabled: drives with a page token in the cursor from their changes, new
+// drives in full. Drives the user has left are dropped from the cursor.
+// Documents go to docsChan during a full sync and changesChan otherwise.
+func (c *Connector) syncSharedDrives(
+	ctx context.Context,
+	svc *drive.Service,
+	cursor *Cursor,
+	docsChan chan<- domain.RawDocument,
+	changesChan chan<- domain.RawDocumentChange,
+) error {
+	if !c.config.SharedDrives {
+		cursor.DriveTokens = nil
+		return nil
+	}
+
+	drives, err := c.listSharedDrives(ctx, svc)
+	if err != nil {
+		return err
+	}
+
+	previous := cursor.DriveTokens
+	cursor.DriveTokens = nil
+	for _, d := range drives {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		token, err := c.syncSharedDrive(ctx, svc, d.Id, previous[d.Id], docsChan, changesChan)
+		switch {
+		case err != nil:
+			slog.Warn("failed to sync shared drive",
+				slog.String("source_id", c.sourceID), slog.String("drive", d.Name), slog.Any("error", err))
+			if prev := previous[d.Id]; prev != "" {
+				cursor.SetDriveToken(d.Id, prev)
+			}
+		case token != "":
+			cursor.SetDriveToken(d.Id, token)
+		}
+	}
+	return nil
+}
+
+// syncSharedDrive syncs one shared drive from pageToken, or in full when it
+// is empty, and returns the drive's next page token.
+func (c *Connector) syncSharedDrive(
+	ctx context.Context,
+	svc *drive.Service,
+	driveID, pageToken string,
+	docsChan chan<- domain.RawDocument,
+	changesChan chan<- domain.RawDocumentChange,
+) (string, error) {
+	if pageToken != "" {
+		return c.processChanges(ctx, svc, driveID, pageToken, changesChan)
+	}
+
+	// Take the token first so changes made while listing are not missed
+	startPageToken, err := c.startPageToken(ctx, svc, driveID)
+	if err != nil {
+		return "", err
+	}
+	if err := c.fetchAllFiles(ctx, svc, driveID, docsChan, changesChan); err != nil {
+		return "", err
+	}
+	return startPageToken, nil
+}
+
+// listSharedDrives lists the shared drives the user is a member of.
+func (c *Connector) listSharedDrives(ctx context.Context, svc *drive.Service) ([]*drive.Drive, error) {
+	var drives []*drive.Drive
+	var pageToken string
+	for {
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		req := svc.Drives.List().PageSize(100).Fields("nextPageToken, drives(id, name)")
+		if pageToken != "" {
+			req = req.PageToken(pageToken)
+		}
+		pageCtx, span := tracing.StartPage(ctx, "google-drive", "drives")
+		resp, err := req.Context(pageCtx).Do()
+		tracing.EndPage(span, err)
+		if err != nil {
+			return nil, fmt.Errorf("list shared drives: %w", google.WrapError(err))
+		}
+
+		drives = append(drives, resp.Drives...)
+		pageToken = resp.NextPageToken
+		if pageToken == "" {
+			return drives, nil
+		}
+	}
+}
+
+// fetchAllFiles fetches all files matching the config in the shared drive
+// with driveID, or in My Drive when it is empty.
 func (c *Connector) fetchAllFiles(
-	ctx context.Context, svc *drive.Service, docsChan chan<- domain.RawDocument,
+	ctx context.Context,
+	svc *drive.Service,
+	driveID string,
+	docsChan chan<- domain.RawDocument,
+	changesChan chan<- domain.RawDocumentChange,
 ) error {
 	var pageToken string
 
@@ -150,12 +295,12 @@ func (c *Connector) fetchAllFiles(
 			return err
 		}
 
-		files, err := c.listFiles(ctx, svc, pageToken)
+		files, err := c.listFiles(ctx, svc, driveID, pageToken)
 		if err != nil {
 			return fmt.Errorf("list files: %w", google.WrapError(err))
 		}
 
-		if err := c.processFiles(ctx, svc, files.Files, docsChan); err != nil {
+		if err := c.processFiles(ctx, svc, files.Files, docsChan, changesChan); err != nil {
 			return err
 		}
 
@@ -168,13 +313,21 @@ func (c *Connector) fetchAllFiles(
 	return nil
 }
 
-// listFiles creates and executes a file list request.
-func (c *Connector) listFiles(ctx context.Context, svc *drive.Service, pageToken string) (*drive.FileList, error) {
+// listFiles creates and executes a file list request for the shared drive
+// with driveID, or for My Drive when it is empty.
+func (c *Connector) listFiles(
+	ctx context.Context, svc *drive.Service, driveID, pageToken string,
+) (*drive.FileList, error) {
 	const fileFields = "nextPageToken, files(id, name, mimeType, modifiedTime, size, parents, webViewLink, trashed)"
 	req := svc.Files.List().
 		PageSize(c.config.MaxResults).
+		SupportsAllDrives(true).
 		Fields(googleapi.Field(fileFields))
 
+	if driveID != "" {
+		req = req.Corpora("drive").DriveId(driveID).IncludeItemsFromAllDrives(true)
+	}
+
 	if pageToken != "" {
 		req = req.PageToken(pageToken)
 	}
@@ -183,7 +336,10 @@ func (c *Connector) listFiles(ctx context.Context, svc *drive.Service, pageToken
 		req = req.Q("(" + buildFolderQuery(c.config.FolderIDs) + ")")
 	}
 
-	return req.Context(ctx).Do()
+	pageCtx, span := tracing.StartPage(ctx, "google-drive", "files")
+	resp, err := req.Context(pageCtx).Do()
+	tracing.EndPage(span, err)
+	return resp, err
 }
 
 // buildFolderQuery builds a Drive query for specific folders.
@@ -198,21 +354,31 @@ func buildFolderQuery(folderIDs []string) string {
 	return result
 }
 
-// processFiles converts files to documents and sends them to the channel.
+// processFiles converts files to documents and sends them to docsChan, or
+// as updates to changesChan when docsChan is nil.
 func (c *Connector) processFiles(
-	ctx context.Context, svc *drive.Service, files []*drive.File, docsChan chan<- domain.RawDocument,
+	ctx context.Context,
+	svc *drive.Service,
+	files []*drive.File,
+	docsChan chan<- domain.RawDocument,
+	changesChan chan<- domain.RawDocumentChange,
 ) error {
 	for _, file := range files {
 		if !ShouldSyncFile(file, c.config) {
 			continue
 		}
 
-		rawDoc, err := FileToRawDocument(ctx, svc, file, c.sourceID)
+		rawDoc, err := FileToRawDocument(ctx, svc, file, c.sourceID, c.config.ContentLimits)
 		if err != nil || rawDoc == nil {
 			continue
 		}
 
-		if err := c.sendDocument(ctx, docsChan, rawDoc); err != nil {
+		if docsChan == nil {
+			err = c.sendChange(ctx, changesChan, domain.ChangeUpdated, rawDoc)
+		} else {
+			err = c.sendDocument(ctx, docsChan, rawDoc)
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -263,26 +429,30 @@ func (c *Connector) runIncrementalSync(
 		return fmt.Errorf("invalid cursor, full sync required: cursor has no page token")
 	}
 
-	ts := google.NewTokenSource(ctx, c.tokenProvider)
-	svc, err := google.NewDriveService(ctx, ts)
+	svc, err := c.newService(ctx)
 	if err != nil {
 		return fmt.Errorf("create drive service: %w", err)
 	}
 
-	newStartPageToken, err := c.processChanges(ctx, svc, cursor.StartPageToken, changesChan)
+	newStartPageToken, err := c.processChanges(ctx, svc, "", cursor.StartPageToken, changesChan)
 	if err != nil {
 		return err
 	}
-
 	cursor.StartPageToken = newStartPageToken
+
+	if err := c.syncSharedDrives(ctx, svc, cursor, nil, changesChan); err != nil {
+		return err
+	}
+
 	return &driven.SyncComplete{NewCursor: cursor.Encode()}
 }
 
-// processChanges fetches and processes all changes.
+// processChanges fetches and processes all changes to the shared drive with
+// driveID, or to My Drive when it is empty.
 func (c *Connector) processChanges(
 	ctx context.Context,
 	svc *drive.Service,
-	pageToken string,
+	driveID, pageToken string,
 	changesChan chan<- domain.RawDocumentChange,
 ) (string, error) {
 	var newStartPageToken string
@@ -296,7 +466,7 @@ func (c *Connector) processChanges(
 			return "", err
 		}
 
-		changes, err := c.listChanges(ctx, svc, pageToken)
+		changes, err := c.listChanges(ctx, svc, driveID, pageToken)
 		if err != nil {
 			return "", fmt.Errorf("list changes: %w", google.WrapError(err))
 		}
@@ -315,18 +485,26 @@ func (c *Connector) processChanges(
 	return newStartPageToken, nil
 }
 
-// listChanges creates and executes a changes list request.
+// listChanges creates and executes a changes list request for the shared
+// drive with driveID, or for My Drive when it is empty.
 func (c *Connector) listChanges(
-	ctx context.Context, svc *drive.Service, pageToken string,
+	ctx context.Context, svc *drive.Service, driveID, pageToken string,
 ) (*drive.ChangeList, error) {
 	const changesFields = "nextPageToken, newStartPageToken, " +
 		"changes(fileId, removed, file(id, name, mimeType, modifiedTime, size, parents, webViewLink, trashed))"
 
-	return svc.Changes.List(pageToken).
+	req := svc.Changes.List(pageToken).
 		Fields(googleapi.Field(changesFields)).
 		PageSize(c.config.MaxResults).
-		Context(ctx).
-		Do()
+		SupportsAllDrives(true)
+	if driveID != "" {
+		req = req.DriveId(driveID).IncludeItemsFromAllDrives(true)
+	}
+
+	pageCtx, span := tracing.StartPage(ctx, "google-drive", "changes")
+	resp, err := req.Context(pageCtx).Do()
+	tracing.EndPage(span, err)
+	return resp, err
 }
 
 // processChangeList processes a batch of changes.
@@ -359,7 +537,7 @@ func (c *Connector) processChange(
 		return nil
 	}
 
-	rawDoc, err := FileToRawDocument(ctx, svc, change.File, c.sourceID)
+	rawDoc, err := FileToRawDocument(ctx, svc, change.File, c.sourceID, c.config.ContentLimits)
 	if err != nil || rawDoc == nil {
 		return nil
 	}
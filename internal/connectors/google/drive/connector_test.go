@@ -2,12 +2,20 @@ package drive
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
 
+	"github.com/custodia-labs/sercha-cli/internal/connectors/google"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
 // mockTokenProvider implements driven.TokenProvider for testing.
@@ -34,6 +42,10 @@ func (m *mockTokenProvider) IsAuthenticated() bool {
 	return m.isAuthed
 }
 
+func (m *mockTokenProvider) RefreshIfNeeded(_ context.Context) error {
+	return nil
+}
+
 func TestNew(t *testing.T) {
 	tp := &mockTokenProvider{token: "test-token", isAuthed: true}
 	cfg := DefaultConfig()
@@ -198,3 +210,137 @@ func TestBuildFolderQuery(t *testing.T) {
 		})
 	}
 }
+
+// stubSharedDrives serves My Drive and two shared drives, each holding one
+// file, recording the files and changes requests made.
+type stubSharedDrives struct {
+	mu       sync.Mutex
+	requests []string
+}
+
+func (s *stubSharedDrives) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+	driveID := query.Get("driveId")
+	var body any
+	switch r.URL.Path {
+	case "/drives":
+		body = map[string]any{"drives": []map[string]any{
+			{"id": "d1", "name": "Engineering"},
+			{"id": "d2", "name": "Sales"},
+		}}
+	case "/changes/startPageToken":
+		body = map[string]any{"startPageToken": "start-" + driveID}
+	case "/files":
+		s.requests = append(s.requests, "files:"+query.Get("corpora")+":"+driveID+":"+query.Get("supportsAllDrives"))
+		id := "mine"
+		if driveID != "" {
+			id = "file-" + driveID
+		}
+		body = map[string]any{"files": []map[string]any{{"id": id, "name": id + ".txt", "mimeType": "image/png"}}}
+	case "/changes":
+		s.requests = append(s.requests, "changes:"+driveID+":"+query.Get("pageToken"))
+		body = map[string]any{
+			"newStartPageToken": "next-" + driveID,
+			"changes":           []map[string]any{{"fileId": "gone-" + driveID, "removed": true}},
+		}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func newStubbedConnector(cfg *Config, serverURL string) *Connector {
+	conn := New("source-123", cfg, &mockTokenProvider{token: "test-token", isAuthed: true})
+	conn.rateLimiter = google.NewRateLimiterWithConfig(google.RateLimitConfig{RequestsPerSecond: 1000, BurstSize: 100})
+	conn.newService = func(ctx context.Context) (*drive.Service, error) {
+		return drive.NewService(ctx, option.WithEndpoint(serverURL+"/"), option.WithoutAuthentication())
+	}
+	return conn
+}
+
+func TestConnector_FullSync_SharedDrives(t *testing.T) {
+	stub := &stubSharedDrives{}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.SharedDrives = true
+	conn := newStubbedConnector(cfg, server.URL)
+
+	docs, errs := conn.FullSync(context.Background())
+	var uris []string
+	for doc := range docs {
+		uris = append(uris, doc.URI)
+	}
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, <-errs, &complete)
+
+	assert.Equal(t, []string{"gdrive://files/mine", "gdrive://files/file-d1", "gdrive://files/file-d2"}, uris)
+	assert.Equal(t, []string{"files:::true", "files:drive:d1:true", "files:drive:d2:true"}, stub.requests)
+
+	cursor, err := DecodeCursor(complete.NewCursor)
+	require.NoError(t, err)
+	assert.Equal(t, "start-", cursor.StartPageToken)
+	assert.Equal(t, map[string]string{"d1": "start-d1", "d2": "start-d2"}, cursor.DriveTokens)
+}
+
+func TestConnector_FullSync_SharedDrivesDisabled(t *testing.T) {
+	stub := &stubSharedDrives{}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	conn := newStubbedConnector(DefaultConfig(), server.URL)
+
+	docs, errs := conn.FullSync(context.Background())
+	for range docs {
+	}
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, <-errs, &complete)
+
+	assert.Equal(t, []string{"files:::true"}, stub.requests)
+	cursor, err := DecodeCursor(complete.NewCursor)
+	require.NoError(t, err)
+	assert.Empty(t, cursor.DriveTokens)
+}
+
+func TestConnector_IncrementalSync_SharedDrives(t *testing.T) {
+	stub := &stubSharedDrives{}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.SharedDrives = true
+	conn := newStubbedConnector(cfg, server.URL)
+
+	// d1 was synced before; d2 is new and d-old has been left
+	start := &Cursor{
+		Version:        CursorVersion,
+		StartPageToken: "tok",
+		DriveTokens:    map[string]string{"d1": "tok-d1", "d-old": "tok-old"},
+	}
+	changes, errs := conn.IncrementalSync(context.Background(), domain.SyncState{Cursor: start.Encode()})
+	var deleted, updated []string
+	for change := range changes {
+		if change.Type == domain.ChangeDeleted {
+			deleted = append(deleted, change.Document.URI)
+		} else {
+			updated = append(updated, change.Document.URI)
+		}
+	}
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, <-errs, &complete)
+
+	assert.Equal(t, []string{"gdrive://files/gone-", "gdrive://files/gone-d1"}, deleted)
+	assert.Equal(t, []string{"gdrive://files/file-d2"}, updated, "new shared drives are synced in full")
+	assert.Equal(t, []string{"changes::tok", "changes:d1:tok-d1", "files:drive:d2:true"}, stub.requests)
+
+	cursor, err := DecodeCursor(complete.NewCursor)
+	require.NoError(t, err)
+	assert.Equal(t, "next-", cursor.StartPageToken)
+	assert.Equal(t, map[string]string{"d1": "next-d1", "d2": "start-d2"}, cursor.DriveTokens)
+}
@@ -12,13 +12,17 @@ const CursorVersion = 1
 // ErrInvalidCursor indicates the cursor could not be decoded.
 var ErrInvalidCursor = errors.New("drive: invalid cursor format")
 
-// Cursor tracks Google Drive sync state using the Changes API.
+// Cursor tracks Google Drive sync state using the Changes API: one page
+// token for My Drive and one per shared drive, whose changes are listed
+// separately.
 type Cursor struct {
 	// Version is the cursor format version for future compatibility.
 	Version int `json:"v"`
 	// StartPageToken is the token from changes.getStartPageToken().
 	// Used as the starting point for changes.list() in incremental sync.
 	StartPageToken string `json:"start_page_token"`
+	// DriveTokens maps shared drive IDs to their changes page tokens.
+	DriveTokens map[string]string `json:"drive_tokens,omitempty"`
 }
 
 // NewCursor creates a new empty cursor.
@@ -61,6 +65,20 @@ func DecodeCursor(s string) (*Cursor, error) {
 	return &cursor, nil
 }
 
+// SetDriveToken updates the changes page token of a shared drive.
+func (c *Cursor) SetDriveToken(driveID, token string) {
+	if c.DriveTokens == nil {
+		c.DriveTokens = make(map[string]string)
+	}
+	c.DriveTokens[driveID] = token
+}
+
+// GetDriveToken returns the changes page token of a shared drive, or "" if
+// it has not been synced.
+func (c *Cursor) GetDriveToken(driveID string) string {
+	return c.DriveTokens[driveID]
+}
+
 // IsEmpty returns true if the cursor has no sync state.
 func (c *Cursor) IsEmpty() bool {
 	return c.StartPageToken == ""
@@ -114,3 +114,16 @@ func TestCursor_RoundTrip(t *testing.T) {
 		original = decoded
 	}
 }
+
+func TestCursor_DriveTokens(t *testing.T) {
+	cursor := NewCursor()
+	cursor.StartPageToken = "tok"
+	assert.Empty(t, cursor.GetDriveToken("d1"))
+
+	cursor.SetDriveToken("d1", "tok-d1")
+
+	decoded, err := DecodeCursor(cursor.Encode())
+	require.NoError(t, err)
+	assert.Equal(t, "tok-d1", decoded.GetDriveToken("d1"))
+	assert.Empty(t, decoded.GetDriveToken("d2"))
+}
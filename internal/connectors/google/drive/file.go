@@ -25,19 +25,17 @@ const (
 	ExportMimeCSV  = "text/csv"
 )
 
-// MaxExportSize is the maximum size for exported content (5MB).
-const MaxExportSize = 5 * 1024 * 1024
-
-// FileToRawDocument converts a Drive file to a RawDocument.
+// FileToRawDocument converts a Drive file to a RawDocument, downloading its
+// content within limits.
 func FileToRawDocument(
-	ctx context.Context, svc *drive.Service, file *drive.File, sourceID string,
+	ctx context.Context, svc *drive.Service, file *drive.File, sourceID string, limits domain.ContentLimits,
 ) (*domain.RawDocument, error) {
 	// Skip folders
 	if file.MimeType == MimeTypeFolder {
 		return nil, nil
 	}
 
-	content, exportedMime, err := fetchFileContent(ctx, svc, file)
+	content, exportedMime, err := fetchFileContent(ctx, svc, file, limits)
 	if err != nil {
 		// Log error but continue with metadata only
 		content = nil
@@ -68,36 +66,42 @@ func FileToRawDocument(
 	}, nil
 }
 
-// fetchFileContent retrieves the content of a file.
+// fetchFileContent retrieves the content of a file within limits.
 // Returns (content, exportedMIME, error) where exportedMIME is non-empty if the file was converted.
-func fetchFileContent(ctx context.Context, svc *drive.Service, file *drive.File) ([]byte, string, error) {
-	// Handle Google Workspace files (Docs, Sheets, etc.)
+func fetchFileContent(
+	ctx context.Context, svc *drive.Service, file *drive.File, limits domain.ContentLimits,
+) ([]byte, string, error) {
+	// Handle Google Workspace files (Docs, Sheets, etc.), whose size is
+	// unknown until exported
+	var exportMime string
 	switch file.MimeType {
-	case MimeTypeGoogleDoc:
-		content, err := exportGoogleFile(ctx, svc, file.Id, ExportMimeText)
-		return content, ExportMimeText, err
+	case MimeTypeGoogleDoc, MimeTypeGoogleSlides:
+		exportMime = ExportMimeText
 	case MimeTypeGoogleSheet:
-		content, err := exportGoogleFile(ctx, svc, file.Id, ExportMimeCSV)
-		return content, ExportMimeCSV, err
-	case MimeTypeGoogleSlides:
-		content, err := exportGoogleFile(ctx, svc, file.Id, ExportMimeText)
-		return content, ExportMimeText, err
+		exportMime = ExportMimeCSV
+	}
+	if exportMime != "" {
+		if limits.MetadataOnly {
+			return nil, exportMime, nil
+		}
+		content, err := exportGoogleFile(ctx, svc, file.Id, exportMime, limits.MaxSize)
+		return content, exportMime, err
 	}
 
 	// Skip files we can't normalise or files that are too large
-	if !shouldDownloadContent(file.MimeType) || file.Size > MaxExportSize {
+	if !shouldDownloadContent(file.MimeType) || !limits.AllowsDownload(file.Size) {
 		return nil, "", nil
 	}
 
 	// Download regular file content
-	resp, err := svc.Files.Get(file.Id).Context(ctx).Download()
+	resp, err := svc.Files.Get(file.Id).SupportsAllDrives(true).Context(ctx).Download()
 	if err != nil {
 		return nil, "", fmt.Errorf("download file: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Read with size limit
-	limitedReader := io.LimitReader(resp.Body, MaxExportSize)
+	limitedReader := io.LimitReader(resp.Body, limits.MaxSize)
 	data, err := io.ReadAll(limitedReader)
 	if err != nil {
 		return nil, "", fmt.Errorf("read file content: %w", err)
@@ -107,8 +111,11 @@ func fetchFileContent(ctx context.Context, svc *drive.Service, file *drive.File)
 	return data, "", nil
 }
 
-// exportGoogleFile exports a Google Workspace file to the specified format.
-func exportGoogleFile(ctx context.Context, svc *drive.Service, fileID, exportMime string) ([]byte, error) {
+// exportGoogleFile exports a Google Workspace file to the specified format,
+// reading at most maxSize bytes.
+func exportGoogleFile(
+	ctx context.Context, svc *drive.Service, fileID, exportMime string, maxSize int64,
+) ([]byte, error) {
 	resp, err := svc.Files.Export(fileID, exportMime).Context(ctx).Download()
 	if err != nil {
 		return nil, fmt.Errorf("export file: %w", err)
@@ -116,7 +123,7 @@ func exportGoogleFile(ctx context.Context, svc *drive.Service, fileID, exportMim
 	defer resp.Body.Close()
 
 	// Read with size limit
-	limitedReader := io.LimitReader(resp.Body, MaxExportSize)
+	limitedReader := io.LimitReader(resp.Body, maxSize)
 	data, err := io.ReadAll(limitedReader)
 	if err != nil {
 		return nil, fmt.Errorf("read export: %w", err)
@@ -135,6 +142,26 @@ func buildFilePath(file *drive.File) string {
 	return fmt.Sprintf("/%s/%s", file.Parents[0], file.Name)
 }
 
+// EmittedMIMETypes returns the MIME types Drive documents carry content for.
+// Workspace files are exported to plain text or CSV; other files keep their
+// original type and only text-like or normalisable types are downloaded.
+func EmittedMIMETypes() []string {
+	return append([]string{ExportMimeText, ExportMimeCSV}, downloadMIMETypes...)
+}
+
+// downloadMIMETypes lists non-text/* types whose content is downloaded for normalisation.
+var downloadMIMETypes = []string{
+	// Text-based formats
+	"application/json",
+	"application/xml",
+	"application/javascript",
+	"application/x-yaml",
+	"application/x-sh",
+	"application/sql",
+	// Binary formats with normalisers
+	"application/pdf",
+}
+
 // shouldDownloadContent checks if a MIME type requires content download.
 // This includes text files and binary formats that have normalisers (e.g., PDF).
 func shouldDownloadContent(mimeType string) bool {
@@ -143,20 +170,7 @@ func shouldDownloadContent(mimeType string) bool {
 		return true
 	}
 
-	// Types that need content downloaded for normalisation
-	downloadTypes := []string{
-		// Text-based formats
-		"application/json",
-		"application/xml",
-		"application/javascript",
-		"application/x-yaml",
-		"application/x-sh",
-		"application/sql",
-		// Binary formats with normalisers
-		"application/pdf",
-	}
-
-	for _, t := range downloadTypes {
+	for _, t := range downloadMIMETypes {
 		if mimeType == t {
 			return true
 		}
@@ -1,10 +1,17 @@
 package drive
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
 func TestShouldSyncFile(t *testing.T) {
@@ -257,3 +264,55 @@ func TestBuildFilePath(t *testing.T) {
 		})
 	}
 }
+
+// downloadCountingService returns a Drive service whose downloads and
+// exports return "hello", counting the requests made.
+func downloadCountingService(t *testing.T) (*drive.Service, *int) {
+	t.Helper()
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		_, _ = w.Write([]byte("hello"))
+	}))
+	t.Cleanup(server.Close)
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithEndpoint(server.URL+"/"), option.WithoutAuthentication())
+	require.NoError(t, err)
+	return svc, &requests
+}
+
+func TestFileToRawDocument_OversizedFileIsMetadataOnly(t *testing.T) {
+	svc, requests := downloadCountingService(t)
+	limits := domain.ContentLimits{MaxSize: 100}
+
+	small := &drive.File{Id: "small", Name: "small.txt", MimeType: "text/plain", Size: 5}
+	doc, err := FileToRawDocument(context.Background(), svc, small, "source-123", limits)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello"), doc.Content)
+
+	large := &drive.File{Id: "large", Name: "large.txt", MimeType: "text/plain", Size: 101}
+	doc, err = FileToRawDocument(context.Background(), svc, large, "source-123", limits)
+	require.NoError(t, err)
+	assert.Empty(t, doc.Content)
+	assert.Equal(t, "large.txt", doc.Metadata["title"])
+	assert.Equal(t, 1, *requests)
+}
+
+func TestFileToRawDocument_MetadataOnly(t *testing.T) {
+	svc, requests := downloadCountingService(t)
+	limits := domain.ContentLimits{MaxSize: domain.DefaultMaxContentSize, MetadataOnly: true}
+
+	files := []*drive.File{
+		{Id: "f1", Name: "notes.txt", MimeType: "text/plain", Size: 5},
+		{Id: "f2", Name: "Plan", MimeType: MimeTypeGoogleDoc},
+		{Id: "f3", Name: "Budget", MimeType: MimeTypeGoogleSheet},
+	}
+	for _, file := range files {
+		doc, err := FileToRawDocument(context.Background(), svc, file, "source-123", limits)
+		require.NoError(t, err)
+		assert.Empty(t, doc.Content)
+	}
+
+	assert.Zero(t, *requests, "metadata-only never downloads or exports content")
+}
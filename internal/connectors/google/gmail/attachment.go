@@ -0,0 +1,86 @@
+package gmail
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net/mail"
+	"slices"
+	"strings"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// defaultMaxAttachmentSize is the largest attachment indexed by default (5MB).
+const defaultMaxAttachmentSize = 5 << 20
+
+// attachmentMIMETypes lists the attachment types indexed as child documents.
+// Each has a normaliser; other attachments stay part of the message only.
+var attachmentMIMETypes = []string{
+	"application/pdf",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+}
+
+// hasAttachments reports whether a raw RFC 2822 message is multipart/mixed
+// or multipart/related, the only messages whose parts can be attachments.
+func hasAttachments(raw []byte) bool {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+	return mediaType == "multipart/mixed" || mediaType == "multipart/related"
+}
+
+// findAttachments returns the parts of a message payload that are
+// attachments of an indexed type no larger than maxSize bytes.
+func findAttachments(part *gmail.MessagePart, maxSize int64) []*gmail.MessagePart {
+	if part == nil {
+		return nil
+	}
+	var found []*gmail.MessagePart
+	if part.Filename != "" && part.Body != nil && part.Body.Size <= maxSize &&
+		slices.Contains(attachmentMIMETypes, strings.ToLower(part.MimeType)) {
+		found = append(found, part)
+	}
+	for _, child := range part.Parts {
+		found = append(found, findAttachments(child, maxSize)...)
+	}
+	return found
+}
+
+// AttachmentToRawDocument converts a message attachment to a RawDocument
+// whose parent is the message. Attachments are identified by part ID, as
+// Gmail attachment IDs change between requests.
+func AttachmentToRawDocument(
+	msg *gmail.Message, part *gmail.MessagePart, content []byte, sourceID string,
+) *domain.RawDocument {
+	parentURI := fmt.Sprintf("gmail://messages/%s", msg.Id)
+
+	return &domain.RawDocument{
+		SourceID:  sourceID,
+		URI:       fmt.Sprintf("%s/attachments/%s", parentURI, part.PartId),
+		MIMEType:  strings.ToLower(part.MimeType),
+		Content:   content,
+		ParentURI: &parentURI,
+		Metadata: map[string]any{
+			"message_id": msg.Id,
+			"thread_id":  msg.ThreadId,
+			"filename":   part.Filename,
+			"title":      part.Filename,
+			"size":       len(content),
+		},
+	}
+}
+
+// decodeAttachmentData decodes base64url attachment data, which Gmail may
+// send with or without padding.
+func decodeAttachmentData(data string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(data, "="))
+}
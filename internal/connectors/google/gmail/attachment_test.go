@@ -0,0 +1,95 @@
+package gmail
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/gmail/v1"
+)
+
+const mimeTypeDOCX = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+
+func TestHasAttachments(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected bool
+	}{
+		{"mixed", "Content-Type: multipart/mixed; boundary=b\r\n\r\n--b--", true},
+		{"related", "Content-Type: Multipart/Related; boundary=b\r\n\r\n--b--", true},
+		{"alternative", "Content-Type: multipart/alternative; boundary=b\r\n\r\n--b--", false},
+		{"plain text", "Content-Type: text/plain\r\n\r\nHello", false},
+		{"no content type", "Subject: Hi\r\n\r\nHello", false},
+		{"not a message", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, hasAttachments([]byte(tt.raw)))
+		})
+	}
+}
+
+func TestFindAttachments(t *testing.T) {
+	pdf := &gmail.MessagePart{
+		PartId: "1", MimeType: "application/pdf", Filename: "report.pdf",
+		Body: &gmail.MessagePartBody{AttachmentId: "a1", Size: 1000},
+	}
+	docx := &gmail.MessagePart{
+		PartId: "2.1", MimeType: mimeTypeDOCX, Filename: "notes.docx",
+		Body: &gmail.MessagePartBody{AttachmentId: "a2", Size: 2000},
+	}
+	tooLarge := &gmail.MessagePart{
+		PartId: "3", MimeType: "application/pdf", Filename: "scan.pdf",
+		Body: &gmail.MessagePartBody{AttachmentId: "a3", Size: 6000},
+	}
+	image := &gmail.MessagePart{
+		PartId: "4", MimeType: "image/png", Filename: "logo.png",
+		Body: &gmail.MessagePartBody{AttachmentId: "a4", Size: 100},
+	}
+	inline := &gmail.MessagePart{
+		PartId: "0", MimeType: "application/pdf",
+		Body: &gmail.MessagePartBody{Size: 100},
+	}
+	payload := &gmail.MessagePart{
+		MimeType: "multipart/mixed",
+		Parts: []*gmail.MessagePart{
+			inline, pdf,
+			{MimeType: "multipart/related", PartId: "2", Parts: []*gmail.MessagePart{docx}},
+			tooLarge, image,
+		},
+	}
+
+	assert.Equal(t, []*gmail.MessagePart{pdf, docx}, findAttachments(payload, 5000))
+	assert.Nil(t, findAttachments(nil, 5000))
+}
+
+func TestAttachmentToRawDocument(t *testing.T) {
+	msg := &gmail.Message{Id: "msg-1", ThreadId: "thread-1"}
+	part := &gmail.MessagePart{PartId: "1", MimeType: "Application/PDF", Filename: "report.pdf"}
+
+	doc := AttachmentToRawDocument(msg, part, []byte("%PDF"), "source-123")
+
+	assert.Equal(t, "source-123", doc.SourceID)
+	assert.Equal(t, "gmail://messages/msg-1/attachments/1", doc.URI)
+	assert.Equal(t, "application/pdf", doc.MIMEType)
+	assert.Equal(t, []byte("%PDF"), doc.Content)
+	require.NotNil(t, doc.ParentURI)
+	assert.Equal(t, "gmail://messages/msg-1", *doc.ParentURI)
+	assert.Equal(t, "report.pdf", doc.Metadata["title"])
+	assert.Equal(t, "thread-1", doc.Metadata["thread_id"])
+}
+
+func TestDecodeAttachmentData(t *testing.T) {
+	data := []byte("attachment?content>")
+
+	padded, err := decodeAttachmentData(base64.URLEncoding.EncodeToString(data))
+	require.NoError(t, err)
+	assert.Equal(t, data, padded)
+
+	unpadded, err := decodeAttachmentData(base64.RawURLEncoding.EncodeToString(data))
+	require.NoError(t, err)
+	assert.Equal(t, data, unpadded)
+}
@@ -30,13 +30,17 @@ type Config struct {
 	MaxResults int64
 	// IncludeSpamTrash includes spam and trash if true.
 	IncludeSpamTrash bool
+	// MaxAttachmentSize is the largest attachment, in bytes, indexed as its
+	// own document. Zero disables attachment indexing.
+	MaxAttachmentSize int64
 }
 
 // DefaultConfig returns the default configuration.
 func DefaultConfig() *Config {
 	return &Config{
-		LabelIDs:   []string{"INBOX"},
-		MaxResults: 100,
+		LabelIDs:          []string{"INBOX"},
+		MaxResults:        100,
+		MaxAttachmentSize: defaultMaxAttachmentSize,
 	}
 }
 
@@ -69,5 +73,12 @@ func ParseConfig(source domain.Source) (*Config, error) {
 		cfg.IncludeSpamTrash = true
 	}
 
+	// Parse max_attachment_size
+	if val := source.Config["max_attachment_size"]; val != "" {
+		if n, err := strconv.ParseInt(val, 10, 64); err == nil && n >= 0 {
+			cfg.MaxAttachmentSize = n
+		}
+	}
+
 	return cfg, nil
 }
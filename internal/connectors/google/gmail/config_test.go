@@ -195,3 +195,30 @@ func TestParseConfig_AllOptions(t *testing.T) {
 	assert.Equal(t, int64(200), cfg.MaxResults)
 	assert.True(t, cfg.IncludeSpamTrash)
 }
+
+func TestParseConfig_MaxAttachmentSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected int64
+	}{
+		{"default", "", defaultMaxAttachmentSize},
+		{"custom", "1048576", 1048576},
+		{"zero disables", "0", 0},
+		{"negative ignored", "-1", defaultMaxAttachmentSize},
+		{"invalid ignored", "big", defaultMaxAttachmentSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := domain.Source{
+				Config: map[string]string{"max_attachment_size": tt.value},
+			}
+
+			cfg, err := ParseConfig(source)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg.MaxAttachmentSize)
+		})
+	}
+}
@@ -3,17 +3,23 @@ package gmail
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"sync"
 
 	"google.golang.org/api/gmail/v1"
+	"google.golang.org/api/googleapi"
 
 	"github.com/custodia-labs/sercha-cli/internal/connectors/google"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
-// Ensure Connector implements the interface.
-var _ driven.Connector = (*Connector)(nil)
+// Ensure Connector implements the interfaces.
+var (
+	_ driven.Connector      = (*Connector)(nil)
+	_ driven.APIBudgetAware = (*Connector)(nil)
+)
 
 // Connector fetches emails from Gmail.
 type Connector struct {
@@ -21,18 +27,41 @@ type Connector struct {
 	config        *Config
 	tokenProvider driven.TokenProvider
 	rateLimiter   *google.RateLimiter
+	apiBudget     driven.APIBudget
+	locale        string
 	mu            sync.Mutex
 	closed        bool
+
+	// newService creates the Gmail API service. Swappable for tests.
+	newService func(ctx context.Context) (*gmail.Service, error)
 }
 
 // New creates a new Gmail connector.
 func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Connector {
-	return &Connector{
+	c := &Connector{
 		sourceID:      sourceID,
 		config:        cfg,
 		tokenProvider: tokenProvider,
 		rateLimiter:   google.NewRateLimiter(google.ServiceGmail),
 	}
+	c.newService = c.gmailService
+	return c
+}
+
+// gmailService creates a Gmail API service authenticated by the token provider.
+func (c *Connector) gmailService(ctx context.Context) (*gmail.Service, error) {
+	ts := google.NewTokenSource(ctx, c.tokenProvider)
+	return google.NewGmailService(ctx, ts, c.apiBudget, c.locale)
+}
+
+// SetAPIBudget charges the connector's API requests to a shared budget.
+func (c *Connector) SetAPIBudget(apiBudget driven.APIBudget) {
+	c.apiBudget = apiBudget
+}
+
+// SetLocale sends locale as Accept-Language on the connector's API requests.
+func (c *Connector) SetLocale(locale string) {
+	c.locale = locale
 }
 
 // Type returns the connector type identifier.
@@ -74,8 +103,7 @@ func (c *Connector) Validate(ctx context.Context) error {
 		return err
 	}
 
-	ts := google.NewTokenSource(ctx, c.tokenProvider)
-	svc, err := google.NewGmailService(ctx, ts)
+	svc, err := c.newService(ctx)
 	if err != nil {
 		return fmt.Errorf("%w: %w", domain.ErrAuthRequired, err)
 	}
@@ -112,9 +140,9 @@ func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawD
 	if err := c.checkClosed(); err != nil {
 		return err
 	}
+	slog.Debug("full sync started", slog.String("connector", c.Type()), slog.String("source_id", c.sourceID))
 
-	ts := google.NewTokenSource(ctx, c.tokenProvider)
-	svc, err := google.NewGmailService(ctx, ts)
+	svc, err := c.newService(ctx)
 	if err != nil {
 		return fmt.Errorf("create gmail service: %w", err)
 	}
@@ -185,7 +213,10 @@ func (c *Connector) listMessages(
 		req = req.PageToken(pageToken)
 	}
 
-	return req.Context(ctx).Do()
+	pageCtx, span := tracing.StartPage(ctx, "gmail", "messages")
+	resp, err := req.Context(pageCtx).Do()
+	tracing.EndPage(span, err)
+	return resp, err
 }
 
 // processMessageRefs fetches full messages and sends them to the channel.
@@ -209,9 +240,15 @@ func (c *Connector) processMessageRefs(
 			continue
 		}
 
-		if err := c.sendDocument(ctx, docsChan, MessageToRawDocument(msg, c.sourceID)); err != nil {
+		docs, err := c.messageDocuments(ctx, svc, msg)
+		if err != nil {
 			return err
 		}
+		for _, doc := range docs {
+			if err := c.sendDocument(ctx, docsChan, doc); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -221,6 +258,59 @@ func (c *Connector) fetchMessage(ctx context.Context, svc *gmail.Service, id str
 	return svc.Users.Messages.Get("me", id).Format("raw").Context(ctx).Do()
 }
 
+// messageDocuments returns the document for a message followed by a child
+// document for each attachment it has of an indexed type and size.
+// Attachments that fail to download are skipped.
+func (c *Connector) messageDocuments(
+	ctx context.Context, svc *gmail.Service, msg *gmail.Message,
+) ([]*domain.RawDocument, error) {
+	doc := MessageToRawDocument(msg, c.sourceID)
+	docs := []*domain.RawDocument{doc}
+	if c.config.MaxAttachmentSize <= 0 || !hasAttachments(doc.Content) {
+		return docs, nil
+	}
+
+	// Raw messages carry no part IDs, so list the parts from the full format
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	full, err := svc.Users.Messages.Get("me", msg.Id).Format("full").
+		Fields(googleapi.Field("payload")).Context(ctx).Do()
+	if err != nil {
+		return docs, nil
+	}
+
+	for _, part := range findAttachments(full.Payload, c.config.MaxAttachmentSize) {
+		content, err := c.fetchAttachment(ctx, svc, msg.Id, part)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			continue
+		}
+		docs = append(docs, AttachmentToRawDocument(msg, part, content, c.sourceID))
+	}
+	return docs, nil
+}
+
+// fetchAttachment returns the content of an attachment part, downloading
+// it with messages.attachments.get unless the part carries its data.
+func (c *Connector) fetchAttachment(
+	ctx context.Context, svc *gmail.Service, messageID string, part *gmail.MessagePart,
+) ([]byte, error) {
+	if part.Body.Data != "" {
+		return decodeAttachmentData(part.Body.Data)
+	}
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	body, err := svc.Users.Messages.Attachments.Get("me", messageID, part.Body.AttachmentId).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("get attachment: %w", google.WrapError(err))
+	}
+	return decodeAttachmentData(body.Data)
+}
+
 // sendDocument sends a document to the channel or returns on context cancellation.
 func (c *Connector) sendDocument(
 	ctx context.Context, docsChan chan<- domain.RawDocument, doc *domain.RawDocument,
@@ -243,15 +333,20 @@ func (c *Connector) IncrementalSync(
 	go func() {
 		defer close(changesChan)
 		defer close(errsChan)
-		errsChan <- c.runIncrementalSync(ctx, state, changesChan)
+		errsChan <- c.runIncrementalSync(ctx, state, changesChan, errsChan)
 	}()
 
 	return changesChan, errsChan
 }
 
 // runIncrementalSync executes the incremental sync logic.
+// A checkpoint is sent after each page of history, so an interrupted sync
+// resumes from the page it reached.
 func (c *Connector) runIncrementalSync(
-	ctx context.Context, state domain.SyncState, changesChan chan<- domain.RawDocumentChange,
+	ctx context.Context,
+	state domain.SyncState,
+	changesChan chan<- domain.RawDocumentChange,
+	errsChan chan<- error,
 ) error {
 	if err := c.checkClosed(); err != nil {
 		return err
@@ -265,29 +360,32 @@ func (c *Connector) runIncrementalSync(
 		return fmt.Errorf("invalid cursor, full sync required: cursor has no history ID")
 	}
 
-	ts := google.NewTokenSource(ctx, c.tokenProvider)
-	svc, err := google.NewGmailService(ctx, ts)
+	svc, err := c.newService(ctx)
 	if err != nil {
 		return fmt.Errorf("create gmail service: %w", err)
 	}
 
-	latestHistoryID, err := c.processHistory(ctx, svc, cursor.HistoryID, changesChan)
+	latestHistoryID, err := c.processHistory(ctx, svc, cursor, changesChan, errsChan)
 	if err != nil {
 		return err
 	}
 
 	cursor.HistoryID = latestHistoryID
+	cursor.PageToken = ""
 	return &driven.SyncComplete{NewCursor: cursor.Encode()}
 }
 
-// processHistory fetches and processes all history records.
+// processHistory fetches and processes all history records from the
+// cursor's history ID, starting at its page token if it has one.
 func (c *Connector) processHistory(
 	ctx context.Context,
 	svc *gmail.Service,
-	startHistoryID uint64,
+	cursor *Cursor,
 	changesChan chan<- domain.RawDocumentChange,
+	errsChan chan<- error,
 ) (uint64, error) {
-	var pageToken string
+	startHistoryID := cursor.HistoryID
+	pageToken := cursor.PageToken
 	latestHistoryID := startHistoryID
 
 	for {
@@ -319,6 +417,11 @@ func (c *Connector) processHistory(
 		if pageToken == "" {
 			break
 		}
+
+		checkpoint := &Cursor{Version: CursorVersion, HistoryID: startHistoryID, PageToken: pageToken}
+		if err := c.sendCheckpoint(ctx, errsChan, checkpoint); err != nil {
+			return 0, err
+		}
 	}
 
 	return latestHistoryID, nil
@@ -339,7 +442,10 @@ func (c *Connector) listHistory(
 		req = req.PageToken(pageToken)
 	}
 
-	return req.Context(ctx).Do()
+	pageCtx, span := tracing.StartPage(ctx, "gmail", "history")
+	resp, err := req.Context(pageCtx).Do()
+	tracing.EndPage(span, err)
+	return resp, err
 }
 
 // processHistoryRecords processes a batch of history records.
@@ -384,10 +490,15 @@ func (c *Connector) processAddedMessages(
 			continue
 		}
 
-		doc := MessageToRawDocument(msg, c.sourceID)
-		if err := c.sendChange(ctx, changesChan, domain.ChangeCreated, doc); err != nil {
+		docs, err := c.messageDocuments(ctx, svc, msg)
+		if err != nil {
 			return err
 		}
+		for _, doc := range docs {
+			if err := c.sendChange(ctx, changesChan, domain.ChangeCreated, doc); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -478,6 +589,16 @@ func (c *Connector) sendChangeRaw(
 	}
 }
 
+// sendCheckpoint reports a cursor from which an interrupted sync can resume.
+func (c *Connector) sendCheckpoint(ctx context.Context, errsChan chan<- error, cursor *Cursor) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case errsChan <- &driven.SyncCheckpoint{Cursor: cursor.Encode()}:
+		return nil
+	}
+}
+
 // checkClosed returns an error if the connector is closed.
 func (c *Connector) checkClosed() error {
 	c.mu.Lock()
@@ -2,12 +2,22 @@ package gmail
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/api/gmail/v1"
+	"google.golang.org/api/option"
 
+	"github.com/custodia-labs/sercha-cli/internal/connectors/google"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
 // mockTokenProvider implements driven.TokenProvider for testing.
@@ -34,6 +44,10 @@ func (m *mockTokenProvider) IsAuthenticated() bool {
 	return m.isAuthed
 }
 
+func (m *mockTokenProvider) RefreshIfNeeded(_ context.Context) error {
+	return nil
+}
+
 func TestNew(t *testing.T) {
 	tp := &mockTokenProvider{token: "test-token", isAuthed: true}
 	cfg := DefaultConfig()
@@ -167,3 +181,220 @@ func TestConnector_IncrementalSync_InvalidCursor(t *testing.T) {
 	assert.Error(t, receivedErr)
 	assert.Contains(t, receivedErr.Error(), "invalid cursor")
 }
+
+// stubHistory serves three pages of Gmail history, each adding one message.
+// The page after failPageToken fails once, interrupting the sync.
+type stubHistory struct {
+	mu            sync.Mutex
+	failPageToken string
+	requests      []string
+}
+
+func (s *stubHistory) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var body any
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/users/me/history"):
+		query := r.URL.Query()
+		pageToken := query.Get("pageToken")
+		s.requests = append(s.requests, query.Get("startHistoryId")+":"+pageToken)
+		if pageToken != "" && pageToken == s.failPageToken {
+			s.failPageToken = ""
+			http.Error(w, `{"error":{"code":400,"message":"interrupted"}}`, http.StatusBadRequest)
+			return
+		}
+
+		pages := map[string][2]string{"": {"m1", "p2"}, "p2": {"m2", "p3"}, "p3": {"m3", ""}}
+		page := pages[pageToken]
+		body = map[string]any{
+			"historyId":     "200",
+			"nextPageToken": page[1],
+			"history": []map[string]any{{
+				"id":            "150",
+				"messagesAdded": []map[string]any{{"message": map[string]any{"id": page[0]}}},
+			}},
+		}
+	case strings.Contains(r.URL.Path, "/users/me/messages/"):
+		id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		raw := base64.URLEncoding.EncodeToString([]byte("Subject: " + id + "\r\n\r\nBody"))
+		body = map[string]any{"id": id, "threadId": id, "raw": raw, "labelIds": []string{"INBOX"}}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// drainChanges collects the URIs of changes and the checkpoint cursors of
+// an incremental sync, returning its final result.
+func drainChanges(changes <-chan domain.RawDocumentChange, errs <-chan error) ([]string, []string, error) {
+	var uris, checkpoints []string
+	var result error
+	for changes != nil || errs != nil {
+		select {
+		case change, ok := <-changes:
+			if !ok {
+				changes = nil
+				continue
+			}
+			uris = append(uris, change.Document.URI)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			if cp, isCheckpoint := driven.IsSyncCheckpoint(err); isCheckpoint {
+				checkpoints = append(checkpoints, cp.Cursor)
+				continue
+			}
+			result = err
+		}
+	}
+	return uris, checkpoints, result
+}
+
+func TestConnector_IncrementalSync_ResumesFromCheckpoint(t *testing.T) {
+	stub := &stubHistory{failPageToken: "p2"}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	conn := New("source-123", DefaultConfig(), &mockTokenProvider{token: "test-token", isAuthed: true})
+	conn.rateLimiter = google.NewRateLimiterWithConfig(google.RateLimitConfig{RequestsPerSecond: 1000, BurstSize: 100})
+	conn.newService = func(ctx context.Context) (*gmail.Service, error) {
+		return gmail.NewService(ctx, option.WithEndpoint(server.URL+"/"), option.WithoutAuthentication())
+	}
+
+	start := &Cursor{Version: CursorVersion, HistoryID: 100}
+	uris, checkpoints, err := drainChanges(conn.IncrementalSync(context.Background(), domain.SyncState{
+		Cursor: start.Encode(),
+	}))
+
+	// The second page fails after the first was checkpointed
+	require.Error(t, err)
+	assert.Equal(t, []string{"gmail://messages/m1"}, uris)
+	require.Len(t, checkpoints, 1)
+	checkpoint, err := DecodeCursor(checkpoints[0])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), checkpoint.HistoryID)
+	assert.Equal(t, "p2", checkpoint.PageToken)
+
+	// The next sync resumes from the saved page token, not the first page
+	stub.requests = nil
+	uris, _, err = drainChanges(conn.IncrementalSync(context.Background(), domain.SyncState{
+		Cursor: checkpoints[0],
+	}))
+
+	complete, ok := driven.IsSyncComplete(err)
+	require.True(t, ok, "expected SyncComplete, got %v", err)
+	assert.Equal(t, []string{"gmail://messages/m2", "gmail://messages/m3"}, uris)
+	assert.Equal(t, []string{"100:p2", "100:p3"}, stub.requests)
+
+	final, err := DecodeCursor(complete.NewCursor)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(200), final.HistoryID)
+	assert.Empty(t, final.PageToken)
+}
+
+// stubAttachments serves one multipart message with a PDF attachment and an
+// image attachment, counting attachment downloads.
+type stubAttachments struct {
+	mu        sync.Mutex
+	downloads []string
+}
+
+func (s *stubAttachments) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var body any
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/users/me/profile"):
+		body = map[string]any{"emailAddress": "user@example.com", "historyId": "100"}
+	case strings.HasSuffix(r.URL.Path, "/users/me/messages"):
+		body = map[string]any{"messages": []map[string]any{{"id": "m1"}}}
+	case strings.Contains(r.URL.Path, "/attachments/"):
+		s.downloads = append(s.downloads, r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+		body = map[string]any{"data": base64.URLEncoding.EncodeToString([]byte("%PDF-1.4")), "size": 8}
+	case strings.HasSuffix(r.URL.Path, "/users/me/messages/m1") && r.URL.Query().Get("format") == "full":
+		body = map[string]any{"payload": map[string]any{
+			"mimeType": "multipart/mixed",
+			"parts": []map[string]any{
+				{"partId": "0", "mimeType": "text/plain", "body": map[string]any{"size": 4, "data": "Qm9keQ"}},
+				{"partId": "1", "mimeType": "application/pdf", "filename": "report.pdf",
+					"body": map[string]any{"attachmentId": "att-pdf", "size": 8}},
+				{"partId": "2", "mimeType": "image/png", "filename": "logo.png",
+					"body": map[string]any{"attachmentId": "att-png", "size": 8}},
+			},
+		}}
+	case strings.HasSuffix(r.URL.Path, "/users/me/messages/m1"):
+		raw := base64.URLEncoding.EncodeToString([]byte(
+			"Subject: Report\r\nContent-Type: multipart/mixed; boundary=b\r\n\r\n--b\r\n\r\nBody\r\n--b--"))
+		body = map[string]any{"id": "m1", "threadId": "m1", "raw": raw, "labelIds": []string{"INBOX"}}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func newStubbedConnector(cfg *Config, serverURL string) *Connector {
+	conn := New("source-123", cfg, &mockTokenProvider{token: "test-token", isAuthed: true})
+	conn.rateLimiter = google.NewRateLimiterWithConfig(google.RateLimitConfig{RequestsPerSecond: 1000, BurstSize: 100})
+	conn.newService = func(ctx context.Context) (*gmail.Service, error) {
+		return gmail.NewService(ctx, option.WithEndpoint(serverURL+"/"), option.WithoutAuthentication())
+	}
+	return conn
+}
+
+func TestConnector_FullSync_IndexesAttachments(t *testing.T) {
+	stub := &stubAttachments{}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	conn := newStubbedConnector(DefaultConfig(), server.URL)
+
+	docs, errs := conn.FullSync(context.Background())
+	var collected []domain.RawDocument
+	for doc := range docs {
+		collected = append(collected, doc)
+	}
+	for err := range errs {
+		_, ok := driven.IsSyncComplete(err)
+		require.True(t, ok, "expected SyncComplete, got %v", err)
+	}
+
+	require.Len(t, collected, 2)
+	assert.Equal(t, "gmail://messages/m1", collected[0].URI)
+	attachment := collected[1]
+	assert.Equal(t, "gmail://messages/m1/attachments/1", attachment.URI)
+	assert.Equal(t, "application/pdf", attachment.MIMEType)
+	assert.Equal(t, []byte("%PDF-1.4"), attachment.Content)
+	require.NotNil(t, attachment.ParentURI)
+	assert.Equal(t, "gmail://messages/m1", *attachment.ParentURI)
+	assert.Equal(t, []string{"att-pdf"}, stub.downloads, "unsupported attachments are not downloaded")
+}
+
+func TestConnector_FullSync_AttachmentsDisabled(t *testing.T) {
+	stub := &stubAttachments{}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.MaxAttachmentSize = 0
+	conn := newStubbedConnector(cfg, server.URL)
+
+	docs, errs := conn.FullSync(context.Background())
+	var uris []string
+	for doc := range docs {
+		uris = append(uris, doc.URI)
+	}
+	for range errs {
+	}
+
+	assert.Equal(t, []string{"gmail://messages/m1"}, uris)
+	assert.Empty(t, stub.downloads)
+}
@@ -19,6 +19,10 @@ type Cursor struct {
 	// HistoryID is the history ID from the last sync.
 	// Used as the starting point for history.list() in incremental sync.
 	HistoryID uint64 `json:"history_id"`
+	// PageToken is the history.list page token reached by an interrupted
+	// sync. It belongs to HistoryID, so the next sync resumes listing from
+	// this page rather than the first.
+	PageToken string `json:"page_token,omitempty"`
 }
 
 // NewCursor creates a new empty cursor.
@@ -9,6 +9,14 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
+// mimeTypeMessage is the MIME type of RFC 822 messages emitted by this connector.
+const mimeTypeMessage = "message/rfc822"
+
+// EmittedMIMETypes returns the MIME types the Gmail connector emits.
+func EmittedMIMETypes() []string {
+	return append([]string{mimeTypeMessage}, attachmentMIMETypes...)
+}
+
 // MessageToRawDocument converts a Gmail message to a RawDocument.
 // When using Format("raw"), msg.Raw contains the base64url-encoded RFC 2822 message.
 func MessageToRawDocument(msg *gmail.Message, sourceID string) *domain.RawDocument {
@@ -23,7 +31,7 @@ func MessageToRawDocument(msg *gmail.Message, sourceID string) *domain.RawDocume
 	return &domain.RawDocument{
 		SourceID:  sourceID,
 		URI:       fmt.Sprintf("gmail://messages/%s", msg.Id),
-		MIMEType:  "message/rfc822",
+		MIMEType:  mimeTypeMessage,
 		Content:   rawBytes,
 		ParentURI: parentURI,
 		Metadata: map[string]any{
@@ -4,9 +4,11 @@ import "strings"
 
 // ResolveWebURL converts a Gmail URI to a web URL.
 // gmail://messages/{id} -> https://mail.google.com/mail/u/0/#all/{id}
+// Attachments (gmail://messages/{id}/attachments/{part}) open their message.
 func ResolveWebURL(uri string, _ map[string]any) string {
 	if strings.HasPrefix(uri, "gmail://messages/") {
 		messageID := strings.TrimPrefix(uri, "gmail://messages/")
+		messageID, _, _ = strings.Cut(messageID, "/")
 		return "https://mail.google.com/mail/u/0/#all/" + messageID
 	}
 	return ""
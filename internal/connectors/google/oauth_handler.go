@@ -122,9 +122,10 @@ func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (str
 // DefaultConfig returns default OAuth URLs and scopes for Google.
 func (h *OAuthHandler) DefaultConfig() driven.OAuthDefaults {
 	return driven.OAuthDefaults{
-		AuthURL:  defaultAuthURL,
-		TokenURL: defaultTokenURL,
-		Scopes:   defaultScopes,
+		AuthURL:   defaultAuthURL,
+		TokenURL:  defaultTokenURL,
+		Scopes:    defaultScopes,
+		DeviceURL: defaultDeviceURL,
 	}
 }
 
@@ -135,8 +136,9 @@ func (h *OAuthHandler) SetupHint() string {
 
 // Google OAuth constants.
 const (
-	defaultAuthURL  = "https://accounts.google.com/o/oauth2/v2/auth"
-	defaultTokenURL = "https://oauth2.googleapis.com/token" //nolint:gosec // G101: Not credentials, OAuth endpoint URL
+	defaultAuthURL   = "https://accounts.google.com/o/oauth2/v2/auth"
+	defaultTokenURL  = "https://oauth2.googleapis.com/token" //nolint:gosec // G101: Not credentials, OAuth endpoint URL
+	defaultDeviceURL = "https://oauth2.googleapis.com/device/code"
 )
 
 // defaultScopes are the default OAuth scopes for Google.
@@ -176,8 +178,7 @@ func refreshOAuthToken(
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Accept", "application/json")
 
-	client := &http.Client{Timeout: 30 * time.Second}
-	resp, err := client.Do(req)
+	resp, err := sharedClient().Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("token refresh request: %w", err)
 	}
@@ -11,6 +11,10 @@ import (
 	"google.golang.org/api/drive/v3"
 	"google.golang.org/api/gmail/v1"
 	"google.golang.org/api/option"
+
+	"github.com/custodia-labs/sercha-cli/internal/connectors/budget"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/locale"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
 const userInfoURL = "https://www.googleapis.com/oauth2/v2/userinfo"
@@ -24,18 +28,41 @@ type UserInfo struct {
 }
 
 // NewGmailService creates a Gmail API service using the provided TokenSource.
-func NewGmailService(ctx context.Context, ts oauth2.TokenSource) (*gmail.Service, error) {
-	return gmail.NewService(ctx, option.WithTokenSource(ts))
+// Requests are charged to apiBudget, if not nil, and send locale as
+// Accept-Language, if set.
+func NewGmailService(
+	ctx context.Context, ts oauth2.TokenSource, apiBudget driven.APIBudget, locale string,
+) (*gmail.Service, error) {
+	return gmail.NewService(ctx, clientOption(ctx, ts, apiBudget, locale))
 }
 
 // NewDriveService creates a Google Drive API service using the provided TokenSource.
-func NewDriveService(ctx context.Context, ts oauth2.TokenSource) (*drive.Service, error) {
-	return drive.NewService(ctx, option.WithTokenSource(ts))
+// Requests are charged to apiBudget, if not nil, and send locale as
+// Accept-Language, if set.
+func NewDriveService(
+	ctx context.Context, ts oauth2.TokenSource, apiBudget driven.APIBudget, locale string,
+) (*drive.Service, error) {
+	return drive.NewService(ctx, clientOption(ctx, ts, apiBudget, locale))
 }
 
 // NewCalendarService creates a Google Calendar API service using the provided TokenSource.
-func NewCalendarService(ctx context.Context, ts oauth2.TokenSource) (*calendar.Service, error) {
-	return calendar.NewService(ctx, option.WithTokenSource(ts))
+// Requests are charged to apiBudget, if not nil, and send locale as
+// Accept-Language, if set.
+func NewCalendarService(
+	ctx context.Context, ts oauth2.TokenSource, apiBudget driven.APIBudget, locale string,
+) (*calendar.Service, error) {
+	return calendar.NewService(ctx, clientOption(ctx, ts, apiBudget, locale))
+}
+
+// clientOption authenticates API services with ts over the pooled
+// connector transport, charging their requests to apiBudget when one is set
+// and sending locale when one is set.
+func clientOption(
+	ctx context.Context, ts oauth2.TokenSource, apiBudget driven.APIBudget, loc string,
+) option.ClientOption {
+	base := &http.Client{Transport: locale.Transport(budget.Transport(sharedTransport(), apiBudget), loc)}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, base)
+	return option.WithHTTPClient(oauth2.NewClient(ctx, ts))
 }
 
 // GetUserInfo fetches the user's profile information using an access token.
@@ -48,7 +75,7 @@ func GetUserInfo(ctx context.Context, accessToken string) (*UserInfo, error) {
 
 	req.Header.Set("Authorization", "Bearer "+accessToken)
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := sharedClient().Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("fetch user info: %w", err)
 	}
@@ -0,0 +1,41 @@
+// Package locale sends the configured locale on connector requests.
+//
+// Providers such as Microsoft Graph and web servers return localised
+// content (folder names, display strings, page variants) based on the
+// Accept-Language header. Sending the same locale on every request keeps
+// indexed content in a consistent language regardless of account settings.
+package locale
+
+import "net/http"
+
+// Transport returns a RoundTripper that sends locale as Accept-Language on
+// requests that do not set one, so a per-source locale takes precedence.
+// A nil base uses http.DefaultTransport; an empty locale returns base
+// unchanged.
+func Transport(base http.RoundTripper, locale string) http.RoundTripper {
+	if locale == "" {
+		return base
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base, locale: locale}
+}
+
+// transport sets Accept-Language before sending requests.
+type transport struct {
+	base   http.RoundTripper
+	locale string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Accept-Language") != "" {
+		return t.base.RoundTrip(req)
+	}
+
+	// A RoundTripper must not modify the caller's request
+	req = req.Clone(req.Context())
+	req.Header.Set("Accept-Language", t.locale)
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,43 @@
+package locale
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransport(t *testing.T) {
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("Accept-Language"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: Transport(nil, "en-GB")}
+	get := func(acceptLanguage string) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, server.URL, http.NoBody)
+		require.NoError(t, err)
+		if acceptLanguage != "" {
+			req.Header.Set("Accept-Language", acceptLanguage)
+		}
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, acceptLanguage, req.Header.Get("Accept-Language"), "caller's request is not modified")
+	}
+
+	get("")
+	get("fr-FR")
+
+	assert.Equal(t, []string{"en-GB", "fr-FR"}, got, "a request's own locale takes precedence")
+}
+
+func TestTransport_NoLocale(t *testing.T) {
+	base := http.DefaultTransport
+	assert.Equal(t, base, Transport(base, ""))
+	assert.Nil(t, Transport(nil, ""))
+}
@@ -1,8 +1,10 @@
 package calendar
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
@@ -18,14 +20,23 @@ type Config struct {
 	ShowCancelled bool
 	// SingleEvents expands recurring events into instances.
 	SingleEvents bool
+	// Locale is sent as Accept-Language on Graph requests (optional).
+	// If empty, Graph uses the account's default language.
+	Locale string
+	// Timezone is the IANA name of the zone event times are rendered in.
+	Timezone string
 }
 
+// DefaultTimezone is the zone event times are rendered in when none is configured.
+const DefaultTimezone = "UTC"
+
 // DefaultConfig returns the default configuration.
 func DefaultConfig() *Config {
 	return &Config{
 		MaxResults:    100,
 		ShowCancelled: false,
 		SingleEvents:  true,
+		Timezone:      DefaultTimezone,
 	}
 }
 
@@ -58,5 +69,18 @@ func ParseConfig(source domain.Source) (*Config, error) {
 		cfg.SingleEvents = val == "true" || val == "1"
 	}
 
+	// Parse locale
+	if val := source.Config["locale"]; val != "" {
+		cfg.Locale = strings.TrimSpace(val)
+	}
+
+	// Parse timezone
+	if val := strings.TrimSpace(source.Config["timezone"]); val != "" {
+		if _, err := time.LoadLocation(val); err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w", val, err)
+		}
+		cfg.Timezone = val
+	}
+
 	return cfg, nil
 }
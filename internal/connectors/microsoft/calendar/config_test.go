@@ -16,6 +16,7 @@ func TestDefaultConfig(t *testing.T) {
 	assert.Empty(t, cfg.CalendarIDs)
 	assert.False(t, cfg.ShowCancelled)
 	assert.True(t, cfg.SingleEvents)
+	assert.Equal(t, "UTC", cfg.Timezone)
 }
 
 func TestParseConfig_Default(t *testing.T) {
@@ -145,3 +146,30 @@ func TestParseConfig_AllOptions(t *testing.T) {
 	assert.True(t, cfg.ShowCancelled)
 	assert.False(t, cfg.SingleEvents)
 }
+
+func TestParseConfig_WithLocale(t *testing.T) {
+	source := domain.Source{
+		Config: map[string]string{
+			"locale": " en-GB ",
+		},
+	}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, "en-GB", cfg.Locale)
+}
+
+func TestParseConfig_WithTimezone(t *testing.T) {
+	cfg, err := ParseConfig(domain.Source{Config: map[string]string{"timezone": " Europe/London "}})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/London", cfg.Timezone)
+}
+
+func TestParseConfig_InvalidTimezone(t *testing.T) {
+	_, err := ParseConfig(domain.Source{Config: map[string]string{"timezone": "Mars/Olympus"}})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid timezone "Mars/Olympus"`)
+}
@@ -5,18 +5,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"sync"
-	"time"
 
 	"github.com/custodia-labs/sercha-cli/internal/connectors/microsoft"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
-	"github.com/custodia-labs/sercha-cli/internal/logger"
 )
 
-// Ensure Connector implements the interface.
-var _ driven.Connector = (*Connector)(nil)
+// Ensure Connector implements the interfaces.
+var (
+	_ driven.Connector      = (*Connector)(nil)
+	_ driven.APIBudgetAware = (*Connector)(nil)
+)
 
 const graphBaseURL = "https://graph.microsoft.com/v1.0"
 
@@ -26,6 +29,7 @@ type Connector struct {
 	config        *Config
 	tokenProvider driven.TokenProvider
 	rateLimiter   *microsoft.RateLimiter
+	apiBudget     driven.APIBudget
 	mu            sync.Mutex
 	closed        bool
 }
@@ -40,11 +44,29 @@ func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Conn
 	}
 }
 
+// SetAPIBudget charges the connector's API requests to a shared budget.
+func (c *Connector) SetAPIBudget(apiBudget driven.APIBudget) {
+	c.apiBudget = apiBudget
+}
+
+// SetLocale sends locale as Accept-Language on Graph requests, unless the
+// source configures its own locale.
+func (c *Connector) SetLocale(locale string) {
+	if c.config.Locale == "" {
+		c.config.Locale = locale
+	}
+}
+
 // Type returns the connector type identifier.
 func (c *Connector) Type() string {
 	return "microsoft-calendar"
 }
 
+// log returns a logger whose records name the connector and its source.
+func (c *Connector) log() *slog.Logger {
+	return slog.With(slog.String("connector", c.Type()), slog.String("source_id", c.sourceID))
+}
+
 // SourceID returns the source identifier.
 func (c *Connector) SourceID() string {
 	return c.sourceID
@@ -123,11 +145,12 @@ func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawD
 		return err
 	}
 
-	logger.Debug("microsoft-calendar: starting full sync for source %s", c.sourceID)
+	log := c.log()
+	log.Debug("full sync started")
 
 	token, err := c.tokenProvider.GetToken(ctx)
 	if err != nil {
-		logger.Debug("microsoft-calendar: failed to get token: %v", err)
+		log.Debug("failed to get token", slog.Any("error", err))
 		return fmt.Errorf("get token: %w", err)
 	}
 
@@ -135,26 +158,26 @@ func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawD
 
 	calendarIDs, err := c.getCalendarIDs(ctx, token)
 	if err != nil {
-		logger.Debug("microsoft-calendar: failed to get calendar IDs: %v", err)
+		log.Debug("failed to get calendar IDs", slog.Any("error", err))
 		return err
 	}
 
-	logger.Debug("microsoft-calendar: found %d calendars to sync", len(calendarIDs))
+	log.Debug("found calendars to sync", slog.Int("calendars", len(calendarIDs)))
 
 	var successCount, failCount int
 	for _, calID := range calendarIDs {
-		logger.Debug("microsoft-calendar: syncing calendar %s", calID)
+		log.Debug("syncing calendar", slog.String("calendar_id", calID))
 		err := c.syncCalendarEvents(ctx, token, calID, docsChan, cursor)
 		if err != nil {
-			logger.Warn("microsoft-calendar: failed to sync calendar %s: %v", calID, err)
+			log.Warn("failed to sync calendar", slog.String("calendar_id", calID), slog.Any("error", err))
 			failCount++
 		} else {
-			logger.Debug("microsoft-calendar: successfully synced calendar %s", calID)
+			log.Debug("synced calendar", slog.String("calendar_id", calID))
 			successCount++
 		}
 	}
 
-	logger.Debug("microsoft-calendar: sync complete - %d succeeded, %d failed", successCount, failCount)
+	log.Debug("full sync finished", slog.Int("succeeded", successCount), slog.Int("failed", failCount))
 
 	return &driven.SyncComplete{NewCursor: cursor.Encode()}
 }
@@ -243,7 +266,8 @@ func (c *Connector) fetchAllCalendarIDs(ctx context.Context, token string) ([]st
 	var calendarIDs []string
 	url := graphBaseURL + "/me/calendars"
 
-	logger.Debug("microsoft-calendar: fetching calendars from Microsoft Graph")
+	log := c.log()
+	log.Debug("fetching calendars from Microsoft Graph")
 
 	for url != "" {
 		if err := ctx.Err(); err != nil {
@@ -254,9 +278,11 @@ func (c *Connector) fetchAllCalendarIDs(ctx context.Context, token string) ([]st
 			return nil, err
 		}
 
-		resp, err := c.doRequest(ctx, url, token)
+		pageCtx, span := tracing.StartPage(ctx, "microsoft-calendar", "calendars")
+		resp, err := c.doRequest(pageCtx, url, token)
+		tracing.EndPage(span, err)
 		if err != nil {
-			logger.Debug("microsoft-calendar: request error: %v", err)
+			log.Debug("list calendars request failed", slog.Any("error", err))
 			return nil, fmt.Errorf("list calendars: %w", err)
 		}
 
@@ -266,10 +292,10 @@ func (c *Connector) fetchAllCalendarIDs(ctx context.Context, token string) ([]st
 			return nil, fmt.Errorf("read response: %w", err)
 		}
 
-		logger.Debug("microsoft-calendar: calendars response status %d, body length %d", resp.StatusCode, len(body))
+		log.Debug("calendars response", slog.Int("status", resp.StatusCode), slog.Int("body_length", len(body)))
 
 		if resp.StatusCode != http.StatusOK {
-			logger.Debug("microsoft-calendar: list calendars failed with body: %s", string(body))
+			log.Debug("list calendars failed", slog.String("body", string(body)))
 			return nil, fmt.Errorf("list calendars failed: status %d", resp.StatusCode)
 		}
 
@@ -278,13 +304,13 @@ func (c *Connector) fetchAllCalendarIDs(ctx context.Context, token string) ([]st
 			NextLink string                `json:"@odata.nextLink"`
 		}
 		if err := json.Unmarshal(body, &listResp); err != nil {
-			logger.Debug("microsoft-calendar: failed to decode calendars response: %v", err)
+			log.Debug("failed to decode calendars response", slog.Any("error", err))
 			return nil, fmt.Errorf("decode calendars: %w", err)
 		}
 
-		logger.Debug("microsoft-calendar: found %d calendars in this page", len(listResp.Value))
+		log.Debug("found calendars in page", slog.Int("calendars", len(listResp.Value)))
 		for _, cal := range listResp.Value {
-			logger.Debug("microsoft-calendar: found calendar ID: %s", cal.ID)
+			log.Debug("found calendar", slog.String("calendar_id", cal.ID))
 			calendarIDs = append(calendarIDs, cal.ID)
 		}
 
@@ -364,7 +390,8 @@ func (c *Connector) processDeltaPages(
 	var finalDeltaLink string
 	var totalEvents int
 
-	logger.Debug("microsoft-calendar: starting delta sync for calendar %s", calendarID)
+	log := c.log().With(slog.String("calendar_id", calendarID))
+	log.Debug("delta sync started")
 
 	for currentURL != "" {
 		if err := ctx.Err(); err != nil {
@@ -373,15 +400,15 @@ func (c *Connector) processDeltaPages(
 
 		pageResult, err := c.fetchDeltaPage(ctx, token, currentURL)
 		if err != nil {
-			logger.Debug("microsoft-calendar: delta page fetch error: %v", err)
+			log.Debug("delta page fetch failed", slog.Any("error", err))
 			return "", err
 		}
 
-		logger.Debug("microsoft-calendar: fetched page with %d events", len(pageResult.events))
+		log.Debug("fetched delta page", slog.Int("events", len(pageResult.events)))
 		totalEvents += len(pageResult.events)
 
 		if err := c.processEvents(ctx, token, calendarID, pageResult.events, docsChan, changesChan); err != nil {
-			logger.Debug("microsoft-calendar: process events error: %v", err)
+			log.Debug("processing events failed", slog.Any("error", err))
 			return "", err
 		}
 
@@ -391,7 +418,7 @@ func (c *Connector) processDeltaPages(
 		}
 	}
 
-	logger.Debug("microsoft-calendar: delta sync complete for calendar %s, total events: %d", calendarID, totalEvents)
+	log.Debug("delta sync finished", slog.Int("events", totalEvents))
 
 	return finalDeltaLink, nil
 }
@@ -404,9 +431,12 @@ func (c *Connector) fetchDeltaPage(
 		return nil, err
 	}
 
-	logger.Debug("microsoft-calendar: fetching delta page: %s", url)
+	log := c.log()
+	log.Debug("fetching delta page", slog.String("url", url))
 
-	resp, err := c.doRequest(ctx, url, token)
+	pageCtx, span := tracing.StartPage(ctx, "microsoft-calendar", "events_delta")
+	resp, err := c.doRequest(pageCtx, url, token)
+	tracing.EndPage(span, err)
 	if err != nil {
 		return nil, fmt.Errorf("delta request: %w", err)
 	}
@@ -417,14 +447,14 @@ func (c *Connector) fetchDeltaPage(
 		return nil, fmt.Errorf("read response: %w", err)
 	}
 
-	logger.Debug("microsoft-calendar: delta response status %d, body length %d", resp.StatusCode, len(body))
+	log.Debug("delta response", slog.Int("status", resp.StatusCode), slog.Int("body_length", len(body)))
 
 	if resp.StatusCode == http.StatusGone {
-		logger.Debug("microsoft-calendar: delta token expired (410 Gone)")
+		log.Debug("delta token expired (410 Gone)")
 		return nil, microsoft.ErrDeltaTokenExpired
 	}
 	if resp.StatusCode != http.StatusOK {
-		logger.Debug("microsoft-calendar: delta request failed with body: %s", string(body))
+		log.Debug("delta request failed", slog.String("body", string(body)))
 		return nil, fmt.Errorf("delta request failed: status %d: %w",
 			resp.StatusCode, microsoft.WrapError(resp.StatusCode))
 	}
@@ -435,12 +465,12 @@ func (c *Connector) fetchDeltaPage(
 		DeltaLink string            `json:"@odata.deltaLink"`
 	}
 	if err := json.Unmarshal(body, &deltaResp); err != nil {
-		logger.Debug("microsoft-calendar: failed to decode delta response: %v", err)
+		log.Debug("failed to decode delta response", slog.Any("error", err))
 		return nil, fmt.Errorf("decode delta response: %w", err)
 	}
 
-	logger.Debug("microsoft-calendar: delta response: %d events, hasNextLink=%v, hasDeltaLink=%v",
-		len(deltaResp.Value), deltaResp.NextLink != "", deltaResp.DeltaLink != "")
+	log.Debug("decoded delta response", slog.Int("events", len(deltaResp.Value)),
+		slog.Bool("has_next_link", deltaResp.NextLink != ""), slog.Bool("has_delta_link", deltaResp.DeltaLink != ""))
 
 	return &deltaPageResult{
 		events:    deltaResp.Value,
@@ -458,16 +488,17 @@ func (c *Connector) processEvents(
 	docsChan chan<- domain.RawDocument,
 	changesChan chan<- domain.RawDocumentChange,
 ) error {
+	log := c.log()
 	var processedCount, skippedCount int
 	for i, raw := range events {
 		// Log the first raw event to see what fields Microsoft returns
 		if i == 0 {
-			logger.Debug("microsoft-calendar: raw delta event JSON sample: %s", string(raw))
+			log.Debug("raw delta event sample", slog.String("json", string(raw)))
 		}
 
 		var eventWithRemoved EventWithRemoved
 		if err := json.Unmarshal(raw, &eventWithRemoved); err != nil {
-			logger.Debug("microsoft-calendar: failed to unmarshal event: %v", err)
+			log.Debug("failed to unmarshal event", slog.Any("error", err))
 			skippedCount++
 			continue
 		}
@@ -477,7 +508,7 @@ func (c *Connector) processEvents(
 		}
 		processedCount++
 	}
-	logger.Debug("microsoft-calendar: processed %d events, skipped %d", processedCount, skippedCount)
+	log.Debug("processed events", slog.Int("processed", processedCount), slog.Int("skipped", skippedCount))
 	return nil
 }
 
@@ -492,36 +523,47 @@ func (c *Connector) processSingleEvent(
 	docsChan chan<- domain.RawDocument,
 	changesChan chan<- domain.RawDocumentChange,
 ) error {
-	logger.Debug("microsoft-calendar: processing event %s", eventWithRemoved.ID)
+	log := c.log().With(slog.String("event_id", eventWithRemoved.ID))
+	log.Debug("processing event")
 
 	if IsEventRemoved(eventWithRemoved) {
-		logger.Debug("microsoft-calendar: event %s is removed, handling deletion", eventWithRemoved.ID)
+		log.Debug("event removed, handling deletion")
 		return c.handleDeletedEvent(ctx, calendarID, eventWithRemoved.ID, changesChan)
 	}
 
 	if !ShouldSyncEvent(&eventWithRemoved.Event) {
-		logger.Debug("microsoft-calendar: event %s filtered by ShouldSyncEvent", eventWithRemoved.ID)
+		log.Debug("event filtered by ShouldSyncEvent")
 		return nil
 	}
 
 	// Fetch full event details since delta only returns minimal fields
 	fullEvent, err := c.fetchFullEvent(ctx, token, calendarID, eventWithRemoved.ID)
 	if err != nil {
-		logger.Debug("microsoft-calendar: failed to fetch full event %s: %v", eventWithRemoved.ID, err)
+		log.Debug("failed to fetch full event", slog.Any("error", err))
 		return nil // Skip this event but continue with others
 	}
 
 	// Skip cancelled events in full sync
 	if docsChan != nil && fullEvent.IsCancelled && !c.config.ShowCancelled {
-		logger.Debug("microsoft-calendar: event %s skipped (cancelled)", fullEvent.ID)
+		log.Debug("event skipped (cancelled)")
 		return nil
 	}
 
-	logger.Debug("microsoft-calendar: emitting event %s (subject: %s)", fullEvent.ID, fullEvent.Subject)
+	log.Debug("emitting event", slog.String("subject", fullEvent.Subject))
 	doc := EventToRawDocument(fullEvent, calendarID, c.sourceID)
+	// Graph returns event times in the Prefer timezone
+	doc.Metadata["timezone"] = c.timezone()
 	return c.emitDocument(ctx, doc, docsChan, changesChan)
 }
 
+// timezone returns the configured timezone, defaulting to UTC.
+func (c *Connector) timezone() string {
+	if c.config.Timezone == "" {
+		return DefaultTimezone
+	}
+	return c.config.Timezone
+}
+
 // fetchFullEvent fetches complete event details from the Graph API.
 func (c *Connector) fetchFullEvent(ctx context.Context, token, calendarID, eventID string) (*Event, error) {
 	url := fmt.Sprintf("%s/me/calendars/%s/events/%s", graphBaseURL, calendarID, eventID)
@@ -601,18 +643,18 @@ func (c *Connector) emitDocument(
 func (c *Connector) doRequest(
 	ctx context.Context, url, token string,
 ) (*http.Response, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	req, err := microsoft.NewRequest(ctx, http.MethodGet, url, token, c.config.Locale)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Accept", "application/json")
-	// Combine Prefer directives: timezone and page size (odata.maxpagesize for delta queries)
-	req.Header.Set("Prefer", fmt.Sprintf("outlook.timezone=\"UTC\", odata.maxpagesize=%d", c.config.MaxResults))
+	// Combine Prefer directives: timezone and page size (odata.maxpagesize for delta queries).
+	// Graph has no Prefer directive for language; it localises by the
+	// Accept-Language header NewRequest sets from the locale.
+	req.Header.Set("Prefer", fmt.Sprintf("outlook.timezone=%q, odata.maxpagesize=%d",
+		c.timezone(), c.config.MaxResults))
 
-	client := &http.Client{Timeout: 60 * time.Second}
-	return client.Do(req)
+	return microsoft.Do(req, c.apiBudget)
 }
 
 // sendDocument sends a document to the channel.
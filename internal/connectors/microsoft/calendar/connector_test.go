@@ -2,6 +2,8 @@ package calendar
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -34,6 +36,10 @@ func (m *mockTokenProvider) IsAuthenticated() bool {
 	return m.isAuthed
 }
 
+func (m *mockTokenProvider) RefreshIfNeeded(_ context.Context) error {
+	return nil
+}
+
 func TestNew(t *testing.T) {
 	tp := &mockTokenProvider{token: "test-token", isAuthed: true}
 	cfg := DefaultConfig()
@@ -201,3 +207,51 @@ func TestConnector_getCalendarIDs_ConfiguredIDs(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, []string{"cal-1", "cal-2"}, ids)
 }
+
+func TestConnector_doRequest_SetsAcceptLanguage(t *testing.T) {
+	var gotLanguage string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotLanguage = r.Header.Get("Accept-Language")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.Locale = "en-GB"
+	conn := New("source-123", cfg, nil)
+
+	resp, err := conn.doRequest(context.Background(), server.URL, "token")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, "en-GB", gotLanguage)
+}
+
+func TestConnector_doRequest_PreferTimezone(t *testing.T) {
+	var gotPrefer string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPrefer = r.Header.Get("Prefer")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		timezone string
+		expected string
+	}{
+		{"", `outlook.timezone="UTC", odata.maxpagesize=100`},
+		{"Europe/London", `outlook.timezone="Europe/London", odata.maxpagesize=100`},
+	}
+
+	for _, tt := range tests {
+		cfg := DefaultConfig()
+		cfg.Timezone = tt.timezone
+		conn := New("source-123", cfg, nil)
+
+		resp, err := conn.doRequest(context.Background(), server.URL, "token")
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, tt.expected, gotPrefer)
+	}
+}
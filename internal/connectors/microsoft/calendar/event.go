@@ -7,6 +7,14 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
+// mimeTypeEvent is the MIME type of iCalendar events emitted by this connector.
+const mimeTypeEvent = "text/calendar"
+
+// EmittedMIMETypes returns the MIME types the Microsoft Calendar connector emits.
+func EmittedMIMETypes() []string {
+	return []string{mimeTypeEvent}
+}
+
 // Event represents a Microsoft Calendar event from the Graph API.
 type Event struct {
 	ID                   string        `json:"id"`
@@ -139,7 +147,7 @@ func EventToRawDocument(event *Event, calendarID, sourceID string) *domain.RawDo
 	return &domain.RawDocument{
 		SourceID:  sourceID,
 		URI:       fmt.Sprintf("mscal://%s/events/%s", calendarID, event.ID),
-		MIMEType:  "text/calendar",
+		MIMEType:  mimeTypeEvent,
 		Content:   []byte(content),
 		ParentURI: parentURI,
 		Metadata:  metadata,
@@ -0,0 +1,54 @@
+package microsoft
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Connection pool limits for the shared transport.
+const (
+	maxIdleConns    = 10
+	idleConnTimeout = 90 * time.Second
+)
+
+var (
+	pooledOnce      sync.Once
+	pooledTransport *http.Transport
+	pooledClient    *http.Client
+)
+
+// sharedTransport returns the keep-alive transport shared by every
+// Microsoft connector instance, so API connections are reused across
+// requests instead of being re-dialled each time.
+func sharedTransport() *http.Transport {
+	initPool()
+	return pooledTransport
+}
+
+// sharedClient returns the package-level client on sharedTransport, with
+// the Graph data requestTimeout.
+func sharedClient() *http.Client {
+	initPool()
+	return pooledClient
+}
+
+// initPool builds the shared transport and client on first use.
+func initPool() {
+	pooledOnce.Do(func() {
+		pooledTransport = newPooledTransport()
+		pooledClient = &http.Client{Timeout: requestTimeout, Transport: pooledTransport}
+	})
+}
+
+// newPooledTransport clones http.DefaultTransport, keeping its proxy and
+// dial settings, and raises the per-host idle limit so concurrent requests
+// to the same API host can all keep their connections open.
+func newPooledTransport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.MaxIdleConns = maxIdleConns
+	t.MaxIdleConnsPerHost = maxIdleConns
+	t.IdleConnTimeout = idleConnTimeout
+	t.DisableKeepAlives = false
+	return t
+}
@@ -0,0 +1,26 @@
+package microsoft
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSharedTransport_IsReused(t *testing.T) {
+	first := sharedTransport()
+
+	assert.Same(t, first, sharedTransport())
+	assert.Same(t, first, sharedClient().Transport)
+	assert.NotSame(t, http.DefaultTransport, first)
+}
+
+func TestSharedTransport_PoolSettings(t *testing.T) {
+	transport := sharedTransport()
+
+	assert.Equal(t, maxIdleConns, transport.MaxIdleConns)
+	assert.Equal(t, maxIdleConns, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, idleConnTimeout, transport.IdleConnTimeout)
+	assert.False(t, transport.DisableKeepAlives)
+	assert.Equal(t, requestTimeout, sharedClient().Timeout)
+}
@@ -127,9 +127,10 @@ func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (str
 // DefaultConfig returns default OAuth URLs and scopes for Microsoft.
 func (h *OAuthHandler) DefaultConfig() driven.OAuthDefaults {
 	return driven.OAuthDefaults{
-		AuthURL:  defaultAuthURL,
-		TokenURL: defaultTokenURL,
-		Scopes:   defaultScopes,
+		AuthURL:   defaultAuthURL,
+		TokenURL:  defaultTokenURL,
+		Scopes:    defaultScopes,
+		DeviceURL: defaultDeviceURL,
 	}
 }
 
@@ -142,7 +143,8 @@ func (h *OAuthHandler) SetupHint() string {
 const (
 	defaultAuthURL = "https://login.microsoftonline.com/common/oauth2/v2.0/authorize"
 	//nolint:gosec // G101: Not credentials, OAuth endpoint URL
-	defaultTokenURL = "https://login.microsoftonline.com/common/oauth2/v2.0/token"
+	defaultTokenURL  = "https://login.microsoftonline.com/common/oauth2/v2.0/token"
+	defaultDeviceURL = "https://login.microsoftonline.com/common/oauth2/v2.0/devicecode"
 )
 
 // defaultScopes are the default OAuth scopes for Microsoft.
@@ -176,7 +178,7 @@ func refreshMicrosoftToken(
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Accept", "application/json")
 
-	client := &http.Client{Timeout: 30 * time.Second}
+	client := &http.Client{Timeout: 30 * time.Second, Transport: sharedTransport()}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("token refresh request: %w", err)
@@ -61,6 +61,7 @@ func TestOAuthHandler_DefaultConfig(t *testing.T) {
 
 	assert.Equal(t, defaultAuthURL, defaults.AuthURL)
 	assert.Equal(t, defaultTokenURL, defaults.TokenURL)
+	assert.Equal(t, defaultDeviceURL, defaults.DeviceURL)
 	assert.NotEmpty(t, defaults.Scopes)
 
 	// Verify required scopes are present
@@ -12,12 +12,21 @@ type Config struct {
 	// FolderIDs limits syncing to specific folders (optional).
 	// If empty, syncs from root.
 	FolderIDs []string
+	// FolderPath limits syncing to a folder by path, e.g. "Documents/Work" (optional).
+	// Takes precedence over FolderIDs and is resolved to an item ID at sync start.
+	FolderPath string
 	// MimeTypeFilter limits syncing to specific MIME types (optional).
 	MimeTypeFilter []string
 	// MaxResults is the page size for API requests.
 	MaxResults int64
-	// IncludeSharedWithMe includes files shared with the user.
+	// IncludeSharedWithMe also syncs the folders shared with the user,
+	// each from its owner's drive.
 	IncludeSharedWithMe bool
+	// Locale is sent as Accept-Language on Graph requests (optional).
+	// If empty, Graph uses the account's default language.
+	Locale string
+	// ContentLimits bounds the file content downloaded for indexing.
+	ContentLimits domain.ContentLimits
 }
 
 // DefaultConfig returns the default configuration.
@@ -25,12 +34,14 @@ func DefaultConfig() *Config {
 	return &Config{
 		MaxResults:          100,
 		IncludeSharedWithMe: false,
+		ContentLimits:       domain.DefaultContentLimits(),
 	}
 }
 
 // ParseConfig extracts configuration from a Source.
 func ParseConfig(source domain.Source) (*Config, error) {
 	cfg := DefaultConfig()
+	cfg.ContentLimits = source.ContentLimits()
 
 	// Parse folder_ids
 	if val := source.Config["folder_ids"]; val != "" {
@@ -40,6 +51,11 @@ func ParseConfig(source domain.Source) (*Config, error) {
 		}
 	}
 
+	// Parse folder_path
+	if val := source.Config["folder_path"]; val != "" {
+		cfg.FolderPath = strings.TrimSpace(val)
+	}
+
 	// Parse mime_types filter
 	if val := source.Config["mime_types"]; val != "" {
 		cfg.MimeTypeFilter = strings.Split(val, ",")
@@ -55,10 +71,19 @@ func ParseConfig(source domain.Source) (*Config, error) {
 		}
 	}
 
-	// Parse include_shared
-	if val := source.Config["include_shared"]; val != "" {
+	// Parse shared_with_me, accepting the older include_shared key
+	val := source.Config["shared_with_me"]
+	if val == "" {
+		val = source.Config["include_shared"]
+	}
+	if val != "" {
 		cfg.IncludeSharedWithMe = val == "true" || val == "1"
 	}
 
+	// Parse locale
+	if val := source.Config["locale"]; val != "" {
+		cfg.Locale = strings.TrimSpace(val)
+	}
+
 	return cfg, nil
 }
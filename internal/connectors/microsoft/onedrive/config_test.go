@@ -131,3 +131,67 @@ func TestParseConfig_AllOptions(t *testing.T) {
 	assert.Equal(t, int64(25), cfg.MaxResults)
 	assert.True(t, cfg.IncludeSharedWithMe)
 }
+
+func TestParseConfig_WithLocale(t *testing.T) {
+	source := domain.Source{
+		Config: map[string]string{
+			"locale": " en-GB ",
+		},
+	}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, "en-GB", cfg.Locale)
+}
+
+func TestParseConfig_WithFolderPath(t *testing.T) {
+	source := domain.Source{
+		Config: map[string]string{
+			"folder_path": " Documents/Work ",
+		},
+	}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Documents/Work", cfg.FolderPath)
+}
+
+func TestParseConfig_SharedWithMe(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]string
+		expected bool
+	}{
+		{name: "enabled", config: map[string]string{"shared_with_me": "true"}, expected: true},
+		{name: "disabled", config: map[string]string{"shared_with_me": "false"}, expected: false},
+		{
+			name:     "takes precedence over include_shared",
+			config:   map[string]string{"shared_with_me": "false", "include_shared": "true"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := ParseConfig(domain.Source{Config: tt.config})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg.IncludeSharedWithMe)
+		})
+	}
+}
+
+func TestParseConfig_ContentLimits(t *testing.T) {
+	cfg, err := ParseConfig(domain.Source{Config: map[string]string{}})
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultContentLimits(), cfg.ContentLimits)
+
+	cfg, err = ParseConfig(domain.Source{Config: map[string]string{
+		domain.ConfigKeyMaxContentSize: "1024",
+		domain.ConfigKeyMetadataOnly:   "true",
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, domain.ContentLimits{MaxSize: 1024, MetadataOnly: true}, cfg.ContentLimits)
+}
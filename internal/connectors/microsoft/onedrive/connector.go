@@ -3,31 +3,37 @@ package onedrive
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"strings"
 	"sync"
-	"time"
 
 	"github.com/custodia-labs/sercha-cli/internal/connectors/microsoft"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
-// Ensure Connector implements the interface.
-var _ driven.Connector = (*Connector)(nil)
+// Ensure Connector implements the interfaces.
+var (
+	_ driven.Connector      = (*Connector)(nil)
+	_ driven.APIBudgetAware = (*Connector)(nil)
+)
 
 const graphBaseURL = "https://graph.microsoft.com/v1.0"
 
-// MaxContentSize is the maximum file size to download (5MB).
-const MaxContentSize = 5 * 1024 * 1024
-
 // Connector fetches files from OneDrive via Microsoft Graph.
 type Connector struct {
 	sourceID      string
+	baseURL       string
 	config        *Config
 	tokenProvider driven.TokenProvider
 	rateLimiter   *microsoft.RateLimiter
+	apiBudget     driven.APIBudget
 	mu            sync.Mutex
 	closed        bool
 }
@@ -36,12 +42,26 @@ type Connector struct {
 func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Connector {
 	return &Connector{
 		sourceID:      sourceID,
+		baseURL:       graphBaseURL,
 		config:        cfg,
 		tokenProvider: tokenProvider,
 		rateLimiter:   microsoft.NewRateLimiter(microsoft.ServiceOneDrive),
 	}
 }
 
+// SetAPIBudget charges the connector's API requests to a shared budget.
+func (c *Connector) SetAPIBudget(apiBudget driven.APIBudget) {
+	c.apiBudget = apiBudget
+}
+
+// SetLocale sends locale as Accept-Language on Graph requests, unless the
+// source configures its own locale.
+func (c *Connector) SetLocale(locale string) {
+	if c.config.Locale == "" {
+		c.config.Locale = locale
+	}
+}
+
 // Type returns the connector type identifier.
 func (c *Connector) Type() string {
 	return "onedrive"
@@ -86,7 +106,7 @@ func (c *Connector) Validate(ctx context.Context) error {
 		return fmt.Errorf("%w: %w", domain.ErrAuthRequired, err)
 	}
 
-	url := graphBaseURL + "/me/drive"
+	url := c.baseURL + "/me/drive"
 	resp, err := c.doRequest(ctx, http.MethodGet, url, token)
 	if err != nil {
 		return fmt.Errorf("%w: %w", domain.ErrAuthRequired, err)
@@ -124,6 +144,7 @@ func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawD
 	if err := c.checkClosed(); err != nil {
 		return err
 	}
+	slog.Debug("full sync started", slog.String("connector", c.Type()), slog.String("source_id", c.sourceID))
 
 	token, err := c.tokenProvider.GetToken(ctx)
 	if err != nil {
@@ -132,16 +153,25 @@ func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawD
 
 	cursor := NewCursor()
 
+	// Resolve the configured folder path, if any, to its item ID
+	folderID, err := c.resolveFolderID(ctx, token)
+	if err != nil {
+		return err
+	}
+
 	// Build initial delta URL
-	deltaURL := c.buildDeltaURL()
+	deltaURL := c.buildDeltaURL("", folderID)
 
 	// Process all pages
-	newDeltaLink, err := c.processDeltaPages(ctx, token, deltaURL, docsChan, nil)
+	newDeltaLink, err := c.processDeltaPages(ctx, token, "", deltaURL, docsChan, nil)
 	if err != nil {
 		return err
 	}
-
 	cursor.SetDeltaLink(newDeltaLink)
+
+	if err := c.syncSharedRoots(ctx, token, cursor, docsChan, nil); err != nil {
+		return err
+	}
 	return &driven.SyncComplete{NewCursor: cursor.Encode()}
 }
 
@@ -183,27 +213,215 @@ func (c *Connector) runIncrementalSync(
 	}
 
 	// Use the stored delta link
-	newDeltaLink, err := c.processDeltaPages(ctx, token, cursor.GetDeltaLink(), nil, changesChan)
+	newDeltaLink, err := c.processDeltaPages(ctx, token, "", cursor.GetDeltaLink(), nil, changesChan)
 	if err != nil {
 		if microsoft.IsDeltaTokenExpired(http.StatusGone) {
 			return fmt.Errorf("%w: full sync required", microsoft.ErrDeltaTokenExpired)
 		}
 		return err
 	}
-
 	cursor.SetDeltaLink(newDeltaLink)
+
+	if err := c.syncSharedRoots(ctx, token, cursor, nil, changesChan); err != nil {
+		return err
+	}
 	return &driven.SyncComplete{NewCursor: cursor.Encode()}
 }
 
-// buildDeltaURL builds the initial delta query URL.
-func (c *Connector) buildDeltaURL() string {
-	// If folder IDs specified, use first folder; otherwise use root
-	if len(c.config.FolderIDs) > 0 {
-		return fmt.Sprintf("%s/me/drive/items/%s/delta?$top=%d",
-			graphBaseURL, c.config.FolderIDs[0], c.config.MaxResults)
+// sharedRoot is a folder shared with the user, synced with delta queries
+// on its owner's drive.
+type sharedRoot struct {
+	driveID string
+	itemID  string
+	name    string
+}
+
+// key identifies the root's delta link in the cursor. A drive can share
+// several folders, so the item ID is part of the key.
+func (r sharedRoot) key() string {
+	return r.driveID + "/" + r.itemID
+}
+
+// syncSharedRoots syncs the folders shared with the user when configured,
+// continuing each from its delta link in cursor. The cursor keeps only the
+// folders still shared. A folder that can't be synced is skipped with a
+// warning so it doesn't block the others; an expired delta link restarts
+// that folder on the next sync.
+func (c *Connector) syncSharedRoots(
+	ctx context.Context,
+	token string,
+	cursor *Cursor,
+	docsChan chan<- domain.RawDocument,
+	changesChan chan<- domain.RawDocumentChange,
+) error {
+	if !c.config.IncludeSharedWithMe {
+		cursor.SharedDeltaLinks = nil
+		return nil
+	}
+
+	roots, err := c.listSharedRoots(ctx, token)
+	if err != nil {
+		return err
 	}
-	return fmt.Sprintf("%s/me/drive/root/delta?$top=%d",
-		graphBaseURL, c.config.MaxResults)
+
+	previous := cursor.SharedDeltaLinks
+	cursor.SharedDeltaLinks = nil
+	for _, root := range roots {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		deltaURL := previous[root.key()]
+		if deltaURL == "" {
+			deltaURL = c.buildDeltaURL(root.driveID, root.itemID)
+		}
+
+		link, err := c.processDeltaPages(ctx, token, root.driveID, deltaURL, docsChan, changesChan)
+		switch {
+		case errors.Is(err, microsoft.ErrDeltaTokenExpired):
+			slog.Warn("shared folder delta link expired, restarting it next sync",
+				slog.String("source_id", c.sourceID), slog.String("folder", root.name))
+		case err != nil:
+			slog.Warn("failed to sync shared folder",
+				slog.String("source_id", c.sourceID), slog.String("folder", root.name), slog.Any("error", err))
+			if prev := previous[root.key()]; prev != "" {
+				cursor.SetSharedDeltaLink(root.key(), prev)
+			}
+		case link != "":
+			cursor.SetSharedDeltaLink(root.key(), link)
+		}
+	}
+	return nil
+}
+
+// listSharedRoots lists the folders shared with the user from
+// /me/drive/sharedWithMe. Individually shared files are skipped, as delta
+// queries only cover folders.
+func (c *Connector) listSharedRoots(ctx context.Context, token string) ([]sharedRoot, error) {
+	var roots []sharedRoot
+	pageURL := c.baseURL + "/me/drive/sharedWithMe"
+	for pageURL != "" {
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := c.doRequest(ctx, http.MethodGet, pageURL, token)
+		if err != nil {
+			return nil, fmt.Errorf("shared with me request: %w", err)
+		}
+
+		var page struct {
+			Value []struct {
+				RemoteItem *struct {
+					ID              string           `json:"id"`
+					Name            string           `json:"name"`
+					Folder          *FolderInfo      `json:"folder"`
+					ParentReference *ParentReference `json:"parentReference"`
+				} `json:"remoteItem"`
+			} `json:"value"`
+			NextLink string `json:"@odata.nextLink"`
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("shared with me request failed: status %d: %w",
+				resp.StatusCode, microsoft.WrapError(resp.StatusCode))
+		}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("decode shared with me response: %w", err)
+		}
+
+		for _, item := range page.Value {
+			remote := item.RemoteItem
+			if remote == nil || remote.Folder == nil || remote.ParentReference == nil ||
+				remote.ParentReference.DriveID == "" {
+				continue
+			}
+			roots = append(roots, sharedRoot{
+				driveID: remote.ParentReference.DriveID,
+				itemID:  remote.ID,
+				name:    remote.Name,
+			})
+		}
+		pageURL = page.NextLink
+	}
+	return roots, nil
+}
+
+// resolveFolderID returns the drive item ID of the folder to sync.
+// A configured folder_path is resolved via /me/drive/root:/path; otherwise the
+// first configured folder ID is used. Returns "" to sync from root.
+func (c *Connector) resolveFolderID(ctx context.Context, token string) (string, error) {
+	path := strings.Trim(c.config.FolderPath, "/")
+	if path == "" {
+		if len(c.config.FolderIDs) > 0 {
+			return c.config.FolderIDs[0], nil
+		}
+		return "", nil
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	itemURL := fmt.Sprintf("%s/me/drive/root:/%s", c.baseURL, strings.Join(segments, "/"))
+
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return "", err
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodGet, itemURL, token)
+	if err != nil {
+		return "", fmt.Errorf("resolve folder_path: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("onedrive folder_path %q not found: %w", c.config.FolderPath, domain.ErrNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("resolve folder_path failed: status %d: %w",
+			resp.StatusCode, microsoft.WrapError(resp.StatusCode))
+	}
+
+	var item struct {
+		ID     string    `json:"id"`
+		Folder *struct{} `json:"folder"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&item); err != nil {
+		return "", fmt.Errorf("decode folder_path response: %w", err)
+	}
+	if item.Folder == nil {
+		return "", fmt.Errorf("onedrive folder_path %q is not a folder: %w", c.config.FolderPath, domain.ErrInvalidInput)
+	}
+
+	return item.ID, nil
+}
+
+// buildDeltaURL builds the initial delta query URL for a folder.
+// An empty driveID queries the user's own drive, and an empty folderID
+// queries from the drive root.
+func (c *Connector) buildDeltaURL(driveID, folderID string) string {
+	if folderID != "" {
+		return fmt.Sprintf("%s/delta?$top=%d", c.itemURL(driveID, folderID), c.config.MaxResults)
+	}
+	return fmt.Sprintf("%s/root/delta?$top=%d", c.driveURL(driveID), c.config.MaxResults)
+}
+
+// driveURL returns the Graph URL of a drive, or of the user's own drive
+// when driveID is empty.
+func (c *Connector) driveURL(driveID string) string {
+	if driveID == "" {
+		return c.baseURL + "/me/drive"
+	}
+	return fmt.Sprintf("%s/drives/%s", c.baseURL, url.PathEscape(driveID))
+}
+
+// itemURL returns the Graph URL of an item in a drive, or in the user's own
+// drive when driveID is empty.
+func (c *Connector) itemURL(driveID, itemID string) string {
+	return fmt.Sprintf("%s/items/%s", c.driveURL(driveID), itemID)
 }
 
 // deltaPageResult holds the result of fetching a single delta page.
@@ -213,10 +431,12 @@ type deltaPageResult struct {
 	deltaLink string
 }
 
-// processDeltaPages processes all pages of a delta query.
+// processDeltaPages processes all pages of a delta query on the drive with
+// driveID, or the user's own drive when it is empty.
 func (c *Connector) processDeltaPages(
 	ctx context.Context,
 	token string,
+	driveID string,
 	initialURL string,
 	docsChan chan<- domain.RawDocument,
 	changesChan chan<- domain.RawDocumentChange,
@@ -234,7 +454,7 @@ func (c *Connector) processDeltaPages(
 			return "", err
 		}
 
-		if err := c.processItems(ctx, token, pageResult.items, docsChan, changesChan); err != nil {
+		if err := c.processItems(ctx, token, driveID, pageResult.items, docsChan, changesChan); err != nil {
 			return "", err
 		}
 
@@ -255,7 +475,9 @@ func (c *Connector) fetchDeltaPage(
 		return nil, err
 	}
 
-	resp, err := c.doRequest(ctx, http.MethodGet, url, token)
+	pageCtx, span := tracing.StartPage(ctx, "onedrive", "items_delta")
+	resp, err := c.doRequest(pageCtx, http.MethodGet, url, token)
+	tracing.EndPage(span, err)
 	if err != nil {
 		return nil, fmt.Errorf("delta request: %w", err)
 	}
@@ -294,6 +516,7 @@ func (c *Connector) fetchDeltaPage(
 func (c *Connector) processItems(
 	ctx context.Context,
 	token string,
+	driveID string,
 	items []json.RawMessage,
 	docsChan chan<- domain.RawDocument,
 	changesChan chan<- domain.RawDocumentChange,
@@ -304,7 +527,7 @@ func (c *Connector) processItems(
 			continue
 		}
 
-		if err := c.processSingleItem(ctx, token, &itemWithRemoved, docsChan, changesChan); err != nil {
+		if err := c.processSingleItem(ctx, token, driveID, &itemWithRemoved, docsChan, changesChan); err != nil {
 			return err
 		}
 	}
@@ -315,6 +538,7 @@ func (c *Connector) processItems(
 func (c *Connector) processSingleItem(
 	ctx context.Context,
 	token string,
+	driveID string,
 	itemWithRemoved *DriveItemWithRemoved,
 	docsChan chan<- domain.RawDocument,
 	changesChan chan<- domain.RawDocumentChange,
@@ -329,9 +553,10 @@ func (c *Connector) processSingleItem(
 
 	// Fetch file content if it's a type we can normalise and small enough
 	var content []byte
-	if shouldDownloadContent(itemWithRemoved.GetMIMEType()) && itemWithRemoved.Size <= MaxContentSize {
+	if shouldDownloadContent(itemWithRemoved.GetMIMEType()) &&
+		c.config.ContentLimits.AllowsDownload(itemWithRemoved.Size) {
 		var err error
-		content, err = c.downloadFileContent(ctx, token, itemWithRemoved.ID)
+		content, err = c.downloadFileContent(ctx, token, driveID, itemWithRemoved.ID)
 		if err != nil {
 			// Continue without content on error
 			content = nil
@@ -386,11 +611,10 @@ func (c *Connector) emitDocument(
 	return nil
 }
 
-// downloadFileContent downloads the content of a file.
-func (c *Connector) downloadFileContent(ctx context.Context, token, itemID string) ([]byte, error) {
-	url := fmt.Sprintf("%s/me/drive/items/%s/content", graphBaseURL, itemID)
-
-	resp, err := c.doRequest(ctx, http.MethodGet, url, token)
+// downloadFileContent downloads the content of a file in the drive with
+// driveID, or the user's own drive when it is empty.
+func (c *Connector) downloadFileContent(ctx context.Context, token, driveID, itemID string) ([]byte, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, c.itemURL(driveID, itemID)+"/content", token)
 	if err != nil {
 		return nil, fmt.Errorf("download request: %w", err)
 	}
@@ -401,7 +625,7 @@ func (c *Connector) downloadFileContent(ctx context.Context, token, itemID strin
 	}
 
 	// Read with size limit
-	limitedReader := io.LimitReader(resp.Body, MaxContentSize)
+	limitedReader := io.LimitReader(resp.Body, c.config.ContentLimits.MaxSize)
 	data, err := io.ReadAll(limitedReader)
 	if err != nil {
 		return nil, fmt.Errorf("read content: %w", err)
@@ -414,16 +638,12 @@ func (c *Connector) downloadFileContent(ctx context.Context, token, itemID strin
 func (c *Connector) doRequest(
 	ctx context.Context, method, url, token string,
 ) (*http.Response, error) {
-	req, err := http.NewRequestWithContext(ctx, method, url, http.NoBody)
+	req, err := microsoft.NewRequest(ctx, method, url, token, c.config.Locale)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Accept", "application/json")
-
-	client := &http.Client{Timeout: 60 * time.Second}
-	return client.Do(req)
+	return microsoft.Do(req, c.apiBudget)
 }
 
 // sendDocument sends a document to the channel or returns on context cancellation.
@@ -2,12 +2,17 @@ package onedrive
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
 // mockTokenProvider implements driven.TokenProvider for testing.
@@ -34,6 +39,10 @@ func (m *mockTokenProvider) IsAuthenticated() bool {
 	return m.isAuthed
 }
 
+func (m *mockTokenProvider) RefreshIfNeeded(_ context.Context) error {
+	return nil
+}
+
 func TestNew(t *testing.T) {
 	tp := &mockTokenProvider{token: "test-token", isAuthed: true}
 	cfg := DefaultConfig()
@@ -170,21 +179,21 @@ func TestConnector_IncrementalSync_InvalidCursor(t *testing.T) {
 
 func TestConnector_buildDeltaURL(t *testing.T) {
 	tests := []struct {
-		name      string
-		folderIDs []string
-		contains  []string
+		name     string
+		folderID string
+		contains []string
 	}{
 		{
-			name:      "default (root)",
-			folderIDs: nil,
+			name:     "default (root)",
+			folderID: "",
 			contains: []string{
 				"/me/drive/root/delta",
 				"$top=100",
 			},
 		},
 		{
-			name:      "specific folder",
-			folderIDs: []string{"folder-abc-123"},
+			name:     "specific folder",
+			folderID: "folder-abc-123",
 			contains: []string{
 				"/me/drive/items/folder-abc-123/delta",
 				"$top=100",
@@ -194,11 +203,9 @@ func TestConnector_buildDeltaURL(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			cfg := DefaultConfig()
-			cfg.FolderIDs = tt.folderIDs
-			conn := New("source-123", cfg, nil)
+			conn := New("source-123", DefaultConfig(), nil)
 
-			url := conn.buildDeltaURL()
+			url := conn.buildDeltaURL("", tt.folderID)
 
 			for _, s := range tt.contains {
 				assert.Contains(t, url, s)
@@ -212,7 +219,304 @@ func TestConnector_buildDeltaURL_CustomMaxResults(t *testing.T) {
 	cfg.MaxResults = 50
 	conn := New("source-123", cfg, nil)
 
-	url := conn.buildDeltaURL()
+	url := conn.buildDeltaURL("", "")
 
 	assert.Contains(t, url, "$top=50")
 }
+
+func TestConnector_FullSync_ResolvesFolderPath(t *testing.T) {
+	var resolvedPath, deltaPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/me/drive/root:"):
+			resolvedPath = r.URL.Path
+			_, _ = w.Write([]byte(`{"id":"folder-xyz","folder":{"childCount":2}}`))
+		case strings.HasSuffix(r.URL.Path, "/delta"):
+			deltaPath = r.URL.Path
+			_, _ = w.Write([]byte(`{"value":[],"@odata.deltaLink":"https://example.com/delta?token=abc"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.FolderPath = "/Documents/Work Notes/"
+	conn := New("source-123", cfg, &mockTokenProvider{token: "token"})
+	conn.baseURL = server.URL
+
+	docs, errs := conn.FullSync(context.Background())
+	for range docs {
+	}
+	err := <-errs
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	assert.Equal(t, "/me/drive/root:/Documents/Work Notes", resolvedPath)
+	assert.Equal(t, "/me/drive/items/folder-xyz/delta", deltaPath)
+}
+
+func TestConnector_FullSync_FolderPathNotFound(t *testing.T) {
+	deltaCalled := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/delta") {
+			deltaCalled = true
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.FolderPath = "Missing"
+	conn := New("source-123", cfg, &mockTokenProvider{token: "token"})
+	conn.baseURL = server.URL
+
+	docs, errs := conn.FullSync(context.Background())
+	for range docs {
+	}
+	err := <-errs
+
+	require.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Contains(t, err.Error(), `folder_path "Missing" not found`)
+	assert.False(t, deltaCalled)
+}
+
+func TestConnector_resolveFolderID_NotAFolder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"file-1","file":{}}`))
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.FolderPath = "notes.txt"
+	conn := New("source-123", cfg, nil)
+	conn.baseURL = server.URL
+
+	_, err := conn.resolveFolderID(context.Background(), "token")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestConnector_resolveFolderID_FallsBackToFolderIDs(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.FolderIDs = []string{"folder-abc-123"}
+	conn := New("source-123", cfg, nil)
+
+	id, err := conn.resolveFolderID(context.Background(), "token")
+
+	require.NoError(t, err)
+	assert.Equal(t, "folder-abc-123", id)
+}
+
+func TestConnector_doRequest_SetsAcceptLanguage(t *testing.T) {
+	var gotLanguage string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotLanguage = r.Header.Get("Accept-Language")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.Locale = "en-GB"
+	conn := New("source-123", cfg, nil)
+
+	resp, err := conn.doRequest(context.Background(), http.MethodGet, server.URL, "token")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, "en-GB", gotLanguage)
+}
+
+func TestConnector_buildDeltaURL_RemoteDrive(t *testing.T) {
+	conn := New("source-123", DefaultConfig(), nil)
+
+	assert.Equal(t, graphBaseURL+"/drives/drive-b/items/folder-1/delta?$top=100",
+		conn.buildDeltaURL("drive-b", "folder-1"))
+	assert.Equal(t, graphBaseURL+"/drives/drive-b/root/delta?$top=100", conn.buildDeltaURL("drive-b", ""))
+}
+
+// sharedDriveServer serves the user's own drive, which is empty, and one
+// folder shared from another drive holding one file.
+func sharedDriveServer(t *testing.T, requests *[]string) *httptest.Server {
+	t.Helper()
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.URL.RequestURI())
+		switch r.URL.Path {
+		case "/me/drive/root/delta":
+			_, _ = w.Write([]byte(`{"value":[],"@odata.deltaLink":"` + server.URL + `/me/drive/root/delta?token=own"}`))
+		case "/me/drive/sharedWithMe":
+			_, _ = w.Write([]byte(`{"value":[
+				{"id":"local-1","remoteItem":{"id":"shared-folder","name":"Team","folder":{"childCount":1},
+					"parentReference":{"driveId":"drive-b"}}},
+				{"id":"local-2","remoteItem":{"id":"shared-file","name":"memo.txt","file":{"mimeType":"text/plain"},
+					"parentReference":{"driveId":"drive-b"}}},
+				{"id":"local-3"}
+			]}`))
+		case "/drives/drive-b/items/shared-folder/delta":
+			_, _ = w.Write([]byte(`{"value":[
+				{"id":"remote-file","name":"notes.txt","size":5,"file":{"mimeType":"text/plain"},
+					"parentReference":{"driveId":"drive-b","id":"shared-folder"}}
+			],"@odata.deltaLink":"` + server.URL + `/drives/drive-b/items/shared-folder/delta?token=next"}`))
+		case "/drives/drive-b/items/remote-file/content":
+			_, _ = w.Write([]byte("hello"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return server
+}
+
+func TestConnector_FullSync_SharedWithMe(t *testing.T) {
+	var requests []string
+	server := sharedDriveServer(t, &requests)
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.IncludeSharedWithMe = true
+	conn := New("source-123", cfg, &mockTokenProvider{token: "token"})
+	conn.baseURL = server.URL
+
+	docs, errs := conn.FullSync(context.Background())
+	var got []domain.RawDocument
+	for doc := range docs {
+		got = append(got, doc)
+	}
+	err := <-errs
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	require.Len(t, got, 1)
+	assert.Equal(t, "onedrive://files/remote-file", got[0].URI)
+	assert.Equal(t, []byte("hello"), got[0].Content)
+	assert.NotContains(t, requests, "/drives/drive-b/items/shared-file/delta?$top=100")
+
+	cursor, err := DecodeCursor(complete.NewCursor)
+	require.NoError(t, err)
+	assert.Equal(t, server.URL+"/me/drive/root/delta?token=own", cursor.GetDeltaLink())
+	assert.Equal(t, server.URL+"/drives/drive-b/items/shared-folder/delta?token=next",
+		cursor.GetSharedDeltaLink("drive-b/shared-folder"))
+}
+
+func TestConnector_IncrementalSync_SharedWithMeUsesStoredLinks(t *testing.T) {
+	var requests []string
+	server := sharedDriveServer(t, &requests)
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.IncludeSharedWithMe = true
+	conn := New("source-123", cfg, &mockTokenProvider{token: "token"})
+	conn.baseURL = server.URL
+
+	cursor := NewCursor()
+	cursor.SetDeltaLink(server.URL + "/me/drive/root/delta?token=own")
+	cursor.SetSharedDeltaLink("drive-b/shared-folder", server.URL+"/drives/drive-b/items/shared-folder/delta?token=s1")
+	cursor.SetSharedDeltaLink("drive-x/unshared", server.URL+"/drives/drive-x/items/unshared/delta?token=old")
+
+	changes, errs := conn.IncrementalSync(context.Background(), domain.SyncState{Cursor: cursor.Encode()})
+	var got []domain.RawDocumentChange
+	for change := range changes {
+		got = append(got, change)
+	}
+	err := <-errs
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	require.Len(t, got, 1)
+	assert.Equal(t, "onedrive://files/remote-file", got[0].Document.URI)
+	assert.Contains(t, requests, "/drives/drive-b/items/shared-folder/delta?token=s1")
+	assert.NotContains(t, requests, "/drives/drive-x/items/unshared/delta?token=old")
+
+	next, err := DecodeCursor(complete.NewCursor)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"drive-b/shared-folder": server.URL + "/drives/drive-b/items/shared-folder/delta?token=next",
+	}, next.SharedDeltaLinks)
+}
+
+func TestConnector_FullSync_SharedWithMeDisabled(t *testing.T) {
+	var requests []string
+	server := sharedDriveServer(t, &requests)
+	defer server.Close()
+
+	conn := New("source-123", DefaultConfig(), &mockTokenProvider{token: "token"})
+	conn.baseURL = server.URL
+
+	docs, errs := conn.FullSync(context.Background())
+	for range docs {
+	}
+	err := <-errs
+
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, err, &complete)
+	assert.Equal(t, []string{"/me/drive/root/delta?$top=100"}, requests)
+}
+
+// contentLimitServer serves a delta page with a small and a large text file,
+// recording the IDs of downloaded files.
+func contentLimitServer(t *testing.T, downloads *[]string) *httptest.Server {
+	t.Helper()
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/delta"):
+			_, _ = w.Write([]byte(`{"value":[
+				{"id":"small","name":"small.txt","size":5,"file":{"mimeType":"text/plain"}},
+				{"id":"large","name":"large.txt","size":10485760,"file":{"mimeType":"text/plain"}}
+			],"@odata.deltaLink":"https://example.com/delta?token=abc"}`))
+		case strings.HasSuffix(r.URL.Path, "/content"):
+			mu.Lock()
+			*downloads = append(*downloads, strings.Split(r.URL.Path, "/")[4])
+			mu.Unlock()
+			_, _ = w.Write([]byte("hello"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// syncContents runs a full sync and returns each document's content by URI.
+func syncContents(t *testing.T, conn *Connector) map[string]string {
+	t.Helper()
+	docs, errs := conn.FullSync(context.Background())
+	contents := make(map[string]string)
+	for doc := range docs {
+		contents[doc.URI] = string(doc.Content)
+	}
+	var complete *driven.SyncComplete
+	require.ErrorAs(t, <-errs, &complete)
+	return contents
+}
+
+func TestConnector_FullSync_OversizedFileIsMetadataOnly(t *testing.T) {
+	var downloads []string
+	server := contentLimitServer(t, &downloads)
+
+	conn := New("source-123", DefaultConfig(), &mockTokenProvider{token: "token"})
+	conn.baseURL = server.URL
+
+	contents := syncContents(t, conn)
+
+	assert.Equal(t, map[string]string{
+		"onedrive://files/small": "hello",
+		"onedrive://files/large": "",
+	}, contents)
+	assert.Equal(t, []string{"small"}, downloads)
+}
+
+func TestConnector_FullSync_MetadataOnly(t *testing.T) {
+	var downloads []string
+	server := contentLimitServer(t, &downloads)
+
+	cfg := DefaultConfig()
+	cfg.ContentLimits.MetadataOnly = true
+	conn := New("source-123", cfg, &mockTokenProvider{token: "token"})
+	conn.baseURL = server.URL
+
+	contents := syncContents(t, conn)
+
+	assert.Len(t, contents, 2, "files are still indexed")
+	assert.Empty(t, downloads)
+}
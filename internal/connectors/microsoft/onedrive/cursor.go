@@ -12,10 +12,14 @@ const CursorVersion = 1
 // ErrInvalidCursor indicates the cursor could not be decoded.
 var ErrInvalidCursor = errors.New("invalid cursor")
 
-// Cursor stores the delta link for incremental sync.
+// Cursor stores the delta links for incremental sync: one for the user's
+// own drive and one per shared folder, which is queried on its owner's drive.
 type Cursor struct {
 	Version   int    `json:"v"`
 	DeltaLink string `json:"delta_link"`
+	// SharedDeltaLinks maps shared folders, keyed by sharedRoot.key, to
+	// their delta links.
+	SharedDeltaLinks map[string]string `json:"shared_delta_links,omitempty"`
 }
 
 // NewCursor creates a new empty cursor.
@@ -71,3 +75,17 @@ func (c *Cursor) SetDeltaLink(link string) {
 func (c *Cursor) GetDeltaLink() string {
 	return c.DeltaLink
 }
+
+// SetSharedDeltaLink updates the delta link of a shared folder.
+func (c *Cursor) SetSharedDeltaLink(key, link string) {
+	if c.SharedDeltaLinks == nil {
+		c.SharedDeltaLinks = make(map[string]string)
+	}
+	c.SharedDeltaLinks[key] = link
+}
+
+// GetSharedDeltaLink returns the delta link of a shared folder, or "" if it
+// has not been synced.
+func (c *Cursor) GetSharedDeltaLink(key string) string {
+	return c.SharedDeltaLinks[key]
+}
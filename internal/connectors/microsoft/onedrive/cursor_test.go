@@ -125,3 +125,14 @@ func TestCursor_RoundTrip(t *testing.T) {
 		original = decoded
 	}
 }
+
+func TestCursor_SharedDeltaLinks(t *testing.T) {
+	cursor := NewCursor()
+	assert.Empty(t, cursor.GetSharedDeltaLink("drive-b/folder-1"))
+
+	cursor.SetSharedDeltaLink("drive-b/folder-1", "https://test.com/shared-delta")
+
+	decoded, err := DecodeCursor(cursor.Encode())
+	require.NoError(t, err)
+	assert.Equal(t, "https://test.com/shared-delta", decoded.GetSharedDeltaLink("drive-b/folder-1"))
+}
@@ -170,6 +170,26 @@ func IsItemRemoved(item *DriveItemWithRemoved) bool {
 	return item.Deleted != nil
 }
 
+// EmittedMIMETypes returns the MIME types OneDrive documents carry content for.
+// Files keep the type reported by Graph; only text-like or normalisable types
+// are downloaded.
+func EmittedMIMETypes() []string {
+	return append([]string{"text/plain"}, downloadMIMETypes...)
+}
+
+// downloadMIMETypes lists non-text/* types whose content is downloaded for normalisation.
+var downloadMIMETypes = []string{
+	// Text-based formats
+	"application/json",
+	"application/xml",
+	"application/javascript",
+	"application/x-yaml",
+	"application/x-sh",
+	"application/sql",
+	// Binary formats with normalisers
+	"application/pdf",
+}
+
 // shouldDownloadContent checks if a MIME type requires content download.
 // This includes text files and binary formats that have normalisers (e.g., PDF).
 func shouldDownloadContent(mimeType string) bool {
@@ -177,20 +197,7 @@ func shouldDownloadContent(mimeType string) bool {
 		return true
 	}
 
-	// Types that need content downloaded for normalisation
-	downloadTypes := []string{
-		// Text-based formats
-		"application/json",
-		"application/xml",
-		"application/javascript",
-		"application/x-yaml",
-		"application/x-sh",
-		"application/sql",
-		// Binary formats with normalisers
-		"application/pdf",
-	}
-
-	for _, t := range downloadTypes {
+	for _, t := range downloadMIMETypes {
 		if mimeType == t {
 			return true
 		}
@@ -31,6 +31,9 @@ type Config struct {
 	MaxResults int64
 	// IncludeSpamTrash includes spam and deleted items if true.
 	IncludeSpamTrash bool
+	// Locale is sent as Accept-Language on Graph requests (optional).
+	// If empty, Graph uses the account's default language.
+	Locale string
 }
 
 // DefaultConfig returns the default configuration.
@@ -66,5 +69,10 @@ func ParseConfig(source domain.Source) (*Config, error) {
 		cfg.IncludeSpamTrash = true
 	}
 
+	// Parse locale
+	if val := source.Config["locale"]; val != "" {
+		cfg.Locale = strings.TrimSpace(val)
+	}
+
 	return cfg, nil
 }
@@ -193,3 +193,16 @@ func TestParseConfig_AllOptions(t *testing.T) {
 	assert.Equal(t, int64(500), cfg.MaxResults)
 	assert.True(t, cfg.IncludeSpamTrash)
 }
+
+func TestParseConfig_WithLocale(t *testing.T) {
+	source := domain.Source{
+		Config: map[string]string{
+			"locale": " en-GB ",
+		},
+	}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, "en-GB", cfg.Locale)
+}
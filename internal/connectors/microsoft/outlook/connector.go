@@ -5,17 +5,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"sync"
-	"time"
 
 	"github.com/custodia-labs/sercha-cli/internal/connectors/microsoft"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
-// Ensure Connector implements the interface.
-var _ driven.Connector = (*Connector)(nil)
+// Ensure Connector implements the interfaces.
+var (
+	_ driven.Connector      = (*Connector)(nil)
+	_ driven.APIBudgetAware = (*Connector)(nil)
+)
 
 const graphBaseURL = "https://graph.microsoft.com/v1.0"
 
@@ -25,6 +29,7 @@ type Connector struct {
 	config        *Config
 	tokenProvider driven.TokenProvider
 	rateLimiter   *microsoft.RateLimiter
+	apiBudget     driven.APIBudget
 	mu            sync.Mutex
 	closed        bool
 }
@@ -39,6 +44,19 @@ func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Conn
 	}
 }
 
+// SetAPIBudget charges the connector's API requests to a shared budget.
+func (c *Connector) SetAPIBudget(apiBudget driven.APIBudget) {
+	c.apiBudget = apiBudget
+}
+
+// SetLocale sends locale as Accept-Language on Graph requests, unless the
+// source configures its own locale.
+func (c *Connector) SetLocale(locale string) {
+	if c.config.Locale == "" {
+		c.config.Locale = locale
+	}
+}
+
 // Type returns the connector type identifier.
 func (c *Connector) Type() string {
 	return "outlook"
@@ -122,6 +140,7 @@ func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawD
 	if err := c.checkClosed(); err != nil {
 		return err
 	}
+	slog.Debug("full sync started", slog.String("connector", c.Type()), slog.String("source_id", c.sourceID))
 
 	token, err := c.tokenProvider.GetToken(ctx)
 	if err != nil {
@@ -260,7 +279,9 @@ func (c *Connector) fetchDeltaPage(
 		return nil, err
 	}
 
-	resp, err := c.doRequest(ctx, http.MethodGet, url, token)
+	pageCtx, span := tracing.StartPage(ctx, "outlook", "messages_delta")
+	resp, err := c.doRequest(pageCtx, http.MethodGet, url, token)
+	tracing.EndPage(span, err)
 	if err != nil {
 		return nil, fmt.Errorf("delta request: %w", err)
 	}
@@ -382,17 +403,14 @@ func (c *Connector) emitDocument(
 func (c *Connector) doRequest(
 	ctx context.Context, method, url, token string,
 ) (*http.Response, error) {
-	req, err := http.NewRequestWithContext(ctx, method, url, http.NoBody)
+	req, err := microsoft.NewRequest(ctx, method, url, token, c.config.Locale)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Prefer", "outlook.body-content-type=\"text\"")
 
-	client := &http.Client{Timeout: 60 * time.Second}
-	return client.Do(req)
+	return microsoft.Do(req, c.apiBudget)
 }
 
 // sendDocument sends a document to the channel or returns on context cancellation.
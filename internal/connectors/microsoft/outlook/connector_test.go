@@ -2,6 +2,8 @@ package outlook
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -34,6 +36,10 @@ func (m *mockTokenProvider) IsAuthenticated() bool {
 	return m.isAuthed
 }
 
+func (m *mockTokenProvider) RefreshIfNeeded(_ context.Context) error {
+	return nil
+}
+
 func TestNew(t *testing.T) {
 	tp := &mockTokenProvider{token: "test-token", isAuthed: true}
 	cfg := DefaultConfig()
@@ -213,3 +219,50 @@ func TestConnector_buildDeltaURL(t *testing.T) {
 		})
 	}
 }
+
+func TestConnector_doRequest_SetsAcceptLanguage(t *testing.T) {
+	var gotLanguage string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotLanguage = r.Header.Get("Accept-Language")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.Locale = "en-GB"
+	conn := New("source-123", cfg, nil)
+
+	resp, err := conn.doRequest(context.Background(), http.MethodGet, server.URL, "token")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, "en-GB", gotLanguage)
+}
+
+func TestConnector_SetLocale(t *testing.T) {
+	var gotLanguage string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotLanguage = r.Header.Get("Accept-Language")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	get := func(conn *Connector) string {
+		t.Helper()
+		resp, err := conn.doRequest(context.Background(), http.MethodGet, server.URL, "token")
+		require.NoError(t, err)
+		resp.Body.Close()
+		return gotLanguage
+	}
+
+	conn := New("source-123", DefaultConfig(), nil)
+	conn.SetLocale("de-DE")
+	assert.Equal(t, "de-DE", get(conn))
+
+	// The source's own locale takes precedence
+	cfg := DefaultConfig()
+	cfg.Locale = "en-GB"
+	conn = New("source-123", cfg, nil)
+	conn.SetLocale("de-DE")
+	assert.Equal(t, "en-GB", get(conn))
+}
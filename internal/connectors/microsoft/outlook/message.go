@@ -8,6 +8,14 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
 
+// mimeTypeMessage is the MIME type of RFC 822 messages emitted by this connector.
+const mimeTypeMessage = "message/rfc822"
+
+// EmittedMIMETypes returns the MIME types the Outlook connector emits.
+func EmittedMIMETypes() []string {
+	return []string{mimeTypeMessage}
+}
+
 // Message represents an Outlook message from Microsoft Graph API.
 type Message struct {
 	ID                 string        `json:"id"`
@@ -114,7 +122,7 @@ func MessageToRawDocument(msg *Message, sourceID string) *domain.RawDocument {
 	return &domain.RawDocument{
 		SourceID:  sourceID,
 		URI:       fmt.Sprintf("outlook://messages/%s", msg.ID),
-		MIMEType:  "message/rfc822",
+		MIMEType:  mimeTypeMessage,
 		Content:   []byte(content),
 		ParentURI: parentURI,
 		Metadata:  metadata,
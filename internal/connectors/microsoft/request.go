@@ -0,0 +1,43 @@
+package microsoft
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/connectors/budget"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// requestTimeout is the HTTP timeout for Microsoft Graph data requests.
+const requestTimeout = 60 * time.Second
+
+// NewRequest builds an authenticated Microsoft Graph request.
+// When locale is set (e.g., "en-GB") it is sent as Accept-Language so
+// Graph returns localised content (folder names, display strings) in a
+// consistent language regardless of the account's mailbox settings.
+func NewRequest(ctx context.Context, method, url, token, locale string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+	if locale = strings.TrimSpace(locale); locale != "" {
+		req.Header.Set("Accept-Language", locale)
+	}
+
+	return req, nil
+}
+
+// Do sends a Graph request over the pooled connector transport with the
+// shared timeout, charging it to apiBudget if not nil.
+func Do(req *http.Request, apiBudget driven.APIBudget) (*http.Response, error) {
+	if apiBudget == nil {
+		return sharedClient().Do(req)
+	}
+	client := &http.Client{Timeout: requestTimeout, Transport: budget.Transport(sharedTransport(), apiBudget)}
+	return client.Do(req)
+}
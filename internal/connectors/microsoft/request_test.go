@@ -0,0 +1,47 @@
+package microsoft
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRequest_SetsAuthHeaders(t *testing.T) {
+	req, err := NewRequest(context.Background(), http.MethodGet, graphBaseURL+"/me", "token-123", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-123", req.Header.Get("Authorization"))
+	assert.Equal(t, "application/json", req.Header.Get("Accept"))
+	assert.Empty(t, req.Header.Get("Accept-Language"))
+}
+
+func TestNewRequest_SetsAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		locale   string
+		expected string
+	}{
+		{name: "simple locale", locale: "en-GB", expected: "en-GB"},
+		{name: "weighted list", locale: "fr-FR, en;q=0.8", expected: "fr-FR, en;q=0.8"},
+		{name: "trimmed", locale: "  de-DE  ", expected: "de-DE"},
+		{name: "whitespace only", locale: "   ", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := NewRequest(context.Background(), http.MethodGet, graphBaseURL+"/me", "token", tt.locale)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, req.Header.Get("Accept-Language"))
+		})
+	}
+}
+
+func TestNewRequest_InvalidURL(t *testing.T) {
+	_, err := NewRequest(context.Background(), http.MethodGet, "://bad", "token", "en-GB")
+
+	assert.Error(t, err)
+}
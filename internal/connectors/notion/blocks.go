@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
 	"github.com/jomei/notionapi"
 )
 
@@ -40,7 +41,9 @@ func (e *BlockExtractor) extractContentRecursive(
 	var cursor notionapi.Cursor
 
 	for {
-		resp, err := e.client.GetBlockChildren(ctx, blockID, cursor, e.pageSize)
+		pageCtx, span := tracing.StartPage(ctx, "notion", "blocks")
+		resp, err := e.client.GetBlockChildren(pageCtx, blockID, cursor, e.pageSize)
+		tracing.EndPage(span, err)
 		if err != nil {
 			// If we can't get blocks, return what we have
 			return content.String(), nil
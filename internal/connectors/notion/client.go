@@ -11,6 +11,7 @@ import (
 
 	"github.com/jomei/notionapi"
 
+	"github.com/custodia-labs/sercha-cli/internal/connectors/budget"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
@@ -20,6 +21,11 @@ type Client struct {
 	sourceID      string
 	tokenProvider driven.TokenProvider
 	rateLimiter   *RateLimiter
+	apiBudget     driven.APIBudget
+
+	// transport is the base transport for API requests, nil for the
+	// default. Swappable for tests.
+	transport http.RoundTripper
 }
 
 // NewClient creates a new Notion API client.
@@ -38,7 +44,8 @@ func (c *Client) init(ctx context.Context) error {
 		return fmt.Errorf("get access token: %w", err)
 	}
 
-	c.client = notionapi.NewClient(notionapi.Token(token))
+	httpClient := &http.Client{Transport: budget.Transport(c.transport, c.apiBudget)}
+	c.client = notionapi.NewClient(notionapi.Token(token), notionapi.WithHTTPClient(httpClient))
 	return nil
 }
 
@@ -121,7 +128,7 @@ func (c *Client) Search(
 	httpReq.Header.Set("Content-Type", "application/json")
 	httpReq.Header.Set("Notion-Version", notionAPIVersion)
 
-	client := &http.Client{Timeout: 30 * time.Second}
+	client := &http.Client{Timeout: 30 * time.Second, Transport: budget.Transport(c.transport, c.apiBudget)}
 	resp, err := client.Do(httpReq)
 	if err != nil {
 		return nil, fmt.Errorf("search request: %w", err)
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
 	"github.com/jomei/notionapi"
 )
 
@@ -28,7 +29,9 @@ func (f *CommentFetcher) FetchComments(ctx context.Context, blockID notionapi.Bl
 	var cursor notionapi.Cursor
 
 	for {
-		resp, err := f.client.GetComments(ctx, blockID, cursor, f.pageSize)
+		pageCtx, span := tracing.StartPage(ctx, "notion", "comments")
+		resp, err := f.client.GetComments(pageCtx, blockID, cursor, f.pageSize)
+		tracing.EndPage(span, err)
 		if err != nil {
 			// If we can't get comments, return empty (not critical)
 			return comments, nil
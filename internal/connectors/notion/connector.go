@@ -3,17 +3,23 @@ package notion
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"sync"
 	"time"
 
 	"github.com/jomei/notionapi"
 
+	"github.com/custodia-labs/sercha-cli/internal/connectors/locale"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
-// Ensure Connector implements the interface.
-var _ driven.Connector = (*Connector)(nil)
+// Ensure Connector implements the interfaces.
+var (
+	_ driven.Connector      = (*Connector)(nil)
+	_ driven.APIBudgetAware = (*Connector)(nil)
+)
 
 // Connector fetches pages and databases from Notion.
 type Connector struct {
@@ -35,6 +41,16 @@ func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Conn
 	}
 }
 
+// SetAPIBudget charges the connector's API requests to a shared budget.
+func (c *Connector) SetAPIBudget(apiBudget driven.APIBudget) {
+	c.client.apiBudget = apiBudget
+}
+
+// SetLocale sends locale as Accept-Language on the connector's API requests.
+func (c *Connector) SetLocale(loc string) {
+	c.client.transport = locale.Transport(c.client.transport, loc)
+}
+
 // Type returns the connector type identifier.
 func (c *Connector) Type() string {
 	return "notion"
@@ -93,19 +109,24 @@ func (c *Connector) FullSync(ctx context.Context) (
 	go func() {
 		defer close(docsChan)
 		defer close(errsChan)
-		errsChan <- c.runFullSync(ctx, docsChan)
+		errsChan <- c.runFullSync(ctx, docsChan, errsChan)
 	}()
 
 	return docsChan, errsChan
 }
 
 // runFullSync executes the full sync logic.
+// A checkpoint is sent after each page of search results, so an interrupted
+// sync resumes enumeration from the page it reached.
 //
 //nolint:gocognit,gocyclo,nestif // Sync logic requires iteration and type handling
-func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawDocument) error {
+func (c *Connector) runFullSync(
+	ctx context.Context, docsChan chan<- domain.RawDocument, errsChan chan<- error,
+) error {
 	if err := c.checkClosed(); err != nil {
 		return err
 	}
+	slog.Debug("full sync started", slog.String("connector", c.Type()), slog.String("source_id", c.sourceID))
 
 	cursor := NewCursor()
 	cursor.SetLastSyncTime(time.Now())
@@ -122,7 +143,9 @@ func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawD
 			return err
 		}
 
-		resp, err := c.client.Search(ctx, "", nil, startCursor, c.config.PageSize)
+		pageCtx, span := tracing.StartPage(ctx, "notion", "search")
+		resp, err := c.client.Search(pageCtx, "", nil, startCursor, c.config.PageSize)
+		tracing.EndPage(span, err)
 		if err != nil {
 			return fmt.Errorf("search: %w", err)
 		}
@@ -162,8 +185,13 @@ func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawD
 			break
 		}
 		startCursor = resp.NextCursor
+
+		if err := c.sendCheckpoint(ctx, errsChan, cursor, startCursor); err != nil {
+			return err
+		}
 	}
 
+	cursor.SearchCursor = ""
 	return &driven.SyncComplete{NewCursor: cursor.Encode()}
 }
 
@@ -177,17 +205,22 @@ func (c *Connector) IncrementalSync(
 	go func() {
 		defer close(changesChan)
 		defer close(errsChan)
-		errsChan <- c.runIncrementalSync(ctx, state, changesChan)
+		errsChan <- c.runIncrementalSync(ctx, state, changesChan, errsChan)
 	}()
 
 	return changesChan, errsChan
 }
 
 // runIncrementalSync executes the incremental sync logic.
+// Like a full sync it checkpoints after each page of search results, and a
+// cursor from an interrupted sync resumes enumeration where it stopped.
 //
 //nolint:gocognit,gocyclo,nestif,funlen // Sync logic requires complex change detection
 func (c *Connector) runIncrementalSync(
-	ctx context.Context, state domain.SyncState, changesChan chan<- domain.RawDocumentChange,
+	ctx context.Context,
+	state domain.SyncState,
+	changesChan chan<- domain.RawDocumentChange,
+	errsChan chan<- error,
 ) error {
 	if err := c.checkClosed(); err != nil {
 		return err
@@ -204,15 +237,19 @@ func (c *Connector) runIncrementalSync(
 	// Track which IDs we see in this sync
 	seenIDs := make(map[string]bool)
 
-	// Search for all pages and databases
-	var startCursor notionapi.Cursor
+	// Search for all pages and databases, resuming an interrupted
+	// enumeration from its saved position
+	startCursor := notionapi.Cursor(cursor.SearchCursor)
+	resumed := startCursor != ""
 
 	for {
 		if err := ctx.Err(); err != nil {
 			return err
 		}
 
-		resp, err := c.client.Search(ctx, "", nil, startCursor, c.config.PageSize)
+		pageCtx, span := tracing.StartPage(ctx, "notion", "search")
+		resp, err := c.client.Search(pageCtx, "", nil, startCursor, c.config.PageSize)
+		tracing.EndPage(span, err)
 		if err != nil {
 			return fmt.Errorf("search: %w", err)
 		}
@@ -267,11 +304,18 @@ func (c *Connector) runIncrementalSync(
 			break
 		}
 		startCursor = resp.NextCursor
+
+		if err := c.sendCheckpoint(ctx, errsChan, cursor, startCursor); err != nil {
+			return err
+		}
 	}
+	cursor.SearchCursor = ""
 
-	// Detect deletions - pages in cursor but not seen
+	// Detect deletions - pages in cursor but not seen. A resumed sync did
+	// not see the pages enumerated before its checkpoint, so deletions are
+	// left to the next complete enumeration.
 	for _, id := range cursor.GetAllPageIDs() {
-		if seenIDs[id] {
+		if resumed || seenIDs[id] {
 			continue
 		}
 		// Page was deleted
@@ -350,7 +394,9 @@ func (c *Connector) processDatabase(
 			return err
 		}
 
-		resp, err := c.client.QueryDatabase(ctx, notionapi.DatabaseID(db.ID), startCursor, c.config.PageSize)
+		pageCtx, span := tracing.StartPage(ctx, "notion", "database_items")
+		resp, err := c.client.QueryDatabase(pageCtx, notionapi.DatabaseID(db.ID), startCursor, c.config.PageSize)
+		tracing.EndPage(span, err)
 		if err != nil {
 			// If we can't query, just emit the database without items
 			return nil
@@ -446,6 +492,20 @@ func (c *Connector) sendChange(
 	}
 }
 
+// sendCheckpoint reports the cursor with the search position reached, so
+// an interrupted sync resumes enumeration from the next page of results.
+func (c *Connector) sendCheckpoint(
+	ctx context.Context, errsChan chan<- error, cursor *Cursor, next notionapi.Cursor,
+) error {
+	cursor.SearchCursor = string(next)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case errsChan <- &driven.SyncCheckpoint{Cursor: cursor.Encode()}:
+		return nil
+	}
+}
+
 // checkClosed returns an error if the connector is closed.
 func (c *Connector) checkClosed() error {
 	c.mu.Lock()
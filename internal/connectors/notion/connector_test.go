@@ -0,0 +1,210 @@
+package notion
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// mockTokenProvider implements driven.TokenProvider for testing.
+type mockTokenProvider struct{}
+
+func (m *mockTokenProvider) GetToken(_ context.Context) (string, error) {
+	return "secret", nil
+}
+
+func (m *mockTokenProvider) AuthorizationID() string {
+	return "creds-1"
+}
+
+func (m *mockTokenProvider) AuthMethod() domain.AuthMethod {
+	return domain.AuthMethodOAuth
+}
+
+func (m *mockTokenProvider) IsAuthenticated() bool {
+	return true
+}
+
+func (m *mockTokenProvider) RefreshIfNeeded(_ context.Context) error {
+	return nil
+}
+
+// redirectTransport sends every request to a test server.
+type redirectTransport struct {
+	target *url.URL
+}
+
+func (t redirectTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.URL.Scheme = t.target.Scheme
+	r.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+// stubSearch serves three pages of search results with one page each.
+// The search from failCursor fails once, interrupting the sync. Block and
+// comment requests are not found, which the connector tolerates.
+type stubSearch struct {
+	mu         sync.Mutex
+	failCursor string
+	cursors    []string
+}
+
+func (s *stubSearch) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.URL.Path != "/v1/search" {
+		http.NotFound(w, r)
+		return
+	}
+
+	var req searchRequest
+	_ = json.NewDecoder(r.Body).Decode(&req)
+	start := string(req.StartCursor)
+	s.cursors = append(s.cursors, start)
+	if start != "" && start == s.failCursor {
+		s.failCursor = ""
+		http.Error(w, `{"object":"error","status":500}`, http.StatusInternalServerError)
+		return
+	}
+
+	pages := map[string][2]string{"": {"p1", "c2"}, "c2": {"p2", "c3"}, "c3": {"p3", ""}}
+	result := pages[start]
+	body := map[string]any{
+		"object": "list",
+		"results": []map[string]any{{
+			"object":           "page",
+			"id":               result[0],
+			"created_time":     "2024-01-01T00:00:00.000Z",
+			"last_edited_time": "2024-01-02T00:00:00.000Z",
+			"parent":           map[string]any{"type": "workspace", "workspace": true},
+			"properties":       map[string]any{},
+			"url":              "https://www.notion.so/" + result[0],
+		}},
+		"has_more": result[1] != "",
+	}
+	if result[1] != "" {
+		body["next_cursor"] = result[1]
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func newTestConnector(t *testing.T, stub *stubSearch) *Connector {
+	t.Helper()
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+	target, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	conn := New("source-1", DefaultConfig(), &mockTokenProvider{})
+	conn.client.transport = redirectTransport{target: target}
+	conn.client.rateLimiter.limiter = rate.NewLimiter(rate.Inf, 1)
+	return conn
+}
+
+// drainErrs collects the items and checkpoint cursors of a sync and returns its final result.
+func drainErrs[T any](items <-chan T, errs <-chan error) ([]T, []string, error) {
+	var collected []T
+	var checkpoints []string
+	var result error
+	for items != nil || errs != nil {
+		select {
+		case item, ok := <-items:
+			if !ok {
+				items = nil
+				continue
+			}
+			collected = append(collected, item)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			if cp, isCheckpoint := driven.IsSyncCheckpoint(err); isCheckpoint {
+				checkpoints = append(checkpoints, cp.Cursor)
+				continue
+			}
+			result = err
+		}
+	}
+	return collected, checkpoints, result
+}
+
+func TestConnector_FullSync_ResumesFromCheckpoint(t *testing.T) {
+	stub := &stubSearch{failCursor: "c2"}
+	conn := newTestConnector(t, stub)
+
+	docs, checkpoints, err := drainErrs(conn.FullSync(context.Background()))
+
+	// The second search page fails after the first was checkpointed
+	require.Error(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "notion://pages/p1", docs[0].URI)
+	require.Len(t, checkpoints, 1)
+	checkpoint, err := DecodeCursor(checkpoints[0])
+	require.NoError(t, err)
+	assert.Equal(t, "c2", checkpoint.SearchCursor)
+	assert.Contains(t, checkpoint.PageStates, "p1")
+
+	// The next sync resumes from the saved search position, not the start
+	stub.cursors = nil
+	changes, _, err := drainErrs(conn.IncrementalSync(context.Background(), domain.SyncState{
+		Cursor: checkpoints[0],
+	}))
+
+	complete, ok := driven.IsSyncComplete(err)
+	require.True(t, ok, "expected SyncComplete, got %v", err)
+	assert.Equal(t, []string{"c2", "c3"}, stub.cursors)
+
+	uris := make([]string, 0, len(changes))
+	for _, change := range changes {
+		assert.Equal(t, domain.ChangeCreated, change.Type,
+			"pages enumerated before the checkpoint must not be deleted: %s", change.Document.URI)
+		uris = append(uris, change.Document.URI)
+	}
+	assert.Equal(t, []string{"notion://pages/p2", "notion://pages/p3"}, uris)
+
+	final, err := DecodeCursor(complete.NewCursor)
+	require.NoError(t, err)
+	assert.Empty(t, final.SearchCursor)
+	assert.Len(t, final.PageStates, 3)
+}
+
+func TestConnector_IncrementalSync_ResumesFromCheckpoint(t *testing.T) {
+	stub := &stubSearch{}
+	conn := newTestConnector(t, stub)
+	_, _, err := drainErrs(conn.FullSync(context.Background()))
+	complete, ok := driven.IsSyncComplete(err)
+	require.True(t, ok, "expected SyncComplete, got %v", err)
+
+	// An incremental sync interrupted on its third search page
+	stub.failCursor = "c3"
+	_, checkpoints, err := drainErrs(conn.IncrementalSync(context.Background(), domain.SyncState{
+		Cursor: complete.NewCursor,
+	}))
+	require.Error(t, err)
+	require.Len(t, checkpoints, 2)
+
+	stub.cursors = nil
+	changes, _, err := drainErrs(conn.IncrementalSync(context.Background(), domain.SyncState{
+		Cursor: checkpoints[len(checkpoints)-1],
+	}))
+
+	_, ok = driven.IsSyncComplete(err)
+	require.True(t, ok, "expected SyncComplete, got %v", err)
+	assert.Equal(t, []string{"c3"}, stub.cursors)
+	assert.Empty(t, changes, "unchanged pages are not re-emitted")
+}
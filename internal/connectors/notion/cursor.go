@@ -20,6 +20,10 @@ type Cursor struct {
 	Version      int                  `json:"v"`
 	LastSyncTime time.Time            `json:"last_sync_time"`
 	PageStates   map[string]PageState `json:"page_states"`
+	// SearchCursor is the search position reached by an interrupted sync.
+	// When set, the next sync resumes enumeration from it; the pages before
+	// it were already synced.
+	SearchCursor string `json:"search_cursor,omitempty"`
 }
 
 // PageState tracks the state of a page or database for change detection.
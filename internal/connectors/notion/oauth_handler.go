@@ -7,13 +7,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"time"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
-	"github.com/custodia-labs/sercha-cli/internal/logger"
 )
 
 // OAuthHandler implements OAuth operations for Notion.
@@ -266,10 +266,10 @@ func exchangeNotionCode(
 		return nil, fmt.Errorf("marshal request body: %w", err)
 	}
 
-	logger.Debug("Notion token exchange: POST %s", tokenURL)
-	logger.Debug("Notion token exchange body: %s", string(jsonBody))
-	logger.Debug("Notion token exchange client_id: %s", clientID)
-	logger.Debug("Notion token exchange redirect_uri: %s", redirectURI)
+	slog.Debug("notion token exchange",
+		slog.String("url", tokenURL),
+		slog.String("client_id", clientID),
+		slog.String("redirect_uri", redirectURI))
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, bytes.NewReader(jsonBody))
 	if err != nil {
@@ -282,8 +282,6 @@ func exchangeNotionCode(
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Notion-Version", notionAPIVersion)
 
-	logger.Debug("Notion token exchange headers: Content-Type=application/json, Notion-Version=%s", notionAPIVersion)
-
 	client := &http.Client{Timeout: 30 * time.Second}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -291,14 +289,13 @@ func exchangeNotionCode(
 	}
 	defer resp.Body.Close()
 
-	logger.Debug("Notion token exchange response status: %d", resp.StatusCode)
+	slog.Debug("notion token exchange response", slog.Int("status", resp.StatusCode))
 
-	// Read response body for logging
+	// Read the whole body so failures can report it
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("read response body: %w", err)
 	}
-	logger.Debug("Notion token exchange response body: %s", string(body))
 
 	if resp.StatusCode != http.StatusOK {
 		var errResp struct {
@@ -321,7 +318,7 @@ func exchangeNotionCode(
 		tokenResp.Expiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
 	}
 
-	logger.Debug("Notion token exchange success: workspace=%s", tokenResp.WorkspaceName)
+	slog.Debug("notion token exchange succeeded", slog.String("workspace", tokenResp.WorkspaceName))
 
 	return &tokenResp, nil
 }
@@ -16,6 +16,11 @@ const (
 	MIMETypeNotionDBItem = "application/vnd.notion.database-item+json"
 )
 
+// EmittedMIMETypes returns the MIME types the Notion connector emits.
+func EmittedMIMETypes() []string {
+	return []string{MIMETypeNotionPage, MIMETypeNotionDB, MIMETypeNotionDBItem}
+}
+
 // PageToRawDocument converts a Notion page to a RawDocument.
 func PageToRawDocument(page *notionapi.Page, content, sourceID string, comments []string) *domain.RawDocument {
 	title := extractPageTitle(page)
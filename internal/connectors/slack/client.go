@@ -0,0 +1,352 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+const (
+	// DefaultBaseURL is the Slack Web API base URL.
+	DefaultBaseURL = "https://slack.com/api"
+
+	// DefaultTimeout is the default HTTP request timeout.
+	DefaultTimeout = 30 * time.Second
+
+	// pageSize is the number of items requested per page. Slack recommends
+	// no more than 200.
+	pageSize = "200"
+
+	// maxRetries is the number of times a rate limited request is retried
+	// after waiting out its Retry-After period.
+	maxRetries = 3
+)
+
+// Client is a minimal Slack Web API client.
+type Client struct {
+	baseURL       string
+	tokenProvider driven.TokenProvider
+	httpClient    *http.Client
+	limiter       *RateLimiter
+}
+
+// NewClient creates a new Slack API client.
+func NewClient(tokenProvider driven.TokenProvider) *Client {
+	return &Client{
+		baseURL:       DefaultBaseURL,
+		tokenProvider: tokenProvider,
+		httpClient:    &http.Client{Timeout: DefaultTimeout},
+		limiter:       NewRateLimiter(),
+	}
+}
+
+// response holds the fields common to every Slack API response.
+// Slack reports most failures with HTTP 200 and ok=false.
+type response struct {
+	OK               bool   `json:"ok"`
+	Error            string `json:"error"`
+	ResponseMetadata struct {
+		NextCursor string `json:"next_cursor"`
+	} `json:"response_metadata"`
+}
+
+// Identity is the result of auth.test.
+type Identity struct {
+	URL    string `json:"url"`
+	Team   string `json:"team"`
+	User   string `json:"user"`
+	TeamID string `json:"team_id"`
+	UserID string `json:"user_id"`
+}
+
+// AccountIdentifier returns the user and workspace, e.g. "alice@acme.slack.com".
+func (i *Identity) AccountIdentifier() string {
+	host := i.Team
+	if u, err := url.Parse(i.URL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	if host == "" {
+		return i.User
+	}
+	return i.User + "@" + host
+}
+
+// Channel is a Slack conversation: a public or private channel.
+type Channel struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	IsPrivate  bool   `json:"is_private"`
+	IsArchived bool   `json:"is_archived"`
+	IsMember   bool   `json:"is_member"`
+	Topic      struct {
+		Value string `json:"value"`
+	} `json:"topic"`
+	Purpose struct {
+		Value string `json:"value"`
+	} `json:"purpose"`
+}
+
+// File is a file shared in a message. Only its name is indexed.
+type File struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+// Message is a Slack message or thread reply.
+type Message struct {
+	Type       string `json:"type"`
+	Subtype    string `json:"subtype"`
+	User       string `json:"user"`
+	BotID      string `json:"bot_id"`
+	Username   string `json:"username"`
+	Text       string `json:"text"`
+	TS         string `json:"ts"`
+	ThreadTS   string `json:"thread_ts"`
+	ReplyCount int    `json:"reply_count"`
+	LatestTS   string `json:"latest_reply"`
+	Files      []File `json:"files"`
+}
+
+// IsThreadParent returns true if the message starts a thread with replies.
+func (m *Message) IsThreadParent() bool {
+	return m.ReplyCount > 0 && (m.ThreadTS == "" || m.ThreadTS == m.TS)
+}
+
+// IsReply returns true if the message is a reply within a thread.
+func (m *Message) IsReply() bool {
+	return m.ThreadTS != "" && m.ThreadTS != m.TS
+}
+
+// User is a Slack workspace member.
+type User struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	RealName string `json:"real_name"`
+	Profile  struct {
+		DisplayName string `json:"display_name"`
+	} `json:"profile"`
+}
+
+// DisplayName returns the name shown for the user in Slack.
+func (u *User) DisplayName() string {
+	switch {
+	case u.Profile.DisplayName != "":
+		return u.Profile.DisplayName
+	case u.RealName != "":
+		return u.RealName
+	default:
+		return u.Name
+	}
+}
+
+// AuthTest returns the identity of the authenticated token.
+func (c *Client) AuthTest(ctx context.Context) (*Identity, error) {
+	token, err := c.tokenProvider.GetToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get token: %w", err)
+	}
+	return c.AuthTestWithToken(ctx, token)
+}
+
+// AuthTestWithToken returns the identity of an explicit token.
+func (c *Client) AuthTestWithToken(ctx context.Context, token string) (*Identity, error) {
+	var identity Identity
+	if err := c.callWithToken(ctx, token, "auth.test", nil, &identity); err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+// Channels returns every public and private channel visible to the token,
+// excluding archived channels.
+func (c *Client) Channels(ctx context.Context) ([]Channel, error) {
+	var channels []Channel
+	params := url.Values{
+		"types":            {"public_channel,private_channel"},
+		"exclude_archived": {"true"},
+		"limit":            {pageSize},
+	}
+	for {
+		var page struct {
+			response
+			Channels []Channel `json:"channels"`
+		}
+		pageCtx, span := tracing.StartPage(ctx, "slack", "conversations.list")
+		err := c.call(pageCtx, "conversations.list", params, &page)
+		tracing.EndPage(span, err)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, page.Channels...)
+
+		next := page.ResponseMetadata.NextCursor
+		if next == "" {
+			return channels, nil
+		}
+		params.Set("cursor", next)
+	}
+}
+
+// History calls fn with each page of a channel's messages posted after
+// oldest (exclusive), newest first. An empty oldest returns all messages.
+func (c *Client) History(ctx context.Context, channelID, oldest string, fn func([]Message) error) error {
+	params := url.Values{
+		"channel": {channelID},
+		"limit":   {pageSize},
+	}
+	if oldest != "" {
+		params.Set("oldest", oldest)
+	}
+	return c.messages(ctx, "conversations.history", params, fn)
+}
+
+// Replies calls fn with each page of a thread's messages posted after
+// oldest (exclusive). Slack always includes the parent message.
+func (c *Client) Replies(ctx context.Context, channelID, threadTS, oldest string, fn func([]Message) error) error {
+	params := url.Values{
+		"channel": {channelID},
+		"ts":      {threadTS},
+		"limit":   {pageSize},
+	}
+	if oldest != "" {
+		params.Set("oldest", oldest)
+	}
+	return c.messages(ctx, "conversations.replies", params, fn)
+}
+
+// User returns a workspace member.
+func (c *Client) User(ctx context.Context, userID string) (*User, error) {
+	var result struct {
+		response
+		User User `json:"user"`
+	}
+	if err := c.call(ctx, "users.info", url.Values{"user": {userID}}, &result); err != nil {
+		return nil, err
+	}
+	return &result.User, nil
+}
+
+// messages pages through a message listing method.
+func (c *Client) messages(ctx context.Context, method string, params url.Values, fn func([]Message) error) error {
+	for {
+		var page struct {
+			response
+			Messages []Message `json:"messages"`
+			HasMore  bool      `json:"has_more"`
+		}
+		pageCtx, span := tracing.StartPage(ctx, "slack", method)
+		err := c.call(pageCtx, method, params, &page)
+		tracing.EndPage(span, err)
+		if err != nil {
+			return err
+		}
+		if err := fn(page.Messages); err != nil {
+			return err
+		}
+
+		next := page.ResponseMetadata.NextCursor
+		if !page.HasMore || next == "" {
+			return nil
+		}
+		params.Set("cursor", next)
+	}
+}
+
+// call performs an authenticated API call and decodes the JSON response.
+func (c *Client) call(ctx context.Context, method string, params url.Values, out any) error {
+	token, err := c.tokenProvider.GetToken(ctx)
+	if err != nil {
+		return fmt.Errorf("get token: %w", err)
+	}
+	return c.callWithToken(ctx, token, method, params, out)
+}
+
+// callWithToken performs an API call with an explicit token, waiting out
+// and retrying rate limited requests up to maxRetries times.
+func (c *Client) callWithToken(ctx context.Context, token, method string, params url.Values, out any) error {
+	for attempt := 0; ; attempt++ {
+		retryAfter, err := c.do(ctx, token, method, params, out)
+		if retryAfter < 0 {
+			return err
+		}
+		if attempt == maxRetries {
+			return fmt.Errorf("%s: %w", method, domain.ErrRateLimited)
+		}
+		c.limiter.RecordRateLimitError(retryAfter)
+	}
+}
+
+// do performs a single API request. It returns the Retry-After seconds
+// when the request was rate limited, and -1 otherwise.
+func (c *Client) do(ctx context.Context, token, method string, params url.Values, out any) (int, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return -1, err
+	}
+
+	reqURL := c.baseURL + "/" + method
+	if len(params) > 0 {
+		reqURL += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
+	if err != nil {
+		return -1, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return -1, fmt.Errorf("request %s: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		retryAfter, _ := strconv.Atoi(resp.Header.Get("Retry-After"))
+		return max(retryAfter, 0), nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return -1, fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return -1, fmt.Errorf("%s: status %d: %s", method, resp.StatusCode, string(body))
+	}
+
+	var result response
+	if err := json.Unmarshal(body, &result); err != nil {
+		return -1, fmt.Errorf("decode %s: %w", method, err)
+	}
+	if !result.OK {
+		return -1, apiError(method, result.Error)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return -1, fmt.Errorf("decode %s: %w", method, err)
+	}
+	return -1, nil
+}
+
+// apiError converts a Slack error code to an error, mapping token
+// failures to domain.ErrAuthInvalid.
+func apiError(method, code string) error {
+	switch code {
+	case "invalid_auth", "not_authed", "token_revoked", "token_expired", "account_inactive":
+		return fmt.Errorf("%s: %s: %w", method, code, domain.ErrAuthInvalid)
+	case "ratelimited":
+		return fmt.Errorf("%s: %w", method, domain.ErrRateLimited)
+	default:
+		return fmt.Errorf("%s: %s", method, code)
+	}
+}
@@ -0,0 +1,59 @@
+package slack
+
+import (
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// Config holds Slack connector configuration.
+type Config struct {
+	// Channels limits syncing to specific channels, given by ID (C0123...)
+	// or name (with or without a leading #). If empty, every channel the
+	// authenticated user is a member of is synced.
+	Channels []string
+	// IncludeThreads fetches thread replies (default: true).
+	IncludeThreads bool
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		IncludeThreads: true,
+	}
+}
+
+// ParseConfig extracts configuration from a Source.
+func ParseConfig(source domain.Source) (*Config, error) {
+	cfg := DefaultConfig()
+
+	// Parse channels
+	if val := source.Config["channels"]; val != "" {
+		for _, channel := range strings.Split(val, ",") {
+			channel = strings.TrimPrefix(strings.TrimSpace(channel), "#")
+			if channel != "" {
+				cfg.Channels = append(cfg.Channels, channel)
+			}
+		}
+	}
+
+	// Parse include_threads
+	if val := source.Config["include_threads"]; val != "" {
+		cfg.IncludeThreads = val == "true" || val == "1"
+	}
+
+	return cfg, nil
+}
+
+// IncludesChannel reports whether a channel is selected by the config.
+func (c *Config) IncludesChannel(channel *Channel) bool {
+	if len(c.Channels) == 0 {
+		return channel.IsMember
+	}
+	for _, want := range c.Channels {
+		if want == channel.ID || want == channel.Name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package slack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestParseConfig(t *testing.T) {
+	source := domain.Source{Config: map[string]string{
+		"channels":        "C123, #general,,",
+		"include_threads": "false",
+	}}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"C123", "general"}, cfg.Channels)
+	assert.False(t, cfg.IncludeThreads)
+}
+
+func TestParseConfig_Defaults(t *testing.T) {
+	cfg, err := ParseConfig(domain.Source{Config: map[string]string{}})
+
+	require.NoError(t, err)
+	assert.Empty(t, cfg.Channels)
+	assert.True(t, cfg.IncludeThreads)
+}
+
+func TestConfig_IncludesChannel(t *testing.T) {
+	member := &Channel{ID: "C1", Name: "general", IsMember: true}
+	other := &Channel{ID: "C2", Name: "random"}
+
+	cfg := DefaultConfig()
+	assert.True(t, cfg.IncludesChannel(member))
+	assert.False(t, cfg.IncludesChannel(other), "only joined channels are synced by default")
+
+	cfg.Channels = []string{"C2"}
+	assert.False(t, cfg.IncludesChannel(member))
+	assert.True(t, cfg.IncludesChannel(other))
+
+	cfg.Channels = []string{"general"}
+	assert.True(t, cfg.IncludesChannel(member))
+}
+
+func TestResolveWebURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		uri      string
+		metadata map[string]any
+		want     string
+	}{
+		{
+			"metadata url", "slack://channels/C1/messages/1.2",
+			map[string]any{"url": "https://acme.slack.com/archives/C1/p12"}, "https://acme.slack.com/archives/C1/p12",
+		},
+		{"message", "slack://channels/C1/messages/1.2", nil, "https://slack.com/app_redirect?channel=C1"},
+		{"channel", "slack://channels/C1", nil, "https://slack.com/app_redirect?channel=C1"},
+		{"unknown", "trello://cards/c1", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ResolveWebURL(tt.uri, tt.metadata))
+		})
+	}
+}
@@ -0,0 +1,433 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/connectors/budget"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/locale"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Connector implements the interfaces.
+var (
+	_ driven.Connector      = (*Connector)(nil)
+	_ driven.APIBudgetAware = (*Connector)(nil)
+)
+
+// Connector fetches channel messages and thread replies from Slack.
+type Connector struct {
+	sourceID      string
+	config        *Config
+	tokenProvider driven.TokenProvider
+	client        *Client
+	mu            sync.Mutex
+	closed        bool
+}
+
+// New creates a new Slack connector.
+func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Connector {
+	return &Connector{
+		sourceID:      sourceID,
+		config:        cfg,
+		tokenProvider: tokenProvider,
+		client:        NewClient(tokenProvider),
+	}
+}
+
+// SetAPIBudget charges the connector's API requests to a shared budget.
+func (c *Connector) SetAPIBudget(apiBudget driven.APIBudget) {
+	c.client.httpClient.Transport = budget.Transport(nil, apiBudget)
+}
+
+// SetLocale sends locale as Accept-Language on the connector's API requests.
+func (c *Connector) SetLocale(loc string) {
+	c.client.httpClient.Transport = locale.Transport(c.client.httpClient.Transport, loc)
+}
+
+// Type returns the connector type identifier.
+func (c *Connector) Type() string {
+	return "slack"
+}
+
+// SourceID returns the source identifier.
+func (c *Connector) SourceID() string {
+	return c.sourceID
+}
+
+// Capabilities returns the connector's capabilities.
+func (c *Connector) Capabilities() driven.ConnectorCapabilities {
+	return driven.ConnectorCapabilities{
+		SupportsIncremental:  true,
+		SupportsWatch:        false,
+		SupportsHierarchy:    true,
+		SupportsBinary:       false,
+		RequiresAuth:         true,
+		SupportsValidation:   true,
+		SupportsCursorReturn: true,
+		SupportsPartialSync:  true,
+		SupportsRateLimiting: true,
+		SupportsPagination:   true,
+	}
+}
+
+// Validate checks if the Slack connector is properly configured.
+func (c *Connector) Validate(ctx context.Context) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if _, err := c.client.AuthTest(ctx); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrAuthRequired, err)
+	}
+	return nil
+}
+
+// FullSync fetches all messages from the configured channels.
+func (c *Connector) FullSync(ctx context.Context) (
+	docs <-chan domain.RawDocument, errs <-chan error,
+) {
+	docsChan := make(chan domain.RawDocument)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(docsChan)
+		defer close(errsChan)
+		errsChan <- c.runFullSync(ctx, docsChan)
+	}()
+
+	return docsChan, errsChan
+}
+
+// runFullSync executes the full sync logic.
+func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawDocument) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+	slog.Debug("full sync started", slog.String("connector", c.Type()), slog.String("source_id", c.sourceID))
+
+	s, err := c.newSession(ctx)
+	if err != nil {
+		return err
+	}
+
+	channels, err := c.channels(ctx)
+	if err != nil {
+		return err
+	}
+
+	emit := func(doc *domain.RawDocument) error {
+		return c.sendDocument(ctx, docsChan, doc)
+	}
+
+	cursor := NewCursor()
+	for i := range channels {
+		channel := &channels[i]
+		if err := emit(ChannelToRawDocument(channel, s.teamURL, c.sourceID)); err != nil {
+			return err
+		}
+
+		var state ChannelCursor
+		if err := c.syncChannel(ctx, s, channel, &state, emit); err != nil {
+			return err
+		}
+		cursor.SetChannel(channel.ID, state)
+	}
+
+	return &driven.SyncComplete{NewCursor: cursor.Encode()}
+}
+
+// IncrementalSync fetches messages posted since the last sync.
+func (c *Connector) IncrementalSync(
+	ctx context.Context, state domain.SyncState,
+) (changes <-chan domain.RawDocumentChange, errs <-chan error) {
+	changesChan := make(chan domain.RawDocumentChange)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(changesChan)
+		defer close(errsChan)
+		errsChan <- c.runIncrementalSync(ctx, state, changesChan)
+	}()
+
+	return changesChan, errsChan
+}
+
+// runIncrementalSync executes the incremental sync logic.
+// Each channel's history is fetched from its cursor timestamp using
+// Slack's oldest parameter. Edits and deletions of earlier messages are
+// not reported by the history, so they are picked up by the next full sync.
+func (c *Connector) runIncrementalSync(
+	ctx context.Context, state domain.SyncState, changesChan chan<- domain.RawDocumentChange,
+) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	cursor, err := DecodeCursor(state.Cursor)
+	if err != nil {
+		return fmt.Errorf("invalid cursor, full sync required: %w", err)
+	}
+	if cursor.IsEmpty() {
+		return fmt.Errorf("invalid cursor, full sync required: cursor has no channels")
+	}
+
+	s, err := c.newSession(ctx)
+	if err != nil {
+		return err
+	}
+
+	channels, err := c.channels(ctx)
+	if err != nil {
+		return err
+	}
+
+	emit := func(doc *domain.RawDocument) error {
+		return c.sendChange(ctx, changesChan, domain.ChangeCreated, doc)
+	}
+
+	next := NewCursor()
+	for i := range channels {
+		channel := &channels[i]
+		channelState, known := cursor.Channels[channel.ID]
+		if !known {
+			if err := emit(ChannelToRawDocument(channel, s.teamURL, c.sourceID)); err != nil {
+				return err
+			}
+		}
+
+		if err := c.syncChannel(ctx, s, channel, &channelState, emit); err != nil {
+			return err
+		}
+		next.SetChannel(channel.ID, channelState)
+	}
+
+	// Channels no longer synced (archived, left or removed from config)
+	for channelID := range cursor.Channels {
+		if _, ok := next.Channels[channelID]; ok {
+			continue
+		}
+		if err := c.sendDeleted(ctx, changesChan, ChannelURI(channelID)); err != nil {
+			return err
+		}
+	}
+
+	return &driven.SyncComplete{NewCursor: next.Encode()}
+}
+
+// session holds state shared across a single sync.
+type session struct {
+	// teamURL is the workspace URL (e.g. https://acme.slack.com/), used
+	// to build permalinks.
+	teamURL string
+	// authors caches user display names by user ID.
+	authors map[string]string
+}
+
+// newSession identifies the workspace the token belongs to.
+func (c *Connector) newSession(ctx context.Context) (*session, error) {
+	identity, err := c.client.AuthTest(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auth test: %w", err)
+	}
+	return &session{teamURL: identity.URL, authors: make(map[string]string)}, nil
+}
+
+// syncChannel emits the messages posted to a channel after state.Latest,
+// followed by new replies to the threads tracked in state.
+func (c *Connector) syncChannel(
+	ctx context.Context,
+	s *session,
+	channel *Channel,
+	state *ChannelCursor,
+	emit func(*domain.RawDocument) error,
+) error {
+	tracked := make([]string, 0, len(state.Threads))
+	for threadTS := range state.Threads {
+		tracked = append(tracked, threadTS)
+	}
+
+	err := c.client.History(ctx, channel.ID, state.Latest, func(messages []Message) error {
+		for i := range messages {
+			msg := &messages[i]
+			state.observe(msg.TS)
+
+			// Replies broadcast to the channel are emitted with their thread
+			if c.config.IncludeThreads && msg.IsReply() {
+				continue
+			}
+			if IsIndexable(msg) {
+				if err := emit(c.messageDocument(ctx, s, channel, msg)); err != nil {
+					return err
+				}
+			}
+			if c.config.IncludeThreads && msg.IsThreadParent() {
+				if err := c.syncThread(ctx, s, channel, msg.TS, "", state, emit); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("history of channel %s: %w", channel.ID, err)
+	}
+
+	for _, threadTS := range tracked {
+		if err := c.syncThread(ctx, s, channel, threadTS, state.Threads[threadTS], state, emit); err != nil {
+			return err
+		}
+	}
+
+	state.pruneThreads(time.Now())
+	return nil
+}
+
+// syncThread emits the replies to a thread posted after oldest and tracks
+// the thread's newest reply.
+func (c *Connector) syncThread(
+	ctx context.Context,
+	s *session,
+	channel *Channel,
+	threadTS, oldest string,
+	state *ChannelCursor,
+	emit func(*domain.RawDocument) error,
+) error {
+	err := c.client.Replies(ctx, channel.ID, threadTS, oldest, func(messages []Message) error {
+		for i := range messages {
+			msg := &messages[i]
+			if msg.TS == threadTS || !tsAfter(msg.TS, oldest) {
+				continue
+			}
+			state.trackThread(threadTS, msg.TS)
+			if !IsIndexable(msg) {
+				continue
+			}
+			if err := emit(c.messageDocument(ctx, s, channel, msg)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("replies to thread %s in channel %s: %w", threadTS, channel.ID, err)
+	}
+	return nil
+}
+
+// messageDocument converts a message to a RawDocument with its author resolved.
+func (c *Connector) messageDocument(
+	ctx context.Context, s *session, channel *Channel, msg *Message,
+) *domain.RawDocument {
+	return MessageToRawDocument(msg, channel, c.author(ctx, s, msg), s.teamURL, c.sourceID)
+}
+
+// author returns the display name of a message's author. Users are looked
+// up once per sync; if the lookup fails the user ID is used instead.
+func (c *Connector) author(ctx context.Context, s *session, msg *Message) string {
+	if msg.User == "" {
+		return msg.Username
+	}
+	if name, ok := s.authors[msg.User]; ok {
+		return name
+	}
+
+	name := msg.User
+	if user, err := c.client.User(ctx, msg.User); err == nil && user.DisplayName() != "" {
+		name = user.DisplayName()
+	}
+	s.authors[msg.User] = name
+	return name
+}
+
+// channels returns the channels to sync: the configured channels, or every
+// channel the authenticated user is a member of.
+func (c *Connector) channels(ctx context.Context) ([]Channel, error) {
+	all, err := c.client.Channels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list channels: %w", err)
+	}
+
+	var channels []Channel
+	for i := range all {
+		if c.config.IncludesChannel(&all[i]) {
+			channels = append(channels, all[i])
+		}
+	}
+	return channels, nil
+}
+
+// sendDocument sends a document to the channel or returns on context cancellation.
+func (c *Connector) sendDocument(
+	ctx context.Context, docsChan chan<- domain.RawDocument, doc *domain.RawDocument,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case docsChan <- *doc:
+		return nil
+	}
+}
+
+// sendChange sends a created or updated document to the channel.
+func (c *Connector) sendChange(
+	ctx context.Context,
+	changesChan chan<- domain.RawDocumentChange,
+	changeType domain.ChangeType,
+	doc *domain.RawDocument,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case changesChan <- domain.RawDocumentChange{Type: changeType, Document: *doc}:
+		return nil
+	}
+}
+
+// sendDeleted sends a deletion for the document with the given URI.
+func (c *Connector) sendDeleted(
+	ctx context.Context, changesChan chan<- domain.RawDocumentChange, uri string,
+) error {
+	doc := &domain.RawDocument{SourceID: c.sourceID, URI: uri}
+	return c.sendChange(ctx, changesChan, domain.ChangeDeleted, doc)
+}
+
+// checkClosed returns an error if the connector is closed.
+func (c *Connector) checkClosed() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return domain.ErrConnectorClosed
+	}
+	return nil
+}
+
+// Watch is not supported for Slack.
+func (c *Connector) Watch(_ context.Context) (<-chan domain.RawDocumentChange, error) {
+	return nil, domain.ErrNotImplemented
+}
+
+// GetAccountIdentifier returns the user and workspace for the given token.
+func (c *Connector) GetAccountIdentifier(ctx context.Context, accessToken string) (string, error) {
+	identity, err := c.client.AuthTestWithToken(ctx, accessToken)
+	if err != nil {
+		return "", err
+	}
+	return identity.AccountIdentifier(), nil
+}
+
+// Close releases resources.
+func (c *Connector) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
@@ -0,0 +1,368 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// mockTokenProvider implements driven.TokenProvider for testing.
+type mockTokenProvider struct {
+	token string
+}
+
+func (m *mockTokenProvider) GetToken(_ context.Context) (string, error) {
+	return m.token, nil
+}
+
+func (m *mockTokenProvider) AuthorizationID() string {
+	return "creds-1"
+}
+
+func (m *mockTokenProvider) AuthMethod() domain.AuthMethod {
+	return domain.AuthMethodOAuth
+}
+
+func (m *mockTokenProvider) IsAuthenticated() bool {
+	return m.token != ""
+}
+
+func (m *mockTokenProvider) RefreshIfNeeded(_ context.Context) error {
+	return nil
+}
+
+// historyPageSize is the number of messages the stub returns per page.
+const historyPageSize = 2
+
+// stubSlack serves the #general channel from the Slack Web API.
+type stubSlack struct {
+	mu          sync.Mutex
+	channels    []map[string]any
+	history     []map[string]any // newest first
+	replies     map[string][]map[string]any
+	oldest      []string
+	auth        []string
+	rateLimited int
+}
+
+func newStubSlack() *stubSlack {
+	return &stubSlack{
+		channels: []map[string]any{
+			{"id": "C1", "name": "general", "is_member": true, "topic": map[string]any{"value": "Company news"}},
+			{"id": "C2", "name": "random", "is_member": false},
+		},
+		replies: make(map[string][]map[string]any),
+	}
+}
+
+func (s *stubSlack) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.auth = append(s.auth, r.Header.Get("Authorization"))
+
+	if s.rateLimited > 0 {
+		s.rateLimited--
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+		return
+	}
+
+	query := r.URL.Query()
+	var body map[string]any
+	switch r.URL.Path {
+	case "/auth.test":
+		body = map[string]any{"url": "https://acme.slack.com/", "team": "Acme", "user": "alice", "user_id": "U1"}
+	case "/conversations.list":
+		body = map[string]any{"channels": s.channels}
+	case "/conversations.history":
+		s.oldest = append(s.oldest, query.Get("oldest"))
+		body = page(after(s.history, query.Get("oldest")), query.Get("cursor"))
+	case "/conversations.replies":
+		thread := s.replies[query.Get("ts")]
+		body = page(append(thread[:1:1], after(thread[1:], query.Get("oldest"))...), query.Get("cursor"))
+	case "/users.info":
+		if query.Get("user") != "U1" {
+			body = map[string]any{"ok": false, "error": "user_not_found"}
+			break
+		}
+		body = map[string]any{"user": map[string]any{"id": "U1", "name": "alice", "real_name": "Alice Smith"}}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if _, ok := body["ok"]; !ok {
+		body["ok"] = true
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// after returns the messages posted after oldest.
+func after(messages []map[string]any, oldest string) []map[string]any {
+	var result []map[string]any
+	for _, msg := range messages {
+		if tsAfter(msg["ts"].(string), oldest) {
+			result = append(result, msg)
+		}
+	}
+	return result
+}
+
+// page returns one page of messages, continuing from cursor.
+func page(messages []map[string]any, cursor string) map[string]any {
+	start := 0
+	if cursor != "" {
+		_, _ = fmt.Sscanf(cursor, "page-%d", &start)
+	}
+	end := min(start+historyPageSize, len(messages))
+	body := map[string]any{"messages": messages[start:end], "has_more": end < len(messages)}
+	if end < len(messages) {
+		body["response_metadata"] = map[string]any{"next_cursor": fmt.Sprintf("page-%d", end)}
+	}
+	return body
+}
+
+// ts returns a Slack timestamp the given number of seconds after a fixed
+// point an hour ago, so threads stay within the tracking window.
+var base = time.Now().Add(-time.Hour).Unix()
+
+func ts(offset int64) string {
+	return fmt.Sprintf("%d.000100", base+offset)
+}
+
+func message(stamp, user, text string) map[string]any {
+	return map[string]any{"type": "message", "ts": stamp, "user": user, "text": text}
+}
+
+func newTestConnector(t *testing.T, stub *stubSlack) *Connector {
+	t.Helper()
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+
+	conn := New("source-1", DefaultConfig(), &mockTokenProvider{token: "xoxp-123"})
+	conn.client.baseURL = server.URL
+	conn.client.limiter.limiter = rate.NewLimiter(rate.Inf, 1)
+	return conn
+}
+
+func collectDocs(docs <-chan domain.RawDocument, errs <-chan error) ([]domain.RawDocument, error) {
+	var collected []domain.RawDocument
+	for doc := range docs {
+		collected = append(collected, doc)
+	}
+	return collected, <-errs
+}
+
+func collectChanges(changes <-chan domain.RawDocumentChange, errs <-chan error) ([]domain.RawDocumentChange, error) {
+	var collected []domain.RawDocumentChange
+	for change := range changes {
+		collected = append(collected, change)
+	}
+	return collected, <-errs
+}
+
+func syncCursor(t *testing.T, err error) string {
+	t.Helper()
+	var complete *driven.SyncComplete
+	require.True(t, errors.As(err, &complete), "expected SyncComplete, got %v", err)
+	return complete.NewCursor
+}
+
+// seedThread adds a thread started by parent with the given replies, and
+// any replies broadcast to the channel.
+func seedThread(stub *stubSlack, parent map[string]any, replies ...map[string]any) {
+	threadTS := parent["ts"].(string)
+	parent["thread_ts"] = threadTS
+	parent["reply_count"] = len(replies)
+	thread := []map[string]any{parent}
+	for _, reply := range replies {
+		reply["thread_ts"] = threadTS
+		thread = append(thread, reply)
+	}
+	stub.replies[threadTS] = thread
+}
+
+func TestConnector_Basics(t *testing.T) {
+	conn := New("source-1", DefaultConfig(), nil)
+
+	assert.Equal(t, "slack", conn.Type())
+	assert.Equal(t, "source-1", conn.SourceID())
+	assert.True(t, conn.Capabilities().SupportsIncremental)
+	assert.True(t, conn.Capabilities().RequiresAuth)
+
+	_, err := conn.Watch(context.Background())
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+
+	require.NoError(t, conn.Close())
+	assert.ErrorIs(t, conn.Validate(context.Background()), domain.ErrConnectorClosed)
+}
+
+func TestConnector_FullSync_EmitsMessagesAndThreads(t *testing.T) {
+	stub := newStubSlack()
+	parent := message(ts(1), "U1", "Deploy is done")
+	reply := message(ts(2), "U2", "Nice")
+	broadcast := message(ts(4), "U1", "Also posted to the channel")
+	broadcast["subtype"] = "thread_broadcast"
+	join := message(ts(3), "U2", "<@U2> has joined the channel")
+	join["subtype"] = "channel_join"
+	latest := message(ts(5), "U2", "Lunch?\nAnyone")
+	seedThread(stub, parent, reply, broadcast)
+	stub.history = []map[string]any{latest, broadcast, join, parent}
+	conn := newTestConnector(t, stub)
+
+	docs, err := collectDocs(conn.FullSync(context.Background()))
+	cursor := syncCursor(t, err)
+
+	uris := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		uris = append(uris, doc.URI)
+	}
+	assert.Equal(t, []string{
+		"slack://channels/C1",
+		"slack://channels/C1/messages/" + ts(5),
+		"slack://channels/C1/messages/" + ts(1),
+		"slack://channels/C1/messages/" + ts(2),
+		"slack://channels/C1/messages/" + ts(4),
+	}, uris, "joins are skipped and broadcasts are emitted once, with their thread")
+
+	channel := docs[0]
+	assert.Equal(t, "#general", channel.Metadata["title"])
+	assert.Contains(t, string(channel.Content), "Company news")
+
+	top := docs[2]
+	assert.Equal(t, "Deploy is done", string(top.Content))
+	assert.Equal(t, "text/markdown", top.MIMEType)
+	require.NotNil(t, top.ParentURI)
+	assert.Equal(t, "slack://channels/C1", *top.ParentURI)
+	assert.Equal(t, "Alice Smith", top.Metadata["author"])
+	assert.Equal(t, "#general: Deploy is done", top.Metadata["title"])
+	assert.Equal(t, 2, top.Metadata["reply_count"])
+
+	threadReply := docs[3]
+	require.NotNil(t, threadReply.ParentURI)
+	assert.Equal(t, "slack://channels/C1/messages/"+ts(1), *threadReply.ParentURI)
+	assert.Equal(t, "U2", threadReply.Metadata["author"], "unknown users fall back to their ID")
+	assert.Equal(t, fmt.Sprintf("https://acme.slack.com/archives/C1/p%d000100?thread_ts=%s&cid=C1", base+2, ts(1)),
+		threadReply.Metadata["url"])
+
+	assert.Equal(t, "#general: Lunch?", docs[1].Metadata["title"])
+
+	decoded, err := DecodeCursor(cursor)
+	require.NoError(t, err)
+	assert.Equal(t, ts(5), decoded.Channels["C1"].Latest)
+	assert.Equal(t, map[string]string{ts(1): ts(4)}, decoded.Channels["C1"].Threads)
+	assert.NotContains(t, decoded.Channels, "C2", "channels the user has not joined are skipped")
+
+	for _, auth := range stub.auth {
+		assert.Equal(t, "Bearer xoxp-123", auth)
+	}
+}
+
+func TestConnector_IncrementalSync_UsesOldestCursor(t *testing.T) {
+	stub := newStubSlack()
+	parent := message(ts(1), "U1", "Deploy is done")
+	seedThread(stub, parent, message(ts(2), "U1", "Nice"))
+	stub.history = []map[string]any{message(ts(3), "U1", "Earlier"), parent}
+	conn := newTestConnector(t, stub)
+	_, err := collectDocs(conn.FullSync(context.Background()))
+	cursor := syncCursor(t, err)
+
+	// A new message, and a new reply to the existing thread
+	stub.history = append([]map[string]any{message(ts(10), "U1", "Later")}, stub.history...)
+	seedThread(stub, parent, message(ts(2), "U1", "Nice"), message(ts(11), "U1", "Rolled back"))
+	stub.oldest = nil
+
+	changes, err := collectChanges(conn.IncrementalSync(context.Background(), domain.SyncState{Cursor: cursor}))
+	next := syncCursor(t, err)
+
+	require.Len(t, changes, 2)
+	assert.Equal(t, domain.ChangeCreated, changes[0].Type)
+	assert.Equal(t, "slack://channels/C1/messages/"+ts(10), changes[0].Document.URI)
+	assert.Equal(t, "slack://channels/C1/messages/"+ts(11), changes[1].Document.URI)
+	require.NotNil(t, changes[1].Document.ParentURI)
+	assert.Equal(t, "slack://channels/C1/messages/"+ts(1), *changes[1].Document.ParentURI)
+	assert.Equal(t, []string{ts(3)}, stub.oldest, "history is fetched from the cursor timestamp")
+
+	// A further sync with no activity emits nothing
+	changes, err = collectChanges(conn.IncrementalSync(context.Background(), domain.SyncState{Cursor: next}))
+	syncCursor(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestConnector_IncrementalSync_DeletesRemovedChannels(t *testing.T) {
+	stub := newStubSlack()
+	stub.history = []map[string]any{message(ts(1), "U1", "Hello")}
+	conn := newTestConnector(t, stub)
+	_, err := collectDocs(conn.FullSync(context.Background()))
+	cursor := syncCursor(t, err)
+
+	stub.channels = stub.channels[1:]
+	changes, err := collectChanges(conn.IncrementalSync(context.Background(), domain.SyncState{Cursor: cursor}))
+	syncCursor(t, err)
+
+	require.Len(t, changes, 1)
+	assert.Equal(t, domain.ChangeDeleted, changes[0].Type)
+	assert.Equal(t, "slack://channels/C1", changes[0].Document.URI)
+}
+
+func TestConnector_IncrementalSync_RequiresCursor(t *testing.T) {
+	conn := newTestConnector(t, newStubSlack())
+
+	_, errs := conn.IncrementalSync(context.Background(), domain.SyncState{})
+
+	err := <-errs
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "full sync required")
+}
+
+func TestConnector_RetriesRateLimitedRequests(t *testing.T) {
+	stub := newStubSlack()
+	stub.rateLimited = 1
+	conn := newTestConnector(t, stub)
+
+	require.NoError(t, conn.Validate(context.Background()))
+	assert.Len(t, stub.auth, 2, "the request is retried after the Retry-After period")
+
+	stub.rateLimited = maxRetries + 1
+	err := conn.Validate(context.Background())
+	assert.ErrorIs(t, err, domain.ErrRateLimited)
+}
+
+func TestConnector_Validate(t *testing.T) {
+	conn := newTestConnector(t, newStubSlack())
+	assert.NoError(t, conn.Validate(context.Background()))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"ok":false,"error":"invalid_auth"}`))
+	}))
+	defer server.Close()
+	conn.client.baseURL = server.URL
+
+	err := conn.Validate(context.Background())
+	assert.ErrorIs(t, err, domain.ErrAuthRequired)
+	assert.ErrorIs(t, err, domain.ErrAuthInvalid)
+}
+
+func TestConnector_GetAccountIdentifier(t *testing.T) {
+	stub := newStubSlack()
+	conn := newTestConnector(t, stub)
+
+	account, err := conn.GetAccountIdentifier(context.Background(), "other-token")
+
+	require.NoError(t, err)
+	assert.Equal(t, "alice@acme.slack.com", account)
+	assert.Equal(t, "Bearer other-token", stub.auth[0])
+}
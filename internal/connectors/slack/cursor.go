@@ -0,0 +1,121 @@
+package slack
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// CursorVersion is the current cursor format version.
+const CursorVersion = 1
+
+// threadWindow is how long a thread is watched for new replies after its
+// last activity. Replies to older threads are not picked up until the next
+// full sync.
+const threadWindow = 14 * 24 * time.Hour
+
+// ErrInvalidCursor indicates the cursor could not be decoded.
+var ErrInvalidCursor = errors.New("slack: invalid cursor format")
+
+// Cursor tracks Slack sync state per channel.
+// conversations.history accepts an oldest timestamp, so each channel only
+// needs the timestamp of the latest message seen. Replies to existing
+// threads do not appear in the history, so recently active threads are
+// tracked separately and polled with conversations.replies.
+type Cursor struct {
+	// Version is the cursor format version for future compatibility.
+	Version int `json:"v"`
+	// Channels maps channel ID to its sync state.
+	Channels map[string]ChannelCursor `json:"channels"`
+}
+
+// ChannelCursor tracks the sync state of a single channel.
+type ChannelCursor struct {
+	// Latest is the timestamp of the newest message seen in the channel.
+	Latest string `json:"latest,omitempty"`
+	// Threads maps a thread's parent timestamp to its newest reply seen.
+	Threads map[string]string `json:"threads,omitempty"`
+}
+
+// NewCursor creates a new empty cursor.
+func NewCursor() *Cursor {
+	return &Cursor{
+		Version:  CursorVersion,
+		Channels: make(map[string]ChannelCursor),
+	}
+}
+
+// Encode serialises the cursor to a base64 string for storage.
+func (c *Cursor) Encode() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// DecodeCursor deserialises a cursor from a base64 string.
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return NewCursor(), nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	// Version check for future migrations
+	if cursor.Version > CursorVersion {
+		return nil, ErrInvalidCursor
+	}
+
+	// Ensure map is initialised
+	if cursor.Channels == nil {
+		cursor.Channels = make(map[string]ChannelCursor)
+	}
+
+	return &cursor, nil
+}
+
+// IsEmpty returns true if the cursor has no tracked channels.
+func (c *Cursor) IsEmpty() bool {
+	return len(c.Channels) == 0
+}
+
+// SetChannel updates the state for a channel.
+func (c *Cursor) SetChannel(channelID string, state ChannelCursor) {
+	c.Channels[channelID] = state
+}
+
+// observe advances the channel's latest timestamp to ts if it is newer.
+func (s *ChannelCursor) observe(ts string) {
+	if tsAfter(ts, s.Latest) {
+		s.Latest = ts
+	}
+}
+
+// trackThread records the newest reply seen in a thread.
+func (s *ChannelCursor) trackThread(threadTS, replyTS string) {
+	if s.Threads == nil {
+		s.Threads = make(map[string]string)
+	}
+	if tsAfter(replyTS, s.Threads[threadTS]) {
+		s.Threads[threadTS] = replyTS
+	}
+}
+
+// pruneThreads stops tracking threads with no activity within threadWindow.
+func (s *ChannelCursor) pruneThreads(now time.Time) {
+	for threadTS, latest := range s.Threads {
+		if now.Sub(parseTS(latest)) > threadWindow {
+			delete(s.Threads, threadTS)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package slack
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	cursor := NewCursor()
+	cursor.SetChannel("C1", ChannelCursor{
+		Latest:  "1712345678.000200",
+		Threads: map[string]string{"1712345600.000100": "1712345670.000100"},
+	})
+
+	decoded, err := DecodeCursor(cursor.Encode())
+
+	require.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+}
+
+func TestDecodeCursor(t *testing.T) {
+	empty, err := DecodeCursor("")
+	require.NoError(t, err)
+	assert.True(t, empty.IsEmpty())
+
+	_, err = DecodeCursor("not base64!")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	future := &Cursor{Version: CursorVersion + 1}
+	_, err = DecodeCursor(future.Encode())
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestTSAfter(t *testing.T) {
+	assert.True(t, tsAfter("1712345678.000200", "1712345678.000100"))
+	assert.True(t, tsAfter("1712345679.1", "1712345678.999999"))
+	assert.False(t, tsAfter("1712345678.000100", "1712345678.000100"))
+	assert.True(t, tsAfter("1712345678.000100", ""), "any timestamp is after an empty one")
+	assert.False(t, tsAfter("", "1712345678.000100"))
+}
+
+func TestChannelCursor_PruneThreads(t *testing.T) {
+	now := time.Unix(1_800_000_000, 0)
+	ts := func(age time.Duration) string {
+		return fmt.Sprintf("%d.000000", now.Add(-age).Unix())
+	}
+
+	var state ChannelCursor
+	state.trackThread("recent", ts(time.Hour))
+	state.trackThread("stale", ts(threadWindow+time.Hour))
+	state.trackThread("recent", ts(2*time.Hour))
+
+	state.pruneThreads(now)
+
+	assert.Equal(t, map[string]string{"recent": ts(time.Hour)}, state.Threads,
+		"stale threads are dropped and older replies do not move a thread back")
+}
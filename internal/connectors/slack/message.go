@@ -0,0 +1,197 @@
+package slack
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mimeTypeMarkdown is the MIME type of documents emitted by this connector.
+// Slack's mrkdwn formatting is close enough to Markdown to be indexed as such.
+const mimeTypeMarkdown = "text/markdown"
+
+// maxTitleLength is the maximum length of a message title in runes.
+const maxTitleLength = 80
+
+// EmittedMIMETypes returns the MIME types the Slack connector emits.
+func EmittedMIMETypes() []string {
+	return []string{mimeTypeMarkdown}
+}
+
+// ChannelURI returns the internal URI of a channel.
+func ChannelURI(channelID string) string {
+	return fmt.Sprintf("slack://channels/%s", channelID)
+}
+
+// MessageURI returns the internal URI of a message or thread reply.
+func MessageURI(channelID, ts string) string {
+	return fmt.Sprintf("slack://channels/%s/messages/%s", channelID, ts)
+}
+
+// indexedSubtypes are the message subtypes that carry user content.
+// Other subtypes (joins, topic changes, ...) are skipped.
+var indexedSubtypes = map[string]bool{
+	"":                 true,
+	"bot_message":      true,
+	"file_share":       true,
+	"me_message":       true,
+	"thread_broadcast": true,
+}
+
+// IsIndexable returns true if the message carries content worth indexing.
+func IsIndexable(msg *Message) bool {
+	if !indexedSubtypes[msg.Subtype] {
+		return false
+	}
+	return strings.TrimSpace(msg.Text) != "" || len(msg.Files) > 0
+}
+
+// ChannelToRawDocument converts a Slack channel to a RawDocument.
+// The content holds the channel's topic and purpose.
+func ChannelToRawDocument(channel *Channel, teamURL, sourceID string) *domain.RawDocument {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# #%s\n", channel.Name)
+	if topic := strings.TrimSpace(channel.Topic.Value); topic != "" {
+		fmt.Fprintf(&b, "\n%s\n", topic)
+	}
+	if purpose := strings.TrimSpace(channel.Purpose.Value); purpose != "" {
+		fmt.Fprintf(&b, "\n%s\n", purpose)
+	}
+
+	metadata := map[string]any{
+		"channel_id": channel.ID,
+		"channel":    channel.Name,
+		"title":      "#" + channel.Name,
+		"private":    channel.IsPrivate,
+	}
+	if teamURL != "" {
+		metadata["url"] = teamURL + "archives/" + channel.ID
+	}
+
+	return &domain.RawDocument{
+		SourceID: sourceID,
+		URI:      ChannelURI(channel.ID),
+		MIMEType: mimeTypeMarkdown,
+		Content:  []byte(b.String()),
+		Metadata: metadata,
+	}
+}
+
+// MessageToRawDocument converts a Slack message to a RawDocument.
+// Top-level messages are children of their channel; thread replies are
+// children of the message that started the thread.
+func MessageToRawDocument(msg *Message, channel *Channel, author, teamURL, sourceID string) *domain.RawDocument {
+	var b strings.Builder
+	b.WriteString(strings.TrimSpace(msg.Text))
+	if len(msg.Files) > 0 {
+		b.WriteString("\n\nFiles:\n")
+		for _, file := range msg.Files {
+			name := file.Title
+			if name == "" {
+				name = file.Name
+			}
+			fmt.Fprintf(&b, "- %s\n", name)
+		}
+	}
+
+	parentURI := ChannelURI(channel.ID)
+	if msg.IsReply() {
+		parentURI = MessageURI(channel.ID, msg.ThreadTS)
+	}
+
+	metadata := map[string]any{
+		"channel_id": channel.ID,
+		"channel":    channel.Name,
+		"ts":         msg.TS,
+		"title":      messageTitle(msg, channel),
+	}
+	if author != "" {
+		metadata["author"] = author
+	}
+	if msg.ThreadTS != "" {
+		metadata["thread_ts"] = msg.ThreadTS
+	}
+	if msg.ReplyCount > 0 {
+		metadata["reply_count"] = msg.ReplyCount
+	}
+	if created := parseTS(msg.TS); !created.IsZero() {
+		metadata["created"] = created.Format(time.RFC3339)
+	}
+	if teamURL != "" {
+		metadata["url"] = Permalink(teamURL, channel.ID, msg)
+	}
+
+	return &domain.RawDocument{
+		SourceID:  sourceID,
+		URI:       MessageURI(channel.ID, msg.TS),
+		MIMEType:  mimeTypeMarkdown,
+		Content:   []byte(b.String()),
+		ParentURI: &parentURI,
+		Metadata:  metadata,
+	}
+}
+
+// Permalink returns the web URL of a message in the workspace at teamURL.
+func Permalink(teamURL, channelID string, msg *Message) string {
+	link := fmt.Sprintf("%sarchives/%s/p%s", teamURL, channelID, strings.Replace(msg.TS, ".", "", 1))
+	if msg.IsReply() {
+		link += fmt.Sprintf("?thread_ts=%s&cid=%s", msg.ThreadTS, channelID)
+	}
+	return link
+}
+
+// messageTitle returns the channel name and the first line of the message.
+func messageTitle(msg *Message, channel *Channel) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(msg.Text), "\n")
+	if line == "" && len(msg.Files) > 0 {
+		line = msg.Files[0].Title
+	}
+	if utf8.RuneCountInString(line) > maxTitleLength {
+		line = string([]rune(line)[:maxTitleLength]) + "…"
+	}
+	if line == "" {
+		return "#" + channel.Name
+	}
+	return "#" + channel.Name + ": " + line
+}
+
+// parseTS converts a Slack timestamp ("1712345678.123456") to a time.
+// It returns the zero time if the timestamp is malformed.
+func parseTS(ts string) time.Time {
+	micros, ok := tsMicros(ts)
+	if !ok {
+		return time.Time{}
+	}
+	return time.UnixMicro(micros)
+}
+
+// tsAfter reports whether Slack timestamp a is after b. Any valid
+// timestamp is after an empty or malformed one.
+func tsAfter(a, b string) bool {
+	am, aok := tsMicros(a)
+	bm, bok := tsMicros(b)
+	if !aok {
+		return false
+	}
+	return !bok || am > bm
+}
+
+// tsMicros parses a Slack timestamp into microseconds since the epoch.
+// Timestamps are compared as integers, as float64 cannot represent them exactly.
+func tsMicros(ts string) (int64, bool) {
+	secs, frac, _ := strings.Cut(ts, ".")
+	sec, err := strconv.ParseInt(secs, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	frac = (frac + "000000")[:6]
+	micro, err := strconv.ParseInt(frac, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return sec*1_000_000 + micro, true
+}
@@ -0,0 +1,208 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// OAuthHandler implements OAuth operations for Slack.
+// Slack issues user tokens through user_scope rather than scope, and
+// returns them nested under authed_user in the token response.
+type OAuthHandler struct{}
+
+// NewOAuthHandler creates a new Slack OAuth handler.
+func NewOAuthHandler() *OAuthHandler {
+	return &OAuthHandler{}
+}
+
+// BuildAuthURL constructs the Slack OAuth authorization URL.
+// Scopes are requested as user scopes so the token reads the channels the
+// user can see. Slack does not support PKCE, so the code challenge is unused.
+func (h *OAuthHandler) BuildAuthURL(
+	authProvider *domain.AuthProvider,
+	redirectURI, state, _ string,
+) string {
+	cfg := authProvider.OAuth
+	authURL := cfg.AuthURL
+	if authURL == "" {
+		authURL = defaultAuthURL
+	}
+
+	// Use default scopes if none configured
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultScopes
+	}
+
+	params := url.Values{
+		"client_id":    {cfg.ClientID},
+		"redirect_uri": {redirectURI},
+		"state":        {state},
+		// Slack uses comma-separated scopes
+		"user_scope": {strings.Join(scopes, ",")},
+	}
+
+	return authURL + "?" + params.Encode()
+}
+
+// ExchangeCode exchanges an authorization code for tokens.
+func (h *OAuthHandler) ExchangeCode(
+	ctx context.Context,
+	authProvider *domain.AuthProvider,
+	code, redirectURI, _ string,
+) (*domain.OAuthToken, error) {
+	cfg := authProvider.OAuth
+	data := url.Values{
+		"client_id":     {cfg.ClientID},
+		"client_secret": {cfg.ClientSecret},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+	}
+	return requestToken(ctx, tokenURL(cfg), data)
+}
+
+// RefreshToken refreshes an expired access token using a refresh token.
+// Refresh tokens are only issued when token rotation is enabled for the app.
+func (h *OAuthHandler) RefreshToken(
+	ctx context.Context,
+	authProvider *domain.AuthProvider,
+	refreshToken string,
+) (*domain.OAuthToken, error) {
+	cfg := authProvider.OAuth
+	data := url.Values{
+		"grant_type":    {"refresh_token"},
+		"client_id":     {cfg.ClientID},
+		"client_secret": {cfg.ClientSecret},
+		"refresh_token": {refreshToken},
+	}
+
+	token, err := requestToken(ctx, tokenURL(cfg), data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the old refresh token if a new one is not returned
+	if token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+	return token, nil
+}
+
+// GetUserInfo returns the user and workspace of the token, e.g. "alice@acme.slack.com".
+func (h *OAuthHandler) GetUserInfo(ctx context.Context, accessToken string) (string, error) {
+	identity, err := NewClient(nil).AuthTestWithToken(ctx, accessToken)
+	if err != nil {
+		return "", err
+	}
+	return identity.AccountIdentifier(), nil
+}
+
+// DefaultConfig returns default OAuth URLs and scopes for Slack.
+func (h *OAuthHandler) DefaultConfig() driven.OAuthDefaults {
+	return driven.OAuthDefaults{
+		AuthURL:  defaultAuthURL,
+		TokenURL: defaultTokenURL,
+		Scopes:   defaultScopes,
+	}
+}
+
+// SetupHint returns guidance for setting up a Slack OAuth app.
+func (h *OAuthHandler) SetupHint() string {
+	return "Create a Slack app at api.slack.com/apps and add the user token scopes"
+}
+
+// Slack OAuth constants.
+const (
+	defaultAuthURL = "https://slack.com/oauth/v2/authorize"
+	//nolint:gosec // G101: Not credentials, OAuth endpoint URL
+	defaultTokenURL = "https://slack.com/api/oauth.v2.access"
+)
+
+// defaultScopes are the default OAuth user scopes for Slack.
+var defaultScopes = []string{
+	"channels:read",
+	"channels:history",
+	"groups:read",
+	"groups:history",
+	"users:read",
+}
+
+// tokenGrant holds the token fields of an oauth.v2.access response.
+type tokenGrant struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+// tokenResponse is the response of oauth.v2.access. The user token from a
+// code exchange is nested under authed_user; refresh responses are flat.
+type tokenResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+	tokenGrant
+	AuthedUser tokenGrant `json:"authed_user"`
+}
+
+// tokenURL returns the configured token URL, or the default.
+func tokenURL(cfg *domain.OAuthProviderConfig) string {
+	if cfg.TokenURL != "" {
+		return cfg.TokenURL
+	}
+	return defaultTokenURL
+}
+
+// requestToken posts a token request to oauth.v2.access.
+func requestToken(ctx context.Context, endpoint string, data url.Values) (*domain.OAuthToken, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token request failed with status %d", resp.StatusCode)
+	}
+
+	var tokenResp tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return nil, fmt.Errorf("decode token response: %w", err)
+	}
+	if !tokenResp.OK {
+		return nil, fmt.Errorf("token error: %s", tokenResp.Error)
+	}
+
+	grant := tokenResp.AuthedUser
+	if grant.AccessToken == "" {
+		grant = tokenResp.tokenGrant
+	}
+	if grant.AccessToken == "" {
+		return nil, fmt.Errorf("token response has no user access token")
+	}
+
+	token := &domain.OAuthToken{
+		AccessToken:  grant.AccessToken,
+		RefreshToken: grant.RefreshToken,
+		TokenType:    grant.TokenType,
+	}
+	if grant.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(grant.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
@@ -0,0 +1,101 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func testAuthProvider(tokenURL string) *domain.AuthProvider {
+	return &domain.AuthProvider{
+		OAuth: &domain.OAuthProviderConfig{
+			ClientID:     "client-id",
+			ClientSecret: "client-secret",
+			TokenURL:     tokenURL,
+		},
+	}
+}
+
+func TestOAuthHandler_DefaultConfig(t *testing.T) {
+	defaults := NewOAuthHandler().DefaultConfig()
+
+	assert.Equal(t, defaultAuthURL, defaults.AuthURL)
+	assert.Equal(t, defaultTokenURL, defaults.TokenURL)
+	assert.Equal(t, defaultScopes, defaults.Scopes)
+}
+
+func TestOAuthHandler_BuildAuthURL(t *testing.T) {
+	authURL := NewOAuthHandler().BuildAuthURL(
+		testAuthProvider(""), "http://localhost:18080/callback", "state-123", "challenge",
+	)
+
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, "slack.com", parsed.Host)
+	assert.Equal(t, "/oauth/v2/authorize", parsed.Path)
+
+	query := parsed.Query()
+	assert.Equal(t, "client-id", query.Get("client_id"))
+	assert.Equal(t, "state-123", query.Get("state"))
+	assert.Equal(t, "http://localhost:18080/callback", query.Get("redirect_uri"))
+	assert.Equal(t, "channels:read,channels:history,groups:read,groups:history,users:read", query.Get("user_scope"))
+	assert.Empty(t, query.Get("scope"), "scopes are requested for the user token, not a bot token")
+}
+
+func TestOAuthHandler_ExchangeCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "code-1", r.PostForm.Get("code"))
+		assert.Equal(t, "client-secret", r.PostForm.Get("client_secret"))
+		_, _ = w.Write([]byte(`{"ok":true,"access_token":"xoxb-bot","token_type":"bot",
+			"authed_user":{"id":"U1","access_token":"xoxp-user","refresh_token":"xoxe-1",
+			"token_type":"user","expires_in":43200}}`))
+	}))
+	defer server.Close()
+
+	token, err := NewOAuthHandler().ExchangeCode(
+		context.Background(), testAuthProvider(server.URL), "code-1", "http://localhost:18080/callback", "",
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "xoxp-user", token.AccessToken, "the user token is used, not the bot token")
+	assert.Equal(t, "xoxe-1", token.RefreshToken)
+	assert.False(t, token.Expiry.IsZero())
+}
+
+func TestOAuthHandler_ExchangeCode_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"ok":false,"error":"invalid_code"}`))
+	}))
+	defer server.Close()
+
+	_, err := NewOAuthHandler().ExchangeCode(
+		context.Background(), testAuthProvider(server.URL), "bad", "http://localhost:18080/callback", "",
+	)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid_code")
+}
+
+func TestOAuthHandler_RefreshToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "xoxe-1", r.PostForm.Get("refresh_token"))
+		_, _ = w.Write([]byte(`{"ok":true,"access_token":"xoxp-new","token_type":"user","expires_in":43200}`))
+	}))
+	defer server.Close()
+
+	token, err := NewOAuthHandler().RefreshToken(context.Background(), testAuthProvider(server.URL), "xoxe-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "xoxp-new", token.AccessToken)
+	assert.Equal(t, "xoxe-1", token.RefreshToken, "the old refresh token is kept when none is returned")
+}
@@ -0,0 +1,45 @@
+package slack
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter_Wait_ContextCancelled(t *testing.T) {
+	rl := NewRateLimiter()
+	rl.RecordRateLimitError(60)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // Cancel immediately
+
+	err := rl.Wait(ctx)
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRateLimiter_RecordRateLimitError(t *testing.T) {
+	rl := NewRateLimiter()
+	assert.True(t, rl.Allow())
+
+	rl.RecordRateLimitError(1)
+	assert.False(t, rl.Allow())
+
+	time.Sleep(1100 * time.Millisecond)
+	assert.True(t, rl.Allow())
+}
+
+func TestRateLimiter_RecordRateLimitError_DefaultBackoff(t *testing.T) {
+	rl := NewRateLimiter()
+
+	rl.RecordRateLimitError(0)
+
+	rl.mu.Lock()
+	retryAt := rl.retryAt
+	rl.mu.Unlock()
+
+	expectedRetry := time.Now().Add(DefaultBackoffSeconds * time.Second)
+	assert.WithinDuration(t, expectedRetry, retryAt, 2*time.Second)
+}
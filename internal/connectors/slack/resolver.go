@@ -0,0 +1,31 @@
+package slack
+
+import (
+	"strings"
+)
+
+// ResolveWebURL converts a Slack URI to a web URL for the user.
+// URI patterns:
+//   - slack://channels/{channel_id}
+//   - slack://channels/{channel_id}/messages/{ts}
+//
+// Returns empty string if the URI cannot be resolved.
+func ResolveWebURL(uri string, metadata map[string]any) string {
+	// Prefer the permalink recorded at sync time
+	if metadata != nil {
+		if url, ok := metadata["url"].(string); ok && url != "" {
+			return url
+		}
+	}
+
+	// Without the workspace URL, open the channel via Slack's redirect
+	rest, ok := strings.CutPrefix(uri, "slack://channels/")
+	if !ok {
+		return ""
+	}
+	channelID, _, _ := strings.Cut(rest, "/")
+	if channelID == "" {
+		return ""
+	}
+	return "https://slack.com/app_redirect?channel=" + channelID
+}
@@ -0,0 +1,40 @@
+// Package tracing records the page fetches of connector syncs as
+// OpenTelemetry spans.
+//
+// Page spans are children of the span in the context they start from, so a
+// sync's span shows each page its connector fetched and how long it took.
+// Until a tracer provider is installed the spans are no-ops.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies the spans recorded by connectors.
+const tracerName = "github.com/custodia-labs/sercha-cli/internal/connectors"
+
+// StartPage starts a span for fetching one page of a connector's resource,
+// e.g. StartPage(ctx, "github", "issues"). The returned context carries the
+// span and should be used for the fetch.
+func StartPage(ctx context.Context, connectorType, resource string) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, "fetch page",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("connector.type", connectorType),
+			attribute.String("page.resource", resource),
+		))
+}
+
+// EndPage ends a page span, recording the error that failed the fetch, if any.
+func EndPage(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,178 @@
+package trello
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mimeTypeMarkdown is the MIME type of documents emitted by this connector.
+// Cards and boards are rendered as Markdown so headings mark their sections.
+const mimeTypeMarkdown = "text/markdown"
+
+// EmittedMIMETypes returns the MIME types the Trello connector emits.
+func EmittedMIMETypes() []string {
+	return []string{mimeTypeMarkdown}
+}
+
+// BoardURI returns the internal URI of a board.
+func BoardURI(boardID string) string {
+	return fmt.Sprintf("trello://boards/%s", boardID)
+}
+
+// CardURI returns the internal URI of a card.
+func CardURI(cardID string) string {
+	return fmt.Sprintf("trello://cards/%s", cardID)
+}
+
+// BoardToRawDocument converts a Trello board and its lists to a RawDocument.
+func BoardToRawDocument(board *Board, lists []List, sourceID string) *domain.RawDocument {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", board.Name)
+	if desc := strings.TrimSpace(board.Desc); desc != "" {
+		fmt.Fprintf(&b, "\n%s\n", desc)
+	}
+
+	var listNames []string
+	for _, list := range lists {
+		if !list.Closed {
+			listNames = append(listNames, list.Name)
+		}
+	}
+	if len(listNames) > 0 {
+		b.WriteString("\n## Lists\n\n")
+		for _, name := range listNames {
+			fmt.Fprintf(&b, "- %s\n", name)
+		}
+	}
+
+	metadata := map[string]any{
+		"board_id": board.ID,
+		"title":    board.Name,
+		"lists":    listNames,
+	}
+	if board.URL != "" {
+		metadata["url"] = board.URL
+	}
+	if !board.DateLastActivity.IsZero() {
+		metadata["last_activity"] = board.DateLastActivity.Format(time.RFC3339)
+	}
+
+	return &domain.RawDocument{
+		SourceID: sourceID,
+		URI:      BoardURI(board.ID),
+		MIMEType: mimeTypeMarkdown,
+		Content:  []byte(b.String()),
+		Metadata: metadata,
+	}
+}
+
+// CardToRawDocument converts a Trello card to a RawDocument.
+// The content holds the description, checklists and comments.
+func CardToRawDocument(card *Card, board *Board, listName, sourceID string) *domain.RawDocument {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", card.Name)
+	if desc := strings.TrimSpace(card.Desc); desc != "" {
+		fmt.Fprintf(&b, "\n%s\n", desc)
+	}
+	writeChecklists(&b, card.Checklists)
+	comments := writeComments(&b, card.Actions)
+
+	metadata := map[string]any{
+		"card_id":       card.ID,
+		"title":         card.Name,
+		"board_id":      card.IDBoard,
+		"board":         board.Name,
+		"list_id":       card.IDList,
+		"list":          listName,
+		"archived":      card.Closed,
+		"last_activity": card.DateLastActivity.Format(time.RFC3339),
+	}
+	if card.URL != "" {
+		metadata["url"] = card.URL
+	}
+	if card.Due != nil {
+		metadata["due"] = card.Due.Format(time.RFC3339)
+	}
+	if labels := labelNames(card.Labels); len(labels) > 0 {
+		metadata["labels"] = labels
+	}
+	if comments > 0 {
+		metadata["comment_count"] = comments
+	}
+
+	parentURI := BoardURI(card.IDBoard)
+	return &domain.RawDocument{
+		SourceID:  sourceID,
+		URI:       CardURI(card.ID),
+		MIMEType:  mimeTypeMarkdown,
+		Content:   []byte(b.String()),
+		ParentURI: &parentURI,
+		Metadata:  metadata,
+	}
+}
+
+// writeChecklists renders checklists as Markdown task lists, in board order.
+func writeChecklists(b *strings.Builder, checklists []Checklist) {
+	sorted := append([]Checklist(nil), checklists...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Pos < sorted[j].Pos })
+
+	for _, checklist := range sorted {
+		fmt.Fprintf(b, "\n## %s\n\n", checklist.Name)
+
+		items := append([]CheckItem(nil), checklist.CheckItems...)
+		sort.SliceStable(items, func(i, j int) bool { return items[i].Pos < items[j].Pos })
+		for _, item := range items {
+			mark := " "
+			if item.IsComplete() {
+				mark = "x"
+			}
+			fmt.Fprintf(b, "- [%s] %s\n", mark, item.Name)
+		}
+	}
+}
+
+// writeComments renders comment actions oldest first and returns how many
+// were written. Trello returns actions newest first.
+func writeComments(b *strings.Builder, actions []Action) int {
+	var comments []Action
+	for _, action := range actions {
+		if action.Type == "commentCard" && strings.TrimSpace(action.Data.Text) != "" {
+			comments = append(comments, action)
+		}
+	}
+	if len(comments) == 0 {
+		return 0
+	}
+	sort.SliceStable(comments, func(i, j int) bool { return comments[i].Date.Before(comments[j].Date) })
+
+	b.WriteString("\n## Comments\n")
+	for _, comment := range comments {
+		author := comment.MemberCreator.FullName
+		if author == "" {
+			author = comment.MemberCreator.Username
+		}
+		fmt.Fprintf(b, "\n**%s** (%s):\n\n%s\n", author,
+			comment.Date.Format("2006-01-02"), strings.TrimSpace(comment.Data.Text))
+	}
+	return len(comments)
+}
+
+// labelNames returns the names of the labels, falling back to the colour
+// for unnamed labels.
+func labelNames(labels []Label) []string {
+	var names []string
+	for _, label := range labels {
+		name := label.Name
+		if name == "" {
+			name = label.Color
+		}
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
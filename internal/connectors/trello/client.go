@@ -0,0 +1,259 @@
+package trello
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+const (
+	// DefaultBaseURL is the Trello REST API base URL.
+	DefaultBaseURL = "https://api.trello.com/1"
+
+	// DefaultTimeout is the default HTTP request timeout.
+	DefaultTimeout = 30 * time.Second
+
+	// Trello allows 100 requests per 10 seconds per token.
+	// See: https://developer.atlassian.com/cloud/trello/guides/rest-api/rate-limits/
+	requestsPerSecond = 10.0
+	burstSize         = 10
+)
+
+// Card fields requested from the API.
+const cardFields = "id,name,desc,idBoard,idList,url,shortUrl,dateLastActivity,closed,due,labels"
+
+// Client is a minimal Trello REST API client.
+type Client struct {
+	baseURL       string
+	apiKey        string
+	tokenProvider driven.TokenProvider
+	httpClient    *http.Client
+	limiter       *rate.Limiter
+}
+
+// NewClient creates a new Trello API client.
+func NewClient(apiKey string, tokenProvider driven.TokenProvider) *Client {
+	return &Client{
+		baseURL:       DefaultBaseURL,
+		apiKey:        apiKey,
+		tokenProvider: tokenProvider,
+		httpClient:    &http.Client{Timeout: DefaultTimeout},
+		limiter:       rate.NewLimiter(rate.Limit(requestsPerSecond), burstSize),
+	}
+}
+
+// Member is a Trello member (user).
+type Member struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	FullName string `json:"fullName"`
+}
+
+// Board is a Trello board.
+type Board struct {
+	ID               string    `json:"id"`
+	Name             string    `json:"name"`
+	Desc             string    `json:"desc"`
+	URL              string    `json:"url"`
+	Closed           bool      `json:"closed"`
+	DateLastActivity time.Time `json:"dateLastActivity"`
+}
+
+// List is a column on a Trello board.
+type List struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Closed bool   `json:"closed"`
+}
+
+// Label is a coloured tag on a card.
+type Label struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// CheckItem is a single item on a checklist.
+type CheckItem struct {
+	ID    string  `json:"id"`
+	Name  string  `json:"name"`
+	State string  `json:"state"`
+	Pos   float64 `json:"pos"`
+}
+
+// IsComplete returns true if the item is checked.
+func (i CheckItem) IsComplete() bool {
+	return i.State == "complete"
+}
+
+// Checklist is a named list of check items on a card.
+type Checklist struct {
+	ID         string      `json:"id"`
+	Name       string      `json:"name"`
+	Pos        float64     `json:"pos"`
+	CheckItems []CheckItem `json:"checkItems"`
+}
+
+// Action is a Trello action. Only comment actions are fetched.
+type Action struct {
+	ID   string    `json:"id"`
+	Type string    `json:"type"`
+	Date time.Time `json:"date"`
+	Data struct {
+		Text string `json:"text"`
+	} `json:"data"`
+	MemberCreator Member `json:"memberCreator"`
+}
+
+// Card is a Trello card with its nested checklists and comments.
+type Card struct {
+	ID               string      `json:"id"`
+	Name             string      `json:"name"`
+	Desc             string      `json:"desc"`
+	IDBoard          string      `json:"idBoard"`
+	IDList           string      `json:"idList"`
+	URL              string      `json:"url"`
+	ShortURL         string      `json:"shortUrl"`
+	DateLastActivity time.Time   `json:"dateLastActivity"`
+	Closed           bool        `json:"closed"`
+	Due              *time.Time  `json:"due"`
+	Labels           []Label     `json:"labels"`
+	Checklists       []Checklist `json:"checklists"`
+	Actions          []Action    `json:"actions"`
+}
+
+// Me returns the authenticated member.
+func (c *Client) Me(ctx context.Context) (*Member, error) {
+	var member Member
+	params := url.Values{"fields": {"id,username,fullName"}}
+	if err := c.get(ctx, "/members/me", params, &member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// Boards returns the open boards of the authenticated member.
+func (c *Client) Boards(ctx context.Context) ([]Board, error) {
+	var boards []Board
+	params := url.Values{
+		"filter": {"open"},
+		"fields": {"id,name,desc,url,closed,dateLastActivity"},
+	}
+	if err := c.get(ctx, "/members/me/boards", params, &boards); err != nil {
+		return nil, err
+	}
+	return boards, nil
+}
+
+// Board returns a single board.
+func (c *Client) Board(ctx context.Context, boardID string) (*Board, error) {
+	var board Board
+	params := url.Values{"fields": {"id,name,desc,url,closed,dateLastActivity"}}
+	if err := c.get(ctx, "/boards/"+url.PathEscape(boardID), params, &board); err != nil {
+		return nil, err
+	}
+	return &board, nil
+}
+
+// Lists returns all lists on a board, including archived ones, so cards
+// can always be attributed to their list.
+func (c *Client) Lists(ctx context.Context, boardID string) ([]List, error) {
+	var lists []List
+	params := url.Values{
+		"filter": {"all"},
+		"fields": {"id,name,closed"},
+	}
+	if err := c.get(ctx, "/boards/"+url.PathEscape(boardID)+"/lists", params, &lists); err != nil {
+		return nil, err
+	}
+	return lists, nil
+}
+
+// Cards returns the cards on a board with their checklists and comments
+// nested, so a board is fetched in a single request.
+func (c *Client) Cards(ctx context.Context, boardID string, cfg *Config) ([]Card, error) {
+	filter := "open"
+	if cfg.IncludeArchived {
+		filter = "all"
+	}
+
+	params := url.Values{"fields": {cardFields}}
+	if cfg.IncludeChecklists {
+		params.Set("checklists", "all")
+	}
+	if cfg.IncludeComments {
+		params.Set("actions", "commentCard")
+		params.Set("action_memberCreator_fields", "username,fullName")
+	}
+
+	var cards []Card
+	path := "/boards/" + url.PathEscape(boardID) + "/cards/" + filter
+	if err := c.get(ctx, path, params, &cards); err != nil {
+		return nil, err
+	}
+	return cards, nil
+}
+
+// get performs an authenticated GET request and decodes the JSON response.
+func (c *Client) get(ctx context.Context, path string, params url.Values, out any) error {
+	token, err := c.tokenProvider.GetToken(ctx)
+	if err != nil {
+		return fmt.Errorf("get token: %w", err)
+	}
+	return c.getWithToken(ctx, token, path, params, out)
+}
+
+// getWithToken performs a GET request with an explicit user token.
+func (c *Client) getWithToken(ctx context.Context, token, path string, params url.Values, out any) error {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	reqURL := c.baseURL + path
+	if len(params) > 0 {
+		reqURL += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	// Credentials go in the header rather than the query string so they
+	// never appear in logged URLs.
+	req.Header.Set("Authorization",
+		fmt.Sprintf(`OAuth oauth_consumer_key=%q, oauth_token=%q`, c.apiKey, token))
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return fmt.Errorf("%s: %w", path, domain.ErrAuthInvalid)
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return fmt.Errorf("%s: %w", path, domain.ErrRateLimited)
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("%s: status %d: %s", path, resp.StatusCode, string(body))
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("decode %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package trello
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// ErrMissingAPIKey indicates the source has no Trello API key configured.
+var ErrMissingAPIKey = errors.New("trello: api_key is required")
+
+// Config holds Trello connector configuration.
+type Config struct {
+	// APIKey is the Trello application key. The user token is supplied
+	// separately as the source's personal access token.
+	APIKey string
+	// BoardIDs limits syncing to specific boards (optional).
+	// If empty, all open boards of the authenticated member are synced.
+	BoardIDs []string
+	// IncludeComments fetches card comments (default: true).
+	IncludeComments bool
+	// IncludeChecklists fetches card checklists (default: true).
+	IncludeChecklists bool
+	// IncludeArchived includes archived cards (default: false).
+	IncludeArchived bool
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		IncludeComments:   true,
+		IncludeChecklists: true,
+	}
+}
+
+// ParseConfig extracts configuration from a Source.
+func ParseConfig(source domain.Source) (*Config, error) {
+	cfg := DefaultConfig()
+
+	// Parse api_key
+	cfg.APIKey = strings.TrimSpace(source.Config["api_key"])
+	if cfg.APIKey == "" {
+		return nil, ErrMissingAPIKey
+	}
+
+	// Parse boards
+	if val := source.Config["boards"]; val != "" {
+		for _, id := range strings.Split(val, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				cfg.BoardIDs = append(cfg.BoardIDs, id)
+			}
+		}
+	}
+
+	// Parse include_comments
+	if val := source.Config["include_comments"]; val != "" {
+		cfg.IncludeComments = val == "true" || val == "1"
+	}
+
+	// Parse include_checklists
+	if val := source.Config["include_checklists"]; val != "" {
+		cfg.IncludeChecklists = val == "true" || val == "1"
+	}
+
+	// Parse include_archived
+	if val := source.Config["include_archived"]; val != "" {
+		cfg.IncludeArchived = val == "true" || val == "1"
+	}
+
+	return cfg, nil
+}
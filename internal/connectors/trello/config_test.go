@@ -0,0 +1,53 @@
+package trello
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestParseConfig(t *testing.T) {
+	source := domain.Source{Config: map[string]string{
+		"api_key":          " key-123 ",
+		"boards":           "b1, b2,,",
+		"include_comments": "false",
+		"include_archived": "true",
+	}}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, "key-123", cfg.APIKey)
+	assert.Equal(t, []string{"b1", "b2"}, cfg.BoardIDs)
+	assert.False(t, cfg.IncludeComments)
+	assert.True(t, cfg.IncludeChecklists)
+	assert.True(t, cfg.IncludeArchived)
+}
+
+func TestParseConfig_MissingAPIKey(t *testing.T) {
+	_, err := ParseConfig(domain.Source{Config: map[string]string{}})
+	assert.ErrorIs(t, err, ErrMissingAPIKey)
+}
+
+func TestResolveWebURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		uri      string
+		metadata map[string]any
+		want     string
+	}{
+		{"metadata url", "trello://cards/c1", map[string]any{"url": "https://trello.com/c/abc/1-card"}, "https://trello.com/c/abc/1-card"},
+		{"card", "trello://cards/c1", nil, "https://trello.com/c/c1"},
+		{"board", "trello://boards/b1", nil, "https://trello.com/b/b1"},
+		{"unknown", "notion://pages/p1", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ResolveWebURL(tt.uri, tt.metadata))
+		})
+	}
+}
@@ -0,0 +1,414 @@
+package trello
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/connectors/budget"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/locale"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Connector implements the interfaces.
+var (
+	_ driven.Connector      = (*Connector)(nil)
+	_ driven.APIBudgetAware = (*Connector)(nil)
+)
+
+// Connector fetches boards, lists and cards from Trello.
+type Connector struct {
+	sourceID      string
+	config        *Config
+	tokenProvider driven.TokenProvider
+	client        *Client
+	mu            sync.Mutex
+	closed        bool
+}
+
+// New creates a new Trello connector.
+func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Connector {
+	return &Connector{
+		sourceID:      sourceID,
+		config:        cfg,
+		tokenProvider: tokenProvider,
+		client:        NewClient(cfg.APIKey, tokenProvider),
+	}
+}
+
+// SetAPIBudget charges the connector's API requests to a shared budget.
+func (c *Connector) SetAPIBudget(apiBudget driven.APIBudget) {
+	c.client.httpClient.Transport = budget.Transport(nil, apiBudget)
+}
+
+// SetLocale sends locale as Accept-Language on the connector's API requests.
+func (c *Connector) SetLocale(loc string) {
+	c.client.httpClient.Transport = locale.Transport(c.client.httpClient.Transport, loc)
+}
+
+// Type returns the connector type identifier.
+func (c *Connector) Type() string {
+	return "trello"
+}
+
+// SourceID returns the source identifier.
+func (c *Connector) SourceID() string {
+	return c.sourceID
+}
+
+// Capabilities returns the connector's capabilities.
+func (c *Connector) Capabilities() driven.ConnectorCapabilities {
+	return driven.ConnectorCapabilities{
+		SupportsIncremental:  true,
+		SupportsWatch:        false,
+		SupportsHierarchy:    true,
+		SupportsBinary:       false,
+		RequiresAuth:         true,
+		SupportsValidation:   true,
+		SupportsCursorReturn: true,
+		SupportsPartialSync:  true,
+		SupportsRateLimiting: true,
+		SupportsPagination:   false,
+	}
+}
+
+// Validate checks if the Trello connector is properly configured.
+func (c *Connector) Validate(ctx context.Context) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if _, err := c.client.Me(ctx); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrAuthRequired, err)
+	}
+	return nil
+}
+
+// FullSync fetches all cards from the configured boards.
+func (c *Connector) FullSync(ctx context.Context) (
+	docs <-chan domain.RawDocument, errs <-chan error,
+) {
+	docsChan := make(chan domain.RawDocument)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(docsChan)
+		defer close(errsChan)
+		errsChan <- c.runFullSync(ctx, docsChan)
+	}()
+
+	return docsChan, errsChan
+}
+
+// runFullSync executes the full sync logic.
+func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawDocument) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+	slog.Debug("full sync started", slog.String("connector", c.Type()), slog.String("source_id", c.sourceID))
+
+	boards, err := c.boards(ctx)
+	if err != nil {
+		return err
+	}
+
+	cursor := NewCursor()
+	for i := range boards {
+		board := &boards[i]
+		content, err := c.fetchBoard(ctx, board.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := c.sendDocument(ctx, docsChan, BoardToRawDocument(board, content.lists, c.sourceID)); err != nil {
+			return err
+		}
+
+		for j := range content.cards {
+			card := &content.cards[j]
+			doc := CardToRawDocument(card, board, content.listNames[card.IDList], c.sourceID)
+			if err := c.sendDocument(ctx, docsChan, doc); err != nil {
+				return err
+			}
+		}
+
+		cursor.SetBoard(board.ID, content.state())
+	}
+
+	return &driven.SyncComplete{NewCursor: cursor.Encode()}
+}
+
+// IncrementalSync fetches cards with activity since the last sync.
+func (c *Connector) IncrementalSync(
+	ctx context.Context, state domain.SyncState,
+) (changes <-chan domain.RawDocumentChange, errs <-chan error) {
+	changesChan := make(chan domain.RawDocumentChange)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(changesChan)
+		defer close(errsChan)
+		errsChan <- c.runIncrementalSync(ctx, state, changesChan)
+	}()
+
+	return changesChan, errsChan
+}
+
+// runIncrementalSync executes the incremental sync logic.
+// Trello cannot filter cards by activity date, so each board's cards are
+// listed and only those active since the board's cursor are emitted.
+func (c *Connector) runIncrementalSync(
+	ctx context.Context, state domain.SyncState, changesChan chan<- domain.RawDocumentChange,
+) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	cursor, err := DecodeCursor(state.Cursor)
+	if err != nil {
+		return fmt.Errorf("invalid cursor, full sync required: %w", err)
+	}
+	if cursor.IsEmpty() {
+		return fmt.Errorf("invalid cursor, full sync required: cursor has no boards")
+	}
+
+	boards, err := c.boards(ctx)
+	if err != nil {
+		return err
+	}
+
+	next := NewCursor()
+	for i := range boards {
+		board := &boards[i]
+		content, err := c.fetchBoard(ctx, board.ID)
+		if err != nil {
+			return err
+		}
+
+		previous, known := cursor.Boards[board.ID]
+		if err := c.syncBoardChanges(ctx, board, content, previous, known, changesChan); err != nil {
+			return err
+		}
+		next.SetBoard(board.ID, content.state())
+	}
+
+	// Boards no longer synced (deleted, closed or removed from config)
+	for boardID, previous := range cursor.Boards {
+		if _, ok := next.Boards[boardID]; ok {
+			continue
+		}
+		if err := c.sendDeleted(ctx, changesChan, BoardURI(boardID)); err != nil {
+			return err
+		}
+		for _, cardID := range previous.Cards {
+			if err := c.sendDeleted(ctx, changesChan, CardURI(cardID)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return &driven.SyncComplete{NewCursor: next.Encode()}
+}
+
+// syncBoardChanges emits the changes to a board since its previous state.
+func (c *Connector) syncBoardChanges(
+	ctx context.Context,
+	board *Board,
+	content *boardContent,
+	previous BoardCursor,
+	known bool,
+	changesChan chan<- domain.RawDocumentChange,
+) error {
+	if !known || board.DateLastActivity.After(previous.LastActivity) {
+		changeType := domain.ChangeUpdated
+		if !known {
+			changeType = domain.ChangeCreated
+		}
+		doc := BoardToRawDocument(board, content.lists, c.sourceID)
+		if err := c.sendChange(ctx, changesChan, changeType, doc); err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[string]bool, len(previous.Cards))
+	for _, id := range previous.Cards {
+		seen[id] = true
+	}
+
+	for i := range content.cards {
+		card := &content.cards[i]
+		isNew := !seen[card.ID]
+		delete(seen, card.ID)
+
+		// New cards are always emitted, even if their activity is not
+		// after the cursor, so cards created mid-sync are not missed
+		if !isNew && !card.DateLastActivity.After(previous.LastActivity) {
+			continue
+		}
+
+		changeType := domain.ChangeUpdated
+		if isNew {
+			changeType = domain.ChangeCreated
+		}
+		doc := CardToRawDocument(card, board, content.listNames[card.IDList], c.sourceID)
+		if err := c.sendChange(ctx, changesChan, changeType, doc); err != nil {
+			return err
+		}
+	}
+
+	// Cards no longer listed were deleted or archived
+	for _, id := range previous.Cards {
+		if !seen[id] {
+			continue
+		}
+		if err := c.sendDeleted(ctx, changesChan, CardURI(id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// boardContent holds the lists and cards fetched for a board.
+type boardContent struct {
+	lists     []List
+	listNames map[string]string
+	cards     []Card
+}
+
+// state returns the cursor state after syncing the board.
+func (b *boardContent) state() BoardCursor {
+	var state BoardCursor
+	for i := range b.cards {
+		card := &b.cards[i]
+		state.Cards = append(state.Cards, card.ID)
+		if card.DateLastActivity.After(state.LastActivity) {
+			state.LastActivity = card.DateLastActivity
+		}
+	}
+	return state
+}
+
+// boards returns the boards to sync: the configured boards, or every open
+// board of the authenticated member.
+func (c *Connector) boards(ctx context.Context) ([]Board, error) {
+	if len(c.config.BoardIDs) == 0 {
+		pageCtx, span := tracing.StartPage(ctx, "trello", "boards")
+		boards, err := c.client.Boards(pageCtx)
+		tracing.EndPage(span, err)
+		if err != nil {
+			return nil, fmt.Errorf("list boards: %w", err)
+		}
+		return boards, nil
+	}
+
+	boards := make([]Board, 0, len(c.config.BoardIDs))
+	for _, id := range c.config.BoardIDs {
+		board, err := c.client.Board(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("get board %s: %w", id, err)
+		}
+		boards = append(boards, *board)
+	}
+	return boards, nil
+}
+
+// fetchBoard fetches the lists and cards of a board.
+func (c *Connector) fetchBoard(ctx context.Context, boardID string) (*boardContent, error) {
+	pageCtx, span := tracing.StartPage(ctx, "trello", "lists")
+	lists, err := c.client.Lists(pageCtx, boardID)
+	tracing.EndPage(span, err)
+	if err != nil {
+		return nil, fmt.Errorf("list lists of board %s: %w", boardID, err)
+	}
+
+	pageCtx, span = tracing.StartPage(ctx, "trello", "cards")
+	cards, err := c.client.Cards(pageCtx, boardID, c.config)
+	tracing.EndPage(span, err)
+	if err != nil {
+		return nil, fmt.Errorf("list cards of board %s: %w", boardID, err)
+	}
+
+	listNames := make(map[string]string, len(lists))
+	for _, list := range lists {
+		listNames[list.ID] = list.Name
+	}
+
+	return &boardContent{lists: lists, listNames: listNames, cards: cards}, nil
+}
+
+// sendDocument sends a document to the channel or returns on context cancellation.
+func (c *Connector) sendDocument(
+	ctx context.Context, docsChan chan<- domain.RawDocument, doc *domain.RawDocument,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case docsChan <- *doc:
+		return nil
+	}
+}
+
+// sendChange sends a created or updated document to the channel.
+func (c *Connector) sendChange(
+	ctx context.Context,
+	changesChan chan<- domain.RawDocumentChange,
+	changeType domain.ChangeType,
+	doc *domain.RawDocument,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case changesChan <- domain.RawDocumentChange{Type: changeType, Document: *doc}:
+		return nil
+	}
+}
+
+// sendDeleted sends a deletion for the document with the given URI.
+func (c *Connector) sendDeleted(
+	ctx context.Context, changesChan chan<- domain.RawDocumentChange, uri string,
+) error {
+	doc := &domain.RawDocument{SourceID: c.sourceID, URI: uri}
+	return c.sendChange(ctx, changesChan, domain.ChangeDeleted, doc)
+}
+
+// checkClosed returns an error if the connector is closed.
+func (c *Connector) checkClosed() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return domain.ErrConnectorClosed
+	}
+	return nil
+}
+
+// Watch is not supported for Trello.
+func (c *Connector) Watch(_ context.Context) (<-chan domain.RawDocumentChange, error) {
+	return nil, domain.ErrNotImplemented
+}
+
+// GetAccountIdentifier returns the Trello username for the given token.
+func (c *Connector) GetAccountIdentifier(ctx context.Context, accessToken string) (string, error) {
+	var member Member
+	params := url.Values{"fields": {"username"}}
+	if err := c.client.getWithToken(ctx, accessToken, "/members/me", params, &member); err != nil {
+		return "", err
+	}
+	return member.Username, nil
+}
+
+// Close releases resources.
+func (c *Connector) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
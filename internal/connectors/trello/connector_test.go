@@ -0,0 +1,276 @@
+package trello
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// mockTokenProvider implements driven.TokenProvider for testing.
+type mockTokenProvider struct {
+	token string
+}
+
+func (m *mockTokenProvider) GetToken(_ context.Context) (string, error) {
+	return m.token, nil
+}
+
+func (m *mockTokenProvider) AuthorizationID() string {
+	return "creds-1"
+}
+
+func (m *mockTokenProvider) AuthMethod() domain.AuthMethod {
+	return domain.AuthMethodPAT
+}
+
+func (m *mockTokenProvider) IsAuthenticated() bool {
+	return m.token != ""
+}
+
+func (m *mockTokenProvider) RefreshIfNeeded(_ context.Context) error {
+	return nil
+}
+
+// stubTrello serves a single board from the Trello REST API.
+type stubTrello struct {
+	mu    sync.Mutex
+	cards []map[string]any
+	auth  []string
+}
+
+func (s *stubTrello) setCards(cards ...map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cards = cards
+}
+
+func (s *stubTrello) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.auth = append(s.auth, r.Header.Get("Authorization"))
+
+	var body any
+	switch r.URL.Path {
+	case "/members/me":
+		body = map[string]any{"id": "m1", "username": "octo", "fullName": "Octo Cat"}
+	case "/members/me/boards":
+		body = []map[string]any{{
+			"id": "b1", "name": "Roadmap", "desc": "Product roadmap",
+			"url": "https://trello.com/b/abc/roadmap", "dateLastActivity": "2024-03-01T12:00:00.000Z",
+		}}
+	case "/boards/b1/lists":
+		body = []map[string]any{
+			{"id": "l1", "name": "Doing", "closed": false},
+			{"id": "l2", "name": "Done", "closed": false},
+		}
+	case "/boards/b1/cards/open":
+		body = s.cards
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func card(id, name, lastActivity string) map[string]any {
+	return map[string]any{
+		"id": id, "name": name, "idBoard": "b1", "idList": "l1",
+		"url": "https://trello.com/c/" + id, "dateLastActivity": lastActivity,
+	}
+}
+
+func newTestConnector(t *testing.T, stub *stubTrello) *Connector {
+	t.Helper()
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.APIKey = "key-123"
+	conn := New("source-1", cfg, &mockTokenProvider{token: "token-456"})
+	conn.client.baseURL = server.URL
+	return conn
+}
+
+func collectDocs(docs <-chan domain.RawDocument, errs <-chan error) ([]domain.RawDocument, error) {
+	var collected []domain.RawDocument
+	for doc := range docs {
+		collected = append(collected, doc)
+	}
+	return collected, <-errs
+}
+
+func collectChanges(changes <-chan domain.RawDocumentChange, errs <-chan error) ([]domain.RawDocumentChange, error) {
+	var collected []domain.RawDocumentChange
+	for change := range changes {
+		collected = append(collected, change)
+	}
+	return collected, <-errs
+}
+
+func syncCursor(t *testing.T, err error) string {
+	t.Helper()
+	var complete *driven.SyncComplete
+	require.True(t, errors.As(err, &complete), "expected SyncComplete, got %v", err)
+	return complete.NewCursor
+}
+
+func TestConnector_Basics(t *testing.T) {
+	conn := New("source-1", &Config{APIKey: "key"}, nil)
+
+	assert.Equal(t, "trello", conn.Type())
+	assert.Equal(t, "source-1", conn.SourceID())
+	assert.True(t, conn.Capabilities().SupportsIncremental)
+	assert.True(t, conn.Capabilities().RequiresAuth)
+
+	_, err := conn.Watch(context.Background())
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+
+	require.NoError(t, conn.Close())
+	assert.ErrorIs(t, conn.Validate(context.Background()), domain.ErrConnectorClosed)
+}
+
+func TestConnector_FullSync_EmitsCards(t *testing.T) {
+	stub := &stubTrello{}
+	full := card("c1", "Ship search", "2024-03-01T10:00:00.000Z")
+	full["desc"] = "Wire up the keyword index."
+	full["labels"] = []map[string]any{{"name": "backend", "color": "green"}}
+	full["checklists"] = []map[string]any{{
+		"id": "cl1", "name": "Tasks", "pos": 1,
+		"checkItems": []map[string]any{
+			{"id": "i2", "name": "Add tests", "state": "incomplete", "pos": 2},
+			{"id": "i1", "name": "Write indexer", "state": "complete", "pos": 1},
+		},
+	}}
+	full["actions"] = []map[string]any{
+		{
+			"id": "a2", "type": "commentCard", "date": "2024-02-02T09:00:00.000Z",
+			"data": map[string]any{"text": "Done on my side."}, "memberCreator": map[string]any{"fullName": "Bea"},
+		},
+		{
+			"id": "a1", "type": "commentCard", "date": "2024-02-01T09:00:00.000Z",
+			"data": map[string]any{"text": "Who owns this?"}, "memberCreator": map[string]any{"fullName": "Ann"},
+		},
+	}
+	stub.setCards(full, card("c2", "Plain card", "2024-03-01T11:00:00.000Z"))
+	conn := newTestConnector(t, stub)
+
+	docs, err := collectDocs(conn.FullSync(context.Background()))
+	cursor := syncCursor(t, err)
+
+	require.Len(t, docs, 3)
+	assert.Equal(t, "trello://boards/b1", docs[0].URI)
+	assert.Contains(t, string(docs[0].Content), "- Doing")
+
+	doc := docs[1]
+	assert.Equal(t, "trello://cards/c1", doc.URI)
+	assert.Equal(t, "text/markdown", doc.MIMEType)
+	require.NotNil(t, doc.ParentURI)
+	assert.Equal(t, "trello://boards/b1", *doc.ParentURI)
+	assert.Equal(t, "Roadmap", doc.Metadata["board"])
+	assert.Equal(t, "Doing", doc.Metadata["list"])
+	assert.Equal(t, []string{"backend"}, doc.Metadata["labels"])
+	assert.Equal(t, 2, doc.Metadata["comment_count"])
+
+	content := string(doc.Content)
+	assert.True(t, strings.HasPrefix(content, "# Ship search\n"))
+	assert.Contains(t, content, "Wire up the keyword index.")
+	assert.Contains(t, content, "## Tasks\n\n- [x] Write indexer\n- [ ] Add tests\n")
+	assert.Less(t, strings.Index(content, "Who owns this?"), strings.Index(content, "Done on my side."),
+		"comments should be oldest first")
+	assert.Equal(t, "trello://cards/c2", docs[2].URI)
+
+	decoded, err := DecodeCursor(cursor)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-01T11:00:00Z", decoded.Board("b1").LastActivity.UTC().Format("2006-01-02T15:04:05Z"))
+	assert.ElementsMatch(t, []string{"c1", "c2"}, decoded.Board("b1").Cards)
+
+	for _, auth := range stub.auth {
+		assert.Equal(t, `OAuth oauth_consumer_key="key-123", oauth_token="token-456"`, auth)
+	}
+}
+
+func TestConnector_IncrementalSync_FiltersByLastActivity(t *testing.T) {
+	stub := &stubTrello{}
+	stub.setCards(
+		card("c1", "Unchanged", "2024-03-01T10:00:00.000Z"),
+		card("c2", "Edited", "2024-03-01T11:00:00.000Z"),
+		card("c3", "Removed", "2024-03-01T09:00:00.000Z"),
+	)
+	conn := newTestConnector(t, stub)
+	_, err := collectDocs(conn.FullSync(context.Background()))
+	cursor := syncCursor(t, err)
+
+	stub.setCards(
+		card("c1", "Unchanged", "2024-03-01T10:00:00.000Z"),
+		card("c2", "Edited again", "2024-03-02T08:00:00.000Z"),
+		card("c4", "Brand new", "2024-03-02T09:00:00.000Z"),
+	)
+	changes, err := collectChanges(conn.IncrementalSync(context.Background(), domain.SyncState{Cursor: cursor}))
+	next := syncCursor(t, err)
+
+	byURI := make(map[string]domain.RawDocumentChange)
+	for _, change := range changes {
+		byURI[change.Document.URI] = change
+	}
+
+	assert.NotContains(t, byURI, "trello://cards/c1", "cards without new activity should be skipped")
+	assert.Equal(t, domain.ChangeUpdated, byURI["trello://cards/c2"].Type)
+	assert.Contains(t, string(byURI["trello://cards/c2"].Document.Content), "# Edited again")
+	assert.Equal(t, domain.ChangeCreated, byURI["trello://cards/c4"].Type)
+	assert.Equal(t, domain.ChangeDeleted, byURI["trello://cards/c3"].Type)
+
+	// A further sync with no activity emits no cards
+	changes, err = collectChanges(conn.IncrementalSync(context.Background(), domain.SyncState{Cursor: next}))
+	syncCursor(t, err)
+	for _, change := range changes {
+		assert.False(t, strings.HasPrefix(change.Document.URI, "trello://cards/"), "unexpected change %s", change.Document.URI)
+	}
+}
+
+func TestConnector_IncrementalSync_RequiresCursor(t *testing.T) {
+	conn := newTestConnector(t, &stubTrello{})
+
+	_, errs := conn.IncrementalSync(context.Background(), domain.SyncState{})
+
+	err := <-errs
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "full sync required")
+}
+
+func TestConnector_Validate(t *testing.T) {
+	conn := newTestConnector(t, &stubTrello{})
+	assert.NoError(t, conn.Validate(context.Background()))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+	conn.client.baseURL = server.URL
+
+	err := conn.Validate(context.Background())
+	assert.ErrorIs(t, err, domain.ErrAuthRequired)
+	assert.ErrorIs(t, err, domain.ErrAuthInvalid)
+}
+
+func TestConnector_GetAccountIdentifier(t *testing.T) {
+	stub := &stubTrello{}
+	conn := newTestConnector(t, stub)
+
+	username, err := conn.GetAccountIdentifier(context.Background(), "other-token")
+
+	require.NoError(t, err)
+	assert.Equal(t, "octo", username)
+	assert.Contains(t, stub.auth[0], `oauth_token="other-token"`)
+}
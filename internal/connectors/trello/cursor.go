@@ -0,0 +1,94 @@
+package trello
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// CursorVersion is the current cursor format version.
+const CursorVersion = 1
+
+// ErrInvalidCursor indicates the cursor could not be decoded.
+var ErrInvalidCursor = errors.New("trello: invalid cursor format")
+
+// Cursor tracks Trello sync state per board.
+// Trello has no change feed, so each sync lists a board's cards and emits
+// those whose dateLastActivity is after the board's cursor.
+type Cursor struct {
+	// Version is the cursor format version for future compatibility.
+	Version int `json:"v"`
+	// Boards maps board ID to its sync state.
+	Boards map[string]BoardCursor `json:"boards"`
+}
+
+// BoardCursor tracks the sync state of a single board.
+type BoardCursor struct {
+	// LastActivity is the latest card dateLastActivity seen on the board.
+	LastActivity time.Time `json:"last_activity"`
+	// Cards holds the IDs of the cards indexed from the board, so cards
+	// that disappear (deleted or archived) can be removed.
+	Cards []string `json:"cards,omitempty"`
+}
+
+// NewCursor creates a new empty cursor.
+func NewCursor() *Cursor {
+	return &Cursor{
+		Version: CursorVersion,
+		Boards:  make(map[string]BoardCursor),
+	}
+}
+
+// Encode serialises the cursor to a base64 string for storage.
+func (c *Cursor) Encode() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// DecodeCursor deserialises a cursor from a base64 string.
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return NewCursor(), nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	// Version check for future migrations
+	if cursor.Version > CursorVersion {
+		return nil, ErrInvalidCursor
+	}
+
+	// Ensure map is initialised
+	if cursor.Boards == nil {
+		cursor.Boards = make(map[string]BoardCursor)
+	}
+
+	return &cursor, nil
+}
+
+// IsEmpty returns true if the cursor has no tracked boards.
+func (c *Cursor) IsEmpty() bool {
+	return len(c.Boards) == 0
+}
+
+// Board returns the state for a board, or a zero state if not tracked.
+func (c *Cursor) Board(boardID string) BoardCursor {
+	return c.Boards[boardID]
+}
+
+// SetBoard updates the state for a board.
+func (c *Cursor) SetBoard(boardID string, state BoardCursor) {
+	c.Boards[boardID] = state
+}
@@ -0,0 +1,30 @@
+package trello
+
+import (
+	"strings"
+)
+
+// ResolveWebURL converts a Trello URI to a web URL for the user.
+// URI patterns:
+//   - trello://boards/{board_id}
+//   - trello://cards/{card_id}
+//
+// Returns empty string if the URI cannot be resolved.
+func ResolveWebURL(uri string, metadata map[string]any) string {
+	const baseURL = "https://trello.com"
+
+	// Prefer the URL recorded by the API
+	if metadata != nil {
+		if url, ok := metadata["url"].(string); ok && url != "" {
+			return url
+		}
+	}
+
+	if id, ok := strings.CutPrefix(uri, "trello://boards/"); ok && id != "" {
+		return baseURL + "/b/" + id
+	}
+	if id, ok := strings.CutPrefix(uri, "trello://cards/"); ok && id != "" {
+		return baseURL + "/c/" + id
+	}
+	return ""
+}
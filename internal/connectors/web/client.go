@@ -0,0 +1,112 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// userAgent identifies the crawler to the sites it visits.
+const userAgent = "sercha (+https://github.com/custodia-labs/sercha-cli)"
+
+// maxBodyBytes is the most of a response body that is read.
+const maxBodyBytes = 10 << 20
+
+// requestTimeout bounds a single request.
+const requestTimeout = 30 * time.Second
+
+// response is the outcome of fetching a URL.
+type response struct {
+	// status is the HTTP status code.
+	status int
+	// url is the URL the response came from, after redirects.
+	url *url.URL
+	// mediaType is the media type of the body, without parameters.
+	mediaType string
+	body      []byte
+	// etag and lastModified are the response's validators.
+	etag         string
+	lastModified string
+}
+
+// fetcher makes the crawler's HTTP requests, pausing between them.
+type fetcher struct {
+	client *http.Client
+	delay  time.Duration
+	last   time.Time
+}
+
+// get fetches u. If state holds validators from an earlier response, the
+// request is conditional and an unchanged page returns 304 Not Modified.
+func (f *fetcher) get(ctx context.Context, u *url.URL, state *PageState) (*response, error) {
+	if err := f.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("web: create request: %w", err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.1")
+	if state != nil {
+		if state.ETag != "" {
+			req.Header.Set("If-None-Match", state.ETag)
+		}
+		if state.LastModified != "" {
+			req.Header.Set("If-Modified-Since", state.LastModified)
+		}
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("web: get %s: %w", u, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodyBytes))
+	if err != nil {
+		return nil, fmt.Errorf("web: read %s: %w", u, err)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	return &response{
+		status:       resp.StatusCode,
+		url:          normaliseURL(resp.Request.URL),
+		mediaType:    mediaType,
+		body:         body,
+		etag:         resp.Header.Get("ETag"),
+		lastModified: resp.Header.Get("Last-Modified"),
+	}, nil
+}
+
+// wait pauses until the politeness delay has passed since the last request.
+func (f *fetcher) wait(ctx context.Context) error {
+	defer func() { f.last = time.Now() }()
+	if f.last.IsZero() || f.delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(time.Until(f.last.Add(f.delay)))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// isHTML reports whether the response body is an HTML page.
+func (r *response) isHTML() bool {
+	return r.mediaType == "text/html" || r.mediaType == "application/xhtml+xml"
+}
+
+// isGone reports whether the page no longer exists.
+func (r *response) isGone() bool {
+	return r.status == http.StatusNotFound || r.status == http.StatusGone
+}
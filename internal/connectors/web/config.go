@@ -0,0 +1,84 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// ErrNoURL indicates the source has no start URL.
+var ErrNoURL = errors.New("web: url is required")
+
+// Config holds web connector configuration.
+type Config struct {
+	// URL is the page the crawl starts from, or a sitemap listing the pages
+	// to sync. Only pages on the same host are synced.
+	URL *url.URL
+	// MaxDepth is how many links are followed from the start page
+	// (default: 3). Pages listed in a sitemap are at depth 0.
+	MaxDepth int
+	// MaxPages is the most pages synced (default: 500).
+	MaxPages int
+	// Delay is the pause between requests (default: 1s). A longer
+	// Crawl-delay in robots.txt takes precedence.
+	Delay time.Duration
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		MaxDepth: 3,
+		MaxPages: 500,
+		Delay:    time.Second,
+	}
+}
+
+// ParseConfig extracts configuration from a Source.
+func ParseConfig(source domain.Source) (*Config, error) {
+	cfg := DefaultConfig()
+
+	// Parse url
+	raw := strings.TrimSpace(source.Config["url"])
+	if raw == "" {
+		return nil, ErrNoURL
+	}
+	start, err := url.Parse(raw)
+	if err != nil || (start.Scheme != "http" && start.Scheme != "https") || start.Host == "" {
+		return nil, fmt.Errorf("web: url must be an http(s) URL, got %q", raw)
+	}
+	cfg.URL = normaliseURL(start)
+
+	// Parse max_depth
+	if val := source.Config["max_depth"]; val != "" {
+		if n, err := strconv.Atoi(val); err == nil && n >= 0 {
+			cfg.MaxDepth = n
+		}
+	}
+
+	// Parse max_pages
+	if val := source.Config["max_pages"]; val != "" {
+		if n, err := strconv.Atoi(val); err == nil && n > 0 {
+			cfg.MaxPages = n
+		}
+	}
+
+	// Parse delay_ms
+	if val := source.Config["delay_ms"]; val != "" {
+		if n, err := strconv.Atoi(val); err == nil && n >= 0 {
+			cfg.Delay = time.Duration(n) * time.Millisecond
+		}
+	}
+
+	return cfg, nil
+}
+
+// IsSitemap reports whether the start URL is a sitemap rather than a page.
+func (c *Config) IsSitemap() bool {
+	return strings.HasSuffix(strings.ToLower(path.Base(c.URL.Path)), ".xml")
+}
@@ -0,0 +1,59 @@
+package web
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestParseConfig(t *testing.T) {
+	source := domain.Source{Config: map[string]string{
+		"url":       " HTTPS://Docs.Example.com:443/guide#intro ",
+		"max_depth": "1",
+		"max_pages": "20",
+		"delay_ms":  "250",
+	}}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://docs.example.com/guide", cfg.URL.String())
+	assert.Equal(t, 1, cfg.MaxDepth)
+	assert.Equal(t, 20, cfg.MaxPages)
+	assert.Equal(t, 250*time.Millisecond, cfg.Delay)
+	assert.False(t, cfg.IsSitemap())
+}
+
+func TestParseConfig_Defaults(t *testing.T) {
+	cfg, err := ParseConfig(domain.Source{Config: map[string]string{
+		"url":       "https://example.com",
+		"max_pages": "0",
+	}})
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/", cfg.URL.String())
+	assert.Equal(t, 3, cfg.MaxDepth)
+	assert.Equal(t, 500, cfg.MaxPages)
+	assert.Equal(t, time.Second, cfg.Delay)
+}
+
+func TestParseConfig_InvalidURL(t *testing.T) {
+	_, err := ParseConfig(domain.Source{Config: map[string]string{}})
+	assert.ErrorIs(t, err, ErrNoURL)
+
+	for _, raw := range []string{"ftp://example.com/", "example.com/docs", "https://"} {
+		_, err := ParseConfig(domain.Source{Config: map[string]string{"url": raw}})
+		assert.Error(t, err, raw)
+	}
+}
+
+func TestConfig_IsSitemap(t *testing.T) {
+	cfg, err := ParseConfig(domain.Source{Config: map[string]string{"url": "https://example.com/Sitemap.XML"}})
+
+	require.NoError(t, err)
+	assert.True(t, cfg.IsSitemap())
+}
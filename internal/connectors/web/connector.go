@@ -0,0 +1,222 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/connectors/locale"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Connector implements the interface.
+var _ driven.Connector = (*Connector)(nil)
+
+// Connector crawls the pages of a website.
+type Connector struct {
+	sourceID   string
+	config     *Config
+	httpClient *http.Client
+	mu         sync.Mutex
+	closed     bool
+}
+
+// New creates a new web connector.
+func New(sourceID string, cfg *Config) *Connector {
+	return &Connector{
+		sourceID:   sourceID,
+		config:     cfg,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// SetLocale sends locale as Accept-Language when fetching pages.
+func (c *Connector) SetLocale(loc string) {
+	c.httpClient.Transport = locale.Transport(c.httpClient.Transport, loc)
+}
+
+// Type returns the connector type identifier.
+func (c *Connector) Type() string {
+	return "web"
+}
+
+// SourceID returns the source identifier.
+func (c *Connector) SourceID() string {
+	return c.sourceID
+}
+
+// Capabilities returns the connector's capabilities.
+func (c *Connector) Capabilities() driven.ConnectorCapabilities {
+	return driven.ConnectorCapabilities{
+		SupportsIncremental:  true,
+		SupportsWatch:        false,
+		SupportsHierarchy:    false,
+		SupportsBinary:       false,
+		RequiresAuth:         false,
+		SupportsValidation:   true,
+		SupportsCursorReturn: true,
+		SupportsPartialSync:  false,
+		SupportsRateLimiting: true,
+		SupportsPagination:   false,
+	}
+}
+
+// Validate checks that the start URL can be fetched.
+func (c *Connector) Validate(ctx context.Context) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	f := &fetcher{client: c.httpClient}
+	resp, err := f.get(ctx, c.config.URL, nil)
+	if err != nil {
+		return err
+	}
+	if resp.status < 200 || resp.status >= 300 {
+		return fmt.Errorf("web: %s returned status %d", c.config.URL, resp.status)
+	}
+	return nil
+}
+
+// FullSync crawls every page of the site.
+func (c *Connector) FullSync(ctx context.Context) (
+	docs <-chan domain.RawDocument, errs <-chan error,
+) {
+	docsChan := make(chan domain.RawDocument)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(docsChan)
+		defer close(errsChan)
+		errsChan <- c.runFullSync(ctx, docsChan)
+	}()
+
+	return docsChan, errsChan
+}
+
+// runFullSync executes the full sync logic.
+func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawDocument) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+	slog.Debug("full sync started", slog.String("connector", c.Type()), slog.String("source_id", c.sourceID))
+
+	cr := c.newCrawl(nil, crawlEvents{
+		page: func(doc *domain.RawDocument, _ bool) error {
+			return c.sendDocument(ctx, docsChan, doc)
+		},
+	})
+	if err := cr.run(ctx); err != nil {
+		return err
+	}
+	return &driven.SyncComplete{NewCursor: cr.cursor.Encode()}
+}
+
+// IncrementalSync crawls the site again, downloading only pages that changed
+// since the last sync. Pages that now return 404 or 410, or are disallowed
+// by robots.txt or no longer listed in the sitemap, are deleted.
+func (c *Connector) IncrementalSync(ctx context.Context, state domain.SyncState) (
+	changes <-chan domain.RawDocumentChange, errs <-chan error,
+) {
+	changesChan := make(chan domain.RawDocumentChange)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(changesChan)
+		defer close(errsChan)
+		errsChan <- c.runIncrementalSync(ctx, state, changesChan)
+	}()
+
+	return changesChan, errsChan
+}
+
+// runIncrementalSync executes the incremental sync logic.
+func (c *Connector) runIncrementalSync(
+	ctx context.Context, state domain.SyncState, changesChan chan<- domain.RawDocumentChange,
+) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	previous, err := DecodeCursor(state.Cursor)
+	if err != nil {
+		return err
+	}
+
+	cr := c.newCrawl(previous, crawlEvents{
+		page: func(doc *domain.RawDocument, created bool) error {
+			changeType := domain.ChangeUpdated
+			if created {
+				changeType = domain.ChangeCreated
+			}
+			return c.sendChange(ctx, changesChan, changeType, doc)
+		},
+		deleted: func(uri string) error {
+			return c.sendChange(ctx, changesChan, domain.ChangeDeleted,
+				&domain.RawDocument{SourceID: c.sourceID, URI: uri})
+		},
+	})
+	if err := cr.run(ctx); err != nil {
+		return err
+	}
+	return &driven.SyncComplete{NewCursor: cr.cursor.Encode()}
+}
+
+// sendDocument sends a document to the channel.
+func (c *Connector) sendDocument(
+	ctx context.Context, docsChan chan<- domain.RawDocument, doc *domain.RawDocument,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case docsChan <- *doc:
+		return nil
+	}
+}
+
+// sendChange sends a change to the channel.
+func (c *Connector) sendChange(
+	ctx context.Context,
+	changesChan chan<- domain.RawDocumentChange,
+	changeType domain.ChangeType,
+	doc *domain.RawDocument,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case changesChan <- domain.RawDocumentChange{Type: changeType, Document: *doc}:
+		return nil
+	}
+}
+
+// checkClosed returns an error if the connector is closed.
+func (c *Connector) checkClosed() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return domain.ErrConnectorClosed
+	}
+	return nil
+}
+
+// Watch is not supported for websites.
+func (c *Connector) Watch(_ context.Context) (<-chan domain.RawDocumentChange, error) {
+	return nil, domain.ErrNotImplemented
+}
+
+// GetAccountIdentifier returns an empty string, as websites are not
+// accessed with an account.
+func (c *Connector) GetAccountIdentifier(_ context.Context, _ string) (string, error) {
+	return "", nil
+}
+
+// Close releases resources.
+func (c *Connector) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
@@ -0,0 +1,272 @@
+package web
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec // ETags of test pages
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// testSite serves a website from memory. Pages are served with an ETag of
+// their body and answer a matching If-None-Match with 304 Not Modified.
+type testSite struct {
+	mu       sync.Mutex
+	pages    map[string]string
+	requests []string
+}
+
+func (s *testSite) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, r.URL.Path)
+	body, ok := s.pages[r.URL.Path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	etag := fmt.Sprintf(`"%x"`, sha1.Sum([]byte(body))) //nolint:gosec // ETags of test pages
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	switch path.Ext(r.URL.Path) {
+	case ".txt":
+		w.Header().Set("Content-Type", "text/plain")
+	case ".xml":
+		w.Header().Set("Content-Type", "application/xml")
+	default:
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	}
+	w.Header().Set("ETag", etag)
+	_, _ = w.Write([]byte(body))
+}
+
+func (s *testSite) set(path, body string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pages[path] = body
+}
+
+func (s *testSite) remove(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.pages, path)
+}
+
+func (s *testSite) fetched() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	requests := s.requests
+	s.requests = nil
+	return requests
+}
+
+// htmlPage returns an HTML page linking to hrefs.
+func htmlPage(title string, hrefs ...string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<html><head><title>%s</title></head><body>", title)
+	for _, href := range hrefs {
+		fmt.Fprintf(&b, `<a href="%s">%s</a>`, href, href)
+	}
+	b.WriteString("</body></html>")
+	return b.String()
+}
+
+// newTestSite starts a site and a connector that crawls it from startPath.
+func newTestSite(t *testing.T, startPath string, pages map[string]string) (*testSite, *Connector, string) {
+	t.Helper()
+	site := &testSite{pages: pages}
+	server := httptest.NewServer(site)
+	t.Cleanup(server.Close)
+
+	start, err := url.Parse(server.URL + startPath)
+	require.NoError(t, err)
+	cfg := DefaultConfig()
+	cfg.URL = normaliseURL(start)
+	cfg.MaxDepth = 2
+	cfg.Delay = 0
+	return site, New("source-1", cfg), server.URL
+}
+
+// drain collects the items of a sync and returns its final result.
+func drain[T any](items <-chan T, errs <-chan error) ([]T, error) {
+	var collected []T
+	for item := range items {
+		collected = append(collected, item)
+	}
+	return collected, <-errs
+}
+
+func docURIs(docs []domain.RawDocument, base string) []string {
+	uris := make([]string, 0, len(docs))
+	for i := range docs {
+		uris = append(uris, strings.TrimPrefix(docs[i].URI, base))
+	}
+	sort.Strings(uris)
+	return uris
+}
+
+func TestConnector_FullSync_CrawlsSite(t *testing.T) {
+	site, conn, base := newTestSite(t, "/", map[string]string{
+		"/robots.txt":     "User-agent: *\nDisallow: /private\n",
+		"/":               htmlPage("Home", "/a", "/b#top", "/private/secret", "/logo.png", "http://other.invalid/"),
+		"/a":              htmlPage("A", "/c"),
+		"/b":              `<html><head><meta name="robots" content="noindex"></head><a href="/d">D</a></html>`,
+		"/c":              htmlPage("C", "/e"),
+		"/d":              htmlPage("D"),
+		"/e":              htmlPage("E"),
+		"/private/secret": htmlPage("Secret"),
+	})
+
+	docs, err := drain(conn.FullSync(context.Background()))
+
+	complete, ok := driven.IsSyncComplete(err)
+	require.True(t, ok, "expected SyncComplete, got %v", err)
+	assert.Equal(t, []string{"/", "/a", "/c", "/d"}, docURIs(docs, base),
+		"noindex pages, disallowed pages and pages beyond max_depth are not indexed")
+	assert.NotContains(t, site.fetched(), "/private/secret")
+
+	for i := range docs {
+		assert.Equal(t, "source-1", docs[i].SourceID)
+		assert.Equal(t, mimeTypeHTML, docs[i].MIMEType)
+		if docs[i].URI == base+"/c" {
+			assert.Equal(t, "C", docs[i].Metadata["title"])
+			assert.Equal(t, 2, docs[i].Metadata["depth"])
+		}
+	}
+
+	cursor, err := DecodeCursor(complete.NewCursor)
+	require.NoError(t, err)
+	assert.Len(t, cursor.Pages, 5)
+	assert.True(t, cursor.Pages[base+"/b"].NoIndex)
+	assert.NotEmpty(t, cursor.Pages[base+"/a"].ETag)
+}
+
+func TestConnector_IncrementalSync_DetectsChanges(t *testing.T) {
+	site, conn, base := newTestSite(t, "/", map[string]string{
+		"/":  htmlPage("Home", "/a", "/b", "/c"),
+		"/a": htmlPage("A"),
+		"/b": `<html><head><meta name="robots" content="noindex"></head></html>`,
+		"/c": htmlPage("C"),
+	})
+	_, err := drain(conn.FullSync(context.Background()))
+	complete, ok := driven.IsSyncComplete(err)
+	require.True(t, ok, "expected SyncComplete, got %v", err)
+
+	site.set("/", htmlPage("Home", "/a", "/b", "/c", "/new"))
+	site.set("/b", htmlPage("B"))
+	site.set("/new", htmlPage("New"))
+	site.remove("/c")
+	site.fetched()
+
+	changes, err := drain(conn.IncrementalSync(context.Background(), domain.SyncState{Cursor: complete.NewCursor}))
+
+	_, ok = driven.IsSyncComplete(err)
+	require.True(t, ok, "expected SyncComplete, got %v", err)
+	got := make(map[string]domain.ChangeType)
+	for _, change := range changes {
+		got[strings.TrimPrefix(change.Document.URI, base)] = change.Type
+	}
+	assert.Equal(t, map[string]domain.ChangeType{
+		"/":    domain.ChangeUpdated,
+		"/b":   domain.ChangeCreated,
+		"/c":   domain.ChangeDeleted,
+		"/new": domain.ChangeCreated,
+	}, got, "the unchanged page /a is not emitted")
+}
+
+func TestConnector_FullSync_Sitemap(t *testing.T) {
+	site, conn, base := newTestSite(t, "/sitemap.xml", map[string]string{
+		"/sitemap.xml": `<sitemapindex><sitemap><loc>/pages.xml</loc></sitemap></sitemapindex>`,
+		"/pages.xml":   `<urlset><url><loc>/a</loc></url><url><loc>/b</loc></url></urlset>`,
+		"/a":           htmlPage("A", "/unlisted"),
+		"/b":           htmlPage("B"),
+		"/unlisted":    htmlPage("Unlisted"),
+	})
+
+	docs, err := drain(conn.FullSync(context.Background()))
+	complete, ok := driven.IsSyncComplete(err)
+	require.True(t, ok, "expected SyncComplete, got %v", err)
+	assert.Equal(t, []string{"/a", "/b"}, docURIs(docs, base), "links are not followed in sitemap mode")
+	assert.NotContains(t, site.fetched(), "/unlisted")
+
+	// A page dropped from the sitemap is deleted
+	site.set("/pages.xml", `<urlset><url><loc>/a</loc></url></urlset>`)
+	changes, err := drain(conn.IncrementalSync(context.Background(), domain.SyncState{Cursor: complete.NewCursor}))
+
+	_, ok = driven.IsSyncComplete(err)
+	require.True(t, ok, "expected SyncComplete, got %v", err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, domain.ChangeDeleted, changes[0].Type)
+	assert.Equal(t, base+"/b", changes[0].Document.URI)
+}
+
+func TestConnector_FullSync_StartPageErrors(t *testing.T) {
+	t.Run("disallowed by robots.txt", func(t *testing.T) {
+		_, conn, _ := newTestSite(t, "/", map[string]string{
+			"/robots.txt": "User-agent: sercha\nDisallow: /\n",
+			"/":           htmlPage("Home"),
+		})
+
+		_, err := drain(conn.FullSync(context.Background()))
+
+		require.Error(t, err)
+		_, ok := driven.IsSyncComplete(err)
+		assert.False(t, ok)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, conn, _ := newTestSite(t, "/missing", map[string]string{})
+
+		_, err := drain(conn.FullSync(context.Background()))
+
+		require.Error(t, err)
+		_, ok := driven.IsSyncComplete(err)
+		assert.False(t, ok)
+	})
+}
+
+func TestConnector_SetLocale(t *testing.T) {
+	var gotLanguage string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotLanguage = r.Header.Get("Accept-Language")
+		_, _ = w.Write([]byte(htmlPage("Home")))
+	}))
+	defer server.Close()
+
+	start, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	cfg := DefaultConfig()
+	cfg.URL = normaliseURL(start)
+	conn := New("source-1", cfg)
+	conn.SetLocale("fr-FR")
+
+	require.NoError(t, conn.Validate(context.Background()))
+	assert.Equal(t, "fr-FR", gotLanguage)
+}
+
+func TestConnector_Validate(t *testing.T) {
+	_, conn, _ := newTestSite(t, "/", map[string]string{"/": htmlPage("Home")})
+	assert.NoError(t, conn.Validate(context.Background()))
+
+	_, missing, _ := newTestSite(t, "/missing", map[string]string{})
+	assert.Error(t, missing.Validate(context.Background()))
+
+	require.NoError(t, conn.Close())
+	assert.ErrorIs(t, conn.Validate(context.Background()), domain.ErrConnectorClosed)
+}
@@ -0,0 +1,363 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// skippedExtensions are file extensions of links that are not followed,
+// because they are not HTML pages.
+var skippedExtensions = map[string]bool{
+	".css": true, ".js": true, ".json": true, ".xml": true, ".pdf": true, ".zip": true, ".gz": true,
+	".tar": true, ".png": true, ".jpg": true, ".jpeg": true, ".gif": true, ".svg": true, ".webp": true,
+	".ico": true, ".mp3": true, ".mp4": true, ".webm": true, ".woff": true, ".woff2": true, ".ttf": true,
+}
+
+// crawlEvents receives what a crawl finds.
+type crawlEvents struct {
+	// page is called for each new or changed page to index. created is set
+	// for pages not indexed by the previous crawl.
+	page func(doc *domain.RawDocument, created bool) error
+	// deleted is called for pages indexed by the previous crawl that are
+	// gone. Nil for a full sync.
+	deleted func(uri string) error
+}
+
+// queuedPage is a page waiting to be fetched.
+type queuedPage struct {
+	url   *url.URL
+	depth int
+}
+
+// crawl is the state of one sync's crawl.
+type crawl struct {
+	sourceID string
+	config   *Config
+	fetcher  *fetcher
+	robots   *robots
+	events   crawlEvents
+
+	// previous holds the pages found by the last crawl; empty for a full sync.
+	previous *Cursor
+	// cursor collects the pages found by this crawl.
+	cursor *Cursor
+
+	queue   []queuedPage
+	seen    map[string]bool
+	visited map[string]bool
+	fetched int
+}
+
+// newCrawl prepares a crawl. previous is nil for a full sync.
+func (c *Connector) newCrawl(previous *Cursor, events crawlEvents) *crawl {
+	if previous == nil {
+		previous = NewCursor()
+	}
+	return &crawl{
+		sourceID: c.sourceID,
+		config:   c.config,
+		fetcher:  &fetcher{client: c.httpClient, delay: c.config.Delay},
+		robots:   allowAll,
+		events:   events,
+		previous: previous,
+		cursor:   NewCursor(),
+		seen:     make(map[string]bool),
+		visited:  make(map[string]bool),
+	}
+}
+
+// run crawls the site. In crawl mode the start page is fetched first, then
+// pages found by the previous crawl, then newly linked pages, breadth first.
+// In sitemap mode the pages listed in the sitemap are fetched and links
+// are not followed.
+func (cr *crawl) run(ctx context.Context) error {
+	if err := cr.loadRobots(ctx); err != nil {
+		return err
+	}
+
+	if cr.config.IsSitemap() {
+		pages, err := cr.sitemapPages(ctx)
+		if err != nil {
+			return err
+		}
+		for _, u := range pages {
+			cr.enqueue(u, 0)
+		}
+		// Pages dropped from the sitemap are deleted
+		for _, uri := range cr.previousURIs() {
+			if !cr.seen[uri] {
+				if err := cr.drop(uri); err != nil {
+					return err
+				}
+			}
+		}
+	} else {
+		cr.enqueue(cr.config.URL, 0)
+		for _, uri := range cr.previousURIs() {
+			if u, err := url.Parse(uri); err == nil {
+				cr.enqueue(u, cr.previous.Pages[uri].Depth)
+			}
+		}
+	}
+
+	for len(cr.queue) > 0 && cr.fetched < cr.config.MaxPages {
+		next := cr.queue[0]
+		cr.queue = cr.queue[1:]
+		if err := cr.visit(ctx, next); err != nil {
+			return err
+		}
+	}
+
+	// Pages not reached within max_pages keep their state, so they are
+	// neither lost from the cursor nor deleted.
+	for uri, state := range cr.previous.Pages {
+		if cr.seen[uri] && !cr.visited[uri] {
+			cr.cursor.Pages[uri] = state
+		}
+	}
+	return nil
+}
+
+// loadRobots fetches the site's robots.txt. A missing robots.txt allows
+// everything; one that cannot be fetched stops the crawl.
+func (cr *crawl) loadRobots(ctx context.Context) error {
+	robotsURL := &url.URL{Scheme: cr.config.URL.Scheme, Host: cr.config.URL.Host, Path: "/robots.txt"}
+	resp, err := cr.fetcher.get(ctx, robotsURL, nil)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case resp.status >= 200 && resp.status < 300:
+		cr.robots = parseRobots(resp.body)
+	case resp.status >= 400 && resp.status < 500:
+		cr.robots = allowAll
+	default:
+		return fmt.Errorf("web: %s returned status %d", robotsURL, resp.status)
+	}
+	cr.fetcher.delay = max(cr.fetcher.delay, cr.robots.crawlDelay)
+	return nil
+}
+
+// sitemapPages returns the pages listed in the sitemap, following sitemap
+// indexes. Any sitemap that cannot be read stops the crawl, as pages missing
+// from it would otherwise be deleted.
+func (cr *crawl) sitemapPages(ctx context.Context) ([]*url.URL, error) {
+	var pages []*url.URL
+	pending := []*url.URL{cr.config.URL}
+	for read := 0; len(pending) > 0 && read < maxSitemaps; read++ {
+		sitemapURL := pending[0]
+		pending = pending[1:]
+
+		pageCtx, span := tracing.StartPage(ctx, "web", "sitemap")
+		resp, err := cr.fetcher.get(pageCtx, sitemapURL, nil)
+		tracing.EndPage(span, err)
+		if err != nil {
+			return nil, err
+		}
+		if resp.status != http.StatusOK {
+			return nil, fmt.Errorf("web: %s returned status %d", sitemapURL, resp.status)
+		}
+		s, err := parseSitemap(resp.body)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range s.URLs {
+			if u := cr.resolve(sitemapURL, entry.Loc); u != nil {
+				pages = append(pages, u)
+			}
+		}
+		for _, entry := range s.Sitemaps {
+			if u := cr.resolve(sitemapURL, entry.Loc); u != nil {
+				pending = append(pending, u)
+			}
+		}
+	}
+	return pages, nil
+}
+
+// resolve resolves a URL listed in a sitemap, returning nil for URLs that
+// are invalid or on another host.
+func (cr *crawl) resolve(base *url.URL, loc string) *url.URL {
+	u, err := base.Parse(strings.TrimSpace(loc))
+	if err != nil || !cr.sameHost(u) {
+		return nil
+	}
+	return normaliseURL(u)
+}
+
+// enqueue queues a page to be fetched, unless it was queued before or is
+// not a page of the site.
+func (cr *crawl) enqueue(u *url.URL, depth int) {
+	uri := u.String()
+	if cr.seen[uri] || !cr.sameHost(u) || skippedExtensions[strings.ToLower(path.Ext(u.Path))] {
+		return
+	}
+	cr.seen[uri] = true
+	cr.queue = append(cr.queue, queuedPage{url: u, depth: depth})
+}
+
+// visit fetches a queued page, reports it if it is new or changed, and
+// queues the pages it links to.
+//
+//nolint:gocognit,gocyclo // Handles every outcome of fetching a page
+func (cr *crawl) visit(ctx context.Context, next queuedPage) error {
+	uri := next.url.String()
+	if cr.visited[uri] {
+		return nil // Already reached through a redirect
+	}
+	cr.visited[uri] = true
+	isStart := !cr.config.IsSitemap() && uri == cr.config.URL.String()
+
+	if !cr.robots.Allowed(next.url) {
+		if isStart {
+			return fmt.Errorf("web: %s is disallowed by robots.txt", uri)
+		}
+		return cr.drop(uri)
+	}
+
+	previous, known := cr.previous.Pages[uri]
+	var validators *PageState
+	if known {
+		validators = &previous
+	}
+
+	pageCtx, span := tracing.StartPage(ctx, "web", "page")
+	resp, err := cr.fetcher.get(pageCtx, next.url, validators)
+	tracing.EndPage(span, err)
+	if err != nil {
+		if ctx.Err() != nil || isStart {
+			return err
+		}
+		cr.keep(uri)
+		return nil
+	}
+	cr.fetched++
+
+	switch {
+	case resp.status == http.StatusNotModified:
+		cr.keep(uri)
+		return nil
+	case resp.isGone():
+		if isStart {
+			return fmt.Errorf("web: %s returned status %d", uri, resp.status)
+		}
+		return cr.drop(uri)
+	case resp.status < 200 || resp.status >= 300:
+		// Server errors and the like are retried on the next sync
+		if isStart {
+			return fmt.Errorf("web: %s returned status %d", uri, resp.status)
+		}
+		cr.keep(uri)
+		return nil
+	case !resp.isHTML() || !cr.sameHost(resp.url):
+		return cr.drop(uri)
+	}
+
+	// A redirect within the site indexes the page under its final URL
+	if final := resp.url.String(); final != uri {
+		if err := cr.drop(uri); err != nil {
+			return err
+		}
+		if cr.visited[final] {
+			return nil
+		}
+		cr.seen[final] = true
+		cr.visited[final] = true
+		uri = final
+		previous, known = cr.previous.Pages[uri]
+	}
+
+	p := parsePage(resp.url, resp.body)
+	cr.cursor.Pages[uri] = PageState{
+		Depth:        next.depth,
+		ETag:         resp.etag,
+		LastModified: resp.lastModified,
+		NoIndex:      p.noIndex,
+	}
+
+	if p.noIndex {
+		if known && !previous.NoIndex && cr.events.deleted != nil {
+			if err := cr.events.deleted(uri); err != nil {
+				return err
+			}
+		}
+	} else if err := cr.events.page(cr.document(uri, resp, p, next.depth), !known || previous.NoIndex); err != nil {
+		return err
+	}
+
+	if !p.noFollow && !cr.config.IsSitemap() && next.depth < cr.config.MaxDepth {
+		for _, link := range p.links {
+			cr.enqueue(link, next.depth+1)
+		}
+	}
+	return nil
+}
+
+// keep carries a page's state from the previous crawl over unchanged.
+func (cr *crawl) keep(uri string) {
+	if state, ok := cr.previous.Pages[uri]; ok {
+		cr.cursor.Pages[uri] = state
+	}
+}
+
+// drop forgets a page, deleting it if the previous crawl indexed it.
+func (cr *crawl) drop(uri string) error {
+	state, ok := cr.previous.Pages[uri]
+	if !ok || state.NoIndex || cr.events.deleted == nil {
+		return nil
+	}
+	return cr.events.deleted(uri)
+}
+
+// previousURIs returns the pages of the previous crawl, shallowest first.
+func (cr *crawl) previousURIs() []string {
+	uris := make([]string, 0, len(cr.previous.Pages))
+	for uri := range cr.previous.Pages {
+		uris = append(uris, uri)
+	}
+	slices.SortFunc(uris, func(a, b string) int {
+		if d := cr.previous.Pages[a].Depth - cr.previous.Pages[b].Depth; d != 0 {
+			return d
+		}
+		return strings.Compare(a, b)
+	})
+	return uris
+}
+
+// sameHost reports whether u is on the site's host.
+func (cr *crawl) sameHost(u *url.URL) bool {
+	return strings.EqualFold(normaliseURL(u).Host, cr.config.URL.Host)
+}
+
+// document converts a fetched page to a RawDocument.
+func (cr *crawl) document(uri string, resp *response, p *page, depth int) *domain.RawDocument {
+	metadata := map[string]any{
+		"url":   uri,
+		"host":  resp.url.Hostname(),
+		"depth": depth,
+	}
+	if p.title != "" {
+		metadata["title"] = p.title
+	}
+	if resp.lastModified != "" {
+		metadata["last_modified"] = resp.lastModified
+	}
+
+	return &domain.RawDocument{
+		SourceID: cr.sourceID,
+		URI:      uri,
+		MIMEType: mimeTypeHTML,
+		Content:  resp.body,
+		Metadata: metadata,
+	}
+}
@@ -0,0 +1,85 @@
+package web
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// CursorVersion is the current cursor format version.
+const CursorVersion = 1
+
+// ErrInvalidCursor indicates the cursor could not be decoded.
+var ErrInvalidCursor = errors.New("web: invalid cursor format")
+
+// Cursor tracks the pages found by the last crawl. Each page keeps the
+// validators it was served with, so that an incremental sync asks the server
+// whether it changed instead of downloading it again.
+type Cursor struct {
+	// Version is the cursor format version for future compatibility.
+	Version int `json:"v"`
+	// Pages maps page URL to its sync state.
+	Pages map[string]PageState `json:"pages"`
+}
+
+// PageState tracks the sync state of a single page.
+type PageState struct {
+	// Depth is how many links the page is from the start page.
+	Depth int `json:"depth,omitempty"`
+	// ETag and LastModified are the page's response headers.
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+	// NoIndex is set for pages crawled for their links but not indexed.
+	NoIndex bool `json:"noindex,omitempty"`
+}
+
+// NewCursor creates a new empty cursor.
+func NewCursor() *Cursor {
+	return &Cursor{
+		Version: CursorVersion,
+		Pages:   make(map[string]PageState),
+	}
+}
+
+// Encode serialises the cursor to a base64 string for storage.
+func (c *Cursor) Encode() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// DecodeCursor deserialises a cursor from a base64 string.
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return NewCursor(), nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	// Version check for future migrations
+	if cursor.Version > CursorVersion {
+		return nil, ErrInvalidCursor
+	}
+
+	// Ensure map is initialised
+	if cursor.Pages == nil {
+		cursor.Pages = make(map[string]PageState)
+	}
+
+	return &cursor, nil
+}
+
+// IsEmpty returns true if the cursor has no tracked pages.
+func (c *Cursor) IsEmpty() bool {
+	return len(c.Pages) == 0
+}
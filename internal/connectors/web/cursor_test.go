@@ -0,0 +1,36 @@
+package web
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	cursor := NewCursor()
+	cursor.Pages["https://example.com/"] = PageState{ETag: `"abc"`}
+	cursor.Pages["https://example.com/hidden"] = PageState{
+		Depth:        1,
+		LastModified: "Wed, 21 Oct 2015 07:28:00 GMT",
+		NoIndex:      true,
+	}
+
+	decoded, err := DecodeCursor(cursor.Encode())
+
+	require.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+}
+
+func TestDecodeCursor(t *testing.T) {
+	empty, err := DecodeCursor("")
+	require.NoError(t, err)
+	assert.True(t, empty.IsEmpty())
+
+	_, err = DecodeCursor("not base64!")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	future := &Cursor{Version: CursorVersion + 1}
+	_, err = DecodeCursor(future.Encode())
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
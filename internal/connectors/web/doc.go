@@ -0,0 +1,5 @@
+// Package web provides a Connector that crawls a website, such as an
+// internal documentation site. The crawl starts from a page and follows
+// links within the same host, or syncs the pages listed in a sitemap.
+// It respects robots.txt and waits between requests.
+package web
@@ -0,0 +1,147 @@
+package web
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// mimeTypeHTML is the MIME type of documents emitted by this connector.
+const mimeTypeHTML = "text/html"
+
+// EmittedMIMETypes returns the MIME types the web connector emits.
+func EmittedMIMETypes() []string {
+	return []string{mimeTypeHTML}
+}
+
+// ResolveWebURL returns the URL of a crawled page, which is its URI.
+func ResolveWebURL(uri string, _ map[string]any) string {
+	return uri
+}
+
+// page is what the crawler reads from an HTML page.
+type page struct {
+	// title is the text of the page's <title>.
+	title string
+	// links are the absolute http(s) URLs the page links to.
+	links []*url.URL
+	// noIndex and noFollow are set by a robots meta tag.
+	noIndex  bool
+	noFollow bool
+}
+
+// parsePage extracts the title, links and robots directives of an HTML
+// page fetched from pageURL. Links are resolved against the page's <base>
+// if it has one.
+func parsePage(pageURL *url.URL, body []byte) *page {
+	p := &page{}
+	base := pageURL
+	var hrefs []string
+	var inTitle bool
+
+	tokenizer := html.NewTokenizer(bytes.NewReader(body))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			p.links = resolveLinks(base, hrefs)
+			p.title = strings.Join(strings.Fields(p.title), " ")
+			return p
+
+		case html.TextToken:
+			if inTitle {
+				p.title += string(tokenizer.Text())
+			}
+
+		case html.EndTagToken:
+			if name, _ := tokenizer.TagName(); atom.Lookup(name) == atom.Title {
+				inTitle = false
+			}
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := tokenizer.TagName()
+			tag := atom.Lookup(name)
+			if tag == atom.Title {
+				inTitle = p.title == ""
+				continue
+			}
+			if !hasAttr {
+				continue
+			}
+			attrs := tagAttributes(tokenizer)
+			switch tag {
+			case atom.A:
+				if href := attrs["href"]; href != "" && !hasToken(attrs["rel"], "nofollow") {
+					hrefs = append(hrefs, href)
+				}
+			case atom.Base:
+				if u, err := pageURL.Parse(attrs["href"]); err == nil && attrs["href"] != "" {
+					base = u
+				}
+			case atom.Meta:
+				if strings.EqualFold(attrs["name"], "robots") {
+					content := strings.ToLower(attrs["content"])
+					p.noIndex = p.noIndex || hasToken(content, "noindex") || hasToken(content, "none")
+					p.noFollow = p.noFollow || hasToken(content, "nofollow") || hasToken(content, "none")
+				}
+			}
+		}
+	}
+}
+
+// tagAttributes returns the attributes of the current tag by lower-case name.
+func tagAttributes(tokenizer *html.Tokenizer) map[string]string {
+	attrs := make(map[string]string)
+	for {
+		key, val, more := tokenizer.TagAttr()
+		attrs[strings.ToLower(string(key))] = strings.TrimSpace(string(val))
+		if !more {
+			return attrs
+		}
+	}
+}
+
+// hasToken reports whether a comma or space separated list contains token.
+func hasToken(list, token string) bool {
+	for _, field := range strings.FieldsFunc(list, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if strings.EqualFold(field, token) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveLinks resolves hrefs against base, keeping the http(s) URLs.
+func resolveLinks(base *url.URL, hrefs []string) []*url.URL {
+	links := make([]*url.URL, 0, len(hrefs))
+	for _, href := range hrefs {
+		u, err := base.Parse(href)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			continue
+		}
+		links = append(links, normaliseURL(u))
+	}
+	return links
+}
+
+// normaliseURL returns u without its fragment, with a lower-case scheme and
+// host, no default port, and a path of at least "/", so that links to the
+// same page compare equal.
+func normaliseURL(u *url.URL) *url.URL {
+	n := *u
+	n.Scheme = strings.ToLower(n.Scheme)
+	n.Host = strings.ToLower(n.Host)
+	if port := n.Port(); (n.Scheme == "http" && port == "80") || (n.Scheme == "https" && port == "443") {
+		n.Host = n.Hostname()
+	}
+	n.Fragment = ""
+	n.RawFragment = ""
+	n.User = nil
+	if n.Path == "" {
+		n.Path = "/"
+		n.RawPath = ""
+	}
+	return &n
+}
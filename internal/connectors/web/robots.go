@@ -0,0 +1,137 @@
+package web
+
+import (
+	"bufio"
+	"bytes"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// robotsAgent is the product token matched against robots.txt user-agents.
+const robotsAgent = "sercha"
+
+// robots holds the robots.txt rules that apply to this crawler.
+type robots struct {
+	rules      []robotsRule
+	crawlDelay time.Duration
+}
+
+// robotsRule is one Allow or Disallow line.
+type robotsRule struct {
+	allow   bool
+	pattern string
+}
+
+// allowAll is the policy of a site without robots.txt.
+var allowAll = &robots{}
+
+// parseRobots parses a robots.txt file, keeping the group for this crawler's
+// user-agent or, if there is none, the group for "*".
+func parseRobots(body []byte) *robots {
+	var specific, generic *robots
+	var current []*robots // groups the current lines apply to
+	inAgents := false
+
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		if key == "user-agent" {
+			if !inAgents {
+				current = nil
+				inAgents = true
+			}
+			agent := strings.ToLower(value)
+			switch {
+			case strings.Contains(agent, robotsAgent):
+				if specific == nil {
+					specific = &robots{}
+				}
+				current = append(current, specific)
+			case agent == "*":
+				if generic == nil {
+					generic = &robots{}
+				}
+				current = append(current, generic)
+			}
+			continue
+		}
+		inAgents = false
+
+		for _, group := range current {
+			switch key {
+			case "allow", "disallow":
+				if value != "" {
+					group.rules = append(group.rules, robotsRule{allow: key == "allow", pattern: value})
+				}
+			case "crawl-delay":
+				if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+					group.crawlDelay = time.Duration(seconds * float64(time.Second))
+				}
+			}
+		}
+	}
+
+	switch {
+	case specific != nil:
+		return specific
+	case generic != nil:
+		return generic
+	default:
+		return allowAll
+	}
+}
+
+// Allowed reports whether u may be crawled. The longest matching rule wins,
+// and Allow wins a tie.
+func (r *robots) Allowed(u *url.URL) bool {
+	target := u.EscapedPath()
+	if u.RawQuery != "" {
+		target += "?" + u.RawQuery
+	}
+
+	allowed, longest := true, -1
+	for _, rule := range r.rules {
+		if !matchRobotsPattern(rule.pattern, target) {
+			continue
+		}
+		if len(rule.pattern) > longest || (len(rule.pattern) == longest && rule.allow) {
+			allowed, longest = rule.allow, len(rule.pattern)
+		}
+	}
+	return allowed
+}
+
+// matchRobotsPattern reports whether a robots.txt path pattern matches the
+// start of target. "*" matches any run of characters and a trailing "$"
+// anchors the pattern to the end of target.
+func matchRobotsPattern(pattern, target string) bool {
+	anchored := strings.HasSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(pattern, "$")
+
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(target, parts[0]) {
+		return false
+	}
+	rest := target[len(parts[0]):]
+	for i, part := range parts[1:] {
+		last := i == len(parts)-2
+		if last && anchored {
+			return strings.HasSuffix(rest, part)
+		}
+		idx := strings.Index(rest, part)
+		if idx < 0 {
+			return false
+		}
+		rest = rest[idx+len(part):]
+	}
+	return !anchored || rest == ""
+}
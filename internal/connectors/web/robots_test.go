@@ -0,0 +1,82 @@
+package web
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustParseURL(t *testing.T, raw string) *url.URL {
+	t.Helper()
+	u, err := url.Parse(raw)
+	require.NoError(t, err)
+	return u
+}
+
+func TestParseRobots_SelectsGroup(t *testing.T) {
+	body := []byte(`
+User-agent: *
+Disallow: /
+
+# Rules for this crawler
+User-agent: Googlebot
+User-agent: Sercha/1.0
+Disallow: /private
+Crawl-delay: 2.5
+`)
+
+	r := parseRobots(body)
+
+	assert.True(t, r.Allowed(mustParseURL(t, "https://example.com/docs")))
+	assert.False(t, r.Allowed(mustParseURL(t, "https://example.com/private/page")))
+	assert.Equal(t, 2500*time.Millisecond, r.crawlDelay)
+}
+
+func TestParseRobots_FallsBackToWildcard(t *testing.T) {
+	r := parseRobots([]byte("User-agent: otherbot\nDisallow: /\n\nUser-agent: *\nDisallow: /tmp\n"))
+
+	assert.True(t, r.Allowed(mustParseURL(t, "https://example.com/")))
+	assert.False(t, r.Allowed(mustParseURL(t, "https://example.com/tmp/file")))
+}
+
+func TestParseRobots_NoMatchingGroupAllowsAll(t *testing.T) {
+	r := parseRobots([]byte("User-agent: otherbot\nDisallow: /\n"))
+
+	assert.Same(t, allowAll, r)
+	assert.True(t, r.Allowed(mustParseURL(t, "https://example.com/anything")))
+}
+
+func TestRobots_Allowed(t *testing.T) {
+	r := parseRobots([]byte(`
+User-agent: *
+Disallow: /docs/
+Allow: /docs/public
+Disallow: /*.pdf$
+Disallow: /search?
+Allow: /page
+Disallow: /page
+`))
+
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"/", true},
+		{"/docs/internal", false},
+		{"/docs/public/intro", true}, // Longer Allow wins
+		{"/files/report.pdf", false},
+		{"/files/report.pdf.html", true}, // "$" anchors the pattern
+		{"/search?q=go", false},
+		{"/search", true},
+		{"/page", true}, // Allow wins a tie
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, r.Allowed(mustParseURL(t, "https://example.com"+tt.path)))
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package web
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// sitemap is a sitemap.xml file: either a list of pages (<urlset>) or a list
+// of further sitemaps (<sitemapindex>).
+type sitemap struct {
+	URLs     []sitemapEntry `xml:"url"`
+	Sitemaps []sitemapEntry `xml:"sitemap"`
+}
+
+// sitemapEntry is a page or nested sitemap listed in a sitemap.
+type sitemapEntry struct {
+	Loc string `xml:"loc"`
+}
+
+// maxSitemaps bounds how many sitemaps a sitemap index may pull in.
+const maxSitemaps = 50
+
+// parseSitemap parses a sitemap.xml file.
+func parseSitemap(body []byte) (*sitemap, error) {
+	var s sitemap
+	if err := xml.Unmarshal(body, &s); err != nil {
+		return nil, fmt.Errorf("web: parse sitemap: %w", err)
+	}
+	return &s, nil
+}
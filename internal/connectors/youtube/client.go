@@ -0,0 +1,309 @@
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/custodia-labs/sercha-cli/internal/connectors/tracing"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+const (
+	// DefaultBaseURL is the YouTube Data API base URL.
+	DefaultBaseURL = "https://www.googleapis.com/youtube/v3"
+
+	// DefaultTranscriptURL serves the caption tracks shown in the YouTube player.
+	DefaultTranscriptURL = "https://www.youtube.com/api/timedtext"
+
+	// DefaultTimeout is the default HTTP request timeout.
+	DefaultTimeout = 30 * time.Second
+
+	// The Data API is limited by a daily quota rather than a request rate,
+	// so requests are only paced to avoid bursts.
+	// See: https://developers.google.com/youtube/v3/getting-started#quota
+	requestsPerSecond = 5.0
+	burstSize         = 5
+
+	// maxResults is the largest page size the Data API allows.
+	maxResults = 50
+)
+
+// Client is a minimal YouTube Data API client. The API key is supplied as
+// the source's personal access token.
+type Client struct {
+	baseURL       string
+	transcriptURL string
+	tokenProvider driven.TokenProvider
+	httpClient    *http.Client
+	limiter       *rate.Limiter
+}
+
+// NewClient creates a new YouTube API client.
+func NewClient(tokenProvider driven.TokenProvider) *Client {
+	return &Client{
+		baseURL:       DefaultBaseURL,
+		transcriptURL: DefaultTranscriptURL,
+		tokenProvider: tokenProvider,
+		httpClient:    &http.Client{Timeout: DefaultTimeout},
+		limiter:       rate.NewLimiter(rate.Limit(requestsPerSecond), burstSize),
+	}
+}
+
+// Video is a YouTube video's metadata.
+type Video struct {
+	ID      string `json:"id"`
+	Snippet struct {
+		Title        string    `json:"title"`
+		Description  string    `json:"description"`
+		ChannelID    string    `json:"channelId"`
+		ChannelTitle string    `json:"channelTitle"`
+		PublishedAt  time.Time `json:"publishedAt"`
+	} `json:"snippet"`
+}
+
+// Segment is one caption line of a transcript.
+type Segment struct {
+	Start    time.Duration
+	Duration time.Duration
+	Text     string
+}
+
+// CheckKey verifies the API key with a request that costs one quota unit.
+func (c *Client) CheckKey(ctx context.Context) error {
+	var resp struct{}
+	return c.get(ctx, "/i18nLanguages", url.Values{"part": {"id"}}, &resp)
+}
+
+// UploadsPlaylist returns the ID of the playlist holding a channel's
+// uploads. The channel is given by ID or by @handle.
+func (c *Client) UploadsPlaylist(ctx context.Context, channel string) (string, error) {
+	params := url.Values{"part": {"contentDetails"}}
+	if strings.HasPrefix(channel, "@") {
+		params.Set("forHandle", channel)
+	} else {
+		params.Set("id", channel)
+	}
+
+	var resp struct {
+		Items []struct {
+			ContentDetails struct {
+				RelatedPlaylists struct {
+					Uploads string `json:"uploads"`
+				} `json:"relatedPlaylists"`
+			} `json:"contentDetails"`
+		} `json:"items"`
+	}
+	if err := c.get(ctx, "/channels", params, &resp); err != nil {
+		return "", err
+	}
+	if len(resp.Items) == 0 || resp.Items[0].ContentDetails.RelatedPlaylists.Uploads == "" {
+		return "", fmt.Errorf("channel %s: %w", channel, domain.ErrNotFound)
+	}
+	return resp.Items[0].ContentDetails.RelatedPlaylists.Uploads, nil
+}
+
+// PlaylistVideoIDs returns the IDs of the videos in a playlist, following
+// pagination.
+func (c *Client) PlaylistVideoIDs(ctx context.Context, playlistID string) ([]string, error) {
+	params := url.Values{
+		"part":       {"contentDetails"},
+		"playlistId": {playlistID},
+		"maxResults": {fmt.Sprint(maxResults)},
+	}
+
+	var ids []string
+	for {
+		var resp struct {
+			Items []struct {
+				ContentDetails struct {
+					VideoID string `json:"videoId"`
+				} `json:"contentDetails"`
+			} `json:"items"`
+			NextPageToken string `json:"nextPageToken"`
+		}
+		pageCtx, span := tracing.StartPage(ctx, "youtube", "playlist_items")
+		err := c.get(pageCtx, "/playlistItems", params, &resp)
+		tracing.EndPage(span, err)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Items {
+			ids = append(ids, item.ContentDetails.VideoID)
+		}
+		if resp.NextPageToken == "" {
+			return ids, nil
+		}
+		params.Set("pageToken", resp.NextPageToken)
+	}
+}
+
+// Videos returns the metadata of the given videos. Private and deleted
+// videos are left out of the result.
+func (c *Client) Videos(ctx context.Context, ids []string) ([]Video, error) {
+	var videos []Video
+	for start := 0; start < len(ids); start += maxResults {
+		batch := ids[start:min(start+maxResults, len(ids))]
+		params := url.Values{
+			"part": {"snippet"},
+			"id":   {strings.Join(batch, ",")},
+		}
+
+		var resp struct {
+			Items []Video `json:"items"`
+		}
+		pageCtx, span := tracing.StartPage(ctx, "youtube", "videos")
+		err := c.get(pageCtx, "/videos", params, &resp)
+		tracing.EndPage(span, err)
+		if err != nil {
+			return nil, err
+		}
+		videos = append(videos, resp.Items...)
+	}
+	return videos, nil
+}
+
+// Transcript returns a video's captions in the given language, or nil if
+// the video has none.
+func (c *Client) Transcript(ctx context.Context, videoID, language string) ([]Segment, error) {
+	params := url.Values{"v": {videoID}, "lang": {language}}
+	body, status, err := c.do(ctx, c.transcriptURL+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("transcript %s: %w", videoID, err)
+	}
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("transcript %s: status %d", videoID, status)
+	}
+	// An empty body means no captions in the language
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return nil, nil
+	}
+	return ParseTranscript(body)
+}
+
+// ParseTranscript parses a timedtext caption track:
+//
+//	<transcript><text start="0.5" dur="2.1">Hello &amp;amp; welcome</text></transcript>
+//
+// Caption text is HTML-escaped inside the XML, so it is unescaped twice.
+func ParseTranscript(data []byte) ([]Segment, error) {
+	var track struct {
+		Texts []struct {
+			Start float64 `xml:"start,attr"`
+			Dur   float64 `xml:"dur,attr"`
+			Text  string  `xml:",chardata"`
+		} `xml:"text"`
+	}
+	if err := xml.Unmarshal(data, &track); err != nil {
+		return nil, fmt.Errorf("parse transcript: %w", err)
+	}
+
+	segments := make([]Segment, 0, len(track.Texts))
+	for _, t := range track.Texts {
+		text := strings.Join(strings.Fields(html.UnescapeString(t.Text)), " ")
+		if text == "" {
+			continue
+		}
+		segments = append(segments, Segment{
+			Start:    time.Duration(t.Start * float64(time.Second)),
+			Duration: time.Duration(t.Dur * float64(time.Second)),
+			Text:     text,
+		})
+	}
+	return segments, nil
+}
+
+// get performs a Data API request with the API key and decodes the JSON response.
+func (c *Client) get(ctx context.Context, path string, params url.Values, out any) error {
+	key, err := c.tokenProvider.GetToken(ctx)
+	if err != nil {
+		return fmt.Errorf("get api key: %w", err)
+	}
+
+	// The key goes in a header rather than the query string so it never
+	// appears in logged URLs.
+	header := http.Header{"X-Goog-Api-Key": {key}}
+	body, status, err := c.do(ctx, c.baseURL+path+"?"+params.Encode(), header)
+	if err != nil {
+		return fmt.Errorf("request %s: %w", path, err)
+	}
+
+	if status != http.StatusOK {
+		return apiError(path, status, body)
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("decode %s: %w", path, err)
+	}
+	return nil
+}
+
+// do performs a rate-limited GET request and returns the body and status.
+func (c *Client) do(ctx context.Context, reqURL string, header http.Header) ([]byte, int, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
+	if err != nil {
+		return nil, 0, fmt.Errorf("create request: %w", err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("read response: %w", err)
+	}
+	return body, resp.StatusCode, nil
+}
+
+// apiError maps a Data API error response to a domain error where one fits.
+func apiError(path string, status int, body []byte) error {
+	var resp struct {
+		Error struct {
+			Message string `json:"message"`
+			Errors  []struct {
+				Reason string `json:"reason"`
+			} `json:"errors"`
+		} `json:"error"`
+	}
+	_ = json.Unmarshal(body, &resp)
+
+	var reason string
+	if len(resp.Error.Errors) > 0 {
+		reason = resp.Error.Errors[0].Reason
+	}
+
+	switch {
+	case status == http.StatusUnauthorized, reason == "keyInvalid", reason == "keyExpired":
+		return fmt.Errorf("%s: %w", path, domain.ErrAuthInvalid)
+	case status == http.StatusTooManyRequests, reason == "quotaExceeded", reason == "rateLimitExceeded":
+		return fmt.Errorf("%s: %w", path, domain.ErrRateLimited)
+	case status == http.StatusNotFound:
+		return fmt.Errorf("%s: %w", path, domain.ErrNotFound)
+	case resp.Error.Message != "":
+		return fmt.Errorf("%s: status %d: %s", path, status, resp.Error.Message)
+	default:
+		return fmt.Errorf("%s: status %d: %s", path, status, string(body))
+	}
+}
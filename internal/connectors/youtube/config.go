@@ -0,0 +1,139 @@
+package youtube
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// ErrNoVideoSources indicates the source lists no channels, playlists or videos.
+var ErrNoVideoSources = errors.New("youtube: at least one of channels, playlists or videos is required")
+
+// DefaultLanguage is the transcript language used when none is configured.
+const DefaultLanguage = "en"
+
+// videoIDPattern matches a YouTube video ID.
+var videoIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+
+// Config holds YouTube connector configuration.
+type Config struct {
+	// Channels lists channel IDs or @handles whose uploads are synced.
+	Channels []string
+	// PlaylistIDs lists playlists whose videos are synced.
+	PlaylistIDs []string
+	// VideoIDs lists individual videos to sync.
+	VideoIDs []string
+	// Language is the transcript language code (default: en).
+	Language string
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		Language: DefaultLanguage,
+	}
+}
+
+// ParseConfig extracts configuration from a Source.
+func ParseConfig(source domain.Source) (*Config, error) {
+	cfg := DefaultConfig()
+
+	// Parse channels
+	cfg.Channels = splitList(source.Config["channels"])
+
+	// Parse playlists, accepting playlist URLs
+	for _, val := range splitList(source.Config["playlists"]) {
+		id, ok := ParsePlaylistID(val)
+		if !ok {
+			return nil, fmt.Errorf("youtube: invalid playlist %q", val)
+		}
+		cfg.PlaylistIDs = append(cfg.PlaylistIDs, id)
+	}
+
+	// Parse videos, accepting video URLs
+	for _, val := range splitList(source.Config["videos"]) {
+		id, ok := ParseVideoID(val)
+		if !ok {
+			return nil, fmt.Errorf("youtube: invalid video %q", val)
+		}
+		cfg.VideoIDs = append(cfg.VideoIDs, id)
+	}
+
+	if len(cfg.Channels) == 0 && len(cfg.PlaylistIDs) == 0 && len(cfg.VideoIDs) == 0 {
+		return nil, ErrNoVideoSources
+	}
+
+	// Parse language
+	if val := strings.TrimSpace(source.Config["language"]); val != "" {
+		cfg.Language = val
+	}
+
+	return cfg, nil
+}
+
+// ParseVideoID extracts the video ID from a video ID or URL, such as
+// https://www.youtube.com/watch?v=ID, https://youtu.be/ID or
+// https://www.youtube.com/shorts/ID.
+func ParseVideoID(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	if videoIDPattern.MatchString(s) {
+		return s, true
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", false
+	}
+
+	var id string
+	switch host := strings.TrimPrefix(u.Hostname(), "www."); host {
+	case "youtu.be":
+		id = strings.Trim(u.Path, "/")
+	case "youtube.com", "m.youtube.com", "music.youtube.com":
+		if u.Path == "/watch" {
+			id = u.Query().Get("v")
+			break
+		}
+		for _, prefix := range []string{"/shorts/", "/embed/", "/live/", "/v/"} {
+			if rest, ok := strings.CutPrefix(u.Path, prefix); ok {
+				id = strings.Trim(rest, "/")
+			}
+		}
+	}
+
+	if !videoIDPattern.MatchString(id) {
+		return "", false
+	}
+	return id, true
+}
+
+// ParsePlaylistID extracts the playlist ID from a playlist ID or a URL with
+// a list parameter.
+func ParsePlaylistID(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "/") {
+		return s, s != ""
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", false
+	}
+	id := u.Query().Get("list")
+	return id, id != ""
+}
+
+// splitList splits a comma-separated config value, dropping empty entries.
+func splitList(val string) []string {
+	var items []string
+	for _, item := range strings.Split(val, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,78 @@
+package youtube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestParseConfig(t *testing.T) {
+	source := domain.Source{Config: map[string]string{
+		"channels":  "UC_x5XG1OV2P6uZZ5FSM9Ttw, @GoogleDevelopers,,",
+		"playlists": "PL123, https://www.youtube.com/playlist?list=PL456",
+		"videos":    "https://youtu.be/dQw4w9WgXcQ, oHg5SJYRHA0",
+		"language":  "de",
+	}}
+
+	cfg, err := ParseConfig(source)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"UC_x5XG1OV2P6uZZ5FSM9Ttw", "@GoogleDevelopers"}, cfg.Channels)
+	assert.Equal(t, []string{"PL123", "PL456"}, cfg.PlaylistIDs)
+	assert.Equal(t, []string{"dQw4w9WgXcQ", "oHg5SJYRHA0"}, cfg.VideoIDs)
+	assert.Equal(t, "de", cfg.Language)
+}
+
+func TestParseConfig_DefaultLanguage(t *testing.T) {
+	cfg, err := ParseConfig(domain.Source{Config: map[string]string{"videos": "dQw4w9WgXcQ"}})
+
+	require.NoError(t, err)
+	assert.Equal(t, DefaultLanguage, cfg.Language)
+}
+
+func TestParseConfig_NoVideoSources(t *testing.T) {
+	_, err := ParseConfig(domain.Source{Config: map[string]string{"language": "en"}})
+	assert.ErrorIs(t, err, ErrNoVideoSources)
+}
+
+func TestParseConfig_InvalidVideo(t *testing.T) {
+	_, err := ParseConfig(domain.Source{Config: map[string]string{"videos": "https://example.com/watch?v=x"}})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid video")
+}
+
+func TestParseVideoID(t *testing.T) {
+	tests := []struct {
+		input  string
+		want   string
+		wantOK bool
+	}{
+		{"dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"https://www.youtube.com/watch?v=dQw4w9WgXcQ&t=42s", "dQw4w9WgXcQ", true},
+		{"https://m.youtube.com/watch?v=dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"https://youtu.be/dQw4w9WgXcQ?si=abc", "dQw4w9WgXcQ", true},
+		{"https://www.youtube.com/shorts/dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"https://www.youtube.com/embed/dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"https://www.youtube.com/live/dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"https://vimeo.com/12345678901", "", false},
+		{"https://www.youtube.com/watch?v=short", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, ok := ParseVideoID(tt.input)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResolveWebURL(t *testing.T) {
+	assert.Equal(t, "https://www.youtube.com/watch?v=dQw4w9WgXcQ", ResolveWebURL("youtube://videos/dQw4w9WgXcQ", nil))
+	assert.Equal(t, "", ResolveWebURL("trello://cards/c1", nil))
+}
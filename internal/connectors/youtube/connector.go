@@ -0,0 +1,320 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/connectors/budget"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/locale"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Connector implements the interfaces.
+var (
+	_ driven.Connector      = (*Connector)(nil)
+	_ driven.APIBudgetAware = (*Connector)(nil)
+)
+
+// Connector fetches video transcripts from YouTube channels, playlists
+// and individual videos.
+type Connector struct {
+	sourceID      string
+	config        *Config
+	tokenProvider driven.TokenProvider
+	client        *Client
+	mu            sync.Mutex
+	closed        bool
+}
+
+// New creates a new YouTube connector.
+func New(sourceID string, cfg *Config, tokenProvider driven.TokenProvider) *Connector {
+	return &Connector{
+		sourceID:      sourceID,
+		config:        cfg,
+		tokenProvider: tokenProvider,
+		client:        NewClient(tokenProvider),
+	}
+}
+
+// SetAPIBudget charges the connector's API requests to a shared budget.
+func (c *Connector) SetAPIBudget(apiBudget driven.APIBudget) {
+	c.client.httpClient.Transport = budget.Transport(nil, apiBudget)
+}
+
+// SetLocale sends locale as Accept-Language on the connector's API requests.
+func (c *Connector) SetLocale(loc string) {
+	c.client.httpClient.Transport = locale.Transport(c.client.httpClient.Transport, loc)
+}
+
+// Type returns the connector type identifier.
+func (c *Connector) Type() string {
+	return "youtube"
+}
+
+// SourceID returns the source identifier.
+func (c *Connector) SourceID() string {
+	return c.sourceID
+}
+
+// Capabilities returns the connector's capabilities.
+func (c *Connector) Capabilities() driven.ConnectorCapabilities {
+	return driven.ConnectorCapabilities{
+		SupportsIncremental:  true,
+		SupportsWatch:        false,
+		SupportsHierarchy:    false,
+		SupportsBinary:       false,
+		RequiresAuth:         true,
+		SupportsValidation:   true,
+		SupportsCursorReturn: true,
+		SupportsPartialSync:  false,
+		SupportsRateLimiting: true,
+		SupportsPagination:   true,
+	}
+}
+
+// Validate checks the API key is accepted.
+func (c *Connector) Validate(ctx context.Context) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := c.client.CheckKey(ctx); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrAuthRequired, err)
+	}
+	return nil
+}
+
+// FullSync fetches the transcripts of all configured videos.
+func (c *Connector) FullSync(ctx context.Context) (
+	docs <-chan domain.RawDocument, errs <-chan error,
+) {
+	docsChan := make(chan domain.RawDocument)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(docsChan)
+		defer close(errsChan)
+		errsChan <- c.runFullSync(ctx, docsChan)
+	}()
+
+	return docsChan, errsChan
+}
+
+// runFullSync executes the full sync logic.
+func (c *Connector) runFullSync(ctx context.Context, docsChan chan<- domain.RawDocument) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+	slog.Debug("full sync started", slog.String("connector", c.Type()), slog.String("source_id", c.sourceID))
+
+	videoIDs, err := c.videoIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	cursor := NewCursor()
+	err = c.fetchTranscripts(ctx, videoIDs, func(doc *domain.RawDocument) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case docsChan <- *doc:
+			return nil
+		}
+	}, cursor)
+	if err != nil {
+		return err
+	}
+
+	return &driven.SyncComplete{NewCursor: cursor.Encode()}
+}
+
+// IncrementalSync fetches transcripts of videos added since the last sync
+// and removes videos no longer listed.
+func (c *Connector) IncrementalSync(
+	ctx context.Context, state domain.SyncState,
+) (changes <-chan domain.RawDocumentChange, errs <-chan error) {
+	changesChan := make(chan domain.RawDocumentChange)
+	errsChan := make(chan error, 1)
+
+	go func() {
+		defer close(changesChan)
+		defer close(errsChan)
+		errsChan <- c.runIncrementalSync(ctx, state, changesChan)
+	}()
+
+	return changesChan, errsChan
+}
+
+// runIncrementalSync executes the incremental sync logic.
+// Videos without a transcript are not recorded in the cursor, so their
+// captions are picked up once they become available.
+func (c *Connector) runIncrementalSync(
+	ctx context.Context, state domain.SyncState, changesChan chan<- domain.RawDocumentChange,
+) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
+
+	if state.Cursor == "" {
+		return fmt.Errorf("invalid cursor, full sync required: no cursor")
+	}
+	cursor, err := DecodeCursor(state.Cursor)
+	if err != nil {
+		return fmt.Errorf("invalid cursor, full sync required: %w", err)
+	}
+
+	videoIDs, err := c.videoIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	listed := make(map[string]bool, len(videoIDs))
+	var added []string
+	for _, id := range videoIDs {
+		listed[id] = true
+		if !cursor.Has(id) {
+			added = append(added, id)
+		}
+	}
+
+	next := NewCursor()
+	for _, id := range cursor.Videos {
+		if listed[id] {
+			next.Add(id)
+			continue
+		}
+		// Removed from its playlist, deleted, or removed from config
+		doc := domain.RawDocument{SourceID: c.sourceID, URI: VideoURI(id)}
+		if err := c.sendChange(ctx, changesChan, domain.ChangeDeleted, &doc); err != nil {
+			return err
+		}
+	}
+
+	err = c.fetchTranscripts(ctx, added, func(doc *domain.RawDocument) error {
+		return c.sendChange(ctx, changesChan, domain.ChangeCreated, doc)
+	}, next)
+	if err != nil {
+		return err
+	}
+
+	return &driven.SyncComplete{NewCursor: next.Encode()}
+}
+
+// videoIDs returns the IDs of every configured video, in config order
+// without duplicates: channel uploads, then playlists, then single videos.
+func (c *Connector) videoIDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	seen := make(map[string]bool)
+	add := func(videoIDs []string) {
+		for _, id := range videoIDs {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	playlists := make([]string, 0, len(c.config.Channels)+len(c.config.PlaylistIDs))
+	for _, channel := range c.config.Channels {
+		uploads, err := c.client.UploadsPlaylist(ctx, channel)
+		if err != nil {
+			return nil, fmt.Errorf("get uploads of channel %s: %w", channel, err)
+		}
+		playlists = append(playlists, uploads)
+	}
+	playlists = append(playlists, c.config.PlaylistIDs...)
+
+	for _, playlistID := range playlists {
+		videoIDs, err := c.client.PlaylistVideoIDs(ctx, playlistID)
+		if err != nil {
+			return nil, fmt.Errorf("list videos of playlist %s: %w", playlistID, err)
+		}
+		add(videoIDs)
+	}
+	add(c.config.VideoIDs)
+
+	return ids, nil
+}
+
+// fetchTranscripts fetches the metadata and transcript of each video and
+// passes those with a transcript to emit, recording them in the cursor.
+func (c *Connector) fetchTranscripts(
+	ctx context.Context, videoIDs []string, emit func(*domain.RawDocument) error, cursor *Cursor,
+) error {
+	if len(videoIDs) == 0 {
+		return nil
+	}
+
+	videos, err := c.client.Videos(ctx, videoIDs)
+	if err != nil {
+		return fmt.Errorf("get videos: %w", err)
+	}
+
+	for i := range videos {
+		video := &videos[i]
+		segments, err := c.client.Transcript(ctx, video.ID, c.config.Language)
+		if err != nil {
+			return err
+		}
+		if len(segments) == 0 {
+			continue
+		}
+
+		if err := emit(VideoToRawDocument(video, segments, c.config.Language, c.sourceID)); err != nil {
+			return err
+		}
+		cursor.Add(video.ID)
+	}
+	return nil
+}
+
+// sendChange sends a change to the channel or returns on context cancellation.
+func (c *Connector) sendChange(
+	ctx context.Context,
+	changesChan chan<- domain.RawDocumentChange,
+	changeType domain.ChangeType,
+	doc *domain.RawDocument,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case changesChan <- domain.RawDocumentChange{Type: changeType, Document: *doc}:
+		return nil
+	}
+}
+
+// checkClosed returns an error if the connector is closed.
+func (c *Connector) checkClosed() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return domain.ErrConnectorClosed
+	}
+	return nil
+}
+
+// Watch is not supported for YouTube.
+func (c *Connector) Watch(_ context.Context) (<-chan domain.RawDocumentChange, error) {
+	return nil, domain.ErrNotImplemented
+}
+
+// GetAccountIdentifier returns an empty string, as API keys are not tied
+// to an account.
+func (c *Connector) GetAccountIdentifier(_ context.Context, _ string) (string, error) {
+	return "", nil
+}
+
+// Close releases resources.
+func (c *Connector) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
@@ -0,0 +1,357 @@
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// mockTokenProvider implements driven.TokenProvider for testing.
+type mockTokenProvider struct {
+	token string
+}
+
+func (m *mockTokenProvider) GetToken(_ context.Context) (string, error) {
+	return m.token, nil
+}
+
+func (m *mockTokenProvider) AuthorizationID() string {
+	return "creds-1"
+}
+
+func (m *mockTokenProvider) AuthMethod() domain.AuthMethod {
+	return domain.AuthMethodPAT
+}
+
+func (m *mockTokenProvider) IsAuthenticated() bool {
+	return m.token != ""
+}
+
+func (m *mockTokenProvider) RefreshIfNeeded(_ context.Context) error {
+	return nil
+}
+
+// stubYouTube serves one channel's uploads from the Data API and their
+// transcripts from the timedtext endpoint.
+type stubYouTube struct {
+	mu          sync.Mutex
+	uploads     []string
+	transcripts map[string]string
+	keys        []string
+	pageSize    int
+}
+
+func (s *stubYouTube) setUploads(ids ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.uploads = ids
+}
+
+func (s *stubYouTube) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+	if r.URL.Path == "/timedtext" {
+		if query.Get("lang") != "en" {
+			return
+		}
+		_, _ = w.Write([]byte(s.transcripts[query.Get("v")]))
+		return
+	}
+
+	s.keys = append(s.keys, r.Header.Get("X-Goog-Api-Key"))
+	if r.Header.Get("X-Goog-Api-Key") != "api-key" {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":{"message":"API key not valid","errors":[{"reason":"keyInvalid"}]}}`))
+		return
+	}
+
+	var body any
+	switch r.URL.Path {
+	case "/i18nLanguages":
+		body = map[string]any{"items": []any{}}
+	case "/channels":
+		if query.Get("forHandle") != "@talks" {
+			body = map[string]any{"items": []any{}}
+			break
+		}
+		body = map[string]any{"items": []map[string]any{{
+			"contentDetails": map[string]any{"relatedPlaylists": map[string]any{"uploads": "UUtalks"}},
+		}}}
+	case "/playlistItems":
+		body = s.playlistPage(query.Get("pageToken"))
+	case "/videos":
+		var items []map[string]any
+		for _, id := range strings.Split(query.Get("id"), ",") {
+			if id == "privateVid1" {
+				continue
+			}
+			items = append(items, map[string]any{
+				"id": id,
+				"snippet": map[string]any{
+					"title":        "Talk " + id,
+					"channelId":    "UCtalks",
+					"channelTitle": "Conference Talks",
+					"publishedAt":  "2024-05-01T09:30:00Z",
+				},
+			})
+		}
+		body = map[string]any{"items": items}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// playlistPage returns a page of the uploads playlist.
+func (s *stubYouTube) playlistPage(pageToken string) map[string]any {
+	start := 0
+	if pageToken != "" {
+		start = len(pageToken)
+	}
+	end := len(s.uploads)
+	if s.pageSize > 0 {
+		end = min(start+s.pageSize, end)
+	}
+
+	var items []map[string]any
+	for _, id := range s.uploads[start:end] {
+		items = append(items, map[string]any{"contentDetails": map[string]any{"videoId": id}})
+	}
+	page := map[string]any{"items": items}
+	if end < len(s.uploads) {
+		page["nextPageToken"] = strings.Repeat("x", end)
+	}
+	return page
+}
+
+func transcript(lines ...string) string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="utf-8" ?><transcript>`)
+	b.WriteString(strings.Join(lines, ""))
+	b.WriteString(`</transcript>`)
+	return b.String()
+}
+
+func newTestConnector(t *testing.T, stub *stubYouTube, cfg *Config) *Connector {
+	t.Helper()
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+
+	conn := New("source-1", cfg, &mockTokenProvider{token: "api-key"})
+	conn.client.baseURL = server.URL
+	conn.client.transcriptURL = server.URL + "/timedtext"
+	return conn
+}
+
+func channelConfig() *Config {
+	cfg := DefaultConfig()
+	cfg.Channels = []string{"@talks"}
+	return cfg
+}
+
+func collectDocs(docs <-chan domain.RawDocument, errs <-chan error) ([]domain.RawDocument, error) {
+	var collected []domain.RawDocument
+	for doc := range docs {
+		collected = append(collected, doc)
+	}
+	return collected, <-errs
+}
+
+func collectChanges(changes <-chan domain.RawDocumentChange, errs <-chan error) ([]domain.RawDocumentChange, error) {
+	var collected []domain.RawDocumentChange
+	for change := range changes {
+		collected = append(collected, change)
+	}
+	return collected, <-errs
+}
+
+func syncCursor(t *testing.T, err error) string {
+	t.Helper()
+	var complete *driven.SyncComplete
+	require.True(t, errors.As(err, &complete), "expected SyncComplete, got %v", err)
+	return complete.NewCursor
+}
+
+func TestConnector_Basics(t *testing.T) {
+	conn := New("source-1", channelConfig(), nil)
+
+	assert.Equal(t, "youtube", conn.Type())
+	assert.Equal(t, "source-1", conn.SourceID())
+	assert.True(t, conn.Capabilities().SupportsIncremental)
+	assert.True(t, conn.Capabilities().RequiresAuth)
+
+	_, err := conn.Watch(context.Background())
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+
+	id, err := conn.GetAccountIdentifier(context.Background(), "api-key")
+	require.NoError(t, err)
+	assert.Empty(t, id)
+
+	require.NoError(t, conn.Close())
+	assert.ErrorIs(t, conn.Validate(context.Background()), domain.ErrConnectorClosed)
+}
+
+func TestConnector_FullSync_EmitsTranscripts(t *testing.T) {
+	stub := &stubYouTube{
+		pageSize: 2,
+		transcripts: map[string]string{
+			"talkVideo01": transcript(
+				`<text start="0.5" dur="2.0">Welcome to the talk</text>`,
+				`<text start="3.1" dur="2.4">about search &amp;amp; indexing</text>`,
+				`<text start="65.0" dur="3.0">Now the demo.</text>`,
+			),
+			"talkVideo03": transcript(`<text start="0" dur="1">Hello</text>`),
+			"privateVid1": transcript(`<text start="0" dur="1">Hidden</text>`),
+		},
+	}
+	stub.setUploads("talkVideo01", "talkVideo02", "privateVid1", "talkVideo03")
+	cfg := channelConfig()
+	cfg.VideoIDs = []string{"talkVideo01"}
+	conn := newTestConnector(t, stub, cfg)
+
+	docs, err := collectDocs(conn.FullSync(context.Background()))
+	cursor := syncCursor(t, err)
+
+	// talkVideo02 has no transcript, privateVid1 has no metadata, and the
+	// configured video duplicates an upload
+	require.Len(t, docs, 2)
+	doc := docs[0]
+	assert.Equal(t, "youtube://videos/talkVideo01", doc.URI)
+	assert.Equal(t, "text/markdown", doc.MIMEType)
+	assert.Equal(t, "source-1", doc.SourceID)
+	assert.Equal(t, "Talk talkVideo01", doc.Metadata["title"])
+	assert.Equal(t, "Conference Talks", doc.Metadata["channel"])
+	assert.Equal(t, "UCtalks", doc.Metadata["channel_id"])
+	assert.Equal(t, "2024-05-01T09:30:00Z", doc.Metadata["published"])
+	assert.Equal(t, "en", doc.Metadata["language"])
+	assert.Equal(t, "https://www.youtube.com/watch?v=talkVideo01", doc.Metadata["url"])
+	assert.Equal(t,
+		"# Talk talkVideo01\n\n[0:00] Welcome to the talk about search & indexing\n[1:05] Now the demo.\n",
+		string(doc.Content))
+	assert.Equal(t, "youtube://videos/talkVideo03", docs[1].URI)
+
+	decoded, err := DecodeCursor(cursor)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"talkVideo01", "talkVideo03"}, decoded.Videos)
+
+	for _, key := range stub.keys {
+		assert.Equal(t, "api-key", key)
+	}
+}
+
+func TestConnector_IncrementalSync_TracksVideoIDs(t *testing.T) {
+	stub := &stubYouTube{transcripts: map[string]string{
+		"talkVideo01": transcript(`<text start="0" dur="1">One</text>`),
+		"talkVideo02": transcript(`<text start="0" dur="1">Two</text>`),
+	}}
+	stub.setUploads("talkVideo01", "talkVideo02", "talkVideo03")
+	conn := newTestConnector(t, stub, channelConfig())
+	_, err := collectDocs(conn.FullSync(context.Background()))
+	cursor := syncCursor(t, err)
+
+	// talkVideo02 is removed, talkVideo03 gains captions and talkVideo04 is new
+	stub.setUploads("talkVideo04", "talkVideo01", "talkVideo03")
+	stub.transcripts["talkVideo03"] = transcript(`<text start="0" dur="1">Three</text>`)
+	stub.transcripts["talkVideo04"] = transcript(`<text start="0" dur="1">Four</text>`)
+	stub.transcripts["talkVideo01"] = transcript(`<text start="0" dur="1">Changed</text>`)
+
+	changes, err := collectChanges(conn.IncrementalSync(context.Background(), domain.SyncState{Cursor: cursor}))
+	next := syncCursor(t, err)
+
+	byURI := make(map[string]domain.RawDocumentChange)
+	for _, change := range changes {
+		byURI[change.Document.URI] = change
+	}
+	assert.Len(t, byURI, 3)
+	assert.NotContains(t, byURI, "youtube://videos/talkVideo01", "synced videos are not fetched again")
+	assert.Equal(t, domain.ChangeDeleted, byURI["youtube://videos/talkVideo02"].Type)
+	assert.Equal(t, domain.ChangeCreated, byURI["youtube://videos/talkVideo03"].Type)
+	assert.Equal(t, domain.ChangeCreated, byURI["youtube://videos/talkVideo04"].Type)
+	assert.Contains(t, string(byURI["youtube://videos/talkVideo04"].Document.Content), "Four")
+
+	decoded, err := DecodeCursor(next)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"talkVideo01", "talkVideo03", "talkVideo04"}, decoded.Videos)
+
+	// A further sync with nothing new emits nothing
+	changes, err = collectChanges(conn.IncrementalSync(context.Background(), domain.SyncState{Cursor: next}))
+	syncCursor(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestConnector_IncrementalSync_RequiresCursor(t *testing.T) {
+	conn := newTestConnector(t, &stubYouTube{}, channelConfig())
+
+	_, errs := conn.IncrementalSync(context.Background(), domain.SyncState{})
+
+	err := <-errs
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "full sync required")
+}
+
+func TestConnector_FullSync_UnknownChannel(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Channels = []string{"UCmissing"}
+	conn := newTestConnector(t, &stubYouTube{}, cfg)
+
+	_, err := collectDocs(conn.FullSync(context.Background()))
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestConnector_Validate(t *testing.T) {
+	stub := &stubYouTube{}
+	conn := newTestConnector(t, stub, channelConfig())
+	assert.NoError(t, conn.Validate(context.Background()))
+
+	conn.client.tokenProvider = &mockTokenProvider{token: "wrong"}
+	err := conn.Validate(context.Background())
+	assert.ErrorIs(t, err, domain.ErrAuthRequired)
+	assert.ErrorIs(t, err, domain.ErrAuthInvalid)
+}
+
+func TestClient_QuotaExceeded(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error":{"message":"quota","errors":[{"reason":"quotaExceeded"}]}}`))
+	}))
+	defer server.Close()
+	client := NewClient(&mockTokenProvider{token: "api-key"})
+	client.baseURL = server.URL
+
+	_, err := client.Videos(context.Background(), []string{"talkVideo01"})
+
+	assert.ErrorIs(t, err, domain.ErrRateLimited)
+}
+
+func TestParseTranscript(t *testing.T) {
+	segments, err := ParseTranscript([]byte(transcript(
+		`<text start="1.5" dur="2">it&amp;#39;s   here</text>`,
+		`<text start="4" dur="1">  </text>`,
+	)))
+
+	require.NoError(t, err)
+	require.Len(t, segments, 1)
+	assert.Equal(t, "it's here", segments[0].Text)
+	assert.Equal(t, 1500*time.Millisecond, segments[0].Start)
+	assert.Equal(t, 2*time.Second, segments[0].Duration)
+
+	_, err = ParseTranscript([]byte("<transcript><text"))
+	assert.Error(t, err)
+}
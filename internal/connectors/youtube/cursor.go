@@ -0,0 +1,80 @@
+package youtube
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sort"
+)
+
+// CursorVersion is the current cursor format version.
+const CursorVersion = 1
+
+// ErrInvalidCursor indicates the cursor could not be decoded.
+var ErrInvalidCursor = errors.New("youtube: invalid cursor format")
+
+// Cursor tracks the videos whose transcripts have been indexed.
+// Published videos rarely change, so incremental syncs only fetch videos
+// missing from the cursor and remove those no longer listed.
+type Cursor struct {
+	// Version is the cursor format version for future compatibility.
+	Version int `json:"v"`
+	// Videos holds the IDs of the indexed videos.
+	Videos []string `json:"videos"`
+}
+
+// NewCursor creates a new empty cursor.
+func NewCursor() *Cursor {
+	return &Cursor{Version: CursorVersion}
+}
+
+// Encode serialises the cursor to a base64 string for storage.
+func (c *Cursor) Encode() string {
+	sort.Strings(c.Videos)
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// DecodeCursor deserialises a cursor from a base64 string.
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return NewCursor(), nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	// Version check for future migrations
+	if cursor.Version > CursorVersion {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+// Has returns true if the video has been indexed.
+func (c *Cursor) Has(videoID string) bool {
+	for _, id := range c.Videos {
+		if id == videoID {
+			return true
+		}
+	}
+	return false
+}
+
+// Add records a video as indexed.
+func (c *Cursor) Add(videoID string) {
+	if !c.Has(videoID) {
+		c.Videos = append(c.Videos, videoID)
+	}
+}
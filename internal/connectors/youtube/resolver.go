@@ -0,0 +1,16 @@
+package youtube
+
+import (
+	"strings"
+)
+
+// ResolveWebURL converts a YouTube URI to a web URL for the user.
+// URI pattern: youtube://videos/{video_id}
+//
+// Returns empty string if the URI cannot be resolved.
+func ResolveWebURL(uri string, _ map[string]any) string {
+	if id, ok := strings.CutPrefix(uri, "youtube://videos/"); ok && id != "" {
+		return "https://www.youtube.com/watch?v=" + id
+	}
+	return ""
+}
@@ -0,0 +1,74 @@
+package youtube
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mimeTypeMarkdown is the MIME type of documents emitted by this connector.
+// Transcripts are rendered as Markdown so the title heads the document.
+const mimeTypeMarkdown = "text/markdown"
+
+// paragraphLength is the stretch of video each transcript paragraph covers.
+const paragraphLength = time.Minute
+
+// EmittedMIMETypes returns the MIME types the YouTube connector emits.
+func EmittedMIMETypes() []string {
+	return []string{mimeTypeMarkdown}
+}
+
+// VideoURI returns the internal URI of a video.
+func VideoURI(videoID string) string {
+	return fmt.Sprintf("youtube://videos/%s", videoID)
+}
+
+// VideoToRawDocument converts a video and its transcript to a RawDocument.
+// The transcript is split into paragraphs of about a minute, each starting
+// with its timestamp so search hits can be found in the video.
+func VideoToRawDocument(video *Video, segments []Segment, language, sourceID string) *domain.RawDocument {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", video.Snippet.Title)
+
+	paragraphEnd := time.Duration(-1)
+	for _, segment := range segments {
+		if segment.Start >= paragraphEnd {
+			fmt.Fprintf(&b, "\n[%s]", formatTimestamp(segment.Start))
+			paragraphEnd = segment.Start.Truncate(paragraphLength) + paragraphLength
+		}
+		b.WriteString(" ")
+		b.WriteString(segment.Text)
+	}
+	b.WriteString("\n")
+
+	metadata := map[string]any{
+		"video_id":   video.ID,
+		"title":      video.Snippet.Title,
+		"channel":    video.Snippet.ChannelTitle,
+		"channel_id": video.Snippet.ChannelID,
+		"language":   language,
+		"url":        "https://www.youtube.com/watch?v=" + video.ID,
+	}
+	if !video.Snippet.PublishedAt.IsZero() {
+		metadata["published"] = video.Snippet.PublishedAt.Format(time.RFC3339)
+	}
+
+	return &domain.RawDocument{
+		SourceID: sourceID,
+		URI:      VideoURI(video.ID),
+		MIMEType: mimeTypeMarkdown,
+		Content:  []byte(b.String()),
+		Metadata: metadata,
+	}
+}
+
+// formatTimestamp formats an offset into a video as m:ss or h:mm:ss.
+func formatTimestamp(d time.Duration) string {
+	seconds := int(d / time.Second)
+	if seconds >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds%3600/60, seconds%60)
+	}
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
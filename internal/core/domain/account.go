@@ -0,0 +1,27 @@
+package domain
+
+// Account groups the sources that authenticate as the same user with a provider.
+// Accounts are not stored: they are derived from Credentials.AccountIdentifier,
+// so re-authenticating a source as a different user moves it to another account.
+//
+// Example: "user@company.com" on Microsoft may back both a OneDrive and an
+// Outlook source, while "user@gmail.com" on Google backs a Gmail source.
+type Account struct {
+	// Identifier is the user's email or username from the provider.
+	// Empty when the provider did not report one.
+	Identifier string `json:"identifier"`
+	// ProviderType identifies the provider the account belongs to.
+	ProviderType ProviderType `json:"provider_type"`
+	// CredentialsIDs lists the credentials authenticated as this account.
+	CredentialsIDs []string `json:"credentials_ids"`
+	// Sources lists the sources using this account.
+	Sources []Source `json:"sources"`
+}
+
+// DisplayName returns the identifier, or a placeholder if it is unknown.
+func (a *Account) DisplayName() string {
+	if a.Identifier == "" {
+		return "(unknown account)"
+	}
+	return a.Identifier
+}
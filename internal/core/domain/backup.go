@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// BackupFormatVersion is the version of the backup archive layout.
+// It is bumped when the archive contents change incompatibly.
+// Version 2 leaves secrets out of the archive and adds the configuration file.
+const BackupFormatVersion = 2
+
+// BackupManifest describes a backup archive.
+// It is stored in the archive alongside the database and index files.
+type BackupManifest struct {
+	// FormatVersion is the archive layout version (see BackupFormatVersion).
+	FormatVersion int `json:"format_version"`
+	// SchemaVersion is the metadata database schema version at backup time.
+	SchemaVersion int `json:"schema_version"`
+	// CreatedAt is when the backup was taken.
+	CreatedAt time.Time `json:"created_at"`
+	// LastSync is when a source last finished syncing before the backup was
+	// taken. Zero if no source had synced.
+	LastSync time.Time `json:"last_sync,omitzero"`
+	// Config reports whether the archive contains the configuration file.
+	Config bool `json:"config,omitempty"`
+}
+
+// RestoreOptions configures a restore.
+type RestoreOptions struct {
+	// Force restores the backup even if the live data is newer.
+	Force bool
+}
+
+// RestoreResult summarises a completed restore.
+type RestoreResult struct {
+	// Manifest describes the restored backup.
+	Manifest BackupManifest
+	// Documents is the number of documents re-indexed for search.
+	Documents int
+	// Chunks is the number of chunks re-indexed for search.
+	Chunks int
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+)
+
+// JSONSchemaDraft07 is the meta-schema URI emitted by ConfigSchema.
+const JSONSchemaDraft07 = "http://json-schema.org/draft-07/schema#"
+
+// ConfigSchema returns a JSON Schema (draft-07) describing the connector's
+// configuration as a JSON object, for generating and validating config forms.
+//
+// Boolean and integer fields are typed accordingly and list fields are arrays
+// of strings; when saved to a source they are joined back into the
+// comma-separated strings connectors read. Unknown keys are rejected.
+func (c *ConnectorType) ConfigSchema() map[string]any {
+	properties := make(map[string]any, len(c.ConfigKeys))
+	required := []string{}
+	for _, key := range c.ConfigKeys {
+		properties[key.Key] = key.schema()
+		if key.Required {
+			required = append(required, key.Key)
+		}
+	}
+
+	return map[string]any{
+		"$schema":              JSONSchemaDraft07,
+		"title":                c.Name,
+		"description":          c.Description,
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
+
+// schema returns the JSON Schema for a single config field.
+func (k ConfigKey) schema() map[string]any {
+	prop := map[string]any{
+		"title": k.Label,
+	}
+	if k.Description != "" {
+		prop["description"] = k.Description
+	}
+
+	switch k.ValueType() {
+	case ConfigValueBool:
+		prop["type"] = "boolean"
+	case ConfigValueInt:
+		prop["type"] = "integer"
+	case ConfigValueList:
+		items := map[string]any{"type": "string"}
+		if len(k.Options) > 0 {
+			items["enum"] = k.Options
+		}
+		prop["type"] = "array"
+		prop["items"] = items
+		prop["uniqueItems"] = true
+	default:
+		prop["type"] = "string"
+		if len(k.Options) > 0 {
+			prop["enum"] = k.Options
+		}
+	}
+
+	if k.Secret {
+		prop["writeOnly"] = true
+	}
+	if def, ok := k.defaultValue(); ok {
+		prop["default"] = def
+	}
+	return prop
+}
+
+// defaultValue converts the string default to the field's JSON type.
+// Reports false if there is no default or it does not parse.
+func (k ConfigKey) defaultValue() (any, bool) {
+	if k.Default == "" {
+		return nil, false
+	}
+
+	switch k.ValueType() {
+	case ConfigValueBool:
+		b, err := strconv.ParseBool(k.Default)
+		return b, err == nil
+	case ConfigValueInt:
+		n, err := strconv.Atoi(k.Default)
+		return n, err == nil
+	case ConfigValueList:
+		items := strings.Split(k.Default, ",")
+		for i := range items {
+			items[i] = strings.TrimSpace(items[i])
+		}
+		return items, true
+	default:
+		return k.Default, true
+	}
+}
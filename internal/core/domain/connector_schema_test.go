@@ -0,0 +1,197 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validateConfig checks instance against the subset of draft-07 that
+// ConfigSchema emits: type, properties, required, additionalProperties,
+// enum, items and uniqueItems. Both values are round-tripped through JSON
+// so the check sees exactly what a form would receive.
+func validateConfig(t *testing.T, schema map[string]any, instance any) error {
+	t.Helper()
+	return validateValue(roundTrip(t, schema), roundTrip(t, instance), "config")
+}
+
+func roundTrip(t *testing.T, v any) any {
+	t.Helper()
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	var out any
+	require.NoError(t, json.Unmarshal(data, &out))
+	return out
+}
+
+func validateValue(schema, value any, path string) error {
+	s := schema.(map[string]any)
+
+	switch s["type"] {
+	case "object":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: expected object", path)
+		}
+		props, _ := s["properties"].(map[string]any)
+		for _, name := range s["required"].([]any) {
+			if _, ok := obj[name.(string)]; !ok {
+				return fmt.Errorf("%s: missing required property %q", path, name)
+			}
+		}
+		for name, v := range obj {
+			prop, ok := props[name]
+			if !ok {
+				if s["additionalProperties"] == false {
+					return fmt.Errorf("%s: unexpected property %q", path, name)
+				}
+				continue
+			}
+			if err := validateValue(prop, v, path+"."+name); err != nil {
+				return err
+			}
+		}
+	case "array":
+		arr, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%s: expected array", path)
+		}
+		for i, item := range arr {
+			if s["uniqueItems"] == true && slices.Contains(arr[:i], item) {
+				return fmt.Errorf("%s: duplicate item %v", path, item)
+			}
+			if err := validateValue(s["items"], item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%s: expected string", path)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s: expected boolean", path)
+		}
+	case "integer":
+		n, ok := value.(float64)
+		if !ok || n != float64(int64(n)) {
+			return fmt.Errorf("%s: expected integer", path)
+		}
+	}
+
+	if enum, ok := s["enum"].([]any); ok && !slices.Contains(enum, value) {
+		return fmt.Errorf("%s: %v is not one of %v", path, value, enum)
+	}
+	return nil
+}
+
+func schemaTestConnector() ConnectorType {
+	return ConnectorType{
+		ID:          "github",
+		Name:        "GitHub",
+		Description: "Index repositories",
+		ConfigKeys: []ConfigKey{
+			{Key: "repo", Label: "Repository", Required: true},
+			{
+				Key:     "content_types",
+				Label:   "Content Types",
+				Default: "files, issues",
+				Type:    ConfigValueList,
+				Options: []string{"files", "issues", "prs", "wikis"},
+			},
+			{Key: "parallel", Label: "Parallel", Default: "false", Type: ConfigValueBool},
+			{Key: "page_size", Label: "Page Size", Default: "100", Type: ConfigValueInt},
+			{Key: "token", Label: "Token", Secret: true},
+		},
+	}
+}
+
+func TestConnectorType_ConfigSchema(t *testing.T) {
+	c := schemaTestConnector()
+
+	schema := c.ConfigSchema()
+
+	assert.Equal(t, JSONSchemaDraft07, schema["$schema"])
+	assert.Equal(t, "GitHub", schema["title"])
+	assert.Equal(t, "object", schema["type"])
+	assert.Equal(t, []string{"repo"}, schema["required"])
+	assert.Equal(t, false, schema["additionalProperties"])
+
+	props := schema["properties"].(map[string]any)
+	assert.Equal(t, map[string]any{
+		"title":       "Content Types",
+		"type":        "array",
+		"items":       map[string]any{"type": "string", "enum": []string{"files", "issues", "prs", "wikis"}},
+		"uniqueItems": true,
+		"default":     []string{"files", "issues"},
+	}, props["content_types"])
+	assert.Equal(t, map[string]any{"title": "Parallel", "type": "boolean", "default": false}, props["parallel"])
+	assert.Equal(t, map[string]any{"title": "Page Size", "type": "integer", "default": 100}, props["page_size"])
+	assert.Equal(t, map[string]any{"title": "Token", "type": "string", "writeOnly": true}, props["token"])
+}
+
+func TestConnectorType_ConfigSchema_NoRequiredKeys(t *testing.T) {
+	c := ConnectorType{ID: "empty", Name: "Empty"}
+
+	data, err := json.Marshal(c.ConfigSchema())
+
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"required":[]`)
+}
+
+func TestConnectorType_ConfigSchema_ValidatesConfig(t *testing.T) {
+	c := schemaTestConnector()
+	schema := c.ConfigSchema()
+
+	valid := map[string]any{
+		"repo":          "custodia-labs/sercha-cli",
+		"content_types": []string{"files", "prs"},
+		"parallel":      true,
+		"page_size":     50,
+	}
+	assert.NoError(t, validateConfig(t, schema, valid))
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		errMsg string
+	}{
+		{
+			name:   "missing required key",
+			config: map[string]any{"content_types": []string{"files"}},
+			errMsg: `missing required property "repo"`,
+		},
+		{
+			name:   "unknown content type",
+			config: map[string]any{"repo": "r", "content_types": []string{"gists"}},
+			errMsg: "is not one of",
+		},
+		{
+			name:   "boolean as string",
+			config: map[string]any{"repo": "r", "parallel": "yes"},
+			errMsg: "expected boolean",
+		},
+		{
+			name:   "unknown key",
+			config: map[string]any{"repo": "r", "branch": "main"},
+			errMsg: `unexpected property "branch"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateConfig(t, schema, tt.config)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestConfigKey_ValueType(t *testing.T) {
+	assert.Equal(t, ConfigValueString, ConfigKey{}.ValueType())
+	assert.Equal(t, ConfigValueBool, ConfigKey{Type: ConfigValueBool}.ValueType())
+}
@@ -33,6 +33,18 @@ type ConnectorType struct {
 	// WebURLResolver converts document URIs to web-openable URLs.
 	// If nil, falls back to legacy URI conversion.
 	WebURLResolver WebURLResolver
+	// EmittedMIMETypes lists the MIME types this connector can produce.
+	// Used to check that every emitted type has a registered normaliser.
+	EmittedMIMETypes []string
+}
+
+// UnhandledMIMEType records a MIME type a connector can emit
+// that no registered normaliser supports.
+type UnhandledMIMEType struct {
+	// ConnectorType is the connector that emits the type.
+	ConnectorType string
+	// MIMEType is the type with no normaliser.
+	MIMEType string
 }
 
 // WebURLResolver converts a document URI to a web-openable URL.
@@ -61,4 +73,32 @@ type ConfigKey struct {
 	Required bool
 	// Secret indicates whether this field should be masked in UI (e.g., tokens).
 	Secret bool
+	// Type is the kind of value this field holds. Empty means ConfigValueString.
+	// Values are always stored as strings; Type describes how they are parsed.
+	Type ConfigValueType
+	// Options lists the accepted values when they are known ahead of time.
+	// For list fields each comma-separated item must be one of them.
+	Options []string
+}
+
+// ConfigValueType describes how a connector reads a configuration value.
+type ConfigValueType string
+
+const (
+	// ConfigValueString is free-form text.
+	ConfigValueString ConfigValueType = "string"
+	// ConfigValueBool is "true" or "false".
+	ConfigValueBool ConfigValueType = "boolean"
+	// ConfigValueInt is a whole number.
+	ConfigValueInt ConfigValueType = "integer"
+	// ConfigValueList is a comma-separated list of strings.
+	ConfigValueList ConfigValueType = "list"
+)
+
+// ValueType returns the field's type, defaulting to ConfigValueString.
+func (k ConfigKey) ValueType() ConfigValueType {
+	if k.Type == "" {
+		return ConfigValueString
+	}
+	return k.Type
 }
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"unicode/utf8"
+)
+
+// Default LLM context budget, sized for small local models.
+const (
+	DefaultMaxContextTokens    = 8192
+	DefaultAnswerReserveTokens = 1024
+)
+
+// charsPerToken approximates how many characters a token covers in English
+// text across common tokenisers.
+const charsPerToken = 4
+
+// EstimateTokens approximates the number of tokens text uses in an LLM
+// prompt. It errs on the side of overestimating so a budget is not exceeded.
+func EstimateTokens(text string) int {
+	return (utf8.RuneCountInString(text) + charsPerToken - 1) / charsPerToken
+}
+
+// SplitByTokens splits text into consecutive pieces that each estimate to at
+// most maxTokens tokens. Pieces break between characters, not words, so text
+// without spaces is split too. Text within the limit is returned whole.
+func SplitByTokens(text string, maxTokens int) []string {
+	if maxTokens <= 0 || EstimateTokens(text) <= maxTokens {
+		return []string{text}
+	}
+
+	limit := maxTokens * charsPerToken
+	var pieces []string
+	for text != "" {
+		end, runes := 0, 0
+		for end < len(text) && runes < limit {
+			_, size := utf8.DecodeRuneInString(text[end:])
+			end += size
+			runes++
+		}
+		pieces = append(pieces, text[:end])
+		text = text[end:]
+	}
+	return pieces
+}
+
+// ContextBudget limits how much retrieved content is sent to an LLM.
+type ContextBudget struct {
+	// MaxTokens is the model's context window.
+	MaxTokens int
+
+	// ReserveTokens is kept free for the model's answer.
+	ReserveTokens int
+}
+
+// Validate checks the budget leaves room for a prompt.
+func (b ContextBudget) Validate() error {
+	if b.MaxTokens <= 0 {
+		return fmt.Errorf("%w: max_context_tokens must be positive, got %d", ErrInvalidInput, b.MaxTokens)
+	}
+	if b.ReserveTokens < 0 || b.ReserveTokens >= b.MaxTokens {
+		return fmt.Errorf("%w: answer_reserve_tokens must be at least 0 and less than max_context_tokens (%d), got %d",
+			ErrInvalidInput, b.MaxTokens, b.ReserveTokens)
+	}
+	return nil
+}
+
+// Available returns the tokens left for retrieved chunks once the prompt
+// template and the answer reserve are accounted for. Never negative.
+func (b ContextBudget) Available(prompt string) int {
+	return max(b.MaxTokens-b.ReserveTokens-EstimateTokens(prompt), 0)
+}
+
+// SelectResults returns the highest-scoring results whose chunks fit the
+// budget alongside prompt, ordered by descending score. Results are dropped
+// lowest score first until the rest fit.
+func (b ContextBudget) SelectResults(prompt string, results []SearchResult) []SearchResult {
+	sorted := slices.Clone(results)
+	slices.SortStableFunc(sorted, func(x, y SearchResult) int {
+		return cmp.Compare(y.Score, x.Score)
+	})
+
+	available := b.Available(prompt)
+	used := 0
+	for i := range sorted {
+		used += EstimateTokens(sorted[i].Chunk.Content)
+		if used > available {
+			return sorted[:i]
+		}
+	}
+	return sorted
+}
@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func budgetResult(id string, score float64, tokens int) SearchResult {
+	return SearchResult{
+		Document: Document{ID: id},
+		Chunk:    Chunk{ID: id, Content: strings.Repeat("word", tokens)},
+		Score:    score,
+	}
+}
+
+func selectedIDs(results []SearchResult) []string {
+	ids := make([]string, len(results))
+	for i := range results {
+		ids[i] = results[i].Chunk.ID
+	}
+	return ids
+}
+
+func TestEstimateTokens(t *testing.T) {
+	assert.Equal(t, 0, EstimateTokens(""))
+	assert.Equal(t, 1, EstimateTokens("a"))
+	assert.Equal(t, 1, EstimateTokens("abcd"))
+	assert.Equal(t, 2, EstimateTokens("abcde"))
+	assert.Equal(t, 1, EstimateTokens("日本語"), "counts characters, not bytes")
+}
+
+func TestSplitByTokens(t *testing.T) {
+	assert.Equal(t, []string{"short"}, SplitByTokens("short", 2))
+	assert.Equal(t, []string{"abcdefgh"}, SplitByTokens("abcdefgh", 0), "no limit")
+
+	pieces := SplitByTokens(strings.Repeat("x", 10), 1)
+	assert.Equal(t, []string{"xxxx", "xxxx", "xx"}, pieces)
+
+	pieces = SplitByTokens(strings.Repeat("日本", 5), 1)
+	require.Len(t, pieces, 3)
+	for _, piece := range pieces {
+		assert.True(t, utf8.ValidString(piece), "splits between characters")
+		assert.LessOrEqual(t, EstimateTokens(piece), 1)
+	}
+	assert.Equal(t, strings.Repeat("日本", 5), strings.Join(pieces, ""))
+}
+
+func TestContextBudget_Validate(t *testing.T) {
+	assert.NoError(t, ContextBudget{MaxTokens: 8192, ReserveTokens: 1024}.Validate())
+	assert.NoError(t, ContextBudget{MaxTokens: 8192}.Validate())
+
+	for _, b := range []ContextBudget{
+		{MaxTokens: 0},
+		{MaxTokens: 100, ReserveTokens: -1},
+		{MaxTokens: 100, ReserveTokens: 100},
+	} {
+		assert.ErrorIs(t, b.Validate(), ErrInvalidInput, "%+v", b)
+	}
+}
+
+func TestContextBudget_Available(t *testing.T) {
+	b := ContextBudget{MaxTokens: 100, ReserveTokens: 20}
+
+	assert.Equal(t, 80, b.Available(""))
+	assert.Equal(t, 70, b.Available(strings.Repeat("word", 10)))
+	assert.Equal(t, 0, b.Available(strings.Repeat("word", 500)))
+}
+
+func TestContextBudget_SelectResults_DropsLowestScores(t *testing.T) {
+	b := ContextBudget{MaxTokens: 100, ReserveTokens: 20}
+	prompt := strings.Repeat("word", 10) // 70 tokens left
+	results := []SearchResult{
+		budgetResult("low", 0.1, 30),
+		budgetResult("high", 0.9, 30),
+		budgetResult("mid", 0.5, 30),
+	}
+
+	selected := b.SelectResults(prompt, results)
+
+	assert.Equal(t, []string{"high", "mid"}, selectedIDs(selected))
+	assert.Equal(t, "low", results[0].Chunk.ID, "input is not reordered")
+}
+
+func TestContextBudget_SelectResults_AllFit(t *testing.T) {
+	b := ContextBudget{MaxTokens: 1000, ReserveTokens: 100}
+	results := []SearchResult{budgetResult("a", 0.2, 10), budgetResult("b", 0.8, 10)}
+
+	assert.Equal(t, []string{"b", "a"}, selectedIDs(b.SelectResults("", results)))
+}
+
+func TestContextBudget_SelectResults_NoRoom(t *testing.T) {
+	b := ContextBudget{MaxTokens: 100, ReserveTokens: 50}
+	results := []SearchResult{budgetResult("a", 0.9, 60)}
+
+	assert.Empty(t, b.SelectResults("", results))
+	assert.Empty(t, b.SelectResults("", nil))
+}
+
+func TestContextBudget_SelectResults_NeverExceedsBudget(t *testing.T) {
+	prompt := "Answer the question using the context below.\n\n%s"
+	var results []SearchResult
+	for i := range 50 {
+		results = append(results, budgetResult(fmt.Sprintf("c%d", i), float64((i*37)%50), 5+(i*13)%40))
+	}
+
+	for _, maxTokens := range []int{64, 200, 512, 1000, 4096} {
+		b := ContextBudget{MaxTokens: maxTokens, ReserveTokens: maxTokens / 4}
+		selected := b.SelectResults(prompt, results)
+
+		used := EstimateTokens(prompt) + b.ReserveTokens
+		minSelected := 1.0e9
+		for _, r := range selected {
+			used += EstimateTokens(r.Chunk.Content)
+			minSelected = min(minSelected, r.Score)
+		}
+		require.LessOrEqual(t, used, maxTokens, "budget %d", maxTokens)
+
+		// Every dropped chunk scores no higher than every selected chunk
+		kept := make(map[string]bool)
+		for _, r := range selected {
+			kept[r.Chunk.ID] = true
+		}
+		for _, r := range results {
+			if !kept[r.Chunk.ID] && len(selected) > 0 {
+				assert.LessOrEqual(t, r.Score, minSelected, "budget %d dropped %s", maxTokens, r.Chunk.ID)
+			}
+		}
+	}
+}
+
+func TestLLMSettings_ContextBudget(t *testing.T) {
+	settings := DefaultAppSettings()
+
+	budget := settings.LLM.ContextBudget()
+
+	assert.Equal(t, ContextBudget{MaxTokens: DefaultMaxContextTokens, ReserveTokens: DefaultAnswerReserveTokens}, budget)
+	assert.NoError(t, budget.Validate())
+}
@@ -3,14 +3,16 @@ package domain
 import "time"
 
 // Credentials stores user-specific authentication tokens for a Source.
-// Each Source has exactly one Credentials (or none for no-auth sources like filesystem).
+// Each Source links to one Credentials (or none for no-auth sources like filesystem).
+// Sources that reuse an account share its Credentials; the owning SourceID is
+// handed over to another source when the owner is removed.
 //
 // This separates user tokens from OAuth app credentials (stored in AuthProvider),
 // enabling one OAuth app to serve multiple user accounts.
 type Credentials struct {
 	// ID is the unique identifier (UUID).
 	ID string `json:"id"`
-	// SourceID links to the Source this credentials belongs to (1:1 relationship).
+	// SourceID links to the Source that owns these credentials.
 	SourceID string `json:"source_id"`
 
 	// AccountIdentifier is the user's email or username from the provider.
@@ -0,0 +1,45 @@
+package domain
+
+// Diagnostics describes the local installation for bug reports. It holds no
+// credentials and no full paths: stores are identified by their last path
+// component only.
+type Diagnostics struct {
+	// Version is the sercha version.
+	Version string `json:"version"`
+	// OS and Arch are the platform sercha was built for.
+	OS   string `json:"os"`
+	Arch string `json:"arch"`
+	// GoVersion is the Go runtime version.
+	GoVersion string `json:"go_version"`
+	// SQLiteVersion and XapianVersion are the library versions in use.
+	// Empty if the library could not report its version.
+	SQLiteVersion string `json:"sqlite_version"`
+	XapianVersion string `json:"xapian_version"`
+
+	// SourcesByType counts configured sources per connector type.
+	SourcesByType map[string]int `json:"sources_by_type"`
+	// Documents is the number of indexed documents across all sources.
+	Documents int `json:"documents"`
+
+	// Database, SearchIndex and VectorIndex name each store's file or
+	// directory, without its parent directories.
+	Database    string `json:"database,omitempty"`
+	SearchIndex string `json:"search_index,omitempty"`
+	VectorIndex string `json:"vector_index,omitempty"`
+	// SearchIndexBytes and VectorIndexBytes are the index sizes on disk.
+	SearchIndexBytes int64 `json:"search_index_bytes"`
+	VectorIndexBytes int64 `json:"vector_index_bytes"`
+
+	// AIMode is "vector" when semantic search is available, else "text-only".
+	AIMode string `json:"ai_mode"`
+	// SearchMode is the configured search mode.
+	SearchMode SearchMode `json:"search_mode"`
+	// EmbeddingModel is the configured embedding model. Empty if none.
+	EmbeddingModel string `json:"embedding_model,omitempty"`
+}
+
+// AI modes reported by Diagnostics.
+const (
+	AIModeTextOnly = "text-only"
+	AIModeVector   = "vector"
+)
@@ -34,6 +34,41 @@ type Document struct {
 	UpdatedAt time.Time
 }
 
+// DocMetaLanguage is the document metadata key holding the detected
+// ISO 639-1 language code, set by the language post-processor.
+const DocMetaLanguage = "language"
+
+// DocMetaMIMEType is the document metadata key holding the MIME type of
+// the raw document it was normalised from.
+const DocMetaMIMEType = "mime_type"
+
+// DocMetaSummary is the document metadata key holding an LLM-written
+// summary of a long document, set by the summary post-processor.
+const DocMetaSummary = "summary"
+
+// DocMetaDuplicateOf is the document metadata key holding the ID of the
+// document this one near-duplicates, set by the dedup post-processor.
+const DocMetaDuplicateOf = "duplicate_of"
+
+// DocMetaHeadings is the document metadata key holding the document's
+// headings in order, each written as a markdown ATX heading (e.g.
+// "## Setup") with its text as it appears in Content. Set by normalisers
+// of structured formats and used by heading-aware chunking.
+const DocMetaHeadings = "headings"
+
+// Chunk metadata keys set by the chunker.
+const (
+	// ChunkMetaStartOffset is the byte offset where the chunk starts in the document content.
+	ChunkMetaStartOffset = "start_offset"
+
+	// ChunkMetaEndOffset is the byte offset where the chunk ends in the document content.
+	ChunkMetaEndOffset = "end_offset"
+
+	// ChunkMetaSection is the heading trail of the section the chunk belongs
+	// to (e.g. "Install > Linux"), set by heading-aware chunking.
+	ChunkMetaSection = "section"
+)
+
 // Chunk represents a searchable unit within a document.
 // Documents are split into chunks for granular search results.
 type Chunk struct {
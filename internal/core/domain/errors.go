@@ -1,6 +1,9 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // Domain errors represent business logic failures.
 // These are distinct from infrastructure errors.
@@ -23,6 +26,10 @@ var (
 	// ErrSyncInProgress indicates a sync is already running.
 	ErrSyncInProgress = errors.New("sync in progress")
 
+	// ErrSkipDocument is returned by a post-processor to drop a document from
+	// a sync. The document is counted as skipped rather than failed.
+	ErrSkipDocument = errors.New("document skipped")
+
 	// ErrLLMUnavailable indicates the LLM service is not configured.
 	// Features requiring LLM (query rewriting, summarisation) are disabled.
 	ErrLLMUnavailable = errors.New("LLM service unavailable")
@@ -67,4 +74,35 @@ var (
 
 	// ErrAuthProviderInUse indicates an auth provider cannot be deleted because sources depend on it.
 	ErrAuthProviderInUse = errors.New("auth provider is in use by one or more sources")
+
+	// Backup Errors.
+
+	// ErrInvalidBackup indicates a backup archive is malformed or incompatible.
+	ErrInvalidBackup = errors.New("invalid backup")
+
+	// ErrBackupOutdated indicates the live data has changed since a backup was taken.
+	ErrBackupOutdated = errors.New("backup is older than the live data")
 )
+
+// ReauthRequiredError indicates a source's stored credentials can no longer
+// be refreshed (e.g., the refresh token was revoked) and the user must
+// authenticate again before the source can sync.
+type ReauthRequiredError struct {
+	// SourceID is the source whose credentials need re-authentication.
+	SourceID string
+	// Err is the underlying refresh failure.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ReauthRequiredError) Error() string {
+	return fmt.Sprintf(
+		"credentials for source %s could not be refreshed (%v); re-authenticate with 'sercha source reauth %s'",
+		e.SourceID, e.Err, e.SourceID,
+	)
+}
+
+// Unwrap returns the underlying refresh failure.
+func (e *ReauthRequiredError) Unwrap() error {
+	return e.Err
+}
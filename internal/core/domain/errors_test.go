@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -264,3 +265,16 @@ func TestErrors_OperationErrors(t *testing.T) {
 		assert.NotEmpty(t, err.Error())
 	}
 }
+
+func TestReauthRequiredError(t *testing.T) {
+	cause := fmt.Errorf("%w: token endpoint rejected refresh token", ErrAuthExpired)
+	err := &ReauthRequiredError{SourceID: "src-1", Err: cause}
+
+	assert.Contains(t, err.Error(), "src-1")
+	assert.Contains(t, err.Error(), "sercha source reauth src-1")
+	assert.ErrorIs(t, err, ErrAuthExpired)
+
+	var target *ReauthRequiredError
+	assert.True(t, errors.As(fmt.Errorf("sync: %w", err), &target))
+	assert.Equal(t, "src-1", target.SourceID)
+}
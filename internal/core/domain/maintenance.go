@@ -0,0 +1,67 @@
+package domain
+
+// DiskUsage is the on-disk size in bytes of each persistent store.
+type DiskUsage struct {
+	// Database is the metadata database, including its write-ahead log.
+	Database int64
+	// SearchIndex is the full-text search index directory.
+	SearchIndex int64
+	// VectorIndex is the vector index directory.
+	VectorIndex int64
+}
+
+// Total returns the combined size of all stores.
+func (u DiskUsage) Total() int64 {
+	return u.Database + u.SearchIndex + u.VectorIndex
+}
+
+// VacuumResult summarises a completed vacuum.
+type VacuumResult struct {
+	// Before is the disk usage before vacuuming.
+	Before DiskUsage
+	// After is the disk usage after vacuuming.
+	After DiskUsage
+	// SearchIndexCompacted reports whether the search index was compacted.
+	// False when the search engine does not support compaction.
+	SearchIndexCompacted bool
+	// OrphanedVectors is the number of vectors removed because their chunk
+	// no longer exists in the document store.
+	OrphanedVectors int
+}
+
+// OptimizeResult summarises a completed index optimisation.
+type OptimizeResult struct {
+	// Before is the disk usage before optimising.
+	Before DiskUsage
+	// After is the disk usage after optimising.
+	After DiskUsage
+	// SearchIndexCompacted reports whether the search index was flushed and
+	// compacted. False when the search engine does not support compaction.
+	SearchIndexCompacted bool
+	// VectorIndexCompacted reports whether the vector index was compacted.
+	// False when there is no vector index or it does not support compaction.
+	VectorIndexCompacted bool
+}
+
+// CompactResult summarises a completed compaction.
+type CompactResult struct {
+	// Before is the disk usage before compacting.
+	Before DiskUsage
+	// After is the disk usage after compacting.
+	After DiskUsage
+	// OrphanedDocuments is the number of documents removed because their
+	// source no longer exists.
+	OrphanedDocuments int
+	// OrphanedChunks is the number of chunks removed from the search index
+	// because they no longer exist in the document store.
+	OrphanedChunks int
+	// OrphanedVectors is the number of vectors removed because their chunk
+	// no longer exists in the document store.
+	OrphanedVectors int
+	// SearchIndexCompacted reports whether the search index was compacted.
+	// False when the search engine does not support compaction.
+	SearchIndexCompacted bool
+	// VectorIndexCompacted reports whether the vector index was compacted.
+	// False when there is no vector index or it does not support compaction.
+	VectorIndexCompacted bool
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// SyncEvent identifies a sync outcome that can trigger a notification.
+type SyncEvent string
+
+const (
+	// SyncEventCompleted is sent when a source finishes syncing.
+	SyncEventCompleted SyncEvent = "completed"
+	// SyncEventFailed is sent when a source's sync returns an error.
+	SyncEventFailed SyncEvent = "failed"
+)
+
+// ParseSyncEvent validates a sync event name.
+func ParseSyncEvent(s string) (SyncEvent, error) {
+	switch event := SyncEvent(s); event {
+	case SyncEventCompleted, SyncEventFailed:
+		return event, nil
+	default:
+		return "", fmt.Errorf("%w: unknown sync event %q (valid: completed, failed)", ErrInvalidInput, s)
+	}
+}
+
+// SyncNotification describes a finished sync. It is the payload given to
+// notifiers and is sent as JSON by the webhook notifier.
+type SyncNotification struct {
+	// Event is the outcome of the sync.
+	Event SyncEvent `json:"event"`
+
+	// SourceID identifies the synced source.
+	SourceID string `json:"source_id"`
+
+	// SourceName is the source's display name. Empty if it could not be loaded.
+	SourceName string `json:"source_name,omitempty"`
+
+	// SourceType is the source's connector type.
+	SourceType string `json:"source_type,omitempty"`
+
+	// DocumentsProcessed, FailedCount and SkippedCount are the document counts
+	// reached before the sync finished.
+	DocumentsProcessed int `json:"documents_processed"`
+	FailedCount        int `json:"failed_count"`
+	SkippedCount       int `json:"skipped_count"`
+
+	// Error is the sync error. Empty for completed syncs.
+	Error string `json:"error,omitempty"`
+
+	// StartedAt and FinishedAt bound the sync.
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+}
+
+// Title returns a one-line summary suitable for a desktop notification.
+func (n *SyncNotification) Title() string {
+	name := n.SourceName
+	if name == "" {
+		name = n.SourceID
+	}
+	if n.Event == SyncEventFailed {
+		return fmt.Sprintf("Sync failed: %s", name)
+	}
+	return fmt.Sprintf("Sync completed: %s", name)
+}
+
+// Message returns the notification body.
+func (n *SyncNotification) Message() string {
+	if n.Event == SyncEventFailed {
+		return n.Error
+	}
+	return fmt.Sprintf("%d documents synced, %d failed, %d skipped",
+		n.DocumentsProcessed, n.FailedCount, n.SkippedCount)
+}
+
+// NotificationConfig holds sync notification settings.
+type NotificationConfig struct {
+	// Events lists the sync outcomes that trigger a notification.
+	Events []SyncEvent
+
+	// Desktop enables desktop notifications.
+	Desktop bool
+
+	// WebhookURL receives a JSON POST of each notification. Empty disables it.
+	WebhookURL string
+}
+
+// DefaultNotificationConfig returns the default notification configuration.
+// No notifier is enabled, but once one is, only failures are reported.
+func DefaultNotificationConfig() NotificationConfig {
+	return NotificationConfig{
+		Events: []SyncEvent{SyncEventFailed},
+	}
+}
+
+// Enabled reports whether any notifier is configured.
+func (c NotificationConfig) Enabled() bool {
+	return c.Desktop || c.WebhookURL != ""
+}
+
+// Notifies reports whether event triggers a notification.
+func (c NotificationConfig) Notifies(event SyncEvent) bool {
+	return slices.Contains(c.Events, event)
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSyncEvent(t *testing.T) {
+	event, err := ParseSyncEvent("failed")
+	require.NoError(t, err)
+	assert.Equal(t, SyncEventFailed, event)
+
+	_, err = ParseSyncEvent("started")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestSyncNotification_TitleAndMessage(t *testing.T) {
+	completed := SyncNotification{
+		Event: SyncEventCompleted, SourceID: "src-1", SourceName: "Notes",
+		DocumentsProcessed: 12, FailedCount: 1, SkippedCount: 2,
+	}
+	assert.Equal(t, "Sync completed: Notes", completed.Title())
+	assert.Equal(t, "12 documents synced, 1 failed, 2 skipped", completed.Message())
+
+	failed := SyncNotification{Event: SyncEventFailed, SourceID: "src-1", Error: "token revoked"}
+	assert.Equal(t, "Sync failed: src-1", failed.Title())
+	assert.Equal(t, "token revoked", failed.Message())
+}
+
+func TestNotificationConfig(t *testing.T) {
+	cfg := DefaultNotificationConfig()
+	assert.False(t, cfg.Enabled())
+	assert.True(t, cfg.Notifies(SyncEventFailed))
+	assert.False(t, cfg.Notifies(SyncEventCompleted))
+
+	cfg.WebhookURL = "https://hooks.example.com"
+	assert.True(t, cfg.Enabled())
+}
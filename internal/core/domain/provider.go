@@ -18,4 +18,8 @@ const (
 	ProviderMicrosoft ProviderType = "microsoft"
 	// ProviderDropbox is for Dropbox file storage.
 	ProviderDropbox ProviderType = "dropbox"
+	// ProviderTrello is for Trello boards.
+	ProviderTrello ProviderType = "trello"
+	// ProviderYouTube is for YouTube video transcripts.
+	ProviderYouTube ProviderType = "youtube"
 )
@@ -1,5 +1,7 @@
 package domain
 
+import "slices"
+
 // RawDocument represents opaque bytes fetched by a connector.
 // It is the connector's output before normalisation.
 type RawDocument struct {
@@ -45,3 +47,36 @@ type RawDocumentChange struct {
 	// Document is the affected document.
 	Document RawDocument
 }
+
+// WatchUpdate summarises changes applied to the index while watching sources
+// for changes. Updates not yet received are merged, so one update may cover
+// several batches of changes.
+type WatchUpdate struct {
+	// SourceIDs lists the sources whose changes were applied.
+	SourceIDs []string
+
+	// Indexed is the number of created or updated documents indexed.
+	Indexed int
+
+	// Deleted is the number of deleted documents removed from the index.
+	Deleted int
+
+	// Failed is the number of changes that could not be applied.
+	Failed int
+}
+
+// Merge returns the update covering the changes of both u and other.
+func (u WatchUpdate) Merge(other WatchUpdate) WatchUpdate {
+	merged := WatchUpdate{
+		SourceIDs: append([]string(nil), u.SourceIDs...),
+		Indexed:   u.Indexed + other.Indexed,
+		Deleted:   u.Deleted + other.Deleted,
+		Failed:    u.Failed + other.Failed,
+	}
+	for _, id := range other.SourceIDs {
+		if !slices.Contains(merged.SourceIDs, id) {
+			merged.SourceIDs = append(merged.SourceIDs, id)
+		}
+	}
+	return merged
+}
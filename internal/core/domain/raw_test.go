@@ -388,3 +388,14 @@ func TestChangeType_InvalidValue(t *testing.T) {
 	assert.NotEqual(t, ChangeUpdated, invalidChange)
 	assert.NotEqual(t, ChangeDeleted, invalidChange)
 }
+
+// TestWatchUpdate_Merge tests merging the updates of several batches
+func TestWatchUpdate_Merge(t *testing.T) {
+	first := WatchUpdate{SourceIDs: []string{"src-1"}, Indexed: 2, Failed: 1}
+	second := WatchUpdate{SourceIDs: []string{"src-2", "src-1"}, Indexed: 1, Deleted: 3}
+
+	merged := first.Merge(second)
+
+	assert.Equal(t, WatchUpdate{SourceIDs: []string{"src-1", "src-2"}, Indexed: 3, Deleted: 3, Failed: 1}, merged)
+	assert.Equal(t, []string{"src-1"}, first.SourceIDs)
+}
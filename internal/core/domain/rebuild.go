@@ -0,0 +1,88 @@
+package domain
+
+import "time"
+
+// RebuildOptions controls an index rebuild.
+type RebuildOptions struct {
+	// SourceID limits the rebuild to one source. Empty rebuilds all sources.
+	SourceID string
+
+	// Refetch re-syncs each source from its connector so documents are
+	// normalised again from their original content. Without it, stored
+	// document content is re-chunked and re-indexed.
+	Refetch bool
+
+	// Model limits the rebuild to documents embedded by this embedding
+	// model, after its overrides or the model itself changed. Empty
+	// rebuilds documents of every model.
+	Model string
+
+	// KeywordOnly re-chunks documents and rebuilds their search index
+	// entries without embedding them. Vectors of chunks that were removed
+	// or changed are deleted rather than recomputed.
+	KeywordOnly bool
+
+	// VectorsOnly re-embeds the stored chunks of documents and replaces
+	// their vectors, leaving chunks and the search index unchanged.
+	VectorsOnly bool
+}
+
+// Scope returns the key under which the rebuild's progress is saved.
+func (o RebuildOptions) Scope() string {
+	scope := o.SourceID
+	if o.Model != "" {
+		scope += "@" + o.Model
+	}
+	switch {
+	case o.KeywordOnly:
+		scope += "#keyword"
+	case o.VectorsOnly:
+		scope += "#vectors"
+	}
+	return scope
+}
+
+// RebuildState is the watermark of an interrupted rebuild.
+// Sources and documents are rebuilt in ID order, so everything up to and
+// including the watermark is done.
+type RebuildState struct {
+	// Scope identifies the rebuild (see RebuildOptions.Scope).
+	Scope string
+
+	// SourceID is the source being rebuilt.
+	SourceID string
+
+	// DocumentID is the last rebuilt document of SourceID.
+	// Empty once the whole source has been rebuilt.
+	DocumentID string
+
+	// Refetch records whether the rebuild re-fetches from connectors.
+	// A saved state is only resumed by a rebuild in the same mode.
+	Refetch bool
+
+	// UpdatedAt is when the watermark was saved.
+	UpdatedAt time.Time
+}
+
+// RebuildProgress reports a rebuild's running counts.
+type RebuildProgress struct {
+	// SourceID is the source being rebuilt.
+	SourceID string
+	// Documents is the number of documents rebuilt so far.
+	Documents int
+	// Chunks is the number of chunks indexed so far.
+	Chunks int
+	// Failed is the number of documents that could not be rebuilt.
+	Failed int
+}
+
+// RebuildResult summarises a completed rebuild.
+type RebuildResult struct {
+	RebuildProgress
+
+	// Sources is the number of sources rebuilt by this run.
+	Sources int
+
+	// Resumed reports whether the run continued an interrupted rebuild.
+	Resumed bool
+}
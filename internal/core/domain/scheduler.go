@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // ScheduledTask represents a recurring background task.
 type ScheduledTask struct {
@@ -13,6 +16,10 @@ type ScheduledTask struct {
 	// Interval defines how often the task should run.
 	Interval time.Duration
 
+	// Cron is an optional 5-field cron expression (e.g., "0 6 * * 1-5").
+	// When set, it takes precedence over Interval.
+	Cron string
+
 	// LastRun is when the task last ran.
 	LastRun time.Time
 
@@ -57,6 +64,20 @@ type SchedulerConfig struct {
 
 	// TaskConfigs holds per-task configuration.
 	TaskConfigs map[string]TaskConfig
+
+	// MaxConcurrentSyncs caps how many source syncs run at once.
+	// Excess runs queue until a slot frees up. Zero means unlimited.
+	MaxConcurrentSyncs int
+
+	// SyncWorkers is how many sources a sync-all run syncs in parallel.
+	// Workers still draw from the MaxConcurrentSyncs pool. Values below 1
+	// sync sources one at a time.
+	SyncWorkers int
+
+	// Jitter is the maximum random delay added before each scheduled sync,
+	// so sources sharing an interval do not all start at the same moment.
+	// Zero disables jitter.
+	Jitter time.Duration
 }
 
 // TaskConfig holds configuration for a single task.
@@ -66,6 +87,10 @@ type TaskConfig struct {
 
 	// Interval defines how often the task should run.
 	Interval time.Duration
+
+	// Cron is an optional 5-field cron expression.
+	// When set, it takes precedence over Interval.
+	Cron string
 }
 
 // GetTaskConfig returns the configuration for a specific task.
@@ -80,7 +105,9 @@ func (c *SchedulerConfig) GetTaskConfig(taskID string) TaskConfig {
 // DefaultSchedulerConfig returns sensible defaults for the scheduler.
 func DefaultSchedulerConfig() SchedulerConfig {
 	return SchedulerConfig{
-		Enabled: true,
+		Enabled:            true,
+		MaxConcurrentSyncs: 2,
+		SyncWorkers:        4,
 		TaskConfigs: map[string]TaskConfig{
 			"oauth-refresh": {
 				Enabled:  true,
@@ -90,12 +117,38 @@ func DefaultSchedulerConfig() SchedulerConfig {
 				Enabled:  true,
 				Interval: 1 * time.Hour,
 			},
+			// Compaction locks the database while it runs, so it is opt-in
+			"maintenance-compact": {
+				Enabled:  false,
+				Interval: 7 * 24 * time.Hour,
+			},
 		},
 	}
 }
 
 // Task IDs for built-in tasks.
 const (
-	TaskIDOAuthRefresh = "oauth-refresh"
-	TaskIDDocumentSync = "document-sync"
+	TaskIDOAuthRefresh       = "oauth-refresh"
+	TaskIDDocumentSync       = "document-sync"
+	TaskIDMaintenanceCompact = "maintenance-compact"
 )
+
+// SourceSyncTaskPrefix prefixes the IDs of per-source sync tasks.
+// A source with its own schedule is synced by its task rather than
+// by the global document-sync task.
+const SourceSyncTaskPrefix = "source-sync:"
+
+// SourceSyncTaskID returns the scheduled task ID for a source's own sync schedule.
+func SourceSyncTaskID(sourceID string) string {
+	return SourceSyncTaskPrefix + sourceID
+}
+
+// SourceIDFromTaskID extracts the source ID from a per-source sync task ID.
+// Returns false if the task is not a per-source sync task.
+func SourceIDFromTaskID(taskID string) (string, bool) {
+	sourceID, ok := strings.CutPrefix(taskID, SourceSyncTaskPrefix)
+	if !ok || sourceID == "" {
+		return "", false
+	}
+	return sourceID, true
+}
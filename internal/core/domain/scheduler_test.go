@@ -12,7 +12,7 @@ func TestDefaultSchedulerConfig(t *testing.T) {
 
 	assert.True(t, config.Enabled)
 	assert.NotNil(t, config.TaskConfigs)
-	assert.Len(t, config.TaskConfigs, 2)
+	assert.Len(t, config.TaskConfigs, 3)
 
 	// OAuth refresh config
 	oauthCfg := config.TaskConfigs[TaskIDOAuthRefresh]
@@ -23,6 +23,11 @@ func TestDefaultSchedulerConfig(t *testing.T) {
 	docCfg := config.TaskConfigs[TaskIDDocumentSync]
 	assert.True(t, docCfg.Enabled)
 	assert.Equal(t, 1*time.Hour, docCfg.Interval)
+
+	// Compaction is opt-in
+	compactCfg := config.TaskConfigs[TaskIDMaintenanceCompact]
+	assert.False(t, compactCfg.Enabled)
+	assert.Equal(t, 7*24*time.Hour, compactCfg.Interval)
 }
 
 func TestSchedulerConfig_GetTaskConfig(t *testing.T) {
@@ -116,3 +121,19 @@ func TestTaskConfig_Fields(t *testing.T) {
 	assert.True(t, cfg.Enabled)
 	assert.Equal(t, 30*time.Minute, cfg.Interval)
 }
+
+func TestSourceSyncTaskID_RoundTrip(t *testing.T) {
+	taskID := SourceSyncTaskID("src-123")
+	assert.Equal(t, "source-sync:src-123", taskID)
+
+	sourceID, ok := SourceIDFromTaskID(taskID)
+	assert.True(t, ok)
+	assert.Equal(t, "src-123", sourceID)
+}
+
+func TestSourceIDFromTaskID_NotSourceTask(t *testing.T) {
+	for _, taskID := range []string{TaskIDDocumentSync, TaskIDOAuthRefresh, "source-sync:", ""} {
+		_, ok := SourceIDFromTaskID(taskID)
+		assert.False(t, ok, taskID)
+	}
+}
@@ -1,5 +1,10 @@
 package domain
 
+import (
+	"html"
+	"strings"
+)
+
 // SearchOptions configures a search query.
 type SearchOptions struct {
 	// Limit is the maximum number of results.
@@ -16,6 +21,23 @@ type SearchOptions struct {
 
 	// Hybrid enables combined keyword + semantic search.
 	Hybrid bool
+
+	// Language is an ISO 639-1 code selecting how keyword query terms are
+	// stemmed. Empty uses the configured search language.
+	Language string
+
+	// MinSimilarity overrides the configured cosine similarity floor for
+	// semantic results. Nil uses the configured floor.
+	MinSimilarity *float64
+
+	// DedupeResults collapses results from the same document into one,
+	// keeping its best-scoring chunk.
+	DedupeResults bool
+
+	// MergeDuplicates also collapses results from different documents with
+	// identical content, such as a file indexed by two sources. Implies
+	// DedupeResults.
+	MergeDuplicates bool
 }
 
 // SearchResult represents a single search hit.
@@ -29,10 +51,89 @@ type SearchResult struct {
 	// Score is the relevance score.
 	Score float64
 
+	// Scores breaks Score down into its keyword and vector components.
+	Scores ScoreBreakdown
+
 	// Highlights contains snippets with matched terms.
 	Highlights []string
 
+	// Snippet is an excerpt of the matched chunk explaining why it matched.
+	// Query terms are wrapped in DefaultSnippetHighlightStart and
+	// DefaultSnippetHighlightEnd, and other text is HTML-escaped.
+	// Empty if the search engine does not produce snippets.
+	Snippet string
+
 	// SourceName is the display name of the source (includes account identifier).
 	// Example: "Gmail - user@gmail.com" or "GitHub - octocat"
 	SourceName string
 }
+
+// AskResult is an answer to a question, synthesised by an LLM from the
+// search results for the question.
+type AskResult struct {
+	// Answer is the LLM's answer, citing sources as [Source: uri].
+	Answer string
+
+	// Citations are the search results the answer cites, in order of first
+	// citation. Citations of URIs that were not in the context are dropped.
+	Citations []SearchResult
+
+	// FollowUpSuggestions are related questions the LLM suggests asking next.
+	FollowUpSuggestions []string
+}
+
+// Delimiters around query terms in SearchResult.Snippet.
+const (
+	DefaultSnippetHighlightStart = "<b>"
+	DefaultSnippetHighlightEnd   = "</b>"
+)
+
+// SnippetSegment is a run of snippet text, either highlighted or not.
+type SnippetSegment struct {
+	// Text is the unescaped text.
+	Text string
+
+	// Highlighted is true if Text matched a query term.
+	Highlighted bool
+}
+
+// SnippetSegments splits a SearchResult.Snippet into plain and highlighted
+// runs of unescaped text, for rendering outside HTML.
+func SnippetSegments(snippet string) []SnippetSegment {
+	var segments []SnippetSegment
+	add := func(text string, highlighted bool) {
+		if text != "" {
+			segments = append(segments, SnippetSegment{Text: html.UnescapeString(text), Highlighted: highlighted})
+		}
+	}
+
+	for snippet != "" {
+		start := strings.Index(snippet, DefaultSnippetHighlightStart)
+		if start < 0 {
+			break
+		}
+		rest := snippet[start+len(DefaultSnippetHighlightStart):]
+		end := strings.Index(rest, DefaultSnippetHighlightEnd)
+		if end < 0 {
+			break
+		}
+		add(snippet[:start], false)
+		add(rest[:end], true)
+		snippet = rest[end+len(DefaultSnippetHighlightEnd):]
+	}
+	add(snippet, false)
+	return segments
+}
+
+// ScoreBreakdown holds the component scores behind a search result.
+// A component is zero when that search did not match the chunk.
+type ScoreBreakdown struct {
+	// Keyword is the BM25 score from full-text search.
+	Keyword float64
+
+	// Vector is the cosine similarity from semantic search.
+	Vector float64
+
+	// Fused is the Reciprocal Rank Fusion score for hybrid results.
+	Fused float64
+}
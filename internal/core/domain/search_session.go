@@ -0,0 +1,35 @@
+package domain
+
+import "unicode/utf8"
+
+// MaxSearchSessionQueryLength is the longest query, in bytes, kept in a
+// search session. Longer queries are truncated.
+const MaxSearchSessionQueryLength = 1024
+
+// SearchSession is the last search made in the TUI, restored on the next
+// launch so it can be resumed. Only the query and the selected result are
+// kept; the results are fetched again.
+type SearchSession struct {
+	// Query is the search query.
+	Query string `json:"query"`
+
+	// Selected is the index of the selected result.
+	Selected int `json:"selected"`
+}
+
+// Bounded returns the session with its query truncated to
+// MaxSearchSessionQueryLength and a negative selection reset to zero.
+func (s SearchSession) Bounded() SearchSession {
+	if len(s.Query) > MaxSearchSessionQueryLength {
+		query := s.Query[:MaxSearchSessionQueryLength]
+		// Don't cut a multi-byte character in half
+		for len(query) > 0 && !utf8.ValidString(query) {
+			query = query[:len(query)-1]
+		}
+		s.Query = query
+	}
+	if s.Selected < 0 {
+		s.Selected = 0
+	}
+	return s
+}
@@ -372,3 +372,37 @@ func TestSearchOptions_DuplicateSourceIDs(t *testing.T) {
 	// Duplicates are preserved in the slice (filtering is application logic)
 	assert.Len(t, opts.SourceIDs, 5)
 }
+
+// TestSnippetSegments tests splitting snippets into plain and highlighted text
+func TestSnippetSegments(t *testing.T) {
+	tests := []struct {
+		name    string
+		snippet string
+		want    []SnippetSegment
+	}{
+		{"empty", "", nil},
+		{"no highlights", "plain text", []SnippetSegment{{Text: "plain text"}}},
+		{
+			"highlights",
+			"...the <b>quick</b> brown <b>fox</b>",
+			[]SnippetSegment{
+				{Text: "...the "},
+				{Text: "quick", Highlighted: true},
+				{Text: " brown "},
+				{Text: "fox", Highlighted: true},
+			},
+		},
+		{
+			"escaped text",
+			"a &lt;b&gt; tag &amp; <b>R&amp;D</b>",
+			[]SnippetSegment{{Text: "a <b> tag & "}, {Text: "R&D", Highlighted: true}},
+		},
+		{"unterminated highlight", "the <b>fox", []SnippetSegment{{Text: "the <b>fox"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SnippetSegments(tt.snippet))
+		})
+	}
+}
@@ -1,5 +1,12 @@
 package domain
 
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
 const unknownDescription = "Unknown"
 
 // SearchMode defines how search operations combine different retrieval methods.
@@ -74,12 +81,16 @@ const (
 
 	// AIProviderAnthropic is Anthropic cloud API.
 	AIProviderAnthropic AIProvider = "anthropic"
+
+	// AIProviderOpenAICompatible is any server implementing the OpenAI
+	// embeddings API (Azure OpenAI, LM Studio, Ollama's /v1, etc.).
+	AIProviderOpenAICompatible AIProvider = "openai-compatible"
 )
 
 // IsValid returns true if the AI provider is recognised.
 func (p AIProvider) IsValid() bool {
 	switch p {
-	case AIProviderOllama, AIProviderOpenAI, AIProviderAnthropic:
+	case AIProviderOllama, AIProviderOpenAI, AIProviderAnthropic, AIProviderOpenAICompatible:
 		return true
 	default:
 		return false
@@ -96,6 +107,12 @@ func (p AIProvider) IsLocal() bool {
 	return p == AIProviderOllama
 }
 
+// RequiresEndpoint returns true if the user must supply the provider's
+// base URL and vector dimensions, because neither can be assumed.
+func (p AIProvider) RequiresEndpoint() bool {
+	return p == AIProviderOpenAICompatible
+}
+
 // String returns the string representation.
 func (p AIProvider) String() string {
 	return string(p)
@@ -110,6 +127,8 @@ func (p AIProvider) Description() string {
 		return "OpenAI (cloud)"
 	case AIProviderAnthropic:
 		return "Anthropic (cloud)"
+	case AIProviderOpenAICompatible:
+		return "OpenAI-compatible (custom endpoint)"
 	default:
 		return unknownDescription
 	}
@@ -119,6 +138,95 @@ func (p AIProvider) Description() string {
 type SearchSettings struct {
 	// Mode is the search retrieval mode.
 	Mode SearchMode
+
+	// BM25K1 controls how quickly repeated query terms stop adding to a
+	// keyword match's score. 0 ignores term frequency entirely.
+	BM25K1 float64
+
+	// BM25B controls how much longer chunks are penalised, from 0 (no
+	// length normalisation) to 1 (full normalisation).
+	BM25B float64
+
+	// MinSimilarity is the cosine similarity, from 0 to 1, below which
+	// semantic results are dropped before fusion. 0 keeps every neighbour.
+	MinSimilarity float64
+
+	// QueryExpansion asks the LLM for alternative phrasings of each query
+	// and searches vectors with the centroid of their embeddings. Keyword
+	// search always uses the query as written.
+	QueryExpansion bool
+}
+
+// Xapian's default BM25 parameters.
+const (
+	DefaultBM25K1 = 1.0
+	DefaultBM25B  = 0.5
+)
+
+// DefaultSearchLanguage is the default stemming language.
+const DefaultSearchLanguage = "en"
+
+// SearchLanguageNone disables stemming.
+const SearchLanguageNone = "none"
+
+// ValidateSearchLanguage checks lang is a two-letter lower-case ISO 639-1
+// code or "none".
+func ValidateSearchLanguage(lang string) error {
+	if lang == SearchLanguageNone {
+		return nil
+	}
+	if len(lang) != 2 || lang[0] < 'a' || lang[0] > 'z' || lang[1] < 'a' || lang[1] > 'z' {
+		return fmt.Errorf("%w: language must be an ISO 639-1 code (e.g. en, fr) or %q, got %q",
+			ErrInvalidInput, SearchLanguageNone, lang)
+	}
+	return nil
+}
+
+// ValidateLocale checks locale is empty or a language tag such as "en" or
+// "en-GB": a two or three letter language followed by subtags of one to
+// eight letters or digits, separated by hyphens.
+func ValidateLocale(locale string) error {
+	if locale == "" {
+		return nil
+	}
+	for i, subtag := range strings.Split(locale, "-") {
+		valid := len(subtag) >= 1 && len(subtag) <= 8
+		for _, r := range subtag {
+			letter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+			valid = valid && (letter || (i > 0 && r >= '0' && r <= '9'))
+		}
+		if i == 0 {
+			valid = valid && len(subtag) >= 2 && len(subtag) <= 3
+		}
+		if !valid {
+			return fmt.Errorf("%w: locale must be a language tag (e.g. en, en-GB), got %q", ErrInvalidInput, locale)
+		}
+	}
+	return nil
+}
+
+// ValidateBM25 checks the BM25 parameters are within range.
+func (s SearchSettings) ValidateBM25() error {
+	if s.BM25K1 < 0 {
+		return fmt.Errorf("%w: bm25_k1 must not be negative, got %g", ErrInvalidInput, s.BM25K1)
+	}
+	if s.BM25B < 0 || s.BM25B > 1 {
+		return fmt.Errorf("%w: bm25_b must be between 0 and 1, got %g", ErrInvalidInput, s.BM25B)
+	}
+	return nil
+}
+
+// ValidateMinSimilarity checks the similarity floor is between 0 and 1.
+func (s SearchSettings) ValidateMinSimilarity() error {
+	return ValidateMinSimilarity(s.MinSimilarity)
+}
+
+// ValidateMinSimilarity checks a cosine similarity floor is between 0 and 1.
+func ValidateMinSimilarity(v float64) error {
+	if v < 0 || v > 1 {
+		return fmt.Errorf("%w: min_similarity must be between 0 and 1, got %g", ErrInvalidInput, v)
+	}
+	return nil
 }
 
 // EmbeddingSettings holds embedding provider configuration.
@@ -129,11 +237,167 @@ type EmbeddingSettings struct {
 	// Model is the embedding model name.
 	Model string
 
-	// BaseURL is the API endpoint (for Ollama).
+	// BaseURL is the API endpoint (for Ollama and OpenAI-compatible servers).
 	BaseURL string
 
-	// APIKey is the API key (for OpenAI).
+	// APIKey is the API key (for OpenAI; optional for OpenAI-compatible servers).
 	APIKey string
+
+	// Dimensions is the size of the vectors the model returns.
+	// Required for OpenAI-compatible servers, whose models are not known.
+	Dimensions int
+
+	// MaxTokens is the most tokens the model accepts in one input. Chunks
+	// estimated to be longer are split into pieces before they are embedded.
+	MaxTokens int
+
+	// BatchSize is the most texts sent to the model in one request.
+	// Syncs collect the chunks of several documents to fill each batch.
+	BatchSize int
+
+	// ModelOverrides maps connector types (e.g. "github") or MIME type
+	// prefixes (e.g. "text/x-go") to models of the same provider used
+	// instead of Model for their documents. See ModelFor.
+	ModelOverrides map[string]string
+}
+
+// DefaultEmbeddingMaxTokens is the default embedding input limit, within the
+// context of common local embedding models.
+const DefaultEmbeddingMaxTokens = 2048
+
+// DefaultEmbeddingBatchSize is the default number of texts embedded per request.
+const DefaultEmbeddingBatchSize = 32
+
+// ValidateMaxTokens checks the embedding input limit is positive.
+func (e EmbeddingSettings) ValidateMaxTokens() error {
+	if e.MaxTokens <= 0 {
+		return fmt.Errorf("%w: embedding_max_tokens must be positive, got %d", ErrInvalidInput, e.MaxTokens)
+	}
+	return nil
+}
+
+// ValidateBatchSize checks the embedding batch size is positive.
+func (e EmbeddingSettings) ValidateBatchSize() error {
+	if e.BatchSize <= 0 {
+		return fmt.Errorf("%w: embedding_batch_size must be positive, got %d", ErrInvalidInput, e.BatchSize)
+	}
+	return nil
+}
+
+// ModelFor returns the model that embeds documents of a connector type and
+// MIME type. An override keyed by a MIME type prefix (any key containing
+// "/") is preferred, the longest matching one winning, then an override
+// keyed by the connector type, then Model.
+func (e EmbeddingSettings) ModelFor(connectorType, mimeType string) string {
+	if model, ok := EmbeddingModelOverride(e.ModelOverrides, connectorType, mimeType); ok {
+		return model
+	}
+	return e.Model
+}
+
+// OverrideModels returns the models named by ModelOverrides other than
+// Model, sorted and without repeats.
+func (e EmbeddingSettings) OverrideModels() []string {
+	var models []string
+	for _, model := range e.ModelOverrides {
+		if model != e.Model && !slices.Contains(models, model) {
+			models = append(models, model)
+		}
+	}
+	slices.Sort(models)
+	return models
+}
+
+// EmbeddingModelOverride returns the model overrides selects for documents
+// of a connector type and MIME type (see EmbeddingSettings.ModelFor), and
+// whether any override matched.
+func EmbeddingModelOverride(overrides map[string]string, connectorType, mimeType string) (string, bool) {
+	var model, prefix string
+	for key, m := range overrides {
+		if strings.Contains(key, "/") && strings.HasPrefix(mimeType, key) && len(key) > len(prefix) {
+			model, prefix = m, key
+		}
+	}
+	if prefix != "" {
+		return model, true
+	}
+	model, ok := overrides[connectorType]
+	return model, ok
+}
+
+// ParseModelOverrides parses embedding model overrides written as
+// "key=model" entries, such as "github=nomic-embed-code". Blank entries are
+// skipped. Returns nil if there are no overrides.
+func ParseModelOverrides(entries []string) (map[string]string, error) {
+	var overrides map[string]string
+	for _, entry := range entries {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		key, model, ok := strings.Cut(entry, "=")
+		key, model = strings.TrimSpace(key), strings.TrimSpace(model)
+		if !ok || key == "" || model == "" {
+			return nil, fmt.Errorf("%w: embedding model override must be written as key=model, got %q",
+				ErrInvalidInput, entry)
+		}
+		if _, exists := overrides[key]; exists {
+			return nil, fmt.Errorf("%w: embedding model override for %q is set twice", ErrInvalidInput, key)
+		}
+		if overrides == nil {
+			overrides = make(map[string]string)
+		}
+		overrides[key] = model
+	}
+	return overrides, nil
+}
+
+// ParseKeyBindings parses key bindings written as "action=keys" entries,
+// where keys are separated by spaces, such as "down=down j". Blank entries
+// are skipped. Returns nil if there are no bindings.
+func ParseKeyBindings(entries []string) (map[string][]string, error) {
+	var bindings map[string][]string
+	for _, entry := range entries {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		action, keys, ok := strings.Cut(entry, "=")
+		action = strings.TrimSpace(action)
+		fields := strings.Fields(keys)
+		if !ok || action == "" || len(fields) == 0 {
+			return nil, fmt.Errorf("%w: key binding must be written as action=keys, got %q",
+				ErrInvalidInput, entry)
+		}
+		if _, exists := bindings[action]; exists {
+			return nil, fmt.Errorf("%w: key binding for %q is set twice", ErrInvalidInput, action)
+		}
+		if bindings == nil {
+			bindings = make(map[string][]string)
+		}
+		bindings[action] = fields
+	}
+	return bindings, nil
+}
+
+// FormatKeyBindings returns bindings as "action=keys" entries sorted by
+// action, the form read by ParseKeyBindings.
+func FormatKeyBindings(bindings map[string][]string) []string {
+	entries := make([]string, 0, len(bindings))
+	for action, keys := range bindings {
+		entries = append(entries, action+"="+strings.Join(keys, " "))
+	}
+	slices.Sort(entries)
+	return entries
+}
+
+// FormatModelOverrides returns overrides as "key=model" entries sorted by key,
+// the form read by ParseModelOverrides.
+func FormatModelOverrides(overrides map[string]string) []string {
+	entries := make([]string, 0, len(overrides))
+	for key, model := range overrides {
+		entries = append(entries, key+"="+model)
+	}
+	slices.Sort(entries)
+	return entries
 }
 
 // IsConfigured returns true if the embedding provider is set up.
@@ -144,9 +408,43 @@ func (e EmbeddingSettings) IsConfigured() bool {
 	if e.Provider.RequiresAPIKey() && e.APIKey == "" {
 		return false
 	}
+	if e.Provider.RequiresEndpoint() && (e.BaseURL == "" || e.Model == "" || e.Dimensions <= 0) {
+		return false
+	}
 	return true
 }
 
+// ValidateEndpoint checks the settings a provider needing an endpoint
+// relies on. Returns nil for other providers.
+// Returns an error wrapping ErrInvalidInput describing the first problem.
+func (e EmbeddingSettings) ValidateEndpoint() error {
+	if !e.Provider.RequiresEndpoint() {
+		return nil
+	}
+	if err := ValidateEndpointURL(e.BaseURL); err != nil {
+		return err
+	}
+	if strings.TrimSpace(e.Model) == "" {
+		return fmt.Errorf("%w: %s requires a model name", ErrInvalidInput, e.Provider)
+	}
+	if e.Dimensions <= 0 {
+		return fmt.Errorf("%w: %s requires the model's vector dimensions", ErrInvalidInput, e.Provider)
+	}
+	return nil
+}
+
+// ValidateEndpointURL checks that baseURL is an absolute http(s) URL.
+func ValidateEndpointURL(baseURL string) error {
+	if strings.TrimSpace(baseURL) == "" {
+		return fmt.Errorf("%w: base URL is required", ErrInvalidInput)
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: base URL must be an http(s) URL, got %q", ErrInvalidInput, baseURL)
+	}
+	return nil
+}
+
 // LLMSettings holds LLM provider configuration.
 type LLMSettings struct {
 	// Provider is the LLM service provider.
@@ -160,6 +458,17 @@ type LLMSettings struct {
 
 	// APIKey is the API key (for OpenAI/Anthropic).
 	APIKey string
+
+	// MaxContextTokens is the model's context window, in tokens.
+	MaxContextTokens int
+
+	// AnswerReserveTokens is kept free in the context window for the answer.
+	AnswerReserveTokens int
+}
+
+// ContextBudget returns the budget for retrieved content in prompts.
+func (l LLMSettings) ContextBudget() ContextBudget {
+	return ContextBudget{MaxTokens: l.MaxContextTokens, ReserveTokens: l.AnswerReserveTokens}
 }
 
 // IsConfigured returns true if the LLM provider is set up.
@@ -230,6 +539,108 @@ type VectorIndexSettings struct {
 	Precision VectorPrecision
 }
 
+// ChunkingStrategy defines how documents are split into chunks before
+// indexing and embedding.
+type ChunkingStrategy string
+
+// Available chunking strategies.
+const (
+	// ChunkingFixed splits content into fixed-size windows with overlap.
+	ChunkingFixed ChunkingStrategy = "fixed"
+
+	// ChunkingSentence packs whole sentences into chunks.
+	ChunkingSentence ChunkingStrategy = "sentence"
+
+	// ChunkingHeading splits content into sections at markdown and HTML
+	// headings, keeping each chunk's heading trail as context.
+	ChunkingHeading ChunkingStrategy = "heading"
+)
+
+// Default chunking parameters.
+const (
+	DefaultChunkSize    = 1000
+	DefaultChunkOverlap = 200
+)
+
+// IsValid returns true if the strategy is recognised.
+func (c ChunkingStrategy) IsValid() bool {
+	switch c {
+	case ChunkingFixed, ChunkingSentence, ChunkingHeading:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the string representation.
+func (c ChunkingStrategy) String() string {
+	return string(c)
+}
+
+// Description returns a human-readable description of the strategy.
+func (c ChunkingStrategy) Description() string {
+	switch c {
+	case ChunkingFixed:
+		return "Fixed size (character windows with overlap)"
+	case ChunkingSentence:
+		return "Sentence-aware (whole sentences per chunk)"
+	case ChunkingHeading:
+		return "Heading-aware (sections of markdown/HTML documents)"
+	default:
+		return unknownDescription
+	}
+}
+
+// AllChunkingStrategies returns all available chunking strategies.
+func AllChunkingStrategies() []ChunkingStrategy {
+	return []ChunkingStrategy{ChunkingFixed, ChunkingSentence, ChunkingHeading}
+}
+
+// ChunkingSettings holds how documents are chunked during sync.
+type ChunkingSettings struct {
+	// Strategy is how content is split into chunks.
+	Strategy ChunkingStrategy
+
+	// ChunkSize is the maximum number of characters per chunk.
+	ChunkSize int
+
+	// Overlap is the number of characters repeated between consecutive
+	// chunks. Sentence and heading strategies repeat whole sentences.
+	Overlap int
+}
+
+// Validate checks the chunking settings are usable.
+func (c ChunkingSettings) Validate() error {
+	if !c.Strategy.IsValid() {
+		return fmt.Errorf("%w: chunk_strategy must be one of fixed, sentence or heading, got %q",
+			ErrInvalidInput, c.Strategy)
+	}
+	if c.ChunkSize <= 0 {
+		return fmt.Errorf("%w: chunk_size must be positive, got %d", ErrInvalidInput, c.ChunkSize)
+	}
+	if c.Overlap < 0 || c.Overlap >= c.ChunkSize {
+		return fmt.Errorf("%w: chunk_overlap must be at least 0 and less than chunk_size (%d), got %d",
+			ErrInvalidInput, c.ChunkSize, c.Overlap)
+	}
+	return nil
+}
+
+// DefaultTheme is the name of the default TUI colour theme, which picks the
+// dark or light theme to suit the terminal background.
+const DefaultTheme = "auto"
+
+// UISettings holds terminal UI settings.
+type UISettings struct {
+	// Theme is the name of a built-in colour theme (e.g. "dark", "light")
+	// or the path to a YAML theme file.
+	Theme string
+
+	// KeyBindings remaps TUI actions (e.g. "down") to the keys that trigger
+	// them, replacing the default keys. Actions and keys are validated by
+	// the TUI when it loads them.
+	KeyBindings map[string][]string
+}
+
 // AppSettings holds all application settings.
 type AppSettings struct {
 	// Search holds search behaviour settings.
@@ -243,6 +654,26 @@ type AppSettings struct {
 
 	// VectorIndex holds vector index settings.
 	VectorIndex VectorIndexSettings
+
+	// Chunking holds how documents are chunked before embedding.
+	Chunking ChunkingSettings
+
+	// UI holds terminal UI settings.
+	UI UISettings
+
+	// DefaultSearchLanguage is the ISO 639-1 code of the stemmer used for
+	// documents without a detected language and for queries without a
+	// language hint. "none" disables stemming.
+	DefaultSearchLanguage string
+
+	// Locale is the language tag (e.g., "en-GB") connectors send as
+	// Accept-Language, so providers return content in one language.
+	// A source's own locale takes precedence. Empty sends none.
+	Locale string
+
+	// OpenTelemetryEndpoint is the OTLP/HTTP endpoint traces are exported to,
+	// e.g. "http://localhost:4318". Empty disables tracing.
+	OpenTelemetryEndpoint string
 }
 
 // DefaultAppSettings returns settings with sensible defaults.
@@ -251,17 +682,35 @@ type AppSettings struct {
 func DefaultAppSettings() AppSettings {
 	return AppSettings{
 		Search: SearchSettings{
-			Mode: SearchModeTextOnly,
+			Mode:   SearchModeTextOnly,
+			BM25K1: DefaultBM25K1,
+			BM25B:  DefaultBM25B,
 		},
 		// Embedding is left unconfigured - user must set up via settings wizard
-		Embedding: EmbeddingSettings{},
+		// Embedding is left unconfigured apart from its input limit
+		Embedding: EmbeddingSettings{
+			MaxTokens: DefaultEmbeddingMaxTokens,
+			BatchSize: DefaultEmbeddingBatchSize,
+		},
 		// LLM is left unconfigured - user must set up via settings wizard
-		LLM: LLMSettings{},
+		LLM: LLMSettings{
+			MaxContextTokens:    DefaultMaxContextTokens,
+			AnswerReserveTokens: DefaultAnswerReserveTokens,
+		},
 		VectorIndex: VectorIndexSettings{
 			Enabled:    false,
 			Dimensions: 768,                    // nomic-embed-text default
 			Precision:  VectorPrecisionFloat16, // Best balance of size vs quality
 		},
+		Chunking: ChunkingSettings{
+			Strategy:  ChunkingFixed,
+			ChunkSize: DefaultChunkSize,
+			Overlap:   DefaultChunkOverlap,
+		},
+		UI: UISettings{
+			Theme: DefaultTheme,
+		},
+		DefaultSearchLanguage: DefaultSearchLanguage,
 	}
 }
 
@@ -280,6 +729,7 @@ func AllEmbeddingProviders() []AIProvider {
 	return []AIProvider{
 		AIProviderOllama,
 		AIProviderOpenAI,
+		AIProviderOpenAICompatible,
 	}
 }
 
@@ -352,16 +802,101 @@ func (c *PipelineConfig) GetProcessorConfig(name string) map[string]any {
 	return c.ProcessorConfigs[name]
 }
 
+// ProcessorsForSource returns the ordered processor names to run on a source's
+// documents. A source's ConfigKeyPipeline replaces the global list; otherwise
+// ConfigKeyPipelineAdd names are appended to it. ConfigKeyPipelineRemove names
+// are then removed. Names are not repeated.
+func (c *PipelineConfig) ProcessorsForSource(source *Source) []string {
+	if source == nil {
+		return slices.Clone(c.Processors)
+	}
+
+	processors := c.Processors
+	if list, ok := source.Config[ConfigKeyPipeline]; ok {
+		processors = splitProcessorNames(list)
+	} else {
+		processors = append(slices.Clone(processors), splitProcessorNames(source.Config[ConfigKeyPipelineAdd])...)
+	}
+	removed := splitProcessorNames(source.Config[ConfigKeyPipelineRemove])
+
+	result := make([]string, 0, len(processors))
+	for _, name := range processors {
+		if !slices.Contains(removed, name) && !slices.Contains(result, name) {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// splitProcessorNames parses a comma-separated list of processor names.
+func splitProcessorNames(list string) []string {
+	var names []string
+	for _, name := range strings.Split(list, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 // DefaultPipelineConfig returns the default pipeline configuration.
-// Works out-of-the-box with chunker using sensible defaults.
+// Works out-of-the-box with chunker using sensible defaults. The language
+// processor runs after the chunker so chunks are stemmed in their
+// document's language. The summary processor is left out, as it needs an
+// LLM and makes a request per long document; add it to the processors
+// after the chunker to enable it.
 func DefaultPipelineConfig() PipelineConfig {
 	return PipelineConfig{
-		Processors: []string{"chunker"},
+		Processors: []string{"chunker", "language"},
 		ProcessorConfigs: map[string]map[string]any{
 			"chunker": {
-				"chunk_size": 1000,
-				"overlap":    200,
+				"chunk_size": DefaultChunkSize,
+				"overlap":    DefaultChunkOverlap,
 			},
 		},
 	}
 }
+
+// FallbackTitleDatePlaceholder is replaced with an event's start date in
+// NormaliserConfig.EventFallbackTitle.
+const FallbackTitleDatePlaceholder = "{date}"
+
+// NormaliserConfig holds normaliser configuration.
+type NormaliserConfig struct {
+	// EmailFallbackTitle is the title given to emails without a subject.
+	EmailFallbackTitle string
+
+	// EventFallbackTitle is the title given to calendar events without a
+	// summary. FallbackTitleDatePlaceholder is replaced with the start date.
+	EventFallbackTitle string
+}
+
+// DefaultNormaliserConfig returns the default normaliser configuration.
+func DefaultNormaliserConfig() NormaliserConfig {
+	return NormaliserConfig{
+		EmailFallbackTitle: "(No subject)",
+		EventFallbackTitle: "Meeting on " + FallbackTitleDatePlaceholder,
+	}
+}
+
+// SyncConfig holds document sync configuration.
+type SyncConfig struct {
+	// SkipEmpty drops documents whose normalised content is empty or only
+	// whitespace instead of indexing them. Disable to track empty files.
+	SkipEmpty bool
+
+	// MaxConcurrentRequests caps the API requests in flight across all
+	// connectors together. Zero means unlimited.
+	MaxConcurrentRequests int
+
+	// RequestsPerSecond caps the API request rate across all connectors
+	// together, on top of each connector's own rate limit. Zero means unlimited.
+	RequestsPerSecond float64
+}
+
+// DefaultSyncConfig returns the default sync configuration.
+func DefaultSyncConfig() SyncConfig {
+	return SyncConfig{
+		SkipEmpty: true,
+	}
+}
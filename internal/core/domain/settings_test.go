@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -254,6 +255,11 @@ func TestAIProvider_IsValid(t *testing.T) {
 			provider: AIProviderAnthropic,
 			expected: true,
 		},
+		{
+			name:     "openai-compatible is valid",
+			provider: AIProviderOpenAICompatible,
+			expected: true,
+		},
 		{
 			name:     "empty string is invalid",
 			provider: AIProvider(""),
@@ -412,6 +418,11 @@ func TestAIProvider_Description(t *testing.T) {
 			provider: AIProviderAnthropic,
 			expected: "Anthropic (cloud)",
 		},
+		{
+			name:     "openai-compatible description",
+			provider: AIProviderOpenAICompatible,
+			expected: "OpenAI-compatible (custom endpoint)",
+		},
 		{
 			name:     "unknown returns Unknown",
 			provider: AIProvider("unknown"),
@@ -457,6 +468,25 @@ func TestEmbeddingSettings_IsConfigured(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name: "valid openai-compatible configuration without API key",
+			settings: EmbeddingSettings{
+				Provider:   AIProviderOpenAICompatible,
+				Model:      "nomic-embed-text",
+				BaseURL:    "http://localhost:1234/v1",
+				Dimensions: 768,
+			},
+			expected: true,
+		},
+		{
+			name: "openai-compatible without dimensions",
+			settings: EmbeddingSettings{
+				Provider: AIProviderOpenAICompatible,
+				Model:    "nomic-embed-text",
+				BaseURL:  "http://localhost:1234/v1",
+			},
+			expected: false,
+		},
 		{
 			name: "invalid provider",
 			settings: EmbeddingSettings{
@@ -604,6 +634,11 @@ func TestDefaultAppSettings(t *testing.T) {
 
 	// Test search settings
 	assert.Equal(t, SearchModeTextOnly, settings.Search.Mode)
+	assert.Equal(t, DefaultBM25K1, settings.Search.BM25K1)
+	assert.Equal(t, DefaultBM25B, settings.Search.BM25B)
+	assert.NoError(t, settings.Search.ValidateBM25())
+	assert.Equal(t, DefaultSearchLanguage, settings.DefaultSearchLanguage)
+	assert.NoError(t, ValidateSearchLanguage(settings.DefaultSearchLanguage))
 
 	// Test embedding settings - should be unconfigured by default
 	assert.Empty(t, settings.Embedding.Provider)
@@ -624,6 +659,96 @@ func TestDefaultAppSettings(t *testing.T) {
 	assert.Equal(t, 768, settings.VectorIndex.Dimensions)
 }
 
+// TestSearchSettings_ValidateBM25 tests BM25 parameter ranges
+func TestSearchSettings_ValidateBM25(t *testing.T) {
+	tests := []struct {
+		name    string
+		k1, b   float64
+		wantErr bool
+	}{
+		{"defaults", 1.0, 0.5, false},
+		{"zero", 0, 0, false},
+		{"full length normalisation", 2.0, 1.0, false},
+		{"negative k1", -0.1, 0.5, true},
+		{"negative b", 1.0, -0.1, true},
+		{"b above one", 1.0, 1.1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SearchSettings{BM25K1: tt.k1, BM25B: tt.b}.ValidateBM25()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInput)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestValidateSearchLanguage tests accepted stemming languages
+func TestValidateLocale(t *testing.T) {
+	for _, locale := range []string{"", "en", "en-GB", "zh-Hant-TW", "es-419"} {
+		assert.NoError(t, ValidateLocale(locale), locale)
+	}
+	for _, locale := range []string{"e", "english", "en_GB", "en-", "-GB", "1a-GB", "en-GB;q=0.9"} {
+		assert.ErrorIs(t, ValidateLocale(locale), ErrInvalidInput, locale)
+	}
+}
+
+func TestValidateSearchLanguage(t *testing.T) {
+	tests := []struct {
+		lang    string
+		wantErr bool
+	}{
+		{"en", false},
+		{"fr", false},
+		{"none", false},
+		{"", true},
+		{"EN", true},
+		{"eng", true},
+		{"english", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			err := ValidateSearchLanguage(tt.lang)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInput)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestChunkingSettings_Validate tests chunking setting ranges
+func TestChunkingSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings ChunkingSettings
+		wantErr  bool
+	}{
+		{"defaults", DefaultAppSettings().Chunking, false},
+		{"heading without overlap", ChunkingSettings{ChunkingHeading, 500, 0}, false},
+		{"unknown strategy", ChunkingSettings{"paragraph", 500, 0}, true},
+		{"zero chunk size", ChunkingSettings{ChunkingFixed, 0, 0}, true},
+		{"negative overlap", ChunkingSettings{ChunkingFixed, 500, -1}, true},
+		{"overlap equals chunk size", ChunkingSettings{ChunkingSentence, 500, 500}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInput)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 // TestAllSearchModes tests complete list of search modes
 func TestAllSearchModes(t *testing.T) {
 	modes := AllSearchModes()
@@ -644,9 +769,10 @@ func TestAllSearchModes(t *testing.T) {
 func TestAllEmbeddingProviders(t *testing.T) {
 	providers := AllEmbeddingProviders()
 
-	require.Len(t, providers, 2)
+	require.Len(t, providers, 3)
 	assert.Contains(t, providers, AIProviderOllama)
 	assert.Contains(t, providers, AIProviderOpenAI)
+	assert.Contains(t, providers, AIProviderOpenAICompatible)
 	assert.NotContains(t, providers, AIProviderAnthropic, "Anthropic should not be in embedding providers")
 
 	// Verify all providers are valid
@@ -809,3 +935,167 @@ func TestUnknownDescription_Constant(t *testing.T) {
 	assert.Equal(t, unknownDescription, SearchMode("invalid").Description())
 	assert.Equal(t, unknownDescription, AIProvider("invalid").Description())
 }
+
+// TestPipelineConfig_ProcessorsForSource tests composing source overrides with the global pipeline
+func TestPipelineConfig_ProcessorsForSource(t *testing.T) {
+	cfg := PipelineConfig{Processors: []string{"chunker", "dedup"}}
+
+	tests := []struct {
+		name   string
+		source *Source
+		want   []string
+	}{
+		{"nil source", nil, []string{"chunker", "dedup"}},
+		{"no override", &Source{Config: map[string]string{"path": "/notes"}}, []string{"chunker", "dedup"}},
+		{"replace", &Source{Config: map[string]string{ConfigKeyPipeline: "chunker, language"}}, []string{"chunker", "language"}},
+		{"replace with empty list", &Source{Config: map[string]string{ConfigKeyPipeline: ""}}, []string{}},
+		{"add", &Source{Config: map[string]string{ConfigKeyPipelineAdd: "language"}}, []string{"chunker", "dedup", "language"}},
+		{"add existing", &Source{Config: map[string]string{ConfigKeyPipelineAdd: "dedup"}}, []string{"chunker", "dedup"}},
+		{"remove", &Source{Config: map[string]string{ConfigKeyPipelineRemove: "dedup"}}, []string{"chunker"}},
+		{
+			"add and remove",
+			&Source{Config: map[string]string{ConfigKeyPipelineAdd: "language", ConfigKeyPipelineRemove: "dedup"}},
+			[]string{"chunker", "language"},
+		},
+		{
+			"replace ignores add",
+			&Source{Config: map[string]string{ConfigKeyPipeline: "chunker", ConfigKeyPipelineAdd: "language"}},
+			[]string{"chunker"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, cfg.ProcessorsForSource(tt.source))
+		})
+	}
+
+	// The global list is not modified
+	assert.Equal(t, []string{"chunker", "dedup"}, cfg.Processors)
+}
+
+// TestDefaultPipelineConfig_DetectsLanguage tests that chunks are tagged with their language by default
+func TestDefaultPipelineConfig_DetectsLanguage(t *testing.T) {
+	cfg := DefaultPipelineConfig()
+
+	chunker := slices.Index(cfg.Processors, "chunker")
+	language := slices.Index(cfg.Processors, "language")
+	require.NotEqual(t, -1, chunker)
+	require.NotEqual(t, -1, language)
+	assert.Greater(t, language, chunker, "language must run after the chunker to tag chunks")
+}
+
+// TestEmbeddingSettings_ValidateEndpoint tests validation of custom embedding endpoints
+func TestEmbeddingSettings_ValidateEndpoint(t *testing.T) {
+	valid := EmbeddingSettings{
+		Provider:   AIProviderOpenAICompatible,
+		Model:      "text-embedding-3-small",
+		BaseURL:    "https://example.openai.azure.com/openai/deployments/embed?api-version=2024-02-01",
+		Dimensions: 1536,
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(*EmbeddingSettings)
+		wantErr bool
+	}{
+		{"valid", func(*EmbeddingSettings) {}, false},
+		{"other providers are not checked", func(e *EmbeddingSettings) { e.Provider = AIProviderOllama; e.BaseURL = "" }, false},
+		{"missing base URL", func(e *EmbeddingSettings) { e.BaseURL = "" }, true},
+		{"relative base URL", func(e *EmbeddingSettings) { e.BaseURL = "localhost:1234/v1" }, true},
+		{"unsupported scheme", func(e *EmbeddingSettings) { e.BaseURL = "ftp://example.com/v1" }, true},
+		{"missing model", func(e *EmbeddingSettings) { e.Model = " " }, true},
+		{"missing dimensions", func(e *EmbeddingSettings) { e.Dimensions = 0 }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := valid
+			tt.modify(&settings)
+
+			err := settings.ValidateEndpoint()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInput)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEmbeddingSettings_ModelFor(t *testing.T) {
+	settings := EmbeddingSettings{
+		Model: "nomic-embed-text",
+		ModelOverrides: map[string]string{
+			"github":    "nomic-embed-code",
+			"text/":     "text-model",
+			"text/x-go": "go-model",
+		},
+	}
+
+	tests := []struct {
+		name          string
+		connectorType string
+		mimeType      string
+		want          string
+	}{
+		{"no override", "filesystem", "application/pdf", "nomic-embed-text"},
+		{"connector type", "github", "application/pdf", "nomic-embed-code"},
+		{"MIME prefix", "filesystem", "text/plain", "text-model"},
+		{"longest MIME prefix wins", "filesystem", "text/x-go", "go-model"},
+		{"MIME prefix before connector type", "github", "text/x-go", "go-model"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, settings.ModelFor(tt.connectorType, tt.mimeType))
+		})
+	}
+}
+
+func TestEmbeddingSettings_OverrideModels(t *testing.T) {
+	settings := EmbeddingSettings{
+		Model: "nomic-embed-text",
+		ModelOverrides: map[string]string{
+			"github":    "nomic-embed-code",
+			"text/x-go": "nomic-embed-code",
+			"notion":    "nomic-embed-text",
+			"gmail":     "all-minilm",
+		},
+	}
+
+	assert.Equal(t, []string{"all-minilm", "nomic-embed-code"}, settings.OverrideModels())
+	assert.Empty(t, EmbeddingSettings{Model: "nomic-embed-text"}.OverrideModels())
+}
+
+func TestParseKeyBindings(t *testing.T) {
+	bindings, err := ParseKeyBindings([]string{"down=down j", " ", " up =  up   k "})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"down": {"down", "j"}, "up": {"up", "k"}}, bindings)
+	assert.Equal(t, []string{"down=down j", "up=up k"}, FormatKeyBindings(bindings))
+
+	bindings, err = ParseKeyBindings(nil)
+	require.NoError(t, err)
+	assert.Nil(t, bindings)
+
+	for _, entries := range [][]string{{"down"}, {"=j"}, {"down= "}, {"down=j", "down=n"}} {
+		_, err := ParseKeyBindings(entries)
+		assert.ErrorIs(t, err, ErrInvalidInput, entries)
+	}
+}
+
+func TestParseModelOverrides(t *testing.T) {
+	overrides, err := ParseModelOverrides([]string{"github=nomic-embed-code", " ", " text/x-go = go-model "})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"github": "nomic-embed-code", "text/x-go": "go-model"}, overrides)
+	assert.Equal(t, []string{"github=nomic-embed-code", "text/x-go=go-model"}, FormatModelOverrides(overrides))
+
+	overrides, err = ParseModelOverrides(nil)
+	require.NoError(t, err)
+	assert.Nil(t, overrides)
+
+	for _, entries := range [][]string{{"github"}, {"=model"}, {"github="}, {"github=a", "github=b"}} {
+		_, err := ParseModelOverrides(entries)
+		assert.ErrorIs(t, err, ErrInvalidInput, entries)
+	}
+}
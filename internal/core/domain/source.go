@@ -2,10 +2,52 @@ package domain
 
 import (
 	"fmt"
+	"path"
+	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
+// ConfigKeyParallelWithinSource is the source config key that makes connectors
+// which support it fetch their content types concurrently.
+const ConfigKeyParallelWithinSource = "parallel_within_source"
+
+// Source config keys that override the global post-processor pipeline for
+// one source's documents. Values are comma-separated processor names.
+const (
+	// ConfigKeyPipeline replaces the global processor list.
+	ConfigKeyPipeline = "pipeline"
+	// ConfigKeyPipelineAdd appends processors to the global list.
+	ConfigKeyPipelineAdd = "pipeline_add"
+	// ConfigKeyPipelineRemove removes processors from the global list.
+	ConfigKeyPipelineRemove = "pipeline_remove"
+)
+
+// PipelineConfigKeys lists the source config keys that override the pipeline.
+// They apply to sources of every connector type.
+var PipelineConfigKeys = []string{ConfigKeyPipeline, ConfigKeyPipelineAdd, ConfigKeyPipelineRemove}
+
+// ConfigKeyExcludePatterns is the source config key holding comma-separated
+// glob patterns for paths a sync skips. It applies to sources of every
+// connector type.
+const ConfigKeyExcludePatterns = "exclude_patterns"
+
+// Source config keys that limit the file content a connector downloads.
+// They apply to sources of every connector type that downloads files.
+const (
+	// ConfigKeyMaxContentSize is the largest file, in bytes, whose content
+	// is downloaded.
+	ConfigKeyMaxContentSize = "max_content_size"
+	// ConfigKeyMetadataOnly indexes files by name, path and metadata
+	// without downloading any content.
+	ConfigKeyMetadataOnly = "metadata_only"
+)
+
+// DefaultMaxContentSize is the largest file whose content connectors
+// download by default (5MB).
+const DefaultMaxContentSize int64 = 5 << 20
+
 // Source represents a configured data source.
 // Each source produces documents via a connector and belongs to a specific user account.
 type Source struct {
@@ -51,6 +93,121 @@ func (s *Source) DisplayName(accountIdentifier string) string {
 	return s.Name
 }
 
+// MaxSourceNameLength is the longest name a source can have, in characters.
+const MaxSourceNameLength = 255
+
+// ValidateSourceName checks that name is non-empty and at most
+// MaxSourceNameLength characters, ignoring surrounding whitespace.
+// Returns an error wrapping ErrInvalidInput otherwise.
+func ValidateSourceName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("%w: source name cannot be empty", ErrInvalidInput)
+	}
+	if n := utf8.RuneCountInString(name); n > MaxSourceNameLength {
+		return fmt.Errorf("%w: source name is %d characters, maximum is %d", ErrInvalidInput, n, MaxSourceNameLength)
+	}
+	return nil
+}
+
+// HasPipelineOverride returns true if the source overrides the global
+// post-processor pipeline.
+func (s *Source) HasPipelineOverride() bool {
+	for _, key := range PipelineConfigKeys {
+		if _, ok := s.Config[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ExclusionPatterns returns the glob patterns for paths the source's syncs
+// skip, from its ConfigKeyExcludePatterns config.
+func (s *Source) ExclusionPatterns() []string {
+	return ParseExclusionPatterns(s.Config[ConfigKeyExcludePatterns])
+}
+
+// ContentLimits bounds the file content a connector downloads for a source.
+// Files over the limits are still indexed, from their metadata only.
+type ContentLimits struct {
+	// MaxSize is the largest file, in bytes, whose content is downloaded.
+	MaxSize int64
+	// MetadataOnly skips downloading content entirely.
+	MetadataOnly bool
+}
+
+// DefaultContentLimits returns the limits of sources that do not set them.
+func DefaultContentLimits() ContentLimits {
+	return ContentLimits{MaxSize: DefaultMaxContentSize}
+}
+
+// AllowsDownload reports whether the content of a file of size bytes is
+// downloaded. Pass 0 when the size is unknown, e.g. for exported files.
+func (l ContentLimits) AllowsDownload(size int64) bool {
+	return !l.MetadataOnly && size <= l.MaxSize
+}
+
+// ContentLimits returns the source's content limits from its
+// ConfigKeyMaxContentSize and ConfigKeyMetadataOnly config. Invalid or
+// non-positive sizes fall back to DefaultMaxContentSize.
+func (s *Source) ContentLimits() ContentLimits {
+	limits := DefaultContentLimits()
+	if n, err := strconv.ParseInt(s.Config[ConfigKeyMaxContentSize], 10, 64); err == nil && n > 0 {
+		limits.MaxSize = n
+	}
+	if val := s.Config[ConfigKeyMetadataOnly]; val != "" {
+		limits.MetadataOnly = val == "true" || val == "1"
+	}
+	return limits
+}
+
+// ParseExclusionPatterns splits a comma-separated list of glob patterns,
+// dropping empty entries.
+func ParseExclusionPatterns(value string) []string {
+	var patterns []string
+	for _, p := range strings.Split(value, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns
+}
+
+// FormatExclusionPatterns joins patterns for storing in source config.
+func FormatExclusionPatterns(patterns []string) string {
+	return strings.Join(patterns, ",")
+}
+
+// ValidateExclusionPatterns checks that every pattern is a valid glob.
+// Returns an error wrapping ErrInvalidInput otherwise.
+func ValidateExclusionPatterns(patterns []string) error {
+	for _, p := range patterns {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("%w: invalid exclusion pattern %q", ErrInvalidInput, p)
+		}
+	}
+	return nil
+}
+
+// MatchesExclusionPattern reports whether a slash-separated path matches any
+// of the glob patterns, either as a whole or by its final element, so "*.log"
+// excludes log files in every directory.
+func MatchesExclusionPattern(name string, patterns []string) bool {
+	if name == "" {
+		return false
+	}
+	base := path.Base(name)
+	for _, p := range patterns {
+		if matched, err := path.Match(p, name); err == nil && matched {
+			return true
+		}
+		if matched, err := path.Match(p, base); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
 // SyncState tracks the synchronisation progress for a source.
 type SyncState struct {
 	// SourceID links to the Source being synced.
@@ -62,3 +219,45 @@ type SyncState struct {
 	// LastSync is when the last successful sync completed.
 	LastSync time.Time
 }
+
+// SyncPreview summarises what a sync would index, as reported by a dry run.
+// Nothing is written while producing it and the sync cursor is not advanced.
+type SyncPreview struct {
+	// SourceID identifies the previewed source.
+	SourceID string
+
+	// Incremental is true when the preview covers changes since the last sync
+	// rather than a full sync.
+	Incremental bool
+
+	// Documents is the number of documents that would be indexed.
+	Documents int
+
+	// Bytes is the estimated raw size of the documents that would be indexed.
+	Bytes int64
+
+	// ByMIMEType breaks Documents and Bytes down by MIME type.
+	ByMIMEType map[string]MIMETypeStats
+
+	// Deletions is the number of documents that would be removed.
+	Deletions int
+
+	// Excluded is the number of documents skipped by exclusion rules.
+	Excluded int
+
+	// Unsupported is the number of documents no normaliser can handle.
+	Unsupported int
+
+	// Empty is the number of documents skipped because their normalised
+	// content is empty or only whitespace.
+	Empty int
+
+	// Errors is the number of documents that failed to normalise.
+	Errors int
+}
+
+// MIMETypeStats counts the documents of one MIME type in a SyncPreview.
+type MIMETypeStats struct {
+	Documents int
+	Bytes     int64
+}
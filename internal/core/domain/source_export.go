@@ -0,0 +1,35 @@
+package domain
+
+import "maps"
+
+// SourceExportVersion is the version of the source export file layout.
+// It is bumped when the layout changes incompatibly.
+const SourceExportVersion = 1
+
+// SourceExportFile is a shareable set of source configurations, written by
+// 'sercha source export' and read by 'sercha source import'.
+type SourceExportFile struct {
+	// Version is the file layout version (see SourceExportVersion).
+	Version int `yaml:"version"`
+	// Sources are the exported source configurations.
+	Sources []SourceExport `yaml:"sources"`
+}
+
+// SourceExport is the shareable part of a Source. It carries no
+// credentials or secret config, so each machine authenticates on import.
+type SourceExport struct {
+	// Type identifies the connector type (e.g., "filesystem", "github").
+	Type string `yaml:"type"`
+	// Name is the human-readable name for the source.
+	Name string `yaml:"name,omitempty"`
+	// Config contains the connector-specific configuration.
+	Config map[string]string `yaml:"config,omitempty"`
+	// AuthProvider is the name of the OAuth app configuration the source
+	// used, so an import can select the same-named one.
+	AuthProvider string `yaml:"auth_provider,omitempty"`
+}
+
+// Matches reports whether source has the same type and config as e.
+func (e *SourceExport) Matches(source *Source) bool {
+	return e.Type == source.Type && maps.Equal(e.Config, source.Config)
+}
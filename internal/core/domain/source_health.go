@@ -0,0 +1,53 @@
+package domain
+
+import "time"
+
+// HealthStatus summarises the result of a source health check.
+type HealthStatus string
+
+const (
+	// HealthUnknown means the source has not been checked.
+	HealthUnknown HealthStatus = "unknown"
+	// HealthOK means the connector validated successfully.
+	HealthOK HealthStatus = "ok"
+	// HealthWarning means validation passed but something needs attention,
+	// such as an OAuth token that is about to expire.
+	HealthWarning HealthStatus = "warning"
+	// HealthFailed means the connector failed validation.
+	HealthFailed HealthStatus = "failed"
+)
+
+// SourceHealth records the last validation result for a source.
+// It is produced by checking credentials and connectivity without syncing.
+type SourceHealth struct {
+	// SourceID links to the Source that was checked.
+	SourceID string
+
+	// Error is the validation failure. Empty if validation passed.
+	Error string
+
+	// Warning describes a non-fatal issue found during the check.
+	Warning string
+
+	// TokenExpiry is when the source's OAuth access token expires.
+	// Zero for sources without OAuth credentials.
+	TokenExpiry time.Time
+
+	// CheckedAt is when the check ran.
+	CheckedAt time.Time
+}
+
+// Status returns the health status derived from the check result.
+// A nil SourceHealth has HealthUnknown status.
+func (h *SourceHealth) Status() HealthStatus {
+	switch {
+	case h == nil:
+		return HealthUnknown
+	case h.Error != "":
+		return HealthFailed
+	case h.Warning != "":
+		return HealthWarning
+	default:
+		return HealthOK
+	}
+}
@@ -0,0 +1,54 @@
+package domain
+
+import "fmt"
+
+// SourceManifestVersion is the version of the source manifest layout.
+// It is bumped when the layout changes incompatibly.
+const SourceManifestVersion = 1
+
+// SourceManifest declares the sources 'sercha apply' creates or updates.
+type SourceManifest struct {
+	// Version is the file layout version (see SourceManifestVersion).
+	Version int `yaml:"version"`
+	// Sources are the declared sources.
+	Sources []SourceSpec `yaml:"sources"`
+}
+
+// SourceSpec declares one source. Applying it updates the source with the
+// same ID, or with the same name if no ID is given, and creates it otherwise.
+type SourceSpec struct {
+	// ID optionally pins the source ID, matching an existing source first.
+	ID string `yaml:"id,omitempty"`
+	// Type identifies the connector type (e.g., "filesystem", "github").
+	Type string `yaml:"type"`
+	// Name is the human-readable name, used to match existing sources.
+	Name string `yaml:"name"`
+	// Config contains the connector-specific configuration.
+	Config map[string]string `yaml:"config,omitempty"`
+	// Auth references the credentials used when the source is created.
+	Auth SourceSpecAuth `yaml:"auth,omitempty"`
+}
+
+// SourceSpecAuth references credentials without embedding secrets in the
+// manifest. It is only used when a source is created.
+type SourceSpecAuth struct {
+	// Provider is the ID or name of an OAuth app configuration.
+	Provider string `yaml:"provider,omitempty"`
+	// TokenEnv names the environment variable holding a Personal Access Token.
+	TokenEnv string `yaml:"token_env,omitempty"`
+}
+
+// Validate checks that the spec has a connector type and a valid name.
+// Returns an error wrapping ErrInvalidInput otherwise.
+func (s *SourceSpec) Validate() error {
+	if s.Type == "" {
+		return fmt.Errorf("%w: connector type is required", ErrInvalidInput)
+	}
+	if err := ValidateSourceName(s.Name); err != nil {
+		return err
+	}
+	if s.Auth.Provider != "" && s.Auth.TokenEnv != "" {
+		return fmt.Errorf("%w: auth provider and token_env are mutually exclusive", ErrInvalidInput)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -319,3 +320,162 @@ func TestSyncState_CursorFormats(t *testing.T) {
 		})
 	}
 }
+
+// TestSource_HasPipelineOverride tests detecting per-source pipeline overrides
+func TestSource_HasPipelineOverride(t *testing.T) {
+	assert.False(t, (&Source{}).HasPipelineOverride())
+	assert.False(t, (&Source{Config: map[string]string{"path": "/notes"}}).HasPipelineOverride())
+
+	for _, key := range PipelineConfigKeys {
+		source := &Source{Config: map[string]string{key: "chunker"}}
+		assert.True(t, source.HasPipelineOverride(), key)
+	}
+}
+
+// TestSource_ExclusionPatterns tests reading exclusion patterns from config
+func TestSource_ExclusionPatterns(t *testing.T) {
+	assert.Nil(t, (&Source{}).ExclusionPatterns())
+
+	source := &Source{Config: map[string]string{ConfigKeyExcludePatterns: "*.log, node_modules,,build/*"}}
+	assert.Equal(t, []string{"*.log", "node_modules", "build/*"}, source.ExclusionPatterns())
+	assert.Equal(t, "*.log,node_modules,build/*", FormatExclusionPatterns(source.ExclusionPatterns()))
+}
+
+func TestSource_ContentLimits(t *testing.T) {
+	tests := []struct {
+		name   string
+		config map[string]string
+		want   ContentLimits
+	}{
+		{"defaults", nil, ContentLimits{MaxSize: DefaultMaxContentSize}},
+		{"max size", map[string]string{ConfigKeyMaxContentSize: "1024"}, ContentLimits{MaxSize: 1024}},
+		{"invalid max size", map[string]string{ConfigKeyMaxContentSize: "big"}, DefaultContentLimits()},
+		{"zero max size", map[string]string{ConfigKeyMaxContentSize: "0"}, DefaultContentLimits()},
+		{
+			"metadata only",
+			map[string]string{ConfigKeyMetadataOnly: "true"},
+			ContentLimits{MaxSize: DefaultMaxContentSize, MetadataOnly: true},
+		},
+		{"metadata only off", map[string]string{ConfigKeyMetadataOnly: "false"}, DefaultContentLimits()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &Source{Config: tt.config}
+			assert.Equal(t, tt.want, source.ContentLimits())
+		})
+	}
+}
+
+func TestContentLimits_AllowsDownload(t *testing.T) {
+	limits := ContentLimits{MaxSize: 100}
+	assert.True(t, limits.AllowsDownload(0))
+	assert.True(t, limits.AllowsDownload(100))
+	assert.False(t, limits.AllowsDownload(101))
+
+	limits.MetadataOnly = true
+	assert.False(t, limits.AllowsDownload(0), "metadata-only never downloads")
+}
+
+func TestValidateExclusionPatterns(t *testing.T) {
+	assert.NoError(t, ValidateExclusionPatterns([]string{"*.log", "docs/[a-c]*"}))
+	assert.NoError(t, ValidateExclusionPatterns(nil))
+
+	err := ValidateExclusionPatterns([]string{"*.md", "[unclosed"})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	assert.Contains(t, err.Error(), `"[unclosed"`)
+}
+
+func TestMatchesExclusionPattern(t *testing.T) {
+	patterns := []string{"*.log", "node_modules", "build/*"}
+
+	tests := []struct {
+		name string
+		path string
+		want bool
+	}{
+		{"base name glob", "logs/app.log", true},
+		{"directory name", "web/node_modules", true},
+		{"whole path glob", "build/out.bin", true},
+		{"nested path outside glob", "src/build/out.bin", false},
+		{"no match", "notes/todo.md", false},
+		{"empty path", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchesExclusionPattern(tt.path, patterns))
+		})
+	}
+	assert.False(t, MatchesExclusionPattern("app.log", nil))
+}
+
+func TestValidateSourceName(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{"simple", "Work Docs", false},
+		{"surrounding whitespace", "  Work Docs  ", false},
+		{"max length", strings.Repeat("a", MaxSourceNameLength), false},
+		{"max length multibyte", strings.Repeat("é", MaxSourceNameLength), false},
+		{"empty", "", true},
+		{"whitespace only", " \t ", true},
+		{"too long", strings.Repeat("a", MaxSourceNameLength+1), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSourceName(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInput)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSourceExport_Matches(t *testing.T) {
+	export := SourceExport{Type: "github", Name: "Docs", Config: map[string]string{"owner": "acme", "repo": "docs"}}
+
+	assert.True(t, export.Matches(&Source{
+		ID: "src-1", Type: "github", Name: "Other name", Config: map[string]string{"repo": "docs", "owner": "acme"},
+	}))
+	assert.False(t, export.Matches(&Source{Type: "gitlab", Config: map[string]string{"owner": "acme", "repo": "docs"}}))
+	assert.False(t, export.Matches(&Source{Type: "github", Config: map[string]string{"owner": "acme", "repo": "web"}}))
+	assert.False(t, export.Matches(&Source{Type: "github", Config: map[string]string{"owner": "acme"}}))
+
+	empty := SourceExport{Type: "gmail"}
+	assert.True(t, empty.Matches(&Source{Type: "gmail", Config: map[string]string{}}))
+}
+
+func TestSourceSpec_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    SourceSpec
+		wantErr bool
+	}{
+		{"valid", SourceSpec{Type: "filesystem", Name: "Notes"}, false},
+		{"token env", SourceSpec{Type: "github", Name: "Docs", Auth: SourceSpecAuth{TokenEnv: "GITHUB_TOKEN"}}, false},
+		{"missing type", SourceSpec{Name: "Notes"}, true},
+		{"missing name", SourceSpec{Type: "filesystem"}, true},
+		{
+			"provider and token env",
+			SourceSpec{Type: "github", Name: "Docs", Auth: SourceSpecAuth{Provider: "app", TokenEnv: "GITHUB_TOKEN"}},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInput)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
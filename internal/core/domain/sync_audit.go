@@ -0,0 +1,65 @@
+package domain
+
+import "time"
+
+// SyncAuditEvent is what a sync did with a document.
+type SyncAuditEvent string
+
+const (
+	// SyncAuditCreated means the document was indexed for the first time.
+	SyncAuditCreated SyncAuditEvent = "created"
+	// SyncAuditUpdated means a document already in the index was re-indexed.
+	SyncAuditUpdated SyncAuditEvent = "updated"
+	// SyncAuditDeleted means the document was removed from the index.
+	SyncAuditDeleted SyncAuditEvent = "deleted"
+	// SyncAuditSkipped means the document was deliberately not indexed.
+	SyncAuditSkipped SyncAuditEvent = "skipped"
+	// SyncAuditFailed means the document could not be indexed.
+	SyncAuditFailed SyncAuditEvent = "failed"
+)
+
+// MaxSyncAuditEntries is how many entries the sync audit log keeps. Once it
+// is full, the oldest entries are evicted first.
+const MaxSyncAuditEntries = 100000
+
+// DefaultSyncAuditLimit is how many entries are listed by default.
+const DefaultSyncAuditLimit = 100
+
+// SyncAuditEntry records what a sync did with one document.
+type SyncAuditEntry struct {
+	// ID orders entries by when they were recorded. Set by the store.
+	ID int64
+
+	// SourceID is the source that was syncing.
+	SourceID string
+
+	// DocumentURI identifies the document within the source.
+	DocumentURI string
+
+	// Event is what happened to the document.
+	Event SyncAuditEvent
+
+	// Reason explains skipped and failed documents, e.g. "excluded".
+	// Empty for other events.
+	Reason string
+
+	// SyncRunID identifies the sync that processed the document.
+	SyncRunID string
+
+	// OccurredAt is when the document was processed.
+	OccurredAt time.Time
+}
+
+// SyncAuditFilter selects sync audit log entries.
+type SyncAuditFilter struct {
+	// SourceID limits entries to one source. Empty matches every source.
+	SourceID string
+
+	// After limits entries to those that occurred after this time.
+	// Zero matches every entry.
+	After time.Time
+
+	// Limit is the maximum number of entries, newest first.
+	// Zero or less means DefaultSyncAuditLimit.
+	Limit int
+}
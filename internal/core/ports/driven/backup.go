@@ -0,0 +1,26 @@
+package driven
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// BackupArchiver packages and restores the application's persistent data:
+// the metadata database, the search index, the vector index and the
+// configuration. Credentials and API keys are left out of archives.
+type BackupArchiver interface {
+	// Create writes a backup archive to outputPath. The archive is written
+	// atomically, so outputPath is never left partially written.
+	Create(ctx context.Context, outputPath string) (*domain.BackupManifest, error)
+
+	// Restore validates the archive at archivePath and replaces the live
+	// metadata database, vector index and configuration with its contents,
+	// keeping the live credentials and API keys. The search index is not
+	// restored; callers rebuild it from the restored documents.
+	// Returns domain.ErrInvalidBackup if the archive cannot be restored, or
+	// domain.ErrBackupOutdated if the live data has synced since the backup
+	// was taken and opts.Force is not set. In both cases the live data is
+	// left untouched.
+	Restore(ctx context.Context, archivePath string, opts domain.RestoreOptions) (*domain.BackupManifest, error)
+}
@@ -15,6 +15,10 @@ type ConfigStore interface {
 	// Returns 0 if key doesn't exist or isn't an integer.
 	GetInt(key string) int
 
+	// GetFloat retrieves a floating-point configuration value.
+	// Integer values are converted. Returns 0 if key doesn't exist or isn't a number.
+	GetFloat(key string) float64
+
 	// GetBool retrieves a boolean configuration value.
 	// Returns false if key doesn't exist or isn't a boolean.
 	GetBool(key string) bool
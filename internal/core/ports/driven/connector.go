@@ -85,7 +85,8 @@ type ConnectorCapabilities struct {
 	SupportsCursorReturn bool
 
 	// SupportsPartialSync indicates the connector can resume interrupted syncs.
-	// When true, sync state should be saved incrementally.
+	// When true, sync state is saved incrementally from SyncCheckpoint values
+	// and failed documents are retried individually rather than failing the run.
 	SupportsPartialSync bool
 
 	// === API Characteristics (informational) ===
@@ -97,6 +98,10 @@ type ConnectorCapabilities struct {
 	// SupportsPagination indicates the connector handles paginated APIs.
 	// Connectors handle pagination internally; this is informational.
 	SupportsPagination bool
+
+	// SupportsParallelFetch indicates the connector can fetch its content
+	// types concurrently when domain.ConfigKeyParallelWithinSource is set.
+	SupportsParallelFetch bool
 }
 
 // SyncComplete is sent on the error channel when sync completes successfully.
@@ -120,3 +125,53 @@ func IsSyncComplete(err error) (*SyncComplete, bool) {
 	}
 	return nil, false
 }
+
+// SyncCheckpoint is sent on the error channel during a sync to report a cursor
+// from which an interrupted sync can resume. Everything sent before the
+// checkpoint must be covered by its cursor. Only honoured for connectors that
+// set SupportsPartialSync; the sync continues after a checkpoint.
+type SyncCheckpoint struct {
+	Cursor string
+}
+
+// Error implements the error interface.
+// This allows SyncCheckpoint to be sent on the error channel.
+func (SyncCheckpoint) Error() string {
+	return "sync checkpoint"
+}
+
+// IsSyncCheckpoint checks if an error is actually a progress checkpoint.
+// Returns the SyncCheckpoint and true if it is, nil and false otherwise.
+func IsSyncCheckpoint(err error) (*SyncCheckpoint, bool) {
+	var cp *SyncCheckpoint
+	if errors.As(err, &cp) {
+		return cp, true
+	}
+	return nil, false
+}
+
+// APIBudget bounds the outbound API load of all connectors together,
+// so total load stays capped however many sources sync at once.
+type APIBudget interface {
+	// Acquire blocks until one more request fits within the budget.
+	// The returned release function must be called once the request
+	// completes. Returns an error if ctx is cancelled while waiting.
+	Acquire(ctx context.Context) (release func(), err error)
+}
+
+// APIBudgetAware is an optional interface for connectors whose API requests
+// can be charged to a shared APIBudget. The connector factory injects the
+// budget after construction; connectors without one are unbounded.
+type APIBudgetAware interface {
+	// SetAPIBudget sets the budget that every API request is charged to.
+	SetAPIBudget(budget APIBudget)
+}
+
+// LocaleAware is an optional interface for connectors whose providers
+// localise content by Accept-Language. The connector factory injects the
+// configured locale after construction, after the API budget.
+type LocaleAware interface {
+	// SetLocale sets the locale (e.g., "en-GB") sent as Accept-Language on
+	// requests that do not set their own, such as from a per-source locale.
+	SetLocale(locale string)
+}
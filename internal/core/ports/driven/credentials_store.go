@@ -7,8 +7,9 @@ import (
 )
 
 // CredentialsStore persists user-specific authentication credentials.
-// Credentials are tied to a specific source (1:1 relationship) and store
-// OAuth tokens or PAT along with the account identifier.
+// Credentials are owned by a specific source and store OAuth tokens or PAT
+// along with the account identifier. One AuthProvider may have many
+// Credentials (one per authenticated account).
 type CredentialsStore interface {
 	// Save stores credentials. Creates if new, updates if exists.
 	Save(ctx context.Context, creds domain.Credentials) error
@@ -20,6 +21,10 @@ type CredentialsStore interface {
 	// Returns nil if no credentials exist for the source.
 	GetBySourceID(ctx context.Context, sourceID string) (*domain.Credentials, error)
 
+	// GetAllByAuthProviderID retrieves all accounts authenticated through an auth provider.
+	// Returns an empty slice if there are none.
+	GetAllByAuthProviderID(ctx context.Context, authProviderID string) ([]domain.Credentials, error)
+
 	// Delete removes credentials by ID.
 	Delete(ctx context.Context, id string) error
 }
@@ -0,0 +1,11 @@
+package driven
+
+import "context"
+
+// VersionReporter is optionally implemented by a store or search engine that
+// can report the version of the library it is built on, for diagnostics.
+type VersionReporter interface {
+	// EngineVersion returns the library version, e.g. "3.45.1" for SQLite.
+	// Returns domain.ErrNotImplemented if the library is not available.
+	EngineVersion(ctx context.Context) (string, error)
+}
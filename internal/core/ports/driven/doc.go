@@ -27,6 +27,7 @@
 //   - VectorIndex: Vector storage/search (HNSWlib). Only enabled when EmbeddingService is configured.
 //   - EmbeddingService: Generates vector embeddings. Without it, VectorIndex is also disabled.
 //   - LLMService: Language model operations. Without it, query rewriting/summarisation is disabled.
+//   - Notifier: Reports finished syncs. Without it, no sync notifications are sent.
 //
 // # Import Rules
 //
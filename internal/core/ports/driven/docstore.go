@@ -30,3 +30,26 @@ type DocumentStore interface {
 	// ListDocuments returns documents for a source.
 	ListDocuments(ctx context.Context, sourceID string) ([]domain.Document, error)
 }
+
+// DocumentCounter is implemented by document stores that can count a
+// source's documents without loading them.
+type DocumentCounter interface {
+	// CountDocuments returns the number of documents for a source.
+	CountDocuments(ctx context.Context, sourceID string) (int, error)
+}
+
+// DocumentBatchDeleter is implemented by document stores that can remove
+// several documents in one operation.
+type DocumentBatchDeleter interface {
+	// DeleteDocuments removes the given documents and their chunks.
+	// IDs that do not exist are ignored.
+	DeleteDocuments(ctx context.Context, ids []string) error
+}
+
+// DocumentSourceLister is implemented by document stores that can list the
+// sources they hold documents for, so documents left behind by a removed
+// source can be found.
+type DocumentSourceLister interface {
+	// DocumentSourceIDs returns the distinct source IDs of stored documents.
+	DocumentSourceIDs(ctx context.Context) ([]string, error)
+}
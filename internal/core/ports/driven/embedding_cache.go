@@ -0,0 +1,46 @@
+package driven
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// EmbeddingCache stores embeddings by content hash, so chunks whose text is
+// unchanged are not re-embedded when a source is synced again.
+// Keys are derived from the model name and the embedded text.
+type EmbeddingCache interface {
+	// Get returns the embedding cached under key.
+	// Returns domain.ErrNotFound on a cache miss.
+	Get(ctx context.Context, key string) ([]float32, error)
+
+	// Put caches an embedding computed by model under key.
+	Put(ctx context.Context, key, model string, embedding []float32) error
+
+	// DeleteOtherModels removes embeddings computed by any model other than
+	// the given ones, invalidating the cache after the embedding models
+	// change. Document hashes recorded for other models are removed too,
+	// when the cache is also a DocumentEmbeddingCache.
+	// Returns the number of embeddings removed.
+	DeleteOtherModels(ctx context.Context, models ...string) (int, error)
+}
+
+// DocumentEmbeddingCache is implemented by embedding caches that also record
+// the content hash each document was embedded from. A re-synced document
+// whose content hash is unchanged reuses the embeddings stored with its
+// previous chunks instead of embedding them again.
+// Callers check for it via type assertion on an EmbeddingCache.
+type DocumentEmbeddingCache interface {
+	// LatestDocument returns the ID of the document most recently embedded
+	// by model for uri in a source, and the content hash it was embedded from.
+	// Returns domain.ErrNotFound if no document was embedded for uri.
+	LatestDocument(ctx context.Context, sourceID, uri, model string) (documentID, contentHash string, err error)
+
+	// PutDocument records that doc's chunks were embedded by model from
+	// content with the given hash. The document must already be saved.
+	PutDocument(ctx context.Context, doc *domain.Document, contentHash, model string) error
+
+	// DeleteDocument removes the hash recorded for a document.
+	// Deleting a document without a recorded hash is not an error.
+	DeleteDocument(ctx context.Context, documentID string) error
+}
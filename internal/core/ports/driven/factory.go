@@ -19,6 +19,9 @@ type OAuthDefaults struct {
 	TokenURL string
 	// Scopes are the default OAuth scopes to request.
 	Scopes []string
+	// DeviceURL is the device authorization endpoint.
+	// Empty if the provider does not support the device code flow.
+	DeviceURL string
 }
 
 // ConnectorFactory creates connectors from source configuration.
@@ -1,7 +1,10 @@
 // Package driven provides interfaces for infrastructure adapters (secondary/outbound ports).
 package driven
 
-import "context"
+import (
+	"context"
+	"strings"
+)
 
 // LLMService provides language model operations for query and document understanding.
 // This is an optional service - when nil, features degrade gracefully to keyword-only search.
@@ -18,6 +21,12 @@ type LLMService interface {
 	// Chat conducts a multi-turn conversation.
 	Chat(ctx context.Context, messages []ChatMessage, opts ChatOptions) (string, error)
 
+	// ChatStream conducts a multi-turn conversation, sending the reply in
+	// chunks as the model generates it. The chunk channel is closed when the
+	// reply ends, after which the error channel yields nil or the failure.
+	// Use CollectStream to wait for the whole reply.
+	ChatStream(ctx context.Context, messages []ChatMessage, opts ChatOptions) (<-chan string, <-chan error)
+
 	// RewriteQuery expands or rewrites a search query for better recall.
 	// This can add synonyms, fix typos, or expand abbreviations.
 	RewriteQuery(ctx context.Context, query string) (string, error)
@@ -65,3 +74,16 @@ type ChatOptions struct {
 	// Temperature controls randomness (0.0 = deterministic, 1.0 = creative).
 	Temperature float64
 }
+
+// CollectStream reads a reply stream from LLMService.ChatStream to the end
+// and returns the concatenated chunks.
+func CollectStream(chunks <-chan string, errs <-chan error) (string, error) {
+	var reply strings.Builder
+	for chunk := range chunks {
+		reply.WriteString(chunk)
+	}
+	if err := <-errs; err != nil {
+		return "", err
+	}
+	return reply.String(), nil
+}
@@ -0,0 +1,58 @@
+package driven
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// DatabaseVacuumer reclaims unused space in the metadata database.
+type DatabaseVacuumer interface {
+	// Vacuum checkpoints the write-ahead log and rebuilds the database file.
+	// Returns an error if another connection prevents the checkpoint.
+	Vacuum(ctx context.Context) error
+}
+
+// DiskUsageMeter measures the disk space used by the persistent stores.
+type DiskUsageMeter interface {
+	// DiskUsage returns the current size of each store.
+	DiskUsage(ctx context.Context) (domain.DiskUsage, error)
+}
+
+// SearchIndexCompactor is optionally implemented by a SearchEngine that can
+// rewrite its index to reclaim space left by deleted and updated chunks.
+type SearchIndexCompactor interface {
+	// Compact rewrites the index in place.
+	Compact(ctx context.Context) error
+}
+
+// SearchIndexFlusher is optionally implemented by a SearchEngine that buffers
+// writes, so pending changes can be committed after a bulk sync.
+type SearchIndexFlusher interface {
+	// Flush commits pending changes to disk.
+	Flush(ctx context.Context) error
+}
+
+// SearchIndexMaintainer is optionally implemented by a SearchEngine that can
+// enumerate its contents, so chunks of deleted documents can be found.
+type SearchIndexMaintainer interface {
+	// ChunkIDs returns the IDs of all indexed chunks.
+	ChunkIDs(ctx context.Context) ([]string, error)
+}
+
+// VectorIndexCompactor is optionally implemented by a VectorIndex that can
+// rebuild itself to drop the space held by deleted and replaced vectors.
+type VectorIndexCompactor interface {
+	// Compact rebuilds the index from its live vectors and saves it.
+	Compact(ctx context.Context) error
+}
+
+// VectorIndexMaintainer is optionally implemented by a VectorIndex that can
+// enumerate its contents, so vectors for deleted chunks can be found.
+type VectorIndexMaintainer interface {
+	// ChunkIDs returns the IDs of all chunks with a stored vector.
+	ChunkIDs(ctx context.Context) ([]string, error)
+
+	// Save writes pending changes to disk.
+	Save(ctx context.Context) error
+}
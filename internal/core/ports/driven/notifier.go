@@ -0,0 +1,15 @@
+package driven
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// Notifier reports finished syncs to the user, e.g. as a desktop
+// notification or a webhook call.
+type Notifier interface {
+	// Notify delivers a notification. Implementations should return promptly;
+	// the caller bounds ctx with a timeout.
+	Notify(ctx context.Context, notification domain.SyncNotification) error
+}
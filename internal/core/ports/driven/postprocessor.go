@@ -24,3 +24,10 @@ type PostProcessorPipeline interface {
 	// Returns the final chunks after all processing.
 	Process(ctx context.Context, doc *domain.Document) ([]domain.Chunk, error)
 }
+
+// PipelineProvider selects the post-processor pipeline for a source's
+// documents, letting sources override the global pipeline.
+type PipelineProvider interface {
+	// PipelineFor returns the pipeline to run on the source's documents.
+	PipelineFor(source *domain.Source) (PostProcessorPipeline, error)
+}
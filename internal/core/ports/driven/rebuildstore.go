@@ -0,0 +1,21 @@
+package driven
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// RebuildStateStore persists index rebuild watermarks so an interrupted
+// rebuild can resume.
+type RebuildStateStore interface {
+	// Save stores or updates the watermark for a rebuild scope.
+	Save(ctx context.Context, state domain.RebuildState) error
+
+	// Get retrieves the watermark for a rebuild scope.
+	// Returns domain.ErrNotFound if no rebuild is in progress.
+	Get(ctx context.Context, scope string) (*domain.RebuildState, error)
+
+	// Delete removes the watermark for a rebuild scope.
+	Delete(ctx context.Context, scope string) error
+}
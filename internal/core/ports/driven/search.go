@@ -22,6 +22,16 @@ type SearchEngine interface {
 	Close() error
 }
 
+// BM25SearchEngine is implemented by search engines whose BM25 weighting
+// can be tuned per query.
+type BM25SearchEngine interface {
+	// SearchBM25 performs a keyword search ranked with the given BM25
+	// parameters: k1 (term frequency saturation) and b (length normalisation).
+	// Language is an ISO 639-1 code selecting how query terms are stemmed;
+	// empty uses the engine's default language.
+	SearchBM25(ctx context.Context, query string, limit int, k1, b float64, language string) ([]SearchHit, error)
+}
+
 // SearchHit represents a search result from the engine.
 type SearchHit struct {
 	// ChunkID is the matched chunk.
@@ -29,4 +39,9 @@ type SearchHit struct {
 
 	// Score is the relevance score (e.g., BM25).
 	Score float64
+
+	// Snippet is an excerpt of the chunk with query terms highlighted,
+	// in the format of domain.SearchResult.Snippet. Empty if the engine
+	// does not produce snippets.
+	Snippet string
 }
@@ -0,0 +1,15 @@
+package driven
+
+import "github.com/custodia-labs/sercha-cli/internal/core/domain"
+
+// SearchSessionStore persists the last TUI search between runs.
+type SearchSessionStore interface {
+	// Load returns the saved session, or nil if there is none.
+	Load() (*domain.SearchSession, error)
+
+	// Save replaces the saved session.
+	Save(session domain.SearchSession) error
+
+	// Clear removes the saved session. Clearing when there is none is not an error.
+	Clear() error
+}
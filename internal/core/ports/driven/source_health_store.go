@@ -0,0 +1,20 @@
+package driven
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// SourceHealthStore persists the last health check result for each source.
+type SourceHealthStore interface {
+	// Save stores or replaces the health result for a source.
+	Save(ctx context.Context, health domain.SourceHealth) error
+
+	// Get retrieves the health result for a source.
+	// Returns domain.ErrNotFound if the source has never been checked.
+	Get(ctx context.Context, sourceID string) (*domain.SourceHealth, error)
+
+	// Delete removes the health result for a source.
+	Delete(ctx context.Context, sourceID string) error
+}
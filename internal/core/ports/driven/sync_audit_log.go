@@ -0,0 +1,20 @@
+package driven
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// SyncAuditLog persists a record of every document processed by syncs.
+// It keeps at most domain.MaxSyncAuditEntries, evicting the oldest first.
+type SyncAuditLog interface {
+	// Append records entries, evicting the oldest if the log is full.
+	Append(ctx context.Context, entries []domain.SyncAuditEntry) error
+
+	// List returns the entries matching filter, newest first.
+	List(ctx context.Context, filter domain.SyncAuditFilter) ([]domain.SyncAuditEntry, error)
+
+	// Clear removes every entry, returning how many were removed.
+	Clear(ctx context.Context) (int, error)
+}
@@ -11,6 +11,7 @@ import (
 //
 // This interface is designed to work alongside the Scheduler's proactive refresh:
 //   - Scheduler: Proactive refresh every 45min (prevents refresh token expiry)
+//   - RefreshIfNeeded: Proactive refresh before a sync starts
 //   - TokenProvider: Reactive refresh if token expired when connector needs it
 type TokenProvider interface {
 	// GetToken returns a valid access token.
@@ -28,4 +29,43 @@ type TokenProvider interface {
 	// IsAuthenticated returns true if valid authentication is available.
 	// Always true for no-auth connectors (NullTokenProvider).
 	IsAuthenticated() bool
+
+	// RefreshIfNeeded refreshes the access token if it expires within the
+	// refresh window, persisting the new token unless the provider was
+	// created under WithoutCredentialWrites. No-op for PAT and no-auth.
+	// A failed refresh returns an error wrapping domain.ErrAuthInvalid, which
+	// also wraps domain.ErrAuthExpired if the refresh token has been rejected
+	// and the user must re-authenticate.
+	RefreshIfNeeded(ctx context.Context) error
+}
+
+// TokenProviderFactory creates TokenProviders for sources.
+type TokenProviderFactory interface {
+	// CreateTokenProvider returns the TokenProvider for a source's credentials.
+	CreateTokenProvider(ctx context.Context, source *domain.Source) (TokenProvider, error)
+}
+
+// credentialWritesKey is the context key set by WithoutCredentialWrites.
+type credentialWritesKey struct{}
+
+// WithoutCredentialWrites returns a copy of ctx under which a
+// TokenProviderFactory creates providers that keep refreshed tokens in memory
+// instead of saving them to the credentials store. Dry runs use it so that
+// previewing a sync writes nothing.
+func WithoutCredentialWrites(ctx context.Context) context.Context {
+	return context.WithValue(ctx, credentialWritesKey{}, true)
+}
+
+// CredentialWritesDisabled reports whether ctx is from WithoutCredentialWrites.
+func CredentialWritesDisabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(credentialWritesKey{}).(bool)
+	return disabled
+}
+
+// TokenRefresher exchanges a refresh token for new tokens using the
+// connector type's OAuth handler. ConnectorFactory satisfies it.
+type TokenRefresher interface {
+	RefreshToken(
+		ctx context.Context, connectorType string, authProvider *domain.AuthProvider, refreshToken string,
+	) (*domain.OAuthToken, error)
 }
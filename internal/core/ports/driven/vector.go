@@ -18,6 +18,20 @@ type VectorIndex interface {
 	Close() error
 }
 
+// ModelVectorIndex is optionally implemented by a VectorIndex that keeps the
+// embeddings of each embedding model apart, since similarities between
+// vectors from different models are meaningless. The VectorIndex methods
+// act on the default model's vectors, except Delete, which removes a chunk's
+// vector whichever model computed it.
+type ModelVectorIndex interface {
+	// AddForModel inserts a vector computed by model for the given chunk ID.
+	AddForModel(ctx context.Context, model, chunkID string, embedding []float32) error
+
+	// SearchModel finds the k nearest neighbours to a query vector computed
+	// by model, among the vectors computed by the same model.
+	SearchModel(ctx context.Context, model string, query []float32, k int) ([]VectorHit, error)
+}
+
 // VectorHit represents a similarity search result.
 type VectorHit struct {
 	// ChunkID is the matched chunk.
@@ -0,0 +1,14 @@
+package driving
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// AccountService provides a cross-connector view of authenticated accounts.
+type AccountService interface {
+	// List returns accounts with the sources that use them, grouped by
+	// provider and account identifier. Sources without credentials are omitted.
+	List(ctx context.Context) ([]domain.Account, error)
+}
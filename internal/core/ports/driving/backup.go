@@ -0,0 +1,19 @@
+package driving
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// BackupService backs up and restores indexed data.
+type BackupService interface {
+	// Backup writes a backup archive to outputPath.
+	Backup(ctx context.Context, outputPath string) (*domain.BackupManifest, error)
+
+	// Restore replaces the live data with the backup at archivePath and
+	// rebuilds the search index from the restored documents. Returns
+	// domain.ErrBackupOutdated if the live data is newer than the backup,
+	// unless opts.Force is set.
+	Restore(ctx context.Context, archivePath string, opts domain.RestoreOptions) (*domain.RestoreResult, error)
+}
@@ -15,6 +15,9 @@ type OAuthDefaults struct {
 	TokenURL string
 	// Scopes are the default OAuth scopes to request.
 	Scopes []string
+	// DeviceURL is the device authorization endpoint.
+	// Empty if the provider does not support the device code flow.
+	DeviceURL string
 }
 
 // ConnectorRegistry provides information about available connector types.
@@ -57,4 +60,12 @@ type ConnectorRegistry interface {
 	// This allows connectors with non-standard OAuth (e.g., Notion requiring JSON body + Basic Auth)
 	// to implement their own token exchange while maintaining the factory abstraction.
 	ExchangeCode(ctx context.Context, connectorType string, authProvider *domain.AuthProvider, code, redirectURI, codeVerifier string) (*domain.OAuthToken, error)
+
+	// RefreshToken exchanges a refresh token for a new access token using
+	// connector-specific logic.
+	RefreshToken(ctx context.Context, connectorType string, authProvider *domain.AuthProvider, refreshToken string) (*domain.OAuthToken, error)
+
+	// UnhandledMIMETypes returns MIME types that connectors can emit but no
+	// registered normaliser supports. Empty when every emitted type is covered.
+	UnhandledMIMETypes() []domain.UnhandledMIMEType
 }
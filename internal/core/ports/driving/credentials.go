@@ -19,6 +19,9 @@ type CredentialsService interface {
 	// Returns nil if no credentials exist for the source.
 	GetBySourceID(ctx context.Context, sourceID string) (*domain.Credentials, error)
 
+	// GetAllByAuthProviderID retrieves all accounts authenticated through an auth provider.
+	GetAllByAuthProviderID(ctx context.Context, authProviderID string) ([]domain.Credentials, error)
+
 	// Delete removes credentials by ID.
 	Delete(ctx context.Context, id string) error
 }
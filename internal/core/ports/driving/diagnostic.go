@@ -0,0 +1,15 @@
+package driving
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// DiagnosticService describes the local installation for bug reports.
+type DiagnosticService interface {
+	// Collect gathers platform, library, index and AI configuration details.
+	// The result holds no credentials or full paths. Version is left empty
+	// for the caller to fill in.
+	Collect(ctx context.Context) (*domain.Diagnostics, error)
+}
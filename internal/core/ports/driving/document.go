@@ -24,9 +24,15 @@ type DocumentService interface {
 	// Exclude removes a document and marks it to skip during re-sync.
 	Exclude(ctx context.Context, documentID, reason string) error
 
+	// ExcludeMultiple excludes several documents at once.
+	ExcludeMultiple(ctx context.Context, documentIDs []string) error
+
 	// Refresh re-syncs a single document from its source.
 	Refresh(ctx context.Context, documentID string) error
 
+	// RefreshMultiple re-syncs several documents from their sources.
+	RefreshMultiple(ctx context.Context, documentIDs []string) error
+
 	// Open opens the document in the default application.
 	Open(ctx context.Context, documentID string) error
 }
@@ -0,0 +1,25 @@
+package driving
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// MaintenanceService performs housekeeping on the local data stores.
+type MaintenanceService interface {
+	// Vacuum compacts the metadata database and search index and removes
+	// vectors whose chunks no longer exist, reporting disk usage before
+	// and after.
+	Vacuum(ctx context.Context) (*domain.VacuumResult, error)
+
+	// Optimize flushes and compacts the search index and compacts the
+	// vector index, reporting disk usage before and after. Intended to be
+	// run after large syncs.
+	Optimize(ctx context.Context) (*domain.OptimizeResult, error)
+
+	// Compact removes documents of removed sources and chunks of deleted
+	// documents from every store, then vacuums the metadata database and
+	// compacts both indexes, reporting disk usage before and after.
+	Compact(ctx context.Context) (*domain.CompactResult, error)
+}
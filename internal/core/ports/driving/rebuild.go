@@ -0,0 +1,26 @@
+package driving
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// RebuildService re-indexes stored documents, for example after a
+// normaliser or chunker change.
+type RebuildService interface {
+	// Rebuild re-processes and re-indexes documents. progress, if not nil,
+	// is called after each document or source. If ctx is cancelled the
+	// rebuild stops and the next Rebuild with the same options resumes
+	// after the last completed document.
+	Rebuild(
+		ctx context.Context, opts domain.RebuildOptions, progress func(domain.RebuildProgress),
+	) (*domain.RebuildResult, error)
+
+	// DeleteReassignedVectors deletes the vectors of documents that the
+	// embedding model overrides assign to a different model after changing
+	// from oldOverrides to newOverrides, so that they are not searched with
+	// another model's queries. Returns how many documents lost their
+	// vectors; a vectors-only rebuild embeds them again.
+	DeleteReassignedVectors(ctx context.Context, oldOverrides, newOverrides map[string]string) (int, error)
+}
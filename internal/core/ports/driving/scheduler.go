@@ -1,6 +1,11 @@
 package driving
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
 
 // Scheduler manages background tasks like OAuth token refresh and document sync.
 type Scheduler interface {
@@ -11,3 +16,19 @@ type Scheduler interface {
 	// Stop gracefully stops all running tasks.
 	Stop() error
 }
+
+// ScheduleService manages per-source sync schedules.
+// Sources without a schedule are synced by the global document sync task.
+type ScheduleService interface {
+	// SetSourceSchedule sets how often a source is synced.
+	SetSourceSchedule(ctx context.Context, sourceID string, interval time.Duration) error
+
+	// SetSourceCron sets a 5-field cron expression (e.g., "0 6 * * 1-5") for when a source is synced.
+	SetSourceCron(ctx context.Context, sourceID, expr string) error
+
+	// ClearSourceSchedule removes a source's schedule so it falls back to the global default.
+	ClearSourceSchedule(ctx context.Context, sourceID string) error
+
+	// GetSourceSchedule returns a source's schedule, or nil if it uses the global default.
+	GetSourceSchedule(ctx context.Context, sourceID string) (*domain.ScheduledTask, error)
+}
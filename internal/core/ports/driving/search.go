@@ -10,4 +10,12 @@ import (
 type SearchService interface {
 	// Search performs hybrid search across all indexed documents.
 	Search(ctx context.Context, query string, opts domain.SearchOptions) ([]domain.SearchResult, error)
+
+	// Ask answers a question with an LLM, using the search results for the
+	// question as context, and reports the results the answer cites.
+	// Returns an empty result if nothing relevant is indexed. onAnswer, if
+	// not nil, is called with each piece of the answer as the LLM writes it.
+	Ask(
+		ctx context.Context, question string, opts domain.SearchOptions, onAnswer func(chunk string),
+	) (domain.AskResult, error)
 }
@@ -0,0 +1,17 @@
+package driving
+
+import "github.com/custodia-labs/sercha-cli/internal/core/domain"
+
+// SearchSessionService remembers the last TUI search so it can be resumed
+// on the next launch.
+type SearchSessionService interface {
+	// Load returns the last search, or nil if there is none.
+	Load() (*domain.SearchSession, error)
+
+	// Save remembers session as the last search. Long queries are
+	// truncated; an empty query clears the last search.
+	Save(session domain.SearchSession) error
+
+	// Clear forgets the last search.
+	Clear() error
+}
@@ -16,6 +16,10 @@ type SettingsService interface {
 	// SetEmbeddingProvider configures the embedding provider.
 	SetEmbeddingProvider(provider domain.AIProvider, model, apiKey string) error
 
+	// SetEmbeddingEndpoint configures the base URL and vector dimensions
+	// for providers that require an endpoint (OpenAI-compatible servers).
+	SetEmbeddingEndpoint(baseURL string, dimensions int) error
+
 	// SetLLMProvider configures the LLM provider.
 	SetLLMProvider(provider domain.AIProvider, model, apiKey string) error
 
@@ -36,4 +40,12 @@ type SettingsService interface {
 
 	// ValidateLLMConfig validates the current LLM configuration by pinging the provider.
 	ValidateLLMConfig() error
+
+	// Set updates a single setting from its string form (e.g., "bm25_k1", "1.2").
+	// Returns an error wrapping domain.ErrInvalidInput for unknown keys or bad values.
+	Set(key, value string) error
+
+	// SetSchedulerCron sets a 5-field cron expression for a built-in scheduler task.
+	// An empty expression clears it. Returns an error if the expression is invalid.
+	SetSchedulerCron(taskID, expr string) error
 }
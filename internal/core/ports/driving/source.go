@@ -20,6 +20,11 @@ type SourceService interface {
 	// Update modifies an existing source configuration.
 	Update(ctx context.Context, source domain.Source) error
 
+	// Rename changes a source's display name.
+	// Returns an error wrapping domain.ErrInvalidInput if the name is empty
+	// or too long, or domain.ErrNotFound if the source does not exist.
+	Rename(ctx context.Context, id, newName string) error
+
 	// Remove deletes a source and its indexed data.
 	Remove(ctx context.Context, id string) error
 
@@ -0,0 +1,23 @@
+package driving
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// SourceHealthService validates source credentials and connectivity without
+// syncing, and records the result for display.
+type SourceHealthService interface {
+	// Check validates a single source and records the result.
+	// Validation failures are reported in the returned SourceHealth;
+	// an error is returned only if the check itself could not run.
+	Check(ctx context.Context, sourceID string) (*domain.SourceHealth, error)
+
+	// CheckAll validates every configured source and records the results.
+	CheckAll(ctx context.Context) ([]domain.SourceHealth, error)
+
+	// Get returns the last recorded result for a source.
+	// Returns nil if the source has never been checked.
+	Get(ctx context.Context, sourceID string) (*domain.SourceHealth, error)
+}
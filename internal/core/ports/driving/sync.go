@@ -1,20 +1,51 @@
 package driving
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
 
 // SyncOrchestrator coordinates document synchronisation from sources.
 type SyncOrchestrator interface {
 	// Sync triggers synchronisation for a source.
 	Sync(ctx context.Context, sourceID string) error
 
+	// DryRun runs a source's connector and normalisers without persisting
+	// anything or advancing the sync cursor, and reports what would be indexed.
+	DryRun(ctx context.Context, sourceID string) (*domain.SyncPreview, error)
+
 	// SyncAll triggers synchronisation for all configured sources.
 	SyncAll(ctx context.Context) error
 
 	// Status returns sync status for a source.
 	Status(ctx context.Context, sourceID string) (*SyncStatus, error)
+
+	// SetParallelWithinSource makes subsequent syncs fetch each source's
+	// content types concurrently, for connectors that support it.
+	SetParallelWithinSource(enabled bool)
+}
+
+// SyncWatcher keeps the index of sources whose connectors support watching
+// up to date as their documents change, without waiting for a sync.
+type SyncWatcher interface {
+	// Start watches the sources that support it and indexes their changes
+	// as they are reported. Blocks until ctx is cancelled or Stop is called.
+	Start(ctx context.Context) error
+
+	// Stop stops watching and waits for changes being applied to finish.
+	Stop() error
+
+	// Updates returns a channel receiving a summary of the changes applied.
+	// Summaries not yet received are merged, so a slow reader never holds up
+	// indexing.
+	Updates() <-chan domain.WatchUpdate
 }
 
 // SyncStatus represents the current state of a sync operation.
+// Once a sync finishes, its final counts remain available until the next sync
+// of the same source starts.
 type SyncStatus struct {
 	// SourceID identifies the source.
 	SourceID string
@@ -27,4 +58,33 @@ type SyncStatus struct {
 
 	// ErrorCount is the number of errors encountered.
 	ErrorCount int
+
+	// FailedCount is the number of documents that still failed after retrying.
+	FailedCount int
+
+	// SkippedCount is the number of documents skipped because no normaliser
+	// supports their content or their content is empty.
+	SkippedCount int
+
+	// FailedURIs lists the documents counted in FailedCount.
+	FailedURIs []string
+
+	// CacheHits is the number of documents whose content was unchanged since
+	// they were last embedded, so their embeddings were reused.
+	CacheHits int
+
+	// CacheMisses is the number of documents that had to be embedded.
+	CacheMisses int
+
+	// LastSyncAt is when the source last synced successfully. Zero if it
+	// never has.
+	LastSyncAt time.Time
+
+	// LastError is why the source's last sync failed, including failures
+	// before any documents were fetched such as rejected credentials.
+	// Nil if it succeeded or no sync has run since startup.
+	LastError error
+
+	// DocumentCount is the number of documents indexed for the source.
+	DocumentCount int
 }
@@ -0,0 +1,17 @@
+package driving
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// SyncAuditService reports what syncs did with each document, for
+// debugging sync issues.
+type SyncAuditService interface {
+	// List returns the audit log entries matching filter, newest first.
+	List(ctx context.Context, filter domain.SyncAuditFilter) ([]domain.SyncAuditEntry, error)
+
+	// Clear empties the audit log, returning how many entries were removed.
+	Clear(ctx context.Context) (int, error)
+}
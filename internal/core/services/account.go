@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// Ensure AccountService implements the interface.
+var _ driving.AccountService = (*AccountService)(nil)
+
+// AccountService groups sources by the account they authenticate as.
+type AccountService struct {
+	sourceStore       driven.SourceStore
+	credentialsStore  driven.CredentialsStore
+	connectorRegistry driving.ConnectorRegistry
+}
+
+// NewAccountService creates a new account service.
+func NewAccountService(
+	sourceStore driven.SourceStore,
+	credentialsStore driven.CredentialsStore,
+	connectorRegistry driving.ConnectorRegistry,
+) *AccountService {
+	return &AccountService{
+		sourceStore:       sourceStore,
+		credentialsStore:  credentialsStore,
+		connectorRegistry: connectorRegistry,
+	}
+}
+
+// accountKey identifies an account. Identifiers are compared case-insensitively
+// since providers are not consistent about the casing of emails.
+type accountKey struct {
+	provider   domain.ProviderType
+	identifier string
+}
+
+// List returns accounts ordered by provider and identifier.
+func (s *AccountService) List(ctx context.Context) ([]domain.Account, error) {
+	if s.sourceStore == nil || s.credentialsStore == nil {
+		return nil, domain.ErrNotImplemented
+	}
+
+	sources, err := s.sourceStore.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Visit sources in a stable order so the identifier casing shown for an
+	// account does not depend on store ordering
+	sort.Slice(sources, func(i, j int) bool { return sources[i].ID < sources[j].ID })
+
+	accounts := make(map[accountKey]*domain.Account)
+	for i := range sources {
+		if sources[i].CredentialsID == "" {
+			continue
+		}
+		creds, err := s.credentialsStore.Get(ctx, sources[i].CredentialsID)
+		if errors.Is(err, domain.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		key := accountKey{
+			provider:   s.providerType(sources[i].Type),
+			identifier: strings.ToLower(creds.AccountIdentifier),
+		}
+		account, ok := accounts[key]
+		if !ok {
+			account = &domain.Account{
+				Identifier:   creds.AccountIdentifier,
+				ProviderType: key.provider,
+			}
+			accounts[key] = account
+		}
+		if !slices.Contains(account.CredentialsIDs, creds.ID) {
+			account.CredentialsIDs = append(account.CredentialsIDs, creds.ID)
+		}
+		account.Sources = append(account.Sources, sources[i])
+	}
+
+	result := make([]domain.Account, 0, len(accounts))
+	for _, account := range accounts {
+		sort.Slice(account.Sources, func(i, j int) bool {
+			return account.Sources[i].Name < account.Sources[j].Name
+		})
+		result = append(result, *account)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].ProviderType != result[j].ProviderType {
+			return result[i].ProviderType < result[j].ProviderType
+		}
+		return strings.ToLower(result[i].Identifier) < strings.ToLower(result[j].Identifier)
+	})
+
+	return result, nil
+}
+
+// providerType resolves the provider for a connector type, falling back to
+// the connector type itself when the connector is not registered.
+func (s *AccountService) providerType(connectorType string) domain.ProviderType {
+	if s.connectorRegistry != nil {
+		if connector, err := s.connectorRegistry.Get(connectorType); err == nil {
+			return connector.ProviderType
+		}
+	}
+	return domain.ProviderType(connectorType)
+}
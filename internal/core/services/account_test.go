@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockCredentialsStore implements driven.CredentialsStore for testing.
+type mockCredentialsStore struct {
+	creds map[string]domain.Credentials
+}
+
+func newMockCredentialsStore(creds ...domain.Credentials) *mockCredentialsStore {
+	m := &mockCredentialsStore{creds: make(map[string]domain.Credentials)}
+	for _, c := range creds {
+		m.creds[c.ID] = c
+	}
+	return m
+}
+
+func (m *mockCredentialsStore) Save(_ context.Context, creds domain.Credentials) error {
+	m.creds[creds.ID] = creds
+	return nil
+}
+
+func (m *mockCredentialsStore) Get(_ context.Context, id string) (*domain.Credentials, error) {
+	creds, ok := m.creds[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &creds, nil
+}
+
+func (m *mockCredentialsStore) GetBySourceID(_ context.Context, _ string) (*domain.Credentials, error) {
+	return nil, nil
+}
+
+func (m *mockCredentialsStore) GetAllByAuthProviderID(
+	_ context.Context, _ string,
+) ([]domain.Credentials, error) {
+	return nil, nil
+}
+
+func (m *mockCredentialsStore) Delete(_ context.Context, id string) error {
+	delete(m.creds, id)
+	return nil
+}
+
+func sourceNames(sources []domain.Source) []string {
+	names := make([]string, len(sources))
+	for i := range sources {
+		names[i] = sources[i].Name
+	}
+	return names
+}
+
+func TestAccountService_List_GroupsSourcesByAccountIdentifier(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	credsStore := newMockCredentialsStore(
+		domain.Credentials{ID: "c-work", AccountIdentifier: "me@work.com"},
+		domain.Credentials{ID: "c-work-2", AccountIdentifier: "Me@Work.com"},
+		domain.Credentials{ID: "c-home", AccountIdentifier: "me@outlook.com"},
+		domain.Credentials{ID: "c-google", AccountIdentifier: "me@gmail.com"},
+	)
+	for _, src := range []domain.Source{
+		{ID: "s1", Type: "onedrive", Name: "Work OneDrive", CredentialsID: "c-work"},
+		{ID: "s2", Type: "outlook", Name: "Work Mail", CredentialsID: "c-work-2"},
+		{ID: "s3", Type: "outlook", Name: "Home Mail", CredentialsID: "c-home"},
+		{ID: "s4", Type: "gmail", Name: "Gmail", CredentialsID: "c-google"},
+		{ID: "s5", Type: "google-drive", Name: "Drive", CredentialsID: "c-google"},
+		{ID: "s6", Type: "filesystem", Name: "Notes"},
+	} {
+		require.NoError(t, sourceStore.Save(ctx, src))
+	}
+
+	svc := NewAccountService(sourceStore, credsStore, NewConnectorRegistry(nil))
+	accounts, err := svc.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, accounts, 3)
+
+	assert.Equal(t, domain.ProviderGoogle, accounts[0].ProviderType)
+	assert.Equal(t, "me@gmail.com", accounts[0].Identifier)
+	assert.Equal(t, []string{"Drive", "Gmail"}, sourceNames(accounts[0].Sources))
+	assert.Equal(t, []string{"c-google"}, accounts[0].CredentialsIDs)
+
+	assert.Equal(t, domain.ProviderMicrosoft, accounts[1].ProviderType)
+	assert.Equal(t, "me@outlook.com", accounts[1].Identifier)
+	assert.Equal(t, []string{"Home Mail"}, sourceNames(accounts[1].Sources))
+
+	assert.Equal(t, domain.ProviderMicrosoft, accounts[2].ProviderType)
+	assert.Equal(t, "me@work.com", accounts[2].Identifier)
+	assert.Equal(t, []string{"Work Mail", "Work OneDrive"}, sourceNames(accounts[2].Sources))
+	assert.ElementsMatch(t, []string{"c-work", "c-work-2"}, accounts[2].CredentialsIDs)
+}
+
+func TestAccountService_List_ReauthUpdatesGrouping(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	credsStore := newMockCredentialsStore(
+		domain.Credentials{ID: "c1", AccountIdentifier: "personal@gmail.com"},
+		domain.Credentials{ID: "c2", AccountIdentifier: "personal@gmail.com"},
+	)
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "s1", Type: "gmail", Name: "Mail", CredentialsID: "c1"}))
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "s2", Type: "google-drive", Name: "Drive", CredentialsID: "c2"}))
+
+	svc := NewAccountService(sourceStore, credsStore, NewConnectorRegistry(nil))
+	accounts, err := svc.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	assert.Len(t, accounts[0].Sources, 2)
+
+	// Re-authenticating the Drive source as a work account moves it.
+	require.NoError(t, credsStore.Save(ctx, domain.Credentials{ID: "c2", AccountIdentifier: "work@company.com"}))
+
+	accounts, err = svc.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	assert.Equal(t, "personal@gmail.com", accounts[0].Identifier)
+	assert.Equal(t, []string{"Mail"}, sourceNames(accounts[0].Sources))
+	assert.Equal(t, "work@company.com", accounts[1].Identifier)
+	assert.Equal(t, []string{"Drive"}, sourceNames(accounts[1].Sources))
+}
+
+func TestAccountService_List_SkipsMissingCredentials(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "s1", Type: "github", Name: "Repos", CredentialsID: "gone"}))
+
+	svc := NewAccountService(sourceStore, newMockCredentialsStore(), NewConnectorRegistry(nil))
+	accounts, err := svc.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, accounts)
+}
+
+func TestAccountService_List_UnknownIdentifier(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	credsStore := newMockCredentialsStore(domain.Credentials{ID: "c1"})
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "s1", Type: "custom", Name: "Custom", CredentialsID: "c1"}))
+
+	svc := NewAccountService(sourceStore, credsStore, NewConnectorRegistry(nil))
+	accounts, err := svc.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, domain.ProviderType("custom"), accounts[0].ProviderType)
+	assert.Equal(t, "(unknown account)", accounts[0].DisplayName())
+}
+
+func TestAccountService_List_NilStores(t *testing.T) {
+	svc := NewAccountService(nil, nil, nil)
+	_, err := svc.List(context.Background())
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
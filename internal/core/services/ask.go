@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// askContextResults is the default number of documents retrieved as context
+// for a question.
+const askContextResults = 10
+
+// askFollowUps is the number of follow-up questions requested per answer.
+const askFollowUps = 3
+
+// askPrompt asks for an answer grounded in the given sources, with
+// citations and follow-up questions.
+const askPrompt = `Answer the question using only the sources below.
+After each statement, cite the source it came from as [Source: <uri>], using the URI exactly as given.
+If the sources do not contain the answer, say that you do not know.
+After the answer, write "Follow-up questions:" and up to %d related questions, one per line.
+
+%s
+Question: %s
+Answer:`
+
+// citationPattern matches a [Source: uri] citation in an answer.
+var citationPattern = regexp.MustCompile(`\[Source:\s*([^\]]+?)\s*\]`)
+
+// followUpHeading matches the heading that introduces follow-up questions.
+var followUpHeading = regexp.MustCompile(`(?im)^[\s#*]*follow-?up questions\s*:?[\s*]*`)
+
+// Ask answers a question with the LLM. The top documents for the question
+// (10 unless opts.Limit says otherwise) are retrieved with Search, as many as
+// fit the configured context budget are put in the prompt, and the results
+// the answer cites are returned alongside it. The answer is streamed to
+// onAnswer, if not nil, as the LLM writes it.
+func (s *SearchService) Ask(
+	ctx context.Context, question string, opts domain.SearchOptions, onAnswer func(chunk string),
+) (domain.AskResult, error) {
+	question = strings.TrimSpace(question)
+	if question == "" {
+		return domain.AskResult{}, fmt.Errorf("%w: question is empty", domain.ErrInvalidInput)
+	}
+	if s.llmService == nil {
+		return domain.AskResult{}, domain.ErrLLMUnavailable
+	}
+
+	if opts.Limit <= 0 {
+		opts.Limit = askContextResults
+	}
+	opts.DedupeResults = true
+	results, err := s.Search(ctx, question, opts)
+	if err != nil {
+		return domain.AskResult{}, err
+	}
+	if len(results) == 0 {
+		slog.Debug("ask: no relevant documents", slog.String("question", question))
+		return domain.AskResult{}, nil
+	}
+
+	budget := s.contextBudget()
+	results = budget.SelectResults(fmt.Sprintf(askPrompt, askFollowUps, "", question), results)
+	slog.Debug("ask: documents fit the context budget", slog.Int("documents", len(results)))
+
+	prompt := fmt.Sprintf(askPrompt, askFollowUps, formatSources(results), question)
+	chunks, errs := s.llmService.ChatStream(ctx, []driven.ChatMessage{{Role: "user", Content: prompt}},
+		driven.ChatOptions{MaxTokens: budget.ReserveTokens, Temperature: 0.3})
+	reply, err := streamAnswer(chunks, errs, onAnswer)
+	if err != nil {
+		return domain.AskResult{}, fmt.Errorf("generate answer: %w", err)
+	}
+
+	answer, followUps := splitFollowUps(reply)
+	return domain.AskResult{
+		Answer:              answer,
+		Citations:           citedResults(answer, results),
+		FollowUpSuggestions: parseQueryVariants(followUps, question, askFollowUps),
+	}, nil
+}
+
+// contextBudget returns the configured LLM context budget, or the default
+// budget if the settings are unavailable or invalid.
+func (s *SearchService) contextBudget() domain.ContextBudget {
+	defaults := domain.ContextBudget{
+		MaxTokens:     domain.DefaultMaxContextTokens,
+		ReserveTokens: domain.DefaultAnswerReserveTokens,
+	}
+	if s.settings == nil {
+		return defaults
+	}
+	settings, err := s.settings.Get()
+	if err != nil {
+		return defaults
+	}
+	budget := settings.LLM.ContextBudget()
+	if err := budget.Validate(); err != nil {
+		slog.Warn("ignoring context budget settings", slog.Any("error", err))
+		return defaults
+	}
+	return budget
+}
+
+// formatSources renders results as the sources section of a prompt.
+func formatSources(results []domain.SearchResult) string {
+	var b strings.Builder
+	for i := range results {
+		doc := &results[i].Document
+		fmt.Fprintf(&b, "[Source: %s]\n", doc.URI)
+		if doc.Title != "" {
+			fmt.Fprintf(&b, "Title: %s\n", doc.Title)
+		}
+		b.WriteString(strings.TrimSpace(results[i].Chunk.Content))
+		b.WriteString("\n\n")
+	}
+	return b.String()
+}
+
+// streamAnswer reads an LLM reply stream to the end and returns the reply.
+// The answer part of the reply is passed to onAnswer as it arrives, without
+// the whitespace around it, so the pieces add up to the answer splitFollowUps
+// returns.
+func streamAnswer(chunks <-chan string, errs <-chan error, onAnswer func(string)) (string, error) {
+	if onAnswer == nil {
+		return driven.CollectStream(chunks, errs)
+	}
+
+	var reply strings.Builder
+	sent := 0
+	answered := false
+	for chunk := range chunks {
+		reply.WriteString(chunk)
+		if answered {
+			continue
+		}
+		text := reply.String()
+		end := answerEnd(text)
+		if loc := followUpHeading.FindStringIndex(text); loc != nil {
+			end, answered = loc[0], true
+		}
+		sent = sendAnswer(text, sent, end, onAnswer)
+	}
+	if err := <-errs; err != nil {
+		return "", err
+	}
+	if !answered {
+		text := reply.String()
+		sendAnswer(text, sent, len(text), onAnswer)
+	}
+	return reply.String(), nil
+}
+
+// answerEnd returns how much of a partial reply can be shown as the answer:
+// all of it but a last line that may be the start of the follow-up heading.
+func answerEnd(text string) int {
+	start := strings.LastIndexByte(text, '\n') + 1
+	line := strings.ToLower(strings.TrimLeft(text[start:], " \t\r#*"))
+	if strings.HasPrefix("follow-up questions", line) || strings.HasPrefix("followup questions", line) {
+		return start
+	}
+	return len(text)
+}
+
+// sendAnswer passes the answer in text[sent:end] to onAnswer, holding back
+// whitespace at either end of the answer, and returns how much of text has
+// been sent.
+func sendAnswer(text string, sent, end int, onAnswer func(string)) int {
+	answer := strings.TrimRightFunc(text[:end], unicode.IsSpace)
+	if sent == 0 {
+		sent = len(answer) - len(strings.TrimLeftFunc(answer, unicode.IsSpace))
+	}
+	if len(answer) <= sent {
+		return sent
+	}
+	onAnswer(answer[sent:])
+	return len(answer)
+}
+
+// splitFollowUps splits an LLM reply into the answer and the follow-up
+// questions after it.
+func splitFollowUps(reply string) (answer, followUps string) {
+	loc := followUpHeading.FindStringIndex(reply)
+	if loc == nil {
+		return strings.TrimSpace(reply), ""
+	}
+	return strings.TrimSpace(reply[:loc[0]]), reply[loc[1]:]
+}
+
+// citedResults returns the results whose URIs answer cites, in order of
+// first citation.
+func citedResults(answer string, results []domain.SearchResult) []domain.SearchResult {
+	var cited []domain.SearchResult
+	seen := make(map[string]bool)
+	for _, match := range citationPattern.FindAllStringSubmatch(answer, -1) {
+		uri := match[1]
+		if seen[uri] {
+			continue
+		}
+		seen[uri] = true
+		for i := range results {
+			if results[i].Document.URI == uri {
+				cited = append(cited, results[i])
+				break
+			}
+		}
+	}
+	return cited
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// promptRecordingLLM records the prompt and options it chatted with.
+type promptRecordingLLM struct {
+	mockLLMService
+	prompts []string
+	opts    driven.ChatOptions
+}
+
+func (m *promptRecordingLLM) ChatStream(
+	ctx context.Context, messages []driven.ChatMessage, opts driven.ChatOptions,
+) (<-chan string, <-chan error) {
+	m.prompts = append(m.prompts, messages[0].Content)
+	m.opts = opts
+	return m.mockLLMService.ChatStream(ctx, messages, opts)
+}
+
+func TestSearchService_Ask(t *testing.T) {
+	llm := &promptRecordingLLM{mockLLMService: mockLLMService{generateResult: `Sercha searches your files ` +
+		`[Source: file://doc-1] and is configured with the settings command [Source: file://doc-2]. ` +
+		`It indexes everything [Source: file://doc-1] [Source: file://made-up].
+
+Follow-up questions:
+1. How do I add a source?
+2. "Which file types are supported?"
+- How do I add a source?`}}
+	svc := NewSearchService(setupTestDocStore(t), &mockSearchEngine{hits: createTestHits()}, nil, nil, llm)
+
+	result, err := svc.Ask(context.Background(), "what is sercha?", domain.SearchOptions{}, nil)
+
+	require.NoError(t, err)
+	require.Len(t, llm.prompts, 1)
+	prompt := llm.prompts[0]
+	assert.Contains(t, prompt, "[Source: file://doc-1]\nTitle: Getting Started with Sercha\n"+
+		"Sercha is a search engine for your files.")
+	assert.Contains(t, prompt, "[Source: file://doc-3]")
+	assert.Contains(t, prompt, "Question: what is sercha?")
+	assert.Equal(t, domain.DefaultAnswerReserveTokens, llm.opts.MaxTokens)
+
+	assert.NotContains(t, result.Answer, "Follow-up")
+	assert.Contains(t, result.Answer, "It indexes everything")
+	require.Len(t, result.Citations, 2)
+	assert.Equal(t, "doc-1", result.Citations[0].Document.ID)
+	assert.Equal(t, "doc-2", result.Citations[1].Document.ID)
+	assert.Equal(t, []string{"How do I add a source?", "Which file types are supported?"}, result.FollowUpSuggestions)
+}
+
+func TestSearchService_Ask_StreamsAnswer(t *testing.T) {
+	llm := &mockLLMService{generateResult: "  Sercha searches your files [Source: file://doc-1].\n" +
+		"Follow the setup guide to start.\n\n**Follow-up questions:**\n- How do I add a source?"}
+	svc := NewSearchService(setupTestDocStore(t), &mockSearchEngine{hits: createTestHits()}, nil, nil, llm)
+
+	var chunks []string
+	result, err := svc.Ask(context.Background(), "what is sercha?", domain.SearchOptions{}, func(chunk string) {
+		chunks = append(chunks, chunk)
+	})
+
+	require.NoError(t, err)
+	assert.Greater(t, len(chunks), 1)
+	assert.Equal(t, result.Answer, strings.Join(chunks, ""))
+	assert.Equal(t, "Sercha searches your files [Source: file://doc-1].\nFollow the setup guide to start.",
+		result.Answer)
+	assert.Equal(t, []string{"How do I add a source?"}, result.FollowUpSuggestions)
+}
+
+func TestSearchService_Ask_ContextBudget(t *testing.T) {
+	llm := &promptRecordingLLM{mockLLMService: mockLLMService{generateResult: "I do not know."}}
+	svc := NewSearchService(setupTestDocStore(t), &mockSearchEngine{hits: createTestHits()}, nil, nil, llm)
+	settings := NewSettingsService(memory.NewConfigStore(), nil)
+	// Leave room for the prompt template and the best chunk only
+	require.NoError(t, settings.Set("answer_reserve_tokens", "20"))
+	require.NoError(t, settings.Set("max_context_tokens", strconv.Itoa(domain.EstimateTokens(askPrompt)+20+15)))
+	svc.SetSettingsService(settings)
+
+	result, err := svc.Ask(context.Background(), "sercha", domain.SearchOptions{}, nil)
+
+	require.NoError(t, err)
+	require.Len(t, llm.prompts, 1)
+	assert.Contains(t, llm.prompts[0], "[Source: file://doc-1]")
+	assert.NotContains(t, llm.prompts[0], "[Source: file://doc-3]")
+	assert.Equal(t, 20, llm.opts.MaxTokens)
+	assert.Equal(t, "I do not know.", result.Answer)
+	assert.Empty(t, result.Citations)
+	assert.Empty(t, result.FollowUpSuggestions)
+}
+
+func TestSearchService_Ask_NoResults(t *testing.T) {
+	llm := &promptRecordingLLM{}
+	svc := NewSearchService(setupTestDocStore(t), &mockSearchEngine{}, nil, nil, llm)
+
+	result, err := svc.Ask(context.Background(), "anything", domain.SearchOptions{}, nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Answer)
+	assert.Empty(t, llm.prompts, "no LLM call without context")
+}
+
+func TestSearchService_Ask_Errors(t *testing.T) {
+	ctx := context.Background()
+	docStore := setupTestDocStore(t)
+	engine := &mockSearchEngine{hits: createTestHits()}
+
+	_, err := NewSearchService(docStore, engine, nil, nil, nil).Ask(ctx, "question", domain.SearchOptions{}, nil)
+	assert.ErrorIs(t, err, domain.ErrLLMUnavailable)
+
+	llm := &mockLLMService{generateErr: errors.New("model not loaded")}
+	svc := NewSearchService(docStore, engine, nil, nil, llm)
+	_, err = svc.Ask(ctx, "  ", domain.SearchOptions{}, nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = svc.Ask(ctx, "question", domain.SearchOptions{}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "generate answer: model not loaded")
+}
+
+func TestSplitFollowUps(t *testing.T) {
+	answer, followUps := splitFollowUps("The answer.\n\n**Follow-up questions:**\n- One?\n- Two?")
+	assert.Equal(t, "The answer.", answer)
+	assert.Equal(t, []string{"One?", "Two?"}, parseQueryVariants(followUps, "", askFollowUps))
+
+	answer, followUps = splitFollowUps("  Just an answer.  ")
+	assert.Equal(t, "Just an answer.", answer)
+	assert.Empty(t, followUps)
+}
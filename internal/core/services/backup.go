@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// Ensure BackupService implements the interface.
+var _ driving.BackupService = (*BackupService)(nil)
+
+// BackupService backs up and restores indexed data.
+type BackupService struct {
+	archiver     driven.BackupArchiver
+	sourceStore  driven.SourceStore
+	docStore     driven.DocumentStore
+	searchEngine driven.SearchEngine
+}
+
+// NewBackupService creates a new backup service.
+// The searchEngine is optional - if nil, the search index is not rebuilt on restore.
+func NewBackupService(
+	archiver driven.BackupArchiver,
+	sourceStore driven.SourceStore,
+	docStore driven.DocumentStore,
+	searchEngine driven.SearchEngine,
+) *BackupService {
+	return &BackupService{
+		archiver:     archiver,
+		sourceStore:  sourceStore,
+		docStore:     docStore,
+		searchEngine: searchEngine,
+	}
+}
+
+// Backup writes a backup archive to outputPath.
+// Pending search index changes are committed first so the archived index
+// is complete.
+func (s *BackupService) Backup(ctx context.Context, outputPath string) (*domain.BackupManifest, error) {
+	if s.archiver == nil {
+		return nil, domain.ErrNotImplemented
+	}
+	if outputPath == "" {
+		return nil, fmt.Errorf("%w: output path is required", domain.ErrInvalidInput)
+	}
+	if err := flushSearchIndex(ctx, s.searchEngine); err != nil {
+		return nil, err
+	}
+	return s.archiver.Create(ctx, outputPath)
+}
+
+// Restore replaces the live data with the backup at archivePath and
+// rebuilds the search index from the restored documents.
+func (s *BackupService) Restore(
+	ctx context.Context, archivePath string, opts domain.RestoreOptions,
+) (*domain.RestoreResult, error) {
+	if s.archiver == nil || s.sourceStore == nil || s.docStore == nil {
+		return nil, domain.ErrNotImplemented
+	}
+	if archivePath == "" {
+		return nil, fmt.Errorf("%w: backup path is required", domain.ErrInvalidInput)
+	}
+
+	// Remember what is indexed now so it can be removed once the database is replaced
+	var staleChunks []string
+	if s.searchEngine != nil {
+		err := walkChunks(ctx, s.sourceStore, s.docStore, func(chunks []domain.Chunk) error {
+			for i := range chunks {
+				staleChunks = append(staleChunks, chunks[i].ID)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list indexed chunks: %w", err)
+		}
+	}
+
+	manifest, err := s.archiver.Restore(ctx, archivePath, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.RestoreResult{Manifest: *manifest}
+	if s.searchEngine == nil {
+		return result, nil
+	}
+
+	for _, id := range staleChunks {
+		if err := s.searchEngine.Delete(ctx, id); err != nil {
+			slog.Warn("failed to remove chunk from search index", slog.String("chunk_id", id), slog.Any("error", err))
+		}
+	}
+
+	err = walkChunks(ctx, s.sourceStore, s.docStore, func(chunks []domain.Chunk) error {
+		for i := range chunks {
+			if err := s.searchEngine.Index(ctx, chunks[i]); err != nil {
+				return err
+			}
+		}
+		result.Documents++
+		result.Chunks += len(chunks)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("rebuild search index: %w", err)
+	}
+
+	return result, nil
+}
+
+// walkChunks calls fn with the chunks of each stored document.
+func walkChunks(
+	ctx context.Context,
+	sourceStore driven.SourceStore,
+	docStore driven.DocumentStore,
+	fn func(chunks []domain.Chunk) error,
+) error {
+	sources, err := sourceStore.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range sources {
+		docs, err := docStore.ListDocuments(ctx, sources[i].ID)
+		if err != nil {
+			return err
+		}
+		for j := range docs {
+			chunks, err := docStore.GetChunks(ctx, docs[j].ID)
+			if err != nil {
+				return err
+			}
+			if err := fn(chunks); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockBackupArchiver implements driven.BackupArchiver for testing.
+// Restore runs onRestore to simulate the database being replaced.
+type mockBackupArchiver struct {
+	created    string
+	opts       domain.RestoreOptions
+	restoreErr error
+	onRestore  func()
+}
+
+func (m *mockBackupArchiver) Create(_ context.Context, outputPath string) (*domain.BackupManifest, error) {
+	m.created = outputPath
+	return &domain.BackupManifest{FormatVersion: domain.BackupFormatVersion, SchemaVersion: 7}, nil
+}
+
+func (m *mockBackupArchiver) Restore(
+	_ context.Context, _ string, opts domain.RestoreOptions,
+) (*domain.BackupManifest, error) {
+	m.opts = opts
+	if m.restoreErr != nil {
+		return nil, m.restoreErr
+	}
+	if m.onRestore != nil {
+		m.onRestore()
+	}
+	return &domain.BackupManifest{FormatVersion: domain.BackupFormatVersion, SchemaVersion: 7}, nil
+}
+
+func saveDocWithChunks(t *testing.T, store *memory.DocumentStore, sourceID, docID string, chunkIDs ...string) {
+	t.Helper()
+	ctx := context.Background()
+	require.NoError(t, store.SaveDocument(ctx, &domain.Document{ID: docID, SourceID: sourceID}))
+	chunks := make([]domain.Chunk, len(chunkIDs))
+	for i, id := range chunkIDs {
+		chunks[i] = domain.Chunk{ID: id, DocumentID: docID, Content: id, Position: i}
+	}
+	require.NoError(t, store.SaveChunks(ctx, chunks))
+}
+
+func TestBackupService_Backup(t *testing.T) {
+	archiver := &mockBackupArchiver{}
+	svc := NewBackupService(archiver, nil, nil, nil)
+
+	manifest, err := svc.Backup(context.Background(), "/tmp/out.tar.gz")
+
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/out.tar.gz", archiver.created)
+	assert.Equal(t, 7, manifest.SchemaVersion)
+}
+
+func TestBackupService_Backup_FlushesSearchIndex(t *testing.T) {
+	searchEngine := &optimizingSearchEngine{syncMockSearchEngine: newSyncMockSearchEngine()}
+	svc := NewBackupService(&mockBackupArchiver{}, nil, nil, searchEngine)
+
+	_, err := svc.Backup(context.Background(), "/tmp/out.tar.gz")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"flush"}, searchEngine.calls)
+}
+
+func TestBackupService_Backup_FlushError(t *testing.T) {
+	archiver := &mockBackupArchiver{}
+	searchEngine := &optimizingSearchEngine{
+		syncMockSearchEngine: newSyncMockSearchEngine(),
+		flushErr:             errors.New("index locked"),
+	}
+	svc := NewBackupService(archiver, nil, nil, searchEngine)
+
+	_, err := svc.Backup(context.Background(), "/tmp/out.tar.gz")
+
+	require.Error(t, err)
+	assert.Empty(t, archiver.created)
+}
+
+func TestBackupService_Restore_PassesOptions(t *testing.T) {
+	archiver := &mockBackupArchiver{}
+	svc := NewBackupService(archiver, memory.NewSourceStore(), memory.NewDocumentStore(), nil)
+
+	_, err := svc.Restore(context.Background(), "backup.tar.gz", domain.RestoreOptions{Force: true})
+
+	require.NoError(t, err)
+	assert.True(t, archiver.opts.Force)
+}
+
+func TestBackupService_Backup_RequiresPath(t *testing.T) {
+	svc := NewBackupService(&mockBackupArchiver{}, nil, nil, nil)
+
+	_, err := svc.Backup(context.Background(), "")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestBackupService_Restore_RebuildsSearchIndex(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	searchEngine := newSyncMockSearchEngine()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Type: "filesystem"}))
+	saveDocWithChunks(t, docStore, "src-1", "doc-old", "old-1")
+	require.NoError(t, searchEngine.Index(ctx, domain.Chunk{ID: "old-1"}))
+
+	archiver := &mockBackupArchiver{onRestore: func() {
+		require.NoError(t, docStore.DeleteDocument(ctx, "doc-old"))
+		saveDocWithChunks(t, docStore, "src-1", "doc-a", "a-1", "a-2")
+		saveDocWithChunks(t, docStore, "src-1", "doc-b", "b-1")
+	}}
+	svc := NewBackupService(archiver, sourceStore, docStore, searchEngine)
+
+	result, err := svc.Restore(ctx, "backup.tar.gz", domain.RestoreOptions{})
+
+	require.NoError(t, err)
+	assert.Equal(t, 7, result.Manifest.SchemaVersion)
+	assert.Equal(t, 2, result.Documents)
+	assert.Equal(t, 3, result.Chunks)
+	assert.NotContains(t, searchEngine.indexed, "old-1")
+	assert.Contains(t, searchEngine.indexed, "a-1")
+	assert.Contains(t, searchEngine.indexed, "a-2")
+	assert.Contains(t, searchEngine.indexed, "b-1")
+}
+
+func TestBackupService_Restore_InvalidBackupKeepsIndex(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	searchEngine := newSyncMockSearchEngine()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Type: "filesystem"}))
+	saveDocWithChunks(t, docStore, "src-1", "doc-1", "chunk-1")
+	require.NoError(t, searchEngine.Index(ctx, domain.Chunk{ID: "chunk-1"}))
+
+	archiver := &mockBackupArchiver{restoreErr: errors.Join(domain.ErrInvalidBackup, errors.New("bad schema"))}
+	svc := NewBackupService(archiver, sourceStore, docStore, searchEngine)
+
+	_, err := svc.Restore(ctx, "backup.tar.gz", domain.RestoreOptions{})
+
+	require.ErrorIs(t, err, domain.ErrInvalidBackup)
+	assert.Contains(t, searchEngine.indexed, "chunk-1")
+}
+
+func TestBackupService_Restore_WithoutSearchEngine(t *testing.T) {
+	svc := NewBackupService(&mockBackupArchiver{}, memory.NewSourceStore(), memory.NewDocumentStore(), nil)
+
+	result, err := svc.Restore(context.Background(), "backup.tar.gz", domain.RestoreOptions{})
+
+	require.NoError(t, err)
+	assert.Zero(t, result.Documents)
+}
+
+func TestBackupService_NilArchiver(t *testing.T) {
+	svc := NewBackupService(nil, nil, nil, nil)
+
+	_, err := svc.Backup(context.Background(), "out.tar.gz")
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+
+	_, err = svc.Restore(context.Background(), "out.tar.gz", domain.RestoreOptions{})
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"sort"
+	"strconv"
 
 	"github.com/custodia-labs/sercha-cli/internal/connectors/dropbox"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/filesystem"
@@ -13,6 +15,10 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/connectors/microsoft/onedrive"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/microsoft/outlook"
 	"github.com/custodia-labs/sercha-cli/internal/connectors/notion"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/slack"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/trello"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/web"
+	"github.com/custodia-labs/sercha-cli/internal/connectors/youtube"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
@@ -23,8 +29,9 @@ var _ driving.ConnectorRegistry = (*ConnectorRegistry)(nil)
 
 // ConnectorRegistry provides information about available connector types.
 type ConnectorRegistry struct {
-	connectors       map[string]domain.ConnectorType
-	connectorFactory driven.ConnectorFactory
+	connectors         map[string]domain.ConnectorType
+	connectorFactory   driven.ConnectorFactory
+	normaliserRegistry driven.NormaliserRegistry
 }
 
 // NewConnectorRegistry creates a new connector registry with built-in connectors.
@@ -37,6 +44,11 @@ func NewConnectorRegistry(connectorFactory driven.ConnectorFactory) *ConnectorRe
 	return r
 }
 
+// SetNormaliserRegistry sets the normaliser registry for MIME coverage checks.
+func (r *ConnectorRegistry) SetNormaliserRegistry(registry driven.NormaliserRegistry) {
+	r.normaliserRegistry = registry
+}
+
 func (r *ConnectorRegistry) registerBuiltinConnectors() {
 	r.registerFilesystem()
 	r.registerGitHub()
@@ -48,18 +60,23 @@ func (r *ConnectorRegistry) registerBuiltinConnectors() {
 	r.registerMicrosoftCalendar()
 	r.registerDropbox()
 	r.registerNotion()
+	r.registerTrello()
+	r.registerSlack()
+	r.registerYouTube()
+	r.registerWeb()
 }
 
 func (r *ConnectorRegistry) registerFilesystem() {
 	r.connectors["filesystem"] = domain.ConnectorType{
-		ID:             "filesystem",
-		Name:           "Local Filesystem",
-		Description:    "Index files from a local directory",
-		ProviderType:   domain.ProviderLocal,
-		AuthCapability: domain.AuthCapNone,
-		AuthMethod:     domain.AuthMethodNone,
-		ConfigKeys:     filesystemConfigKeys(),
-		WebURLResolver: filesystem.ResolveWebURL,
+		ID:               "filesystem",
+		Name:             "Local Filesystem",
+		Description:      "Index files from a local directory",
+		ProviderType:     domain.ProviderLocal,
+		AuthCapability:   domain.AuthCapNone,
+		AuthMethod:       domain.AuthMethodNone,
+		ConfigKeys:       filesystemConfigKeys(),
+		WebURLResolver:   filesystem.ResolveWebURL,
+		EmittedMIMETypes: filesystem.EmittedMIMETypes(),
 	}
 }
 
@@ -75,20 +92,22 @@ func filesystemConfigKeys() []domain.ConfigKey {
 			Key:         "patterns",
 			Label:       "File Patterns",
 			Description: "Glob patterns to match (e.g., *.md,*.txt)",
+			Type:        domain.ConfigValueList,
 		},
 	}
 }
 
 func (r *ConnectorRegistry) registerGitHub() {
 	r.connectors["github"] = domain.ConnectorType{
-		ID:             "github",
-		Name:           "GitHub",
-		Description:    "Index repositories, issues, PRs, and wikis from GitHub",
-		ProviderType:   domain.ProviderGitHub,
-		AuthCapability: domain.AuthCapPAT | domain.AuthCapOAuth,
-		AuthMethod:     domain.AuthMethodPAT,
-		ConfigKeys:     githubConfigKeys(),
-		WebURLResolver: github.ResolveWebURL,
+		ID:               "github",
+		Name:             "GitHub",
+		Description:      "Index repositories, issues, PRs, wikis, and discussions from GitHub",
+		ProviderType:     domain.ProviderGitHub,
+		AuthCapability:   domain.AuthCapPAT | domain.AuthCapOAuth,
+		AuthMethod:       domain.AuthMethodPAT,
+		ConfigKeys:       githubConfigKeys(),
+		WebURLResolver:   github.ResolveWebURL,
+		EmittedMIMETypes: github.EmittedMIMETypes(),
 	}
 }
 
@@ -97,28 +116,39 @@ func githubConfigKeys() []domain.ConfigKey {
 		{
 			Key:         "content_types",
 			Label:       "Content Types",
-			Description: "Content to index: files,issues,prs,wikis",
+			Description: "Content to index: files,issues,prs,wikis,discussions",
 			Default:     "files",
+			Type:        domain.ConfigValueList,
+			Options:     []string{"files", "issues", "prs", "wikis", "discussions"},
 		},
 		{
 			Key:         "file_patterns",
 			Label:       "File Patterns",
 			Description: "Glob patterns for files to include",
 			Default:     "*",
+			Type:        domain.ConfigValueList,
+		},
+		{
+			Key:         domain.ConfigKeyParallelWithinSource,
+			Label:       "Parallel Fetch",
+			Description: "Fetch files, issues, PRs, wikis and discussions concurrently (true/false)",
+			Default:     "false",
+			Type:        domain.ConfigValueBool,
 		},
 	}
 }
 
 func (r *ConnectorRegistry) registerGoogleDrive() {
 	r.connectors["google-drive"] = domain.ConnectorType{
-		ID:             "google-drive",
-		Name:           "Google Drive",
-		Description:    "Index documents from Google Drive",
-		ProviderType:   domain.ProviderGoogle,
-		AuthCapability: domain.AuthCapOAuth,
-		AuthMethod:     domain.AuthMethodOAuth,
-		ConfigKeys:     driveConfigKeys(),
-		WebURLResolver: drive.ResolveWebURL,
+		ID:               "google-drive",
+		Name:             "Google Drive",
+		Description:      "Index documents from Google Drive",
+		ProviderType:     domain.ProviderGoogle,
+		AuthCapability:   domain.AuthCapOAuth,
+		AuthMethod:       domain.AuthMethodOAuth,
+		ConfigKeys:       append(driveConfigKeys(), contentLimitConfigKeys()...),
+		WebURLResolver:   drive.ResolveWebURL,
+		EmittedMIMETypes: drive.EmittedMIMETypes(),
 	}
 }
 
@@ -129,30 +159,63 @@ func driveConfigKeys() []domain.ConfigKey {
 			Label:       "Content Types",
 			Description: "Content to sync: files,docs,sheets",
 			Default:     "files,docs,sheets",
+			Type:        domain.ConfigValueList,
+			Options:     []string{"files", "docs", "sheets"},
 		},
 		{
 			Key:         "folder_ids",
 			Label:       "Folder IDs",
 			Description: "Specific folder IDs to sync (optional)",
+			Type:        domain.ConfigValueList,
 		},
 		{
 			Key:         "mime_types",
 			Label:       "MIME Types",
 			Description: "Filter by MIME types (optional)",
+			Type:        domain.ConfigValueList,
+		},
+		{
+			Key:         "shared_drives",
+			Label:       "Shared Drives",
+			Description: "Also sync the shared drives you are a member of (true/false)",
+			Default:     "false",
+			Type:        domain.ConfigValueBool,
+		},
+	}
+}
+
+// contentLimitConfigKeys returns the config keys limiting the file content
+// downloaded by connectors that index files.
+func contentLimitConfigKeys() []domain.ConfigKey {
+	return []domain.ConfigKey{
+		{
+			Key:         domain.ConfigKeyMaxContentSize,
+			Label:       "Max Content Size",
+			Description: "Largest file whose content is downloaded, in bytes; larger files index metadata only",
+			Default:     strconv.FormatInt(domain.DefaultMaxContentSize, 10),
+			Type:        domain.ConfigValueInt,
+		},
+		{
+			Key:         domain.ConfigKeyMetadataOnly,
+			Label:       "Metadata Only",
+			Description: "Index file names, paths and metadata without downloading content (true/false)",
+			Default:     "false",
+			Type:        domain.ConfigValueBool,
 		},
 	}
 }
 
 func (r *ConnectorRegistry) registerGmail() {
 	r.connectors["gmail"] = domain.ConnectorType{
-		ID:             "gmail",
-		Name:           "Gmail",
-		Description:    "Index emails from Gmail",
-		ProviderType:   domain.ProviderGoogle,
-		AuthCapability: domain.AuthCapOAuth,
-		AuthMethod:     domain.AuthMethodOAuth,
-		ConfigKeys:     gmailConfigKeys(),
-		WebURLResolver: gmail.ResolveWebURL,
+		ID:               "gmail",
+		Name:             "Gmail",
+		Description:      "Index emails from Gmail",
+		ProviderType:     domain.ProviderGoogle,
+		AuthCapability:   domain.AuthCapOAuth,
+		AuthMethod:       domain.AuthMethodOAuth,
+		ConfigKeys:       gmailConfigKeys(),
+		WebURLResolver:   gmail.ResolveWebURL,
+		EmittedMIMETypes: gmail.EmittedMIMETypes(),
 	}
 }
 
@@ -163,6 +226,7 @@ func gmailConfigKeys() []domain.ConfigKey {
 			Label:       "Label IDs",
 			Description: "Labels to sync: INBOX,SENT,etc",
 			Default:     "INBOX",
+			Type:        domain.ConfigValueList,
 		},
 		{
 			Key:         "query",
@@ -174,20 +238,29 @@ func gmailConfigKeys() []domain.ConfigKey {
 			Label:       "Include Spam/Trash",
 			Description: "Include spam and trash (true/false)",
 			Default:     "false",
+			Type:        domain.ConfigValueBool,
+		},
+		{
+			Key:         "max_attachment_size",
+			Label:       "Max Attachment Size",
+			Description: "Largest PDF or DOCX attachment to index, in bytes (0 disables attachments)",
+			Default:     "5242880",
+			Type:        domain.ConfigValueInt,
 		},
 	}
 }
 
 func (r *ConnectorRegistry) registerGoogleCalendar() {
 	r.connectors["google-calendar"] = domain.ConnectorType{
-		ID:             "google-calendar",
-		Name:           "Google Calendar",
-		Description:    "Index events from Google Calendar",
-		ProviderType:   domain.ProviderGoogle,
-		AuthCapability: domain.AuthCapOAuth,
-		AuthMethod:     domain.AuthMethodOAuth,
-		ConfigKeys:     calendarConfigKeys(),
-		WebURLResolver: calendar.ResolveWebURL,
+		ID:               "google-calendar",
+		Name:             "Google Calendar",
+		Description:      "Index events from Google Calendar",
+		ProviderType:     domain.ProviderGoogle,
+		AuthCapability:   domain.AuthCapOAuth,
+		AuthMethod:       domain.AuthMethodOAuth,
+		ConfigKeys:       calendarConfigKeys(),
+		WebURLResolver:   calendar.ResolveWebURL,
+		EmittedMIMETypes: calendar.EmittedMIMETypes(),
 	}
 }
 
@@ -197,26 +270,35 @@ func calendarConfigKeys() []domain.ConfigKey {
 			Key:         "calendar_ids",
 			Label:       "Calendar IDs",
 			Description: "Specific calendar IDs to sync (optional)",
+			Type:        domain.ConfigValueList,
 		},
 		{
 			Key:         "single_events",
 			Label:       "Expand Recurring",
 			Description: "Expand recurring events (true/false)",
 			Default:     "true",
+			Type:        domain.ConfigValueBool,
+		},
+		{
+			Key:         "timezone",
+			Label:       "Timezone",
+			Description: "IANA timezone event times are shown in (e.g., Europe/London)",
+			Default:     "UTC",
 		},
 	}
 }
 
 func (r *ConnectorRegistry) registerOutlook() {
 	r.connectors["outlook"] = domain.ConnectorType{
-		ID:             "outlook",
-		Name:           "Outlook",
-		Description:    "Index emails from Microsoft Outlook",
-		ProviderType:   domain.ProviderMicrosoft,
-		AuthCapability: domain.AuthCapOAuth,
-		AuthMethod:     domain.AuthMethodOAuth,
-		ConfigKeys:     outlookConfigKeys(),
-		WebURLResolver: outlook.ResolveWebURL,
+		ID:               "outlook",
+		Name:             "Outlook",
+		Description:      "Index emails from Microsoft Outlook",
+		ProviderType:     domain.ProviderMicrosoft,
+		AuthCapability:   domain.AuthCapOAuth,
+		AuthMethod:       domain.AuthMethodOAuth,
+		ConfigKeys:       outlookConfigKeys(),
+		WebURLResolver:   outlook.ResolveWebURL,
+		EmittedMIMETypes: outlook.EmittedMIMETypes(),
 	}
 }
 
@@ -232,19 +314,25 @@ func outlookConfigKeys() []domain.ConfigKey {
 			Label:       "Search Query",
 			Description: "OData filter query to filter emails",
 		},
+		{
+			Key:         "locale",
+			Label:       "Locale",
+			Description: "Language for fetched content, sent as Accept-Language (e.g., en-GB)",
+		},
 	}
 }
 
 func (r *ConnectorRegistry) registerOneDrive() {
 	r.connectors["onedrive"] = domain.ConnectorType{
-		ID:             "onedrive",
-		Name:           "OneDrive",
-		Description:    "Index files from Microsoft OneDrive",
-		ProviderType:   domain.ProviderMicrosoft,
-		AuthCapability: domain.AuthCapOAuth,
-		AuthMethod:     domain.AuthMethodOAuth,
-		ConfigKeys:     onedriveConfigKeys(),
-		WebURLResolver: onedrive.ResolveWebURL,
+		ID:               "onedrive",
+		Name:             "OneDrive",
+		Description:      "Index files from Microsoft OneDrive",
+		ProviderType:     domain.ProviderMicrosoft,
+		AuthCapability:   domain.AuthCapOAuth,
+		AuthMethod:       domain.AuthMethodOAuth,
+		ConfigKeys:       append(onedriveConfigKeys(), contentLimitConfigKeys()...),
+		WebURLResolver:   onedrive.ResolveWebURL,
+		EmittedMIMETypes: onedrive.EmittedMIMETypes(),
 	}
 }
 
@@ -255,19 +343,32 @@ func onedriveConfigKeys() []domain.ConfigKey {
 			Label:       "Folder Path",
 			Description: "Path to folder to sync (optional, defaults to root)",
 		},
+		{
+			Key:         "shared_with_me",
+			Label:       "Shared With Me",
+			Description: "Also sync folders shared with you (true/false)",
+			Default:     "false",
+			Type:        domain.ConfigValueBool,
+		},
+		{
+			Key:         "locale",
+			Label:       "Locale",
+			Description: "Language for fetched content, sent as Accept-Language (e.g., en-GB)",
+		},
 	}
 }
 
 func (r *ConnectorRegistry) registerMicrosoftCalendar() {
 	r.connectors["microsoft-calendar"] = domain.ConnectorType{
-		ID:             "microsoft-calendar",
-		Name:           "Microsoft Calendar",
-		Description:    "Index events from Microsoft Calendar",
-		ProviderType:   domain.ProviderMicrosoft,
-		AuthCapability: domain.AuthCapOAuth,
-		AuthMethod:     domain.AuthMethodOAuth,
-		ConfigKeys:     msCalendarConfigKeys(),
-		WebURLResolver: mscalendar.ResolveWebURL,
+		ID:               "microsoft-calendar",
+		Name:             "Microsoft Calendar",
+		Description:      "Index events from Microsoft Calendar",
+		ProviderType:     domain.ProviderMicrosoft,
+		AuthCapability:   domain.AuthCapOAuth,
+		AuthMethod:       domain.AuthMethodOAuth,
+		ConfigKeys:       msCalendarConfigKeys(),
+		WebURLResolver:   mscalendar.ResolveWebURL,
+		EmittedMIMETypes: mscalendar.EmittedMIMETypes(),
 	}
 }
 
@@ -277,20 +378,33 @@ func msCalendarConfigKeys() []domain.ConfigKey {
 			Key:         "calendar_ids",
 			Label:       "Calendar IDs",
 			Description: "Specific calendar IDs to sync (optional)",
+			Type:        domain.ConfigValueList,
+		},
+		{
+			Key:         "locale",
+			Label:       "Locale",
+			Description: "Language for fetched content, sent as Accept-Language (e.g., en-GB)",
+		},
+		{
+			Key:         "timezone",
+			Label:       "Timezone",
+			Description: "IANA timezone event times are shown in (e.g., Europe/London)",
+			Default:     "UTC",
 		},
 	}
 }
 
 func (r *ConnectorRegistry) registerDropbox() {
 	r.connectors["dropbox"] = domain.ConnectorType{
-		ID:             "dropbox",
-		Name:           "Dropbox",
-		Description:    "Index files from Dropbox",
-		ProviderType:   domain.ProviderDropbox,
-		AuthCapability: domain.AuthCapOAuth,
-		AuthMethod:     domain.AuthMethodOAuth,
-		ConfigKeys:     dropboxConfigKeys(),
-		WebURLResolver: dropbox.ResolveWebURL,
+		ID:               "dropbox",
+		Name:             "Dropbox",
+		Description:      "Index files from Dropbox",
+		ProviderType:     domain.ProviderDropbox,
+		AuthCapability:   domain.AuthCapOAuth,
+		AuthMethod:       domain.AuthMethodOAuth,
+		ConfigKeys:       append(dropboxConfigKeys(), contentLimitConfigKeys()...),
+		WebURLResolver:   dropbox.ResolveWebURL,
+		EmittedMIMETypes: dropbox.EmittedMIMETypes(),
 	}
 }
 
@@ -306,25 +420,33 @@ func dropboxConfigKeys() []domain.ConfigKey {
 			Label:       "Recursive",
 			Description: "Include subfolders (true/false)",
 			Default:     "true",
+			Type:        domain.ConfigValueBool,
 		},
 		{
 			Key:         "mime_types",
 			Label:       "MIME Types",
 			Description: "Filter by MIME types (optional)",
+			Type:        domain.ConfigValueList,
+		},
+		{
+			Key:         "namespace_id",
+			Label:       "Namespace ID",
+			Description: "Team space or shared folder namespace ID to sync instead of your own files (optional)",
 		},
 	}
 }
 
 func (r *ConnectorRegistry) registerNotion() {
 	r.connectors["notion"] = domain.ConnectorType{
-		ID:             "notion",
-		Name:           "Notion",
-		Description:    "Index pages and databases from Notion",
-		ProviderType:   domain.ProviderNotion,
-		AuthCapability: domain.AuthCapOAuth,
-		AuthMethod:     domain.AuthMethodOAuth,
-		ConfigKeys:     notionConfigKeys(),
-		WebURLResolver: notion.ResolveWebURL,
+		ID:               "notion",
+		Name:             "Notion",
+		Description:      "Index pages and databases from Notion",
+		ProviderType:     domain.ProviderNotion,
+		AuthCapability:   domain.AuthCapOAuth,
+		AuthMethod:       domain.AuthMethodOAuth,
+		ConfigKeys:       notionConfigKeys(),
+		WebURLResolver:   notion.ResolveWebURL,
+		EmittedMIMETypes: notion.EmittedMIMETypes(),
 	}
 }
 
@@ -335,24 +457,156 @@ func notionConfigKeys() []domain.ConfigKey {
 			Label:       "Include Comments",
 			Description: "Fetch page comments (true/false)",
 			Default:     "true",
+			Type:        domain.ConfigValueBool,
 		},
 		{
 			Key:         "content_types",
 			Label:       "Content Types",
 			Description: "Content to sync: pages,databases",
 			Default:     "pages,databases",
+			Type:        domain.ConfigValueList,
+			Options:     []string{"pages", "databases"},
 		},
 		{
 			Key:         "max_block_depth",
 			Label:       "Max Block Depth",
 			Description: "Maximum depth for recursive block fetching",
 			Default:     "10",
+			Type:        domain.ConfigValueInt,
 		},
 		{
 			Key:         "page_size",
 			Label:       "Page Size",
 			Description: "Items per API page (max: 100)",
 			Default:     "100",
+			Type:        domain.ConfigValueInt,
+		},
+	}
+}
+
+func (r *ConnectorRegistry) registerTrello() {
+	r.connectors["trello"] = domain.ConnectorType{
+		ID:               "trello",
+		Name:             "Trello",
+		Description:      "Index cards, checklists and comments from Trello boards",
+		ProviderType:     domain.ProviderTrello,
+		AuthCapability:   domain.AuthCapPAT,
+		AuthMethod:       domain.AuthMethodPAT,
+		ConfigKeys:       trelloConfigKeys(),
+		WebURLResolver:   trello.ResolveWebURL,
+		EmittedMIMETypes: trello.EmittedMIMETypes(),
+	}
+}
+
+func trelloConfigKeys() []domain.ConfigKey {
+	return []domain.ConfigKey{
+		{
+			Key:         "api_key",
+			Label:       "API Key",
+			Description: "Trello API key (the token is stored as the personal access token)",
+			Required:    true,
+		},
+		{
+			Key:         "boards",
+			Label:       "Boards",
+			Description: "Board IDs to sync (optional, defaults to all open boards)",
+			Type:        domain.ConfigValueList,
+		},
+		{
+			Key:         "include_comments",
+			Label:       "Include Comments",
+			Description: "Fetch card comments (true/false)",
+			Default:     "true",
+			Type:        domain.ConfigValueBool,
+		},
+		{
+			Key:         "include_checklists",
+			Label:       "Include Checklists",
+			Description: "Fetch card checklists (true/false)",
+			Default:     "true",
+			Type:        domain.ConfigValueBool,
+		},
+		{
+			Key:         "include_archived",
+			Label:       "Include Archived",
+			Description: "Include archived cards (true/false)",
+			Default:     "false",
+			Type:        domain.ConfigValueBool,
+		},
+	}
+}
+
+func (r *ConnectorRegistry) registerSlack() {
+	r.connectors["slack"] = domain.ConnectorType{
+		ID:               "slack",
+		Name:             "Slack",
+		Description:      "Index channel messages and thread replies from Slack",
+		ProviderType:     domain.ProviderSlack,
+		AuthCapability:   domain.AuthCapOAuth,
+		AuthMethod:       domain.AuthMethodOAuth,
+		ConfigKeys:       slackConfigKeys(),
+		WebURLResolver:   slack.ResolveWebURL,
+		EmittedMIMETypes: slack.EmittedMIMETypes(),
+	}
+}
+
+func slackConfigKeys() []domain.ConfigKey {
+	return []domain.ConfigKey{
+		{
+			Key:         "channels",
+			Label:       "Channels",
+			Description: "Channel IDs or names to sync (optional, defaults to channels you are a member of)",
+			Type:        domain.ConfigValueList,
+		},
+		{
+			Key:         "include_threads",
+			Label:       "Include Threads",
+			Description: "Fetch thread replies (true/false)",
+			Default:     "true",
+			Type:        domain.ConfigValueBool,
+		},
+	}
+}
+
+func (r *ConnectorRegistry) registerYouTube() {
+	r.connectors["youtube"] = domain.ConnectorType{
+		ID:               "youtube",
+		Name:             "YouTube",
+		Description:      "Index video transcripts from YouTube channels, playlists and videos (Data API key as token)",
+		ProviderType:     domain.ProviderYouTube,
+		AuthCapability:   domain.AuthCapPAT,
+		AuthMethod:       domain.AuthMethodPAT,
+		ConfigKeys:       youtubeConfigKeys(),
+		WebURLResolver:   youtube.ResolveWebURL,
+		EmittedMIMETypes: youtube.EmittedMIMETypes(),
+	}
+}
+
+func youtubeConfigKeys() []domain.ConfigKey {
+	return []domain.ConfigKey{
+		{
+			Key:         "channels",
+			Label:       "Channels",
+			Description: "Channel IDs or @handles whose uploads are synced",
+			Type:        domain.ConfigValueList,
+		},
+		{
+			Key:         "playlists",
+			Label:       "Playlists",
+			Description: "Playlist IDs or URLs to sync",
+			Type:        domain.ConfigValueList,
+		},
+		{
+			Key:         "videos",
+			Label:       "Videos",
+			Description: "Video URLs or IDs to sync",
+			Type:        domain.ConfigValueList,
+		},
+		{
+			Key:         "language",
+			Label:       "Language",
+			Description: "Transcript language code",
+			Default:     youtube.DefaultLanguage,
 		},
 	}
 }
@@ -404,6 +658,41 @@ func (r *ConnectorRegistry) ValidateConfig(connectorID string, config map[string
 	return nil
 }
 
+// UnhandledMIMETypes cross-references each connector's emitted MIME types
+// with the normaliser registry and returns those no normaliser supports.
+// Results are sorted by connector type, then MIME type.
+// Returns nil if no normaliser registry is set.
+func (r *ConnectorRegistry) UnhandledMIMETypes() []domain.UnhandledMIMEType {
+	if r.normaliserRegistry == nil {
+		return nil
+	}
+
+	supported := make(map[string]bool)
+	for _, mime := range r.normaliserRegistry.SupportedMIMETypes() {
+		supported[mime] = true
+	}
+
+	var unhandled []domain.UnhandledMIMEType
+	for id := range r.connectors {
+		for _, mime := range r.connectors[id].EmittedMIMETypes {
+			if !supported[mime] {
+				unhandled = append(unhandled, domain.UnhandledMIMEType{
+					ConnectorType: id,
+					MIMEType:      mime,
+				})
+			}
+		}
+	}
+
+	sort.Slice(unhandled, func(i, j int) bool {
+		if unhandled[i].ConnectorType != unhandled[j].ConnectorType {
+			return unhandled[i].ConnectorType < unhandled[j].ConnectorType
+		}
+		return unhandled[i].MIMEType < unhandled[j].MIMEType
+	})
+	return unhandled
+}
+
 // GetOAuthDefaults returns default OAuth URLs and scopes for a connector type.
 // Returns nil if the connector type doesn't support OAuth.
 func (r *ConnectorRegistry) GetOAuthDefaults(connectorType string) *driving.OAuthDefaults {
@@ -415,9 +704,10 @@ func (r *ConnectorRegistry) GetOAuthDefaults(connectorType string) *driving.OAut
 		return nil
 	}
 	return &driving.OAuthDefaults{
-		AuthURL:  defaults.AuthURL,
-		TokenURL: defaults.TokenURL,
-		Scopes:   defaults.Scopes,
+		AuthURL:   defaults.AuthURL,
+		TokenURL:  defaults.TokenURL,
+		Scopes:    defaults.Scopes,
+		DeviceURL: defaults.DeviceURL,
 	}
 }
 
@@ -474,3 +764,62 @@ func (r *ConnectorRegistry) ExchangeCode(
 	}
 	return r.connectorFactory.ExchangeCode(ctx, connectorType, authProvider, code, redirectURI, codeVerifier)
 }
+
+// RefreshToken exchanges a refresh token for new tokens using connector-specific logic.
+func (r *ConnectorRegistry) RefreshToken(
+	ctx context.Context,
+	connectorType string,
+	authProvider *domain.AuthProvider,
+	refreshToken string,
+) (*domain.OAuthToken, error) {
+	if r.connectorFactory == nil {
+		return nil, domain.ErrNotFound
+	}
+	return r.connectorFactory.RefreshToken(ctx, connectorType, authProvider, refreshToken)
+}
+
+func (r *ConnectorRegistry) registerWeb() {
+	r.connectors["web"] = domain.ConnectorType{
+		ID:               "web",
+		Name:             "Website",
+		Description:      "Crawl the pages of a website, such as internal documentation, or those in its sitemap",
+		ProviderType:     domain.ProviderLocal,
+		AuthCapability:   domain.AuthCapNone,
+		AuthMethod:       domain.AuthMethodNone,
+		ConfigKeys:       webConfigKeys(),
+		WebURLResolver:   web.ResolveWebURL,
+		EmittedMIMETypes: web.EmittedMIMETypes(),
+	}
+}
+
+func webConfigKeys() []domain.ConfigKey {
+	return []domain.ConfigKey{
+		{
+			Key:         "url",
+			Label:       "Start URL",
+			Description: "Page to start crawling from, or a sitemap.xml listing the pages to index",
+			Required:    true,
+		},
+		{
+			Key:         "max_depth",
+			Label:       "Max Depth",
+			Description: "Links followed from the start page (not used with a sitemap)",
+			Default:     "3",
+			Type:        domain.ConfigValueInt,
+		},
+		{
+			Key:         "max_pages",
+			Label:       "Max Pages",
+			Description: "Most pages fetched per sync",
+			Default:     "500",
+			Type:        domain.ConfigValueInt,
+		},
+		{
+			Key:         "delay_ms",
+			Label:       "Delay (ms)",
+			Description: "Pause between requests; a longer robots.txt Crawl-delay takes precedence",
+			Default:     "1000",
+			Type:        domain.ConfigValueInt,
+		},
+	}
+}
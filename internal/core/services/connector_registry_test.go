@@ -9,6 +9,7 @@ import (
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/normalisers"
 )
 
 // mockConnectorFactory is a minimal mock for testing GetSetupHint delegation.
@@ -70,8 +71,8 @@ func TestConnectorRegistry_List(t *testing.T) {
 	connectors := registry.List()
 
 	// All built-in connectors: filesystem, github, google-drive, gmail, google-calendar,
-	// outlook, onedrive, microsoft-calendar, dropbox, notion
-	assert.Len(t, connectors, 10)
+	// outlook, onedrive, microsoft-calendar, dropbox, notion, trello, slack, youtube, web
+	assert.Len(t, connectors, 14)
 
 	// Verify all expected connectors are present
 	ids := make(map[string]bool)
@@ -88,6 +89,10 @@ func TestConnectorRegistry_List(t *testing.T) {
 	assert.True(t, ids["microsoft-calendar"])
 	assert.True(t, ids["dropbox"])
 	assert.True(t, ids["notion"])
+	assert.True(t, ids["trello"])
+	assert.True(t, ids["slack"])
+	assert.True(t, ids["youtube"])
+	assert.True(t, ids["web"])
 }
 
 func TestConnectorRegistry_Get_Filesystem(t *testing.T) {
@@ -117,7 +122,7 @@ func TestConnectorRegistry_Get_GitHub(t *testing.T) {
 	assert.True(t, connector.AuthCapability.SupportsOAuth())
 	assert.True(t, connector.AuthCapability.SupportsMultipleMethods())
 	// No required config keys for GitHub - indexes all accessible repos
-	assert.Len(t, connector.ConfigKeys, 2) // content_types, file_patterns
+	assert.Len(t, connector.ConfigKeys, 3) // content_types, file_patterns, parallel_within_source
 }
 
 func TestConnectorRegistry_Get_NotFound(t *testing.T) {
@@ -304,3 +309,103 @@ func TestConnectorRegistry_GetSetupHint_WithFactory_NoHint(t *testing.T) {
 
 	assert.Equal(t, "", hint)
 }
+
+// mockMIMENormaliserRegistry reports a fixed set of supported MIME types.
+type mockMIMENormaliserRegistry struct {
+	supported []string
+}
+
+func (m *mockMIMENormaliserRegistry) Normalise(_ context.Context, _ *domain.RawDocument) (*driven.NormaliseResult, error) {
+	return nil, domain.ErrNotImplemented
+}
+
+func (m *mockMIMENormaliserRegistry) Register(_ driven.Normaliser) {}
+
+func (m *mockMIMENormaliserRegistry) SupportedMIMETypes() []string {
+	return m.supported
+}
+
+func TestConnectorRegistry_EmittedMIMETypes_Populated(t *testing.T) {
+	registry := NewConnectorRegistry(nil)
+
+	for _, c := range registry.List() {
+		assert.NotEmpty(t, c.EmittedMIMETypes, "connector %s should declare emitted MIME types", c.ID)
+	}
+}
+
+func TestConnectorRegistry_UnhandledMIMETypes_NoNormaliserRegistry(t *testing.T) {
+	registry := NewConnectorRegistry(nil)
+
+	assert.Nil(t, registry.UnhandledMIMETypes())
+}
+
+func TestConnectorRegistry_UnhandledMIMETypes_FlagsMissingNormaliser(t *testing.T) {
+	registry := &ConnectorRegistry{
+		connectors: map[string]domain.ConnectorType{
+			"gmail":  {ID: "gmail", EmittedMIMETypes: []string{"message/rfc822"}},
+			"custom": {ID: "custom", EmittedMIMETypes: []string{"text/plain", "application/x-custom"}},
+		},
+	}
+	registry.SetNormaliserRegistry(&mockMIMENormaliserRegistry{
+		supported: []string{"message/rfc822", "text/plain"},
+	})
+
+	unhandled := registry.UnhandledMIMETypes()
+
+	require.Len(t, unhandled, 1)
+	assert.Equal(t, "custom", unhandled[0].ConnectorType)
+	assert.Equal(t, "application/x-custom", unhandled[0].MIMEType)
+}
+
+func TestConnectorRegistry_UnhandledMIMETypes_AllCovered(t *testing.T) {
+	registry := &ConnectorRegistry{
+		connectors: map[string]domain.ConnectorType{
+			"gmail": {ID: "gmail", EmittedMIMETypes: []string{"message/rfc822"}},
+		},
+	}
+	registry.SetNormaliserRegistry(&mockMIMENormaliserRegistry{
+		supported: []string{"message/rfc822"},
+	})
+
+	assert.Empty(t, registry.UnhandledMIMETypes())
+}
+
+func TestConnectorRegistry_UnhandledMIMETypes_DefaultNormalisers(t *testing.T) {
+	registry := NewConnectorRegistry(nil)
+	registry.SetNormaliserRegistry(normalisers.NewRegistry())
+
+	assert.Empty(t, registry.UnhandledMIMETypes(), "every built-in connector type should be normalisable")
+}
+
+func TestConnectorRegistry_UnhandledMIMETypes_Sorted(t *testing.T) {
+	registry := &ConnectorRegistry{
+		connectors: map[string]domain.ConnectorType{
+			"b": {ID: "b", EmittedMIMETypes: []string{"x/2", "x/1"}},
+			"a": {ID: "a", EmittedMIMETypes: []string{"x/3"}},
+		},
+	}
+	registry.SetNormaliserRegistry(&mockMIMENormaliserRegistry{})
+
+	unhandled := registry.UnhandledMIMETypes()
+
+	require.Len(t, unhandled, 3)
+	assert.Equal(t, domain.UnhandledMIMEType{ConnectorType: "a", MIMEType: "x/3"}, unhandled[0])
+	assert.Equal(t, domain.UnhandledMIMEType{ConnectorType: "b", MIMEType: "x/1"}, unhandled[1])
+	assert.Equal(t, domain.UnhandledMIMEType{ConnectorType: "b", MIMEType: "x/2"}, unhandled[2])
+}
+
+func TestConnectorRegistry_ConfigSchema_DefaultsMatchTypes(t *testing.T) {
+	registry := NewConnectorRegistry(nil)
+
+	for _, c := range registry.List() {
+		props := c.ConfigSchema()["properties"].(map[string]any)
+		for _, key := range c.ConfigKeys {
+			if key.Default == "" {
+				continue
+			}
+			prop := props[key.Key].(map[string]any)
+			assert.Contains(t, prop, "default", "%s.%s default %q does not parse as %s",
+				c.ID, key.Key, key.Default, key.ValueType())
+		}
+	}
+}
@@ -50,6 +50,16 @@ func (s *CredentialsService) GetBySourceID(ctx context.Context, sourceID string)
 	return s.store.GetBySourceID(ctx, sourceID)
 }
 
+// GetAllByAuthProviderID retrieves all accounts authenticated through an auth provider.
+func (s *CredentialsService) GetAllByAuthProviderID(
+	ctx context.Context, authProviderID string,
+) ([]domain.Credentials, error) {
+	if s.store == nil {
+		return nil, domain.ErrNotImplemented
+	}
+	return s.store.GetAllByAuthProviderID(ctx, authProviderID)
+}
+
 // Delete removes credentials by ID.
 func (s *CredentialsService) Delete(ctx context.Context, id string) error {
 	if s.store == nil {
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// parseCron parses a standard 5-field cron expression
+// (minute, hour, day of month, month, day of week).
+// Descriptors such as "@daily" and a "CRON_TZ=" prefix are also accepted.
+func parseCron(expr string) (cron.Schedule, error) {
+	schedule, err := cron.ParseStandard(strings.TrimSpace(expr))
+	if err != nil {
+		return nil, fmt.Errorf("%w: cron expression %q: %w", domain.ErrInvalidInput, expr, err)
+	}
+	return schedule, nil
+}
+
+// nextRunAfter computes when a task should next run after from.
+// A cron expression takes precedence over the fixed interval. Cron times are
+// computed in from's location, so daylight saving transitions follow local time:
+// a time skipped when clocks go forward does not fire that day, and a time
+// repeated when clocks go back fires only once.
+func nextRunAfter(task *domain.ScheduledTask, from time.Time) (time.Time, error) {
+	if task.Cron == "" {
+		return from.Add(task.Interval), nil
+	}
+	schedule, err := parseCron(task.Cron)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	next := schedule.Next(from)
+	if !task.LastRun.IsZero() && sameWallClockMinute(next, task.LastRun.In(next.Location())) {
+		// The wall-clock time repeated after clocks went back; skip the repeat
+		next = schedule.Next(next)
+	}
+	return next, nil
+}
+
+// sameWallClockMinute reports whether a and b show the same local date and minute.
+func sameWallClockMinute(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd && a.Hour() == b.Hour() && a.Minute() == b.Minute()
+}
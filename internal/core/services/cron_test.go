@@ -0,0 +1,129 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s unavailable: %v", name, err)
+	}
+	return loc
+}
+
+func TestParseCron_Invalid(t *testing.T) {
+	for _, expr := range []string{"", "every day", "61 * * * *", "* * * *", "0 6 * * 1-5 2025"} {
+		_, err := parseCron(expr)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput, expr)
+	}
+}
+
+func TestNextRunAfter_Interval(t *testing.T) {
+	from := time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC)
+	task := &domain.ScheduledTask{Interval: 45 * time.Minute}
+
+	next, err := nextRunAfter(task, from)
+
+	require.NoError(t, err)
+	assert.Equal(t, from.Add(45*time.Minute), next)
+}
+
+func TestNextRunAfter_Cron(t *testing.T) {
+	london := mustLoadLocation(t, "Europe/London")
+	newYork := mustLoadLocation(t, "America/New_York")
+
+	tests := []struct {
+		name     string
+		cron     string
+		from     time.Time
+		expected time.Time
+	}{
+		{
+			name:     "weekday 6am from friday evening skips weekend",
+			cron:     "0 6 * * 1-5",
+			from:     time.Date(2025, 6, 6, 18, 0, 0, 0, london), // Friday
+			expected: time.Date(2025, 6, 9, 6, 0, 0, 0, london),  // Monday
+		},
+		{
+			name:     "weekday 6am from monday before six",
+			cron:     "0 6 * * 1-5",
+			from:     time.Date(2025, 6, 9, 5, 59, 0, 0, london),
+			expected: time.Date(2025, 6, 9, 6, 0, 0, 0, london),
+		},
+		{
+			name:     "every fifteen minutes",
+			cron:     "*/15 * * * *",
+			from:     time.Date(2025, 6, 9, 10, 7, 30, 0, time.UTC),
+			expected: time.Date(2025, 6, 9, 10, 15, 0, 0, time.UTC),
+		},
+		{
+			name:     "daily 6am keeps wall clock across spring forward",
+			cron:     "0 6 * * *",
+			from:     time.Date(2025, 3, 29, 7, 0, 0, 0, london), // Day before BST starts
+			expected: time.Date(2025, 3, 30, 6, 0, 0, 0, london), // 23 hours later
+		},
+		{
+			name:     "daily 6am keeps wall clock across fall back",
+			cron:     "0 6 * * *",
+			from:     time.Date(2025, 10, 25, 7, 0, 0, 0, london), // Day before BST ends
+			expected: time.Date(2025, 10, 26, 6, 0, 0, 0, london), // 25 hours later
+		},
+		{
+			name:     "time skipped by spring forward does not fire that day",
+			cron:     "30 2 * * *",
+			from:     time.Date(2025, 3, 9, 0, 0, 0, 0, newYork), // 02:00-03:00 does not exist
+			expected: time.Date(2025, 3, 10, 2, 30, 0, 0, newYork),
+		},
+		{
+			name:     "explicit time zone overrides clock location",
+			cron:     "CRON_TZ=America/New_York 0 9 * * *",
+			from:     time.Date(2025, 6, 9, 12, 0, 0, 0, time.UTC), // 08:00 in New York
+			expected: time.Date(2025, 6, 9, 13, 0, 0, 0, time.UTC), // 09:00 in New York
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &domain.ScheduledTask{Cron: tt.cron, Interval: time.Hour}
+
+			next, err := nextRunAfter(task, tt.from)
+
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(next), "expected %s, got %s", tt.expected, next)
+		})
+	}
+}
+
+func TestNextRunAfter_FallBackDoesNotFireTwice(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	task := &domain.ScheduledTask{Cron: "30 1 * * *"}
+
+	// 01:30 occurs twice on 2 Nov 2025 (EDT then EST)
+	first, err := nextRunAfter(task, time.Date(2025, 11, 2, 0, 0, 0, 0, newYork))
+	require.NoError(t, err)
+
+	// The task ran at the first 01:30 and finished a minute later
+	task.LastRun = first
+	second, err := nextRunAfter(task, first.Add(time.Minute))
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, first.Hour())
+	assert.Equal(t, 2, first.Day())
+	assert.Equal(t, 3, second.Day())
+}
+
+func TestNextRunAfter_InvalidCron(t *testing.T) {
+	task := &domain.ScheduledTask{Cron: "not a cron", Interval: time.Hour}
+
+	_, err := nextRunAfter(task, time.Now())
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
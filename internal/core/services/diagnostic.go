@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"runtime"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// Ensure DiagnosticService implements the interface.
+var _ driving.DiagnosticService = (*DiagnosticService)(nil)
+
+// DiagnosticService describes the local installation for bug reports.
+type DiagnosticService struct {
+	sourceStore  driven.SourceStore
+	docStore     driven.DocumentStore
+	meter        driven.DiskUsageMeter
+	database     driven.VersionReporter
+	searchEngine driven.SearchEngine
+	vectorIndex  driven.VectorIndex
+	settings     driving.SettingsService
+
+	databasePath    string
+	searchIndexPath string
+	vectorIndexPath string
+}
+
+// NewDiagnosticService creates a new diagnostic service.
+// The meter, database, searchEngine and vectorIndex are optional - if nil,
+// the details they provide are left empty. A nil vectorIndex means sercha is
+// running in text-only mode.
+func NewDiagnosticService(
+	sourceStore driven.SourceStore,
+	docStore driven.DocumentStore,
+	meter driven.DiskUsageMeter,
+	database driven.VersionReporter,
+	searchEngine driven.SearchEngine,
+	vectorIndex driven.VectorIndex,
+) *DiagnosticService {
+	return &DiagnosticService{
+		sourceStore:  sourceStore,
+		docStore:     docStore,
+		meter:        meter,
+		database:     database,
+		searchEngine: searchEngine,
+		vectorIndex:  vectorIndex,
+	}
+}
+
+// SetSettingsService sets the settings service used to report the search
+// mode and embedding model.
+func (s *DiagnosticService) SetSettingsService(settings driving.SettingsService) {
+	s.settings = settings
+}
+
+// SetPaths sets the locations of the database and indexes. Only the last
+// component of each path is reported.
+func (s *DiagnosticService) SetPaths(database, searchIndex, vectorIndex string) {
+	s.databasePath = database
+	s.searchIndexPath = searchIndex
+	s.vectorIndexPath = vectorIndex
+}
+
+// Collect gathers platform, library, index and AI configuration details.
+func (s *DiagnosticService) Collect(ctx context.Context) (*domain.Diagnostics, error) {
+	if s.sourceStore == nil || s.docStore == nil {
+		return nil, domain.ErrNotImplemented
+	}
+
+	diag := &domain.Diagnostics{
+		OS:            runtime.GOOS,
+		Arch:          runtime.GOARCH,
+		GoVersion:     runtime.Version(),
+		SQLiteVersion: engineVersion(ctx, s.database),
+		SourcesByType: make(map[string]int),
+		Database:      lastPathComponent(s.databasePath),
+		SearchIndex:   lastPathComponent(s.searchIndexPath),
+		VectorIndex:   lastPathComponent(s.vectorIndexPath),
+		AIMode:        domain.AIModeTextOnly,
+	}
+	if reporter, ok := s.searchEngine.(driven.VersionReporter); ok {
+		diag.XapianVersion = engineVersion(ctx, reporter)
+	}
+
+	sources, err := s.sourceStore.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sources: %w", err)
+	}
+	for i := range sources {
+		diag.SourcesByType[sources[i].Type]++
+		count, err := countDocuments(ctx, s.docStore, sources[i].ID)
+		if err != nil {
+			return nil, fmt.Errorf("count documents: %w", err)
+		}
+		diag.Documents += count
+	}
+
+	if s.meter != nil {
+		usage, err := s.meter.DiskUsage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("measure disk usage: %w", err)
+		}
+		diag.SearchIndexBytes = usage.SearchIndex
+		diag.VectorIndexBytes = usage.VectorIndex
+	}
+
+	if s.vectorIndex != nil {
+		diag.AIMode = domain.AIModeVector
+	}
+	if s.settings != nil {
+		if settings, err := s.settings.Get(); err == nil {
+			diag.SearchMode = settings.Search.Mode
+			diag.EmbeddingModel = settings.Embedding.Model
+		}
+	}
+
+	return diag, nil
+}
+
+// engineVersion returns the version a library reports, or "" if it cannot.
+func engineVersion(ctx context.Context, reporter driven.VersionReporter) string {
+	if reporter == nil {
+		return ""
+	}
+	version, err := reporter.EngineVersion(ctx)
+	if err != nil {
+		slog.Debug("engine version unavailable", slog.Any("error", err))
+		return ""
+	}
+	return version
+}
+
+// lastPathComponent returns the last element of path, so diagnostics never
+// reveal the directories (and user name) it is under. Empty stays empty.
+func lastPathComponent(path string) string {
+	if path == "" {
+		return ""
+	}
+	return filepath.Base(path)
+}
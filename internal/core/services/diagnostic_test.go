@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockVersionReporter implements driven.VersionReporter for testing.
+type mockVersionReporter struct {
+	version string
+	err     error
+}
+
+func (m *mockVersionReporter) EngineVersion(_ context.Context) (string, error) {
+	return m.version, m.err
+}
+
+// versionedSearchEngine is a search engine that reports its library version.
+type versionedSearchEngine struct {
+	*syncMockSearchEngine
+	mockVersionReporter
+}
+
+func setupDiagnosticStores(t *testing.T) (*memory.SourceStore, *memory.DocumentStore) {
+	t.Helper()
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	for _, src := range []domain.Source{
+		{ID: "src-1", Type: "filesystem"},
+		{ID: "src-2", Type: "filesystem"},
+		{ID: "src-3", Type: "github"},
+	} {
+		require.NoError(t, sourceStore.Save(ctx, src))
+	}
+	for _, doc := range []domain.Document{
+		{ID: "doc-1", SourceID: "src-1"},
+		{ID: "doc-2", SourceID: "src-1"},
+		{ID: "doc-3", SourceID: "src-3"},
+	} {
+		require.NoError(t, docStore.SaveDocument(ctx, &doc))
+	}
+	return sourceStore, docStore
+}
+
+func TestDiagnosticService_Collect(t *testing.T) {
+	sourceStore, docStore := setupDiagnosticStores(t)
+	meter := &mockDiskUsageMeter{readings: []domain.DiskUsage{
+		{Database: 100, SearchIndex: 2048, VectorIndex: 4096},
+	}}
+	searchEngine := &versionedSearchEngine{
+		syncMockSearchEngine: newSyncMockSearchEngine(),
+		mockVersionReporter:  mockVersionReporter{version: "1.4.22"},
+	}
+	settings := NewSettingsService(memory.NewConfigStore(), nil)
+	require.NoError(t, settings.SetSearchMode(domain.SearchModeHybrid))
+	require.NoError(t, settings.SetEmbeddingProvider(domain.AIProviderOllama, "nomic-embed-text", ""))
+
+	service := NewDiagnosticService(
+		sourceStore, docStore, meter, &mockVersionReporter{version: "3.45.1"},
+		searchEngine, newSyncMockVectorIndex())
+	service.SetSettingsService(settings)
+	service.SetPaths("/home/alice/.sercha/data/metadata.db", "/home/alice/.sercha/data/xapian", "")
+
+	diag, err := service.Collect(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, runtime.GOOS, diag.OS)
+	assert.Equal(t, runtime.GOARCH, diag.Arch)
+	assert.Equal(t, runtime.Version(), diag.GoVersion)
+	assert.Equal(t, "3.45.1", diag.SQLiteVersion)
+	assert.Equal(t, "1.4.22", diag.XapianVersion)
+	assert.Equal(t, map[string]int{"filesystem": 2, "github": 1}, diag.SourcesByType)
+	assert.Equal(t, 3, diag.Documents)
+	assert.Equal(t, int64(2048), diag.SearchIndexBytes)
+	assert.Equal(t, int64(4096), diag.VectorIndexBytes)
+	assert.Equal(t, "metadata.db", diag.Database, "paths are reduced to their last component")
+	assert.Equal(t, "xapian", diag.SearchIndex)
+	assert.Empty(t, diag.VectorIndex)
+	assert.Equal(t, domain.AIModeVector, diag.AIMode)
+	assert.Equal(t, domain.SearchModeHybrid, diag.SearchMode)
+	assert.Equal(t, "nomic-embed-text", diag.EmbeddingModel)
+	assert.Empty(t, diag.Version, "version is filled in by the caller")
+}
+
+func TestDiagnosticService_Collect_TextOnlyWithoutOptionalDependencies(t *testing.T) {
+	sourceStore, docStore := setupDiagnosticStores(t)
+	service := NewDiagnosticService(sourceStore, docStore, nil, nil, newSyncMockSearchEngine(), nil)
+
+	diag, err := service.Collect(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.AIModeTextOnly, diag.AIMode)
+	assert.Empty(t, diag.SQLiteVersion)
+	assert.Empty(t, diag.XapianVersion, "search engine does not report a version")
+	assert.Zero(t, diag.SearchIndexBytes)
+	assert.Equal(t, 3, diag.Documents)
+}
+
+func TestDiagnosticService_Collect_VersionErrorLeavesVersionEmpty(t *testing.T) {
+	sourceStore, docStore := setupDiagnosticStores(t)
+	database := &mockVersionReporter{err: errors.New("database closed")}
+	service := NewDiagnosticService(sourceStore, docStore, nil, database, nil, nil)
+
+	diag, err := service.Collect(context.Background())
+
+	require.NoError(t, err)
+	assert.Empty(t, diag.SQLiteVersion)
+}
+
+func TestDiagnosticService_Collect_NoStores(t *testing.T) {
+	service := NewDiagnosticService(nil, nil, nil, nil, nil, nil)
+
+	_, err := service.Collect(context.Background())
+
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
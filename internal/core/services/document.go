@@ -27,6 +27,7 @@ type DocumentService struct {
 	sourceStore       driven.SourceStore
 	exclusionStore    driven.ExclusionStore
 	connectorRegistry driving.ConnectorRegistry
+	syncer            driving.SyncOrchestrator
 }
 
 // NewDocumentService creates a new document service.
@@ -44,6 +45,12 @@ func NewDocumentService(
 	}
 }
 
+// SetSyncOrchestrator sets the orchestrator used to refresh documents by
+// re-syncing the sources that own them.
+func (s *DocumentService) SetSyncOrchestrator(syncer driving.SyncOrchestrator) {
+	s.syncer = syncer
+}
+
 // ListBySource returns all documents for a source.
 func (s *DocumentService) ListBySource(ctx context.Context, sourceID string) ([]domain.Document, error) {
 	if s.docStore == nil {
@@ -157,30 +164,112 @@ func (s *DocumentService) Exclude(ctx context.Context, documentID, reason string
 	}
 
 	// Add to exclusion store
-	if s.exclusionStore != nil {
-		exclusion := &domain.Exclusion{
-			ID:         fmt.Sprintf("excl-%s", documentID),
-			SourceID:   doc.SourceID,
-			DocumentID: documentID,
-			URI:        doc.URI,
-			Reason:     reason,
-			ExcludedAt: time.Now(),
-		}
-		if err := s.exclusionStore.Add(ctx, exclusion); err != nil {
-			return fmt.Errorf("failed to add exclusion: %w", err)
-		}
+	if err := s.addExclusion(ctx, doc, reason); err != nil {
+		return err
 	}
 
 	// Delete the document
 	return s.docStore.DeleteDocument(ctx, documentID)
 }
 
+// ExcludeMultiple excludes several documents at once. Every document is
+// looked up before any is excluded, so an unknown ID excludes nothing.
+// Stores implementing driven.DocumentBatchDeleter delete the documents in
+// one operation.
+func (s *DocumentService) ExcludeMultiple(ctx context.Context, documentIDs []string) error {
+	if s.docStore == nil {
+		return domain.ErrNotImplemented
+	}
+
+	docs := make([]*domain.Document, 0, len(documentIDs))
+	for _, id := range documentIDs {
+		doc, err := s.docStore.GetDocument(ctx, id)
+		if err != nil {
+			return fmt.Errorf("document %s: %w", id, err)
+		}
+		docs = append(docs, doc)
+	}
+
+	for _, doc := range docs {
+		if err := s.addExclusion(ctx, doc, batchExcludeReason); err != nil {
+			return err
+		}
+	}
+
+	if deleter, ok := s.docStore.(driven.DocumentBatchDeleter); ok {
+		return deleter.DeleteDocuments(ctx, documentIDs)
+	}
+	for _, id := range documentIDs {
+		if err := s.docStore.DeleteDocument(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// batchExcludeReason is the reason recorded for documents excluded together.
+const batchExcludeReason = "user excluded"
+
+// addExclusion records a document in the exclusion store, if one is set.
+func (s *DocumentService) addExclusion(ctx context.Context, doc *domain.Document, reason string) error {
+	if s.exclusionStore == nil {
+		return nil
+	}
+
+	exclusion := &domain.Exclusion{
+		ID:         fmt.Sprintf("excl-%s", doc.ID),
+		SourceID:   doc.SourceID,
+		DocumentID: doc.ID,
+		URI:        doc.URI,
+		Reason:     reason,
+		ExcludedAt: time.Now(),
+	}
+	if err := s.exclusionStore.Add(ctx, exclusion); err != nil {
+		return fmt.Errorf("failed to add exclusion: %w", err)
+	}
+	return nil
+}
+
 // Refresh re-syncs a single document from its source.
 // TODO: Implement when sync infrastructure supports single-document refresh.
 func (s *DocumentService) Refresh(_ context.Context, _ string) error {
 	return ErrRefreshNotImplemented
 }
 
+// RefreshMultiple re-syncs several documents from their sources. Documents
+// are grouped by source and each owning source is synced once, so a refresh
+// also picks up any other changes in those sources. Every source is
+// attempted; the failures are returned together.
+func (s *DocumentService) RefreshMultiple(ctx context.Context, documentIDs []string) error {
+	if len(documentIDs) == 0 {
+		return nil
+	}
+	if s.docStore == nil || s.syncer == nil {
+		return ErrRefreshNotImplemented
+	}
+
+	var sourceIDs []string
+	seen := make(map[string]bool)
+	for _, id := range documentIDs {
+		doc, err := s.docStore.GetDocument(ctx, id)
+		if err != nil {
+			return fmt.Errorf("document %s: %w", id, err)
+		}
+		if !seen[doc.SourceID] {
+			seen[doc.SourceID] = true
+			sourceIDs = append(sourceIDs, doc.SourceID)
+		}
+	}
+
+	var errs []error
+	for _, sourceID := range sourceIDs {
+		if err := s.syncer.Sync(ctx, sourceID); err != nil {
+			errs = append(errs, fmt.Errorf("source %s: %w", sourceID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // Open opens the document in the default application.
 func (s *DocumentService) Open(ctx context.Context, documentID string) error {
 	if s.docStore == nil {
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 )
 
 func TestNewDocumentService(t *testing.T) {
@@ -225,6 +227,144 @@ func TestDocumentService_Exclude_VerifiesExclusionID(t *testing.T) {
 	assert.True(t, excluded)
 }
 
+// countingDocStore records how documents are deleted from a memory store.
+type countingDocStore struct {
+	*memory.DocumentStore
+	deletes      int
+	batchDeletes [][]string
+}
+
+func (s *countingDocStore) DeleteDocument(ctx context.Context, id string) error {
+	s.deletes++
+	return s.DocumentStore.DeleteDocument(ctx, id)
+}
+
+func (s *countingDocStore) DeleteDocuments(ctx context.Context, ids []string) error {
+	s.batchDeletes = append(s.batchDeletes, ids)
+	return s.DocumentStore.DeleteDocuments(ctx, ids)
+}
+
+// singleDeleteDocStore hides the memory store's batch delete.
+type singleDeleteDocStore struct {
+	driven.DocumentStore
+}
+
+func saveExcludeTestDocs(t *testing.T, store driven.DocumentStore) {
+	t.Helper()
+	ctx := context.Background()
+	for _, id := range []string{"doc-1", "doc-2", "doc-3"} {
+		require.NoError(t, store.SaveDocument(ctx, &domain.Document{ID: id, SourceID: "src-1", URI: "/path/" + id}))
+	}
+}
+
+func TestDocumentService_ExcludeMultiple(t *testing.T) {
+	docStore := &countingDocStore{DocumentStore: memory.NewDocumentStore()}
+	exclusionStore := memory.NewExclusionStore()
+	svc := NewDocumentService(docStore, nil, exclusionStore, nil)
+	ctx := context.Background()
+	saveExcludeTestDocs(t, docStore)
+
+	err := svc.ExcludeMultiple(ctx, []string{"doc-1", "doc-3"})
+	require.NoError(t, err)
+
+	// Deleted in one batch rather than one at a time
+	assert.Equal(t, [][]string{{"doc-1", "doc-3"}}, docStore.batchDeletes)
+	assert.Zero(t, docStore.deletes)
+
+	docs, _ := docStore.ListDocuments(ctx, "src-1")
+	require.Len(t, docs, 1)
+	assert.Equal(t, "doc-2", docs[0].ID)
+
+	for _, uri := range []string{"/path/doc-1", "/path/doc-3"} {
+		excluded, _ := exclusionStore.IsExcluded(ctx, "src-1", uri)
+		assert.True(t, excluded, uri)
+	}
+	excluded, _ := exclusionStore.IsExcluded(ctx, "src-1", "/path/doc-2")
+	assert.False(t, excluded)
+}
+
+func TestDocumentService_ExcludeMultiple_UnknownDocumentExcludesNothing(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	exclusionStore := memory.NewExclusionStore()
+	svc := NewDocumentService(docStore, nil, exclusionStore, nil)
+	ctx := context.Background()
+	saveExcludeTestDocs(t, docStore)
+
+	err := svc.ExcludeMultiple(ctx, []string{"doc-1", "missing"})
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = docStore.GetDocument(ctx, "doc-1")
+	assert.NoError(t, err)
+	exclusions, _ := exclusionStore.List(ctx)
+	assert.Empty(t, exclusions)
+}
+
+func TestDocumentService_ExcludeMultiple_WithoutBatchDelete(t *testing.T) {
+	memStore := memory.NewDocumentStore()
+	svc := NewDocumentService(&singleDeleteDocStore{memStore}, nil, nil, nil)
+	ctx := context.Background()
+	saveExcludeTestDocs(t, memStore)
+
+	err := svc.ExcludeMultiple(ctx, []string{"doc-1", "doc-2"})
+	require.NoError(t, err)
+
+	docs, _ := memStore.ListDocuments(ctx, "src-1")
+	require.Len(t, docs, 1)
+	assert.Equal(t, "doc-3", docs[0].ID)
+}
+
+func TestDocumentService_RefreshMultiple_SyncsOwningSources(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	syncer := &mockSyncOrchestrator{}
+	svc := NewDocumentService(docStore, nil, nil, nil)
+	svc.SetSyncOrchestrator(syncer)
+	ctx := context.Background()
+
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: "doc-1", SourceID: "src-1"})
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: "doc-2", SourceID: "src-2"})
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: "doc-3", SourceID: "src-1"})
+
+	err := svc.RefreshMultiple(ctx, []string{"doc-1", "doc-2", "doc-3"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"src-1", "src-2"}, syncer.synced)
+}
+
+func TestDocumentService_RefreshMultiple_SyncError(t *testing.T) {
+	docStore := memory.NewDocumentStore()
+	syncer := &mockSyncOrchestrator{syncErr: errors.New("connector unavailable")}
+	svc := NewDocumentService(docStore, nil, nil, nil)
+	svc.SetSyncOrchestrator(syncer)
+	ctx := context.Background()
+
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: "doc-1", SourceID: "src-1"})
+	_ = docStore.SaveDocument(ctx, &domain.Document{ID: "doc-2", SourceID: "src-2"})
+
+	err := svc.RefreshMultiple(ctx, []string{"doc-1", "doc-2"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "source src-1")
+	assert.Contains(t, err.Error(), "source src-2")
+	assert.Len(t, syncer.synced, 2)
+}
+
+func TestDocumentService_RefreshMultiple_MissingDocument(t *testing.T) {
+	syncer := &mockSyncOrchestrator{}
+	svc := NewDocumentService(memory.NewDocumentStore(), nil, nil, nil)
+	svc.SetSyncOrchestrator(syncer)
+
+	err := svc.RefreshMultiple(context.Background(), []string{"missing"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "document missing")
+	assert.Empty(t, syncer.synced)
+}
+
+func TestDocumentService_RefreshMultiple_NoSyncOrchestrator(t *testing.T) {
+	svc := NewDocumentService(memory.NewDocumentStore(), nil, nil, nil)
+
+	err := svc.RefreshMultiple(context.Background(), []string{"doc-1"})
+	assert.ErrorIs(t, err, ErrRefreshNotImplemented)
+	assert.NoError(t, svc.RefreshMultiple(context.Background(), nil))
+}
+
 func TestDocumentService_Refresh_NotImplemented(t *testing.T) {
 	svc := NewDocumentService(nil, nil, nil, nil)
 	ctx := context.Background()
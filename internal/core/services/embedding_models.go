@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// embeddingModels selects the embedding service for each document when
+// embedding model overrides are configured.
+type embeddingModels struct {
+	// overrides maps connector types and MIME type prefixes to models
+	// (see domain.EmbeddingSettings.ModelOverrides).
+	overrides map[string]string
+	// services holds the embedding service of each override model.
+	services map[string]driven.EmbeddingService
+}
+
+// serviceFor returns the service that embeds documents of a connector type
+// and MIME type. Documents without an override, or whose model has no
+// service, are embedded by defaultService.
+func (m *embeddingModels) serviceFor(
+	defaultService driven.EmbeddingService, connectorType, mimeType string,
+) driven.EmbeddingService {
+	model, ok := domain.EmbeddingModelOverride(m.overrides, connectorType, mimeType)
+	if !ok {
+		return defaultService
+	}
+	if svc, ok := m.services[model]; ok {
+		return svc
+	}
+	return defaultService
+}
+
+// modelNames returns the names of the default model and the override models.
+func (m *embeddingModels) modelNames(defaultService driven.EmbeddingService) []string {
+	names := []string{defaultService.ModelName()}
+	for _, svc := range m.services {
+		names = append(names, svc.ModelName())
+	}
+	return names
+}
+
+// addVector stores a chunk's embedding computed by svc. Embeddings of models
+// other than the default go to their own index when the vector index keeps
+// models apart.
+func addVector(
+	ctx context.Context, index driven.VectorIndex, defaultService, svc driven.EmbeddingService,
+	chunkID string, embedding []float32,
+) error {
+	if models, ok := index.(driven.ModelVectorIndex); ok && svc != defaultService {
+		return models.AddForModel(ctx, svc.ModelName(), chunkID, embedding)
+	}
+	return index.Add(ctx, chunkID, embedding)
+}
+
+// documentMIMEType returns the MIME type a stored document was normalised
+// from, or "" if it was not recorded.
+func documentMIMEType(doc *domain.Document) string {
+	mimeType, _ := doc.Metadata[domain.DocMetaMIMEType].(string)
+	return mimeType
+}
@@ -0,0 +1,375 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// Ensure MaintenanceService implements the interface.
+var _ driving.MaintenanceService = (*MaintenanceService)(nil)
+
+// MaintenanceService performs housekeeping on the local data stores.
+type MaintenanceService struct {
+	database     driven.DatabaseVacuumer
+	meter        driven.DiskUsageMeter
+	sourceStore  driven.SourceStore
+	docStore     driven.DocumentStore
+	searchEngine driven.SearchEngine
+	vectorIndex  driven.VectorIndex
+}
+
+// NewMaintenanceService creates a new maintenance service.
+// The searchEngine and vectorIndex are optional - if nil, or if they do not
+// support maintenance, they are left as they are.
+func NewMaintenanceService(
+	database driven.DatabaseVacuumer,
+	meter driven.DiskUsageMeter,
+	sourceStore driven.SourceStore,
+	docStore driven.DocumentStore,
+	searchEngine driven.SearchEngine,
+	vectorIndex driven.VectorIndex,
+) *MaintenanceService {
+	return &MaintenanceService{
+		database:     database,
+		meter:        meter,
+		sourceStore:  sourceStore,
+		docStore:     docStore,
+		searchEngine: searchEngine,
+		vectorIndex:  vectorIndex,
+	}
+}
+
+// Vacuum compacts the metadata database and search index and removes
+// vectors whose chunks no longer exist, reporting disk usage before and after.
+func (s *MaintenanceService) Vacuum(ctx context.Context) (*domain.VacuumResult, error) {
+	if s.database == nil || s.meter == nil {
+		return nil, domain.ErrNotImplemented
+	}
+
+	before, err := s.meter.DiskUsage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("measure disk usage: %w", err)
+	}
+	result := &domain.VacuumResult{Before: before}
+
+	// Prune first so the vector index is saved before it is measured
+	if result.OrphanedVectors, err = s.pruneVectors(ctx, nil); err != nil {
+		return nil, fmt.Errorf("prune vector index: %w", err)
+	}
+
+	if err := s.database.Vacuum(ctx); err != nil {
+		return nil, fmt.Errorf("vacuum database: %w", err)
+	}
+
+	if result.SearchIndexCompacted, err = s.compactSearchIndex(ctx); err != nil {
+		return nil, err
+	}
+
+	if result.After, err = s.meter.DiskUsage(ctx); err != nil {
+		return nil, fmt.Errorf("measure disk usage: %w", err)
+	}
+
+	return result, nil
+}
+
+// Optimize flushes and compacts the search index and compacts the vector
+// index, reporting disk usage before and after. Unlike Vacuum it leaves the
+// metadata database and the set of stored vectors as they are.
+func (s *MaintenanceService) Optimize(ctx context.Context) (*domain.OptimizeResult, error) {
+	if s.meter == nil {
+		return nil, domain.ErrNotImplemented
+	}
+
+	before, err := s.meter.DiskUsage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("measure disk usage: %w", err)
+	}
+	result := &domain.OptimizeResult{Before: before}
+
+	if err := flushSearchIndex(ctx, s.searchEngine); err != nil {
+		return nil, err
+	}
+
+	if result.SearchIndexCompacted, err = s.compactSearchIndex(ctx); err != nil {
+		return nil, err
+	}
+
+	if result.VectorIndexCompacted, err = s.compactVectorIndex(ctx); err != nil {
+		return nil, err
+	}
+
+	if result.After, err = s.meter.DiskUsage(ctx); err != nil {
+		return nil, fmt.Errorf("measure disk usage: %w", err)
+	}
+
+	return result, nil
+}
+
+// Compact removes documents whose source no longer exists and chunks of
+// deleted documents from the search and vector indexes, then vacuums the
+// metadata database and compacts both indexes, reporting disk usage before
+// and after.
+func (s *MaintenanceService) Compact(ctx context.Context) (*domain.CompactResult, error) {
+	if s.database == nil || s.meter == nil {
+		return nil, domain.ErrNotImplemented
+	}
+
+	before, err := s.meter.DiskUsage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("measure disk usage: %w", err)
+	}
+	result := &domain.CompactResult{Before: before}
+
+	if result.OrphanedDocuments, err = s.pruneDocuments(ctx); err != nil {
+		return nil, fmt.Errorf("prune documents: %w", err)
+	}
+
+	// List stored chunks once for both indexes
+	var live map[string]struct{}
+	if s.sourceStore != nil && s.docStore != nil {
+		if live, err = s.liveChunks(ctx); err != nil {
+			return nil, fmt.Errorf("list stored chunks: %w", err)
+		}
+	}
+	if result.OrphanedChunks, err = s.pruneSearchIndex(ctx, live); err != nil {
+		return nil, fmt.Errorf("prune search index: %w", err)
+	}
+	if result.OrphanedVectors, err = s.pruneVectors(ctx, live); err != nil {
+		return nil, fmt.Errorf("prune vector index: %w", err)
+	}
+
+	if err := s.database.Vacuum(ctx); err != nil {
+		return nil, fmt.Errorf("vacuum database: %w", err)
+	}
+
+	if err := flushSearchIndex(ctx, s.searchEngine); err != nil {
+		return nil, err
+	}
+	if result.SearchIndexCompacted, err = s.compactSearchIndex(ctx); err != nil {
+		return nil, err
+	}
+	if result.VectorIndexCompacted, err = s.compactVectorIndex(ctx); err != nil {
+		return nil, err
+	}
+
+	if result.After, err = s.meter.DiskUsage(ctx); err != nil {
+		return nil, fmt.Errorf("measure disk usage: %w", err)
+	}
+
+	return result, nil
+}
+
+// flushSearchIndex commits pending changes to the search index if the engine
+// supports it, so its files on disk are complete.
+func flushSearchIndex(ctx context.Context, searchEngine driven.SearchEngine) error {
+	flusher, ok := searchEngine.(driven.SearchIndexFlusher)
+	if !ok {
+		return nil
+	}
+	err := flusher.Flush(ctx)
+	switch {
+	case errors.Is(err, domain.ErrNotImplemented):
+		slog.Debug("search index flush not supported")
+		return nil
+	case err != nil:
+		return fmt.Errorf("flush search index: %w", err)
+	default:
+		return nil
+	}
+}
+
+// compactSearchIndex compacts the search index if the engine supports it
+// and reports whether it was compacted.
+func (s *MaintenanceService) compactSearchIndex(ctx context.Context) (bool, error) {
+	compactor, ok := s.searchEngine.(driven.SearchIndexCompactor)
+	if !ok {
+		return false, nil
+	}
+	err := compactor.Compact(ctx)
+	switch {
+	case errors.Is(err, domain.ErrNotImplemented):
+		slog.Debug("search index compaction not supported")
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("compact search index: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// compactVectorIndex compacts the vector index if it supports it and
+// reports whether it was compacted.
+func (s *MaintenanceService) compactVectorIndex(ctx context.Context) (bool, error) {
+	compactor, ok := s.vectorIndex.(driven.VectorIndexCompactor)
+	if !ok {
+		return false, nil
+	}
+	err := compactor.Compact(ctx)
+	switch {
+	case errors.Is(err, domain.ErrNotImplemented):
+		slog.Debug("vector index compaction not supported")
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("compact vector index: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// pruneDocuments deletes documents whose source no longer exists and returns
+// how many were removed. Their chunks are left in the indexes for
+// pruneSearchIndex and pruneVectors to remove.
+func (s *MaintenanceService) pruneDocuments(ctx context.Context) (int, error) {
+	lister, ok := s.docStore.(driven.DocumentSourceLister)
+	if !ok || s.sourceStore == nil {
+		return 0, nil
+	}
+
+	sourceIDs, err := lister.DocumentSourceIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	sources, err := s.sourceStore.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+	configured := make(map[string]struct{}, len(sources))
+	for i := range sources {
+		configured[sources[i].ID] = struct{}{}
+	}
+
+	var orphans []string
+	for _, sourceID := range sourceIDs {
+		if _, ok := configured[sourceID]; ok {
+			continue
+		}
+		docs, err := s.docStore.ListDocuments(ctx, sourceID)
+		if err != nil {
+			return 0, err
+		}
+		for i := range docs {
+			orphans = append(orphans, docs[i].ID)
+		}
+	}
+	if len(orphans) == 0 {
+		return 0, nil
+	}
+
+	if deleter, ok := s.docStore.(driven.DocumentBatchDeleter); ok {
+		if err := deleter.DeleteDocuments(ctx, orphans); err != nil {
+			return 0, err
+		}
+	} else {
+		for i, id := range orphans {
+			if err := s.docStore.DeleteDocument(ctx, id); err != nil {
+				return i, err
+			}
+		}
+	}
+
+	slog.Info("removed documents of removed sources", slog.Int("documents", len(orphans)))
+	return len(orphans), nil
+}
+
+// liveChunks returns the IDs of all chunks in the document store.
+func (s *MaintenanceService) liveChunks(ctx context.Context) (map[string]struct{}, error) {
+	live := make(map[string]struct{})
+	err := walkChunks(ctx, s.sourceStore, s.docStore, func(chunks []domain.Chunk) error {
+		for i := range chunks {
+			live[chunks[i].ID] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return live, nil
+}
+
+// pruneSearchIndex deletes chunks from the search index that are not in the
+// document store and returns how many were removed. Live is the set of
+// stored chunk IDs, or nil to skip pruning.
+func (s *MaintenanceService) pruneSearchIndex(ctx context.Context, live map[string]struct{}) (int, error) {
+	maintainer, ok := s.searchEngine.(driven.SearchIndexMaintainer)
+	if !ok || live == nil {
+		return 0, nil
+	}
+
+	ids, err := maintainer.ChunkIDs(ctx)
+	if errors.Is(err, domain.ErrNotImplemented) {
+		slog.Debug("search index pruning not supported")
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, id := range ids {
+		if _, ok := live[id]; ok {
+			continue
+		}
+		if err := s.searchEngine.Delete(ctx, id); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+
+	if removed > 0 {
+		slog.Info("removed orphaned chunks from the search index", slog.Int("chunks", removed))
+	}
+	return removed, nil
+}
+
+// pruneVectors deletes vectors for chunks that are not in the document store
+// and returns how many were removed. Live is the set of stored chunk IDs, or
+// nil to list them only if the index holds any vectors.
+func (s *MaintenanceService) pruneVectors(ctx context.Context, live map[string]struct{}) (int, error) {
+	maintainer, ok := s.vectorIndex.(driven.VectorIndexMaintainer)
+	if !ok || s.sourceStore == nil || s.docStore == nil {
+		return 0, nil
+	}
+
+	ids, err := maintainer.ChunkIDs(ctx)
+	if errors.Is(err, domain.ErrNotImplemented) {
+		slog.Debug("vector index pruning not supported")
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	if live == nil {
+		if live, err = s.liveChunks(ctx); err != nil {
+			return 0, fmt.Errorf("list stored chunks: %w", err)
+		}
+	}
+
+	removed := 0
+	for _, id := range ids {
+		if _, ok := live[id]; ok {
+			continue
+		}
+		if err := s.vectorIndex.Delete(ctx, id); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+
+	if removed > 0 {
+		slog.Info("removed orphaned vectors", slog.Int("vectors", removed))
+		if err := maintainer.Save(ctx); err != nil {
+			return removed, err
+		}
+	}
+	return removed, nil
+}
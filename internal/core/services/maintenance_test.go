@@ -0,0 +1,337 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockDatabaseVacuumer implements driven.DatabaseVacuumer for testing.
+type mockDatabaseVacuumer struct {
+	vacuumed bool
+	err      error
+}
+
+func (m *mockDatabaseVacuumer) Vacuum(_ context.Context) error {
+	m.vacuumed = true
+	return m.err
+}
+
+// mockDiskUsageMeter returns each reading in turn.
+type mockDiskUsageMeter struct {
+	readings []domain.DiskUsage
+}
+
+func (m *mockDiskUsageMeter) DiskUsage(_ context.Context) (domain.DiskUsage, error) {
+	usage := m.readings[0]
+	if len(m.readings) > 1 {
+		m.readings = m.readings[1:]
+	}
+	return usage, nil
+}
+
+// compactingSearchEngine is a search engine that supports compaction.
+type compactingSearchEngine struct {
+	*syncMockSearchEngine
+	compacted bool
+	err       error
+}
+
+func (e *compactingSearchEngine) Compact(_ context.Context) error {
+	if e.err != nil {
+		return e.err
+	}
+	e.compacted = true
+	return nil
+}
+
+// maintainedVectorIndex is a vector index that can list its chunk IDs.
+type maintainedVectorIndex struct {
+	*syncMockVectorIndex
+	saved int
+}
+
+func (v *maintainedVectorIndex) ChunkIDs(_ context.Context) ([]string, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	ids := make([]string, 0, len(v.vectors))
+	for id := range v.vectors {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (v *maintainedVectorIndex) Save(_ context.Context) error {
+	v.saved++
+	return nil
+}
+
+func TestMaintenanceService_Vacuum(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Type: "filesystem"}))
+	saveDocWithChunks(t, docStore, "src-1", "doc-a", "a-1", "a-2")
+
+	vectors := &maintainedVectorIndex{syncMockVectorIndex: newSyncMockVectorIndex()}
+	for _, id := range []string{"a-1", "a-2", "gone-1", "gone-2"} {
+		require.NoError(t, vectors.Add(ctx, id, []float32{1}))
+	}
+	search := &compactingSearchEngine{syncMockSearchEngine: newSyncMockSearchEngine()}
+	database := &mockDatabaseVacuumer{}
+	meter := &mockDiskUsageMeter{readings: []domain.DiskUsage{
+		{Database: 1000, SearchIndex: 500, VectorIndex: 200},
+		{Database: 600, SearchIndex: 300, VectorIndex: 100},
+	}}
+
+	svc := NewMaintenanceService(database, meter, sourceStore, docStore, search, vectors)
+	result, err := svc.Vacuum(ctx)
+
+	require.NoError(t, err)
+	assert.True(t, database.vacuumed)
+	assert.True(t, search.compacted)
+	assert.True(t, result.SearchIndexCompacted)
+	assert.Equal(t, 2, result.OrphanedVectors)
+	assert.Equal(t, 1, vectors.saved)
+	assert.Equal(t, int64(1700), result.Before.Total())
+	assert.Equal(t, int64(1000), result.After.Total())
+
+	remaining, err := vectors.ChunkIDs(ctx)
+	require.NoError(t, err)
+	sort.Strings(remaining)
+	assert.Equal(t, []string{"a-1", "a-2"}, remaining)
+}
+
+func TestMaintenanceService_Vacuum_UnsupportedIndexes(t *testing.T) {
+	ctx := context.Background()
+	search := &compactingSearchEngine{
+		syncMockSearchEngine: newSyncMockSearchEngine(),
+		err:                  domain.ErrNotImplemented,
+	}
+	database := &mockDatabaseVacuumer{}
+	meter := &mockDiskUsageMeter{readings: []domain.DiskUsage{{Database: 10}}}
+
+	// A plain vector index cannot be pruned and is left alone
+	vectors := newSyncMockVectorIndex()
+	require.NoError(t, vectors.Add(ctx, "orphan", []float32{1}))
+
+	svc := NewMaintenanceService(database, meter, memory.NewSourceStore(), memory.NewDocumentStore(), search, vectors)
+	result, err := svc.Vacuum(ctx)
+
+	require.NoError(t, err)
+	assert.True(t, database.vacuumed)
+	assert.False(t, result.SearchIndexCompacted)
+	assert.Zero(t, result.OrphanedVectors)
+	assert.Contains(t, vectors.vectors, "orphan")
+}
+
+func TestMaintenanceService_Vacuum_DatabaseError(t *testing.T) {
+	database := &mockDatabaseVacuumer{err: errors.New("database is in use")}
+	meter := &mockDiskUsageMeter{readings: []domain.DiskUsage{{}}}
+	svc := NewMaintenanceService(database, meter, nil, nil, nil, nil)
+
+	_, err := svc.Vacuum(context.Background())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "database is in use")
+}
+
+func TestMaintenanceService_Vacuum_NotConfigured(t *testing.T) {
+	svc := NewMaintenanceService(nil, nil, nil, nil, nil, nil)
+
+	_, err := svc.Vacuum(context.Background())
+
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
+
+// optimizingSearchEngine is a search engine that supports flushing and
+// compaction, recording the order they ran in.
+type optimizingSearchEngine struct {
+	*syncMockSearchEngine
+	calls    []string
+	flushErr error
+}
+
+func (e *optimizingSearchEngine) Flush(_ context.Context) error {
+	if e.flushErr != nil {
+		return e.flushErr
+	}
+	e.calls = append(e.calls, "flush")
+	return nil
+}
+
+func (e *optimizingSearchEngine) Compact(_ context.Context) error {
+	e.calls = append(e.calls, "compact")
+	return nil
+}
+
+// compactingVectorIndex is a vector index that supports compaction.
+type compactingVectorIndex struct {
+	*syncMockVectorIndex
+	compacted bool
+	err       error
+}
+
+func (v *compactingVectorIndex) Compact(_ context.Context) error {
+	if v.err != nil {
+		return v.err
+	}
+	v.compacted = true
+	return nil
+}
+
+func TestMaintenanceService_Optimize(t *testing.T) {
+	search := &optimizingSearchEngine{syncMockSearchEngine: newSyncMockSearchEngine()}
+	vectors := &compactingVectorIndex{syncMockVectorIndex: newSyncMockVectorIndex()}
+	database := &mockDatabaseVacuumer{}
+	meter := &mockDiskUsageMeter{readings: []domain.DiskUsage{
+		{Database: 1000, SearchIndex: 500, VectorIndex: 200},
+		{Database: 1000, SearchIndex: 300, VectorIndex: 150},
+	}}
+
+	svc := NewMaintenanceService(database, meter, nil, nil, search, vectors)
+	result, err := svc.Optimize(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"flush", "compact"}, search.calls)
+	assert.True(t, vectors.compacted)
+	assert.True(t, result.SearchIndexCompacted)
+	assert.True(t, result.VectorIndexCompacted)
+	assert.False(t, database.vacuumed, "optimize leaves the metadata database alone")
+	assert.Equal(t, int64(1700), result.Before.Total())
+	assert.Equal(t, int64(1450), result.After.Total())
+}
+
+func TestMaintenanceService_Optimize_UnsupportedIndexes(t *testing.T) {
+	search := &optimizingSearchEngine{
+		syncMockSearchEngine: newSyncMockSearchEngine(),
+		flushErr:             domain.ErrNotImplemented,
+	}
+	vectors := &compactingVectorIndex{syncMockVectorIndex: newSyncMockVectorIndex(), err: domain.ErrNotImplemented}
+	meter := &mockDiskUsageMeter{readings: []domain.DiskUsage{{SearchIndex: 10}}}
+
+	svc := NewMaintenanceService(nil, meter, nil, nil, search, vectors)
+	result, err := svc.Optimize(context.Background())
+
+	require.NoError(t, err)
+	assert.True(t, result.SearchIndexCompacted)
+	assert.False(t, result.VectorIndexCompacted)
+}
+
+func TestMaintenanceService_Optimize_VectorIndexError(t *testing.T) {
+	vectors := &compactingVectorIndex{syncMockVectorIndex: newSyncMockVectorIndex(), err: errors.New("disk full")}
+	meter := &mockDiskUsageMeter{readings: []domain.DiskUsage{{}}}
+	svc := NewMaintenanceService(nil, meter, nil, nil, nil, vectors)
+
+	_, err := svc.Optimize(context.Background())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "compact vector index: disk full")
+}
+
+func TestMaintenanceService_Optimize_NotConfigured(t *testing.T) {
+	svc := NewMaintenanceService(nil, nil, nil, nil, nil, nil)
+
+	_, err := svc.Optimize(context.Background())
+
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
+
+// listingSearchEngine is a compacting search engine that can list its
+// chunk IDs.
+type listingSearchEngine struct {
+	*compactingSearchEngine
+}
+
+func (e *listingSearchEngine) ChunkIDs(_ context.Context) ([]string, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	ids := make([]string, 0, len(e.indexed))
+	for id := range e.indexed {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func TestMaintenanceService_Compact_RemovedSourceDropsFromAllStores(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	search := &listingSearchEngine{&compactingSearchEngine{syncMockSearchEngine: newSyncMockSearchEngine()}}
+	vectors := &maintainedVectorIndex{syncMockVectorIndex: newSyncMockVectorIndex()}
+
+	chunks := map[string][]string{"src-1": {"a-1", "a-2"}, "src-2": {"b-1"}, "src-3": {"c-1", "c-2"}}
+	for sourceID, ids := range chunks {
+		require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: sourceID, Type: "filesystem"}))
+		saveDocWithChunks(t, docStore, sourceID, "doc-"+sourceID, ids...)
+		for _, id := range ids {
+			require.NoError(t, search.Index(ctx, domain.Chunk{ID: id, DocumentID: "doc-" + sourceID}))
+			require.NoError(t, vectors.Add(ctx, id, []float32{1}))
+		}
+	}
+
+	// Removing a source deletes its documents but leaves its chunks indexed
+	require.NoError(t, NewSourceService(sourceStore, nil, docStore).Remove(ctx, "src-2"))
+	// A source deleted without cleanup also leaves its documents behind
+	require.NoError(t, sourceStore.Delete(ctx, "src-3"))
+
+	database := &mockDatabaseVacuumer{}
+	meter := &mockDiskUsageMeter{readings: []domain.DiskUsage{
+		{Database: 1000, SearchIndex: 500, VectorIndex: 200},
+		{Database: 700, SearchIndex: 300, VectorIndex: 100},
+	}}
+	svc := NewMaintenanceService(database, meter, sourceStore, docStore, search, vectors)
+	result, err := svc.Compact(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.OrphanedDocuments)
+	assert.Equal(t, 3, result.OrphanedChunks)
+	assert.Equal(t, 3, result.OrphanedVectors)
+	assert.True(t, database.vacuumed)
+	assert.True(t, search.compacted)
+	assert.True(t, result.SearchIndexCompacted)
+	assert.False(t, result.VectorIndexCompacted)
+	assert.Equal(t, 1, vectors.saved)
+	assert.Equal(t, int64(1700), result.Before.Total())
+	assert.Equal(t, int64(1100), result.After.Total())
+
+	sourceIDs, err := docStore.DocumentSourceIDs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"src-1"}, sourceIDs)
+
+	indexed, err := search.ChunkIDs(ctx)
+	require.NoError(t, err)
+	sort.Strings(indexed)
+	assert.Equal(t, []string{"a-1", "a-2"}, indexed)
+
+	remaining, err := vectors.ChunkIDs(ctx)
+	require.NoError(t, err)
+	sort.Strings(remaining)
+	assert.Equal(t, []string{"a-1", "a-2"}, remaining)
+}
+
+func TestMaintenanceService_Compact_DatabaseError(t *testing.T) {
+	database := &mockDatabaseVacuumer{err: errors.New("database is in use")}
+	meter := &mockDiskUsageMeter{readings: []domain.DiskUsage{{}}}
+	svc := NewMaintenanceService(database, meter, nil, nil, nil, nil)
+
+	_, err := svc.Compact(context.Background())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "vacuum database: database is in use")
+}
+
+func TestMaintenanceService_Compact_NotConfigured(t *testing.T) {
+	svc := NewMaintenanceService(nil, nil, nil, nil, nil, nil)
+
+	_, err := svc.Compact(context.Background())
+
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
@@ -165,8 +165,9 @@ func (r *ProviderRegistry) GetOAuthEndpoints(provider domain.ProviderType) *driv
 	}
 
 	return &driving.OAuthEndpoints{
-		AuthURL:  defaults.AuthURL,
-		TokenURL: defaults.TokenURL,
-		Scopes:   defaults.Scopes,
+		AuthURL:   defaults.AuthURL,
+		TokenURL:  defaults.TokenURL,
+		DeviceURL: defaults.DeviceURL,
+		Scopes:    defaults.Scopes,
 	}
 }
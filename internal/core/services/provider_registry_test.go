@@ -73,8 +73,8 @@ func TestProviderRegistry_GetProviders(t *testing.T) {
 
 	providers := registry.GetProviders()
 
-	// Should have local, google, github, microsoft, dropbox, notion (6 providers)
-	assert.Len(t, providers, 6)
+	// Should have local, google, github, microsoft, dropbox, notion, trello, slack, youtube (9 providers)
+	assert.Len(t, providers, 9)
 
 	// Verify all expected providers are present
 	providerSet := make(map[domain.ProviderType]bool)
@@ -87,6 +87,9 @@ func TestProviderRegistry_GetProviders(t *testing.T) {
 	assert.True(t, providerSet[domain.ProviderMicrosoft])
 	assert.True(t, providerSet[domain.ProviderDropbox])
 	assert.True(t, providerSet[domain.ProviderNotion])
+	assert.True(t, providerSet[domain.ProviderTrello])
+	assert.True(t, providerSet[domain.ProviderSlack])
+	assert.True(t, providerSet[domain.ProviderYouTube])
 }
 
 func TestProviderRegistry_GetConnectorsForProvider_Local(t *testing.T) {
@@ -259,6 +262,9 @@ func TestProviderRegistry_GetAuthCapability(t *testing.T) {
 		{domain.ProviderGoogle, false, true, true},
 		{domain.ProviderGitHub, true, true, true}, // GitHub supports both!
 		{domain.ProviderMicrosoft, false, true, true},
+		{domain.ProviderTrello, true, false, true},
+		{domain.ProviderSlack, false, true, true},
+		{domain.ProviderYouTube, true, false, true},
 		{domain.ProviderType("unknown"), false, false, false},
 	}
 
@@ -326,7 +332,7 @@ func TestProviderRegistry_HasMultipleConnectors(t *testing.T) {
 		provider domain.ProviderType
 		expected bool
 	}{
-		{domain.ProviderLocal, false},
+		{domain.ProviderLocal, true},     // Filesystem, Web
 		{domain.ProviderGoogle, true},    // Drive, Gmail, Calendar
 		{domain.ProviderGitHub, false},   // Single connector
 		{domain.ProviderMicrosoft, true}, // Outlook, OneDrive, Calendar
@@ -357,9 +363,10 @@ func TestProviderRegistry_GetOAuthEndpoints(t *testing.T) {
 	mockFactory := &mockConnectorFactoryForProvider{
 		oauthDefaults: map[string]*driven.OAuthDefaults{
 			"github": {
-				AuthURL:  "https://github.com/login/oauth/authorize",
-				TokenURL: "https://github.com/login/oauth/access_token",
-				Scopes:   []string{"repo", "read:user"},
+				AuthURL:   "https://github.com/login/oauth/authorize",
+				TokenURL:  "https://github.com/login/oauth/access_token",
+				Scopes:    []string{"repo", "read:user"},
+				DeviceURL: "https://github.com/login/device/code",
 			},
 			// Google connectors
 			"google-drive":    googleDefaults,
@@ -384,6 +391,7 @@ func TestProviderRegistry_GetOAuthEndpoints(t *testing.T) {
 		require.NotNil(t, endpoints)
 		assert.Equal(t, "https://github.com/login/oauth/authorize", endpoints.AuthURL)
 		assert.Equal(t, "https://github.com/login/oauth/access_token", endpoints.TokenURL)
+		assert.Equal(t, "https://github.com/login/device/code", endpoints.DeviceURL)
 		assert.Contains(t, endpoints.Scopes, "repo")
 	})
 
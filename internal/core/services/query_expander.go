@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// queryExpansionPrompt asks for alternative phrasings of a query, one per line.
+const queryExpansionPrompt = `Generate %d alternative phrasings for this search query.
+Use synonyms and spell out abbreviations. Return ONLY the phrasings, one per line.
+
+Query: %s
+Phrasings:`
+
+// listMarker matches a bullet or number starting a line of an LLM reply.
+var listMarker = regexp.MustCompile(`^(?:[-*•]|\d+[.)])\s*`)
+
+// DefaultQueryVariants is the number of alternative phrasings requested per query.
+const DefaultQueryVariants = 3
+
+// QueryExpander rewrites search queries into alternative phrasings with an
+// LLM, so that semantic search also finds documents worded differently,
+// e.g. "authentication failure" for "auth bug".
+type QueryExpander struct {
+	llm      driven.LLMService
+	variants int
+}
+
+// NewQueryExpander creates a query expander asking llm for DefaultQueryVariants
+// phrasings of each query.
+func NewQueryExpander(llm driven.LLMService) *QueryExpander {
+	return &QueryExpander{llm: llm, variants: DefaultQueryVariants}
+}
+
+// Expand returns alternative phrasings of query, excluding the query itself.
+// Returns an error if the LLM fails or suggests none.
+func (e *QueryExpander) Expand(ctx context.Context, query string) ([]string, error) {
+	reply, err := e.llm.Generate(ctx, fmt.Sprintf(queryExpansionPrompt, e.variants, query), driven.GenerateOptions{
+		MaxTokens:   200,
+		Temperature: 0.3,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("expand query: %w", err)
+	}
+
+	variants := parseQueryVariants(reply, query, e.variants)
+	if len(variants) == 0 {
+		return nil, errors.New("expand query: no alternative phrasings in reply")
+	}
+	return variants, nil
+}
+
+// parseQueryVariants extracts up to limit phrasings from an LLM reply with
+// one per line, removing list markers and quotes, repeats and the query.
+func parseQueryVariants(reply, query string, limit int) []string {
+	seen := []string{strings.ToLower(strings.TrimSpace(query))}
+	var variants []string
+	for _, line := range strings.Split(reply, "\n") {
+		variant := listMarker.ReplaceAllString(strings.TrimSpace(line), "")
+		variant = strings.TrimSpace(strings.Trim(variant, `"'`))
+		key := strings.ToLower(variant)
+		if variant == "" || slices.Contains(seen, key) {
+			continue
+		}
+		seen = append(seen, key)
+		variants = append(variants, variant)
+		if len(variants) == limit {
+			break
+		}
+	}
+	return variants
+}
+
+// centroid returns the mean of vectors, which must share a dimension.
+func centroid(vectors [][]float32) []float32 {
+	mean := make([]float32, len(vectors[0]))
+	for _, vector := range vectors {
+		for i, v := range vector {
+			mean[i] += v
+		}
+	}
+	for i := range mean {
+		mean[i] /= float32(len(vectors))
+	}
+	return mean
+}
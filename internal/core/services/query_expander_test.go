@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryVariants(t *testing.T) {
+	reply := `1. Authentication failure
+2) "login issue"
+- 2FA sign-in error
+* auth bug
+
+login issue
+* password reset problem`
+
+	variants := parseQueryVariants(reply, "Auth bug", 3)
+
+	assert.Equal(t, []string{"Authentication failure", "login issue", "2FA sign-in error"}, variants)
+}
+
+func TestQueryExpander_Expand(t *testing.T) {
+	llm := &mockLLMService{generateResult: "authentication failure\nlogin issue"}
+
+	variants, err := NewQueryExpander(llm).Expand(context.Background(), "auth bug")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"authentication failure", "login issue"}, variants)
+}
+
+func TestQueryExpander_Expand_Errors(t *testing.T) {
+	_, err := NewQueryExpander(&mockLLMService{generateErr: errors.New("timeout")}).Expand(context.Background(), "q")
+	assert.Error(t, err)
+
+	_, err = NewQueryExpander(&mockLLMService{generateResult: " q \n\n"}).Expand(context.Background(), "q")
+	assert.Error(t, err, "a reply repeating the query has no phrasings")
+}
+
+func TestCentroid(t *testing.T) {
+	assert.Equal(t, []float32{2, 0.5}, centroid([][]float32{{1, 0}, {3, 1}}))
+}
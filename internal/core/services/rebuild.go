@@ -0,0 +1,505 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// Ensure RebuildService implements the interface.
+var _ driving.RebuildService = (*RebuildService)(nil)
+
+// RebuildService re-indexes stored documents.
+//
+// Raw connector content is not stored, so by default a rebuild re-chunks each
+// document's stored normalised content, re-embeds it and replaces its entries
+// in the search and vector indexes. Picking up normaliser changes requires
+// re-fetching: each source's sync cursor is cleared and the source is synced
+// again from its connector.
+//
+// Sources and documents are processed in ID order and a watermark is saved
+// after each, so a cancelled rebuild resumes where it stopped.
+type RebuildService struct {
+	sourceStore      driven.SourceStore
+	syncStore        driven.SyncStateStore
+	docStore         driven.DocumentStore
+	stateStore       driven.RebuildStateStore
+	pipeline         driven.PostProcessorPipeline
+	pipelines        driven.PipelineProvider
+	searchIndex      driven.SearchEngine
+	vectorIndex      driven.VectorIndex
+	embeddingService driven.EmbeddingService
+	syncOrchestrator driving.SyncOrchestrator
+	embedMaxTokens   int
+	embeddingModels  embeddingModels
+	now              func() time.Time
+}
+
+// NewRebuildService creates a new rebuild service.
+// The vectorIndex and embeddingService are optional - if nil, vectors are not
+// rebuilt. The syncOrchestrator is only needed to re-fetch documents.
+func NewRebuildService(
+	sourceStore driven.SourceStore,
+	syncStore driven.SyncStateStore,
+	docStore driven.DocumentStore,
+	stateStore driven.RebuildStateStore,
+	pipeline driven.PostProcessorPipeline,
+	searchIndex driven.SearchEngine,
+	vectorIndex driven.VectorIndex,
+	embeddingService driven.EmbeddingService,
+	syncOrchestrator driving.SyncOrchestrator,
+) *RebuildService {
+	return &RebuildService{
+		sourceStore:      sourceStore,
+		syncStore:        syncStore,
+		docStore:         docStore,
+		stateStore:       stateStore,
+		pipeline:         pipeline,
+		searchIndex:      searchIndex,
+		vectorIndex:      vectorIndex,
+		embeddingService: embeddingService,
+		syncOrchestrator: syncOrchestrator,
+		now:              time.Now,
+	}
+}
+
+// SetEmbeddingMaxTokens sets the most tokens the embedding model accepts in
+// one input, matching the limit used by sync. Values below 1 disable
+// splitting chunks that exceed it.
+func (s *RebuildService) SetEmbeddingMaxTokens(maxTokens int) {
+	s.embedMaxTokens = maxTokens
+}
+
+// SetEmbeddingModels sets the embedding model overrides and the embedding
+// service of each override model, matching the models used by sync.
+func (s *RebuildService) SetEmbeddingModels(
+	overrides map[string]string, services map[string]driven.EmbeddingService,
+) {
+	s.embeddingModels = embeddingModels{overrides: overrides, services: services}
+}
+
+// SetPipelineProvider sets the provider that selects each source's
+// post-processor pipeline, matching the pipelines used by sync.
+// If unset, every source uses the pipeline passed to NewRebuildService.
+func (s *RebuildService) SetPipelineProvider(provider driven.PipelineProvider) {
+	s.pipelines = provider
+}
+
+// Rebuild re-processes and re-indexes documents.
+func (s *RebuildService) Rebuild(
+	ctx context.Context, opts domain.RebuildOptions, progress func(domain.RebuildProgress),
+) (*domain.RebuildResult, error) {
+	if s.sourceStore == nil || s.docStore == nil || s.stateStore == nil {
+		return nil, domain.ErrNotImplemented
+	}
+	if opts.Refetch && (s.syncOrchestrator == nil || s.syncStore == nil) {
+		return nil, fmt.Errorf("%w: re-fetching requires sync", domain.ErrNotImplemented)
+	}
+	if !opts.Refetch && !opts.VectorsOnly && (s.pipeline == nil || s.searchIndex == nil) {
+		return nil, domain.ErrNotImplemented
+	}
+	if err := s.checkTargets(opts); err != nil {
+		return nil, err
+	}
+	if err := s.checkModel(opts); err != nil {
+		return nil, err
+	}
+
+	sources, err := s.sources(ctx, opts.SourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	watermark, err := s.watermark(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.RebuildResult{Resumed: watermark != nil}
+	report := func() {
+		if progress != nil {
+			progress(result.RebuildProgress)
+		}
+	}
+
+	for i := range sources {
+		source := &sources[i]
+		if watermark != nil && sourceDone(watermark, source.ID) {
+			continue
+		}
+		result.SourceID = source.ID
+
+		// Only the source the watermark is in was partly rebuilt
+		after := ""
+		if watermark != nil && watermark.SourceID == source.ID {
+			after = watermark.DocumentID
+		}
+
+		if opts.Refetch {
+			err = s.refetchSource(ctx, source.ID)
+		} else {
+			err = s.rebuildSource(ctx, opts, source, after, result, report)
+		}
+		if err != nil {
+			return result, err
+		}
+
+		if err := s.saveWatermark(ctx, opts, source.ID, ""); err != nil {
+			return result, err
+		}
+		result.Sources++
+		report()
+	}
+
+	// The rebuild is complete; the next one starts from the beginning
+	if err := s.stateStore.Delete(ctx, opts.Scope()); err != nil {
+		return result, fmt.Errorf("clear rebuild state: %w", err)
+	}
+	return result, nil
+}
+
+// checkTargets checks that a rebuild limited to the keyword or vector index
+// can run. Both work from stored documents, so neither can re-fetch.
+func (s *RebuildService) checkTargets(opts domain.RebuildOptions) error {
+	switch {
+	case !opts.KeywordOnly && !opts.VectorsOnly:
+		return nil
+	case opts.KeywordOnly && opts.VectorsOnly:
+		return fmt.Errorf("%w: keyword-only and vectors-only rebuilds cannot be combined", domain.ErrInvalidInput)
+	case opts.Refetch:
+		return fmt.Errorf("%w: a rebuild limited to one index cannot re-fetch", domain.ErrInvalidInput)
+	case opts.KeywordOnly && opts.Model != "":
+		return fmt.Errorf("%w: a keyword-only rebuild cannot be limited to an embedding model", domain.ErrInvalidInput)
+	case opts.VectorsOnly && (s.vectorIndex == nil || s.embeddingService == nil):
+		return fmt.Errorf("%w: rebuilding vectors requires an embedding service", domain.ErrNotImplemented)
+	}
+	return nil
+}
+
+// checkModel checks that a rebuild limited to an embedding model can run:
+// documents are re-embedded, so it cannot re-fetch, and the model must be
+// one documents are embedded by.
+func (s *RebuildService) checkModel(opts domain.RebuildOptions) error {
+	if opts.Model == "" {
+		return nil
+	}
+	if opts.Refetch {
+		return fmt.Errorf("%w: a rebuild limited to an embedding model cannot re-fetch", domain.ErrInvalidInput)
+	}
+	if s.vectorIndex == nil || s.embeddingService == nil {
+		return fmt.Errorf("%w: rebuilding by embedding model requires an embedding service", domain.ErrNotImplemented)
+	}
+	models := s.embeddingModels.modelNames(s.embeddingService)
+	if !slices.Contains(models, opts.Model) {
+		return fmt.Errorf("%w: embedding model %q is not in use (models: %s)",
+			domain.ErrInvalidInput, opts.Model, strings.Join(models, ", "))
+	}
+	return nil
+}
+
+// sources returns the sources to rebuild in ID order.
+func (s *RebuildService) sources(ctx context.Context, sourceID string) ([]domain.Source, error) {
+	if sourceID != "" {
+		source, err := s.sourceStore.Get(ctx, sourceID)
+		if err != nil {
+			return nil, fmt.Errorf("get source: %w", err)
+		}
+		return []domain.Source{*source}, nil
+	}
+
+	sources, err := s.sourceStore.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sources: %w", err)
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i].ID < sources[j].ID })
+	return sources, nil
+}
+
+// watermark returns the saved progress of an interrupted rebuild with the
+// same options, or nil to start from the beginning.
+func (s *RebuildService) watermark(ctx context.Context, opts domain.RebuildOptions) (*domain.RebuildState, error) {
+	state, err := s.stateStore.Get(ctx, opts.Scope())
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get rebuild state: %w", err)
+	}
+	if state.Refetch != opts.Refetch {
+		slog.Info("discarding interrupted rebuild that used different options")
+		return nil, nil
+	}
+	slog.Info("resuming rebuild",
+		slog.String("source_id", state.SourceID),
+		slog.String("document_id", state.DocumentID))
+	return state, nil
+}
+
+// sourceDone reports whether the watermark is past the whole source.
+func sourceDone(watermark *domain.RebuildState, sourceID string) bool {
+	if sourceID == watermark.SourceID {
+		return watermark.DocumentID == ""
+	}
+	return sourceID < watermark.SourceID
+}
+
+// saveWatermark records that everything up to documentID of sourceID is done.
+// An empty documentID marks the whole source as done.
+func (s *RebuildService) saveWatermark(
+	ctx context.Context, opts domain.RebuildOptions, sourceID, documentID string,
+) error {
+	err := s.stateStore.Save(ctx, domain.RebuildState{
+		Scope:      opts.Scope(),
+		SourceID:   sourceID,
+		DocumentID: documentID,
+		Refetch:    opts.Refetch,
+		UpdatedAt:  s.now(),
+	})
+	if err != nil {
+		return fmt.Errorf("save rebuild state: %w", err)
+	}
+	return nil
+}
+
+// refetchSource re-syncs a source from scratch so every document is fetched
+// and normalised again.
+func (s *RebuildService) refetchSource(ctx context.Context, sourceID string) error {
+	if err := s.syncStore.Delete(ctx, sourceID); err != nil {
+		return fmt.Errorf("reset sync state: %w", err)
+	}
+	if err := s.syncOrchestrator.Sync(ctx, sourceID); err != nil {
+		return fmt.Errorf("sync source %s: %w", sourceID, err)
+	}
+	return nil
+}
+
+// rebuildSource re-indexes the stored documents of a source whose IDs sort
+// after the given ID.
+func (s *RebuildService) rebuildSource(
+	ctx context.Context,
+	opts domain.RebuildOptions,
+	source *domain.Source,
+	after string,
+	result *domain.RebuildResult,
+	report func(),
+) error {
+	sourceID := source.ID
+	pipeline := s.pipeline
+	if s.pipelines != nil {
+		var err error
+		if pipeline, err = s.pipelines.PipelineFor(source); err != nil {
+			return fmt.Errorf("select pipeline: %w", err)
+		}
+	}
+
+	docs, err := s.docStore.ListDocuments(ctx, sourceID)
+	if err != nil {
+		return fmt.Errorf("list documents: %w", err)
+	}
+	sort.Slice(docs, func(i, j int) bool { return docs[i].ID < docs[j].ID })
+
+	for i := range docs {
+		if docs[i].ID <= after {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		embedder := s.embedderFor(source, &docs[i])
+		if opts.Model != "" && embedder.ModelName() != opts.Model {
+			continue
+		}
+
+		var chunks int
+		switch {
+		case opts.VectorsOnly:
+			chunks, err = s.reembedDocument(ctx, embedder, &docs[i])
+		case opts.KeywordOnly:
+			chunks, err = s.rebuildDocument(ctx, pipeline, nil, &docs[i])
+		default:
+			chunks, err = s.rebuildDocument(ctx, pipeline, embedder, &docs[i])
+		}
+		switch {
+		case ctx.Err() != nil:
+			// Interrupted mid-document; it is redone on resume
+			return ctx.Err()
+		case err != nil:
+			slog.Warn("failed to rebuild document",
+				slog.String("source_id", docs[i].SourceID),
+				slog.String("uri", docs[i].URI),
+				slog.Any("error", err))
+			result.Failed++
+		default:
+			result.Documents++
+			result.Chunks += chunks
+		}
+
+		if err := s.saveWatermark(ctx, opts, sourceID, docs[i].ID); err != nil {
+			return err
+		}
+		report()
+	}
+	return nil
+}
+
+// DeleteReassignedVectors deletes the vectors of documents whose embedding
+// model differs between oldOverrides and newOverrides.
+func (s *RebuildService) DeleteReassignedVectors(
+	ctx context.Context, oldOverrides, newOverrides map[string]string,
+) (int, error) {
+	if s.sourceStore == nil || s.docStore == nil || s.vectorIndex == nil {
+		return 0, nil
+	}
+
+	sources, err := s.sources(ctx, "")
+	if err != nil {
+		return 0, err
+	}
+
+	var reassigned int
+	for i := range sources {
+		docs, err := s.docStore.ListDocuments(ctx, sources[i].ID)
+		if err != nil {
+			return reassigned, fmt.Errorf("list documents: %w", err)
+		}
+		for j := range docs {
+			// An empty model is the default model
+			mimeType := documentMIMEType(&docs[j])
+			oldModel, _ := domain.EmbeddingModelOverride(oldOverrides, sources[i].Type, mimeType)
+			newModel, _ := domain.EmbeddingModelOverride(newOverrides, sources[i].Type, mimeType)
+			if oldModel == newModel {
+				continue
+			}
+
+			chunks, err := s.docStore.GetChunks(ctx, docs[j].ID)
+			if err != nil {
+				return reassigned, fmt.Errorf("get chunks: %w", err)
+			}
+			for k := range chunks {
+				if err := s.vectorIndex.Delete(ctx, chunks[k].ID); err != nil {
+					slog.Debug("failed to delete vector", slog.String("chunk_id", chunks[k].ID), slog.Any("error", err))
+				}
+			}
+			reassigned++
+		}
+	}
+	return reassigned, nil
+}
+
+// embedderFor returns the embedding service selected for a document of a
+// source, or nil if vectors are not rebuilt.
+func (s *RebuildService) embedderFor(source *domain.Source, doc *domain.Document) driven.EmbeddingService {
+	if s.vectorIndex == nil || s.embeddingService == nil {
+		return nil
+	}
+	return s.embeddingModels.serviceFor(s.embeddingService, source.Type, documentMIMEType(doc))
+}
+
+// rebuildDocument re-chunks a stored document, embedding its chunks with
+// embedder unless it is nil, and replaces its index entries.
+// Returns the number of chunks indexed.
+func (s *RebuildService) rebuildDocument(
+	ctx context.Context, pipeline driven.PostProcessorPipeline, embedder driven.EmbeddingService,
+	doc *domain.Document,
+) (int, error) {
+	oldChunks, err := s.docStore.GetChunks(ctx, doc.ID)
+	if err != nil {
+		return 0, fmt.Errorf("get chunks: %w", err)
+	}
+
+	chunks, err := pipeline.Process(ctx, doc)
+	if err != nil {
+		return 0, fmt.Errorf("post-process: %w", err)
+	}
+
+	embed := embedder != nil
+	if embed {
+		chunks = splitForEmbedding(chunks, s.embedMaxTokens)
+		for i := range chunks {
+			embedding, err := embedder.Embed(ctx, embeddingInput(chunks[i]))
+			if err != nil {
+				return 0, fmt.Errorf("embed chunk: %w", err)
+			}
+			chunks[i].Embedding = embedding
+		}
+	}
+
+	// Remove index entries for chunks the document no longer has
+	kept := make(map[string]string, len(chunks))
+	for i := range chunks {
+		kept[chunks[i].ID] = chunks[i].Content
+	}
+	for i := range oldChunks {
+		content, ok := kept[oldChunks[i].ID]
+		if !ok {
+			if err := s.searchIndex.Delete(ctx, oldChunks[i].ID); err != nil {
+				slog.Debug("failed to delete chunk from search index",
+					slog.String("chunk_id", oldChunks[i].ID), slog.Any("error", err))
+			}
+		}
+		// Without re-embedding, a changed chunk's vector no longer matches it
+		if s.vectorIndex != nil && (!ok || (!embed && content != oldChunks[i].Content)) {
+			if err := s.vectorIndex.Delete(ctx, oldChunks[i].ID); err != nil {
+				slog.Debug("failed to delete vector", slog.String("chunk_id", oldChunks[i].ID), slog.Any("error", err))
+			}
+		}
+	}
+
+	if err := s.docStore.SaveChunks(ctx, chunks); err != nil {
+		return 0, fmt.Errorf("save chunks: %w", err)
+	}
+	for i := range chunks {
+		if err := s.searchIndex.Index(ctx, chunks[i]); err != nil {
+			return 0, fmt.Errorf("index chunk: %w", err)
+		}
+		if embed && chunks[i].Embedding != nil {
+			err := addVector(ctx, s.vectorIndex, s.embeddingService, embedder, chunks[i].ID, chunks[i].Embedding)
+			if err != nil {
+				return 0, fmt.Errorf("add vector: %w", err)
+			}
+		}
+	}
+
+	return len(chunks), nil
+}
+
+// reembedDocument embeds a document's stored chunks with embedder and
+// replaces their vectors, leaving the chunks and search index as they are.
+// Returns the number of chunks embedded.
+func (s *RebuildService) reembedDocument(
+	ctx context.Context, embedder driven.EmbeddingService, doc *domain.Document,
+) (int, error) {
+	chunks, err := s.docStore.GetChunks(ctx, doc.ID)
+	if err != nil {
+		return 0, fmt.Errorf("get chunks: %w", err)
+	}
+
+	for i := range chunks {
+		embedding, err := embedder.Embed(ctx, embeddingInput(chunks[i]))
+		if err != nil {
+			return 0, fmt.Errorf("embed chunk: %w", err)
+		}
+		chunks[i].Embedding = embedding
+	}
+
+	if err := s.docStore.SaveChunks(ctx, chunks); err != nil {
+		return 0, fmt.Errorf("save chunks: %w", err)
+	}
+	for i := range chunks {
+		err := addVector(ctx, s.vectorIndex, s.embeddingService, embedder, chunks[i].ID, chunks[i].Embedding)
+		if err != nil {
+			return 0, fmt.Errorf("add vector: %w", err)
+		}
+	}
+	return len(chunks), nil
+}
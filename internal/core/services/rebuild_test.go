@@ -0,0 +1,404 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// rebuildPipeline splits documents into one chunk per line.
+// onProcess, if set, runs before each document is processed.
+type rebuildPipeline struct {
+	processed []string
+	onProcess func(doc *domain.Document) error
+}
+
+func (p *rebuildPipeline) Process(_ context.Context, doc *domain.Document) ([]domain.Chunk, error) {
+	p.processed = append(p.processed, doc.ID)
+	if p.onProcess != nil {
+		if err := p.onProcess(doc); err != nil {
+			return nil, err
+		}
+	}
+	lines := strings.Split(doc.Content, "\n")
+	chunks := make([]domain.Chunk, len(lines))
+	for i, line := range lines {
+		chunks[i] = domain.Chunk{ID: fmt.Sprintf("%s-%d", doc.ID, i), DocumentID: doc.ID, Content: line, Position: i}
+	}
+	return chunks, nil
+}
+
+type rebuildFixture struct {
+	service    *RebuildService
+	sources    *memory.SourceStore
+	syncStore  *memory.SyncStateStore
+	docStore   *memory.DocumentStore
+	stateStore *memory.RebuildStateStore
+	pipeline   *rebuildPipeline
+	search     *syncMockSearchEngine
+	vectors    *syncMockVectorIndex
+	syncer     *mockSyncOrchestrator
+}
+
+func newRebuildFixture(t *testing.T) *rebuildFixture {
+	t.Helper()
+	f := &rebuildFixture{
+		sources:    memory.NewSourceStore(),
+		syncStore:  memory.NewSyncStateStore(),
+		docStore:   memory.NewDocumentStore(),
+		stateStore: memory.NewRebuildStateStore(),
+		pipeline:   &rebuildPipeline{},
+		search:     newSyncMockSearchEngine(),
+		vectors:    newSyncMockVectorIndex(),
+		syncer:     &mockSyncOrchestrator{},
+	}
+	f.service = NewRebuildService(
+		f.sources, f.syncStore, f.docStore, f.stateStore, f.pipeline,
+		f.search, f.vectors, &syncMockEmbeddingService{}, f.syncer,
+	)
+
+	ctx := context.Background()
+	for _, sourceID := range []string{"src-b", "src-a"} {
+		require.NoError(t, f.sources.Save(ctx, domain.Source{ID: sourceID, Type: "filesystem"}))
+		for i := 1; i <= 2; i++ {
+			docID := fmt.Sprintf("%s-doc%d", sourceID, i)
+			require.NoError(t, f.docStore.SaveDocument(ctx, &domain.Document{
+				ID: docID, SourceID: sourceID, URI: docID, Content: "line one\nline two",
+			}))
+		}
+	}
+	return f
+}
+
+func TestRebuildService_Rebuild(t *testing.T) {
+	ctx := context.Background()
+	f := newRebuildFixture(t)
+
+	// A stale chunk from before the rebuild is removed from the indexes
+	saveDocWithChunks(t, f.docStore, "src-a", "src-a-doc1", "src-a-doc1-0", "src-a-doc1-stale")
+	require.NoError(t, f.search.Index(ctx, domain.Chunk{ID: "src-a-doc1-stale"}))
+	require.NoError(t, f.vectors.Add(ctx, "src-a-doc1-stale", []float32{1}))
+	require.NoError(t, f.docStore.SaveDocument(ctx, &domain.Document{
+		ID: "src-a-doc1", SourceID: "src-a", URI: "src-a-doc1", Content: "line one\nline two",
+	}))
+
+	var reports []domain.RebuildProgress
+	result, err := f.service.Rebuild(ctx, domain.RebuildOptions{}, func(p domain.RebuildProgress) {
+		reports = append(reports, p)
+	})
+
+	require.NoError(t, err)
+	assert.False(t, result.Resumed)
+	assert.Equal(t, 2, result.Sources)
+	assert.Equal(t, 4, result.Documents)
+	assert.Equal(t, 8, result.Chunks)
+	assert.Zero(t, result.Failed)
+	assert.Equal(t, []string{"src-a-doc1", "src-a-doc2", "src-b-doc1", "src-b-doc2"}, f.pipeline.processed)
+
+	assert.Len(t, f.search.indexed, 8)
+	assert.NotContains(t, f.search.indexed, "src-a-doc1-stale")
+	assert.Len(t, f.vectors.vectors, 8)
+	assert.NotContains(t, f.vectors.vectors, "src-a-doc1-stale")
+
+	require.NotEmpty(t, reports)
+	assert.Equal(t, result.RebuildProgress, reports[len(reports)-1])
+
+	_, err = f.stateStore.Get(ctx, "")
+	assert.ErrorIs(t, err, domain.ErrNotFound, "completed rebuilds leave no watermark")
+}
+
+// rebuildPipelineProvider gives one source its own pipeline.
+type rebuildPipelineProvider struct {
+	overrideSourceID string
+	global, override *rebuildPipeline
+}
+
+func (p *rebuildPipelineProvider) PipelineFor(source *domain.Source) (driven.PostProcessorPipeline, error) {
+	if source.ID == p.overrideSourceID {
+		return p.override, nil
+	}
+	return p.global, nil
+}
+
+func TestRebuildService_Rebuild_UsesSourcePipelineOverride(t *testing.T) {
+	f := newRebuildFixture(t)
+	override := &rebuildPipeline{}
+	f.service.SetPipelineProvider(&rebuildPipelineProvider{
+		overrideSourceID: "src-b", global: f.pipeline, override: override,
+	})
+
+	_, err := f.service.Rebuild(context.Background(), domain.RebuildOptions{}, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"src-a-doc1", "src-a-doc2"}, f.pipeline.processed)
+	assert.Equal(t, []string{"src-b-doc1", "src-b-doc2"}, override.processed)
+}
+
+func TestRebuildService_Rebuild_ResumesAfterCancellation(t *testing.T) {
+	f := newRebuildFixture(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	f.pipeline.onProcess = func(doc *domain.Document) error {
+		if doc.ID == "src-b-doc1" {
+			cancel()
+		}
+		return nil
+	}
+
+	result, err := f.service.Rebuild(ctx, domain.RebuildOptions{}, nil)
+
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 2, result.Documents)
+	state, err := f.stateStore.Get(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, "src-a", state.SourceID)
+	assert.Empty(t, state.DocumentID, "src-a was completed")
+
+	// The interrupted document is rebuilt again on resume
+	f.pipeline.onProcess = nil
+	f.pipeline.processed = nil
+	result, err = f.service.Rebuild(context.Background(), domain.RebuildOptions{}, nil)
+
+	require.NoError(t, err)
+	assert.True(t, result.Resumed)
+	assert.Equal(t, []string{"src-b-doc1", "src-b-doc2"}, f.pipeline.processed)
+	assert.Equal(t, 1, result.Sources)
+}
+
+func TestRebuildService_Rebuild_ResumesWithinSource(t *testing.T) {
+	ctx := context.Background()
+	f := newRebuildFixture(t)
+	require.NoError(t, f.stateStore.Save(ctx, domain.RebuildState{
+		Scope: "src-b", SourceID: "src-b", DocumentID: "src-b-doc1", UpdatedAt: time.Now(),
+	}))
+
+	result, err := f.service.Rebuild(ctx, domain.RebuildOptions{SourceID: "src-b"}, nil)
+
+	require.NoError(t, err)
+	assert.True(t, result.Resumed)
+	assert.Equal(t, []string{"src-b-doc2"}, f.pipeline.processed)
+}
+
+func TestRebuildService_Rebuild_IgnoresWatermarkFromOtherMode(t *testing.T) {
+	ctx := context.Background()
+	f := newRebuildFixture(t)
+	require.NoError(t, f.stateStore.Save(ctx, domain.RebuildState{
+		Scope: "", SourceID: "src-a", Refetch: true, UpdatedAt: time.Now(),
+	}))
+
+	result, err := f.service.Rebuild(ctx, domain.RebuildOptions{}, nil)
+
+	require.NoError(t, err)
+	assert.False(t, result.Resumed)
+	assert.Len(t, f.pipeline.processed, 4)
+}
+
+func TestRebuildService_Rebuild_CountsFailedDocuments(t *testing.T) {
+	f := newRebuildFixture(t)
+	f.pipeline.onProcess = func(doc *domain.Document) error {
+		if doc.ID == "src-a-doc2" {
+			return errors.New("chunker failed")
+		}
+		return nil
+	}
+
+	result, err := f.service.Rebuild(context.Background(), domain.RebuildOptions{SourceID: "src-a"}, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Documents)
+	assert.Equal(t, 1, result.Failed)
+}
+
+func TestRebuildService_Rebuild_Refetch(t *testing.T) {
+	ctx := context.Background()
+	f := newRebuildFixture(t)
+	require.NoError(t, f.syncStore.Save(ctx, domain.SyncState{SourceID: "src-a", Cursor: "cursor-1"}))
+
+	result, err := f.service.Rebuild(ctx, domain.RebuildOptions{Refetch: true}, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Sources)
+	assert.Equal(t, []string{"src-a", "src-b"}, f.syncer.synced)
+	assert.Empty(t, f.pipeline.processed, "re-fetched documents are processed by the sync")
+	_, err = f.syncStore.Get(ctx, "src-a")
+	assert.ErrorIs(t, err, domain.ErrNotFound, "cursor is reset so the sync starts over")
+}
+
+func TestRebuildService_Rebuild_RefetchFailureKeepsWatermark(t *testing.T) {
+	ctx := context.Background()
+	f := newRebuildFixture(t)
+	f.syncer.syncErr = errors.New("rate limited")
+
+	_, err := f.service.Rebuild(ctx, domain.RebuildOptions{Refetch: true}, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rate limited")
+	_, err = f.stateStore.Get(ctx, "")
+	assert.ErrorIs(t, err, domain.ErrNotFound, "no source completed")
+}
+
+func TestRebuildService_Rebuild_Model(t *testing.T) {
+	ctx := context.Background()
+	f := newRebuildFixture(t)
+	require.NoError(t, f.docStore.SaveDocument(ctx, &domain.Document{
+		ID: "src-a-doc1", SourceID: "src-a", URI: "src-a-doc1", Content: "line one\nline two",
+		Metadata: map[string]any{domain.DocMetaMIMEType: "text/x-go"},
+	}))
+	f.service.SetEmbeddingModels(
+		map[string]string{"text/x-go": "model-code"},
+		map[string]driven.EmbeddingService{"model-code": &countingEmbeddingService{model: "model-code"}},
+	)
+
+	_, err := f.service.Rebuild(ctx, domain.RebuildOptions{Model: "model-code"}, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"src-a-doc1"}, f.pipeline.processed, "only documents of the model are rebuilt")
+	assert.Len(t, f.vectors.vectors, 2)
+}
+
+func TestRebuildService_DeleteReassignedVectors(t *testing.T) {
+	ctx := context.Background()
+	f := newRebuildFixture(t)
+	require.NoError(t, f.docStore.SaveDocument(ctx, &domain.Document{
+		ID: "src-a-doc1", SourceID: "src-a", URI: "src-a-doc1", Content: "line one\nline two",
+		Metadata: map[string]any{domain.DocMetaMIMEType: "text/x-go"},
+	}))
+	_, err := f.service.Rebuild(ctx, domain.RebuildOptions{}, nil)
+	require.NoError(t, err)
+	require.Len(t, f.vectors.vectors, 8)
+
+	reassigned, err := f.service.DeleteReassignedVectors(ctx,
+		map[string]string{"text/x-go": "model-code", "github": "model-code"},
+		map[string]string{"text/x-go": "model-go", "github": "model-other"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, reassigned, "only documents whose model changed lose their vectors")
+	assert.Len(t, f.vectors.vectors, 6)
+	assert.NotContains(t, f.vectors.vectors, "src-a-doc1-0")
+
+	reassigned, err = f.service.DeleteReassignedVectors(ctx, nil, map[string]string{"filesystem": "model-fs"})
+	require.NoError(t, err)
+	assert.Equal(t, 4, reassigned, "documents moving off the default model lose their vectors")
+	assert.Empty(t, f.vectors.vectors)
+}
+
+func TestRebuildService_Rebuild_ModelErrors(t *testing.T) {
+	f := newRebuildFixture(t)
+
+	_, err := f.service.Rebuild(context.Background(), domain.RebuildOptions{Model: "unknown"}, nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = f.service.Rebuild(context.Background(), domain.RebuildOptions{Model: "mock", Refetch: true}, nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	assert.Empty(t, f.pipeline.processed)
+}
+
+// substringSearchEngine finds indexed chunks whose content contains the query.
+type substringSearchEngine struct {
+	*syncMockSearchEngine
+}
+
+func (e *substringSearchEngine) Search(_ context.Context, query string, limit int) ([]driven.SearchHit, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var hits []driven.SearchHit
+	for id, chunk := range e.indexed {
+		if strings.Contains(chunk.Content, query) && len(hits) < limit {
+			hits = append(hits, driven.SearchHit{ChunkID: id, Score: 1})
+		}
+	}
+	return hits, nil
+}
+
+func TestRebuildService_Rebuild_RestoresSearchResultsFromStoredDocuments(t *testing.T) {
+	ctx := context.Background()
+	f := newRebuildFixture(t)
+	engine := &substringSearchEngine{syncMockSearchEngine: newSyncMockSearchEngine()}
+	service := NewRebuildService(
+		f.sources, f.syncStore, f.docStore, f.stateStore, f.pipeline,
+		engine, f.vectors, &syncMockEmbeddingService{}, f.syncer,
+	)
+	search := NewSearchService(f.docStore, engine, nil, nil, nil)
+
+	results, err := search.Search(ctx, "line two", domain.SearchOptions{})
+	require.NoError(t, err)
+	require.Empty(t, results, "the search index starts empty")
+
+	_, err = service.Rebuild(ctx, domain.RebuildOptions{SourceID: "src-a"}, nil)
+	require.NoError(t, err)
+
+	results, err = search.Search(ctx, "line two", domain.SearchOptions{})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"src-a-doc1-1", "src-a-doc2-1"}, resultChunkIDs(results))
+	assert.Empty(t, f.syncer.synced, "connectors are not used")
+}
+
+func TestRebuildService_Rebuild_KeywordOnly(t *testing.T) {
+	ctx := context.Background()
+	f := newRebuildFixture(t)
+	// The stored chunk's content differs from its re-chunked content
+	saveDocWithChunks(t, f.docStore, "src-a", "src-a-doc1", "src-a-doc1-0")
+	require.NoError(t, f.vectors.Add(ctx, "src-a-doc1-0", []float32{1}))
+	require.NoError(t, f.docStore.SaveDocument(ctx, &domain.Document{
+		ID: "src-a-doc1", SourceID: "src-a", URI: "src-a-doc1", Content: "line one\nline two",
+	}))
+
+	result, err := f.service.Rebuild(ctx, domain.RebuildOptions{KeywordOnly: true}, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 8, result.Chunks)
+	assert.Len(t, f.search.indexed, 8)
+	assert.Empty(t, f.vectors.vectors, "nothing is embedded, and the changed chunk's vector is dropped")
+}
+
+func TestRebuildService_Rebuild_VectorsOnly(t *testing.T) {
+	ctx := context.Background()
+	f := newRebuildFixture(t)
+	saveDocWithChunks(t, f.docStore, "src-a", "src-a-doc1", "chunk-1", "chunk-2")
+
+	result, err := f.service.Rebuild(ctx, domain.RebuildOptions{SourceID: "src-a", VectorsOnly: true}, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Chunks)
+	assert.Empty(t, f.pipeline.processed, "documents are not re-chunked")
+	assert.Empty(t, f.search.indexed)
+	assert.Len(t, f.vectors.vectors, 2)
+	assert.Contains(t, f.vectors.vectors, "chunk-1")
+}
+
+func TestRebuildService_Rebuild_TargetErrors(t *testing.T) {
+	f := newRebuildFixture(t)
+
+	for _, opts := range []domain.RebuildOptions{
+		{KeywordOnly: true, VectorsOnly: true},
+		{KeywordOnly: true, Refetch: true},
+		{KeywordOnly: true, Model: "mock"},
+	} {
+		_, err := f.service.Rebuild(context.Background(), opts, nil)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput, "%+v", opts)
+	}
+
+	noEmbedding := NewRebuildService(
+		f.sources, f.syncStore, f.docStore, f.stateStore, f.pipeline, f.search, nil, nil, f.syncer,
+	)
+	_, err := noEmbedding.Rebuild(context.Background(), domain.RebuildOptions{VectorsOnly: true}, nil)
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
+
+func TestRebuildService_Rebuild_SourceNotFound(t *testing.T) {
+	f := newRebuildFixture(t)
+
+	_, err := f.service.Rebuild(context.Background(), domain.RebuildOptions{SourceID: "missing"}, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "get source")
+}
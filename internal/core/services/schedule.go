@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// Ensure ScheduleService implements the interface.
+var _ driving.ScheduleService = (*ScheduleService)(nil)
+
+// MinSourceSyncInterval is the shortest allowed per-source sync interval.
+// The scheduler checks for due tasks once a minute.
+const MinSourceSyncInterval = time.Minute
+
+// ScheduleService manages per-source sync schedules.
+type ScheduleService struct {
+	sourceStore    driven.SourceStore
+	schedulerStore driven.SchedulerStore
+}
+
+// NewScheduleService creates a new schedule service.
+func NewScheduleService(
+	sourceStore driven.SourceStore,
+	schedulerStore driven.SchedulerStore,
+) *ScheduleService {
+	return &ScheduleService{
+		sourceStore:    sourceStore,
+		schedulerStore: schedulerStore,
+	}
+}
+
+// SetSourceSchedule sets how often a source is synced.
+func (s *ScheduleService) SetSourceSchedule(ctx context.Context, sourceID string, interval time.Duration) error {
+	if s.sourceStore == nil || s.schedulerStore == nil {
+		return domain.ErrNotImplemented
+	}
+	if interval < MinSourceSyncInterval {
+		return fmt.Errorf("%w: interval must be at least %s", domain.ErrInvalidInput, MinSourceSyncInterval)
+	}
+
+	return s.saveSourceTask(ctx, sourceID, interval, "")
+}
+
+// SetSourceCron sets a 5-field cron expression for when a source is synced.
+func (s *ScheduleService) SetSourceCron(ctx context.Context, sourceID, expr string) error {
+	if s.sourceStore == nil || s.schedulerStore == nil {
+		return domain.ErrNotImplemented
+	}
+	if _, err := parseCron(expr); err != nil {
+		return err
+	}
+
+	return s.saveSourceTask(ctx, sourceID, 0, strings.TrimSpace(expr))
+}
+
+// saveSourceTask creates or updates a source's sync task with a new schedule.
+func (s *ScheduleService) saveSourceTask(
+	ctx context.Context, sourceID string, interval time.Duration, cronExpr string,
+) error {
+	source, err := s.sourceStore.Get(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+
+	taskID := domain.SourceSyncTaskID(sourceID)
+	task, err := s.schedulerStore.GetTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if task == nil {
+		task = &domain.ScheduledTask{ID: taskID}
+	}
+
+	task.Name = "Sync " + source.Name
+	task.Interval = interval
+	task.Cron = cronExpr
+	task.Enabled = true
+	if task.NextRun, err = nextRunAfter(task, time.Now()); err != nil {
+		return err
+	}
+
+	return s.schedulerStore.SaveTask(ctx, task)
+}
+
+// ClearSourceSchedule removes a source's schedule so it falls back to the global default.
+func (s *ScheduleService) ClearSourceSchedule(ctx context.Context, sourceID string) error {
+	if s.schedulerStore == nil {
+		return domain.ErrNotImplemented
+	}
+	return s.schedulerStore.DeleteTask(ctx, domain.SourceSyncTaskID(sourceID))
+}
+
+// GetSourceSchedule returns a source's schedule, or nil if it uses the global default.
+func (s *ScheduleService) GetSourceSchedule(ctx context.Context, sourceID string) (*domain.ScheduledTask, error) {
+	if s.schedulerStore == nil {
+		return nil, domain.ErrNotImplemented
+	}
+	return s.schedulerStore.GetTask(ctx, domain.SourceSyncTaskID(sourceID))
+}
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+func TestScheduleService_SetSourceSchedule(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	schedulerStore := newMockSchedulerStore()
+	svc := NewScheduleService(sourceStore, schedulerStore)
+	ctx := context.Background()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "files", Name: "Notes"}))
+
+	err := svc.SetSourceSchedule(ctx, "files", 5*time.Minute)
+	require.NoError(t, err)
+
+	task, err := svc.GetSourceSchedule(ctx, "files")
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.Equal(t, domain.SourceSyncTaskID("files"), task.ID)
+	assert.Equal(t, "Sync Notes", task.Name)
+	assert.Equal(t, 5*time.Minute, task.Interval)
+	assert.True(t, task.Enabled)
+	assert.WithinDuration(t, time.Now().Add(5*time.Minute), task.NextRun, 5*time.Second)
+}
+
+func TestScheduleService_SetSourceSchedule_UpdatesInterval(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	schedulerStore := newMockSchedulerStore()
+	svc := NewScheduleService(sourceStore, schedulerStore)
+	ctx := context.Background()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "gmail", Name: "Mail"}))
+	require.NoError(t, svc.SetSourceSchedule(ctx, "gmail", 5*time.Minute))
+	require.NoError(t, svc.SetSourceSchedule(ctx, "gmail", time.Hour))
+
+	task, err := svc.GetSourceSchedule(ctx, "gmail")
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, task.Interval)
+}
+
+func TestScheduleService_SetSourceSchedule_IntervalTooShort(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	svc := NewScheduleService(sourceStore, newMockSchedulerStore())
+	ctx := context.Background()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "files"}))
+
+	err := svc.SetSourceSchedule(ctx, "files", 30*time.Second)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestScheduleService_SetSourceSchedule_SourceNotFound(t *testing.T) {
+	svc := NewScheduleService(memory.NewSourceStore(), newMockSchedulerStore())
+
+	err := svc.SetSourceSchedule(context.Background(), "missing", time.Hour)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestScheduleService_ClearSourceSchedule(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	svc := NewScheduleService(sourceStore, newMockSchedulerStore())
+	ctx := context.Background()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "files"}))
+	require.NoError(t, svc.SetSourceSchedule(ctx, "files", 5*time.Minute))
+
+	require.NoError(t, svc.ClearSourceSchedule(ctx, "files"))
+
+	task, err := svc.GetSourceSchedule(ctx, "files")
+	require.NoError(t, err)
+	assert.Nil(t, task)
+}
+
+func TestScheduleService_NilStores(t *testing.T) {
+	svc := NewScheduleService(nil, nil)
+	ctx := context.Background()
+
+	assert.ErrorIs(t, svc.SetSourceSchedule(ctx, "files", time.Hour), domain.ErrNotImplemented)
+	assert.ErrorIs(t, svc.ClearSourceSchedule(ctx, "files"), domain.ErrNotImplemented)
+	_, err := svc.GetSourceSchedule(ctx, "files")
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
+
+func TestScheduleService_SetSourceCron(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	svc := NewScheduleService(sourceStore, newMockSchedulerStore())
+	ctx := context.Background()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "gmail", Name: "Mail"}))
+	require.NoError(t, svc.SetSourceSchedule(ctx, "gmail", time.Hour))
+
+	err := svc.SetSourceCron(ctx, "gmail", "0 6 * * 1-5")
+	require.NoError(t, err)
+
+	task, err := svc.GetSourceSchedule(ctx, "gmail")
+	require.NoError(t, err)
+	assert.Equal(t, "0 6 * * 1-5", task.Cron)
+	assert.Zero(t, task.Interval)
+	assert.Equal(t, 6, task.NextRun.Hour())
+	assert.Equal(t, 0, task.NextRun.Minute())
+	assert.NotEqual(t, time.Saturday, task.NextRun.Weekday())
+	assert.NotEqual(t, time.Sunday, task.NextRun.Weekday())
+}
+
+func TestScheduleService_SetSourceCron_Invalid(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	svc := NewScheduleService(sourceStore, newMockSchedulerStore())
+	ctx := context.Background()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "gmail"}))
+
+	err := svc.SetSourceCron(ctx, "gmail", "every weekday")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
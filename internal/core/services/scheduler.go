@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
-	"log"
+	"errors"
+	"log/slog"
+	"math/rand/v2"
 	"sync"
 	"time"
 
@@ -11,32 +13,70 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
 )
 
+// maxIdle caps how long the scheduler sleeps between checks, so that
+// schedules changed by other processes are picked up promptly.
+const maxIdle = 1 * time.Minute
+
+// minIdle prevents the loop from spinning when a task is overdue.
+const minIdle = 1 * time.Second
+
 // Scheduler manages background task execution.
 // It is a pure core service with no external control API.
 type Scheduler struct {
-	config   domain.SchedulerConfig
-	store    driven.SchedulerStore
-	syncOrch driving.SyncOrchestrator
+	config      domain.SchedulerConfig
+	store       driven.SchedulerStore
+	sourceStore driven.SourceStore
+	syncOrch    driving.SyncOrchestrator
+	maintenance driving.MaintenanceService
+	limiter     *SyncLimiter
+
+	// now returns the current time; replaced by tests with a fake clock.
+	now func() time.Time
 
-	mu      sync.Mutex
-	running bool
-	stopCh  chan struct{}
-	wg      sync.WaitGroup
+	// jitter returns a random delay up to the given maximum.
+	jitter func(maxDelay time.Duration) time.Duration
+
+	mu       sync.Mutex
+	running  bool
+	inFlight map[string]bool
+	stopCh   chan struct{}
+	wg       sync.WaitGroup
 }
 
 // NewScheduler creates a scheduler with configuration.
+// When sourceStore is provided, sources with their own schedule are synced
+// by their per-source task and skipped by the global document sync.
+// Concurrent syncs are capped by config.MaxConcurrentSyncs.
 func NewScheduler(
 	config domain.SchedulerConfig,
 	store driven.SchedulerStore,
+	sourceStore driven.SourceStore,
 	syncOrch driving.SyncOrchestrator,
 ) *Scheduler {
 	return &Scheduler{
-		config:   config,
-		store:    store,
-		syncOrch: syncOrch,
+		config:      config,
+		store:       store,
+		sourceStore: sourceStore,
+		syncOrch:    syncOrch,
+		limiter:     NewSyncLimiter(config.MaxConcurrentSyncs),
+		now:         time.Now,
+		jitter:      randomJitter,
+		inFlight:    make(map[string]bool),
 	}
 }
 
+// SetSyncLimiter replaces the scheduler's sync limiter, e.g. with one shared
+// with the SyncOrchestrator so that both respect the same cap.
+func (s *Scheduler) SetSyncLimiter(limiter *SyncLimiter) {
+	s.limiter = limiter
+}
+
+// SetMaintenanceService enables the periodic compaction task, which runs
+// when it is enabled in the scheduler config.
+func (s *Scheduler) SetMaintenanceService(maintenance driving.MaintenanceService) {
+	s.maintenance = maintenance
+}
+
 // Start begins the scheduler loop. This method blocks until Stop is called.
 func (s *Scheduler) Start(ctx context.Context) error {
 	s.mu.Lock()
@@ -50,7 +90,7 @@ func (s *Scheduler) Start(ctx context.Context) error {
 
 	// Initialise tasks in store
 	if err := s.initialiseTasks(ctx); err != nil {
-		log.Printf("scheduler: failed to initialise tasks: %v", err)
+		slog.Error("scheduler: failed to initialise tasks", slog.Any("error", err))
 	}
 
 	// Run the main scheduler loop
@@ -83,6 +123,21 @@ func (s *Scheduler) initialiseTasks(ctx context.Context) error {
 		}
 	}
 
+	// Compaction task; it is opt-in, so one saved while it was enabled is
+	// updated rather than left running once the config turns it off
+	if s.maintenance != nil {
+		taskCfg := s.config.GetTaskConfig(domain.TaskIDMaintenanceCompact)
+		existing, err := s.store.GetTask(ctx, domain.TaskIDMaintenanceCompact)
+		if err != nil {
+			return err
+		}
+		if taskCfg.Enabled || existing != nil {
+			if err := s.ensureTask(ctx, domain.TaskIDMaintenanceCompact, "Maintenance Compact", taskCfg); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -99,15 +154,21 @@ func (s *Scheduler) ensureTask(ctx context.Context, id, name string, cfg domain.
 			ID:       id,
 			Name:     name,
 			Interval: cfg.Interval,
+			Cron:     cfg.Cron,
 			Enabled:  cfg.Enabled,
-			NextRun:  time.Now().Add(cfg.Interval),
+		}
+		if task.NextRun, err = nextRunAfter(task, s.now()); err != nil {
+			return err
 		}
 	} else {
-		// Update interval if changed
-		if task.Interval != cfg.Interval {
+		// Update schedule if changed
+		if task.Interval != cfg.Interval || task.Cron != cfg.Cron {
 			task.Interval = cfg.Interval
+			task.Cron = cfg.Cron
 			// Recalculate next run from now
-			task.NextRun = time.Now().Add(cfg.Interval)
+			if task.NextRun, err = nextRunAfter(task, s.now()); err != nil {
+				return err
+			}
 		}
 		task.Enabled = cfg.Enabled
 	}
@@ -116,67 +177,120 @@ func (s *Scheduler) ensureTask(ctx context.Context, id, name string, cfg domain.
 }
 
 // run is the main scheduler loop.
+// It sleeps until the next task is due rather than polling on a fixed tick.
 func (s *Scheduler) run(ctx context.Context) error {
-	// Check for due tasks immediately on startup
-	s.checkAndRunDueTasks(ctx)
-
-	// Use a 1-minute ticker to check for due tasks
-	ticker := time.NewTicker(1 * time.Minute)
-	defer ticker.Stop()
-
 	for {
+		// Run due tasks, then sleep until the next one
+		wait := s.checkAndRunDueTasks(ctx)
+
+		timer := time.NewTimer(wait)
 		select {
 		case <-ctx.Done():
+			timer.Stop()
 			return ctx.Err()
 		case <-s.stopCh:
+			timer.Stop()
 			return nil
-		case <-ticker.C:
-			s.checkAndRunDueTasks(ctx)
+		case <-timer.C:
 		}
 	}
 }
 
 // checkAndRunDueTasks finds and executes tasks that are due.
-func (s *Scheduler) checkAndRunDueTasks(ctx context.Context) {
+// Returns how long to wait before the next task is due.
+func (s *Scheduler) checkAndRunDueTasks(ctx context.Context) time.Duration {
 	tasks, err := s.store.ListTasks(ctx)
 	if err != nil {
-		log.Printf("scheduler: failed to list tasks: %v", err)
-		return
+		slog.Error("scheduler: failed to list tasks", slog.Any("error", err))
+		return maxIdle
 	}
 
-	now := time.Now()
+	now := s.now()
+	wait := maxIdle
 	for i := range tasks {
 		task := &tasks[i]
-		if !task.Enabled {
+		if !task.Enabled || s.isInFlight(task.ID) {
 			continue
 		}
-		if task.NextRun.IsZero() || task.NextRun.Before(now) || task.NextRun.Equal(now) {
+		if task.NextRun.IsZero() || !task.NextRun.After(now) {
 			s.runTask(ctx, task)
+			continue
 		}
+		wait = min(wait, task.NextRun.Sub(now))
 	}
+
+	return max(wait, minIdle)
+}
+
+// isInFlight reports whether a task is currently executing.
+func (s *Scheduler) isInFlight(taskID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.inFlight[taskID]
+}
+
+// isStopped reports whether Stop has been called since the last Start.
+func (s *Scheduler) isStopped() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stopCh != nil && !s.running
 }
 
 // runTask executes a single task.
 func (s *Scheduler) runTask(ctx context.Context, task *domain.ScheduledTask) {
+	s.mu.Lock()
+	s.inFlight[task.ID] = true
+	s.mu.Unlock()
+
 	s.wg.Add(1)
 	go func() {
 		defer s.wg.Done()
+		defer func() {
+			s.mu.Lock()
+			delete(s.inFlight, task.ID)
+			s.mu.Unlock()
+		}()
+
+		// Spread out runs that fall due together; if the scheduler stops
+		// while waiting the task stays due and runs on the next start
+		if err := s.waitJitter(ctx); err != nil {
+			return
+		}
 
 		result := &domain.TaskResult{
 			TaskID:    task.ID,
-			StartedAt: time.Now(),
+			StartedAt: s.now(),
 		}
 
 		var err error
 		switch task.ID {
 		case domain.TaskIDDocumentSync:
 			result.ItemsProcessed, err = s.runDocumentSync(ctx)
+		case domain.TaskIDMaintenanceCompact:
+			result.ItemsProcessed, err = s.runMaintenanceCompact(ctx)
 		default:
-			log.Printf("scheduler: unknown task ID: %s", task.ID)
+			sourceID, ok := domain.SourceIDFromTaskID(task.ID)
+			if !ok {
+				slog.Warn("scheduler: unknown task", slog.String("task_id", task.ID))
+				return
+			}
+			err = s.runSourceSync(ctx, sourceID)
+			if errors.Is(err, domain.ErrNotFound) {
+				// Source was removed; drop its schedule
+				if delErr := s.store.DeleteTask(ctx, task.ID); delErr != nil {
+					slog.Warn("scheduler: failed to delete task",
+						slog.String("task_id", task.ID), slog.Any("error", delErr))
+				}
+				return
+			}
+		}
+
+		// Interrupted while queued for a slot by Stop; leave the task due
+		if errors.Is(err, context.Canceled) && s.isStopped() {
 			return
 		}
 
-		result.EndedAt = time.Now()
+		result.EndedAt = s.now()
 		if err != nil {
 			result.Success = false
 			result.Error = err.Error()
@@ -189,25 +303,32 @@ func (s *Scheduler) runTask(ctx context.Context, task *domain.ScheduledTask) {
 
 		// Update task state
 		task.LastRun = result.StartedAt
-		task.NextRun = result.EndedAt.Add(task.Interval)
+		next, nextErr := nextRunAfter(task, result.EndedAt)
+		if nextErr != nil {
+			slog.Warn("scheduler: falling back to interval",
+				slog.String("task_id", task.ID), slog.Any("error", nextErr))
+			next = result.EndedAt.Add(task.Interval)
+		}
+		task.NextRun = next
 
 		if saveErr := s.store.SaveTask(ctx, task); saveErr != nil {
-			log.Printf("scheduler: failed to save task %s: %v", task.ID, saveErr)
+			slog.Error("scheduler: failed to save task", slog.String("task_id", task.ID), slog.Any("error", saveErr))
 		}
 
 		// Record result for history
 		if recordErr := s.store.RecordResult(ctx, result); recordErr != nil {
-			log.Printf("scheduler: failed to record result for %s: %v", task.ID, recordErr)
+			slog.Warn("scheduler: failed to record result",
+				slog.String("task_id", task.ID), slog.Any("error", recordErr))
 		}
 
 		// Prune old history (keep last 100 results per task)
 		if pruneErr := s.store.PruneHistory(ctx, 100); pruneErr != nil {
-			log.Printf("scheduler: failed to prune history: %v", pruneErr)
+			slog.Warn("scheduler: failed to prune history", slog.Any("error", pruneErr))
 		}
 	}()
 }
 
-// runDocumentSync syncs all sources.
+// runDocumentSync syncs all sources that do not have their own schedule.
 //
 //nolint:unparam // itemsProcessed always 0 until SyncAll returns count
 func (s *Scheduler) runDocumentSync(ctx context.Context) (int, error) {
@@ -215,9 +336,127 @@ func (s *Scheduler) runDocumentSync(ctx context.Context) (int, error) {
 		return 0, nil
 	}
 
-	// SyncAll syncs all configured sources
+	// Without a source store we cannot tell which sources are scheduled
+	// individually, so SyncAll syncs all configured sources.
 	// We don't have a direct way to count documents synced here,
 	// so we return 0 for items processed
-	err := s.syncOrch.SyncAll(ctx)
-	return 0, err
+	if s.sourceStore == nil {
+		return 0, s.syncOrch.SyncAll(ctx)
+	}
+
+	sources, err := s.sourceStore.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	for i := range sources {
+		scheduled, err := s.hasOwnSchedule(ctx, sources[i].ID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if scheduled {
+			continue
+		}
+		if err := s.acquireSlot(ctx); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		err = s.syncOrch.Sync(ctx, sources[i].ID)
+		s.limiter.Release()
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return 0, errors.Join(errs...)
+}
+
+// runMaintenanceCompact removes orphaned data and compacts the stores,
+// returning how many documents, chunks and vectors were removed.
+func (s *Scheduler) runMaintenanceCompact(ctx context.Context) (int, error) {
+	if s.maintenance == nil {
+		return 0, nil
+	}
+	result, err := s.maintenance.Compact(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return result.OrphanedDocuments + result.OrphanedChunks + result.OrphanedVectors, nil
+}
+
+// runSourceSync syncs a single source on its own schedule.
+func (s *Scheduler) runSourceSync(ctx context.Context, sourceID string) error {
+	if s.syncOrch == nil {
+		return nil
+	}
+	if err := s.acquireSlot(ctx); err != nil {
+		return err
+	}
+	defer s.limiter.Release()
+	return s.syncOrch.Sync(ctx, sourceID)
+}
+
+// acquireSlot waits for a free sync slot, giving up if the scheduler stops.
+func (s *Scheduler) acquireSlot(ctx context.Context) error {
+	waitCtx, cancel := s.untilStopped(ctx)
+	defer cancel()
+	return s.limiter.Acquire(waitCtx)
+}
+
+// waitJitter sleeps for a random delay up to the configured jitter,
+// giving up if the scheduler stops.
+func (s *Scheduler) waitJitter(ctx context.Context) error {
+	delay := s.jitter(s.config.Jitter)
+	if delay <= 0 {
+		return nil
+	}
+
+	waitCtx, cancel := s.untilStopped(ctx)
+	defer cancel()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-waitCtx.Done():
+		return waitCtx.Err()
+	}
+}
+
+// untilStopped returns a context that is also cancelled when Stop is called.
+// Used for waits only; running syncs are left to finish on Stop.
+func (s *Scheduler) untilStopped(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	s.mu.Lock()
+	stopCh := s.stopCh
+	s.mu.Unlock()
+
+	go func() {
+		select {
+		case <-stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// randomJitter returns a random duration in [0, maxDelay).
+func randomJitter(maxDelay time.Duration) time.Duration {
+	if maxDelay <= 0 {
+		return 0
+	}
+	return rand.N(maxDelay)
+}
+
+// hasOwnSchedule reports whether a source has an enabled per-source sync task.
+func (s *Scheduler) hasOwnSchedule(ctx context.Context, sourceID string) (bool, error) {
+	task, err := s.store.GetTask(ctx, domain.SourceSyncTaskID(sourceID))
+	if err != nil {
+		return false, err
+	}
+	return task != nil && task.Enabled, nil
 }
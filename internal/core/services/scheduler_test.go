@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
@@ -109,12 +111,30 @@ func (m *mockSchedulerStore) PruneHistory(_ context.Context, _ int) error {
 
 // mockSyncOrchestrator implements driving.SyncOrchestrator for testing.
 type mockSyncOrchestrator struct {
+	mu            sync.Mutex
 	syncAllCalled bool
 	syncAllErr    error
+	synced        []string
+	syncErr       error
 }
 
-func (m *mockSyncOrchestrator) Sync(_ context.Context, _ string) error {
-	return nil
+func (m *mockSyncOrchestrator) Sync(_ context.Context, sourceID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.synced = append(m.synced, sourceID)
+	return m.syncErr
+}
+
+func (m *mockSyncOrchestrator) syncCount(sourceID string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	count := 0
+	for _, id := range m.synced {
+		if id == sourceID {
+			count++
+		}
+	}
+	return count
 }
 
 func (m *mockSyncOrchestrator) SyncAll(_ context.Context) error {
@@ -122,13 +142,43 @@ func (m *mockSyncOrchestrator) SyncAll(_ context.Context) error {
 	return m.syncAllErr
 }
 
+func (m *mockSyncOrchestrator) SetParallelWithinSource(_ bool) {}
+
+func (m *mockSyncOrchestrator) DryRun(_ context.Context, sourceID string) (*domain.SyncPreview, error) {
+	return &domain.SyncPreview{SourceID: sourceID}, nil
+}
+
 func (m *mockSyncOrchestrator) Status(_ context.Context, _ string) (*driving.SyncStatus, error) {
 	return &driving.SyncStatus{}, nil
 }
 
+// mockCompactor implements driving.MaintenanceService, counting compactions.
+type mockCompactor struct {
+	mu        sync.Mutex
+	compacted int
+	result    domain.CompactResult
+}
+
+func (m *mockCompactor) Vacuum(_ context.Context) (*domain.VacuumResult, error) {
+	return &domain.VacuumResult{}, nil
+}
+
+func (m *mockCompactor) Optimize(_ context.Context) (*domain.OptimizeResult, error) {
+	return &domain.OptimizeResult{}, nil
+}
+
+func (m *mockCompactor) Compact(_ context.Context) (*domain.CompactResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.compacted++
+	result := m.result
+	return &result, nil
+}
+
 // Ensure mocks implement interfaces
 var _ driven.SchedulerStore = (*mockSchedulerStore)(nil)
 var _ driving.SyncOrchestrator = (*mockSyncOrchestrator)(nil)
+var _ driving.MaintenanceService = (*mockCompactor)(nil)
 
 // ==================== Scheduler Tests ====================
 
@@ -137,7 +187,7 @@ func TestNewScheduler(t *testing.T) {
 	store := newMockSchedulerStore()
 	syncOrch := &mockSyncOrchestrator{}
 
-	scheduler := NewScheduler(config, store, syncOrch)
+	scheduler := NewScheduler(config, store, nil, syncOrch)
 
 	require.NotNil(t, scheduler)
 	assert.Equal(t, config.Enabled, scheduler.config.Enabled)
@@ -148,7 +198,7 @@ func TestScheduler_StartStop(t *testing.T) {
 	store := newMockSchedulerStore()
 	syncOrch := &mockSyncOrchestrator{}
 
-	scheduler := NewScheduler(config, store, syncOrch)
+	scheduler := NewScheduler(config, store, nil, syncOrch)
 
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -176,7 +226,7 @@ func TestScheduler_StopWithoutStart(t *testing.T) {
 	store := newMockSchedulerStore()
 	syncOrch := &mockSyncOrchestrator{}
 
-	scheduler := NewScheduler(config, store, syncOrch)
+	scheduler := NewScheduler(config, store, nil, syncOrch)
 
 	// Stop without starting should be safe
 	err := scheduler.Stop()
@@ -188,7 +238,7 @@ func TestScheduler_DoubleStart(t *testing.T) {
 	store := newMockSchedulerStore()
 	syncOrch := &mockSyncOrchestrator{}
 
-	scheduler := NewScheduler(config, store, syncOrch)
+	scheduler := NewScheduler(config, store, nil, syncOrch)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -218,7 +268,7 @@ func TestScheduler_InitialiseTasks(t *testing.T) {
 	store := newMockSchedulerStore()
 	syncOrch := &mockSyncOrchestrator{}
 
-	scheduler := NewScheduler(config, store, syncOrch)
+	scheduler := NewScheduler(config, store, nil, syncOrch)
 
 	ctx := context.Background()
 	err := scheduler.initialiseTasks(ctx)
@@ -232,12 +282,73 @@ func TestScheduler_InitialiseTasks(t *testing.T) {
 	assert.True(t, docTask.Enabled)
 }
 
+func TestScheduler_InitialiseTasks_MaintenanceCompact(t *testing.T) {
+	ctx := context.Background()
+	store := newMockSchedulerStore()
+
+	// Compaction is opt-in
+	scheduler := NewScheduler(domain.DefaultSchedulerConfig(), store, nil, nil)
+	scheduler.SetMaintenanceService(&mockCompactor{})
+	require.NoError(t, scheduler.initialiseTasks(ctx))
+	task, err := store.GetTask(ctx, domain.TaskIDMaintenanceCompact)
+	require.NoError(t, err)
+	assert.Nil(t, task)
+
+	config := domain.DefaultSchedulerConfig()
+	taskCfg := config.TaskConfigs[domain.TaskIDMaintenanceCompact]
+	taskCfg.Enabled = true
+	config.TaskConfigs[domain.TaskIDMaintenanceCompact] = taskCfg
+	scheduler = NewScheduler(config, store, nil, nil)
+	scheduler.SetMaintenanceService(&mockCompactor{})
+	require.NoError(t, scheduler.initialiseTasks(ctx))
+	task, err = store.GetTask(ctx, domain.TaskIDMaintenanceCompact)
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.True(t, task.Enabled)
+	assert.Equal(t, 7*24*time.Hour, task.Interval)
+
+	// Turning it off again disables the saved task
+	scheduler = NewScheduler(domain.DefaultSchedulerConfig(), store, nil, nil)
+	scheduler.SetMaintenanceService(&mockCompactor{})
+	require.NoError(t, scheduler.initialiseTasks(ctx))
+	task, err = store.GetTask(ctx, domain.TaskIDMaintenanceCompact)
+	require.NoError(t, err)
+	require.NotNil(t, task)
+	assert.False(t, task.Enabled)
+}
+
+func TestScheduler_RunTask_MaintenanceCompact(t *testing.T) {
+	ctx := context.Background()
+	store := newMockSchedulerStore()
+	compactor := &mockCompactor{result: domain.CompactResult{
+		OrphanedDocuments: 2, OrphanedChunks: 5, OrphanedVectors: 5,
+	}}
+	scheduler := NewScheduler(domain.DefaultSchedulerConfig(), store, nil, nil)
+	scheduler.SetMaintenanceService(compactor)
+
+	task := &domain.ScheduledTask{
+		ID:       domain.TaskIDMaintenanceCompact,
+		Interval: 7 * 24 * time.Hour,
+		Enabled:  true,
+	}
+	require.NoError(t, store.SaveTask(ctx, task))
+	scheduler.runTask(ctx, task)
+	scheduler.wg.Wait()
+
+	assert.Equal(t, 1, compactor.compacted)
+	history, err := store.GetTaskHistory(ctx, domain.TaskIDMaintenanceCompact, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.True(t, history[0].Success)
+	assert.Equal(t, 12, history[0].ItemsProcessed)
+}
+
 func TestScheduler_EnsureTask_UpdateInterval(t *testing.T) {
 	config := domain.DefaultSchedulerConfig()
 	store := newMockSchedulerStore()
 	syncOrch := &mockSyncOrchestrator{}
 
-	scheduler := NewScheduler(config, store, syncOrch)
+	scheduler := NewScheduler(config, store, nil, syncOrch)
 	ctx := context.Background()
 
 	// Create initial task
@@ -264,7 +375,7 @@ func TestScheduler_RunDocumentSync(t *testing.T) {
 	store := newMockSchedulerStore()
 	syncOrch := &mockSyncOrchestrator{}
 
-	scheduler := NewScheduler(config, store, syncOrch)
+	scheduler := NewScheduler(config, store, nil, syncOrch)
 	ctx := context.Background()
 
 	_, err := scheduler.runDocumentSync(ctx)
@@ -276,7 +387,7 @@ func TestScheduler_RunDocumentSync_NilOrchestrator(t *testing.T) {
 	config := domain.DefaultSchedulerConfig()
 	store := newMockSchedulerStore()
 
-	scheduler := NewScheduler(config, store, nil)
+	scheduler := NewScheduler(config, store, nil, nil)
 	ctx := context.Background()
 
 	_, err := scheduler.runDocumentSync(ctx)
@@ -288,7 +399,7 @@ func TestScheduler_CheckAndRunDueTasks(t *testing.T) {
 	store := newMockSchedulerStore()
 	syncOrch := &mockSyncOrchestrator{}
 
-	scheduler := NewScheduler(config, store, syncOrch)
+	scheduler := NewScheduler(config, store, nil, syncOrch)
 	ctx := context.Background()
 
 	// Create a task that is due
@@ -317,7 +428,7 @@ func TestScheduler_RunTask_UnknownTaskID(t *testing.T) {
 	config := domain.DefaultSchedulerConfig()
 	store := newMockSchedulerStore()
 
-	scheduler := NewScheduler(config, store, nil)
+	scheduler := NewScheduler(config, store, nil, nil)
 	ctx := context.Background()
 
 	// Create unknown task
@@ -331,3 +442,359 @@ func TestScheduler_RunTask_UnknownTaskID(t *testing.T) {
 	scheduler.runTask(ctx, task)
 	scheduler.wg.Wait()
 }
+
+// ==================== Per-Source Schedule Tests ====================
+
+func TestScheduler_SourcesWithDifferentIntervalsFireIndependently(t *testing.T) {
+	config := domain.DefaultSchedulerConfig()
+	store := newMockSchedulerStore()
+	syncOrch := &mockSyncOrchestrator{}
+	ctx := context.Background()
+
+	scheduler := NewScheduler(config, store, nil, syncOrch)
+
+	now := time.Now()
+	require.NoError(t, store.SaveTask(ctx, &domain.ScheduledTask{
+		ID:       domain.SourceSyncTaskID("files"),
+		Interval: 5 * time.Minute,
+		NextRun:  now.Add(-1 * time.Minute),
+		Enabled:  true,
+	}))
+	require.NoError(t, store.SaveTask(ctx, &domain.ScheduledTask{
+		ID:       domain.SourceSyncTaskID("gmail"),
+		Interval: 1 * time.Hour,
+		NextRun:  now.Add(30 * time.Minute),
+		Enabled:  true,
+	}))
+
+	// Only the filesystem source is due
+	scheduler.checkAndRunDueTasks(ctx)
+	scheduler.wg.Wait()
+
+	assert.Equal(t, 1, syncOrch.syncCount("files"))
+	assert.Equal(t, 0, syncOrch.syncCount("gmail"))
+	assert.False(t, syncOrch.syncAllCalled)
+
+	// Each task is rescheduled by its own interval
+	files, err := store.GetTask(ctx, domain.SourceSyncTaskID("files"))
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(5*time.Minute), files.NextRun, 5*time.Second)
+
+	gmail, err := store.GetTask(ctx, domain.SourceSyncTaskID("gmail"))
+	require.NoError(t, err)
+	assert.WithinDuration(t, now.Add(30*time.Minute), gmail.NextRun, time.Second)
+
+	// Once the Gmail task is due it fires while the filesystem task waits
+	gmail.NextRun = time.Now().Add(-1 * time.Second)
+	require.NoError(t, store.SaveTask(ctx, gmail))
+
+	scheduler.checkAndRunDueTasks(ctx)
+	scheduler.wg.Wait()
+
+	assert.Equal(t, 1, syncOrch.syncCount("files"))
+	assert.Equal(t, 1, syncOrch.syncCount("gmail"))
+}
+
+func TestScheduler_RunDocumentSync_SkipsSourcesWithOwnSchedule(t *testing.T) {
+	config := domain.DefaultSchedulerConfig()
+	store := newMockSchedulerStore()
+	sourceStore := memory.NewSourceStore()
+	syncOrch := &mockSyncOrchestrator{}
+	ctx := context.Background()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "files", Type: "filesystem"}))
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "gmail", Type: "gmail"}))
+	require.NoError(t, store.SaveTask(ctx, &domain.ScheduledTask{
+		ID:       domain.SourceSyncTaskID("gmail"),
+		Interval: 1 * time.Hour,
+		Enabled:  true,
+	}))
+
+	scheduler := NewScheduler(config, store, sourceStore, syncOrch)
+
+	_, err := scheduler.runDocumentSync(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, syncOrch.syncCount("files"))
+	assert.Equal(t, 0, syncOrch.syncCount("gmail"))
+	assert.False(t, syncOrch.syncAllCalled)
+}
+
+func TestScheduler_RunTask_SourceRemovedDeletesTask(t *testing.T) {
+	config := domain.DefaultSchedulerConfig()
+	store := newMockSchedulerStore()
+	syncOrch := &mockSyncOrchestrator{syncErr: fmt.Errorf("get source: %w", domain.ErrNotFound)}
+	ctx := context.Background()
+
+	task := &domain.ScheduledTask{
+		ID:       domain.SourceSyncTaskID("gone"),
+		Interval: 5 * time.Minute,
+		Enabled:  true,
+	}
+	require.NoError(t, store.SaveTask(ctx, task))
+
+	scheduler := NewScheduler(config, store, nil, syncOrch)
+	scheduler.runTask(ctx, task)
+	scheduler.wg.Wait()
+
+	stored, err := store.GetTask(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Nil(t, stored)
+}
+
+// ==================== Cron Schedule Tests ====================
+
+func TestScheduler_EnsureTask_Cron(t *testing.T) {
+	store := newMockSchedulerStore()
+	scheduler := NewScheduler(domain.DefaultSchedulerConfig(), store, nil, nil)
+	fakeNow := time.Date(2025, 6, 6, 18, 0, 0, 0, time.UTC) // Friday
+	scheduler.now = func() time.Time { return fakeNow }
+	ctx := context.Background()
+
+	err := scheduler.ensureTask(ctx, "test-task", "Test", domain.TaskConfig{
+		Enabled: true,
+		Cron:    "0 6 * * 1-5",
+	})
+	require.NoError(t, err)
+
+	task, err := store.GetTask(ctx, "test-task")
+	require.NoError(t, err)
+	assert.Equal(t, "0 6 * * 1-5", task.Cron)
+	assert.Equal(t, time.Date(2025, 6, 9, 6, 0, 0, 0, time.UTC), task.NextRun)
+}
+
+func TestScheduler_EnsureTask_InvalidCron(t *testing.T) {
+	scheduler := NewScheduler(domain.DefaultSchedulerConfig(), newMockSchedulerStore(), nil, nil)
+
+	err := scheduler.ensureTask(context.Background(), "test-task", "Test", domain.TaskConfig{
+		Enabled: true,
+		Cron:    "bogus",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestScheduler_CronTaskSleepsUntilNextFire(t *testing.T) {
+	store := newMockSchedulerStore()
+	syncOrch := &mockSyncOrchestrator{}
+	scheduler := NewScheduler(domain.DefaultSchedulerConfig(), store, nil, syncOrch)
+	ctx := context.Background()
+
+	// Friday 06:00 fire is due
+	fakeNow := time.Date(2025, 6, 6, 6, 0, 0, 0, time.UTC)
+	scheduler.now = func() time.Time { return fakeNow }
+	require.NoError(t, store.SaveTask(ctx, &domain.ScheduledTask{
+		ID:      domain.SourceSyncTaskID("gmail"),
+		Cron:    "0 6 * * 1-5",
+		NextRun: fakeNow,
+		Enabled: true,
+	}))
+
+	scheduler.checkAndRunDueTasks(ctx)
+	scheduler.wg.Wait()
+	assert.Equal(t, 1, syncOrch.syncCount("gmail"))
+
+	// Next fire skips the weekend
+	task, err := store.GetTask(ctx, domain.SourceSyncTaskID("gmail"))
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 6, 9, 6, 0, 0, 0, time.UTC), task.NextRun)
+
+	// Shortly afterwards nothing is due; wait is capped at maxIdle
+	fakeNow = fakeNow.Add(time.Minute)
+	wait := scheduler.checkAndRunDueTasks(ctx)
+	scheduler.wg.Wait()
+	assert.Equal(t, 1, syncOrch.syncCount("gmail"))
+	assert.Equal(t, maxIdle, wait)
+
+	// Just before Monday's fire the scheduler sleeps exactly until it
+	fakeNow = time.Date(2025, 6, 9, 5, 59, 30, 0, time.UTC)
+	wait = scheduler.checkAndRunDueTasks(ctx)
+	assert.Equal(t, 30*time.Second, wait)
+}
+
+func TestScheduler_CheckAndRunDueTasks_SkipsInFlight(t *testing.T) {
+	store := newMockSchedulerStore()
+	scheduler := NewScheduler(domain.DefaultSchedulerConfig(), store, nil, &mockSyncOrchestrator{})
+	ctx := context.Background()
+
+	require.NoError(t, store.SaveTask(ctx, &domain.ScheduledTask{
+		ID:       domain.SourceSyncTaskID("files"),
+		Interval: time.Hour,
+		Enabled:  true,
+	}))
+	scheduler.inFlight[domain.SourceSyncTaskID("files")] = true
+
+	scheduler.checkAndRunDueTasks(ctx)
+	scheduler.wg.Wait()
+
+	// Task was not re-run, so its schedule is unchanged
+	task, err := store.GetTask(ctx, domain.SourceSyncTaskID("files"))
+	require.NoError(t, err)
+	assert.True(t, task.LastRun.IsZero())
+}
+
+// ==================== Concurrency and Jitter Tests ====================
+
+// countingSyncOrchestrator records the peak number of concurrent syncs.
+type countingSyncOrchestrator struct {
+	mockSyncOrchestrator
+	delay       time.Duration
+	inFlight    int
+	maxInFlight int
+}
+
+func (m *countingSyncOrchestrator) Sync(ctx context.Context, sourceID string) error {
+	m.mu.Lock()
+	m.inFlight++
+	m.maxInFlight = max(m.maxInFlight, m.inFlight)
+	m.mu.Unlock()
+
+	time.Sleep(m.delay)
+
+	m.mu.Lock()
+	m.inFlight--
+	m.mu.Unlock()
+	return m.mockSyncOrchestrator.Sync(ctx, sourceID)
+}
+
+func (m *countingSyncOrchestrator) peak() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.maxInFlight
+}
+
+func TestScheduler_MaxConcurrentSyncs(t *testing.T) {
+	config := domain.DefaultSchedulerConfig()
+	config.MaxConcurrentSyncs = 2
+	store := newMockSchedulerStore()
+	syncOrch := &countingSyncOrchestrator{delay: 20 * time.Millisecond}
+	ctx := context.Background()
+
+	scheduler := NewScheduler(config, store, nil, syncOrch)
+
+	// Six sources sharing an interval all fall due together
+	sourceIDs := []string{"a", "b", "c", "d", "e", "f"}
+	for _, id := range sourceIDs {
+		require.NoError(t, store.SaveTask(ctx, &domain.ScheduledTask{
+			ID:       domain.SourceSyncTaskID(id),
+			Interval: time.Hour,
+			NextRun:  time.Now().Add(-time.Minute),
+			Enabled:  true,
+		}))
+	}
+
+	scheduler.checkAndRunDueTasks(ctx)
+	scheduler.wg.Wait()
+
+	assert.Equal(t, 2, syncOrch.peak())
+	for _, id := range sourceIDs {
+		assert.Equal(t, 1, syncOrch.syncCount(id), "source %s", id)
+	}
+}
+
+func TestScheduler_RunDocumentSync_RespectsSharedLimiter(t *testing.T) {
+	config := domain.DefaultSchedulerConfig()
+	store := newMockSchedulerStore()
+	sourceStore := memory.NewSourceStore()
+	syncOrch := &countingSyncOrchestrator{delay: 20 * time.Millisecond}
+	ctx := context.Background()
+
+	for _, id := range []string{"a", "b", "c"} {
+		require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: id, Name: id, Type: "filesystem"}))
+		require.NoError(t, store.SaveTask(ctx, &domain.ScheduledTask{
+			ID:       domain.SourceSyncTaskID(id),
+			Interval: time.Hour,
+			NextRun:  time.Now().Add(-time.Minute),
+			Enabled:  true,
+		}))
+	}
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "d", Name: "d", Type: "filesystem"}))
+	require.NoError(t, store.SaveTask(ctx, &domain.ScheduledTask{
+		ID:       domain.TaskIDDocumentSync,
+		Interval: time.Hour,
+		NextRun:  time.Now().Add(-time.Minute),
+		Enabled:  true,
+	}))
+
+	scheduler := NewScheduler(config, store, sourceStore, syncOrch)
+	scheduler.SetSyncLimiter(NewSyncLimiter(2))
+
+	scheduler.checkAndRunDueTasks(ctx)
+	scheduler.wg.Wait()
+
+	assert.LessOrEqual(t, syncOrch.peak(), 2)
+	for _, id := range []string{"a", "b", "c", "d"} {
+		assert.Equal(t, 1, syncOrch.syncCount(id), "source %s", id)
+	}
+}
+
+func TestScheduler_AppliesJitterBeforeSync(t *testing.T) {
+	config := domain.DefaultSchedulerConfig()
+	config.Jitter = 2 * time.Minute
+	store := newMockSchedulerStore()
+	syncOrch := &mockSyncOrchestrator{}
+	ctx := context.Background()
+
+	scheduler := NewScheduler(config, store, nil, syncOrch)
+	var gotMax time.Duration
+	scheduler.jitter = func(maxDelay time.Duration) time.Duration {
+		gotMax = maxDelay
+		return 10 * time.Millisecond
+	}
+
+	task := &domain.ScheduledTask{ID: domain.SourceSyncTaskID("files"), Interval: time.Hour, Enabled: true}
+	started := time.Now()
+	scheduler.runTask(ctx, task)
+	scheduler.wg.Wait()
+
+	assert.Equal(t, 2*time.Minute, gotMax)
+	assert.GreaterOrEqual(t, time.Since(started), 10*time.Millisecond)
+	assert.Equal(t, 1, syncOrch.syncCount("files"))
+}
+
+func TestRandomJitter(t *testing.T) {
+	assert.Zero(t, randomJitter(0))
+	for range 100 {
+		d := randomJitter(time.Second)
+		assert.GreaterOrEqual(t, d, time.Duration(0))
+		assert.Less(t, d, time.Second)
+	}
+}
+
+func TestScheduler_StopWhileQueuedLeavesTaskDue(t *testing.T) {
+	config := domain.DefaultSchedulerConfig()
+	store := newMockSchedulerStore()
+	syncOrch := &mockSyncOrchestrator{}
+	ctx := context.Background()
+
+	scheduler := NewScheduler(config, store, nil, syncOrch)
+	limiter := NewSyncLimiter(1)
+	scheduler.SetSyncLimiter(limiter)
+
+	go func() { _ = scheduler.Start(ctx) }()
+	require.Eventually(t, func() bool {
+		scheduler.mu.Lock()
+		defer scheduler.mu.Unlock()
+		return scheduler.running
+	}, time.Second, 5*time.Millisecond)
+
+	// All slots are busy, so the task queues
+	require.NoError(t, limiter.Acquire(ctx))
+	task := &domain.ScheduledTask{
+		ID:       domain.SourceSyncTaskID("files"),
+		Interval: time.Hour,
+		NextRun:  time.Now().Add(time.Hour), // keep the scheduler loop from starting it too
+		Enabled:  true,
+	}
+	require.NoError(t, store.SaveTask(ctx, task))
+	scheduler.runTask(ctx, task)
+
+	require.NoError(t, scheduler.Stop())
+
+	assert.Equal(t, 0, syncOrch.syncCount("files"))
+	saved, err := store.GetTask(ctx, task.ID)
+	require.NoError(t, err)
+	assert.True(t, saved.LastRun.IsZero())
+	assert.Empty(t, saved.LastError)
+	assert.Empty(t, store.results[task.ID])
+}
@@ -2,16 +2,21 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"log/slog"
 	"sort"
 	"strings"
 	"sync"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
-	"github.com/custodia-labs/sercha-cli/internal/logger"
 )
 
 // Ensure SearchService implements the interface.
@@ -22,6 +27,8 @@ type scoredChunk struct {
 	chunkID string
 	score   float64
 	source  string // "keyword", "vector", or "merged"
+	scores  domain.ScoreBreakdown
+	snippet string // from keyword search only
 }
 
 // SearchService provides hybrid search functionality.
@@ -33,6 +40,15 @@ type SearchService struct {
 	llmService       driven.LLMService
 	sourceStore      driven.SourceStore
 	credentialsStore driven.CredentialsStore
+	settings         driving.SettingsService
+
+	// modelServices embed queries for the models named by embedding model
+	// overrides, whose vectors are searched separately.
+	modelServices map[string]driven.EmbeddingService
+
+	// queryExpander rewrites queries for semantic search when query
+	// expansion is enabled in the settings.
+	queryExpander *QueryExpander
 }
 
 // NewSearchService creates a new search service.
@@ -63,17 +79,49 @@ func (s *SearchService) SetCredentialsStore(store driven.CredentialsStore) {
 	s.credentialsStore = store
 }
 
-// Search performs hybrid search across all indexed documents.
+// SetSettingsService sets the settings service used to read BM25 parameters
+// at search time. Without it the search engine's defaults are used.
+func (s *SearchService) SetSettingsService(settings driving.SettingsService) {
+	s.settings = settings
+}
+
+// SetEmbeddingModels sets the embedding services of the models named by
+// embedding model overrides. When the vector index keeps models apart, the
+// query is embedded by each model and searched among its vectors.
+func (s *SearchService) SetEmbeddingModels(services map[string]driven.EmbeddingService) {
+	s.modelServices = services
+}
+
+// SetQueryExpander sets the expander that rewrites queries into alternative
+// phrasings for semantic search. It is only used while the query_expansion
+// setting is enabled.
+func (s *SearchService) SetQueryExpander(expander *QueryExpander) {
+	s.queryExpander = expander
+}
+
+// Search performs hybrid search across all indexed documents. The search is
+// traced as a span; the query is recorded only as a hash, since queries can
+// contain private details.
 func (s *SearchService) Search(
 	ctx context.Context, query string, opts domain.SearchOptions,
 ) ([]domain.SearchResult, error) {
-	logger.Section("Search Execution")
-	logger.Debug("Query: %q", query)
+	ctx, span := startSpan(ctx, "search", trace.WithAttributes(attribute.String("query.text", redactQuery(query))))
+	results, err := s.search(ctx, query, opts)
+	span.SetAttributes(attribute.Int("results.count", len(results)))
+	endSpan(span, err)
+	return results, err
+}
+
+// search runs a search for Search.
+func (s *SearchService) search(
+	ctx context.Context, query string, opts domain.SearchOptions,
+) ([]domain.SearchResult, error) {
+	slog.Debug("search started", slog.String("query", query))
 
 	// Return empty for empty query
 	query = strings.TrimSpace(query)
 	if query == "" {
-		logger.Debug("Empty query, returning no results")
+		slog.Debug("empty query, returning no results")
 		return []domain.SearchResult{}, nil
 	}
 
@@ -82,26 +130,35 @@ func (s *SearchService) Search(
 	if limit <= 0 {
 		limit = 20
 	}
-	logger.Debug("Limit: %d, Offset: %d", limit, opts.Offset)
+	slog.Debug("search pagination", slog.Int("limit", limit), slog.Int("offset", opts.Offset))
+
+	if opts.MinSimilarity != nil {
+		if err := domain.ValidateMinSimilarity(*opts.MinSimilarity); err != nil {
+			return nil, err
+		}
+	}
 
 	// Request more results internally to account for filtering
 	internalLimit := limit * 2
-	if len(opts.SourceIDs) > 0 {
+	if len(opts.SourceIDs) > 0 || opts.DedupeResults || opts.MergeDuplicates {
 		internalLimit = limit * 3
-		logger.Debug("Source filter: %v", opts.SourceIDs)
 	}
-	logger.Debug("Internal limit: %d", internalLimit)
+	if len(opts.SourceIDs) > 0 {
+		slog.Debug("search source filter", slog.Any("source_ids", opts.SourceIDs))
+	}
+	slog.Debug("search internal limit", slog.Int("internal_limit", internalLimit))
 
 	// Determine effective search mode based on options and available services
 	mode := s.effectiveMode(opts)
-	logger.Info("Effective search mode: %s", mode.Description())
+	slog.Info("search mode", slog.String("mode", mode.Description()))
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("search.mode", traceSearchMode(mode)))
 
 	// Log available services
-	logger.Debug("Services available: keyword=%t, vector=%t, embedding=%t, llm=%t",
-		s.searchIndex != nil,
-		s.vectorIndex != nil,
-		s.embeddingService != nil,
-		s.llmService != nil)
+	slog.Debug("search services available",
+		slog.Bool("keyword", s.searchIndex != nil),
+		slog.Bool("vector", s.vectorIndex != nil),
+		slog.Bool("embedding", s.embeddingService != nil),
+		slog.Bool("llm", s.llmService != nil))
 
 	// Execute search based on mode
 	var chunks []scoredChunk
@@ -109,32 +166,32 @@ func (s *SearchService) Search(
 
 	switch mode {
 	case domain.SearchModeTextOnly:
-		logger.Debug("Executing keyword search")
-		chunks, err = s.keywordSearch(ctx, query, internalLimit)
+		slog.Debug("executing keyword search")
+		chunks, err = s.keywordSearch(ctx, query, opts.Language, internalLimit)
 
 	case domain.SearchModeHybrid:
-		logger.Debug("Executing hybrid search (keyword + vector)")
-		chunks, err = s.hybridSearch(ctx, query, internalLimit)
+		slog.Debug("executing hybrid search (keyword + vector)")
+		chunks, err = s.hybridSearch(ctx, query, opts.Language, s.minSimilarity(opts), internalLimit)
 
 	case domain.SearchModeLLMAssisted:
-		logger.Debug("Executing LLM-assisted search")
-		chunks, err = s.llmAssistedSearch(ctx, query, internalLimit)
+		slog.Debug("executing LLM-assisted search")
+		chunks, err = s.llmAssistedSearch(ctx, query, opts.Language, internalLimit)
 
 	case domain.SearchModeFull:
-		logger.Debug("Executing full search (LLM + hybrid)")
-		chunks, err = s.fullSearch(ctx, query, internalLimit)
+		slog.Debug("executing full search (LLM + hybrid)")
+		chunks, err = s.fullSearch(ctx, query, opts.Language, s.minSimilarity(opts), internalLimit)
 
 	default:
-		logger.Debug("Fallback to keyword search")
-		chunks, err = s.keywordSearch(ctx, query, internalLimit)
+		slog.Debug("falling back to keyword search")
+		chunks, err = s.keywordSearch(ctx, query, opts.Language, internalLimit)
 	}
 
 	if err != nil {
-		logger.Warn("Search failed: %v", err)
+		slog.Debug("search failed", slog.Any("error", err))
 		return nil, fmt.Errorf("search: %w", err)
 	}
 
-	logger.Debug("Raw results: %d chunks", len(chunks))
+	slog.Debug("search raw results", slog.Int("chunks", len(chunks)))
 
 	// Hydrate results with full document data
 	results, err := s.hydrateResults(ctx, chunks, query)
@@ -142,21 +199,91 @@ func (s *SearchService) Search(
 		return nil, fmt.Errorf("hydrate results: %w", err)
 	}
 
-	logger.Debug("Hydrated results: %d documents", len(results))
+	slog.Debug("search hydrated results", slog.Int("documents", len(results)))
 
 	// Filter by source IDs if specified
 	if len(opts.SourceIDs) > 0 {
 		results = s.filterBySourceIDs(results, opts.SourceIDs)
-		logger.Debug("After source filter: %d results", len(results))
+		slog.Debug("search results after source filter", slog.Int("results", len(results)))
+	}
+
+	// Collapse duplicate hits after ranking
+	if opts.DedupeResults || opts.MergeDuplicates {
+		results = dedupeResults(results, opts.MergeDuplicates)
+		slog.Debug("search results after dedupe", slog.Int("results", len(results)))
 	}
 
 	// Apply pagination
 	results = s.applyPagination(results, opts.Offset, limit)
-	logger.Info("Final results: %d", len(results))
+	slog.Info("search finished", slog.Int("results", len(results)))
 
 	return results, nil
 }
 
+// dedupeResults collapses results sharing a document ID, keeping the
+// best-scoring chunk in the position of the document's first hit. With
+// mergeContent, documents with identical content are collapsed too.
+func dedupeResults(results []domain.SearchResult, mergeContent bool) []domain.SearchResult {
+	deduped := make([]domain.SearchResult, 0, len(results))
+	seen := make(map[string]int, len(results))
+
+	for i := range results {
+		keys := []string{"doc:" + results[i].Document.ID}
+		if mergeContent {
+			if hash := contentHash(results[i].Document.Content); hash != "" {
+				keys = append(keys, "content:"+hash)
+			}
+		}
+
+		idx, found := -1, false
+		for _, key := range keys {
+			if idx, found = seen[key]; found {
+				break
+			}
+		}
+		if !found {
+			idx = len(deduped)
+			deduped = append(deduped, results[i])
+		} else if results[i].Score > deduped[idx].Score {
+			deduped[idx] = results[i]
+		}
+		for _, key := range keys {
+			seen[key] = idx
+		}
+	}
+
+	return deduped
+}
+
+// contentHash returns a hex SHA-256 of content with surrounding whitespace
+// removed, or "" for empty content.
+func contentHash(content string) string {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// redactQuery returns a stand-in for query that identifies repeated queries
+// without revealing them.
+func redactQuery(query string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(query)))
+	return "sha256:" + hex.EncodeToString(sum[:8])
+}
+
+// traceSearchMode names the retrieval a search mode runs, for traces: LLM
+// assistance only rewrites the query, so it does not change the retrieval.
+func traceSearchMode(mode domain.SearchMode) string {
+	switch mode {
+	case domain.SearchModeHybrid, domain.SearchModeFull:
+		return "hybrid"
+	default:
+		return "keyword"
+	}
+}
+
 // effectiveMode determines the search mode based on options and available services.
 // It gracefully degrades if required services are unavailable.
 func (s *SearchService) effectiveMode(opts domain.SearchOptions) domain.SearchMode {
@@ -193,21 +320,21 @@ func (s *SearchService) effectiveMode(opts domain.SearchOptions) domain.SearchMo
 }
 
 // keywordSearch performs full-text search using Xapian.
-func (s *SearchService) keywordSearch(ctx context.Context, query string, limit int) ([]scoredChunk, error) {
+func (s *SearchService) keywordSearch(ctx context.Context, query, language string, limit int) ([]scoredChunk, error) {
 	if s.searchIndex == nil {
-		logger.Warn("Keyword search unavailable: search engine is nil")
+		slog.Debug("keyword search unavailable: no search engine")
 		return nil, errors.New("search engine unavailable")
 	}
 
-	logger.Debug("Keyword search: query=%q, limit=%d", query, limit)
+	slog.Debug("keyword search", slog.String("query", query), slog.Int("limit", limit))
 
-	hits, err := s.searchIndex.Search(ctx, query, limit)
+	hits, err := s.searchKeywords(ctx, query, language, limit)
 	if err != nil {
-		logger.Warn("Keyword search error: %v", err)
+		slog.Debug("keyword search failed", slog.Any("error", err))
 		return nil, fmt.Errorf("keyword search: %w", err)
 	}
 
-	logger.Debug("Keyword search: %d hits", len(hits))
+	slog.Debug("keyword search hits", slog.Int("hits", len(hits)))
 
 	results := make([]scoredChunk, len(hits))
 	for i, hit := range hits {
@@ -215,58 +342,203 @@ func (s *SearchService) keywordSearch(ctx context.Context, query string, limit i
 			chunkID: hit.ChunkID,
 			score:   hit.Score,
 			source:  "keyword",
+			scores:  domain.ScoreBreakdown{Keyword: hit.Score},
+			snippet: hit.Snippet,
 		}
 	}
 
 	return results, nil
 }
 
-// vectorSearch performs semantic similarity search using HNSW.
-func (s *SearchService) vectorSearch(ctx context.Context, query string, limit int) ([]scoredChunk, error) {
+// searchKeywords queries the search engine, applying the configured BM25
+// parameters and stemming language when the engine supports them. A
+// non-empty language hint overrides the configured language.
+func (s *SearchService) searchKeywords(
+	ctx context.Context, query, language string, limit int,
+) ([]driven.SearchHit, error) {
+	tuner, ok := s.searchIndex.(driven.BM25SearchEngine)
+	if !ok {
+		return s.searchIndex.Search(ctx, query, limit)
+	}
+
+	k1, b := domain.DefaultBM25K1, domain.DefaultBM25B
+	tuned := false
+	if s.settings != nil {
+		settings, err := s.settings.Get()
+		if err == nil {
+			err = settings.Search.ValidateBM25()
+		}
+		if err != nil {
+			slog.Warn("ignoring BM25 settings, using defaults", slog.Any("error", err))
+		} else {
+			k1, b = settings.Search.BM25K1, settings.Search.BM25B
+			tuned = true
+			if language == "" && domain.ValidateSearchLanguage(settings.DefaultSearchLanguage) == nil {
+				language = settings.DefaultSearchLanguage
+			}
+		}
+	}
+	if !tuned && language == "" {
+		return s.searchIndex.Search(ctx, query, limit)
+	}
+
+	slog.Debug("keyword search tuning",
+		slog.Float64("bm25_k1", k1), slog.Float64("bm25_b", b), slog.String("language", language))
+	return tuner.SearchBM25(ctx, query, limit, k1, b, language)
+}
+
+// minSimilarity returns the cosine similarity floor for semantic results:
+// the query's override, else the configured floor.
+func (s *SearchService) minSimilarity(opts domain.SearchOptions) float64 {
+	if opts.MinSimilarity != nil {
+		return *opts.MinSimilarity
+	}
+	if s.settings == nil {
+		return 0
+	}
+	settings, err := s.settings.Get()
+	if err == nil {
+		err = settings.Search.ValidateMinSimilarity()
+	}
+	if err != nil {
+		slog.Warn("ignoring min_similarity setting", slog.Any("error", err))
+		return 0
+	}
+	return settings.Search.MinSimilarity
+}
+
+// vectorSearch performs semantic similarity search using HNSW, dropping
+// neighbours less similar than minSimilarity.
+func (s *SearchService) vectorSearch(
+	ctx context.Context, query string, minSimilarity float64, limit int,
+) ([]scoredChunk, error) {
 	if s.vectorIndex == nil {
-		logger.Warn("Vector search unavailable: vector index is nil")
+		slog.Debug("vector search unavailable: no vector index")
 		return nil, errors.New("vector index unavailable")
 	}
 	if s.embeddingService == nil {
-		logger.Warn("Vector search unavailable: embedding service is nil")
+		slog.Debug("vector search unavailable: no embedding service")
 		return nil, errors.New("embedding service unavailable")
 	}
 
-	logger.Debug("Vector search: query=%q, limit=%d", query, limit)
+	slog.Debug("vector search", slog.String("query", query), slog.Int("limit", limit))
 
 	// Generate query embedding
-	logger.Debug("Generating query embedding...")
-	embedding, err := s.embeddingService.Embed(ctx, query)
+	variants := s.expandQuery(ctx, query)
+	embedding, err := embedQuery(ctx, s.embeddingService, query, variants)
 	if err != nil {
-		logger.Warn("Query embedding failed: %v", err)
+		slog.Debug("query embedding failed", slog.Any("error", err))
 		return nil, fmt.Errorf("generate query embedding: %w", err)
 	}
-	logger.Debug("Query embedding: %d dimensions", len(embedding))
+	slog.Debug("query embedded", slog.Int("dimensions", len(embedding)))
 
 	// Search vector index
 	hits, err := s.vectorIndex.Search(ctx, embedding, limit)
 	if err != nil {
-		logger.Warn("Vector index search failed: %v", err)
+		slog.Debug("vector index search failed", slog.Any("error", err))
 		return nil, fmt.Errorf("vector search: %w", err)
 	}
+	hits = append(hits, s.searchOtherModels(ctx, query, variants, limit)...)
+	if len(hits) > limit {
+		sort.SliceStable(hits, func(i, j int) bool { return hits[i].Similarity > hits[j].Similarity })
+		hits = hits[:limit]
+	}
 
-	logger.Debug("Vector search: %d hits", len(hits))
+	slog.Debug("vector search hits", slog.Int("hits", len(hits)))
 
-	results := make([]scoredChunk, len(hits))
-	for i, hit := range hits {
-		results[i] = scoredChunk{
+	results := make([]scoredChunk, 0, len(hits))
+	for _, hit := range hits {
+		if hit.Similarity < minSimilarity {
+			continue
+		}
+		results = append(results, scoredChunk{
 			chunkID: hit.ChunkID,
 			score:   hit.Similarity, // Cosine similarity 0-1
 			source:  "vector",
-		}
+			scores:  domain.ScoreBreakdown{Vector: hit.Similarity},
+		})
+	}
+	if dropped := len(hits) - len(results); dropped > 0 {
+		slog.Debug("vector search dropped dissimilar hits",
+			slog.Int("dropped", dropped), slog.Float64("min_similarity", minSimilarity))
 	}
 
 	return results, nil
 }
 
+// searchOtherModels searches the vectors of the override embedding models,
+// each with the query embedded by that model. A model that fails is logged
+// and skipped, as its documents are still found by keyword search.
+func (s *SearchService) searchOtherModels(
+	ctx context.Context, query string, variants []string, limit int,
+) []driven.VectorHit {
+	index, ok := s.vectorIndex.(driven.ModelVectorIndex)
+	if !ok {
+		return nil
+	}
+
+	var hits []driven.VectorHit
+	for model, svc := range s.modelServices {
+		embedding, err := embedQuery(ctx, svc, query, variants)
+		if err != nil {
+			slog.Warn("query embedding failed", slog.String("model", model), slog.Any("error", err))
+			continue
+		}
+		modelHits, err := index.SearchModel(ctx, model, embedding, limit)
+		if err != nil {
+			slog.Warn("vector index search failed", slog.String("model", model), slog.Any("error", err))
+			continue
+		}
+		slog.Debug("vector search hits", slog.String("model", model), slog.Int("hits", len(modelHits)))
+		hits = append(hits, modelHits...)
+	}
+	return hits
+}
+
+// expandQuery returns the LLM's alternative phrasings of query when query
+// expansion is enabled, or nil if it is disabled or fails.
+func (s *SearchService) expandQuery(ctx context.Context, query string) []string {
+	if s.queryExpander == nil || s.settings == nil {
+		return nil
+	}
+	settings, err := s.settings.Get()
+	if err != nil || !settings.Search.QueryExpansion {
+		return nil
+	}
+
+	variants, err := s.queryExpander.Expand(ctx, query)
+	if err != nil {
+		slog.Warn("query expansion failed, using original query", slog.Any("error", err))
+		return nil
+	}
+	slog.Info("query expanded", slog.Any("variants", variants))
+	return variants
+}
+
+// embedQuery embeds query with svc. Given alternative phrasings, it returns
+// the centroid of the embeddings of the query and its phrasings, falling
+// back to the query's own embedding if they cannot be embedded.
+func embedQuery(
+	ctx context.Context, svc driven.EmbeddingService, query string, variants []string,
+) ([]float32, error) {
+	if len(variants) > 0 {
+		embeddings, err := svc.EmbedBatch(ctx, append([]string{query}, variants...))
+		if err == nil && len(embeddings) != len(variants)+1 {
+			err = fmt.Errorf("got %d embeddings for %d texts", len(embeddings), len(variants)+1)
+		}
+		if err == nil {
+			return centroid(embeddings), nil
+		}
+		slog.Warn("embedding expanded query failed, using original query", slog.Any("error", err))
+	}
+	return svc.Embed(ctx, query)
+}
+
 // hybridSearch combines keyword and vector search using RRF.
-func (s *SearchService) hybridSearch(ctx context.Context, query string, limit int) ([]scoredChunk, error) {
-	logger.Debug("Hybrid search: running keyword and vector searches in parallel")
+func (s *SearchService) hybridSearch(
+	ctx context.Context, query, language string, minSimilarity float64, limit int,
+) ([]scoredChunk, error) {
+	slog.Debug("hybrid search: running keyword and vector searches in parallel")
 
 	// Run keyword and vector searches in parallel
 	var keywordResults, vectorResults []scoredChunk
@@ -277,79 +549,83 @@ func (s *SearchService) hybridSearch(ctx context.Context, query string, limit in
 
 	go func() {
 		defer wg.Done()
-		keywordResults, keywordErr = s.keywordSearch(ctx, query, limit)
+		keywordResults, keywordErr = s.keywordSearch(ctx, query, language, limit)
 	}()
 
 	go func() {
 		defer wg.Done()
-		vectorResults, vectorErr = s.vectorSearch(ctx, query, limit)
+		vectorResults, vectorErr = s.vectorSearch(ctx, query, minSimilarity, limit)
 	}()
 
 	wg.Wait()
 
 	// Handle errors gracefully - degrade if one search fails
 	if keywordErr != nil && vectorErr != nil {
-		logger.Warn("Hybrid search: both keyword and vector searches failed")
+		slog.Debug("hybrid search: keyword and vector searches failed")
 		return nil, fmt.Errorf("hybrid search: keyword=%w, vector=%w", keywordErr, vectorErr)
 	}
 
 	if keywordErr != nil {
-		logger.Warn("Hybrid search: keyword search failed, using vector results only")
+		slog.Warn("keyword search failed, using vector results only", slog.Any("error", keywordErr))
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String("search.mode", "semantic"))
 		return vectorResults, nil
 	}
 
 	if vectorErr != nil {
-		logger.Warn("Hybrid search: vector search failed, using keyword results only")
+		slog.Warn("vector search failed, using keyword results only", slog.Any("error", vectorErr))
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String("search.mode", "keyword"))
 		return keywordResults, nil
 	}
 
 	// Merge using Reciprocal Rank Fusion
-	logger.Debug("Hybrid search: merging %d keyword + %d vector results with RRF",
-		len(keywordResults), len(vectorResults))
 	merged := s.reciprocalRankFusion(keywordResults, vectorResults, 60)
-	logger.Debug("Hybrid search: merged to %d results", len(merged))
+	slog.Debug("hybrid search: merged results with RRF",
+		slog.Int("keyword", len(keywordResults)), slog.Int("vector", len(vectorResults)),
+		slog.Int("merged", len(merged)))
 
 	return merged, nil
 }
 
 // llmAssistedSearch uses LLM to expand the query before keyword search.
-func (s *SearchService) llmAssistedSearch(ctx context.Context, query string, limit int) ([]scoredChunk, error) {
+func (s *SearchService) llmAssistedSearch(ctx context.Context, query, language string, limit int) ([]scoredChunk, error) {
 	// Expand query using LLM if available
 	expandedQuery := query
 	if s.llmService != nil {
-		logger.Debug("LLM query rewrite: original=%q", query)
+		slog.Debug("rewriting query with LLM", slog.String("query", query))
 		expanded, err := s.llmService.RewriteQuery(ctx, query)
 		if err == nil && expanded != "" {
 			expandedQuery = expanded
-			logger.Info("LLM query rewrite: expanded=%q", expanded)
+			slog.Info("query rewritten", slog.String("rewritten", expanded))
 		} else if err != nil {
-			logger.Warn("LLM query rewrite failed: %v (using original query)", err)
+			slog.Warn("LLM query rewrite failed, using original query", slog.Any("error", err))
 		}
 	} else {
-		logger.Debug("LLM service not available, using original query")
+		slog.Debug("LLM service not available, using original query")
 	}
 
 	// Perform keyword search with expanded query
-	return s.keywordSearch(ctx, expandedQuery, limit)
+	return s.keywordSearch(ctx, expandedQuery, language, limit)
 }
 
 // fullSearch combines LLM query expansion with hybrid search.
-func (s *SearchService) fullSearch(ctx context.Context, query string, limit int) ([]scoredChunk, error) {
+func (s *SearchService) fullSearch(
+	ctx context.Context, query, language string, minSimilarity float64, limit int,
+) ([]scoredChunk, error) {
 	// Expand query using LLM if available
 	expandedQuery := query
 	if s.llmService != nil {
-		logger.Debug("Full search: LLM query rewrite for %q", query)
+		slog.Debug("rewriting query with LLM", slog.String("query", query))
 		expanded, err := s.llmService.RewriteQuery(ctx, query)
 		if err == nil && expanded != "" {
 			expandedQuery = expanded
-			logger.Info("Full search: expanded query=%q", expanded)
+			slog.Info("query rewritten", slog.String("rewritten", expanded))
 		} else if err != nil {
-			logger.Warn("Full search: LLM rewrite failed: %v", err)
+			slog.Warn("LLM query rewrite failed, using original query", slog.Any("error", err))
 		}
 	}
 
 	// Run hybrid search with the expanded query
-	return s.hybridSearch(ctx, expandedQuery, limit)
+	return s.hybridSearch(ctx, expandedQuery, language, minSimilarity, limit)
 }
 
 // Merges two ranked lists using Reciprocal Rank Fusion (RRF).
@@ -358,12 +634,18 @@ func (s *SearchService) fullSearch(ctx context.Context, query string, limit int)
 //nolint:godot // Private method - no exported name to start with.
 func (s *SearchService) reciprocalRankFusion(list1, list2 []scoredChunk, k int) []scoredChunk {
 	scores := make(map[string]float64)
+	components := make(map[string]domain.ScoreBreakdown)
+	snippets := make(map[string]string)
 	seen := make(map[string]bool)
 
 	// Calculate RRF scores for list1
 	for rank, chunk := range list1 {
 		rrf := 1.0 / float64(k+rank+1)
 		scores[chunk.chunkID] += rrf
+		components[chunk.chunkID] = mergeScores(components[chunk.chunkID], chunk.scores)
+		if chunk.snippet != "" {
+			snippets[chunk.chunkID] = chunk.snippet
+		}
 		seen[chunk.chunkID] = true
 	}
 
@@ -371,16 +653,24 @@ func (s *SearchService) reciprocalRankFusion(list1, list2 []scoredChunk, k int)
 	for rank, chunk := range list2 {
 		rrf := 1.0 / float64(k+rank+1)
 		scores[chunk.chunkID] += rrf
+		components[chunk.chunkID] = mergeScores(components[chunk.chunkID], chunk.scores)
+		if chunk.snippet != "" {
+			snippets[chunk.chunkID] = chunk.snippet
+		}
 		seen[chunk.chunkID] = true
 	}
 
 	// Convert to slice and sort by combined score
 	results := make([]scoredChunk, 0, len(seen))
 	for id := range seen {
+		breakdown := components[id]
+		breakdown.Fused = scores[id]
 		results = append(results, scoredChunk{
 			chunkID: id,
 			score:   scores[id],
 			source:  "merged",
+			scores:  breakdown,
+			snippet: snippets[id],
 		})
 	}
 
@@ -391,6 +681,15 @@ func (s *SearchService) reciprocalRankFusion(list1, list2 []scoredChunk, k int)
 	return results
 }
 
+// mergeScores combines the component scores of the same chunk from two searches.
+func mergeScores(a, b domain.ScoreBreakdown) domain.ScoreBreakdown {
+	return domain.ScoreBreakdown{
+		Keyword: max(a.Keyword, b.Keyword),
+		Vector:  max(a.Vector, b.Vector),
+		Fused:   max(a.Fused, b.Fused),
+	}
+}
+
 // hydrateResults converts chunk IDs to full SearchResult objects.
 func (s *SearchService) hydrateResults(
 	ctx context.Context, chunks []scoredChunk, query string,
@@ -432,7 +731,9 @@ func (s *SearchService) hydrateResults(
 			Document:   *doc,
 			Chunk:      *chunk,
 			Score:      sc.score,
+			Scores:     sc.scores,
 			Highlights: highlights,
+			Snippet:    sc.snippet,
 			SourceName: sourceName,
 		})
 	}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// Ensure SearchSessionService implements the interface.
+var _ driving.SearchSessionService = (*SearchSessionService)(nil)
+
+// SearchSessionService remembers the last TUI search.
+type SearchSessionService struct {
+	store driven.SearchSessionStore
+}
+
+// NewSearchSessionService creates a new search session service.
+func NewSearchSessionService(store driven.SearchSessionStore) *SearchSessionService {
+	return &SearchSessionService{store: store}
+}
+
+// Load returns the last search, or nil if there is none.
+func (s *SearchSessionService) Load() (*domain.SearchSession, error) {
+	if s.store == nil {
+		return nil, domain.ErrNotImplemented
+	}
+	session, err := s.store.Load()
+	if err != nil || session == nil {
+		return nil, err
+	}
+	bounded := session.Bounded()
+	return &bounded, nil
+}
+
+// Save remembers session as the last search. Long queries are truncated;
+// an empty query clears the last search.
+func (s *SearchSessionService) Save(session domain.SearchSession) error {
+	if s.store == nil {
+		return domain.ErrNotImplemented
+	}
+	if strings.TrimSpace(session.Query) == "" {
+		return s.store.Clear()
+	}
+	return s.store.Save(session.Bounded())
+}
+
+// Clear forgets the last search.
+func (s *SearchSessionService) Clear() error {
+	if s.store == nil {
+		return domain.ErrNotImplemented
+	}
+	return s.store.Clear()
+}
@@ -0,0 +1,81 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// mockSearchSessionStore implements driven.SearchSessionStore in memory.
+type mockSearchSessionStore struct {
+	session *domain.SearchSession
+}
+
+func (m *mockSearchSessionStore) Load() (*domain.SearchSession, error) {
+	return m.session, nil
+}
+
+func (m *mockSearchSessionStore) Save(session domain.SearchSession) error {
+	m.session = &session
+	return nil
+}
+
+func (m *mockSearchSessionStore) Clear() error {
+	m.session = nil
+	return nil
+}
+
+func TestSearchSessionService_SaveThenLoad(t *testing.T) {
+	svc := NewSearchSessionService(&mockSearchSessionStore{})
+
+	require.NoError(t, svc.Save(domain.SearchSession{Query: "invoices 2024", Selected: 4}))
+
+	session, err := svc.Load()
+	require.NoError(t, err)
+	assert.Equal(t, &domain.SearchSession{Query: "invoices 2024", Selected: 4}, session)
+}
+
+func TestSearchSessionService_SaveIsBounded(t *testing.T) {
+	store := &mockSearchSessionStore{}
+	svc := NewSearchSessionService(store)
+
+	require.NoError(t, svc.Save(domain.SearchSession{Query: strings.Repeat("é", 1000), Selected: -2}))
+
+	require.NotNil(t, store.session)
+	assert.LessOrEqual(t, len(store.session.Query), domain.MaxSearchSessionQueryLength)
+	assert.Equal(t, strings.Repeat("é", domain.MaxSearchSessionQueryLength/2), store.session.Query)
+	assert.Equal(t, 0, store.session.Selected)
+}
+
+func TestSearchSessionService_EmptyQueryClears(t *testing.T) {
+	store := &mockSearchSessionStore{session: &domain.SearchSession{Query: "old"}}
+	svc := NewSearchSessionService(store)
+
+	require.NoError(t, svc.Save(domain.SearchSession{Query: "  ", Selected: 2}))
+
+	assert.Nil(t, store.session)
+}
+
+func TestSearchSessionService_Clear(t *testing.T) {
+	store := &mockSearchSessionStore{session: &domain.SearchSession{Query: "old"}}
+	svc := NewSearchSessionService(store)
+
+	require.NoError(t, svc.Clear())
+
+	session, err := svc.Load()
+	require.NoError(t, err)
+	assert.Nil(t, session)
+}
+
+func TestSearchSessionService_NoStore(t *testing.T) {
+	svc := NewSearchSessionService(nil)
+
+	_, err := svc.Load()
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+	assert.ErrorIs(t, svc.Save(domain.SearchSession{Query: "q"}), domain.ErrNotImplemented)
+	assert.ErrorIs(t, svc.Clear(), domain.ErrNotImplemented)
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -46,6 +47,23 @@ func (m *mockSearchEngine) Close() error {
 	return nil
 }
 
+// mockBM25SearchEngine records the BM25 parameters it was searched with.
+type mockBM25SearchEngine struct {
+	mockSearchEngine
+	bm25Calls int
+	k1, b     float64
+	language  string
+}
+
+func (m *mockBM25SearchEngine) SearchBM25(
+	ctx context.Context, query string, limit int, k1, b float64, language string,
+) ([]driven.SearchHit, error) {
+	m.bm25Calls++
+	m.k1, m.b = k1, b
+	m.language = language
+	return m.Search(ctx, query, limit)
+}
+
 // mockVectorIndex implements driven.VectorIndex for testing.
 type mockVectorIndex struct {
 	hits      []driven.VectorHit
@@ -122,18 +140,39 @@ func (m *mockEmbeddingService) Close() error {
 
 // mockLLMService implements driven.LLMService for testing.
 type mockLLMService struct {
-	rewriteResult string
-	rewriteErr    error
+	rewriteResult  string
+	rewriteErr     error
+	generateResult string
+	generateErr    error
 }
 
 func (m *mockLLMService) Generate(_ context.Context, _ string, _ driven.GenerateOptions) (string, error) {
-	return "", nil
+	return m.generateResult, m.generateErr
 }
 
 func (m *mockLLMService) Chat(_ context.Context, _ []driven.ChatMessage, _ driven.ChatOptions) (string, error) {
 	return "", nil
 }
 
+// ChatStream streams generateResult a word at a time, then generateErr.
+func (m *mockLLMService) ChatStream(
+	_ context.Context, _ []driven.ChatMessage, _ driven.ChatOptions,
+) (<-chan string, <-chan error) {
+	chunks := make(chan string)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		if m.generateErr == nil {
+			for _, word := range strings.SplitAfter(m.generateResult, " ") {
+				chunks <- word
+			}
+		}
+		close(chunks)
+		errs <- m.generateErr
+	}()
+	return chunks, errs
+}
+
 func (m *mockLLMService) RewriteQuery(_ context.Context, query string) (string, error) {
 	if m.rewriteErr != nil {
 		return "", m.rewriteErr
@@ -272,6 +311,73 @@ func TestSearchService_Search_KeywordOnly(t *testing.T) {
 	}
 }
 
+func TestSearchService_Search_UsesBM25Settings(t *testing.T) {
+	docStore := setupTestDocStore(t)
+	searchEngine := &mockBM25SearchEngine{mockSearchEngine: mockSearchEngine{hits: createTestHits()}}
+	settings := NewSettingsService(memory.NewConfigStore(), nil)
+	service := NewSearchService(docStore, searchEngine, nil, nil, nil)
+	service.SetSettingsService(settings)
+	ctx := context.Background()
+
+	_, err := service.Search(ctx, "sercha", domain.SearchOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, searchEngine.bm25Calls)
+	assert.InDelta(t, domain.DefaultBM25K1, searchEngine.k1, 1e-9)
+	assert.InDelta(t, domain.DefaultBM25B, searchEngine.b, 1e-9)
+
+	// Changes apply to the next search without rebuilding the service
+	require.NoError(t, settings.Set("bm25_k1", "1.2"))
+	require.NoError(t, settings.Set("bm25_b", "0.75"))
+
+	results, err := service.Search(ctx, "sercha", domain.SearchOptions{})
+	require.NoError(t, err)
+	assert.Len(t, results, 3)
+	assert.InDelta(t, 1.2, searchEngine.k1, 1e-9)
+	assert.InDelta(t, 0.75, searchEngine.b, 1e-9)
+}
+
+func TestSearchService_Search_InvalidBM25SettingsUseDefaults(t *testing.T) {
+	docStore := setupTestDocStore(t)
+	searchEngine := &mockBM25SearchEngine{mockSearchEngine: mockSearchEngine{hits: createTestHits()}}
+	store := memory.NewConfigStore()
+	_ = store.Set("search.bm25_b", 3.0)
+	service := NewSearchService(docStore, searchEngine, nil, nil, nil)
+	service.SetSettingsService(NewSettingsService(store, nil))
+
+	results, err := service.Search(context.Background(), "sercha", domain.SearchOptions{})
+
+	require.NoError(t, err)
+	assert.Len(t, results, 3)
+	assert.Zero(t, searchEngine.bm25Calls)
+}
+
+func TestSearchService_Search_Language(t *testing.T) {
+	docStore := setupTestDocStore(t)
+	searchEngine := &mockBM25SearchEngine{mockSearchEngine: mockSearchEngine{hits: createTestHits()}}
+	settings := NewSettingsService(memory.NewConfigStore(), nil)
+	service := NewSearchService(docStore, searchEngine, nil, nil, nil)
+	ctx := context.Background()
+
+	// A hint is passed on even without settings
+	_, err := service.Search(ctx, "recherche", domain.SearchOptions{Language: "fr"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, searchEngine.bm25Calls)
+	assert.Equal(t, "fr", searchEngine.language)
+	assert.InDelta(t, domain.DefaultBM25K1, searchEngine.k1, 1e-9)
+
+	// Without a hint the configured language is used
+	service.SetSettingsService(settings)
+	require.NoError(t, settings.Set("language", "de"))
+	_, err = service.Search(ctx, "suche", domain.SearchOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "de", searchEngine.language)
+
+	// A hint overrides the configured language
+	_, err = service.Search(ctx, "recherche", domain.SearchOptions{Language: "fr"})
+	require.NoError(t, err)
+	assert.Equal(t, "fr", searchEngine.language)
+}
+
 func TestSearchService_Search_HybridMode(t *testing.T) {
 	docStore := setupTestDocStore(t)
 	searchEngine := &mockSearchEngine{hits: createTestHits()}
@@ -288,6 +394,188 @@ func TestSearchService_Search_HybridMode(t *testing.T) {
 	assert.NotEmpty(t, results)
 }
 
+// resultChunkIDs returns the chunk IDs of results, in order.
+func resultChunkIDs(results []domain.SearchResult) []string {
+	ids := make([]string, len(results))
+	for i, r := range results {
+		ids[i] = r.Chunk.ID
+	}
+	return ids
+}
+
+func TestSearchService_Search_MinSimilarity(t *testing.T) {
+	docStore := setupTestDocStore(t)
+	vectorIndex := &mockVectorIndex{hits: createTestVectorHits()}
+	embedService := &mockEmbeddingService{embedding: make([]float32, 384)}
+	settings := NewSettingsService(memory.NewConfigStore(), nil)
+	service := NewSearchService(docStore, &mockSearchEngine{}, vectorIndex, embedService, nil)
+	service.SetSettingsService(settings)
+	ctx := context.Background()
+
+	// No floor by default
+	results, err := service.Search(ctx, "configure", domain.SearchOptions{Semantic: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"chunk-doc-2", "chunk-doc-1", "chunk-doc-3"}, resultChunkIDs(results))
+
+	// Neighbours below the configured floor are dropped
+	require.NoError(t, settings.Set("min_similarity", "0.8"))
+	results, err = service.Search(ctx, "configure", domain.SearchOptions{Semantic: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"chunk-doc-2", "chunk-doc-1"}, resultChunkIDs(results))
+
+	// The query's floor overrides the configured one
+	floor := 0.9
+	results, err = service.Search(ctx, "configure", domain.SearchOptions{Semantic: true, MinSimilarity: &floor})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"chunk-doc-2"}, resultChunkIDs(results))
+
+	floor = 0.99
+	results, err = service.Search(ctx, "configure", domain.SearchOptions{Semantic: true, MinSimilarity: &floor})
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+// queryVectorIndex records the query vectors it is searched with.
+type queryVectorIndex struct {
+	mockVectorIndex
+	queries [][]float32
+}
+
+func (m *queryVectorIndex) Search(ctx context.Context, query []float32, k int) ([]driven.VectorHit, error) {
+	m.queries = append(m.queries, query)
+	return m.mockVectorIndex.Search(ctx, query, k)
+}
+
+// queryRecordingSearchEngine records the queries of keyword searches.
+type queryRecordingSearchEngine struct {
+	mockSearchEngine
+	queries []string
+}
+
+func (m *queryRecordingSearchEngine) Search(ctx context.Context, query string, limit int) ([]driven.SearchHit, error) {
+	m.queries = append(m.queries, query)
+	return m.mockSearchEngine.Search(ctx, query, limit)
+}
+
+// textEmbeddingService embeds each text as the vector given for it.
+type textEmbeddingService struct {
+	mockEmbeddingService
+	vectors map[string][]float32
+}
+
+func (m *textEmbeddingService) Embed(_ context.Context, text string) ([]float32, error) {
+	return m.vectors[text], nil
+}
+
+func (m *textEmbeddingService) EmbedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	result := make([][]float32, len(texts))
+	for i, text := range texts {
+		result[i], _ = m.Embed(ctx, text)
+	}
+	return result, nil
+}
+
+func TestSearchService_Search_QueryExpansion(t *testing.T) {
+	docStore := setupTestDocStore(t)
+	searchEngine := &queryRecordingSearchEngine{}
+	vectorIndex := &queryVectorIndex{mockVectorIndex: mockVectorIndex{hits: createTestVectorHits()}}
+	embedService := &textEmbeddingService{vectors: map[string][]float32{
+		"auth bug":               {1, 0, 0},
+		"authentication failure": {0, 1, 0},
+		"login issue":            {0, 0, 1},
+		"sign-in error":          {1, 1, 1},
+	}}
+	llm := &mockLLMService{generateResult: "1. authentication failure\n2. \"login issue\"\n- sign-in error\n"}
+	settings := NewSettingsService(memory.NewConfigStore(), nil)
+	service := NewSearchService(docStore, searchEngine, vectorIndex, embedService, llm)
+	service.SetSettingsService(settings)
+	service.SetQueryExpander(NewQueryExpander(llm))
+	ctx := context.Background()
+
+	// Disabled by default
+	_, err := service.Search(ctx, "auth bug", domain.SearchOptions{Hybrid: true})
+	require.NoError(t, err)
+	assert.Equal(t, []float32{1, 0, 0}, vectorIndex.queries[0])
+
+	// Enabled, the vector query is the centroid of the query and its phrasings
+	require.NoError(t, settings.Set("query_expansion", "true"))
+	_, err = service.Search(ctx, "auth bug", domain.SearchOptions{Hybrid: true})
+	require.NoError(t, err)
+	assert.Equal(t, []float32{0.5, 0.5, 0.5}, vectorIndex.queries[1])
+	assert.Equal(t, []string{"auth bug", "auth bug"}, searchEngine.queries, "keyword search is not expanded")
+
+	// An LLM failure falls back to the query's own embedding
+	llm.generateErr = errors.New("llm unavailable")
+	_, err = service.Search(ctx, "auth bug", domain.SearchOptions{Hybrid: true})
+	require.NoError(t, err)
+	assert.Equal(t, []float32{1, 0, 0}, vectorIndex.queries[2])
+}
+
+func TestSearchService_Search_MinSimilarityKeepsKeywordHits(t *testing.T) {
+	docStore := setupTestDocStore(t)
+	searchEngine := &mockSearchEngine{hits: []driven.SearchHit{{ChunkID: "chunk-doc-3", Score: 0.7}}}
+	vectorIndex := &mockVectorIndex{hits: createTestVectorHits()}
+	embedService := &mockEmbeddingService{embedding: make([]float32, 384)}
+	service := NewSearchService(docStore, searchEngine, vectorIndex, embedService, nil)
+	floor := 0.9
+
+	results, err := service.Search(context.Background(), "api", domain.SearchOptions{
+		Hybrid:        true,
+		MinSimilarity: &floor,
+	})
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"chunk-doc-2", "chunk-doc-3"}, resultChunkIDs(results))
+	for _, r := range results {
+		if r.Chunk.ID == "chunk-doc-3" {
+			assert.Zero(t, r.Scores.Vector, "the vector score below the floor is not fused")
+		}
+	}
+}
+
+func TestSearchService_Search_InvalidMinSimilarity(t *testing.T) {
+	service := NewSearchService(setupTestDocStore(t), &mockSearchEngine{}, nil, nil, nil)
+	floor := 1.5
+
+	_, err := service.Search(context.Background(), "sercha", domain.SearchOptions{MinSimilarity: &floor})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestSearchService_Search_CarriesSnippets(t *testing.T) {
+	hits := createTestHits()
+	hits[0].Snippet = "<b>Sercha</b> is a search engine"
+	hits[2].Snippet = "search endpoints"
+
+	t.Run("keyword", func(t *testing.T) {
+		service := NewSearchService(setupTestDocStore(t), &mockSearchEngine{hits: hits}, nil, nil, nil)
+
+		results, err := service.Search(context.Background(), "sercha", domain.SearchOptions{})
+
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		assert.Equal(t, "<b>Sercha</b> is a search engine", results[0].Snippet)
+		assert.Empty(t, results[1].Snippet)
+	})
+
+	t.Run("hybrid", func(t *testing.T) {
+		vectorIndex := &mockVectorIndex{hits: createTestVectorHits()}
+		embedService := &mockEmbeddingService{embedding: make([]float32, 384)}
+		service := NewSearchService(setupTestDocStore(t), &mockSearchEngine{hits: hits}, vectorIndex, embedService, nil)
+
+		results, err := service.Search(context.Background(), "sercha", domain.SearchOptions{Hybrid: true})
+
+		require.NoError(t, err)
+		snippets := make(map[string]string)
+		for _, r := range results {
+			snippets[r.Chunk.ID] = r.Snippet
+		}
+		assert.Equal(t, "<b>Sercha</b> is a search engine", snippets["chunk-doc-1"])
+		assert.Equal(t, "search endpoints", snippets["chunk-doc-3"])
+		assert.Empty(t, snippets["chunk-doc-2"])
+	})
+}
+
 func TestSearchService_Search_SemanticMode(t *testing.T) {
 	docStore := setupTestDocStore(t)
 	searchEngine := &mockSearchEngine{hits: createTestHits()}
@@ -382,6 +670,96 @@ func TestSearchService_Search_SourceIDFilter(t *testing.T) {
 	}
 }
 
+// setupDuplicateDocStore stores a three-chunk document, a copy of it in
+// another source and an unrelated document, returning hits across them.
+func setupDuplicateDocStore(t *testing.T) (*memory.DocumentStore, []driven.SearchHit) {
+	t.Helper()
+	store := memory.NewDocumentStore()
+	ctx := context.Background()
+
+	docs := []domain.Document{
+		{ID: "doc-a", SourceID: "src-1", Title: "Runbook", Content: "Restart the indexer nightly."},
+		{ID: "doc-b", SourceID: "src-2", Title: "Runbook copy", Content: "  Restart the indexer nightly.\n"},
+		{ID: "doc-c", SourceID: "src-2", Title: "Other", Content: "Something else entirely."},
+	}
+	for i := range docs {
+		require.NoError(t, store.SaveDocument(ctx, &docs[i]))
+	}
+	require.NoError(t, store.SaveChunks(ctx, []domain.Chunk{
+		{ID: "a1", DocumentID: "doc-a", Content: "Restart", Position: 0},
+		{ID: "a2", DocumentID: "doc-a", Content: "the indexer", Position: 1},
+		{ID: "a3", DocumentID: "doc-a", Content: "nightly.", Position: 2},
+		{ID: "b1", DocumentID: "doc-b", Content: "Restart the indexer nightly.", Position: 0},
+		{ID: "c1", DocumentID: "doc-c", Content: "Something else entirely.", Position: 0},
+	}))
+
+	return store, []driven.SearchHit{
+		{ChunkID: "a2", Score: 0.9},
+		{ChunkID: "b1", Score: 0.85},
+		{ChunkID: "a1", Score: 0.8},
+		{ChunkID: "c1", Score: 0.7},
+		{ChunkID: "a3", Score: 0.6},
+	}
+}
+
+func TestSearchService_Search_DedupeResults(t *testing.T) {
+	docStore, hits := setupDuplicateDocStore(t)
+	service := NewSearchService(docStore, &mockSearchEngine{hits: hits}, nil, nil, nil)
+	ctx := context.Background()
+
+	results, err := service.Search(ctx, "indexer", domain.SearchOptions{})
+	require.NoError(t, err)
+	assert.Len(t, results, 5)
+
+	results, err = service.Search(ctx, "indexer", domain.SearchOptions{DedupeResults: true})
+	require.NoError(t, err)
+
+	// Chunks of doc-a collapse to its best-scoring chunk
+	require.Len(t, results, 3)
+	assert.Equal(t, "doc-a", results[0].Document.ID)
+	assert.Equal(t, "a2", results[0].Chunk.ID)
+	assert.InDelta(t, 0.9, results[0].Score, 0.0001)
+	assert.Equal(t, "doc-b", results[1].Document.ID)
+	assert.Equal(t, "doc-c", results[2].Document.ID)
+}
+
+func TestSearchService_Search_MergeDuplicates(t *testing.T) {
+	docStore, hits := setupDuplicateDocStore(t)
+	service := NewSearchService(docStore, &mockSearchEngine{hits: hits}, nil, nil, nil)
+
+	results, err := service.Search(context.Background(), "indexer", domain.SearchOptions{MergeDuplicates: true})
+
+	// doc-b has the same content as doc-a in another source
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "doc-a", results[0].Document.ID)
+	assert.Equal(t, "a2", results[0].Chunk.ID)
+	assert.Equal(t, "doc-c", results[1].Document.ID)
+}
+
+func TestDedupeResults_KeepsBestScore(t *testing.T) {
+	results := []domain.SearchResult{
+		{Document: domain.Document{ID: "doc-1", Content: "same"}, Chunk: domain.Chunk{ID: "c1"}, Score: 0.5},
+		{Document: domain.Document{ID: "doc-2", Content: "other"}, Chunk: domain.Chunk{ID: "c2"}, Score: 0.4},
+		{Document: domain.Document{ID: "doc-1", Content: "same"}, Chunk: domain.Chunk{ID: "c3"}, Score: 0.7},
+		{Document: domain.Document{ID: "doc-3", Content: "same"}, Chunk: domain.Chunk{ID: "c4"}, Score: 0.9},
+		{Document: domain.Document{ID: "doc-4"}, Chunk: domain.Chunk{ID: "c5"}, Score: 0.3},
+		{Document: domain.Document{ID: "doc-5"}, Chunk: domain.Chunk{ID: "c6"}, Score: 0.2},
+	}
+
+	deduped := dedupeResults(results, false)
+	require.Len(t, deduped, 5)
+	assert.Equal(t, "c3", deduped[0].Chunk.ID)
+
+	// Empty content is never treated as a duplicate
+	merged := dedupeResults(results, true)
+	require.Len(t, merged, 4)
+	assert.Equal(t, "c4", merged[0].Chunk.ID)
+	assert.Equal(t, "c2", merged[1].Chunk.ID)
+	assert.Equal(t, "c5", merged[2].Chunk.ID)
+	assert.Equal(t, "c6", merged[3].Chunk.ID)
+}
+
 func TestSearchService_Search_NoSearchEngine(t *testing.T) {
 	docStore := setupTestDocStore(t)
 	service := NewSearchService(docStore, nil, nil, nil, nil)
@@ -635,6 +1013,31 @@ func TestSearchService_reciprocalRankFusion(t *testing.T) {
 	assert.True(t, ids["d"])
 }
 
+func TestSearchService_reciprocalRankFusion_KeepsComponentScores(t *testing.T) {
+	service := &SearchService{}
+
+	keyword := []scoredChunk{
+		{chunkID: "a", score: 7.5, scores: domain.ScoreBreakdown{Keyword: 7.5}},
+		{chunkID: "b", score: 3.2, scores: domain.ScoreBreakdown{Keyword: 3.2}},
+	}
+	vector := []scoredChunk{
+		{chunkID: "b", score: 0.91, scores: domain.ScoreBreakdown{Vector: 0.91}},
+	}
+
+	merged := service.reciprocalRankFusion(keyword, vector, 60)
+
+	byID := make(map[string]scoredChunk)
+	for _, c := range merged {
+		byID[c.chunkID] = c
+	}
+
+	assert.InDelta(t, 7.5, byID["a"].scores.Keyword, 1e-9)
+	assert.Zero(t, byID["a"].scores.Vector)
+	assert.InDelta(t, 3.2, byID["b"].scores.Keyword, 1e-9)
+	assert.InDelta(t, 0.91, byID["b"].scores.Vector, 1e-9)
+	assert.InDelta(t, byID["b"].score, byID["b"].scores.Fused, 1e-9)
+}
+
 func TestSearchService_splitSentences(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -796,3 +1199,18 @@ func TestSearchService_Search_NilDocStore(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "document store unavailable")
 }
+
+func TestRedactQuery(t *testing.T) {
+	redacted := redactQuery("salary review for alice")
+
+	assert.NotContains(t, redacted, "alice")
+	assert.Equal(t, redacted, redactQuery("  salary review for alice "))
+	assert.NotEqual(t, redacted, redactQuery("salary review for bob"))
+}
+
+func TestTraceSearchMode(t *testing.T) {
+	assert.Equal(t, "keyword", traceSearchMode(domain.SearchModeTextOnly))
+	assert.Equal(t, "keyword", traceSearchMode(domain.SearchModeLLMAssisted))
+	assert.Equal(t, "hybrid", traceSearchMode(domain.SearchModeHybrid))
+	assert.Equal(t, "hybrid", traceSearchMode(domain.SearchModeFull))
+}
@@ -2,6 +2,9 @@ package services
 
 import (
 	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -17,17 +20,35 @@ var _ driving.SettingsService = (*SettingsService)(nil)
 //nolint:gosec // G101: These are config key names, not actual credentials.
 const (
 	keySearchMode      = "search.mode"
+	keySearchBM25K1    = "search.bm25_k1"
+	keySearchBM25B     = "search.bm25_b"
+	keySearchLanguage  = "search.language"
+	keySearchMinSim    = "search.min_similarity"
+	keySearchExpansion = "search.query_expansion"
 	keyEmbedProvider   = "embedding.provider"
 	keyEmbedModel      = "embedding.model"
 	keyEmbedBaseURL    = "embedding.base_url"
 	keyEmbedAPIKey     = "embedding.api_key"
+	keyEmbedDimensions = "embedding.dimensions"
+	keyEmbedMaxTokens  = "embedding.max_tokens"
+	keyEmbedBatchSize  = "embedding.batch_size"
+	keyEmbedOverrides  = "embedding.model_overrides"
 	keyLLMProvider     = "llm.provider"
 	keyLLMModel        = "llm.model"
 	keyLLMBaseURL      = "llm.base_url"
 	keyLLMAPIKey       = "llm.api_key"
+	keyLLMMaxContext   = "llm.max_context_tokens"
+	keyLLMAnswerTokens = "llm.answer_reserve_tokens"
 	keyVectorEnabled   = "vector_index.enabled"
 	keyVectorDims      = "vector_index.dimensions"
 	keyVectorPrecision = "vector_index.precision"
+	keyChunkStrategy   = "pipeline.chunker.strategy"
+	keyChunkSize       = "pipeline.chunker.chunk_size"
+	keyChunkOverlap    = "pipeline.chunker.overlap"
+	keyUITheme         = "ui.theme"
+	keyUIKeyBindings   = "ui.keybindings"
+	keyTraceEndpoint   = "telemetry.otlp_endpoint"
+	keyLocale          = "connectors.locale"
 )
 
 // SettingsService manages application settings.
@@ -50,25 +71,50 @@ func (s *SettingsService) Get() (*domain.AppSettings, error) {
 
 	settings := &domain.AppSettings{
 		Search: domain.SearchSettings{
-			Mode: s.getSearchMode(defaults.Search.Mode),
+			Mode:          s.getSearchMode(defaults.Search.Mode),
+			BM25K1:        s.getFloat(keySearchBM25K1, defaults.Search.BM25K1),
+			BM25B:         s.getFloat(keySearchBM25B, defaults.Search.BM25B),
+			MinSimilarity: s.getFloat(keySearchMinSim, defaults.Search.MinSimilarity),
+
+			QueryExpansion: s.getBool(keySearchExpansion, defaults.Search.QueryExpansion),
 		},
 		Embedding: domain.EmbeddingSettings{
-			Provider: s.getProvider(keyEmbedProvider, defaults.Embedding.Provider),
-			Model:    s.getString(keyEmbedModel, defaults.Embedding.Model),
-			BaseURL:  s.configStore.GetString(keyEmbedBaseURL), // No default - empty is valid for cloud providers
-			APIKey:   s.configStore.GetString(keyEmbedAPIKey),
+			Provider:   s.getProvider(keyEmbedProvider, defaults.Embedding.Provider),
+			Model:      s.getString(keyEmbedModel, defaults.Embedding.Model),
+			BaseURL:    s.configStore.GetString(keyEmbedBaseURL), // No default - empty is valid for cloud providers
+			APIKey:     s.configStore.GetString(keyEmbedAPIKey),
+			Dimensions: s.configStore.GetInt(keyEmbedDimensions),
+			MaxTokens:  s.getInt(keyEmbedMaxTokens, defaults.Embedding.MaxTokens),
+			BatchSize:  s.getInt(keyEmbedBatchSize, defaults.Embedding.BatchSize),
+
+			ModelOverrides: s.getModelOverrides(),
 		},
 		LLM: domain.LLMSettings{
 			Provider: s.getProvider(keyLLMProvider, defaults.LLM.Provider),
 			Model:    s.getString(keyLLMModel, defaults.LLM.Model),
 			BaseURL:  s.configStore.GetString(keyLLMBaseURL), // No default - empty is valid for cloud providers
 			APIKey:   s.configStore.GetString(keyLLMAPIKey),
+
+			MaxContextTokens:    s.getInt(keyLLMMaxContext, defaults.LLM.MaxContextTokens),
+			AnswerReserveTokens: s.getIntAllowZero(keyLLMAnswerTokens, defaults.LLM.AnswerReserveTokens),
 		},
 		VectorIndex: domain.VectorIndexSettings{
 			Enabled:    s.getBool(keyVectorEnabled, defaults.VectorIndex.Enabled),
 			Dimensions: s.getInt(keyVectorDims, defaults.VectorIndex.Dimensions),
 			Precision:  s.getVectorPrecision(defaults.VectorIndex.Precision),
 		},
+		Chunking: domain.ChunkingSettings{
+			Strategy:  s.getChunkingStrategy(defaults.Chunking.Strategy),
+			ChunkSize: s.getInt(keyChunkSize, defaults.Chunking.ChunkSize),
+			Overlap:   s.getIntAllowZero(keyChunkOverlap, defaults.Chunking.Overlap),
+		},
+		UI: domain.UISettings{
+			Theme:       s.getString(keyUITheme, defaults.UI.Theme),
+			KeyBindings: s.getKeyBindings(),
+		},
+		DefaultSearchLanguage: s.getString(keySearchLanguage, defaults.DefaultSearchLanguage),
+		Locale:                s.configStore.GetString(keyLocale),
+		OpenTelemetryEndpoint: s.configStore.GetString(keyTraceEndpoint),
 	}
 
 	return settings, nil
@@ -80,6 +126,21 @@ func (s *SettingsService) Save(settings *domain.AppSettings) error {
 	if err := s.configStore.Set(keySearchMode, settings.Search.Mode.String()); err != nil {
 		return fmt.Errorf("save search mode: %w", err)
 	}
+	if err := s.configStore.Set(keySearchBM25K1, settings.Search.BM25K1); err != nil {
+		return fmt.Errorf("save bm25_k1: %w", err)
+	}
+	if err := s.configStore.Set(keySearchBM25B, settings.Search.BM25B); err != nil {
+		return fmt.Errorf("save bm25_b: %w", err)
+	}
+	if err := s.configStore.Set(keySearchLanguage, settings.DefaultSearchLanguage); err != nil {
+		return fmt.Errorf("save search language: %w", err)
+	}
+	if err := s.configStore.Set(keySearchMinSim, settings.Search.MinSimilarity); err != nil {
+		return fmt.Errorf("save min_similarity: %w", err)
+	}
+	if err := s.configStore.Set(keySearchExpansion, settings.Search.QueryExpansion); err != nil {
+		return fmt.Errorf("save query_expansion: %w", err)
+	}
 
 	// Save embedding settings
 	if err := s.configStore.Set(keyEmbedProvider, settings.Embedding.Provider.String()); err != nil {
@@ -96,6 +157,19 @@ func (s *SettingsService) Save(settings *domain.AppSettings) error {
 			return fmt.Errorf("save embedding api_key: %w", err)
 		}
 	}
+	if err := s.configStore.Set(keyEmbedDimensions, settings.Embedding.Dimensions); err != nil {
+		return fmt.Errorf("save embedding dimensions: %w", err)
+	}
+	if err := s.configStore.Set(keyEmbedMaxTokens, settings.Embedding.MaxTokens); err != nil {
+		return fmt.Errorf("save embedding max_tokens: %w", err)
+	}
+	if err := s.configStore.Set(keyEmbedBatchSize, settings.Embedding.BatchSize); err != nil {
+		return fmt.Errorf("save embedding batch_size: %w", err)
+	}
+	overrides := domain.FormatModelOverrides(settings.Embedding.ModelOverrides)
+	if err := s.configStore.Set(keyEmbedOverrides, overrides); err != nil {
+		return fmt.Errorf("save embedding model_overrides: %w", err)
+	}
 
 	// Save LLM settings
 	if err := s.configStore.Set(keyLLMProvider, settings.LLM.Provider.String()); err != nil {
@@ -112,6 +186,12 @@ func (s *SettingsService) Save(settings *domain.AppSettings) error {
 			return fmt.Errorf("save llm api_key: %w", err)
 		}
 	}
+	if err := s.configStore.Set(keyLLMMaxContext, settings.LLM.MaxContextTokens); err != nil {
+		return fmt.Errorf("save llm max_context_tokens: %w", err)
+	}
+	if err := s.configStore.Set(keyLLMAnswerTokens, settings.LLM.AnswerReserveTokens); err != nil {
+		return fmt.Errorf("save llm answer_reserve_tokens: %w", err)
+	}
 
 	// Save vector index settings
 	if err := s.configStore.Set(keyVectorEnabled, settings.VectorIndex.Enabled); err != nil {
@@ -124,6 +204,35 @@ func (s *SettingsService) Save(settings *domain.AppSettings) error {
 		return fmt.Errorf("save vector precision: %w", err)
 	}
 
+	// Save chunking settings
+	if err := s.configStore.Set(keyChunkStrategy, settings.Chunking.Strategy.String()); err != nil {
+		return fmt.Errorf("save chunk strategy: %w", err)
+	}
+	if err := s.configStore.Set(keyChunkSize, settings.Chunking.ChunkSize); err != nil {
+		return fmt.Errorf("save chunk size: %w", err)
+	}
+	if err := s.configStore.Set(keyChunkOverlap, settings.Chunking.Overlap); err != nil {
+		return fmt.Errorf("save chunk overlap: %w", err)
+	}
+
+	// Save UI settings
+	if err := s.configStore.Set(keyUITheme, settings.UI.Theme); err != nil {
+		return fmt.Errorf("save ui theme: %w", err)
+	}
+	if err := s.configStore.Set(keyUIKeyBindings, domain.FormatKeyBindings(settings.UI.KeyBindings)); err != nil {
+		return fmt.Errorf("save ui keybindings: %w", err)
+	}
+
+	// Save connector settings
+	if err := s.configStore.Set(keyLocale, settings.Locale); err != nil {
+		return fmt.Errorf("save locale: %w", err)
+	}
+
+	// Save telemetry settings
+	if err := s.configStore.Set(keyTraceEndpoint, settings.OpenTelemetryEndpoint); err != nil {
+		return fmt.Errorf("save telemetry endpoint: %w", err)
+	}
+
 	return nil
 }
 
@@ -195,23 +304,52 @@ func (s *SettingsService) SetEmbeddingProvider(provider domain.AIProvider, model
 		if settings.Embedding.BaseURL == "" {
 			settings.Embedding.BaseURL = "http://localhost:11434"
 		}
-	} else {
+		settings.Embedding.Dimensions = 0
+	} else if !provider.RequiresEndpoint() {
 		// Cloud providers don't need a custom base URL
 		settings.Embedding.BaseURL = ""
+		settings.Embedding.Dimensions = 0
 	}
+	// Endpoint providers keep their base URL and dimensions; set them
+	// with SetEmbeddingEndpoint
 
 	// Set API key
 	settings.Embedding.APIKey = apiKey
 
 	// Update vector dimensions based on model
 	dims := domain.EmbeddingDimensions()
-	if d, ok := dims[settings.Embedding.Model]; ok {
+	if settings.Embedding.Dimensions > 0 {
+		settings.VectorIndex.Dimensions = settings.Embedding.Dimensions
+	} else if d, ok := dims[settings.Embedding.Model]; ok {
 		settings.VectorIndex.Dimensions = d
 	}
 
 	return s.Save(settings)
 }
 
+// SetEmbeddingEndpoint configures the base URL and vector dimensions of an
+// embedding provider that requires an endpoint, such as an OpenAI-compatible server.
+func (s *SettingsService) SetEmbeddingEndpoint(baseURL string, dimensions int) error {
+	if err := domain.ValidateEndpointURL(baseURL); err != nil {
+		return err
+	}
+	if dimensions <= 0 {
+		return fmt.Errorf("%w: dimensions must be positive, got %d", domain.ErrInvalidInput, dimensions)
+	}
+
+	settings, err := s.Get()
+	if err != nil {
+		return err
+	}
+
+	settings.Embedding.BaseURL = strings.TrimSpace(baseURL)
+	settings.Embedding.Dimensions = dimensions
+	// The vector index must match the vectors the model returns
+	settings.VectorIndex.Dimensions = dimensions
+
+	return s.Save(settings)
+}
+
 // SetLLMProvider configures the LLM provider.
 func (s *SettingsService) SetLLMProvider(provider domain.AIProvider, model, apiKey string) error {
 	if !provider.IsValid() {
@@ -268,6 +406,35 @@ func (s *SettingsService) Validate() error {
 	if !settings.Search.Mode.IsValid() {
 		return fmt.Errorf("invalid search mode: %s", settings.Search.Mode)
 	}
+	if err := settings.Search.ValidateBM25(); err != nil {
+		return err
+	}
+	if err := domain.ValidateSearchLanguage(settings.DefaultSearchLanguage); err != nil {
+		return err
+	}
+	if err := domain.ValidateLocale(settings.Locale); err != nil {
+		return err
+	}
+	if err := settings.Search.ValidateMinSimilarity(); err != nil {
+		return err
+	}
+	if err := settings.Chunking.Validate(); err != nil {
+		return err
+	}
+	if err := settings.Embedding.ValidateMaxTokens(); err != nil {
+		return err
+	}
+	if err := settings.Embedding.ValidateBatchSize(); err != nil {
+		return err
+	}
+	if err := settings.LLM.ContextBudget().Validate(); err != nil {
+		return err
+	}
+
+	// Validate scheduler cron expressions
+	if err := s.validateSchedulerCron(); err != nil {
+		return err
+	}
 
 	// Check embedding configuration if required
 	if settings.Search.Mode.RequiresEmbedding() {
@@ -364,6 +531,42 @@ func (s *SettingsService) getBool(key string, defaultVal bool) bool {
 	return s.configStore.GetBool(key)
 }
 
+func (s *SettingsService) getIntAllowZero(key string, defaultVal int) int {
+	if _, exists := s.configStore.Get(key); !exists {
+		return defaultVal
+	}
+	return s.configStore.GetInt(key)
+}
+
+func (s *SettingsService) getFloat(key string, defaultVal float64) float64 {
+	if _, exists := s.configStore.Get(key); !exists {
+		return defaultVal
+	}
+	return s.configStore.GetFloat(key)
+}
+
+// getModelOverrides reads the embedding model overrides, ignoring them with
+// a warning if they are malformed.
+func (s *SettingsService) getModelOverrides() map[string]string {
+	overrides, err := domain.ParseModelOverrides(s.configStore.GetStringSlice(keyEmbedOverrides))
+	if err != nil {
+		slog.Warn("ignoring embedding.model_overrides", slog.Any("error", err))
+		return nil
+	}
+	return overrides
+}
+
+// getKeyBindings reads the TUI key bindings, ignoring them with a warning
+// if they are malformed.
+func (s *SettingsService) getKeyBindings() map[string][]string {
+	bindings, err := domain.ParseKeyBindings(s.configStore.GetStringSlice(keyUIKeyBindings))
+	if err != nil {
+		slog.Warn("ignoring ui.keybindings", slog.Any("error", err))
+		return nil
+	}
+	return bindings
+}
+
 func (s *SettingsService) getSearchMode(defaultVal domain.SearchMode) domain.SearchMode {
 	val := s.configStore.GetString(keySearchMode)
 	if val == "" {
@@ -376,6 +579,18 @@ func (s *SettingsService) getSearchMode(defaultVal domain.SearchMode) domain.Sea
 	return mode
 }
 
+func (s *SettingsService) getChunkingStrategy(defaultVal domain.ChunkingStrategy) domain.ChunkingStrategy {
+	val := s.configStore.GetString(keyChunkStrategy)
+	if val == "" {
+		return defaultVal
+	}
+	strategy := domain.ChunkingStrategy(val)
+	if !strategy.IsValid() {
+		return defaultVal
+	}
+	return strategy
+}
+
 func (s *SettingsService) getProvider(key string, defaultVal domain.AIProvider) domain.AIProvider {
 	val := s.configStore.GetString(key)
 	if val == "" {
@@ -439,7 +654,7 @@ func (s *SettingsService) loadProcessorConfig(prefix string) map[string]any {
 	cfg := make(map[string]any)
 
 	// Check common processor config keys
-	knownKeys := []string{"chunk_size", "overlap", "max_length", "model"}
+	knownKeys := []string{"chunk_size", "overlap", "strategy", "max_length", "min_length", "model"}
 	for _, key := range knownKeys {
 		fullKey := prefix + key
 		if val, exists := s.configStore.Get(fullKey); exists {
@@ -450,6 +665,254 @@ func (s *SettingsService) loadProcessorConfig(prefix string) map[string]any {
 	return cfg
 }
 
+// GetNormaliserConfig returns the normaliser configuration.
+// Returns default configuration if nothing is configured.
+func (s *SettingsService) GetNormaliserConfig() domain.NormaliserConfig {
+	defaults := domain.DefaultNormaliserConfig()
+
+	if title := s.configStore.GetString("normalisers.email_fallback_title"); title != "" {
+		defaults.EmailFallbackTitle = title
+	}
+	if title := s.configStore.GetString("normalisers.event_fallback_title"); title != "" {
+		defaults.EventFallbackTitle = title
+	}
+
+	return defaults
+}
+
+// GetSyncConfig returns the document sync configuration.
+// Returns default configuration if nothing is configured.
+func (s *SettingsService) GetSyncConfig() domain.SyncConfig {
+	defaults := domain.DefaultSyncConfig()
+
+	if _, exists := s.configStore.Get("sync.skip_empty"); exists {
+		defaults.SkipEmpty = s.configStore.GetBool("sync.skip_empty")
+	}
+	if n := s.configStore.GetInt("sync.max_concurrent_requests"); n > 0 {
+		defaults.MaxConcurrentRequests = n
+	}
+	if rps := s.configStore.GetFloat("sync.requests_per_second"); rps > 0 {
+		defaults.RequestsPerSecond = rps
+	}
+
+	return defaults
+}
+
+// GetNotificationConfig returns the sync notification configuration.
+// Unknown event names in notifications.events are logged and ignored.
+func (s *SettingsService) GetNotificationConfig() domain.NotificationConfig {
+	defaults := domain.DefaultNotificationConfig()
+
+	if names := s.configStore.GetStringSlice("notifications.events"); names != nil {
+		defaults.Events = nil
+		for _, name := range names {
+			event, err := domain.ParseSyncEvent(strings.ToLower(strings.TrimSpace(name)))
+			if err != nil {
+				slog.Warn("ignoring notifications.events entry", slog.Any("error", err))
+				continue
+			}
+			defaults.Events = append(defaults.Events, event)
+		}
+	}
+	defaults.Desktop = s.configStore.GetBool("notifications.desktop")
+	defaults.WebhookURL = s.configStore.GetString("notifications.webhook_url")
+
+	return defaults
+}
+
+// schedulerTaskKeys maps task IDs to config keys (underscore version for TOML).
+var schedulerTaskKeys = map[string]string{
+	domain.TaskIDOAuthRefresh:       "oauth_refresh",
+	domain.TaskIDDocumentSync:       "document_sync",
+	domain.TaskIDMaintenanceCompact: "maintenance_compact",
+}
+
+// settableKeys lists the settings that Set accepts.
+var settableKeys = []string{
+	"bm25_k1", "bm25_b", "language", "min_similarity", "query_expansion", "chunk_strategy", "chunk_size",
+	"chunk_overlap", "embedding_max_tokens", "embedding_batch_size", "embedding_model_overrides",
+	"max_context_tokens", "answer_reserve_tokens", "theme",
+	"keybindings", "locale", "trace_endpoint",
+}
+
+// Set updates a single setting from its string form, e.g. Set("bm25_k1", "1.2").
+// A language change applies to queries immediately, but documents keep the
+// stemming they were indexed with until the index is rebuilt. Likewise,
+// chunking changes apply to documents synced or rebuilt afterwards.
+func (s *SettingsService) Set(key, value string) error {
+	settings, err := s.Get()
+	if err != nil {
+		return err
+	}
+
+	switch key {
+	case "bm25_k1", "bm25_b":
+		f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return fmt.Errorf("%w: %s must be a number, got %q", domain.ErrInvalidInput, key, value)
+		}
+		if key == "bm25_k1" {
+			settings.Search.BM25K1 = f
+		} else {
+			settings.Search.BM25B = f
+		}
+		if err := settings.Search.ValidateBM25(); err != nil {
+			return err
+		}
+	case "language":
+		lang := strings.ToLower(strings.TrimSpace(value))
+		if err := domain.ValidateSearchLanguage(lang); err != nil {
+			return err
+		}
+		settings.DefaultSearchLanguage = lang
+	case "min_similarity":
+		f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return fmt.Errorf("%w: %s must be a number, got %q", domain.ErrInvalidInput, key, value)
+		}
+		if err := domain.ValidateMinSimilarity(f); err != nil {
+			return err
+		}
+		settings.Search.MinSimilarity = f
+	case "query_expansion":
+		enabled, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("%w: %s must be true or false, got %q", domain.ErrInvalidInput, key, value)
+		}
+		settings.Search.QueryExpansion = enabled
+	case "chunk_strategy":
+		settings.Chunking.Strategy = domain.ChunkingStrategy(strings.ToLower(strings.TrimSpace(value)))
+		if err := settings.Chunking.Validate(); err != nil {
+			return err
+		}
+	case "chunk_size", "chunk_overlap":
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("%w: %s must be a whole number, got %q", domain.ErrInvalidInput, key, value)
+		}
+		if key == "chunk_size" {
+			settings.Chunking.ChunkSize = n
+		} else {
+			settings.Chunking.Overlap = n
+		}
+		if err := settings.Chunking.Validate(); err != nil {
+			return err
+		}
+	case "embedding_max_tokens":
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("%w: %s must be a whole number, got %q", domain.ErrInvalidInput, key, value)
+		}
+		settings.Embedding.MaxTokens = n
+		if err := settings.Embedding.ValidateMaxTokens(); err != nil {
+			return err
+		}
+	case "embedding_batch_size":
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("%w: %s must be a whole number, got %q", domain.ErrInvalidInput, key, value)
+		}
+		settings.Embedding.BatchSize = n
+		if err := settings.Embedding.ValidateBatchSize(); err != nil {
+			return err
+		}
+	case "embedding_model_overrides":
+		// A comma-separated list of key=model entries; empty clears them
+		overrides, err := domain.ParseModelOverrides(strings.Split(value, ","))
+		if err != nil {
+			return err
+		}
+		settings.Embedding.ModelOverrides = overrides
+	case "max_context_tokens", "answer_reserve_tokens":
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("%w: %s must be a whole number, got %q", domain.ErrInvalidInput, key, value)
+		}
+		if key == "max_context_tokens" {
+			settings.LLM.MaxContextTokens = n
+		} else {
+			settings.LLM.AnswerReserveTokens = n
+		}
+		if err := settings.LLM.ContextBudget().Validate(); err != nil {
+			return err
+		}
+	case "theme":
+		// Theme names and files are resolved by the TUI, which falls back
+		// to the default theme if this one cannot be loaded
+		theme := strings.TrimSpace(value)
+		if theme == "" {
+			return fmt.Errorf("%w: theme must not be empty", domain.ErrInvalidInput)
+		}
+		settings.UI.Theme = theme
+	case "keybindings":
+		// A semicolon-separated list of action=keys entries; empty clears
+		// them. Actions and keys are validated by the TUI when it starts
+		bindings, err := domain.ParseKeyBindings(strings.Split(value, ";"))
+		if err != nil {
+			return err
+		}
+		settings.UI.KeyBindings = bindings
+	case "locale":
+		// An empty locale stops sending one
+		locale := strings.TrimSpace(value)
+		if err := domain.ValidateLocale(locale); err != nil {
+			return err
+		}
+		settings.Locale = locale
+	case "trace_endpoint":
+		// An empty endpoint turns tracing off
+		endpoint := strings.TrimSpace(value)
+		if endpoint != "" {
+			if err := domain.ValidateEndpointURL(endpoint); err != nil {
+				return err
+			}
+		}
+		settings.OpenTelemetryEndpoint = endpoint
+	default:
+		return fmt.Errorf("%w: unknown setting %q (settable: %s)",
+			domain.ErrInvalidInput, key, strings.Join(settableKeys, ", "))
+	}
+
+	if err := s.Save(settings); err != nil {
+		return err
+	}
+	return s.configStore.Save()
+}
+
+// SetSchedulerCron sets the cron expression for a built-in scheduler task.
+// An empty expression clears it so the task falls back to its interval.
+func (s *SettingsService) SetSchedulerCron(taskID, expr string) error {
+	configKey, ok := schedulerTaskKeys[taskID]
+	if !ok {
+		return fmt.Errorf("%w: unknown scheduler task %q", domain.ErrInvalidInput, taskID)
+	}
+
+	expr = strings.TrimSpace(expr)
+	if expr != "" {
+		if _, err := parseCron(expr); err != nil {
+			return err
+		}
+	}
+
+	if err := s.configStore.Set("scheduler."+configKey+".cron", expr); err != nil {
+		return fmt.Errorf("failed to set scheduler cron: %w", err)
+	}
+	return s.configStore.Save()
+}
+
+// validateSchedulerCron checks the cron expressions configured for scheduler tasks.
+func (s *SettingsService) validateSchedulerCron() error {
+	for _, configKey := range schedulerTaskKeys {
+		key := "scheduler." + configKey + ".cron"
+		if expr := s.configStore.GetString(key); expr != "" {
+			if _, err := parseCron(expr); err != nil {
+				return fmt.Errorf("invalid %s: %w", key, err)
+			}
+		}
+	}
+	return nil
+}
+
 // GetSchedulerConfig returns the scheduler configuration.
 // Returns default configuration if nothing is configured.
 func (s *SettingsService) GetSchedulerConfig() domain.SchedulerConfig {
@@ -460,14 +923,29 @@ func (s *SettingsService) GetSchedulerConfig() domain.SchedulerConfig {
 		defaults.Enabled = s.configStore.GetBool("scheduler.enabled")
 	}
 
-	// Per-task config
-	// Map from task ID to config key (underscore version for TOML)
-	taskKeys := map[string]string{
-		domain.TaskIDOAuthRefresh: "oauth_refresh",
-		domain.TaskIDDocumentSync: "document_sync",
+	// Concurrency cap (0 means unlimited)
+	if _, exists := s.configStore.Get("scheduler.max_concurrent_syncs"); exists {
+		if n := s.configStore.GetInt("scheduler.max_concurrent_syncs"); n >= 0 {
+			defaults.MaxConcurrentSyncs = n
+		}
 	}
 
-	for taskID, configKey := range taskKeys {
+	// Sync-all worker pool size
+	if _, exists := s.configStore.Get("scheduler.sync_workers"); exists {
+		if n := s.configStore.GetInt("scheduler.sync_workers"); n >= 0 {
+			defaults.SyncWorkers = n
+		}
+	}
+
+	// Startup jitter (duration string like "30s", "2m")
+	if jitter := s.configStore.GetString("scheduler.jitter"); jitter != "" {
+		if d, err := s.parseDuration(jitter); err == nil && d >= 0 {
+			defaults.Jitter = d
+		}
+	}
+
+	// Per-task config
+	for taskID, configKey := range schedulerTaskKeys {
 		prefix := "scheduler." + configKey + "."
 
 		taskCfg := defaults.TaskConfigs[taskID]
@@ -484,6 +962,14 @@ func (s *SettingsService) GetSchedulerConfig() domain.SchedulerConfig {
 			}
 		}
 
+		// Check cron (5-field expression like "0 6 * * 1-5"); invalid values are
+		// ignored here and reported by Validate
+		if expr := s.configStore.GetString(prefix + "cron"); expr != "" {
+			if _, err := parseCron(expr); err == nil {
+				taskCfg.Cron = expr
+			}
+		}
+
 		defaults.TaskConfigs[taskID] = taskCfg
 	}
 
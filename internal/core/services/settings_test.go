@@ -2,6 +2,7 @@ package services
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -211,6 +212,38 @@ func TestSettingsService_SetEmbeddingProvider_UpdatesDimensions(t *testing.T) {
 	assert.Equal(t, 1536, settings.VectorIndex.Dimensions)
 }
 
+func TestSettingsService_SetEmbeddingEndpoint_OpenAICompatible(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	require.NoError(t, service.SetEmbeddingProvider(domain.AIProviderOpenAICompatible, "nomic-embed-text", ""))
+	err := service.SetEmbeddingEndpoint("http://localhost:1234/v1", 768)
+
+	require.NoError(t, err)
+
+	settings, _ := service.Get()
+	assert.Equal(t, domain.AIProviderOpenAICompatible, settings.Embedding.Provider)
+	assert.Equal(t, "http://localhost:1234/v1", settings.Embedding.BaseURL)
+	assert.Equal(t, 768, settings.Embedding.Dimensions)
+	assert.Equal(t, 768, settings.VectorIndex.Dimensions)
+	assert.True(t, settings.Embedding.IsConfigured())
+
+	// Switching to a cloud provider clears the endpoint
+	require.NoError(t, service.SetEmbeddingProvider(domain.AIProviderOpenAI, "text-embedding-3-small", "sk-test-key"))
+	settings, _ = service.Get()
+	assert.Empty(t, settings.Embedding.BaseURL)
+	assert.Zero(t, settings.Embedding.Dimensions)
+	assert.Equal(t, 1536, settings.VectorIndex.Dimensions)
+}
+
+func TestSettingsService_SetEmbeddingEndpoint_Invalid(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	assert.ErrorIs(t, service.SetEmbeddingEndpoint("localhost:1234", 768), domain.ErrInvalidInput)
+	assert.ErrorIs(t, service.SetEmbeddingEndpoint("http://localhost:1234/v1", 0), domain.ErrInvalidInput)
+}
+
 func TestSettingsService_SetEmbeddingProvider_RequiresAPIKey(t *testing.T) {
 	store := memory.NewConfigStore()
 	service := NewSettingsService(store, nil)
@@ -1329,3 +1362,466 @@ func TestSettingsService_ValidateLLMConfig_Error(t *testing.T) {
 
 	assert.Error(t, err)
 }
+
+func TestSettingsService_SetSchedulerCron(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	err := service.SetSchedulerCron(domain.TaskIDDocumentSync, "0 6 * * 1-5")
+	require.NoError(t, err)
+
+	cfg := service.GetSchedulerConfig()
+	assert.Equal(t, "0 6 * * 1-5", cfg.GetTaskConfig(domain.TaskIDDocumentSync).Cron)
+	assert.Empty(t, cfg.GetTaskConfig(domain.TaskIDOAuthRefresh).Cron)
+	assert.NoError(t, service.Validate())
+}
+
+func TestSettingsService_SetSchedulerCron_Clear(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+	require.NoError(t, service.SetSchedulerCron(domain.TaskIDDocumentSync, "@daily"))
+
+	err := service.SetSchedulerCron(domain.TaskIDDocumentSync, "")
+
+	require.NoError(t, err)
+	cfg := service.GetSchedulerConfig()
+	assert.Empty(t, cfg.GetTaskConfig(domain.TaskIDDocumentSync).Cron)
+}
+
+func TestSettingsService_SetSchedulerCron_Invalid(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	err := service.SetSchedulerCron(domain.TaskIDDocumentSync, "every weekday at 6")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	assert.Empty(t, store.GetString("scheduler.document_sync.cron"))
+}
+
+func TestSettingsService_SetSchedulerCron_UnknownTask(t *testing.T) {
+	service := NewSettingsService(memory.NewConfigStore(), nil)
+
+	err := service.SetSchedulerCron("no-such-task", "0 6 * * *")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestSettingsService_Validate_InvalidSchedulerCron(t *testing.T) {
+	store := memory.NewConfigStore()
+	_ = store.Set("scheduler.document_sync.cron", "61 * * * *")
+	service := NewSettingsService(store, nil)
+
+	err := service.Validate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "scheduler.document_sync.cron")
+	// Invalid expressions are not applied
+	cfg := service.GetSchedulerConfig()
+	assert.Empty(t, cfg.GetTaskConfig(domain.TaskIDDocumentSync).Cron)
+}
+
+func TestSettingsService_GetSchedulerConfig_ConcurrencyAndJitter(t *testing.T) {
+	store := memory.NewConfigStore()
+	_ = store.Set("scheduler.max_concurrent_syncs", 4)
+	_ = store.Set("scheduler.jitter", "45s")
+	_ = store.Set("scheduler.sync_workers", 6)
+	service := NewSettingsService(store, nil)
+
+	cfg := service.GetSchedulerConfig()
+
+	assert.Equal(t, 4, cfg.MaxConcurrentSyncs)
+	assert.Equal(t, 6, cfg.SyncWorkers)
+	assert.Equal(t, 45*time.Second, cfg.Jitter)
+}
+
+func TestSettingsService_GetSchedulerConfig_InvalidJitterIgnored(t *testing.T) {
+	store := memory.NewConfigStore()
+	_ = store.Set("scheduler.jitter", "soon")
+	service := NewSettingsService(store, nil)
+
+	cfg := service.GetSchedulerConfig()
+
+	assert.Equal(t, domain.DefaultSchedulerConfig().Jitter, cfg.Jitter)
+	assert.Equal(t, domain.DefaultSchedulerConfig().MaxConcurrentSyncs, cfg.MaxConcurrentSyncs)
+}
+
+func TestSettingsService_GetSyncConfig_Defaults(t *testing.T) {
+	service := NewSettingsService(memory.NewConfigStore(), nil)
+
+	cfg := service.GetSyncConfig()
+
+	assert.True(t, cfg.SkipEmpty)
+	assert.Zero(t, cfg.MaxConcurrentRequests)
+	assert.Zero(t, cfg.RequestsPerSecond)
+}
+
+func TestSettingsService_GetSyncConfig_Configured(t *testing.T) {
+	store := memory.NewConfigStore()
+	_ = store.Set("sync.skip_empty", false)
+	_ = store.Set("sync.max_concurrent_requests", 8)
+	_ = store.Set("sync.requests_per_second", 12.5)
+	service := NewSettingsService(store, nil)
+
+	cfg := service.GetSyncConfig()
+
+	assert.False(t, cfg.SkipEmpty)
+	assert.Equal(t, 8, cfg.MaxConcurrentRequests)
+	assert.InDelta(t, 12.5, cfg.RequestsPerSecond, 0.001)
+}
+
+func TestSettingsService_GetNotificationConfig_Defaults(t *testing.T) {
+	service := NewSettingsService(memory.NewConfigStore(), nil)
+
+	cfg := service.GetNotificationConfig()
+
+	assert.False(t, cfg.Enabled())
+	assert.Equal(t, []domain.SyncEvent{domain.SyncEventFailed}, cfg.Events)
+}
+
+func TestSettingsService_GetNotificationConfig_Configured(t *testing.T) {
+	store := memory.NewConfigStore()
+	_ = store.Set("notifications.events", []string{"completed", "Failed", "started"})
+	_ = store.Set("notifications.desktop", true)
+	_ = store.Set("notifications.webhook_url", "https://hooks.example.com/sercha")
+	service := NewSettingsService(store, nil)
+
+	cfg := service.GetNotificationConfig()
+
+	assert.True(t, cfg.Desktop)
+	assert.Equal(t, "https://hooks.example.com/sercha", cfg.WebhookURL)
+	assert.Equal(t, []domain.SyncEvent{domain.SyncEventCompleted, domain.SyncEventFailed}, cfg.Events)
+}
+
+func TestSettingsService_GetNormaliserConfig_Defaults(t *testing.T) {
+	service := NewSettingsService(memory.NewConfigStore(), nil)
+
+	cfg := service.GetNormaliserConfig()
+
+	assert.Equal(t, "(No subject)", cfg.EmailFallbackTitle)
+	assert.Equal(t, "Meeting on {date}", cfg.EventFallbackTitle)
+}
+
+func TestSettingsService_GetNormaliserConfig_Configured(t *testing.T) {
+	store := memory.NewConfigStore()
+	_ = store.Set("normalisers.email_fallback_title", "Untitled email")
+	_ = store.Set("normalisers.event_fallback_title", "Event ({date})")
+	service := NewSettingsService(store, nil)
+
+	cfg := service.GetNormaliserConfig()
+
+	assert.Equal(t, "Untitled email", cfg.EmailFallbackTitle)
+	assert.Equal(t, "Event ({date})", cfg.EventFallbackTitle)
+}
+
+func TestSettingsService_Get_BM25Defaults(t *testing.T) {
+	service := NewSettingsService(memory.NewConfigStore(), nil)
+
+	settings, err := service.Get()
+
+	require.NoError(t, err)
+	assert.InDelta(t, domain.DefaultBM25K1, settings.Search.BM25K1, 1e-9)
+	assert.InDelta(t, domain.DefaultBM25B, settings.Search.BM25B, 1e-9)
+}
+
+func TestSettingsService_Set_BM25(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	require.NoError(t, service.Set("bm25_k1", "1.2"))
+	require.NoError(t, service.Set("bm25_b", "0"))
+
+	settings, err := service.Get()
+	require.NoError(t, err)
+	assert.InDelta(t, 1.2, settings.Search.BM25K1, 1e-9)
+	assert.InDelta(t, 0.0, settings.Search.BM25B, 1e-9, "zero is a valid value, not unset")
+	assert.NoError(t, service.Validate())
+}
+
+func TestSettingsService_Set_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   string
+		value string
+	}{
+		{"not a number", "bm25_k1", "high"},
+		{"negative k1", "bm25_k1", "-1"},
+		{"b above one", "bm25_b", "1.1"},
+		{"unknown key", "bm25_k3", "1"},
+		{"invalid language", "language", "english"},
+		{"min similarity not a number", "min_similarity", "high"},
+		{"min similarity above one", "min_similarity", "1.2"},
+		{"negative min similarity", "min_similarity", "-0.1"},
+		{"query expansion not a bool", "query_expansion", "sometimes"},
+		{"embedding max tokens not a number", "embedding_max_tokens", "many"},
+		{"zero embedding max tokens", "embedding_max_tokens", "0"},
+		{"embedding batch size not a number", "embedding_batch_size", "large"},
+		{"zero embedding batch size", "embedding_batch_size", "0"},
+		{"model override without model", "embedding_model_overrides", "github"},
+		{"unknown chunk strategy", "chunk_strategy", "paragraph"},
+		{"chunk size not a number", "chunk_size", "big"},
+		{"overlap not below chunk size", "chunk_overlap", "1000"},
+		{"context tokens not a number", "max_context_tokens", "lots"},
+		{"reserve not below context tokens", "answer_reserve_tokens", "8192"},
+		{"empty theme", "theme", " "},
+		{"key binding without keys", "keybindings", "down="},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewConfigStore()
+			service := NewSettingsService(store, nil)
+
+			err := service.Set(tt.key, tt.value)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+			_, exists := store.Get("search." + tt.key)
+			assert.False(t, exists)
+		})
+	}
+}
+
+func TestSettingsService_Set_ContextBudget(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	settings, err := service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultMaxContextTokens, settings.LLM.MaxContextTokens)
+	assert.Equal(t, domain.DefaultAnswerReserveTokens, settings.LLM.AnswerReserveTokens)
+
+	require.NoError(t, service.Set("max_context_tokens", "128000"))
+	require.NoError(t, service.Set("answer_reserve_tokens", "0"))
+
+	settings, err = service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, 128000, settings.LLM.MaxContextTokens)
+	assert.Equal(t, 0, settings.LLM.AnswerReserveTokens, "zero is a valid value, not unset")
+	assert.NoError(t, service.Validate())
+}
+
+func TestSettingsService_Set_Language(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	settings, err := service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultSearchLanguage, settings.DefaultSearchLanguage)
+
+	require.NoError(t, service.Set("language", " FR "))
+
+	settings, err = service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, "fr", settings.DefaultSearchLanguage)
+	assert.NoError(t, service.Validate())
+}
+
+func TestSettingsService_Set_Chunking(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	settings, err := service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, domain.ChunkingFixed, settings.Chunking.Strategy)
+	assert.Equal(t, domain.DefaultChunkSize, settings.Chunking.ChunkSize)
+	assert.Equal(t, domain.DefaultChunkOverlap, settings.Chunking.Overlap)
+
+	require.NoError(t, service.Set("chunk_strategy", "heading"))
+	require.NoError(t, service.Set("chunk_size", "500"))
+	require.NoError(t, service.Set("chunk_overlap", "0"))
+
+	settings, err = service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, domain.ChunkingHeading, settings.Chunking.Strategy)
+	assert.Equal(t, 500, settings.Chunking.ChunkSize)
+	assert.Equal(t, 0, settings.Chunking.Overlap, "zero is a valid value, not unset")
+
+	// The chunker processor is built from the same settings
+	pipelineCfg := service.GetPipelineConfig()
+	cfg := pipelineCfg.GetProcessorConfig("chunker")
+	assert.Equal(t, "heading", cfg["strategy"])
+	assert.EqualValues(t, 500, cfg["chunk_size"])
+	assert.EqualValues(t, 0, cfg["overlap"])
+}
+
+func TestSettingsService_Set_MinSimilarity(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	settings, err := service.Get()
+	require.NoError(t, err)
+	assert.Zero(t, settings.Search.MinSimilarity)
+
+	require.NoError(t, service.Set("min_similarity", "0.35"))
+
+	settings, err = service.Get()
+	require.NoError(t, err)
+	assert.InDelta(t, 0.35, settings.Search.MinSimilarity, 1e-9)
+	assert.NoError(t, service.Validate())
+}
+
+func TestSettingsService_Set_EmbeddingMaxTokens(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	settings, err := service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultEmbeddingMaxTokens, settings.Embedding.MaxTokens)
+
+	require.NoError(t, service.Set("embedding_max_tokens", "8192"))
+
+	settings, err = service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, 8192, settings.Embedding.MaxTokens)
+	assert.NoError(t, service.Validate())
+}
+
+func TestSettingsService_Set_EmbeddingBatchSize(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	settings, err := service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultEmbeddingBatchSize, settings.Embedding.BatchSize)
+
+	require.NoError(t, service.Set("embedding_batch_size", "128"))
+
+	settings, err = service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, 128, settings.Embedding.BatchSize)
+	assert.NoError(t, service.Validate())
+}
+
+func TestSettingsService_Set_EmbeddingModelOverrides(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	require.NoError(t, service.Set("embedding_model_overrides", "github=nomic-embed-code, text/x-go=nomic-embed-code"))
+
+	settings, err := service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"github":    "nomic-embed-code",
+		"text/x-go": "nomic-embed-code",
+	}, settings.Embedding.ModelOverrides)
+
+	require.NoError(t, service.Set("embedding_model_overrides", ""))
+
+	settings, err = service.Get()
+	require.NoError(t, err)
+	assert.Empty(t, settings.Embedding.ModelOverrides)
+}
+
+func TestSettingsService_Set_Theme(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	settings, err := service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultTheme, settings.UI.Theme)
+
+	require.NoError(t, service.Set("theme", " ~/themes/nord.yaml "))
+
+	settings, err = service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, "~/themes/nord.yaml", settings.UI.Theme)
+	value, _ := store.Get("ui.theme")
+	assert.Equal(t, "~/themes/nord.yaml", value)
+}
+
+func TestSettingsService_Set_KeyBindings(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	require.NoError(t, service.Set("keybindings", "down=down n; up = up e"))
+
+	settings, err := service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"down": {"down", "n"},
+		"up":   {"up", "e"},
+	}, settings.UI.KeyBindings)
+	value, _ := store.Get("ui.keybindings")
+	assert.Equal(t, []string{"down=down n", "up=up e"}, value)
+
+	require.NoError(t, service.Set("keybindings", ""))
+
+	settings, err = service.Get()
+	require.NoError(t, err)
+	assert.Empty(t, settings.UI.KeyBindings)
+}
+
+func TestSettingsService_Get_IgnoresMalformedKeyBindings(t *testing.T) {
+	store := memory.NewConfigStore()
+	require.NoError(t, store.Set("ui.keybindings", []string{"down"}))
+	service := NewSettingsService(store, nil)
+
+	settings, err := service.Get()
+
+	require.NoError(t, err)
+	assert.Nil(t, settings.UI.KeyBindings)
+}
+
+func TestSettingsService_Set_TraceEndpoint(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	settings, err := service.Get()
+	require.NoError(t, err)
+	assert.Empty(t, settings.OpenTelemetryEndpoint)
+
+	require.NoError(t, service.Set("trace_endpoint", "http://localhost:4318"))
+	settings, err = service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:4318", settings.OpenTelemetryEndpoint)
+
+	err = service.Set("trace_endpoint", "localhost:4318")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	require.NoError(t, service.Set("trace_endpoint", ""))
+	settings, err = service.Get()
+	require.NoError(t, err)
+	assert.Empty(t, settings.OpenTelemetryEndpoint)
+}
+
+func TestSettingsService_Set_Locale(t *testing.T) {
+	store := memory.NewConfigStore()
+	service := NewSettingsService(store, nil)
+
+	settings, err := service.Get()
+	require.NoError(t, err)
+	assert.Empty(t, settings.Locale)
+
+	require.NoError(t, service.Set("locale", " en-GB "))
+	settings, err = service.Get()
+	require.NoError(t, err)
+	assert.Equal(t, "en-GB", settings.Locale)
+	assert.NoError(t, service.Validate())
+
+	err = service.Set("locale", "en_GB")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	require.NoError(t, service.Set("locale", ""))
+	settings, err = service.Get()
+	require.NoError(t, err)
+	assert.Empty(t, settings.Locale)
+}
+
+func TestSettingsService_Validate_InvalidLanguage(t *testing.T) {
+	store := memory.NewConfigStore()
+	_ = store.Set("search.language", "french")
+	service := NewSettingsService(store, nil)
+
+	err := service.Validate()
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestSettingsService_Validate_InvalidBM25(t *testing.T) {
+	store := memory.NewConfigStore()
+	_ = store.Set("search.bm25_b", 2.0)
+	service := NewSettingsService(store, nil)
+
+	err := service.Validate()
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
@@ -46,6 +47,9 @@ func (s *SourceService) Add(ctx context.Context, source domain.Source) error {
 	if source.ID == "" {
 		return domain.ErrInvalidInput
 	}
+	if err := domain.ValidateExclusionPatterns(source.ExclusionPatterns()); err != nil {
+		return err
+	}
 	// Check if already exists
 	existing, err := s.sourceStore.Get(ctx, source.ID)
 	if err == nil && existing != nil {
@@ -78,6 +82,9 @@ func (s *SourceService) Update(ctx context.Context, source domain.Source) error
 	if source.ID == "" {
 		return domain.ErrInvalidInput
 	}
+	if err := domain.ValidateExclusionPatterns(source.ExclusionPatterns()); err != nil {
+		return err
+	}
 	// Verify source exists
 	_, err := s.sourceStore.Get(ctx, source.ID)
 	if err != nil {
@@ -86,6 +93,19 @@ func (s *SourceService) Update(ctx context.Context, source domain.Source) error
 	return s.sourceStore.Save(ctx, source)
 }
 
+// Rename changes a source's display name.
+func (s *SourceService) Rename(ctx context.Context, id, newName string) error {
+	if err := domain.ValidateSourceName(newName); err != nil {
+		return err
+	}
+	source, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	source.Name = strings.TrimSpace(newName)
+	return s.Update(ctx, *source)
+}
+
 // Remove deletes a source and its indexed data.
 func (s *SourceService) Remove(ctx context.Context, id string) error {
 	if s.sourceStore == nil {
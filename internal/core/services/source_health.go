@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// Ensure SourceHealthService implements the interface.
+var _ driving.SourceHealthService = (*SourceHealthService)(nil)
+
+// tokenExpiryWarning is how close to expiry an OAuth token must be to warn.
+const tokenExpiryWarning = 24 * time.Hour
+
+// SourceHealthService validates sources without syncing them.
+type SourceHealthService struct {
+	sourceStore      driven.SourceStore
+	credentialsStore driven.CredentialsStore
+	healthStore      driven.SourceHealthStore
+	factory          driven.ConnectorFactory
+	now              func() time.Time
+}
+
+// NewSourceHealthService creates a new source health service.
+// The healthStore is optional - if nil, results are not recorded.
+func NewSourceHealthService(
+	sourceStore driven.SourceStore,
+	credentialsStore driven.CredentialsStore,
+	healthStore driven.SourceHealthStore,
+	factory driven.ConnectorFactory,
+) *SourceHealthService {
+	return &SourceHealthService{
+		sourceStore:      sourceStore,
+		credentialsStore: credentialsStore,
+		healthStore:      healthStore,
+		factory:          factory,
+		now:              time.Now,
+	}
+}
+
+// Check validates a single source and records the result.
+func (s *SourceHealthService) Check(ctx context.Context, sourceID string) (*domain.SourceHealth, error) {
+	if s.sourceStore == nil || s.factory == nil {
+		return nil, domain.ErrNotImplemented
+	}
+
+	source, err := s.sourceStore.Get(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("get source: %w", err)
+	}
+
+	health := domain.SourceHealth{
+		SourceID:  source.ID,
+		CheckedAt: s.now(),
+	}
+
+	if err := s.validate(ctx, source); err != nil {
+		health.Error = err.Error()
+	}
+
+	// Read credentials after validation, which may have refreshed the token
+	if err := s.checkToken(ctx, source, &health); err != nil {
+		return nil, err
+	}
+
+	if s.healthStore != nil {
+		if err := s.healthStore.Save(ctx, health); err != nil {
+			return nil, fmt.Errorf("save health: %w", err)
+		}
+	}
+
+	return &health, nil
+}
+
+// CheckAll validates every configured source and records the results.
+func (s *SourceHealthService) CheckAll(ctx context.Context) ([]domain.SourceHealth, error) {
+	if s.sourceStore == nil {
+		return nil, domain.ErrNotImplemented
+	}
+
+	sources, err := s.sourceStore.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sources: %w", err)
+	}
+
+	results := make([]domain.SourceHealth, 0, len(sources))
+	for i := range sources {
+		health, err := s.Check(ctx, sources[i].ID)
+		if err != nil {
+			return nil, fmt.Errorf("check %s: %w", sources[i].ID, err)
+		}
+		results = append(results, *health)
+	}
+	return results, nil
+}
+
+// Get returns the last recorded result for a source.
+func (s *SourceHealthService) Get(ctx context.Context, sourceID string) (*domain.SourceHealth, error) {
+	if s.healthStore == nil {
+		return nil, nil
+	}
+	health, err := s.healthStore.Get(ctx, sourceID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
+	}
+	return health, err
+}
+
+// validate creates the source's connector and validates it.
+func (s *SourceHealthService) validate(ctx context.Context, source *domain.Source) error {
+	connector, err := s.factory.Create(ctx, *source)
+	if err != nil {
+		return fmt.Errorf("create connector: %w", err)
+	}
+	defer connector.Close()
+
+	return connector.Validate(ctx)
+}
+
+// checkToken records the OAuth token expiry and warns if it is close.
+func (s *SourceHealthService) checkToken(
+	ctx context.Context, source *domain.Source, health *domain.SourceHealth,
+) error {
+	if source.CredentialsID == "" || s.credentialsStore == nil {
+		return nil
+	}
+
+	creds, err := s.credentialsStore.Get(ctx, source.CredentialsID)
+	if errors.Is(err, domain.ErrNotFound) {
+		if health.Error == "" {
+			health.Error = "credentials not found"
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get credentials: %w", err)
+	}
+	if creds.OAuth == nil || creds.OAuth.Expiry.IsZero() {
+		return nil
+	}
+
+	health.TokenExpiry = creds.OAuth.Expiry
+	remaining := creds.OAuth.Expiry.Sub(health.CheckedAt)
+	if remaining >= tokenExpiryWarning {
+		return nil
+	}
+
+	if remaining <= 0 {
+		health.Warning = "OAuth token has expired"
+	} else {
+		health.Warning = fmt.Sprintf("OAuth token expires in %s", remaining.Round(time.Minute))
+	}
+	if creds.OAuth.RefreshToken == "" {
+		health.Warning += " and no refresh token is stored"
+	}
+	return nil
+}
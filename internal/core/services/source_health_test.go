@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+type healthFixture struct {
+	sources     *memory.SourceStore
+	creds       *mockCredentialsStore
+	healthStore *memory.SourceHealthStore
+	factory     *syncMockConnectorFactory
+	svc         *SourceHealthService
+	now         time.Time
+}
+
+func newHealthFixture(t *testing.T) *healthFixture {
+	t.Helper()
+	f := &healthFixture{
+		sources:     memory.NewSourceStore(),
+		creds:       newMockCredentialsStore(),
+		healthStore: memory.NewSourceHealthStore(),
+		factory:     newSyncMockConnectorFactory(),
+		now:         time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+	f.svc = NewSourceHealthService(f.sources, f.creds, f.healthStore, f.factory)
+	f.svc.now = func() time.Time { return f.now }
+	return f
+}
+
+func (f *healthFixture) addSource(t *testing.T, src domain.Source, validateErr error) {
+	t.Helper()
+	require.NoError(t, f.sources.Save(context.Background(), src))
+	f.factory.connectors[src.ID] = &syncMockConnector{sourceID: src.ID, connType: src.Type, validateErr: validateErr}
+}
+
+func TestSourceHealthService_Check_OK(t *testing.T) {
+	f := newHealthFixture(t)
+	f.addSource(t, domain.Source{ID: "files", Type: "filesystem"}, nil)
+
+	health, err := f.svc.Check(context.Background(), "files")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.HealthOK, health.Status())
+	assert.Equal(t, f.now, health.CheckedAt)
+	assert.True(t, f.factory.connectors["files"].closed)
+
+	stored, err := f.svc.Get(context.Background(), "files")
+	require.NoError(t, err)
+	assert.Equal(t, health, stored)
+}
+
+func TestSourceHealthService_Check_ValidationFails(t *testing.T) {
+	f := newHealthFixture(t)
+	f.addSource(t, domain.Source{ID: "mail", Type: "gmail"}, errors.New("401 unauthorized"))
+
+	health, err := f.svc.Check(context.Background(), "mail")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.HealthFailed, health.Status())
+	assert.Equal(t, "401 unauthorized", health.Error)
+}
+
+func TestSourceHealthService_Check_CreateFails(t *testing.T) {
+	f := newHealthFixture(t)
+	require.NoError(t, f.sources.Save(context.Background(), domain.Source{ID: "orphan", Type: "gmail"}))
+
+	health, err := f.svc.Check(context.Background(), "orphan")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.HealthFailed, health.Status())
+	assert.Contains(t, health.Error, "create connector")
+}
+
+func TestSourceHealthService_Check_WarnsWhenTokenExpiresWithin24Hours(t *testing.T) {
+	f := newHealthFixture(t)
+	expiry := f.now.Add(5 * time.Hour)
+	f.creds.creds["c1"] = domain.Credentials{ID: "c1", OAuth: &domain.OAuthCredentials{
+		AccessToken: "token", RefreshToken: "refresh", Expiry: expiry,
+	}}
+	f.addSource(t, domain.Source{ID: "drive", Type: "google-drive", CredentialsID: "c1"}, nil)
+
+	health, err := f.svc.Check(context.Background(), "drive")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.HealthWarning, health.Status())
+	assert.Equal(t, "OAuth token expires in 5h0m0s", health.Warning)
+	assert.Equal(t, expiry, health.TokenExpiry)
+}
+
+func TestSourceHealthService_Check_ExpiredTokenWithoutRefreshToken(t *testing.T) {
+	f := newHealthFixture(t)
+	f.creds.creds["c1"] = domain.Credentials{ID: "c1", OAuth: &domain.OAuthCredentials{
+		AccessToken: "token", Expiry: f.now.Add(-time.Minute),
+	}}
+	f.addSource(t, domain.Source{ID: "drive", Type: "google-drive", CredentialsID: "c1"}, nil)
+
+	health, err := f.svc.Check(context.Background(), "drive")
+
+	require.NoError(t, err)
+	assert.Equal(t, "OAuth token has expired and no refresh token is stored", health.Warning)
+}
+
+func TestSourceHealthService_Check_NoWarningForDistantExpiry(t *testing.T) {
+	f := newHealthFixture(t)
+	f.creds.creds["c1"] = domain.Credentials{ID: "c1", OAuth: &domain.OAuthCredentials{
+		AccessToken: "token", Expiry: f.now.Add(48 * time.Hour),
+	}}
+	f.addSource(t, domain.Source{ID: "drive", Type: "google-drive", CredentialsID: "c1"}, nil)
+
+	health, err := f.svc.Check(context.Background(), "drive")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.HealthOK, health.Status())
+	assert.False(t, health.TokenExpiry.IsZero())
+}
+
+func TestSourceHealthService_Check_MissingCredentials(t *testing.T) {
+	f := newHealthFixture(t)
+	f.addSource(t, domain.Source{ID: "repo", Type: "github", CredentialsID: "gone"}, nil)
+
+	health, err := f.svc.Check(context.Background(), "repo")
+
+	require.NoError(t, err)
+	assert.Equal(t, "credentials not found", health.Error)
+}
+
+func TestSourceHealthService_Check_SourceNotFound(t *testing.T) {
+	f := newHealthFixture(t)
+
+	_, err := f.svc.Check(context.Background(), "missing")
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestSourceHealthService_CheckAll(t *testing.T) {
+	f := newHealthFixture(t)
+	f.addSource(t, domain.Source{ID: "good", Type: "filesystem"}, nil)
+	f.addSource(t, domain.Source{ID: "bad", Type: "gmail"}, errors.New("revoked"))
+
+	results, err := f.svc.CheckAll(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	statuses := map[string]domain.HealthStatus{}
+	for i := range results {
+		statuses[results[i].SourceID] = results[i].Status()
+	}
+	assert.Equal(t, map[string]domain.HealthStatus{
+		"good": domain.HealthOK,
+		"bad":  domain.HealthFailed,
+	}, statuses)
+}
+
+func TestSourceHealthService_Get_NeverChecked(t *testing.T) {
+	f := newHealthFixture(t)
+
+	health, err := f.svc.Get(context.Background(), "files")
+
+	require.NoError(t, err)
+	assert.Nil(t, health)
+	assert.Equal(t, domain.HealthUnknown, health.Status())
+}
+
+func TestSourceHealthService_NotConfigured(t *testing.T) {
+	svc := NewSourceHealthService(nil, nil, nil, nil)
+
+	_, err := svc.Check(context.Background(), "files")
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+
+	health, err := svc.Get(context.Background(), "files")
+	require.NoError(t, err)
+	assert.Nil(t, health)
+}
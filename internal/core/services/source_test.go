@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -67,6 +68,26 @@ func TestSourceService_Add_EmptyID(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrInvalidInput)
 }
 
+func TestSourceService_InvalidExclusionPattern(t *testing.T) {
+	service := NewSourceService(memory.NewSourceStore(), memory.NewSyncStateStore(), memory.NewDocumentStore())
+	ctx := context.Background()
+
+	source := domain.Source{
+		ID:     "test-source",
+		Name:   "Notes",
+		Type:   "filesystem",
+		Config: map[string]string{"path": "/notes", domain.ConfigKeyExcludePatterns: "*.log,[bad"},
+	}
+	err := service.Add(ctx, source)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	source.Config[domain.ConfigKeyExcludePatterns] = "*.log"
+	require.NoError(t, service.Add(ctx, source))
+
+	source.Config[domain.ConfigKeyExcludePatterns] = "[bad"
+	assert.ErrorIs(t, service.Update(ctx, source), domain.ErrInvalidInput)
+}
+
 func TestSourceService_Add_AlreadyExists(t *testing.T) {
 	sourceStore := memory.NewSourceStore()
 	syncStore := memory.NewSyncStateStore()
@@ -190,6 +211,51 @@ func TestSourceService_List_NilStore(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrNotImplemented)
 }
 
+func TestSourceService_Rename_Success(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	service := NewSourceService(sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore())
+	ctx := context.Background()
+	require.NoError(t, service.Add(ctx, domain.Source{
+		ID:     "test-source",
+		Name:   "Old Name",
+		Type:   "filesystem",
+		Config: map[string]string{"path": "/docs"},
+	}))
+
+	err := service.Rename(ctx, "test-source", "  New Name  ")
+
+	require.NoError(t, err)
+	retrieved, err := service.Get(ctx, "test-source")
+	require.NoError(t, err)
+	assert.Equal(t, "New Name", retrieved.Name)
+	assert.Equal(t, "filesystem", retrieved.Type)
+	assert.Equal(t, "/docs", retrieved.Config["path"])
+}
+
+func TestSourceService_Rename_InvalidName(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	service := NewSourceService(sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore())
+	ctx := context.Background()
+	require.NoError(t, service.Add(ctx, domain.Source{ID: "test-source", Name: "Old Name", Type: "filesystem"}))
+
+	for _, name := range []string{"", "   ", strings.Repeat("a", domain.MaxSourceNameLength+1)} {
+		err := service.Rename(ctx, "test-source", name)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	}
+
+	retrieved, err := service.Get(ctx, "test-source")
+	require.NoError(t, err)
+	assert.Equal(t, "Old Name", retrieved.Name)
+}
+
+func TestSourceService_Rename_NotFound(t *testing.T) {
+	service := NewSourceService(memory.NewSourceStore(), memory.NewSyncStateStore(), memory.NewDocumentStore())
+
+	err := service.Rename(context.Background(), "nonexistent", "New Name")
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
 func TestSourceService_Remove_Success(t *testing.T) {
 	sourceStore := memory.NewSourceStore()
 	syncStore := memory.NewSyncStateStore()
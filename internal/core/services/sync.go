@@ -2,15 +2,24 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
-	"github.com/custodia-labs/sercha-cli/internal/logger"
 )
 
 // Ensure SyncOrchestrator implements the interface.
@@ -25,9 +34,32 @@ type SyncOrchestrator struct {
 	factory          driven.ConnectorFactory
 	registry         driven.NormaliserRegistry
 	pipeline         driven.PostProcessorPipeline
+	pipelines        driven.PipelineProvider
 	searchIndex      driven.SearchEngine
 	vectorIndex      driven.VectorIndex
 	embeddingService driven.EmbeddingService
+	tokenProviders   driven.TokenProviderFactory
+	limiter          *SyncLimiter
+	workers          int
+	parallelFetch    bool
+	retryAttempts    int
+	retryDelay       time.Duration
+	skipEmpty        bool
+	embedMaxTokens   int
+	embedBatchSize   int
+	embeddingModels  embeddingModels
+
+	// Notifier told about finished syncs, and the events it is told about
+	notifier     driven.Notifier
+	notifyEvents []domain.SyncEvent
+
+	// Embedding cache, and the models it was last invalidated for
+	embeddingCache driven.EmbeddingCache
+	cacheMu        sync.Mutex
+	cacheModels    string
+
+	// Audit log recording what each sync did with every document
+	auditLog driven.SyncAuditLog
 
 	// Status tracking
 	mu          sync.RWMutex
@@ -60,45 +92,193 @@ func NewSyncOrchestrator(
 		searchIndex:      searchIndex,
 		vectorIndex:      vectorIndex,
 		embeddingService: embeddingService,
+		workers:          defaultSyncWorkers,
+		retryAttempts:    defaultItemRetryAttempts,
+		retryDelay:       defaultItemRetryDelay,
+		skipEmpty:        domain.DefaultSyncConfig().SkipEmpty,
+		embedBatchSize:   domain.DefaultEmbeddingBatchSize,
 		activeSyncs:      make(map[string]*driving.SyncStatus),
 	}
 }
 
-// Sync triggers synchronisation for a source.
-//
-//nolint:gocyclo // Orchestration function with necessary sequential steps
+// defaultSyncWorkers is how many sources SyncAll syncs in parallel by default.
+const defaultSyncWorkers = 4
+
+// errEmptyDocument marks a document skipped because its normalised content
+// is empty or only whitespace.
+var errEmptyDocument = errors.New("document has no content")
+
+// errExcludedDocument marks a document the user excluded. Unlike skipped
+// documents, it counts as processed.
+var errExcludedDocument = errors.New("document is excluded")
+
+// errPatternExcluded marks a document skipped because it matches one of its
+// source's exclusion patterns.
+var errPatternExcluded = errors.New("document matches an exclusion pattern")
+
+// Retry policy for individual documents during partial syncs.
+const (
+	defaultItemRetryAttempts = 3
+	defaultItemRetryDelay    = 500 * time.Millisecond
+)
+
+// notifyTimeout bounds how long a sync waits for its notification to be sent.
+const notifyTimeout = 10 * time.Second
+
+// syncRun holds the state of one source's sync while it is running.
+type syncRun struct {
+	source   *domain.Source
+	status   *driving.SyncStatus
+	pipeline driven.PostProcessorPipeline
+
+	// partial is set when the connector supports partial sync, enabling
+	// checkpoints and per-document retries.
+	partial bool
+
+	// lastSync is carried over to checkpoints, which do not complete a sync.
+	lastSync time.Time
+
+	// id identifies the run in the audit log, and audit holds its entries
+	// until they are written at the next checkpoint, when the buffer is
+	// full, or when the run finishes. knownURIs holds the URIs of the source's
+	// documents, telling created and updated documents apart. All three are
+	// only set when there is an audit log.
+	id        string
+	audit     []domain.SyncAuditEntry
+	knownURIs map[string]bool
+}
+
+// SetTokenProviderFactory sets the factory used to refresh credentials before sync.
+// If unset, tokens are only refreshed reactively by connectors.
+func (o *SyncOrchestrator) SetTokenProviderFactory(factory driven.TokenProviderFactory) {
+	o.tokenProviders = factory
+}
+
+// SetPipelineProvider sets the provider that selects each source's
+// post-processor pipeline, so sources can override the global one.
+// If unset, every source uses the pipeline passed to NewSyncOrchestrator.
+func (o *SyncOrchestrator) SetPipelineProvider(provider driven.PipelineProvider) {
+	o.pipelines = provider
+}
+
+// SetSyncLimiter sets the limiter that caps concurrent syncs started by SyncAll.
+// Share it with the Scheduler so both draw from the same pool.
+func (o *SyncOrchestrator) SetSyncLimiter(limiter *SyncLimiter) {
+	o.limiter = limiter
+}
+
+// SetSyncWorkers sets how many sources SyncAll syncs in parallel.
+// Values below 1 sync sources one at a time.
+func (o *SyncOrchestrator) SetSyncWorkers(workers int) {
+	o.workers = max(workers, 1)
+}
+
+// SetItemRetry sets how many times a failing document is attempted during a
+// partial sync, and the delay before the first retry. The delay doubles after
+// each attempt. Values below 1 disable retries.
+func (o *SyncOrchestrator) SetItemRetry(attempts int, baseDelay time.Duration) {
+	o.retryAttempts = max(attempts, 1)
+	o.retryDelay = baseDelay
+}
+
+// SetSkipEmpty sets whether documents whose normalised content is empty or
+// only whitespace are skipped rather than indexed. Enabled by default.
+func (o *SyncOrchestrator) SetSkipEmpty(enabled bool) {
+	o.skipEmpty = enabled
+}
+
+// SetEmbeddingMaxTokens sets the most tokens the embedding model accepts in
+// one input. Chunks estimated to be longer are split into pieces that fit
+// before they are embedded. Values below 1 disable splitting.
+func (o *SyncOrchestrator) SetEmbeddingMaxTokens(maxTokens int) {
+	o.embedMaxTokens = maxTokens
+}
+
+// SetEmbeddingBatchSize sets the most texts sent to the embedding service in
+// one request. Full syncs collect documents until their chunks fill a batch,
+// then embed them together. Values below 1 embed one text per request.
+func (o *SyncOrchestrator) SetEmbeddingBatchSize(size int) {
+	o.embedBatchSize = max(size, 1)
+}
+
+// SetEmbeddingModels sets the embedding model overrides, keyed by connector
+// type or MIME type prefix (see domain.EmbeddingSettings.ModelFor), and the
+// embedding service of each override model. Documents whose model has no
+// service are embedded by the default embedding service.
+func (o *SyncOrchestrator) SetEmbeddingModels(
+	overrides map[string]string, services map[string]driven.EmbeddingService,
+) {
+	o.embeddingModels = embeddingModels{overrides: overrides, services: services}
+}
+
+// SetEmbeddingCache sets the cache consulted before embedding a chunk, so
+// chunks whose text is unchanged are not re-embedded on later syncs.
+// If the cache implements driven.DocumentEmbeddingCache, documents whose
+// content is unchanged reuse their stored embeddings without any lookups.
+// Entries computed by a different model are discarded when first used.
+// If unset, every chunk is embedded.
+func (o *SyncOrchestrator) SetEmbeddingCache(cache driven.EmbeddingCache) {
+	o.embeddingCache = cache
+}
+
+// SetSyncAuditLog sets the log recording what each sync did with every
+// document. If unset, nothing is recorded.
+func (o *SyncOrchestrator) SetSyncAuditLog(auditLog driven.SyncAuditLog) {
+	o.auditLog = auditLog
+}
+
+// SetParallelWithinSource makes subsequent syncs fetch each source's content
+// types concurrently, for connectors that support it. Sources can also opt in
+// permanently via the domain.ConfigKeyParallelWithinSource config key.
+func (o *SyncOrchestrator) SetParallelWithinSource(enabled bool) {
+	o.parallelFetch = enabled
+}
+
+// SetNotifier sets the notifier told when a sync finishes with one of
+// events. This covers syncs started by SyncAll and by the Scheduler.
+// Notification failures are logged and do not fail the sync.
+// If unset, no notifications are sent.
+func (o *SyncOrchestrator) SetNotifier(notifier driven.Notifier, events []domain.SyncEvent) {
+	o.notifier = notifier
+	o.notifyEvents = events
+}
+
+// Sync triggers synchronisation for a source. The sync is traced as a span
+// whose children are the page fetches of the source's connector.
 func (o *SyncOrchestrator) Sync(ctx context.Context, sourceID string) error {
-	// 1. Get source configuration
-	source, err := o.sourceStore.Get(ctx, sourceID)
-	if err != nil {
-		return fmt.Errorf("get source: %w", err)
+	ctx, span := startSpan(ctx, "sync", trace.WithAttributes(attribute.String("source.id", sourceID)))
+	startedAt := time.Now()
+	run, err := o.sync(ctx, sourceID)
+	if run != nil {
+		span.SetAttributes(
+			attribute.Int("documents.processed", run.status.DocumentsProcessed),
+			attribute.Int("documents.failed", run.status.FailedCount))
 	}
+	endSpan(span, err)
+	o.writeAudit(ctx, run)
+	o.recordError(sourceID, err)
+	o.notify(ctx, sourceID, run, startedAt, err)
+	return err
+}
 
-	// 2. Create connector from source
-	if o.factory == nil {
-		return fmt.Errorf("create connector: connector factory not configured")
-	}
-	connector, err := o.factory.Create(ctx, *source)
+// sync runs a source's sync. The returned run is nil if the sync failed
+// before any documents were fetched.
+func (o *SyncOrchestrator) sync(ctx context.Context, sourceID string) (*syncRun, error) {
+	// 1-5. Load the source, create and validate its connector, and get sync state
+	source, connector, syncState, err := o.openConnector(ctx, sourceID)
 	if err != nil {
-		return fmt.Errorf("create connector: %w", err)
+		return nil, err
 	}
 	defer connector.Close()
-
-	// 3. Validate connector (check auth, configuration, connectivity)
 	caps := connector.Capabilities()
-	if caps.SupportsValidation {
-		if err := connector.Validate(ctx); err != nil {
-			return fmt.Errorf("%w: %w", domain.ErrConnectorValidation, err)
-		}
-	}
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("connector.type", source.Type))
 
-	// 4. Get sync state (for incremental sync)
-	syncState, err := o.syncStore.Get(ctx, sourceID)
-	if err != nil && !errors.Is(err, domain.ErrNotFound) {
-		return fmt.Errorf("get sync state: %w", err)
+	pipeline, err := o.pipelineFor(source)
+	if err != nil {
+		return nil, err
 	}
 
-	// 5. Initialise status tracking
+	// 6. Initialise status tracking
 	status := &driving.SyncStatus{
 		SourceID:           sourceID,
 		Running:            true,
@@ -106,21 +286,27 @@ func (o *SyncOrchestrator) Sync(ctx context.Context, sourceID string) error {
 		ErrorCount:         0,
 	}
 	o.setStatus(sourceID, status)
-	defer o.clearStatus(sourceID)
+	defer o.finishStatus(sourceID)
 
-	logger.Info("Starting sync for source %s", sourceID)
+	run := &syncRun{source: source, status: status, pipeline: pipeline, partial: caps.SupportsPartialSync}
+	if syncState != nil {
+		run.lastSync = syncState.LastSync
+	}
+	o.startAudit(ctx, run)
 
-	// 6. Choose sync strategy based on connector capabilities
+	slog.Info("sync started", slog.String("source_id", sourceID))
+
+	// 7. Choose sync strategy based on connector capabilities
 	var newCursor string
 
-	if caps.SupportsIncremental && syncState != nil && syncState.Cursor != "" {
+	if useIncremental(caps, syncState) {
 		// Incremental sync
 		changesCh, errsCh := connector.IncrementalSync(ctx, *syncState)
-		newCursor, err = o.processChanges(ctx, source, changesCh, errsCh, status)
+		newCursor, err = o.processChanges(ctx, run, changesCh, errsCh)
 	} else {
 		// Full sync
 		docsCh, errsCh := connector.FullSync(ctx)
-		newCursor, err = o.processDocuments(ctx, source, docsCh, errsCh, status)
+		newCursor, err = o.processDocuments(ctx, run, docsCh, errsCh)
 		// For full sync, fall back to current time if no cursor was returned
 		if err == nil && newCursor == "" && caps.SupportsCursorReturn {
 			newCursor = fmt.Sprintf("%d", time.Now().UnixNano())
@@ -128,46 +314,262 @@ func (o *SyncOrchestrator) Sync(ctx context.Context, sourceID string) error {
 	}
 
 	if err != nil {
-		return err
+		return run, err
 	}
 
-	// 7. Update sync state with new cursor
+	// 8. Update sync state with new cursor
 	newState := domain.SyncState{
 		SourceID: sourceID,
 		Cursor:   newCursor,
 		LastSync: time.Now(),
 	}
 	if err := o.syncStore.Save(ctx, newState); err != nil {
-		return fmt.Errorf("save sync state: %w", err)
+		return run, fmt.Errorf("save sync state: %w", err)
+	}
+
+	slog.Info("sync finished",
+		slog.String("source_id", sourceID),
+		slog.Int("succeeded", status.DocumentsProcessed),
+		slog.Int("failed", status.FailedCount),
+		slog.Int("skipped", status.SkippedCount))
+	return run, nil
+}
+
+// notify tells the notifier how a sync finished, if it asked for the event.
+func (o *SyncOrchestrator) notify(
+	ctx context.Context, sourceID string, run *syncRun, startedAt time.Time, err error,
+) {
+	if o.notifier == nil {
+		return
+	}
+	event := domain.SyncEventCompleted
+	if err != nil {
+		event = domain.SyncEventFailed
+	}
+	if !slices.Contains(o.notifyEvents, event) {
+		return
+	}
+
+	// Failed syncs are still reported when ctx was cancelled
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), notifyTimeout)
+	defer cancel()
+
+	notification := domain.SyncNotification{
+		Event:      event,
+		SourceID:   sourceID,
+		StartedAt:  startedAt,
+		FinishedAt: time.Now(),
+	}
+	if err != nil {
+		notification.Error = err.Error()
+	}
+
+	var source *domain.Source
+	if run != nil {
+		source = run.source
+		notification.DocumentsProcessed = run.status.DocumentsProcessed
+		notification.FailedCount = run.status.FailedCount
+		notification.SkippedCount = run.status.SkippedCount
+	} else if s, getErr := o.sourceStore.Get(ctx, sourceID); getErr == nil {
+		source = s
+	}
+	if source != nil {
+		notification.SourceName = source.Name
+		notification.SourceType = source.Type
+	}
+
+	if err := o.notifier.Notify(ctx, notification); err != nil {
+		slog.Warn("failed to send sync notification", slog.String("source_id", sourceID), slog.Any("error", err))
+	}
+}
+
+// openConnector loads a source, creates and validates its connector, and
+// returns the source's sync state, which is nil before the first sync.
+// The caller must close the connector.
+func (o *SyncOrchestrator) openConnector(
+	ctx context.Context, sourceID string,
+) (*domain.Source, driven.Connector, *domain.SyncState, error) {
+	// 1. Get source configuration
+	source, err := o.sourceStore.Get(ctx, sourceID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("get source: %w", err)
+	}
+
+	// 2. Proactively refresh credentials so tokens don't expire mid-sync
+	if err := o.refreshCredentials(ctx, source); err != nil {
+		return nil, nil, nil, err
+	}
+
+	// 3. Create connector from source
+	if o.factory == nil {
+		return nil, nil, nil, fmt.Errorf("create connector: connector factory not configured")
+	}
+	connector, err := o.factory.Create(ctx, o.connectorSource(source))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("create connector: %w", err)
+	}
+
+	// 4. Validate connector (check auth, configuration, connectivity)
+	if connector.Capabilities().SupportsValidation {
+		if err := connector.Validate(ctx); err != nil {
+			connector.Close()
+			return nil, nil, nil, fmt.Errorf("%w: %w", domain.ErrConnectorValidation, err)
+		}
+	}
+
+	// 5. Get sync state (for incremental sync)
+	syncState, err := o.syncStore.Get(ctx, sourceID)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		connector.Close()
+		return nil, nil, nil, fmt.Errorf("get sync state: %w", err)
 	}
 
-	logger.Info("Sync complete: %d documents, %d errors", status.DocumentsProcessed, status.ErrorCount)
-	status.Running = false
+	return source, connector, syncState, nil
+}
+
+// useIncremental reports whether a sync can fetch only changes since syncState.
+func useIncremental(caps driven.ConnectorCapabilities, syncState *domain.SyncState) bool {
+	return caps.SupportsIncremental && syncState != nil && syncState.Cursor != ""
+}
+
+// pipelineFor returns the post-processor pipeline for the source's documents.
+func (o *SyncOrchestrator) pipelineFor(source *domain.Source) (driven.PostProcessorPipeline, error) {
+	if o.pipelines == nil {
+		return o.pipeline, nil
+	}
+	pipeline, err := o.pipelines.PipelineFor(source)
+	if err != nil {
+		return nil, fmt.Errorf("select pipeline: %w", err)
+	}
+	return pipeline, nil
+}
+
+// connectorSource returns the source to create a connector from, applying
+// per-run options without modifying the stored source.
+func (o *SyncOrchestrator) connectorSource(source *domain.Source) domain.Source {
+	if !o.parallelFetch {
+		return *source
+	}
+
+	connSource := *source
+	connSource.Config = maps.Clone(source.Config)
+	if connSource.Config == nil {
+		connSource.Config = make(map[string]string)
+	}
+	connSource.Config[domain.ConfigKeyParallelWithinSource] = "true"
+	return connSource
+}
+
+// refreshCredentials refreshes the source's access token if it is about to expire.
+// A rejected refresh token is reported as a ReauthRequiredError so the user
+// knows to authenticate again rather than retry.
+func (o *SyncOrchestrator) refreshCredentials(ctx context.Context, source *domain.Source) error {
+	if o.tokenProviders == nil || source.CredentialsID == "" {
+		return nil
+	}
+
+	tokenProvider, err := o.tokenProviders.CreateTokenProvider(ctx, source)
+	if err != nil {
+		return fmt.Errorf("create token provider: %w", err)
+	}
+
+	if err := tokenProvider.RefreshIfNeeded(ctx); err != nil {
+		if errors.Is(err, domain.ErrAuthExpired) {
+			return &domain.ReauthRequiredError{SourceID: source.ID, Err: err}
+		}
+		return fmt.Errorf("refresh credentials: %w", err)
+	}
 	return nil
 }
 
 // SyncAll triggers synchronisation for all configured sources.
+// Sources are synced in parallel by a bounded pool of workers, each of which
+// also takes a slot from the shared limiter. A failing source does not stop
+// the others; failures are combined into one error naming each source.
 func (o *SyncOrchestrator) SyncAll(ctx context.Context) error {
 	sources, err := o.sourceStore.List(ctx)
 	if err != nil {
 		return fmt.Errorf("list sources: %w", err)
 	}
 
-	var errs []error
-	for _, source := range sources {
-		if err := o.Sync(ctx, source.ID); err != nil {
-			errs = append(errs, fmt.Errorf("sync %s: %w", source.ID, err))
+	// Each worker records into its source's slot, so errors keep source order
+	results := make([]error, len(sources))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for range min(o.workers, len(sources)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = o.syncWithSlot(ctx, sources[i].ID)
+			}
+		}()
+	}
+
+feed:
+	for i := range sources {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			for j := i; j < len(sources); j++ {
+				results[j] = ctx.Err()
+			}
+			break feed
 		}
 	}
+	close(jobs)
+	wg.Wait()
 
+	var errs []error
+	for i, err := range results {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("sync %s (%s): %w", sources[i].ID, sources[i].Name, err))
+		}
+	}
 	if len(errs) > 0 {
 		return errors.Join(errs...)
 	}
 	return nil
 }
 
-// Status returns sync status for a source.
-func (o *SyncOrchestrator) Status(_ context.Context, sourceID string) (*driving.SyncStatus, error) {
+// syncWithSlot syncs a source once a slot is free in the shared limiter.
+func (o *SyncOrchestrator) syncWithSlot(ctx context.Context, sourceID string) error {
+	if err := o.limiter.Acquire(ctx); err != nil {
+		return err
+	}
+	defer o.limiter.Release()
+	return o.Sync(ctx, sourceID)
+}
+
+// Status returns sync status for a source, including when it last synced
+// and how many documents it has.
+func (o *SyncOrchestrator) Status(ctx context.Context, sourceID string) (*driving.SyncStatus, error) {
+	status := o.runStatus(sourceID)
+
+	if o.syncStore != nil {
+		state, err := o.syncStore.Get(ctx, sourceID)
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+		case err != nil:
+			return nil, fmt.Errorf("get sync state: %w", err)
+		case state != nil:
+			status.LastSyncAt = state.LastSync
+		}
+	}
+
+	count, err := o.countDocuments(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("count documents: %w", err)
+	}
+	status.DocumentCount = count
+
+	return status, nil
+}
+
+// runStatus returns a copy of the status of a source's current or last sync
+// since startup, or an idle status if it has not synced.
+func (o *SyncOrchestrator) runStatus(sourceID string) *driving.SyncStatus {
 	o.mu.RLock()
 	defer o.mu.RUnlock()
 
@@ -178,14 +580,38 @@ func (o *SyncOrchestrator) Status(_ context.Context, sourceID string) (*driving.
 			Running:            status.Running,
 			DocumentsProcessed: status.DocumentsProcessed,
 			ErrorCount:         status.ErrorCount,
-		}, nil
+			FailedCount:        status.FailedCount,
+			SkippedCount:       status.SkippedCount,
+			FailedURIs:         slices.Clone(status.FailedURIs),
+			CacheHits:          status.CacheHits,
+			CacheMisses:        status.CacheMisses,
+			LastError:          status.LastError,
+		}
 	}
 
 	// Not running - return idle status
 	return &driving.SyncStatus{
 		SourceID: sourceID,
 		Running:  false,
-	}, nil
+	}
+}
+
+// countDocuments counts a source's documents, or none without a document store.
+func (o *SyncOrchestrator) countDocuments(ctx context.Context, sourceID string) (int, error) {
+	if o.docStore == nil {
+		return 0, nil
+	}
+	return countDocuments(ctx, o.docStore, sourceID)
+}
+
+// countDocuments counts a source's documents in docStore, without loading
+// them when the store implements driven.DocumentCounter.
+func countDocuments(ctx context.Context, docStore driven.DocumentStore, sourceID string) (int, error) {
+	if counter, ok := docStore.(driven.DocumentCounter); ok {
+		return counter.CountDocuments(ctx, sourceID)
+	}
+	docs, err := docStore.ListDocuments(ctx, sourceID)
+	return len(docs), err
 }
 
 // processDocuments handles full sync - processes all documents from the connector.
@@ -194,14 +620,19 @@ func (o *SyncOrchestrator) Status(_ context.Context, sourceID string) (*driving.
 //nolint:gocognit // Orchestration function coordinating multiple async operations
 func (o *SyncOrchestrator) processDocuments(
 	ctx context.Context,
-	source *domain.Source,
+	run *syncRun,
 	docsCh <-chan domain.RawDocument,
 	errsCh <-chan error,
-	status *driving.SyncStatus,
 ) (string, error) {
 	var newCursor string
+	batch := &documentBatch{}
 
 	for {
+		if docsCh == nil && errsCh == nil {
+			o.flushBatch(ctx, run, batch)
+			return newCursor, nil // Done - both channels closed
+		}
+
 		select {
 		case <-ctx.Done():
 			return "", ctx.Err()
@@ -216,44 +647,104 @@ func (o *SyncOrchestrator) processDocuments(
 				newCursor = sc.NewCursor
 				continue
 			}
+			if cp, isCheckpoint := driven.IsSyncCheckpoint(err); isCheckpoint {
+				// Documents before the checkpoint must be stored before it is saved
+				o.flushBatch(ctx, run, batch)
+				o.saveCheckpoint(ctx, run, cp.Cursor)
+				continue
+			}
 			if err != nil {
+				o.flushBatch(ctx, run, batch)
 				return "", fmt.Errorf("connector error: %w", err)
 			}
 
 		case rawDoc, ok := <-docsCh:
 			if !ok {
-				return newCursor, nil // Done - channel closed
-			}
-
-			logger.Debug("Processing: %s", rawDoc.URI)
-			if err := o.processOneDocument(ctx, source, &rawDoc); err != nil {
-				status.ErrorCount++
-				if errors.Is(err, domain.ErrNotImplemented) {
-					logger.Debug("Skipping %s: %v", rawDoc.URI, err)
-				} else {
-					logger.Debug("Failed to process %s: %v", rawDoc.URI, err)
-				}
+				// Keep reading errors: the connector's final result may still be pending
+				docsCh = nil
 				continue
 			}
-			status.DocumentsProcessed++
+
+			slog.Debug("processing document", slog.String("source_id", run.source.ID), slog.String("uri", rawDoc.URI))
+			o.addToBatch(ctx, run, batch, &rawDoc)
 		}
 	}
 }
 
+// documentBatch collects prepared documents during a full sync, so that the
+// chunks of several documents are embedded in one request.
+type documentBatch struct {
+	docs []*preparedDocument
+
+	// texts counts the chunks of docs still to be embedded.
+	texts int
+}
+
+// addToBatch prepares a document and adds it to batch, which is flushed once
+// it holds a full embedding batch of documents or chunks. Documents that fail
+// to prepare or are excluded are recorded straight away.
+func (o *SyncOrchestrator) addToBatch(
+	ctx context.Context, run *syncRun, batch *documentBatch, raw *domain.RawDocument,
+) {
+	var prepared *preparedDocument
+	err := o.processWithRetry(ctx, run, raw.URI, func() error {
+		var err error
+		prepared, err = o.prepareDocument(ctx, run, raw)
+		return err
+	})
+	if err != nil {
+		o.recordResult(ctx, run, domain.ChangeCreated, raw.URI, err)
+		return
+	}
+
+	batch.docs = append(batch.docs, prepared)
+	if !prepared.embedded {
+		batch.texts += len(prepared.chunks)
+	}
+	if o.embeddingService == nil || len(batch.docs) >= o.embedBatchSize || batch.texts >= o.embedBatchSize {
+		o.flushBatch(ctx, run, batch)
+	}
+}
+
+// flushBatch embeds the batch's documents together, then stores and records
+// each of them. If the batch fails to embed, its documents are embedded one
+// at a time, so that one bad document does not fail the others.
+func (o *SyncOrchestrator) flushBatch(ctx context.Context, run *syncRun, batch *documentBatch) {
+	if len(batch.docs) == 0 {
+		return
+	}
+	if err := o.embedDocuments(ctx, batch.docs); err != nil {
+		slog.Debug("embedding batch failed, embedding documents one at a time",
+			slog.String("source_id", run.source.ID), slog.Int("documents", len(batch.docs)), slog.Any("error", err))
+	}
+
+	for _, prepared := range batch.docs {
+		err := o.processWithRetry(ctx, run, prepared.raw.URI, func() error {
+			return o.finishDocument(ctx, prepared)
+		})
+		o.recordResult(ctx, run, domain.ChangeCreated, prepared.raw.URI, err)
+	}
+	batch.docs = nil
+	batch.texts = 0
+}
+
 // processChanges handles incremental sync - processes document changes.
 // Returns the new cursor from SyncComplete if the connector provides one.
 //
 //nolint:gocognit // Orchestration function coordinating multiple async operations
 func (o *SyncOrchestrator) processChanges(
 	ctx context.Context,
-	source *domain.Source,
+	run *syncRun,
 	changesCh <-chan domain.RawDocumentChange,
 	errsCh <-chan error,
-	status *driving.SyncStatus,
 ) (string, error) {
 	var newCursor string
 
 	for {
+		if changesCh == nil && errsCh == nil {
+			return newCursor, nil // Done - both channels closed
+		}
+
 		select {
 		case <-ctx.Done():
 			return "", ctx.Err()
@@ -268,91 +759,254 @@ func (o *SyncOrchestrator) processChanges(
 				newCursor = sc.NewCursor
 				continue
 			}
+			if cp, isCheckpoint := driven.IsSyncCheckpoint(err); isCheckpoint {
+				o.saveCheckpoint(ctx, run, cp.Cursor)
+				continue
+			}
 			if err != nil {
 				return "", fmt.Errorf("connector error: %w", err)
 			}
 
 		case change, ok := <-changesCh:
 			if !ok {
-				return newCursor, nil // Done - channel closed
+				// Keep reading errors: the connector's final result may still be pending
+				changesCh = nil
+				continue
 			}
 
+			var err error
 			switch change.Type {
 			case domain.ChangeCreated, domain.ChangeUpdated:
-				logger.Debug("Processing: %s", change.Document.URI)
-				if err := o.processOneDocument(ctx, source, &change.Document); err != nil {
-					status.ErrorCount++
-					if errors.Is(err, domain.ErrNotImplemented) {
-						logger.Debug("Skipping %s: %v", change.Document.URI, err)
-					} else {
-						logger.Debug("Failed to process %s: %v", change.Document.URI, err)
-					}
-					continue
-				}
+				slog.Debug("processing document",
+					slog.String("source_id", run.source.ID), slog.String("uri", change.Document.URI))
+				err = o.processWithRetry(ctx, run, change.Document.URI, func() error {
+					return o.processOneDocument(ctx, run, &change.Document)
+				})
 
 			case domain.ChangeDeleted:
-				logger.Debug("Deleting: %s", change.Document.URI)
-				if err := o.deleteDocumentByURI(ctx, source.ID, change.Document.URI); err != nil {
-					status.ErrorCount++
-					logger.Debug("Failed to delete %s: %v", change.Document.URI, err)
-					continue
-				}
+				slog.Debug("deleting document",
+					slog.String("source_id", run.source.ID), slog.String("uri", change.Document.URI))
+				err = o.processWithRetry(ctx, run, change.Document.URI, func() error {
+					return o.deleteDocumentByURI(ctx, run.source.ID, change.Document.URI)
+				})
 			}
-			status.DocumentsProcessed++
+			o.recordResult(ctx, run, change.Type, change.Document.URI, err)
 		}
 	}
 }
 
+// processWithRetry runs process for one document. For partial syncs a failure
+// is retried with exponential backoff; skipped documents are never retried.
+func (o *SyncOrchestrator) processWithRetry(
+	ctx context.Context, run *syncRun, uri string, process func() error,
+) error {
+	attempts := 1
+	if run.partial {
+		attempts = o.retryAttempts
+	}
+
+	delay := o.retryDelay
+	for attempt := 1; ; attempt++ {
+		err := process()
+		if err == nil || isSkipped(err) || errors.Is(err, errExcludedDocument) || attempt >= attempts {
+			return err
+		}
+
+		slog.Debug("document attempt failed, retrying",
+			slog.String("source_id", run.source.ID), slog.String("uri", uri),
+			slog.Int("attempt", attempt), slog.Duration("delay", delay), slog.Any("error", err))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// recordResult counts the outcome of one change to a document in the run's
+// status, and audits it. Failed documents are recorded so the summary can
+// name them.
+func (o *SyncOrchestrator) recordResult(
+	ctx context.Context, run *syncRun, change domain.ChangeType, uri string, err error,
+) {
+	o.mu.Lock()
+	o.auditResult(run, change, uri, err)
+	full := len(run.audit) >= maxBufferedAuditEntries
+	o.countResult(run, uri, err)
+	o.mu.Unlock()
+
+	if full {
+		o.writeAudit(ctx, run)
+	}
+}
+
+// countResult counts the outcome of one document in the run's status. The
+// caller must hold o.mu.
+func (o *SyncOrchestrator) countResult(run *syncRun, uri string, err error) {
+	status := run.status
+	switch {
+	case err == nil, errors.Is(err, errExcludedDocument):
+		status.DocumentsProcessed++
+	case isSkipped(err):
+		status.ErrorCount++
+		status.SkippedCount++
+		slog.Debug("skipping document",
+			slog.String("source_id", run.source.ID), slog.String("uri", uri), slog.Any("error", err))
+	default:
+		status.ErrorCount++
+		status.FailedCount++
+		status.FailedURIs = append(status.FailedURIs, uri)
+		slog.Debug("failed to process document",
+			slog.String("source_id", run.source.ID), slog.String("uri", uri), slog.Any("error", err))
+	}
+}
+
+// isSkipped reports whether a document was deliberately not indexed, rather
+// than failing: its content is unsupported or empty, it matches an exclusion
+// pattern, or a post-processor dropped it.
+func isSkipped(err error) bool {
+	return errors.Is(err, domain.ErrNotImplemented) ||
+		errors.Is(err, errEmptyDocument) ||
+		errors.Is(err, errPatternExcluded) ||
+		errors.Is(err, domain.ErrSkipDocument)
+}
+
+// saveCheckpoint saves a connector checkpoint so an interrupted sync resumes
+// from it, writing the audit entries of the documents before it. Checkpoints
+// are ignored for connectors without partial sync.
+func (o *SyncOrchestrator) saveCheckpoint(ctx context.Context, run *syncRun, cursor string) {
+	if !run.partial || cursor == "" {
+		return
+	}
+	o.writeAudit(ctx, run)
+
+	state := domain.SyncState{
+		SourceID: run.source.ID,
+		Cursor:   cursor,
+		LastSync: run.lastSync,
+	}
+	if err := o.syncStore.Save(ctx, state); err != nil {
+		slog.Warn("failed to save sync checkpoint", slog.String("source_id", run.source.ID), slog.Any("error", err))
+	}
+}
+
+// preparedDocument is a normalised and chunked document waiting for its
+// chunks to be embedded and stored.
+type preparedDocument struct {
+	raw    *domain.RawDocument
+	doc    *domain.Document
+	chunks []domain.Chunk
+
+	// embedder is the embedding service selected for the document, set when
+	// there is an embedding service.
+	embedder driven.EmbeddingService
+
+	// contentHash is the hash of the content, set when there is an embedding
+	// service. embedded is set once every chunk has its embedding, including
+	// when they were reused or nothing is embedded.
+	contentHash string
+	embedded    bool
+}
+
 // processOneDocument handles the 7-step document processing pipeline.
-//
-//nolint:gocognit,gocyclo // Pipeline orchestration with sequential steps
 func (o *SyncOrchestrator) processOneDocument(
 	ctx context.Context,
-	source *domain.Source,
+	run *syncRun,
 	raw *domain.RawDocument,
 ) error {
+	prepared, err := o.prepareDocument(ctx, run, raw)
+	if err != nil {
+		return err
+	}
+	return o.finishDocument(ctx, prepared)
+}
+
+// prepareDocument runs the steps before embedding: exclusion, normalisation,
+// post-processing and the embedding cache lookup. Returns errExcludedDocument
+// for excluded documents.
+func (o *SyncOrchestrator) prepareDocument(
+	ctx context.Context,
+	run *syncRun,
+	raw *domain.RawDocument,
+) (*preparedDocument, error) {
+	source := run.source
+
 	// 1. CHECK EXCLUSION
 	excluded, err := o.exclusionStore.IsExcluded(ctx, source.ID, raw.URI)
 	if err != nil {
-		return fmt.Errorf("check exclusion: %w", err)
+		return nil, fmt.Errorf("check exclusion: %w", err)
 	}
 	if excluded {
-		return nil // Skip silently
+		return nil, errExcludedDocument
 	}
 
 	// 2. NORMALISE (produces Document with Content)
 	result, err := o.registry.Normalise(ctx, raw)
 	if err != nil {
-		return fmt.Errorf("normalise: %w", err)
+		return nil, fmt.Errorf("normalise: %w", err)
 	}
+	if o.skipEmpty && isEmptyContent(result.Document.Content) {
+		return nil, errEmptyDocument
+	}
+	if matchesExclusionPatterns(&result.Document, source.ExclusionPatterns()) {
+		return nil, errPatternExcluded
+	}
+	recordMIMEType(&result.Document, raw.MIMEType)
 
 	// 3. RUN POST-PROCESSOR PIPELINE (produces Chunks)
-	chunks, err := o.pipeline.Process(ctx, &result.Document)
+	chunks, err := run.pipeline.Process(ctx, &result.Document)
 	if err != nil {
-		return fmt.Errorf("post-process: %w", err)
+		return nil, fmt.Errorf("post-process: %w", err)
 	}
 
-	// 4. GENERATE EMBEDDINGS (if service available)
+	prepared := &preparedDocument{raw: raw, doc: &result.Document, chunks: chunks, embedded: true}
 	if o.embeddingService != nil {
-		for i := range chunks {
-			embedding, err := o.embeddingService.Embed(ctx, chunks[i].Content)
-			if err != nil {
-				return fmt.Errorf("embed chunk: %w", err)
-			}
-			chunks[i].Embedding = embedding
-		}
+		prepared.embedder = o.embeddingModels.serviceFor(o.embeddingService, source.Type, raw.MIMEType)
+		prepared.chunks = splitForEmbedding(chunks, o.embedMaxTokens)
+		prepared.contentHash = documentContentHash(result.Document.Content)
+		prepared.embedded = o.reuseEmbeddings(ctx, prepared)
+		o.recordCacheLookup(run, prepared.embedded)
+	}
+	return prepared, nil
+}
+
+// matchesExclusionPatterns reports whether a normalised document matches one
+// of its source's exclusion patterns by its path metadata, title or URI.
+// Connectors that walk a directory tree skip excluded paths themselves; this
+// filters documents from connectors that cannot.
+func matchesExclusionPatterns(doc *domain.Document, patterns []string) bool {
+	if len(patterns) == 0 {
+		return false
+	}
+	if p, ok := doc.Metadata["path"].(string); ok && domain.MatchesExclusionPattern(p, patterns) {
+		return true
+	}
+	return domain.MatchesExclusionPattern(doc.Title, patterns) ||
+		domain.MatchesExclusionPattern(doc.URI, patterns)
+}
+
+// finishDocument embeds a prepared document's chunks if needed, then stores
+// and indexes it.
+func (o *SyncOrchestrator) finishDocument(ctx context.Context, prepared *preparedDocument) error {
+	// 4. GENERATE EMBEDDINGS (if service available)
+	if err := o.embedDocuments(ctx, []*preparedDocument{prepared}); err != nil {
+		return err
 	}
 
 	// 5. SAVE TO DOCUMENT STORE
-	if err := o.docStore.SaveDocument(ctx, &result.Document); err != nil {
+	if err := o.docStore.SaveDocument(ctx, prepared.doc); err != nil {
 		return fmt.Errorf("save document: %w", err)
 	}
-	if err := o.docStore.SaveChunks(ctx, chunks); err != nil {
+	if err := o.docStore.SaveChunks(ctx, prepared.chunks); err != nil {
 		return fmt.Errorf("save chunks: %w", err)
 	}
+	if prepared.contentHash != "" {
+		o.recordDocumentHash(ctx, prepared)
+	}
 
 	// 6. INDEX FOR KEYWORD SEARCH
-	for _, chunk := range chunks {
+	for _, chunk := range prepared.chunks {
 		if err := o.searchIndex.Index(ctx, chunk); err != nil {
 			return fmt.Errorf("index chunk: %w", err)
 		}
@@ -360,11 +1014,13 @@ func (o *SyncOrchestrator) processOneDocument(
 
 	// 7. INDEX FOR VECTOR SEARCH (if available)
 	if o.vectorIndex != nil && o.embeddingService != nil {
-		for _, chunk := range chunks {
-			if chunk.Embedding != nil {
-				if err := o.vectorIndex.Add(ctx, chunk.ID, chunk.Embedding); err != nil {
-					return fmt.Errorf("add vector: %w", err)
-				}
+		for _, chunk := range prepared.chunks {
+			if chunk.Embedding == nil {
+				continue
+			}
+			err := addVector(ctx, o.vectorIndex, o.embeddingService, prepared.embedder, chunk.ID, chunk.Embedding)
+			if err != nil {
+				return fmt.Errorf("add vector: %w", err)
 			}
 		}
 	}
@@ -372,6 +1028,316 @@ func (o *SyncOrchestrator) processOneDocument(
 	return nil
 }
 
+// embedDocuments embeds the chunks of documents not yet embedded, sending
+// the texts that are not cached to each document's embedding service in
+// batches. Documents are only marked embedded if every batch succeeds. Cache
+// failures are logged and fall back to the embedding service.
+func (o *SyncOrchestrator) embedDocuments(ctx context.Context, docs []*preparedDocument) error {
+	if o.embeddingService == nil {
+		return nil
+	}
+	if o.embeddingCache != nil {
+		o.invalidateEmbeddingCache(ctx)
+	}
+
+	// Texts are grouped by embedding service, in the order services are first used
+	var embedders []driven.EmbeddingService
+	texts := make(map[driven.EmbeddingService][]string)
+	targets := make(map[driven.EmbeddingService][]*domain.Chunk)
+	for _, prepared := range docs {
+		if prepared.embedded {
+			continue
+		}
+		embedder := prepared.embedder
+		model := o.cacheModelName(embedder)
+		for i := range prepared.chunks {
+			text := embeddingInput(prepared.chunks[i])
+			if embedding := o.cachedEmbedding(ctx, model, text); embedding != nil {
+				prepared.chunks[i].Embedding = embedding
+				continue
+			}
+			if _, seen := texts[embedder]; !seen {
+				embedders = append(embedders, embedder)
+			}
+			texts[embedder] = append(texts[embedder], text)
+			targets[embedder] = append(targets[embedder], &prepared.chunks[i])
+		}
+	}
+
+	for _, embedder := range embedders {
+		if err := o.embedTexts(ctx, embedder, texts[embedder], targets[embedder]); err != nil {
+			return err
+		}
+	}
+
+	for _, prepared := range docs {
+		prepared.embedded = true
+	}
+	return nil
+}
+
+// embedTexts embeds texts with embedder in batches, setting each embedding on
+// its target chunk and caching it.
+func (o *SyncOrchestrator) embedTexts(
+	ctx context.Context, embedder driven.EmbeddingService, texts []string, targets []*domain.Chunk,
+) error {
+	model := o.cacheModelName(embedder)
+	batchSize := max(o.embedBatchSize, 1)
+	for start := 0; start < len(texts); start += batchSize {
+		end := min(start+batchSize, len(texts))
+		embeddings, err := embedder.EmbedBatch(ctx, texts[start:end])
+		if err != nil {
+			return fmt.Errorf("embed chunks: %w", err)
+		}
+		if len(embeddings) != end-start {
+			return fmt.Errorf("embed chunks: got %d embeddings for %d texts", len(embeddings), end-start)
+		}
+		for i, embedding := range embeddings {
+			targets[start+i].Embedding = embedding
+			o.cacheEmbedding(ctx, model, texts[start+i], embedding)
+		}
+	}
+	return nil
+}
+
+// cacheModelName returns the model name embeddings by embedder are cached
+// under, or "" without a cache.
+func (o *SyncOrchestrator) cacheModelName(embedder driven.EmbeddingService) string {
+	if o.embeddingCache == nil {
+		return ""
+	}
+	return embedder.ModelName()
+}
+
+// cachedEmbedding returns the cached embedding of text by model, or nil on a
+// miss or without a cache.
+func (o *SyncOrchestrator) cachedEmbedding(ctx context.Context, model, text string) []float32 {
+	if o.embeddingCache == nil {
+		return nil
+	}
+	embedding, err := o.embeddingCache.Get(ctx, embeddingCacheKey(model, text))
+	if err != nil {
+		if !errors.Is(err, domain.ErrNotFound) {
+			slog.Warn("embedding cache lookup failed", slog.Any("error", err))
+		}
+		return nil
+	}
+	return embedding
+}
+
+// cacheEmbedding caches the embedding of text by model, if there is a cache.
+func (o *SyncOrchestrator) cacheEmbedding(ctx context.Context, model, text string, embedding []float32) {
+	if o.embeddingCache == nil {
+		return
+	}
+	if err := o.embeddingCache.Put(ctx, embeddingCacheKey(model, text), model, embedding); err != nil {
+		slog.Warn("embedding cache store failed", slog.Any("error", err))
+	}
+}
+
+// reuseEmbeddings copies the embeddings of the document previously synced from
+// the prepared document's URI onto its chunks, if that document was embedded
+// by the same model from content with the same hash and was chunked the same
+// way. A document whose embedding model changed is therefore embedded again.
+// Returns whether the embeddings were reused. Cache failures are logged and
+// treated as a miss.
+func (o *SyncOrchestrator) reuseEmbeddings(ctx context.Context, prepared *preparedDocument) bool {
+	cache, ok := o.embeddingCache.(driven.DocumentEmbeddingCache)
+	if !ok {
+		return false
+	}
+	o.invalidateEmbeddingCache(ctx)
+
+	doc, chunks := prepared.doc, prepared.chunks
+	previousID, previousHash, err := cache.LatestDocument(ctx, doc.SourceID, doc.URI, prepared.embedder.ModelName())
+	if err != nil {
+		if !errors.Is(err, domain.ErrNotFound) {
+			slog.Warn("document embedding lookup failed", slog.Any("error", err))
+		}
+		return false
+	}
+	if previousHash != prepared.contentHash {
+		return false
+	}
+
+	previous, err := o.docStore.GetChunks(ctx, previousID)
+	if err != nil {
+		slog.Warn("loading chunks for embedding reuse failed", slog.String("uri", doc.URI), slog.Any("error", err))
+		return false
+	}
+	if len(previous) != len(chunks) {
+		return false
+	}
+	for i := range chunks {
+		if previous[i].Embedding == nil || embeddingInput(previous[i]) != embeddingInput(chunks[i]) {
+			return false
+		}
+	}
+	for i := range chunks {
+		chunks[i].Embedding = previous[i].Embedding
+	}
+	return true
+}
+
+// recordDocumentHash records the content hash and model a saved document's
+// chunks were embedded from. Cache failures are logged.
+func (o *SyncOrchestrator) recordDocumentHash(ctx context.Context, prepared *preparedDocument) {
+	cache, ok := o.embeddingCache.(driven.DocumentEmbeddingCache)
+	if !ok {
+		return
+	}
+	err := cache.PutDocument(ctx, prepared.doc, prepared.contentHash, prepared.embedder.ModelName())
+	if err != nil {
+		slog.Warn("document embedding hash store failed", slog.Any("error", err))
+	}
+}
+
+// recordCacheLookup counts whether a document's embeddings were reused in the
+// run's status.
+func (o *SyncOrchestrator) recordCacheLookup(run *syncRun, hit bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if hit {
+		run.status.CacheHits++
+	} else {
+		run.status.CacheMisses++
+	}
+}
+
+// invalidateEmbeddingCache discards cached embeddings computed by models no
+// longer in use, once per change of the models.
+func (o *SyncOrchestrator) invalidateEmbeddingCache(ctx context.Context) {
+	models := o.embeddingModels.modelNames(o.embeddingService)
+	slices.Sort(models)
+	key := strings.Join(models, "\n")
+
+	o.cacheMu.Lock()
+	defer o.cacheMu.Unlock()
+	if o.cacheModels == key {
+		return
+	}
+
+	removed, err := o.embeddingCache.DeleteOtherModels(ctx, models...)
+	if err != nil {
+		slog.Warn("embedding cache invalidation failed", slog.Any("error", err))
+		return
+	}
+	if removed > 0 {
+		slog.Info("embedding models changed, discarded cached embeddings",
+			slog.String("models", strings.Join(models, ", ")), slog.Int("removed", removed))
+	}
+	o.cacheModels = key
+}
+
+// embeddingCacheKey returns the cache key for text embedded by model:
+// the hex SHA-256 of the model name followed by the text.
+func embeddingCacheKey(model, text string) string {
+	sum := sha256.Sum256([]byte(model + text))
+	return hex.EncodeToString(sum[:])
+}
+
+// documentContentHash returns the hex SHA-256 of a document's normalised content.
+func documentContentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// embeddingInput returns the text embedded for a chunk. Chunks that belong
+// to a section are prefixed with its heading trail, so their embedding
+// keeps the section's context.
+func embeddingInput(chunk domain.Chunk) string {
+	if section, _ := chunk.Metadata[domain.ChunkMetaSection].(string); section != "" {
+		return section + "\n\n" + chunk.Content
+	}
+	return chunk.Content
+}
+
+// splitForEmbedding splits chunks whose embedding input is estimated to exceed
+// maxTokens into consecutive pieces that fit, so that every chunk can be
+// embedded. The first piece keeps the chunk's ID, later pieces get new IDs,
+// and positions are renumbered. Values of maxTokens below 1 disable splitting.
+func splitForEmbedding(chunks []domain.Chunk, maxTokens int) []domain.Chunk {
+	if maxTokens <= 0 {
+		return chunks
+	}
+
+	out := make([]domain.Chunk, 0, len(chunks))
+	for i := range chunks {
+		if domain.EstimateTokens(embeddingInput(chunks[i])) <= maxTokens {
+			out = append(out, chunks[i])
+			continue
+		}
+
+		// Leave room for the section heading prepended to every piece
+		limit := maxTokens
+		if section, _ := chunks[i].Metadata[domain.ChunkMetaSection].(string); section != "" {
+			limit = max(maxTokens-domain.EstimateTokens(section+"\n\n"), 1)
+		}
+		pieces := domain.SplitByTokens(chunks[i].Content, limit)
+		slog.Info("chunk exceeds the embedding token limit, split into pieces",
+			slog.String("document_id", chunks[i].DocumentID),
+			slog.Int("max_tokens", maxTokens), slog.Int("pieces", len(pieces)))
+
+		start, hasOffset := chunkOffset(chunks[i].Metadata[domain.ChunkMetaStartOffset])
+		for n, content := range pieces {
+			piece := chunks[i]
+			piece.Content = content
+			piece.Metadata = maps.Clone(chunks[i].Metadata)
+			if n > 0 {
+				piece.ID = uuid.New().String()
+			}
+			if hasOffset {
+				piece.Metadata[domain.ChunkMetaStartOffset] = start
+				piece.Metadata[domain.ChunkMetaEndOffset] = start + len(content)
+			}
+			start += len(content)
+			out = append(out, piece)
+		}
+	}
+
+	for i := range out {
+		out[i].Position = i
+	}
+	return out
+}
+
+// chunkOffset returns a chunk offset from metadata. Offsets are ints when
+// chunked, but float64 for chunks reloaded from storage as JSON.
+func chunkOffset(val any) (int, bool) {
+	switch v := val.(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case float64:
+		return int(v), true
+	default:
+		return 0, false
+	}
+}
+
+// recordMIMEType records the MIME type a document was normalised from in
+// its metadata, unless the normaliser already did, so that rebuilds can
+// select the same embedding model as sync.
+func recordMIMEType(doc *domain.Document, mimeType string) {
+	if mimeType == "" {
+		return
+	}
+	if _, ok := doc.Metadata[domain.DocMetaMIMEType]; ok {
+		return
+	}
+	if doc.Metadata == nil {
+		doc.Metadata = make(map[string]any)
+	}
+	doc.Metadata[domain.DocMetaMIMEType] = mimeType
+}
+
+// isEmptyContent reports whether normalised content is empty or only whitespace.
+func isEmptyContent(content string) bool {
+	return strings.TrimSpace(content) == ""
+}
+
 // deleteDocumentByURI removes a document and its indexes by URI.
 func (o *SyncOrchestrator) deleteDocumentByURI(ctx context.Context, sourceID, uri string) error {
 	// Find document by URI - iterate through source documents
@@ -403,7 +1369,7 @@ func (o *SyncOrchestrator) deleteDocumentByURI(ctx context.Context, sourceID, ur
 	if o.vectorIndex != nil {
 		for _, chunk := range chunks {
 			if err := o.vectorIndex.Delete(ctx, chunk.ID); err != nil {
-				logger.Debug("Failed to delete vector %s: %v", chunk.ID, err)
+				slog.Debug("failed to delete vector", slog.String("chunk_id", chunk.ID), slog.Any("error", err))
 			}
 		}
 	}
@@ -411,7 +1377,8 @@ func (o *SyncOrchestrator) deleteDocumentByURI(ctx context.Context, sourceID, ur
 	// Delete from search index
 	for _, chunk := range chunks {
 		if err := o.searchIndex.Delete(ctx, chunk.ID); err != nil {
-			logger.Debug("Failed to delete search index %s: %v", chunk.ID, err)
+			slog.Debug("failed to delete chunk from search index",
+				slog.String("chunk_id", chunk.ID), slog.Any("error", err))
 		}
 	}
 
@@ -420,6 +1387,14 @@ func (o *SyncOrchestrator) deleteDocumentByURI(ctx context.Context, sourceID, ur
 		return fmt.Errorf("delete document: %w", err)
 	}
 
+	// Forget the content hash its embeddings were computed from
+	if cache, ok := o.embeddingCache.(driven.DocumentEmbeddingCache); ok {
+		if err := cache.DeleteDocument(ctx, docToDelete.ID); err != nil {
+			slog.Debug("failed to delete document embedding hash",
+				slog.String("document_id", docToDelete.ID), slog.Any("error", err))
+		}
+	}
+
 	return nil
 }
 
@@ -430,9 +1405,29 @@ func (o *SyncOrchestrator) setStatus(sourceID string, status *driving.SyncStatus
 	o.activeSyncs[sourceID] = status
 }
 
-// clearStatus removes the sync status for a source.
-func (o *SyncOrchestrator) clearStatus(sourceID string) {
+// recordError records the outcome of a source's sync in its status. A sync
+// that failed before it started, such as on rejected credentials, gets a
+// status of its own so the failure is still reported.
+func (o *SyncOrchestrator) recordError(sourceID string, err error) {
 	o.mu.Lock()
 	defer o.mu.Unlock()
-	delete(o.activeSyncs, sourceID)
+	status, ok := o.activeSyncs[sourceID]
+	if !ok {
+		if err == nil {
+			return
+		}
+		status = &driving.SyncStatus{SourceID: sourceID}
+		o.activeSyncs[sourceID] = status
+	}
+	status.LastError = err
+}
+
+// finishStatus marks a source's sync as finished, keeping its final counts
+// available until the next sync of the source starts.
+func (o *SyncOrchestrator) finishStatus(sourceID string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if status, ok := o.activeSyncs[sourceID]; ok {
+		status.Running = false
+	}
 }
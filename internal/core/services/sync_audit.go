@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// maxBufferedAuditEntries is how many audit entries a run holds in memory
+// before writing them to the audit log.
+const maxBufferedAuditEntries = 500
+
+// Ensure SyncAuditService implements the interface.
+var _ driving.SyncAuditService = (*SyncAuditService)(nil)
+
+// SyncAuditService reads and clears the sync audit log.
+type SyncAuditService struct {
+	auditLog driven.SyncAuditLog
+}
+
+// NewSyncAuditService creates a new sync audit service.
+func NewSyncAuditService(auditLog driven.SyncAuditLog) *SyncAuditService {
+	return &SyncAuditService{auditLog: auditLog}
+}
+
+// List returns the audit log entries matching filter, newest first.
+func (s *SyncAuditService) List(
+	ctx context.Context, filter domain.SyncAuditFilter,
+) ([]domain.SyncAuditEntry, error) {
+	if s.auditLog == nil {
+		return nil, domain.ErrNotImplemented
+	}
+	return s.auditLog.List(ctx, filter)
+}
+
+// Clear empties the audit log, returning how many entries were removed.
+func (s *SyncAuditService) Clear(ctx context.Context) (int, error) {
+	if s.auditLog == nil {
+		return 0, domain.ErrNotImplemented
+	}
+	return s.auditLog.Clear(ctx)
+}
+
+// startAudit prepares a run for auditing, if there is an audit log. The
+// source's existing documents are listed so that re-indexed documents are
+// audited as updated; if they cannot be, every document is audited as
+// created.
+func (o *SyncOrchestrator) startAudit(ctx context.Context, run *syncRun) {
+	if o.auditLog == nil {
+		return
+	}
+	run.id = uuid.New().String()
+	run.knownURIs = make(map[string]bool)
+
+	docs, err := o.docStore.ListDocuments(ctx, run.source.ID)
+	if err != nil {
+		slog.Warn("failed to list documents for the sync audit log",
+			slog.String("source_id", run.source.ID), slog.Any("error", err))
+		return
+	}
+	for i := range docs {
+		run.knownURIs[docs[i].URI] = true
+	}
+}
+
+// auditResult adds the outcome of one change to a document to the run's
+// audit entries. The caller must hold o.mu.
+func (o *SyncOrchestrator) auditResult(run *syncRun, change domain.ChangeType, uri string, err error) {
+	if o.auditLog == nil {
+		return
+	}
+
+	entry := domain.SyncAuditEntry{
+		SourceID:    run.source.ID,
+		DocumentURI: uri,
+		SyncRunID:   run.id,
+		OccurredAt:  time.Now(),
+	}
+	switch {
+	case errors.Is(err, errExcludedDocument):
+		entry.Event = domain.SyncAuditSkipped
+		entry.Reason = "excluded"
+	case isSkipped(err):
+		entry.Event = domain.SyncAuditSkipped
+		entry.Reason = skipReason(err)
+	case err != nil:
+		entry.Event = domain.SyncAuditFailed
+		entry.Reason = err.Error()
+	case change == domain.ChangeDeleted:
+		entry.Event = domain.SyncAuditDeleted
+		delete(run.knownURIs, uri)
+	case run.knownURIs[uri]:
+		entry.Event = domain.SyncAuditUpdated
+	default:
+		entry.Event = domain.SyncAuditCreated
+		run.knownURIs[uri] = true
+	}
+	run.audit = append(run.audit, entry)
+}
+
+// skipReason describes why a skipped document was not indexed.
+func skipReason(err error) string {
+	switch {
+	case errors.Is(err, errEmptyDocument):
+		return "empty content"
+	case errors.Is(err, errPatternExcluded):
+		return "exclusion pattern"
+	case errors.Is(err, domain.ErrSkipDocument):
+		return "filter mismatch"
+	default:
+		return "unsupported content"
+	}
+}
+
+// writeAudit appends a run's buffered audit entries to the audit log and
+// empties the buffer. The entries are written even if the sync was
+// cancelled, so a failed write is only logged.
+func (o *SyncOrchestrator) writeAudit(ctx context.Context, run *syncRun) {
+	if o.auditLog == nil || run == nil {
+		return
+	}
+
+	o.mu.Lock()
+	entries := run.audit
+	run.audit = nil
+	o.mu.Unlock()
+
+	if len(entries) == 0 {
+		return
+	}
+	if err := o.auditLog.Append(context.WithoutCancel(ctx), entries); err != nil {
+		slog.Warn("failed to write the sync audit log",
+			slog.String("source_id", run.source.ID), slog.Any("error", err))
+	}
+}
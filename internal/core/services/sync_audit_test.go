@@ -0,0 +1,276 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// mockSyncAuditLog implements driven.SyncAuditLog in memory.
+type mockSyncAuditLog struct {
+	entries   []domain.SyncAuditEntry
+	appends   []int
+	appendErr error
+	filter    domain.SyncAuditFilter
+}
+
+func (m *mockSyncAuditLog) Append(_ context.Context, entries []domain.SyncAuditEntry) error {
+	m.appends = append(m.appends, len(entries))
+	if m.appendErr != nil {
+		return m.appendErr
+	}
+	m.entries = append(m.entries, entries...)
+	return nil
+}
+
+func (m *mockSyncAuditLog) List(_ context.Context, filter domain.SyncAuditFilter) ([]domain.SyncAuditEntry, error) {
+	m.filter = filter
+	return m.entries, nil
+}
+
+func (m *mockSyncAuditLog) Clear(_ context.Context) (int, error) {
+	n := len(m.entries)
+	m.entries = nil
+	return n, nil
+}
+
+// auditEvents returns the audited event and reason of each URI.
+func auditEvents(entries []domain.SyncAuditEntry) map[string]string {
+	events := make(map[string]string)
+	for _, e := range entries {
+		events[e.DocumentURI] = string(e.Event) + ":" + e.Reason
+	}
+	return events
+}
+
+func TestSyncOrchestrator_Sync_AuditsEveryDocument(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	exclusionStore := memory.NewExclusionStore()
+	factory := newSyncMockConnectorFactory()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	require.NoError(t, docStore.SaveDocument(ctx, &domain.Document{ID: "old", SourceID: "src-1", URI: "known.txt"}))
+	require.NoError(t, exclusionStore.Add(ctx, &domain.Exclusion{ID: "exc-1", SourceID: "src-1", URI: "excluded.txt"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "new.txt", MIMEType: "text/plain", Content: []byte("new")},
+			{SourceID: "src-1", URI: "known.txt", MIMEType: "text/plain", Content: []byte("known")},
+			{SourceID: "src-1", URI: "excluded.txt", MIMEType: "text/plain", Content: []byte("excluded")},
+			{SourceID: "src-1", URI: "blank.txt", MIMEType: "text/plain", Content: []byte("  ")},
+		},
+	}
+
+	auditLog := &mockSyncAuditLog{}
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), docStore, exclusionStore,
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+	orchestrator.SetSyncAuditLog(auditLog)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	require.Len(t, auditLog.entries, 4)
+	assert.Equal(t, map[string]string{
+		"new.txt":      "created:",
+		"known.txt":    "updated:",
+		"excluded.txt": "skipped:excluded",
+		"blank.txt":    "skipped:empty content",
+	}, auditEvents(auditLog.entries))
+	runID := auditLog.entries[0].SyncRunID
+	assert.NotEmpty(t, runID)
+	for _, e := range auditLog.entries {
+		assert.Equal(t, "src-1", e.SourceID)
+		assert.Equal(t, runID, e.SyncRunID)
+		assert.False(t, e.OccurredAt.IsZero())
+	}
+
+	status, err := orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 3, status.DocumentsProcessed, "excluded documents still count as processed")
+	assert.Equal(t, 1, status.SkippedCount)
+
+	// Each run has its own ID
+	auditLog.entries = nil
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	require.NotEmpty(t, auditLog.entries)
+	assert.NotEqual(t, runID, auditLog.entries[0].SyncRunID)
+}
+
+func TestSyncOrchestrator_Sync_AuditsDeletesAndFailures(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	docStore := memory.NewDocumentStore()
+	factory := newSyncMockConnectorFactory()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	require.NoError(t, docStore.SaveDocument(ctx, &domain.Document{ID: "doc-1", SourceID: "src-1", URI: "gone.txt"}))
+	require.NoError(t, syncStore.Save(ctx, domain.SyncState{SourceID: "src-1", Cursor: "c", LastSync: time.Now()}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID:     "src-1",
+		connType:     "mock",
+		capabilities: driven.ConnectorCapabilities{SupportsIncremental: true},
+		incSyncDocs: []domain.RawDocumentChange{
+			{Type: domain.ChangeDeleted, Document: domain.RawDocument{SourceID: "src-1", URI: "gone.txt"}},
+			{Type: domain.ChangeCreated, Document: domain.RawDocument{SourceID: "src-1", URI: "bad.txt"}},
+		},
+	}
+
+	auditLog := &mockSyncAuditLog{}
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, docStore, memory.NewExclusionStore(), factory,
+		&syncMockNormaliserRegistry{normaliseErr: errors.New("corrupt")},
+		&syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+	orchestrator.SetSyncAuditLog(auditLog)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	assert.Equal(t, map[string]string{
+		"gone.txt": "deleted:",
+		"bad.txt":  "failed:normalise: corrupt",
+	}, auditEvents(auditLog.entries))
+}
+
+func TestSyncOrchestrator_Sync_SkipsExclusionPatterns(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	factory := newSyncMockConnectorFactory()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{
+		ID: "src-1", Name: "Mail", Type: "mock",
+		Config: map[string]string{domain.ConfigKeyExcludePatterns: "Newsletter*,*.log"},
+	}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "Invoice March", MIMEType: "text/plain", Content: []byte("invoice")},
+			{SourceID: "src-1", URI: "Newsletter: May", MIMEType: "text/plain", Content: []byte("news")},
+			{SourceID: "src-1", URI: "logs/sync.log", MIMEType: "text/plain", Content: []byte("log")},
+		},
+	}
+
+	auditLog := &mockSyncAuditLog{}
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), docStore, memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+	orchestrator.SetSyncAuditLog(auditLog)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "Invoice March", docs[0].URI)
+	assert.Equal(t, map[string]string{
+		"Invoice March":   "created:",
+		"Newsletter: May": "skipped:exclusion pattern",
+		"logs/sync.log":   "skipped:exclusion pattern",
+	}, auditEvents(auditLog.entries))
+}
+
+func TestMatchesExclusionPatterns(t *testing.T) {
+	doc := &domain.Document{
+		URI:      "onedrive://files/abc",
+		Title:    "report.pdf",
+		Metadata: map[string]any{"path": "/drive/root:/Archive/report.pdf"},
+	}
+
+	assert.False(t, matchesExclusionPatterns(doc, nil))
+	assert.True(t, matchesExclusionPatterns(doc, []string{"/drive/root:/Archive/*"}), "matches path metadata")
+	assert.True(t, matchesExclusionPatterns(doc, []string{"*.pdf"}), "matches title")
+	assert.True(t, matchesExclusionPatterns(doc, []string{"onedrive://files/*"}), "matches URI")
+	assert.False(t, matchesExclusionPatterns(doc, []string{"*.docx", "Drafts"}))
+}
+
+func TestSyncOrchestrator_Sync_AuditWriteFailureDoesNotFailSync(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	factory := newSyncMockConnectorFactory()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "a.txt", MIMEType: "text/plain", Content: []byte("a")},
+		},
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+	orchestrator.SetSyncAuditLog(&mockSyncAuditLog{appendErr: errors.New("disk full")})
+
+	assert.NoError(t, orchestrator.Sync(ctx, "src-1"))
+}
+
+func TestSyncOrchestrator_Sync_WritesAuditInBatches(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	factory := newSyncMockConnectorFactory()
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	docs := make([]domain.RawDocument, maxBufferedAuditEntries*2+1)
+	for i := range docs {
+		uri := fmt.Sprintf("doc-%d.txt", i)
+		docs[i] = domain.RawDocument{SourceID: "src-1", URI: uri, MIMEType: "text/plain", Content: []byte(uri)}
+	}
+	factory.connectors["src-1"] = &syncMockConnector{sourceID: "src-1", connType: "mock", fullSyncDocs: docs}
+
+	auditLog := &mockSyncAuditLog{}
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+	orchestrator.SetSyncAuditLog(auditLog)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	assert.Len(t, auditLog.entries, len(docs))
+	assert.Equal(t, []int{maxBufferedAuditEntries, maxBufferedAuditEntries, 1}, auditLog.appends)
+}
+
+func TestSkipReason(t *testing.T) {
+	assert.Equal(t, "empty content", skipReason(errEmptyDocument))
+	assert.Equal(t, "filter mismatch", skipReason(domain.ErrSkipDocument))
+	assert.Equal(t, "exclusion pattern", skipReason(errPatternExcluded))
+	assert.Equal(t, "unsupported content", skipReason(domain.ErrNotImplemented))
+}
+
+func TestSyncAuditService(t *testing.T) {
+	ctx := context.Background()
+	auditLog := &mockSyncAuditLog{entries: []domain.SyncAuditEntry{{DocumentURI: "a.txt"}}}
+	svc := NewSyncAuditService(auditLog)
+
+	filter := domain.SyncAuditFilter{SourceID: "src-1", Limit: 5}
+	entries, err := svc.List(ctx, filter)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, filter, auditLog.filter)
+
+	removed, err := svc.Clear(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+}
+
+func TestSyncAuditService_NoAuditLog(t *testing.T) {
+	svc := NewSyncAuditService(nil)
+
+	_, err := svc.List(context.Background(), domain.SyncAuditFilter{})
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+	_, err = svc.Clear(context.Background())
+	assert.ErrorIs(t, err, domain.ErrNotImplemented)
+}
@@ -0,0 +1,40 @@
+package services
+
+import "context"
+
+// SyncLimiter caps the number of source syncs running at once.
+// It is shared by the Scheduler and SyncOrchestrator so that scheduled and
+// on-demand syncs draw from the same pool. A nil SyncLimiter is unlimited.
+type SyncLimiter struct {
+	slots chan struct{}
+}
+
+// NewSyncLimiter creates a limiter allowing up to limit concurrent syncs.
+// Returns nil (unlimited) if limit is zero or negative.
+func NewSyncLimiter(limit int) *SyncLimiter {
+	if limit <= 0 {
+		return nil
+	}
+	return &SyncLimiter{slots: make(chan struct{}, limit)}
+}
+
+// Acquire blocks until a sync slot is free or ctx is done.
+func (l *SyncLimiter) Acquire(ctx context.Context) error {
+	if l == nil {
+		return ctx.Err()
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees a slot taken by Acquire.
+func (l *SyncLimiter) Release() {
+	if l == nil {
+		return
+	}
+	<-l.slots
+}
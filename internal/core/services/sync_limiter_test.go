@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSyncLimiter_Unlimited(t *testing.T) {
+	limiter := NewSyncLimiter(0)
+
+	assert.Nil(t, limiter)
+	// A nil limiter never blocks
+	require.NoError(t, limiter.Acquire(context.Background()))
+	limiter.Release()
+}
+
+func TestSyncLimiter_AcquireBlocksWhenFull(t *testing.T) {
+	limiter := NewSyncLimiter(1)
+	require.NoError(t, limiter.Acquire(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := limiter.Acquire(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	limiter.Release()
+	assert.NoError(t, limiter.Acquire(context.Background()))
+}
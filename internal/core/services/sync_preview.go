@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// DryRun runs a source's connector and normalisers as Sync would, but stops
+// before anything is persisted: no documents are saved or indexed, the sync
+// cursor is not advanced and refreshed tokens are not saved. Returns counts
+// of what the sync would index.
+func (o *SyncOrchestrator) DryRun(ctx context.Context, sourceID string) (*domain.SyncPreview, error) {
+	ctx = driven.WithoutCredentialWrites(ctx)
+	source, connector, syncState, err := o.openConnector(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	defer connector.Close()
+
+	preview := &domain.SyncPreview{
+		SourceID:    sourceID,
+		Incremental: useIncremental(connector.Capabilities(), syncState),
+		ByMIMEType:  make(map[string]domain.MIMETypeStats),
+	}
+
+	slog.Info("dry run started", slog.String("source_id", sourceID))
+
+	if preview.Incremental {
+		changesCh, errsCh := connector.IncrementalSync(ctx, *syncState)
+		err = drainSync(ctx, changesCh, errsCh, func(change domain.RawDocumentChange) {
+			if change.Type == domain.ChangeDeleted {
+				preview.Deletions++
+				return
+			}
+			o.previewDocument(ctx, source, &change.Document, preview)
+		})
+	} else {
+		docsCh, errsCh := connector.FullSync(ctx)
+		err = drainSync(ctx, docsCh, errsCh, func(raw domain.RawDocument) {
+			o.previewDocument(ctx, source, &raw, preview)
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return preview, nil
+}
+
+// previewDocument counts one document in the preview without persisting it.
+func (o *SyncOrchestrator) previewDocument(
+	ctx context.Context, source *domain.Source, raw *domain.RawDocument, preview *domain.SyncPreview,
+) {
+	excluded, err := o.exclusionStore.IsExcluded(ctx, source.ID, raw.URI)
+	if err != nil {
+		preview.Errors++
+		slog.Debug("failed to check exclusion",
+			slog.String("source_id", source.ID), slog.String("uri", raw.URI), slog.Any("error", err))
+		return
+	}
+	if excluded {
+		preview.Excluded++
+		return
+	}
+
+	result, err := o.registry.Normalise(ctx, raw)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotImplemented) {
+			preview.Unsupported++
+		} else {
+			preview.Errors++
+		}
+		slog.Debug("dry run would skip document",
+			slog.String("source_id", source.ID), slog.String("uri", raw.URI), slog.Any("error", err))
+		return
+	}
+	if o.skipEmpty && isEmptyContent(result.Document.Content) {
+		preview.Empty++
+		return
+	}
+	if matchesExclusionPatterns(&result.Document, source.ExclusionPatterns()) {
+		preview.Excluded++
+		return
+	}
+
+	size := int64(len(raw.Content))
+	stats := preview.ByMIMEType[raw.MIMEType]
+	stats.Documents++
+	stats.Bytes += size
+	preview.ByMIMEType[raw.MIMEType] = stats
+	preview.Documents++
+	preview.Bytes += size
+}
+
+// drainSync passes every item from a connector's channels to fn until both
+// channels are closed. Checkpoints and completion cursors are ignored;
+// any other connector error is returned.
+func drainSync[T any](ctx context.Context, itemsCh <-chan T, errsCh <-chan error, fn func(T)) error {
+	for {
+		if itemsCh == nil && errsCh == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case err, ok := <-errsCh:
+			if !ok {
+				errsCh = nil
+				continue
+			}
+			if _, isSyncComplete := driven.IsSyncComplete(err); isSyncComplete {
+				continue
+			}
+			if _, isCheckpoint := driven.IsSyncCheckpoint(err); isCheckpoint {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("connector error: %w", err)
+			}
+
+		case item, ok := <-itemsCh:
+			if !ok {
+				itemsCh = nil
+				continue
+			}
+			fn(item)
+		}
+	}
+}
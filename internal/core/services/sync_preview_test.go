@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/auth"
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// unsupportedMIMERegistry rejects documents of one MIME type as unsupported.
+type unsupportedMIMERegistry struct {
+	syncMockNormaliserRegistry
+	unsupported string
+}
+
+func (r *unsupportedMIMERegistry) Normalise(ctx context.Context, raw *domain.RawDocument) (*driven.NormaliseResult, error) {
+	if raw.MIMEType == r.unsupported {
+		return nil, domain.ErrNotImplemented
+	}
+	return r.syncMockNormaliserRegistry.Normalise(ctx, raw)
+}
+
+// countingCredentialsStore counts the credentials saved to it. Credentials
+// are copied out, so only Save changes what is stored.
+type countingCredentialsStore struct {
+	*mockCredentialsStore
+	saves int
+}
+
+func (m *countingCredentialsStore) Get(ctx context.Context, id string) (*domain.Credentials, error) {
+	creds, err := m.mockCredentialsStore.Get(ctx, id)
+	if err != nil || creds.OAuth == nil {
+		return creds, err
+	}
+	oauth := *creds.OAuth
+	creds.OAuth = &oauth
+	return creds, nil
+}
+
+func (m *countingCredentialsStore) Save(ctx context.Context, creds domain.Credentials) error {
+	m.saves++
+	return m.mockCredentialsStore.Save(ctx, creds)
+}
+
+// dryRunAuthProviders implements Get of driven.AuthProviderStore for testing.
+type dryRunAuthProviders struct {
+	driven.AuthProviderStore
+}
+
+func (dryRunAuthProviders) Get(_ context.Context, id string) (*domain.AuthProvider, error) {
+	return &domain.AuthProvider{ID: id, OAuth: &domain.OAuthProviderConfig{ClientID: "client"}}, nil
+}
+
+// dryRunTokenRefresher returns a fresh token for every refresh.
+type dryRunTokenRefresher struct {
+	calls int
+}
+
+func (r *dryRunTokenRefresher) RefreshToken(
+	_ context.Context, _ string, _ *domain.AuthProvider, _ string,
+) (*domain.OAuthToken, error) {
+	r.calls++
+	return &domain.OAuthToken{AccessToken: "new-access", Expiry: time.Now().Add(time.Hour)}, nil
+}
+
+// tokenConnectorFactory gets a token for each connector it creates, as the
+// connectors do when they first call their API.
+type tokenConnectorFactory struct {
+	*syncMockConnectorFactory
+	tokens driven.TokenProviderFactory
+}
+
+func (f *tokenConnectorFactory) Create(ctx context.Context, source domain.Source) (driven.Connector, error) {
+	tokenProvider, err := f.tokens.CreateTokenProvider(ctx, &source)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tokenProvider.GetToken(ctx); err != nil {
+		return nil, err
+	}
+	return f.syncMockConnectorFactory.Create(ctx, source)
+}
+
+type dryRunFixture struct {
+	orchestrator *SyncOrchestrator
+	syncStore    *memory.SyncStateStore
+	docStore     *memory.DocumentStore
+	search       *syncMockSearchEngine
+	vectors      *syncMockVectorIndex
+	exclusions   *memory.ExclusionStore
+	connector    *syncMockConnector
+}
+
+func newDryRunFixture(t *testing.T) *dryRunFixture {
+	t.Helper()
+	sourceStore := memory.NewSourceStore()
+	f := &dryRunFixture{
+		syncStore:  memory.NewSyncStateStore(),
+		docStore:   memory.NewDocumentStore(),
+		search:     newSyncMockSearchEngine(),
+		vectors:    newSyncMockVectorIndex(),
+		exclusions: memory.NewExclusionStore(),
+		connector:  &syncMockConnector{sourceID: "src-1", connType: "mock"},
+	}
+	require.NoError(t, sourceStore.Save(context.Background(), domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+
+	factory := newSyncMockConnectorFactory()
+	factory.connectors["src-1"] = f.connector
+
+	f.orchestrator = NewSyncOrchestrator(
+		sourceStore, f.syncStore, f.docStore, f.exclusions,
+		factory, &unsupportedMIMERegistry{unsupported: "image/png"}, &syncMockPostProcessorPipeline{},
+		f.search, f.vectors, &syncMockEmbeddingService{embedding: []float32{0.1, 0.2, 0.3}},
+	)
+	return f
+}
+
+func TestSyncOrchestrator_DryRun_FullSync(t *testing.T) {
+	ctx := context.Background()
+	f := newDryRunFixture(t)
+	f.connector.fullSyncDocs = []domain.RawDocument{
+		{SourceID: "src-1", URI: "a.txt", MIMEType: "text/plain", Content: []byte("12345")},
+		{SourceID: "src-1", URI: "b.txt", MIMEType: "text/plain", Content: []byte("123")},
+		{SourceID: "src-1", URI: "c.md", MIMEType: "text/markdown", Content: []byte("1234567890")},
+		{SourceID: "src-1", URI: "d.png", MIMEType: "image/png", Content: []byte("png")},
+		{SourceID: "src-1", URI: "e.txt", MIMEType: "text/plain", Content: []byte("excluded")},
+	}
+	require.NoError(t, f.exclusions.Add(ctx, &domain.Exclusion{ID: "exc-1", SourceID: "src-1", URI: "e.txt"}))
+
+	preview, err := f.orchestrator.DryRun(ctx, "src-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "src-1", preview.SourceID)
+	assert.False(t, preview.Incremental)
+	assert.Equal(t, 3, preview.Documents)
+	assert.Equal(t, int64(18), preview.Bytes)
+	assert.Equal(t, map[string]domain.MIMETypeStats{
+		"text/plain":    {Documents: 2, Bytes: 8},
+		"text/markdown": {Documents: 1, Bytes: 10},
+	}, preview.ByMIMEType)
+	assert.Equal(t, 1, preview.Unsupported)
+	assert.Equal(t, 1, preview.Excluded)
+
+	// Nothing was written
+	docs, err := f.docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Empty(t, docs)
+	assert.Empty(t, f.search.indexed)
+	assert.Empty(t, f.vectors.vectors)
+	_, err = f.syncStore.Get(ctx, "src-1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestSyncOrchestrator_DryRun_IncrementalDoesNotAdvanceCursor(t *testing.T) {
+	ctx := context.Background()
+	f := newDryRunFixture(t)
+	lastSync := time.Now().Add(-time.Hour).Truncate(time.Second)
+	require.NoError(t, f.syncStore.Save(ctx, domain.SyncState{SourceID: "src-1", Cursor: "cursor-1", LastSync: lastSync}))
+
+	f.connector.capabilities = driven.ConnectorCapabilities{SupportsIncremental: true, SupportsPartialSync: true}
+	f.connector.incSyncDocs = []domain.RawDocumentChange{
+		{Type: domain.ChangeCreated, Document: domain.RawDocument{SourceID: "src-1", URI: "new.txt", MIMEType: "text/plain", Content: []byte("new")}},
+		{Type: domain.ChangeDeleted, Document: domain.RawDocument{SourceID: "src-1", URI: "old.txt"}},
+	}
+
+	preview, err := f.orchestrator.DryRun(ctx, "src-1")
+
+	require.NoError(t, err)
+	assert.True(t, preview.Incremental)
+	assert.Equal(t, 1, preview.Documents)
+	assert.Equal(t, 1, preview.Deletions)
+
+	state, err := f.syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "cursor-1", state.Cursor)
+	assert.True(t, lastSync.Equal(state.LastSync))
+}
+
+func TestSyncOrchestrator_DryRun_DoesNotSaveRefreshedTokens(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	source := domain.Source{
+		ID: "src-1", Name: "Mail", Type: "mock", CredentialsID: "creds-1", AuthProviderID: "auth-1",
+	}
+	require.NoError(t, sourceStore.Save(ctx, source))
+	credentials := &countingCredentialsStore{mockCredentialsStore: newMockCredentialsStore(domain.Credentials{
+		ID: "creds-1",
+		OAuth: &domain.OAuthCredentials{
+			AccessToken:  "old-access",
+			RefreshToken: "refresh",
+			Expiry:       time.Now().Add(time.Minute),
+		},
+	})}
+	refresher := &dryRunTokenRefresher{}
+	tokens := auth.NewFactory(credentials, dryRunAuthProviders{})
+	tokens.SetTokenRefresher(refresher)
+
+	factory := &tokenConnectorFactory{syncMockConnectorFactory: newSyncMockConnectorFactory(), tokens: tokens}
+	factory.connectors["src-1"] = &syncMockConnector{sourceID: "src-1", connType: "mock"}
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+	orchestrator.SetTokenProviderFactory(tokens)
+
+	_, err := orchestrator.DryRun(ctx, "src-1")
+
+	require.NoError(t, err)
+	assert.Positive(t, refresher.calls, "the expiring token is refreshed for the run")
+	assert.Zero(t, credentials.saves)
+	assert.Equal(t, "old-access", credentials.creds["creds-1"].OAuth.AccessToken)
+
+	// A real sync saves the refreshed token
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	assert.Positive(t, credentials.saves)
+}
+
+func TestSyncOrchestrator_DryRun_ConnectorError(t *testing.T) {
+	f := newDryRunFixture(t)
+	f.connector.fullSyncErr = errors.New("rate limited")
+
+	_, err := f.orchestrator.DryRun(context.Background(), "src-1")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rate limited")
+}
+
+func TestSyncOrchestrator_DryRun_SourceNotFound(t *testing.T) {
+	f := newDryRunFixture(t)
+
+	_, err := f.orchestrator.DryRun(context.Background(), "missing")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "get source")
+}
@@ -3,6 +3,10 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	stdsync "sync"
 	"testing"
 	"time"
@@ -29,6 +33,13 @@ type syncMockConnector struct {
 	incSyncDocs  []domain.RawDocumentChange
 	incSyncErr   error
 	closed       bool
+	validateErr  error
+
+	// fullSyncGate, if set, holds FullSync until it is closed.
+	fullSyncGate chan struct{}
+
+	// watchChanges, if set, is returned by Watch.
+	watchChanges chan domain.RawDocumentChange
 }
 
 func (m *syncMockConnector) Type() string     { return m.connType }
@@ -45,6 +56,14 @@ func (m *syncMockConnector) FullSync(ctx context.Context) (<-chan domain.RawDocu
 		defer close(docs)
 		defer close(errs)
 
+		if m.fullSyncGate != nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-m.fullSyncGate:
+			}
+		}
+
 		if m.fullSyncErr != nil {
 			errs <- m.fullSyncErr
 			return
@@ -88,11 +107,14 @@ func (m *syncMockConnector) IncrementalSync(ctx context.Context, _ domain.SyncSt
 }
 
 func (m *syncMockConnector) Watch(_ context.Context) (<-chan domain.RawDocumentChange, error) {
+	if m.watchChanges != nil {
+		return m.watchChanges, nil
+	}
 	return nil, errors.New("watch not implemented")
 }
 
 func (m *syncMockConnector) Validate(_ context.Context) error {
-	return nil
+	return m.validateErr
 }
 
 func (m *syncMockConnector) Close() error {
@@ -108,6 +130,9 @@ func (m *syncMockConnector) GetAccountIdentifier(_ context.Context, _ string) (s
 type syncMockConnectorFactory struct {
 	connectors map[string]*syncMockConnector
 	createErr  error
+
+	mu      stdsync.Mutex // Guards created; sources may sync concurrently
+	created []domain.Source
 }
 
 func newSyncMockConnectorFactory() *syncMockConnectorFactory {
@@ -117,6 +142,9 @@ func newSyncMockConnectorFactory() *syncMockConnectorFactory {
 }
 
 func (f *syncMockConnectorFactory) Create(_ context.Context, source domain.Source) (driven.Connector, error) {
+	f.mu.Lock()
+	f.created = append(f.created, source)
+	f.mu.Unlock()
 	if f.createErr != nil {
 		return nil, f.createErr
 	}
@@ -126,6 +154,13 @@ func (f *syncMockConnectorFactory) Create(_ context.Context, source domain.Sourc
 	return nil, errors.New("no connector configured for source")
 }
 
+// createdSources returns the sources connectors were created for.
+func (f *syncMockConnectorFactory) createdSources() []domain.Source {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]domain.Source(nil), f.created...)
+}
+
 func (f *syncMockConnectorFactory) Register(_ string, _ driven.ConnectorBuilder) {}
 
 func (f *syncMockConnectorFactory) SupportedTypes() []string {
@@ -419,6 +454,42 @@ func TestSyncOrchestrator_Sync_FullSync_Success(t *testing.T) {
 	assert.Len(t, searchEngine.indexed, 2)
 }
 
+func TestSyncOrchestrator_Sync_ParallelWithinSource(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	factory := newSyncMockConnectorFactory()
+	ctx := context.Background()
+
+	source := domain.Source{
+		ID: "src-1", Name: "Repos", Type: "mock",
+		Config: map[string]string{"content_types": "issues,prs"},
+	}
+	require.NoError(t, sourceStore.Save(ctx, source))
+	factory.connectors["src-1"] = &syncMockConnector{sourceID: "src-1", connType: "mock"}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+
+	// Off by default
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	created := factory.createdSources()
+	require.Len(t, created, 1)
+	assert.NotContains(t, created[0].Config, domain.ConfigKeyParallelWithinSource)
+
+	orchestrator.SetParallelWithinSource(true)
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	created = factory.createdSources()
+	require.Len(t, created, 2)
+	assert.Equal(t, "true", created[1].Config[domain.ConfigKeyParallelWithinSource])
+	assert.Equal(t, "issues,prs", created[1].Config["content_types"])
+
+	// The stored source is not modified
+	stored, err := sourceStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.NotContains(t, stored.Config, domain.ConfigKeyParallelWithinSource)
+}
+
 func TestSyncOrchestrator_Sync_WithExclusions(t *testing.T) {
 	sourceStore := memory.NewSourceStore()
 	syncStore := memory.NewSyncStateStore()
@@ -511,6 +582,425 @@ func TestSyncOrchestrator_Sync_WithEmbeddings(t *testing.T) {
 	assert.Len(t, vectorIndex.vectors, 1)
 }
 
+// countingEmbeddingService counts the texts it embeds, and records the size
+// of each batch.
+type countingEmbeddingService struct {
+	syncMockEmbeddingService
+	model   string
+	calls   int
+	batches []int
+	mu      stdsync.Mutex
+}
+
+func (e *countingEmbeddingService) Embed(ctx context.Context, text string) ([]float32, error) {
+	e.mu.Lock()
+	e.calls++
+	e.mu.Unlock()
+	return e.syncMockEmbeddingService.Embed(ctx, text)
+}
+
+func (e *countingEmbeddingService) EmbedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	e.mu.Lock()
+	e.calls += len(texts)
+	e.batches = append(e.batches, len(texts))
+	e.mu.Unlock()
+	return e.syncMockEmbeddingService.EmbedBatch(ctx, texts)
+}
+
+func (e *countingEmbeddingService) ModelName() string { return e.model }
+
+func (e *countingEmbeddingService) takeCalls() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	calls := e.calls
+	e.calls = 0
+	return calls
+}
+
+func TestSyncOrchestrator_Sync_EmbeddingCache(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	factory := newSyncMockConnectorFactory()
+	vectorIndex := newSyncMockVectorIndex()
+	embeddingService := &countingEmbeddingService{model: "model-a"}
+	cache := memory.NewEmbeddingCache()
+	ctx := context.Background()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "file1.txt", MIMEType: "text/plain", Content: []byte("content 1")},
+			{SourceID: "src-1", URI: "file2.txt", MIMEType: "text/plain", Content: []byte("content 2")},
+		},
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{},
+		newSyncMockSearchEngine(), vectorIndex, embeddingService,
+	)
+	orchestrator.SetEmbeddingCache(cache)
+
+	// First sync embeds every chunk
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	assert.Equal(t, 2, embeddingService.takeCalls())
+
+	// Re-syncing identical content reuses the cached embeddings
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	assert.Equal(t, 0, embeddingService.takeCalls())
+	assert.Len(t, vectorIndex.vectors, 2)
+
+	// A model change invalidates the cache
+	embeddingService.model = "model-b"
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	assert.Equal(t, 2, embeddingService.takeCalls())
+	removed, err := cache.DeleteOtherModels(ctx, "model-b")
+	require.NoError(t, err)
+	assert.Zero(t, removed, "model-a embeddings should already be discarded")
+}
+
+func TestSyncOrchestrator_Sync_ReusesEmbeddingsOfUnchangedDocuments(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	factory := newSyncMockConnectorFactory()
+	vectorIndex := newSyncMockVectorIndex()
+	embeddingService := &countingEmbeddingService{model: "model-a"}
+	cache := memory.NewEmbeddingCache()
+	ctx := context.Background()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	connector := &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "file1.txt", MIMEType: "text/plain", Content: []byte("content 1")},
+			{SourceID: "src-1", URI: "file2.txt", MIMEType: "text/plain", Content: []byte("content 2")},
+		},
+	}
+	factory.connectors["src-1"] = connector
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{},
+		newSyncMockSearchEngine(), vectorIndex, embeddingService,
+	)
+	orchestrator.SetEmbeddingCache(cache)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	status, err := orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 0, status.CacheHits)
+	assert.Equal(t, 2, status.CacheMisses)
+	assert.Equal(t, 2, embeddingService.takeCalls())
+
+	// Only the changed document is embedded again
+	connector.fullSyncDocs[1].Content = []byte("content 2, edited")
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	status, err = orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, status.CacheHits)
+	assert.Equal(t, 1, status.CacheMisses)
+	assert.Equal(t, 1, embeddingService.takeCalls())
+
+	_, hash, err := cache.LatestDocument(ctx, "src-1", "file2.txt", "model-a")
+	require.NoError(t, err)
+	assert.Equal(t, documentContentHash("content 2, edited"), hash)
+}
+
+func TestSyncOrchestrator_Sync_EmbedsDocumentsInBatches(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	factory := newSyncMockConnectorFactory()
+	vectorIndex := newSyncMockVectorIndex()
+	embeddingService := &countingEmbeddingService{model: "model-a"}
+	ctx := context.Background()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	var docs []domain.RawDocument
+	for i := range 5 {
+		docs = append(docs, domain.RawDocument{
+			SourceID: "src-1", URI: fmt.Sprintf("file%d.txt", i), MIMEType: "text/plain",
+			Content: []byte(fmt.Sprintf("content %d", i)),
+		})
+	}
+	factory.connectors["src-1"] = &syncMockConnector{sourceID: "src-1", connType: "mock", fullSyncDocs: docs}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{},
+		newSyncMockSearchEngine(), vectorIndex, embeddingService,
+	)
+	orchestrator.SetEmbeddingBatchSize(2)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	assert.Equal(t, []int{2, 2, 1}, embeddingService.batches)
+	assert.Len(t, vectorIndex.vectors, 5)
+	status, err := orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 5, status.DocumentsProcessed)
+}
+
+// modelMockVectorIndex is a vector index that keeps the vectors of models
+// other than the default apart.
+type modelMockVectorIndex struct {
+	*syncMockVectorIndex
+	models map[string]string
+}
+
+func (v *modelMockVectorIndex) AddForModel(ctx context.Context, model, id string, embedding []float32) error {
+	v.mu.Lock()
+	v.models[id] = model
+	v.mu.Unlock()
+	return v.Add(ctx, id, embedding)
+}
+
+func (v *modelMockVectorIndex) SearchModel(
+	_ context.Context, _ string, _ []float32, _ int,
+) ([]driven.VectorHit, error) {
+	return nil, nil
+}
+
+func TestSyncOrchestrator_Sync_EmbedsWithOverrideModels(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	factory := newSyncMockConnectorFactory()
+	vectorIndex := &modelMockVectorIndex{syncMockVectorIndex: newSyncMockVectorIndex(), models: map[string]string{}}
+	textService := &countingEmbeddingService{model: "model-text"}
+	codeService := &countingEmbeddingService{model: "model-code"}
+	cache := memory.NewEmbeddingCache()
+	ctx := context.Background()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "notes.txt", MIMEType: "text/plain", Content: []byte("notes")},
+			{SourceID: "src-1", URI: "main.go", MIMEType: "text/x-go", Content: []byte("package main")},
+		},
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), docStore, memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{},
+		newSyncMockSearchEngine(), vectorIndex, textService,
+	)
+	orchestrator.SetEmbeddingCache(cache)
+	orchestrator.SetEmbeddingModels(
+		map[string]string{"text/x-go": "model-code"},
+		map[string]driven.EmbeddingService{"model-code": codeService},
+	)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	assert.Equal(t, 1, textService.takeCalls())
+	assert.Equal(t, 1, codeService.takeCalls())
+	assert.Len(t, vectorIndex.vectors, 2)
+	assert.Equal(t, []string{"model-code"}, slices.Collect(maps.Values(vectorIndex.models)))
+	_, hash, err := cache.LatestDocument(ctx, "src-1", "main.go", "model-code")
+	require.NoError(t, err)
+	assert.Equal(t, documentContentHash("package main"), hash)
+
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	for i := range docs {
+		assert.NotEmpty(t, documentMIMEType(&docs[i]))
+	}
+}
+
+func TestSyncOrchestrator_Sync_FailedBatchEmbedsDocumentsOneAtATime(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	factory := newSyncMockConnectorFactory()
+	vectorIndex := newSyncMockVectorIndex()
+	ctx := context.Background()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "small1.txt", MIMEType: "text/plain", Content: []byte("small")},
+			{SourceID: "src-1", URI: "giant.txt", MIMEType: "text/plain", Content: []byte(strings.Repeat("x", 100))},
+			{SourceID: "src-1", URI: "small2.txt", MIMEType: "text/plain", Content: []byte("small too")},
+		},
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{},
+		newSyncMockSearchEngine(), vectorIndex, &limitedEmbeddingService{maxTokens: 10},
+	)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	// The giant document fails the batch, but not the documents batched with it
+	status, err := orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, status.DocumentsProcessed)
+	assert.Equal(t, []string{"giant.txt"}, status.FailedURIs)
+	assert.Len(t, vectorIndex.vectors, 2)
+}
+
+// limitedEmbeddingService fails to embed texts longer than maxTokens, like a
+// model whose context is exceeded.
+type limitedEmbeddingService struct {
+	syncMockEmbeddingService
+	maxTokens int
+	texts     []string
+	mu        stdsync.Mutex
+}
+
+func (e *limitedEmbeddingService) Embed(ctx context.Context, text string) ([]float32, error) {
+	if domain.EstimateTokens(text) > e.maxTokens {
+		return nil, errors.New("input exceeds the model's context length")
+	}
+	e.mu.Lock()
+	e.texts = append(e.texts, text)
+	e.mu.Unlock()
+	return e.syncMockEmbeddingService.Embed(ctx, text)
+}
+
+func (e *limitedEmbeddingService) EmbedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	embeddings := make([][]float32, len(texts))
+	for i, text := range texts {
+		embedding, err := e.Embed(ctx, text)
+		if err != nil {
+			return nil, err
+		}
+		embeddings[i] = embedding
+	}
+	return embeddings, nil
+}
+
+func TestSyncOrchestrator_Sync_SplitsChunksOverEmbeddingLimit(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	factory := newSyncMockConnectorFactory()
+	vectorIndex := newSyncMockVectorIndex()
+	embeddingService := &limitedEmbeddingService{maxTokens: 10}
+	ctx := context.Background()
+
+	// A single giant line: 100 characters, about 25 tokens
+	content := strings.Repeat("abcdefghij", 10)
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "giant.txt", MIMEType: "text/plain", Content: []byte(content)},
+		},
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), docStore, memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{},
+		newSyncMockSearchEngine(), vectorIndex, embeddingService,
+	)
+	orchestrator.SetEmbeddingMaxTokens(10)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	chunks, err := docStore.GetChunks(ctx, docs[0].ID)
+	require.NoError(t, err)
+	require.Len(t, chunks, 3, "40 + 40 + 20 characters")
+
+	var joined strings.Builder
+	for i := range chunks {
+		assert.Equal(t, i, chunks[i].Position)
+		assert.LessOrEqual(t, domain.EstimateTokens(chunks[i].Content), 10)
+		assert.NotNil(t, chunks[i].Embedding, "every piece is embedded")
+		joined.WriteString(chunks[i].Content)
+	}
+	assert.Equal(t, content, joined.String(), "pieces cover the whole chunk")
+	assert.Len(t, embeddingService.texts, 3)
+	assert.Len(t, vectorIndex.vectors, 3)
+}
+
+func TestSyncOrchestrator_Sync_OverLimitChunkFailsWithoutSplitting(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	factory := newSyncMockConnectorFactory()
+	ctx := context.Background()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "giant.txt", MIMEType: "text/plain", Content: []byte(strings.Repeat("x", 100))},
+		},
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{},
+		newSyncMockSearchEngine(), newSyncMockVectorIndex(), &limitedEmbeddingService{maxTokens: 10},
+	)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	status, err := orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"giant.txt"}, status.FailedURIs)
+}
+
+func TestSplitForEmbedding(t *testing.T) {
+	chunks := []domain.Chunk{
+		{ID: "c1", DocumentID: "doc-1", Content: "short", Position: 0},
+		{
+			ID: "c2", DocumentID: "doc-1", Content: strings.Repeat("y", 30), Position: 1,
+			Metadata: map[string]any{
+				domain.ChunkMetaSection:     "Intro",
+				domain.ChunkMetaStartOffset: 5,
+				domain.ChunkMetaEndOffset:   35,
+			},
+		},
+	}
+
+	out := splitForEmbedding(chunks, 4)
+
+	// The section heading uses 2 of the 4 tokens, leaving 8 characters a piece
+	require.Len(t, out, 5)
+	assert.Equal(t, "c1", out[0].ID)
+	assert.Equal(t, "c2", out[1].ID, "first piece keeps the chunk's ID")
+	ids := map[string]bool{}
+	for i := range out {
+		assert.Equal(t, i, out[i].Position)
+		assert.LessOrEqual(t, domain.EstimateTokens(embeddingInput(out[i])), 4)
+		ids[out[i].ID] = true
+	}
+	assert.Len(t, ids, 5, "pieces get distinct IDs")
+
+	assert.Equal(t, "Intro", out[4].Metadata[domain.ChunkMetaSection])
+	assert.Equal(t, 13, out[2].Metadata[domain.ChunkMetaStartOffset])
+	assert.Equal(t, 21, out[2].Metadata[domain.ChunkMetaEndOffset])
+	assert.Equal(t, 35, out[4].Metadata[domain.ChunkMetaEndOffset])
+	assert.Equal(t, 5, chunks[1].Metadata[domain.ChunkMetaStartOffset], "input metadata is not modified")
+
+	assert.Equal(t, chunks, splitForEmbedding(chunks, 0), "0 disables splitting")
+}
+
+func TestSplitForEmbedding_StoredOffsets(t *testing.T) {
+	// Chunks reloaded from SQLite have JSON-decoded float64 offsets
+	chunks := []domain.Chunk{{
+		ID: "c1", DocumentID: "doc-1", Content: strings.Repeat("y", 16),
+		Metadata: map[string]any{
+			domain.ChunkMetaStartOffset: float64(100),
+			domain.ChunkMetaEndOffset:   float64(116),
+		},
+	}}
+
+	out := splitForEmbedding(chunks, 2)
+
+	require.Len(t, out, 2)
+	assert.Equal(t, 100, out[0].Metadata[domain.ChunkMetaStartOffset])
+	assert.Equal(t, 108, out[0].Metadata[domain.ChunkMetaEndOffset])
+	assert.Equal(t, 108, out[1].Metadata[domain.ChunkMetaStartOffset])
+	assert.Equal(t, 116, out[1].Metadata[domain.ChunkMetaEndOffset])
+}
+
 func TestSyncOrchestrator_Sync_IncrementalSync(t *testing.T) {
 	sourceStore := memory.NewSourceStore()
 	syncStore := memory.NewSyncStateStore()
@@ -609,6 +1099,49 @@ func TestSyncOrchestrator_SyncAll_Success(t *testing.T) {
 	}
 }
 
+func TestSyncOrchestrator_SyncAll_WaitsForSharedLimiter(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	factory := newSyncMockConnectorFactory()
+	ctx := context.Background()
+
+	src := domain.Source{ID: "src-1", Name: "Source 1", Type: "mock"}
+	require.NoError(t, sourceStore.Save(ctx, src))
+	factory.connectors[src.ID] = &syncMockConnector{
+		sourceID: src.ID,
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: src.ID, URI: "file.txt", MIMEType: "text/plain", Content: []byte("content")},
+		},
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), docStore, memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+	limiter := NewSyncLimiter(1)
+	orchestrator.SetSyncLimiter(limiter)
+
+	// The only slot is held, e.g. by a scheduled sync
+	require.NoError(t, limiter.Acquire(ctx))
+
+	done := make(chan error, 1)
+	go func() { done <- orchestrator.SyncAll(ctx) }()
+
+	select {
+	case <-done:
+		t.Fatal("SyncAll ran while the limiter was full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	limiter.Release()
+	require.NoError(t, <-done)
+
+	docs, err := docStore.ListDocuments(ctx, src.ID)
+	require.NoError(t, err)
+	assert.Len(t, docs, 1)
+}
+
 func TestSyncOrchestrator_SyncAll_NoSources(t *testing.T) {
 	sourceStore := memory.NewSourceStore()
 	syncStore := memory.NewSyncStateStore()
@@ -670,22 +1203,139 @@ func TestSyncOrchestrator_SyncAll_PartialFailure(t *testing.T) {
 	assert.Len(t, docs, 1)
 }
 
-func TestSyncOrchestrator_Status_NotRunning(t *testing.T) {
+func TestSyncOrchestrator_SyncAll_SlowSourceDoesNotBlockOthers(t *testing.T) {
 	sourceStore := memory.NewSourceStore()
-	syncStore := memory.NewSyncStateStore()
 	docStore := memory.NewDocumentStore()
-	exclusionStore := memory.NewExclusionStore()
+	factory := newSyncMockConnectorFactory()
+	ctx := context.Background()
+
+	gate := make(chan struct{})
+	for _, id := range []string{"slow", "fast-1", "broken", "fast-2"} {
+		require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: id, Name: id, Type: "mock"}))
+		factory.connectors[id] = &syncMockConnector{
+			sourceID: id,
+			connType: "mock",
+			fullSyncDocs: []domain.RawDocument{
+				{SourceID: id, URI: "file.txt", MIMEType: "text/plain", Content: []byte("content")},
+			},
+		}
+	}
+	factory.connectors["slow"].fullSyncGate = gate
+	factory.connectors["broken"].fullSyncErr = errors.New("connector error")
 
 	orchestrator := NewSyncOrchestrator(
-		sourceStore, syncStore, docStore, exclusionStore,
-		nil, nil, nil, nil, nil, nil,
+		sourceStore, memory.NewSyncStateStore(), docStore, memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
 	)
+	orchestrator.SetSyncWorkers(2)
 
-	status, err := orchestrator.Status(context.Background(), "src-1")
+	done := make(chan error, 1)
+	go func() { done <- orchestrator.SyncAll(ctx) }()
 
-	require.NoError(t, err)
-	assert.NotNil(t, status)
-	assert.Equal(t, "src-1", status.SourceID)
+	// The fast sources finish while the slow one is still running
+	for _, id := range []string{"fast-1", "fast-2"} {
+		assert.Eventually(t, func() bool {
+			docs, _ := docStore.ListDocuments(ctx, id)
+			return len(docs) == 1
+		}, 2*time.Second, 10*time.Millisecond, "source %s was not synced", id)
+	}
+	select {
+	case <-done:
+		t.Fatal("SyncAll returned before the slow source finished")
+	default:
+	}
+
+	close(gate)
+	err := <-done
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sync broken (broken)")
+	assert.Contains(t, err.Error(), "connector error")
+	assert.NotContains(t, err.Error(), "fast-1")
+	assert.NotContains(t, err.Error(), "slow")
+
+	docs, err := docStore.ListDocuments(ctx, "slow")
+	require.NoError(t, err)
+	assert.Len(t, docs, 1)
+}
+
+func TestSyncOrchestrator_SyncAll_NamesEveryFailedSource(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	factory := newSyncMockConnectorFactory()
+	ctx := context.Background()
+
+	for _, id := range []string{"src-a", "src-b", "src-c"} {
+		require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: id, Name: "Source " + id, Type: "mock"}))
+		factory.connectors[id] = &syncMockConnector{
+			sourceID:    id,
+			connType:    "mock",
+			fullSyncErr: errors.New(id + " failed"),
+		}
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+
+	err := orchestrator.SyncAll(ctx)
+
+	require.Error(t, err)
+	lines := strings.Split(err.Error(), "\n")
+	assert.ElementsMatch(t, []string{
+		"sync src-a (Source src-a): connector error: src-a failed",
+		"sync src-b (Source src-b): connector error: src-b failed",
+		"sync src-c (Source src-c): connector error: src-c failed",
+	}, lines)
+}
+
+func TestSyncOrchestrator_SyncAll_CancelledContext(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Source 1", Type: "mock"}))
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), memory.NewExclusionStore(),
+		newSyncMockConnectorFactory(), nil, nil, nil, nil, nil,
+	)
+	orchestrator.SetSyncLimiter(NewSyncLimiter(1))
+
+	err := orchestrator.SyncAll(ctx)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Contains(t, err.Error(), "src-1")
+}
+
+func TestSyncOrchestrator_SetSyncWorkers_ClampsToOne(t *testing.T) {
+	orchestrator := NewSyncOrchestrator(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	assert.Equal(t, defaultSyncWorkers, orchestrator.workers)
+
+	orchestrator.SetSyncWorkers(0)
+	assert.Equal(t, 1, orchestrator.workers)
+
+	orchestrator.SetSyncWorkers(8)
+	assert.Equal(t, 8, orchestrator.workers)
+}
+
+func TestSyncOrchestrator_Status_NotRunning(t *testing.T) {
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	docStore := memory.NewDocumentStore()
+	exclusionStore := memory.NewExclusionStore()
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, docStore, exclusionStore,
+		nil, nil, nil, nil, nil, nil,
+	)
+
+	status, err := orchestrator.Status(context.Background(), "src-1")
+
+	require.NoError(t, err)
+	assert.NotNil(t, status)
+	assert.Equal(t, "src-1", status.SourceID)
 	assert.False(t, status.Running)
 }
 
@@ -718,6 +1368,59 @@ func TestSyncOrchestrator_Status_WhileRunning(t *testing.T) {
 	assert.Equal(t, 1, status.ErrorCount)
 }
 
+func TestSyncOrchestrator_Status_ReportsLastSyncAndDocumentCount(t *testing.T) {
+	conn := &flakyConnector{docs: flakyDocs(3)}
+	orchestrator, _, _ := newFlakyOrchestrator(t, conn, &flakyNormaliserRegistry{})
+	ctx := context.Background()
+
+	status, err := orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	assert.True(t, status.LastSyncAt.IsZero())
+	assert.Zero(t, status.DocumentCount)
+
+	before := time.Now()
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	status, err = orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	assert.False(t, status.LastSyncAt.Before(before))
+	assert.Equal(t, 3, status.DocumentCount)
+	assert.NoError(t, status.LastError)
+}
+
+func TestSyncOrchestrator_Status_ReportsLastError(t *testing.T) {
+	conn := &flakyConnector{docs: flakyDocs(3), stopAfter: 1, stopErr: errors.New("connection reset")}
+	orchestrator, _, _ := newFlakyOrchestrator(t, conn, &flakyNormaliserRegistry{})
+	ctx := context.Background()
+
+	require.Error(t, orchestrator.Sync(ctx, "src-1"))
+
+	status, err := orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	require.Error(t, status.LastError)
+	assert.Contains(t, status.LastError.Error(), "connection reset")
+
+	// A successful sync clears the error
+	conn.stopErr = nil
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+	status, err = orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	assert.NoError(t, status.LastError)
+}
+
+func TestSyncOrchestrator_Status_ReportsFailureBeforeSyncStarts(t *testing.T) {
+	orchestrator, _, _ := newFlakyOrchestrator(t, &flakyConnector{}, &flakyNormaliserRegistry{})
+	ctx := context.Background()
+
+	syncErr := orchestrator.Sync(ctx, "missing")
+	require.Error(t, syncErr)
+
+	status, err := orchestrator.Status(ctx, "missing")
+	require.NoError(t, err)
+	assert.False(t, status.Running)
+	assert.Equal(t, syncErr, status.LastError)
+}
+
 func TestSyncOrchestrator_Sync_ConnectorClosed(t *testing.T) {
 	sourceStore := memory.NewSourceStore()
 	syncStore := memory.NewSyncStateStore()
@@ -864,3 +1567,674 @@ func TestSyncOrchestrator_ProcessChanges_Delete(t *testing.T) {
 	// Verify search index was cleaned
 	assert.Len(t, searchEngine.indexed, 0)
 }
+
+// syncMockTokenProvider implements driven.TokenProvider for refresh testing.
+type syncMockTokenProvider struct {
+	refreshErr   error
+	refreshCalls int
+}
+
+func (p *syncMockTokenProvider) GetToken(_ context.Context) (string, error) { return "token", nil }
+func (p *syncMockTokenProvider) AuthorizationID() string                    { return "creds-1" }
+func (p *syncMockTokenProvider) AuthMethod() domain.AuthMethod              { return domain.AuthMethodOAuth }
+func (p *syncMockTokenProvider) IsAuthenticated() bool                      { return true }
+
+func (p *syncMockTokenProvider) RefreshIfNeeded(_ context.Context) error {
+	p.refreshCalls++
+	return p.refreshErr
+}
+
+// syncMockTokenProviderFactory implements driven.TokenProviderFactory.
+type syncMockTokenProviderFactory struct {
+	provider *syncMockTokenProvider
+}
+
+func (f *syncMockTokenProviderFactory) CreateTokenProvider(_ context.Context, _ *domain.Source) (driven.TokenProvider, error) {
+	return f.provider, nil
+}
+
+func newRefreshTestOrchestrator(t *testing.T, provider *syncMockTokenProvider) *SyncOrchestrator {
+	t.Helper()
+	sourceStore := memory.NewSourceStore()
+	factory := newSyncMockConnectorFactory()
+	ctx := context.Background()
+
+	source := domain.Source{ID: "src-1", Name: "Test", Type: "mock", CredentialsID: "creds-1", AuthProviderID: "ap-1"}
+	require.NoError(t, sourceStore.Save(ctx, source))
+	factory.connectors["src-1"] = &syncMockConnector{sourceID: "src-1", connType: "mock"}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+	orchestrator.SetTokenProviderFactory(&syncMockTokenProviderFactory{provider: provider})
+	return orchestrator
+}
+
+func TestSyncOrchestrator_Sync_RefreshesCredentialsBeforeSync(t *testing.T) {
+	provider := &syncMockTokenProvider{}
+	orchestrator := newRefreshTestOrchestrator(t, provider)
+
+	err := orchestrator.Sync(context.Background(), "src-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, provider.refreshCalls)
+}
+
+func TestSyncOrchestrator_Sync_RevokedRefreshTokenRequiresReauth(t *testing.T) {
+	provider := &syncMockTokenProvider{
+		refreshErr: fmt.Errorf("refresh token: %w: status 400", domain.ErrAuthExpired),
+	}
+	orchestrator := newRefreshTestOrchestrator(t, provider)
+
+	err := orchestrator.Sync(context.Background(), "src-1")
+
+	var reauthErr *domain.ReauthRequiredError
+	require.ErrorAs(t, err, &reauthErr)
+	assert.Equal(t, "src-1", reauthErr.SourceID)
+	assert.ErrorIs(t, err, domain.ErrAuthExpired)
+}
+
+func TestSyncOrchestrator_Sync_TransientRefreshFailure(t *testing.T) {
+	provider := &syncMockTokenProvider{
+		refreshErr: fmt.Errorf("refresh token: %w: status 503", domain.ErrTokenRefreshFailed),
+	}
+	orchestrator := newRefreshTestOrchestrator(t, provider)
+
+	err := orchestrator.Sync(context.Background(), "src-1")
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrTokenRefreshFailed)
+	var reauthErr *domain.ReauthRequiredError
+	assert.False(t, errors.As(err, &reauthErr))
+}
+
+func TestSyncOrchestrator_Sync_SkipsRefreshForNoAuthSource(t *testing.T) {
+	provider := &syncMockTokenProvider{}
+	orchestrator := newRefreshTestOrchestrator(t, provider)
+	ctx := context.Background()
+	require.NoError(t, orchestrator.sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+
+	err := orchestrator.Sync(ctx, "src-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, provider.refreshCalls)
+}
+
+// --- Partial sync resilience ---
+
+// flakyConnector is a partial-sync connector whose third document fails to
+// process failUntil times before recovering. It sends a checkpoint after each
+// document and can end the sync with a connector error after a given count.
+type flakyConnector struct {
+	syncMockConnector
+	docs      []domain.RawDocument
+	stopAfter int
+	stopErr   error
+
+	// resumedFrom records the cursor IncrementalSync was called with.
+	resumedFrom string
+}
+
+func (c *flakyConnector) FullSync(ctx context.Context) (<-chan domain.RawDocument, <-chan error) {
+	docs := make(chan domain.RawDocument)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(docs)
+		defer close(errs)
+
+		for i, doc := range c.docs {
+			if c.stopErr != nil && i == c.stopAfter {
+				errs <- c.stopErr
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case docs <- doc:
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case errs <- &driven.SyncCheckpoint{Cursor: fmt.Sprintf("after-%s", doc.URI)}:
+			}
+		}
+		errs <- &driven.SyncComplete{NewCursor: "done"}
+	}()
+
+	return docs, errs
+}
+
+// IncrementalSync resumes after the document named in a checkpoint cursor,
+// reporting the remaining documents as created.
+func (c *flakyConnector) IncrementalSync(
+	ctx context.Context, state domain.SyncState,
+) (<-chan domain.RawDocumentChange, <-chan error) {
+	c.resumedFrom = state.Cursor
+	changes := make(chan domain.RawDocumentChange)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(changes)
+		defer close(errs)
+
+		resumed := false
+		for _, doc := range c.docs {
+			if !resumed {
+				resumed = state.Cursor == fmt.Sprintf("after-%s", doc.URI)
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case changes <- domain.RawDocumentChange{Type: domain.ChangeCreated, Document: doc}:
+			}
+		}
+		errs <- &driven.SyncComplete{NewCursor: "done"}
+	}()
+
+	return changes, errs
+}
+
+// flakyNormaliserRegistry fails to normalise failURI until it has been
+// attempted failUntil times.
+type flakyNormaliserRegistry struct {
+	syncMockNormaliserRegistry
+	failURI   string
+	failUntil int
+	err       error
+	attempts  int
+}
+
+func (r *flakyNormaliserRegistry) Normalise(ctx context.Context, raw *domain.RawDocument) (*driven.NormaliseResult, error) {
+	if raw.URI == r.failURI {
+		r.attempts++
+		if r.attempts <= r.failUntil {
+			return nil, r.err
+		}
+	}
+	return r.syncMockNormaliserRegistry.Normalise(ctx, raw)
+}
+
+func flakyDocs(n int) []domain.RawDocument {
+	docs := make([]domain.RawDocument, n)
+	for i := range docs {
+		uri := fmt.Sprintf("doc%d.txt", i+1)
+		docs[i] = domain.RawDocument{SourceID: "src-1", URI: uri, MIMEType: "text/plain", Content: []byte(uri)}
+	}
+	return docs
+}
+
+func newFlakyOrchestrator(
+	t *testing.T, conn *flakyConnector, registry *flakyNormaliserRegistry,
+) (*SyncOrchestrator, driven.SyncStateStore, driven.DocumentStore) {
+	t.Helper()
+	sourceStore := memory.NewSourceStore()
+	syncStore := memory.NewSyncStateStore()
+	docStore := memory.NewDocumentStore()
+	factory := newSyncMockConnectorFactory()
+
+	require.NoError(t, sourceStore.Save(context.Background(), domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	conn.sourceID = "src-1"
+	conn.connType = "mock"
+	factory.connectors["src-1"] = &conn.syncMockConnector
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, syncStore, docStore, memory.NewExclusionStore(),
+		&flakyConnectorFactory{syncMockConnectorFactory: factory, conn: conn},
+		registry, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+	orchestrator.SetItemRetry(3, time.Millisecond)
+	return orchestrator, syncStore, docStore
+}
+
+// flakyConnectorFactory always returns its flakyConnector.
+type flakyConnectorFactory struct {
+	*syncMockConnectorFactory
+	conn *flakyConnector
+}
+
+func (f *flakyConnectorFactory) Create(_ context.Context, _ domain.Source) (driven.Connector, error) {
+	return f.conn, nil
+}
+
+func TestSyncOrchestrator_Sync_PartialSync_RetriesFailedDocument(t *testing.T) {
+	conn := &flakyConnector{docs: flakyDocs(5)}
+	conn.capabilities = driven.ConnectorCapabilities{SupportsPartialSync: true}
+	registry := &flakyNormaliserRegistry{failURI: "doc3.txt", failUntil: 2, err: errors.New("temporarily unavailable")}
+	orchestrator, syncStore, docStore := newFlakyOrchestrator(t, conn, registry)
+	ctx := context.Background()
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	assert.Equal(t, 3, registry.attempts)
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Len(t, docs, 5)
+
+	status, err := orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	assert.False(t, status.Running)
+	assert.Equal(t, 5, status.DocumentsProcessed)
+	assert.Equal(t, 0, status.FailedCount)
+	assert.Equal(t, 0, status.SkippedCount)
+
+	state, err := syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "done", state.Cursor)
+}
+
+func TestSyncOrchestrator_Sync_PartialSync_RecordsDocumentAfterRetriesExhausted(t *testing.T) {
+	conn := &flakyConnector{docs: flakyDocs(5)}
+	conn.capabilities = driven.ConnectorCapabilities{SupportsPartialSync: true}
+	registry := &flakyNormaliserRegistry{failURI: "doc3.txt", failUntil: 10, err: errors.New("temporarily unavailable")}
+	orchestrator, syncStore, _ := newFlakyOrchestrator(t, conn, registry)
+	ctx := context.Background()
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	assert.Equal(t, 3, registry.attempts)
+	status, err := orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 4, status.DocumentsProcessed)
+	assert.Equal(t, 1, status.FailedCount)
+	assert.Equal(t, []string{"doc3.txt"}, status.FailedURIs)
+
+	// The remaining documents still advance the cursor
+	state, err := syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "done", state.Cursor)
+}
+
+func TestSyncOrchestrator_Sync_PartialSync_DoesNotRetryUnsupportedContent(t *testing.T) {
+	conn := &flakyConnector{docs: flakyDocs(4)}
+	conn.capabilities = driven.ConnectorCapabilities{SupportsPartialSync: true}
+	registry := &flakyNormaliserRegistry{failURI: "doc3.txt", failUntil: 10, err: domain.ErrNotImplemented}
+	orchestrator, _, _ := newFlakyOrchestrator(t, conn, registry)
+	ctx := context.Background()
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	assert.Equal(t, 1, registry.attempts)
+	status, err := orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 3, status.DocumentsProcessed)
+	assert.Equal(t, 1, status.SkippedCount)
+	assert.Equal(t, 0, status.FailedCount)
+}
+
+func TestSyncOrchestrator_Sync_NoRetryWithoutPartialSync(t *testing.T) {
+	conn := &flakyConnector{docs: flakyDocs(4)}
+	registry := &flakyNormaliserRegistry{failURI: "doc3.txt", failUntil: 1, err: errors.New("temporarily unavailable")}
+	orchestrator, _, _ := newFlakyOrchestrator(t, conn, registry)
+	ctx := context.Background()
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	assert.Equal(t, 1, registry.attempts)
+	status, err := orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, status.FailedCount)
+}
+
+func TestSyncOrchestrator_Sync_PartialSync_CheckpointSurvivesConnectorError(t *testing.T) {
+	conn := &flakyConnector{docs: flakyDocs(5), stopAfter: 2, stopErr: errors.New("connection reset")}
+	conn.capabilities = driven.ConnectorCapabilities{SupportsPartialSync: true}
+	orchestrator, syncStore, _ := newFlakyOrchestrator(t, conn, &flakyNormaliserRegistry{})
+	ctx := context.Background()
+
+	err := orchestrator.Sync(ctx, "src-1")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "connection reset")
+	state, err := syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "after-doc2.txt", state.Cursor)
+	assert.True(t, state.LastSync.IsZero(), "a checkpoint does not complete a sync")
+}
+
+func TestSyncOrchestrator_Sync_RetryResumesFromCheckpoint(t *testing.T) {
+	conn := &flakyConnector{docs: flakyDocs(5), stopAfter: 2, stopErr: errors.New("connection reset")}
+	conn.capabilities = driven.ConnectorCapabilities{SupportsIncremental: true, SupportsPartialSync: true}
+	orchestrator, syncStore, docStore := newFlakyOrchestrator(t, conn, &flakyNormaliserRegistry{})
+	ctx := context.Background()
+
+	require.Error(t, orchestrator.Sync(ctx, "src-1"))
+
+	// Retrying continues from the saved cursor rather than starting over
+	conn.stopErr = nil
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	assert.Equal(t, "after-doc2.txt", conn.resumedFrom)
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Len(t, docs, 5)
+	state, err := syncStore.Get(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, "done", state.Cursor)
+	assert.False(t, state.LastSync.IsZero())
+}
+
+func TestSyncOrchestrator_Sync_IgnoresCheckpointWithoutPartialSync(t *testing.T) {
+	conn := &flakyConnector{docs: flakyDocs(5), stopAfter: 2, stopErr: errors.New("connection reset")}
+	orchestrator, syncStore, _ := newFlakyOrchestrator(t, conn, &flakyNormaliserRegistry{})
+	ctx := context.Background()
+
+	require.Error(t, orchestrator.Sync(ctx, "src-1"))
+
+	_, err := syncStore.Get(ctx, "src-1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+// --- Empty documents ---
+
+func newEmptyDocOrchestrator(t *testing.T) (*SyncOrchestrator, *memory.DocumentStore, *syncMockSearchEngine) {
+	t.Helper()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	searchEngine := newSyncMockSearchEngine()
+	factory := newSyncMockConnectorFactory()
+
+	require.NoError(t, sourceStore.Save(context.Background(), domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "full.txt", MIMEType: "text/plain", Content: []byte("content")},
+			{SourceID: "src-1", URI: "empty.txt", MIMEType: "text/plain", Content: []byte{}},
+			{SourceID: "src-1", URI: "blank.txt", MIMEType: "text/plain", Content: []byte(" \n\t ")},
+		},
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), docStore, memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, searchEngine, nil, nil,
+	)
+	return orchestrator, docStore, searchEngine
+}
+
+func TestSyncOrchestrator_Sync_SkipsEmptyDocumentsByDefault(t *testing.T) {
+	ctx := context.Background()
+	orchestrator, docStore, searchEngine := newEmptyDocOrchestrator(t)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "full.txt", docs[0].URI)
+	assert.Len(t, searchEngine.indexed, 1)
+
+	status, err := orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, status.DocumentsProcessed)
+	assert.Equal(t, 2, status.SkippedCount)
+	assert.Equal(t, 0, status.FailedCount)
+}
+
+func TestSyncOrchestrator_Sync_IndexesEmptyDocumentsWhenSkipEmptyDisabled(t *testing.T) {
+	ctx := context.Background()
+	orchestrator, docStore, _ := newEmptyDocOrchestrator(t)
+	orchestrator.SetSkipEmpty(false)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Len(t, docs, 3)
+
+	status, err := orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 3, status.DocumentsProcessed)
+	assert.Equal(t, 0, status.SkippedCount)
+}
+
+// skippingPipeline drops documents with the given URI, as a post-processor
+// such as dedup does.
+type skippingPipeline struct {
+	syncMockPostProcessorPipeline
+	skipURI string
+}
+
+func (p *skippingPipeline) Process(ctx context.Context, doc *domain.Document) ([]domain.Chunk, error) {
+	if doc.URI == p.skipURI {
+		return nil, fmt.Errorf("%w: duplicate", domain.ErrSkipDocument)
+	}
+	return p.syncMockPostProcessorPipeline.Process(ctx, doc)
+}
+
+func TestSyncOrchestrator_Sync_CountsPostProcessorSkipsAsSkipped(t *testing.T) {
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	searchEngine := newSyncMockSearchEngine()
+	factory := newSyncMockConnectorFactory()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Test", Type: "mock"}))
+	factory.connectors["src-1"] = &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "original.txt", MIMEType: "text/plain", Content: []byte("content")},
+			{SourceID: "src-1", URI: "copy.txt", MIMEType: "text/plain", Content: []byte("content")},
+		},
+	}
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), docStore, memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &skippingPipeline{skipURI: "copy.txt"}, searchEngine, nil, nil,
+	)
+
+	require.NoError(t, orchestrator.Sync(ctx, "src-1"))
+
+	docs, err := docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "original.txt", docs[0].URI)
+
+	status, err := orchestrator.Status(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, status.DocumentsProcessed)
+	assert.Equal(t, 1, status.SkippedCount)
+	assert.Equal(t, 0, status.FailedCount)
+}
+
+// --- Per-source pipelines ---
+
+// recordingPipeline records the URIs of the documents it processes.
+type recordingPipeline struct {
+	syncMockPostProcessorPipeline
+	mu   stdsync.Mutex
+	uris []string
+}
+
+func (p *recordingPipeline) Process(ctx context.Context, doc *domain.Document) ([]domain.Chunk, error) {
+	p.mu.Lock()
+	p.uris = append(p.uris, doc.URI)
+	p.mu.Unlock()
+	return p.syncMockPostProcessorPipeline.Process(ctx, doc)
+}
+
+// overridePipelineProvider gives sources with a pipeline override their own pipeline.
+type overridePipelineProvider struct {
+	global   *recordingPipeline
+	override *recordingPipeline
+	err      error
+}
+
+func (p *overridePipelineProvider) PipelineFor(source *domain.Source) (driven.PostProcessorPipeline, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	if source.HasPipelineOverride() {
+		return p.override, nil
+	}
+	return p.global, nil
+}
+
+func newPipelineOverrideOrchestrator(t *testing.T) (*SyncOrchestrator, *overridePipelineProvider) {
+	t.Helper()
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	factory := newSyncMockConnectorFactory()
+
+	sources := []domain.Source{
+		{ID: "work", Name: "Work", Type: "mock"},
+		{ID: "notes", Name: "Notes", Type: "mock", Config: map[string]string{domain.ConfigKeyPipelineRemove: "redact"}},
+	}
+	for _, source := range sources {
+		require.NoError(t, sourceStore.Save(ctx, source))
+		factory.connectors[source.ID] = &syncMockConnector{
+			sourceID: source.ID,
+			connType: "mock",
+			fullSyncDocs: []domain.RawDocument{
+				{SourceID: source.ID, URI: source.ID + ".txt", MIMEType: "text/plain", Content: []byte("content")},
+			},
+		}
+	}
+
+	provider := &overridePipelineProvider{global: &recordingPipeline{}, override: &recordingPipeline{}}
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, provider.global, newSyncMockSearchEngine(), nil, nil,
+	)
+	orchestrator.SetPipelineProvider(provider)
+	return orchestrator, provider
+}
+
+func TestSyncOrchestrator_Sync_UsesSourcePipelineOverride(t *testing.T) {
+	ctx := context.Background()
+	orchestrator, provider := newPipelineOverrideOrchestrator(t)
+
+	require.NoError(t, orchestrator.SyncAll(ctx))
+
+	assert.Equal(t, []string{"work.txt"}, provider.global.uris)
+	assert.Equal(t, []string{"notes.txt"}, provider.override.uris)
+}
+
+func TestSyncOrchestrator_Sync_PipelineProviderError(t *testing.T) {
+	ctx := context.Background()
+	orchestrator, provider := newPipelineOverrideOrchestrator(t)
+	provider.err = errors.New("unknown processor: redact")
+
+	err := orchestrator.Sync(ctx, "notes")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown processor")
+	assert.Empty(t, provider.override.uris)
+}
+
+func TestSyncOrchestrator_DryRun_CountsEmptyDocuments(t *testing.T) {
+	orchestrator, _, _ := newEmptyDocOrchestrator(t)
+
+	preview, err := orchestrator.DryRun(context.Background(), "src-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, preview.Documents)
+	assert.Equal(t, 2, preview.Empty)
+}
+
+func TestEmbeddingInput_PrefixesSection(t *testing.T) {
+	plain := domain.Chunk{Content: "Run the installer."}
+	sectioned := domain.Chunk{
+		Content:  "Run the installer.",
+		Metadata: map[string]any{domain.ChunkMetaSection: "Install > Linux"},
+	}
+
+	assert.Equal(t, "Run the installer.", embeddingInput(plain))
+	assert.Equal(t, "Install > Linux\n\nRun the installer.", embeddingInput(sectioned))
+}
+
+// syncMockNotifier records the notifications it is sent.
+type syncMockNotifier struct {
+	notifications []domain.SyncNotification
+	err           error
+}
+
+func (m *syncMockNotifier) Notify(_ context.Context, notification domain.SyncNotification) error {
+	m.notifications = append(m.notifications, notification)
+	return m.err
+}
+
+func newNotifyTestOrchestrator(t *testing.T, connector *syncMockConnector) *SyncOrchestrator {
+	t.Helper()
+	sourceStore := memory.NewSourceStore()
+	factory := newSyncMockConnectorFactory()
+	source := domain.Source{ID: "src-1", Name: "Notes", Type: "mock"}
+	require.NoError(t, sourceStore.Save(context.Background(), source))
+	factory.connectors["src-1"] = connector
+
+	return NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), memory.NewDocumentStore(), memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, newSyncMockSearchEngine(), nil, nil,
+	)
+}
+
+func TestSyncOrchestrator_Sync_NotifiesCompleted(t *testing.T) {
+	orchestrator := newNotifyTestOrchestrator(t, &syncMockConnector{
+		sourceID: "src-1",
+		connType: "mock",
+		fullSyncDocs: []domain.RawDocument{
+			{SourceID: "src-1", URI: "file1.txt", MIMEType: "text/plain", Content: []byte("content 1")},
+			{SourceID: "src-1", URI: "file2.txt", MIMEType: "text/plain", Content: []byte("content 2")},
+		},
+	})
+	notifier := &syncMockNotifier{}
+	orchestrator.SetNotifier(notifier, []domain.SyncEvent{domain.SyncEventCompleted, domain.SyncEventFailed})
+
+	before := time.Now()
+	require.NoError(t, orchestrator.Sync(context.Background(), "src-1"))
+
+	require.Len(t, notifier.notifications, 1)
+	n := notifier.notifications[0]
+	assert.Equal(t, domain.SyncEventCompleted, n.Event)
+	assert.Equal(t, "src-1", n.SourceID)
+	assert.Equal(t, "Notes", n.SourceName)
+	assert.Equal(t, "mock", n.SourceType)
+	assert.Equal(t, 2, n.DocumentsProcessed)
+	assert.Zero(t, n.FailedCount)
+	assert.Empty(t, n.Error)
+	assert.False(t, n.StartedAt.Before(before))
+	assert.False(t, n.FinishedAt.Before(n.StartedAt))
+}
+
+func TestSyncOrchestrator_Sync_NotifiesFailed(t *testing.T) {
+	orchestrator := newNotifyTestOrchestrator(t, &syncMockConnector{
+		sourceID:     "src-1",
+		connType:     "mock",
+		capabilities: driven.ConnectorCapabilities{SupportsValidation: true},
+		validateErr:  errors.New("token revoked"),
+	})
+	notifier := &syncMockNotifier{}
+	orchestrator.SetNotifier(notifier, []domain.SyncEvent{domain.SyncEventFailed})
+
+	err := orchestrator.Sync(context.Background(), "src-1")
+
+	require.Error(t, err)
+	require.Len(t, notifier.notifications, 1)
+	n := notifier.notifications[0]
+	assert.Equal(t, domain.SyncEventFailed, n.Event)
+	assert.Equal(t, "Notes", n.SourceName)
+	assert.Equal(t, err.Error(), n.Error)
+	assert.Contains(t, n.Error, "token revoked")
+}
+
+func TestSyncOrchestrator_Sync_NotifiesOnlyConfiguredEvents(t *testing.T) {
+	orchestrator := newNotifyTestOrchestrator(t, &syncMockConnector{sourceID: "src-1", connType: "mock"})
+	notifier := &syncMockNotifier{}
+	orchestrator.SetNotifier(notifier, []domain.SyncEvent{domain.SyncEventFailed})
+
+	require.NoError(t, orchestrator.Sync(context.Background(), "src-1"))
+
+	assert.Empty(t, notifier.notifications)
+}
+
+func TestSyncOrchestrator_Sync_NotifierErrorDoesNotFailSync(t *testing.T) {
+	orchestrator := newNotifyTestOrchestrator(t, &syncMockConnector{sourceID: "src-1", connType: "mock"})
+	notifier := &syncMockNotifier{err: errors.New("webhook down")}
+	orchestrator.SetNotifier(notifier, []domain.SyncEvent{domain.SyncEventCompleted})
+
+	require.NoError(t, orchestrator.Sync(context.Background(), "src-1"))
+	assert.Len(t, notifier.notifications, 1)
+}
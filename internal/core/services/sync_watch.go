@@ -0,0 +1,296 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driving"
+)
+
+// Ensure SyncWatcher implements the interface.
+var _ driving.SyncWatcher = (*SyncWatcher)(nil)
+
+// defaultWatchSettle is how long a source's changes must pause before they
+// are applied, so a burst such as an editor's save is indexed once.
+const defaultWatchSettle = 500 * time.Millisecond
+
+// SyncWatcher indexes the changes reported by the connectors of sources that
+// support watching, through the SyncOrchestrator's document pipeline.
+// Sources added while it is running are watched from its next start.
+type SyncWatcher struct {
+	sourceStore  driven.SourceStore
+	orchestrator *SyncOrchestrator
+	settle       time.Duration
+	updates      chan domain.WatchUpdate
+
+	mu      sync.Mutex
+	running bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// NewSyncWatcher creates a watcher that applies changes with orchestrator.
+func NewSyncWatcher(sourceStore driven.SourceStore, orchestrator *SyncOrchestrator) *SyncWatcher {
+	return &SyncWatcher{
+		sourceStore:  sourceStore,
+		orchestrator: orchestrator,
+		settle:       defaultWatchSettle,
+		updates:      make(chan domain.WatchUpdate, 1),
+	}
+}
+
+// SetSettleDelay sets how long a source's changes must pause before they are
+// applied. Zero applies them as soon as they arrive.
+func (w *SyncWatcher) SetSettleDelay(delay time.Duration) {
+	if delay >= 0 {
+		w.settle = delay
+	}
+}
+
+// Start watches every source whose connector supports it. Sources that fail
+// to open are logged and skipped. Blocks until ctx is cancelled or Stop is
+// called, then waits for changes being applied to finish.
+func (w *SyncWatcher) Start(ctx context.Context) error {
+	w.mu.Lock()
+	if w.running {
+		w.mu.Unlock()
+		return nil // Already running
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	w.running = true
+	w.cancel = cancel
+	w.done = make(chan struct{})
+	done := w.done
+	w.mu.Unlock()
+
+	defer func() {
+		cancel()
+		w.mu.Lock()
+		w.running = false
+		w.mu.Unlock()
+		close(done)
+	}()
+
+	sources, err := w.sourceStore.List(ctx)
+	if err != nil {
+		return fmt.Errorf("list sources: %w", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := range sources {
+		wg.Add(1)
+		go func(sourceID string) {
+			defer wg.Done()
+			if err := w.watchSource(ctx, sourceID); err != nil {
+				slog.Warn("failed to watch source", slog.String("source_id", sourceID), slog.Any("error", err))
+			}
+		}(sources[i].ID)
+	}
+
+	<-ctx.Done()
+	wg.Wait()
+	return nil
+}
+
+// Stop stops watching and waits for Start to return.
+func (w *SyncWatcher) Stop() error {
+	w.mu.Lock()
+	if !w.running {
+		w.mu.Unlock()
+		return nil
+	}
+	w.cancel()
+	done := w.done
+	w.mu.Unlock()
+
+	<-done
+	return nil
+}
+
+// Updates returns the channel receiving a summary of the changes applied.
+func (w *SyncWatcher) Updates() <-chan domain.WatchUpdate {
+	return w.updates
+}
+
+// watchSource applies a source's changes until ctx is cancelled or its
+// connector stops reporting them. Sources whose connector does not support
+// watching are ignored.
+func (w *SyncWatcher) watchSource(ctx context.Context, sourceID string) error {
+	source, connector, _, err := w.orchestrator.openConnector(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+	defer connector.Close()
+	if !connector.Capabilities().SupportsWatch {
+		return nil
+	}
+
+	pipeline, err := w.orchestrator.pipelineFor(source)
+	if err != nil {
+		return err
+	}
+	changes, err := connector.Watch(ctx)
+	if err != nil {
+		return fmt.Errorf("watch: %w", err)
+	}
+
+	slog.Info("watching source", slog.String("source_id", sourceID))
+	run := &syncRun{source: source, status: &driving.SyncStatus{SourceID: sourceID}, pipeline: pipeline}
+
+	// Changes are queued as they arrive, so a slow batch never holds up the
+	// connector; repeated changes to a document are applied once
+	queue := newChangeQueue()
+	go func() {
+		defer queue.close()
+		for change := range changes {
+			queue.add(change)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, open := <-queue.ready:
+			if open && !w.waitToSettle(ctx) {
+				return nil
+			}
+			if batch := queue.take(); len(batch) > 0 {
+				w.publish(w.apply(ctx, run, batch))
+			}
+			if !open {
+				return nil
+			}
+		}
+	}
+}
+
+// waitToSettle waits out the settle delay, reporting false if ctx is
+// cancelled first.
+func (w *SyncWatcher) waitToSettle(ctx context.Context) bool {
+	if w.settle == 0 {
+		return true
+	}
+	timer := time.NewTimer(w.settle)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// apply indexes or deletes the documents of a batch of changes.
+func (w *SyncWatcher) apply(
+	ctx context.Context, run *syncRun, batch []domain.RawDocumentChange,
+) domain.WatchUpdate {
+	update := domain.WatchUpdate{SourceIDs: []string{run.source.ID}}
+	for i := range batch {
+		change := &batch[i]
+		var err error
+		switch change.Type {
+		case domain.ChangeCreated, domain.ChangeUpdated:
+			err = w.orchestrator.processOneDocument(ctx, run, &change.Document)
+			if errors.Is(err, errExcludedDocument) {
+				err = nil
+			}
+		case domain.ChangeDeleted:
+			err = w.orchestrator.deleteDocumentByURI(ctx, run.source.ID, change.Document.URI)
+		}
+
+		switch {
+		case err != nil:
+			slog.Debug("failed to apply watched change",
+				slog.String("source_id", run.source.ID),
+				slog.String("uri", change.Document.URI),
+				slog.Any("error", err))
+			update.Failed++
+		case change.Type == domain.ChangeDeleted:
+			update.Deleted++
+		default:
+			update.Indexed++
+		}
+	}
+	return update
+}
+
+// publish sends an update to the Updates channel, merging it with the update
+// waiting there if it has not been received yet.
+func (w *SyncWatcher) publish(update domain.WatchUpdate) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for {
+		select {
+		case w.updates <- update:
+			return
+		default:
+		}
+		select {
+		case pending := <-w.updates:
+			update = pending.Merge(update)
+		default:
+		}
+	}
+}
+
+// changeQueue holds the changes of one source waiting to be applied, keeping
+// only the latest change to each document.
+type changeQueue struct {
+	mu      sync.Mutex
+	changes map[string]domain.RawDocumentChange
+	order   []string
+
+	// ready receives a value when changes are waiting, and is closed once no
+	// more will be added.
+	ready chan struct{}
+}
+
+// newChangeQueue creates an empty change queue.
+func newChangeQueue() *changeQueue {
+	return &changeQueue{
+		changes: make(map[string]domain.RawDocumentChange),
+		ready:   make(chan struct{}, 1),
+	}
+}
+
+// add queues a change, replacing any queued change to the same document.
+func (q *changeQueue) add(change domain.RawDocumentChange) {
+	q.mu.Lock()
+	uri := change.Document.URI
+	if _, queued := q.changes[uri]; !queued {
+		q.order = append(q.order, uri)
+	}
+	q.changes[uri] = change
+	q.mu.Unlock()
+
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// take removes and returns the queued changes in the order their documents
+// first changed.
+func (q *changeQueue) take() []domain.RawDocumentChange {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	batch := make([]domain.RawDocumentChange, 0, len(q.order))
+	for _, uri := range q.order {
+		batch = append(batch, q.changes[uri])
+	}
+	clear(q.changes)
+	q.order = q.order[:0]
+	return batch
+}
+
+// close signals that no more changes will be added.
+func (q *changeQueue) close() {
+	close(q.ready)
+}
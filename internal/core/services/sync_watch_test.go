@@ -0,0 +1,209 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/adapters/driven/storage/memory"
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// watchTest holds a watcher over one source whose connector reports the
+// changes sent on changes.
+type watchTest struct {
+	watcher      *SyncWatcher
+	docStore     *memory.DocumentStore
+	searchEngine *syncMockSearchEngine
+	connector    *syncMockConnector
+	changes      chan domain.RawDocumentChange
+}
+
+func newWatchTest(t *testing.T) *watchTest {
+	t.Helper()
+	ctx := context.Background()
+	sourceStore := memory.NewSourceStore()
+	docStore := memory.NewDocumentStore()
+	searchEngine := newSyncMockSearchEngine()
+	factory := newSyncMockConnectorFactory()
+
+	require.NoError(t, sourceStore.Save(ctx, domain.Source{ID: "src-1", Name: "Notes", Type: "filesystem"}))
+	changes := make(chan domain.RawDocumentChange)
+	connector := &syncMockConnector{
+		sourceID:     "src-1",
+		connType:     "filesystem",
+		capabilities: driven.ConnectorCapabilities{SupportsWatch: true},
+		watchChanges: changes,
+	}
+	factory.connectors["src-1"] = connector
+
+	orchestrator := NewSyncOrchestrator(
+		sourceStore, memory.NewSyncStateStore(), docStore, memory.NewExclusionStore(),
+		factory, &syncMockNormaliserRegistry{}, &syncMockPostProcessorPipeline{}, searchEngine, nil, nil,
+	)
+	watcher := NewSyncWatcher(sourceStore, orchestrator)
+	watcher.SetSettleDelay(0)
+
+	return &watchTest{
+		watcher:      watcher,
+		docStore:     docStore,
+		searchEngine: searchEngine,
+		connector:    connector,
+		changes:      changes,
+	}
+}
+
+// start runs the watcher until the test ends and returns Start's result.
+func (w *watchTest) start(t *testing.T) <-chan error {
+	t.Helper()
+	result := make(chan error, 1)
+	go func() { result <- w.watcher.Start(context.Background()) }()
+	t.Cleanup(func() { _ = w.watcher.Stop() })
+	return result
+}
+
+// nextUpdate waits for the watcher's next update.
+func (w *watchTest) nextUpdate(t *testing.T) domain.WatchUpdate {
+	t.Helper()
+	select {
+	case update := <-w.watcher.Updates():
+		return update
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for watch update")
+		return domain.WatchUpdate{}
+	}
+}
+
+func createdChange(uri, content string) domain.RawDocumentChange {
+	return domain.RawDocumentChange{
+		Type:     domain.ChangeCreated,
+		Document: domain.RawDocument{SourceID: "src-1", URI: uri, MIMEType: "text/plain", Content: []byte(content)},
+	}
+}
+
+func TestSyncWatcher_IndexesCreatedDocument(t *testing.T) {
+	w := newWatchTest(t)
+	w.start(t)
+
+	w.changes <- createdChange("notes.txt", "hello")
+	update := w.nextUpdate(t)
+
+	assert.Equal(t, domain.WatchUpdate{SourceIDs: []string{"src-1"}, Indexed: 1}, update)
+	docs, err := w.docStore.ListDocuments(context.Background(), "src-1")
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "notes.txt", docs[0].URI)
+	w.searchEngine.mu.Lock()
+	assert.Contains(t, w.searchEngine.indexed, "src-1-chunk-notes.txt")
+	w.searchEngine.mu.Unlock()
+}
+
+func TestSyncWatcher_DeletedChangeRemovesDocument(t *testing.T) {
+	w := newWatchTest(t)
+	ctx := context.Background()
+	doc := domain.Document{ID: "doc-1", SourceID: "src-1", URI: "old.txt", Title: "Old"}
+	chunk := domain.Chunk{ID: "chunk-1", DocumentID: "doc-1", Content: "old"}
+	require.NoError(t, w.docStore.SaveDocument(ctx, &doc))
+	require.NoError(t, w.docStore.SaveChunks(ctx, []domain.Chunk{chunk}))
+	require.NoError(t, w.searchEngine.Index(ctx, chunk))
+	w.start(t)
+
+	w.changes <- domain.RawDocumentChange{
+		Type:     domain.ChangeDeleted,
+		Document: domain.RawDocument{SourceID: "src-1", URI: "old.txt"},
+	}
+	update := w.nextUpdate(t)
+
+	assert.Equal(t, 1, update.Deleted)
+	docs, err := w.docStore.ListDocuments(ctx, "src-1")
+	require.NoError(t, err)
+	assert.Empty(t, docs)
+	w.searchEngine.mu.Lock()
+	assert.Empty(t, w.searchEngine.indexed)
+	w.searchEngine.mu.Unlock()
+}
+
+func TestSyncWatcher_AppliesLatestChangeOfBurst(t *testing.T) {
+	w := newWatchTest(t)
+	w.watcher.SetSettleDelay(200 * time.Millisecond)
+	w.start(t)
+
+	w.changes <- createdChange("draft.txt", "first")
+	w.changes <- createdChange("draft.txt", "second")
+	w.changes <- createdChange("other.txt", "other")
+	update := w.nextUpdate(t)
+
+	assert.Equal(t, 2, update.Indexed)
+	docs, err := w.docStore.ListDocuments(context.Background(), "src-1")
+	require.NoError(t, err)
+	require.Len(t, docs, 2)
+	doc, err := w.docStore.GetDocument(context.Background(), "src-1-doc-draft.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "second", doc.Content)
+}
+
+func TestSyncWatcher_StopEndsStart(t *testing.T) {
+	w := newWatchTest(t)
+	result := w.start(t)
+
+	w.changes <- createdChange("notes.txt", "hello")
+	w.nextUpdate(t)
+	require.NoError(t, w.watcher.Stop())
+
+	select {
+	case err := <-result:
+		assert.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("Start did not return after Stop")
+	}
+	assert.True(t, w.connector.closed)
+}
+
+func TestSyncWatcher_StartEndsWithContext(t *testing.T) {
+	w := newWatchTest(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error, 1)
+	go func() { result <- w.watcher.Start(ctx) }()
+
+	cancel()
+
+	select {
+	case err := <-result:
+		assert.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("Start did not return after its context was cancelled")
+	}
+}
+
+func TestSyncWatcher_StopWhenNotRunning(t *testing.T) {
+	w := newWatchTest(t)
+	assert.NoError(t, w.watcher.Stop())
+}
+
+func TestSyncWatcher_IgnoresSourcesWithoutWatch(t *testing.T) {
+	w := newWatchTest(t)
+	w.connector.capabilities.SupportsWatch = false
+
+	err := w.watcher.watchSource(context.Background(), "src-1")
+
+	assert.NoError(t, err)
+	assert.True(t, w.connector.closed)
+}
+
+func TestSyncWatcher_MergesUnreadUpdates(t *testing.T) {
+	w := newWatchTest(t)
+
+	w.watcher.publish(domain.WatchUpdate{SourceIDs: []string{"src-1"}, Indexed: 2})
+	w.watcher.publish(domain.WatchUpdate{SourceIDs: []string{"src-2"}, Deleted: 1})
+
+	assert.Equal(t, domain.WatchUpdate{SourceIDs: []string{"src-1", "src-2"}, Indexed: 2, Deleted: 1}, w.nextUpdate(t))
+	select {
+	case update := <-w.watcher.Updates():
+		t.Fatalf("unexpected second update: %+v", update)
+	default:
+	}
+}
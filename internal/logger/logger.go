@@ -1,36 +1,42 @@
-// Package logger provides verbose logging for the Sercha CLI.
-// When verbose mode is enabled via the --verbose flag, debug messages
-// are printed to stderr to help users understand the search pipeline.
+// Package logger configures structured logging for the Sercha CLI.
+//
+// Code logs through the standard log/slog package with key-value attributes,
+// for example:
+//
+//	slog.Warn("failed to index chunk", slog.String("chunk_id", id), slog.Any("error", err))
+//
+// Setup installs the default slog handler, which writes records at or above
+// the configured level to stderr as text or JSON. The --log-level and
+// --log-format flags select the level and format; --verbose is shorthand for
+// the debug level, which also traces the search pipeline.
 package logger
 
 import (
 	"fmt"
 	"io"
+	"log/slog"
 	"os"
 	"sync"
 )
 
-var (
-	mu      sync.RWMutex
-	verbose bool
-	output  io.Writer = os.Stderr
+// Log formats accepted by Setup.
+const (
+	// FormatText writes records as key=value pairs.
+	FormatText = "text"
+	// FormatJSON writes records as JSON objects, one per line.
+	FormatJSON = "json"
 )
 
-// SetVerbose enables or disables verbose logging.
-func SetVerbose(v bool) {
-	mu.Lock()
-	defer mu.Unlock()
-	verbose = v
-}
+// DefaultLevel is the level logged when none is configured: warnings and
+// errors only.
+const DefaultLevel = slog.LevelWarn
 
-// IsVerbose returns true if verbose mode is enabled.
-func IsVerbose() bool {
-	mu.RLock()
-	defer mu.RUnlock()
-	return verbose
-}
+var (
+	mu     sync.Mutex
+	output io.Writer = os.Stderr
+)
 
-// SetOutput sets the output writer for verbose logs.
+// SetOutput sets the writer used by subsequent calls to Setup.
 // Defaults to os.Stderr. Useful for testing.
 func SetOutput(w io.Writer) {
 	mu.Lock()
@@ -38,38 +44,38 @@ func SetOutput(w io.Writer) {
 	output = w
 }
 
-// Debug prints a message if verbose mode is enabled.
-func Debug(format string, args ...any) {
-	mu.RLock()
-	defer mu.RUnlock()
-	if verbose {
-		fmt.Fprintf(output, "[DEBUG] "+format+"\n", args...)
-	}
-}
+// Setup installs the default slog handler, logging records at level or above
+// in format. Formats other than FormatJSON are written as FormatText.
+// Output of the standard log package is routed through the same handler.
+func Setup(level slog.Level, format string) {
+	mu.Lock()
+	defer mu.Unlock()
 
-// Section prints a section header if verbose mode is enabled.
-func Section(name string) {
-	mu.RLock()
-	defer mu.RUnlock()
-	if verbose {
-		fmt.Fprintf(output, "\n=== %s ===\n", name)
+	opts := &slog.HandlerOptions{Level: level}
+	var handler slog.Handler
+	if format == FormatJSON {
+		handler = slog.NewJSONHandler(output, opts)
+	} else {
+		handler = slog.NewTextHandler(output, opts)
 	}
+	slog.SetDefault(slog.New(handler))
 }
 
-// Info prints an informational message if verbose mode is enabled.
-func Info(format string, args ...any) {
-	mu.RLock()
-	defer mu.RUnlock()
-	if verbose {
-		fmt.Fprintf(output, "[INFO] "+format+"\n", args...)
+// ParseLevel parses a level name: debug, info, warn or error.
+func ParseLevel(name string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(name)); err != nil {
+		return 0, fmt.Errorf("invalid log level %q: use debug, info, warn or error", name)
 	}
+	return level, nil
 }
 
-// Warn prints a warning message if verbose mode is enabled.
-func Warn(format string, args ...any) {
-	mu.RLock()
-	defer mu.RUnlock()
-	if verbose {
-		fmt.Fprintf(output, "[WARN] "+format+"\n", args...)
+// ParseFormat checks a format name is FormatText or FormatJSON.
+func ParseFormat(name string) (string, error) {
+	switch name {
+	case FormatText, FormatJSON:
+		return name, nil
+	default:
+		return "", fmt.Errorf("invalid log format %q: use %s or %s", name, FormatText, FormatJSON)
 	}
 }
@@ -2,152 +2,119 @@ package logger
 
 import (
 	"bytes"
+	"encoding/json"
+	"log"
+	"log/slog"
 	"os"
+	"strings"
 	"testing"
 )
 
-func TestSetVerbose(t *testing.T) {
-	// Reset state after test
-	defer func() {
-		SetVerbose(false)
+// resetLogging restores the default output and handler after a test.
+func resetLogging(t *testing.T) {
+	t.Helper()
+	previous := slog.Default()
+	t.Cleanup(func() {
 		SetOutput(os.Stderr)
-	}()
-
-	// Initially not verbose
-	SetVerbose(false)
-	if IsVerbose() {
-		t.Error("expected verbose to be false initially")
-	}
-
-	// Enable verbose
-	SetVerbose(true)
-	if !IsVerbose() {
-		t.Error("expected verbose to be true after SetVerbose(true)")
-	}
-
-	// Disable verbose
-	SetVerbose(false)
-	if IsVerbose() {
-		t.Error("expected verbose to be false after SetVerbose(false)")
-	}
+		slog.SetDefault(previous)
+	})
 }
 
-func TestDebug_WhenVerbose(t *testing.T) {
-	defer func() {
-		SetVerbose(false)
-		SetOutput(os.Stderr)
-	}()
-
+func TestSetup_Text(t *testing.T) {
+	resetLogging(t)
 	var buf bytes.Buffer
 	SetOutput(&buf)
-	SetVerbose(true)
 
-	Debug("test message %s", "arg")
+	Setup(slog.LevelInfo, FormatText)
+	slog.Info("indexed document", slog.String("source_id", "src-1"), slog.Int("chunks", 3))
 
 	output := buf.String()
-	if output == "" {
-		t.Error("expected output when verbose is enabled")
-	}
-	if output != "[DEBUG] test message arg\n" {
-		t.Errorf("unexpected output: %q", output)
+	for _, want := range []string{"level=INFO", `msg="indexed document"`, "source_id=src-1", "chunks=3"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output %q does not contain %q", output, want)
+		}
 	}
 }
 
-func TestDebug_WhenNotVerbose(t *testing.T) {
-	defer func() {
-		SetVerbose(false)
-		SetOutput(os.Stderr)
-	}()
-
+func TestSetup_JSON(t *testing.T) {
+	resetLogging(t)
 	var buf bytes.Buffer
 	SetOutput(&buf)
-	SetVerbose(false)
 
-	Debug("test message")
+	Setup(slog.LevelInfo, FormatJSON)
+	slog.Info("indexed document", slog.String("source_id", "src-1"))
 
-	if buf.Len() > 0 {
-		t.Error("expected no output when verbose is disabled")
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("output is not JSON: %v: %q", err, buf.String())
+	}
+	if record["msg"] != "indexed document" || record["source_id"] != "src-1" || record["level"] != "INFO" {
+		t.Errorf("unexpected record: %v", record)
 	}
 }
 
-func TestSection(t *testing.T) {
-	defer func() {
-		SetVerbose(false)
-		SetOutput(os.Stderr)
-	}()
-
+func TestSetup_Level(t *testing.T) {
+	resetLogging(t)
 	var buf bytes.Buffer
 	SetOutput(&buf)
-	SetVerbose(true)
 
-	Section("Test Section")
+	Setup(slog.LevelWarn, FormatText)
+	slog.Debug("debug message")
+	slog.Info("info message")
 
-	output := buf.String()
-	if output != "\n=== Test Section ===\n" {
-		t.Errorf("unexpected section output: %q", output)
+	if buf.Len() > 0 {
+		t.Errorf("expected no output below the level, got %q", buf.String())
 	}
-}
 
-func TestInfo(t *testing.T) {
-	defer func() {
-		SetVerbose(false)
-		SetOutput(os.Stderr)
-	}()
-
-	var buf bytes.Buffer
-	SetOutput(&buf)
-	SetVerbose(true)
-
-	Info("info message %d", 42)
-
-	output := buf.String()
-	if output != "[INFO] info message 42\n" {
-		t.Errorf("unexpected info output: %q", output)
+	slog.Warn("warn message")
+	if !strings.Contains(buf.String(), "warn message") {
+		t.Errorf("expected warning to be logged, got %q", buf.String())
 	}
 }
 
-func TestWarn(t *testing.T) {
-	defer func() {
-		SetVerbose(false)
-		SetOutput(os.Stderr)
-	}()
-
+func TestSetup_RoutesStandardLog(t *testing.T) {
+	resetLogging(t)
 	var buf bytes.Buffer
 	SetOutput(&buf)
-	SetVerbose(true)
 
-	Warn("warning message")
+	Setup(slog.LevelInfo, FormatText)
+	log.Printf("from the log package")
 
-	output := buf.String()
-	if output != "[WARN] warning message\n" {
-		t.Errorf("unexpected warn output: %q", output)
+	if !strings.Contains(buf.String(), `msg="from the log package"`) {
+		t.Errorf("expected standard log output in handler, got %q", buf.String())
 	}
 }
 
-func TestConcurrentAccess(t *testing.T) {
-	defer func() {
-		SetVerbose(false)
-		SetOutput(os.Stderr)
-	}()
+func TestParseLevel(t *testing.T) {
+	tests := map[string]slog.Level{
+		"debug": slog.LevelDebug,
+		"info":  slog.LevelInfo,
+		"warn":  slog.LevelWarn,
+		"ERROR": slog.LevelError,
+	}
+	for name, want := range tests {
+		got, err := ParseLevel(name)
+		if err != nil {
+			t.Errorf("ParseLevel(%q) error: %v", name, err)
+		}
+		if got != want {
+			t.Errorf("ParseLevel(%q) = %v, want %v", name, got, want)
+		}
+	}
 
-	var buf bytes.Buffer
-	SetOutput(&buf)
+	if _, err := ParseLevel("loud"); err == nil {
+		t.Error("expected error for unknown level")
+	}
+}
 
-	// Run concurrent operations
-	done := make(chan bool)
-	for i := 0; i < 10; i++ {
-		go func() {
-			SetVerbose(true)
-			Debug("concurrent %d", i)
-			IsVerbose()
-			SetVerbose(false)
-			done <- true
-		}()
+func TestParseFormat(t *testing.T) {
+	for _, name := range []string{FormatText, FormatJSON} {
+		if got, err := ParseFormat(name); err != nil || got != name {
+			t.Errorf("ParseFormat(%q) = %q, %v", name, got, err)
+		}
 	}
 
-	// Wait for all goroutines
-	for i := 0; i < 10; i++ {
-		<-done
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Error("expected error for unknown format")
 	}
-	// Test passes if no race conditions
 }
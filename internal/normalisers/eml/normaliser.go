@@ -21,11 +21,21 @@ import (
 var _ driven.Normaliser = (*Normaliser)(nil)
 
 // Normaliser handles EML (email) documents.
-type Normaliser struct{}
+type Normaliser struct {
+	fallbackTitle string
+}
 
 // New creates a new EML normaliser.
 func New() *Normaliser {
-	return &Normaliser{}
+	return &Normaliser{
+		fallbackTitle: domain.DefaultNormaliserConfig().EmailFallbackTitle,
+	}
+}
+
+// SetFallbackTitle sets the title used for emails without a subject.
+// An empty title falls back to the filename.
+func (n *Normaliser) SetFallbackTitle(title string) {
+	n.fallbackTitle = title
 }
 
 // SupportedMIMETypes returns the MIME types this normaliser handles.
@@ -94,8 +104,11 @@ func (n *Normaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*dri
 	content.WriteString("\n")
 	content.WriteString(body)
 
-	// Use subject as title, fall back to filename
+	// Use subject as title, fall back to the configured title or filename
 	title := subject
+	if title == "" {
+		title = n.fallbackTitle
+	}
 	if title == "" {
 		title = extractTitleFromURI(raw.URI)
 	}
@@ -107,7 +107,38 @@ Email without subject.
 	require.NoError(t, err)
 	require.NotNil(t, result)
 
-	// Should fall back to filename as title
+	assert.Equal(t, "(No subject)", result.Document.Title)
+}
+
+func TestNormalise_NoSubject_ConfiguredFallback(t *testing.T) {
+	normaliser := New()
+	normaliser.SetFallbackTitle("Untitled email")
+
+	raw := &domain.RawDocument{
+		SourceID: "test-source",
+		URI:      "gmail://messages/abc123",
+		MIMEType: "message/rfc822",
+		Content:  []byte("From: sender@example.com\n\nNo subject here.\n"),
+	}
+
+	result, err := normaliser.Normalise(context.Background(), raw)
+	require.NoError(t, err)
+	assert.Equal(t, "Untitled email", result.Document.Title)
+}
+
+func TestNormalise_NoSubject_EmptyFallbackUsesFilename(t *testing.T) {
+	normaliser := New()
+	normaliser.SetFallbackTitle("")
+
+	raw := &domain.RawDocument{
+		SourceID: "test-source",
+		URI:      "/path/to/my_email.eml",
+		MIMEType: "message/rfc822",
+		Content:  []byte("From: sender@example.com\n\nNo subject here.\n"),
+	}
+
+	result, err := normaliser.Normalise(context.Background(), raw)
+	require.NoError(t, err)
 	assert.Equal(t, "my email", result.Document.Title)
 }
 
@@ -0,0 +1,165 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// MIMETypeGitHubDiscussion is the custom MIME type for GitHub discussions.
+const MIMETypeGitHubDiscussion = "application/vnd.github.discussion+json"
+
+// Ensure DiscussionNormaliser implements the interface.
+var _ driven.Normaliser = (*DiscussionNormaliser)(nil)
+
+// DiscussionNormaliser handles GitHub discussion documents.
+type DiscussionNormaliser struct{}
+
+// NewDiscussion creates a new GitHub discussion normaliser.
+func NewDiscussion() *DiscussionNormaliser {
+	return &DiscussionNormaliser{}
+}
+
+// SupportedMIMETypes returns the MIME types this normaliser handles.
+func (n *DiscussionNormaliser) SupportedMIMETypes() []string {
+	return []string{MIMETypeGitHubDiscussion}
+}
+
+// SupportedConnectorTypes returns connector types for specialised handling.
+func (n *DiscussionNormaliser) SupportedConnectorTypes() []string {
+	return []string{"github"} // GitHub-specific
+}
+
+// Priority returns the selection priority.
+func (n *DiscussionNormaliser) Priority() int {
+	return 95 // Connector-specific priority
+}
+
+// DiscussionContent represents the JSON content of a discussion.
+type DiscussionContent struct {
+	Number    int                        `json:"number"`
+	Title     string                     `json:"title"`
+	Body      string                     `json:"body"`
+	Category  string                     `json:"category"`
+	Author    string                     `json:"author"`
+	CreatedAt time.Time                  `json:"created_at"`
+	UpdatedAt time.Time                  `json:"updated_at"`
+	Labels    []string                   `json:"labels"`
+	Upvotes   int                        `json:"upvotes"`
+	Answer    *DiscussionCommentContent  `json:"answer,omitempty"`
+	Comments  []DiscussionCommentContent `json:"comments"`
+}
+
+// DiscussionCommentContent represents a comment thread on a discussion.
+type DiscussionCommentContent struct {
+	Author    string           `json:"author"`
+	Body      string           `json:"body"`
+	CreatedAt time.Time        `json:"created_at"`
+	Upvotes   int              `json:"upvotes"`
+	Replies   []CommentContent `json:"replies,omitempty"`
+}
+
+// Normalise converts a GitHub discussion document to a normalised document.
+func (n *DiscussionNormaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*driven.NormaliseResult, error) {
+	if raw == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	// Parse JSON content
+	var content DiscussionContent
+	if err := json.Unmarshal(raw.Content, &content); err != nil {
+		return nil, fmt.Errorf("parse discussion content: %w", err)
+	}
+
+	// Build normalised content with preserved authorship
+	var sb strings.Builder
+
+	// Header with metadata
+	sb.WriteString(fmt.Sprintf("# Discussion #%d: %s\n\n", content.Number, content.Title))
+	sb.WriteString(fmt.Sprintf("**Author:** @%s", content.Author))
+	if content.Category != "" {
+		sb.WriteString(fmt.Sprintf(" | **Category:** %s", content.Category))
+	}
+	if content.Answer != nil {
+		sb.WriteString(" | **Status:** Answered")
+	}
+	if len(content.Labels) > 0 {
+		sb.WriteString(fmt.Sprintf(" | **Labels:** %s", strings.Join(content.Labels, ", ")))
+	}
+	sb.WriteString("\n\n")
+
+	// Timestamps
+	sb.WriteString(fmt.Sprintf("*Created: %s | Updated: %s*\n\n",
+		content.CreatedAt.Format("2006-01-02 15:04"),
+		content.UpdatedAt.Format("2006-01-02 15:04")))
+
+	// Description
+	sb.WriteString("## Description\n\n")
+	if content.Body != "" {
+		sb.WriteString(content.Body)
+	} else {
+		sb.WriteString("*No description provided.*")
+	}
+	sb.WriteString("\n\n")
+
+	// Accepted answer
+	if content.Answer != nil {
+		sb.WriteString("## Answer\n\n")
+		writeDiscussionComment(&sb, content.Answer)
+	}
+
+	// Comment threads
+	if len(content.Comments) > 0 {
+		sb.WriteString("## Comments\n\n")
+		for i := range content.Comments {
+			writeDiscussionComment(&sb, &content.Comments[i])
+		}
+	}
+
+	// Build title
+	title := fmt.Sprintf("Discussion #%d: %s", content.Number, content.Title)
+
+	// Build document
+	doc := domain.Document{
+		ID:        uuid.New().String(),
+		SourceID:  raw.SourceID,
+		URI:       raw.URI,
+		Title:     title,
+		Content:   sb.String(),
+		Metadata:  copyMetadata(raw.Metadata),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	// Add normaliser info to metadata
+	if doc.Metadata == nil {
+		doc.Metadata = make(map[string]any)
+	}
+	doc.Metadata["mime_type"] = raw.MIMEType
+	doc.Metadata["format"] = "github_discussion"
+
+	return &driven.NormaliseResult{
+		Document: doc,
+	}, nil
+}
+
+// writeDiscussionComment writes a comment followed by its replies.
+func writeDiscussionComment(sb *strings.Builder, comment *DiscussionCommentContent) {
+	sb.WriteString(fmt.Sprintf("### @%s (%s)\n\n%s\n\n",
+		comment.Author,
+		comment.CreatedAt.Format("2006-01-02 15:04"),
+		comment.Body))
+	for _, reply := range comment.Replies {
+		sb.WriteString(fmt.Sprintf("#### Reply from @%s (%s)\n\n%s\n\n",
+			reply.Author,
+			reply.CreatedAt.Format("2006-01-02 15:04"),
+			reply.Body))
+	}
+}
@@ -3,6 +3,7 @@
 // This package contains normalisers for:
 //   - Issues (application/vnd.github.issue+json)
 //   - Pull Requests (application/vnd.github.pull+json)
+//   - Discussions (application/vnd.github.discussion+json)
 //
 // These normalisers preserve authorship, labels, state, and comment history
 // in a structured text format suitable for search and retrieval.
@@ -74,6 +74,9 @@ func (n *Normaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*dri
 	}
 	doc.Metadata["mime_type"] = raw.MIMEType
 	doc.Metadata["format"] = "html"
+	if headings := extractHTMLHeadings(rawContent); len(headings) > 0 {
+		doc.Metadata[domain.DocMetaHeadings] = headings
+	}
 
 	return &driven.NormaliseResult{
 		Document: doc,
@@ -96,6 +99,7 @@ var (
 	allTags           = regexp.MustCompile(`<[^>]+>`)
 	multiSpaces       = regexp.MustCompile(`[ \t]+`)
 	multiNewlines     = regexp.MustCompile(`\n{3,}`)
+	headingTag        = regexp.MustCompile(`(?is)<h([1-6])[^>]*>(.*?)</h[1-6]>`)
 )
 
 // extractHTMLTitle extracts a title from the HTML content or falls back to filename.
@@ -122,6 +126,20 @@ func extractHTMLTitle(content, uri string) string {
 	return filename
 }
 
+// extractHTMLHeadings returns the document's <h1>-<h6> headings in the form
+// of domain.DocMetaHeadings, with their text as it is in Content.
+func extractHTMLHeadings(content string) []string {
+	var headings []string
+	for _, m := range headingTag.FindAllStringSubmatch(content, -1) {
+		text := strings.Join(strings.Fields(stripHTML(m[2])), " ")
+		if text != "" {
+			level := int(m[1][0] - '0')
+			headings = append(headings, strings.Repeat("#", level)+" "+text)
+		}
+	}
+	return headings
+}
+
 // stripHTML removes HTML tags and extracts readable text content.
 func stripHTML(content string) string {
 	// Remove script, style, noscript, head, and svg tags entirely
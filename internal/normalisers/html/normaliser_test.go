@@ -2,6 +2,7 @@ package html
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -345,6 +346,26 @@ func TestNormalise_MetadataPreserved(t *testing.T) {
 	assert.Equal(t, "html", doc.Metadata["format"])
 }
 
+func TestNormalise_RecordsHeadings(t *testing.T) {
+	normaliser := New()
+	raw := &domain.RawDocument{
+		URI:      "guide.html",
+		MIMEType: "text/html",
+		Content: []byte(`<html><body><h1>Guide</h1><p>Intro.</p>` +
+			`<h2 class="section">Install &amp; <em>setup</em></h2><p>Run it.</p></body></html>`),
+	}
+
+	result, err := normaliser.Normalise(context.Background(), raw)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"# Guide", "## Install & setup"}, result.Document.Metadata[domain.DocMetaHeadings])
+
+	// Heading text matches a line of the normalised content
+	lines := strings.Split(result.Document.Content, "\n")
+	assert.Contains(t, lines, "Guide")
+	assert.Contains(t, lines, "Install & setup")
+}
+
 func TestInterfaceCompliance(t *testing.T) {
 	var _ driven.Normaliser = (*Normaliser)(nil)
 }
@@ -18,11 +18,22 @@ import (
 var _ driven.Normaliser = (*Normaliser)(nil)
 
 // Normaliser handles ICS (iCalendar) documents.
-type Normaliser struct{}
+type Normaliser struct {
+	fallbackTitle string
+}
 
 // New creates a new ICS normaliser.
 func New() *Normaliser {
-	return &Normaliser{}
+	return &Normaliser{
+		fallbackTitle: domain.DefaultNormaliserConfig().EventFallbackTitle,
+	}
+}
+
+// SetFallbackTitle sets the title template used for events without a summary.
+// domain.FallbackTitleDatePlaceholder is replaced with the event's start date.
+// An empty template falls back to the filename.
+func (n *Normaliser) SetFallbackTitle(template string) {
+	n.fallbackTitle = template
 }
 
 // SupportedMIMETypes returns the MIME types this normaliser handles.
@@ -48,6 +59,7 @@ type event struct {
 	Description string
 	Location    string
 	Start       string
+	StartDate   string
 	End         string
 	Organiser   string
 	Attendees   []string
@@ -59,12 +71,19 @@ func (n *Normaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*dri
 		return nil, domain.ErrInvalidInput
 	}
 
+	loc := eventLocation(raw)
+
 	// Parse the iCalendar content
-	events, calendarName := parseICS(raw.Content)
+	events, calendarName := parseICS(raw.Content, loc)
 
 	if len(events) == 0 {
-		// If no events found, still create a document with raw content
-		return n.createDocument(raw, "", string(raw.Content)), nil
+		// If no events found, still create a document with raw content.
+		// Calendar connectors emit event times as metadata instead.
+		content := string(raw.Content)
+		if when := formatMetadataTimes(raw, loc); when != "" {
+			content = "When: " + when + "\n\n" + content
+		}
+		return n.createDocument(raw, "", "", content, loc), nil
 	}
 
 	// Build searchable content from all events
@@ -87,13 +106,26 @@ func (n *Normaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*dri
 		title = calendarName
 	}
 
-	return n.createDocument(raw, title, content.String()), nil
+	return n.createDocument(raw, title, events[0].StartDate, content.String(), loc), nil
 }
 
-// createDocument builds the normalised document.
-func (n *Normaliser) createDocument(raw *domain.RawDocument, title, content string) *driven.NormaliseResult {
+// createDocument builds the normalised document. The startDate is used for
+// the fallback title when neither the content nor the metadata has a title.
+func (n *Normaliser) createDocument(
+	raw *domain.RawDocument, title, startDate, content string, loc *time.Location,
+) *driven.NormaliseResult {
+	if title == "" {
+		title = metadataString(raw, "title")
+	}
+	if title == "" {
+		if startDate == "" {
+			// Connectors emit event details as metadata rather than VEVENTs
+			startDate = formatDate(metadataString(raw, "start_time"), loc)
+		}
+		title = n.buildFallbackTitle(startDate)
+	}
 	if title == "" {
-		title = extractTitleFromMetadataOrURI(raw)
+		title = extractTitleFromURI(raw.URI)
 	}
 
 	doc := domain.Document{
@@ -120,6 +152,7 @@ func (n *Normaliser) createDocument(raw *domain.RawDocument, title, content stri
 
 // parseState holds the state during ICS parsing.
 type parseState struct {
+	loc          *time.Location
 	events       []event
 	currentEvent *event
 	calendarName string
@@ -127,9 +160,9 @@ type parseState struct {
 	currentValue strings.Builder
 }
 
-// parseICS extracts events from iCalendar content.
-func parseICS(content []byte) (events []event, calendarName string) {
-	state := &parseState{}
+// parseICS extracts events from iCalendar content, rendering UTC times in loc.
+func parseICS(content []byte, loc *time.Location) (events []event, calendarName string) {
+	state := &parseState{loc: loc}
 
 	scanner := bufio.NewScanner(bytes.NewReader(content))
 	for scanner.Scan() {
@@ -190,7 +223,7 @@ func (s *parseState) processLine(line string) {
 // flushProperty applies the current property to the event and resets state.
 func (s *parseState) flushProperty() {
 	if s.currentProp != "" && s.currentEvent != nil {
-		applyProperty(s.currentEvent, s.currentProp, s.currentValue.String())
+		applyProperty(s.currentEvent, s.currentProp, s.currentValue.String(), s.loc)
 	}
 	s.currentProp = ""
 	s.currentValue.Reset()
@@ -215,7 +248,7 @@ func parsePropValue(line string) (prop, value string) {
 }
 
 // applyProperty sets the property value on the event.
-func applyProperty(evt *event, prop, value string) {
+func applyProperty(evt *event, prop, value string, loc *time.Location) {
 	decoded := decodeValue(value)
 	switch prop {
 	case "SUMMARY":
@@ -225,9 +258,10 @@ func applyProperty(evt *event, prop, value string) {
 	case "LOCATION":
 		evt.Location = decoded
 	case "DTSTART":
-		evt.Start = formatDateTime(value)
+		evt.Start = formatDateTime(value, loc)
+		evt.StartDate = formatDate(value, loc)
 	case "DTEND":
-		evt.End = formatDateTime(value)
+		evt.End = formatDateTime(value, loc)
 	case "ORGANIZER": //nolint:misspell // iCalendar standard uses American spelling
 		evt.Organiser = extractEmail(decoded)
 	case "ATTENDEE":
@@ -248,24 +282,121 @@ func decodeValue(value string) string {
 	return value
 }
 
+// Layouts for rendering event times.
+const (
+	dateLayout     = "January 2, 2006"
+	timeLayout     = "3:04 PM"
+	dateTimeLayout = dateLayout + " at " + timeLayout
+)
+
+// eventLocation returns the timezone to render event times in: the
+// "timezone" metadata set by calendar connectors, or UTC.
+func eventLocation(raw *domain.RawDocument) *time.Location {
+	if name := metadataString(raw, "timezone"); name != "" {
+		if loc, err := time.LoadLocation(name); err == nil {
+			return loc
+		}
+	}
+	return time.UTC
+}
+
 // formatDateTime converts iCalendar date/time to readable format.
-func formatDateTime(value string) string {
-	// Handle basic date format: 20240115
-	if len(value) == 8 {
-		if t, err := time.Parse("20060102", value); err == nil {
-			return t.Format("January 2, 2006")
+// UTC times are converted to loc; floating times are shown as written.
+func formatDateTime(value string, loc *time.Location) string {
+	t, dateOnly, ok := parseEventTime(value, loc)
+	switch {
+	case !ok:
+		return value
+	case dateOnly:
+		return t.Format(dateLayout)
+	default:
+		return t.Format(dateTimeLayout)
+	}
+}
+
+// formatDate converts an iCalendar or ISO 8601 date/time to a readable date
+// in loc. Returns an empty string if the value cannot be parsed.
+func formatDate(value string, loc *time.Location) string {
+	t, _, ok := parseEventTime(value, loc)
+	if !ok {
+		return ""
+	}
+	return t.Format(dateLayout)
+}
+
+// parseEventTime parses an iCalendar or ISO 8601 date/time. Times with a
+// zone are converted to loc, and times without one are taken to be in loc.
+// dateOnly is true for values without a time of day.
+func parseEventTime(value string, loc *time.Location) (t time.Time, dateOnly, ok bool) {
+	for _, layout := range []string{"20060102", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, true, true
+		}
+	}
+	for _, layout := range []string{"20060102T150405Z", time.RFC3339} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.In(loc), false, true
 		}
 	}
+	// Microsoft Graph uses fractional seconds without a zone
+	if i := strings.Index(value, "."); i > 0 && !strings.ContainsAny(value[i:], "Z+-") {
+		value = value[:i]
+	}
+	for _, layout := range []string{"20060102T150405", "2006-01-02T15:04:05"} {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, false, true
+		}
+	}
+	return time.Time{}, false, false
+}
 
-	// Handle date-time format: 20240115T100000 or 20240115T100000Z
-	value = strings.TrimSuffix(value, "Z")
-	if len(value) == 15 {
-		if t, err := time.Parse("20060102T150405", value); err == nil {
-			return t.Format("January 2, 2006 at 3:04 PM")
+// formatMetadataTimes renders the start_time and end_time metadata calendar
+// connectors emit, in loc. Returns an empty string without a start time.
+func formatMetadataTimes(raw *domain.RawDocument, loc *time.Location) string {
+	start, dateOnly, ok := parseEventTime(metadataString(raw, "start_time"), loc)
+	if !ok {
+		return ""
+	}
+	end, _, hasEnd := parseEventTime(metadataString(raw, "end_time"), loc)
+	if allDay, _ := raw.Metadata["is_all_day"].(bool); allDay {
+		dateOnly = true
+	}
+
+	if dateOnly {
+		// All-day events end at the start of the following day
+		when := start.Format(dateLayout)
+		if hasEnd {
+			if last := end.AddDate(0, 0, -1); last.After(start) {
+				when += " to " + last.Format(dateLayout)
+			}
 		}
+		return when
 	}
 
-	return value
+	when := start.Format(dateTimeLayout)
+	if hasEnd && end.After(start) {
+		if end.Format(dateLayout) == start.Format(dateLayout) {
+			when += " to " + end.Format(timeLayout)
+		} else {
+			when += " to " + end.Format(dateTimeLayout)
+		}
+	}
+	return when + " (" + loc.String() + ")"
+}
+
+// buildFallbackTitle builds the title for an event without a summary.
+// Returns an empty string if there is no template or no date to fill it.
+func (n *Normaliser) buildFallbackTitle(startDate string) string {
+	if n.fallbackTitle == "" {
+		return ""
+	}
+	if !strings.Contains(n.fallbackTitle, domain.FallbackTitleDatePlaceholder) {
+		return n.fallbackTitle
+	}
+	if startDate == "" {
+		return ""
+	}
+	return strings.ReplaceAll(n.fallbackTitle, domain.FallbackTitleDatePlaceholder, startDate)
 }
 
 // extractEmail extracts email from organiser/attendee values.
@@ -326,14 +457,12 @@ func formatEvent(evt *event) string {
 	return result.String()
 }
 
-// extractTitleFromMetadataOrURI checks metadata for title first, then falls back to URI.
-func extractTitleFromMetadataOrURI(raw *domain.RawDocument) string {
-	if raw.Metadata != nil {
-		if title, ok := raw.Metadata["title"].(string); ok && title != "" {
-			return title
-		}
+// metadataString returns a string metadata value, or empty if not set.
+func metadataString(raw *domain.RawDocument, key string) string {
+	if value, ok := raw.Metadata[key].(string); ok {
+		return value
 	}
-	return extractTitleFromURI(raw.URI)
+	return ""
 }
 
 // extractTitleFromURI extracts a title from the file URI.
@@ -3,6 +3,7 @@ package ics
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -261,6 +262,191 @@ END:VCALENDAR`
 	assert.Equal(t, "Work Calendar", result.Document.Title)
 }
 
+func TestNormalise_NoSummary_DateFallback(t *testing.T) {
+	normaliser := New()
+	ctx := context.Background()
+
+	icsContent := `BEGIN:VCALENDAR
+VERSION:2.0
+BEGIN:VEVENT
+DTSTART:20240115T100000Z
+DTEND:20240115T110000Z
+END:VEVENT
+END:VCALENDAR`
+
+	raw := &domain.RawDocument{
+		SourceID: "test-source",
+		URI:      "/path/to/calendar.ics",
+		MIMEType: "text/calendar",
+		Content:  []byte(icsContent),
+	}
+
+	result, err := normaliser.Normalise(ctx, raw)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	assert.Equal(t, "Meeting on January 15, 2024", result.Document.Title)
+}
+
+func TestNormalise_NoSummary_MetadataStartTime(t *testing.T) {
+	normaliser := New()
+	ctx := context.Background()
+
+	// Calendar connectors emit event details as text with metadata
+	tests := []struct {
+		name      string
+		startTime string
+	}{
+		{name: "google datetime", startTime: "2024-03-05T09:30:00Z"},
+		{name: "google all-day", startTime: "2024-03-05"},
+		{name: "microsoft datetime", startTime: "2024-03-05T09:30:00.0000000"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			raw := &domain.RawDocument{
+				SourceID: "test-source",
+				URI:      "gcal://primary/events/abc123",
+				MIMEType: "text/calendar",
+				Content:  []byte("Location: Room 1"),
+				Metadata: map[string]any{
+					"title":      "",
+					"start_time": tc.startTime,
+				},
+			}
+
+			result, err := normaliser.Normalise(ctx, raw)
+			require.NoError(t, err)
+			assert.Equal(t, "Meeting on March 5, 2024", result.Document.Title)
+		})
+	}
+}
+
+func TestNormalise_MetadataTimes_Timezone(t *testing.T) {
+	normaliser := New()
+
+	tests := []struct {
+		name     string
+		metadata map[string]any
+		expected string
+	}{
+		{
+			name: "google converted to timezone",
+			metadata: map[string]any{
+				"start_time": "2024-03-05T09:30:00Z", "end_time": "2024-03-05T10:30:00Z",
+				"timezone": "America/New_York",
+			},
+			expected: "When: March 5, 2024 at 4:30 AM to 5:30 AM (America/New_York)",
+		},
+		{
+			name: "microsoft rendered in timezone",
+			metadata: map[string]any{
+				"start_time": "2024-07-01T09:00:00.0000000", "end_time": "2024-07-02T17:00:00.0000000",
+				"timezone": "Europe/London",
+			},
+			expected: "When: July 1, 2024 at 9:00 AM to July 2, 2024 at 5:00 PM (Europe/London)",
+		},
+		{
+			name:     "defaults to UTC",
+			metadata: map[string]any{"start_time": "2024-03-05T09:30:00+01:00"},
+			expected: "When: March 5, 2024 at 8:30 AM (UTC)",
+		},
+		{
+			name:     "google all-day",
+			metadata: map[string]any{"start_time": "2024-03-05", "end_time": "2024-03-07", "timezone": "Asia/Tokyo"},
+			expected: "When: March 5, 2024 to March 6, 2024",
+		},
+		{
+			name: "microsoft all-day",
+			metadata: map[string]any{
+				"start_time": "2024-03-05T00:00:00.0000000", "end_time": "2024-03-06T00:00:00.0000000",
+				"is_all_day": true, "timezone": "Europe/London",
+			},
+			expected: "When: March 5, 2024",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.metadata["title"] = "Standup"
+			raw := &domain.RawDocument{
+				SourceID: "test-source",
+				URI:      "gcal://primary/events/abc123",
+				MIMEType: "text/calendar",
+				Content:  []byte("Standup\n\nLocation: Room 1"),
+				Metadata: tc.metadata,
+			}
+
+			result, err := normaliser.Normalise(context.Background(), raw)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected+"\n\nStandup\n\nLocation: Room 1", result.Document.Content)
+		})
+	}
+}
+
+func TestNormalise_UTCTimes_Timezone(t *testing.T) {
+	normaliser := New()
+
+	raw := &domain.RawDocument{
+		SourceID: "test-source",
+		URI:      "/path/to/calendar.ics",
+		MIMEType: "text/calendar",
+		Content: []byte("BEGIN:VCALENDAR\nBEGIN:VEVENT\nSUMMARY:Late call\n" +
+			"DTSTART:20240115T230000Z\nEND:VEVENT\nEND:VCALENDAR"),
+		Metadata: map[string]any{"timezone": "Asia/Tokyo"},
+	}
+
+	result, err := normaliser.Normalise(context.Background(), raw)
+	require.NoError(t, err)
+	assert.Contains(t, result.Document.Content, "When: January 16, 2024 at 8:00 AM")
+}
+
+func TestNormalise_NoSummary_MetadataStartTime_Timezone(t *testing.T) {
+	normaliser := New()
+
+	raw := &domain.RawDocument{
+		SourceID: "test-source",
+		URI:      "gcal://primary/events/abc123",
+		MIMEType: "text/calendar",
+		Metadata: map[string]any{"start_time": "2024-03-05T23:30:00Z", "timezone": "Asia/Tokyo"},
+	}
+
+	result, err := normaliser.Normalise(context.Background(), raw)
+	require.NoError(t, err)
+	assert.Equal(t, "Meeting on March 6, 2024", result.Document.Title)
+}
+
+func TestNormalise_NoSummary_ConfiguredFallback(t *testing.T) {
+	normaliser := New()
+	normaliser.SetFallbackTitle("Event ({date})")
+
+	raw := &domain.RawDocument{
+		SourceID: "test-source",
+		URI:      "/path/to/calendar.ics",
+		MIMEType: "text/calendar",
+		Content:  []byte("BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20240115\nEND:VEVENT\nEND:VCALENDAR"),
+	}
+
+	result, err := normaliser.Normalise(context.Background(), raw)
+	require.NoError(t, err)
+	assert.Equal(t, "Event (January 15, 2024)", result.Document.Title)
+}
+
+func TestNormalise_NoSummary_NoStartUsesFilename(t *testing.T) {
+	normaliser := New()
+
+	raw := &domain.RawDocument{
+		SourceID: "test-source",
+		URI:      "/path/to/team_sync.ics",
+		MIMEType: "text/calendar",
+		Content:  []byte("BEGIN:VCALENDAR\nBEGIN:VEVENT\nLOCATION:Room 1\nEND:VEVENT\nEND:VCALENDAR"),
+	}
+
+	result, err := normaliser.Normalise(context.Background(), raw)
+	require.NoError(t, err)
+	assert.Equal(t, "team sync", result.Document.Title)
+}
+
 func TestNormalise_NoEvents(t *testing.T) {
 	normaliser := New()
 	ctx := context.Background()
@@ -393,12 +579,43 @@ func TestFormatDateTime(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			result := formatDateTime(tc.input)
+			result := formatDateTime(tc.input, time.UTC)
 			assert.Equal(t, tc.expected, result)
 		})
 	}
 }
 
+func TestFormatDateTime_Timezone(t *testing.T) {
+	loc, err := time.LoadLocation("America/Los_Angeles")
+	require.NoError(t, err)
+
+	assert.Equal(t, "January 15, 2024 at 2:00 AM", formatDateTime("20240115T100000Z", loc))
+	assert.Equal(t, "January 15, 2024 at 10:00 AM", formatDateTime("20240115T100000", loc), "floating time unchanged")
+	assert.Equal(t, "January 15, 2024", formatDateTime("20240115", loc))
+}
+
+func TestFormatDate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "ics date", input: "20240115", expected: "January 15, 2024"},
+		{name: "ics datetime", input: "20240115T100000Z", expected: "January 15, 2024"},
+		{name: "iso date", input: "2024-01-15", expected: "January 15, 2024"},
+		{name: "rfc3339", input: "2024-01-15T10:00:00+02:00", expected: "January 15, 2024"},
+		{name: "fractional seconds", input: "2024-01-15T10:00:00.0000000", expected: "January 15, 2024"},
+		{name: "invalid", input: "invalid", expected: ""},
+		{name: "empty", input: "", expected: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, formatDate(tc.input, time.UTC))
+		})
+	}
+}
+
 func TestExtractEmail(t *testing.T) {
 	tests := []struct {
 		name     string
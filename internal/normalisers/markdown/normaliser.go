@@ -73,6 +73,9 @@ func (n *Normaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*dri
 	}
 	doc.Metadata["mime_type"] = raw.MIMEType
 	doc.Metadata["format"] = "markdown"
+	if headings := extractMarkdownHeadings(rawContent); len(headings) > 0 {
+		doc.Metadata[domain.DocMetaHeadings] = headings
+	}
 
 	return &driven.NormaliseResult{
 		Document: doc,
@@ -101,6 +104,25 @@ func extractMarkdownTitle(content, uri string) string {
 	return filename
 }
 
+// atxHeading matches a markdown ATX heading line.
+var atxHeading = regexp.MustCompile(`(?m)^(#{1,6})[ \t]+(.+)$`)
+
+// extractMarkdownHeadings returns the document's headings in the form of
+// domain.DocMetaHeadings, with their text stripped as it is in Content.
+func extractMarkdownHeadings(content string) []string {
+	codeBlock := regexp.MustCompile("(?s)```[^`]*```")
+	content = codeBlock.ReplaceAllString(content, "")
+
+	var headings []string
+	for _, m := range atxHeading.FindAllStringSubmatch(content, -1) {
+		text := strings.TrimSpace(strings.TrimRight(strings.TrimSpace(m[2]), "#"))
+		if text = stripMarkdown(text); text != "" {
+			headings = append(headings, m[1]+" "+text)
+		}
+	}
+	return headings
+}
+
 // stripMarkdown removes common markdown formatting for plain text content.
 // This is a simplified implementation that handles common cases.
 func stripMarkdown(content string) string {
@@ -120,7 +142,9 @@ func stripMarkdown(content string) string {
 	links := regexp.MustCompile(`\[([^\]]+)\]\([^)]+\)`)
 	content = links.ReplaceAllString(content, "$1")
 
-	// Remove heading markers (# ## ### etc)
+	// Remove heading markers (# ## ### etc), including closing sequences
+	closingHashes := regexp.MustCompile(`(?m)^(#{1,6}[ \t]+.*?)[ \t]+#+[ \t]*$`)
+	content = closingHashes.ReplaceAllString(content, "$1")
 	headings := regexp.MustCompile(`(?m)^#{1,6}\s+`)
 	content = headings.ReplaceAllString(content, "")
 
@@ -2,6 +2,7 @@ package markdown
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -282,6 +283,38 @@ func TestNormalise_MetadataPreserved(t *testing.T) {
 	assert.Equal(t, "markdown", doc.Metadata["format"])
 }
 
+func TestNormalise_RecordsHeadings(t *testing.T) {
+	normaliser := New()
+	raw := &domain.RawDocument{
+		SourceID: "source-123",
+		URI:      "/path/to/guide.md",
+		MIMEType: "text/markdown",
+		Content: []byte("# Guide\nIntro text.\n\n## Install **now**\n" +
+			"Run it.\n\n```sh\n# not a heading\n```\n\n### Linux ###\nUse apt."),
+	}
+
+	result, err := normaliser.Normalise(context.Background(), raw)
+
+	require.NoError(t, err)
+	headings := result.Document.Metadata[domain.DocMetaHeadings]
+	assert.Equal(t, []string{"# Guide", "## Install now", "### Linux"}, headings)
+
+	// Heading text matches a line of the normalised content
+	lines := strings.Split(result.Document.Content, "\n")
+	for _, text := range []string{"Guide", "Install now", "Linux"} {
+		assert.Contains(t, lines, text)
+	}
+}
+
+func TestNormalise_NoHeadings(t *testing.T) {
+	raw := &domain.RawDocument{URI: "notes.md", Content: []byte("Just a paragraph.")}
+
+	result, err := New().Normalise(context.Background(), raw)
+
+	require.NoError(t, err)
+	assert.NotContains(t, result.Document.Metadata, domain.DocMetaHeadings)
+}
+
 func TestInterfaceCompliance(t *testing.T) {
 	var _ driven.Normaliser = (*Normaliser)(nil)
 }
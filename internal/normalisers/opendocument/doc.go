@@ -0,0 +1,4 @@
+// Package opendocument provides a Normaliser implementation for OpenDocument
+// text, spreadsheet and presentation files (ODT, ODS and ODP). It extracts
+// text content from the content.xml file within the ZIP container.
+package opendocument
@@ -0,0 +1,420 @@
+package opendocument
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Ensure Normaliser implements the interface.
+var _ driven.Normaliser = (*Normaliser)(nil)
+
+// OpenDocument MIME types.
+const (
+	MIMETypeText         = "application/vnd.oasis.opendocument.text"
+	MIMETypeSpreadsheet  = "application/vnd.oasis.opendocument.spreadsheet"
+	MIMETypePresentation = "application/vnd.oasis.opendocument.presentation"
+)
+
+// XML namespaces of the content.xml elements the normaliser reads.
+const (
+	nsText         = "urn:oasis:names:tc:opendocument:xmlns:text:1.0"
+	nsTable        = "urn:oasis:names:tc:opendocument:xmlns:table:1.0"
+	nsDrawing      = "urn:oasis:names:tc:opendocument:xmlns:drawing:1.0"
+	nsPresentation = "urn:oasis:names:tc:opendocument:xmlns:presentation:1.0"
+)
+
+// maxRepeat caps how many times a repeated cell or space is written, as
+// spreadsheets may repeat an empty cell across thousands of columns.
+const maxRepeat = 100
+
+// formats maps each supported MIME type to its format name.
+var formats = map[string]string{
+	MIMETypeText:         "odt",
+	MIMETypeSpreadsheet:  "ods",
+	MIMETypePresentation: "odp",
+}
+
+// Normaliser handles OpenDocument files.
+type Normaliser struct{}
+
+// New creates a new OpenDocument normaliser.
+func New() *Normaliser {
+	return &Normaliser{}
+}
+
+// SupportedMIMETypes returns the MIME types this normaliser handles.
+func (n *Normaliser) SupportedMIMETypes() []string {
+	return []string{
+		MIMETypeText,
+		MIMETypeSpreadsheet,
+		MIMETypePresentation,
+	}
+}
+
+// SupportedConnectorTypes returns connector types for specialised handling.
+func (n *Normaliser) SupportedConnectorTypes() []string {
+	return nil // All connectors
+}
+
+// Priority returns the selection priority.
+func (n *Normaliser) Priority() int {
+	return 50 // Generic MIME normaliser
+}
+
+// Normalise converts an OpenDocument file to a normalised document.
+func (n *Normaliser) Normalise(_ context.Context, raw *domain.RawDocument) (*driven.NormaliseResult, error) {
+	if raw == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	// Open as ZIP archive
+	reader, err := zip.NewReader(bytes.NewReader(raw.Content), int64(len(raw.Content)))
+	if err != nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	// Extract text content from content.xml
+	content, err := extractContentText(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract title from meta.xml or fall back to filename
+	title := extractTitle(reader, raw.URI)
+
+	// Build document
+	doc := domain.Document{
+		ID:        uuid.New().String(),
+		SourceID:  raw.SourceID,
+		URI:       raw.URI,
+		Title:     title,
+		Content:   content,
+		Metadata:  copyMetadata(raw.Metadata),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if doc.Metadata == nil {
+		doc.Metadata = make(map[string]any)
+	}
+	doc.Metadata["mime_type"] = raw.MIMEType
+	if format, ok := formats[raw.MIMEType]; ok {
+		doc.Metadata["format"] = format
+	}
+
+	return &driven.NormaliseResult{
+		Document: doc,
+	}, nil
+}
+
+// readFile returns the contents of the named file in the archive, or nil
+// if the archive has no such file.
+func readFile(reader *zip.Reader, name string) ([]byte, error) {
+	for _, file := range reader.File {
+		if file.Name != name {
+			continue
+		}
+
+		rc, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+
+		return io.ReadAll(rc)
+	}
+	return nil, nil
+}
+
+// extractContentText extracts text from content.xml. Embedded objects are
+// stored in their own subdirectories of the archive and are not read.
+func extractContentText(reader *zip.Reader) (string, error) {
+	content, err := readFile(reader, "content.xml")
+	if err != nil {
+		return "", domain.ErrInvalidInput
+	}
+	if content == nil {
+		return "", nil
+	}
+	return parseContentXML(content), nil
+}
+
+// tableRow collects the cells of a table row as it is parsed.
+type tableRow struct {
+	cells  []string
+	cell   []string // paragraphs of the current cell
+	repeat int      // times the current cell is repeated
+}
+
+// contentParser walks content.xml, collecting paragraphs as lines of text
+// and table rows as tab-separated lines.
+type contentParser struct {
+	lines      []string
+	paragraphs []*strings.Builder // open paragraphs, innermost last
+	rows       []*tableRow        // open table rows, innermost last
+}
+
+// parseContentXML extracts the text of content.xml. Paragraphs and headings
+// become lines, and each table row becomes a line of tab-separated cells.
+// Malformed XML yields the text parsed before the error.
+func parseContentXML(content []byte) string {
+	p := &contentParser{}
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if skipElement(t.Name) {
+				if err := decoder.Skip(); err != nil {
+					return p.text()
+				}
+				continue
+			}
+			p.start(t)
+		case xml.EndElement:
+			p.end(t)
+		case xml.CharData:
+			p.write(string(t))
+		}
+	}
+
+	return p.text()
+}
+
+// skipElement reports whether an element's subtree holds no document text:
+// embedded objects, images, and deleted text kept for change tracking.
+func skipElement(name xml.Name) bool {
+	switch name.Space {
+	case nsDrawing:
+		switch name.Local {
+		case "object", "object-ole", "image":
+			return true
+		}
+	case nsText:
+		return name.Local == "tracked-changes"
+	}
+	return false
+}
+
+// start handles an opening element.
+func (p *contentParser) start(el xml.StartElement) {
+	switch el.Name.Space {
+	case nsText:
+		switch el.Name.Local {
+		case "p", "h":
+			p.paragraphs = append(p.paragraphs, &strings.Builder{})
+		case "s":
+			count := attrInt(el, nsText, "c", 1)
+			p.write(strings.Repeat(" ", min(count, maxRepeat)))
+		case "tab":
+			p.write("\t")
+		case "line-break":
+			p.write("\n")
+		}
+	case nsTable:
+		switch el.Name.Local {
+		case "table-row":
+			p.rows = append(p.rows, &tableRow{})
+		case "table-cell", "covered-table-cell":
+			if row := p.row(); row != nil {
+				row.repeat = attrInt(el, nsTable, "number-columns-repeated", 1)
+			}
+		}
+	}
+}
+
+// end handles a closing element.
+func (p *contentParser) end(el xml.EndElement) {
+	switch el.Name.Space {
+	case nsText:
+		if el.Name.Local == "p" || el.Name.Local == "h" {
+			p.endParagraph()
+		}
+	case nsTable:
+		switch el.Name.Local {
+		case "table-cell", "covered-table-cell":
+			p.endCell()
+		case "table-row":
+			p.endRow()
+		case "table":
+			// Separate tables, e.g. spreadsheet sheets, with a blank line
+			p.lines = append(p.lines, "")
+		}
+	case nsDrawing:
+		// Separate slides with a blank line
+		if el.Name.Local == "page" {
+			p.lines = append(p.lines, "")
+		}
+	case nsPresentation:
+		if el.Name.Local == "notes" {
+			p.lines = append(p.lines, "")
+		}
+	}
+}
+
+// write appends text to the innermost open paragraph. Text outside
+// paragraphs is formatting whitespace and is dropped.
+func (p *contentParser) write(text string) {
+	if len(p.paragraphs) == 0 {
+		return
+	}
+	p.paragraphs[len(p.paragraphs)-1].WriteString(text)
+}
+
+// endParagraph closes the innermost paragraph, adding it to the current
+// table cell if inside a table and to the lines otherwise.
+func (p *contentParser) endParagraph() {
+	if len(p.paragraphs) == 0 {
+		return
+	}
+	text := strings.TrimSpace(p.paragraphs[len(p.paragraphs)-1].String())
+	p.paragraphs = p.paragraphs[:len(p.paragraphs)-1]
+	if text == "" {
+		return
+	}
+	if row := p.row(); row != nil {
+		row.cell = append(row.cell, text)
+		return
+	}
+	p.lines = append(p.lines, text)
+}
+
+// endCell closes the current table cell, adding its value once for each
+// column it is repeated across.
+func (p *contentParser) endCell() {
+	row := p.row()
+	if row == nil {
+		return
+	}
+	value := strings.Join(row.cell, " ")
+	for range min(max(row.repeat, 1), maxRepeat) {
+		row.cells = append(row.cells, value)
+	}
+	row.cell = nil
+	row.repeat = 0
+}
+
+// endRow closes the innermost table row, adding its cells as a line with
+// trailing empty cells removed. Rows with no text are dropped.
+func (p *contentParser) endRow() {
+	row := p.row()
+	if row == nil {
+		return
+	}
+	p.rows = p.rows[:len(p.rows)-1]
+
+	cells := row.cells
+	for len(cells) > 0 && cells[len(cells)-1] == "" {
+		cells = cells[:len(cells)-1]
+	}
+	if len(cells) == 0 {
+		return
+	}
+	line := strings.Join(cells, "\t")
+	if outer := p.row(); outer != nil {
+		outer.cell = append(outer.cell, line)
+		return
+	}
+	p.lines = append(p.lines, line)
+}
+
+// row returns the innermost open table row, or nil outside tables.
+func (p *contentParser) row() *tableRow {
+	if len(p.rows) == 0 {
+		return nil
+	}
+	return p.rows[len(p.rows)-1]
+}
+
+// text returns the collected lines, collapsing runs of blank lines.
+func (p *contentParser) text() string {
+	var result strings.Builder
+	blank := false
+	for _, line := range p.lines {
+		if line == "" {
+			blank = true
+			continue
+		}
+		if result.Len() > 0 {
+			if blank {
+				result.WriteString("\n")
+			}
+			result.WriteString("\n")
+		}
+		blank = false
+		result.WriteString(line)
+	}
+	return result.String()
+}
+
+// attrInt returns the value of a numeric attribute, or def if the element
+// has no such attribute or its value is not a positive integer.
+func attrInt(el xml.StartElement, space, local string, def int) int {
+	for _, attr := range el.Attr {
+		if attr.Name.Space != space || attr.Name.Local != local {
+			continue
+		}
+		if n, err := strconv.Atoi(attr.Value); err == nil && n > 0 {
+			return n
+		}
+	}
+	return def
+}
+
+// metaXML represents the structure of meta.xml.
+type metaXML struct {
+	Meta struct {
+		Title string `xml:"http://purl.org/dc/elements/1.1/ title"`
+	} `xml:"urn:oasis:names:tc:opendocument:xmlns:office:1.0 meta"`
+}
+
+// extractTitle extracts the title from meta.xml or falls back to filename.
+func extractTitle(reader *zip.Reader, uri string) string {
+	if content, err := readFile(reader, "meta.xml"); err == nil && content != nil {
+		var meta metaXML
+		if err := xml.Unmarshal(content, &meta); err == nil {
+			if title := strings.TrimSpace(meta.Meta.Title); title != "" {
+				return title
+			}
+		}
+	}
+
+	// Fall back to filename
+	filename := filepath.Base(uri)
+	ext := filepath.Ext(filename)
+	if ext != "" {
+		filename = strings.TrimSuffix(filename, ext)
+	}
+	filename = strings.ReplaceAll(filename, "_", " ")
+	filename = strings.ReplaceAll(filename, "-", " ")
+	return filename
+}
+
+// copyMetadata creates a shallow copy of metadata.
+func copyMetadata(src map[string]any) map[string]any {
+	if src == nil {
+		return nil
+	}
+	dst := make(map[string]any, len(src))
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
@@ -0,0 +1,351 @@
+package opendocument
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// Namespace declarations used by the content.xml fixtures.
+const contentNamespaces = `xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" ` +
+	`xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0" ` +
+	`xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0" ` +
+	`xmlns:draw="urn:oasis:names:tc:opendocument:xmlns:drawing:1.0" ` +
+	`xmlns:presentation="urn:oasis:names:tc:opendocument:xmlns:presentation:1.0" ` +
+	`xmlns:xlink="http://www.w3.org/1999/xlink"`
+
+// odtContentXML is the content.xml of a LibreOffice Writer document with a
+// heading, formatted paragraphs, a table, a tracked deletion and an
+// embedded chart.
+const odtContentXML = `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-content ` + contentNamespaces + ` office:version="1.3">
+<office:body>
+<office:text>
+<text:tracked-changes>
+<text:changed-region text:id="ct1">
+<text:deletion><text:p>Deleted draft text</text:p></text:deletion>
+</text:changed-region>
+</text:tracked-changes>
+<text:h text:outline-level="1">Quarterly Report</text:h>
+<text:p text:style-name="P1">Revenue grew<text:s text:c="2"/>by ` +
+	`<text:span text:style-name="T1">12%</text:span>.</text:p>
+<text:p>First line<text:line-break/>Second line</text:p>
+<text:list><text:list-item><text:p>Bullet point</text:p></text:list-item></text:list>
+<table:table table:name="Table1">
+<table:table-column table:number-columns-repeated="2"/>
+<table:table-row>
+<table:table-cell office:value-type="string"><text:p>Region</text:p></table:table-cell>
+<table:table-cell office:value-type="string"><text:p>Sales</text:p></table:table-cell>
+</table:table-row>
+</table:table>
+<text:p><draw:frame draw:name="Chart1"><draw:object xlink:href="./Object 1">` +
+	`<text:p>Embedded chart text</text:p></draw:object></draw:frame>After chart</text:p>
+</office:text>
+</office:body>
+</office:document-content>`
+
+// odsContentXML is the content.xml of a LibreOffice Calc spreadsheet with
+// two sheets, repeated cells and trailing empty columns.
+const odsContentXML = `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-content ` + contentNamespaces + ` office:version="1.3">
+<office:body>
+<office:spreadsheet>
+<table:table table:name="Budget">
+<table:table-column table:number-columns-repeated="3"/>
+<table:table-row>
+<table:table-cell office:value-type="string"><text:p>Item</text:p></table:table-cell>
+<table:table-cell office:value-type="string"><text:p>Cost</text:p></table:table-cell>
+<table:table-cell table:number-columns-repeated="1021"/>
+</table:table-row>
+<table:table-row>
+<table:table-cell office:value-type="string"><text:p>Rent</text:p></table:table-cell>
+<table:table-cell office:value-type="float" office:value="1200"><text:p>1200</text:p></table:table-cell>
+<table:table-cell table:number-columns-repeated="1021"/>
+</table:table-row>
+<table:table-row>
+<table:table-cell table:number-columns-repeated="2" office:value-type="string"><text:p>TBD</text:p></table:table-cell>
+</table:table-row>
+<table:table-row table:number-rows-repeated="1048573">
+<table:table-cell table:number-columns-repeated="1024"/>
+</table:table-row>
+</table:table>
+<table:table table:name="Notes">
+<table:table-row>
+<table:table-cell/>
+<table:table-cell office:value-type="string"><text:p>Review in May</text:p></table:table-cell>
+</table:table-row>
+</table:table>
+</office:spreadsheet>
+</office:body>
+</office:document-content>`
+
+// odpContentXML is the content.xml of a LibreOffice Impress presentation
+// with two slides.
+const odpContentXML = `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-content ` + contentNamespaces + ` office:version="1.3">
+<office:body>
+<office:presentation>
+<draw:page draw:name="page1">
+<draw:frame presentation:class="title"><draw:text-box><text:p>Welcome</text:p></draw:text-box></draw:frame>
+</draw:page>
+<draw:page draw:name="page2">
+<draw:frame><draw:text-box><text:p>Agenda</text:p></draw:text-box></draw:frame>
+<draw:frame><draw:image xlink:href="Pictures/logo.png"><text:p>Logo</text:p></draw:image></draw:frame>
+</draw:page>
+</office:presentation>
+</office:body>
+</office:document-content>`
+
+// metaXMLWithTitle returns a meta.xml declaring title.
+func metaXMLWithTitle(title string) string {
+	return `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-meta xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" ` +
+		`xmlns:dc="http://purl.org/dc/elements/1.1/" office:version="1.3">
+<office:meta><dc:title>` + title + `</dc:title></office:meta>
+</office:document-meta>`
+}
+
+// createTestODF creates a minimal OpenDocument package in memory. Empty
+// contentXML or metaXML omits that file.
+func createTestODF(mimeType, contentXML, metaXML string) []byte {
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+
+	// The mimetype file comes first, uncompressed
+	mt, _ := w.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	mt.Write([]byte(mimeType))
+
+	if contentXML != "" {
+		content, _ := w.Create("content.xml")
+		content.Write([]byte(contentXML))
+	}
+
+	if metaXML != "" {
+		meta, _ := w.Create("meta.xml")
+		meta.Write([]byte(metaXML))
+	}
+
+	// Embedded objects live in their own directories and are not indexed
+	object, _ := w.Create("Object 1/content.xml")
+	object.Write([]byte(`<office:document-content ` + contentNamespaces + `>` +
+		`<office:body><office:chart><text:p>Chart internals</text:p></office:chart></office:body>` +
+		`</office:document-content>`))
+
+	w.Close()
+	return buf.Bytes()
+}
+
+func TestNew(t *testing.T) {
+	normaliser := New()
+	require.NotNil(t, normaliser)
+	assert.IsType(t, &Normaliser{}, normaliser)
+}
+
+func TestSupportedMIMETypes(t *testing.T) {
+	normaliser := New()
+	mimeTypes := normaliser.SupportedMIMETypes()
+
+	assert.ElementsMatch(t, []string{
+		"application/vnd.oasis.opendocument.text",
+		"application/vnd.oasis.opendocument.spreadsheet",
+		"application/vnd.oasis.opendocument.presentation",
+	}, mimeTypes)
+}
+
+func TestSupportedConnectorTypes(t *testing.T) {
+	normaliser := New()
+	assert.Nil(t, normaliser.SupportedConnectorTypes())
+}
+
+func TestPriority(t *testing.T) {
+	normaliser := New()
+	assert.Equal(t, 50, normaliser.Priority())
+}
+
+func TestNormalise_TextDocument(t *testing.T) {
+	normaliser := New()
+
+	raw := &domain.RawDocument{
+		SourceID: "test-source",
+		URI:      "/path/to/report.odt",
+		MIMEType: MIMETypeText,
+		Content:  createTestODF(MIMETypeText, odtContentXML, metaXMLWithTitle("Q3 Report")),
+	}
+
+	result, err := normaliser.Normalise(context.Background(), raw)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	doc := result.Document
+	assert.NotEmpty(t, doc.ID)
+	assert.Equal(t, raw.SourceID, doc.SourceID)
+	assert.Equal(t, raw.URI, doc.URI)
+	assert.Equal(t, "Q3 Report", doc.Title)
+	assert.Equal(t, "Quarterly Report\n"+
+		"Revenue grew  by 12%.\n"+
+		"First line\nSecond line\n"+
+		"Bullet point\n"+
+		"Region\tSales\n\n"+
+		"After chart", doc.Content)
+	assert.NotContains(t, doc.Content, "Deleted draft text")
+	assert.NotContains(t, doc.Content, "Embedded chart text")
+	assert.NotContains(t, doc.Content, "Chart internals")
+	assert.Equal(t, MIMETypeText, doc.Metadata["mime_type"])
+	assert.Equal(t, "odt", doc.Metadata["format"])
+}
+
+func TestNormalise_Spreadsheet(t *testing.T) {
+	normaliser := New()
+
+	raw := &domain.RawDocument{
+		SourceID: "test-source",
+		URI:      "/path/to/budget.ods",
+		MIMEType: MIMETypeSpreadsheet,
+		Content:  createTestODF(MIMETypeSpreadsheet, odsContentXML, metaXMLWithTitle("Household Budget")),
+	}
+
+	result, err := normaliser.Normalise(context.Background(), raw)
+	require.NoError(t, err)
+
+	doc := result.Document
+	assert.Equal(t, "Household Budget", doc.Title)
+	assert.Equal(t, "Item\tCost\n"+
+		"Rent\t1200\n"+
+		"TBD\tTBD\n\n"+
+		"\tReview in May", doc.Content)
+	assert.Equal(t, "ods", doc.Metadata["format"])
+}
+
+func TestNormalise_Presentation(t *testing.T) {
+	normaliser := New()
+
+	raw := &domain.RawDocument{
+		SourceID: "test-source",
+		URI:      "/path/to/slides.odp",
+		MIMEType: MIMETypePresentation,
+		Content:  createTestODF(MIMETypePresentation, odpContentXML, ""),
+	}
+
+	result, err := normaliser.Normalise(context.Background(), raw)
+	require.NoError(t, err)
+
+	doc := result.Document
+	assert.Equal(t, "Welcome\n\nAgenda", doc.Content)
+	assert.Equal(t, "slides", doc.Title)
+	assert.Equal(t, "odp", doc.Metadata["format"])
+}
+
+func TestNormalise_NilDocument(t *testing.T) {
+	normaliser := New()
+
+	result, err := normaliser.Normalise(context.Background(), nil)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestNormalise_InvalidZip(t *testing.T) {
+	normaliser := New()
+
+	raw := &domain.RawDocument{
+		SourceID: "test-source",
+		URI:      "/path/to/document.odt",
+		MIMEType: MIMETypeText,
+		Content:  []byte("not a zip file"),
+	}
+
+	result, err := normaliser.Normalise(context.Background(), raw)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestNormalise_MissingContent(t *testing.T) {
+	normaliser := New()
+
+	raw := &domain.RawDocument{
+		SourceID: "test-source",
+		URI:      "/path/to/empty.odt",
+		MIMEType: MIMETypeText,
+		Content:  createTestODF(MIMETypeText, "", ""),
+	}
+
+	result, err := normaliser.Normalise(context.Background(), raw)
+	require.NoError(t, err)
+	assert.Empty(t, result.Document.Content)
+}
+
+func TestNormalise_MalformedContentKeepsParsedText(t *testing.T) {
+	normaliser := New()
+
+	contentXML := `<office:document-content ` + contentNamespaces + `><office:body><office:text>` +
+		`<text:p>Recovered</text:p><text:p>Truncated`
+
+	raw := &domain.RawDocument{
+		SourceID: "test-source",
+		URI:      "/path/to/broken.odt",
+		MIMEType: MIMETypeText,
+		Content:  createTestODF(MIMETypeText, contentXML, ""),
+	}
+
+	result, err := normaliser.Normalise(context.Background(), raw)
+	require.NoError(t, err)
+	assert.Equal(t, "Recovered", result.Document.Content)
+}
+
+func TestNormalise_TitleFallbackToFilename(t *testing.T) {
+	tests := []struct {
+		name    string
+		metaXML string
+	}{
+		{name: "no meta.xml"},
+		{name: "empty title", metaXML: metaXMLWithTitle("  ")},
+		{name: "malformed meta.xml", metaXML: "<office:document-meta"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := &domain.RawDocument{
+				SourceID: "test-source",
+				URI:      "/path/to/meeting_notes-2024.odt",
+				MIMEType: MIMETypeText,
+				Content:  createTestODF(MIMETypeText, odtContentXML, tt.metaXML),
+			}
+
+			result, err := New().Normalise(context.Background(), raw)
+			require.NoError(t, err)
+			assert.Equal(t, "meeting notes 2024", result.Document.Title)
+		})
+	}
+}
+
+func TestNormalise_MetadataPreserved(t *testing.T) {
+	normaliser := New()
+
+	raw := &domain.RawDocument{
+		SourceID: "test-source",
+		URI:      "/path/to/document.odt",
+		MIMEType: MIMETypeText,
+		Content:  createTestODF(MIMETypeText, odtContentXML, ""),
+		Metadata: map[string]any{
+			"author": "test-author",
+		},
+	}
+
+	result, err := normaliser.Normalise(context.Background(), raw)
+	require.NoError(t, err)
+
+	doc := result.Document
+	assert.Equal(t, "test-author", doc.Metadata["author"])
+	assert.Equal(t, "odt", doc.Metadata["format"])
+	assert.NotContains(t, raw.Metadata, "format")
+}
+
+func TestInterfaceCompliance(t *testing.T) {
+	var _ driven.Normaliser = (*Normaliser)(nil)
+}
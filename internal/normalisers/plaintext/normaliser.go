@@ -41,12 +41,23 @@ func (n *Normaliser) SupportedMIMETypes() []string {
 		"text/toml",
 		"text/javascript",
 		"text/jsx",
+		"text/javascript-jsx",
 		"text/typescript",
 		"text/typescript-jsx",
+		"text/x-kotlin",
+		"text/x-swift",
+		"text/x-php",
+		"text/x-vue",
+		"text/x-svelte",
 		"text/css",
 		"text/html",
 		"application/json",
 		"application/xml",
+		"application/javascript",
+		"application/typescript",
+		"application/x-yaml",
+		"application/x-sh",
+		"application/sql",
 		"image/svg+xml",
 	}
 }
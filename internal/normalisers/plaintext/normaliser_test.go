@@ -25,6 +25,8 @@ func TestSupportedMIMETypes(t *testing.T) {
 	assert.Contains(t, mimeTypes, "text/plain")
 	assert.Contains(t, mimeTypes, "text/x-go")
 	assert.Contains(t, mimeTypes, "application/json")
+	assert.Contains(t, mimeTypes, "application/javascript")
+	assert.Contains(t, mimeTypes, "text/x-kotlin")
 }
 
 func TestSupportedConnectorTypes(t *testing.T) {
@@ -15,6 +15,7 @@ import (
 	"github.com/custodia-labs/sercha-cli/internal/normalisers/ics"
 	"github.com/custodia-labs/sercha-cli/internal/normalisers/markdown"
 	"github.com/custodia-labs/sercha-cli/internal/normalisers/notion"
+	"github.com/custodia-labs/sercha-cli/internal/normalisers/opendocument"
 	"github.com/custodia-labs/sercha-cli/internal/normalisers/pdf"
 	"github.com/custodia-labs/sercha-cli/internal/normalisers/plaintext"
 )
@@ -31,22 +32,36 @@ type Registry struct {
 
 // NewRegistry creates a new normaliser registry with default normalisers.
 func NewRegistry() *Registry {
+	return NewRegistryWithConfig(domain.DefaultNormaliserConfig())
+}
+
+// NewRegistryWithConfig creates a new normaliser registry with default
+// normalisers using the given configuration.
+func NewRegistryWithConfig(cfg domain.NormaliserConfig) *Registry {
 	r := &Registry{
 		normalisers: make([]driven.Normaliser, 0),
 		byMIME:      make(map[string][]driven.Normaliser),
 	}
+
+	emlNormaliser := eml.New()
+	emlNormaliser.SetFallbackTitle(cfg.EmailFallbackTitle)
+	icsNormaliser := ics.New()
+	icsNormaliser.SetFallbackTitle(cfg.EventFallbackTitle)
+
 	// Register default normalisers
 	r.Register(docx.New())
-	r.Register(eml.New())
+	r.Register(emlNormaliser)
 	r.Register(html.New())
-	r.Register(ics.New())
+	r.Register(icsNormaliser)
 	r.Register(markdown.New())
+	r.Register(opendocument.New())
 	r.Register(pdf.New())
 	r.Register(plaintext.New())
 
 	// Register GitHub-specific normalisers
 	r.Register(github.NewIssue())
 	r.Register(github.NewPull())
+	r.Register(github.NewDiscussion())
 
 	// Register Notion-specific normalisers
 	r.Register(notion.NewPage())
@@ -56,7 +56,7 @@ func TestNewRegistry(t *testing.T) {
 
 	// Verify default normalisers are registered
 	assert.NotEmpty(t, registry.normalisers, "registry should have default normalisers")
-	assert.Equal(t, 12, len(registry.normalisers), "should have 12 default normalisers (docx, eml, html, ics, markdown, pdf, plaintext, github-issue, github-pull, notion-page, notion-database, notion-database-item)")
+	assert.Equal(t, 14, len(registry.normalisers), "should have 14 default normalisers (docx, eml, html, ics, markdown, opendocument, pdf, plaintext, github-issue, github-pull, github-discussion, notion-page, notion-database, notion-database-item)")
 
 	// Verify MIME types are indexed
 	supportedTypes := registry.SupportedMIMETypes()
@@ -65,6 +65,7 @@ func TestNewRegistry(t *testing.T) {
 	// Check for expected MIME types from default normalisers
 	expectedTypes := map[string]bool{
 		"application/vnd.openxmlformats-officedocument.wordprocessingml.document": true,
+		"application/vnd.oasis.opendocument.text":                                 true,
 		"application/pdf":  true,
 		"message/rfc822":   true,
 		"text/calendar":    true,
@@ -80,6 +81,32 @@ func TestNewRegistry(t *testing.T) {
 	}
 }
 
+// TestNewRegistryWithConfig verifies fallback titles are passed to normalisers.
+func TestNewRegistryWithConfig(t *testing.T) {
+	registry := NewRegistryWithConfig(domain.NormaliserConfig{
+		EmailFallbackTitle: "Untitled email",
+		EventFallbackTitle: "Event on {date}",
+	})
+	ctx := context.Background()
+
+	email, err := registry.Normalise(ctx, &domain.RawDocument{
+		URI:      "gmail://messages/abc",
+		MIMEType: "message/rfc822",
+		Content:  []byte("From: a@example.com\n\nHello\n"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Untitled email", email.Document.Title)
+
+	event, err := registry.Normalise(ctx, &domain.RawDocument{
+		URI:      "gcal://primary/events/abc",
+		MIMEType: "text/calendar",
+		Content:  []byte("Location: Room 1"),
+		Metadata: map[string]any{"start_time": "2024-01-15"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Event on January 15, 2024", event.Document.Title)
+}
+
 // TestRegistryRegister verifies that normalisers can be registered.
 func TestRegistryRegister(t *testing.T) {
 	registry := NewRegistry()
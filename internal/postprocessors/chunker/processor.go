@@ -1,4 +1,5 @@
-// Package chunker provides a fixed-size text chunking processor.
+// Package chunker provides a text chunking processor with fixed-size,
+// sentence-aware and heading-aware strategies.
 package chunker
 
 import (
@@ -15,11 +16,36 @@ const DefaultChunkSize = 1000
 // DefaultChunkOverlap is the default number of overlapping characters.
 const DefaultChunkOverlap = 200
 
-// Processor splits document content into fixed-size chunks.
+// Strategy is how content is split into chunks.
+type Strategy string
+
+const (
+	// StrategyFixed cuts content into windows of the chunk size, each
+	// overlapping the previous one by the overlap.
+	StrategyFixed Strategy = "fixed"
+	// StrategySentence packs whole sentences into chunks of up to the chunk
+	// size, preferring to end a chunk at a paragraph break, and repeats
+	// trailing sentences of up to the overlap in the next chunk. Sentences
+	// longer than a chunk are cut.
+	StrategySentence Strategy = "sentence"
+	// StrategyHeading splits content into sections at headings, then packs
+	// each section's sentences like StrategySentence. Chunks never span
+	// sections and record their heading trail in
+	// Metadata[domain.ChunkMetaSection].
+	StrategyHeading Strategy = "heading"
+)
+
+// IsValid returns true if the strategy is recognised.
+func (s Strategy) IsValid() bool {
+	return s == StrategyFixed || s == StrategySentence || s == StrategyHeading
+}
+
+// Processor splits document content into chunks.
 // It implements the PostProcessor interface.
 type Processor struct {
 	chunkSize int
 	overlap   int
+	strategy  Strategy
 }
 
 // Option configures the chunker processor.
@@ -43,11 +69,21 @@ func WithOverlap(overlap int) Option {
 	}
 }
 
+// WithStrategy sets how content is split into chunks.
+func WithStrategy(s Strategy) Option {
+	return func(p *Processor) {
+		if s.IsValid() {
+			p.strategy = s
+		}
+	}
+}
+
 // New creates a new chunker processor with the given options.
 func New(opts ...Option) *Processor {
 	p := &Processor{
 		chunkSize: DefaultChunkSize,
 		overlap:   DefaultChunkOverlap,
+		strategy:  StrategyFixed,
 	}
 
 	for _, opt := range opts {
@@ -69,49 +105,49 @@ func (p *Processor) Name() string {
 
 // Process splits the document content into chunks.
 // Input chunks are ignored; this processor creates new chunks from document content.
-func (p *Processor) Process(ctx context.Context, doc *domain.Document, _ []domain.Chunk) ([]domain.Chunk, error) {
+func (p *Processor) Process(_ context.Context, doc *domain.Document, _ []domain.Chunk) ([]domain.Chunk, error) {
 	if doc.Content == "" {
 		// Empty content produces no chunks
 		return nil, nil
 	}
 
-	content := doc.Content
-	contentLen := len(content)
-
-	// Estimate number of chunks
-	estimatedChunks := (contentLen / (p.chunkSize - p.overlap)) + 1
-	chunks := make([]domain.Chunk, 0, estimatedChunks)
-
-	position := 0
-	start := 0
-
-	for start < contentLen {
-		end := start + p.chunkSize
-		if end > contentLen {
-			end = contentLen
+	switch p.strategy {
+	case StrategySentence:
+		return p.chunks(doc, p.sentenceSpans(doc.Content, 0, len(doc.Content)), ""), nil
+	case StrategyHeading:
+		var chunks []domain.Chunk
+		for _, sec := range sections(doc) {
+			spans := p.sentenceSpans(doc.Content, sec.start, sec.end)
+			for _, chunk := range p.chunks(doc, spans, sec.trail) {
+				chunk.Position = len(chunks)
+				chunks = append(chunks, chunk)
+			}
 		}
+		return chunks, nil
+	default:
+		return p.chunks(doc, p.fixedSpans(len(doc.Content)), ""), nil
+	}
+}
 
-		chunkContent := content[start:end]
-
+// chunks creates a chunk for each span of the document's content, tagged
+// with the section trail if not empty.
+func (p *Processor) chunks(doc *domain.Document, spans []span, trail string) []domain.Chunk {
+	chunks := make([]domain.Chunk, 0, len(spans))
+	for i, s := range spans {
 		chunk := domain.Chunk{
 			ID:         uuid.New().String(),
 			DocumentID: doc.ID,
-			Content:    chunkContent,
-			Position:   position,
-			Metadata:   make(map[string]any),
+			Content:    doc.Content[s.start:s.end],
+			Position:   i,
+			Metadata: map[string]any{
+				domain.ChunkMetaStartOffset: s.start,
+				domain.ChunkMetaEndOffset:   s.end,
+			},
 		}
-
-		chunks = append(chunks, chunk)
-		position++
-
-		// Move start forward by (chunkSize - overlap)
-		start += p.chunkSize - p.overlap
-
-		// Avoid infinite loop for edge cases
-		if p.chunkSize <= p.overlap {
-			break
+		if trail != "" {
+			chunk.Metadata[domain.ChunkMetaSection] = trail
 		}
+		chunks = append(chunks, chunk)
 	}
-
-	return chunks, nil
+	return chunks
 }
@@ -2,8 +2,10 @@ package chunker
 
 import (
 	"context"
+	"slices"
 	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
 )
@@ -237,3 +239,334 @@ func TestProcessor_Process_MetadataInitialized(t *testing.T) {
 		}
 	}
 }
+
+func TestProcessor_Process_RecordsOffsets(t *testing.T) {
+	p := New(WithChunkSize(10), WithOverlap(2))
+
+	doc := &domain.Document{
+		ID:      "test-doc",
+		Content: "abcdefghijklmnopqrstuvwxyz",
+	}
+
+	chunks, err := p.Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, chunk := range chunks {
+		start, ok := chunk.Metadata[domain.ChunkMetaStartOffset].(int)
+		if !ok {
+			t.Fatalf("chunk %d: missing start offset", chunk.Position)
+		}
+		end, ok := chunk.Metadata[domain.ChunkMetaEndOffset].(int)
+		if !ok {
+			t.Fatalf("chunk %d: missing end offset", chunk.Position)
+		}
+		if got := doc.Content[start:end]; got != chunk.Content {
+			t.Errorf("chunk %d: offsets [%d:%d] give %q, want %q", chunk.Position, start, end, got, chunk.Content)
+		}
+	}
+}
+
+// sampleMarkdown is a small markdown document with nested sections.
+const sampleMarkdown = `# Guide
+Sercha indexes local documents. It runs offline.
+
+## Install
+Download the binary. Put it on your PATH. Run sercha init.
+
+## Usage
+Search with sercha search. Results show snippets.`
+
+func chunkContents(chunks []domain.Chunk) []string {
+	contents := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		contents[i] = chunk.Content
+	}
+	return contents
+}
+
+func TestProcessor_Process_FixedStrategyOnMarkdown(t *testing.T) {
+	p := New(WithStrategy(StrategyFixed), WithChunkSize(60), WithOverlap(0))
+	doc := &domain.Document{ID: "test-doc", Content: sampleMarkdown}
+
+	chunks, err := p.Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := (len(sampleMarkdown) + 59) / 60
+	if len(chunks) != want {
+		t.Fatalf("expected %d chunks, got %d: %q", want, len(chunks), chunkContents(chunks))
+	}
+	for i, chunk := range chunks {
+		if chunk.Metadata[domain.ChunkMetaStartOffset] != i*60 {
+			t.Errorf("chunk %d: expected start offset %d, got %v", i, i*60, chunk.Metadata[domain.ChunkMetaStartOffset])
+		}
+	}
+}
+
+func TestProcessor_Process_SentenceStrategy(t *testing.T) {
+	p := New(WithStrategy(StrategySentence), WithChunkSize(60), WithOverlap(0))
+	doc := &domain.Document{ID: "test-doc", Content: sampleMarkdown}
+
+	chunks, err := p.Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		"# Guide\nSercha indexes local documents. It runs offline.",
+		"## Install\nDownload the binary. Put it on your PATH.",
+		"Run sercha init.\n\n## Usage\nSearch with sercha search.",
+		"Results show snippets.",
+	}
+	if got := chunkContents(chunks); !slices.Equal(got, want) {
+		t.Fatalf("unexpected chunks:\n got %q\nwant %q", got, want)
+	}
+	for _, chunk := range chunks {
+		if len(chunk.Content) > 60 {
+			t.Errorf("chunk %d exceeds chunk size: %d", chunk.Position, len(chunk.Content))
+		}
+	}
+}
+
+func TestProcessor_Process_SentenceStrategyOverlap(t *testing.T) {
+	p := New(WithStrategy(StrategySentence), WithChunkSize(35), WithOverlap(25))
+	doc := &domain.Document{ID: "test-doc", Content: "One two three. Four five six. Seven eight nine. Ten eleven."}
+
+	chunks, err := p.Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Each chunk repeats the previous chunk's last sentence
+	want := []string{
+		"One two three. Four five six.",
+		"Four five six. Seven eight nine.",
+		"Seven eight nine. Ten eleven.",
+	}
+	if got := chunkContents(chunks); !slices.Equal(got, want) {
+		t.Fatalf("unexpected chunks:\n got %q\nwant %q", got, want)
+	}
+}
+
+func TestProcessor_Process_SentenceStrategyCutsLongSentences(t *testing.T) {
+	p := New(WithStrategy(StrategySentence), WithChunkSize(10), WithOverlap(0))
+	doc := &domain.Document{ID: "test-doc", Content: strings.Repeat("é", 12) + "."}
+
+	chunks, err := p.Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var joined strings.Builder
+	for _, chunk := range chunks {
+		if len(chunk.Content) > 10 || !utf8.ValidString(chunk.Content) {
+			t.Errorf("chunk %d should be valid UTF-8 within the chunk size, got %q", chunk.Position, chunk.Content)
+		}
+		joined.WriteString(chunk.Content)
+	}
+	if joined.String() != doc.Content {
+		t.Errorf("chunks should cover the sentence, got %q", joined.String())
+	}
+}
+
+func TestProcessor_Process_SentenceStrategyKeepsAbbreviations(t *testing.T) {
+	p := New(WithStrategy(StrategySentence), WithChunkSize(40), WithOverlap(0))
+	doc := &domain.Document{ID: "test-doc", Content: "Use a flag, e.g. the device flag. Then run it."}
+
+	chunks, err := p.Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// "e.g." is followed by a lowercase word, so it does not end a sentence
+	want := []string{"Use a flag, e.g. the device flag.", "Then run it."}
+	if got := chunkContents(chunks); !slices.Equal(got, want) {
+		t.Fatalf("unexpected chunks:\n got %q\nwant %q", got, want)
+	}
+}
+
+func TestProcessor_Process_SentenceStrategyPrefersParagraphBreaks(t *testing.T) {
+	p := New(WithStrategy(StrategySentence), WithChunkSize(60), WithOverlap(20))
+	doc := &domain.Document{
+		ID:      "test-doc",
+		Content: "First paragraph here. It has two sentences.\n\nSecond one. Short.",
+	}
+
+	chunks, err := p.Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The first chunk is half full at the paragraph break, so it ends
+	// there, and the overlap is not carried into the new paragraph
+	want := []string{"First paragraph here. It has two sentences.", "Second one. Short."}
+	if got := chunkContents(chunks); !slices.Equal(got, want) {
+		t.Fatalf("unexpected chunks:\n got %q\nwant %q", got, want)
+	}
+}
+
+func TestProcessor_Process_SentenceStrategyPacksShortParagraphs(t *testing.T) {
+	p := New(WithStrategy(StrategySentence), WithChunkSize(60), WithOverlap(0))
+	doc := &domain.Document{ID: "test-doc", Content: "Short.\n\nAlso short.\n\nStill short."}
+
+	chunks, err := p.Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Paragraphs are only split on once a chunk is half full
+	if len(chunks) != 1 || chunks[0].Content != doc.Content {
+		t.Fatalf("expected one chunk holding all paragraphs, got %q", chunkContents(chunks))
+	}
+}
+
+func TestProcessor_Process_HeadingStrategy(t *testing.T) {
+	p := New(WithStrategy(StrategyHeading), WithChunkSize(1000))
+	doc := &domain.Document{ID: "test-doc", Content: sampleMarkdown}
+
+	chunks, err := p.Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []struct {
+		content string
+		section string
+	}{
+		{"# Guide\nSercha indexes local documents. It runs offline.", "Guide"},
+		{"## Install\nDownload the binary. Put it on your PATH. Run sercha init.", "Guide > Install"},
+		{"## Usage\nSearch with sercha search. Results show snippets.", "Guide > Usage"},
+	}
+	if len(chunks) != len(want) {
+		t.Fatalf("expected %d chunks, got %d: %q", len(want), len(chunks), chunkContents(chunks))
+	}
+	for i, w := range want {
+		if chunks[i].Content != w.content {
+			t.Errorf("chunk %d: expected content %q, got %q", i, w.content, chunks[i].Content)
+		}
+		if got := chunks[i].Metadata[domain.ChunkMetaSection]; got != w.section {
+			t.Errorf("chunk %d: expected section %q, got %v", i, w.section, got)
+		}
+		if chunks[i].Position != i {
+			t.Errorf("chunk %d: expected position %d, got %d", i, i, chunks[i].Position)
+		}
+	}
+}
+
+func TestProcessor_Process_HeadingStrategySplitsLongSections(t *testing.T) {
+	p := New(WithStrategy(StrategyHeading), WithChunkSize(40), WithOverlap(0))
+	doc := &domain.Document{ID: "test-doc", Content: sampleMarkdown}
+
+	chunks, err := p.Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []struct {
+		content string
+		section string
+	}{
+		{"# Guide\nSercha indexes local documents.", "Guide"},
+		{"It runs offline.", "Guide"},
+		{"## Install\nDownload the binary.", "Guide > Install"},
+		{"Put it on your PATH. Run sercha init.", "Guide > Install"},
+		{"## Usage\nSearch with sercha search.", "Guide > Usage"},
+		{"Results show snippets.", "Guide > Usage"},
+	}
+	if len(chunks) != len(want) {
+		t.Fatalf("expected %d chunks, got %d: %q", len(want), len(chunks), chunkContents(chunks))
+	}
+	for i, w := range want {
+		if chunks[i].Content != w.content || chunks[i].Metadata[domain.ChunkMetaSection] != w.section {
+			t.Errorf("chunk %d: expected %q in %q, got %q in %v",
+				i, w.content, w.section, chunks[i].Content, chunks[i].Metadata[domain.ChunkMetaSection])
+		}
+	}
+}
+
+func TestProcessor_Process_HeadingStrategyUsesRecordedHeadings(t *testing.T) {
+	p := New(WithStrategy(StrategyHeading))
+	// Normalised content with heading markers stripped, as the markdown
+	// and HTML normalisers produce it; metadata loaded from storage
+	doc := &domain.Document{
+		ID:      "test-doc",
+		Content: "Preamble text.\nInstall\nRun the installer.\nLinux\nUse the package.",
+		Metadata: map[string]any{
+			domain.DocMetaHeadings: []any{"# Install", "## Linux", "## Missing"},
+		},
+	}
+
+	chunks, err := p.Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []struct {
+		content string
+		section any
+	}{
+		{"Preamble text.", nil},
+		{"Install\nRun the installer.", "Install"},
+		{"Linux\nUse the package.", "Install > Linux"},
+	}
+	if len(chunks) != len(want) {
+		t.Fatalf("expected %d chunks, got %d: %q", len(want), len(chunks), chunkContents(chunks))
+	}
+	for i, w := range want {
+		if chunks[i].Content != w.content || chunks[i].Metadata[domain.ChunkMetaSection] != w.section {
+			t.Errorf("chunk %d: expected %q in %v, got %q in %v",
+				i, w.content, w.section, chunks[i].Content, chunks[i].Metadata[domain.ChunkMetaSection])
+		}
+	}
+}
+
+func TestProcessor_Process_HeadingStrategyIgnoresCodeFences(t *testing.T) {
+	p := New(WithStrategy(StrategyHeading))
+	doc := &domain.Document{ID: "test-doc", Content: "# Script\n```sh\n# not a heading\necho hi\n```"}
+
+	chunks, err := p.Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(chunks) != 1 || chunks[0].Metadata[domain.ChunkMetaSection] != "Script" {
+		t.Errorf("expected one chunk in section 'Script', got %q", chunkContents(chunks))
+	}
+}
+
+func TestProcessor_Process_StrategiesRecordOffsets(t *testing.T) {
+	for _, strategy := range []Strategy{StrategyFixed, StrategySentence, StrategyHeading} {
+		t.Run(string(strategy), func(t *testing.T) {
+			p := New(WithStrategy(strategy), WithChunkSize(30), WithOverlap(10))
+			doc := &domain.Document{ID: "test-doc", Content: sampleMarkdown}
+
+			chunks, err := p.Process(context.Background(), doc, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, chunk := range chunks {
+				start, _ := chunk.Metadata[domain.ChunkMetaStartOffset].(int)
+				end, _ := chunk.Metadata[domain.ChunkMetaEndOffset].(int)
+				if got := doc.Content[start:end]; got != chunk.Content {
+					t.Errorf("chunk %d: offsets [%d:%d] give %q, want %q", chunk.Position, start, end, got, chunk.Content)
+				}
+			}
+		})
+	}
+}
+
+func TestWithStrategy(t *testing.T) {
+	if got := New().strategy; got != StrategyFixed {
+		t.Errorf("expected default strategy %q, got %q", StrategyFixed, got)
+	}
+	if got := New(WithStrategy(StrategyHeading)).strategy; got != StrategyHeading {
+		t.Errorf("expected strategy %q, got %q", StrategyHeading, got)
+	}
+	if got := New(WithStrategy("paragraph")).strategy; got != StrategyFixed {
+		t.Errorf("invalid strategy should keep the default, got %q", got)
+	}
+}
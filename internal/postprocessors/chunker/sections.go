@@ -0,0 +1,160 @@
+package chunker
+
+import (
+	"strings"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// section is a byte range of document content under one heading.
+type section struct {
+	start, end int
+	// trail is the heading and its ancestors, e.g. "Install > Linux".
+	// Empty for content before the first heading.
+	trail string
+}
+
+// heading is a heading line found in document content.
+type heading struct {
+	offset int
+	level  int
+	text   string
+}
+
+// sections splits the document's content at its headings. Headings are
+// taken from Metadata[domain.DocMetaHeadings] when a normaliser recorded
+// them, or else from markdown ATX heading lines in the content.
+func sections(doc *domain.Document) []section {
+	var headings []heading
+	if recorded := recordedHeadings(doc.Metadata); len(recorded) > 0 {
+		headings = locateHeadings(doc.Content, recorded)
+	} else {
+		headings = markdownHeadings(doc.Content)
+	}
+
+	var secs []section
+	if len(headings) == 0 || headings[0].offset > 0 {
+		end := len(doc.Content)
+		if len(headings) > 0 {
+			end = headings[0].offset
+		}
+		secs = append(secs, section{start: 0, end: end})
+	}
+
+	var stack []heading
+	for i, h := range headings {
+		for len(stack) > 0 && stack[len(stack)-1].level >= h.level {
+			stack = stack[:len(stack)-1]
+		}
+		stack = append(stack, h)
+
+		names := make([]string, len(stack))
+		for j, ancestor := range stack {
+			names[j] = ancestor.text
+		}
+
+		end := len(doc.Content)
+		if i+1 < len(headings) {
+			end = headings[i+1].offset
+		}
+		secs = append(secs, section{start: h.offset, end: end, trail: strings.Join(names, " > ")})
+	}
+	return secs
+}
+
+// recordedHeadings returns the headings a normaliser recorded in metadata.
+// Metadata loaded from storage holds them as []any.
+func recordedHeadings(metadata map[string]any) []heading {
+	var lines []string
+	switch v := metadata[domain.DocMetaHeadings].(type) {
+	case []string:
+		lines = v
+	case []any:
+		for _, item := range v {
+			if line, ok := item.(string); ok {
+				lines = append(lines, line)
+			}
+		}
+	}
+
+	var headings []heading
+	for _, line := range lines {
+		if h, ok := parseHeading(line); ok {
+			headings = append(headings, h)
+		}
+	}
+	return headings
+}
+
+// locateHeadings finds the offset of each recorded heading in content by
+// matching whole lines in order. Headings that cannot be found are skipped.
+func locateHeadings(content string, recorded []heading) []heading {
+	var found []heading
+	next := 0
+	forEachLine(content, func(offset int, line string) {
+		line = strings.TrimSpace(line)
+		for i := next; i < len(recorded) && line != ""; i++ {
+			if recorded[i].text == line {
+				h := recorded[i]
+				h.offset = offset
+				found = append(found, h)
+				next = i + 1
+				return
+			}
+		}
+	})
+	return found
+}
+
+// markdownHeadings finds ATX heading lines in content, skipping fenced
+// code blocks.
+func markdownHeadings(content string) []heading {
+	var headings []heading
+	inFence := false
+	forEachLine(content, func(offset int, line string) {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			return
+		}
+		if inFence {
+			return
+		}
+		if h, ok := parseHeading(line); ok {
+			h.offset = offset
+			headings = append(headings, h)
+		}
+	})
+	return headings
+}
+
+// parseHeading parses a markdown ATX heading line such as "## Setup".
+func parseHeading(line string) (heading, bool) {
+	level := 0
+	for level < len(line) && line[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || level == len(line) || (line[level] != ' ' && line[level] != '\t') {
+		return heading{}, false
+	}
+	text := strings.TrimSpace(line[level:])
+	// Drop an optional closing sequence, as in "## Setup ##"
+	if trimmed := strings.TrimRight(text, "#"); trimmed != text && (trimmed == "" || strings.HasSuffix(trimmed, " ")) {
+		text = strings.TrimSpace(trimmed)
+	}
+	if text == "" {
+		return heading{}, false
+	}
+	return heading{level: level, text: text}, true
+}
+
+// forEachLine calls fn with the offset and text of each line of content.
+func forEachLine(content string, fn func(offset int, line string)) {
+	for offset := 0; offset < len(content); {
+		end := strings.IndexByte(content[offset:], '\n')
+		if end < 0 {
+			end = len(content) - offset
+		}
+		fn(offset, content[offset:offset+end])
+		offset += end + 1
+	}
+}
@@ -0,0 +1,127 @@
+package chunker
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// span is a byte range of document content.
+type span struct {
+	start, end int
+}
+
+// fixedSpans cuts content of the given length into windows of the chunk
+// size, each starting chunkSize-overlap bytes after the previous one.
+func (p *Processor) fixedSpans(contentLen int) []span {
+	step := p.chunkSize - p.overlap
+	spans := make([]span, 0, contentLen/step+1)
+	for start := 0; start < contentLen; start += step {
+		spans = append(spans, span{start: start, end: min(start+p.chunkSize, contentLen)})
+	}
+	return spans
+}
+
+// sentence is a span holding one sentence, or a piece of a long one.
+type sentence struct {
+	span
+	// paragraph reports whether a blank line precedes the sentence.
+	paragraph bool
+}
+
+// sentenceSpans packs the sentences of content[start:end] into spans of up
+// to the chunk size. Once a span is half full it ends early at a paragraph
+// break rather than running into the next paragraph. Each span after the
+// first repeats the trailing sentences of the previous span that fit within
+// the overlap, unless the new span starts a paragraph.
+func (p *Processor) sentenceSpans(content string, start, end int) []span {
+	units := p.sentences(content, start, end)
+
+	var spans []span
+	for i := 0; i < len(units); {
+		// Take as many sentences as fit, and always at least one
+		j := i + 1
+		for j < len(units) && units[j].end-units[i].start <= p.chunkSize {
+			if units[j].paragraph && units[j-1].end-units[i].start >= p.chunkSize/2 {
+				break
+			}
+			j++
+		}
+		spans = append(spans, span{start: units[i].start, end: units[j-1].end})
+		if j == len(units) {
+			break
+		}
+
+		// Start the next span at the earliest sentence within the overlap,
+		// while still moving forward
+		next := j
+		for k := j - 1; k > i && !units[j].paragraph && units[j-1].end-units[k].start <= p.overlap; k-- {
+			next = k
+		}
+		i = next
+	}
+	return spans
+}
+
+// sentences splits content[start:end] into sentences, without surrounding
+// whitespace. A sentence ends at a line break, or at '.', '!' or '?'
+// followed by whitespace and a word that does not start with a lowercase
+// letter, so abbreviations such as "e.g. this" do not end a sentence.
+// Sentences longer than the chunk size are cut into pieces of at most the
+// chunk size, on rune boundaries.
+func (p *Processor) sentences(content string, start, end int) []sentence {
+	var units []sentence
+	add := func(s, e int) {
+		for s < e && isSpace(content[s]) {
+			s++
+		}
+		for e > s && isSpace(content[e-1]) {
+			e--
+		}
+		for e-s > p.chunkSize {
+			cut := s + p.chunkSize
+			for cut > s+1 && !utf8.RuneStart(content[cut]) {
+				cut--
+			}
+			units = append(units, sentence{span: span{start: s, end: cut}})
+			s = cut
+		}
+		if s < e {
+			units = append(units, sentence{span: span{start: s, end: e}})
+		}
+	}
+
+	sentenceStart := start
+	for i := start; i < end; i++ {
+		switch content[i] {
+		case '\n':
+			add(sentenceStart, i)
+			sentenceStart = i + 1
+		case '.', '!', '?':
+			if i+1 == end || isSpace(content[i+1]) && !continuesSentence(content[i+1:end]) {
+				add(sentenceStart, i+1)
+				sentenceStart = i + 1
+			}
+		}
+	}
+	add(sentenceStart, end)
+
+	for k := 1; k < len(units); k++ {
+		gap := content[units[k-1].end:units[k].start]
+		units[k].paragraph = strings.Count(gap, "\n") >= 2
+	}
+	return units
+}
+
+// continuesSentence reports whether text, which follows sentence-ending
+// punctuation, continues the same sentence because its first word starts
+// with a lowercase letter.
+func continuesSentence(text string) bool {
+	r, _ := utf8.DecodeRuneInString(strings.TrimLeft(text, " \t"))
+	return unicode.IsLower(r)
+}
+
+// isSpace reports whether b is ASCII whitespace.
+func isSpace(b byte) bool {
+	return b < utf8.RuneSelf && unicode.IsSpace(rune(b))
+}
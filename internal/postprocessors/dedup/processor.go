@@ -0,0 +1,205 @@
+// Package dedup provides a processor that detects near-duplicate documents
+// using SimHash fingerprints.
+package dedup
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// DefaultMaxDistance is the default number of differing fingerprint bits
+// at which two documents are still considered near-duplicates.
+const DefaultMaxDistance = 3
+
+// maxAllowedDistance caps the distance so each lookup block keeps enough
+// bits to be selective.
+const maxAllowedDistance = 15
+
+// Action is what the processor does with a near-duplicate document.
+type Action string
+
+const (
+	// ActionFlag keeps the document and records the canonical document's ID
+	// in Metadata[domain.DocMetaDuplicateOf].
+	ActionFlag Action = "flag"
+	// ActionDrop skips the document by returning domain.ErrSkipDocument.
+	ActionDrop Action = "drop"
+)
+
+// IsValid returns true if the action is recognised.
+func (a Action) IsValid() bool {
+	return a == ActionFlag || a == ActionDrop
+}
+
+// entry is a fingerprinted document.
+type entry struct {
+	fingerprint uint64
+	id          string
+	sourceID    string
+	uri         string
+}
+
+// Processor detects documents whose content nearly matches one already seen.
+// The first document with given content is canonical; later near-duplicates,
+// from any source, are flagged or dropped.
+//
+// Fingerprints are kept for the processor's lifetime, which for the CLI is a
+// single sync run. Call Reset to start a new run in a long-lived process.
+// Chunks are passed through unchanged, so in drop mode the processor may run
+// before or after the chunker. It is safe for concurrent use.
+type Processor struct {
+	maxDistance int
+	action      Action
+
+	mu sync.Mutex
+	// blocks indexes entries by each of the maxDistance+1 bit ranges of
+	// their fingerprint. Fingerprints within maxDistance bits of each other
+	// agree exactly on at least one range.
+	blocks []map[uint64][]*entry
+	// byURI finds the entry a re-processed document replaces.
+	byURI map[string]*entry
+}
+
+// Option configures the dedup processor.
+type Option func(*Processor)
+
+// WithMaxDistance sets the maximum number of differing fingerprint bits for
+// two documents to count as near-duplicates. 0 matches identical content only.
+func WithMaxDistance(n int) Option {
+	return func(p *Processor) {
+		if n >= 0 && n <= maxAllowedDistance {
+			p.maxDistance = n
+		}
+	}
+}
+
+// WithAction sets what happens to near-duplicates.
+func WithAction(a Action) Option {
+	return func(p *Processor) {
+		if a.IsValid() {
+			p.action = a
+		}
+	}
+}
+
+// New creates a new dedup processor with the given options.
+func New(opts ...Option) *Processor {
+	p := &Processor{
+		maxDistance: DefaultMaxDistance,
+		action:      ActionFlag,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	p.Reset()
+	return p
+}
+
+// Name returns the processor name.
+func (p *Processor) Name() string {
+	return "dedup"
+}
+
+// Reset forgets all fingerprinted documents.
+func (p *Processor) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.blocks = make([]map[uint64][]*entry, p.maxDistance+1)
+	for i := range p.blocks {
+		p.blocks[i] = make(map[uint64][]*entry)
+	}
+	p.byURI = make(map[string]*entry)
+}
+
+// Process fingerprints the document and checks it against those seen so far.
+// A near-duplicate is flagged with the canonical document's ID, or dropped
+// with an error wrapping domain.ErrSkipDocument.
+func (p *Processor) Process(_ context.Context, doc *domain.Document, chunks []domain.Chunk) ([]domain.Chunk, error) {
+	fingerprint, ok := simhash(doc.Content)
+	if !ok {
+		return chunks, nil
+	}
+
+	canonical := p.match(doc, fingerprint)
+	if canonical == nil {
+		return chunks, nil
+	}
+
+	if p.action == ActionDrop {
+		return nil, fmt.Errorf("%w: %s duplicates %s", domain.ErrSkipDocument, doc.URI, canonical.uri)
+	}
+
+	if doc.Metadata == nil {
+		doc.Metadata = make(map[string]any)
+	}
+	doc.Metadata[domain.DocMetaDuplicateOf] = canonical.id
+	return chunks, nil
+}
+
+// match returns the canonical entry doc near-duplicates, or records doc as
+// canonical and returns nil.
+func (p *Processor) match(doc *domain.Document, fingerprint uint64) *entry {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// A document seen again (e.g., updated) replaces its own fingerprint
+	key := doc.SourceID + "\x00" + doc.URI
+	if previous, ok := p.byURI[key]; ok {
+		p.remove(previous)
+	}
+
+	for i, block := range p.blockValues(fingerprint) {
+		for _, candidate := range p.blocks[i][block] {
+			if hammingDistance(candidate.fingerprint, fingerprint) <= p.maxDistance {
+				return candidate
+			}
+		}
+	}
+
+	e := &entry{fingerprint: fingerprint, id: doc.ID, sourceID: doc.SourceID, uri: doc.URI}
+	p.byURI[key] = e
+	for i, block := range p.blockValues(fingerprint) {
+		p.blocks[i][block] = append(p.blocks[i][block], e)
+	}
+	return nil
+}
+
+// remove drops an entry from the indexes. Callers must hold p.mu.
+func (p *Processor) remove(e *entry) {
+	for i, block := range p.blockValues(e.fingerprint) {
+		p.blocks[i][block] = without(p.blocks[i][block], e)
+		if len(p.blocks[i][block]) == 0 {
+			delete(p.blocks[i], block)
+		}
+	}
+	delete(p.byURI, e.sourceID+"\x00"+e.uri)
+}
+
+// blockValues splits a fingerprint into maxDistance+1 bit ranges.
+func (p *Processor) blockValues(fingerprint uint64) []uint64 {
+	n := len(p.blocks)
+	values := make([]uint64, n)
+	start := 0
+	for i := 0; i < n; i++ {
+		width := 64 / n
+		if i < 64%n {
+			width++
+		}
+		values[i] = (fingerprint >> start) & (1<<width - 1)
+		start += width
+	}
+	return values
+}
+
+func without(entries []*entry, e *entry) []*entry {
+	for i, candidate := range entries {
+		if candidate == e {
+			return append(entries[:i:i], entries[i+1:]...)
+		}
+	}
+	return entries
+}
@@ -0,0 +1,242 @@
+package dedup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+const article = `Sercha indexes documents from many sources so they can be searched
+locally. Each sync run fetches changed documents, normalises them into plain
+text, splits them into chunks and writes the chunks to the keyword index.`
+
+const otherArticle = `Connectors authenticate with OAuth where the provider supports it.
+Tokens are refreshed automatically and stored in the local credential store,
+separate from the configuration of each source.`
+
+func TestProcessor_Name(t *testing.T) {
+	if got := New().Name(); got != "dedup" {
+		t.Errorf("expected name 'dedup', got %q", got)
+	}
+}
+
+func TestProcessor_Process_WhitespaceDifferenceIsFlagged(t *testing.T) {
+	p := New()
+	ctx := context.Background()
+	first := &domain.Document{ID: "doc-1", SourceID: "src-1", URI: "a.md", Content: article}
+	second := &domain.Document{
+		ID:       "doc-2",
+		SourceID: "src-2",
+		URI:      "b.md",
+		Content:  "  " + strings.Join(strings.Fields(article), "\n\t ") + "\n\n",
+	}
+
+	if _, err := p.Process(ctx, first, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	chunks := []domain.Chunk{{ID: "c1", DocumentID: "doc-2", Content: "chunk"}}
+	result, err := p.Process(ctx, second, chunks)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok := first.Metadata[domain.DocMetaDuplicateOf]; ok {
+		t.Error("canonical document should not be flagged")
+	}
+	if got := second.Metadata[domain.DocMetaDuplicateOf]; got != "doc-1" {
+		t.Errorf("expected duplicate_of 'doc-1', got %v", got)
+	}
+	if len(result) != 1 || result[0].ID != "c1" {
+		t.Errorf("expected chunks to pass through unchanged, got %+v", result)
+	}
+}
+
+func TestProcessor_Process_IdenticalContentIsDropped(t *testing.T) {
+	p := New(WithAction(ActionDrop))
+	ctx := context.Background()
+	first := &domain.Document{ID: "doc-1", SourceID: "src-1", URI: "a.md", Content: article}
+	second := &domain.Document{ID: "doc-2", SourceID: "src-1", URI: "copy-of-a.md", Content: article}
+
+	if _, err := p.Process(ctx, first, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result, err := p.Process(ctx, second, []domain.Chunk{{ID: "c1"}})
+	if !errors.Is(err, domain.ErrSkipDocument) {
+		t.Fatalf("expected ErrSkipDocument, got %v", err)
+	}
+	if result != nil {
+		t.Errorf("expected no chunks for a dropped document, got %+v", result)
+	}
+	if second.Metadata != nil {
+		t.Errorf("dropped document should not be flagged, got %v", second.Metadata)
+	}
+}
+
+func TestProcessor_Process_DistinctContentIsKept(t *testing.T) {
+	p := New(WithAction(ActionDrop))
+	ctx := context.Background()
+
+	for i, content := range []string{article, otherArticle} {
+		doc := &domain.Document{ID: fmt.Sprintf("doc-%d", i), URI: fmt.Sprintf("%d.md", i), Content: content}
+		if _, err := p.Process(ctx, doc, nil); err != nil {
+			t.Fatalf("document %d: unexpected error: %v", i, err)
+		}
+	}
+}
+
+func TestProcessor_Process_ReprocessedDocumentIsNotDuplicate(t *testing.T) {
+	p := New(WithAction(ActionDrop))
+	ctx := context.Background()
+
+	for _, id := range []string{"doc-1", "doc-1-updated"} {
+		doc := &domain.Document{ID: id, SourceID: "src-1", URI: "a.md", Content: article}
+		if _, err := p.Process(ctx, doc, nil); err != nil {
+			t.Fatalf("%s: unexpected error: %v", id, err)
+		}
+	}
+}
+
+func TestProcessor_Process_ReprocessedDocumentReplacesFingerprint(t *testing.T) {
+	p := New()
+	ctx := context.Background()
+	copyOfArticle := &domain.Document{ID: "doc-2", SourceID: "src-1", URI: "b.md", Content: article}
+
+	for _, doc := range []*domain.Document{
+		{ID: "doc-1", SourceID: "src-1", URI: "a.md", Content: otherArticle},
+		{ID: "doc-1-updated", SourceID: "src-1", URI: "a.md", Content: article},
+		copyOfArticle,
+	} {
+		if _, err := p.Process(ctx, doc, nil); err != nil {
+			t.Fatalf("%s: unexpected error: %v", doc.ID, err)
+		}
+	}
+
+	if got := copyOfArticle.Metadata[domain.DocMetaDuplicateOf]; got != "doc-1-updated" {
+		t.Errorf("expected duplicate_of 'doc-1-updated', got %v", got)
+	}
+
+	// The old content was forgotten, so it is canonical again
+	doc := &domain.Document{ID: "doc-3", SourceID: "src-2", URI: "c.md", Content: otherArticle}
+	if _, err := p.Process(ctx, doc, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := doc.Metadata[domain.DocMetaDuplicateOf]; ok {
+		t.Errorf("replaced content should not be a duplicate, got %v", doc.Metadata)
+	}
+}
+
+func TestProcessor_Process_MaxDistance(t *testing.T) {
+	near := strings.Replace(article, "plain", "simple", 1)
+	a, _ := simhash(article)
+	b, _ := simhash(near)
+	distance := hammingDistance(a, b)
+	if distance == 0 {
+		t.Fatal("test content should produce different fingerprints")
+	}
+
+	tests := []struct {
+		name        string
+		maxDistance int
+		want        bool
+	}{
+		{"below distance", distance - 1, false},
+		{"at distance", distance, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.maxDistance > maxAllowedDistance {
+				t.Skipf("distance %d exceeds the allowed maximum", distance)
+			}
+			p := New(WithMaxDistance(tt.maxDistance))
+			ctx := context.Background()
+			if _, err := p.Process(ctx, &domain.Document{ID: "doc-1", URI: "a.md", Content: article}, nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			doc := &domain.Document{ID: "doc-2", URI: "b.md", Content: near}
+			if _, err := p.Process(ctx, doc, nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if _, got := doc.Metadata[domain.DocMetaDuplicateOf]; got != tt.want {
+				t.Errorf("flagged = %v, want %v (distance %d)", got, tt.want, distance)
+			}
+		})
+	}
+}
+
+func TestProcessor_Process_EmptyContentIsIgnored(t *testing.T) {
+	p := New(WithAction(ActionDrop))
+	ctx := context.Background()
+
+	for _, id := range []string{"doc-1", "doc-2"} {
+		doc := &domain.Document{ID: id, URI: id, Content: " \n\t "}
+		if _, err := p.Process(ctx, doc, nil); err != nil {
+			t.Fatalf("%s: unexpected error: %v", id, err)
+		}
+	}
+}
+
+func TestProcessor_Reset(t *testing.T) {
+	p := New(WithAction(ActionDrop))
+	ctx := context.Background()
+	if _, err := p.Process(ctx, &domain.Document{ID: "doc-1", URI: "a.md", Content: article}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	p.Reset()
+
+	if _, err := p.Process(ctx, &domain.Document{ID: "doc-2", URI: "b.md", Content: article}, nil); err != nil {
+		t.Errorf("expected no duplicate after reset, got %v", err)
+	}
+}
+
+func TestProcessor_Options(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		p := New()
+		if p.maxDistance != DefaultMaxDistance || p.action != ActionFlag {
+			t.Errorf("expected defaults (%d, %q), got (%d, %q)", DefaultMaxDistance, ActionFlag, p.maxDistance, p.action)
+		}
+	})
+
+	t.Run("invalid values keep defaults", func(t *testing.T) {
+		p := New(WithMaxDistance(-1), WithMaxDistance(maxAllowedDistance+1), WithAction("delete"))
+		if p.maxDistance != DefaultMaxDistance || p.action != ActionFlag {
+			t.Errorf("expected defaults (%d, %q), got (%d, %q)", DefaultMaxDistance, ActionFlag, p.maxDistance, p.action)
+		}
+	})
+}
+
+func TestProcessor_Process_Concurrent(t *testing.T) {
+	p := New(WithAction(ActionDrop))
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	kept := 0
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			doc := &domain.Document{ID: fmt.Sprintf("doc-%d", i), URI: fmt.Sprintf("%d.md", i), Content: article}
+			_, err := p.Process(ctx, doc, nil)
+			if err != nil && !errors.Is(err, domain.ErrSkipDocument) {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if err == nil {
+				mu.Lock()
+				kept++
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if kept != 1 {
+		t.Errorf("expected exactly one canonical document, got %d", kept)
+	}
+}
@@ -0,0 +1,59 @@
+package dedup
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"strings"
+)
+
+// shingleSize is the number of consecutive words hashed as one feature.
+// Shingles make the fingerprint sensitive to word order, not just vocabulary.
+const shingleSize = 3
+
+// simhash returns the 64-bit SimHash of content's word shingles.
+// Similar content yields fingerprints differing in few bits.
+// Reports false if content has no words.
+func simhash(content string) (uint64, bool) {
+	words := tokens(content)
+	if len(words) == 0 {
+		return 0, false
+	}
+
+	size := shingleSize
+	if len(words) < size {
+		size = len(words)
+	}
+
+	var weights [64]int
+	for i := 0; i+size <= len(words); i++ {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(strings.Join(words[i:i+size], " ")))
+		feature := h.Sum64()
+		for bit := 0; bit < 64; bit++ {
+			if feature&(1<<bit) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+
+	var fingerprint uint64
+	for bit, weight := range weights {
+		if weight > 0 {
+			fingerprint |= 1 << bit
+		}
+	}
+	return fingerprint, true
+}
+
+// tokens splits content into lower-cased words, so differences in
+// whitespace and case do not affect the fingerprint.
+func tokens(content string) []string {
+	return strings.Fields(strings.ToLower(content))
+}
+
+// hammingDistance returns the number of bits that differ between a and b.
+func hammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
@@ -1,20 +1,32 @@
 package postprocessors
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
 	"github.com/custodia-labs/sercha-cli/internal/postprocessors/chunker"
+	"github.com/custodia-labs/sercha-cli/internal/postprocessors/dedup"
+	"github.com/custodia-labs/sercha-cli/internal/postprocessors/language"
+	"github.com/custodia-labs/sercha-cli/internal/postprocessors/summary"
 )
 
 // RegisterDefaults registers all built-in processors with the registry.
 // Call this during application initialisation to enable standard processors.
 func RegisterDefaults(r *Registry) {
 	r.Register("chunker", buildChunker)
+	r.Register("language", buildLanguage)
+	r.Register("dedup", buildDedup)
+	r.Register("summary", func(cfg map[string]any) (driven.PostProcessor, error) {
+		return buildSummary(r.llm, cfg)
+	})
 }
 
 // buildChunker creates a chunker processor from generic config.
 // Supported config keys:
 //   - chunk_size (int): Characters per chunk (default: 1000)
 //   - overlap (int): Overlapping characters between chunks (default: 200)
+//   - strategy (string): "fixed", "sentence" or "heading" (default: "fixed")
 func buildChunker(cfg map[string]any) (driven.PostProcessor, error) {
 	var opts []chunker.Option
 
@@ -25,11 +37,79 @@ func buildChunker(cfg map[string]any) (driven.PostProcessor, error) {
 		if overlap := getIntFromConfig(cfg, "overlap"); overlap >= 0 {
 			opts = append(opts, chunker.WithOverlap(overlap))
 		}
+		if strategy, ok := cfg["strategy"].(string); ok && strategy != "" {
+			if !chunker.Strategy(strategy).IsValid() {
+				return nil, fmt.Errorf("chunker: strategy must be %q, %q or %q, got %q",
+					chunker.StrategyFixed, chunker.StrategySentence, chunker.StrategyHeading, strategy)
+			}
+			opts = append(opts, chunker.WithStrategy(chunker.Strategy(strategy)))
+		}
 	}
 
 	return chunker.New(opts...), nil
 }
 
+// buildLanguage creates a language detection processor from generic config.
+// Supported config keys:
+//   - min_length (int): Minimum content length in characters (default: 100)
+func buildLanguage(cfg map[string]any) (driven.PostProcessor, error) {
+	var opts []language.Option
+
+	if cfg != nil {
+		if minLength := getIntFromConfig(cfg, "min_length"); minLength > 0 {
+			opts = append(opts, language.WithMinLength(minLength))
+		}
+	}
+
+	return language.New(opts...), nil
+}
+
+// buildDedup creates a near-duplicate detection processor from generic config.
+// Supported config keys:
+//   - max_distance (int): Differing SimHash bits still counted as a duplicate (default: 3)
+//   - action (string): "flag" to record the canonical document ID, or "drop"
+//     to skip the duplicate (default: "flag")
+func buildDedup(cfg map[string]any) (driven.PostProcessor, error) {
+	var opts []dedup.Option
+
+	if cfg != nil {
+		if _, ok := cfg["max_distance"]; ok {
+			distance := getIntFromConfig(cfg, "max_distance")
+			if distance < 0 || distance > 15 {
+				return nil, fmt.Errorf("dedup: max_distance must be between 0 and 15, got %d", distance)
+			}
+			opts = append(opts, dedup.WithMaxDistance(distance))
+		}
+		if action, ok := cfg["action"].(string); ok {
+			if !dedup.Action(action).IsValid() {
+				return nil, fmt.Errorf("dedup: action must be %q or %q, got %q", dedup.ActionFlag, dedup.ActionDrop, action)
+			}
+			opts = append(opts, dedup.WithAction(dedup.Action(action)))
+		}
+	}
+
+	return dedup.New(opts...), nil
+}
+
+// buildSummary creates an LLM summary processor from generic config.
+// It is not in the default pipeline, as it makes an LLM request per long document.
+// Supported config keys:
+//   - min_length (int): Minimum content length in characters (default: 2000)
+func buildSummary(llm driven.LLMService, cfg map[string]any) (driven.PostProcessor, error) {
+	if llm == nil {
+		return nil, errors.New("summary: requires an LLM provider; run 'sercha settings llm'")
+	}
+
+	var opts []summary.Option
+	if cfg != nil {
+		if minLength := getIntFromConfig(cfg, "min_length"); minLength > 0 {
+			opts = append(opts, summary.WithMinLength(minLength))
+		}
+	}
+
+	return summary.New(llm, opts...), nil
+}
+
 // getIntFromConfig safely extracts an int from generic config map.
 // Handles int, int64, and float64 types that may come from TOML/JSON parsing.
 func getIntFromConfig(cfg map[string]any, key string) int {
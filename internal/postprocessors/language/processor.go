@@ -0,0 +1,194 @@
+// Package language provides a processor that tags documents with their
+// dominant language.
+package language
+
+import (
+	"context"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+// DefaultMinLength is the default number of characters a document needs
+// before detection is attempted. Shorter text is too ambiguous to classify.
+const DefaultMinLength = 100
+
+// sampleSize caps how many bytes of content are examined.
+// The opening of a document is representative enough and keeps detection fast.
+const sampleSize = 16 * 1024
+
+// minWordHits is the number of common-word matches a Latin-script
+// language needs before it is reported.
+const minWordHits = 3
+
+// Processor detects the dominant language of a document's content and writes
+// its ISO 639-1 code to Document.Metadata[domain.DocMetaLanguage], and to the
+// same key of each chunk it receives so search engines can stem them.
+// Content is not modified; run it after the chunker to tag chunks.
+// It implements the PostProcessor interface.
+type Processor struct {
+	minLength int
+}
+
+// Option configures the language processor.
+type Option func(*Processor)
+
+// WithMinLength sets the minimum content length in characters.
+func WithMinLength(n int) Option {
+	return func(p *Processor) {
+		if n > 0 {
+			p.minLength = n
+		}
+	}
+}
+
+// New creates a new language processor with the given options.
+func New(opts ...Option) *Processor {
+	p := &Processor{minLength: DefaultMinLength}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Name returns the processor name.
+func (p *Processor) Name() string {
+	return "language"
+}
+
+// Process tags the document and its chunks with the document's language.
+// Documents shorter than the minimum length, or whose language cannot be
+// determined, are left untagged.
+func (p *Processor) Process(_ context.Context, doc *domain.Document, chunks []domain.Chunk) ([]domain.Chunk, error) {
+	content := strings.TrimSpace(doc.Content)
+	if utf8.RuneCountInString(content) < p.minLength {
+		return chunks, nil
+	}
+
+	lang := Detect(content)
+	if lang == "" {
+		return chunks, nil
+	}
+
+	if doc.Metadata == nil {
+		doc.Metadata = make(map[string]any)
+	}
+	doc.Metadata[domain.DocMetaLanguage] = lang
+
+	for i := range chunks {
+		if chunks[i].Metadata == nil {
+			chunks[i].Metadata = make(map[string]any)
+		}
+		chunks[i].Metadata[domain.DocMetaLanguage] = lang
+	}
+	return chunks, nil
+}
+
+// Detect returns the ISO 639-1 code of the dominant language in text,
+// or "" if it cannot be determined.
+//
+// Text in a script used by a single language (e.g., Hangul, Greek) is
+// classified by script. Latin-script text is scored against the most
+// common words of each supported language.
+func Detect(text string) string {
+	if len(text) > sampleSize {
+		// Back up to a rune boundary so no character is split
+		cut := sampleSize
+		for cut > 0 && !utf8.RuneStart(text[cut]) {
+			cut--
+		}
+		text = text[:cut]
+	}
+
+	if lang, ok := detectScript(text); ok {
+		return lang
+	}
+	return detectLatin(text)
+}
+
+// detectScript classifies text by its dominant non-Latin script.
+// Reports false if most letters are Latin or the script is shared by
+// several languages.
+func detectScript(text string) (string, bool) {
+	var latin, han, kana, hangul, greek, hebrew, thai int
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Latin, r):
+			latin++
+		case unicode.Is(unicode.Hiragana, r), unicode.Is(unicode.Katakana, r):
+			kana++
+		case unicode.Is(unicode.Han, r):
+			han++
+		case unicode.Is(unicode.Hangul, r):
+			hangul++
+		case unicode.Is(unicode.Greek, r):
+			greek++
+		case unicode.Is(unicode.Hebrew, r):
+			hebrew++
+		case unicode.Is(unicode.Thai, r):
+			thai++
+		}
+	}
+
+	// Japanese mixes kana with Han; Chinese uses Han alone
+	var ja, zh int
+	if kana > 0 {
+		ja = kana + han
+	} else {
+		zh = han
+	}
+
+	scripts := []struct {
+		lang  string
+		count int
+	}{
+		{"ja", ja},
+		{"zh", zh},
+		{"ko", hangul},
+		{"el", greek},
+		{"he", hebrew},
+		{"th", thai},
+	}
+
+	best, bestCount := "", 0
+	for _, s := range scripts {
+		if s.count > bestCount {
+			best, bestCount = s.lang, s.count
+		}
+	}
+	if bestCount <= latin {
+		return "", false
+	}
+	return best, true
+}
+
+// detectLatin scores text against each language's common words.
+// Returns "" if no language reaches minWordHits or the top two tie.
+func detectLatin(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	scores := make(map[string]int, len(commonWords))
+	for _, word := range words {
+		for _, lang := range wordLanguages[word] {
+			scores[lang]++
+		}
+	}
+
+	best, bestScore, runnerUp := "", 0, 0
+	for _, lang := range languages {
+		switch score := scores[lang]; {
+		case score > bestScore:
+			best, bestScore, runnerUp = lang, score, bestScore
+		case score > runnerUp:
+			runnerUp = score
+		}
+	}
+	if bestScore < minWordHits || bestScore == runnerUp {
+		return ""
+	}
+	return best
+}
@@ -0,0 +1,188 @@
+package language
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+)
+
+const english = `The quick brown fox jumps over the lazy dog. This sentence is used
+to test typewriters and keyboards, and it has been in use for a long time.
+Most people who learn to type will have seen it at least once.`
+
+const german = `Der schnelle braune Fuchs springt über den faulen Hund. Dieser Satz
+wird seit langer Zeit verwendet, um Schreibmaschinen und Tastaturen zu testen,
+und die meisten Menschen, die tippen lernen, haben ihn auch schon gesehen.`
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"english", english, "en"},
+		{"german", german, "de"},
+		{"french", "Le renard brun saute par-dessus le chien. Cette phrase est utilisée pour tester les claviers et elle est connue dans le monde.", "fr"},
+		{"spanish", "El zorro marrón salta sobre el perro. Esta frase se usa para probar los teclados y es muy conocida por todos.", "es"},
+		{"japanese", "素早い茶色の狐が怠け者の犬を飛び越える。この文はタイプライターのテストに使われています。", "ja"},
+		{"chinese", "敏捷的棕色狐狸跳过了懒狗。这个句子被用来测试打字机和键盘。", "zh"},
+		{"korean", "빠른 갈색 여우가 게으른 개를 뛰어넘습니다. 이 문장은 타자기를 시험하는 데 사용됩니다.", "ko"},
+		{"greek", "Η γρήγορη καφέ αλεπού πηδάει πάνω από τον τεμπέλη σκύλο.", "el"},
+		{"no common words", "lorem ipsum dolor sit amet consectetur adipiscing elit sed", ""},
+		{"numbers only", "12345 67890 3.14159 2.71828", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Detect(tt.text); got != tt.want {
+				t.Errorf("Detect() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetect_MixedLanguage(t *testing.T) {
+	t.Run("mostly english", func(t *testing.T) {
+		text := english + "\n\n" + english + "\n\nZitat: Das ist nicht mein Hund."
+		if got := Detect(text); got != "en" {
+			t.Errorf("Detect() = %q, want %q", got, "en")
+		}
+	})
+
+	t.Run("mostly german", func(t *testing.T) {
+		text := german + "\n\n" + german + "\n\nQuote: that is not my dog."
+		if got := Detect(text); got != "de" {
+			t.Errorf("Detect() = %q, want %q", got, "de")
+		}
+	})
+
+	t.Run("english with japanese title", func(t *testing.T) {
+		text := "日本語\n\n" + english
+		if got := Detect(text); got != "en" {
+			t.Errorf("Detect() = %q, want %q", got, "en")
+		}
+	})
+}
+
+func TestDetect_LongContentIsSampled(t *testing.T) {
+	// Multi-byte runes straddle the sample boundary
+	text := strings.Repeat("für ", sampleSize) + german
+	if got := Detect(text); got != "de" {
+		t.Errorf("Detect() = %q, want %q", got, "de")
+	}
+}
+
+func TestProcessor_Name(t *testing.T) {
+	if got := New().Name(); got != "language" {
+		t.Errorf("expected name 'language', got %q", got)
+	}
+}
+
+func TestProcessor_Process_SetsMetadata(t *testing.T) {
+	doc := &domain.Document{ID: "doc-1", Content: german}
+
+	_, err := New().Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := doc.Metadata[domain.DocMetaLanguage]; got != "de" {
+		t.Errorf("expected language 'de', got %v", got)
+	}
+	if doc.Content != german {
+		t.Error("content should not be modified")
+	}
+}
+
+func TestProcessor_Process_PreservesMetadataAndTagsChunks(t *testing.T) {
+	doc := &domain.Document{
+		ID:       "doc-1",
+		Content:  english,
+		Metadata: map[string]any{"author": "alice"},
+	}
+	chunks := []domain.Chunk{
+		{ID: "c1", DocumentID: "doc-1", Content: "The quick brown fox"},
+		{ID: "c2", DocumentID: "doc-1", Content: "jumps over the lazy dog"},
+	}
+
+	result, err := New().Process(context.Background(), doc, chunks)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 2 || result[0].Content != chunks[0].Content || result[1].Content != chunks[1].Content {
+		t.Errorf("expected chunk content to pass through unchanged, got %+v", result)
+	}
+	for _, chunk := range result {
+		if chunk.Metadata[domain.DocMetaLanguage] != "en" {
+			t.Errorf("expected chunk %s tagged 'en', got %v", chunk.ID, chunk.Metadata)
+		}
+	}
+	if doc.Metadata["author"] != "alice" {
+		t.Error("existing metadata should be preserved")
+	}
+	if doc.Metadata[domain.DocMetaLanguage] != "en" {
+		t.Errorf("expected language 'en', got %v", doc.Metadata[domain.DocMetaLanguage])
+	}
+	if doc.Content != english {
+		t.Error("content should not be modified")
+	}
+}
+
+func TestProcessor_Process_SkipsShortContent(t *testing.T) {
+	doc := &domain.Document{ID: "doc-1", Content: "This is the end of it."}
+
+	_, err := New().Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if doc.Metadata != nil {
+		t.Errorf("short content should not be tagged, got %v", doc.Metadata)
+	}
+}
+
+func TestProcessor_Process_MinLength(t *testing.T) {
+	content := "This is the end of it."
+
+	t.Run("below threshold", func(t *testing.T) {
+		doc := &domain.Document{Content: content}
+		if _, err := New(WithMinLength(len(content)+1)).Process(context.Background(), doc, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := doc.Metadata[domain.DocMetaLanguage]; ok {
+			t.Error("content below the threshold should not be tagged")
+		}
+	})
+
+	t.Run("at threshold", func(t *testing.T) {
+		doc := &domain.Document{Content: content}
+		if _, err := New(WithMinLength(len(content))).Process(context.Background(), doc, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := doc.Metadata[domain.DocMetaLanguage]; got != "en" {
+			t.Errorf("expected language 'en', got %v", got)
+		}
+	})
+
+	t.Run("invalid value keeps default", func(t *testing.T) {
+		if p := New(WithMinLength(0)); p.minLength != DefaultMinLength {
+			t.Errorf("expected minLength %d, got %d", DefaultMinLength, p.minLength)
+		}
+	})
+}
+
+func TestProcessor_Process_UndetectedLeavesMetadataUnset(t *testing.T) {
+	doc := &domain.Document{Content: strings.Repeat("lorem ipsum dolor sit amet ", 10)}
+
+	_, err := New().Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok := doc.Metadata[domain.DocMetaLanguage]; ok {
+		t.Error("undetected language should not be tagged")
+	}
+}
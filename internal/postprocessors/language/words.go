@@ -0,0 +1,70 @@
+package language
+
+import "sort"
+
+// commonWords lists the most frequent function words of each supported
+// Latin-script language, keyed by ISO 639-1 code. Words shared between
+// languages count towards each of them; the distinctive ones decide.
+var commonWords = map[string][]string{
+	"en": {
+		"the", "and", "of", "to", "is", "in", "that", "it", "was", "for",
+		"with", "as", "are", "this", "be", "on", "have", "not", "by", "from",
+		"which", "you", "they", "at", "or", "but", "were", "has", "an", "will",
+	},
+	"de": {
+		"der", "die", "und", "das", "ist", "nicht", "ein", "eine", "zu", "den",
+		"mit", "sich", "des", "auf", "für", "im", "dem", "von", "auch", "es",
+		"wird", "sind", "wir", "ich", "werden", "oder", "aber", "nach", "bei", "einer",
+	},
+	"fr": {
+		"le", "la", "les", "et", "des", "est", "un", "une", "du", "en",
+		"que", "qui", "dans", "pour", "pas", "sur", "au", "avec", "ce", "il",
+		"sont", "par", "mais", "nous", "vous", "elle", "ont", "cette", "aux", "être",
+	},
+	"es": {
+		"el", "la", "los", "las", "y", "de", "que", "en", "es", "un",
+		"una", "por", "con", "para", "del", "se", "no", "al", "lo", "como",
+		"más", "pero", "sus", "su", "está", "son", "este", "esta", "muy", "también",
+	},
+	"it": {
+		"il", "la", "di", "che", "e", "è", "un", "una", "per", "non",
+		"del", "della", "sono", "con", "gli", "le", "da", "si", "nel", "anche",
+		"come", "questo", "ma", "ha", "dei", "alla", "più", "essere", "delle", "questa",
+	},
+	"nl": {
+		"de", "het", "een", "en", "van", "is", "dat", "niet", "op", "te",
+		"zijn", "met", "voor", "die", "er", "maar", "ook", "aan", "wordt", "bij",
+		"deze", "hij", "naar", "om", "worden", "wij", "ik", "heeft", "kan", "nog",
+	},
+	"pt": {
+		"o", "a", "os", "as", "de", "que", "e", "do", "da", "em",
+		"um", "uma", "para", "com", "não", "por", "dos", "das", "se", "mais",
+		"na", "no", "ao", "é", "foi", "são", "mas", "está", "pelo", "também",
+	},
+}
+
+// languages is the sorted list of Latin-script languages, so ties between
+// scores are resolved the same way on every run.
+var languages = sortedLanguages()
+
+// wordLanguages maps each common word to the languages it belongs to.
+var wordLanguages = indexWords()
+
+func sortedLanguages() []string {
+	langs := make([]string, 0, len(commonWords))
+	for lang := range commonWords {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+func indexWords() map[string][]string {
+	index := make(map[string][]string)
+	for lang, words := range commonWords {
+		for _, word := range words {
+			index[word] = append(index[word], lang)
+		}
+	}
+	return index
+}
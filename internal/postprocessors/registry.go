@@ -14,6 +14,9 @@ type BuilderFunc func(cfg map[string]any) (driven.PostProcessor, error)
 // It allows dynamic construction of processors from configuration.
 type Registry struct {
 	builders map[string]BuilderFunc
+
+	// llm is given to processors that need a language model.
+	llm driven.LLMService
 }
 
 // NewRegistry creates a new processor registry.
@@ -29,6 +32,12 @@ func (r *Registry) Register(name string, builder BuilderFunc) {
 	r.builders[name] = builder
 }
 
+// SetLLMService sets the LLM used by processors that need one, such as the
+// summary processor. Without it those processors fail to build.
+func (r *Registry) SetLLMService(llm driven.LLMService) {
+	r.llm = llm
+}
+
 // Build creates a processor by name with the given config.
 // Returns error if the processor name is not registered.
 func (r *Registry) Build(name string, cfg map[string]any) (driven.PostProcessor, error) {
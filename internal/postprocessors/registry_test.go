@@ -2,6 +2,7 @@ package postprocessors
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/custodia-labs/sercha-cli/internal/core/domain"
@@ -13,6 +14,12 @@ type registryMockProcessor struct {
 	name string
 }
 
+// stubLLMService satisfies driven.LLMService for processors that are built
+// but not run.
+type stubLLMService struct {
+	driven.LLMService
+}
+
 func (m *registryMockProcessor) Name() string { return m.name }
 func (m *registryMockProcessor) Process(_ context.Context, _ *domain.Document, chunks []domain.Chunk) ([]domain.Chunk, error) {
 	return chunks, nil
@@ -127,6 +134,33 @@ func TestRegisterDefaults(t *testing.T) {
 	if !r.Has("chunker") {
 		t.Error("expected 'chunker' to be registered after RegisterDefaults")
 	}
+	if !r.Has("language") {
+		t.Error("expected 'language' to be registered after RegisterDefaults")
+	}
+	if !r.Has("dedup") {
+		t.Error("expected 'dedup' to be registered after RegisterDefaults")
+	}
+	if !r.Has("summary") {
+		t.Error("expected 'summary' to be registered after RegisterDefaults")
+	}
+}
+
+func TestBuildSummary_RequiresLLM(t *testing.T) {
+	r := NewRegistry()
+	RegisterDefaults(r)
+
+	if _, err := r.Build("summary", nil); err == nil {
+		t.Error("expected error without an LLM service")
+	}
+
+	r.SetLLMService(&stubLLMService{})
+	proc, err := r.Build("summary", map[string]any{"min_length": int64(500)})
+	if err != nil {
+		t.Fatalf("Build summary failed: %v", err)
+	}
+	if proc.Name() != "summary" {
+		t.Errorf("expected name 'summary', got %q", proc.Name())
+	}
 }
 
 func TestBuildChunker_WithConfig(t *testing.T) {
@@ -148,6 +182,29 @@ func TestBuildChunker_WithConfig(t *testing.T) {
 	}
 }
 
+func TestBuildChunker_Strategy(t *testing.T) {
+	r := NewRegistry()
+	RegisterDefaults(r)
+
+	proc, err := r.Build("chunker", map[string]any{"strategy": "heading", "chunk_size": 1000})
+	if err != nil {
+		t.Fatalf("Build chunker failed: %v", err)
+	}
+
+	doc := &domain.Document{ID: "doc-1", Content: "# Intro\nHello.\n## Next\nWorld."}
+	chunks, err := proc.Process(context.Background(), doc, nil)
+	if err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+	if len(chunks) != 2 {
+		t.Errorf("expected one chunk per section, got %d", len(chunks))
+	}
+
+	if _, err := r.Build("chunker", map[string]any{"strategy": "paragraph"}); err == nil {
+		t.Error("expected error for unknown strategy")
+	}
+}
+
 func TestBuildChunker_WithNilConfig(t *testing.T) {
 	r := NewRegistry()
 	RegisterDefaults(r)
@@ -162,6 +219,74 @@ func TestBuildChunker_WithNilConfig(t *testing.T) {
 	}
 }
 
+func TestBuildLanguage_WithConfig(t *testing.T) {
+	r := NewRegistry()
+	RegisterDefaults(r)
+
+	proc, err := r.Build("language", map[string]any{"min_length": int64(20)})
+	if err != nil {
+		t.Fatalf("Build language failed: %v", err)
+	}
+
+	if proc.Name() != "language" {
+		t.Errorf("expected name 'language', got %q", proc.Name())
+	}
+
+	doc := &domain.Document{Content: "This is the text of a short note."}
+	if _, err := proc.Process(context.Background(), doc, nil); err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+	if doc.Metadata[domain.DocMetaLanguage] != "en" {
+		t.Errorf("expected min_length to allow tagging, got metadata %v", doc.Metadata)
+	}
+}
+
+func TestBuildDedup_WithConfig(t *testing.T) {
+	r := NewRegistry()
+	RegisterDefaults(r)
+
+	proc, err := r.Build("dedup", map[string]any{"max_distance": int64(0), "action": "drop"})
+	if err != nil {
+		t.Fatalf("Build dedup failed: %v", err)
+	}
+
+	if proc.Name() != "dedup" {
+		t.Errorf("expected name 'dedup', got %q", proc.Name())
+	}
+
+	ctx := context.Background()
+	content := "The same note was saved in two places."
+	if _, err := proc.Process(ctx, &domain.Document{ID: "doc-1", URI: "a.md", Content: content}, nil); err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+	_, err = proc.Process(ctx, &domain.Document{ID: "doc-2", URI: "b.md", Content: content}, nil)
+	if !errors.Is(err, domain.ErrSkipDocument) {
+		t.Errorf("expected drop action to skip the duplicate, got %v", err)
+	}
+}
+
+func TestBuildDedup_InvalidConfig(t *testing.T) {
+	r := NewRegistry()
+	RegisterDefaults(r)
+
+	tests := []struct {
+		name string
+		cfg  map[string]any
+	}{
+		{"unknown action", map[string]any{"action": "delete"}},
+		{"negative distance", map[string]any{"max_distance": -1}},
+		{"distance too large", map[string]any{"max_distance": 64}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := r.Build("dedup", tt.cfg); err == nil {
+				t.Error("expected error for invalid config")
+			}
+		})
+	}
+}
+
 func TestGetIntFromConfig(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,92 @@
+package postprocessors
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// SourcePipelines selects each source's pipeline, composing the global
+// pipeline config with the source's overrides.
+// It implements the PipelineProvider interface.
+//
+// Each processor is built once and shared by every pipeline that runs it,
+// so stateful processors such as dedup see documents from all sources.
+type SourcePipelines struct {
+	registry *Registry
+	config   domain.PipelineConfig
+	global   *Pipeline
+
+	mu         sync.Mutex
+	processors map[string]driven.PostProcessor
+	pipelines  map[string]*Pipeline
+}
+
+// NewSourcePipelines creates a provider from the global pipeline config.
+// The global pipeline is built immediately so config errors surface early;
+// override pipelines are built when first requested.
+func NewSourcePipelines(registry *Registry, cfg domain.PipelineConfig) (*SourcePipelines, error) {
+	s := &SourcePipelines{
+		registry:   registry,
+		config:     cfg,
+		processors: make(map[string]driven.PostProcessor),
+		pipelines:  make(map[string]*Pipeline),
+	}
+
+	global, err := s.build(cfg.ProcessorsForSource(nil))
+	if err != nil {
+		return nil, err
+	}
+	s.global = global
+	return s, nil
+}
+
+// Global returns the pipeline for sources without overrides.
+func (s *SourcePipelines) Global() *Pipeline {
+	return s.global
+}
+
+// PipelineFor returns the pipeline for the source's documents.
+// Sources sharing the same resolved processor list share a pipeline.
+func (s *SourcePipelines) PipelineFor(source *domain.Source) (driven.PostProcessorPipeline, error) {
+	if source == nil || !source.HasPipelineOverride() {
+		return s.global, nil
+	}
+
+	pipeline, err := s.build(s.config.ProcessorsForSource(source))
+	if err != nil {
+		return nil, fmt.Errorf("source %s: %w", source.ID, err)
+	}
+	return pipeline, nil
+}
+
+// build returns the pipeline running the named processors in order.
+func (s *SourcePipelines) build(names []string) (*Pipeline, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := strings.Join(names, ",")
+	if pipeline, ok := s.pipelines[key]; ok {
+		return pipeline, nil
+	}
+
+	pipeline := NewPipeline()
+	for _, name := range names {
+		processor, ok := s.processors[name]
+		if !ok {
+			var err error
+			processor, err = s.registry.Build(name, s.config.GetProcessorConfig(name))
+			if err != nil {
+				return nil, fmt.Errorf("build processor %s: %w", name, err)
+			}
+			s.processors[name] = processor
+		}
+		pipeline.Add(processor)
+	}
+
+	s.pipelines[key] = pipeline
+	return pipeline, nil
+}
@@ -0,0 +1,159 @@
+package postprocessors
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// taggingProcessor records its name in the document's metadata.
+type taggingProcessor struct {
+	name string
+}
+
+func (p *taggingProcessor) Name() string {
+	return p.name
+}
+
+func (p *taggingProcessor) Process(_ context.Context, doc *domain.Document, chunks []domain.Chunk) ([]domain.Chunk, error) {
+	if doc.Metadata == nil {
+		doc.Metadata = make(map[string]any)
+	}
+	ran, _ := doc.Metadata["ran"].([]string)
+	doc.Metadata["ran"] = append(ran, p.name)
+	return chunks, nil
+}
+
+// newTaggingRegistry returns a registry of tagging processors and counts
+// how many times each is built.
+func newTaggingRegistry(names ...string) (*Registry, map[string]int) {
+	r := NewRegistry()
+	builds := make(map[string]int)
+	for _, name := range names {
+		r.Register(name, func(_ map[string]any) (driven.PostProcessor, error) {
+			builds[name]++
+			return &taggingProcessor{name: name}, nil
+		})
+	}
+	return r, builds
+}
+
+// ranProcessors runs a document through the pipeline and returns the names
+// of the processors that ran, in order.
+func ranProcessors(t *testing.T, pipeline driven.PostProcessorPipeline) []string {
+	t.Helper()
+	doc := &domain.Document{ID: "doc-1"}
+	if _, err := pipeline.Process(context.Background(), doc); err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+	ran, _ := doc.Metadata["ran"].([]string)
+	return ran
+}
+
+func TestSourcePipelines_OverrideRunsDifferentProcessors(t *testing.T) {
+	registry, _ := newTaggingRegistry("chunker", "redact", "language")
+	cfg := domain.PipelineConfig{Processors: []string{"chunker", "redact"}}
+
+	pipelines, err := NewSourcePipelines(registry, cfg)
+	if err != nil {
+		t.Fatalf("NewSourcePipelines failed: %v", err)
+	}
+
+	work := &domain.Source{ID: "work"}
+	notes := &domain.Source{ID: "notes", Config: map[string]string{
+		domain.ConfigKeyPipelineRemove: "redact",
+		domain.ConfigKeyPipelineAdd:    "language",
+	}}
+
+	workPipeline, err := pipelines.PipelineFor(work)
+	if err != nil {
+		t.Fatalf("PipelineFor work failed: %v", err)
+	}
+	notesPipeline, err := pipelines.PipelineFor(notes)
+	if err != nil {
+		t.Fatalf("PipelineFor notes failed: %v", err)
+	}
+
+	if got := ranProcessors(t, workPipeline); !slices.Equal(got, []string{"chunker", "redact"}) {
+		t.Errorf("expected global processors for work, got %v", got)
+	}
+	if got := ranProcessors(t, notesPipeline); !slices.Equal(got, []string{"chunker", "language"}) {
+		t.Errorf("expected override processors for notes, got %v", got)
+	}
+	if workPipeline != driven.PostProcessorPipeline(pipelines.Global()) {
+		t.Error("source without override should use the global pipeline")
+	}
+}
+
+func TestSourcePipelines_SharesProcessorsAndPipelines(t *testing.T) {
+	registry, builds := newTaggingRegistry("chunker", "language")
+	cfg := domain.PipelineConfig{Processors: []string{"chunker"}}
+
+	pipelines, err := NewSourcePipelines(registry, cfg)
+	if err != nil {
+		t.Fatalf("NewSourcePipelines failed: %v", err)
+	}
+
+	override := map[string]string{domain.ConfigKeyPipelineAdd: "language"}
+	first, err := pipelines.PipelineFor(&domain.Source{ID: "a", Config: override})
+	if err != nil {
+		t.Fatalf("PipelineFor failed: %v", err)
+	}
+	second, err := pipelines.PipelineFor(&domain.Source{ID: "b", Config: override})
+	if err != nil {
+		t.Fatalf("PipelineFor failed: %v", err)
+	}
+
+	if first != second {
+		t.Error("sources with the same processors should share a pipeline")
+	}
+	if builds["chunker"] != 1 || builds["language"] != 1 {
+		t.Errorf("expected each processor to be built once, got %v", builds)
+	}
+}
+
+func TestSourcePipelines_UsesProcessorConfig(t *testing.T) {
+	r := NewRegistry()
+	var got map[string]any
+	r.Register("chunker", func(cfg map[string]any) (driven.PostProcessor, error) {
+		got = cfg
+		return &taggingProcessor{name: "chunker"}, nil
+	})
+	cfg := domain.PipelineConfig{
+		Processors:       []string{},
+		ProcessorConfigs: map[string]map[string]any{"chunker": {"chunk_size": 500}},
+	}
+
+	pipelines, err := NewSourcePipelines(r, cfg)
+	if err != nil {
+		t.Fatalf("NewSourcePipelines failed: %v", err)
+	}
+	source := &domain.Source{ID: "notes", Config: map[string]string{domain.ConfigKeyPipeline: "chunker"}}
+	if _, err := pipelines.PipelineFor(source); err != nil {
+		t.Fatalf("PipelineFor failed: %v", err)
+	}
+
+	if got["chunk_size"] != 500 {
+		t.Errorf("expected global processor config, got %v", got)
+	}
+}
+
+func TestSourcePipelines_UnknownProcessor(t *testing.T) {
+	registry, _ := newTaggingRegistry("chunker")
+
+	if _, err := NewSourcePipelines(registry, domain.PipelineConfig{Processors: []string{"missing"}}); err == nil {
+		t.Error("expected error for unknown global processor")
+	}
+
+	pipelines, err := NewSourcePipelines(registry, domain.PipelineConfig{Processors: []string{"chunker"}})
+	if err != nil {
+		t.Fatalf("NewSourcePipelines failed: %v", err)
+	}
+	source := &domain.Source{ID: "notes", Config: map[string]string{domain.ConfigKeyPipelineAdd: "missing"}}
+	if _, err := pipelines.PipelineFor(source); err == nil {
+		t.Error("expected error for unknown override processor")
+	}
+}
@@ -0,0 +1,126 @@
+// Package summary provides a processor that summarises long documents with
+// an LLM.
+package summary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// DefaultMinLength is the default number of characters a document needs
+// before it is summarised. Shorter documents are their own summary.
+const DefaultMinLength = 2000
+
+// maxInputLength caps the characters of content sent to the LLM, so long
+// documents fit in the context window of small local models.
+const maxInputLength = 12000
+
+// summaryPrompt asks for a two-sentence summary of a document.
+const summaryPrompt = `Summarise the following document in two sentences.
+Return ONLY the summary, nothing else.
+
+Title: %s
+
+%s
+
+Summary:`
+
+// Processor asks an LLM for a two-sentence summary of each long document
+// and writes it to Document.Metadata[domain.DocMetaSummary], and to the same
+// key of each chunk it receives so search engines can index it alongside
+// the chunk's content. Content is not modified; run it after the chunker.
+// A document the LLM fails to summarise is left without a summary.
+// It implements the PostProcessor interface.
+type Processor struct {
+	llm       driven.LLMService
+	minLength int
+}
+
+// Option configures the summary processor.
+type Option func(*Processor)
+
+// WithMinLength sets the minimum content length in characters.
+func WithMinLength(n int) Option {
+	return func(p *Processor) {
+		if n > 0 {
+			p.minLength = n
+		}
+	}
+}
+
+// New creates a new summary processor using llm with the given options.
+func New(llm driven.LLMService, opts ...Option) *Processor {
+	p := &Processor{llm: llm, minLength: DefaultMinLength}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Name returns the processor name.
+func (p *Processor) Name() string {
+	return "summary"
+}
+
+// Process summarises the document if it is at least the minimum length, and
+// tags it and its chunks with the summary.
+func (p *Processor) Process(ctx context.Context, doc *domain.Document, chunks []domain.Chunk) ([]domain.Chunk, error) {
+	content := strings.TrimSpace(doc.Content)
+	if utf8.RuneCountInString(content) < p.minLength {
+		return chunks, nil
+	}
+
+	summary, err := p.summarise(ctx, doc.Title, content)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		slog.Warn("failed to summarise document", slog.String("uri", doc.URI), slog.Any("error", err))
+		return chunks, nil
+	}
+
+	if doc.Metadata == nil {
+		doc.Metadata = make(map[string]any)
+	}
+	doc.Metadata[domain.DocMetaSummary] = summary
+
+	for i := range chunks {
+		if chunks[i].Metadata == nil {
+			chunks[i].Metadata = make(map[string]any)
+		}
+		chunks[i].Metadata[domain.DocMetaSummary] = summary
+	}
+	return chunks, nil
+}
+
+// summarise asks the LLM for a summary of the opening of content.
+func (p *Processor) summarise(ctx context.Context, title, content string) (string, error) {
+	if len(content) > maxInputLength {
+		// Back up to a rune boundary so no character is split
+		cut := maxInputLength
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		content = content[:cut]
+	}
+
+	reply, err := p.llm.Generate(ctx, fmt.Sprintf(summaryPrompt, title, content), driven.GenerateOptions{
+		MaxTokens:   150,
+		Temperature: 0.3,
+	})
+	if err != nil {
+		return "", fmt.Errorf("summarise: %w", err)
+	}
+	summary := strings.Join(strings.Fields(reply), " ")
+	if summary == "" {
+		return "", errors.New("summarise: empty reply")
+	}
+	return summary, nil
+}
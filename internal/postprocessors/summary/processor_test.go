@@ -0,0 +1,112 @@
+package summary
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/custodia-labs/sercha-cli/internal/core/domain"
+	"github.com/custodia-labs/sercha-cli/internal/core/ports/driven"
+)
+
+// stubLLM replies to every prompt with reply, or fails with err.
+type stubLLM struct {
+	reply   string
+	err     error
+	prompts []string
+}
+
+func (s *stubLLM) Generate(_ context.Context, prompt string, _ driven.GenerateOptions) (string, error) {
+	s.prompts = append(s.prompts, prompt)
+	return s.reply, s.err
+}
+
+func (s *stubLLM) Chat(_ context.Context, _ []driven.ChatMessage, _ driven.ChatOptions) (string, error) {
+	return "", nil
+}
+
+func (s *stubLLM) ChatStream(
+	_ context.Context, _ []driven.ChatMessage, _ driven.ChatOptions,
+) (<-chan string, <-chan error) {
+	return nil, nil
+}
+
+func (s *stubLLM) RewriteQuery(_ context.Context, query string) (string, error) { return query, nil }
+
+func (s *stubLLM) Summarise(_ context.Context, _ string, _ int) (string, error) { return "", nil }
+
+func (s *stubLLM) ModelName() string            { return "stub" }
+func (s *stubLLM) Ping(_ context.Context) error { return nil }
+func (s *stubLLM) Close() error                 { return nil }
+
+func TestProcessor_Process(t *testing.T) {
+	llm := &stubLLM{reply: "  The team agreed to ship on Friday.\nQA signs off on Thursday.  "}
+	p := New(llm, WithMinLength(20))
+	doc := &domain.Document{
+		URI: "notes.md", Title: "Release meeting", Content: strings.Repeat("We discussed the release. ", 10),
+	}
+	chunks := []domain.Chunk{{ID: "c-1"}, {ID: "c-2", Metadata: map[string]any{"language": "en"}}}
+
+	got, err := p.Process(context.Background(), doc, chunks)
+	if err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+
+	want := "The team agreed to ship on Friday. QA signs off on Thursday."
+	if doc.Metadata[domain.DocMetaSummary] != want {
+		t.Errorf("document summary = %v, want %q", doc.Metadata[domain.DocMetaSummary], want)
+	}
+	for _, chunk := range got {
+		if chunk.Metadata[domain.DocMetaSummary] != want {
+			t.Errorf("chunk %s summary = %v, want %q", chunk.ID, chunk.Metadata[domain.DocMetaSummary], want)
+		}
+	}
+	if got[1].Metadata["language"] != "en" {
+		t.Error("expected existing chunk metadata to be kept")
+	}
+	if len(llm.prompts) != 1 || !strings.Contains(llm.prompts[0], "Release meeting") {
+		t.Errorf("expected one prompt with the title, got %q", llm.prompts)
+	}
+}
+
+func TestProcessor_Process_ShortDocument(t *testing.T) {
+	llm := &stubLLM{reply: "A summary."}
+	doc := &domain.Document{Content: "A short note."}
+
+	if _, err := New(llm).Process(context.Background(), doc, nil); err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+
+	if len(llm.prompts) != 0 || doc.Metadata != nil {
+		t.Errorf("expected documents under %d characters not to be summarised", DefaultMinLength)
+	}
+}
+
+func TestProcessor_Process_LLMFailure(t *testing.T) {
+	llm := &stubLLM{err: errors.New("connection refused")}
+	doc := &domain.Document{Content: strings.Repeat("x", DefaultMinLength)}
+	chunks := []domain.Chunk{{ID: "c-1"}}
+
+	got, err := New(llm).Process(context.Background(), doc, chunks)
+
+	if err != nil {
+		t.Fatalf("expected the document to be kept without a summary, got %v", err)
+	}
+	if len(got) != 1 || doc.Metadata[domain.DocMetaSummary] != nil {
+		t.Errorf("expected chunks unchanged and no summary, got %v and %v", got, doc.Metadata)
+	}
+}
+
+func TestProcessor_Process_TruncatesInput(t *testing.T) {
+	llm := &stubLLM{reply: "Summary."}
+	doc := &domain.Document{Content: strings.Repeat("é", maxInputLength)}
+
+	if _, err := New(llm).Process(context.Background(), doc, nil); err != nil {
+		t.Fatalf("Process failed: %v", err)
+	}
+
+	if len(llm.prompts[0]) > maxInputLength+len(summaryPrompt) {
+		t.Errorf("expected content to be capped at %d bytes, prompt was %d", maxInputLength, len(llm.prompts[0]))
+	}
+}